@@ -54,47 +54,75 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xbf, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xcf, 0x0b, 0x83, 0xc5, 0x2f, 0xb9, 0x08, 0x89, 0x0e, 0x06, 0xc1, 0x7e, 0x51, 0x8b,
-	0x54, 0x44, 0x0b, 0xb4, 0xe9, 0x0f, 0x52, 0x89, 0x06, 0xc1, 0x05, 0x81, 0xc4, 0x82, 0x9c, 0xcb,
-	0x6b, 0x7b, 0xea, 0x25, 0x3e, 0x6c, 0x5f, 0x4a, 0x27, 0x18, 0x91, 0x90, 0x10, 0x4c, 0x48, 0x48,
-	0x4c, 0x2c, 0x0c, 0xfc, 0x0d, 0x48, 0x6c, 0x8c, 0x1d, 0xcb, 0x46, 0x2f, 0x0b, 0x63, 0xff, 0x04,
-	0x14, 0x2e, 0x76, 0xae, 0xe9, 0x51, 0x7c, 0x97, 0x6e, 0xb1, 0xe4, 0xcf, 0xf7, 0x7d, 0x9c, 0x38,
-	0xcf, 0x0f, 0x4f, 0x29, 0x68, 0x47, 0x5c, 0xb0, 0xb0, 0x22, 0x41, 0x74, 0x41, 0x54, 0x58, 0x14,
-	0x54, 0x58, 0xab, 0x1d, 0x74, 0xfa, 0xeb, 0xc0, 0x87, 0x4a, 0x77, 0xaa, 0x32, 0xf8, 0xe8, 0x46,
-	0x82, 0x2b, 0x4e, 0xae, 0x69, 0xc4, 0x4d, 0x11, 0x97, 0x45, 0x81, 0x9b, 0x45, 0xdc, 0xee, 0xd4,
-	0xe4, 0xac, 0x4d, 0xae, 0x80, 0xe7, 0x31, 0x48, 0xf5, 0x4c, 0x80, 0x8c, 0x78, 0x47, 0x0e, 0x0a,
-	0x4c, 0xff, 0x9c, 0xc0, 0xa7, 0xab, 0xfd, 0xad, 0x8d, 0x74, 0x2b, 0xf9, 0x84, 0xf0, 0x85, 0x65,
-	0x90, 0xbe, 0x08, 0x9a, 0x50, 0x8f, 0x15, 0x6b, 0x86, 0xd0, 0x50, 0x4c, 0x01, 0x59, 0x70, 0x2d,
-	0x5c, 0xdc, 0x3c, 0xd4, 0x4b, 0x4b, 0x4f, 0x56, 0xc7, 0x48, 0x48, 0xa5, 0xaf, 0x3a, 0xe4, 0x23,
-	0xc2, 0x13, 0x7a, 0xcb, 0x6a, 0x20, 0x15, 0x17, 0x3b, 0xab, 0x5c, 0x2a, 0x32, 0x5f, 0x28, 0x3c,
-	0x43, 0x6a, 0xbb, 0x85, 0xf2, 0x01, 0x46, 0xee, 0x25, 0xc6, 0x4b, 0x21, 0x97, 0xd0, 0xd8, 0x64,
-	0xa2, 0x45, 0x66, 0xac, 0x12, 0x87, 0x80, 0x36, 0xb9, 0x51, 0x98, 0xcb, 0x0a, 0x78, 0xd0, 0xe6,
-	0x5d, 0x78, 0xc4, 0xe4, 0x96, 0xa5, 0xc0, 0x10, 0x28, 0x26, 0x90, 0xe5, 0x8c, 0xc0, 0x77, 0x84,
-	0xaf, 0xd4, 0x40, 0x3d, 0xe1, 0x62, 0x6b, 0x3d, 0xe4, 0xdb, 0x2b, 0x2f, 0xc0, 0x8f, 0x55, 0xc0,
-	0x3b, 0x1e, 0xdb, 0x1e, 0x7c, 0x65, 0x8f, 0xa7, 0xc9, 0x9a, 0x55, 0xfe, 0xff, 0x62, 0xb4, 0x6d,
-	0xfd, 0x84, 0xd2, 0xcc, 0x19, 0x3e, 0x23, 0x7c, 0xb1, 0x06, 0xca, 0x83, 0x28, 0x0c, 0x7c, 0xd6,
-	0xdf, 0x58, 0x07, 0x29, 0xd9, 0x06, 0x48, 0xb2, 0x68, 0x5b, 0x2b, 0x07, 0xd6, 0xbe, 0x4b, 0x63,
-	0x65, 0x18, 0xcb, 0x6f, 0x08, 0x5f, 0xae, 0x81, 0xba, 0xcf, 0xda, 0x20, 0x23, 0xe6, 0x43, 0x9e,
-	0xee, 0x3d, 0xdb, 0x52, 0xc7, 0xa5, 0x68, 0xef, 0xb5, 0x93, 0x09, 0x33, 0x07, 0xf8, 0x8a, 0xf0,
-	0xa5, 0x1a, 0xa8, 0xe5, 0xb5, 0x87, 0x79, 0xea, 0x2b, 0xb6, 0xd5, 0xf2, 0x79, 0x2d, 0x7d, 0x77,
-	0xdc, 0x18, 0xa3, 0xfb, 0x1a, 0xe1, 0x33, 0x1e, 0xb0, 0x28, 0x0a, 0x77, 0x56, 0xba, 0xd0, 0x51,
-	0x92, 0xdc, 0xb4, 0xfc, 0x9b, 0x64, 0x18, 0xad, 0x35, 0x5b, 0x06, 0x35, 0x2a, 0x1f, 0x10, 0x26,
-	0xd5, 0x56, 0xab, 0x01, 0x4c, 0xf8, 0x9b, 0x55, 0xa5, 0x44, 0xd0, 0x8c, 0x15, 0x90, 0x3b, 0x56,
-	0xa1, 0x47, 0x41, 0x2d, 0x35, 0x5f, 0x9a, 0x37, 0x66, 0x6f, 0x11, 0x3e, 0xa7, 0x5b, 0xe4, 0x52,
-	0x18, 0x4b, 0x05, 0x82, 0xcc, 0x15, 0x6a, 0xac, 0x03, 0x4a, 0x3b, 0xdd, 0x2a, 0x07, 0x1b, 0xa1,
-	0x37, 0x08, 0x9f, 0x4d, 0x7f, 0x5d, 0x73, 0xb3, 0x66, 0x0b, 0x5c, 0x89, 0xd1, 0xeb, 0x34, 0x57,
-	0x8a, 0x35, 0x36, 0xef, 0x11, 0x3e, 0xff, 0x20, 0x16, 0x1b, 0x90, 0xf5, 0xb1, 0x3b, 0xe2, 0x28,
-	0xa6, 0x8d, 0x6e, 0x97, 0xa4, 0x0f, 0x39, 0xd5, 0xa1, 0x94, 0xd3, 0x28, 0x56, 0xcc, 0xe9, 0x28,
-	0x6d, 0x9c, 0xfa, 0x43, 0x88, 0x07, 0xeb, 0x02, 0xe4, 0xa6, 0x6e, 0xda, 0xfd, 0x77, 0x46, 0x5a,
-	0x0e, 0x21, 0x79, 0x68, 0xb1, 0x21, 0x24, 0x3f, 0xe1, 0xd0, 0x0b, 0xe1, 0x81, 0x84, 0x4e, 0x2b,
-	0xd3, 0x33, 0x52, 0xc3, 0x45, 0xcb, 0xfc, 0x3c, 0xb8, 0xd8, 0x0b, 0xf1, 0xaf, 0x0c, 0x6d, 0xb9,
-	0x18, 0xee, 0xee, 0x53, 0x67, 0x6f, 0x9f, 0x3a, 0x07, 0xfb, 0x14, 0xbd, 0x4a, 0x28, 0xfa, 0x92,
-	0x50, 0xf4, 0x23, 0xa1, 0x68, 0x37, 0xa1, 0xe8, 0x57, 0x42, 0xd1, 0xef, 0x84, 0x3a, 0x07, 0x09,
-	0x45, 0xef, 0x7a, 0xd4, 0xd9, 0xed, 0x51, 0x67, 0xaf, 0x47, 0x9d, 0xa7, 0x33, 0x1b, 0x7c, 0x58,
-	0x3e, 0xe0, 0xc7, 0xcc, 0x94, 0x73, 0xd9, 0x75, 0xf3, 0xd4, 0xdf, 0x81, 0xf2, 0xfa, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf6, 0x9b, 0x25, 0x12, 0xe6, 0x0a, 0x00, 0x00,
+	// 1075 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcf, 0x6b, 0x33, 0x45,
+	0x18, 0xc7, 0x33, 0x17, 0x0f, 0x83, 0xbf, 0x58, 0xc5, 0x1f, 0x55, 0x56, 0xd1, 0xab, 0xa4, 0xf4,
+	0x15, 0x5e, 0xb1, 0x55, 0xdf, 0xa6, 0xdb, 0xba, 0x79, 0x31, 0xb1, 0xbe, 0x9b, 0xa2, 0xe0, 0x45,
+	0x26, 0x9b, 0xa7, 0xc9, 0x98, 0xcd, 0xce, 0x3a, 0x33, 0x9b, 0xda, 0x93, 0x1e, 0x05, 0x41, 0xf4,
+	0x24, 0x0a, 0x9e, 0x04, 0x51, 0x10, 0x04, 0xc1, 0xab, 0xe0, 0xcd, 0x63, 0x8f, 0xef, 0xd1, 0xa6,
+	0x17, 0x8f, 0xef, 0x9f, 0x20, 0x9b, 0x64, 0xb6, 0xbb, 0xc9, 0x24, 0xce, 0x6c, 0x7a, 0x6b, 0x9a,
+	0xf9, 0x7e, 0xe7, 0xb3, 0xcf, 0x3c, 0x33, 0xfb, 0x9d, 0xe0, 0x1d, 0x09, 0xa3, 0x84, 0x71, 0x12,
+	0x6d, 0x0b, 0xe0, 0x63, 0xe0, 0xdb, 0x24, 0xa1, 0xdb, 0xa4, 0x37, 0xa2, 0x71, 0xf6, 0x99, 0x86,
+	0xb0, 0x3d, 0xde, 0xd9, 0x9e, 0xff, 0x59, 0x4f, 0x38, 0x93, 0xcc, 0x79, 0x59, 0x49, 0xea, 0x33,
+	0x49, 0x9d, 0x24, 0xb4, 0x5e, 0x94, 0xd4, 0xc7, 0x3b, 0x5b, 0xbb, 0x26, 0xbe, 0x1c, 0x3e, 0x49,
+	0x41, 0xc8, 0x8f, 0x38, 0x88, 0x84, 0xc5, 0x62, 0x3e, 0xc1, 0xad, 0xef, 0x5e, 0xc1, 0x0f, 0x37,
+	0xb2, 0xa1, 0x9d, 0xd9, 0x50, 0xe7, 0x07, 0x84, 0x9f, 0x3c, 0x04, 0x11, 0x72, 0xda, 0x85, 0x76,
+	0x2a, 0x49, 0x37, 0x82, 0x8e, 0x24, 0x12, 0x9c, 0xfd, 0xba, 0x01, 0x4b, 0x5d, 0x27, 0x0d, 0x66,
+	0x53, 0x6f, 0x35, 0x36, 0x70, 0x98, 0x41, 0xbf, 0x54, 0x73, 0xbe, 0x47, 0xf8, 0x09, 0x35, 0xa4,
+	0x49, 0x85, 0x64, 0xfc, 0xbc, 0xc9, 0x84, 0x74, 0xee, 0x58, 0x99, 0x17, 0x94, 0x8a, 0x6e, 0xbf,
+	0xba, 0x41, 0x0e, 0xf7, 0x19, 0xc6, 0x5e, 0xc4, 0x04, 0x74, 0x06, 0x84, 0xf7, 0x9c, 0xdb, 0x46,
+	0x8e, 0xd7, 0x02, 0x45, 0xf2, 0x9a, 0xb5, 0xae, 0x08, 0x10, 0xc0, 0x88, 0x8d, 0xe1, 0x84, 0x88,
+	0xa1, 0x21, 0xc0, 0xb5, 0xc0, 0x0e, 0xa0, 0xa8, 0xcb, 0x01, 0xfe, 0x42, 0xf8, 0x45, 0x1f, 0xe4,
+	0x07, 0x8c, 0x0f, 0x4f, 0x23, 0x76, 0x76, 0xf4, 0x29, 0x84, 0xa9, 0xa4, 0x2c, 0x0e, 0xc8, 0xd9,
+	0xbc, 0x64, 0xef, 0xdf, 0x72, 0x5a, 0x46, 0xfe, 0xff, 0x67, 0xa3, 0x68, 0xdb, 0x37, 0xe4, 0x96,
+	0x3f, 0xc3, 0x8f, 0x08, 0x3f, 0xe5, 0x83, 0x0c, 0x20, 0x89, 0x68, 0x48, 0xb2, 0x81, 0x6d, 0x10,
+	0x82, 0xf4, 0x41, 0x38, 0x07, 0xa6, 0x73, 0x69, 0xc4, 0x8a, 0xd7, 0xdb, 0xc8, 0x23, 0xa7, 0xfc,
+	0x13, 0xe1, 0x17, 0x7c, 0x90, 0xef, 0x92, 0x11, 0x88, 0x84, 0x84, 0xa0, 0xc3, 0x7d, 0xc7, 0x74,
+	0xaa, 0x75, 0x2e, 0x8a, 0xbb, 0x75, 0x33, 0x66, 0xf9, 0x03, 0xfc, 0x8a, 0xf0, 0xb3, 0x3e, 0xc8,
+	0xc3, 0xd6, 0x3d, 0x1d, 0xfa, 0x91, 0xe9, 0x6c, 0x7a, 0xbd, 0x82, 0x7e, 0x7b, 0x53, 0x9b, 0x1c,
+	0xf7, 0x0b, 0x84, 0x1f, 0x09, 0x80, 0x24, 0x49, 0x74, 0x7e, 0x34, 0x86, 0x58, 0x0a, 0xe7, 0x75,
+	0xc3, 0x6d, 0x52, 0xd0, 0x28, 0xac, 0xdd, 0x2a, 0xd2, 0x1c, 0xe5, 0x5b, 0x84, 0x9d, 0x46, 0xaf,
+	0xd7, 0x01, 0xc2, 0xc3, 0x41, 0x43, 0x4a, 0x4e, 0xbb, 0xa9, 0x04, 0xe7, 0x2d, 0x23, 0xd3, 0x65,
+	0xa1, 0x82, 0xba, 0x53, 0x59, 0x9f, 0x93, 0x7d, 0x85, 0xf0, 0x63, 0xea, 0x88, 0xf4, 0xa2, 0x54,
+	0x48, 0xe0, 0xce, 0x9e, 0xd5, 0xc1, 0x3a, 0x57, 0x29, 0xa6, 0x37, 0xaa, 0x89, 0x73, 0xa0, 0x2f,
+	0x11, 0x7e, 0x74, 0xb6, 0xba, 0x79, 0x67, 0xed, 0x5a, 0xb4, 0xc4, 0x62, 0x3b, 0xed, 0x55, 0xd2,
+	0xe6, 0x34, 0xdf, 0x20, 0xfc, 0xf8, 0x7b, 0x29, 0xef, 0x43, 0x91, 0xc7, 0xec, 0x11, 0x17, 0x65,
+	0x8a, 0xe8, 0xcd, 0x8a, 0xea, 0x12, 0x53, 0x1b, 0x2a, 0x31, 0xb5, 0x61, 0x13, 0xa6, 0x36, 0xac,
+	0x64, 0xca, 0x42, 0x48, 0x00, 0xa7, 0x1c, 0xc4, 0x40, 0x1d, 0xda, 0xd9, 0x7b, 0x46, 0x18, 0x86,
+	0x10, 0x9d, 0xd4, 0x2e, 0x84, 0xe8, 0x1d, 0x4a, 0x6f, 0x88, 0x00, 0x04, 0xc4, 0xbd, 0xc2, 0x99,
+	0x31, 0x23, 0x3c, 0x30, 0xf4, 0xd7, 0x89, 0xed, 0xde, 0x10, 0xab, 0x3c, 0x4a, 0x2b, 0xeb, 0x83,
+	0xbc, 0x1b, 0x4b, 0x32, 0x84, 0xe3, 0x54, 0x86, 0x6c, 0x04, 0x86, 0x2b, 0xbb, 0x28, 0xb3, 0x5b,
+	0xd9, 0x65, 0x75, 0xce, 0xf4, 0x13, 0xc2, 0x4f, 0xfb, 0x20, 0xa7, 0xb9, 0xe5, 0xf8, 0x2c, 0x06,
+	0x2e, 0x06, 0x34, 0x09, 0x20, 0x61, 0x5c, 0x3a, 0xc6, 0x2f, 0x46, 0x9d, 0x5a, 0x11, 0x1e, 0x6e,
+	0x66, 0x52, 0xca, 0x99, 0x1d, 0x49, 0xb8, 0x9c, 0x47, 0xac, 0x2e, 0x89, 0x48, 0x1c, 0x82, 0x61,
+	0xce, 0xd4, 0x28, 0xed, 0x72, 0xa6, 0xd6, 0xa0, 0xb4, 0x3f, 0xbc, 0xec, 0x7f, 0xd1, 0x02, 0x9d,
+	0x99, 0xb9, 0x4e, 0x6a, 0xb7, 0x3f, 0xf4, 0x0e, 0xe5, 0xfd, 0xcb, 0x24, 0x91, 0x70, 0xd2, 0xea,
+	0x78, 0xc0, 0x25, 0x3d, 0xcd, 0x7a, 0xd4, 0x94, 0x4f, 0x27, 0xb5, 0xdc, 0xbf, 0x5a, 0x07, 0xed,
+	0x25, 0xe2, 0xa4, 0xd5, 0x99, 0x8e, 0xa6, 0x2c, 0xb6, 0xbc, 0x44, 0x14, 0x94, 0xd5, 0x2e, 0x11,
+	0x25, 0x83, 0x52, 0x2e, 0x6a, 0xc4, 0x71, 0xf6, 0x05, 0x2c, 0x45, 0x56, 0xc3, 0x5c, 0xb4, 0x52,
+	0x6f, 0x97, 0x8b, 0xd6, 0xd8, 0x94, 0x6a, 0xd9, 0xc8, 0x62, 0xca, 0x71, 0x02, 0x9c, 0x48, 0xc6,
+	0x1b, 0xa1, 0x45, 0x2d, 0x35, 0x4a, 0xbb, 0x5a, 0x6a, 0x0d, 0x72, 0xb8, 0x5f, 0x10, 0x7e, 0xa6,
+	0x9c, 0xa4, 0xa7, 0x61, 0xca, 0x1b, 0xa4, 0xf1, 0xd0, 0x39, 0xac, 0x10, 0xc4, 0xaf, 0xe5, 0x0a,
+	0xf3, 0x68, 0x43, 0x97, 0xd2, 0x71, 0x3d, 0xdd, 0xf6, 0xd9, 0x40, 0x72, 0xee, 0x0d, 0x20, 0x1c,
+	0x1a, 0x1e, 0xd7, 0x8b, 0x32, 0xbb, 0xe3, 0x7a, 0x59, 0xad, 0xdd, 0x28, 0x45, 0x2c, 0xbb, 0x8d,
+	0xa2, 0x21, 0xdb, 0xaf, 0x6e, 0x90, 0xc3, 0xfd, 0x86, 0xf0, 0x56, 0x8b, 0x8a, 0xe2, 0x7d, 0xa3,
+	0x4f, 0x85, 0xe4, 0xd3, 0x12, 0x0b, 0xc7, 0xac, 0xc5, 0x57, 0x1b, 0x28, 0x54, 0x7f, 0x63, 0x9f,
+	0x9c, 0xf8, 0x0f, 0x84, 0x9f, 0x6f, 0x24, 0x09, 0x67, 0x63, 0xd0, 0x8e, 0x75, 0x9a, 0xa6, 0x3d,
+	0xbf, 0xd2, 0x42, 0x51, 0xdf, 0xbd, 0x01, 0xa7, 0x9c, 0xfb, 0x77, 0x84, 0x9f, 0x0b, 0xe0, 0x63,
+	0x08, 0xf5, 0x8f, 0xe8, 0xf8, 0x86, 0x81, 0x65, 0xa5, 0x83, 0xa2, 0x6e, 0x6e, 0x6e, 0x54, 0xea,
+	0xdd, 0x6c, 0x55, 0xe6, 0x57, 0xfc, 0x03, 0x4e, 0xe2, 0x70, 0x00, 0xc2, 0xb0, 0x77, 0x35, 0x4a,
+	0xbb, 0xde, 0xd5, 0x1a, 0x94, 0x2a, 0xea, 0x83, 0xcc, 0x22, 0xdb, 0xbd, 0x14, 0x52, 0x68, 0xa4,
+	0x92, 0x89, 0x90, 0x44, 0x34, 0xee, 0x37, 0x69, 0x2c, 0x0d, 0x2b, 0xba, 0xc6, 0xc1, 0xae, 0xa2,
+	0x6b, 0x8d, 0x4a, 0xe1, 0x2d, 0x80, 0x90, 0xf1, 0x9e, 0xc7, 0x62, 0x41, 0x85, 0x84, 0x38, 0x3c,
+	0x6f, 0x13, 0x3e, 0x04, 0xee, 0x98, 0x66, 0x56, 0xad, 0xda, 0x2e, 0xbc, 0xad, 0x34, 0x29, 0xe5,
+	0xf3, 0xac, 0xfe, 0x4b, 0x63, 0x4c, 0xf3, 0xb9, 0x5e, 0x6c, 0x97, 0xcf, 0x57, 0x79, 0x94, 0x52,
+	0x92, 0x0f, 0xb2, 0x10, 0x51, 0xbc, 0x01, 0xa1, 0xb1, 0xe9, 0x2d, 0x47, 0x27, 0xb5, 0x4b, 0x49,
+	0x7a, 0x07, 0xc5, 0x77, 0x10, 0x5d, 0x5c, 0xba, 0xb5, 0xfb, 0x97, 0x6e, 0xed, 0xc1, 0xa5, 0x8b,
+	0x3e, 0x9f, 0xb8, 0xe8, 0xe7, 0x89, 0x8b, 0xfe, 0x9e, 0xb8, 0xe8, 0x62, 0xe2, 0xa2, 0x7f, 0x26,
+	0x2e, 0xfa, 0x77, 0xe2, 0xd6, 0x1e, 0x4c, 0x5c, 0xf4, 0xf5, 0x95, 0x5b, 0xbb, 0xb8, 0x72, 0x6b,
+	0xf7, 0xaf, 0xdc, 0xda, 0x87, 0xb7, 0xfb, 0xec, 0x7a, 0x72, 0xca, 0xd6, 0xfc, 0x26, 0xbd, 0x57,
+	0xfc, 0xdc, 0x7d, 0x68, 0xfa, 0x83, 0xf4, 0xab, 0xff, 0x0d, 0x00, 0x52, 0x64, 0xfa, 0xbb, 0x26,
+	0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -144,6 +172,52 @@ type AdminServiceClient interface {
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
+	// GetIntakeOutcome returns the outcome of a request accepted to the intake queue.
+	GetIntakeOutcome(ctx context.Context, in *GetIntakeOutcomeRequest, opts ...grpc.CallOption) (*GetIntakeOutcomeResponse, error)
+	// GetShardOwnershipReport reports shard counts and load of every history host.
+	GetShardOwnershipReport(ctx context.Context, in *GetShardOwnershipReportRequest, opts ...grpc.CallOption) (*GetShardOwnershipReportResponse, error)
+	// StartShardRebalance gradually moves history shards towards target distribution over history hosts.
+	StartShardRebalance(ctx context.Context, in *StartShardRebalanceRequest, opts ...grpc.CallOption) (*StartShardRebalanceResponse, error)
+	// CancelShardRebalance gradually moves history shards back to the owners decided by hash ring.
+	CancelShardRebalance(ctx context.Context, in *CancelShardRebalanceRequest, opts ...grpc.CallOption) (*CancelShardRebalanceResponse, error)
+	// RotateTLSCertificate asks every host to reload the certificate of a TLS group from its configured source.
+	RotateTLSCertificate(ctx context.Context, in *RotateTLSCertificateRequest, opts ...grpc.CallOption) (*RotateTLSCertificateResponse, error)
+	// DescribeTLSRotation returns the latest TLS certificate rotation and its outcome on every host.
+	DescribeTLSRotation(ctx context.Context, in *DescribeTLSRotationRequest, opts ...grpc.CallOption) (*DescribeTLSRotationResponse, error)
+	// AnnotateWorkflowExecution attaches an operator note to a running or closed workflow execution.
+	// Annotations are kept outside of history and shown in visibility through reserved search attributes.
+	AnnotateWorkflowExecution(ctx context.Context, in *AnnotateWorkflowExecutionRequest, opts ...grpc.CallOption) (*AnnotateWorkflowExecutionResponse, error)
+	// ApplyOperatorAction applies an operator action, e.g. firing a pending timer, to a running workflow execution
+	// during incident recovery. Applied actions are kept as operator annotations of the execution.
+	ApplyOperatorAction(ctx context.Context, in *ApplyOperatorActionRequest, opts ...grpc.CallOption) (*ApplyOperatorActionResponse, error)
+	// GetReplicationEventChunk returns a chunk of the events of a history replication task
+	// which is too large to be sent in one message.
+	GetReplicationEventChunk(ctx context.Context, in *GetReplicationEventChunkRequest, opts ...grpc.CallOption) (*GetReplicationEventChunkResponse, error)
+	// StartReplayCheck replays recent workflow histories on a shadow worker build polling a validation task queue,
+	// so that non-determinism of the build is reported before it receives real traffic.
+	StartReplayCheck(ctx context.Context, in *StartReplayCheckRequest, opts ...grpc.CallOption) (*StartReplayCheckResponse, error)
+	// DescribeReplayCheck returns the status and the outcome of a replay check.
+	DescribeReplayCheck(ctx context.Context, in *DescribeReplayCheckRequest, opts ...grpc.CallOption) (*DescribeReplayCheckResponse, error)
+	// ListNamespaceRegistrations lists namespace registrations which wait for approval.
+	ListNamespaceRegistrations(ctx context.Context, in *ListNamespaceRegistrationsRequest, opts ...grpc.CallOption) (*ListNamespaceRegistrationsResponse, error)
+	// ApproveNamespaceRegistration registers the namespace of a registration which waits for approval.
+	ApproveNamespaceRegistration(ctx context.Context, in *ApproveNamespaceRegistrationRequest, opts ...grpc.CallOption) (*ApproveNamespaceRegistrationResponse, error)
+	// RejectNamespaceRegistration drops a registration which waits for approval.
+	RejectNamespaceRegistration(ctx context.Context, in *RejectNamespaceRegistrationRequest, opts ...grpc.CallOption) (*RejectNamespaceRegistrationResponse, error)
+	// ListHistoryBranches scans a page of history branches and returns workflows with many live branches
+	// along with the size of every branch.
+	ListHistoryBranches(ctx context.Context, in *ListHistoryBranchesRequest, opts ...grpc.CallOption) (*ListHistoryBranchesResponse, error)
+	// GetTaskQueueAutoscalingHint returns recommended worker count of a task queue, aggregated over its partitions,
+	// for external autoscalers of worker fleets.
+	GetTaskQueueAutoscalingHint(ctx context.Context, in *GetTaskQueueAutoscalingHintRequest, opts ...grpc.CallOption) (*GetTaskQueueAutoscalingHintResponse, error)
+	// RecordConsistencyMarker records the ack levels of all shards and the namespace replication queue as a
+	// consistency marker, backup tooling snapshots it along with the tables. History hosts reconcile restored
+	// shards with the marker set by history.restoreConsistencyMarkerID dynamic config.
+	RecordConsistencyMarker(ctx context.Context, in *RecordConsistencyMarkerRequest, opts ...grpc.CallOption) (*RecordConsistencyMarkerResponse, error)
+	ListConsistencyMarkers(ctx context.Context, in *ListConsistencyMarkersRequest, opts ...grpc.CallOption) (*ListConsistencyMarkersResponse, error)
+	// GetCertificateChains returns the certificate chains currently loaded by the frontend node serving the request,
+	// so that operators can verify which certificates a node presents after rotation.
+	GetCertificateChains(ctx context.Context, in *GetCertificateChainsRequest, opts ...grpc.CallOption) (*GetCertificateChainsResponse, error)
 }
 
 type adminServiceClient struct {
@@ -298,6 +372,177 @@ func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *adminServiceClient) GetIntakeOutcome(ctx context.Context, in *GetIntakeOutcomeRequest, opts ...grpc.CallOption) (*GetIntakeOutcomeResponse, error) {
+	out := new(GetIntakeOutcomeResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetIntakeOutcome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetShardOwnershipReport(ctx context.Context, in *GetShardOwnershipReportRequest, opts ...grpc.CallOption) (*GetShardOwnershipReportResponse, error) {
+	out := new(GetShardOwnershipReportResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetShardOwnershipReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartShardRebalance(ctx context.Context, in *StartShardRebalanceRequest, opts ...grpc.CallOption) (*StartShardRebalanceResponse, error) {
+	out := new(StartShardRebalanceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartShardRebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CancelShardRebalance(ctx context.Context, in *CancelShardRebalanceRequest, opts ...grpc.CallOption) (*CancelShardRebalanceResponse, error) {
+	out := new(CancelShardRebalanceResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CancelShardRebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RotateTLSCertificate(ctx context.Context, in *RotateTLSCertificateRequest, opts ...grpc.CallOption) (*RotateTLSCertificateResponse, error) {
+	out := new(RotateTLSCertificateResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RotateTLSCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeTLSRotation(ctx context.Context, in *DescribeTLSRotationRequest, opts ...grpc.CallOption) (*DescribeTLSRotationResponse, error) {
+	out := new(DescribeTLSRotationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeTLSRotation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AnnotateWorkflowExecution(ctx context.Context, in *AnnotateWorkflowExecutionRequest, opts ...grpc.CallOption) (*AnnotateWorkflowExecutionResponse, error) {
+	out := new(AnnotateWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/AnnotateWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ApplyOperatorAction(ctx context.Context, in *ApplyOperatorActionRequest, opts ...grpc.CallOption) (*ApplyOperatorActionResponse, error) {
+	out := new(ApplyOperatorActionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ApplyOperatorAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetReplicationEventChunk(ctx context.Context, in *GetReplicationEventChunkRequest, opts ...grpc.CallOption) (*GetReplicationEventChunkResponse, error) {
+	out := new(GetReplicationEventChunkResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetReplicationEventChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartReplayCheck(ctx context.Context, in *StartReplayCheckRequest, opts ...grpc.CallOption) (*StartReplayCheckResponse, error) {
+	out := new(StartReplayCheckResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartReplayCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeReplayCheck(ctx context.Context, in *DescribeReplayCheckRequest, opts ...grpc.CallOption) (*DescribeReplayCheckResponse, error) {
+	out := new(DescribeReplayCheckResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeReplayCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListNamespaceRegistrations(ctx context.Context, in *ListNamespaceRegistrationsRequest, opts ...grpc.CallOption) (*ListNamespaceRegistrationsResponse, error) {
+	out := new(ListNamespaceRegistrationsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListNamespaceRegistrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ApproveNamespaceRegistration(ctx context.Context, in *ApproveNamespaceRegistrationRequest, opts ...grpc.CallOption) (*ApproveNamespaceRegistrationResponse, error) {
+	out := new(ApproveNamespaceRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ApproveNamespaceRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RejectNamespaceRegistration(ctx context.Context, in *RejectNamespaceRegistrationRequest, opts ...grpc.CallOption) (*RejectNamespaceRegistrationResponse, error) {
+	out := new(RejectNamespaceRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RejectNamespaceRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListHistoryBranches(ctx context.Context, in *ListHistoryBranchesRequest, opts ...grpc.CallOption) (*ListHistoryBranchesResponse, error) {
+	out := new(ListHistoryBranchesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListHistoryBranches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetTaskQueueAutoscalingHint(ctx context.Context, in *GetTaskQueueAutoscalingHintRequest, opts ...grpc.CallOption) (*GetTaskQueueAutoscalingHintResponse, error) {
+	out := new(GetTaskQueueAutoscalingHintResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueAutoscalingHint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RecordConsistencyMarker(ctx context.Context, in *RecordConsistencyMarkerRequest, opts ...grpc.CallOption) (*RecordConsistencyMarkerResponse, error) {
+	out := new(RecordConsistencyMarkerResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/RecordConsistencyMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListConsistencyMarkers(ctx context.Context, in *ListConsistencyMarkersRequest, opts ...grpc.CallOption) (*ListConsistencyMarkersResponse, error) {
+	out := new(ListConsistencyMarkersResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListConsistencyMarkers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetCertificateChains(ctx context.Context, in *GetCertificateChainsRequest, opts ...grpc.CallOption) (*GetCertificateChainsResponse, error) {
+	out := new(GetCertificateChainsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetCertificateChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
@@ -335,6 +580,52 @@ type AdminServiceServer interface {
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
+	// GetIntakeOutcome returns the outcome of a request accepted to the intake queue.
+	GetIntakeOutcome(context.Context, *GetIntakeOutcomeRequest) (*GetIntakeOutcomeResponse, error)
+	// GetShardOwnershipReport reports shard counts and load of every history host.
+	GetShardOwnershipReport(context.Context, *GetShardOwnershipReportRequest) (*GetShardOwnershipReportResponse, error)
+	// StartShardRebalance gradually moves history shards towards target distribution over history hosts.
+	StartShardRebalance(context.Context, *StartShardRebalanceRequest) (*StartShardRebalanceResponse, error)
+	// CancelShardRebalance gradually moves history shards back to the owners decided by hash ring.
+	CancelShardRebalance(context.Context, *CancelShardRebalanceRequest) (*CancelShardRebalanceResponse, error)
+	// RotateTLSCertificate asks every host to reload the certificate of a TLS group from its configured source.
+	RotateTLSCertificate(context.Context, *RotateTLSCertificateRequest) (*RotateTLSCertificateResponse, error)
+	// DescribeTLSRotation returns the latest TLS certificate rotation and its outcome on every host.
+	DescribeTLSRotation(context.Context, *DescribeTLSRotationRequest) (*DescribeTLSRotationResponse, error)
+	// AnnotateWorkflowExecution attaches an operator note to a running or closed workflow execution.
+	// Annotations are kept outside of history and shown in visibility through reserved search attributes.
+	AnnotateWorkflowExecution(context.Context, *AnnotateWorkflowExecutionRequest) (*AnnotateWorkflowExecutionResponse, error)
+	// ApplyOperatorAction applies an operator action, e.g. firing a pending timer, to a running workflow execution
+	// during incident recovery. Applied actions are kept as operator annotations of the execution.
+	ApplyOperatorAction(context.Context, *ApplyOperatorActionRequest) (*ApplyOperatorActionResponse, error)
+	// GetReplicationEventChunk returns a chunk of the events of a history replication task
+	// which is too large to be sent in one message.
+	GetReplicationEventChunk(context.Context, *GetReplicationEventChunkRequest) (*GetReplicationEventChunkResponse, error)
+	// StartReplayCheck replays recent workflow histories on a shadow worker build polling a validation task queue,
+	// so that non-determinism of the build is reported before it receives real traffic.
+	StartReplayCheck(context.Context, *StartReplayCheckRequest) (*StartReplayCheckResponse, error)
+	// DescribeReplayCheck returns the status and the outcome of a replay check.
+	DescribeReplayCheck(context.Context, *DescribeReplayCheckRequest) (*DescribeReplayCheckResponse, error)
+	// ListNamespaceRegistrations lists namespace registrations which wait for approval.
+	ListNamespaceRegistrations(context.Context, *ListNamespaceRegistrationsRequest) (*ListNamespaceRegistrationsResponse, error)
+	// ApproveNamespaceRegistration registers the namespace of a registration which waits for approval.
+	ApproveNamespaceRegistration(context.Context, *ApproveNamespaceRegistrationRequest) (*ApproveNamespaceRegistrationResponse, error)
+	// RejectNamespaceRegistration drops a registration which waits for approval.
+	RejectNamespaceRegistration(context.Context, *RejectNamespaceRegistrationRequest) (*RejectNamespaceRegistrationResponse, error)
+	// ListHistoryBranches scans a page of history branches and returns workflows with many live branches
+	// along with the size of every branch.
+	ListHistoryBranches(context.Context, *ListHistoryBranchesRequest) (*ListHistoryBranchesResponse, error)
+	// GetTaskQueueAutoscalingHint returns recommended worker count of a task queue, aggregated over its partitions,
+	// for external autoscalers of worker fleets.
+	GetTaskQueueAutoscalingHint(context.Context, *GetTaskQueueAutoscalingHintRequest) (*GetTaskQueueAutoscalingHintResponse, error)
+	// RecordConsistencyMarker records the ack levels of all shards and the namespace replication queue as a
+	// consistency marker, backup tooling snapshots it along with the tables. History hosts reconcile restored
+	// shards with the marker set by history.restoreConsistencyMarkerID dynamic config.
+	RecordConsistencyMarker(context.Context, *RecordConsistencyMarkerRequest) (*RecordConsistencyMarkerResponse, error)
+	ListConsistencyMarkers(context.Context, *ListConsistencyMarkersRequest) (*ListConsistencyMarkersResponse, error)
+	// GetCertificateChains returns the certificate chains currently loaded by the frontend node serving the request,
+	// so that operators can verify which certificates a node presents after rotation.
+	GetCertificateChains(context.Context, *GetCertificateChainsRequest) (*GetCertificateChainsResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -389,6 +680,63 @@ func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context
 func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) GetIntakeOutcome(ctx context.Context, req *GetIntakeOutcomeRequest) (*GetIntakeOutcomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIntakeOutcome not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetShardOwnershipReport(ctx context.Context, req *GetShardOwnershipReportRequest) (*GetShardOwnershipReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardOwnershipReport not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartShardRebalance(ctx context.Context, req *StartShardRebalanceRequest) (*StartShardRebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartShardRebalance not implemented")
+}
+func (*UnimplementedAdminServiceServer) CancelShardRebalance(ctx context.Context, req *CancelShardRebalanceRequest) (*CancelShardRebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelShardRebalance not implemented")
+}
+func (*UnimplementedAdminServiceServer) RotateTLSCertificate(ctx context.Context, req *RotateTLSCertificateRequest) (*RotateTLSCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateTLSCertificate not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeTLSRotation(ctx context.Context, req *DescribeTLSRotationRequest) (*DescribeTLSRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTLSRotation not implemented")
+}
+func (*UnimplementedAdminServiceServer) AnnotateWorkflowExecution(ctx context.Context, req *AnnotateWorkflowExecutionRequest) (*AnnotateWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnotateWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) ApplyOperatorAction(ctx context.Context, req *ApplyOperatorActionRequest) (*ApplyOperatorActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyOperatorAction not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetReplicationEventChunk(ctx context.Context, req *GetReplicationEventChunkRequest) (*GetReplicationEventChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationEventChunk not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartReplayCheck(ctx context.Context, req *StartReplayCheckRequest) (*StartReplayCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartReplayCheck not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeReplayCheck(ctx context.Context, req *DescribeReplayCheckRequest) (*DescribeReplayCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeReplayCheck not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListNamespaceRegistrations(ctx context.Context, req *ListNamespaceRegistrationsRequest) (*ListNamespaceRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaceRegistrations not implemented")
+}
+func (*UnimplementedAdminServiceServer) ApproveNamespaceRegistration(ctx context.Context, req *ApproveNamespaceRegistrationRequest) (*ApproveNamespaceRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveNamespaceRegistration not implemented")
+}
+func (*UnimplementedAdminServiceServer) RejectNamespaceRegistration(ctx context.Context, req *RejectNamespaceRegistrationRequest) (*RejectNamespaceRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectNamespaceRegistration not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListHistoryBranches(ctx context.Context, req *ListHistoryBranchesRequest) (*ListHistoryBranchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHistoryBranches not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetTaskQueueAutoscalingHint(ctx context.Context, req *GetTaskQueueAutoscalingHintRequest) (*GetTaskQueueAutoscalingHintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueAutoscalingHint not implemented")
+}
+func (*UnimplementedAdminServiceServer) RecordConsistencyMarker(ctx context.Context, req *RecordConsistencyMarkerRequest) (*RecordConsistencyMarkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordConsistencyMarker not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListConsistencyMarkers(ctx context.Context, req *ListConsistencyMarkersRequest) (*ListConsistencyMarkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConsistencyMarkers not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetCertificateChains(ctx context.Context, req *GetCertificateChainsRequest) (*GetCertificateChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCertificateChains not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -682,6 +1030,348 @@ func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetIntakeOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIntakeOutcomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetIntakeOutcome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetIntakeOutcome",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetIntakeOutcome(ctx, req.(*GetIntakeOutcomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetShardOwnershipReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardOwnershipReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetShardOwnershipReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetShardOwnershipReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetShardOwnershipReport(ctx, req.(*GetShardOwnershipReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartShardRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartShardRebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartShardRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartShardRebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartShardRebalance(ctx, req.(*StartShardRebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CancelShardRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelShardRebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelShardRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CancelShardRebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelShardRebalance(ctx, req.(*CancelShardRebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RotateTLSCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateTLSCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RotateTLSCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RotateTLSCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RotateTLSCertificate(ctx, req.(*RotateTLSCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeTLSRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTLSRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTLSRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeTLSRotation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTLSRotation(ctx, req.(*DescribeTLSRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AnnotateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotateWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AnnotateWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/AnnotateWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AnnotateWorkflowExecution(ctx, req.(*AnnotateWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ApplyOperatorAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyOperatorActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ApplyOperatorAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ApplyOperatorAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ApplyOperatorAction(ctx, req.(*ApplyOperatorActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetReplicationEventChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationEventChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReplicationEventChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetReplicationEventChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReplicationEventChunk(ctx, req.(*GetReplicationEventChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartReplayCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartReplayCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartReplayCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartReplayCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartReplayCheck(ctx, req.(*StartReplayCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeReplayCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeReplayCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeReplayCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeReplayCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeReplayCheck(ctx, req.(*DescribeReplayCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListNamespaceRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespaceRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListNamespaceRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListNamespaceRegistrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListNamespaceRegistrations(ctx, req.(*ListNamespaceRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ApproveNamespaceRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveNamespaceRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ApproveNamespaceRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ApproveNamespaceRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ApproveNamespaceRegistration(ctx, req.(*ApproveNamespaceRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RejectNamespaceRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectNamespaceRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RejectNamespaceRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RejectNamespaceRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RejectNamespaceRegistration(ctx, req.(*RejectNamespaceRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListHistoryBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryBranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListHistoryBranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListHistoryBranches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListHistoryBranches(ctx, req.(*ListHistoryBranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetTaskQueueAutoscalingHint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskQueueAutoscalingHintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetTaskQueueAutoscalingHint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueAutoscalingHint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetTaskQueueAutoscalingHint(ctx, req.(*GetTaskQueueAutoscalingHintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RecordConsistencyMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordConsistencyMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RecordConsistencyMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/RecordConsistencyMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RecordConsistencyMarker(ctx, req.(*RecordConsistencyMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListConsistencyMarkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConsistencyMarkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListConsistencyMarkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListConsistencyMarkers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListConsistencyMarkers(ctx, req.(*ListConsistencyMarkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetCertificateChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCertificateChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetCertificateChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetCertificateChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetCertificateChains(ctx, req.(*GetCertificateChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -750,6 +1440,82 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResendReplicationTasks",
 			Handler:    _AdminService_ResendReplicationTasks_Handler,
 		},
+		{
+			MethodName: "GetIntakeOutcome",
+			Handler:    _AdminService_GetIntakeOutcome_Handler,
+		},
+		{
+			MethodName: "GetShardOwnershipReport",
+			Handler:    _AdminService_GetShardOwnershipReport_Handler,
+		},
+		{
+			MethodName: "StartShardRebalance",
+			Handler:    _AdminService_StartShardRebalance_Handler,
+		},
+		{
+			MethodName: "CancelShardRebalance",
+			Handler:    _AdminService_CancelShardRebalance_Handler,
+		},
+		{
+			MethodName: "RotateTLSCertificate",
+			Handler:    _AdminService_RotateTLSCertificate_Handler,
+		},
+		{
+			MethodName: "DescribeTLSRotation",
+			Handler:    _AdminService_DescribeTLSRotation_Handler,
+		},
+		{
+			MethodName: "AnnotateWorkflowExecution",
+			Handler:    _AdminService_AnnotateWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ApplyOperatorAction",
+			Handler:    _AdminService_ApplyOperatorAction_Handler,
+		},
+		{
+			MethodName: "GetReplicationEventChunk",
+			Handler:    _AdminService_GetReplicationEventChunk_Handler,
+		},
+		{
+			MethodName: "StartReplayCheck",
+			Handler:    _AdminService_StartReplayCheck_Handler,
+		},
+		{
+			MethodName: "DescribeReplayCheck",
+			Handler:    _AdminService_DescribeReplayCheck_Handler,
+		},
+		{
+			MethodName: "ListNamespaceRegistrations",
+			Handler:    _AdminService_ListNamespaceRegistrations_Handler,
+		},
+		{
+			MethodName: "ApproveNamespaceRegistration",
+			Handler:    _AdminService_ApproveNamespaceRegistration_Handler,
+		},
+		{
+			MethodName: "RejectNamespaceRegistration",
+			Handler:    _AdminService_RejectNamespaceRegistration_Handler,
+		},
+		{
+			MethodName: "ListHistoryBranches",
+			Handler:    _AdminService_ListHistoryBranches_Handler,
+		},
+		{
+			MethodName: "GetTaskQueueAutoscalingHint",
+			Handler:    _AdminService_GetTaskQueueAutoscalingHint_Handler,
+		},
+		{
+			MethodName: "RecordConsistencyMarker",
+			Handler:    _AdminService_RecordConsistencyMarker_Handler,
+		},
+		{
+			MethodName: "ListConsistencyMarkers",
+			Handler:    _AdminService_ListConsistencyMarkers_Handler,
+		},
+		{
+			MethodName: "GetCertificateChains",
+			Handler:    _AdminService_GetCertificateChains_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
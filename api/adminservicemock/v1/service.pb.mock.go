@@ -80,6 +80,86 @@ func (mr *MockAdminServiceClientMockRecorder) AddSearchAttribute(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttribute", reflect.TypeOf((*MockAdminServiceClient)(nil).AddSearchAttribute), varargs...)
 }
 
+// AnnotateWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) AnnotateWorkflowExecution(ctx context.Context, in *adminservice.AnnotateWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.AnnotateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AnnotateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.AnnotateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnotateWorkflowExecution indicates an expected call of AnnotateWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) AnnotateWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnotateWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).AnnotateWorkflowExecution), varargs...)
+}
+
+// ApplyOperatorAction mocks base method.
+func (m *MockAdminServiceClient) ApplyOperatorAction(ctx context.Context, in *adminservice.ApplyOperatorActionRequest, opts ...grpc.CallOption) (*adminservice.ApplyOperatorActionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApplyOperatorAction", varargs...)
+	ret0, _ := ret[0].(*adminservice.ApplyOperatorActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyOperatorAction indicates an expected call of ApplyOperatorAction.
+func (mr *MockAdminServiceClientMockRecorder) ApplyOperatorAction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyOperatorAction", reflect.TypeOf((*MockAdminServiceClient)(nil).ApplyOperatorAction), varargs...)
+}
+
+// ApproveNamespaceRegistration mocks base method.
+func (m *MockAdminServiceClient) ApproveNamespaceRegistration(ctx context.Context, in *adminservice.ApproveNamespaceRegistrationRequest, opts ...grpc.CallOption) (*adminservice.ApproveNamespaceRegistrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApproveNamespaceRegistration", varargs...)
+	ret0, _ := ret[0].(*adminservice.ApproveNamespaceRegistrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveNamespaceRegistration indicates an expected call of ApproveNamespaceRegistration.
+func (mr *MockAdminServiceClientMockRecorder) ApproveNamespaceRegistration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveNamespaceRegistration", reflect.TypeOf((*MockAdminServiceClient)(nil).ApproveNamespaceRegistration), varargs...)
+}
+
+// CancelShardRebalance mocks base method.
+func (m *MockAdminServiceClient) CancelShardRebalance(ctx context.Context, in *adminservice.CancelShardRebalanceRequest, opts ...grpc.CallOption) (*adminservice.CancelShardRebalanceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelShardRebalance", varargs...)
+	ret0, _ := ret[0].(*adminservice.CancelShardRebalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelShardRebalance indicates an expected call of CancelShardRebalance.
+func (mr *MockAdminServiceClientMockRecorder) CancelShardRebalance(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelShardRebalance", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelShardRebalance), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +240,66 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeReplayCheck mocks base method.
+func (m *MockAdminServiceClient) DescribeReplayCheck(ctx context.Context, in *adminservice.DescribeReplayCheckRequest, opts ...grpc.CallOption) (*adminservice.DescribeReplayCheckResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeReplayCheck", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeReplayCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplayCheck indicates an expected call of DescribeReplayCheck.
+func (mr *MockAdminServiceClientMockRecorder) DescribeReplayCheck(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplayCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeReplayCheck), varargs...)
+}
+
+// DescribeTLSRotation mocks base method.
+func (m *MockAdminServiceClient) DescribeTLSRotation(ctx context.Context, in *adminservice.DescribeTLSRotationRequest, opts ...grpc.CallOption) (*adminservice.DescribeTLSRotationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTLSRotation", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTLSRotationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTLSRotation indicates an expected call of DescribeTLSRotation.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTLSRotation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTLSRotation", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTLSRotation), varargs...)
+}
+
+// GetCertificateChains mocks base method.
+func (m *MockAdminServiceClient) GetCertificateChains(ctx context.Context, in *adminservice.GetCertificateChainsRequest, opts ...grpc.CallOption) (*adminservice.GetCertificateChainsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCertificateChains", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetCertificateChainsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificateChains indicates an expected call of GetCertificateChains.
+func (mr *MockAdminServiceClientMockRecorder) GetCertificateChains(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateChains", reflect.TypeOf((*MockAdminServiceClient)(nil).GetCertificateChains), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +340,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetDLQReplicationMessages(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQReplicationMessages), varargs...)
 }
 
+// GetIntakeOutcome mocks base method.
+func (m *MockAdminServiceClient) GetIntakeOutcome(ctx context.Context, in *adminservice.GetIntakeOutcomeRequest, opts ...grpc.CallOption) (*adminservice.GetIntakeOutcomeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIntakeOutcome", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetIntakeOutcomeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIntakeOutcome indicates an expected call of GetIntakeOutcome.
+func (mr *MockAdminServiceClientMockRecorder) GetIntakeOutcome(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIntakeOutcome", reflect.TypeOf((*MockAdminServiceClient)(nil).GetIntakeOutcome), varargs...)
+}
+
 // GetNamespaceReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetNamespaceReplicationMessages(ctx context.Context, in *adminservice.GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +380,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetNamespaceReplicationMessages(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceReplicationMessages), varargs...)
 }
 
+// GetReplicationEventChunk mocks base method.
+func (m *MockAdminServiceClient) GetReplicationEventChunk(ctx context.Context, in *adminservice.GetReplicationEventChunkRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationEventChunkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationEventChunk", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetReplicationEventChunkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationEventChunk indicates an expected call of GetReplicationEventChunk.
+func (mr *MockAdminServiceClientMockRecorder) GetReplicationEventChunk(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationEventChunk", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationEventChunk), varargs...)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetReplicationMessages(ctx context.Context, in *adminservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -240,6 +420,46 @@ func (mr *MockAdminServiceClientMockRecorder) GetReplicationMessages(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetShardOwnershipReport mocks base method.
+func (m *MockAdminServiceClient) GetShardOwnershipReport(ctx context.Context, in *adminservice.GetShardOwnershipReportRequest, opts ...grpc.CallOption) (*adminservice.GetShardOwnershipReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetShardOwnershipReport", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetShardOwnershipReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShardOwnershipReport indicates an expected call of GetShardOwnershipReport.
+func (mr *MockAdminServiceClientMockRecorder) GetShardOwnershipReport(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardOwnershipReport", reflect.TypeOf((*MockAdminServiceClient)(nil).GetShardOwnershipReport), varargs...)
+}
+
+// GetTaskQueueAutoscalingHint mocks base method.
+func (m *MockAdminServiceClient) GetTaskQueueAutoscalingHint(ctx context.Context, in *adminservice.GetTaskQueueAutoscalingHintRequest, opts ...grpc.CallOption) (*adminservice.GetTaskQueueAutoscalingHintResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskQueueAutoscalingHint", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetTaskQueueAutoscalingHintResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskQueueAutoscalingHint indicates an expected call of GetTaskQueueAutoscalingHint.
+func (mr *MockAdminServiceClientMockRecorder) GetTaskQueueAutoscalingHint(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueAutoscalingHint", reflect.TypeOf((*MockAdminServiceClient)(nil).GetTaskQueueAutoscalingHint), varargs...)
+}
+
 // GetWorkflowExecutionRawHistoryV2 mocks base method.
 func (m *MockAdminServiceClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, in *adminservice.GetWorkflowExecutionRawHistoryV2Request, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
 	m.ctrl.T.Helper()
@@ -260,6 +480,66 @@ func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionRawHistoryV2(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionRawHistoryV2), varargs...)
 }
 
+// ListConsistencyMarkers mocks base method.
+func (m *MockAdminServiceClient) ListConsistencyMarkers(ctx context.Context, in *adminservice.ListConsistencyMarkersRequest, opts ...grpc.CallOption) (*adminservice.ListConsistencyMarkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConsistencyMarkers", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListConsistencyMarkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsistencyMarkers indicates an expected call of ListConsistencyMarkers.
+func (mr *MockAdminServiceClientMockRecorder) ListConsistencyMarkers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsistencyMarkers", reflect.TypeOf((*MockAdminServiceClient)(nil).ListConsistencyMarkers), varargs...)
+}
+
+// ListHistoryBranches mocks base method.
+func (m *MockAdminServiceClient) ListHistoryBranches(ctx context.Context, in *adminservice.ListHistoryBranchesRequest, opts ...grpc.CallOption) (*adminservice.ListHistoryBranchesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHistoryBranches", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListHistoryBranchesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistoryBranches indicates an expected call of ListHistoryBranches.
+func (mr *MockAdminServiceClientMockRecorder) ListHistoryBranches(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryBranches", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryBranches), varargs...)
+}
+
+// ListNamespaceRegistrations mocks base method.
+func (m *MockAdminServiceClient) ListNamespaceRegistrations(ctx context.Context, in *adminservice.ListNamespaceRegistrationsRequest, opts ...grpc.CallOption) (*adminservice.ListNamespaceRegistrationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNamespaceRegistrations", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListNamespaceRegistrationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaceRegistrations indicates an expected call of ListNamespaceRegistrations.
+func (mr *MockAdminServiceClientMockRecorder) ListNamespaceRegistrations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaceRegistrations", reflect.TypeOf((*MockAdminServiceClient)(nil).ListNamespaceRegistrations), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -320,6 +600,26 @@ func (mr *MockAdminServiceClientMockRecorder) ReapplyEvents(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceClient)(nil).ReapplyEvents), varargs...)
 }
 
+// RecordConsistencyMarker mocks base method.
+func (m *MockAdminServiceClient) RecordConsistencyMarker(ctx context.Context, in *adminservice.RecordConsistencyMarkerRequest, opts ...grpc.CallOption) (*adminservice.RecordConsistencyMarkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordConsistencyMarker", varargs...)
+	ret0, _ := ret[0].(*adminservice.RecordConsistencyMarkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordConsistencyMarker indicates an expected call of RecordConsistencyMarker.
+func (mr *MockAdminServiceClientMockRecorder) RecordConsistencyMarker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordConsistencyMarker", reflect.TypeOf((*MockAdminServiceClient)(nil).RecordConsistencyMarker), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +640,26 @@ func (mr *MockAdminServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// RejectNamespaceRegistration mocks base method.
+func (m *MockAdminServiceClient) RejectNamespaceRegistration(ctx context.Context, in *adminservice.RejectNamespaceRegistrationRequest, opts ...grpc.CallOption) (*adminservice.RejectNamespaceRegistrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RejectNamespaceRegistration", varargs...)
+	ret0, _ := ret[0].(*adminservice.RejectNamespaceRegistrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectNamespaceRegistration indicates an expected call of RejectNamespaceRegistration.
+func (mr *MockAdminServiceClientMockRecorder) RejectNamespaceRegistration(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectNamespaceRegistration", reflect.TypeOf((*MockAdminServiceClient)(nil).RejectNamespaceRegistration), varargs...)
+}
+
 // RemoveTask mocks base method.
 func (m *MockAdminServiceClient) RemoveTask(ctx context.Context, in *adminservice.RemoveTaskRequest, opts ...grpc.CallOption) (*adminservice.RemoveTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -380,6 +700,66 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// RotateTLSCertificate mocks base method.
+func (m *MockAdminServiceClient) RotateTLSCertificate(ctx context.Context, in *adminservice.RotateTLSCertificateRequest, opts ...grpc.CallOption) (*adminservice.RotateTLSCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateTLSCertificate", varargs...)
+	ret0, _ := ret[0].(*adminservice.RotateTLSCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateTLSCertificate indicates an expected call of RotateTLSCertificate.
+func (mr *MockAdminServiceClientMockRecorder) RotateTLSCertificate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateTLSCertificate", reflect.TypeOf((*MockAdminServiceClient)(nil).RotateTLSCertificate), varargs...)
+}
+
+// StartReplayCheck mocks base method.
+func (m *MockAdminServiceClient) StartReplayCheck(ctx context.Context, in *adminservice.StartReplayCheckRequest, opts ...grpc.CallOption) (*adminservice.StartReplayCheckResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartReplayCheck", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartReplayCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartReplayCheck indicates an expected call of StartReplayCheck.
+func (mr *MockAdminServiceClientMockRecorder) StartReplayCheck(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReplayCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).StartReplayCheck), varargs...)
+}
+
+// StartShardRebalance mocks base method.
+func (m *MockAdminServiceClient) StartShardRebalance(ctx context.Context, in *adminservice.StartShardRebalanceRequest, opts ...grpc.CallOption) (*adminservice.StartShardRebalanceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartShardRebalance", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartShardRebalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartShardRebalance indicates an expected call of StartShardRebalance.
+func (mr *MockAdminServiceClientMockRecorder) StartShardRebalance(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartShardRebalance", reflect.TypeOf((*MockAdminServiceClient)(nil).StartShardRebalance), varargs...)
+}
+
 // MockAdminServiceServer is a mock of AdminServiceServer interface.
 type MockAdminServiceServer struct {
 	ctrl     *gomock.Controller
@@ -418,6 +798,66 @@ func (mr *MockAdminServiceServerMockRecorder) AddSearchAttribute(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttribute", reflect.TypeOf((*MockAdminServiceServer)(nil).AddSearchAttribute), arg0, arg1)
 }
 
+// AnnotateWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) AnnotateWorkflowExecution(arg0 context.Context, arg1 *adminservice.AnnotateWorkflowExecutionRequest) (*adminservice.AnnotateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnotateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AnnotateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnotateWorkflowExecution indicates an expected call of AnnotateWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) AnnotateWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnotateWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).AnnotateWorkflowExecution), arg0, arg1)
+}
+
+// ApplyOperatorAction mocks base method.
+func (m *MockAdminServiceServer) ApplyOperatorAction(arg0 context.Context, arg1 *adminservice.ApplyOperatorActionRequest) (*adminservice.ApplyOperatorActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyOperatorAction", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ApplyOperatorActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyOperatorAction indicates an expected call of ApplyOperatorAction.
+func (mr *MockAdminServiceServerMockRecorder) ApplyOperatorAction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyOperatorAction", reflect.TypeOf((*MockAdminServiceServer)(nil).ApplyOperatorAction), arg0, arg1)
+}
+
+// ApproveNamespaceRegistration mocks base method.
+func (m *MockAdminServiceServer) ApproveNamespaceRegistration(arg0 context.Context, arg1 *adminservice.ApproveNamespaceRegistrationRequest) (*adminservice.ApproveNamespaceRegistrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveNamespaceRegistration", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ApproveNamespaceRegistrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveNamespaceRegistration indicates an expected call of ApproveNamespaceRegistration.
+func (mr *MockAdminServiceServerMockRecorder) ApproveNamespaceRegistration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveNamespaceRegistration", reflect.TypeOf((*MockAdminServiceServer)(nil).ApproveNamespaceRegistration), arg0, arg1)
+}
+
+// CancelShardRebalance mocks base method.
+func (m *MockAdminServiceServer) CancelShardRebalance(arg0 context.Context, arg1 *adminservice.CancelShardRebalanceRequest) (*adminservice.CancelShardRebalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelShardRebalance", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CancelShardRebalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelShardRebalance indicates an expected call of CancelShardRebalance.
+func (mr *MockAdminServiceServerMockRecorder) CancelShardRebalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelShardRebalance", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelShardRebalance), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -478,6 +918,51 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeReplayCheck mocks base method.
+func (m *MockAdminServiceServer) DescribeReplayCheck(arg0 context.Context, arg1 *adminservice.DescribeReplayCheckRequest) (*adminservice.DescribeReplayCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplayCheck", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeReplayCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplayCheck indicates an expected call of DescribeReplayCheck.
+func (mr *MockAdminServiceServerMockRecorder) DescribeReplayCheck(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplayCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeReplayCheck), arg0, arg1)
+}
+
+// DescribeTLSRotation mocks base method.
+func (m *MockAdminServiceServer) DescribeTLSRotation(arg0 context.Context, arg1 *adminservice.DescribeTLSRotationRequest) (*adminservice.DescribeTLSRotationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTLSRotation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTLSRotationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTLSRotation indicates an expected call of DescribeTLSRotation.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTLSRotation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTLSRotation", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTLSRotation), arg0, arg1)
+}
+
+// GetCertificateChains mocks base method.
+func (m *MockAdminServiceServer) GetCertificateChains(arg0 context.Context, arg1 *adminservice.GetCertificateChainsRequest) (*adminservice.GetCertificateChainsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateChains", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetCertificateChainsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificateChains indicates an expected call of GetCertificateChains.
+func (mr *MockAdminServiceServerMockRecorder) GetCertificateChains(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateChains", reflect.TypeOf((*MockAdminServiceServer)(nil).GetCertificateChains), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -508,6 +993,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetDLQReplicationMessages(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQReplicationMessages), arg0, arg1)
 }
 
+// GetIntakeOutcome mocks base method.
+func (m *MockAdminServiceServer) GetIntakeOutcome(arg0 context.Context, arg1 *adminservice.GetIntakeOutcomeRequest) (*adminservice.GetIntakeOutcomeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIntakeOutcome", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetIntakeOutcomeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIntakeOutcome indicates an expected call of GetIntakeOutcome.
+func (mr *MockAdminServiceServerMockRecorder) GetIntakeOutcome(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIntakeOutcome", reflect.TypeOf((*MockAdminServiceServer)(nil).GetIntakeOutcome), arg0, arg1)
+}
+
 // GetNamespaceReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) GetNamespaceReplicationMessages(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationMessagesRequest) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -523,6 +1023,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetNamespaceReplicationMessages(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceReplicationMessages), arg0, arg1)
 }
 
+// GetReplicationEventChunk mocks base method.
+func (m *MockAdminServiceServer) GetReplicationEventChunk(arg0 context.Context, arg1 *adminservice.GetReplicationEventChunkRequest) (*adminservice.GetReplicationEventChunkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationEventChunk", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetReplicationEventChunkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationEventChunk indicates an expected call of GetReplicationEventChunk.
+func (mr *MockAdminServiceServerMockRecorder) GetReplicationEventChunk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationEventChunk", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationEventChunk), arg0, arg1)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *adminservice.GetReplicationMessagesRequest) (*adminservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -538,6 +1053,36 @@ func (mr *MockAdminServiceServerMockRecorder) GetReplicationMessages(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// GetShardOwnershipReport mocks base method.
+func (m *MockAdminServiceServer) GetShardOwnershipReport(arg0 context.Context, arg1 *adminservice.GetShardOwnershipReportRequest) (*adminservice.GetShardOwnershipReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardOwnershipReport", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetShardOwnershipReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShardOwnershipReport indicates an expected call of GetShardOwnershipReport.
+func (mr *MockAdminServiceServerMockRecorder) GetShardOwnershipReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardOwnershipReport", reflect.TypeOf((*MockAdminServiceServer)(nil).GetShardOwnershipReport), arg0, arg1)
+}
+
+// GetTaskQueueAutoscalingHint mocks base method.
+func (m *MockAdminServiceServer) GetTaskQueueAutoscalingHint(arg0 context.Context, arg1 *adminservice.GetTaskQueueAutoscalingHintRequest) (*adminservice.GetTaskQueueAutoscalingHintResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskQueueAutoscalingHint", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetTaskQueueAutoscalingHintResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskQueueAutoscalingHint indicates an expected call of GetTaskQueueAutoscalingHint.
+func (mr *MockAdminServiceServerMockRecorder) GetTaskQueueAutoscalingHint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueAutoscalingHint", reflect.TypeOf((*MockAdminServiceServer)(nil).GetTaskQueueAutoscalingHint), arg0, arg1)
+}
+
 // GetWorkflowExecutionRawHistoryV2 mocks base method.
 func (m *MockAdminServiceServer) GetWorkflowExecutionRawHistoryV2(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionRawHistoryV2Request) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
 	m.ctrl.T.Helper()
@@ -553,6 +1098,51 @@ func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionRawHistoryV2(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionRawHistoryV2", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionRawHistoryV2), arg0, arg1)
 }
 
+// ListConsistencyMarkers mocks base method.
+func (m *MockAdminServiceServer) ListConsistencyMarkers(arg0 context.Context, arg1 *adminservice.ListConsistencyMarkersRequest) (*adminservice.ListConsistencyMarkersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConsistencyMarkers", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListConsistencyMarkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsistencyMarkers indicates an expected call of ListConsistencyMarkers.
+func (mr *MockAdminServiceServerMockRecorder) ListConsistencyMarkers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsistencyMarkers", reflect.TypeOf((*MockAdminServiceServer)(nil).ListConsistencyMarkers), arg0, arg1)
+}
+
+// ListHistoryBranches mocks base method.
+func (m *MockAdminServiceServer) ListHistoryBranches(arg0 context.Context, arg1 *adminservice.ListHistoryBranchesRequest) (*adminservice.ListHistoryBranchesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHistoryBranches", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListHistoryBranchesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistoryBranches indicates an expected call of ListHistoryBranches.
+func (mr *MockAdminServiceServerMockRecorder) ListHistoryBranches(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryBranches", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryBranches), arg0, arg1)
+}
+
+// ListNamespaceRegistrations mocks base method.
+func (m *MockAdminServiceServer) ListNamespaceRegistrations(arg0 context.Context, arg1 *adminservice.ListNamespaceRegistrationsRequest) (*adminservice.ListNamespaceRegistrationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNamespaceRegistrations", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListNamespaceRegistrationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaceRegistrations indicates an expected call of ListNamespaceRegistrations.
+func (mr *MockAdminServiceServerMockRecorder) ListNamespaceRegistrations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaceRegistrations", reflect.TypeOf((*MockAdminServiceServer)(nil).ListNamespaceRegistrations), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -598,6 +1188,21 @@ func (mr *MockAdminServiceServerMockRecorder) ReapplyEvents(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminServiceServer)(nil).ReapplyEvents), arg0, arg1)
 }
 
+// RecordConsistencyMarker mocks base method.
+func (m *MockAdminServiceServer) RecordConsistencyMarker(arg0 context.Context, arg1 *adminservice.RecordConsistencyMarkerRequest) (*adminservice.RecordConsistencyMarkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordConsistencyMarker", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RecordConsistencyMarkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordConsistencyMarker indicates an expected call of RecordConsistencyMarker.
+func (mr *MockAdminServiceServerMockRecorder) RecordConsistencyMarker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordConsistencyMarker", reflect.TypeOf((*MockAdminServiceServer)(nil).RecordConsistencyMarker), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *adminservice.RefreshWorkflowTasksRequest) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -613,6 +1218,21 @@ func (mr *MockAdminServiceServerMockRecorder) RefreshWorkflowTasks(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
+// RejectNamespaceRegistration mocks base method.
+func (m *MockAdminServiceServer) RejectNamespaceRegistration(arg0 context.Context, arg1 *adminservice.RejectNamespaceRegistrationRequest) (*adminservice.RejectNamespaceRegistrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectNamespaceRegistration", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RejectNamespaceRegistrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectNamespaceRegistration indicates an expected call of RejectNamespaceRegistration.
+func (mr *MockAdminServiceServerMockRecorder) RejectNamespaceRegistration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectNamespaceRegistration", reflect.TypeOf((*MockAdminServiceServer)(nil).RejectNamespaceRegistration), arg0, arg1)
+}
+
 // RemoveTask mocks base method.
 func (m *MockAdminServiceServer) RemoveTask(arg0 context.Context, arg1 *adminservice.RemoveTaskRequest) (*adminservice.RemoveTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -642,3 +1262,48 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
+
+// RotateTLSCertificate mocks base method.
+func (m *MockAdminServiceServer) RotateTLSCertificate(arg0 context.Context, arg1 *adminservice.RotateTLSCertificateRequest) (*adminservice.RotateTLSCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateTLSCertificate", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RotateTLSCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateTLSCertificate indicates an expected call of RotateTLSCertificate.
+func (mr *MockAdminServiceServerMockRecorder) RotateTLSCertificate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateTLSCertificate", reflect.TypeOf((*MockAdminServiceServer)(nil).RotateTLSCertificate), arg0, arg1)
+}
+
+// StartReplayCheck mocks base method.
+func (m *MockAdminServiceServer) StartReplayCheck(arg0 context.Context, arg1 *adminservice.StartReplayCheckRequest) (*adminservice.StartReplayCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartReplayCheck", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartReplayCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartReplayCheck indicates an expected call of StartReplayCheck.
+func (mr *MockAdminServiceServerMockRecorder) StartReplayCheck(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReplayCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).StartReplayCheck), arg0, arg1)
+}
+
+// StartShardRebalance mocks base method.
+func (m *MockAdminServiceServer) StartShardRebalance(arg0 context.Context, arg1 *adminservice.StartShardRebalanceRequest) (*adminservice.StartShardRebalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartShardRebalance", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartShardRebalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartShardRebalance indicates an expected call of StartShardRebalance.
+func (mr *MockAdminServiceServerMockRecorder) StartShardRebalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartShardRebalance", reflect.TypeOf((*MockAdminServiceServer)(nil).StartShardRebalance), arg0, arg1)
+}
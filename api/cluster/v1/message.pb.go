@@ -28,6 +28,7 @@
 package cluster
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -36,6 +37,7 @@ import (
 	strings "strings"
 
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -210,10 +212,293 @@ func (m *MembershipInfo) GetRings() []*RingInfo {
 	return nil
 }
 
+type HostShardReport struct {
+	Address           string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Shards            int32   `protobuf:"varint,2,opt,name=shards,proto3" json:"shards,omitempty"`
+	RequestsPerSecond float64 `protobuf:"fixed64,3,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	Error             string  `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *HostShardReport) Reset()      { *m = HostShardReport{} }
+func (*HostShardReport) ProtoMessage() {}
+func (*HostShardReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcc65697c8eece3a, []int{3}
+}
+func (m *HostShardReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostShardReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostShardReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostShardReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostShardReport.Merge(m, src)
+}
+func (m *HostShardReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostShardReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostShardReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostShardReport proto.InternalMessageInfo
+
+func (m *HostShardReport) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *HostShardReport) GetShards() int32 {
+	if m != nil {
+		return m.Shards
+	}
+	return 0
+}
+
+func (m *HostShardReport) GetRequestsPerSecond() float64 {
+	if m != nil {
+		return m.RequestsPerSecond
+	}
+	return 0
+}
+
+func (m *HostShardReport) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// StoreInfo describes a configured persistence or visibility store.
+type StoreInfo struct {
+	// Name of the datastore in persistence config.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Store type, i.e. cassandra, SQL plugin name, custom visibility store name or elasticsearch.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Schema version the server expects, or the configured elasticsearch version.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *StoreInfo) Reset()      { *m = StoreInfo{} }
+func (*StoreInfo) ProtoMessage() {}
+func (*StoreInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcc65697c8eece3a, []int{4}
+}
+func (m *StoreInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreInfo.Merge(m, src)
+}
+func (m *StoreInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreInfo proto.InternalMessageInfo
+
+func (m *StoreInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StoreInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StoreInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// ClusterEnvironment describes stores configured for the cluster and server versions of its members.
+type ClusterEnvironment struct {
+	PersistenceStore        *StoreInfo `protobuf:"bytes,1,opt,name=persistence_store,json=persistenceStore,proto3" json:"persistence_store,omitempty"`
+	VisibilityStore         *StoreInfo `protobuf:"bytes,2,opt,name=visibility_store,json=visibilityStore,proto3" json:"visibility_store,omitempty"`
+	AdvancedVisibilityStore *StoreInfo `protobuf:"bytes,3,opt,name=advanced_visibility_store,json=advancedVisibilityStore,proto3" json:"advanced_visibility_store,omitempty"`
+	HistoryShardCount       int32      `protobuf:"varint,4,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
+	// Min and max server versions of reachable members, they differ while the cluster is being upgraded.
+	MinServerVersion string `protobuf:"bytes,5,opt,name=min_server_version,json=minServerVersion,proto3" json:"min_server_version,omitempty"`
+	MaxServerVersion string `protobuf:"bytes,6,opt,name=max_server_version,json=maxServerVersion,proto3" json:"max_server_version,omitempty"`
+	// Number of reachable members by server version.
+	ServerVersions map[string]int32 `protobuf:"bytes,7,rep,name=server_versions,json=serverVersions,proto3" json:"server_versions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *ClusterEnvironment) Reset()      { *m = ClusterEnvironment{} }
+func (*ClusterEnvironment) ProtoMessage() {}
+func (*ClusterEnvironment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcc65697c8eece3a, []int{5}
+}
+func (m *ClusterEnvironment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterEnvironment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterEnvironment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterEnvironment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterEnvironment.Merge(m, src)
+}
+func (m *ClusterEnvironment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterEnvironment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterEnvironment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterEnvironment proto.InternalMessageInfo
+
+func (m *ClusterEnvironment) GetPersistenceStore() *StoreInfo {
+	if m != nil {
+		return m.PersistenceStore
+	}
+	return nil
+}
+
+func (m *ClusterEnvironment) GetVisibilityStore() *StoreInfo {
+	if m != nil {
+		return m.VisibilityStore
+	}
+	return nil
+}
+
+func (m *ClusterEnvironment) GetAdvancedVisibilityStore() *StoreInfo {
+	if m != nil {
+		return m.AdvancedVisibilityStore
+	}
+	return nil
+}
+
+func (m *ClusterEnvironment) GetHistoryShardCount() int32 {
+	if m != nil {
+		return m.HistoryShardCount
+	}
+	return 0
+}
+
+func (m *ClusterEnvironment) GetMinServerVersion() string {
+	if m != nil {
+		return m.MinServerVersion
+	}
+	return ""
+}
+
+func (m *ClusterEnvironment) GetMaxServerVersion() string {
+	if m != nil {
+		return m.MaxServerVersion
+	}
+	return ""
+}
+
+func (m *ClusterEnvironment) GetServerVersions() map[string]int32 {
+	if m != nil {
+		return m.ServerVersions
+	}
+	return nil
+}
+
+// ServerCapabilities describes protocol features supported by the server.
+type ServerCapabilities struct {
+	// Payload encodings accepted in failure details, empty accepts all encodings.
+	FailureDetailEncodings []string `protobuf:"bytes,1,rep,name=failure_detail_encodings,json=failureDetailEncodings,proto3" json:"failure_detail_encodings,omitempty"`
+	// Size limit of payloads above which requests are rejected.
+	MaxPayloadSize int32 `protobuf:"varint,2,opt,name=max_payload_size,json=maxPayloadSize,proto3" json:"max_payload_size,omitempty"`
+}
+
+func (m *ServerCapabilities) Reset()      { *m = ServerCapabilities{} }
+func (*ServerCapabilities) ProtoMessage() {}
+func (*ServerCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcc65697c8eece3a, []int{6}
+}
+func (m *ServerCapabilities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServerCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServerCapabilities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServerCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerCapabilities.Merge(m, src)
+}
+func (m *ServerCapabilities) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServerCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerCapabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServerCapabilities proto.InternalMessageInfo
+
+func (m *ServerCapabilities) GetFailureDetailEncodings() []string {
+	if m != nil {
+		return m.FailureDetailEncodings
+	}
+	return nil
+}
+
+func (m *ServerCapabilities) GetMaxPayloadSize() int32 {
+	if m != nil {
+		return m.MaxPayloadSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*HostInfo)(nil), "temporal.server.api.cluster.v1.HostInfo")
 	proto.RegisterType((*RingInfo)(nil), "temporal.server.api.cluster.v1.RingInfo")
 	proto.RegisterType((*MembershipInfo)(nil), "temporal.server.api.cluster.v1.MembershipInfo")
+	proto.RegisterType((*HostShardReport)(nil), "temporal.server.api.cluster.v1.HostShardReport")
+	proto.RegisterType((*StoreInfo)(nil), "temporal.server.api.cluster.v1.StoreInfo")
+	proto.RegisterType((*ClusterEnvironment)(nil), "temporal.server.api.cluster.v1.ClusterEnvironment")
+	proto.RegisterMapType((map[string]int32)(nil), "temporal.server.api.cluster.v1.ClusterEnvironment.ServerVersionsEntry")
+	proto.RegisterType((*ServerCapabilities)(nil), "temporal.server.api.cluster.v1.ServerCapabilities")
 }
 
 func init() {
@@ -221,29 +506,53 @@ func init() {
 }
 
 var fileDescriptor_fcc65697c8eece3a = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0xf6, 0xeb, 0x47, 0xeb, 0x56, 0x08, 0x3c, 0x55, 0x0c, 0x57, 0xa1, 0x03, 0x8a,
-	0x44, 0xe5, 0xa8, 0x30, 0x22, 0x31, 0x94, 0x05, 0x84, 0x58, 0x32, 0xb2, 0x54, 0x49, 0x30, 0xa9,
-	0xa5, 0x24, 0x8e, 0x6c, 0xb7, 0x12, 0x1b, 0x0b, 0x3b, 0x8f, 0xc1, 0xa3, 0x20, 0xa6, 0x8e, 0x1d,
-	0xa9, 0xb3, 0x30, 0xf6, 0x11, 0x50, 0xf3, 0xa7, 0x2c, 0x08, 0xc1, 0x76, 0xee, 0xc9, 0x39, 0xf1,
-	0xcf, 0xd6, 0xc5, 0x43, 0xcd, 0x92, 0x4c, 0x48, 0x3f, 0x76, 0x15, 0x93, 0x73, 0x26, 0x5d, 0x3f,
-	0xe3, 0x6e, 0x18, 0xcf, 0x94, 0x66, 0xd2, 0x9d, 0x8f, 0xdc, 0x84, 0x29, 0xe5, 0x47, 0x8c, 0x66,
-	0x52, 0x68, 0x41, 0xa0, 0x4e, 0xd3, 0x32, 0x4d, 0xfd, 0x8c, 0xd3, 0x2a, 0x4d, 0xe7, 0xa3, 0xc1,
-	0x11, 0x6e, 0x5f, 0x0a, 0xa5, 0xaf, 0xd2, 0x7b, 0x41, 0x0e, 0x70, 0x9b, 0xdf, 0xb1, 0x54, 0x73,
-	0xfd, 0xd0, 0x47, 0x36, 0x72, 0x3a, 0xde, 0x76, 0x1e, 0x3c, 0x21, 0xdc, 0xf6, 0x78, 0x1a, 0x15,
-	0x41, 0x82, 0xff, 0x49, 0x11, 0xb3, 0x2a, 0x54, 0x68, 0x72, 0x88, 0x7b, 0x09, 0x4b, 0x02, 0x26,
-	0x27, 0xa1, 0x98, 0xa5, 0xba, 0xdf, 0xb0, 0x91, 0xd3, 0xf2, 0xba, 0xa5, 0x77, 0xb1, 0xb1, 0xc8,
-	0x18, 0xef, 0x94, 0xa3, 0xea, 0x37, 0xed, 0xa6, 0xd3, 0x3d, 0x71, 0xe8, 0xcf, 0x74, 0xb4, 0x46,
-	0xf3, 0xea, 0xe2, 0xe0, 0x0d, 0xe1, 0xdd, 0x9b, 0x52, 0x4f, 0x79, 0x56, 0xd0, 0x5c, 0xe3, 0x5e,
-	0x38, 0x93, 0x92, 0xa5, 0x7a, 0x32, 0x15, 0x4a, 0x17, 0x54, 0x7f, 0xf9, 0x77, 0xb7, 0x6a, 0x6f,
-	0x0c, 0x72, 0x8c, 0xf7, 0x25, 0xf3, 0xc3, 0xa9, 0x1f, 0xc4, 0x6c, 0x52, 0xd3, 0x36, 0xec, 0xa6,
-	0xd3, 0xf1, 0xf6, 0xb6, 0x1f, 0x2a, 0x00, 0x72, 0x8e, 0x5b, 0x92, 0xa7, 0xd1, 0xaf, 0xaf, 0x53,
-	0x3f, 0xa0, 0x57, 0xd6, 0xc6, 0xc1, 0x62, 0x05, 0xd6, 0x72, 0x05, 0xd6, 0x7a, 0x05, 0xe8, 0xd1,
-	0x00, 0x7a, 0x31, 0x80, 0x5e, 0x0d, 0xa0, 0x85, 0x01, 0xf4, 0x6e, 0x00, 0x7d, 0x18, 0xb0, 0xd6,
-	0x06, 0xd0, 0x73, 0x0e, 0xd6, 0x22, 0x07, 0x6b, 0x99, 0x83, 0x75, 0x3b, 0x8c, 0xc4, 0xd7, 0x41,
-	0x5c, 0x7c, 0xbf, 0x06, 0x67, 0x95, 0x0c, 0xfe, 0x17, 0x7b, 0x70, 0xfa, 0x19, 0x00, 0x00, 0xff,
-	0xff, 0x4e, 0xa0, 0x30, 0xe6, 0x37, 0x02, 0x00, 0x00,
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3f, 0x4f, 0x1b, 0x49,
+	0x14, 0xf7, 0xf8, 0x0f, 0xe0, 0x01, 0x81, 0x3d, 0x9c, 0xb8, 0x3d, 0x8a, 0x95, 0xcf, 0xc5, 0xc9,
+	0xa7, 0x43, 0x6b, 0x71, 0x69, 0x50, 0x22, 0x45, 0x0a, 0x84, 0x28, 0x51, 0x84, 0x84, 0xd6, 0x11,
+	0x45, 0x9a, 0xd5, 0x78, 0xf7, 0x61, 0x8f, 0xb2, 0x3b, 0xb3, 0x99, 0x19, 0xaf, 0x6c, 0xaa, 0x34,
+	0x29, 0xd2, 0xe5, 0x63, 0xe4, 0xa3, 0x44, 0xa9, 0x28, 0x29, 0x83, 0x69, 0x92, 0x8e, 0x8f, 0x10,
+	0xed, 0xec, 0xae, 0x89, 0x01, 0x85, 0xd0, 0xbd, 0x3f, 0xbf, 0xf9, 0xbd, 0x37, 0xef, 0x37, 0x6f,
+	0xf0, 0x96, 0x86, 0x28, 0x16, 0x92, 0x86, 0x5d, 0x05, 0x32, 0x01, 0xd9, 0xa5, 0x31, 0xeb, 0xfa,
+	0xe1, 0x48, 0x69, 0x90, 0xdd, 0x64, 0xbb, 0x1b, 0x81, 0x52, 0x74, 0x00, 0x4e, 0x2c, 0x85, 0x16,
+	0xc4, 0x2e, 0xd0, 0x4e, 0x86, 0x76, 0x68, 0xcc, 0x9c, 0x1c, 0xed, 0x24, 0xdb, 0xed, 0x7f, 0xf0,
+	0xd2, 0x73, 0xa1, 0xf4, 0x0b, 0x7e, 0x2c, 0xc8, 0x26, 0x5e, 0x62, 0x01, 0x70, 0xcd, 0xf4, 0xc4,
+	0x42, 0x2d, 0xd4, 0xa9, 0xbb, 0x33, 0xbf, 0xfd, 0x1e, 0xe1, 0x25, 0x97, 0xf1, 0x81, 0x01, 0x12,
+	0x5c, 0x95, 0x22, 0x84, 0x1c, 0x64, 0x6c, 0xf2, 0x37, 0x5e, 0x89, 0x20, 0xea, 0x83, 0xf4, 0x7c,
+	0x31, 0xe2, 0xda, 0x2a, 0xb7, 0x50, 0xa7, 0xe6, 0x2e, 0x67, 0xb1, 0xbd, 0x34, 0x44, 0x76, 0xf1,
+	0x62, 0xe6, 0x2a, 0xab, 0xd2, 0xaa, 0x74, 0x96, 0xff, 0xef, 0x38, 0xbf, 0xee, 0xce, 0x29, 0x5a,
+	0x73, 0x8b, 0x83, 0xed, 0x2f, 0x08, 0xaf, 0x1e, 0x64, 0xf6, 0x90, 0xc5, 0xa6, 0x9b, 0x97, 0x78,
+	0xc5, 0x1f, 0x49, 0x09, 0x5c, 0x7b, 0x43, 0xa1, 0xb4, 0xe9, 0xea, 0x3e, 0xdc, 0xcb, 0xf9, 0xe9,
+	0x34, 0x40, 0xfe, 0xc3, 0x4d, 0x09, 0xd4, 0x1f, 0xd2, 0x7e, 0x08, 0x5e, 0xd1, 0x6d, 0xb9, 0x55,
+	0xe9, 0xd4, 0xdd, 0xc6, 0x2c, 0x91, 0x37, 0x40, 0x1e, 0xe3, 0x9a, 0x64, 0x7c, 0xf0, 0xdb, 0xd7,
+	0x29, 0x06, 0xe8, 0x66, 0xc7, 0xda, 0x1f, 0x10, 0x5e, 0x4b, 0xab, 0xf6, 0x86, 0x54, 0x06, 0x2e,
+	0xc4, 0x42, 0x6a, 0x62, 0xe1, 0x45, 0x1a, 0x04, 0x12, 0x94, 0xca, 0xc7, 0x5b, 0xb8, 0x64, 0x03,
+	0x2f, 0xa8, 0x14, 0xa8, 0xf2, 0xd9, 0xe6, 0x1e, 0x71, 0xf0, 0xba, 0x84, 0xb7, 0x23, 0x50, 0x5a,
+	0x79, 0x31, 0x48, 0x4f, 0x81, 0x2f, 0x78, 0x60, 0x55, 0x5a, 0xa8, 0x83, 0xdc, 0x66, 0x91, 0x3a,
+	0x04, 0xd9, 0x33, 0x09, 0xf2, 0x07, 0xae, 0x81, 0x94, 0x42, 0x5a, 0x55, 0xc3, 0x9f, 0x39, 0xed,
+	0x03, 0x5c, 0xef, 0x69, 0x21, 0xa1, 0x10, 0x98, 0xd3, 0x68, 0x26, 0x70, 0x6a, 0xa7, 0x31, 0x3d,
+	0x89, 0xc1, 0x14, 0xaf, 0xbb, 0xc6, 0x4e, 0x9b, 0x4d, 0x40, 0x2a, 0x26, 0xb8, 0x29, 0x57, 0x77,
+	0x0b, 0xb7, 0xfd, 0xbd, 0x8a, 0xc9, 0x5e, 0x76, 0xf3, 0x7d, 0x9e, 0x30, 0x29, 0x78, 0x04, 0x5c,
+	0x93, 0x23, 0xdc, 0x8c, 0x53, 0x84, 0xd2, 0xc0, 0x7d, 0xf0, 0x54, 0x5a, 0x31, 0x17, 0xec, 0xdf,
+	0xbb, 0xa6, 0x37, 0x6b, 0xcf, 0x6d, 0xfc, 0xc4, 0x61, 0xa2, 0xe4, 0x15, 0x6e, 0x24, 0x4c, 0xb1,
+	0x3e, 0x0b, 0x99, 0x9e, 0xe4, 0xb4, 0xe5, 0xfb, 0xd2, 0xae, 0x5d, 0x51, 0x64, 0xac, 0x80, 0xff,
+	0xa2, 0x41, 0x42, 0xb9, 0x0f, 0x81, 0x77, 0x83, 0xbe, 0x72, 0x5f, 0xfa, 0x3f, 0x0b, 0xae, 0xa3,
+	0x6b, 0x65, 0x1c, 0xbc, 0x3e, 0x64, 0x29, 0xe9, 0xc4, 0x33, 0x92, 0xe6, 0x1b, 0x54, 0x35, 0x2a,
+	0x37, 0xf3, 0x94, 0x79, 0x23, 0xd9, 0x1e, 0x6d, 0x61, 0x12, 0x31, 0xee, 0x65, 0xf5, 0xbc, 0x42,
+	0x80, 0x9a, 0x11, 0xa0, 0x11, 0x31, 0xde, 0x33, 0x89, 0xa3, 0x2c, 0x6e, 0xd0, 0x74, 0x7c, 0x1d,
+	0xbd, 0x90, 0xa3, 0xe9, 0x78, 0x1e, 0x2d, 0xf0, 0xda, 0x3c, 0x52, 0x59, 0x8b, 0xe6, 0x71, 0x3f,
+	0xbb, 0xeb, 0xa2, 0x37, 0xd5, 0x76, 0xe6, 0xa8, 0xd5, 0x3e, 0xd7, 0x72, 0xe2, 0xae, 0xaa, 0xb9,
+	0xe0, 0xe6, 0x13, 0xbc, 0x7e, 0x0b, 0x8c, 0x34, 0x70, 0xe5, 0x0d, 0x14, 0xdf, 0x50, 0x6a, 0xa6,
+	0xcf, 0x36, 0xa1, 0xe1, 0x08, 0xf2, 0xd7, 0x9f, 0x39, 0x0f, 0xcb, 0x3b, 0xa8, 0x3d, 0xc6, 0x24,
+	0xa3, 0xd8, 0xa3, 0x31, 0x35, 0x83, 0x65, 0xa0, 0xc8, 0x0e, 0xb6, 0x8e, 0x29, 0x0b, 0x47, 0x12,
+	0xbc, 0x00, 0x34, 0x65, 0xa1, 0x07, 0xdc, 0x17, 0x81, 0xd9, 0x57, 0x64, 0x16, 0x7a, 0x23, 0xcf,
+	0x3f, 0x35, 0xe9, 0xfd, 0x22, 0x4b, 0x3a, 0x38, 0x9d, 0x8b, 0x17, 0xd3, 0x49, 0x28, 0x68, 0xe0,
+	0x29, 0x76, 0x52, 0x14, 0x5d, 0x8d, 0xe8, 0xf8, 0x30, 0x0b, 0xf7, 0xd8, 0x09, 0xec, 0xf6, 0x4f,
+	0xcf, 0xed, 0xd2, 0xd9, 0xb9, 0x5d, 0xba, 0x3c, 0xb7, 0xd1, 0xbb, 0xa9, 0x8d, 0x3e, 0x4d, 0x6d,
+	0xf4, 0x79, 0x6a, 0xa3, 0xd3, 0xa9, 0x8d, 0xbe, 0x4e, 0x6d, 0xf4, 0x6d, 0x6a, 0x97, 0x2e, 0xa7,
+	0x36, 0xfa, 0x78, 0x61, 0x97, 0x4e, 0x2f, 0xec, 0xd2, 0xd9, 0x85, 0x5d, 0x7a, 0xbd, 0x35, 0x10,
+	0x57, 0xc3, 0x64, 0xe2, 0xf6, 0x7f, 0xfc, 0x51, 0x6e, 0xf6, 0x17, 0xcc, 0x47, 0xfe, 0xe0, 0xc7,
+	0x00, 0x64, 0xed, 0xae, 0x7c, 0xf8, 0x05, 0x00, 0x00,
 }
 
 func (this *HostInfo) Equal(that interface{}) bool {
@@ -345,83 +654,295 @@ func (this *MembershipInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *HostInfo) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *HostShardReport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&cluster.HostInfo{")
-	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RingInfo) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*HostShardReport)
+	if !ok {
+		that2, ok := that.(HostShardReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&cluster.RingInfo{")
-	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
-	s = append(s, "MemberCount: "+fmt.Sprintf("%#v", this.MemberCount)+",\n")
-	if this.Members != nil {
-		s = append(s, "Members: "+fmt.Sprintf("%#v", this.Members)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *MembershipInfo) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Address != that1.Address {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&cluster.MembershipInfo{")
-	if this.CurrentHost != nil {
-		s = append(s, "CurrentHost: "+fmt.Sprintf("%#v", this.CurrentHost)+",\n")
+	if this.Shards != that1.Shards {
+		return false
 	}
-	s = append(s, "ReachableMembers: "+fmt.Sprintf("%#v", this.ReachableMembers)+",\n")
-	if this.Rings != nil {
-		s = append(s, "Rings: "+fmt.Sprintf("%#v", this.Rings)+",\n")
+	if this.RequestsPerSecond != that1.RequestsPerSecond {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringMessage(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.Error != that1.Error {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return true
 }
-func (m *HostInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *StoreInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *HostInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *HostInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Identity) > 0 {
-		i -= len(m.Identity)
-		copy(dAtA[i:], m.Identity)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Identity)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*StoreInfo)
+	if !ok {
+		that2, ok := that.(StoreInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *ClusterEnvironment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClusterEnvironment)
+	if !ok {
+		that2, ok := that.(ClusterEnvironment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.PersistenceStore.Equal(that1.PersistenceStore) {
+		return false
+	}
+	if !this.VisibilityStore.Equal(that1.VisibilityStore) {
+		return false
+	}
+	if !this.AdvancedVisibilityStore.Equal(that1.AdvancedVisibilityStore) {
+		return false
+	}
+	if this.HistoryShardCount != that1.HistoryShardCount {
+		return false
+	}
+	if this.MinServerVersion != that1.MinServerVersion {
+		return false
+	}
+	if this.MaxServerVersion != that1.MaxServerVersion {
+		return false
+	}
+	if len(this.ServerVersions) != len(that1.ServerVersions) {
+		return false
+	}
+	for i := range this.ServerVersions {
+		if this.ServerVersions[i] != that1.ServerVersions[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ServerCapabilities) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServerCapabilities)
+	if !ok {
+		that2, ok := that.(ServerCapabilities)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.FailureDetailEncodings) != len(that1.FailureDetailEncodings) {
+		return false
+	}
+	for i := range this.FailureDetailEncodings {
+		if this.FailureDetailEncodings[i] != that1.FailureDetailEncodings[i] {
+			return false
+		}
+	}
+	if this.MaxPayloadSize != that1.MaxPayloadSize {
+		return false
+	}
+	return true
+}
+func (this *HostInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&cluster.HostInfo{")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RingInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&cluster.RingInfo{")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "MemberCount: "+fmt.Sprintf("%#v", this.MemberCount)+",\n")
+	if this.Members != nil {
+		s = append(s, "Members: "+fmt.Sprintf("%#v", this.Members)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MembershipInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&cluster.MembershipInfo{")
+	if this.CurrentHost != nil {
+		s = append(s, "CurrentHost: "+fmt.Sprintf("%#v", this.CurrentHost)+",\n")
+	}
+	s = append(s, "ReachableMembers: "+fmt.Sprintf("%#v", this.ReachableMembers)+",\n")
+	if this.Rings != nil {
+		s = append(s, "Rings: "+fmt.Sprintf("%#v", this.Rings)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HostShardReport) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&cluster.HostShardReport{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Shards: "+fmt.Sprintf("%#v", this.Shards)+",\n")
+	s = append(s, "RequestsPerSecond: "+fmt.Sprintf("%#v", this.RequestsPerSecond)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StoreInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&cluster.StoreInfo{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ClusterEnvironment) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&cluster.ClusterEnvironment{")
+	if this.PersistenceStore != nil {
+		s = append(s, "PersistenceStore: "+fmt.Sprintf("%#v", this.PersistenceStore)+",\n")
+	}
+	if this.VisibilityStore != nil {
+		s = append(s, "VisibilityStore: "+fmt.Sprintf("%#v", this.VisibilityStore)+",\n")
+	}
+	if this.AdvancedVisibilityStore != nil {
+		s = append(s, "AdvancedVisibilityStore: "+fmt.Sprintf("%#v", this.AdvancedVisibilityStore)+",\n")
+	}
+	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
+	s = append(s, "MinServerVersion: "+fmt.Sprintf("%#v", this.MinServerVersion)+",\n")
+	s = append(s, "MaxServerVersion: "+fmt.Sprintf("%#v", this.MaxServerVersion)+",\n")
+	keysForServerVersions := make([]string, 0, len(this.ServerVersions))
+	for k, _ := range this.ServerVersions {
+		keysForServerVersions = append(keysForServerVersions, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForServerVersions)
+	mapStringForServerVersions := "map[string]int32{"
+	for _, k := range keysForServerVersions {
+		mapStringForServerVersions += fmt.Sprintf("%#v: %#v,", k, this.ServerVersions[k])
+	}
+	mapStringForServerVersions += "}"
+	if this.ServerVersions != nil {
+		s = append(s, "ServerVersions: "+mapStringForServerVersions+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ServerCapabilities) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&cluster.ServerCapabilities{")
+	s = append(s, "FailureDetailEncodings: "+fmt.Sprintf("%#v", this.FailureDetailEncodings)+",\n")
+	s = append(s, "MaxPayloadSize: "+fmt.Sprintf("%#v", this.MaxPayloadSize)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *HostInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *RingInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -530,61 +1051,285 @@ func (m *MembershipInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HostShardReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HostInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	return n
+
+func (m *HostShardReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RingInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *HostShardReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.MemberCount != 0 {
-		n += 1 + sovMessage(uint64(m.MemberCount))
+	if m.RequestsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RequestsPerSecond))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
-		}
+	if m.Shards != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Shards))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MembershipInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StoreInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CurrentHost != nil {
-		l = m.CurrentHost.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterEnvironment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterEnvironment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterEnvironment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServerVersions) > 0 {
+		for k := range m.ServerVersions {
+			v := m.ServerVersions[k]
+			baseI := i
+			i = encodeVarintMessage(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MaxServerVersion) > 0 {
+		i -= len(m.MaxServerVersion)
+		copy(dAtA[i:], m.MaxServerVersion)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.MaxServerVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MinServerVersion) > 0 {
+		i -= len(m.MinServerVersion)
+		copy(dAtA[i:], m.MinServerVersion)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.MinServerVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HistoryShardCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.HistoryShardCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AdvancedVisibilityStore != nil {
+		{
+			size, err := m.AdvancedVisibilityStore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VisibilityStore != nil {
+		{
+			size, err := m.VisibilityStore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PersistenceStore != nil {
+		{
+			size, err := m.PersistenceStore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServerCapabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServerCapabilities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServerCapabilities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPayloadSize != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.MaxPayloadSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FailureDetailEncodings) > 0 {
+		for iNdEx := len(m.FailureDetailEncodings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailureDetailEncodings[iNdEx])
+			copy(dAtA[i:], m.FailureDetailEncodings[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.FailureDetailEncodings[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HostInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *RingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.MemberCount != 0 {
+		n += 1 + sovMessage(uint64(m.MemberCount))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MembershipInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentHost != nil {
+		l = m.CurrentHost.Size()
+		n += 1 + l + sovMessage(uint64(l))
 	}
 	if len(m.ReachableMembers) > 0 {
 		for _, s := range m.ReachableMembers {
@@ -601,6 +1346,108 @@ func (m *MembershipInfo) Size() (n int) {
 	return n
 }
 
+func (m *HostShardReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Shards != 0 {
+		n += 1 + sovMessage(uint64(m.Shards))
+	}
+	if m.RequestsPerSecond != 0 {
+		n += 9
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *StoreInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ClusterEnvironment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PersistenceStore != nil {
+		l = m.PersistenceStore.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.VisibilityStore != nil {
+		l = m.VisibilityStore.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.AdvancedVisibilityStore != nil {
+		l = m.AdvancedVisibilityStore.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.HistoryShardCount != 0 {
+		n += 1 + sovMessage(uint64(m.HistoryShardCount))
+	}
+	l = len(m.MinServerVersion)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.MaxServerVersion)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.ServerVersions) > 0 {
+		for k, v := range m.ServerVersions {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + sovMessage(uint64(v))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ServerCapabilities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailureDetailEncodings) > 0 {
+		for _, s := range m.FailureDetailEncodings {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.MaxPayloadSize != 0 {
+		n += 1 + sovMessage(uint64(m.MaxPayloadSize))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -651,6 +1498,68 @@ func (this *MembershipInfo) String() string {
 	}, "")
 	return s
 }
+func (this *HostShardReport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HostShardReport{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Shards:` + fmt.Sprintf("%v", this.Shards) + `,`,
+		`RequestsPerSecond:` + fmt.Sprintf("%v", this.RequestsPerSecond) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StoreInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StoreInfo{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterEnvironment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForServerVersions := make([]string, 0, len(this.ServerVersions))
+	for k, _ := range this.ServerVersions {
+		keysForServerVersions = append(keysForServerVersions, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForServerVersions)
+	mapStringForServerVersions := "map[string]int32{"
+	for _, k := range keysForServerVersions {
+		mapStringForServerVersions += fmt.Sprintf("%v: %v,", k, this.ServerVersions[k])
+	}
+	mapStringForServerVersions += "}"
+	s := strings.Join([]string{`&ClusterEnvironment{`,
+		`PersistenceStore:` + strings.Replace(this.PersistenceStore.String(), "StoreInfo", "StoreInfo", 1) + `,`,
+		`VisibilityStore:` + strings.Replace(this.VisibilityStore.String(), "StoreInfo", "StoreInfo", 1) + `,`,
+		`AdvancedVisibilityStore:` + strings.Replace(this.AdvancedVisibilityStore.String(), "StoreInfo", "StoreInfo", 1) + `,`,
+		`HistoryShardCount:` + fmt.Sprintf("%v", this.HistoryShardCount) + `,`,
+		`MinServerVersion:` + fmt.Sprintf("%v", this.MinServerVersion) + `,`,
+		`MaxServerVersion:` + fmt.Sprintf("%v", this.MaxServerVersion) + `,`,
+		`ServerVersions:` + mapStringForServerVersions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServerCapabilities) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServerCapabilities{`,
+		`FailureDetailEncodings:` + fmt.Sprintf("%v", this.FailureDetailEncodings) + `,`,
+		`MaxPayloadSize:` + fmt.Sprintf("%v", this.MaxPayloadSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -672,25 +1581,614 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberCount", wireType)
+			}
+			m.MemberCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &HostInfo{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MembershipInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MembershipInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MembershipInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentHost == nil {
+				m.CurrentHost = &HostInfo{}
+			}
+			if err := m.CurrentHost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReachableMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReachableMembers = append(m.ReachableMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rings = append(m.Rings, &RingInfo{})
+			if err := m.Rings[len(m.Rings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostShardReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostShardReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostShardReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			m.Shards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shards |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequestsPerSecond = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -718,7 +2216,7 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -744,7 +2242,7 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RingInfo) Unmarshal(dAtA []byte) error {
+func (m *ClusterEnvironment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -767,15 +2265,142 @@ func (m *RingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterEnvironment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterEnvironment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PersistenceStore == nil {
+				m.PersistenceStore = &StoreInfo{}
+			}
+			if err := m.PersistenceStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityStore == nil {
+				m.VisibilityStore = &StoreInfo{}
+			}
+			if err := m.VisibilityStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdvancedVisibilityStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdvancedVisibilityStore == nil {
+				m.AdvancedVisibilityStore = &StoreInfo{}
+			}
+			if err := m.AdvancedVisibilityStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryShardCount", wireType)
+			}
+			m.HistoryShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinServerVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -803,13 +2428,13 @@ func (m *RingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.MinServerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberCount", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxServerVersion", wireType)
 			}
-			m.MemberCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -819,14 +2444,27 @@ func (m *RingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemberCount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxServerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -853,10 +2491,89 @@ func (m *RingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &HostInfo{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.ServerVersions == nil {
+				m.ServerVersions = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.ServerVersions[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -882,7 +2599,7 @@ func (m *RingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MembershipInfo) Unmarshal(dAtA []byte) error {
+func (m *ServerCapabilities) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -905,51 +2622,15 @@ func (m *MembershipInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MembershipInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServerCapabilities: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MembershipInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServerCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHost", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CurrentHost == nil {
-				m.CurrentHost = &HostInfo{}
-			}
-			if err := m.CurrentHost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReachableMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureDetailEncodings", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -977,13 +2658,13 @@ func (m *MembershipInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReachableMembers = append(m.ReachableMembers, string(dAtA[iNdEx:postIndex]))
+			m.FailureDetailEncodings = append(m.FailureDetailEncodings, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rings", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadSize", wireType)
 			}
-			var msglen int
+			m.MaxPayloadSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -993,26 +2674,11 @@ func (m *MembershipInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPayloadSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Rings = append(m.Rings, &RingInfo{})
-			if err := m.Rings[len(m.Rings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -52,18 +52,21 @@ const (
 	DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED DeadLetterQueueType = 0
 	DEAD_LETTER_QUEUE_TYPE_REPLICATION DeadLetterQueueType = 1
 	DEAD_LETTER_QUEUE_TYPE_NAMESPACE   DeadLetterQueueType = 2
+	DEAD_LETTER_QUEUE_TYPE_SIGNAL      DeadLetterQueueType = 3
 )
 
 var DeadLetterQueueType_name = map[int32]string{
 	0: "Unspecified",
 	1: "Replication",
 	2: "Namespace",
+	3: "Signal",
 }
 
 var DeadLetterQueueType_value = map[string]int32{
 	"Unspecified": 0,
 	"Replication": 1,
 	"Namespace":   2,
+	"Signal":      3,
 }
 
 func (DeadLetterQueueType) EnumDescriptor() ([]byte, []int) {
@@ -91,9 +94,70 @@ func (ChecksumFlavor) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_4a3bfa9c01eff6e4, []int{1}
 }
 
+type IntakeOutcomeState int32
+
+const (
+	INTAKE_OUTCOME_STATE_UNSPECIFIED IntakeOutcomeState = 0
+	// Request is not applied yet.
+	INTAKE_OUTCOME_STATE_PENDING IntakeOutcomeState = 1
+	INTAKE_OUTCOME_STATE_APPLIED IntakeOutcomeState = 2
+	// Request can't be applied and is moved to the dead-letter queue.
+	INTAKE_OUTCOME_STATE_REJECTED IntakeOutcomeState = 3
+)
+
+var IntakeOutcomeState_name = map[int32]string{
+	0: "Unspecified",
+	1: "Pending",
+	2: "Applied",
+	3: "Rejected",
+}
+
+var IntakeOutcomeState_value = map[string]int32{
+	"Unspecified": 0,
+	"Pending":     1,
+	"Applied":     2,
+	"Rejected":    3,
+}
+
+func (IntakeOutcomeState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4a3bfa9c01eff6e4, []int{2}
+}
+
+type OperatorActionType int32
+
+const (
+	OPERATOR_ACTION_TYPE_UNSPECIFIED OperatorActionType = 0
+	// Fires pending user timer immediately.
+	OPERATOR_ACTION_TYPE_FIRE_TIMER OperatorActionType = 1
+	// Dispatches activity waiting for retry backoff immediately.
+	OPERATOR_ACTION_TYPE_RETRY_ACTIVITY OperatorActionType = 2
+	// Moves pending activities of activity type from task queue to target task queue.
+	OPERATOR_ACTION_TYPE_REROUTE_ACTIVITIES OperatorActionType = 3
+)
+
+var OperatorActionType_name = map[int32]string{
+	0: "Unspecified",
+	1: "FireTimer",
+	2: "RetryActivity",
+	3: "RerouteActivities",
+}
+
+var OperatorActionType_value = map[string]int32{
+	"Unspecified":       0,
+	"FireTimer":         1,
+	"RetryActivity":     2,
+	"RerouteActivities": 3,
+}
+
+func (OperatorActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4a3bfa9c01eff6e4, []int{3}
+}
+
 func init() {
 	proto.RegisterEnum("temporal.server.api.enums.v1.DeadLetterQueueType", DeadLetterQueueType_name, DeadLetterQueueType_value)
 	proto.RegisterEnum("temporal.server.api.enums.v1.ChecksumFlavor", ChecksumFlavor_name, ChecksumFlavor_value)
+	proto.RegisterEnum("temporal.server.api.enums.v1.IntakeOutcomeState", IntakeOutcomeState_name, IntakeOutcomeState_value)
+	proto.RegisterEnum("temporal.server.api.enums.v1.OperatorActionType", OperatorActionType_name, OperatorActionType_value)
 }
 
 func init() {
@@ -101,28 +165,38 @@ func init() {
 }
 
 var fileDescriptor_4a3bfa9c01eff6e4 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd0, 0x31, 0x4b, 0xf3, 0x40,
-	0x1c, 0xc7, 0xf1, 0xdc, 0x33, 0x3c, 0xc3, 0x0d, 0x12, 0xe2, 0xa8, 0x9c, 0x22, 0x22, 0x5a, 0x30,
-	0xa1, 0x76, 0x74, 0x4a, 0x2f, 0xff, 0x62, 0x30, 0x4d, 0xd2, 0x6b, 0x52, 0xa8, 0x83, 0x47, 0x6c,
-	0x0f, 0x2d, 0x36, 0xbd, 0x90, 0x26, 0x01, 0x37, 0x77, 0x17, 0x5f, 0x86, 0x2f, 0xc5, 0xb1, 0x63,
-	0x47, 0x7b, 0x5d, 0x1c, 0xfb, 0x12, 0x84, 0x8a, 0x0e, 0x45, 0xdd, 0x7e, 0xc3, 0x67, 0xf8, 0xf1,
-	0xc5, 0x27, 0x85, 0x48, 0x33, 0x99, 0x27, 0x63, 0x6b, 0x2a, 0xf2, 0x4a, 0xe4, 0x56, 0x92, 0x8d,
-	0x2c, 0x31, 0x29, 0xd3, 0xa9, 0x55, 0xd5, 0xad, 0x81, 0x4c, 0x53, 0x39, 0x31, 0xb3, 0x5c, 0x16,
-	0xd2, 0xd8, 0xfd, 0xa2, 0xe6, 0x27, 0x35, 0x93, 0x6c, 0x64, 0xae, 0xa9, 0x59, 0xd5, 0x6b, 0x4f,
-	0x08, 0x6f, 0x3b, 0x22, 0x19, 0x7a, 0xa2, 0x28, 0x44, 0xde, 0x29, 0x45, 0x29, 0xa2, 0x87, 0x4c,
-	0x18, 0x47, 0xf8, 0xc0, 0x01, 0xdb, 0xe1, 0x1e, 0x44, 0x11, 0x30, 0xde, 0x89, 0x21, 0x06, 0x1e,
-	0xf5, 0x43, 0xe0, 0xb1, 0xdf, 0x0d, 0x81, 0xba, 0x2d, 0x17, 0x1c, 0x5d, 0xfb, 0xc3, 0x31, 0x08,
-	0x3d, 0x97, 0xda, 0x91, 0x1b, 0xf8, 0x3a, 0x32, 0x0e, 0xf1, 0xfe, 0x2f, 0xce, 0xb7, 0xdb, 0xd0,
-	0x0d, 0x6d, 0x0a, 0xfa, 0xbf, 0xda, 0x10, 0x6f, 0xd1, 0x3b, 0x31, 0xb8, 0x9f, 0x96, 0x69, 0x6b,
-	0x9c, 0x54, 0x32, 0x37, 0xf6, 0xf0, 0x0e, 0xbd, 0x00, 0x7a, 0xd9, 0x8d, 0xdb, 0xbc, 0xe5, 0xd9,
-	0xbd, 0x80, 0x6d, 0x1c, 0xa8, 0xe3, 0xd3, 0x4d, 0xe0, 0x02, 0x00, 0xa7, 0x8c, 0x36, 0xce, 0x78,
-	0xd0, 0x03, 0xc6, 0x43, 0x16, 0x44, 0x41, 0x83, 0x37, 0x5d, 0xdf, 0x66, 0x7d, 0x1d, 0x35, 0xaf,
-	0x67, 0x0b, 0xa2, 0xcd, 0x17, 0x44, 0x5b, 0x2d, 0x08, 0x7a, 0x54, 0x04, 0xbd, 0x28, 0x82, 0x5e,
-	0x15, 0x41, 0x33, 0x45, 0xd0, 0x9b, 0x22, 0xe8, 0x5d, 0x11, 0x6d, 0xa5, 0x08, 0x7a, 0x5e, 0x12,
-	0x6d, 0xb6, 0x24, 0xda, 0x7c, 0x49, 0xb4, 0xab, 0xe3, 0x5b, 0x69, 0x7e, 0xa7, 0x1c, 0xc9, 0x9f,
-	0xc2, 0x9f, 0xaf, 0xc7, 0xcd, 0xff, 0x75, 0xf8, 0xc6, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x8a, 0xe1, 0xa4, 0xa5, 0x01, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0xd2, 0x31, 0x6f, 0xd3, 0x4c,
+	0x18, 0x07, 0x70, 0x5f, 0x2b, 0xbd, 0xc3, 0x0d, 0xaf, 0x2c, 0x33, 0x52, 0xae, 0x81, 0x56, 0x14,
+	0x82, 0x70, 0x14, 0x32, 0x32, 0x5d, 0xed, 0x27, 0xe5, 0xa8, 0xe3, 0x73, 0xcf, 0xe7, 0x48, 0x61,
+	0xe0, 0x64, 0xd2, 0x13, 0x44, 0xad, 0x73, 0x96, 0xe3, 0x44, 0x62, 0xe3, 0x23, 0xf0, 0x15, 0xd8,
+	0x98, 0xd8, 0xf8, 0x0e, 0x8c, 0x19, 0x3b, 0x12, 0x67, 0x61, 0xec, 0x47, 0x40, 0x31, 0x2a, 0x43,
+	0xe4, 0xb0, 0x9d, 0x4e, 0x3f, 0x9d, 0xee, 0xf9, 0x3f, 0x7f, 0xfc, 0xb4, 0xd4, 0x59, 0x6e, 0x8a,
+	0xf4, 0xba, 0x33, 0xd3, 0xc5, 0x42, 0x17, 0x9d, 0x34, 0x9f, 0x74, 0xf4, 0x74, 0x9e, 0xcd, 0x3a,
+	0x8b, 0x6e, 0x67, 0x6c, 0xb2, 0xcc, 0x4c, 0xdd, 0xbc, 0x30, 0xa5, 0x71, 0x0e, 0xee, 0xa8, 0xfb,
+	0x87, 0xba, 0x69, 0x3e, 0x71, 0x6b, 0xea, 0x2e, 0xba, 0xed, 0x6f, 0x08, 0xdf, 0xf3, 0x75, 0x7a,
+	0x19, 0xe8, 0xb2, 0xd4, 0xc5, 0xc5, 0x5c, 0xcf, 0xb5, 0xfc, 0x98, 0x6b, 0xe7, 0x31, 0x7e, 0xe4,
+	0x03, 0xf5, 0x55, 0x00, 0x52, 0x82, 0x50, 0x17, 0x09, 0x24, 0xa0, 0xe4, 0x28, 0x02, 0x95, 0x84,
+	0x71, 0x04, 0x1e, 0xeb, 0x33, 0xf0, 0x6d, 0xeb, 0x1f, 0x4e, 0x40, 0x14, 0x30, 0x8f, 0x4a, 0xc6,
+	0x43, 0x1b, 0x39, 0xc7, 0xb8, 0xb5, 0xc3, 0x85, 0x74, 0x00, 0x71, 0x44, 0x3d, 0xb0, 0xf7, 0x9c,
+	0x87, 0xf8, 0xc1, 0x0e, 0x15, 0xb3, 0xb3, 0x90, 0x06, 0xf6, 0x7e, 0xfb, 0x12, 0xff, 0xef, 0x7d,
+	0xd0, 0xe3, 0xab, 0xd9, 0x3c, 0xeb, 0x5f, 0xa7, 0x0b, 0x53, 0x38, 0x87, 0xf8, 0xbe, 0xf7, 0x0a,
+	0xbc, 0xf3, 0x38, 0x19, 0xa8, 0x7e, 0x40, 0x87, 0x5c, 0x6c, 0xfd, 0xb1, 0x8b, 0x9f, 0x6f, 0x03,
+	0x06, 0x00, 0xca, 0x13, 0x5e, 0xef, 0x85, 0xe2, 0x43, 0x10, 0x2a, 0x12, 0x5c, 0xf2, 0x9e, 0x3a,
+	0x65, 0x21, 0x15, 0x23, 0x1b, 0xb5, 0xbf, 0x20, 0xec, 0xb0, 0x69, 0x99, 0x5e, 0x69, 0x3e, 0x2f,
+	0xc7, 0x26, 0xd3, 0x71, 0x99, 0x96, 0x7a, 0x33, 0x05, 0x0b, 0x25, 0x3d, 0x07, 0xc5, 0x13, 0xe9,
+	0xf1, 0x01, 0xa8, 0x58, 0x52, 0xb9, 0x9d, 0x49, 0x0b, 0x1f, 0x34, 0xaa, 0x08, 0x42, 0x9f, 0x85,
+	0x67, 0x36, 0xda, 0x29, 0x68, 0x14, 0x05, 0x9b, 0x37, 0xea, 0x24, 0x1a, 0x85, 0x80, 0xd7, 0xe0,
+	0x49, 0xf0, 0xed, 0xfd, 0xf6, 0x77, 0x84, 0x1d, 0x9e, 0xeb, 0x22, 0x2d, 0x4d, 0x41, 0xc7, 0xe5,
+	0xc4, 0x4c, 0xeb, 0xcd, 0x1d, 0xe3, 0x16, 0x8f, 0x40, 0x50, 0xc9, 0x85, 0xa2, 0xde, 0x26, 0xfe,
+	0xa6, 0xbd, 0x1d, 0xe1, 0xc3, 0x46, 0xd5, 0x67, 0x02, 0x94, 0x64, 0x03, 0x10, 0x36, 0x72, 0x4e,
+	0xf0, 0x51, 0x23, 0x12, 0x20, 0xc5, 0xa8, 0xbe, 0x19, 0x32, 0x39, 0xb2, 0xf7, 0x9c, 0x67, 0xf8,
+	0x64, 0x07, 0x14, 0x3c, 0x91, 0x70, 0x47, 0x19, 0xc4, 0xf6, 0xfe, 0xe9, 0xdb, 0xe5, 0x8a, 0x58,
+	0x37, 0x2b, 0x62, 0xdd, 0xae, 0x08, 0xfa, 0x54, 0x11, 0xf4, 0xb5, 0x22, 0xe8, 0x47, 0x45, 0xd0,
+	0xb2, 0x22, 0xe8, 0x67, 0x45, 0xd0, 0xaf, 0x8a, 0x58, 0xb7, 0x15, 0x41, 0x9f, 0xd7, 0xc4, 0x5a,
+	0xae, 0x89, 0x75, 0xb3, 0x26, 0xd6, 0x9b, 0x27, 0xef, 0x8d, 0xfb, 0xb7, 0xc9, 0x13, 0xd3, 0xd4,
+	0xfb, 0x97, 0xf5, 0xe1, 0xdd, 0x7f, 0x75, 0xef, 0x7b, 0xbf, 0x07, 0x00, 0x9f, 0x4a, 0xf3, 0x87,
+	0x24, 0x03, 0x00, 0x00,
 }
 
 func (x DeadLetterQueueType) String() string {
@@ -139,3 +213,17 @@ func (x ChecksumFlavor) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x IntakeOutcomeState) String() string {
+	s, ok := IntakeOutcomeState_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x OperatorActionType) String() string {
+	s, ok := OperatorActionType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
@@ -133,6 +133,7 @@ const (
 	TASK_TYPE_VISIBILITY_UPSERT_EXECUTION                TaskType = 20
 	TASK_TYPE_VISIBILITY_CLOSE_EXECUTION                 TaskType = 21
 	TASK_TYPE_VISIBILITY_DELETE_EXECUTION                TaskType = 22
+	TASK_TYPE_STUCK_EXECUTION_CHECK                      TaskType = 23
 )
 
 var TaskType_name = map[int32]string{
@@ -159,6 +160,7 @@ var TaskType_name = map[int32]string{
 	20: "VisibilityUpsertExecution",
 	21: "VisibilityCloseExecution",
 	22: "VisibilityDeleteExecution",
+	23: "StuckExecutionCheck",
 }
 
 var TaskType_value = map[string]int32{
@@ -185,6 +187,7 @@ var TaskType_value = map[string]int32{
 	"VisibilityUpsertExecution":              20,
 	"VisibilityCloseExecution":               21,
 	"VisibilityDeleteExecution":              22,
+	"StuckExecutionCheck":                    23,
 }
 
 func (TaskType) EnumDescriptor() ([]byte, []int) {
@@ -202,47 +205,48 @@ func init() {
 }
 
 var fileDescriptor_36a3d3674ca3cfa6 = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4d, 0x53, 0x13, 0x4d,
-	0x10, 0xc7, 0x77, 0x03, 0x0f, 0xf0, 0xb4, 0xa8, 0xe3, 0xf0, 0x8e, 0x30, 0xca, 0x9b, 0x60, 0xca,
-	0x4a, 0x0a, 0xf5, 0xa6, 0x97, 0xcd, 0x64, 0x02, 0x53, 0xac, 0xbb, 0xa9, 0x99, 0x59, 0x30, 0x1e,
-	0xd8, 0x8a, 0xd6, 0x16, 0x45, 0x21, 0x6e, 0x2a, 0x09, 0x54, 0x71, 0xf3, 0x23, 0x78, 0xf5, 0xe4,
-	0xd5, 0x8f, 0xe2, 0x91, 0x23, 0x47, 0x59, 0x2e, 0x1e, 0xf9, 0x08, 0x56, 0x96, 0x64, 0x67, 0x13,
-	0x37, 0xb7, 0xad, 0xfa, 0xff, 0xfa, 0xdf, 0xdd, 0xdb, 0xdd, 0x03, 0x9b, 0xed, 0xe0, 0xb4, 0x11,
-	0x36, 0xeb, 0x9f, 0x8b, 0xad, 0xa0, 0x79, 0x1e, 0x34, 0x8b, 0xf5, 0xc6, 0x71, 0x31, 0xf8, 0x72,
-	0x76, 0xda, 0x2a, 0x9e, 0x6f, 0x17, 0xdb, 0xf5, 0xd6, 0x49, 0xa1, 0xd1, 0x0c, 0xdb, 0x21, 0x5e,
-	0xea, 0x81, 0x85, 0x3b, 0xb0, 0x50, 0x6f, 0x1c, 0x17, 0x62, 0xb0, 0x70, 0xbe, 0x9d, 0x3f, 0x04,
-	0x50, 0xf5, 0xd6, 0x89, 0x0c, 0xcf, 0x9a, 0x9f, 0x02, 0xfc, 0x18, 0xe6, 0x94, 0x25, 0xf7, 0x7c,
-	0xe9, 0x7a, 0x82, 0x32, 0xdf, 0x73, 0x64, 0x95, 0x51, 0x5e, 0xe1, 0xac, 0x8c, 0x0c, 0x3c, 0x07,
-	0x53, 0x69, 0x71, 0x97, 0x4b, 0xe5, 0x8a, 0x1a, 0x32, 0xf1, 0x22, 0xcc, 0xa6, 0x85, 0x72, 0xc9,
-	0x2f, 0x59, 0x74, 0xcf, 0x76, 0x77, 0x50, 0x2e, 0xff, 0xc3, 0x84, 0xc9, 0x4e, 0x02, 0x5a, 0x6f,
-	0x07, 0x47, 0x61, 0xf3, 0x02, 0x2f, 0xc3, 0x42, 0x0c, 0x53, 0x4b, 0xb1, 0x1d, 0x57, 0xd4, 0x06,
-	0x92, 0xf4, 0xbc, 0x12, 0x59, 0x09, 0xcb, 0x91, 0x15, 0x26, 0x90, 0x99, 0x14, 0xa0, 0x35, 0xfe,
-	0x8e, 0x09, 0x94, 0xfb, 0xd7, 0x53, 0xb0, 0xaa, 0xcd, 0xa9, 0xa5, 0xb8, 0xeb, 0xa0, 0x11, 0xbc,
-	0x04, 0xf3, 0xfd, 0xf2, 0x3e, 0x97, 0xbc, 0xc4, 0x6d, 0xae, 0x6a, 0x68, 0x34, 0xff, 0x7d, 0x1c,
-	0x26, 0x3a, 0x15, 0xaa, 0x8b, 0x46, 0x80, 0x17, 0x60, 0x26, 0x46, 0x55, 0xad, 0x3a, 0xd8, 0xfe,
-	0x0a, 0x2c, 0x6b, 0x29, 0x95, 0x20, 0xf5, 0x23, 0x36, 0x61, 0x2d, 0x1b, 0x91, 0x35, 0x87, 0xfa,
-	0x16, 0x55, 0x7c, 0xbf, 0x93, 0x33, 0x87, 0xd7, 0xe1, 0xa9, 0x06, 0x7b, 0x1d, 0xfa, 0x07, 0xae,
-	0xd8, 0xab, 0xd8, 0xee, 0x81, 0xdf, 0xd1, 0xd0, 0xc8, 0x10, 0xaa, 0x67, 0x73, 0x47, 0x8d, 0xe2,
-	0x67, 0xb0, 0x9a, 0x41, 0x51, 0xdb, 0x95, 0xcc, 0x67, 0xef, 0x19, 0xf5, 0xe2, 0xbf, 0xf0, 0x5f,
-	0x7f, 0x71, 0x9a, 0xb3, 0x1c, 0xca, 0xec, 0x14, 0x38, 0x86, 0x5f, 0xc0, 0x56, 0x06, 0x28, 0x95,
-	0x25, 0x94, 0x4f, 0x77, 0xb9, 0x5d, 0x4e, 0xd1, 0xe3, 0x43, 0x6c, 0x25, 0xdf, 0x71, 0xac, 0xb4,
-	0xed, 0x04, 0x7e, 0x09, 0xf9, 0x0c, 0x50, 0x30, 0xea, 0x8a, 0xb2, 0x6e, 0x3d, 0x4e, 0xc3, 0xca,
-	0xe8, 0xff, 0xc5, 0xdc, 0x84, 0x89, 0x37, 0x60, 0x25, 0x33, 0x46, 0x32, 0x95, 0x84, 0x20, 0xc0,
-	0x6f, 0xe1, 0x75, 0x06, 0xe6, 0x55, 0x25, 0x13, 0x2a, 0x65, 0xcd, 0x2c, 0x41, 0x77, 0x7d, 0x4b,
-	0x29, 0xc1, 0x4b, 0x9e, 0x62, 0x12, 0xdd, 0x8b, 0x93, 0xac, 0xc1, 0x13, 0x1d, 0xdd, 0x37, 0x83,
-	0x78, 0xc1, 0x5c, 0x4f, 0xa1, 0x49, 0x4c, 0x60, 0x51, 0x43, 0x7a, 0x04, 0x5d, 0xfd, 0x3e, 0x9e,
-	0x87, 0xe9, 0xd4, 0xe2, 0x48, 0x26, 0xba, 0xcb, 0xf9, 0x00, 0xaf, 0x02, 0xc9, 0xb0, 0x17, 0x9e,
-	0x93, 0x44, 0x3f, 0xec, 0x67, 0xca, 0xcc, 0x66, 0x2a, 0xb9, 0x2f, 0x9f, 0xed, 0x33, 0x47, 0x21,
-	0xd4, 0xcf, 0x24, 0x15, 0x08, 0xa6, 0x92, 0x43, 0x78, 0xd4, 0xbf, 0x31, 0x49, 0xae, 0xce, 0x35,
-	0xba, 0x95, 0x4a, 0x97, 0xc2, 0x78, 0x0b, 0xd6, 0x35, 0xa5, 0x6f, 0xa1, 0x3b, 0x62, 0x3d, 0xb3,
-	0x29, 0xfc, 0x1c, 0x36, 0x32, 0xc9, 0xee, 0xaf, 0xd5, 0xe8, 0xf4, 0x50, 0xd3, 0xc1, 0x45, 0x9c,
-	0x19, 0x6a, 0xda, 0xed, 0x5b, 0xa3, 0xb3, 0xa5, 0xc3, 0xcb, 0x6b, 0x62, 0x5c, 0x5d, 0x13, 0xe3,
-	0xf6, 0x9a, 0x98, 0x5f, 0x23, 0x62, 0xfe, 0x8c, 0x88, 0xf9, 0x2b, 0x22, 0xe6, 0x65, 0x44, 0xcc,
-	0xdf, 0x11, 0x31, 0xff, 0x44, 0xc4, 0xb8, 0x8d, 0x88, 0xf9, 0xed, 0x86, 0x18, 0x97, 0x37, 0xc4,
-	0xb8, 0xba, 0x21, 0xc6, 0x87, 0xad, 0xa3, 0xb0, 0x90, 0x3c, 0x7a, 0xc7, 0x61, 0xd6, 0x03, 0xf9,
-	0x26, 0xfe, 0xf8, 0x38, 0x16, 0x3f, 0x91, 0xaf, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x31,
-	0x49, 0x69, 0x4d, 0x05, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc7, 0xe3, 0xc0, 0x05, 0xee, 0xb9, 0xdc, 0x7b, 0xa7, 0xc3, 0x37, 0x85, 0x69, 0xf9, 0x2a,
+	0x34, 0xaa, 0x12, 0xd1, 0x76, 0xd7, 0x6e, 0x9c, 0xc9, 0x84, 0x8c, 0xe2, 0xda, 0xd1, 0xcc, 0x18,
+	0x9a, 0x2e, 0xb0, 0xd2, 0xca, 0x42, 0x88, 0x52, 0x47, 0x49, 0x40, 0x62, 0xd7, 0x47, 0xe8, 0x1b,
+	0x74, 0xdb, 0xa7, 0xe8, 0xba, 0x4b, 0x96, 0x2c, 0x8b, 0xd9, 0x74, 0xc9, 0x23, 0x54, 0x31, 0x89,
+	0xc7, 0xa6, 0xce, 0xce, 0xd2, 0xff, 0x77, 0xfe, 0xe7, 0x1c, 0x9f, 0x73, 0x06, 0xb6, 0x7b, 0xfe,
+	0x69, 0x3b, 0xe8, 0xb4, 0x3e, 0x96, 0xba, 0x7e, 0xe7, 0xdc, 0xef, 0x94, 0x5a, 0xed, 0xe3, 0x92,
+	0xff, 0xe9, 0xec, 0xb4, 0x5b, 0x3a, 0xdf, 0x2d, 0xf5, 0x5a, 0xdd, 0x93, 0x62, 0xbb, 0x13, 0xf4,
+	0x02, 0xbc, 0x32, 0x04, 0x8b, 0x77, 0x60, 0xb1, 0xd5, 0x3e, 0x2e, 0x46, 0x60, 0xf1, 0x7c, 0xb7,
+	0x70, 0x08, 0xa0, 0x5a, 0xdd, 0x13, 0x19, 0x9c, 0x75, 0x3e, 0xf8, 0xf8, 0x21, 0x2c, 0x28, 0x53,
+	0xd6, 0x3d, 0xe9, 0xb8, 0x82, 0x32, 0xcf, 0xb5, 0x65, 0x83, 0x51, 0x5e, 0xe5, 0xac, 0x82, 0x72,
+	0x78, 0x01, 0x66, 0x92, 0x62, 0x8d, 0x4b, 0xe5, 0x88, 0x26, 0x32, 0xf0, 0x32, 0xcc, 0x27, 0x85,
+	0x4a, 0xd9, 0x2b, 0x9b, 0xb4, 0x6e, 0x39, 0x7b, 0x28, 0x5f, 0xf8, 0x6a, 0xc0, 0x74, 0x3f, 0x01,
+	0x6d, 0xf5, 0xfc, 0xa3, 0xa0, 0x73, 0x81, 0x57, 0x61, 0x29, 0x82, 0xa9, 0xa9, 0xd8, 0x9e, 0x23,
+	0x9a, 0xf7, 0x92, 0x0c, 0xbd, 0x62, 0x59, 0x09, 0xd3, 0x96, 0x55, 0x26, 0x90, 0x11, 0x17, 0xa0,
+	0x35, 0xfe, 0x86, 0x09, 0x94, 0xff, 0xd3, 0x53, 0xb0, 0x86, 0xc5, 0xa9, 0xa9, 0xb8, 0x63, 0xa3,
+	0x31, 0xbc, 0x02, 0x8b, 0x69, 0x79, 0x9f, 0x4b, 0x5e, 0xe6, 0x16, 0x57, 0x4d, 0x34, 0x5e, 0xf8,
+	0x3e, 0x09, 0x53, 0xfd, 0x0a, 0xd5, 0x45, 0xdb, 0xc7, 0x4b, 0x30, 0x17, 0xa1, 0xaa, 0xd9, 0xb8,
+	0xdf, 0xfe, 0x1a, 0xac, 0x6a, 0x29, 0x91, 0x20, 0xf1, 0x23, 0xb6, 0x61, 0x23, 0x1b, 0x91, 0x4d,
+	0x9b, 0x7a, 0x26, 0x55, 0x7c, 0xbf, 0x9f, 0x33, 0x8f, 0x37, 0xe1, 0xb1, 0x06, 0x87, 0x1d, 0x7a,
+	0x07, 0x8e, 0xa8, 0x57, 0x2d, 0xe7, 0xc0, 0xeb, 0x6b, 0x68, 0x6c, 0x04, 0x35, 0xb4, 0xb9, 0xa3,
+	0xc6, 0xf1, 0x13, 0x58, 0xcf, 0xa0, 0xa8, 0xe5, 0x48, 0xe6, 0xb1, 0xb7, 0x8c, 0xba, 0xd1, 0x5f,
+	0xf8, 0x2b, 0x5d, 0x9c, 0xe6, 0x4c, 0x9b, 0x32, 0x2b, 0x01, 0x4e, 0xe0, 0x67, 0xb0, 0x93, 0x01,
+	0x4a, 0x65, 0x0a, 0xe5, 0xd1, 0x1a, 0xb7, 0x2a, 0x09, 0x7a, 0x72, 0x84, 0xad, 0xe4, 0x7b, 0xb6,
+	0x99, 0xb4, 0x9d, 0xc2, 0xcf, 0xa1, 0x90, 0x01, 0x0a, 0x46, 0x1d, 0x51, 0xd1, 0xad, 0x47, 0x69,
+	0x58, 0x05, 0xfd, 0xbd, 0x9c, 0x9f, 0x32, 0xf0, 0x16, 0xac, 0x65, 0xc6, 0x48, 0xa6, 0xe2, 0x10,
+	0x04, 0xf8, 0x35, 0xbc, 0xcc, 0xc0, 0xdc, 0x86, 0x64, 0x42, 0x25, 0xac, 0x99, 0x29, 0x68, 0xcd,
+	0x33, 0x95, 0x12, 0xbc, 0xec, 0x2a, 0x26, 0xd1, 0x3f, 0x51, 0x92, 0x0d, 0x78, 0xa4, 0xa3, 0x53,
+	0x33, 0x88, 0x16, 0xcc, 0x71, 0x15, 0x9a, 0xc6, 0x04, 0x96, 0x35, 0xa4, 0x47, 0x30, 0xd0, 0xff,
+	0xc5, 0x8b, 0x30, 0x9b, 0x58, 0x1c, 0xc9, 0xc4, 0x60, 0x39, 0xff, 0xc3, 0xeb, 0x40, 0x32, 0xec,
+	0x85, 0x6b, 0xc7, 0xd1, 0xff, 0xa7, 0x99, 0x0a, 0xb3, 0x98, 0x8a, 0xef, 0xcb, 0x63, 0xfb, 0xcc,
+	0x56, 0x08, 0xa5, 0x99, 0xb8, 0x02, 0xc1, 0x54, 0x7c, 0x08, 0x0f, 0xd2, 0x1b, 0x13, 0xe7, 0xea,
+	0x5f, 0xa3, 0x53, 0xad, 0x0e, 0x28, 0x8c, 0x77, 0x60, 0x53, 0x53, 0xfa, 0x16, 0x06, 0x23, 0xd6,
+	0x33, 0x9b, 0xc1, 0x4f, 0x61, 0x2b, 0x93, 0x1c, 0xfc, 0x5a, 0x8d, 0xce, 0x8e, 0x34, 0xbd, 0xbf,
+	0x88, 0x73, 0x23, 0x4d, 0x07, 0x7d, 0x6b, 0x74, 0x3e, 0x3d, 0x1a, 0xa9, 0x5c, 0x5a, 0xd7, 0xb2,
+	0x47, 0x6b, 0x8c, 0xd6, 0xd1, 0x42, 0xf9, 0xf0, 0xf2, 0x9a, 0xe4, 0xae, 0xae, 0x49, 0xee, 0xf6,
+	0x9a, 0x18, 0x9f, 0x43, 0x62, 0x7c, 0x0b, 0x89, 0xf1, 0x23, 0x24, 0xc6, 0x65, 0x48, 0x8c, 0x9f,
+	0x21, 0x31, 0x7e, 0x85, 0x24, 0x77, 0x1b, 0x12, 0xe3, 0xcb, 0x0d, 0xc9, 0x5d, 0xde, 0x90, 0xdc,
+	0xd5, 0x0d, 0xc9, 0xbd, 0xdb, 0x39, 0x0a, 0x8a, 0xf1, 0xcb, 0x78, 0x1c, 0x64, 0xbd, 0xa2, 0xaf,
+	0xa2, 0x8f, 0xf7, 0x13, 0xd1, 0x3b, 0xfa, 0xe2, 0xf7, 0x00, 0xaf, 0x50, 0x55, 0x66, 0x72, 0x05,
+	0x00, 0x00,
 }
 
 func (x TaskSource) String() string {
@@ -35,14 +35,19 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -278,11 +283,55 @@ func (m *RetryReplicationFailure) GetEndEventVersion() int64 {
 	return 0
 }
 
+type HistoryDegradedFailure struct {
+	RetryAfter *time.Duration `protobuf:"bytes,1,opt,name=retry_after,json=retryAfter,proto3,stdduration" json:"retry_after,omitempty"`
+}
+
+func (m *HistoryDegradedFailure) Reset()      { *m = HistoryDegradedFailure{} }
+func (*HistoryDegradedFailure) ProtoMessage() {}
+func (*HistoryDegradedFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73580c2e9c4cb332, []int{4}
+}
+func (m *HistoryDegradedFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryDegradedFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryDegradedFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryDegradedFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryDegradedFailure.Merge(m, src)
+}
+func (m *HistoryDegradedFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryDegradedFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryDegradedFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryDegradedFailure proto.InternalMessageInfo
+
+func (m *HistoryDegradedFailure) GetRetryAfter() *time.Duration {
+	if m != nil {
+		return m.RetryAfter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TaskAlreadyStartedFailure)(nil), "temporal.server.api.errordetails.v1.TaskAlreadyStartedFailure")
 	proto.RegisterType((*CurrentBranchChangedFailure)(nil), "temporal.server.api.errordetails.v1.CurrentBranchChangedFailure")
 	proto.RegisterType((*ShardOwnershipLostFailure)(nil), "temporal.server.api.errordetails.v1.ShardOwnershipLostFailure")
 	proto.RegisterType((*RetryReplicationFailure)(nil), "temporal.server.api.errordetails.v1.RetryReplicationFailure")
+	proto.RegisterType((*HistoryDegradedFailure)(nil), "temporal.server.api.errordetails.v1.HistoryDegradedFailure")
 }
 
 func init() {
@@ -290,37 +339,42 @@ func init() {
 }
 
 var fileDescriptor_73580c2e9c4cb332 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x77, 0x52, 0x1b, 0xe9, 0x24, 0x28, 0x5d, 0x15, 0x53, 0x8a, 0x63, 0x1a, 0x3d, 0x14,
-	0x0f, 0x1b, 0x83, 0xe0, 0xc5, 0x93, 0x2d, 0x8a, 0x01, 0x41, 0x48, 0x8b, 0x07, 0x41, 0xc2, 0x74,
-	0xe7, 0x99, 0x1d, 0xb2, 0x99, 0x59, 0xdf, 0xcc, 0x6e, 0xe8, 0x4d, 0xbf, 0x81, 0x47, 0x3f, 0x82,
-	0x1f, 0xc5, 0x63, 0x8e, 0x3d, 0x9a, 0xcd, 0xc5, 0x63, 0x3f, 0x82, 0xec, 0x6c, 0x36, 0x59, 0x3c,
-	0xf4, 0x38, 0xff, 0xff, 0xef, 0xff, 0x7f, 0x8f, 0xe1, 0xd1, 0x81, 0x85, 0x59, 0xa2, 0x91, 0xc7,
-	0x7d, 0x03, 0x98, 0x01, 0xf6, 0x79, 0x22, 0xfb, 0x80, 0xa8, 0x51, 0x80, 0xe5, 0x32, 0x36, 0xfd,
-	0x6c, 0xd0, 0x9f, 0x81, 0x31, 0x7c, 0x02, 0x41, 0x82, 0xda, 0x6a, 0xff, 0x49, 0x15, 0x09, 0xca,
-	0x48, 0xc0, 0x13, 0x19, 0xd4, 0x23, 0x41, 0x36, 0xe8, 0x1d, 0xd2, 0x83, 0x73, 0x6e, 0xa6, 0xaf,
-	0x63, 0x04, 0x2e, 0x2e, 0xcf, 0x2c, 0x47, 0x0b, 0xe2, 0x2d, 0x97, 0x71, 0x8a, 0xd0, 0xfb, 0x4e,
-	0xe8, 0xe1, 0x69, 0x8a, 0x08, 0xca, 0x9e, 0x20, 0x57, 0x61, 0x74, 0x1a, 0x71, 0x35, 0xd9, 0xf8,
-	0xfe, 0x73, 0x7a, 0x3f, 0x2c, 0xed, 0xf1, 0x85, 0xf3, 0xc7, 0x56, 0x4f, 0x41, 0x75, 0x48, 0x97,
-	0x1c, 0xb7, 0x47, 0x7e, 0x58, 0x8f, 0x9e, 0x17, 0x4e, 0x91, 0x40, 0xf8, 0x9a, 0x82, 0xf9, 0x2f,
-	0xd1, 0x28, 0x13, 0x6b, 0xaf, 0x96, 0xe8, 0x7d, 0xa6, 0x07, 0x67, 0x11, 0x47, 0xf1, 0x61, 0xae,
-	0x00, 0x4d, 0x24, 0x93, 0xf7, 0xda, 0xd8, 0x6a, 0x81, 0x47, 0x94, 0xea, 0x42, 0x1f, 0x47, 0xda,
-	0x58, 0x37, 0x76, 0x6f, 0xb4, 0xe7, 0x94, 0x77, 0xda, 0x58, 0xff, 0x88, 0xb6, 0xab, 0xfd, 0x1c,
-	0xd0, 0x70, 0x40, 0x6b, 0xad, 0x15, 0x48, 0xef, 0x67, 0x83, 0x3e, 0x1c, 0x81, 0xc5, 0xcb, 0x11,
-	0x24, 0xb1, 0x0c, 0xb9, 0x95, 0x5a, 0x55, 0xed, 0x47, 0xb4, 0xad, 0xf8, 0x0c, 0x4c, 0xc2, 0x43,
-	0x18, 0x4b, 0xb1, 0xee, 0x6f, 0x6d, 0xb4, 0xa1, 0xf0, 0x1f, 0xd3, 0xd6, 0x5c, 0xe3, 0xf4, 0x4b,
-	0xac, 0xe7, 0x05, 0x51, 0x0e, 0xa0, 0x95, 0x34, 0x14, 0xfe, 0x03, 0xda, 0xc4, 0x54, 0x15, 0xde,
-	0x8e, 0xf3, 0x76, 0x31, 0x55, 0x43, 0xe1, 0x3f, 0xa5, 0x77, 0x4c, 0xf1, 0xd7, 0x63, 0xc8, 0x8a,
-	0xed, 0xa4, 0xe8, 0xdc, 0xea, 0x92, 0xe3, 0x9d, 0x51, 0xdb, 0xa9, 0x6f, 0x0a, 0x71, 0x28, 0xfc,
-	0x80, 0xde, 0xab, 0x53, 0x19, 0xa0, 0x91, 0x5a, 0x75, 0x76, 0x1d, 0xba, 0xbf, 0x45, 0x3f, 0x96,
-	0x86, 0xdf, 0xa5, 0x6d, 0x50, 0x62, 0xdb, 0xd9, 0x74, 0x20, 0x05, 0x25, 0xaa, 0xc6, 0x67, 0x74,
-	0x7f, 0x4b, 0x54, 0x7d, 0xb7, 0x1d, 0x76, 0xb7, 0xc2, 0xd6, 0x6d, 0x27, 0xf1, 0x62, 0xc9, 0xbc,
-	0xab, 0x25, 0xf3, 0xae, 0x97, 0x8c, 0x7c, 0xcb, 0x19, 0xf9, 0x95, 0x33, 0xf2, 0x3b, 0x67, 0x64,
-	0x91, 0x33, 0xf2, 0x27, 0x67, 0xe4, 0x6f, 0xce, 0xbc, 0xeb, 0x9c, 0x91, 0x1f, 0x2b, 0xe6, 0x2d,
-	0x56, 0xcc, 0xbb, 0x5a, 0x31, 0xef, 0xd3, 0xcb, 0x89, 0x0e, 0x36, 0x87, 0x27, 0xf5, 0x0d, 0xe7,
-	0xfa, 0xaa, 0xfe, 0xbe, 0x68, 0xba, 0xa3, 0x7d, 0xf1, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xaa,
-	0xa0, 0x9f, 0xe9, 0x02, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xbb, 0x6e, 0xd4, 0x40,
+	0x14, 0x86, 0x3d, 0xb9, 0xa1, 0xcc, 0xae, 0x40, 0x31, 0xb7, 0x5c, 0xc4, 0x24, 0x59, 0x28, 0x22,
+	0x0a, 0x9b, 0x80, 0x44, 0x43, 0x43, 0x2e, 0xa0, 0xac, 0x84, 0x84, 0xe4, 0x44, 0x14, 0x91, 0x90,
+	0x35, 0xf1, 0x9c, 0x78, 0x47, 0x71, 0x66, 0xcc, 0x99, 0xf1, 0x46, 0xe9, 0xe0, 0x0d, 0x28, 0xf3,
+	0x08, 0x3c, 0x0a, 0x65, 0xca, 0x74, 0xb0, 0xde, 0x86, 0x32, 0x8f, 0x80, 0x3c, 0x5e, 0xef, 0xae,
+	0x28, 0xe8, 0x76, 0xff, 0xff, 0xfb, 0xff, 0x39, 0x3a, 0x9e, 0xa1, 0xdb, 0x16, 0xce, 0x73, 0x8d,
+	0x3c, 0x0b, 0x0d, 0x60, 0x1f, 0x30, 0xe4, 0xb9, 0x0c, 0x01, 0x51, 0xa3, 0x00, 0xcb, 0x65, 0x66,
+	0xc2, 0xfe, 0x76, 0x78, 0x0e, 0xc6, 0xf0, 0x14, 0x82, 0x1c, 0xb5, 0xd5, 0xfe, 0xd3, 0x26, 0x12,
+	0xd4, 0x91, 0x80, 0xe7, 0x32, 0x98, 0x8e, 0x04, 0xfd, 0xed, 0x55, 0x96, 0x6a, 0x9d, 0x66, 0x10,
+	0xba, 0xc8, 0x49, 0x71, 0x1a, 0x8a, 0x02, 0xb9, 0x95, 0x5a, 0xd5, 0x25, 0xab, 0x9b, 0x02, 0x72,
+	0x50, 0x02, 0x54, 0x22, 0xc1, 0x84, 0xa9, 0x4e, 0xb5, 0xd3, 0xdd, 0xaf, 0x1a, 0xe9, 0xac, 0xd1,
+	0x95, 0x23, 0x6e, 0xce, 0x76, 0x32, 0x04, 0x2e, 0x2e, 0x0f, 0x2d, 0x47, 0x0b, 0xe2, 0x3d, 0x97,
+	0x59, 0x81, 0xd0, 0xf9, 0x46, 0xe8, 0xda, 0x5e, 0x81, 0x08, 0xca, 0xee, 0x22, 0x57, 0x49, 0x6f,
+	0xaf, 0xc7, 0x55, 0x3a, 0xf6, 0xfd, 0x17, 0xf4, 0x41, 0x52, 0xdb, 0xf1, 0x89, 0xf3, 0x63, 0xab,
+	0xcf, 0x40, 0x2d, 0x93, 0x0d, 0xb2, 0xd5, 0x8e, 0xfc, 0x64, 0x3a, 0x7a, 0x54, 0x39, 0x55, 0x02,
+	0xe1, 0x4b, 0x01, 0xe6, 0x9f, 0xc4, 0x4c, 0x9d, 0x18, 0x79, 0x53, 0x89, 0xce, 0x67, 0xba, 0x72,
+	0xd8, 0xe3, 0x28, 0x3e, 0x5e, 0x28, 0x40, 0xd3, 0x93, 0xf9, 0x07, 0x6d, 0x6c, 0x33, 0xc0, 0x13,
+	0x4a, 0x75, 0xa5, 0xc7, 0x3d, 0x6d, 0xac, 0x3b, 0x76, 0x31, 0x5a, 0x74, 0xca, 0x81, 0x36, 0xd6,
+	0xdf, 0xa4, 0xed, 0x66, 0x3e, 0x07, 0xcc, 0x38, 0xa0, 0x35, 0xd2, 0x2a, 0xa4, 0x73, 0x35, 0x43,
+	0x1f, 0x47, 0x60, 0xf1, 0x32, 0x82, 0x3c, 0x93, 0x89, 0xdb, 0x5e, 0xd3, 0xbe, 0x49, 0xdb, 0x8a,
+	0x9f, 0x83, 0xc9, 0x79, 0x02, 0xb1, 0x14, 0xa3, 0xfe, 0xd6, 0x58, 0xeb, 0x0a, 0x7f, 0x9d, 0xb6,
+	0x2e, 0x34, 0x9e, 0x9d, 0x66, 0xfa, 0xa2, 0x22, 0xea, 0x03, 0x68, 0x23, 0x75, 0x85, 0xff, 0x90,
+	0x2e, 0x60, 0xa1, 0x2a, 0x6f, 0xd6, 0x79, 0xf3, 0x58, 0xa8, 0xae, 0xf0, 0x9f, 0xd1, 0xbb, 0xa6,
+	0xda, 0x75, 0x0c, 0xfd, 0x6a, 0x3a, 0x29, 0x96, 0xe7, 0x36, 0xc8, 0xd6, 0x6c, 0xd4, 0x76, 0xea,
+	0xbb, 0x4a, 0xec, 0x0a, 0x3f, 0xa0, 0xf7, 0xa7, 0xa9, 0x3e, 0xa0, 0x91, 0x5a, 0x2d, 0xcf, 0x3b,
+	0x74, 0x69, 0x82, 0x7e, 0xaa, 0x0d, 0x7f, 0x83, 0xb6, 0x41, 0x89, 0x49, 0xe7, 0x82, 0x03, 0x29,
+	0x28, 0xd1, 0x34, 0x3e, 0xa7, 0x4b, 0x13, 0xa2, 0xe9, 0xbb, 0xe3, 0xb0, 0x7b, 0x0d, 0x36, 0x6a,
+	0xeb, 0x1c, 0xd3, 0x47, 0x07, 0xd2, 0x58, 0x8d, 0x97, 0xfb, 0x90, 0x22, 0x17, 0x93, 0xef, 0xfe,
+	0x96, 0xb6, 0xb0, 0xda, 0x59, 0xcc, 0x4f, 0x2d, 0xa0, 0xdb, 0x4b, 0xeb, 0xe5, 0x4a, 0x50, 0xdf,
+	0xc6, 0xa0, 0xb9, 0x8d, 0xc1, 0xfe, 0xe8, 0x36, 0xee, 0xce, 0x5d, 0xfd, 0x5a, 0x27, 0x11, 0x75,
+	0x99, 0x9d, 0x2a, 0xb2, 0x9b, 0x5d, 0x0f, 0x98, 0x77, 0x33, 0x60, 0xde, 0xed, 0x80, 0x91, 0xaf,
+	0x25, 0x23, 0x3f, 0x4a, 0x46, 0x7e, 0x96, 0x8c, 0x5c, 0x97, 0x8c, 0xfc, 0x2e, 0x19, 0xf9, 0x53,
+	0x32, 0xef, 0xb6, 0x64, 0xe4, 0xfb, 0x90, 0x79, 0xd7, 0x43, 0xe6, 0xdd, 0x0c, 0x99, 0x77, 0xfc,
+	0x3a, 0xd5, 0xc1, 0xf8, 0x5d, 0x48, 0xfd, 0x9f, 0xd7, 0xf4, 0x66, 0xfa, 0xff, 0xc9, 0x82, 0x1b,
+	0xe9, 0xd5, 0xdf, 0x01, 0x00, 0xe8, 0x02, 0x16, 0x65, 0x88, 0x03, 0x00, 0x00,
 }
 
 func (this *TaskAlreadyStartedFailure) Equal(that interface{}) bool {
@@ -440,6 +494,36 @@ func (this *RetryReplicationFailure) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HistoryDegradedFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryDegradedFailure)
+	if !ok {
+		that2, ok := that.(HistoryDegradedFailure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RetryAfter != nil && that1.RetryAfter != nil {
+		if *this.RetryAfter != *that1.RetryAfter {
+			return false
+		}
+	} else if this.RetryAfter != nil {
+		return false
+	} else if that1.RetryAfter != nil {
+		return false
+	}
+	return true
+}
 func (this *TaskAlreadyStartedFailure) GoString() string {
 	if this == nil {
 		return "nil"
@@ -487,6 +571,16 @@ func (this *RetryReplicationFailure) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *HistoryDegradedFailure) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&errordetails.HistoryDegradedFailure{")
+	s = append(s, "RetryAfter: "+fmt.Sprintf("%#v", this.RetryAfter)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -656,6 +750,39 @@ func (m *RetryReplicationFailure) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoryDegradedFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryDegradedFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryDegradedFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryAfter != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryAfter):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMessage(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -743,6 +870,19 @@ func (m *RetryReplicationFailure) Size() (n int) {
 	return n
 }
 
+func (m *HistoryDegradedFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetryAfter != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryAfter)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -796,6 +936,16 @@ func (this *RetryReplicationFailure) String() string {
 	}, "")
 	return s
 }
+func (this *HistoryDegradedFailure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryDegradedFailure{`,
+		`RetryAfter:` + strings.Replace(fmt.Sprintf("%v", this.RetryAfter), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1320,6 +1470,95 @@ func (m *RetryReplicationFailure) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HistoryDegradedFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryDegradedFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryDegradedFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryAfter == nil {
+				m.RetryAfter = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.RetryAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
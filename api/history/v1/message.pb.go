@@ -35,8 +35,13 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	v11 "go.temporal.io/api/enums/v1"
 	v1 "go.temporal.io/api/history/v1"
 )
 
@@ -44,6 +49,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -258,541 +264,3538 @@ func (m *VersionHistories) GetHistories() []*VersionHistory {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*TransientWorkflowTaskInfo)(nil), "temporal.server.api.history.v1.TransientWorkflowTaskInfo")
-	proto.RegisterType((*VersionHistoryItem)(nil), "temporal.server.api.history.v1.VersionHistoryItem")
-	proto.RegisterType((*VersionHistory)(nil), "temporal.server.api.history.v1.VersionHistory")
-	proto.RegisterType((*VersionHistories)(nil), "temporal.server.api.history.v1.VersionHistories")
+// ContendedWorkflow contains lock wait statistics of workflow whose lock wait exceeded contention threshold.
+type ContendedWorkflow struct {
+	NamespaceId  string         `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId   string         `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	WaitCount    int64          `protobuf:"varint,3,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	TotalWait    *time.Duration `protobuf:"bytes,4,opt,name=total_wait,json=totalWait,proto3,stdduration" json:"total_wait,omitempty"`
+	MaxWait      *time.Duration `protobuf:"bytes,5,opt,name=max_wait,json=maxWait,proto3,stdduration" json:"max_wait,omitempty"`
+	LastWaitTime *time.Time     `protobuf:"bytes,6,opt,name=last_wait_time,json=lastWaitTime,proto3,stdtime" json:"last_wait_time,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/history/v1/message.proto", fileDescriptor_670cd05c700ece14)
+func (m *ContendedWorkflow) Reset()      { *m = ContendedWorkflow{} }
+func (*ContendedWorkflow) ProtoMessage() {}
+func (*ContendedWorkflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{4}
 }
-
-var fileDescriptor_670cd05c700ece14 = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6a, 0xd4, 0x40,
-	0x1c, 0xc6, 0x33, 0x5d, 0x6a, 0x75, 0x76, 0xad, 0x32, 0xa7, 0x6d, 0xc1, 0xa1, 0x06, 0x0a, 0x3d,
-	0x94, 0x09, 0x5d, 0x8f, 0x9e, 0x14, 0x84, 0x46, 0xc4, 0x43, 0x58, 0x14, 0xbc, 0x84, 0xd9, 0xcd,
-	0xbf, 0xcd, 0xb0, 0x9b, 0x99, 0x30, 0x33, 0x8d, 0x7a, 0x10, 0x7c, 0x04, 0xdf, 0xc1, 0x8b, 0x6f,
-	0xe0, 0x2b, 0x78, 0xdc, 0x63, 0x8f, 0x6e, 0xf6, 0xe2, 0xb1, 0x8f, 0x20, 0x99, 0xcc, 0xa6, 0xac,
-	0x96, 0x42, 0x6f, 0xf3, 0x4d, 0xbe, 0xff, 0xef, 0xfb, 0xc2, 0xfc, 0xf1, 0xb1, 0x85, 0xa2, 0x54,
-	0x9a, 0xcf, 0x23, 0x03, 0xba, 0x02, 0x1d, 0xf1, 0x52, 0x44, 0xb9, 0x30, 0x56, 0xe9, 0xcf, 0x51,
-	0x75, 0x12, 0x15, 0x60, 0x0c, 0x3f, 0x07, 0x56, 0x6a, 0x65, 0x15, 0xa1, 0x6b, 0x37, 0x6b, 0xdd,
-	0x8c, 0x97, 0x82, 0x79, 0x37, 0xab, 0x4e, 0xf6, 0x0f, 0x3b, 0xda, 0x6d, 0x98, 0xf0, 0x27, 0xc2,
-	0x7b, 0x63, 0xcd, 0xa5, 0x11, 0x20, 0xed, 0x7b, 0xa5, 0x67, 0x67, 0x73, 0xf5, 0x71, 0xcc, 0xcd,
-	0x2c, 0x96, 0x67, 0x8a, 0xbc, 0xc5, 0x8f, 0xcc, 0x34, 0x87, 0xec, 0x62, 0x0e, 0x59, 0x0a, 0x15,
-	0x48, 0x3b, 0x44, 0x07, 0xe8, 0xa8, 0x3f, 0x3a, 0x64, 0x5d, 0xfc, 0x66, 0x2e, 0x3b, 0x6d, 0x8f,
-	0xaf, 0x1a, 0x73, 0xb2, 0xdb, 0x4d, 0x3b, 0x4d, 0x5e, 0xe3, 0x87, 0xc6, 0x72, 0x6d, 0x3b, 0xda,
-	0xd6, 0x5d, 0x68, 0x03, 0x3f, 0xeb, 0x54, 0x18, 0x63, 0xf2, 0x0e, 0xb4, 0x11, 0x4a, 0x7a, 0x53,
-	0x6c, 0xa1, 0x20, 0x7b, 0xf8, 0xbe, 0x23, 0xa7, 0x22, 0x73, 0x55, 0x7b, 0xc9, 0x8e, 0xd3, 0x71,
-	0x46, 0x86, 0x78, 0xa7, 0x6a, 0x07, 0x5c, 0x6c, 0x2f, 0x59, 0xcb, 0xf0, 0x0b, 0xde, 0xdd, 0x44,
-	0x91, 0xa7, 0x78, 0x30, 0xd1, 0x5c, 0x4e, 0xf3, 0xd4, 0xaa, 0x19, 0x48, 0x87, 0x1a, 0x24, 0xfd,
-	0xf6, 0x6e, 0xdc, 0x5c, 0x91, 0x53, 0xbc, 0x2d, 0x2c, 0x14, 0x66, 0xb8, 0x75, 0xd0, 0x3b, 0xea,
-	0x8f, 0x46, 0xec, 0xf6, 0x07, 0x61, 0xff, 0x97, 0x4d, 0x5a, 0x40, 0xf8, 0x1d, 0xe1, 0xc7, 0x1b,
-	0x5f, 0x05, 0x18, 0xf2, 0x02, 0x3f, 0x99, 0x5e, 0x68, 0xdd, 0xfc, 0x8a, 0xaf, 0x99, 0x7a, 0x58,
-	0x2a, 0x64, 0x06, 0x9f, 0x5c, 0xa5, 0xed, 0x64, 0xdf, 0x9b, 0xfe, 0xa1, 0x37, 0x0e, 0xf2, 0x06,
-	0x3f, 0xc8, 0xd7, 0x3c, 0xdf, 0x92, 0xdd, 0xad, 0x65, 0x72, 0x0d, 0x78, 0x39, 0x59, 0x2c, 0x69,
-	0x70, 0xb9, 0xa4, 0xc1, 0xd5, 0x92, 0xa2, 0xaf, 0x35, 0x45, 0x3f, 0x6a, 0x8a, 0x7e, 0xd5, 0x14,
-	0x2d, 0x6a, 0x8a, 0x7e, 0xd7, 0x14, 0xfd, 0xa9, 0x69, 0x70, 0x55, 0x53, 0xf4, 0x6d, 0x45, 0x83,
-	0xc5, 0x8a, 0x06, 0x97, 0x2b, 0x1a, 0x7c, 0x38, 0x3e, 0x57, 0xd7, 0x91, 0x42, 0xdd, 0xbc, 0xda,
-	0xcf, 0xfd, 0x71, 0x72, 0xcf, 0x2d, 0xe5, 0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xc0,
-	0xb3, 0x54, 0x0b, 0x03, 0x00, 0x00,
+func (m *ContendedWorkflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContendedWorkflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContendedWorkflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContendedWorkflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContendedWorkflow.Merge(m, src)
+}
+func (m *ContendedWorkflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContendedWorkflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContendedWorkflow.DiscardUnknown(m)
 }
 
-func (this *TransientWorkflowTaskInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_ContendedWorkflow proto.InternalMessageInfo
+
+func (m *ContendedWorkflow) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
+	return ""
+}
 
-	that1, ok := that.(*TransientWorkflowTaskInfo)
-	if !ok {
-		that2, ok := that.(TransientWorkflowTaskInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ContendedWorkflow) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *ContendedWorkflow) GetWaitCount() int64 {
+	if m != nil {
+		return m.WaitCount
 	}
-	if !this.ScheduledEvent.Equal(that1.ScheduledEvent) {
-		return false
+	return 0
+}
+
+func (m *ContendedWorkflow) GetTotalWait() *time.Duration {
+	if m != nil {
+		return m.TotalWait
 	}
-	if !this.StartedEvent.Equal(that1.StartedEvent) {
-		return false
+	return nil
+}
+
+func (m *ContendedWorkflow) GetMaxWait() *time.Duration {
+	if m != nil {
+		return m.MaxWait
 	}
-	return true
+	return nil
 }
-func (this *VersionHistoryItem) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ContendedWorkflow) GetLastWaitTime() *time.Time {
+	if m != nil {
+		return m.LastWaitTime
 	}
+	return nil
+}
 
-	that1, ok := that.(*VersionHistoryItem)
-	if !ok {
-		that2, ok := that.(VersionHistoryItem)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// PendingExecutionDetails explains what a running execution is waiting for.
+type PendingExecutionDetails struct {
+	WorkflowTask *PendingWorkflowTaskDetails `protobuf:"bytes,1,opt,name=workflow_task,json=workflowTask,proto3" json:"workflow_task,omitempty"`
+	TotalTimers  int32                       `protobuf:"varint,2,opt,name=total_timers,json=totalTimers,proto3" json:"total_timers,omitempty"`
+	// Timers which fire next, up to number of timers requested.
+	NextTimers     []*PendingTimerDetails          `protobuf:"bytes,3,rep,name=next_timers,json=nextTimers,proto3" json:"next_timers,omitempty"`
+	Children       []*PendingChildDetails          `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	CancelRequests []*PendingCancelRequestDetails  `protobuf:"bytes,5,rep,name=cancel_requests,json=cancelRequests,proto3" json:"cancel_requests,omitempty"`
+	Signals        []*PendingSignalExternalDetails `protobuf:"bytes,6,rep,name=signals,proto3" json:"signals,omitempty"`
+}
+
+func (m *PendingExecutionDetails) Reset()      { *m = PendingExecutionDetails{} }
+func (*PendingExecutionDetails) ProtoMessage() {}
+func (*PendingExecutionDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{5}
+}
+func (m *PendingExecutionDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingExecutionDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingExecutionDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.EventId != that1.EventId {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
-	}
-	return true
 }
-func (this *VersionHistory) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PendingExecutionDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingExecutionDetails.Merge(m, src)
+}
+func (m *PendingExecutionDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingExecutionDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingExecutionDetails.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*VersionHistory)
-	if !ok {
-		that2, ok := that.(VersionHistory)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_PendingExecutionDetails proto.InternalMessageInfo
+
+func (m *PendingExecutionDetails) GetWorkflowTask() *PendingWorkflowTaskDetails {
+	if m != nil {
+		return m.WorkflowTask
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *PendingExecutionDetails) GetTotalTimers() int32 {
+	if m != nil {
+		return m.TotalTimers
 	}
-	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
-		return false
+	return 0
+}
+
+func (m *PendingExecutionDetails) GetNextTimers() []*PendingTimerDetails {
+	if m != nil {
+		return m.NextTimers
 	}
-	if len(this.Items) != len(that1.Items) {
-		return false
+	return nil
+}
+
+func (m *PendingExecutionDetails) GetChildren() []*PendingChildDetails {
+	if m != nil {
+		return m.Children
 	}
-	for i := range this.Items {
-		if !this.Items[i].Equal(that1.Items[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *PendingExecutionDetails) GetCancelRequests() []*PendingCancelRequestDetails {
+	if m != nil {
+		return m.CancelRequests
 	}
-	return true
+	return nil
 }
-func (this *VersionHistories) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PendingExecutionDetails) GetSignals() []*PendingSignalExternalDetails {
+	if m != nil {
+		return m.Signals
 	}
+	return nil
+}
 
-	that1, ok := that.(*VersionHistories)
-	if !ok {
-		that2, ok := that.(VersionHistories)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type PendingWorkflowTaskDetails struct {
+	State                 string         `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	ScheduleId            int64          `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	StartedId             int64          `protobuf:"varint,3,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
+	Attempt               int32          `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Timeout               *time.Duration `protobuf:"bytes,5,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	ScheduledTime         *time.Time     `protobuf:"bytes,6,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
+	OriginalScheduledTime *time.Time     `protobuf:"bytes,7,opt,name=original_scheduled_time,json=originalScheduledTime,proto3,stdtime" json:"original_scheduled_time,omitempty"`
+	StartedTime           *time.Time     `protobuf:"bytes,8,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
+}
+
+func (m *PendingWorkflowTaskDetails) Reset()      { *m = PendingWorkflowTaskDetails{} }
+func (*PendingWorkflowTaskDetails) ProtoMessage() {}
+func (*PendingWorkflowTaskDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{6}
+}
+func (m *PendingWorkflowTaskDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingWorkflowTaskDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingWorkflowTaskDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CurrentVersionHistoryIndex != that1.CurrentVersionHistoryIndex {
-		return false
-	}
-	if len(this.Histories) != len(that1.Histories) {
-		return false
-	}
-	for i := range this.Histories {
-		if !this.Histories[i].Equal(that1.Histories[i]) {
-			return false
-		}
+}
+func (m *PendingWorkflowTaskDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingWorkflowTaskDetails.Merge(m, src)
+}
+func (m *PendingWorkflowTaskDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingWorkflowTaskDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingWorkflowTaskDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingWorkflowTaskDetails proto.InternalMessageInfo
+
+func (m *PendingWorkflowTaskDetails) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	return true
+	return ""
 }
-func (this *TransientWorkflowTaskInfo) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *PendingWorkflowTaskDetails) GetScheduleId() int64 {
+	if m != nil {
+		return m.ScheduleId
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&history.TransientWorkflowTaskInfo{")
-	if this.ScheduledEvent != nil {
-		s = append(s, "ScheduledEvent: "+fmt.Sprintf("%#v", this.ScheduledEvent)+",\n")
+	return 0
+}
+
+func (m *PendingWorkflowTaskDetails) GetStartedId() int64 {
+	if m != nil {
+		return m.StartedId
 	}
-	if this.StartedEvent != nil {
-		s = append(s, "StartedEvent: "+fmt.Sprintf("%#v", this.StartedEvent)+",\n")
+	return 0
+}
+
+func (m *PendingWorkflowTaskDetails) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *VersionHistoryItem) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *PendingWorkflowTaskDetails) GetTimeout() *time.Duration {
+	if m != nil {
+		return m.Timeout
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&history.VersionHistoryItem{")
-	s = append(s, "EventId: "+fmt.Sprintf("%#v", this.EventId)+",\n")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *VersionHistory) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *PendingWorkflowTaskDetails) GetScheduledTime() *time.Time {
+	if m != nil {
+		return m.ScheduledTime
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&history.VersionHistory{")
-	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
-	if this.Items != nil {
-		s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
+	return nil
+}
+
+func (m *PendingWorkflowTaskDetails) GetOriginalScheduledTime() *time.Time {
+	if m != nil {
+		return m.OriginalScheduledTime
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *VersionHistories) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *PendingWorkflowTaskDetails) GetStartedTime() *time.Time {
+	if m != nil {
+		return m.StartedTime
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&history.VersionHistories{")
-	s = append(s, "CurrentVersionHistoryIndex: "+fmt.Sprintf("%#v", this.CurrentVersionHistoryIndex)+",\n")
-	if this.Histories != nil {
-		s = append(s, "Histories: "+fmt.Sprintf("%#v", this.Histories)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func valueToGoStringMessage(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+type PendingTimerDetails struct {
+	TimerId   string     `protobuf:"bytes,1,opt,name=timer_id,json=timerId,proto3" json:"timer_id,omitempty"`
+	StartedId int64      `protobuf:"varint,2,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
+	FireTime  *time.Time `protobuf:"bytes,3,opt,name=fire_time,json=fireTime,proto3,stdtime" json:"fire_time,omitempty"`
+}
+
+func (m *PendingTimerDetails) Reset()      { *m = PendingTimerDetails{} }
+func (*PendingTimerDetails) ProtoMessage() {}
+func (*PendingTimerDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{7}
+}
+func (m *PendingTimerDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTimerDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTimerDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
-func (m *TransientWorkflowTaskInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingTimerDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTimerDetails.Merge(m, src)
+}
+func (m *PendingTimerDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTimerDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTimerDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTimerDetails proto.InternalMessageInfo
+
+func (m *PendingTimerDetails) GetTimerId() string {
+	if m != nil {
+		return m.TimerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TransientWorkflowTaskInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingTimerDetails) GetStartedId() int64 {
+	if m != nil {
+		return m.StartedId
+	}
+	return 0
 }
 
-func (m *TransientWorkflowTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StartedEvent != nil {
-		{
-			size, err := m.StartedEvent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *PendingTimerDetails) GetFireTime() *time.Time {
+	if m != nil {
+		return m.FireTime
 	}
-	if m.ScheduledEvent != nil {
-		{
-			size, err := m.ScheduledEvent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMessage(dAtA, i, uint64(size))
+	return nil
+}
+
+type PendingChildDetails struct {
+	InitiatedId       int64                 `protobuf:"varint,1,opt,name=initiated_id,json=initiatedId,proto3" json:"initiated_id,omitempty"`
+	StartedId         int64                 `protobuf:"varint,2,opt,name=started_id,json=startedId,proto3" json:"started_id,omitempty"`
+	State             string                `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Namespace         string                `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	WorkflowTypeName  string                `protobuf:"bytes,5,opt,name=workflow_type_name,json=workflowTypeName,proto3" json:"workflow_type_name,omitempty"`
+	WorkflowId        string                `protobuf:"bytes,6,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId             string                `protobuf:"bytes,7,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	ParentClosePolicy v11.ParentClosePolicy `protobuf:"varint,8,opt,name=parent_close_policy,json=parentClosePolicy,proto3,enum=temporal.api.enums.v1.ParentClosePolicy" json:"parent_close_policy,omitempty"`
+}
+
+func (m *PendingChildDetails) Reset()      { *m = PendingChildDetails{} }
+func (*PendingChildDetails) ProtoMessage() {}
+func (*PendingChildDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{8}
+}
+func (m *PendingChildDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingChildDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingChildDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PendingChildDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChildDetails.Merge(m, src)
+}
+func (m *PendingChildDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingChildDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChildDetails.DiscardUnknown(m)
 }
 
-func (m *VersionHistoryItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PendingChildDetails proto.InternalMessageInfo
+
+func (m *PendingChildDetails) GetInitiatedId() int64 {
+	if m != nil {
+		return m.InitiatedId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *VersionHistoryItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingChildDetails) GetStartedId() int64 {
+	if m != nil {
+		return m.StartedId
+	}
+	return 0
 }
 
-func (m *VersionHistoryItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EventId != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.EventId))
-		i--
-		dAtA[i] = 0x8
+func (m *PendingChildDetails) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VersionHistory) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingChildDetails) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VersionHistory) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingChildDetails) GetWorkflowTypeName() string {
+	if m != nil {
+		return m.WorkflowTypeName
+	}
+	return ""
 }
 
-func (m *VersionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *PendingChildDetails) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
 	}
-	if len(m.BranchToken) > 0 {
-		i -= len(m.BranchToken)
-		copy(dAtA[i:], m.BranchToken)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.BranchToken)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *PendingChildDetails) GetRunId() string {
+	if m != nil {
+		return m.RunId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VersionHistories) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingChildDetails) GetParentClosePolicy() v11.ParentClosePolicy {
+	if m != nil {
+		return m.ParentClosePolicy
 	}
-	return dAtA[:n], nil
+	return v11.PARENT_CLOSE_POLICY_UNSPECIFIED
 }
 
-func (m *VersionHistories) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PendingCancelRequestDetails struct {
+	InitiatedId     int64  `protobuf:"varint,1,opt,name=initiated_id,json=initiatedId,proto3" json:"initiated_id,omitempty"`
+	CancelRequestId string `protobuf:"bytes,2,opt,name=cancel_request_id,json=cancelRequestId,proto3" json:"cancel_request_id,omitempty"`
 }
 
-func (m *VersionHistories) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Histories) > 0 {
-		for iNdEx := len(m.Histories) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Histories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMessage(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *PendingCancelRequestDetails) Reset()      { *m = PendingCancelRequestDetails{} }
+func (*PendingCancelRequestDetails) ProtoMessage() {}
+func (*PendingCancelRequestDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{9}
+}
+func (m *PendingCancelRequestDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCancelRequestDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCancelRequestDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.CurrentVersionHistoryIndex != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.CurrentVersionHistoryIndex))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *PendingCancelRequestDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCancelRequestDetails.Merge(m, src)
+}
+func (m *PendingCancelRequestDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCancelRequestDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCancelRequestDetails.DiscardUnknown(m)
 }
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_PendingCancelRequestDetails proto.InternalMessageInfo
+
+func (m *PendingCancelRequestDetails) GetInitiatedId() int64 {
+	if m != nil {
+		return m.InitiatedId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *TransientWorkflowTaskInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ScheduledEvent != nil {
-		l = m.ScheduledEvent.Size()
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.StartedEvent != nil {
-		l = m.StartedEvent.Size()
-		n += 1 + l + sovMessage(uint64(l))
+
+func (m *PendingCancelRequestDetails) GetCancelRequestId() string {
+	if m != nil {
+		return m.CancelRequestId
 	}
-	return n
+	return ""
 }
 
-func (m *VersionHistoryItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EventId != 0 {
-		n += 1 + sovMessage(uint64(m.EventId))
-	}
-	if m.Version != 0 {
-		n += 1 + sovMessage(uint64(m.Version))
-	}
-	return n
+type PendingSignalExternalDetails struct {
+	InitiatedId int64  `protobuf:"varint,1,opt,name=initiated_id,json=initiatedId,proto3" json:"initiated_id,omitempty"`
+	SignalName  string `protobuf:"bytes,2,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	RequestId   string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (m *VersionHistory) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BranchToken)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
+func (m *PendingSignalExternalDetails) Reset()      { *m = PendingSignalExternalDetails{} }
+func (*PendingSignalExternalDetails) ProtoMessage() {}
+func (*PendingSignalExternalDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{10}
+}
+func (m *PendingSignalExternalDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSignalExternalDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSignalExternalDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *PendingSignalExternalDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSignalExternalDetails.Merge(m, src)
+}
+func (m *PendingSignalExternalDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSignalExternalDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSignalExternalDetails.DiscardUnknown(m)
 }
 
-func (m *VersionHistories) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_PendingSignalExternalDetails proto.InternalMessageInfo
+
+func (m *PendingSignalExternalDetails) GetInitiatedId() int64 {
+	if m != nil {
+		return m.InitiatedId
 	}
-	var l int
-	_ = l
-	if m.CurrentVersionHistoryIndex != 0 {
-		n += 1 + sovMessage(uint64(m.CurrentVersionHistoryIndex))
+	return 0
+}
+
+func (m *PendingSignalExternalDetails) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
 	}
-	if len(m.Histories) > 0 {
-		for _, e := range m.Histories {
-			l = e.Size()
-			n += 1 + l + sovMessage(uint64(l))
-		}
+	return ""
+}
+
+func (m *PendingSignalExternalDetails) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return n
+	return ""
 }
 
-func sovMessage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func init() {
+	proto.RegisterType((*TransientWorkflowTaskInfo)(nil), "temporal.server.api.history.v1.TransientWorkflowTaskInfo")
+	proto.RegisterType((*VersionHistoryItem)(nil), "temporal.server.api.history.v1.VersionHistoryItem")
+	proto.RegisterType((*VersionHistory)(nil), "temporal.server.api.history.v1.VersionHistory")
+	proto.RegisterType((*VersionHistories)(nil), "temporal.server.api.history.v1.VersionHistories")
+	proto.RegisterType((*ContendedWorkflow)(nil), "temporal.server.api.history.v1.ContendedWorkflow")
+	proto.RegisterType((*PendingExecutionDetails)(nil), "temporal.server.api.history.v1.PendingExecutionDetails")
+	proto.RegisterType((*PendingWorkflowTaskDetails)(nil), "temporal.server.api.history.v1.PendingWorkflowTaskDetails")
+	proto.RegisterType((*PendingTimerDetails)(nil), "temporal.server.api.history.v1.PendingTimerDetails")
+	proto.RegisterType((*PendingChildDetails)(nil), "temporal.server.api.history.v1.PendingChildDetails")
+	proto.RegisterType((*PendingCancelRequestDetails)(nil), "temporal.server.api.history.v1.PendingCancelRequestDetails")
+	proto.RegisterType((*PendingSignalExternalDetails)(nil), "temporal.server.api.history.v1.PendingSignalExternalDetails")
 }
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func init() {
+	proto.RegisterFile("temporal/server/api/history/v1/message.proto", fileDescriptor_670cd05c700ece14)
 }
-func (this *TransientWorkflowTaskInfo) String() string {
-	if this == nil {
-		return "nil"
+
+var fileDescriptor_670cd05c700ece14 = []byte{
+	// 1128 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0xdb, 0xb6,
+	0x1b, 0xb6, 0xec, 0x38, 0x8e, 0x5f, 0xe7, 0x4f, 0xc3, 0xfc, 0x8a, 0x3a, 0xf9, 0x35, 0x4a, 0x6b,
+	0xac, 0x40, 0x30, 0x04, 0x32, 0x9a, 0x9e, 0xd6, 0x6e, 0x03, 0x96, 0x34, 0x5b, 0x3d, 0x0c, 0x5d,
+	0xa1, 0x1a, 0x6d, 0xb1, 0x8b, 0xc0, 0x48, 0x8c, 0x43, 0x44, 0xa6, 0x34, 0x92, 0x4e, 0x9c, 0xc3,
+	0x80, 0x6d, 0x1f, 0x60, 0xe8, 0x71, 0xf7, 0x5d, 0x76, 0xdb, 0x71, 0xc0, 0x3e, 0xc1, 0x80, 0x5d,
+	0x72, 0xec, 0x6d, 0x8b, 0x73, 0xd9, 0xb1, 0x1f, 0x61, 0x20, 0x45, 0xca, 0xb1, 0x93, 0xa6, 0xce,
+	0x4d, 0x7c, 0xf9, 0x3e, 0x8f, 0x1e, 0xbd, 0x7c, 0x9f, 0x97, 0x82, 0x0d, 0x49, 0xba, 0x69, 0xc2,
+	0x71, 0xdc, 0x14, 0x84, 0x1f, 0x12, 0xde, 0xc4, 0x29, 0x6d, 0xee, 0x53, 0x21, 0x13, 0x7e, 0xdc,
+	0x3c, 0xbc, 0xdf, 0xec, 0x12, 0x21, 0x70, 0x87, 0x78, 0x29, 0x4f, 0x64, 0x82, 0x5c, 0x9b, 0xed,
+	0x65, 0xd9, 0x1e, 0x4e, 0xa9, 0x67, 0xb2, 0xbd, 0xc3, 0xfb, 0x2b, 0x6e, 0x27, 0x49, 0x3a, 0x31,
+	0x69, 0xea, 0xec, 0xdd, 0xde, 0x5e, 0x33, 0xea, 0x71, 0x2c, 0x69, 0xc2, 0x32, 0xfc, 0xca, 0xda,
+	0xf8, 0xbe, 0xa4, 0x5d, 0x22, 0x24, 0xee, 0xa6, 0x26, 0xe1, 0x6e, 0x44, 0x52, 0xc2, 0x22, 0xc2,
+	0x42, 0x4a, 0x44, 0xb3, 0x93, 0x74, 0x12, 0x1d, 0xd7, 0x4f, 0x26, 0xe5, 0x83, 0x5c, 0xb1, 0x92,
+	0x4a, 0x58, 0xaf, 0x2b, 0x94, 0xd0, 0xa3, 0x84, 0x1f, 0xec, 0xc5, 0xc9, 0x91, 0xc9, 0xba, 0x37,
+	0x92, 0xf5, 0xae, 0x0f, 0x6a, 0xfc, 0xee, 0xc0, 0x72, 0x9b, 0x63, 0x26, 0x28, 0x61, 0xf2, 0xa5,
+	0xa1, 0x68, 0x63, 0x71, 0xd0, 0x62, 0x7b, 0x09, 0x7a, 0x0a, 0x0b, 0x22, 0xdc, 0x27, 0x51, 0x2f,
+	0x26, 0x51, 0x40, 0x0e, 0x09, 0x93, 0x75, 0xe7, 0x8e, 0xb3, 0x5e, 0xdb, 0xbc, 0xe7, 0xe5, 0x85,
+	0x18, 0xad, 0x80, 0xf7, 0x24, 0x7b, 0xdc, 0x51, 0xc9, 0xfe, 0x7c, 0x8e, 0xd6, 0x6b, 0xf4, 0x25,
+	0xcc, 0x09, 0x89, 0xb9, 0xcc, 0xd9, 0x8a, 0xd7, 0x61, 0x9b, 0x35, 0x58, 0xbd, 0x6a, 0xb4, 0x00,
+	0xbd, 0x20, 0x5c, 0xd0, 0x84, 0x99, 0xa4, 0x96, 0x24, 0x5d, 0xb4, 0x0c, 0x33, 0x9a, 0x39, 0xa0,
+	0x91, 0x96, 0x5a, 0xf2, 0x2b, 0x7a, 0xdd, 0x8a, 0x50, 0x1d, 0x2a, 0x87, 0x19, 0x40, 0xbf, 0xb6,
+	0xe4, 0xdb, 0x65, 0xe3, 0x3b, 0x98, 0x1f, 0xa5, 0x42, 0x77, 0x61, 0x76, 0x97, 0x63, 0x16, 0xee,
+	0x07, 0x32, 0x39, 0x20, 0x4c, 0x53, 0xcd, 0xfa, 0xb5, 0x2c, 0xd6, 0x56, 0x21, 0xf4, 0x04, 0xca,
+	0x54, 0x92, 0xae, 0xa8, 0x17, 0xef, 0x94, 0xd6, 0x6b, 0x9b, 0x9b, 0xde, 0xd5, 0xad, 0xe1, 0x5d,
+	0x14, 0xeb, 0x67, 0x04, 0x8d, 0x5f, 0x1c, 0xb8, 0x31, 0xb2, 0x4b, 0x89, 0x40, 0x9f, 0xc1, 0x6a,
+	0xd8, 0xe3, 0x5c, 0x7d, 0x8a, 0x91, 0x19, 0x18, 0xb2, 0x80, 0xb2, 0x88, 0xf4, 0xb5, 0xa4, 0xb2,
+	0xbf, 0x62, 0x92, 0xc6, 0xd8, 0x55, 0x06, 0xfa, 0x0a, 0xaa, 0xfb, 0x96, 0xcf, 0xa8, 0xf4, 0xae,
+	0xa7, 0xd2, 0x1f, 0x12, 0x34, 0xfe, 0x28, 0xc2, 0xe2, 0x76, 0xc2, 0xa4, 0xea, 0xce, 0xc8, 0x76,
+	0x8a, 0x2a, 0x14, 0xc3, 0x5d, 0x22, 0x52, 0x1c, 0x12, 0x5b, 0xf3, 0xaa, 0x5f, 0xcb, 0x63, 0xad,
+	0x08, 0xad, 0x41, 0xcd, 0xf6, 0xa6, 0xca, 0x28, 0xea, 0x0c, 0xb0, 0xa1, 0x56, 0x84, 0x56, 0x01,
+	0x8e, 0x30, 0x95, 0x41, 0x98, 0xf4, 0x98, 0xac, 0x97, 0xf4, 0xd9, 0x54, 0x55, 0x64, 0x5b, 0x05,
+	0xd0, 0xa7, 0x00, 0x32, 0x91, 0x38, 0x0e, 0x54, 0xa8, 0x3e, 0xa5, 0x3b, 0x66, 0xd9, 0xcb, 0x8c,
+	0xe4, 0x59, 0x23, 0x79, 0x8f, 0x8d, 0xd1, 0xb6, 0xa6, 0x7e, 0xfe, 0x7b, 0xcd, 0xf1, 0xab, 0x1a,
+	0xf2, 0x12, 0x53, 0x89, 0x1e, 0xc2, 0x4c, 0x17, 0xf7, 0x33, 0x74, 0x79, 0x32, 0x74, 0xa5, 0x8b,
+	0xfb, 0x1a, 0xfb, 0x39, 0xcc, 0xc7, 0x58, 0x48, 0x0d, 0x0e, 0x94, 0x57, 0xeb, 0xd3, 0x9a, 0x61,
+	0xe5, 0x02, 0x43, 0xdb, 0x1a, 0x79, 0x6b, 0xea, 0xb5, 0xa2, 0x98, 0x55, 0x38, 0xc5, 0xa1, 0x36,
+	0x1a, 0x3f, 0x4e, 0xc1, 0xad, 0x67, 0x84, 0x45, 0x94, 0x75, 0x76, 0xfa, 0x24, 0xec, 0xa9, 0x77,
+	0x3d, 0x26, 0x12, 0xd3, 0x58, 0xa0, 0x00, 0xe6, 0xf2, 0xfa, 0x48, 0x2c, 0x0e, 0x8c, 0xc5, 0x1e,
+	0xbe, 0xef, 0xa8, 0x0c, 0xdf, 0x79, 0xd3, 0x1a, 0x4a, 0x7f, 0xf6, 0xe8, 0x5c, 0x50, 0x9d, 0x51,
+	0x56, 0x40, 0xf5, 0x01, 0x5c, 0xe8, 0x13, 0x28, 0xfb, 0x35, 0x1d, 0x6b, 0xeb, 0x10, 0x6a, 0x43,
+	0x8d, 0x91, 0xbe, 0xb4, 0x19, 0x25, 0xdd, 0x2c, 0x0f, 0x26, 0x54, 0xa0, 0x39, 0xec, 0xab, 0x41,
+	0xf1, 0x18, 0xd6, 0xaf, 0x61, 0x26, 0xdc, 0xa7, 0x71, 0xc4, 0x09, 0xab, 0x4f, 0x5d, 0x8b, 0x72,
+	0x5b, 0xc1, 0x2c, 0x65, 0x4e, 0x82, 0x22, 0x58, 0x08, 0x31, 0x0b, 0x49, 0x1c, 0x70, 0xf2, 0x6d,
+	0x8f, 0x08, 0x29, 0xea, 0x65, 0xcd, 0xfb, 0x68, 0x52, 0x5e, 0x8d, 0xf6, 0x33, 0xb0, 0xe5, 0x9f,
+	0x0f, 0xcf, 0x47, 0x05, 0x7a, 0x01, 0x15, 0x41, 0x3b, 0x0c, 0xc7, 0xa2, 0x3e, 0xad, 0xd9, 0x3f,
+	0x9e, 0x90, 0xfd, 0xb9, 0x46, 0xed, 0xf4, 0x25, 0xe1, 0x0c, 0xc7, 0x96, 0xde, 0x92, 0x35, 0x7e,
+	0x2b, 0xc1, 0xca, 0xbb, 0x0f, 0x0d, 0xfd, 0x0f, 0xca, 0x42, 0x62, 0x49, 0x8c, 0x87, 0xb2, 0x85,
+	0x72, 0x8f, 0x1d, 0xa2, 0xd6, 0x3d, 0x25, 0x1f, 0x6c, 0x28, 0x73, 0x8f, 0x9d, 0xa9, 0x34, 0xb2,
+	0xee, 0x31, 0x91, 0x6c, 0xea, 0x61, 0xa9, 0xe4, 0x67, 0xd6, 0x29, 0xfb, 0x76, 0x89, 0x3e, 0x82,
+	0x8a, 0x3a, 0xee, 0xa4, 0x37, 0xb9, 0x2d, 0x4c, 0x3e, 0xfa, 0x02, 0x86, 0x93, 0xfd, 0x7a, 0xb6,
+	0x98, 0xcb, 0x71, 0x6a, 0x07, 0xbd, 0x82, 0x5b, 0x09, 0xa7, 0x1d, 0xca, 0x70, 0x1c, 0x8c, 0x31,
+	0x56, 0x26, 0x64, 0xbc, 0x69, 0x09, 0x9e, 0x8f, 0x30, 0x6f, 0x83, 0xbd, 0x2e, 0x32, 0xba, 0x99,
+	0x09, 0xe9, 0x6a, 0x06, 0xa5, 0x6d, 0xfb, 0x93, 0x03, 0x4b, 0x97, 0x34, 0xb9, 0xba, 0x65, 0xb4,
+	0x53, 0x86, 0x13, 0x4f, 0x97, 0x86, 0x5f, 0x38, 0x8e, 0xe2, 0xf8, 0x71, 0x7c, 0x02, 0xd5, 0x3d,
+	0xca, 0x49, 0xa6, 0xa9, 0x34, 0xa1, 0xa6, 0x19, 0x05, 0xd1, 0x82, 0xfe, 0x2a, 0xc2, 0xd2, 0x25,
+	0x16, 0x51, 0x16, 0xa7, 0x8c, 0x4a, 0x8a, 0xcd, 0x7b, 0xb3, 0xab, 0xaf, 0x96, 0xc7, 0xde, 0x2f,
+	0x2c, 0xef, 0xbe, 0xd2, 0xf9, 0xee, 0xbb, 0x0d, 0xd5, 0x7c, 0x94, 0xeb, 0xfe, 0xa9, 0xfa, 0xc3,
+	0x00, 0xda, 0x00, 0x34, 0x9c, 0x5c, 0xc7, 0x29, 0x09, 0xd4, 0x96, 0x6e, 0xa6, 0xaa, 0x7f, 0x23,
+	0x1f, 0x41, 0xc7, 0x29, 0x79, 0x8a, 0xbb, 0x64, 0xfc, 0x1e, 0x98, 0xbe, 0x70, 0x0f, 0xdc, 0x84,
+	0x69, 0xde, 0x63, 0x6a, 0xaf, 0x92, 0x69, 0xe0, 0x3d, 0xd6, 0x8a, 0xd0, 0x2b, 0x58, 0x4a, 0xb1,
+	0xbe, 0x08, 0xc3, 0x38, 0x11, 0x24, 0x48, 0x93, 0x98, 0x86, 0xc7, 0xfa, 0x40, 0xe7, 0x37, 0xd7,
+	0x47, 0x7f, 0x1d, 0xf4, 0xdf, 0x90, 0x76, 0xa4, 0x46, 0x6c, 0x2b, 0xc0, 0x33, 0x9d, 0xef, 0x2f,
+	0xa6, 0xe3, 0xa1, 0x46, 0x0c, 0xff, 0xbf, 0x62, 0x2e, 0x4c, 0x52, 0xd4, 0x0f, 0x61, 0x71, 0x74,
+	0x20, 0x0d, 0x6f, 0xb8, 0x85, 0x91, 0xa9, 0xd2, 0x8a, 0x1a, 0x3f, 0x38, 0x70, 0xfb, 0xaa, 0x41,
+	0x31, 0xc9, 0xfb, 0xd4, 0x34, 0xd0, 0xd8, 0xac, 0xd4, 0xe6, 0x2e, 0xcd, 0x42, 0xba, 0xc8, 0xab,
+	0x00, 0xe7, 0x94, 0x64, 0x67, 0x59, 0xe5, 0x56, 0xc3, 0xd6, 0xee, 0xc9, 0xa9, 0x5b, 0x78, 0x73,
+	0xea, 0x16, 0xde, 0x9e, 0xba, 0xce, 0xf7, 0x03, 0xd7, 0xf9, 0x75, 0xe0, 0x3a, 0x7f, 0x0e, 0x5c,
+	0xe7, 0x64, 0xe0, 0x3a, 0xff, 0x0c, 0x5c, 0xe7, 0xdf, 0x81, 0x5b, 0x78, 0x3b, 0x70, 0x9d, 0xd7,
+	0x67, 0x6e, 0xe1, 0xe4, 0xcc, 0x2d, 0xbc, 0x39, 0x73, 0x0b, 0xdf, 0x6c, 0x74, 0x92, 0x61, 0x99,
+	0x69, 0x72, 0xf9, 0x9f, 0xf2, 0x23, 0xf3, 0xb8, 0x3b, 0xad, 0xfb, 0xf8, 0xc1, 0x7f, 0x03, 0x00,
+	0xc9, 0xba, 0x1a, 0xab, 0x5a, 0x0b, 0x00, 0x00,
+}
+
+func (this *TransientWorkflowTaskInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&TransientWorkflowTaskInfo{`,
-		`ScheduledEvent:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledEvent), "HistoryEvent", "v1.HistoryEvent", 1) + `,`,
-		`StartedEvent:` + strings.Replace(fmt.Sprintf("%v", this.StartedEvent), "HistoryEvent", "v1.HistoryEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*TransientWorkflowTaskInfo)
+	if !ok {
+		that2, ok := that.(TransientWorkflowTaskInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ScheduledEvent.Equal(that1.ScheduledEvent) {
+		return false
+	}
+	if !this.StartedEvent.Equal(that1.StartedEvent) {
+		return false
+	}
+	return true
 }
-func (this *VersionHistoryItem) String() string {
-	if this == nil {
-		return "nil"
+func (this *VersionHistoryItem) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&VersionHistoryItem{`,
-		`EventId:` + fmt.Sprintf("%v", this.EventId) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*VersionHistoryItem)
+	if !ok {
+		that2, ok := that.(VersionHistoryItem)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EventId != that1.EventId {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
 }
-func (this *VersionHistory) String() string {
-	if this == nil {
-		return "nil"
+func (this *VersionHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	repeatedStringForItems := "[]*VersionHistoryItem{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(f.String(), "VersionHistoryItem", "VersionHistoryItem", 1) + ","
+
+	that1, ok := that.(*VersionHistory)
+	if !ok {
+		that2, ok := that.(VersionHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&VersionHistory{`,
-		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
+		return false
+	}
+	if len(this.Items) != len(that1.Items) {
+		return false
+	}
+	for i := range this.Items {
+		if !this.Items[i].Equal(that1.Items[i]) {
+			return false
+		}
+	}
+	return true
 }
-func (this *VersionHistories) String() string {
-	if this == nil {
-		return "nil"
+func (this *VersionHistories) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	repeatedStringForHistories := "[]*VersionHistory{"
-	for _, f := range this.Histories {
-		repeatedStringForHistories += strings.Replace(f.String(), "VersionHistory", "VersionHistory", 1) + ","
+
+	that1, ok := that.(*VersionHistories)
+	if !ok {
+		that2, ok := that.(VersionHistories)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	repeatedStringForHistories += "}"
-	s := strings.Join([]string{`&VersionHistories{`,
-		`CurrentVersionHistoryIndex:` + fmt.Sprintf("%v", this.CurrentVersionHistoryIndex) + `,`,
-		`Histories:` + repeatedStringForHistories + `,`,
-		`}`,
-	}, "")
-	return s
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CurrentVersionHistoryIndex != that1.CurrentVersionHistoryIndex {
+		return false
+	}
+	if len(this.Histories) != len(that1.Histories) {
+		return false
+	}
+	for i := range this.Histories {
+		if !this.Histories[i].Equal(that1.Histories[i]) {
+			return false
+		}
+	}
+	return true
 }
-func valueToStringMessage(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (this *ContendedWorkflow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContendedWorkflow)
+	if !ok {
+		that2, ok := that.(ContendedWorkflow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.WaitCount != that1.WaitCount {
+		return false
+	}
+	if this.TotalWait != nil && that1.TotalWait != nil {
+		if *this.TotalWait != *that1.TotalWait {
+			return false
+		}
+	} else if this.TotalWait != nil {
+		return false
+	} else if that1.TotalWait != nil {
+		return false
+	}
+	if this.MaxWait != nil && that1.MaxWait != nil {
+		if *this.MaxWait != *that1.MaxWait {
+			return false
+		}
+	} else if this.MaxWait != nil {
+		return false
+	} else if that1.MaxWait != nil {
+		return false
+	}
+	if that1.LastWaitTime == nil {
+		if this.LastWaitTime != nil {
+			return false
+		}
+	} else if !this.LastWaitTime.Equal(*that1.LastWaitTime) {
+		return false
+	}
+	return true
+}
+func (this *PendingExecutionDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingExecutionDetails)
+	if !ok {
+		that2, ok := that.(PendingExecutionDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.WorkflowTask.Equal(that1.WorkflowTask) {
+		return false
+	}
+	if this.TotalTimers != that1.TotalTimers {
+		return false
+	}
+	if len(this.NextTimers) != len(that1.NextTimers) {
+		return false
+	}
+	for i := range this.NextTimers {
+		if !this.NextTimers[i].Equal(that1.NextTimers[i]) {
+			return false
+		}
+	}
+	if len(this.Children) != len(that1.Children) {
+		return false
+	}
+	for i := range this.Children {
+		if !this.Children[i].Equal(that1.Children[i]) {
+			return false
+		}
+	}
+	if len(this.CancelRequests) != len(that1.CancelRequests) {
+		return false
+	}
+	for i := range this.CancelRequests {
+		if !this.CancelRequests[i].Equal(that1.CancelRequests[i]) {
+			return false
+		}
+	}
+	if len(this.Signals) != len(that1.Signals) {
+		return false
+	}
+	for i := range this.Signals {
+		if !this.Signals[i].Equal(that1.Signals[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PendingWorkflowTaskDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingWorkflowTaskDetails)
+	if !ok {
+		that2, ok := that.(PendingWorkflowTaskDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.ScheduleId != that1.ScheduleId {
+		return false
+	}
+	if this.StartedId != that1.StartedId {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.Timeout != nil && that1.Timeout != nil {
+		if *this.Timeout != *that1.Timeout {
+			return false
+		}
+	} else if this.Timeout != nil {
+		return false
+	} else if that1.Timeout != nil {
+		return false
+	}
+	if that1.ScheduledTime == nil {
+		if this.ScheduledTime != nil {
+			return false
+		}
+	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
+		return false
+	}
+	if that1.OriginalScheduledTime == nil {
+		if this.OriginalScheduledTime != nil {
+			return false
+		}
+	} else if !this.OriginalScheduledTime.Equal(*that1.OriginalScheduledTime) {
+		return false
+	}
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
+		}
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+		return false
+	}
+	return true
+}
+func (this *PendingTimerDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingTimerDetails)
+	if !ok {
+		that2, ok := that.(PendingTimerDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TimerId != that1.TimerId {
+		return false
+	}
+	if this.StartedId != that1.StartedId {
+		return false
+	}
+	if that1.FireTime == nil {
+		if this.FireTime != nil {
+			return false
+		}
+	} else if !this.FireTime.Equal(*that1.FireTime) {
+		return false
+	}
+	return true
+}
+func (this *PendingChildDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingChildDetails)
+	if !ok {
+		that2, ok := that.(PendingChildDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InitiatedId != that1.InitiatedId {
+		return false
+	}
+	if this.StartedId != that1.StartedId {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if this.WorkflowTypeName != that1.WorkflowTypeName {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.ParentClosePolicy != that1.ParentClosePolicy {
+		return false
+	}
+	return true
+}
+func (this *PendingCancelRequestDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingCancelRequestDetails)
+	if !ok {
+		that2, ok := that.(PendingCancelRequestDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InitiatedId != that1.InitiatedId {
+		return false
+	}
+	if this.CancelRequestId != that1.CancelRequestId {
+		return false
+	}
+	return true
+}
+func (this *PendingSignalExternalDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingSignalExternalDetails)
+	if !ok {
+		that2, ok := that.(PendingSignalExternalDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InitiatedId != that1.InitiatedId {
+		return false
+	}
+	if this.SignalName != that1.SignalName {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	return true
+}
+func (this *TransientWorkflowTaskInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&history.TransientWorkflowTaskInfo{")
+	if this.ScheduledEvent != nil {
+		s = append(s, "ScheduledEvent: "+fmt.Sprintf("%#v", this.ScheduledEvent)+",\n")
+	}
+	if this.StartedEvent != nil {
+		s = append(s, "StartedEvent: "+fmt.Sprintf("%#v", this.StartedEvent)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VersionHistoryItem) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&history.VersionHistoryItem{")
+	s = append(s, "EventId: "+fmt.Sprintf("%#v", this.EventId)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VersionHistory) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&history.VersionHistory{")
+	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
+	if this.Items != nil {
+		s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VersionHistories) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&history.VersionHistories{")
+	s = append(s, "CurrentVersionHistoryIndex: "+fmt.Sprintf("%#v", this.CurrentVersionHistoryIndex)+",\n")
+	if this.Histories != nil {
+		s = append(s, "Histories: "+fmt.Sprintf("%#v", this.Histories)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ContendedWorkflow) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&history.ContendedWorkflow{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "WaitCount: "+fmt.Sprintf("%#v", this.WaitCount)+",\n")
+	s = append(s, "TotalWait: "+fmt.Sprintf("%#v", this.TotalWait)+",\n")
+	s = append(s, "MaxWait: "+fmt.Sprintf("%#v", this.MaxWait)+",\n")
+	s = append(s, "LastWaitTime: "+fmt.Sprintf("%#v", this.LastWaitTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PendingExecutionDetails) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&history.PendingExecutionDetails{")
+	if this.WorkflowTask != nil {
+		s = append(s, "WorkflowTask: "+fmt.Sprintf("%#v", this.WorkflowTask)+",\n")
+	}
+	s = append(s, "TotalTimers: "+fmt.Sprintf("%#v", this.TotalTimers)+",\n")
+	if this.NextTimers != nil {
+		s = append(s, "NextTimers: "+fmt.Sprintf("%#v", this.NextTimers)+",\n")
+	}
+	if this.Children != nil {
+		s = append(s, "Children: "+fmt.Sprintf("%#v", this.Children)+",\n")
+	}
+	if this.CancelRequests != nil {
+		s = append(s, "CancelRequests: "+fmt.Sprintf("%#v", this.CancelRequests)+",\n")
+	}
+	if this.Signals != nil {
+		s = append(s, "Signals: "+fmt.Sprintf("%#v", this.Signals)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PendingWorkflowTaskDetails) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&history.PendingWorkflowTaskDetails{")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "StartedId: "+fmt.Sprintf("%#v", this.StartedId)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "Timeout: "+fmt.Sprintf("%#v", this.Timeout)+",\n")
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "OriginalScheduledTime: "+fmt.Sprintf("%#v", this.OriginalScheduledTime)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PendingTimerDetails) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&history.PendingTimerDetails{")
+	s = append(s, "TimerId: "+fmt.Sprintf("%#v", this.TimerId)+",\n")
+	s = append(s, "StartedId: "+fmt.Sprintf("%#v", this.StartedId)+",\n")
+	s = append(s, "FireTime: "+fmt.Sprintf("%#v", this.FireTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PendingChildDetails) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&history.PendingChildDetails{")
+	s = append(s, "InitiatedId: "+fmt.Sprintf("%#v", this.InitiatedId)+",\n")
+	s = append(s, "StartedId: "+fmt.Sprintf("%#v", this.StartedId)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "WorkflowTypeName: "+fmt.Sprintf("%#v", this.WorkflowTypeName)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "ParentClosePolicy: "+fmt.Sprintf("%#v", this.ParentClosePolicy)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PendingCancelRequestDetails) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&history.PendingCancelRequestDetails{")
+	s = append(s, "InitiatedId: "+fmt.Sprintf("%#v", this.InitiatedId)+",\n")
+	s = append(s, "CancelRequestId: "+fmt.Sprintf("%#v", this.CancelRequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PendingSignalExternalDetails) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&history.PendingSignalExternalDetails{")
+	s = append(s, "InitiatedId: "+fmt.Sprintf("%#v", this.InitiatedId)+",\n")
+	s = append(s, "SignalName: "+fmt.Sprintf("%#v", this.SignalName)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TransientWorkflowTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransientWorkflowTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransientWorkflowTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartedEvent != nil {
+		{
+			size, err := m.StartedEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduledEvent != nil {
+		{
+			size, err := m.ScheduledEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionHistoryItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionHistoryItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionHistoryItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.EventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionHistories) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionHistories) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionHistories) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Histories) > 0 {
+		for iNdEx := len(m.Histories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Histories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentVersionHistoryIndex != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.CurrentVersionHistoryIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContendedWorkflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContendedWorkflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContendedWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastWaitTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastWaitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastWaitTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMessage(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxWait != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxWait, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxWait):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintMessage(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalWait != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.TotalWait, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TotalWait):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.WaitCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.WaitCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingExecutionDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingExecutionDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingExecutionDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CancelRequests) > 0 {
+		for iNdEx := len(m.CancelRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CancelRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NextTimers) > 0 {
+		for iNdEx := len(m.NextTimers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NextTimers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TotalTimers != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.TotalTimers))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WorkflowTask != nil {
+		{
+			size, err := m.WorkflowTask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingWorkflowTaskDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingWorkflowTaskDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingWorkflowTaskDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartedTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintMessage(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.OriginalScheduledTime != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.OriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.OriginalScheduledTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintMessage(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ScheduledTime != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintMessage(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Timeout != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintMessage(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartedId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.StartedId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTimerDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTimerDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTimerDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FireTime != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FireTime):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintMessage(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartedId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.StartedId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TimerId) > 0 {
+		i -= len(m.TimerId)
+		copy(dAtA[i:], m.TimerId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TimerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingChildDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingChildDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingChildDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParentClosePolicy != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ParentClosePolicy))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.WorkflowTypeName) > 0 {
+		i -= len(m.WorkflowTypeName)
+		copy(dAtA[i:], m.WorkflowTypeName)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.WorkflowTypeName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartedId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.StartedId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InitiatedId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.InitiatedId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingCancelRequestDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCancelRequestDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCancelRequestDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelRequestId) > 0 {
+		i -= len(m.CancelRequestId)
+		copy(dAtA[i:], m.CancelRequestId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CancelRequestId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InitiatedId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.InitiatedId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSignalExternalDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSignalExternalDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSignalExternalDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InitiatedId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.InitiatedId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransientWorkflowTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledEvent != nil {
+		l = m.ScheduledEvent.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartedEvent != nil {
+		l = m.StartedEvent.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *VersionHistoryItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventId != 0 {
+		n += 1 + sovMessage(uint64(m.EventId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMessage(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *VersionHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BranchToken)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VersionHistories) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentVersionHistoryIndex != 0 {
+		n += 1 + sovMessage(uint64(m.CurrentVersionHistoryIndex))
+	}
+	if len(m.Histories) > 0 {
+		for _, e := range m.Histories {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContendedWorkflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.WaitCount != 0 {
+		n += 1 + sovMessage(uint64(m.WaitCount))
+	}
+	if m.TotalWait != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.TotalWait)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.MaxWait != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxWait)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.LastWaitTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastWaitTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingExecutionDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowTask != nil {
+		l = m.WorkflowTask.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.TotalTimers != 0 {
+		n += 1 + sovMessage(uint64(m.TotalTimers))
+	}
+	if len(m.NextTimers) > 0 {
+		for _, e := range m.NextTimers {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.CancelRequests) > 0 {
+		for _, e := range m.CancelRequests {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingWorkflowTaskDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovMessage(uint64(m.ScheduleId))
+	}
+	if m.StartedId != 0 {
+		n += 1 + sovMessage(uint64(m.StartedId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovMessage(uint64(m.Attempt))
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.OriginalScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.OriginalScheduledTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingTimerDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TimerId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartedId != 0 {
+		n += 1 + sovMessage(uint64(m.StartedId))
+	}
+	if m.FireTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FireTime)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingChildDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitiatedId != 0 {
+		n += 1 + sovMessage(uint64(m.InitiatedId))
+	}
+	if m.StartedId != 0 {
+		n += 1 + sovMessage(uint64(m.StartedId))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowTypeName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.ParentClosePolicy != 0 {
+		n += 1 + sovMessage(uint64(m.ParentClosePolicy))
+	}
+	return n
+}
+
+func (m *PendingCancelRequestDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitiatedId != 0 {
+		n += 1 + sovMessage(uint64(m.InitiatedId))
+	}
+	l = len(m.CancelRequestId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingSignalExternalDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitiatedId != 0 {
+		n += 1 + sovMessage(uint64(m.InitiatedId))
+	}
+	l = len(m.SignalName)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TransientWorkflowTaskInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransientWorkflowTaskInfo{`,
+		`ScheduledEvent:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledEvent), "HistoryEvent", "v1.HistoryEvent", 1) + `,`,
+		`StartedEvent:` + strings.Replace(fmt.Sprintf("%v", this.StartedEvent), "HistoryEvent", "v1.HistoryEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionHistoryItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionHistoryItem{`,
+		`EventId:` + fmt.Sprintf("%v", this.EventId) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]*VersionHistoryItem{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(f.String(), "VersionHistoryItem", "VersionHistoryItem", 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&VersionHistory{`,
+		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionHistories) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistories := "[]*VersionHistory{"
+	for _, f := range this.Histories {
+		repeatedStringForHistories += strings.Replace(f.String(), "VersionHistory", "VersionHistory", 1) + ","
+	}
+	repeatedStringForHistories += "}"
+	s := strings.Join([]string{`&VersionHistories{`,
+		`CurrentVersionHistoryIndex:` + fmt.Sprintf("%v", this.CurrentVersionHistoryIndex) + `,`,
+		`Histories:` + repeatedStringForHistories + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContendedWorkflow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContendedWorkflow{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`WaitCount:` + fmt.Sprintf("%v", this.WaitCount) + `,`,
+		`TotalWait:` + strings.Replace(fmt.Sprintf("%v", this.TotalWait), "Duration", "types.Duration", 1) + `,`,
+		`MaxWait:` + strings.Replace(fmt.Sprintf("%v", this.MaxWait), "Duration", "types.Duration", 1) + `,`,
+		`LastWaitTime:` + strings.Replace(fmt.Sprintf("%v", this.LastWaitTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingExecutionDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNextTimers := "[]*PendingTimerDetails{"
+	for _, f := range this.NextTimers {
+		repeatedStringForNextTimers += strings.Replace(f.String(), "PendingTimerDetails", "PendingTimerDetails", 1) + ","
+	}
+	repeatedStringForNextTimers += "}"
+	repeatedStringForChildren := "[]*PendingChildDetails{"
+	for _, f := range this.Children {
+		repeatedStringForChildren += strings.Replace(f.String(), "PendingChildDetails", "PendingChildDetails", 1) + ","
+	}
+	repeatedStringForChildren += "}"
+	repeatedStringForCancelRequests := "[]*PendingCancelRequestDetails{"
+	for _, f := range this.CancelRequests {
+		repeatedStringForCancelRequests += strings.Replace(f.String(), "PendingCancelRequestDetails", "PendingCancelRequestDetails", 1) + ","
+	}
+	repeatedStringForCancelRequests += "}"
+	repeatedStringForSignals := "[]*PendingSignalExternalDetails{"
+	for _, f := range this.Signals {
+		repeatedStringForSignals += strings.Replace(f.String(), "PendingSignalExternalDetails", "PendingSignalExternalDetails", 1) + ","
+	}
+	repeatedStringForSignals += "}"
+	s := strings.Join([]string{`&PendingExecutionDetails{`,
+		`WorkflowTask:` + strings.Replace(this.WorkflowTask.String(), "PendingWorkflowTaskDetails", "PendingWorkflowTaskDetails", 1) + `,`,
+		`TotalTimers:` + fmt.Sprintf("%v", this.TotalTimers) + `,`,
+		`NextTimers:` + repeatedStringForNextTimers + `,`,
+		`Children:` + repeatedStringForChildren + `,`,
+		`CancelRequests:` + repeatedStringForCancelRequests + `,`,
+		`Signals:` + repeatedStringForSignals + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingWorkflowTaskDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingWorkflowTaskDetails{`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "types.Duration", 1) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`OriginalScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.OriginalScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingTimerDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingTimerDetails{`,
+		`TimerId:` + fmt.Sprintf("%v", this.TimerId) + `,`,
+		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
+		`FireTime:` + strings.Replace(fmt.Sprintf("%v", this.FireTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingChildDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingChildDetails{`,
+		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
+		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`WorkflowTypeName:` + fmt.Sprintf("%v", this.WorkflowTypeName) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`ParentClosePolicy:` + fmt.Sprintf("%v", this.ParentClosePolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingCancelRequestDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingCancelRequestDetails{`,
+		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
+		`CancelRequestId:` + fmt.Sprintf("%v", this.CancelRequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingSignalExternalDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingSignalExternalDetails{`,
+		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
+		`SignalName:` + fmt.Sprintf("%v", this.SignalName) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TransientWorkflowTaskInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransientWorkflowTaskInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransientWorkflowTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledEvent == nil {
+				m.ScheduledEvent = &v1.HistoryEvent{}
+			}
+			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedEvent == nil {
+				m.StartedEvent = &v1.HistoryEvent{}
+			}
+			if err := m.StartedEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionHistoryItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionHistoryItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+			}
+			m.EventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &VersionHistoryItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionHistories) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionHistories: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionHistories: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVersionHistoryIndex", wireType)
+			}
+			m.CurrentVersionHistoryIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentVersionHistoryIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Histories = append(m.Histories, &VersionHistory{})
+			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContendedWorkflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContendedWorkflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContendedWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitCount", wireType)
+			}
+			m.WaitCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWait", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalWait == nil {
+				m.TotalWait = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.TotalWait, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWait", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxWait == nil {
+				m.MaxWait = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxWait, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWaitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastWaitTime == nil {
+				m.LastWaitTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastWaitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingExecutionDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingExecutionDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingExecutionDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowTask == nil {
+				m.WorkflowTask = &PendingWorkflowTaskDetails{}
+			}
+			if err := m.WorkflowTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTimers", wireType)
+			}
+			m.TotalTimers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTimers |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTimers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextTimers = append(m.NextTimers, &PendingTimerDetails{})
+			if err := m.NextTimers[len(m.NextTimers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &PendingChildDetails{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelRequests = append(m.CancelRequests, &PendingCancelRequestDetails{})
+			if err := m.CancelRequests[len(m.CancelRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signals = append(m.Signals, &PendingSignalExternalDetails{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingWorkflowTaskDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingWorkflowTaskDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingWorkflowTaskDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+			}
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OriginalScheduledTime == nil {
+				m.OriginalScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.OriginalScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *TransientWorkflowTaskInfo) Unmarshal(dAtA []byte) error {
+func (m *PendingTimerDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -815,17 +3818,17 @@ func (m *TransientWorkflowTaskInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransientWorkflowTaskInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingTimerDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransientWorkflowTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingTimerDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -835,31 +3838,46 @@ func (m *TransientWorkflowTaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledEvent == nil {
-				m.ScheduledEvent = &v1.HistoryEvent{}
-			}
-			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TimerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+			}
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FireTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -886,10 +3904,10 @@ func (m *TransientWorkflowTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedEvent == nil {
-				m.StartedEvent = &v1.HistoryEvent{}
+			if m.FireTime == nil {
+				m.FireTime = new(time.Time)
 			}
-			if err := m.StartedEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FireTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -917,7 +3935,7 @@ func (m *TransientWorkflowTaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
+func (m *PendingChildDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -940,17 +3958,17 @@ func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionHistoryItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingChildDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionHistoryItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingChildDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
 			}
-			m.EventId = 0
+			m.InitiatedId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -960,16 +3978,16 @@ func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventId |= int64(b&0x7F) << shift
+				m.InitiatedId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
 			}
-			m.Version = 0
+			m.StartedId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -979,7 +3997,186 @@ func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentClosePolicy", wireType)
+			}
+			m.ParentClosePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentClosePolicy |= v11.ParentClosePolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1008,7 +4205,7 @@ func (m *VersionHistoryItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionHistory) Unmarshal(dAtA []byte) error {
+func (m *PendingCancelRequestDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1031,17 +4228,17 @@ func (m *VersionHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingCancelRequestDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingCancelRequestDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
 			}
-			var byteLen int
+			m.InitiatedId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1051,31 +4248,16 @@ func (m *VersionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.InitiatedId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequestId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1085,25 +4267,23 @@ func (m *VersionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &VersionHistoryItem{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CancelRequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1129,7 +4309,7 @@ func (m *VersionHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionHistories) Unmarshal(dAtA []byte) error {
+func (m *PendingSignalExternalDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1152,17 +4332,17 @@ func (m *VersionHistories) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionHistories: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSignalExternalDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionHistories: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSignalExternalDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVersionHistoryIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
 			}
-			m.CurrentVersionHistoryIndex = 0
+			m.InitiatedId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1172,16 +4352,16 @@ func (m *VersionHistories) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentVersionHistoryIndex |= int32(b&0x7F) << shift
+				m.InitiatedId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -1191,25 +4371,55 @@ func (m *VersionHistories) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Histories = append(m.Histories, &VersionHistory{})
-			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SignalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29,6 +29,7 @@ package historyservice
 
 import (
 	bytes "bytes"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -45,17 +46,18 @@ import (
 	v14 "go.temporal.io/api/common/v1"
 	v12 "go.temporal.io/api/enums/v1"
 	v13 "go.temporal.io/api/failure/v1"
-	v19 "go.temporal.io/api/history/v1"
-	v18 "go.temporal.io/api/query/v1"
+	v110 "go.temporal.io/api/history/v1"
+	v19 "go.temporal.io/api/query/v1"
 	v15 "go.temporal.io/api/taskqueue/v1"
-	v110 "go.temporal.io/api/workflow/v1"
+	v111 "go.temporal.io/api/workflow/v1"
 	v1 "go.temporal.io/api/workflowservice/v1"
 	v114 "go.temporal.io/server/api/adminservice/v1"
 	v16 "go.temporal.io/server/api/enums/v1"
 	v17 "go.temporal.io/server/api/history/v1"
 	v112 "go.temporal.io/server/api/namespace/v1"
-	v111 "go.temporal.io/server/api/persistence/v1"
+	v18 "go.temporal.io/server/api/persistence/v1"
 	v113 "go.temporal.io/server/api/replication/v1"
+	v115 "go.temporal.io/server/api/searchattributesservice/v1"
 	v11 "go.temporal.io/server/api/workflow/v1"
 )
 
@@ -304,6 +306,8 @@ type GetMutableStateResponse struct {
 	WorkflowStatus                        v12.WorkflowExecutionStatus `protobuf:"varint,16,opt,name=workflow_status,json=workflowStatus,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"workflow_status,omitempty"`
 	VersionHistories                      *v17.VersionHistories       `protobuf:"bytes,17,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
 	IsStickyTaskQueueEnabled              bool                        `protobuf:"varint,18,opt,name=is_sticky_task_queue_enabled,json=isStickyTaskQueueEnabled,proto3" json:"is_sticky_task_queue_enabled,omitempty"`
+	// Run which this run was reset from, empty if the run was not created by reset.
+	ResetBaseRunId string `protobuf:"bytes,19,opt,name=reset_base_run_id,json=resetBaseRunId,proto3" json:"reset_base_run_id,omitempty"`
 }
 
 func (m *GetMutableStateResponse) Reset()      { *m = GetMutableStateResponse{} }
@@ -429,6 +433,13 @@ func (m *GetMutableStateResponse) GetIsStickyTaskQueueEnabled() bool {
 	return false
 }
 
+func (m *GetMutableStateResponse) GetResetBaseRunId() string {
+	if m != nil {
+		return m.ResetBaseRunId
+	}
+	return ""
+}
+
 type PollMutableStateRequest struct {
 	NamespaceId         string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Execution           *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
@@ -723,6 +734,8 @@ type RecordWorkflowTaskStartedRequest struct {
 	// Unique id of each poll request. Used to ensure at most once delivery of tasks.
 	RequestId   string                           `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	PollRequest *v1.PollWorkflowTaskQueueRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
+	// Latency of the task measured by matching, history completes it with schedule-to-start latency.
+	LatencyBreakdown *v18.TaskLatencyBreakdown `protobuf:"bytes,7,opt,name=latency_breakdown,json=latencyBreakdown,proto3" json:"latency_breakdown,omitempty"`
 }
 
 func (m *RecordWorkflowTaskStartedRequest) Reset()      { *m = RecordWorkflowTaskStartedRequest{} }
@@ -799,6 +812,13 @@ func (m *RecordWorkflowTaskStartedRequest) GetPollRequest() *v1.PollWorkflowTask
 	return nil
 }
 
+func (m *RecordWorkflowTaskStartedRequest) GetLatencyBreakdown() *v18.TaskLatencyBreakdown {
+	if m != nil {
+		return m.LatencyBreakdown
+	}
+	return nil
+}
+
 type RecordWorkflowTaskStartedResponse struct {
 	WorkflowType               *v14.WorkflowType              `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
 	PreviousStartedEventId     int64                          `protobuf:"varint,2,opt,name=previous_started_event_id,json=previousStartedEventId,proto3" json:"previous_started_event_id,omitempty"`
@@ -812,7 +832,9 @@ type RecordWorkflowTaskStartedResponse struct {
 	BranchToken                []byte                         `protobuf:"bytes,11,opt,name=branch_token,json=branchToken,proto3" json:"branch_token,omitempty"`
 	ScheduledTime              *time.Time                     `protobuf:"bytes,12,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 	StartedTime                *time.Time                     `protobuf:"bytes,13,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
-	Queries                    map[string]*v18.WorkflowQuery  `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Queries                    map[string]*v19.WorkflowQuery  `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Reasons to suggest workflow to continue-as-new, empty if continue-as-new is not suggested.
+	SuggestContinueAsNewReasons []string `protobuf:"bytes,15,rep,name=suggest_continue_as_new_reasons,json=suggestContinueAsNewReasons,proto3" json:"suggest_continue_as_new_reasons,omitempty"`
 }
 
 func (m *RecordWorkflowTaskStartedResponse) Reset()      { *m = RecordWorkflowTaskStartedResponse{} }
@@ -931,13 +953,20 @@ func (m *RecordWorkflowTaskStartedResponse) GetStartedTime() *time.Time {
 	return nil
 }
 
-func (m *RecordWorkflowTaskStartedResponse) GetQueries() map[string]*v18.WorkflowQuery {
+func (m *RecordWorkflowTaskStartedResponse) GetQueries() map[string]*v19.WorkflowQuery {
 	if m != nil {
 		return m.Queries
 	}
 	return nil
 }
 
+func (m *RecordWorkflowTaskStartedResponse) GetSuggestContinueAsNewReasons() []string {
+	if m != nil {
+		return m.SuggestContinueAsNewReasons
+	}
+	return nil
+}
+
 type RecordActivityTaskStartedRequest struct {
 	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -946,6 +975,8 @@ type RecordActivityTaskStartedRequest struct {
 	// Unique id of each poll request. Used to ensure at most once delivery of tasks.
 	RequestId   string                           `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	PollRequest *v1.PollActivityTaskQueueRequest `protobuf:"bytes,6,opt,name=poll_request,json=pollRequest,proto3" json:"poll_request,omitempty"`
+	// Latency of the task measured by matching, history completes it with schedule-to-start latency.
+	LatencyBreakdown *v18.TaskLatencyBreakdown `protobuf:"bytes,7,opt,name=latency_breakdown,json=latencyBreakdown,proto3" json:"latency_breakdown,omitempty"`
 }
 
 func (m *RecordActivityTaskStartedRequest) Reset()      { *m = RecordActivityTaskStartedRequest{} }
@@ -1022,14 +1053,21 @@ func (m *RecordActivityTaskStartedRequest) GetPollRequest() *v1.PollActivityTask
 	return nil
 }
 
+func (m *RecordActivityTaskStartedRequest) GetLatencyBreakdown() *v18.TaskLatencyBreakdown {
+	if m != nil {
+		return m.LatencyBreakdown
+	}
+	return nil
+}
+
 type RecordActivityTaskStartedResponse struct {
-	ScheduledEvent              *v19.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
-	StartedTime                 *time.Time        `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
-	Attempt                     int32             `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	CurrentAttemptScheduledTime *time.Time        `protobuf:"bytes,4,opt,name=current_attempt_scheduled_time,json=currentAttemptScheduledTime,proto3,stdtime" json:"current_attempt_scheduled_time,omitempty"`
-	HeartbeatDetails            *v14.Payloads     `protobuf:"bytes,5,opt,name=heartbeat_details,json=heartbeatDetails,proto3" json:"heartbeat_details,omitempty"`
-	WorkflowType                *v14.WorkflowType `protobuf:"bytes,6,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
-	WorkflowNamespace           string            `protobuf:"bytes,7,opt,name=workflow_namespace,json=workflowNamespace,proto3" json:"workflow_namespace,omitempty"`
+	ScheduledEvent              *v110.HistoryEvent `protobuf:"bytes,1,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
+	StartedTime                 *time.Time         `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
+	Attempt                     int32              `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	CurrentAttemptScheduledTime *time.Time         `protobuf:"bytes,4,opt,name=current_attempt_scheduled_time,json=currentAttemptScheduledTime,proto3,stdtime" json:"current_attempt_scheduled_time,omitempty"`
+	HeartbeatDetails            *v14.Payloads      `protobuf:"bytes,5,opt,name=heartbeat_details,json=heartbeatDetails,proto3" json:"heartbeat_details,omitempty"`
+	WorkflowType                *v14.WorkflowType  `protobuf:"bytes,6,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	WorkflowNamespace           string             `protobuf:"bytes,7,opt,name=workflow_namespace,json=workflowNamespace,proto3" json:"workflow_namespace,omitempty"`
 }
 
 func (m *RecordActivityTaskStartedResponse) Reset()      { *m = RecordActivityTaskStartedResponse{} }
@@ -1064,7 +1102,7 @@ func (m *RecordActivityTaskStartedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RecordActivityTaskStartedResponse proto.InternalMessageInfo
 
-func (m *RecordActivityTaskStartedResponse) GetScheduledEvent() *v19.HistoryEvent {
+func (m *RecordActivityTaskStartedResponse) GetScheduledEvent() *v110.HistoryEvent {
 	if m != nil {
 		return m.ScheduledEvent
 	}
@@ -1650,6 +1688,8 @@ type SignalWorkflowExecutionRequest struct {
 	SignalRequest             *v1.SignalWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=signal_request,json=signalRequest,proto3" json:"signal_request,omitempty"`
 	ExternalWorkflowExecution *v14.WorkflowExecution             `protobuf:"bytes,3,opt,name=external_workflow_execution,json=externalWorkflowExecution,proto3" json:"external_workflow_execution,omitempty"`
 	ChildWorkflowOnly         bool                               `protobuf:"varint,4,opt,name=child_workflow_only,json=childWorkflowOnly,proto3" json:"child_workflow_only,omitempty"`
+	// Signal is re-delivered from signal DLQ, it stays in the DLQ if it fails again.
+	Redelivery bool `protobuf:"varint,5,opt,name=redelivery,proto3" json:"redelivery,omitempty"`
 }
 
 func (m *SignalWorkflowExecutionRequest) Reset()      { *m = SignalWorkflowExecutionRequest{} }
@@ -1712,6 +1752,13 @@ func (m *SignalWorkflowExecutionRequest) GetChildWorkflowOnly() bool {
 	return false
 }
 
+func (m *SignalWorkflowExecutionRequest) GetRedelivery() bool {
+	if m != nil {
+		return m.Redelivery
+	}
+	return false
+}
+
 type SignalWorkflowExecutionResponse struct {
 }
 
@@ -2338,7 +2385,7 @@ type RecordChildExecutionCompletedRequest struct {
 	WorkflowExecution  *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
 	InitiatedId        int64                  `protobuf:"varint,3,opt,name=initiated_id,json=initiatedId,proto3" json:"initiated_id,omitempty"`
 	CompletedExecution *v14.WorkflowExecution `protobuf:"bytes,4,opt,name=completed_execution,json=completedExecution,proto3" json:"completed_execution,omitempty"`
-	CompletionEvent    *v19.HistoryEvent      `protobuf:"bytes,5,opt,name=completion_event,json=completionEvent,proto3" json:"completion_event,omitempty"`
+	CompletionEvent    *v110.HistoryEvent     `protobuf:"bytes,5,opt,name=completion_event,json=completionEvent,proto3" json:"completion_event,omitempty"`
 }
 
 func (m *RecordChildExecutionCompletedRequest) Reset()      { *m = RecordChildExecutionCompletedRequest{} }
@@ -2401,7 +2448,7 @@ func (m *RecordChildExecutionCompletedRequest) GetCompletedExecution() *v14.Work
 	return nil
 }
 
-func (m *RecordChildExecutionCompletedRequest) GetCompletionEvent() *v19.HistoryEvent {
+func (m *RecordChildExecutionCompletedRequest) GetCompletionEvent() *v110.HistoryEvent {
 	if m != nil {
 		return m.CompletionEvent
 	}
@@ -2495,10 +2542,12 @@ func (m *DescribeWorkflowExecutionRequest) GetRequest() *v1.DescribeWorkflowExec
 }
 
 type DescribeWorkflowExecutionResponse struct {
-	ExecutionConfig       *v110.WorkflowExecutionConfig     `protobuf:"bytes,1,opt,name=execution_config,json=executionConfig,proto3" json:"execution_config,omitempty"`
-	WorkflowExecutionInfo *v110.WorkflowExecutionInfo       `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
-	PendingActivities     []*v110.PendingActivityInfo       `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
-	PendingChildren       []*v110.PendingChildExecutionInfo `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
+	ExecutionConfig       *v111.WorkflowExecutionConfig     `protobuf:"bytes,1,opt,name=execution_config,json=executionConfig,proto3" json:"execution_config,omitempty"`
+	WorkflowExecutionInfo *v111.WorkflowExecutionInfo       `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
+	PendingActivities     []*v111.PendingActivityInfo       `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
+	PendingChildren       []*v111.PendingChildExecutionInfo `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
+	// Latest value of each report published by the workflow, keyed by report name.
+	WorkflowReports map[string]*v14.Payload `protobuf:"bytes,5,rep,name=workflow_reports,json=workflowReports,proto3" json:"workflow_reports,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *DescribeWorkflowExecutionResponse) Reset()      { *m = DescribeWorkflowExecutionResponse{} }
@@ -2533,34 +2582,41 @@ func (m *DescribeWorkflowExecutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DescribeWorkflowExecutionResponse proto.InternalMessageInfo
 
-func (m *DescribeWorkflowExecutionResponse) GetExecutionConfig() *v110.WorkflowExecutionConfig {
+func (m *DescribeWorkflowExecutionResponse) GetExecutionConfig() *v111.WorkflowExecutionConfig {
 	if m != nil {
 		return m.ExecutionConfig
 	}
 	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetWorkflowExecutionInfo() *v110.WorkflowExecutionInfo {
+func (m *DescribeWorkflowExecutionResponse) GetWorkflowExecutionInfo() *v111.WorkflowExecutionInfo {
 	if m != nil {
 		return m.WorkflowExecutionInfo
 	}
 	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetPendingActivities() []*v110.PendingActivityInfo {
+func (m *DescribeWorkflowExecutionResponse) GetPendingActivities() []*v111.PendingActivityInfo {
 	if m != nil {
 		return m.PendingActivities
 	}
 	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) GetPendingChildren() []*v110.PendingChildExecutionInfo {
+func (m *DescribeWorkflowExecutionResponse) GetPendingChildren() []*v111.PendingChildExecutionInfo {
 	if m != nil {
 		return m.PendingChildren
 	}
 	return nil
 }
 
+func (m *DescribeWorkflowExecutionResponse) GetWorkflowReports() map[string]*v14.Payload {
+	if m != nil {
+		return m.WorkflowReports
+	}
+	return nil
+}
+
 type ReplicateEventsV2Request struct {
 	NamespaceId         string                    `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution   *v14.WorkflowExecution    `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -2949,8 +3005,11 @@ func (m *SyncActivityResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_SyncActivityResponse proto.InternalMessageInfo
 
 type DescribeMutableStateRequest struct {
-	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution   *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	NamespaceId           string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution             *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	IncludePendingDetails bool                   `protobuf:"varint,3,opt,name=include_pending_details,json=includePendingDetails,proto3" json:"include_pending_details,omitempty"`
+	// Number of next timers to report with pending details, 10 if not set.
+	PendingTimerCount int32 `protobuf:"varint,4,opt,name=pending_timer_count,json=pendingTimerCount,proto3" json:"pending_timer_count,omitempty"`
 }
 
 func (m *DescribeMutableStateRequest) Reset()      { *m = DescribeMutableStateRequest{} }
@@ -2999,9 +3058,24 @@ func (m *DescribeMutableStateRequest) GetExecution() *v14.WorkflowExecution {
 	return nil
 }
 
+func (m *DescribeMutableStateRequest) GetIncludePendingDetails() bool {
+	if m != nil {
+		return m.IncludePendingDetails
+	}
+	return false
+}
+
+func (m *DescribeMutableStateRequest) GetPendingTimerCount() int32 {
+	if m != nil {
+		return m.PendingTimerCount
+	}
+	return 0
+}
+
 type DescribeMutableStateResponse struct {
-	CacheMutableState    *v111.WorkflowMutableState `protobuf:"bytes,1,opt,name=cache_mutable_state,json=cacheMutableState,proto3" json:"cache_mutable_state,omitempty"`
-	DatabaseMutableState *v111.WorkflowMutableState `protobuf:"bytes,2,opt,name=database_mutable_state,json=databaseMutableState,proto3" json:"database_mutable_state,omitempty"`
+	CacheMutableState       *v18.WorkflowMutableState    `protobuf:"bytes,1,opt,name=cache_mutable_state,json=cacheMutableState,proto3" json:"cache_mutable_state,omitempty"`
+	DatabaseMutableState    *v18.WorkflowMutableState    `protobuf:"bytes,2,opt,name=database_mutable_state,json=databaseMutableState,proto3" json:"database_mutable_state,omitempty"`
+	PendingExecutionDetails *v17.PendingExecutionDetails `protobuf:"bytes,3,opt,name=pending_execution_details,json=pendingExecutionDetails,proto3" json:"pending_execution_details,omitempty"`
 }
 
 func (m *DescribeMutableStateResponse) Reset()      { *m = DescribeMutableStateResponse{} }
@@ -3036,20 +3110,27 @@ func (m *DescribeMutableStateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DescribeMutableStateResponse proto.InternalMessageInfo
 
-func (m *DescribeMutableStateResponse) GetCacheMutableState() *v111.WorkflowMutableState {
+func (m *DescribeMutableStateResponse) GetCacheMutableState() *v18.WorkflowMutableState {
 	if m != nil {
 		return m.CacheMutableState
 	}
 	return nil
 }
 
-func (m *DescribeMutableStateResponse) GetDatabaseMutableState() *v111.WorkflowMutableState {
+func (m *DescribeMutableStateResponse) GetDatabaseMutableState() *v18.WorkflowMutableState {
 	if m != nil {
 		return m.DatabaseMutableState
 	}
 	return nil
 }
 
+func (m *DescribeMutableStateResponse) GetPendingExecutionDetails() *v17.PendingExecutionDetails {
+	if m != nil {
+		return m.PendingExecutionDetails
+	}
+	return nil
+}
+
 // At least one of the parameters needs to be provided.
 type DescribeHistoryHostRequest struct {
 	//ip:port
@@ -3125,6 +3206,8 @@ type DescribeHistoryHostResponse struct {
 	NamespaceCache        *v112.NamespaceCacheInfo `protobuf:"bytes,3,opt,name=namespace_cache,json=namespaceCache,proto3" json:"namespace_cache,omitempty"`
 	ShardControllerStatus string                   `protobuf:"bytes,4,opt,name=shard_controller_status,json=shardControllerStatus,proto3" json:"shard_controller_status,omitempty"`
 	Address               string                   `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	RequestsPerSecond     float64                  `protobuf:"fixed64,6,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	ContendedWorkflows    []*v17.ContendedWorkflow `protobuf:"bytes,7,rep,name=contended_workflows,json=contendedWorkflows,proto3" json:"contended_workflows,omitempty"`
 }
 
 func (m *DescribeHistoryHostResponse) Reset()      { *m = DescribeHistoryHostResponse{} }
@@ -3194,6 +3277,20 @@ func (m *DescribeHistoryHostResponse) GetAddress() string {
 	return ""
 }
 
+func (m *DescribeHistoryHostResponse) GetRequestsPerSecond() float64 {
+	if m != nil {
+		return m.RequestsPerSecond
+	}
+	return 0
+}
+
+func (m *DescribeHistoryHostResponse) GetContendedWorkflows() []*v17.ContendedWorkflow {
+	if m != nil {
+		return m.ContendedWorkflows
+	}
+	return nil
+}
+
 type CloseShardRequest struct {
 	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 }
@@ -4190,386 +4287,778 @@ func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
-	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
-	proto.RegisterType((*GetMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.GetMutableStateRequest")
-	proto.RegisterType((*GetMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.GetMutableStateResponse")
-	proto.RegisterType((*PollMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.PollMutableStateRequest")
-	proto.RegisterType((*PollMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.PollMutableStateResponse")
-	proto.RegisterType((*ResetStickyTaskQueueRequest)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueRequest")
-	proto.RegisterType((*ResetStickyTaskQueueResponse)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueResponse")
-	proto.RegisterType((*RecordWorkflowTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedRequest")
-	proto.RegisterType((*RecordWorkflowTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse")
-	proto.RegisterMapType((map[string]*v18.WorkflowQuery)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse.QueriesEntry")
-	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedRequest")
-	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedResponse")
-	proto.RegisterType((*RespondWorkflowTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedRequest")
-	proto.RegisterType((*RespondWorkflowTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedResponse")
-	proto.RegisterType((*RespondWorkflowTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedRequest")
-	proto.RegisterType((*RespondWorkflowTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedResponse")
-	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatRequest")
-	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatResponse")
-	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedRequest")
-	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedResponse")
-	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedRequest")
-	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedResponse")
-	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledRequest")
-	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledResponse")
-	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionResponse")
-	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateRequest")
-	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateResponse")
-	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionRequest")
-	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionResponse")
-	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionRequest")
-	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionResponse")
-	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionRequest")
-	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionResponse")
-	proto.RegisterType((*ScheduleWorkflowTaskRequest)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskRequest")
-	proto.RegisterType((*ScheduleWorkflowTaskResponse)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskResponse")
-	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedRequest")
-	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedResponse")
-	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionRequest")
-	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionResponse")
-	proto.RegisterType((*ReplicateEventsV2Request)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Request")
-	proto.RegisterType((*ReplicateEventsV2Response)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Response")
-	proto.RegisterType((*SyncShardStatusRequest)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusRequest")
-	proto.RegisterType((*SyncShardStatusResponse)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusResponse")
-	proto.RegisterType((*SyncActivityRequest)(nil), "temporal.server.api.historyservice.v1.SyncActivityRequest")
-	proto.RegisterType((*SyncActivityResponse)(nil), "temporal.server.api.historyservice.v1.SyncActivityResponse")
-	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateRequest")
-	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.historyservice.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.historyservice.v1.CloseShardResponse")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.historyservice.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.historyservice.v1.RemoveTaskResponse")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v113.ReplicationMessages)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsResponse")
-	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesRequest")
-	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesResponse")
-	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesRequest")
-	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesResponse")
-	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesRequest")
-	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
+type AnnotateWorkflowExecutionRequest struct {
+	NamespaceId string                                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v114.AnnotateWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/historyservice/v1/request_response.proto", fileDescriptor_b8c78c1d460a3711)
+func (m *AnnotateWorkflowExecutionRequest) Reset()      { *m = AnnotateWorkflowExecutionRequest{} }
+func (*AnnotateWorkflowExecutionRequest) ProtoMessage() {}
+func (*AnnotateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{72}
 }
-
-var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 3673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x49, 0x70, 0x1b, 0xc7,
-	0xd5, 0xd6, 0x10, 0x5c, 0xc0, 0x07, 0x10, 0x04, 0x87, 0x1b, 0x48, 0x4a, 0x10, 0x39, 0xda, 0xe8,
-	0x45, 0xa0, 0x96, 0xff, 0xb7, 0x64, 0xfd, 0xbf, 0xed, 0x5f, 0xa4, 0x36, 0xa8, 0x2c, 0x99, 0x1e,
-	0xf2, 0x97, 0x5c, 0xde, 0xc6, 0x43, 0x4c, 0x93, 0x98, 0x9f, 0xc0, 0x0c, 0x34, 0x3d, 0x00, 0x05,
-	0xff, 0x87, 0x6c, 0x95, 0x83, 0x93, 0xaa, 0x94, 0xaa, 0x72, 0x49, 0x55, 0x9c, 0x4b, 0x2e, 0xf1,
-	0x25, 0xe5, 0x43, 0x0e, 0x29, 0x1f, 0x72, 0x4d, 0xe5, 0x16, 0x57, 0xaa, 0x52, 0x71, 0x25, 0x87,
-	0xc4, 0xf2, 0x25, 0xa9, 0xe4, 0xe0, 0x83, 0x0f, 0x39, 0xa6, 0x7a, 0x1b, 0xcc, 0x86, 0x8d, 0x94,
-	0x62, 0xc7, 0xf1, 0x0d, 0xd3, 0xfd, 0xde, 0xeb, 0x7e, 0xaf, 0xdf, 0xfb, 0xba, 0xfb, 0xf5, 0x03,
-	0xfc, 0xb7, 0x8b, 0xaa, 0x35, 0xdb, 0xd1, 0x2b, 0x2b, 0x18, 0x39, 0x0d, 0xe4, 0xac, 0xe8, 0x35,
-	0x73, 0xa5, 0x6c, 0x62, 0xd7, 0x76, 0x9a, 0xa4, 0xc5, 0x2c, 0xa1, 0x95, 0xc6, 0xd9, 0x15, 0x07,
-	0xdd, 0xab, 0x23, 0xec, 0x6a, 0x0e, 0xc2, 0x35, 0xdb, 0xc2, 0xa8, 0x50, 0x73, 0x6c, 0xd7, 0x96,
-	0x4f, 0x08, 0xee, 0x02, 0xe3, 0x2e, 0xe8, 0x35, 0xb3, 0x10, 0xe4, 0x2e, 0x34, 0xce, 0xce, 0xe7,
-	0x77, 0x6c, 0x7b, 0xa7, 0x82, 0x56, 0x28, 0xd3, 0x56, 0x7d, 0x7b, 0xc5, 0xa8, 0x3b, 0xba, 0x6b,
-	0xda, 0x16, 0x13, 0x33, 0x7f, 0x34, 0xdc, 0xef, 0x9a, 0x55, 0x84, 0x5d, 0xbd, 0x5a, 0xe3, 0x04,
-	0x4b, 0x06, 0xaa, 0x21, 0xcb, 0x40, 0x56, 0xc9, 0x44, 0x78, 0x65, 0xc7, 0xde, 0xb1, 0x69, 0x3b,
-	0xfd, 0xc5, 0x49, 0x8e, 0x7b, 0x8a, 0x10, 0x0d, 0x4a, 0x76, 0xb5, 0x6a, 0x5b, 0x64, 0xe6, 0x55,
-	0x84, 0xb1, 0xbe, 0xc3, 0x27, 0x3c, 0x7f, 0x22, 0x40, 0xc5, 0x67, 0x1a, 0x25, 0x3b, 0x15, 0x20,
-	0x73, 0x75, 0xbc, 0x7b, 0xaf, 0x8e, 0xea, 0x28, 0x4a, 0x18, 0x1c, 0x15, 0x59, 0xf5, 0x2a, 0x26,
-	0x44, 0x7b, 0xb6, 0xb3, 0xbb, 0x5d, 0xb1, 0xf7, 0x38, 0xd5, 0xc9, 0x00, 0x95, 0xe8, 0x8c, 0x4a,
-	0x3b, 0x16, 0xa0, 0xbb, 0x57, 0x47, 0x71, 0x73, 0x0b, 0xaa, 0xb0, 0xad, 0x9b, 0x95, 0xba, 0x13,
-	0x33, 0xb3, 0xa7, 0x3b, 0x2c, 0x6c, 0x94, 0xfa, 0x89, 0x38, 0x6a, 0x4f, 0x1d, 0x66, 0x4d, 0x4e,
-	0xfa, 0x54, 0x47, 0xd2, 0x90, 0xe6, 0xa7, 0x3a, 0x12, 0x13, 0xc3, 0x72, 0xc2, 0xd3, 0x71, 0x84,
-	0xed, 0x2d, 0x55, 0x88, 0x23, 0xb7, 0xf4, 0x2a, 0xc2, 0x35, 0xbd, 0x14, 0x63, 0x8d, 0x33, 0x71,
-	0xf4, 0x0e, 0xaa, 0x55, 0xcc, 0x12, 0x75, 0xc4, 0x28, 0xc7, 0x0b, 0x71, 0x1c, 0x35, 0xe4, 0x60,
-	0x13, 0xbb, 0xc8, 0x62, 0x63, 0x88, 0xf9, 0x69, 0xd5, 0xba, 0xab, 0x6f, 0x55, 0x90, 0x86, 0x5d,
-	0xdd, 0x15, 0x02, 0x9e, 0x89, 0x5d, 0xf4, 0xae, 0x31, 0x35, 0x7f, 0x29, 0x6e, 0x60, 0xdd, 0xa8,
-	0x9a, 0x56, 0x57, 0x5e, 0xe5, 0xbb, 0xc3, 0x70, 0x64, 0xc3, 0xd5, 0x1d, 0xf7, 0x2e, 0x1f, 0xee,
-	0xea, 0x7d, 0x54, 0xaa, 0x13, 0x05, 0x55, 0xc6, 0x20, 0x2f, 0x41, 0xda, 0x33, 0x93, 0x66, 0x1a,
-	0x39, 0x69, 0x51, 0x5a, 0x1e, 0x55, 0x53, 0x5e, 0x5b, 0xd1, 0x90, 0x4b, 0x30, 0x86, 0x89, 0x0c,
-	0x8d, 0x0f, 0x92, 0x1b, 0x58, 0x94, 0x96, 0x53, 0xe7, 0x9e, 0xf7, 0x6c, 0x4e, 0xa3, 0x3c, 0xa4,
-	0x50, 0xa1, 0x71, 0xb6, 0xd0, 0x71, 0x64, 0x35, 0x4d, 0x85, 0x8a, 0x79, 0x94, 0x61, 0xba, 0xa6,
-	0x3b, 0xc8, 0x72, 0x35, 0x24, 0x08, 0x35, 0xd3, 0xda, 0xb6, 0x73, 0x09, 0x3a, 0xd8, 0x7f, 0x14,
-	0xe2, 0x90, 0xc5, 0x73, 0xae, 0xc6, 0xd9, 0xc2, 0x3a, 0xe5, 0xf6, 0x46, 0x29, 0x5a, 0xdb, 0xb6,
-	0x3a, 0x59, 0x8b, 0x36, 0xca, 0x39, 0x18, 0xd1, 0x5d, 0x22, 0xcd, 0xcd, 0x0d, 0x2e, 0x4a, 0xcb,
-	0x43, 0xaa, 0xf8, 0x94, 0xab, 0xa0, 0x78, 0x2b, 0xd8, 0x9a, 0x05, 0xba, 0x5f, 0x33, 0x19, 0x3a,
-	0x69, 0x04, 0x86, 0x72, 0x43, 0x74, 0x42, 0xf3, 0x05, 0x86, 0x51, 0x05, 0x81, 0x51, 0x85, 0x4d,
-	0x81, 0x51, 0xab, 0x83, 0x0f, 0xfe, 0x78, 0x54, 0x52, 0x8f, 0xee, 0x85, 0x35, 0xbf, 0xea, 0x49,
-	0x22, 0xb4, 0x72, 0x19, 0xe6, 0x4a, 0xb6, 0xe5, 0x9a, 0x56, 0x1d, 0x69, 0x3a, 0xd6, 0x2c, 0xb4,
-	0xa7, 0x99, 0x96, 0xe9, 0x9a, 0xba, 0x6b, 0x3b, 0xb9, 0xe1, 0x45, 0x69, 0x39, 0x73, 0xee, 0x74,
-	0xd0, 0xc6, 0x34, 0x50, 0x88, 0xb2, 0x6b, 0x9c, 0xef, 0x32, 0xbe, 0x8d, 0xf6, 0x8a, 0x82, 0x49,
-	0x9d, 0x29, 0xc5, 0xb6, 0xcb, 0xb7, 0x60, 0x42, 0xf4, 0x18, 0x1a, 0x47, 0x88, 0xdc, 0x08, 0xd5,
-	0x63, 0x31, 0x38, 0x02, 0xef, 0x24, 0x63, 0x5c, 0x63, 0x3f, 0xd5, 0xac, 0xc7, 0xca, 0x5b, 0xe4,
-	0x3b, 0x30, 0x53, 0xd1, 0xb1, 0xab, 0x95, 0xec, 0x6a, 0xad, 0x82, 0xa8, 0x65, 0x1c, 0x84, 0xeb,
-	0x15, 0x37, 0x97, 0x8c, 0x93, 0xc9, 0xd1, 0x82, 0xae, 0x51, 0xb3, 0x62, 0xeb, 0x06, 0x56, 0xa7,
-	0x08, 0xff, 0x9a, 0xc7, 0xae, 0x52, 0x6e, 0xf9, 0x4d, 0x58, 0xd8, 0x36, 0x1d, 0xec, 0x6a, 0xde,
-	0x2a, 0x10, 0x40, 0xd0, 0xb6, 0xf4, 0xd2, 0xae, 0xbd, 0xbd, 0x9d, 0x1b, 0xa5, 0xc2, 0xe7, 0x22,
-	0x86, 0xbf, 0xc2, 0x37, 0x8f, 0xd5, 0xc1, 0x1f, 0x10, 0xbb, 0xe7, 0xa8, 0x0c, 0xe1, 0x76, 0x9b,
-	0x3a, 0xde, 0x5d, 0x65, 0x02, 0x94, 0x0b, 0x90, 0x6f, 0xe7, 0x92, 0x2c, 0x6a, 0xe4, 0x69, 0x18,
-	0x76, 0xea, 0x56, 0x2b, 0x0e, 0x86, 0x9c, 0xba, 0x55, 0x34, 0x94, 0xbf, 0x4a, 0x30, 0x73, 0x1d,
-	0xb9, 0xb7, 0x58, 0x54, 0x6f, 0x90, 0xa0, 0xee, 0x23, 0x7e, 0xae, 0xc3, 0xa8, 0xe7, 0x4d, 0x3c,
-	0x76, 0x9e, 0x68, 0x67, 0xa1, 0xe8, 0xd4, 0x5a, 0xbc, 0xf2, 0x79, 0x98, 0x41, 0xf7, 0x6b, 0xa8,
-	0xe4, 0x22, 0x43, 0xb3, 0xd0, 0x7d, 0x57, 0x43, 0x0d, 0x12, 0x30, 0xa6, 0x41, 0x83, 0x24, 0xa1,
-	0x4e, 0x8a, 0xde, 0xdb, 0xe8, 0xbe, 0x7b, 0x95, 0xf4, 0x15, 0x0d, 0xf9, 0x0c, 0x4c, 0x95, 0xea,
-	0x0e, 0x8d, 0xac, 0x2d, 0x47, 0xb7, 0x4a, 0x65, 0xcd, 0xb5, 0x77, 0x91, 0x45, 0x7d, 0x3f, 0xad,
-	0xca, 0xbc, 0x6f, 0x95, 0x76, 0x6d, 0x92, 0x1e, 0xe5, 0xb3, 0x11, 0x98, 0x8d, 0x68, 0xcb, 0x0d,
-	0x14, 0xd0, 0x45, 0x3a, 0x80, 0x2e, 0x45, 0x18, 0x6b, 0xad, 0x72, 0xb3, 0x86, 0xb8, 0x61, 0x8e,
-	0x77, 0x13, 0xb6, 0xd9, 0xac, 0x21, 0x35, 0xbd, 0xe7, 0xfb, 0x92, 0x15, 0x18, 0x8b, 0xb3, 0x46,
-	0xca, 0xf2, 0x59, 0xe1, 0x59, 0x98, 0xab, 0x39, 0xa8, 0x61, 0xda, 0x75, 0xac, 0x51, 0xdc, 0x41,
-	0x46, 0x8b, 0x7e, 0x90, 0xd2, 0xcf, 0x08, 0x82, 0x0d, 0xd6, 0x2f, 0x58, 0x4f, 0xc3, 0x24, 0xf5,
-	0x76, 0xe6, 0x9a, 0x1e, 0xd3, 0x10, 0x65, 0xca, 0x92, 0xae, 0x6b, 0xa4, 0x47, 0x90, 0xaf, 0x01,
-	0x50, 0xaf, 0xa5, 0x07, 0x04, 0x1a, 0xc6, 0x11, 0xad, 0xbc, 0xf3, 0x03, 0x51, 0x8c, 0x38, 0xe8,
-	0xcb, 0xe4, 0x43, 0x1d, 0x75, 0xc5, 0x4f, 0x79, 0x1d, 0x26, 0xb0, 0x6b, 0x96, 0x76, 0x9b, 0x9a,
-	0x4f, 0xd6, 0x48, 0x1f, 0xb2, 0xc6, 0x19, 0xbb, 0xd7, 0x20, 0xff, 0x3f, 0x3c, 0x15, 0x91, 0xa8,
-	0xe1, 0x52, 0x19, 0x19, 0xf5, 0x0a, 0xd2, 0x5c, 0x9b, 0x59, 0x85, 0x22, 0x9c, 0x5d, 0x77, 0x73,
-	0xa9, 0xde, 0x62, 0xed, 0x44, 0x68, 0x98, 0x0d, 0x2e, 0x70, 0xd3, 0xa6, 0x46, 0xdc, 0x64, 0xd2,
-	0xda, 0xfa, 0xe0, 0x58, 0x3b, 0x1f, 0x94, 0x5f, 0x83, 0x8c, 0xe7, 0x1e, 0x74, 0x13, 0xcd, 0x8d,
-	0x53, 0x40, 0x8c, 0xdf, 0x07, 0x3c, 0x5c, 0x8c, 0xb8, 0x1c, 0xf3, 0x5e, 0xcf, 0xd5, 0xe8, 0xa7,
-	0x7c, 0x17, 0xc6, 0x03, 0xc2, 0xeb, 0x38, 0x97, 0xa5, 0xd2, 0x0b, 0x6d, 0xe0, 0x36, 0x56, 0x6c,
-	0x1d, 0xab, 0x19, 0xbf, 0xdc, 0x3a, 0x96, 0xdf, 0x80, 0x89, 0x06, 0x39, 0x11, 0xd8, 0x96, 0xc6,
-	0x4e, 0x56, 0x26, 0xc2, 0xb9, 0x09, 0x6a, 0xca, 0x33, 0x85, 0x0e, 0x47, 0x63, 0x32, 0xc6, 0x1d,
-	0xc6, 0x78, 0x43, 0xf0, 0xa9, 0xd9, 0x46, 0xa8, 0x45, 0x7e, 0x1e, 0x0e, 0x9b, 0xc4, 0x7d, 0xc3,
-	0xcb, 0x88, 0x2c, 0x12, 0xa8, 0x46, 0x4e, 0x5e, 0x94, 0x96, 0x93, 0x6a, 0xce, 0xc4, 0x1b, 0xc1,
-	0x55, 0xb9, 0xca, 0xfa, 0x6f, 0x0e, 0x26, 0x93, 0xd9, 0xd1, 0x9b, 0x83, 0xc9, 0xd1, 0x2c, 0xdc,
-	0x1c, 0x4c, 0x42, 0x36, 0x75, 0x73, 0x30, 0x99, 0xce, 0x8e, 0xdd, 0x1c, 0x4c, 0x66, 0xb2, 0xe3,
-	0xca, 0xdf, 0x24, 0x98, 0x5d, 0xb7, 0x2b, 0x95, 0x7f, 0x13, 0x94, 0x7b, 0x7f, 0x04, 0x72, 0x51,
-	0x75, 0xbf, 0x82, 0xb9, 0xaf, 0x60, 0xee, 0x91, 0xc3, 0x5c, 0xba, 0x2d, 0xcc, 0xc5, 0x02, 0x46,
-	0xe6, 0x91, 0x01, 0xc6, 0xbf, 0x24, 0x8a, 0xc6, 0xc2, 0xd4, 0x58, 0x36, 0xa3, 0xbc, 0x23, 0xc1,
-	0x82, 0x8a, 0x30, 0x72, 0x43, 0xf0, 0xf6, 0x39, 0x80, 0x94, 0x92, 0x87, 0xc3, 0xf1, 0x53, 0x61,
-	0x00, 0xa2, 0xfc, 0x7e, 0x00, 0x16, 0x55, 0x54, 0xb2, 0x1d, 0xc3, 0x7f, 0x10, 0xe5, 0x21, 0xd7,
-	0xc7, 0x84, 0x5f, 0x01, 0x39, 0x7a, 0x25, 0xe9, 0x7f, 0xe6, 0x13, 0x91, 0xbb, 0x88, 0x7c, 0x14,
-	0x52, 0x5e, 0x5c, 0x78, 0x60, 0x02, 0xa2, 0xa9, 0x68, 0xc8, 0xb3, 0x30, 0x42, 0x63, 0xc8, 0x43,
-	0x8e, 0x61, 0xf2, 0x59, 0x34, 0xe4, 0x23, 0x00, 0xe2, 0xba, 0xc9, 0x01, 0x62, 0x54, 0x1d, 0xe5,
-	0x2d, 0x45, 0x43, 0x7e, 0x0b, 0xd2, 0x35, 0xbb, 0x52, 0xf1, 0x6e, 0x8b, 0x0c, 0x1b, 0x9e, 0xeb,
-	0x7a, 0x5b, 0x24, 0x60, 0xec, 0x37, 0x96, 0x7f, 0x6d, 0xd5, 0x14, 0x11, 0xc9, 0x3f, 0x94, 0xdf,
-	0x8e, 0xc0, 0x52, 0x07, 0xe3, 0x72, 0x0c, 0x8f, 0x40, 0xaf, 0xb4, 0x6f, 0xe8, 0xed, 0x08, 0xab,
-	0x03, 0x1d, 0x61, 0xf5, 0x69, 0x90, 0x85, 0x4d, 0x8d, 0x30, 0x74, 0x67, 0xbd, 0x1e, 0x41, 0xbd,
-	0x0c, 0xd9, 0x36, 0xb0, 0x9d, 0xc1, 0x41, 0xb9, 0x91, 0xdd, 0x60, 0x28, 0xba, 0x1b, 0xf8, 0x6e,
-	0xba, 0xc3, 0xc1, 0x9b, 0xee, 0x45, 0xc8, 0x71, 0x98, 0xf4, 0xdd, 0x73, 0xf9, 0x29, 0x62, 0x84,
-	0x9e, 0x22, 0x66, 0x58, 0x7f, 0xeb, 0xee, 0xca, 0x7a, 0xe5, 0x1d, 0x9f, 0x43, 0x32, 0xf7, 0x20,
-	0x97, 0x74, 0x76, 0xef, 0x7b, 0xb6, 0x1b, 0x64, 0x6d, 0x3a, 0xba, 0x85, 0x4d, 0x64, 0x05, 0x6e,
-	0x67, 0xf4, 0xa6, 0x9e, 0xdd, 0x0b, 0xb5, 0xc8, 0x3b, 0x70, 0x24, 0xe6, 0x32, 0xee, 0xdb, 0x27,
-	0x46, 0xfb, 0xd8, 0x27, 0xe6, 0x23, 0xfe, 0xdf, 0xda, 0x32, 0x96, 0x20, 0x1d, 0x40, 0xeb, 0x14,
-	0x45, 0xeb, 0xd4, 0x96, 0x0f, 0xa6, 0xaf, 0x43, 0xa6, 0xb5, 0x88, 0x34, 0x09, 0x90, 0xee, 0x31,
-	0x09, 0x30, 0xe6, 0xf1, 0xd1, 0x2b, 0xff, 0x1a, 0xa4, 0xc5, 0xfa, 0x52, 0x31, 0x63, 0x3d, 0x8a,
-	0x49, 0x71, 0x2e, 0x2a, 0xc4, 0x86, 0x91, 0x7b, 0x75, 0xc4, 0xb7, 0x8a, 0xc4, 0x72, 0xea, 0xdc,
-	0xff, 0x16, 0x7a, 0x4a, 0xbb, 0x16, 0xba, 0xc6, 0x4c, 0xe1, 0x65, 0x26, 0xf7, 0xaa, 0xe5, 0x3a,
-	0x4d, 0x55, 0x8c, 0x32, 0xff, 0x16, 0xa4, 0xfd, 0x1d, 0x72, 0x16, 0x12, 0xbb, 0xa8, 0xc9, 0xe1,
-	0x8a, 0xfc, 0x94, 0x2f, 0xc1, 0x50, 0x43, 0xaf, 0xd4, 0xdb, 0x1c, 0x6f, 0x68, 0xe2, 0xd2, 0x1f,
-	0x62, 0x44, 0x5a, 0x53, 0x65, 0x2c, 0x97, 0x06, 0x2e, 0x4a, 0x0c, 0xe6, 0x7d, 0xa0, 0x79, 0xb9,
-	0xe4, 0x9a, 0x0d, 0xd3, 0x6d, 0x7e, 0x05, 0x9a, 0x3d, 0x80, 0xa6, 0xdf, 0x58, 0xed, 0x41, 0xf3,
-	0x9b, 0x83, 0x02, 0x34, 0x63, 0x8d, 0xcb, 0x41, 0xf3, 0x36, 0x8c, 0x87, 0xe0, 0x8a, 0xc3, 0xe6,
-	0x89, 0xe0, 0x54, 0x7c, 0x41, 0xcd, 0x8e, 0x1b, 0x4d, 0x0a, 0x3a, 0x6a, 0x26, 0x08, 0x69, 0x11,
-	0x87, 0x1f, 0xd8, 0x8f, 0xc3, 0xfb, 0x70, 0x2c, 0x11, 0xc4, 0x31, 0x04, 0x79, 0x71, 0xe2, 0xe2,
-	0x4d, 0x5a, 0x28, 0x50, 0x07, 0x7b, 0x1c, 0x70, 0x81, 0xcb, 0xb9, 0xcc, 0xc4, 0x6c, 0x04, 0xc2,
-	0xf6, 0x16, 0x4c, 0x94, 0x91, 0xee, 0xb8, 0x5b, 0x48, 0x77, 0x35, 0x03, 0xb9, 0xba, 0x59, 0xc1,
-	0x3c, 0x0f, 0xd8, 0x3d, 0xd7, 0x95, 0xf5, 0x58, 0xaf, 0x30, 0xce, 0xe8, 0xce, 0x34, 0xbc, 0xef,
-	0x9d, 0xe9, 0xb4, 0xcf, 0xd5, 0xbd, 0x10, 0xa0, 0x10, 0x3e, 0xda, 0xf2, 0xdf, 0xdb, 0xa2, 0x43,
-	0xf9, 0x40, 0x82, 0x63, 0x6c, 0xad, 0x03, 0x30, 0xc0, 0x33, 0x71, 0x7d, 0x05, 0x99, 0x0d, 0x59,
-	0x9e, 0xff, 0x43, 0xa1, 0xc4, 0xf0, 0x95, 0xae, 0x5e, 0xdb, 0xc3, 0x14, 0xd4, 0x71, 0x21, 0x5d,
-	0x38, 0xf0, 0x0f, 0x25, 0x38, 0xde, 0x99, 0x91, 0xfb, 0x30, 0x6e, 0x6d, 0xa2, 0x22, 0x1d, 0xce,
-	0x9d, 0xf8, 0xc6, 0xa3, 0x02, 0x4a, 0x72, 0xf1, 0x08, 0x34, 0x28, 0xef, 0x4b, 0x04, 0xbb, 0x22,
-	0xb3, 0xbb, 0xa6, 0x9b, 0x95, 0xbe, 0xcc, 0x5a, 0x86, 0xcc, 0x36, 0xe5, 0x09, 0x19, 0xf5, 0xf2,
-	0x7e, 0x8c, 0x1a, 0x18, 0x5d, 0x1d, 0xdb, 0xf6, 0x7f, 0x2a, 0xc7, 0x08, 0x1e, 0xb4, 0x65, 0xe1,
-	0x6a, 0x7d, 0x20, 0x81, 0x12, 0x45, 0x8d, 0x1b, 0xc2, 0xa3, 0xfb, 0x50, 0xac, 0xe6, 0x8f, 0xa1,
-	0xa0, 0x6e, 0x6b, 0x3d, 0xe8, 0xd6, 0x6d, 0x0a, 0xbe, 0x30, 0x13, 0x0a, 0xae, 0x13, 0x5f, 0xef,
-	0xc0, 0xc7, 0xdd, 0xe5, 0x09, 0xc8, 0x96, 0x74, 0xab, 0x84, 0x3c, 0xf0, 0x45, 0x6c, 0xfe, 0x49,
-	0x75, 0x9c, 0xb5, 0xab, 0xa2, 0xd9, 0x1f, 0x3e, 0x7e, 0x99, 0x9f, 0x53, 0xf8, 0x74, 0x9a, 0x42,
-	0x34, 0x7c, 0x4e, 0x7a, 0xd1, 0xd3, 0x86, 0x2f, 0xea, 0xc8, 0x7e, 0xc2, 0x7f, 0xbe, 0x23, 0xb7,
-	0x1d, 0xbd, 0xbd, 0x23, 0xc7, 0xb1, 0x70, 0xb5, 0x7e, 0x46, 0x1d, 0x39, 0xaa, 0x3f, 0x5d, 0xe1,
-	0xbe, 0x14, 0xfb, 0x3f, 0xc8, 0x04, 0xfd, 0xa5, 0x0f, 0x2f, 0xee, 0x36, 0xbe, 0x3a, 0x16, 0x70,
-	0x39, 0xe5, 0x44, 0xbc, 0xbf, 0x79, 0x4c, 0x5c, 0xb9, 0x5f, 0x0e, 0x40, 0x7e, 0xc3, 0xdc, 0xb1,
-	0xf4, 0xca, 0x41, 0xde, 0xf9, 0xb6, 0x21, 0x83, 0xa9, 0x90, 0x90, 0x62, 0x2f, 0x74, 0x7f, 0xe8,
-	0xeb, 0x38, 0xb6, 0x3a, 0xc6, 0xc4, 0x8a, 0xa9, 0x98, 0xb0, 0x80, 0xee, 0xbb, 0xc8, 0x21, 0x23,
-	0xc5, 0x9c, 0xd3, 0x12, 0xfd, 0x9e, 0xd3, 0xe6, 0x84, 0xb4, 0x48, 0x97, 0x5c, 0x80, 0xc9, 0x52,
-	0xd9, 0xac, 0x18, 0xad, 0x71, 0x6c, 0xab, 0xd2, 0xa4, 0x87, 0x82, 0xa4, 0x3a, 0x41, 0xbb, 0x04,
-	0xd3, 0x4b, 0x56, 0xa5, 0xa9, 0x2c, 0xc1, 0xd1, 0xb6, 0xba, 0x70, 0x5b, 0xff, 0x46, 0x82, 0x53,
-	0x9c, 0xc6, 0x74, 0xcb, 0x07, 0x7e, 0x5c, 0xfd, 0x96, 0x04, 0x73, 0xdc, 0xea, 0x7b, 0xa6, 0x5b,
-	0xd6, 0xe2, 0x5e, 0x5a, 0x6f, 0xf4, 0xba, 0x00, 0xdd, 0x26, 0xa4, 0xce, 0xe0, 0x20, 0xa1, 0xf0,
-	0xb3, 0xcb, 0xb0, 0xdc, 0x5d, 0x44, 0xe7, 0x37, 0xb2, 0x5f, 0x48, 0x70, 0x54, 0x45, 0x55, 0xbb,
-	0x81, 0x98, 0xa4, 0x7d, 0xa6, 0x91, 0x1f, 0xdf, 0xd9, 0x3d, 0x78, 0x02, 0x4f, 0x84, 0x4e, 0xe0,
-	0x8a, 0x42, 0x60, 0xaf, 0xdd, 0xf4, 0xf9, 0xda, 0xff, 0x5c, 0x82, 0xa5, 0x4d, 0xe4, 0x54, 0x4d,
-	0x4b, 0x77, 0xd1, 0x41, 0x56, 0xdd, 0x86, 0x09, 0x57, 0xc8, 0x09, 0x2d, 0xf6, 0x6a, 0xd7, 0xc5,
-	0xee, 0x3a, 0x03, 0x35, 0xeb, 0x09, 0x17, 0x0b, 0x7c, 0x1c, 0x94, 0x4e, 0x6c, 0x5c, 0xbf, 0x9f,
-	0x48, 0x70, 0x84, 0xa6, 0xb5, 0x0e, 0x58, 0x2e, 0xe0, 0x10, 0x19, 0x7d, 0x97, 0x0b, 0x74, 0x1c,
-	0x59, 0x4d, 0x53, 0xa1, 0x42, 0x9f, 0x0b, 0x90, 0x6f, 0x47, 0xde, 0xd9, 0x4d, 0xbf, 0x9f, 0x80,
-	0x13, 0x5c, 0x08, 0x83, 0xd1, 0x83, 0xa8, 0x5a, 0x6d, 0xb3, 0x15, 0x5c, 0xeb, 0x41, 0xd7, 0x1e,
-	0xa6, 0x10, 0xda, 0x0d, 0xe4, 0xe7, 0x7c, 0xc0, 0xc9, 0x2b, 0x05, 0xa2, 0x49, 0xa5, 0x9c, 0x20,
-	0x29, 0x0a, 0x0a, 0x91, 0x0e, 0xea, 0x82, 0xbb, 0x83, 0x8f, 0x1f, 0x77, 0x87, 0xda, 0xe1, 0xee,
-	0x32, 0x9c, 0xec, 0x66, 0x11, 0xee, 0xa2, 0xbf, 0x96, 0x60, 0x41, 0x5c, 0xce, 0xfc, 0xe7, 0xd6,
-	0x2f, 0x04, 0xc4, 0x9c, 0x87, 0x19, 0x13, 0x6b, 0x31, 0x35, 0x0c, 0x74, 0x6d, 0x92, 0xea, 0xa4,
-	0x89, 0xaf, 0x85, 0x8b, 0x13, 0x94, 0x3c, 0x1c, 0x8e, 0x57, 0x88, 0x6b, 0xfc, 0xd9, 0x00, 0x39,
-	0xb9, 0x91, 0x73, 0xec, 0x1a, 0xb1, 0x9b, 0x37, 0xda, 0x7e, 0x4e, 0x9d, 0x8f, 0x4f, 0xf5, 0x25,
-	0x48, 0xb7, 0x5c, 0xb2, 0xf5, 0x38, 0xe5, 0xb5, 0x15, 0x0d, 0xf9, 0x55, 0x98, 0x14, 0x87, 0x52,
-	0xe3, 0x20, 0x7e, 0x27, 0x7b, 0x52, 0x5a, 0xc3, 0xaf, 0x7b, 0xc7, 0x69, 0x9a, 0xca, 0xa4, 0x89,
-	0x8b, 0xa1, 0x7e, 0x12, 0x17, 0xe3, 0x2d, 0x76, 0xda, 0xa0, 0x9c, 0x22, 0x38, 0xd1, 0xd1, 0xea,
-	0x7c, 0x7d, 0x7e, 0x2c, 0xc1, 0xe2, 0x15, 0x84, 0x4b, 0x8e, 0xb9, 0x75, 0xa0, 0x3d, 0xe1, 0x35,
-	0x18, 0xe9, 0xf7, 0xa4, 0xdc, 0x6d, 0x58, 0x55, 0x48, 0x54, 0xde, 0x4b, 0xc0, 0x52, 0x07, 0x6a,
-	0x8e, 0x99, 0xaf, 0x43, 0xb6, 0x95, 0x6a, 0x2d, 0xd9, 0xd6, 0xb6, 0xb9, 0xc3, 0x6f, 0xce, 0x67,
-	0xe3, 0xe7, 0x12, 0xbb, 0x40, 0x6b, 0x94, 0x51, 0x1d, 0x47, 0xc1, 0x06, 0x79, 0x07, 0x66, 0x63,
-	0x32, 0xba, 0x34, 0x7f, 0xcc, 0x14, 0x5e, 0xe9, 0x63, 0x10, 0x9a, 0x35, 0x9e, 0xde, 0x8b, 0x6b,
-	0x96, 0x5f, 0x07, 0xb9, 0x86, 0x2c, 0xc3, 0xb4, 0x76, 0x34, 0x9d, 0x1d, 0x9b, 0x4d, 0x84, 0x73,
-	0x09, 0x9a, 0x2b, 0x3d, 0xdd, 0x7e, 0x8c, 0x75, 0xc6, 0x23, 0x4e, 0xda, 0x74, 0x84, 0x89, 0x5a,
-	0xa0, 0xd1, 0x44, 0x58, 0x7e, 0x13, 0xb2, 0x42, 0x3a, 0x05, 0x32, 0x87, 0x3e, 0x33, 0x13, 0xd9,
-	0xe7, 0xbb, 0xca, 0x0e, 0xfa, 0x12, 0x1d, 0x61, 0xbc, 0xe6, 0xeb, 0x72, 0x90, 0xa5, 0x7c, 0x23,
-	0x01, 0x39, 0x95, 0x57, 0x22, 0x22, 0xea, 0x8b, 0xf8, 0xce, 0xb9, 0x2f, 0x44, 0x8c, 0x6f, 0xc3,
-	0x74, 0xf0, 0xb5, 0xb2, 0xa9, 0x99, 0x2e, 0xaa, 0x0a, 0xd3, 0x9e, 0xeb, 0xeb, 0xc5, 0xb2, 0x59,
-	0x74, 0x51, 0x55, 0x9d, 0x6c, 0x44, 0xda, 0xb0, 0x7c, 0x11, 0x86, 0x69, 0x04, 0x63, 0x8e, 0x0d,
-	0x6d, 0x73, 0x6c, 0x57, 0x74, 0x57, 0x5f, 0xad, 0xd8, 0x5b, 0x2a, 0xa7, 0x97, 0xaf, 0x41, 0xc6,
-	0x42, 0x7b, 0x1a, 0xd9, 0xf8, 0xb9, 0x84, 0xa1, 0x1e, 0x25, 0xa4, 0x2d, 0xb4, 0xa7, 0xd6, 0x59,
-	0xec, 0x63, 0x65, 0x01, 0xe6, 0x62, 0x96, 0x80, 0x07, 0xfc, 0x8f, 0x24, 0x98, 0xd9, 0x68, 0x5a,
-	0xa5, 0x8d, 0xb2, 0xee, 0x18, 0xfc, 0x0d, 0x93, 0x2f, 0xcf, 0x09, 0xc8, 0x60, 0xbb, 0xee, 0x94,
-	0x90, 0x56, 0xaa, 0xd4, 0xb1, 0x8b, 0x1c, 0xbe, 0x40, 0x63, 0xac, 0x75, 0x8d, 0x35, 0xca, 0x73,
-	0x90, 0xc4, 0x84, 0x59, 0x3c, 0x1f, 0x0d, 0xa9, 0x23, 0xf4, 0xbb, 0x68, 0xc8, 0x97, 0x21, 0xc5,
-	0x1e, 0x53, 0x59, 0xfa, 0x32, 0xd1, 0x63, 0xfa, 0x12, 0x18, 0x13, 0x69, 0x56, 0xe6, 0x60, 0x36,
-	0x32, 0x3d, 0x71, 0x79, 0x19, 0x82, 0x49, 0xd2, 0x27, 0x7c, 0xbc, 0x0f, 0xb7, 0x3a, 0x0a, 0x29,
-	0xcf, 0xad, 0xf8, 0xb4, 0x47, 0x55, 0x10, 0x4d, 0x45, 0xc3, 0x77, 0xe0, 0x4a, 0xf8, 0x0e, 0x5c,
-	0x72, 0x0e, 0x46, 0xf8, 0x1a, 0xf3, 0x8c, 0xb8, 0xf8, 0x24, 0x83, 0xb6, 0x92, 0xb5, 0xad, 0x17,
-	0x2c, 0xaf, 0x8d, 0xbe, 0xd7, 0x86, 0x1f, 0x5e, 0x86, 0xf7, 0xf7, 0xf0, 0x72, 0x04, 0x40, 0xe4,
-	0x04, 0x4d, 0xf6, 0xc4, 0x95, 0x50, 0x47, 0x79, 0x0b, 0xad, 0x66, 0x08, 0xa6, 0xa9, 0x93, 0xfb,
-	0x49, 0x53, 0xaf, 0xf3, 0x0a, 0x8a, 0x56, 0x9a, 0x8b, 0xca, 0x1a, 0xed, 0x51, 0xd6, 0x04, 0x61,
-	0xf6, 0xd2, 0x53, 0x54, 0xe2, 0x25, 0x18, 0x11, 0xd9, 0x66, 0xe8, 0x31, 0xdb, 0x2c, 0x18, 0xfc,
-	0x49, 0xf3, 0x54, 0x30, 0x69, 0xbe, 0x06, 0x69, 0x56, 0xe9, 0xc1, 0x0b, 0x41, 0xd3, 0x3d, 0x16,
-	0x82, 0xa6, 0x68, 0x11, 0x08, 0xaf, 0x01, 0x3d, 0x03, 0xb4, 0x86, 0x93, 0x1e, 0x73, 0x90, 0xa3,
-	0x99, 0x06, 0xb2, 0x5c, 0xd3, 0x6d, 0xd2, 0x17, 0xad, 0x51, 0x55, 0x26, 0x7d, 0x77, 0x69, 0x57,
-	0x91, 0xf7, 0xc8, 0x77, 0x61, 0x3c, 0x84, 0x1e, 0xbc, 0xd2, 0xa1, 0xd0, 0x1f, 0x6e, 0xa8, 0x99,
-	0x20, 0x66, 0x28, 0x33, 0x30, 0x15, 0xf4, 0x69, 0xee, 0xec, 0xef, 0x48, 0xb0, 0x20, 0xf6, 0xbc,
-	0xcf, 0xb9, 0xa8, 0x49, 0xf9, 0xbb, 0x04, 0x87, 0xe3, 0xe7, 0xc2, 0xb7, 0xde, 0x32, 0x4c, 0x96,
-	0xf4, 0x52, 0x19, 0x05, 0x4b, 0xc7, 0xf9, 0xee, 0x7b, 0x31, 0xd6, 0x42, 0xbe, 0xe2, 0x73, 0xff,
-	0xf8, 0x01, 0xf1, 0x13, 0x54, 0xa8, 0xbf, 0x49, 0xb6, 0x60, 0xc6, 0xd0, 0x5d, 0x7d, 0x4b, 0xc7,
-	0xe1, 0xc1, 0x06, 0x0e, 0x38, 0xd8, 0x94, 0x90, 0xeb, 0x6f, 0x55, 0x7e, 0x27, 0xc1, 0xbc, 0x50,
-	0x9d, 0x2f, 0xd9, 0x0d, 0x1b, 0xfb, 0x53, 0xc7, 0x65, 0x1b, 0xbb, 0x9a, 0x6e, 0x18, 0x0e, 0xc2,
-	0x58, 0xac, 0x02, 0x69, 0xbb, 0xcc, 0x9a, 0x3a, 0xc1, 0x65, 0x78, 0x0d, 0x13, 0xbd, 0xee, 0x87,
-	0x83, 0x07, 0xdf, 0x0f, 0x95, 0x07, 0x03, 0x2d, 0x07, 0x0b, 0x68, 0xc6, 0xd7, 0xf4, 0x18, 0x8c,
-	0xd1, 0x79, 0x62, 0xcd, 0xaa, 0x57, 0xb7, 0xf8, 0x66, 0x30, 0xa4, 0xa6, 0x59, 0xe3, 0x6d, 0xda,
-	0x26, 0x2f, 0xc0, 0xa8, 0x50, 0x0e, 0xe7, 0x06, 0x16, 0x13, 0xcb, 0x43, 0x6a, 0x92, 0x6b, 0x87,
-	0xe5, 0x37, 0x60, 0xbc, 0xa5, 0x1e, 0x5d, 0xca, 0x8e, 0xf5, 0xf0, 0x1e, 0x2d, 0x51, 0xc1, 0x7b,
-	0xf5, 0x59, 0x23, 0x7c, 0xf4, 0xac, 0x91, 0xb1, 0x02, 0x6d, 0xf2, 0x33, 0x30, 0xcb, 0xc6, 0x2e,
-	0xd9, 0x96, 0xeb, 0xd8, 0x95, 0x0a, 0x72, 0x44, 0x29, 0xcf, 0x20, 0x35, 0xe4, 0x34, 0xed, 0x5e,
-	0xf3, 0x7a, 0x79, 0x9d, 0x23, 0xc1, 0x16, 0xbe, 0x5c, 0xec, 0x25, 0x53, 0x7c, 0x2a, 0x05, 0x98,
-	0x58, 0xab, 0xd8, 0x18, 0xd1, 0xcd, 0x47, 0x2c, 0xb1, 0x7f, 0xfd, 0xa4, 0xc0, 0xfa, 0x29, 0x53,
-	0x20, 0xfb, 0xe9, 0x45, 0xf5, 0x8c, 0x04, 0x13, 0x2c, 0x19, 0xe3, 0xbf, 0xda, 0xb5, 0x17, 0x23,
-	0x5f, 0x83, 0x24, 0xd9, 0xaa, 0x77, 0x08, 0xa8, 0x0c, 0xd0, 0x22, 0xa4, 0x27, 0x3b, 0x97, 0x38,
-	0xb1, 0x34, 0x2a, 0xe3, 0x50, 0x3d, 0x5e, 0xff, 0xf3, 0x6d, 0x22, 0xf0, 0x7c, 0x5b, 0x84, 0xf1,
-	0x86, 0x89, 0xcd, 0x2d, 0xb3, 0x62, 0xba, 0xcd, 0xfe, 0x5e, 0x16, 0x33, 0x2d, 0x46, 0xba, 0x3d,
-	0x4f, 0x81, 0xec, 0xd7, 0x8d, 0xab, 0xfc, 0x40, 0x82, 0x23, 0xd7, 0x91, 0xab, 0xb6, 0xfe, 0x82,
-	0x72, 0x8b, 0xfd, 0xfd, 0xc4, 0x3b, 0x5b, 0xbc, 0x08, 0xc3, 0xb4, 0x40, 0x81, 0x84, 0x48, 0xa2,
-	0xad, 0x0b, 0xf8, 0xfe, 0xc3, 0xc2, 0xf2, 0x0c, 0xde, 0x27, 0x2d, 0x65, 0x50, 0xb9, 0x0c, 0x12,
-	0x38, 0xfc, 0x88, 0x42, 0xdf, 0x0d, 0xf9, 0x7e, 0x9e, 0xe2, 0x6d, 0xc4, 0x77, 0x94, 0x77, 0x07,
-	0x20, 0xdf, 0x6e, 0x4a, 0xdc, 0xc3, 0xbf, 0x06, 0x19, 0xb6, 0x24, 0xfc, 0xbf, 0x32, 0x62, 0x6e,
-	0xaf, 0xf4, 0xf8, 0xd0, 0xd6, 0x59, 0x7c, 0x81, 0x7a, 0x85, 0x68, 0x65, 0x45, 0x09, 0x2c, 0xa2,
-	0x44, 0xdb, 0x7c, 0x13, 0xe4, 0x28, 0x91, 0xbf, 0x40, 0x61, 0x88, 0x15, 0x28, 0xdc, 0x0a, 0x16,
-	0x28, 0x5c, 0xe8, 0xd3, 0x76, 0xde, 0xcc, 0x5a, 0x35, 0x0b, 0xca, 0xdb, 0xb0, 0x78, 0x1d, 0xb9,
-	0x57, 0x5e, 0x7c, 0xb9, 0xc3, 0x9a, 0xdd, 0xe1, 0x55, 0x92, 0xe4, 0x92, 0x23, 0x6c, 0xd3, 0xef,
-	0xd8, 0x5e, 0x8d, 0x0c, 0x2d, 0x9c, 0x24, 0xbf, 0xb0, 0xf2, 0x6d, 0x09, 0x96, 0x3a, 0x0c, 0xce,
-	0x57, 0xe7, 0x2d, 0x98, 0xf0, 0x89, 0xa5, 0x89, 0x08, 0x31, 0x89, 0xf3, 0xfb, 0x98, 0x84, 0x9a,
-	0x75, 0x82, 0x0d, 0x58, 0xf9, 0x8e, 0x04, 0x53, 0xb4, 0x98, 0x43, 0xe0, 0x65, 0x1f, 0x7b, 0xeb,
-	0x4b, 0xe1, 0xfb, 0xee, 0x7f, 0x76, 0xbd, 0xef, 0xc6, 0x0d, 0xd5, 0xba, 0xe3, 0xee, 0xc2, 0x74,
-	0x88, 0x80, 0xdb, 0x41, 0x85, 0x64, 0xe8, 0x21, 0xf8, 0x99, 0x7e, 0x87, 0xe2, 0xcf, 0xbe, 0x9e,
-	0x1c, 0xe5, 0x7b, 0x12, 0x4c, 0xa9, 0x48, 0xaf, 0xd5, 0x2a, 0x2c, 0x81, 0x80, 0xfb, 0xd0, 0x7c,
-	0x23, 0xac, 0x79, 0x7c, 0xe1, 0x94, 0xff, 0x3f, 0x5e, 0x6c, 0x39, 0xa2, 0xc3, 0xb5, 0xb4, 0x9f,
-	0x85, 0xe9, 0x10, 0x01, 0x9f, 0xe9, 0x4f, 0x07, 0x60, 0x9a, 0xf9, 0x4a, 0xd8, 0x3b, 0xaf, 0xc2,
-	0xa0, 0x57, 0x18, 0x97, 0xf1, 0x5f, 0xf1, 0xe3, 0x10, 0xf3, 0x0a, 0xd2, 0x8d, 0x17, 0x91, 0xeb,
-	0x22, 0x87, 0xd6, 0x98, 0xd0, 0x5a, 0x04, 0xca, 0xde, 0x69, 0x7b, 0x8e, 0xde, 0x87, 0x12, 0x71,
-	0xf7, 0xa1, 0x0b, 0x90, 0x33, 0x2d, 0x42, 0x61, 0x36, 0x90, 0x86, 0x2c, 0x0f, 0x4e, 0x5a, 0x65,
-	0x34, 0xd3, 0x5e, 0xff, 0x55, 0x4b, 0x04, 0x7b, 0xd1, 0x90, 0x9f, 0x84, 0x89, 0xaa, 0x7e, 0xdf,
-	0xac, 0xd6, 0xab, 0x5a, 0x8d, 0xd0, 0x63, 0xf3, 0x6d, 0xf6, 0x07, 0xad, 0x21, 0x75, 0x9c, 0x77,
-	0xac, 0xeb, 0x3b, 0x68, 0xc3, 0x7c, 0x1b, 0xc9, 0x27, 0x61, 0x9c, 0x56, 0xcc, 0x51, 0x42, 0x56,
-	0xea, 0x35, 0x4c, 0x4b, 0xbd, 0x68, 0x21, 0x1d, 0x21, 0x63, 0x85, 0xe1, 0x7f, 0x61, 0x7f, 0xf6,
-	0x09, 0xd8, 0x8b, 0x3b, 0xd2, 0x23, 0x32, 0x58, 0x6c, 0x5c, 0x0e, 0x3c, 0xc2, 0xb8, 0x8c, 0xd3,
-	0x35, 0x11, 0xa7, 0xeb, 0x1f, 0x24, 0x98, 0x5d, 0xaf, 0x3b, 0x3b, 0xe8, 0xcb, 0xe8, 0x1d, 0xca,
-	0x3c, 0xe4, 0xa2, 0xca, 0x89, 0x67, 0xee, 0x01, 0x98, 0xbd, 0x85, 0xbe, 0xa4, 0x9a, 0x3f, 0x96,
-	0xb8, 0x58, 0x85, 0x5c, 0xd4, 0x60, 0x3c, 0x30, 0x62, 0x64, 0x48, 0x71, 0x32, 0xde, 0xa5, 0x25,
-	0xdc, 0xdb, 0x0e, 0xc2, 0x65, 0x7f, 0xae, 0xbb, 0x1f, 0xf0, 0x7c, 0x35, 0x0c, 0x9e, 0xff, 0xd3,
-	0x23, 0x78, 0xb6, 0x1d, 0xb5, 0x85, 0xa1, 0xb4, 0xaa, 0x3b, 0x8e, 0x8e, 0xa9, 0xb9, 0x5a, 0xfb,
-	0xf0, 0xe3, 0xfc, 0xa1, 0x8f, 0x3e, 0xce, 0x1f, 0xfa, 0xf4, 0xe3, 0xbc, 0xf4, 0xf5, 0x87, 0x79,
-	0xe9, 0xbd, 0x87, 0x79, 0xe9, 0x57, 0x0f, 0xf3, 0xd2, 0x87, 0x0f, 0xf3, 0xd2, 0x9f, 0x1e, 0xe6,
-	0xa5, 0x3f, 0x3f, 0xcc, 0x1f, 0xfa, 0xf4, 0x61, 0x5e, 0x7a, 0xf0, 0x49, 0xfe, 0xd0, 0x87, 0x9f,
-	0xe4, 0x0f, 0x7d, 0xf4, 0x49, 0xfe, 0xd0, 0xab, 0x97, 0x76, 0xec, 0xd6, 0x14, 0x4d, 0xbb, 0xe3,
-	0x1f, 0xeb, 0xff, 0x2b, 0xd8, 0xb2, 0x35, 0x4c, 0x8f, 0x95, 0xe7, 0xff, 0x11, 0x00, 0x00, 0xff,
-	0xff, 0x36, 0x72, 0xdc, 0x41, 0x97, 0x3f, 0x00, 0x00,
+func (m *AnnotateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*StartWorkflowExecutionRequest)
-	if !ok {
-		that2, ok := that.(StartWorkflowExecutionRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *AnnotateWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AnnotateWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.StartRequest.Equal(that1.StartRequest) {
-		return false
-	}
-	if !this.ParentExecutionInfo.Equal(that1.ParentExecutionInfo) {
-		return false
+}
+func (m *AnnotateWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotateWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *AnnotateWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnnotateWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotateWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotateWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *AnnotateWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.Attempt != that1.Attempt {
-		return false
+	return ""
+}
+
+func (m *AnnotateWorkflowExecutionRequest) GetRequest() *v114.AnnotateWorkflowExecutionRequest {
+	if m != nil {
+		return m.Request
 	}
-	if that1.WorkflowExecutionExpirationTime == nil {
-		if this.WorkflowExecutionExpirationTime != nil {
-			return false
+	return nil
+}
+
+type AnnotateWorkflowExecutionResponse struct {
+}
+
+func (m *AnnotateWorkflowExecutionResponse) Reset()      { *m = AnnotateWorkflowExecutionResponse{} }
+func (*AnnotateWorkflowExecutionResponse) ProtoMessage() {}
+func (*AnnotateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{73}
+}
+func (m *AnnotateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnnotateWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AnnotateWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.WorkflowExecutionExpirationTime.Equal(*that1.WorkflowExecutionExpirationTime) {
-		return false
-	}
-	if this.ContinueAsNewInitiator != that1.ContinueAsNewInitiator {
-		return false
-	}
-	if !this.ContinuedFailure.Equal(that1.ContinuedFailure) {
-		return false
-	}
-	if !this.LastCompletionResult.Equal(that1.LastCompletionResult) {
-		return false
+		return b[:n], nil
 	}
-	if this.FirstWorkflowTaskBackoff != nil && that1.FirstWorkflowTaskBackoff != nil {
-		if *this.FirstWorkflowTaskBackoff != *that1.FirstWorkflowTaskBackoff {
-			return false
+}
+func (m *AnnotateWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotateWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *AnnotateWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnnotateWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotateWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotateWorkflowExecutionResponse proto.InternalMessageInfo
+
+type UpsertWorkflowSearchAttributesRequest struct {
+	NamespaceId string                                      `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v115.UpsertWorkflowSearchAttributesRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) Reset()      { *m = UpsertWorkflowSearchAttributesRequest{} }
+func (*UpsertWorkflowSearchAttributesRequest) ProtoMessage() {}
+func (*UpsertWorkflowSearchAttributesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{74}
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowSearchAttributesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.FirstWorkflowTaskBackoff != nil {
-		return false
-	} else if that1.FirstWorkflowTaskBackoff != nil {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesRequest.Merge(m, src)
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowSearchAttributesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowSearchAttributesRequest proto.InternalMessageInfo
+
+func (m *UpsertWorkflowSearchAttributesRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
+	return ""
+}
 
-	that1, ok := that.(*StartWorkflowExecutionResponse)
-	if !ok {
+func (m *UpsertWorkflowSearchAttributesRequest) GetRequest() *v115.UpsertWorkflowSearchAttributesRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type UpsertWorkflowSearchAttributesResponse struct {
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) Reset() {
+	*m = UpsertWorkflowSearchAttributesResponse{}
+}
+func (*UpsertWorkflowSearchAttributesResponse) ProtoMessage() {}
+func (*UpsertWorkflowSearchAttributesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{75}
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpsertWorkflowSearchAttributesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesResponse.Merge(m, src)
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpsertWorkflowSearchAttributesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertWorkflowSearchAttributesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertWorkflowSearchAttributesResponse proto.InternalMessageInfo
+
+type ApplyOperatorActionRequest struct {
+	NamespaceId string                           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v114.ApplyOperatorActionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *ApplyOperatorActionRequest) Reset()      { *m = ApplyOperatorActionRequest{} }
+func (*ApplyOperatorActionRequest) ProtoMessage() {}
+func (*ApplyOperatorActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{76}
+}
+func (m *ApplyOperatorActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplyOperatorActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplyOperatorActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplyOperatorActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyOperatorActionRequest.Merge(m, src)
+}
+func (m *ApplyOperatorActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplyOperatorActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyOperatorActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyOperatorActionRequest proto.InternalMessageInfo
+
+func (m *ApplyOperatorActionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *ApplyOperatorActionRequest) GetRequest() *v114.ApplyOperatorActionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type ApplyOperatorActionResponse struct {
+}
+
+func (m *ApplyOperatorActionResponse) Reset()      { *m = ApplyOperatorActionResponse{} }
+func (*ApplyOperatorActionResponse) ProtoMessage() {}
+func (*ApplyOperatorActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{77}
+}
+func (m *ApplyOperatorActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplyOperatorActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplyOperatorActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplyOperatorActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyOperatorActionResponse.Merge(m, src)
+}
+func (m *ApplyOperatorActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplyOperatorActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyOperatorActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyOperatorActionResponse proto.InternalMessageInfo
+
+type GetReplicationEventChunkRequest struct {
+	NamespaceId string                                `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Request     *v114.GetReplicationEventChunkRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (m *GetReplicationEventChunkRequest) Reset()      { *m = GetReplicationEventChunkRequest{} }
+func (*GetReplicationEventChunkRequest) ProtoMessage() {}
+func (*GetReplicationEventChunkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{78}
+}
+func (m *GetReplicationEventChunkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationEventChunkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationEventChunkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplicationEventChunkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationEventChunkRequest.Merge(m, src)
+}
+func (m *GetReplicationEventChunkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationEventChunkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationEventChunkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationEventChunkRequest proto.InternalMessageInfo
+
+func (m *GetReplicationEventChunkRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *GetReplicationEventChunkRequest) GetRequest() *v114.GetReplicationEventChunkRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+type GetReplicationEventChunkResponse struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *GetReplicationEventChunkResponse) Reset()      { *m = GetReplicationEventChunkResponse{} }
+func (*GetReplicationEventChunkResponse) ProtoMessage() {}
+func (*GetReplicationEventChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{79}
+}
+func (m *GetReplicationEventChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationEventChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationEventChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplicationEventChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationEventChunkResponse.Merge(m, src)
+}
+func (m *GetReplicationEventChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationEventChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationEventChunkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationEventChunkResponse proto.InternalMessageInfo
+
+func (m *GetReplicationEventChunkResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
+	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
+	proto.RegisterType((*GetMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.GetMutableStateRequest")
+	proto.RegisterType((*GetMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.GetMutableStateResponse")
+	proto.RegisterType((*PollMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.PollMutableStateRequest")
+	proto.RegisterType((*PollMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.PollMutableStateResponse")
+	proto.RegisterType((*ResetStickyTaskQueueRequest)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueRequest")
+	proto.RegisterType((*ResetStickyTaskQueueResponse)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueResponse")
+	proto.RegisterType((*RecordWorkflowTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedRequest")
+	proto.RegisterType((*RecordWorkflowTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse")
+	proto.RegisterMapType((map[string]*v19.WorkflowQuery)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse.QueriesEntry")
+	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedRequest")
+	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedResponse")
+	proto.RegisterType((*RespondWorkflowTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedRequest")
+	proto.RegisterType((*RespondWorkflowTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedResponse")
+	proto.RegisterType((*RespondWorkflowTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedRequest")
+	proto.RegisterType((*RespondWorkflowTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedResponse")
+	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatRequest")
+	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatResponse")
+	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedRequest")
+	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedResponse")
+	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedRequest")
+	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedResponse")
+	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledRequest")
+	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledResponse")
+	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionResponse")
+	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateRequest")
+	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateResponse")
+	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionRequest")
+	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionRequest")
+	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionResponse")
+	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionRequest")
+	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionResponse")
+	proto.RegisterType((*ScheduleWorkflowTaskRequest)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskRequest")
+	proto.RegisterType((*ScheduleWorkflowTaskResponse)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskResponse")
+	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedRequest")
+	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedResponse")
+	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionRequest")
+	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionResponse")
+	proto.RegisterMapType((map[string]*v14.Payload)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionResponse.WorkflowReportsEntry")
+	proto.RegisterType((*ReplicateEventsV2Request)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Request")
+	proto.RegisterType((*ReplicateEventsV2Response)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Response")
+	proto.RegisterType((*SyncShardStatusRequest)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusRequest")
+	proto.RegisterType((*SyncShardStatusResponse)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusResponse")
+	proto.RegisterType((*SyncActivityRequest)(nil), "temporal.server.api.historyservice.v1.SyncActivityRequest")
+	proto.RegisterType((*SyncActivityResponse)(nil), "temporal.server.api.historyservice.v1.SyncActivityResponse")
+	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateRequest")
+	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.historyservice.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.historyservice.v1.CloseShardResponse")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.historyservice.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.historyservice.v1.RemoveTaskResponse")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v113.ReplicationMessages)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsResponse")
+	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesRequest")
+	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesResponse")
+	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesRequest")
+	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesResponse")
+	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesRequest")
+	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*AnnotateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.AnnotateWorkflowExecutionRequest")
+	proto.RegisterType((*AnnotateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.AnnotateWorkflowExecutionResponse")
+	proto.RegisterType((*UpsertWorkflowSearchAttributesRequest)(nil), "temporal.server.api.historyservice.v1.UpsertWorkflowSearchAttributesRequest")
+	proto.RegisterType((*UpsertWorkflowSearchAttributesResponse)(nil), "temporal.server.api.historyservice.v1.UpsertWorkflowSearchAttributesResponse")
+	proto.RegisterType((*ApplyOperatorActionRequest)(nil), "temporal.server.api.historyservice.v1.ApplyOperatorActionRequest")
+	proto.RegisterType((*ApplyOperatorActionResponse)(nil), "temporal.server.api.historyservice.v1.ApplyOperatorActionResponse")
+	proto.RegisterType((*GetReplicationEventChunkRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationEventChunkRequest")
+	proto.RegisterType((*GetReplicationEventChunkResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationEventChunkResponse")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/historyservice/v1/request_response.proto", fileDescriptor_b8c78c1d460a3711)
+}
+
+var fileDescriptor_b8c78c1d460a3711 = []byte{
+	// 4135 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xcb, 0x6f, 0x24, 0xc7,
+	0x79, 0xdf, 0xe6, 0xf0, 0x31, 0xfc, 0x48, 0x0e, 0x67, 0x9a, 0xaf, 0x21, 0xa9, 0x1d, 0x92, 0xbd,
+	0xcb, 0x15, 0x65, 0x7b, 0x87, 0xda, 0xdd, 0x58, 0x2b, 0x6f, 0x62, 0x2b, 0x24, 0x97, 0xbb, 0x3b,
+	0x0b, 0xed, 0x8a, 0x6a, 0xae, 0x25, 0x47, 0xb6, 0xd5, 0x6a, 0x76, 0x17, 0x67, 0x3a, 0x9c, 0xe9,
+	0x1e, 0x75, 0x55, 0x93, 0x3b, 0xca, 0x21, 0x2f, 0x04, 0xc8, 0x03, 0x08, 0x04, 0xe4, 0x62, 0xc4,
+	0x0e, 0x02, 0x04, 0x46, 0x92, 0x4b, 0xe0, 0x43, 0x0e, 0x81, 0x11, 0xe4, 0x90, 0x5b, 0x6e, 0x11,
+	0x72, 0x89, 0x91, 0x1c, 0x12, 0xad, 0x80, 0x20, 0x41, 0x12, 0xc0, 0x01, 0xf2, 0x07, 0x04, 0xf5,
+	0xea, 0xc7, 0x4c, 0xcf, 0x8b, 0x5c, 0x45, 0x8e, 0xa3, 0x1b, 0xe7, 0xab, 0xef, 0x51, 0x5f, 0xd5,
+	0x57, 0xbf, 0xaa, 0xfa, 0xea, 0x6b, 0xc2, 0xcf, 0x11, 0xd4, 0x68, 0x7a, 0xbe, 0x59, 0xdf, 0xc6,
+	0xc8, 0x3f, 0x45, 0xfe, 0xb6, 0xd9, 0x74, 0xb6, 0x6b, 0x0e, 0x26, 0x9e, 0xdf, 0xa2, 0x14, 0xc7,
+	0x42, 0xdb, 0xa7, 0x37, 0xb6, 0x7d, 0xf4, 0x7e, 0x80, 0x30, 0x31, 0x7c, 0x84, 0x9b, 0x9e, 0x8b,
+	0x51, 0xb9, 0xe9, 0x7b, 0xc4, 0x53, 0x37, 0xa5, 0x74, 0x99, 0x4b, 0x97, 0xcd, 0xa6, 0x53, 0x4e,
+	0x4a, 0x97, 0x4f, 0x6f, 0xac, 0x94, 0xaa, 0x9e, 0x57, 0xad, 0xa3, 0x6d, 0x26, 0x74, 0x14, 0x1c,
+	0x6f, 0xdb, 0x81, 0x6f, 0x12, 0xc7, 0x73, 0xb9, 0x9a, 0x95, 0xb5, 0xf6, 0x76, 0xe2, 0x34, 0x10,
+	0x26, 0x66, 0xa3, 0x29, 0x18, 0x36, 0x6c, 0xd4, 0x44, 0xae, 0x8d, 0x5c, 0xcb, 0x41, 0x78, 0xbb,
+	0xea, 0x55, 0x3d, 0x46, 0x67, 0x7f, 0x09, 0x96, 0xab, 0xa1, 0x23, 0xd4, 0x03, 0xcb, 0x6b, 0x34,
+	0x3c, 0x97, 0xf6, 0xbc, 0x81, 0x30, 0x36, 0xab, 0xa2, 0xc3, 0x2b, 0x9b, 0x09, 0x2e, 0xd1, 0xd3,
+	0x4e, 0xb6, 0x17, 0x13, 0x6c, 0xc4, 0xc4, 0x27, 0xef, 0x07, 0x28, 0x40, 0x9d, 0x8c, 0x49, 0xab,
+	0xc8, 0x0d, 0x1a, 0x98, 0x32, 0x9d, 0x79, 0xfe, 0xc9, 0x71, 0xdd, 0x3b, 0x13, 0x5c, 0xd7, 0x12,
+	0x5c, 0xb2, 0xb1, 0x53, 0xdb, 0x95, 0x04, 0xdf, 0xfb, 0x01, 0xf2, 0x5b, 0xfd, 0x5c, 0x38, 0x36,
+	0x9d, 0x7a, 0xe0, 0xa7, 0xf4, 0xec, 0x4b, 0x3d, 0x26, 0xb6, 0x93, 0xfb, 0xa5, 0x34, 0xee, 0xd0,
+	0x1d, 0x3e, 0x9a, 0x82, 0xf5, 0x8b, 0x3d, 0x59, 0xdb, 0x3c, 0x7f, 0xb1, 0x27, 0x33, 0x1d, 0x58,
+	0xc1, 0x78, 0x3d, 0x8d, 0xb1, 0xfb, 0x48, 0x95, 0xd3, 0xd8, 0x5d, 0xb3, 0x81, 0x70, 0xd3, 0xb4,
+	0x52, 0x46, 0xe3, 0xe5, 0x34, 0x7e, 0x1f, 0x35, 0xeb, 0x8e, 0xc5, 0x02, 0xb1, 0x53, 0xe2, 0xb5,
+	0x34, 0x89, 0x26, 0xf2, 0xb1, 0x83, 0x09, 0x72, 0xb9, 0x0d, 0xd9, 0x3f, 0xa3, 0x11, 0x10, 0xf3,
+	0xa8, 0x8e, 0x0c, 0x4c, 0x4c, 0x22, 0x15, 0xdc, 0x1a, 0x40, 0x01, 0x7a, 0x8a, 0xac, 0x80, 0xda,
+	0xc7, 0x42, 0xe8, 0x95, 0xd4, 0x48, 0xe9, 0xbb, 0x10, 0x57, 0xee, 0xa4, 0x19, 0x33, 0xed, 0x86,
+	0xe3, 0xf6, 0x97, 0xdd, 0x4f, 0x93, 0xc5, 0xc8, 0xf4, 0xad, 0x9a, 0x49, 0x88, 0xef, 0x1c, 0x05,
+	0x04, 0xe1, 0xbe, 0x6a, 0xb4, 0xdf, 0x19, 0x87, 0xcb, 0x87, 0xc4, 0xf4, 0xc9, 0xdb, 0xa2, 0xd7,
+	0xfb, 0xd2, 0x39, 0x9d, 0x0b, 0xa8, 0x1b, 0x30, 0x1d, 0x4e, 0x91, 0xe1, 0xd8, 0x45, 0x65, 0x5d,
+	0xd9, 0x9a, 0xd4, 0xa7, 0x42, 0x5a, 0xc5, 0x56, 0x2d, 0x98, 0xc1, 0x54, 0x87, 0x21, 0x8c, 0x14,
+	0x47, 0xd6, 0x95, 0xad, 0xa9, 0x9b, 0x5f, 0x0b, 0xe7, 0x9b, 0x21, 0x4c, 0xdb, 0xb8, 0x94, 0x4f,
+	0x6f, 0x94, 0x7b, 0x5a, 0xd6, 0xa7, 0x99, 0x52, 0xd9, 0x8f, 0x1a, 0x2c, 0x34, 0x4d, 0x1f, 0xb9,
+	0xc4, 0x08, 0xc7, 0xdf, 0x70, 0xdc, 0x63, 0xaf, 0x98, 0x61, 0xc6, 0x7e, 0xa6, 0x9c, 0x86, 0x6a,
+	0x61, 0x60, 0x9f, 0xde, 0x28, 0x1f, 0x30, 0xe9, 0xd0, 0x4a, 0xc5, 0x3d, 0xf6, 0xf4, 0xb9, 0x66,
+	0x27, 0x51, 0x2d, 0xc2, 0x84, 0x49, 0xa8, 0x36, 0x52, 0x1c, 0x5d, 0x57, 0xb6, 0xc6, 0x74, 0xf9,
+	0x53, 0x6d, 0x80, 0x16, 0x46, 0x4f, 0xd4, 0x0b, 0xf4, 0xb4, 0xe9, 0x70, 0x64, 0x34, 0x28, 0x04,
+	0x16, 0xc7, 0x58, 0x87, 0x56, 0xca, 0x1c, 0x1f, 0xcb, 0x12, 0x1f, 0xcb, 0x4f, 0x24, 0x3e, 0xee,
+	0x8e, 0x7e, 0xf8, 0x4f, 0x6b, 0x8a, 0xbe, 0x76, 0xd6, 0xee, 0xf9, 0x7e, 0xa8, 0x89, 0xf2, 0xaa,
+	0x35, 0x58, 0xb6, 0x3c, 0x97, 0x38, 0x6e, 0x80, 0x0c, 0x13, 0x1b, 0x2e, 0x3a, 0x33, 0x1c, 0xd7,
+	0x21, 0x8e, 0x49, 0x3c, 0xbf, 0x38, 0xbe, 0xae, 0x6c, 0xe5, 0x6e, 0x5e, 0x4f, 0x8e, 0x31, 0x5b,
+	0xa4, 0xd4, 0xd9, 0x3d, 0x21, 0xb7, 0x83, 0x1f, 0xa3, 0xb3, 0x8a, 0x14, 0xd2, 0x17, 0xad, 0x54,
+	0xba, 0xfa, 0x08, 0x0a, 0xb2, 0xc5, 0x36, 0x04, 0x3a, 0x15, 0x27, 0x98, 0x1f, 0xeb, 0x49, 0x0b,
+	0xa2, 0x91, 0xda, 0xb8, 0xc7, 0xff, 0xd4, 0xf3, 0xa1, 0xa8, 0xa0, 0xa8, 0x6f, 0xc1, 0x62, 0xdd,
+	0xc4, 0xc4, 0xb0, 0xbc, 0x46, 0xb3, 0x8e, 0xd8, 0xc8, 0xf8, 0x08, 0x07, 0x75, 0x52, 0xcc, 0xa6,
+	0xe9, 0x14, 0x48, 0xc5, 0xe6, 0xa8, 0x55, 0xf7, 0x4c, 0x1b, 0xeb, 0xf3, 0x54, 0x7e, 0x2f, 0x14,
+	0xd7, 0x99, 0xb4, 0xfa, 0x2e, 0xac, 0x1e, 0x3b, 0x3e, 0x26, 0x46, 0x38, 0x0b, 0x14, 0x8c, 0x8c,
+	0x23, 0xd3, 0x3a, 0xf1, 0x8e, 0x8f, 0x8b, 0x93, 0x4c, 0xf9, 0x72, 0xc7, 0xc0, 0xdf, 0x15, 0x1b,
+	0xd7, 0xee, 0xe8, 0x77, 0xe8, 0xb8, 0x17, 0x99, 0x0e, 0x19, 0x76, 0x4f, 0x4c, 0x7c, 0xb2, 0xcb,
+	0x15, 0x68, 0xb7, 0xa1, 0xd4, 0x2d, 0x24, 0xf9, 0xaa, 0x51, 0x17, 0x60, 0xdc, 0x0f, 0xdc, 0x68,
+	0x1d, 0x8c, 0xf9, 0x81, 0x5b, 0xb1, 0xb5, 0x7f, 0x57, 0x60, 0xf1, 0x3e, 0x22, 0x8f, 0x38, 0xa2,
+	0x1c, 0x12, 0x93, 0xa0, 0x21, 0xd6, 0xcf, 0x7d, 0x98, 0x0c, 0xa3, 0x49, 0xac, 0x9d, 0x97, 0xba,
+	0x8d, 0x50, 0x67, 0xd7, 0x22, 0x59, 0xf5, 0x16, 0x2c, 0xa2, 0xa7, 0x4d, 0x64, 0x11, 0x64, 0x1b,
+	0x2e, 0x7a, 0x4a, 0x0c, 0x74, 0x4a, 0x17, 0x8c, 0x63, 0xb3, 0x45, 0x92, 0xd1, 0xe7, 0x64, 0xeb,
+	0x63, 0xf4, 0x94, 0xec, 0xd3, 0xb6, 0x8a, 0xad, 0xbe, 0x0c, 0xf3, 0x56, 0xe0, 0xb3, 0x95, 0x75,
+	0xe4, 0x9b, 0xae, 0x55, 0x33, 0x88, 0x77, 0x82, 0x5c, 0x16, 0xfb, 0xd3, 0xba, 0x2a, 0xda, 0x76,
+	0x59, 0xd3, 0x13, 0xda, 0xa2, 0xfd, 0x61, 0x16, 0x96, 0x3a, 0xbc, 0x15, 0x03, 0x94, 0xf0, 0x45,
+	0xb9, 0x80, 0x2f, 0x15, 0x98, 0x89, 0x66, 0xb9, 0xd5, 0x44, 0x62, 0x60, 0xae, 0xf6, 0x53, 0xf6,
+	0xa4, 0xd5, 0x44, 0xfa, 0xf4, 0x59, 0xec, 0x97, 0xaa, 0xc1, 0x4c, 0xda, 0x68, 0x4c, 0xb9, 0xb1,
+	0x51, 0xf8, 0x0a, 0x2c, 0x37, 0x7d, 0x74, 0xea, 0x78, 0x01, 0x36, 0x18, 0xee, 0x20, 0x3b, 0xe2,
+	0x1f, 0x65, 0xfc, 0x8b, 0x92, 0xe1, 0x90, 0xb7, 0x4b, 0xd1, 0xeb, 0x30, 0xc7, 0xa2, 0x9d, 0x87,
+	0x66, 0x28, 0x34, 0xc6, 0x84, 0xf2, 0xb4, 0xe9, 0x1e, 0x6d, 0x91, 0xec, 0x7b, 0x00, 0x2c, 0x6a,
+	0xd9, 0xe1, 0xa4, 0x38, 0x9e, 0xe6, 0x55, 0x78, 0x76, 0xa1, 0x8e, 0xd1, 0x00, 0x7d, 0x93, 0xfe,
+	0xd0, 0x27, 0x89, 0xfc, 0x53, 0x3d, 0x80, 0x02, 0x26, 0x8e, 0x75, 0xd2, 0x32, 0x62, 0xba, 0x26,
+	0x86, 0xd0, 0x35, 0xcb, 0xc5, 0x43, 0x82, 0xfa, 0x4b, 0xf0, 0xc5, 0x0e, 0x8d, 0x06, 0xb6, 0x6a,
+	0xc8, 0x0e, 0xea, 0xc8, 0x20, 0x1e, 0x1f, 0x15, 0x86, 0x70, 0x5e, 0x40, 0x8a, 0x53, 0x83, 0xad,
+	0xb5, 0xcd, 0x36, 0x33, 0x87, 0x42, 0xe1, 0x13, 0x8f, 0x0d, 0xe2, 0x13, 0xae, 0xad, 0x6b, 0x0c,
+	0xce, 0x74, 0x8b, 0x41, 0xf5, 0x9b, 0x90, 0x0b, 0xc3, 0x83, 0x6d, 0xe0, 0xc5, 0x59, 0x06, 0x88,
+	0xe9, 0xfb, 0x40, 0x88, 0x8b, 0x1d, 0x21, 0xc7, 0xa3, 0x37, 0x0c, 0x35, 0xf6, 0x53, 0x7d, 0x1b,
+	0x66, 0x13, 0xca, 0x03, 0x5c, 0xcc, 0x33, 0xed, 0xe5, 0x2e, 0x70, 0x9b, 0xaa, 0x36, 0xc0, 0x7a,
+	0x2e, 0xae, 0x37, 0xc0, 0xea, 0xb7, 0xa1, 0x70, 0x8a, 0x7c, 0x4c, 0x01, 0x91, 0x9f, 0xea, 0x1c,
+	0x84, 0x8b, 0x05, 0x36, 0x94, 0x2f, 0x97, 0x7b, 0x1c, 0xcb, 0xa9, 0x8d, 0xb7, 0xb8, 0xe0, 0x03,
+	0x29, 0xa7, 0xe7, 0x4f, 0xdb, 0x28, 0xea, 0xd7, 0xe0, 0x05, 0x07, 0x1b, 0x7c, 0xc8, 0xe3, 0xd3,
+	0x88, 0x5c, 0xba, 0x50, 0xed, 0xa2, 0xba, 0xae, 0x6c, 0x65, 0xf5, 0xa2, 0x83, 0x0f, 0x93, 0xb3,
+	0xb2, 0xcf, 0xdb, 0xd5, 0x97, 0xa0, 0xe0, 0x23, 0x8c, 0x88, 0x71, 0x64, 0x62, 0x64, 0x08, 0xa0,
+	0x9b, 0x63, 0x80, 0x95, 0x63, 0x0d, 0xbb, 0x26, 0x46, 0x3a, 0x45, 0xbc, 0x87, 0xa3, 0xd9, 0x6c,
+	0x7e, 0xf2, 0xe1, 0x68, 0x76, 0x32, 0x0f, 0x0f, 0x47, 0xb3, 0x90, 0x9f, 0x7a, 0x38, 0x9a, 0x9d,
+	0xce, 0xcf, 0x3c, 0x1c, 0xcd, 0xe6, 0xf2, 0xb3, 0xda, 0x7f, 0x28, 0xb0, 0x74, 0xe0, 0xd5, 0xeb,
+	0xff, 0x4f, 0x00, 0xf1, 0x07, 0x13, 0x50, 0xec, 0x74, 0xf7, 0x73, 0x44, 0xfc, 0x1c, 0x11, 0x9f,
+	0x3b, 0x22, 0x4e, 0x77, 0x45, 0xc4, 0x54, 0x6c, 0xc9, 0x3d, 0x37, 0x6c, 0xf9, 0x3f, 0x09, 0xb8,
+	0xa9, 0x30, 0x35, 0x93, 0xcf, 0x69, 0xbf, 0xa5, 0xc0, 0xaa, 0x8e, 0x30, 0x22, 0x6d, 0x48, 0xf8,
+	0x19, 0x80, 0x94, 0x56, 0x82, 0x17, 0xd2, 0xbb, 0xc2, 0x01, 0x44, 0xfb, 0xeb, 0x0c, 0xac, 0xeb,
+	0xc8, 0xf2, 0x7c, 0x3b, 0x7e, 0x66, 0x15, 0x4b, 0x6e, 0x88, 0x0e, 0x7f, 0x03, 0xd4, 0xce, 0xdb,
+	0xcb, 0xf0, 0x3d, 0x2f, 0x74, 0x5c, 0x5b, 0xd4, 0x35, 0x98, 0x0a, 0xd7, 0x45, 0x08, 0x26, 0x20,
+	0x49, 0x15, 0x5b, 0x5d, 0x82, 0x09, 0xb6, 0x86, 0x42, 0xe4, 0x18, 0xa7, 0x3f, 0x2b, 0xb6, 0x7a,
+	0x19, 0x40, 0xde, 0x4c, 0x05, 0x40, 0x4c, 0xea, 0x93, 0x82, 0x52, 0xb1, 0xd5, 0xf7, 0x60, 0xba,
+	0xe9, 0xd5, 0xeb, 0xe1, 0xc5, 0x92, 0x63, 0xc3, 0x57, 0xfb, 0x5e, 0x2c, 0x29, 0x18, 0xc7, 0x07,
+	0x2b, 0x3e, 0xb7, 0xfa, 0x14, 0x55, 0x29, 0xc7, 0x0d, 0x41, 0xa1, 0x6e, 0x12, 0xe4, 0x5a, 0x2d,
+	0xe3, 0xc8, 0x47, 0xe6, 0x89, 0xed, 0x9d, 0xb9, 0x02, 0x36, 0x5e, 0x4d, 0x8d, 0xec, 0x58, 0x32,
+	0x40, 0xe2, 0xc7, 0xeb, 0x5c, 0xc1, 0xae, 0x94, 0xa7, 0x10, 0x97, 0xa4, 0x68, 0xdf, 0xcd, 0xc2,
+	0x46, 0x8f, 0x39, 0x14, 0x5b, 0x45, 0x07, 0xc2, 0x2b, 0xe7, 0x46, 0xf8, 0x9e, 0xe8, 0x3d, 0xd2,
+	0x13, 0xbd, 0xbf, 0x04, 0xaa, 0x9c, 0x3a, 0xbb, 0x7d, 0x87, 0xc8, 0x87, 0x2d, 0x92, 0x7b, 0x0b,
+	0xf2, 0x5d, 0x76, 0x87, 0x1c, 0x4e, 0xea, 0xed, 0xd8, 0x74, 0xc6, 0x3a, 0x37, 0x9d, 0xd8, 0xdd,
+	0x7b, 0x3c, 0x79, 0xf7, 0x7e, 0x15, 0x8a, 0x02, 0x8d, 0x63, 0x37, 0x6f, 0x71, 0xae, 0x99, 0x60,
+	0xe7, 0x9a, 0x45, 0xde, 0x1e, 0xdd, 0xa6, 0x79, 0xab, 0x5a, 0x8d, 0xc5, 0x3d, 0x8f, 0x42, 0x9a,
+	0x36, 0xe0, 0x37, 0xd1, 0xaf, 0xf4, 0x43, 0xc6, 0x27, 0xbe, 0xe9, 0x62, 0x07, 0xb9, 0x89, 0xfb,
+	0x22, 0xcb, 0x1d, 0xe4, 0xcf, 0xda, 0x28, 0x6a, 0x15, 0x2e, 0xa7, 0xa4, 0x07, 0x62, 0xdb, 0xd1,
+	0xe4, 0x10, 0xdb, 0xd1, 0x4a, 0xc7, 0x32, 0x0b, 0xdb, 0xe8, 0x62, 0x4f, 0x6c, 0x0a, 0x53, 0x6c,
+	0x53, 0x98, 0x3a, 0x8a, 0xed, 0x06, 0xf7, 0x21, 0x17, 0x4d, 0x22, 0x4b, 0x4b, 0x4c, 0x0f, 0x98,
+	0x96, 0x98, 0x09, 0xe5, 0x68, 0x8b, 0xba, 0x07, 0xd3, 0x72, 0x7e, 0x99, 0x9a, 0x99, 0x01, 0xd5,
+	0x4c, 0x09, 0x29, 0xa6, 0xc4, 0x83, 0x09, 0x9a, 0x18, 0xe5, 0x3b, 0x52, 0x66, 0x6b, 0xea, 0xe6,
+	0xd7, 0xcb, 0x03, 0x25, 0xa1, 0xcb, 0x7d, 0xd7, 0x4c, 0xf9, 0x4d, 0xae, 0x77, 0xdf, 0x25, 0x7e,
+	0x4b, 0x97, 0x56, 0xd4, 0xbb, 0xb0, 0x86, 0x83, 0x6a, 0x15, 0xb1, 0x24, 0x44, 0x32, 0x85, 0xe2,
+	0x23, 0x13, 0x7b, 0x2e, 0x2e, 0xce, 0xae, 0x67, 0xb6, 0x26, 0xf5, 0x55, 0xc1, 0x96, 0x48, 0x98,
+	0xe8, 0x9c, 0x65, 0xe5, 0x3d, 0x98, 0x8e, 0xab, 0x57, 0xf3, 0x90, 0x39, 0x41, 0x2d, 0x81, 0xad,
+	0xf4, 0x4f, 0xf5, 0x0e, 0x8c, 0x9d, 0x9a, 0xf5, 0xa0, 0xcb, 0x59, 0x8c, 0x25, 0x83, 0xe3, 0x0b,
+	0x95, 0x6a, 0x6b, 0xe9, 0x5c, 0xe4, 0xce, 0xc8, 0xab, 0x0a, 0xdf, 0x93, 0x62, 0x08, 0xbf, 0x63,
+	0x11, 0xe7, 0xd4, 0x21, 0xad, 0xcf, 0x11, 0x7e, 0x00, 0x84, 0x8f, 0x0f, 0xd6, 0x67, 0x8e, 0xf0,
+	0xbf, 0x36, 0x2a, 0x11, 0x3e, 0x75, 0x0e, 0x05, 0xc2, 0x3f, 0x86, 0xd9, 0x36, 0x6c, 0x15, 0x18,
+	0xbf, 0x99, 0xf4, 0x38, 0x86, 0x40, 0xfc, 0x08, 0xd6, 0x62, 0x08, 0xa9, 0xe7, 0x92, 0xf8, 0xdb,
+	0xb1, 0x3a, 0x47, 0xce, 0xb3, 0x3a, 0x63, 0xa0, 0x9b, 0x49, 0x82, 0x2e, 0x82, 0x92, 0x3c, 0x85,
+	0x0a, 0x92, 0xd1, 0x86, 0x2a, 0xa3, 0x03, 0x1a, 0x5c, 0x15, 0x7a, 0x76, 0xb8, 0x9a, 0xc3, 0x04,
+	0xc6, 0x3c, 0x82, 0x42, 0x0d, 0x99, 0x3e, 0x39, 0x42, 0x26, 0x31, 0x6c, 0x44, 0x4c, 0xa7, 0x8e,
+	0x8b, 0x63, 0x03, 0xa6, 0x0a, 0xf3, 0xa1, 0xe8, 0x5d, 0x2e, 0xd9, 0xb9, 0x8d, 0x8e, 0x9f, 0x7b,
+	0x1b, 0xbd, 0x1e, 0x5b, 0x51, 0xe1, 0x4a, 0x63, 0xd1, 0x33, 0x19, 0x2d, 0x93, 0xc7, 0xb2, 0x41,
+	0xfb, 0xa1, 0x02, 0x57, 0xf8, 0x5c, 0x27, 0x30, 0x4b, 0x24, 0x32, 0x87, 0x5a, 0xcb, 0x1e, 0xe4,
+	0x45, 0xfa, 0x14, 0xb5, 0xe5, 0xd5, 0xef, 0xf6, 0x5d, 0x1c, 0x03, 0x74, 0x41, 0x9f, 0x95, 0xda,
+	0x05, 0x41, 0xfb, 0xae, 0x02, 0x57, 0x7b, 0x0b, 0x8a, 0x18, 0xc6, 0xd1, 0x8e, 0x2f, 0x5f, 0x13,
+	0x44, 0x10, 0x3f, 0x78, 0x5e, 0xa8, 0x4e, 0x2f, 0x63, 0x09, 0x82, 0xf6, 0x03, 0x05, 0xd6, 0xf9,
+	0x8f, 0x84, 0x1c, 0xcd, 0x38, 0x0f, 0x35, 0xac, 0x35, 0xc8, 0x1d, 0x33, 0x99, 0xb6, 0x41, 0xdd,
+	0x39, 0xcf, 0xa0, 0x26, 0xac, 0xeb, 0x33, 0xc7, 0xf1, 0x9f, 0xda, 0x15, 0xd8, 0xe8, 0x21, 0x22,
+	0xdc, 0xfa, 0xa1, 0x02, 0x5a, 0x27, 0x6a, 0x3c, 0x90, 0x11, 0x3d, 0x84, 0x63, 0xcd, 0xf8, 0x1a,
+	0x4a, 0xfa, 0xb6, 0x37, 0x80, 0x6f, 0xfd, 0xba, 0x10, 0x5b, 0x66, 0xd2, 0xc1, 0x03, 0xb8, 0xd2,
+	0x53, 0x4e, 0x84, 0xcb, 0x4b, 0x90, 0xb7, 0x4c, 0xd7, 0x42, 0x21, 0xc6, 0x23, 0xde, 0xff, 0xac,
+	0x3e, 0xcb, 0xe9, 0xba, 0x24, 0xc7, 0x97, 0x4f, 0x5c, 0xe7, 0x67, 0xb4, 0x7c, 0x7a, 0x75, 0xa1,
+	0x73, 0xf9, 0x5c, 0x83, 0xab, 0xbd, 0xe5, 0x3a, 0x03, 0x39, 0xce, 0xf8, 0xbf, 0x1f, 0xc8, 0x5d,
+	0xad, 0x77, 0x0f, 0xe4, 0x34, 0x11, 0xe1, 0xd6, 0x9f, 0xb3, 0x40, 0xee, 0xf4, 0x9f, 0xcd, 0xf0,
+	0x50, 0x8e, 0xfd, 0x22, 0xe4, 0x92, 0xf1, 0x32, 0x44, 0x14, 0xf7, 0xb3, 0xaf, 0xcf, 0x24, 0x42,
+	0x4e, 0xdb, 0x4c, 0x8f, 0xb7, 0x50, 0x48, 0x38, 0xf7, 0x2f, 0x23, 0x50, 0x3a, 0x74, 0xaa, 0xae,
+	0x59, 0xbf, 0xc8, 0x33, 0xe9, 0x31, 0xe4, 0x30, 0x53, 0xd2, 0xe6, 0xd8, 0x6b, 0xfd, 0xdf, 0x49,
+	0x7b, 0xda, 0xd6, 0x67, 0xb8, 0x5a, 0xd9, 0x15, 0x07, 0x56, 0xd1, 0x53, 0x82, 0x7c, 0x6a, 0x29,
+	0xe5, 0x38, 0x98, 0x19, 0xf6, 0x38, 0xb8, 0x2c, 0xb5, 0x75, 0x34, 0xa9, 0x65, 0x98, 0xb3, 0x6a,
+	0x4e, 0xdd, 0x8e, 0xec, 0x78, 0x6e, 0xbd, 0xc5, 0x0e, 0x05, 0x59, 0xbd, 0xc0, 0x9a, 0xa4, 0xd0,
+	0x1b, 0x6e, 0xbd, 0xa5, 0x96, 0xe8, 0x61, 0xd0, 0x46, 0x75, 0xe7, 0x14, 0xf9, 0x2d, 0xb6, 0xc3,
+	0x67, 0xf5, 0x18, 0x45, 0xdb, 0x80, 0xb5, 0xae, 0xbe, 0x8a, 0xb9, 0xf8, 0x3b, 0x05, 0x5e, 0x14,
+	0x3c, 0x0e, 0xa9, 0x5d, 0xf8, 0xed, 0xfa, 0xd7, 0x15, 0x58, 0x16, 0xb3, 0x72, 0xe6, 0x90, 0x9a,
+	0x91, 0xf6, 0x90, 0xfd, 0x60, 0xd0, 0x09, 0xea, 0xd7, 0x21, 0x7d, 0x11, 0x27, 0x19, 0x65, 0x1c,
+	0xee, 0xc0, 0x56, 0x7f, 0x15, 0xbd, 0x9f, 0x20, 0xff, 0x4a, 0x81, 0x35, 0x1d, 0x35, 0xbc, 0x53,
+	0xc4, 0x35, 0x9d, 0x33, 0xf5, 0xfe, 0xe9, 0x5d, 0x21, 0x92, 0x17, 0x81, 0x4c, 0xdb, 0x45, 0x40,
+	0xd3, 0x60, 0xbd, 0x7b, 0xf7, 0xc5, 0xdc, 0xff, 0x85, 0x02, 0x1b, 0x4f, 0x90, 0xdf, 0x70, 0x5c,
+	0x93, 0xa0, 0x8b, 0xcc, 0xba, 0x07, 0x05, 0x22, 0xf5, 0xb4, 0x4d, 0xf6, 0x6e, 0xdf, 0xc9, 0xee,
+	0xdb, 0x03, 0x3d, 0x1f, 0x2a, 0x97, 0x13, 0x7c, 0x15, 0xb4, 0x5e, 0x62, 0xc2, 0xbf, 0x3f, 0x51,
+	0xe0, 0x32, 0x4b, 0x05, 0x5e, 0xb0, 0x1a, 0x83, 0x3f, 0xe2, 0x0c, 0x5b, 0x8d, 0xd1, 0xd3, 0xb2,
+	0x3e, 0xcd, 0x94, 0x4a, 0x7f, 0x6e, 0x43, 0xa9, 0x1b, 0x7b, 0xef, 0x30, 0xfd, 0xbd, 0x0c, 0x6c,
+	0x0a, 0x25, 0x1c, 0x66, 0x2f, 0xe2, 0x6a, 0xa3, 0xcb, 0x56, 0x71, 0x6f, 0x00, 0x5f, 0x07, 0xe8,
+	0x42, 0xdb, 0x6e, 0xa1, 0x7e, 0x35, 0x06, 0xac, 0xa2, 0x10, 0xa3, 0x33, 0x43, 0x56, 0x94, 0x2c,
+	0x15, 0xc9, 0x21, 0x73, 0x5b, 0x7d, 0x70, 0x79, 0xf4, 0xd3, 0xc7, 0xe5, 0xb1, 0x2e, 0xb8, 0xac,
+	0x6d, 0xc1, 0xb5, 0x7e, 0x23, 0x22, 0x42, 0xf4, 0x6f, 0x15, 0x58, 0x95, 0x97, 0xb7, 0xf8, 0xb9,
+	0xf6, 0x27, 0x02, 0x62, 0x6e, 0xc1, 0xa2, 0x83, 0x8d, 0x94, 0x12, 0x11, 0x36, 0x37, 0x59, 0x7d,
+	0xce, 0xc1, 0xf7, 0xda, 0x6b, 0x3f, 0x68, 0xfa, 0x3d, 0xdd, 0x21, 0xe1, 0xf1, 0x7f, 0x8f, 0xc0,
+	0x55, 0x7e, 0xce, 0xdd, 0xa3, 0xe3, 0x16, 0x5a, 0x3b, 0xcf, 0xa9, 0xf4, 0xd3, 0x73, 0x7d, 0x03,
+	0xa6, 0xa3, 0x90, 0x8c, 0x1e, 0xf4, 0x42, 0x5a, 0xc5, 0x56, 0xdf, 0x81, 0x39, 0x79, 0x68, 0xb5,
+	0x2f, 0x12, 0x77, 0x6a, 0xa8, 0x25, 0x32, 0x7f, 0x10, 0x1e, 0xb7, 0x59, 0x5e, 0x96, 0x25, 0x36,
+	0xc6, 0x86, 0x49, 0x6c, 0xcc, 0x46, 0xe2, 0x8c, 0xa0, 0xbd, 0x08, 0x9b, 0x7d, 0x46, 0x5d, 0xcc,
+	0xcf, 0x1f, 0x29, 0xb0, 0x7e, 0x17, 0x61, 0xcb, 0x77, 0x8e, 0x2e, 0xb4, 0x27, 0x7c, 0x13, 0x26,
+	0x86, 0x3d, 0x49, 0xf7, 0x33, 0xab, 0x4b, 0x8d, 0xda, 0x77, 0xc6, 0x60, 0xa3, 0x07, 0xb7, 0xc0,
+	0xcc, 0x6f, 0x41, 0x3e, 0xca, 0x1b, 0x5b, 0x9e, 0x7b, 0xec, 0x54, 0xc5, 0xcd, 0xfa, 0x46, 0x7a,
+	0x5f, 0x52, 0x27, 0x68, 0x8f, 0x09, 0xea, 0xb3, 0x28, 0x49, 0x50, 0xab, 0xb0, 0x94, 0x92, 0x9e,
+	0x66, 0xc9, 0x70, 0xee, 0xf0, 0xf6, 0x10, 0x46, 0x58, 0x0a, 0x7c, 0xe1, 0x2c, 0x8d, 0xac, 0x7e,
+	0x0b, 0xd4, 0x26, 0x72, 0x6d, 0xc7, 0xad, 0x1a, 0x26, 0x3f, 0x56, 0x3b, 0x08, 0x17, 0x33, 0x2c,
+	0xf1, 0x7b, 0xbd, 0xbb, 0x8d, 0x03, 0x2e, 0x23, 0x4f, 0xe2, 0xcc, 0x42, 0xa1, 0x99, 0x20, 0x3a,
+	0x08, 0xab, 0xef, 0x42, 0x5e, 0x6a, 0x67, 0x40, 0xe6, 0xb3, 0xa7, 0x79, 0xaa, 0xfb, 0x56, 0x5f,
+	0xdd, 0xc9, 0x58, 0x62, 0x16, 0x66, 0x9b, 0xb1, 0x26, 0x1f, 0xb9, 0xea, 0x6f, 0x2a, 0x10, 0xa6,
+	0xf6, 0x0d, 0x1f, 0x35, 0x3d, 0x9f, 0xd0, 0x64, 0x14, 0x35, 0xf0, 0xed, 0x01, 0xf3, 0x1b, 0x7d,
+	0x67, 0x3a, 0x1c, 0x4f, 0x9d, 0xeb, 0xe7, 0xd9, 0xeb, 0xd9, 0xb3, 0x24, 0x75, 0xc5, 0x82, 0xf9,
+	0x34, 0xc6, 0x94, 0x3c, 0xf4, 0x97, 0x93, 0x79, 0xe8, 0xb5, 0x3e, 0x59, 0xb3, 0x58, 0x0a, 0x5a,
+	0xfb, 0xd5, 0x0c, 0x14, 0x75, 0x51, 0x54, 0x8b, 0xd8, 0xda, 0xc3, 0x6f, 0xdd, 0xfc, 0x89, 0xc0,
+	0xb4, 0x63, 0x58, 0x48, 0xbe, 0x68, 0xb7, 0x0c, 0x87, 0xa0, 0x86, 0x0c, 0xa5, 0x9b, 0x43, 0xbd,
+	0x6a, 0xb7, 0x2a, 0x04, 0x35, 0xf4, 0xb9, 0xd3, 0x0e, 0x1a, 0x56, 0x5f, 0x85, 0x71, 0x86, 0x58,
+	0xb8, 0x38, 0xda, 0x3b, 0xe7, 0x78, 0xd7, 0x24, 0xe6, 0x6e, 0xdd, 0x3b, 0xd2, 0x05, 0xbf, 0x7a,
+	0x0f, 0x72, 0xec, 0x49, 0x21, 0x10, 0x98, 0xd7, 0x37, 0x6b, 0x19, 0x6a, 0x98, 0x76, 0xd1, 0x99,
+	0x1e, 0x70, 0xac, 0xc3, 0xda, 0x2a, 0x2c, 0xa7, 0x4c, 0x81, 0x00, 0xb8, 0x3f, 0x50, 0x60, 0xf1,
+	0xb0, 0xe5, 0x5a, 0x87, 0x35, 0xd3, 0xb7, 0xc5, 0x3b, 0xb7, 0x98, 0x9e, 0x4d, 0xc8, 0x61, 0x2f,
+	0xf0, 0x2d, 0x64, 0x58, 0xf5, 0x00, 0x13, 0xe4, 0x8b, 0x09, 0x9a, 0xe1, 0xd4, 0x3d, 0x4e, 0x54,
+	0x97, 0x21, 0x8b, 0xa9, 0xb0, 0x7c, 0xfb, 0x1b, 0xd3, 0x27, 0xd8, 0xef, 0x8a, 0xad, 0xee, 0xc0,
+	0x14, 0x7f, 0x70, 0xe7, 0xe9, 0xdc, 0xcc, 0x80, 0xe9, 0x5c, 0xe0, 0x42, 0x94, 0xac, 0x2d, 0xc3,
+	0x52, 0x47, 0xf7, 0xe4, 0x65, 0x6d, 0x0c, 0xe6, 0x68, 0x9b, 0x5c, 0xd3, 0x43, 0x84, 0xd5, 0x1a,
+	0x4c, 0x85, 0x61, 0x25, 0xba, 0x3d, 0xa9, 0x83, 0x24, 0x55, 0xec, 0xd8, 0x01, 0x33, 0x13, 0x3b,
+	0x60, 0xd2, 0x64, 0xb6, 0x98, 0x63, 0xf1, 0x10, 0x21, 0x7f, 0x52, 0xa3, 0x51, 0xf2, 0x3a, 0x7a,
+	0x7e, 0x0c, 0x69, 0xec, 0x4d, 0xbf, 0xfd, 0xd5, 0x6c, 0xfc, 0x7c, 0xaf, 0x66, 0x97, 0x01, 0x64,
+	0x8e, 0xd4, 0xe1, 0xef, 0x93, 0x19, 0x7d, 0x52, 0x50, 0x2a, 0x76, 0x47, 0xda, 0x3e, 0x7b, 0x9e,
+	0xb4, 0xfd, 0x81, 0xa8, 0xb2, 0x89, 0xd2, 0x7e, 0x4c, 0xd7, 0xe4, 0x80, 0xba, 0x0a, 0x54, 0x38,
+	0x4c, 0xd7, 0x31, 0x8d, 0x77, 0x60, 0x42, 0x66, 0xdf, 0x61, 0xc0, 0xec, 0xbb, 0x14, 0x88, 0x3f,
+	0x22, 0x4c, 0x25, 0x1f, 0x11, 0xf6, 0x60, 0x9a, 0xf5, 0x53, 0xd6, 0x15, 0x4f, 0x0f, 0x58, 0x57,
+	0x3c, 0xc5, 0x0a, 0x85, 0xf8, 0x0f, 0x5a, 0x0f, 0xc3, 0x94, 0xd0, 0x00, 0x40, 0xbe, 0xe1, 0xd8,
+	0xc8, 0x25, 0x0e, 0x69, 0xb1, 0xe7, 0xc8, 0x49, 0x5d, 0xa5, 0x6d, 0x6f, 0xb3, 0xa6, 0x8a, 0x68,
+	0xa1, 0x35, 0x25, 0x6d, 0xe8, 0x21, 0xaa, 0x61, 0xca, 0xc3, 0xe1, 0x86, 0x9e, 0x4b, 0x62, 0x86,
+	0xb6, 0x08, 0xf3, 0xc9, 0x98, 0x16, 0xc1, 0xfe, 0x5f, 0x0a, 0xac, 0x4a, 0xe4, 0xff, 0xac, 0x0b,
+	0xdf, 0x5e, 0x81, 0x25, 0xc7, 0xb5, 0xea, 0x81, 0x8d, 0x0c, 0xb9, 0x59, 0xca, 0x99, 0xe5, 0x47,
+	0xe1, 0x05, 0xd1, 0x2c, 0x36, 0x46, 0xf9, 0x74, 0x52, 0x86, 0x39, 0xc9, 0x4f, 0x83, 0xc9, 0x37,
+	0x2c, 0x2f, 0x70, 0x65, 0x1d, 0xbc, 0xdc, 0x8c, 0x69, 0xac, 0xf8, 0x7b, 0xb4, 0x41, 0xfb, 0xcf,
+	0x11, 0x78, 0x21, 0xdd, 0x67, 0x71, 0xa4, 0xa9, 0xc1, 0x9c, 0x65, 0x5a, 0x35, 0x94, 0xfc, 0xda,
+	0xa2, 0xa8, 0x0c, 0xfe, 0xfe, 0x26, 0xfd, 0x4c, 0xa8, 0x2f, 0x30, 0xa5, 0x71, 0x92, 0xea, 0xc2,
+	0xa2, 0x6d, 0x12, 0x93, 0x95, 0x2e, 0x26, 0x8d, 0x8d, 0x5c, 0xd0, 0xd8, 0xbc, 0xd4, 0x9b, 0xb0,
+	0x87, 0x61, 0x59, 0x0e, 0x55, 0x74, 0x9a, 0x8a, 0x0f, 0xf2, 0xd4, 0xcd, 0xdb, 0xfd, 0x22, 0x4d,
+	0x8c, 0x7e, 0x38, 0x85, 0x62, 0x1a, 0xf4, 0xa5, 0x66, 0x7a, 0x83, 0xf6, 0xf7, 0x0a, 0xac, 0xc8,
+	0xf1, 0x16, 0xf1, 0xf8, 0xc0, 0xc3, 0xf1, 0x77, 0x82, 0x9a, 0x87, 0x89, 0x61, 0xda, 0xb6, 0x8f,
+	0x30, 0x96, 0x21, 0x46, 0x69, 0x3b, 0x9c, 0xd4, 0x6b, 0x2f, 0x68, 0x0f, 0xd0, 0xcc, 0xa0, 0x9b,
+	0xfd, 0xe8, 0xc5, 0x37, 0x7b, 0xed, 0xfb, 0x19, 0x58, 0x4d, 0xf5, 0x4c, 0x04, 0xd2, 0x15, 0x98,
+	0x61, 0xfd, 0xc4, 0x86, 0x1b, 0x34, 0x8e, 0xc4, 0x4e, 0x37, 0xa6, 0x4f, 0x73, 0xe2, 0x63, 0x46,
+	0x53, 0x57, 0x61, 0x52, 0x3a, 0x87, 0x8b, 0x23, 0xeb, 0x99, 0xad, 0x31, 0x3d, 0x2b, 0xbc, 0xa3,
+	0xc5, 0xb7, 0xb3, 0x91, 0x7b, 0x2c, 0x7e, 0x7a, 0x7e, 0x3b, 0x12, 0xf2, 0x52, 0x17, 0xc2, 0x27,
+	0xbe, 0x3d, 0x2a, 0xc7, 0x0e, 0x8e, 0x39, 0x37, 0x41, 0xa3, 0x4b, 0x8e, 0xdb, 0xb6, 0x3c, 0x97,
+	0xf8, 0x5e, 0xbd, 0x8e, 0x7c, 0x59, 0xcb, 0x36, 0xca, 0x06, 0x72, 0x81, 0x35, 0xef, 0x85, 0xad,
+	0xa2, 0x26, 0x98, 0x02, 0xa7, 0x98, 0x2e, 0xfe, 0x3a, 0x2e, 0x7f, 0xd2, 0xc5, 0x28, 0xee, 0x0f,
+	0xd8, 0x68, 0x52, 0x6d, 0xc8, 0xf2, 0x5c, 0x9b, 0x6d, 0x49, 0x8a, 0x5e, 0x90, 0x4d, 0x07, 0xc8,
+	0x3f, 0x64, 0x0d, 0xea, 0x11, 0xbd, 0xe0, 0xb9, 0x04, 0xb9, 0x36, 0x8a, 0x6e, 0xfe, 0xb8, 0x38,
+	0xb1, 0x9e, 0x49, 0xde, 0x20, 0xd2, 0x63, 0x71, 0x4f, 0x8a, 0x86, 0x47, 0x4e, 0xd5, 0x6a, 0x27,
+	0x61, 0xad, 0x0c, 0x85, 0xbd, 0xba, 0x87, 0x11, 0xdb, 0xed, 0x65, 0xd8, 0xc5, 0x63, 0x4a, 0x49,
+	0xc4, 0x94, 0x36, 0x0f, 0x6a, 0x9c, 0x5f, 0x40, 0xe5, 0x3f, 0x28, 0x50, 0xe0, 0xd9, 0xbe, 0x78,
+	0xee, 0xa0, 0xbb, 0x1a, 0xf5, 0x1e, 0x64, 0x2d, 0x93, 0xa0, 0x2a, 0x45, 0xf1, 0x11, 0x56, 0x19,
+	0xf8, 0x85, 0xde, 0x75, 0x87, 0x3c, 0x8f, 0xcf, 0x25, 0xf4, 0x50, 0x36, 0x5e, 0xa6, 0x90, 0x49,
+	0x94, 0x29, 0x54, 0x60, 0xf6, 0xd4, 0xc1, 0xce, 0x91, 0x53, 0x77, 0x48, 0x6b, 0xb8, 0xa7, 0xed,
+	0x5c, 0x24, 0xc8, 0xce, 0x43, 0xf3, 0xa0, 0xc6, 0x7d, 0x13, 0x2e, 0x7f, 0xa8, 0xc0, 0xe5, 0xfb,
+	0x88, 0xe8, 0xd1, 0xe7, 0x6b, 0x8f, 0xf8, 0xa7, 0x6b, 0xe1, 0x61, 0xee, 0x75, 0x18, 0x67, 0xe5,
+	0x3c, 0x74, 0xd9, 0x66, 0xba, 0x86, 0x65, 0xec, 0xfb, 0x37, 0x9e, 0xc8, 0x0a, 0x7f, 0xb2, 0xc2,
+	0x1f, 0x5d, 0xe8, 0xa0, 0x8b, 0x59, 0x9c, 0x09, 0xd9, 0xc3, 0xb5, 0x38, 0x40, 0x4d, 0x09, 0x1a,
+	0x8d, 0x67, 0xed, 0x7b, 0x23, 0x50, 0xea, 0xd6, 0x25, 0xb1, 0xea, 0x7e, 0x19, 0x72, 0x7c, 0x4a,
+	0xc4, 0x77, 0x76, 0xb2, 0x6f, 0xdf, 0x18, 0xf0, 0x26, 0xd4, 0x5b, 0x7d, 0x99, 0x45, 0x85, 0xa4,
+	0xf2, 0x4b, 0xd0, 0x0c, 0x8e, 0xd3, 0x56, 0x5a, 0xa0, 0x76, 0x32, 0xc5, 0x2f, 0x40, 0x63, 0xfc,
+	0x02, 0xf4, 0x28, 0x79, 0x01, 0xba, 0x3d, 0xe4, 0xd8, 0x85, 0x3d, 0x8b, 0x5d, 0x8c, 0x3e, 0x80,
+	0xf5, 0xfb, 0x88, 0xdc, 0x7d, 0xfd, 0xcd, 0x1e, 0x73, 0xf6, 0x96, 0x28, 0x5d, 0xa6, 0xb7, 0x68,
+	0x39, 0x36, 0xc3, 0xda, 0x0e, 0x2b, 0xca, 0x26, 0x89, 0xf8, 0x0b, 0x6b, 0xbf, 0xa1, 0xc0, 0x46,
+	0x0f, 0xe3, 0x62, 0x76, 0xde, 0xa3, 0xf5, 0xfa, 0x61, 0x33, 0xcb, 0x74, 0xc9, 0x4e, 0xdc, 0x3a,
+	0x47, 0x27, 0xf4, 0xbc, 0x9f, 0x24, 0x60, 0xed, 0xb7, 0x15, 0x98, 0x67, 0x45, 0x4b, 0xd1, 0x3d,
+	0x74, 0xe0, 0xc3, 0xcc, 0x1b, 0xed, 0x09, 0x95, 0x2f, 0xf7, 0x4d, 0xa8, 0xa4, 0x99, 0x8a, 0x92,
+	0x28, 0x27, 0xb0, 0xd0, 0xc6, 0x20, 0xc6, 0x41, 0x87, 0x6c, 0x5b, 0x25, 0xc2, 0x2b, 0xc3, 0x9a,
+	0xe2, 0xd2, 0x7a, 0xa8, 0x47, 0xfb, 0x5d, 0x05, 0xe6, 0x75, 0x64, 0x36, 0x9b, 0x75, 0x9e, 0xa1,
+	0xc2, 0x43, 0x78, 0x7e, 0xd8, 0xee, 0x79, 0x7a, 0x99, 0x61, 0xfc, 0x53, 0x4f, 0x3e, 0x1d, 0x9d,
+	0xe6, 0x22, 0xef, 0x97, 0x60, 0xa1, 0x8d, 0x41, 0xf4, 0xf4, 0xcf, 0x46, 0x60, 0x81, 0xc7, 0x4a,
+	0x7b, 0x74, 0xee, 0xc3, 0x68, 0x58, 0x46, 0x9a, 0xbb, 0x79, 0xa3, 0x37, 0x62, 0xde, 0x45, 0xa6,
+	0xfd, 0x3a, 0x22, 0x04, 0xf9, 0xac, 0x96, 0x8a, 0x15, 0xc3, 0x30, 0xf1, 0x5e, 0x47, 0x86, 0xce,
+	0x0b, 0x68, 0x26, 0xed, 0x02, 0x7a, 0x1b, 0x8a, 0xec, 0xbc, 0x89, 0x9d, 0x53, 0x64, 0x20, 0x37,
+	0x84, 0x93, 0xa8, 0x5c, 0x6c, 0x21, 0x6c, 0xdf, 0x77, 0xe5, 0x62, 0xaf, 0xd8, 0xea, 0x17, 0xa0,
+	0xd0, 0x30, 0x9f, 0x3a, 0x8d, 0xa0, 0x61, 0x34, 0x29, 0x3f, 0x76, 0x3e, 0xe0, 0x1f, 0x58, 0x8e,
+	0xe9, 0xb3, 0xa2, 0xe1, 0xc0, 0xac, 0xa2, 0x43, 0xe7, 0x03, 0xa4, 0x5e, 0x83, 0x59, 0x56, 0x5f,
+	0xca, 0x18, 0x79, 0x61, 0xe4, 0x38, 0x2b, 0x8c, 0x64, 0x65, 0xa7, 0x94, 0x8d, 0x7f, 0xad, 0xf1,
+	0x6f, 0xfc, 0x63, 0xbd, 0xc4, 0x78, 0x89, 0x40, 0x7a, 0x4e, 0x03, 0x96, 0xba, 0x2e, 0x47, 0x9e,
+	0xe3, 0xba, 0x4c, 0xf3, 0x35, 0x93, 0xe6, 0xeb, 0x3f, 0xd2, 0x0f, 0x71, 0x02, 0xbf, 0x8a, 0x7e,
+	0x1a, 0xa3, 0x43, 0x5b, 0x81, 0x62, 0xa7, 0x73, 0xb2, 0xce, 0x62, 0x04, 0x96, 0x1e, 0xa1, 0x9f,
+	0x52, 0xcf, 0x3f, 0x95, 0x75, 0xb1, 0x0b, 0xc5, 0x47, 0x28, 0x7d, 0x34, 0xd3, 0x74, 0x28, 0x69,
+	0x3a, 0xbe, 0xc7, 0xbe, 0xab, 0x38, 0xf6, 0x11, 0xae, 0xc5, 0x1f, 0x53, 0x86, 0x01, 0xcf, 0x77,
+	0xda, 0xc1, 0xf3, 0xe7, 0x07, 0x04, 0xcf, 0xae, 0x56, 0x23, 0x0c, 0x65, 0x9f, 0x5a, 0xa4, 0xf1,
+	0x89, 0xa0, 0xf9, 0x63, 0x05, 0xd6, 0x77, 0x5c, 0xd7, 0x23, 0x17, 0x7c, 0x5f, 0x36, 0xda, 0x7d,
+	0xd8, 0x1f, 0xc8, 0x87, 0x7e, 0xa6, 0x23, 0x47, 0xae, 0xc0, 0x46, 0x0f, 0x66, 0xe1, 0xcd, 0x5f,
+	0x2a, 0xb0, 0xf9, 0xf5, 0x26, 0x46, 0x51, 0x35, 0xc1, 0x21, 0xfb, 0x07, 0x01, 0x3b, 0xe1, 0x3f,
+	0x08, 0x18, 0xea, 0xc9, 0xbc, 0xcd, 0xa5, 0xf4, 0x12, 0xee, 0x2e, 0xff, 0x82, 0x80, 0x7a, 0x37,
+	0x50, 0x57, 0x22, 0x17, 0xb7, 0xe0, 0x5a, 0x3f, 0x09, 0xe1, 0xe7, 0xef, 0x2b, 0xb0, 0xb2, 0x43,
+	0x37, 0xc6, 0x37, 0x9a, 0xc8, 0x37, 0x89, 0xe7, 0xef, 0x58, 0x7c, 0x1c, 0x06, 0x76, 0xee, 0x17,
+	0xda, 0x9d, 0x7b, 0x6d, 0xb0, 0xf9, 0xea, 0x6a, 0x34, 0x72, 0xe3, 0x32, 0xac, 0xa6, 0xb2, 0x89,
+	0xbe, 0x7f, 0x5f, 0x81, 0xb5, 0xe4, 0x21, 0x99, 0xed, 0xee, 0x7b, 0xb5, 0xc0, 0x1d, 0xe6, 0x4d,
+	0xf5, 0x5d, 0x98, 0xe8, 0x5a, 0xe5, 0xd6, 0xc3, 0x81, 0x3e, 0x96, 0x23, 0x2f, 0x5e, 0x81, 0xf5,
+	0xee, 0xbc, 0x02, 0x23, 0x54, 0x18, 0xa5, 0x89, 0x12, 0x01, 0x0c, 0xec, 0xef, 0xdd, 0xe6, 0x47,
+	0x1f, 0x97, 0x2e, 0xfd, 0xe8, 0xe3, 0xd2, 0xa5, 0x1f, 0x7f, 0x5c, 0x52, 0x7e, 0xe5, 0x59, 0x49,
+	0xf9, 0xd3, 0x67, 0x25, 0xe5, 0x6f, 0x9e, 0x95, 0x94, 0x8f, 0x9e, 0x95, 0x94, 0x7f, 0x7e, 0x56,
+	0x52, 0xfe, 0xf5, 0x59, 0xe9, 0xd2, 0x8f, 0x9f, 0x95, 0x94, 0x0f, 0x3f, 0x29, 0x5d, 0xfa, 0xe8,
+	0x93, 0xd2, 0xa5, 0x1f, 0x7d, 0x52, 0xba, 0xf4, 0xce, 0x9d, 0xaa, 0x17, 0x75, 0xdf, 0xf1, 0x7a,
+	0xfe, 0x97, 0x9b, 0x9f, 0x4d, 0x52, 0x8e, 0xc6, 0xd9, 0x3d, 0xed, 0xd6, 0xff, 0x0c, 0x00, 0x44,
+	0x76, 0x12, 0xa9, 0x24, 0x47, 0x00, 0x00,
+}
+
+func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(StartWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.StartRequest.Equal(that1.StartRequest) {
+		return false
+	}
+	if !this.ParentExecutionInfo.Equal(that1.ParentExecutionInfo) {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if that1.WorkflowExecutionExpirationTime == nil {
+		if this.WorkflowExecutionExpirationTime != nil {
+			return false
+		}
+	} else if !this.WorkflowExecutionExpirationTime.Equal(*that1.WorkflowExecutionExpirationTime) {
+		return false
+	}
+	if this.ContinueAsNewInitiator != that1.ContinueAsNewInitiator {
+		return false
+	}
+	if !this.ContinuedFailure.Equal(that1.ContinuedFailure) {
+		return false
+	}
+	if !this.LastCompletionResult.Equal(that1.LastCompletionResult) {
+		return false
+	}
+	if this.FirstWorkflowTaskBackoff != nil && that1.FirstWorkflowTaskBackoff != nil {
+		if *this.FirstWorkflowTaskBackoff != *that1.FirstWorkflowTaskBackoff {
+			return false
+		}
+	} else if this.FirstWorkflowTaskBackoff != nil {
+		return false
+	} else if that1.FirstWorkflowTaskBackoff != nil {
+		return false
+	}
+	return true
+}
+func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartWorkflowExecutionResponse)
+	if !ok {
 		that2, ok := that.(StartWorkflowExecutionResponse)
 		if ok {
 			that1 = &that2
@@ -4684,6 +5173,9 @@ func (this *GetMutableStateResponse) Equal(that interface{}) bool {
 	if this.IsStickyTaskQueueEnabled != that1.IsStickyTaskQueueEnabled {
 		return false
 	}
+	if this.ResetBaseRunId != that1.ResetBaseRunId {
+		return false
+	}
 	return true
 }
 func (this *PollMutableStateRequest) Equal(that interface{}) bool {
@@ -4867,6 +5359,9 @@ func (this *RecordWorkflowTaskStartedRequest) Equal(that interface{}) bool {
 	if !this.PollRequest.Equal(that1.PollRequest) {
 		return false
 	}
+	if !this.LatencyBreakdown.Equal(that1.LatencyBreakdown) {
+		return false
+	}
 	return true
 }
 func (this *RecordWorkflowTaskStartedResponse) Equal(that interface{}) bool {
@@ -4940,6 +5435,14 @@ func (this *RecordWorkflowTaskStartedResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.SuggestContinueAsNewReasons) != len(that1.SuggestContinueAsNewReasons) {
+		return false
+	}
+	for i := range this.SuggestContinueAsNewReasons {
+		if this.SuggestContinueAsNewReasons[i] != that1.SuggestContinueAsNewReasons[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *RecordActivityTaskStartedRequest) Equal(that interface{}) bool {
@@ -4979,6 +5482,9 @@ func (this *RecordActivityTaskStartedRequest) Equal(that interface{}) bool {
 	if !this.PollRequest.Equal(that1.PollRequest) {
 		return false
 	}
+	if !this.LatencyBreakdown.Equal(that1.LatencyBreakdown) {
+		return false
+	}
 	return true
 }
 func (this *RecordActivityTaskStartedResponse) Equal(that interface{}) bool {
@@ -5356,6 +5862,9 @@ func (this *SignalWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if this.ChildWorkflowOnly != that1.ChildWorkflowOnly {
 		return false
 	}
+	if this.Redelivery != that1.Redelivery {
+		return false
+	}
 	return true
 }
 func (this *SignalWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -5813,6 +6322,14 @@ func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.WorkflowReports) != len(that1.WorkflowReports) {
+		return false
+	}
+	for i := range this.WorkflowReports {
+		if !this.WorkflowReports[i].Equal(that1.WorkflowReports[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReplicateEventsV2Request) Equal(that interface{}) bool {
@@ -6053,6 +6570,12 @@ func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
 	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
+	if this.IncludePendingDetails != that1.IncludePendingDetails {
+		return false
+	}
+	if this.PendingTimerCount != that1.PendingTimerCount {
+		return false
+	}
 	return true
 }
 func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
@@ -6080,6 +6603,9 @@ func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	if !this.DatabaseMutableState.Equal(that1.DatabaseMutableState) {
 		return false
 	}
+	if !this.PendingExecutionDetails.Equal(that1.PendingExecutionDetails) {
+		return false
+	}
 	return true
 }
 func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
@@ -6154,6 +6680,17 @@ func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	if this.Address != that1.Address {
 		return false
 	}
+	if this.RequestsPerSecond != that1.RequestsPerSecond {
+		return false
+	}
+	if len(this.ContendedWorkflows) != len(that1.ContendedWorkflows) {
+		return false
+	}
+	for i := range this.ContendedWorkflows {
+		if !this.ContendedWorkflows[i].Equal(that1.ContendedWorkflows[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *CloseShardRequest) Equal(that interface{}) bool {
@@ -6511,19 +7048,219 @@ func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
 	if this.MaximumPageSize != that1.MaximumPageSize {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
+}
+func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(GetDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+		return false
+	}
+	for i := range this.ReplicationTasks {
+		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
+}
+func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PurgeDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(PurgeDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.SourceCluster != that1.SourceCluster {
+		return false
+	}
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
+	}
+	return true
+}
+func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PurgeDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(PurgeDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MergeDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(MergeDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.SourceCluster != that1.SourceCluster {
+		return false
+	}
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
+	}
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
+}
+func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MergeDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(MergeDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
+}
+func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RefreshWorkflowTasksRequest)
+	if !ok {
+		that2, ok := that.(RefreshWorkflowTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	return true
+}
+func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RefreshWorkflowTasksResponse)
+	if !ok {
+		that2, ok := that.(RefreshWorkflowTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
 	return true
 }
-func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *AnnotateWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetDLQMessagesResponse)
+	that1, ok := that.(*AnnotateWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(GetDLQMessagesResponse)
+		that2, ok := that.(AnnotateWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6535,30 +7272,43 @@ func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+	if !this.Request.Equal(that1.Request) {
 		return false
 	}
-	for i := range this.ReplicationTasks {
-		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+	return true
+}
+func (this *AnnotateWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AnnotateWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(AnnotateWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
 	return true
 }
-func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *UpsertWorkflowSearchAttributesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PurgeDLQMessagesRequest)
+	that1, ok := that.(*UpsertWorkflowSearchAttributesRequest)
 	if !ok {
-		that2, ok := that.(PurgeDLQMessagesRequest)
+		that2, ok := that.(UpsertWorkflowSearchAttributesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6570,28 +7320,22 @@ func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.SourceCluster != that1.SourceCluster {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+	if !this.Request.Equal(that1.Request) {
 		return false
 	}
 	return true
 }
-func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *UpsertWorkflowSearchAttributesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PurgeDLQMessagesResponse)
+	that1, ok := that.(*UpsertWorkflowSearchAttributesResponse)
 	if !ok {
-		that2, ok := that.(PurgeDLQMessagesResponse)
+		that2, ok := that.(UpsertWorkflowSearchAttributesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6605,14 +7349,14 @@ func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *ApplyOperatorActionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MergeDLQMessagesRequest)
+	that1, ok := that.(*ApplyOperatorActionRequest)
 	if !ok {
-		that2, ok := that.(MergeDLQMessagesRequest)
+		that2, ok := that.(ApplyOperatorActionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6624,34 +7368,22 @@ func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
-	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
-		return false
-	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if !this.Request.Equal(that1.Request) {
 		return false
 	}
 	return true
 }
-func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *ApplyOperatorActionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MergeDLQMessagesResponse)
+	that1, ok := that.(*ApplyOperatorActionResponse)
 	if !ok {
-		that2, ok := that.(MergeDLQMessagesResponse)
+		that2, ok := that.(ApplyOperatorActionResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6663,19 +7395,16 @@ func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
-	}
 	return true
 }
-func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+func (this *GetReplicationEventChunkRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefreshWorkflowTasksRequest)
+	that1, ok := that.(*GetReplicationEventChunkRequest)
 	if !ok {
-		that2, ok := that.(RefreshWorkflowTasksRequest)
+		that2, ok := that.(GetReplicationEventChunkRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6695,14 +7424,14 @@ func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+func (this *GetReplicationEventChunkResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefreshWorkflowTasksResponse)
+	that1, ok := that.(*GetReplicationEventChunkResponse)
 	if !ok {
-		that2, ok := that.(RefreshWorkflowTasksResponse)
+		that2, ok := that.(GetReplicationEventChunkResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6714,6 +7443,9 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
 	return true
 }
 func (this *StartWorkflowExecutionRequest) GoString() string {
@@ -6771,7 +7503,7 @@ func (this *GetMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 18)
 	s = append(s, "&historyservice.GetMutableStateResponse{")
 	if this.Execution != nil {
 		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
@@ -6796,6 +7528,7 @@ func (this *GetMutableStateResponse) GoString() string {
 		s = append(s, "VersionHistories: "+fmt.Sprintf("%#v", this.VersionHistories)+",\n")
 	}
 	s = append(s, "IsStickyTaskQueueEnabled: "+fmt.Sprintf("%#v", this.IsStickyTaskQueueEnabled)+",\n")
+	s = append(s, "ResetBaseRunId: "+fmt.Sprintf("%#v", this.ResetBaseRunId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -6871,7 +7604,7 @@ func (this *RecordWorkflowTaskStartedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 11)
 	s = append(s, "&historyservice.RecordWorkflowTaskStartedRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.WorkflowExecution != nil {
@@ -6883,6 +7616,9 @@ func (this *RecordWorkflowTaskStartedRequest) GoString() string {
 	if this.PollRequest != nil {
 		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
+	if this.LatencyBreakdown != nil {
+		s = append(s, "LatencyBreakdown: "+fmt.Sprintf("%#v", this.LatencyBreakdown)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -6890,7 +7626,7 @@ func (this *RecordWorkflowTaskStartedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 18)
 	s = append(s, "&historyservice.RecordWorkflowTaskStartedResponse{")
 	if this.WorkflowType != nil {
 		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
@@ -6915,7 +7651,7 @@ func (this *RecordWorkflowTaskStartedResponse) GoString() string {
 		keysForQueries = append(keysForQueries, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v18.WorkflowQuery{"
+	mapStringForQueries := "map[string]*v19.WorkflowQuery{"
 	for _, k := range keysForQueries {
 		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
 	}
@@ -6923,6 +7659,7 @@ func (this *RecordWorkflowTaskStartedResponse) GoString() string {
 	if this.Queries != nil {
 		s = append(s, "Queries: "+mapStringForQueries+",\n")
 	}
+	s = append(s, "SuggestContinueAsNewReasons: "+fmt.Sprintf("%#v", this.SuggestContinueAsNewReasons)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -6930,7 +7667,7 @@ func (this *RecordActivityTaskStartedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 11)
 	s = append(s, "&historyservice.RecordActivityTaskStartedRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.WorkflowExecution != nil {
@@ -6942,6 +7679,9 @@ func (this *RecordActivityTaskStartedRequest) GoString() string {
 	if this.PollRequest != nil {
 		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
+	if this.LatencyBreakdown != nil {
+		s = append(s, "LatencyBreakdown: "+fmt.Sprintf("%#v", this.LatencyBreakdown)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7107,7 +7847,7 @@ func (this *SignalWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&historyservice.SignalWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.SignalRequest != nil {
@@ -7117,6 +7857,7 @@ func (this *SignalWorkflowExecutionRequest) GoString() string {
 		s = append(s, "ExternalWorkflowExecution: "+fmt.Sprintf("%#v", this.ExternalWorkflowExecution)+",\n")
 	}
 	s = append(s, "ChildWorkflowOnly: "+fmt.Sprintf("%#v", this.ChildWorkflowOnly)+",\n")
+	s = append(s, "Redelivery: "+fmt.Sprintf("%#v", this.Redelivery)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7316,7 +8057,7 @@ func (this *DescribeWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&historyservice.DescribeWorkflowExecutionResponse{")
 	if this.ExecutionConfig != nil {
 		s = append(s, "ExecutionConfig: "+fmt.Sprintf("%#v", this.ExecutionConfig)+",\n")
@@ -7330,6 +8071,19 @@ func (this *DescribeWorkflowExecutionResponse) GoString() string {
 	if this.PendingChildren != nil {
 		s = append(s, "PendingChildren: "+fmt.Sprintf("%#v", this.PendingChildren)+",\n")
 	}
+	keysForWorkflowReports := make([]string, 0, len(this.WorkflowReports))
+	for k, _ := range this.WorkflowReports {
+		keysForWorkflowReports = append(keysForWorkflowReports, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForWorkflowReports)
+	mapStringForWorkflowReports := "map[string]*v14.Payload{"
+	for _, k := range keysForWorkflowReports {
+		mapStringForWorkflowReports += fmt.Sprintf("%#v: %#v,", k, this.WorkflowReports[k])
+	}
+	mapStringForWorkflowReports += "}"
+	if this.WorkflowReports != nil {
+		s = append(s, "WorkflowReports: "+mapStringForWorkflowReports+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7427,12 +8181,14 @@ func (this *DescribeMutableStateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&historyservice.DescribeMutableStateRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.Execution != nil {
 		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
+	s = append(s, "IncludePendingDetails: "+fmt.Sprintf("%#v", this.IncludePendingDetails)+",\n")
+	s = append(s, "PendingTimerCount: "+fmt.Sprintf("%#v", this.PendingTimerCount)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7440,7 +8196,7 @@ func (this *DescribeMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&historyservice.DescribeMutableStateResponse{")
 	if this.CacheMutableState != nil {
 		s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
@@ -7448,6 +8204,9 @@ func (this *DescribeMutableStateResponse) GoString() string {
 	if this.DatabaseMutableState != nil {
 		s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
 	}
+	if this.PendingExecutionDetails != nil {
+		s = append(s, "PendingExecutionDetails: "+fmt.Sprintf("%#v", this.PendingExecutionDetails)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7470,7 +8229,7 @@ func (this *DescribeHistoryHostResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 11)
 	s = append(s, "&historyservice.DescribeHistoryHostResponse{")
 	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
 	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
@@ -7479,6 +8238,10 @@ func (this *DescribeHistoryHostResponse) GoString() string {
 	}
 	s = append(s, "ShardControllerStatus: "+fmt.Sprintf("%#v", this.ShardControllerStatus)+",\n")
 	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "RequestsPerSecond: "+fmt.Sprintf("%#v", this.RequestsPerSecond)+",\n")
+	if this.ContendedWorkflows != nil {
+		s = append(s, "ContendedWorkflows: "+fmt.Sprintf("%#v", this.ContendedWorkflows)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -7727,6 +8490,95 @@ func (this *RefreshWorkflowTasksResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *AnnotateWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.AnnotateWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AnnotateWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.AnnotateWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpsertWorkflowSearchAttributesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.UpsertWorkflowSearchAttributesRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpsertWorkflowSearchAttributesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.UpsertWorkflowSearchAttributesResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ApplyOperatorActionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.ApplyOperatorActionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ApplyOperatorActionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.ApplyOperatorActionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetReplicationEventChunkRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.GetReplicationEventChunkRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetReplicationEventChunkResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.GetReplicationEventChunkResponse{")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringRequestResponse(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -7947,6 +8799,15 @@ func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ResetBaseRunId) > 0 {
+		i -= len(m.ResetBaseRunId)
+		copy(dAtA[i:], m.ResetBaseRunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ResetBaseRunId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.IsStickyTaskQueueEnabled {
 		i--
 		if m.IsStickyTaskQueueEnabled {
@@ -8334,6 +9195,18 @@ func (m *RecordWorkflowTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.LatencyBreakdown != nil {
+		{
+			size, err := m.LatencyBreakdown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.PollRequest != nil {
 		{
 			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -8405,6 +9278,15 @@ func (m *RecordWorkflowTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if len(m.SuggestContinueAsNewReasons) > 0 {
+		for iNdEx := len(m.SuggestContinueAsNewReasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SuggestContinueAsNewReasons[iNdEx])
+			copy(dAtA[i:], m.SuggestContinueAsNewReasons[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SuggestContinueAsNewReasons[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.Queries) > 0 {
 		for k := range m.Queries {
 			v := m.Queries[k]
@@ -8432,22 +9314,22 @@ func (m *RecordWorkflowTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		}
 	}
 	if m.StartedTime != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err25 != nil {
-			return 0, err25
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n25
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n25))
+		i -= n26
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.ScheduledTime != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err26 != nil {
-			return 0, err26
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err27 != nil {
+			return 0, err27
 		}
-		i -= n26
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n26))
+		i -= n27
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n27))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -8552,6 +9434,18 @@ func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.LatencyBreakdown != nil {
+		{
+			size, err := m.LatencyBreakdown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.PollRequest != nil {
 		{
 			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -8655,12 +9549,12 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		dAtA[i] = 0x2a
 	}
 	if m.CurrentAttemptScheduledTime != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
-		if err34 != nil {
-			return 0, err34
+		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
+		if err36 != nil {
+			return 0, err36
 		}
-		i -= n34
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n34))
+		i -= n36
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n36))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -8670,12 +9564,12 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		dAtA[i] = 0x18
 	}
 	if m.StartedTime != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err35 != nil {
-			return 0, err35
+		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n35
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n35))
+		i -= n37
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9126,6 +10020,16 @@ func (m *SignalWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Redelivery {
+		i--
+		if m.Redelivery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.ChildWorkflowOnly {
 		i--
 		if m.ChildWorkflowOnly {
@@ -9797,6 +10701,32 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if len(m.WorkflowReports) > 0 {
+		for k := range m.WorkflowReports {
+			v := m.WorkflowReports[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.PendingChildren) > 0 {
 		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9976,12 +10906,12 @@ func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n62, err62 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err62 != nil {
-			return 0, err62
+		n65, err65 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err65 != nil {
+			return 0, err65
 		}
-		i -= n62
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n62))
+		i -= n65
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n65))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -10092,22 +11022,22 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n66, err66 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err66 != nil {
-			return 0, err66
+		n69, err69 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err69 != nil {
+			return 0, err69
 		}
-		i -= n66
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n66))
+		i -= n69
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n69))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n67, err67 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err67 != nil {
-			return 0, err67
+		n70, err70 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err70 != nil {
+			return 0, err70
 		}
-		i -= n67
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n67))
+		i -= n70
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n70))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -10117,12 +11047,12 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n68, err68 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err68 != nil {
-			return 0, err68
+		n71, err71 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err71 != nil {
+			return 0, err71
 		}
-		i -= n68
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n68))
+		i -= n71
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n71))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -10203,6 +11133,21 @@ func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.PendingTimerCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PendingTimerCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IncludePendingDetails {
+		i--
+		if m.IncludePendingDetails {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Execution != nil {
 		{
 			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
@@ -10245,6 +11190,18 @@ func (m *DescribeMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.PendingExecutionDetails != nil {
+		{
+			size, err := m.PendingExecutionDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.DatabaseMutableState != nil {
 		{
 			size, err := m.DatabaseMutableState.MarshalToSizedBuffer(dAtA[:i])
@@ -10346,6 +11303,26 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContendedWorkflows) > 0 {
+		for iNdEx := len(m.ContendedWorkflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContendedWorkflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.RequestsPerSecond != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RequestsPerSecond))))
+		i--
+		dAtA[i] = 0x31
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -10373,21 +11350,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA75 := make([]byte, len(m.ShardIds)*10)
-		var j74 int
+		dAtA79 := make([]byte, len(m.ShardIds)*10)
+		var j78 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA75[j74] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA79[j78] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j74++
+				j78++
 			}
-			dAtA75[j74] = uint8(num)
-			j74++
+			dAtA79[j78] = uint8(num)
+			j78++
 		}
-		i -= j74
-		copy(dAtA[i:], dAtA75[:j74])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j74))
+		i -= j78
+		copy(dAtA[i:], dAtA79[:j78])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j78))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10471,12 +11448,12 @@ func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n76, err76 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err76 != nil {
-			return 0, err76
+		n80, err80 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err80 != nil {
+			return 0, err80
 		}
-		i -= n76
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n76))
+		i -= n80
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n80))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -11154,6 +12131,273 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *AnnotateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnnotateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnnotateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnnotateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnnotateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnnotateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyOperatorActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyOperatorActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyOperatorActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyOperatorActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyOperatorActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyOperatorActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationEventChunkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationEventChunkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationEventChunkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationEventChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationEventChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationEventChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRequestResponse(v)
 	base := offset
@@ -11297,6 +12541,10 @@ func (m *GetMutableStateResponse) Size() (n int) {
 	if m.IsStickyTaskQueueEnabled {
 		n += 3
 	}
+	l = len(m.ResetBaseRunId)
+	if l > 0 {
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -11430,6 +12678,10 @@ func (m *RecordWorkflowTaskStartedRequest) Size() (n int) {
 		l = m.PollRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.LatencyBreakdown != nil {
+		l = m.LatencyBreakdown.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -11494,6 +12746,12 @@ func (m *RecordWorkflowTaskStartedResponse) Size() (n int) {
 			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
 		}
 	}
+	if len(m.SuggestContinueAsNewReasons) > 0 {
+		for _, s := range m.SuggestContinueAsNewReasons {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -11525,6 +12783,10 @@ func (m *RecordActivityTaskStartedRequest) Size() (n int) {
 		l = m.PollRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.LatencyBreakdown != nil {
+		l = m.LatencyBreakdown.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -11748,6 +13010,9 @@ func (m *SignalWorkflowExecutionRequest) Size() (n int) {
 	if m.ChildWorkflowOnly {
 		n += 2
 	}
+	if m.Redelivery {
+		n += 2
+	}
 	return n
 }
 
@@ -12021,6 +13286,19 @@ func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
 			n += 1 + l + sovRequestResponse(uint64(l))
 		}
 	}
+	if len(m.WorkflowReports) > 0 {
+		for k, v := range m.WorkflowReports {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -12177,6 +13455,12 @@ func (m *DescribeMutableStateRequest) Size() (n int) {
 		l = m.Execution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.IncludePendingDetails {
+		n += 2
+	}
+	if m.PendingTimerCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PendingTimerCount))
+	}
 	return n
 }
 
@@ -12194,6 +13478,10 @@ func (m *DescribeMutableStateResponse) Size() (n int) {
 		l = m.DatabaseMutableState.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.PendingExecutionDetails != nil {
+		l = m.PendingExecutionDetails.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -12249,6 +13537,15 @@ func (m *DescribeHistoryHostResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.RequestsPerSecond != 0 {
+		n += 9
+	}
+	if len(m.ContendedWorkflows) > 0 {
+		for _, e := range m.ContendedWorkflows {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -12581,6 +13878,114 @@ func (m *RefreshWorkflowTasksResponse) Size() (n int) {
 	return n
 }
 
+func (m *AnnotateWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *AnnotateWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpsertWorkflowSearchAttributesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpsertWorkflowSearchAttributesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ApplyOperatorActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplyOperatorActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetReplicationEventChunkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetReplicationEventChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
 func sovRequestResponse(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -12646,6 +14051,7 @@ func (this *GetMutableStateResponse) String() string {
 		`WorkflowStatus:` + fmt.Sprintf("%v", this.WorkflowStatus) + `,`,
 		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v17.VersionHistories", 1) + `,`,
 		`IsStickyTaskQueueEnabled:` + fmt.Sprintf("%v", this.IsStickyTaskQueueEnabled) + `,`,
+		`ResetBaseRunId:` + fmt.Sprintf("%v", this.ResetBaseRunId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12715,6 +14121,7 @@ func (this *RecordWorkflowTaskStartedRequest) String() string {
 		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
 		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
 		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
+		`LatencyBreakdown:` + strings.Replace(fmt.Sprintf("%v", this.LatencyBreakdown), "TaskLatencyBreakdown", "v18.TaskLatencyBreakdown", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12728,7 +14135,7 @@ func (this *RecordWorkflowTaskStartedResponse) String() string {
 		keysForQueries = append(keysForQueries, k)
 	}
 	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v18.WorkflowQuery{"
+	mapStringForQueries := "map[string]*v19.WorkflowQuery{"
 	for _, k := range keysForQueries {
 		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
 	}
@@ -12747,6 +14154,7 @@ func (this *RecordWorkflowTaskStartedResponse) String() string {
 		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Queries:` + mapStringForQueries + `,`,
+		`SuggestContinueAsNewReasons:` + fmt.Sprintf("%v", this.SuggestContinueAsNewReasons) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12762,6 +14170,7 @@ func (this *RecordActivityTaskStartedRequest) String() string {
 		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
 		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
 		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
+		`LatencyBreakdown:` + strings.Replace(fmt.Sprintf("%v", this.LatencyBreakdown), "TaskLatencyBreakdown", "v18.TaskLatencyBreakdown", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12771,7 +14180,7 @@ func (this *RecordActivityTaskStartedResponse) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&RecordActivityTaskStartedResponse{`,
-		`ScheduledEvent:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledEvent), "HistoryEvent", "v19.HistoryEvent", 1) + `,`,
+		`ScheduledEvent:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledEvent), "HistoryEvent", "v110.HistoryEvent", 1) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
 		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
@@ -12913,6 +14322,7 @@ func (this *SignalWorkflowExecutionRequest) String() string {
 		`SignalRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalRequest), "SignalWorkflowExecutionRequest", "v1.SignalWorkflowExecutionRequest", 1) + `,`,
 		`ExternalWorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.ExternalWorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
 		`ChildWorkflowOnly:` + fmt.Sprintf("%v", this.ChildWorkflowOnly) + `,`,
+		`Redelivery:` + fmt.Sprintf("%v", this.Redelivery) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13062,7 +14472,7 @@ func (this *RecordChildExecutionCompletedRequest) String() string {
 		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
 		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
 		`CompletedExecution:` + strings.Replace(fmt.Sprintf("%v", this.CompletedExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`CompletionEvent:` + strings.Replace(fmt.Sprintf("%v", this.CompletionEvent), "HistoryEvent", "v19.HistoryEvent", 1) + `,`,
+		`CompletionEvent:` + strings.Replace(fmt.Sprintf("%v", this.CompletionEvent), "HistoryEvent", "v110.HistoryEvent", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13093,19 +14503,30 @@ func (this *DescribeWorkflowExecutionResponse) String() string {
 	}
 	repeatedStringForPendingActivities := "[]*PendingActivityInfo{"
 	for _, f := range this.PendingActivities {
-		repeatedStringForPendingActivities += strings.Replace(fmt.Sprintf("%v", f), "PendingActivityInfo", "v110.PendingActivityInfo", 1) + ","
+		repeatedStringForPendingActivities += strings.Replace(fmt.Sprintf("%v", f), "PendingActivityInfo", "v111.PendingActivityInfo", 1) + ","
 	}
 	repeatedStringForPendingActivities += "}"
 	repeatedStringForPendingChildren := "[]*PendingChildExecutionInfo{"
 	for _, f := range this.PendingChildren {
-		repeatedStringForPendingChildren += strings.Replace(fmt.Sprintf("%v", f), "PendingChildExecutionInfo", "v110.PendingChildExecutionInfo", 1) + ","
+		repeatedStringForPendingChildren += strings.Replace(fmt.Sprintf("%v", f), "PendingChildExecutionInfo", "v111.PendingChildExecutionInfo", 1) + ","
 	}
 	repeatedStringForPendingChildren += "}"
+	keysForWorkflowReports := make([]string, 0, len(this.WorkflowReports))
+	for k, _ := range this.WorkflowReports {
+		keysForWorkflowReports = append(keysForWorkflowReports, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForWorkflowReports)
+	mapStringForWorkflowReports := "map[string]*v14.Payload{"
+	for _, k := range keysForWorkflowReports {
+		mapStringForWorkflowReports += fmt.Sprintf("%v: %v,", k, this.WorkflowReports[k])
+	}
+	mapStringForWorkflowReports += "}"
 	s := strings.Join([]string{`&DescribeWorkflowExecutionResponse{`,
-		`ExecutionConfig:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionConfig), "WorkflowExecutionConfig", "v110.WorkflowExecutionConfig", 1) + `,`,
-		`WorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionInfo), "WorkflowExecutionInfo", "v110.WorkflowExecutionInfo", 1) + `,`,
+		`ExecutionConfig:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionConfig), "WorkflowExecutionConfig", "v111.WorkflowExecutionConfig", 1) + `,`,
+		`WorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionInfo), "WorkflowExecutionInfo", "v111.WorkflowExecutionInfo", 1) + `,`,
 		`PendingActivities:` + repeatedStringForPendingActivities + `,`,
 		`PendingChildren:` + repeatedStringForPendingChildren + `,`,
+		`WorkflowReports:` + mapStringForWorkflowReports + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13198,6 +14619,8 @@ func (this *DescribeMutableStateRequest) String() string {
 	s := strings.Join([]string{`&DescribeMutableStateRequest{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`IncludePendingDetails:` + fmt.Sprintf("%v", this.IncludePendingDetails) + `,`,
+		`PendingTimerCount:` + fmt.Sprintf("%v", this.PendingTimerCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13207,8 +14630,9 @@ func (this *DescribeMutableStateResponse) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&DescribeMutableStateResponse{`,
-		`CacheMutableState:` + strings.Replace(fmt.Sprintf("%v", this.CacheMutableState), "WorkflowMutableState", "v111.WorkflowMutableState", 1) + `,`,
-		`DatabaseMutableState:` + strings.Replace(fmt.Sprintf("%v", this.DatabaseMutableState), "WorkflowMutableState", "v111.WorkflowMutableState", 1) + `,`,
+		`CacheMutableState:` + strings.Replace(fmt.Sprintf("%v", this.CacheMutableState), "WorkflowMutableState", "v18.WorkflowMutableState", 1) + `,`,
+		`DatabaseMutableState:` + strings.Replace(fmt.Sprintf("%v", this.DatabaseMutableState), "WorkflowMutableState", "v18.WorkflowMutableState", 1) + `,`,
+		`PendingExecutionDetails:` + strings.Replace(fmt.Sprintf("%v", this.PendingExecutionDetails), "PendingExecutionDetails", "v17.PendingExecutionDetails", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13230,12 +14654,19 @@ func (this *DescribeHistoryHostResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForContendedWorkflows := "[]*ContendedWorkflow{"
+	for _, f := range this.ContendedWorkflows {
+		repeatedStringForContendedWorkflows += strings.Replace(fmt.Sprintf("%v", f), "ContendedWorkflow", "v17.ContendedWorkflow", 1) + ","
+	}
+	repeatedStringForContendedWorkflows += "}"
 	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
 		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
 		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
 		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v112.NamespaceCacheInfo", 1) + `,`,
 		`ShardControllerStatus:` + fmt.Sprintf("%v", this.ShardControllerStatus) + `,`,
 		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`RequestsPerSecond:` + fmt.Sprintf("%v", this.RequestsPerSecond) + `,`,
+		`ContendedWorkflows:` + repeatedStringForContendedWorkflows + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13487,6 +14918,87 @@ func (this *RefreshWorkflowTasksResponse) String() string {
 	}, "")
 	return s
 }
+func (this *AnnotateWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnnotateWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "AnnotateWorkflowExecutionRequest", "v114.AnnotateWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnnotateWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnnotateWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpsertWorkflowSearchAttributesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpsertWorkflowSearchAttributesRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "UpsertWorkflowSearchAttributesRequest", "v115.UpsertWorkflowSearchAttributesRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpsertWorkflowSearchAttributesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpsertWorkflowSearchAttributesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplyOperatorActionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplyOperatorActionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "ApplyOperatorActionRequest", "v114.ApplyOperatorActionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplyOperatorActionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplyOperatorActionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationEventChunkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetReplicationEventChunkRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "GetReplicationEventChunkRequest", "v114.GetReplicationEventChunkRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationEventChunkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetReplicationEventChunkResponse{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRequestResponse(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -14487,6 +15999,38 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsStickyTaskQueueEnabled = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetBaseRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResetBaseRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -15396,7 +16940,39 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15406,27 +16982,31 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyBreakdown", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15453,10 +17033,10 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
+			if m.LatencyBreakdown == nil {
+				m.LatencyBreakdown = &v18.TaskLatencyBreakdown{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LatencyBreakdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15872,10 +17452,10 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Queries == nil {
-				m.Queries = make(map[string]*v18.WorkflowQuery)
+				m.Queries = make(map[string]*v19.WorkflowQuery)
 			}
 			var mapkey string
-			var mapvalue *v18.WorkflowQuery
+			var mapvalue *v19.WorkflowQuery
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -15949,7 +17529,7 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &v18.WorkflowQuery{}
+					mapvalue = &v19.WorkflowQuery{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -15969,7 +17549,302 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Queries[mapkey] = mapvalue
+			m.Queries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNewReasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuggestContinueAsNewReasons = append(m.SuggestContinueAsNewReasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyBreakdown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatencyBreakdown == nil {
+				m.LatencyBreakdown = &v18.TaskLatencyBreakdown{}
+			}
+			if err := m.LatencyBreakdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15995,7 +17870,7 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16018,17 +17893,17 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16038,27 +17913,31 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.ScheduledEvent == nil {
+				m.ScheduledEvent = &v110.HistoryEvent{}
+			}
+			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16085,18 +17964,18 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.ScheduleId = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16106,16 +17985,16 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
 			}
-			m.TaskId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16125,16 +18004,33 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentAttemptScheduledTime == nil {
+				m.CurrentAttemptScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16144,27 +18040,31 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v14.Payloads{}
+			}
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16191,13 +18091,45 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v14.WorkflowType{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16222,7 +18154,7 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16245,17 +18177,17 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16265,31 +18197,27 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledEvent == nil {
-				m.ScheduledEvent = &v19.HistoryEvent{}
-			}
-			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16316,35 +18244,69 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
+			if m.CompleteRequest == nil {
+				m.CompleteRequest = &v1.RespondWorkflowTaskCompletedRequest{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16371,18 +18333,71 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentAttemptScheduledTime == nil {
-				m.CurrentAttemptScheduledTime = new(time.Time)
+			if m.StartedResponse == nil {
+				m.StartedResponse = &RecordWorkflowTaskStartedResponse{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16392,31 +18407,27 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v14.Payloads{}
-			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16443,45 +18454,66 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v14.WorkflowType{}
+			if m.FailedRequest == nil {
+				m.FailedRequest = &v1.RespondWorkflowTaskFailedRequest{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16506,7 +18538,7 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16529,10 +18561,10 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16569,7 +18601,7 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16596,10 +18628,10 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompleteRequest == nil {
-				m.CompleteRequest = &v1.RespondWorkflowTaskCompletedRequest{}
+			if m.HeartbeatRequest == nil {
+				m.HeartbeatRequest = &v1.RecordActivityTaskHeartbeatRequest{}
 			}
-			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HeartbeatRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16627,7 +18659,7 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16650,17 +18682,17 @@ func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16670,28 +18702,12 @@ func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartedResponse == nil {
-				m.StartedResponse = &RecordWorkflowTaskStartedResponse{}
-			}
-			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.CancelRequested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16716,7 +18732,7 @@ func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16739,10 +18755,10 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16779,7 +18795,7 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16806,10 +18822,10 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailedRequest == nil {
-				m.FailedRequest = &v1.RespondWorkflowTaskFailedRequest{}
+			if m.CompleteRequest == nil {
+				m.CompleteRequest = &v1.RespondActivityTaskCompletedRequest{}
 			}
-			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16837,7 +18853,7 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16860,10 +18876,10 @@ func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16890,7 +18906,7 @@ func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16913,10 +18929,10 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16953,7 +18969,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16980,10 +18996,10 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatRequest == nil {
-				m.HeartbeatRequest = &v1.RecordActivityTaskHeartbeatRequest{}
+			if m.FailedRequest == nil {
+				m.FailedRequest = &v1.RespondActivityTaskFailedRequest{}
 			}
-			if err := m.HeartbeatRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17011,7 +19027,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17034,32 +19050,12 @@ func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CancelRequested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17084,7 +19080,7 @@ func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17107,10 +19103,10 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17147,7 +19143,7 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17174,10 +19170,10 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompleteRequest == nil {
-				m.CompleteRequest = &v1.RespondActivityTaskCompletedRequest{}
+			if m.CancelRequest == nil {
+				m.CancelRequest = &v1.RespondActivityTaskCanceledRequest{}
 			}
-			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17205,7 +19201,7 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17228,10 +19224,10 @@ func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17258,7 +19254,7 @@ func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
+func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17281,10 +19277,10 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17321,7 +19317,7 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17348,13 +19344,89 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailedRequest == nil {
-				m.FailedRequest = &v1.RespondActivityTaskFailedRequest{}
+			if m.SignalRequest == nil {
+				m.SignalRequest = &v1.SignalWorkflowExecutionRequest{}
 			}
-			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SignalRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalWorkflowExecution == nil {
+				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChildWorkflowOnly = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelivery", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Redelivery = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17379,7 +19451,7 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
+func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17402,10 +19474,10 @@ func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17432,7 +19504,7 @@ func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
+func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17455,10 +19527,10 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17495,7 +19567,7 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalWithStartRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17522,10 +19594,10 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CancelRequest == nil {
-				m.CancelRequest = &v1.RespondActivityTaskCanceledRequest{}
+			if m.SignalWithStartRequest == nil {
+				m.SignalWithStartRequest = &v1.SignalWithStartWorkflowExecutionRequest{}
 			}
-			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SignalWithStartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17553,7 +19625,7 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
+func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17576,12 +19648,44 @@ func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17606,7 +19710,7 @@ func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17629,10 +19733,10 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17669,43 +19773,7 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalRequest", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SignalRequest == nil {
-				m.SignalRequest = &v1.SignalWorkflowExecutionRequest{}
-			}
-			if err := m.SignalRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17732,18 +19800,18 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalWorkflowExecution == nil {
-				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
-			}
-			var v int
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -17753,12 +19821,24 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ChildWorkflowOnly = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17783,7 +19863,7 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17806,10 +19886,10 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17836,7 +19916,7 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17859,10 +19939,10 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17899,7 +19979,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalWithStartRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminateRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17926,10 +20006,10 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignalWithStartRequest == nil {
-				m.SignalWithStartRequest = &v1.SignalWithStartWorkflowExecutionRequest{}
+			if m.TerminateRequest == nil {
+				m.TerminateRequest = &v1.TerminateWorkflowExecutionRequest{}
 			}
-			if err := m.SignalWithStartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TerminateRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17957,7 +20037,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17980,44 +20060,12 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18042,7 +20090,7 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18065,10 +20113,10 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18105,7 +20153,7 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18132,45 +20180,13 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
+			if m.ResetRequest == nil {
+				m.ResetRequest = &v1.ResetWorkflowExecutionRequest{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResetRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18195,7 +20211,7 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18218,12 +20234,44 @@ func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18248,7 +20296,7 @@ func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18271,10 +20319,10 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18311,7 +20359,7 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerminateRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18338,13 +20386,88 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TerminateRequest == nil {
-				m.TerminateRequest = &v1.TerminateWorkflowExecutionRequest{}
+			if m.CancelRequest == nil {
+				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
 			}
-			if err := m.TerminateRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalInitiatedEventId", wireType)
+			}
+			m.ExternalInitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalInitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalWorkflowExecution == nil {
+				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18369,7 +20492,7 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18392,10 +20515,10 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18422,7 +20545,7 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18445,10 +20568,10 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18485,7 +20608,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18512,13 +20635,33 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResetRequest == nil {
-				m.ResetRequest = &v1.ResetWorkflowExecutionRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.ResetRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstWorkflowTask", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFirstWorkflowTask = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18543,7 +20686,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18566,44 +20709,12 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18628,7 +20739,7 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18651,10 +20762,10 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18691,7 +20802,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18718,18 +20829,18 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CancelRequest == nil {
-				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalInitiatedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
 			}
-			m.ExternalInitiatedEventId = 0
+			m.InitiatedId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18739,14 +20850,14 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalInitiatedEventId |= int64(b&0x7F) << shift
+				m.InitiatedId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18773,18 +20884,18 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalWorkflowExecution == nil {
-				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
+			if m.CompletedExecution == nil {
+				m.CompletedExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18794,12 +20905,28 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ChildWorkflowOnly = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletionEvent == nil {
+				m.CompletionEvent = &v110.HistoryEvent{}
+			}
+			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18824,7 +20951,60 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18834,25 +21014,93 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowRequestResponse
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Request == nil {
+				m.Request = &v1.DescribeWorkflowExecutionRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18877,7 +21125,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18900,17 +21148,17 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18920,27 +21168,135 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.ExecutionConfig == nil {
+				m.ExecutionConfig = &v111.WorkflowExecutionConfig{}
+			}
+			if err := m.ExecutionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecutionInfo == nil {
+				m.WorkflowExecutionInfo = &v111.WorkflowExecutionInfo{}
+			}
+			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingActivities = append(m.PendingActivities, &v111.PendingActivityInfo{})
+			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChildren = append(m.PendingChildren, &v111.PendingChildExecutionInfo{})
+			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowReports", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18957,96 +21313,116 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowReports == nil {
+				m.WorkflowReports = make(map[string]*v14.Payload)
+			}
+			var mapkey string
+			var mapvalue *v14.Payload
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v14.Payload{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.WorkflowReports[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstWorkflowTask", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsFirstWorkflowTask = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -19071,7 +21447,7 @@ func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19094,10 +21470,10 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateEventsV2Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateEventsV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19169,10 +21545,10 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
 			}
-			m.InitiatedId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19182,14 +21558,29 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitiatedId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &v17.VersionHistoryItem{})
+			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19216,16 +21607,16 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletedExecution == nil {
-				m.CompletedExecution = &v14.WorkflowExecution{}
+			if m.Events == nil {
+				m.Events = &v14.DataBlob{}
 			}
-			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19252,10 +21643,10 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletionEvent == nil {
-				m.CompletionEvent = &v19.HistoryEvent{}
+			if m.NewRunEvents == nil {
+				m.NewRunEvents = &v14.DataBlob{}
 			}
-			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19283,7 +21674,7 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19306,10 +21697,10 @@ func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateEventsV2Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateEventsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19336,7 +21727,7 @@ func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19359,15 +21750,15 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19395,11 +21786,30 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19426,10 +21836,10 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.DescribeWorkflowExecutionRequest{}
+			if m.StatusTime == nil {
+				m.StatusTime = new(time.Time)
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19457,7 +21867,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19480,17 +21890,102 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncShardStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncShardStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncActivityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19500,33 +21995,29 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionConfig == nil {
-				m.ExecutionConfig = &v110.WorkflowExecutionConfig{}
-			}
-			if err := m.ExecutionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19536,31 +22027,65 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionInfo == nil {
-				m.WorkflowExecutionInfo = &v110.WorkflowExecutionInfo{}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+			}
+			m.ScheduledId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19587,14 +22112,35 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingActivities = append(m.PendingActivities, &v110.PendingActivityInfo{})
-			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+			}
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19621,69 +22167,18 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingChildren = append(m.PendingChildren, &v110.PendingChildExecutionInfo{})
-			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateEventsV2Request: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateEventsV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19693,27 +22188,31 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.LastHeartbeatTime == nil {
+				m.LastHeartbeatTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19740,16 +22239,35 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
+			if m.Details == nil {
+				m.Details = &v14.Payloads{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19776,16 +22294,18 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &v17.VersionHistoryItem{})
-			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastFailure == nil {
+				m.LastFailure = &v13.Failure{}
+			}
+			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19795,31 +22315,27 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Events == nil {
-				m.Events = &v14.DataBlob{}
-			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19846,10 +22362,10 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewRunEvents == nil {
-				m.NewRunEvents = &v14.DataBlob{}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v17.VersionHistory{}
 			}
-			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19877,7 +22393,7 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
+func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19900,10 +22416,10 @@ func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateEventsV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncActivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateEventsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19930,7 +22446,7 @@ func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19953,15 +22469,15 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19989,30 +22505,11 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20039,66 +22536,52 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusTime == nil {
-				m.StatusTime = new(time.Time)
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePendingDetails", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.IncludePendingDetails = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTimerCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingTimerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingTimerCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -20123,7 +22606,7 @@ func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20146,17 +22629,17 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20166,29 +22649,33 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.CacheMutableState == nil {
+				m.CacheMutableState = &v18.WorkflowMutableState{}
+			}
+			if err := m.CacheMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20198,29 +22685,33 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			if m.DatabaseMutableState == nil {
+				m.DatabaseMutableState = &v18.WorkflowMutableState{}
+			}
+			if err := m.DatabaseMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingExecutionDetails", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20230,67 +22721,86 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			if m.PendingExecutionDetails == nil {
+				m.PendingExecutionDetails = &v17.PendingExecutionDetails{}
+			}
+			if err := m.PendingExecutionDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.ScheduledId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20300,33 +22810,29 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.StartedId = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20336,16 +22842,16 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartedId |= int64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20355,31 +22861,27 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20406,16 +22908,164 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastHeartbeatTime == nil {
-				m.LastHeartbeatTime = new(time.Time)
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+			}
+			m.ShardsNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardsNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20442,37 +23092,18 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &v14.Payloads{}
+			if m.NamespaceCache == nil {
+				m.NamespaceCache = &v112.NamespaceCacheInfo{}
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
-			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20482,31 +23113,27 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastFailure == nil {
-				m.LastFailure = &v13.Failure{}
-			}
-			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20534,11 +23161,22 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestsPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequestsPerSecond = float64(math.Float64frombits(v))
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContendedWorkflows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20565,10 +23203,8 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistory == nil {
-				m.VersionHistory = &v17.VersionHistory{}
-			}
-			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContendedWorkflows = append(m.ContendedWorkflows, &v17.ContendedWorkflow{})
+			if err := m.ContendedWorkflows[len(m.ContendedWorkflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20596,7 +23232,7 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
+func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20619,12 +23255,31 @@ func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -20649,7 +23304,7 @@ func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20672,80 +23327,12 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -20770,7 +23357,7 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20793,17 +23380,17 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var msglen int
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20813,31 +23400,52 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= v16.TaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.CacheMutableState == nil {
-				m.CacheMutableState = &v111.WorkflowMutableState{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			if err := m.CacheMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20864,10 +23472,10 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatabaseMutableState == nil {
-				m.DatabaseMutableState = &v111.WorkflowMutableState{}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = new(time.Time)
 			}
-			if err := m.DatabaseMutableState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20895,7 +23503,7 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20918,17 +23526,70 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20938,46 +23599,29 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			m.Tokens = append(m.Tokens, &v113.ReplicationToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21005,43 +23649,7 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21067,7 +23675,7 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21090,17 +23698,17 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
 			}
-			m.ShardsNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21110,31 +23718,29 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardsNumber |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardMessages == nil {
+				m.ShardMessages = make(map[int32]*v113.ReplicationMessages)
+			}
+			var mapkey int32
+			var mapvalue *v113.ReplicationMessages
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowRequestResponse
@@ -21144,34 +23750,29 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
+				} else if fieldNum == 2 {
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowRequestResponse
@@ -21181,19 +23782,99 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= int32(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.ShardIds = append(m.ShardIds, v)
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v113.ReplicationMessages{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
 			}
-		case 3:
+			m.ShardMessages[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21220,50 +23901,69 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NamespaceCache == nil {
-				m.NamespaceCache = &v112.NamespaceCacheInfo{}
-			}
-			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TaskInfos = append(m.TaskInfos, &v113.ReplicationTaskInfo{})
+			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21273,23 +23973,25 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ReplicationTasks = append(m.ReplicationTasks, &v113.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21315,7 +24017,7 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21338,17 +24040,17 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21358,11 +24060,60 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.QueryWorkflowRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -21387,7 +24138,7 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21410,12 +24161,48 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &v1.QueryWorkflowResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -21440,7 +24227,7 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21463,36 +24250,17 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.Category = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21502,33 +24270,27 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Category |= v16.TaskCategory(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21555,10 +24317,10 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VisibilityTime == nil {
-				m.VisibilityTime = new(time.Time)
+			if m.Request == nil {
+				m.Request = &v114.ReapplyEventsRequest{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21586,7 +24348,7 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21609,10 +24371,10 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -21639,7 +24401,7 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21662,17 +24424,55 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21682,31 +24482,67 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &v113.ReplicationToken{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21716,23 +24552,25 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21758,7 +24596,7 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21781,17 +24619,17 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21801,106 +24639,78 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ShardMessages == nil {
-				m.ShardMessages = make(map[int32]*v113.ReplicationMessages)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
-			var mapkey int32
-			var mapvalue *v113.ReplicationMessages
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v113.ReplicationMessages{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.ShardMessages[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationTasks = append(m.ReplicationTasks, &v113.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21926,7 +24736,7 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21949,17 +24759,55 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21969,26 +24817,43 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskInfos = append(m.TaskInfos, &v113.ReplicationTaskInfo{})
-			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22013,7 +24878,7 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22036,46 +24901,12 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v113.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22100,7 +24931,7 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22123,15 +24954,53 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22159,13 +25028,51 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22175,26 +25082,24 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.QueryWorkflowRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -22221,7 +25126,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22244,17 +25149,17 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22264,26 +25169,24 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &v1.QueryWorkflowResponse{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -22310,7 +25213,7 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22333,10 +25236,10 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22401,7 +25304,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v114.ReapplyEventsRequest{}
+				m.Request = &v114.RefreshWorkflowTasksRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -22431,7 +25334,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22454,10 +25357,10 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -22484,7 +25387,7 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *AnnotateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22507,53 +25410,15 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AnnotateWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AnnotateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22581,51 +25446,13 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
-			}
-			m.InclusiveEndMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
-			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22635,24 +25462,26 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.Request == nil {
+				m.Request = &v114.AnnotateWorkflowExecutionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -22679,7 +25508,7 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *AnnotateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22700,101 +25529,14 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			}
 		}
 		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v113.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnnotateWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnnotateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22819,7 +25561,7 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *UpsertWorkflowSearchAttributesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22842,53 +25584,15 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22916,13 +25620,13 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22932,11 +25636,28 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v115.UpsertWorkflowSearchAttributesRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22961,7 +25682,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *UpsertWorkflowSearchAttributesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22984,10 +25705,10 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpsertWorkflowSearchAttributesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -23014,7 +25735,7 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplyOperatorActionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23037,53 +25758,15 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyOperatorActionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyOperatorActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23111,51 +25794,13 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
-			}
-			m.InclusiveEndMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
-			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -23165,24 +25810,26 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.Request == nil {
+				m.Request = &v114.ApplyOperatorActionRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -23209,7 +25856,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplyOperatorActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23232,46 +25879,12 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyOperatorActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyOperatorActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -23296,7 +25909,7 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationEventChunkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23319,10 +25932,10 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationEventChunkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationEventChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -23387,7 +26000,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v114.RefreshWorkflowTasksRequest{}
+				m.Request = &v114.GetReplicationEventChunkRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -23417,7 +26030,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationEventChunkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23440,12 +26053,46 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationEventChunkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationEventChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -54,72 +54,78 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1034 bytes of a gzipped FileDescriptorProto
+	// 1130 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x8b, 0x23, 0x45,
-	0x18, 0x87, 0x53, 0x17, 0x0f, 0x85, 0xae, 0xda, 0x8a, 0x1f, 0xa3, 0x36, 0x22, 0x78, 0x4d, 0xd8,
-	0xdd, 0xcb, 0x7e, 0xcc, 0xba, 0xee, 0x64, 0x66, 0x32, 0xb3, 0x3b, 0xa3, 0x4e, 0xb2, 0x28, 0x78,
-	0x91, 0x9a, 0xce, 0xbb, 0x93, 0x62, 0x7a, 0x52, 0x6d, 0x55, 0x25, 0x9a, 0x9b, 0xe0, 0x49, 0x58,
-	0x50, 0x04, 0xc1, 0x93, 0xe0, 0x49, 0x11, 0x04, 0x41, 0x10, 0x04, 0xc1, 0x93, 0xe0, 0x71, 0x8e,
-	0x7b, 0x74, 0x32, 0x17, 0x8f, 0xfb, 0x27, 0x2c, 0x49, 0xa7, 0x6a, 0x52, 0xdd, 0xd5, 0xa1, 0xaa,
-	0x92, 0xdb, 0x6e, 0xa6, 0x7e, 0x4f, 0x3f, 0x5d, 0x5f, 0x6f, 0xa5, 0x82, 0xaf, 0x4a, 0x38, 0xc9,
-	0x18, 0x27, 0x69, 0x43, 0x00, 0x1f, 0x02, 0x6f, 0x90, 0x8c, 0x36, 0x7a, 0x54, 0x48, 0xc6, 0x47,
-	0x93, 0x4f, 0x68, 0x02, 0x8d, 0xe1, 0xe5, 0xc6, 0xec, 0x9f, 0xf5, 0x8c, 0x33, 0xc9, 0xa2, 0xb7,
-	0x55, 0xa8, 0x9e, 0x87, 0xea, 0x24, 0xa3, 0x75, 0x33, 0x54, 0x1f, 0x5e, 0x5e, 0x5b, 0x77, 0x63,
-	0x73, 0xf8, 0x74, 0x00, 0x42, 0x7e, 0xc2, 0x41, 0x64, 0xac, 0x2f, 0x66, 0x0f, 0xb9, 0xf2, 0xb0,
-	0x81, 0x2f, 0xed, 0xe4, 0x8d, 0x3b, 0x79, 0xe3, 0xe8, 0x27, 0x84, 0x5f, 0xea, 0x48, 0xc2, 0xe5,
-	0x47, 0x8c, 0x1f, 0x3f, 0x48, 0xd9, 0x67, 0x5b, 0x9f, 0x43, 0x32, 0x90, 0x94, 0xf5, 0xa3, 0xcd,
-	0xba, 0x93, 0x53, 0xdd, 0x1e, 0x6f, 0xe7, 0x0a, 0x6b, 0x5b, 0x4b, 0x52, 0xf2, 0x17, 0x78, 0xab,
-	0x16, 0x7d, 0x8b, 0xf0, 0xb3, 0x2d, 0x90, 0xfb, 0x03, 0x49, 0x0e, 0x53, 0xe8, 0x48, 0x22, 0x21,
-	0xba, 0xe5, 0x08, 0x2f, 0xe4, 0x94, 0xdb, 0x3b, 0xa1, 0x71, 0x2d, 0xf5, 0x1d, 0xc2, 0xcf, 0x7d,
-	0xc0, 0xd2, 0xd4, 0xb0, 0x72, 0xc5, 0x16, 0x83, 0x4a, 0xeb, 0x76, 0x70, 0x5e, 0x7b, 0xfd, 0x88,
-	0xf0, 0x8b, 0x6d, 0x10, 0x20, 0x3b, 0x92, 0x26, 0xc7, 0xa3, 0xfb, 0x44, 0x1c, 0x1f, 0x0c, 0x60,
-	0x00, 0xd1, 0x86, 0x23, 0xdb, 0x16, 0x56, 0x7e, 0xcd, 0xa5, 0x18, 0xda, 0xf1, 0x37, 0x84, 0x5f,
-	0x6d, 0x43, 0xc2, 0x78, 0x57, 0x0d, 0xfb, 0xa4, 0xd5, 0x74, 0x1e, 0x40, 0x37, 0x6a, 0x39, 0x3f,
-	0xa4, 0x82, 0xa0, 0x6c, 0x77, 0x96, 0x07, 0x59, 0x94, 0xef, 0x24, 0x92, 0x0e, 0xa9, 0x1c, 0x85,
-	0x2b, 0x5b, 0x08, 0x61, 0xca, 0x56, 0x90, 0x56, 0xfe, 0x13, 0xe1, 0xd7, 0xf3, 0xff, 0x1a, 0xef,
-	0xd6, 0x64, 0x27, 0x59, 0x0a, 0x13, 0xeb, 0xbb, 0xee, 0xa3, 0x59, 0x09, 0x51, 0xe2, 0xf7, 0x56,
-	0xc2, 0x2a, 0x74, 0x77, 0xa9, 0xe9, 0x36, 0xa1, 0xa9, 0x57, 0x77, 0x57, 0x10, 0xfc, 0xbb, 0xbb,
-	0x12, 0xa4, 0x95, 0xff, 0x40, 0xf8, 0xb5, 0xf2, 0xb0, 0xec, 0x00, 0xe1, 0xf2, 0x10, 0x88, 0x8c,
-	0x76, 0x83, 0x87, 0x56, 0x33, 0x94, 0xf6, 0xdd, 0x55, 0xa0, 0x6c, 0xf3, 0x64, 0xbe, 0x69, 0xf0,
-	0x3c, 0xb1, 0x42, 0x02, 0xe7, 0x49, 0x05, 0xcb, 0x36, 0x4f, 0xe6, 0x9b, 0x86, 0xcd, 0x93, 0x32,
-	0x21, 0x70, 0x9e, 0xd8, 0x40, 0x85, 0x79, 0x52, 0x7e, 0x3b, 0xd2, 0x4f, 0x60, 0x22, 0xbd, 0xbb,
-	0x44, 0x0f, 0xcd, 0x18, 0xfe, 0xf3, 0x64, 0x01, 0x4a, 0x8b, 0xff, 0x82, 0xf0, 0xcb, 0x1d, 0x7a,
-	0xd4, 0x27, 0x69, 0xf9, 0xc4, 0xe0, 0x5c, 0xeb, 0xed, 0x79, 0x25, 0xbc, 0xbd, 0x2c, 0x46, 0xcb,
-	0xfe, 0x83, 0xf0, 0x9b, 0xb3, 0x56, 0x54, 0xf6, 0x2a, 0xce, 0x39, 0xef, 0xf9, 0x3d, 0xae, 0x12,
-	0xa4, 0xf4, 0xdf, 0x5f, 0x19, 0x4f, 0xbf, 0xc7, 0xaf, 0x08, 0xbf, 0xd2, 0x86, 0x13, 0x36, 0x84,
-	0x3c, 0x64, 0x1c, 0x37, 0xb6, 0x9d, 0xc7, 0xd7, 0x0e, 0x50, 0xde, 0xad, 0xa5, 0x39, 0xda, 0xf7,
-	0x77, 0x84, 0xd7, 0xee, 0x03, 0x3f, 0xa1, 0x7d, 0x22, 0xa1, 0xdc, 0xe3, 0xae, 0x0b, 0xa9, 0x1a,
-	0xa1, 0x9c, 0x77, 0x57, 0x40, 0xd2, 0xd6, 0x93, 0xb3, 0xf0, 0xf4, 0xcc, 0x12, 0x7e, 0x16, 0xb6,
-	0xc7, 0x7d, 0xcf, 0xc2, 0x55, 0x14, 0x6d, 0xfa, 0x37, 0xc2, 0xf1, 0x0c, 0x9a, 0x2f, 0xd1, 0xb2,
-	0xf1, 0x9e, 0xf3, 0xb3, 0x16, 0x61, 0x94, 0xf9, 0xfe, 0x8a, 0x68, 0xc6, 0x01, 0xb5, 0x93, 0xf4,
-	0xa0, 0x3b, 0x48, 0x61, 0xbe, 0xa0, 0x3a, 0x1f, 0x50, 0x6d, 0x61, 0xdf, 0x03, 0xaa, 0x9d, 0xa1,
-	0x1d, 0xff, 0x42, 0xf8, 0x8d, 0xbc, 0x78, 0x36, 0x7b, 0x34, 0xed, 0xea, 0xd7, 0xb8, 0xa8, 0x89,
-	0xf7, 0xbc, 0x4a, 0x70, 0x05, 0x45, 0x59, 0xef, 0xad, 0x06, 0x66, 0x54, 0xc5, 0x4d, 0x10, 0x09,
-	0xa7, 0x87, 0x96, 0x35, 0xe8, 0xba, 0xda, 0x2b, 0x09, 0xbe, 0x55, 0x71, 0x01, 0x48, 0x2b, 0x7f,
-	0x8f, 0xf0, 0xf3, 0x6d, 0xc8, 0x52, 0x9a, 0x10, 0x09, 0x5b, 0x43, 0xe8, 0x4b, 0xf1, 0xe1, 0x95,
-	0xe8, 0xb6, 0x73, 0xc7, 0x14, 0x92, 0x4a, 0xf1, 0xdd, 0x70, 0x80, 0xf1, 0xf5, 0xb3, 0x33, 0xea,
-	0x27, 0x9d, 0x1e, 0xe1, 0xdd, 0xc9, 0x7e, 0x37, 0x10, 0xce, 0x5f, 0x3f, 0x0b, 0x39, 0xdf, 0xaf,
-	0x9f, 0xa5, 0xb8, 0x96, 0xfa, 0x0a, 0xe1, 0xa7, 0x27, 0x7f, 0x55, 0x35, 0x3b, 0xba, 0xe1, 0x81,
-	0x54, 0x21, 0xa5, 0x73, 0x33, 0x28, 0x6b, 0xac, 0x68, 0x35, 0xc6, 0x46, 0x7d, 0xda, 0xf0, 0x9c,
-	0x20, 0xb6, 0xda, 0xd4, 0x5c, 0x8a, 0xa1, 0x1d, 0x7f, 0x40, 0xf8, 0x05, 0xd5, 0x64, 0x76, 0x11,
-	0xb2, 0xc3, 0x84, 0x8c, 0xee, 0x78, 0xe2, 0xe7, 0xb2, 0xca, 0x70, 0x63, 0x19, 0x84, 0x16, 0xfc,
-	0x12, 0x61, 0xdc, 0x4c, 0x99, 0x80, 0xe9, 0x78, 0x47, 0xd7, 0x1c, 0xa1, 0x17, 0x11, 0xa5, 0x73,
-	0x3d, 0x20, 0x69, 0x58, 0xe4, 0x55, 0x7e, 0xba, 0x25, 0x5f, 0xf3, 0x3a, 0x18, 0xcc, 0x6f, 0xc4,
-	0xd7, 0x03, 0x92, 0x46, 0x39, 0x6e, 0x81, 0x54, 0x8b, 0x92, 0xb2, 0xfe, 0x3e, 0x08, 0x41, 0x8e,
-	0x40, 0x38, 0x97, 0x63, 0x7b, 0xdc, 0xb7, 0x1c, 0x57, 0x51, 0x8c, 0x9d, 0xb6, 0x05, 0x72, 0x73,
-	0xef, 0xc0, 0x26, 0xdb, 0x72, 0x7f, 0x8c, 0x9d, 0xe0, 0xbb, 0xd3, 0x2e, 0x00, 0x69, 0xe5, 0x87,
-	0x08, 0x3f, 0x73, 0x30, 0x00, 0x3e, 0x52, 0xdb, 0x71, 0xe4, 0xba, 0xfc, 0x8d, 0x94, 0x52, 0x5b,
-	0x0f, 0x0b, 0x1b, 0x3a, 0x6d, 0x20, 0x59, 0x96, 0x8e, 0xf2, 0xbd, 0xd7, 0x59, 0xc7, 0x48, 0xf9,
-	0xea, 0x14, 0xc2, 0x5a, 0xe7, 0x6b, 0x84, 0x2f, 0xe5, 0xbd, 0xa8, 0x47, 0x71, 0xdd, 0xab, 0xf3,
-	0x8b, 0x43, 0x77, 0x2b, 0x30, 0x6d, 0x5e, 0x34, 0x0e, 0xf8, 0x11, 0xcc, 0x3b, 0x39, 0x5f, 0x34,
-	0x16, 0x82, 0xde, 0x17, 0x8d, 0xa5, 0xbc, 0xe1, 0xb5, 0x0f, 0x81, 0x5e, 0xc5, 0xa0, 0xaf, 0x57,
-	0x39, 0x5f, 0xb8, 0x00, 0x7d, 0xc0, 0x41, 0xf4, 0xe6, 0x4f, 0x77, 0xc2, 0xe3, 0x02, 0xb4, 0x1c,
-	0xf6, 0xbf, 0x00, 0xb5, 0x31, 0x94, 0xe3, 0x46, 0x76, 0x7a, 0x16, 0xd7, 0x1e, 0x9d, 0xc5, 0xb5,
-	0xc7, 0x67, 0x31, 0xfa, 0x62, 0x1c, 0xa3, 0x9f, 0xc7, 0x31, 0xfa, 0x77, 0x1c, 0xa3, 0xd3, 0x71,
-	0x8c, 0xfe, 0x1b, 0xc7, 0xe8, 0xff, 0x71, 0x5c, 0x7b, 0x3c, 0x8e, 0xd1, 0x37, 0xe7, 0x71, 0xed,
-	0xf4, 0x3c, 0xae, 0x3d, 0x3a, 0x8f, 0x6b, 0x1f, 0xdf, 0x38, 0x62, 0x17, 0x8f, 0xa7, 0x6c, 0xe1,
-	0x0f, 0x01, 0x37, 0xcd, 0x4f, 0x0e, 0x9f, 0x9a, 0xfe, 0x0e, 0x70, 0xf5, 0x49, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x3e, 0xd6, 0x58, 0xf3, 0xa3, 0x18, 0x00, 0x00,
+	0x1f, 0xc7, 0x53, 0x97, 0xe7, 0x50, 0x3c, 0xae, 0xda, 0x8a, 0x2f, 0xa3, 0x36, 0x22, 0x78, 0x4d,
+	0xd8, 0x5d, 0x0f, 0xfb, 0x32, 0xeb, 0x9a, 0xc9, 0xcc, 0x64, 0x66, 0x77, 0xe2, 0x3a, 0xc9, 0xaa,
+	0xe0, 0x45, 0x2a, 0x9d, 0xdf, 0x4e, 0x9a, 0xe9, 0xe9, 0x6a, 0xab, 0xaa, 0xa3, 0xb9, 0x09, 0x9e,
+	0x04, 0x41, 0x11, 0x04, 0x4f, 0x82, 0x27, 0x45, 0x10, 0x04, 0x41, 0x10, 0x04, 0x4f, 0x82, 0xc7,
+	0xc1, 0xd3, 0x1e, 0x9d, 0xcc, 0xc5, 0xe3, 0xfe, 0x09, 0x92, 0x74, 0xaa, 0x26, 0x95, 0xae, 0x8e,
+	0x55, 0xdd, 0xb9, 0xed, 0xce, 0xd4, 0xf7, 0xd3, 0x9f, 0xae, 0xd7, 0x5f, 0xf5, 0xe0, 0xab, 0x02,
+	0x4e, 0x12, 0xca, 0x48, 0xd4, 0xe0, 0xc0, 0x46, 0xc0, 0x1a, 0x24, 0x09, 0x1b, 0xc3, 0x90, 0x0b,
+	0xca, 0xc6, 0xd3, 0x9f, 0x84, 0x01, 0x34, 0x46, 0x97, 0x1b, 0xf3, 0x7f, 0xd6, 0x13, 0x46, 0x05,
+	0xf5, 0x5e, 0x95, 0xa1, 0x7a, 0x16, 0xaa, 0x93, 0x24, 0xac, 0xeb, 0xa1, 0xfa, 0xe8, 0xf2, 0xc6,
+	0xa6, 0x1d, 0x9b, 0xc1, 0x07, 0x29, 0x70, 0xf1, 0x3e, 0x03, 0x9e, 0xd0, 0x98, 0xcf, 0x1f, 0x72,
+	0xe5, 0xaf, 0xd7, 0xf0, 0xa5, 0xbd, 0xac, 0x71, 0x2f, 0x6b, 0xec, 0x7d, 0x87, 0xf0, 0x33, 0x3d,
+	0x41, 0x98, 0x78, 0x97, 0xb2, 0xe3, 0x07, 0x11, 0xfd, 0x70, 0xe7, 0x23, 0x08, 0x52, 0x11, 0xd2,
+	0xd8, 0xdb, 0xae, 0x5b, 0x39, 0xd5, 0xcd, 0xf1, 0x6e, 0xa6, 0xb0, 0xb1, 0x53, 0x91, 0x92, 0xbd,
+	0xc0, 0x2b, 0x35, 0xef, 0x4b, 0x84, 0x1f, 0x6f, 0x83, 0xe8, 0xa4, 0x82, 0xf4, 0x23, 0xe8, 0x09,
+	0x22, 0xc0, 0xbb, 0x65, 0x09, 0x5f, 0xca, 0x49, 0xb7, 0xd7, 0xcb, 0xc6, 0x95, 0xd4, 0x57, 0x08,
+	0x3f, 0xf1, 0x16, 0x8d, 0x22, 0xcd, 0xca, 0x16, 0xbb, 0x1c, 0x94, 0x5a, 0xb7, 0x4b, 0xe7, 0x95,
+	0xd7, 0xb7, 0x08, 0x3f, 0xdd, 0x05, 0x0e, 0xa2, 0x27, 0xc2, 0xe0, 0x78, 0x7c, 0x9f, 0xf0, 0xe3,
+	0xc3, 0x14, 0x52, 0xf0, 0xb6, 0x2c, 0xd9, 0xa6, 0xb0, 0xf4, 0x6b, 0x55, 0x62, 0x28, 0xc7, 0x9f,
+	0x10, 0x7e, 0xbe, 0x0b, 0x01, 0x65, 0x03, 0x39, 0xec, 0xd3, 0x56, 0xb3, 0x79, 0x00, 0x03, 0xaf,
+	0x6d, 0xfd, 0x90, 0x02, 0x82, 0xb4, 0xdd, 0xab, 0x0e, 0x32, 0x28, 0x37, 0x03, 0x11, 0x8e, 0x42,
+	0x31, 0x2e, 0xaf, 0x6c, 0x20, 0x94, 0x53, 0x36, 0x82, 0x94, 0xf2, 0xaf, 0x08, 0xbf, 0x98, 0xfd,
+	0x57, 0x7b, 0xb7, 0x16, 0x3d, 0x49, 0x22, 0x98, 0x5a, 0xdf, 0xb1, 0x1f, 0xcd, 0x42, 0x88, 0x14,
+	0xbf, 0xbb, 0x16, 0xd6, 0x52, 0x77, 0xe7, 0x9a, 0xee, 0x92, 0x30, 0x72, 0xea, 0xee, 0x02, 0x82,
+	0x7b, 0x77, 0x17, 0x82, 0x94, 0xf2, 0x2f, 0x08, 0xbf, 0x90, 0x1f, 0x96, 0x3d, 0x20, 0x4c, 0xf4,
+	0x81, 0x08, 0x6f, 0xbf, 0xf4, 0xd0, 0x2a, 0x86, 0xd4, 0xbe, 0xb3, 0x0e, 0x94, 0x69, 0x9e, 0x2c,
+	0x36, 0x2d, 0x3d, 0x4f, 0x8c, 0x90, 0x92, 0xf3, 0xa4, 0x80, 0x65, 0x9a, 0x27, 0x8b, 0x4d, 0xcb,
+	0xcd, 0x93, 0x3c, 0xa1, 0xe4, 0x3c, 0x31, 0x81, 0x96, 0xe6, 0x49, 0xfe, 0xed, 0x48, 0x1c, 0xc0,
+	0x54, 0x7a, 0xbf, 0x42, 0x0f, 0xcd, 0x19, 0xee, 0xf3, 0x64, 0x05, 0x4a, 0x89, 0xff, 0x80, 0xf0,
+	0xb3, 0xbd, 0xf0, 0x28, 0x26, 0x51, 0xbe, 0x62, 0xb0, 0x3e, 0xeb, 0xcd, 0x79, 0x29, 0xbc, 0x5b,
+	0x15, 0xa3, 0x64, 0xff, 0x40, 0xf8, 0xe5, 0x79, 0xab, 0x50, 0x0c, 0x0b, 0xea, 0x9c, 0x37, 0xdd,
+	0x1e, 0x57, 0x08, 0x92, 0xfa, 0xf7, 0xd6, 0xc6, 0x53, 0xef, 0xf1, 0x23, 0xc2, 0xcf, 0x75, 0xe1,
+	0x84, 0x8e, 0x20, 0x0b, 0x69, 0xe5, 0xc6, 0xae, 0xf5, 0xf8, 0x9a, 0x01, 0xd2, 0xbb, 0x5d, 0x99,
+	0xa3, 0x7c, 0x7f, 0x46, 0x78, 0xe3, 0x3e, 0xb0, 0x93, 0x30, 0x26, 0x02, 0xf2, 0x3d, 0x6e, 0xbb,
+	0x90, 0x8a, 0x11, 0xd2, 0x79, 0x7f, 0x0d, 0x24, 0x65, 0x3d, 0xad, 0x85, 0x67, 0x35, 0x4b, 0xf9,
+	0x5a, 0xd8, 0x1c, 0x77, 0xad, 0x85, 0x8b, 0x28, 0xca, 0xf4, 0x77, 0x84, 0xfd, 0x39, 0x34, 0x5b,
+	0xa2, 0x79, 0xe3, 0x03, 0xeb, 0x67, 0xad, 0xc2, 0x48, 0xf3, 0xce, 0x9a, 0x68, 0x5a, 0x81, 0xda,
+	0x0b, 0x86, 0x30, 0x48, 0x23, 0x58, 0x3c, 0x50, 0xad, 0x0b, 0x54, 0x53, 0xd8, 0xb5, 0x40, 0x35,
+	0x33, 0x94, 0xe3, 0x6f, 0x08, 0xbf, 0x94, 0x1d, 0x9e, 0xad, 0x61, 0x18, 0x0d, 0xd4, 0x6b, 0x5c,
+	0x9c, 0x89, 0x77, 0x9d, 0x8e, 0xe0, 0x02, 0x8a, 0xb4, 0x3e, 0x58, 0x0f, 0x4c, 0x3b, 0x15, 0xb7,
+	0x81, 0x07, 0x2c, 0xec, 0x1b, 0xd6, 0xa0, 0xed, 0x6a, 0x2f, 0x24, 0xb8, 0x9e, 0x8a, 0x2b, 0x40,
+	0x4a, 0xf9, 0x6b, 0x84, 0x9f, 0xec, 0x42, 0x12, 0x85, 0x01, 0x11, 0xb0, 0x33, 0x82, 0x58, 0xf0,
+	0x77, 0xae, 0x78, 0xb7, 0xad, 0x3b, 0x66, 0x29, 0x29, 0x15, 0xdf, 0x28, 0x0f, 0xd0, 0xae, 0x9f,
+	0xbd, 0x71, 0x1c, 0xf4, 0x86, 0x84, 0x0d, 0xa6, 0xfb, 0x5d, 0xca, 0xad, 0xaf, 0x9f, 0x4b, 0x39,
+	0xd7, 0xeb, 0x67, 0x2e, 0xae, 0xa4, 0x3e, 0x45, 0xf8, 0xff, 0xd3, 0xdf, 0xca, 0x33, 0xdb, 0xbb,
+	0xe1, 0x80, 0x94, 0x21, 0xa9, 0x73, 0xb3, 0x54, 0x56, 0x5b, 0xd1, 0x72, 0x8c, 0xb5, 0xf3, 0x69,
+	0xcb, 0x71, 0x82, 0x98, 0xce, 0xa6, 0x56, 0x25, 0x86, 0x72, 0xfc, 0x06, 0xe1, 0xa7, 0x64, 0x93,
+	0xf9, 0x87, 0x90, 0x3d, 0xca, 0x85, 0xd7, 0x74, 0xc4, 0x2f, 0x64, 0xa5, 0xe1, 0x56, 0x15, 0x84,
+	0x12, 0xfc, 0x04, 0x61, 0xdc, 0x8a, 0x28, 0x87, 0xd9, 0x78, 0x7b, 0xd7, 0x2c, 0xa1, 0x17, 0x11,
+	0xa9, 0x73, 0xbd, 0x44, 0x52, 0xb3, 0xc8, 0x4e, 0xf9, 0xd9, 0x96, 0x7c, 0xcd, 0xa9, 0x30, 0x58,
+	0xdc, 0x88, 0xaf, 0x97, 0x48, 0x6a, 0xc7, 0x71, 0x1b, 0x84, 0x5c, 0x94, 0x21, 0x8d, 0x3b, 0xc0,
+	0x39, 0x39, 0x02, 0x6e, 0x7d, 0x1c, 0x9b, 0xe3, 0xae, 0xc7, 0x71, 0x11, 0x45, 0xdb, 0x69, 0xdb,
+	0x20, 0xb6, 0x0f, 0x0e, 0x4d, 0xb2, 0x6d, 0xfb, 0xc7, 0x98, 0x09, 0xae, 0x3b, 0xed, 0x0a, 0x90,
+	0x52, 0xfe, 0x0c, 0xe1, 0xc7, 0x0e, 0x53, 0x60, 0x63, 0xb9, 0x1d, 0x7b, 0xb6, 0xcb, 0x5f, 0x4b,
+	0x49, 0xb5, 0xcd, 0x72, 0x61, 0x4d, 0xa7, 0x0b, 0x24, 0x49, 0xa2, 0x71, 0xb6, 0xf7, 0x5a, 0xeb,
+	0x68, 0x29, 0x57, 0x9d, 0xa5, 0xb0, 0xd2, 0xf9, 0x1c, 0xe1, 0x4b, 0x59, 0x2f, 0xaa, 0x51, 0xdc,
+	0x74, 0xea, 0xfc, 0xe5, 0xa1, 0xbb, 0x55, 0x32, 0xad, 0x7f, 0x68, 0x4c, 0xd9, 0x11, 0x2c, 0x3a,
+	0x59, 0x7f, 0x68, 0x5c, 0x0a, 0x3a, 0x7f, 0x68, 0xcc, 0xe5, 0x35, 0xaf, 0x0e, 0x94, 0xf4, 0xea,
+	0x40, 0x35, 0xaf, 0x0e, 0x14, 0x7a, 0x65, 0x1f, 0x40, 0x1f, 0x30, 0xe0, 0xc3, 0xc5, 0xea, 0x8e,
+	0x3b, 0x7c, 0x00, 0xcd, 0x87, 0xdd, 0x3f, 0x80, 0x9a, 0x18, 0xda, 0xb6, 0xd1, 0x8c, 0x63, 0x2a,
+	0x8c, 0x97, 0x24, 0xdb, 0x6d, 0xa3, 0x90, 0xe0, 0xba, 0x6d, 0xac, 0x00, 0x69, 0x17, 0x8f, 0xb7,
+	0x13, 0x0e, 0x17, 0xd7, 0xd5, 0x1e, 0x10, 0x16, 0x0c, 0x9b, 0x42, 0xb0, 0xb0, 0x9f, 0x0a, 0xe0,
+	0xd6, 0x17, 0x8f, 0xd5, 0x18, 0xd7, 0x8b, 0xc7, 0x7f, 0xd1, 0xb4, 0x12, 0xa0, 0x39, 0x5d, 0xf4,
+	0xf7, 0x12, 0x60, 0x44, 0x50, 0x36, 0x2d, 0x65, 0x68, 0x6c, 0x5d, 0x02, 0x18, 0xb2, 0xae, 0x25,
+	0x80, 0x11, 0xa1, 0xdd, 0xf5, 0xf5, 0x13, 0x67, 0xb6, 0x3d, 0xb5, 0x86, 0x69, 0x7c, 0x6c, 0x7d,
+	0xd7, 0x2f, 0x02, 0xb8, 0xde, 0xf5, 0x8b, 0x39, 0xd2, 0x77, 0x2b, 0x39, 0x3d, 0xf3, 0x6b, 0x0f,
+	0xcf, 0xfc, 0xda, 0xa3, 0x33, 0x1f, 0x7d, 0x3c, 0xf1, 0xd1, 0xf7, 0x13, 0x1f, 0xfd, 0x39, 0xf1,
+	0xd1, 0xe9, 0xc4, 0x47, 0x7f, 0x4f, 0x7c, 0xf4, 0xcf, 0xc4, 0xaf, 0x3d, 0x9a, 0xf8, 0xe8, 0x8b,
+	0x73, 0xbf, 0x76, 0x7a, 0xee, 0xd7, 0x1e, 0x9e, 0xfb, 0xb5, 0xf7, 0x6e, 0x1c, 0xd1, 0x0b, 0x85,
+	0x90, 0xae, 0xfc, 0x73, 0xd6, 0x4d, 0xfd, 0x27, 0xfd, 0xff, 0xcd, 0xfe, 0x9a, 0x75, 0xf5, 0xdf,
+	0x01, 0x00, 0x34, 0x96, 0x75, 0x94, 0x69, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -262,6 +268,16 @@ type HistoryServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// AnnotateWorkflowExecution stores an operator annotation on a workflow execution.
+	AnnotateWorkflowExecution(ctx context.Context, in *AnnotateWorkflowExecutionRequest, opts ...grpc.CallOption) (*AnnotateWorkflowExecutionResponse, error)
+	// UpsertWorkflowSearchAttributes upserts search attributes of a running workflow execution on behalf of
+	// an external system, without writing a history event.
+	UpsertWorkflowSearchAttributes(ctx context.Context, in *UpsertWorkflowSearchAttributesRequest, opts ...grpc.CallOption) (*UpsertWorkflowSearchAttributesResponse, error)
+	// ApplyOperatorAction applies an operator action to a running workflow execution.
+	ApplyOperatorAction(ctx context.Context, in *ApplyOperatorActionRequest, opts ...grpc.CallOption) (*ApplyOperatorActionResponse, error)
+	// GetReplicationEventChunk returns a chunk of the events of a history replication task
+	// which is too large to be sent in one message.
+	GetReplicationEventChunk(ctx context.Context, in *GetReplicationEventChunkRequest, opts ...grpc.CallOption) (*GetReplicationEventChunkResponse, error)
 }
 
 type historyServiceClient struct {
@@ -596,6 +612,42 @@ func (c *historyServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Ref
 	return out, nil
 }
 
+func (c *historyServiceClient) AnnotateWorkflowExecution(ctx context.Context, in *AnnotateWorkflowExecutionRequest, opts ...grpc.CallOption) (*AnnotateWorkflowExecutionResponse, error) {
+	out := new(AnnotateWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/AnnotateWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpsertWorkflowSearchAttributes(ctx context.Context, in *UpsertWorkflowSearchAttributesRequest, opts ...grpc.CallOption) (*UpsertWorkflowSearchAttributesResponse, error) {
+	out := new(UpsertWorkflowSearchAttributesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpsertWorkflowSearchAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ApplyOperatorAction(ctx context.Context, in *ApplyOperatorActionRequest, opts ...grpc.CallOption) (*ApplyOperatorActionResponse, error) {
+	out := new(ApplyOperatorActionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/ApplyOperatorAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) GetReplicationEventChunk(ctx context.Context, in *GetReplicationEventChunkRequest, opts ...grpc.CallOption) (*GetReplicationEventChunkResponse, error) {
+	out := new(GetReplicationEventChunkResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GetReplicationEventChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -726,6 +778,16 @@ type HistoryServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// AnnotateWorkflowExecution stores an operator annotation on a workflow execution.
+	AnnotateWorkflowExecution(context.Context, *AnnotateWorkflowExecutionRequest) (*AnnotateWorkflowExecutionResponse, error)
+	// UpsertWorkflowSearchAttributes upserts search attributes of a running workflow execution on behalf of
+	// an external system, without writing a history event.
+	UpsertWorkflowSearchAttributes(context.Context, *UpsertWorkflowSearchAttributesRequest) (*UpsertWorkflowSearchAttributesResponse, error)
+	// ApplyOperatorAction applies an operator action to a running workflow execution.
+	ApplyOperatorAction(context.Context, *ApplyOperatorActionRequest) (*ApplyOperatorActionResponse, error)
+	// GetReplicationEventChunk returns a chunk of the events of a history replication task
+	// which is too large to be sent in one message.
+	GetReplicationEventChunk(context.Context, *GetReplicationEventChunkRequest) (*GetReplicationEventChunkResponse, error)
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -840,6 +902,18 @@ func (*UnimplementedHistoryServiceServer) MergeDLQMessages(ctx context.Context,
 func (*UnimplementedHistoryServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedHistoryServiceServer) AnnotateWorkflowExecution(ctx context.Context, req *AnnotateWorkflowExecutionRequest) (*AnnotateWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnotateWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UpsertWorkflowSearchAttributes(ctx context.Context, req *UpsertWorkflowSearchAttributesRequest) (*UpsertWorkflowSearchAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkflowSearchAttributes not implemented")
+}
+func (*UnimplementedHistoryServiceServer) ApplyOperatorAction(ctx context.Context, req *ApplyOperatorActionRequest) (*ApplyOperatorActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyOperatorAction not implemented")
+}
+func (*UnimplementedHistoryServiceServer) GetReplicationEventChunk(ctx context.Context, req *GetReplicationEventChunkRequest) (*GetReplicationEventChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationEventChunk not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -1493,6 +1567,78 @@ func _HistoryService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_AnnotateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotateWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).AnnotateWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/AnnotateWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).AnnotateWorkflowExecution(ctx, req.(*AnnotateWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpsertWorkflowSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertWorkflowSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpsertWorkflowSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpsertWorkflowSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpsertWorkflowSearchAttributes(ctx, req.(*UpsertWorkflowSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ApplyOperatorAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyOperatorActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ApplyOperatorAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/ApplyOperatorAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ApplyOperatorAction(ctx, req.(*ApplyOperatorActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_GetReplicationEventChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationEventChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetReplicationEventChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/GetReplicationEventChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetReplicationEventChunk(ctx, req.(*GetReplicationEventChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -1641,6 +1787,22 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _HistoryService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "AnnotateWorkflowExecution",
+			Handler:    _HistoryService_AnnotateWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UpsertWorkflowSearchAttributes",
+			Handler:    _HistoryService_UpsertWorkflowSearchAttributes_Handler,
+		},
+		{
+			MethodName: "ApplyOperatorAction",
+			Handler:    _HistoryService_ApplyOperatorAction_Handler,
+		},
+		{
+			MethodName: "GetReplicationEventChunk",
+			Handler:    _HistoryService_GetReplicationEventChunk_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/historyservice/v1/service.proto",
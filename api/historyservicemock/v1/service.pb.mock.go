@@ -60,6 +60,46 @@ func (m *MockHistoryServiceClient) EXPECT() *MockHistoryServiceClientMockRecorde
 	return m.recorder
 }
 
+// AnnotateWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) AnnotateWorkflowExecution(ctx context.Context, in *historyservice.AnnotateWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.AnnotateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AnnotateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.AnnotateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnotateWorkflowExecution indicates an expected call of AnnotateWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) AnnotateWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnotateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).AnnotateWorkflowExecution), varargs...)
+}
+
+// ApplyOperatorAction mocks base method.
+func (m *MockHistoryServiceClient) ApplyOperatorAction(ctx context.Context, in *historyservice.ApplyOperatorActionRequest, opts ...grpc.CallOption) (*historyservice.ApplyOperatorActionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApplyOperatorAction", varargs...)
+	ret0, _ := ret[0].(*historyservice.ApplyOperatorActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyOperatorAction indicates an expected call of ApplyOperatorAction.
+func (mr *MockHistoryServiceClientMockRecorder) ApplyOperatorAction(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyOperatorAction", reflect.TypeOf((*MockHistoryServiceClient)(nil).ApplyOperatorAction), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockHistoryServiceClient) CloseShard(ctx context.Context, in *historyservice.CloseShardRequest, opts ...grpc.CallOption) (*historyservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +240,26 @@ func (mr *MockHistoryServiceClientMockRecorder) GetMutableState(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetMutableState), varargs...)
 }
 
+// GetReplicationEventChunk mocks base method.
+func (m *MockHistoryServiceClient) GetReplicationEventChunk(ctx context.Context, in *historyservice.GetReplicationEventChunkRequest, opts ...grpc.CallOption) (*historyservice.GetReplicationEventChunkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationEventChunk", varargs...)
+	ret0, _ := ret[0].(*historyservice.GetReplicationEventChunkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationEventChunk indicates an expected call of GetReplicationEventChunk.
+func (mr *MockHistoryServiceClientMockRecorder) GetReplicationEventChunk(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationEventChunk", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetReplicationEventChunk), varargs...)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockHistoryServiceClient) GetReplicationMessages(ctx context.Context, in *historyservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*historyservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -780,6 +840,26 @@ func (mr *MockHistoryServiceClientMockRecorder) TerminateWorkflowExecution(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).TerminateWorkflowExecution), varargs...)
 }
 
+// UpsertWorkflowSearchAttributes mocks base method.
+func (m *MockHistoryServiceClient) UpsertWorkflowSearchAttributes(ctx context.Context, in *historyservice.UpsertWorkflowSearchAttributesRequest, opts ...grpc.CallOption) (*historyservice.UpsertWorkflowSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", varargs...)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowSearchAttributes indicates an expected call of UpsertWorkflowSearchAttributes.
+func (mr *MockHistoryServiceClientMockRecorder) UpsertWorkflowSearchAttributes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributes", reflect.TypeOf((*MockHistoryServiceClient)(nil).UpsertWorkflowSearchAttributes), varargs...)
+}
+
 // MockHistoryServiceServer is a mock of HistoryServiceServer interface.
 type MockHistoryServiceServer struct {
 	ctrl     *gomock.Controller
@@ -803,6 +883,36 @@ func (m *MockHistoryServiceServer) EXPECT() *MockHistoryServiceServerMockRecorde
 	return m.recorder
 }
 
+// AnnotateWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) AnnotateWorkflowExecution(arg0 context.Context, arg1 *historyservice.AnnotateWorkflowExecutionRequest) (*historyservice.AnnotateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnotateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.AnnotateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnotateWorkflowExecution indicates an expected call of AnnotateWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) AnnotateWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnotateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).AnnotateWorkflowExecution), arg0, arg1)
+}
+
+// ApplyOperatorAction mocks base method.
+func (m *MockHistoryServiceServer) ApplyOperatorAction(arg0 context.Context, arg1 *historyservice.ApplyOperatorActionRequest) (*historyservice.ApplyOperatorActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyOperatorAction", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ApplyOperatorActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyOperatorAction indicates an expected call of ApplyOperatorAction.
+func (mr *MockHistoryServiceServerMockRecorder) ApplyOperatorAction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyOperatorAction", reflect.TypeOf((*MockHistoryServiceServer)(nil).ApplyOperatorAction), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockHistoryServiceServer) CloseShard(arg0 context.Context, arg1 *historyservice.CloseShardRequest) (*historyservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -908,6 +1018,21 @@ func (mr *MockHistoryServiceServerMockRecorder) GetMutableState(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetMutableState), arg0, arg1)
 }
 
+// GetReplicationEventChunk mocks base method.
+func (m *MockHistoryServiceServer) GetReplicationEventChunk(arg0 context.Context, arg1 *historyservice.GetReplicationEventChunkRequest) (*historyservice.GetReplicationEventChunkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationEventChunk", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GetReplicationEventChunkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationEventChunk indicates an expected call of GetReplicationEventChunk.
+func (mr *MockHistoryServiceServerMockRecorder) GetReplicationEventChunk(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationEventChunk", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetReplicationEventChunk), arg0, arg1)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockHistoryServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *historyservice.GetReplicationMessagesRequest) (*historyservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1342,3 +1467,18 @@ func (mr *MockHistoryServiceServerMockRecorder) TerminateWorkflowExecution(arg0,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
+
+// UpsertWorkflowSearchAttributes mocks base method.
+func (m *MockHistoryServiceServer) UpsertWorkflowSearchAttributes(arg0 context.Context, arg1 *historyservice.UpsertWorkflowSearchAttributesRequest) (*historyservice.UpsertWorkflowSearchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UpsertWorkflowSearchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkflowSearchAttributes indicates an expected call of UpsertWorkflowSearchAttributes.
+func (mr *MockHistoryServiceServerMockRecorder) UpsertWorkflowSearchAttributes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributes", reflect.TypeOf((*MockHistoryServiceServer)(nil).UpsertWorkflowSearchAttributes), arg0, arg1)
+}
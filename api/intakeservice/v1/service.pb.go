@@ -0,0 +1,198 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/intakeservice/v1/service.proto
+
+package intakeservice
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("temporal/server/api/intakeservice/v1/service.proto", fileDescriptor_5b27e37e99fdf994)
+}
+
+var fileDescriptor_5b27e37e99fdf994 = []byte{
+	// 274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x2a, 0x49, 0xcd, 0x2d,
+	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
+	0xcf, 0xcc, 0x2b, 0x49, 0xcc, 0x4e, 0x05, 0x09, 0x64, 0x26, 0xa7, 0xea, 0x97, 0x19, 0xea, 0x43,
+	0x99, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x2a, 0x30, 0x3d, 0x7a, 0x10, 0x3d, 0x7a, 0x89,
+	0x05, 0x99, 0x7a, 0x28, 0x7a, 0xf4, 0xca, 0x0c, 0xa5, 0xac, 0x89, 0x32, 0xb9, 0x28, 0xb5, 0xb0,
+	0x34, 0xb5, 0xb8, 0x24, 0xbe, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x18, 0x6a, 0x85, 0x51, 0x1b,
+	0x33, 0x17, 0xaf, 0x27, 0x58, 0x6d, 0x30, 0x44, 0xad, 0xd0, 0x76, 0x46, 0x2e, 0x19, 0xc7, 0xe4,
+	0xe4, 0xd4, 0x82, 0x92, 0xe0, 0x92, 0xc4, 0xa2, 0x92, 0xf0, 0xfc, 0xa2, 0xec, 0xb4, 0x9c, 0xfc,
+	0x72, 0xd7, 0x8a, 0xd4, 0xe4, 0xd2, 0x92, 0xcc, 0xfc, 0x3c, 0x21, 0x4f, 0x3d, 0x62, 0x9c, 0xa5,
+	0x87, 0xcf, 0x8c, 0x20, 0x88, 0x63, 0xa4, 0xbc, 0xa8, 0x61, 0x14, 0xc4, 0x3f, 0x4a, 0x0c, 0x42,
+	0xbb, 0x18, 0xb9, 0x64, 0xa1, 0x4a, 0x33, 0xd3, 0xf3, 0x12, 0x73, 0x30, 0x9d, 0x4e, 0x9a, 0x7d,
+	0xd8, 0x0d, 0x81, 0xb9, 0xdd, 0x9b, 0x2a, 0x66, 0xc1, 0x1c, 0xef, 0x94, 0x77, 0xe1, 0xa1, 0x1c,
+	0xc3, 0x8d, 0x87, 0x72, 0x0c, 0x1f, 0x1e, 0xca, 0x31, 0x36, 0x3c, 0x92, 0x63, 0x5c, 0xf1, 0x48,
+	0x8e, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x7c, 0xf1,
+	0x48, 0x8e, 0xe1, 0xc3, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
+	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x22, 0x3d, 0x1f, 0xe1, 0x8c, 0xcc, 0x7c, 0x7c, 0x29, 0xc0, 0x1a,
+	0x45, 0x20, 0x89, 0x0d, 0x1c, 0xff, 0xc6, 0x80, 0x01, 0x00, 0x1c, 0x7c, 0x46, 0x2d, 0x98, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// IntakeServiceClient is the client API for IntakeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type IntakeServiceClient interface {
+	// AcceptStartWorkflowExecution validates the start request and accepts it to the intake queue. The run ID of the
+	// started workflow is not known until the request is applied.
+	AcceptStartWorkflowExecution(ctx context.Context, in *AcceptStartWorkflowExecutionRequest, opts ...grpc.CallOption) (*AcceptStartWorkflowExecutionResponse, error)
+	// AcceptSignalWorkflowExecution validates the signal request and accepts it to the intake queue.
+	AcceptSignalWorkflowExecution(ctx context.Context, in *AcceptSignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*AcceptSignalWorkflowExecutionResponse, error)
+}
+
+type intakeServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewIntakeServiceClient(cc *grpc.ClientConn) IntakeServiceClient {
+	return &intakeServiceClient{cc}
+}
+
+func (c *intakeServiceClient) AcceptStartWorkflowExecution(ctx context.Context, in *AcceptStartWorkflowExecutionRequest, opts ...grpc.CallOption) (*AcceptStartWorkflowExecutionResponse, error) {
+	out := new(AcceptStartWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.intakeservice.v1.IntakeService/AcceptStartWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *intakeServiceClient) AcceptSignalWorkflowExecution(ctx context.Context, in *AcceptSignalWorkflowExecutionRequest, opts ...grpc.CallOption) (*AcceptSignalWorkflowExecutionResponse, error) {
+	out := new(AcceptSignalWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.intakeservice.v1.IntakeService/AcceptSignalWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IntakeServiceServer is the server API for IntakeService service.
+type IntakeServiceServer interface {
+	// AcceptStartWorkflowExecution validates the start request and accepts it to the intake queue. The run ID of the
+	// started workflow is not known until the request is applied.
+	AcceptStartWorkflowExecution(context.Context, *AcceptStartWorkflowExecutionRequest) (*AcceptStartWorkflowExecutionResponse, error)
+	// AcceptSignalWorkflowExecution validates the signal request and accepts it to the intake queue.
+	AcceptSignalWorkflowExecution(context.Context, *AcceptSignalWorkflowExecutionRequest) (*AcceptSignalWorkflowExecutionResponse, error)
+}
+
+// UnimplementedIntakeServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedIntakeServiceServer struct {
+}
+
+func (*UnimplementedIntakeServiceServer) AcceptStartWorkflowExecution(ctx context.Context, req *AcceptStartWorkflowExecutionRequest) (*AcceptStartWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptStartWorkflowExecution not implemented")
+}
+func (*UnimplementedIntakeServiceServer) AcceptSignalWorkflowExecution(ctx context.Context, req *AcceptSignalWorkflowExecutionRequest) (*AcceptSignalWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSignalWorkflowExecution not implemented")
+}
+
+func RegisterIntakeServiceServer(s *grpc.Server, srv IntakeServiceServer) {
+	s.RegisterService(&_IntakeService_serviceDesc, srv)
+}
+
+func _IntakeService_AcceptStartWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptStartWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntakeServiceServer).AcceptStartWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.intakeservice.v1.IntakeService/AcceptStartWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntakeServiceServer).AcceptStartWorkflowExecution(ctx, req.(*AcceptStartWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntakeService_AcceptSignalWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptSignalWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntakeServiceServer).AcceptSignalWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.intakeservice.v1.IntakeService/AcceptSignalWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntakeServiceServer).AcceptSignalWorkflowExecution(ctx, req.(*AcceptSignalWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _IntakeService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "temporal.server.api.intakeservice.v1.IntakeService",
+	HandlerType: (*IntakeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcceptStartWorkflowExecution",
+			Handler:    _IntakeService_AcceptStartWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "AcceptSignalWorkflowExecution",
+			Handler:    _IntakeService_AcceptSignalWorkflowExecution_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "temporal/server/api/intakeservice/v1/service.proto",
+}
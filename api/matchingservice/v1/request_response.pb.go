@@ -49,6 +49,7 @@ import (
 	v1 "go.temporal.io/api/workflowservice/v1"
 	v15 "go.temporal.io/server/api/enums/v1"
 	v13 "go.temporal.io/server/api/history/v1"
+	v17 "go.temporal.io/server/api/taskqueue/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -147,6 +148,8 @@ type PollWorkflowTaskQueueResponse struct {
 	ScheduledTime              *time.Time                     `protobuf:"bytes,15,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 	StartedTime                *time.Time                     `protobuf:"bytes,16,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	Queries                    map[string]*v12.WorkflowQuery  `protobuf:"bytes,17,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Reasons to suggest workflow to continue-as-new, empty if continue-as-new is not suggested.
+	SuggestContinueAsNewReasons []string `protobuf:"bytes,18,rep,name=suggest_continue_as_new_reasons,json=suggestContinueAsNewReasons,proto3" json:"suggest_continue_as_new_reasons,omitempty"`
 }
 
 func (m *PollWorkflowTaskQueueResponse) Reset()      { *m = PollWorkflowTaskQueueResponse{} }
@@ -293,6 +296,13 @@ func (m *PollWorkflowTaskQueueResponse) GetQueries() map[string]*v12.WorkflowQue
 	return nil
 }
 
+func (m *PollWorkflowTaskQueueResponse) GetSuggestContinueAsNewReasons() []string {
+	if m != nil {
+		return m.SuggestContinueAsNewReasons
+	}
+	return nil
+}
+
 type PollActivityTaskQueueRequest struct {
 	NamespaceId     string                           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	PollerId        string                           `protobuf:"bytes,2,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
@@ -1167,6 +1177,8 @@ func (m *DescribeTaskQueueRequest) GetDescRequest() *v1.DescribeTaskQueueRequest
 type DescribeTaskQueueResponse struct {
 	Pollers         []*v14.PollerInfo    `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
 	TaskQueueStatus *v14.TaskQueueStatus `protobuf:"bytes,2,opt,name=task_queue_status,json=taskQueueStatus,proto3" json:"task_queue_status,omitempty"`
+	// Not set until task queue partition has been loaded for one autoscaling hint interval.
+	AutoscalingHint *v17.TaskQueueAutoscalingHint `protobuf:"bytes,3,opt,name=autoscaling_hint,json=autoscalingHint,proto3" json:"autoscaling_hint,omitempty"`
 }
 
 func (m *DescribeTaskQueueResponse) Reset()      { *m = DescribeTaskQueueResponse{} }
@@ -1215,6 +1227,13 @@ func (m *DescribeTaskQueueResponse) GetTaskQueueStatus() *v14.TaskQueueStatus {
 	return nil
 }
 
+func (m *DescribeTaskQueueResponse) GetAutoscalingHint() *v17.TaskQueueAutoscalingHint {
+	if m != nil {
+		return m.AutoscalingHint
+	}
+	return nil
+}
+
 type ListTaskQueuePartitionsRequest struct {
 	Namespace string         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	TaskQueue *v14.TaskQueue `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
@@ -1344,114 +1363,120 @@ func init() {
 }
 
 var fileDescriptor_a429a3813476c583 = []byte{
-	// 1712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x18, 0x4f, 0x73, 0xdb, 0x58,
-	0x3d, 0x72, 0x12, 0x27, 0x7e, 0xb2, 0x13, 0x47, 0x0b, 0x59, 0x25, 0x6d, 0x94, 0xd4, 0xbb, 0xec,
-	0x66, 0x99, 0x45, 0x9e, 0x86, 0xd9, 0xce, 0xb6, 0xd0, 0x81, 0x34, 0xcd, 0xb4, 0x86, 0xb6, 0xa4,
-	0x8a, 0x07, 0x98, 0x0e, 0x33, 0xea, 0x8b, 0xf4, 0xe2, 0x88, 0xc8, 0x7a, 0x8e, 0xde, 0x93, 0x53,
-	0x73, 0x62, 0xa6, 0xc3, 0xbd, 0x33, 0x5c, 0x60, 0xf8, 0x02, 0x70, 0x86, 0x0f, 0xc1, 0x81, 0x43,
-	0x8f, 0xbd, 0x41, 0x93, 0x0b, 0x33, 0x5c, 0xca, 0x37, 0x60, 0xde, 0x1f, 0xc9, 0x92, 0x6c, 0x27,
-	0x4e, 0x9a, 0xa1, 0x7b, 0xb3, 0x7e, 0xff, 0xff, 0xff, 0x7e, 0xcf, 0xe0, 0x2e, 0x45, 0xed, 0x0e,
-	0x0e, 0xa1, 0x5f, 0x27, 0x28, 0xec, 0xa2, 0xb0, 0x0e, 0x3b, 0x5e, 0xbd, 0x0d, 0xa9, 0x73, 0xe0,
-	0x05, 0x2d, 0x06, 0xf2, 0x1c, 0x54, 0xef, 0xde, 0xac, 0x87, 0xe8, 0x28, 0x42, 0x84, 0xda, 0x21,
-	0x22, 0x1d, 0x1c, 0x10, 0x64, 0x76, 0x42, 0x4c, 0xb1, 0xf6, 0x59, 0xcc, 0x6e, 0x0a, 0x76, 0x13,
-	0x76, 0x3c, 0x33, 0xc7, 0x6e, 0x76, 0x6f, 0x2e, 0x1b, 0x2d, 0x8c, 0x5b, 0x3e, 0xaa, 0x73, 0xae,
-	0xbd, 0x68, 0xbf, 0xee, 0x46, 0x21, 0xa4, 0x1e, 0x0e, 0x84, 0x9c, 0xe5, 0xd5, 0x3c, 0x9e, 0x7a,
-	0x6d, 0x44, 0x28, 0x6c, 0x77, 0x24, 0xc1, 0x0d, 0x17, 0x75, 0x50, 0xe0, 0xa2, 0xc0, 0xf1, 0x10,
-	0xa9, 0xb7, 0x70, 0x0b, 0x73, 0x38, 0xff, 0x25, 0x49, 0x3e, 0x4d, 0x5c, 0x61, 0x3e, 0x38, 0xb8,
-	0xdd, 0xc6, 0x01, 0x33, 0xbd, 0x8d, 0x08, 0x81, 0x2d, 0x69, 0xf1, 0xf2, 0x67, 0x19, 0x2a, 0x14,
-	0x44, 0x6d, 0xc2, 0x88, 0x28, 0x24, 0x87, 0xf6, 0x51, 0x84, 0xa2, 0x98, 0xee, 0xf3, 0x0c, 0x1d,
-	0x43, 0x73, 0xec, 0xa0, 0xc0, 0x4f, 0x32, 0x84, 0x47, 0x11, 0x0a, 0x7b, 0x83, 0x44, 0x9f, 0x0f,
-	0x0b, 0x73, 0x46, 0xb9, 0x24, 0xfc, 0x72, 0x18, 0xe1, 0x81, 0x47, 0x28, 0x1e, 0x26, 0xf6, 0x56,
-	0x46, 0xf7, 0x31, 0x0e, 0x0f, 0xf7, 0x7d, 0x7c, 0x7c, 0x6e, 0xda, 0x6a, 0xff, 0x51, 0xc0, 0xf5,
-	0x1d, 0xec, 0xfb, 0xbf, 0x90, 0x1c, 0x4d, 0x48, 0x0e, 0x9f, 0x32, 0xf7, 0x2c, 0x41, 0xaf, 0xdd,
-	0x00, 0xe5, 0x00, 0xb6, 0x11, 0xe9, 0x40, 0x07, 0xd9, 0x9e, 0xab, 0x2b, 0x6b, 0xca, 0x7a, 0xc9,
-	0x52, 0x13, 0x58, 0xc3, 0xd5, 0xae, 0x81, 0x52, 0x07, 0xfb, 0x3e, 0x0a, 0x19, 0xbe, 0xc0, 0xf1,
-	0xb3, 0x02, 0xd0, 0x70, 0xb5, 0xe7, 0xa0, 0xcc, 0x7e, 0xdb, 0x52, 0xbf, 0x3e, 0xb9, 0xa6, 0xac,
-	0xab, 0x1b, 0x77, 0xcd, 0xa4, 0x5c, 0x58, 0x9d, 0xe4, 0xec, 0x35, 0xbb, 0x37, 0xcd, 0xb3, 0x8c,
-	0xb2, 0x54, 0x26, 0x32, 0xb6, 0xf0, 0x0b, 0x50, 0xdd, 0xc7, 0xe1, 0x31, 0x0c, 0x5d, 0xe4, 0xda,
-	0x04, 0x47, 0xa1, 0x83, 0xf4, 0x29, 0x6e, 0xc5, 0x7c, 0x02, 0xdf, 0xe5, 0xe0, 0xda, 0xcb, 0x12,
-	0x58, 0x19, 0x21, 0x58, 0x44, 0x45, 0x5b, 0x01, 0x80, 0x17, 0x00, 0xc5, 0x87, 0x28, 0xe0, 0xce,
-	0x96, 0xad, 0x12, 0x83, 0x34, 0x19, 0x40, 0xfb, 0x25, 0xd0, 0x62, 0x5b, 0x6d, 0xf4, 0x02, 0x39,
-	0x11, 0xab, 0x5c, 0xee, 0xb3, 0xba, 0xf1, 0x45, 0xd6, 0x27, 0x51, 0x76, 0xcc, 0x95, 0x58, 0xdb,
-	0x76, 0xcc, 0x60, 0x2d, 0x1c, 0xe7, 0x41, 0x5a, 0x03, 0x54, 0x12, 0xc9, 0xb4, 0xd7, 0x41, 0x32,
-	0x50, 0x9f, 0x9e, 0x27, 0xb4, 0xd9, 0xeb, 0x20, 0xab, 0x7c, 0x9c, 0xfa, 0xd2, 0x6e, 0x83, 0xa5,
-	0x4e, 0x88, 0xba, 0x1e, 0x8e, 0x88, 0x4d, 0x28, 0x0c, 0x29, 0x72, 0x6d, 0xd4, 0x45, 0x01, 0x65,
-	0xf9, 0x61, 0x91, 0x99, 0xb4, 0x16, 0x63, 0x82, 0x5d, 0x81, 0xdf, 0x66, 0xe8, 0x86, 0xab, 0xad,
-	0x83, 0xea, 0x00, 0xc7, 0x34, 0xe7, 0x98, 0x23, 0x59, 0x4a, 0x1d, 0xcc, 0x40, 0xca, 0x6c, 0xa3,
-	0x7a, 0x71, 0x4d, 0x59, 0x9f, 0xb6, 0xe2, 0x4f, 0xad, 0x06, 0x2a, 0x01, 0x7a, 0x41, 0xfb, 0x02,
-	0x66, 0xb8, 0x00, 0x95, 0x01, 0x63, 0xee, 0x2f, 0x81, 0xb6, 0x07, 0x9d, 0x43, 0x1f, 0xb7, 0x6c,
-	0x07, 0x47, 0x01, 0xb5, 0x0f, 0xbc, 0x80, 0xea, 0xb3, 0x9c, 0xb0, 0x2a, 0x31, 0x5b, 0x0c, 0xf1,
-	0xd0, 0x0b, 0xa8, 0xf6, 0x35, 0xd0, 0x09, 0xf5, 0x9c, 0xc3, 0x5e, 0x3f, 0xe6, 0x36, 0x0a, 0xe0,
-	0x9e, 0x8f, 0x5c, 0xbd, 0xb4, 0xa6, 0xac, 0xcf, 0x5a, 0x8b, 0x02, 0x9f, 0x84, 0x73, 0x5b, 0x60,
-	0xb5, 0x3b, 0x60, 0x9a, 0xf7, 0xa1, 0x0e, 0x86, 0x45, 0x93, 0xa3, 0xd2, 0xc1, 0x7c, 0xca, 0x00,
-	0x96, 0x60, 0xd1, 0x5a, 0xa9, 0x5c, 0xf3, 0x9a, 0xf0, 0x82, 0x7d, 0xac, 0xab, 0x5c, 0xd0, 0x6d,
-	0x73, 0xd8, 0xb8, 0x93, 0xdd, 0xc9, 0x24, 0x36, 0x43, 0x18, 0x10, 0x0f, 0x05, 0x34, 0x5d, 0x6a,
-	0x8d, 0x60, 0x1f, 0x5b, 0xd5, 0xe3, 0x1c, 0x44, 0x6b, 0x81, 0x95, 0xc1, 0xa2, 0xb2, 0xfb, 0x73,
-	0x48, 0x2f, 0x0f, 0x33, 0x3e, 0x19, 0x44, 0x5c, 0x5d, 0x52, 0xc8, 0xcb, 0x03, 0xa5, 0x95, 0xe0,
-	0x58, 0x2f, 0xef, 0x85, 0x30, 0x70, 0x0e, 0x64, 0x79, 0xcf, 0xf1, 0xf2, 0x56, 0x05, 0x4c, 0x14,
-	0xf8, 0x03, 0x30, 0x47, 0x9c, 0x03, 0xe4, 0x46, 0x3e, 0x72, 0x6d, 0x36, 0x7a, 0xf5, 0x79, 0xae,
-	0x7c, 0xd9, 0x14, 0x73, 0xd9, 0x8c, 0xe7, 0xb2, 0xd9, 0x8c, 0xe7, 0xf2, 0xbd, 0xa9, 0x57, 0xff,
-	0x5c, 0x55, 0xac, 0x4a, 0xc2, 0xc7, 0x30, 0xda, 0x16, 0x28, 0xc7, 0x95, 0xc4, 0xc5, 0x54, 0xc7,
-	0x14, 0xa3, 0x4a, 0x2e, 0x2e, 0xc4, 0x07, 0x33, 0x2c, 0x17, 0x1e, 0x22, 0xfa, 0xc2, 0xda, 0xe4,
-	0xba, 0xba, 0x61, 0x99, 0xe3, 0xad, 0x19, 0xf3, 0xcc, 0x2e, 0x37, 0x9f, 0x0a, 0xa1, 0xdb, 0x01,
-	0x0d, 0x7b, 0x56, 0xac, 0x62, 0xf9, 0x39, 0x28, 0xa7, 0x11, 0x5a, 0x15, 0x4c, 0x1e, 0xa2, 0x9e,
-	0x9c, 0x78, 0xec, 0x27, 0x2b, 0xa7, 0x2e, 0xf4, 0x23, 0x24, 0x3b, 0x7e, 0xcc, 0x72, 0xe2, 0x2c,
-	0x77, 0x0a, 0x5f, 0x2b, 0x3f, 0x99, 0x9a, 0xad, 0x54, 0xe7, 0x92, 0x99, 0xbb, 0xe9, 0x50, 0xaf,
-	0xeb, 0xd1, 0xde, 0x37, 0x6a, 0xe6, 0x8e, 0x32, 0xea, 0xd2, 0x33, 0xf7, 0x1f, 0xb3, 0x62, 0xe6,
-	0x0e, 0x11, 0xfc, 0xa1, 0x67, 0xee, 0x2a, 0x50, 0xa1, 0xb4, 0x8a, 0x85, 0x71, 0x92, 0x3b, 0x00,
-	0x62, 0x50, 0xc3, 0x65, 0x43, 0x39, 0x21, 0xe0, 0x43, 0x79, 0xea, 0xec, 0xa1, 0x9c, 0xf8, 0xc8,
-	0x87, 0x32, 0x4c, 0x7d, 0x69, 0xb7, 0xc0, 0xb4, 0x17, 0x74, 0x22, 0xca, 0xc7, 0xa9, 0xba, 0xb1,
-	0x36, 0x4a, 0xc4, 0x0e, 0xec, 0xf9, 0x18, 0xba, 0xc4, 0x12, 0xe4, 0x43, 0x1a, 0xb2, 0x78, 0xb9,
-	0x86, 0x7c, 0x06, 0x96, 0x62, 0x80, 0x4d, 0xb1, 0xed, 0xf8, 0x98, 0x20, 0x2e, 0x10, 0x47, 0x94,
-	0x8f, 0x68, 0x75, 0x63, 0x69, 0x40, 0xe6, 0x7d, 0x79, 0x9c, 0xdd, 0x9b, 0xfa, 0x03, 0x13, 0xb9,
-	0x18, 0x4b, 0x68, 0xe2, 0x2d, 0xc6, 0xdf, 0x14, 0xec, 0x03, 0xcd, 0x3e, 0x7b, 0x99, 0x66, 0x6f,
-	0x82, 0x45, 0xfe, 0x39, 0x68, 0x5d, 0x69, 0x3c, 0xeb, 0x3e, 0xe2, 0xec, 0x39, 0xd3, 0x1e, 0x81,
-	0x85, 0x03, 0x04, 0x43, 0xba, 0x87, 0x20, 0x4d, 0x04, 0x82, 0xf1, 0x04, 0x56, 0x13, 0xce, 0x58,
-	0x5a, 0x6a, 0xeb, 0xa9, 0xd9, 0xad, 0x87, 0x80, 0xe1, 0x44, 0x61, 0xc8, 0x56, 0x9e, 0x04, 0xd9,
-	0xb9, 0xbc, 0x95, 0xc7, 0x0c, 0xca, 0x35, 0x29, 0x67, 0x53, 0x88, 0xd9, 0xcd, 0x64, 0xf1, 0x71,
-	0xda, 0x1d, 0x17, 0x51, 0xe8, 0xf9, 0x44, 0xaf, 0x8c, 0x59, 0x52, 0x7d, 0x7f, 0xee, 0x0b, 0xce,
-	0xc1, 0xab, 0x63, 0xee, 0xd2, 0x57, 0xc7, 0xf7, 0x52, 0x6d, 0x9a, 0x4c, 0x2a, 0xbe, 0x3d, 0x4a,
-	0xfd, 0xde, 0x7b, 0x12, 0x23, 0xb4, 0x5b, 0xa0, 0x78, 0x80, 0xa0, 0x8b, 0x42, 0xb9, 0x19, 0x8c,
-	0x51, 0x2a, 0x1f, 0x72, 0x2a, 0x4b, 0x52, 0xd7, 0xfe, 0x3a, 0x09, 0x16, 0x37, 0x5d, 0x37, 0x3d,
-	0xdb, 0x2f, 0x30, 0x36, 0x1f, 0x80, 0xd2, 0x7b, 0x8c, 0x90, 0x3e, 0xaf, 0xb6, 0x25, 0x67, 0x96,
-	0x58, 0xd0, 0x93, 0x17, 0x58, 0xd0, 0x7c, 0xb2, 0x89, 0x7d, 0xbc, 0x0a, 0xd4, 0xa4, 0x25, 0x93,
-	0xd3, 0x0c, 0xc4, 0xa0, 0x86, 0x9b, 0xef, 0x59, 0xd9, 0x1e, 0xb2, 0x88, 0xa7, 0x2f, 0xdc, 0xb3,
-	0xfc, 0xd8, 0x8b, 0x4b, 0x79, 0xd8, 0x08, 0x2f, 0x0e, 0x1d, 0xe1, 0xda, 0x8f, 0x41, 0x51, 0x12,
-	0xb0, 0x39, 0x31, 0xb7, 0xb1, 0x3e, 0x74, 0x0b, 0xf3, 0x47, 0x4c, 0xec, 0xab, 0xe0, 0xb4, 0x24,
-	0x5f, 0x6d, 0x09, 0x7c, 0x3c, 0x90, 0x34, 0x31, 0xfd, 0x6b, 0xa7, 0x22, 0xa1, 0xe9, 0xf5, 0xf0,
-	0x21, 0x12, 0x6a, 0x82, 0x8f, 0x84, 0xad, 0x76, 0x46, 0xa5, 0xd8, 0x09, 0x0b, 0x02, 0xf5, 0x24,
-	0xa5, 0x38, 0x5b, 0x00, 0x53, 0x57, 0x52, 0x00, 0xd3, 0x17, 0x2b, 0x80, 0xe2, 0xd5, 0x17, 0xc0,
-	0xcc, 0x79, 0x05, 0x30, 0xfb, 0x5e, 0x05, 0x90, 0x4d, 0xb2, 0x2c, 0x80, 0xdf, 0x15, 0xc0, 0xb7,
-	0xf8, 0xa5, 0x14, 0xe7, 0xe7, 0x02, 0xe9, 0xcf, 0x66, 0xa1, 0x70, 0xb9, 0x2c, 0x3c, 0x03, 0x15,
-	0x7e, 0xba, 0xe5, 0xee, 0xa5, 0xaf, 0xce, 0xbd, 0x97, 0x86, 0x59, 0x6d, 0x95, 0xb9, 0xac, 0x4b,
-	0x1c, 0x4a, 0x7f, 0x51, 0xc0, 0xb7, 0x73, 0x12, 0xe5, 0x81, 0xb4, 0x05, 0xca, 0xb1, 0x81, 0x24,
-	0xf2, 0x29, 0x0f, 0xc4, 0x38, 0xf3, 0x5e, 0x95, 0xa6, 0x30, 0x26, 0xed, 0xa7, 0x60, 0x2e, 0x16,
-	0xf2, 0x6b, 0xe4, 0x50, 0xe4, 0x9e, 0x73, 0xc4, 0x8a, 0xe3, 0x55, 0xd2, 0x5a, 0x95, 0xa3, 0xf4,
-	0x67, 0xed, 0xf7, 0x05, 0xb0, 0x26, 0xcc, 0x73, 0x39, 0x1d, 0x8b, 0xeb, 0x16, 0x6e, 0x77, 0x7c,
-	0xc4, 0x88, 0xff, 0xcf, 0xf9, 0xfb, 0x18, 0xcc, 0x88, 0xf7, 0x59, 0xdc, 0xae, 0x45, 0xf6, 0xd9,
-	0x70, 0xb5, 0x00, 0x2c, 0x38, 0xb1, 0x51, 0x49, 0x72, 0x45, 0xab, 0x6e, 0x9e, 0x9b, 0xdc, 0xf3,
-	0xdc, 0xb3, 0xaa, 0x4e, 0x0e, 0x52, 0xfb, 0x04, 0xdc, 0x38, 0x83, 0x4b, 0x96, 0xfb, 0x7f, 0x15,
-	0x70, 0x7d, 0x0b, 0x06, 0x0e, 0xf2, 0x7f, 0x16, 0x51, 0x42, 0x61, 0xe0, 0x7a, 0x41, 0x6b, 0x27,
-	0x75, 0x5b, 0x8f, 0x11, 0xb6, 0x47, 0x60, 0xbe, 0x1f, 0x36, 0xb1, 0xb8, 0x0b, 0xbc, 0x31, 0x73,
-	0xb1, 0xcb, 0x74, 0x24, 0x0f, 0x16, 0x5f, 0xdc, 0x15, 0x9a, 0xfe, 0xbc, 0x9a, 0x5d, 0x96, 0x79,
-	0x90, 0x4c, 0x65, 0x1f, 0x24, 0xb5, 0x55, 0xb0, 0x32, 0xc2, 0x65, 0x19, 0x94, 0x3f, 0x29, 0x40,
-	0xbf, 0x8f, 0x88, 0x13, 0x7a, 0x7b, 0xe8, 0x32, 0xcf, 0xa1, 0x5f, 0x81, 0xb2, 0x8b, 0x88, 0x93,
-	0x24, 0xb9, 0x90, 0x7f, 0xa5, 0x8f, 0x48, 0xf2, 0x28, 0x9d, 0x96, 0xca, 0xc4, 0xc5, 0x79, 0xfd,
-	0x9b, 0x02, 0x96, 0x86, 0x50, 0xca, 0xee, 0xfc, 0x11, 0x98, 0x11, 0x8e, 0x12, 0x5d, 0xe1, 0x8f,
-	0xd4, 0xef, 0x9c, 0x11, 0xbb, 0x1d, 0x11, 0x92, 0x60, 0x1f, 0x5b, 0x31, 0x97, 0xf6, 0x73, 0xb0,
-	0x90, 0xca, 0x26, 0xa1, 0x90, 0x46, 0x44, 0x7a, 0xf0, 0xdd, 0x71, 0xd2, 0xb0, 0xcb, 0x39, 0xac,
-	0x79, 0x9a, 0x05, 0xd4, 0x5e, 0x2a, 0xc0, 0x78, 0xe4, 0x11, 0x9a, 0x10, 0xee, 0xc0, 0x90, 0x7a,
-	0x6c, 0x33, 0x90, 0x38, 0xb4, 0xd7, 0x41, 0xa9, 0x7f, 0xab, 0x89, 0xb8, 0xf6, 0x01, 0x57, 0xd2,
-	0x9d, 0xb5, 0x3f, 0x16, 0xc0, 0xea, 0x48, 0x2b, 0x64, 0x08, 0x7f, 0x03, 0x8c, 0xfe, 0x3b, 0xab,
-	0x1f, 0x8a, 0x4e, 0x42, 0x29, 0x23, 0xfb, 0xd5, 0x38, 0xca, 0x13, 0xf9, 0x8f, 0x11, 0x85, 0x2e,
-	0xa4, 0xd0, 0xba, 0x06, 0xf3, 0x6f, 0xcf, 0xbe, 0x0d, 0x4c, 0x77, 0xf6, 0x6f, 0x9e, 0x01, 0xdd,
-	0x85, 0xf7, 0xd2, 0x7d, 0x9c, 0xff, 0x17, 0xa2, 0xaf, 0xfb, 0x5e, 0xf8, 0xfa, 0xad, 0x31, 0xf1,
-	0xe6, 0xad, 0x31, 0xf1, 0xee, 0xad, 0xa1, 0xfc, 0xf6, 0xc4, 0x50, 0xfe, 0x7c, 0x62, 0x28, 0x7f,
-	0x3f, 0x31, 0x94, 0xd7, 0x27, 0x86, 0xf2, 0xaf, 0x13, 0x43, 0xf9, 0xf7, 0x89, 0x31, 0xf1, 0xee,
-	0xc4, 0x50, 0x5e, 0x9d, 0x1a, 0x13, 0xaf, 0x4f, 0x8d, 0x89, 0x37, 0xa7, 0xc6, 0xc4, 0xb3, 0x1f,
-	0xb6, 0x70, 0xdf, 0x16, 0x0f, 0x9f, 0xfd, 0x7f, 0xfd, 0x0f, 0x72, 0xa0, 0xbd, 0x22, 0xbf, 0x13,
-	0xbe, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x11, 0x60, 0x20, 0xf0, 0x17, 0x00, 0x00,
+	// 1793 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0xdc, 0xc6,
+	0x15, 0x17, 0x57, 0x7f, 0xf7, 0xed, 0x4a, 0x5a, 0x31, 0xad, 0x42, 0xc9, 0x16, 0x25, 0x6f, 0xd2,
+	0x44, 0x29, 0x52, 0x0a, 0x56, 0x11, 0x23, 0x71, 0x1b, 0xb4, 0xb2, 0x6c, 0x24, 0x6a, 0x1d, 0x57,
+	0xa6, 0x17, 0x6d, 0x61, 0x14, 0x60, 0x46, 0xe4, 0x68, 0xc5, 0x8a, 0xcb, 0x59, 0x73, 0x86, 0xbb,
+	0xd9, 0x9e, 0x0a, 0x14, 0xbd, 0x07, 0xe8, 0xa5, 0x45, 0xbe, 0x40, 0x7b, 0xee, 0x97, 0x68, 0x81,
+	0x1e, 0x7c, 0xcc, 0xad, 0xb5, 0x7c, 0x29, 0xd0, 0x4b, 0xfa, 0x0d, 0x8a, 0xf9, 0x43, 0x2e, 0xc9,
+	0xe5, 0x4a, 0x2b, 0x59, 0x68, 0x72, 0x5b, 0xbe, 0x79, 0xef, 0x37, 0x6f, 0xde, 0x9f, 0xdf, 0x3c,
+	0x72, 0xe1, 0x43, 0x86, 0x3b, 0x5d, 0x12, 0xa1, 0x60, 0x87, 0xe2, 0xa8, 0x87, 0xa3, 0x1d, 0xd4,
+	0xf5, 0x77, 0x3a, 0x88, 0xb9, 0x27, 0x7e, 0xd8, 0xe6, 0x22, 0xdf, 0xc5, 0x3b, 0xbd, 0xdb, 0x3b,
+	0x11, 0x7e, 0x16, 0x63, 0xca, 0x9c, 0x08, 0xd3, 0x2e, 0x09, 0x29, 0xb6, 0xba, 0x11, 0x61, 0x44,
+	0x7f, 0x2b, 0x31, 0xb7, 0xa4, 0xb9, 0x85, 0xba, 0xbe, 0x55, 0x30, 0xb7, 0x7a, 0xb7, 0xd7, 0xcd,
+	0x36, 0x21, 0xed, 0x00, 0xef, 0x08, 0xab, 0xa3, 0xf8, 0x78, 0xc7, 0x8b, 0x23, 0xc4, 0x7c, 0x12,
+	0x4a, 0x9c, 0xf5, 0xcd, 0xe2, 0x3a, 0xf3, 0x3b, 0x98, 0x32, 0xd4, 0xe9, 0x2a, 0x85, 0x5b, 0x1e,
+	0xee, 0xe2, 0xd0, 0xc3, 0xa1, 0xeb, 0x63, 0xba, 0xd3, 0x26, 0x6d, 0x22, 0xe4, 0xe2, 0x97, 0x52,
+	0x79, 0x33, 0x3d, 0x0a, 0x3f, 0x83, 0x4b, 0x3a, 0x1d, 0x12, 0x72, 0xd7, 0x3b, 0x98, 0x52, 0xd4,
+	0x56, 0x1e, 0xaf, 0xbf, 0x95, 0xd3, 0xc2, 0x61, 0xdc, 0xa1, 0x5c, 0x89, 0x21, 0x7a, 0xea, 0x3c,
+	0x8b, 0x71, 0x9c, 0xe8, 0xbd, 0x9d, 0xd3, 0xe3, 0xcb, 0x62, 0x75, 0x14, 0xf0, 0x8d, 0x9c, 0xe2,
+	0xb3, 0x18, 0x47, 0x83, 0x51, 0xa5, 0xb7, 0xcb, 0xc2, 0x9c, 0xdb, 0x5c, 0x29, 0xbe, 0x5b, 0xa6,
+	0x78, 0xe2, 0x53, 0x46, 0xca, 0x60, 0xad, 0x32, 0xed, 0x73, 0x7c, 0xbd, 0x93, 0xf3, 0xb5, 0x4f,
+	0xa2, 0xd3, 0xe3, 0x80, 0xf4, 0x2f, 0x4c, 0x73, 0xf3, 0x3f, 0x1a, 0xdc, 0x3c, 0x24, 0x41, 0xf0,
+	0x0b, 0x65, 0xd1, 0x42, 0xf4, 0xf4, 0x31, 0xdf, 0xc2, 0x96, 0xfa, 0xfa, 0x2d, 0xa8, 0x87, 0xa8,
+	0x83, 0x69, 0x17, 0xb9, 0xd8, 0xf1, 0x3d, 0x43, 0xdb, 0xd2, 0xb6, 0xab, 0x76, 0x2d, 0x95, 0x1d,
+	0x78, 0xfa, 0x0d, 0xa8, 0x76, 0x49, 0x10, 0xe0, 0x88, 0xaf, 0x57, 0xc4, 0xfa, 0x82, 0x14, 0x1c,
+	0x78, 0xfa, 0xa7, 0x50, 0xe7, 0xbf, 0x1d, 0xb5, 0xbf, 0x31, 0xbd, 0xa5, 0x6d, 0xd7, 0x76, 0x3f,
+	0x4c, 0xcf, 0x27, 0xea, 0xaa, 0xe0, 0xaf, 0xd5, 0xbb, 0x6d, 0x9d, 0xe7, 0x94, 0x5d, 0xe3, 0x90,
+	0x89, 0x87, 0xef, 0x40, 0xe3, 0x98, 0x44, 0x7d, 0x14, 0x79, 0xd8, 0x73, 0x28, 0x89, 0x23, 0x17,
+	0x1b, 0x33, 0xc2, 0x8b, 0xe5, 0x54, 0xfe, 0x44, 0x88, 0x9b, 0x7f, 0xaf, 0xc2, 0xc6, 0x18, 0x60,
+	0x19, 0x15, 0x7d, 0x03, 0x40, 0x14, 0x0c, 0x23, 0xa7, 0x38, 0x14, 0x87, 0xad, 0xdb, 0x55, 0x2e,
+	0x69, 0x71, 0x81, 0xfe, 0x4b, 0xd0, 0x13, 0x5f, 0x1d, 0xfc, 0x19, 0x76, 0x63, 0x5e, 0xe9, 0xe2,
+	0xcc, 0xb5, 0xdd, 0x77, 0xf2, 0x67, 0x92, 0x65, 0xca, 0x8f, 0x92, 0xec, 0xf6, 0x20, 0x31, 0xb0,
+	0x57, 0xfa, 0x45, 0x91, 0x7e, 0x00, 0x8b, 0x29, 0x32, 0x1b, 0x74, 0xb1, 0x0a, 0xd4, 0x9b, 0x17,
+	0x81, 0xb6, 0x06, 0x5d, 0x6c, 0xd7, 0xfb, 0x99, 0x27, 0xfd, 0x03, 0x58, 0xeb, 0x46, 0xb8, 0xe7,
+	0x93, 0x98, 0x3a, 0x94, 0xa1, 0x88, 0x61, 0xcf, 0xc1, 0x3d, 0x1c, 0x32, 0x9e, 0x1f, 0x1e, 0x99,
+	0x69, 0x7b, 0x35, 0x51, 0x78, 0x22, 0xd7, 0x1f, 0xf0, 0xe5, 0x03, 0x4f, 0xdf, 0x86, 0xc6, 0x88,
+	0xc5, 0xac, 0xb0, 0x58, 0xa2, 0x79, 0x4d, 0x03, 0xe6, 0x11, 0xe3, 0xbe, 0x31, 0x63, 0x6e, 0x4b,
+	0xdb, 0x9e, 0xb5, 0x93, 0x47, 0xbd, 0x09, 0x8b, 0x21, 0xfe, 0x8c, 0x0d, 0x01, 0xe6, 0x05, 0x40,
+	0x8d, 0x0b, 0x13, 0xeb, 0x77, 0x41, 0x3f, 0x42, 0xee, 0x69, 0x40, 0xda, 0x8e, 0x4b, 0xe2, 0x90,
+	0x39, 0x27, 0x7e, 0xc8, 0x8c, 0x05, 0xa1, 0xd8, 0x50, 0x2b, 0xfb, 0x7c, 0xe1, 0x63, 0x3f, 0x64,
+	0xfa, 0xfb, 0x60, 0x50, 0xe6, 0xbb, 0xa7, 0x83, 0x61, 0xcc, 0x1d, 0x1c, 0xa2, 0xa3, 0x00, 0x7b,
+	0x46, 0x75, 0x4b, 0xdb, 0x5e, 0xb0, 0x57, 0xe5, 0x7a, 0x1a, 0xce, 0x07, 0x72, 0x55, 0xbf, 0x0b,
+	0xb3, 0xa2, 0x6f, 0x0d, 0x28, 0x8b, 0xa6, 0x58, 0xca, 0x06, 0xf3, 0x31, 0x17, 0xd8, 0xd2, 0x44,
+	0x6f, 0x67, 0x72, 0x2d, 0x6a, 0xc2, 0x0f, 0x8f, 0x89, 0x51, 0x13, 0x40, 0x1f, 0x58, 0x65, 0xf4,
+	0xa8, 0xba, 0x99, 0x23, 0xb6, 0x22, 0x14, 0x52, 0x1f, 0x87, 0x2c, 0x5b, 0x6a, 0x07, 0xe1, 0x31,
+	0xb1, 0x1b, 0xfd, 0x82, 0x44, 0x6f, 0xc3, 0xc6, 0x68, 0x51, 0x39, 0x43, 0xde, 0x32, 0xea, 0x65,
+	0xce, 0xa7, 0x64, 0x20, 0xb6, 0x4b, 0x0b, 0x79, 0x7d, 0xa4, 0xb4, 0xd2, 0x35, 0xde, 0xcb, 0x47,
+	0x11, 0x0a, 0xdd, 0x13, 0x55, 0xde, 0x4b, 0xa2, 0xbc, 0x6b, 0x52, 0x26, 0x0b, 0xfc, 0x23, 0x58,
+	0xa2, 0xee, 0x09, 0xf6, 0xe2, 0x00, 0x7b, 0x0e, 0xa7, 0x6a, 0x63, 0x59, 0x6c, 0xbe, 0x6e, 0x49,
+	0x1e, 0xb7, 0x12, 0x1e, 0xb7, 0x5a, 0x09, 0x8f, 0xdf, 0x9b, 0xf9, 0xfc, 0x9f, 0x9b, 0x9a, 0xbd,
+	0x98, 0xda, 0xf1, 0x15, 0x7d, 0x1f, 0xea, 0x49, 0x25, 0x09, 0x98, 0xc6, 0x84, 0x30, 0x35, 0x65,
+	0x25, 0x40, 0x02, 0x98, 0xe7, 0xb9, 0xf0, 0x31, 0x35, 0x56, 0xb6, 0xa6, 0xb7, 0x6b, 0xbb, 0xb6,
+	0x35, 0xd9, 0xb5, 0x64, 0x9d, 0xdb, 0xe5, 0xd6, 0x63, 0x09, 0xfa, 0x20, 0x64, 0xd1, 0xc0, 0x4e,
+	0xb6, 0xd0, 0xef, 0xc3, 0x26, 0x8d, 0xdb, 0x6d, 0xce, 0x92, 0x2e, 0x09, 0x99, 0x1f, 0xc6, 0xd8,
+	0x41, 0xd4, 0x09, 0x71, 0xdf, 0x89, 0x30, 0xa2, 0x24, 0xa4, 0x86, 0xbe, 0x35, 0xbd, 0x5d, 0xb5,
+	0x6f, 0x28, 0xb5, 0x7d, 0xa5, 0xb5, 0x47, 0x1f, 0xe1, 0xbe, 0x2d, 0x55, 0xd6, 0x3f, 0x85, 0x7a,
+	0x16, 0x5e, 0x6f, 0xc0, 0xf4, 0x29, 0x1e, 0x28, 0xde, 0xe4, 0x3f, 0x79, 0x51, 0xf6, 0x50, 0x10,
+	0x63, 0xa3, 0x52, 0x96, 0xd7, 0x71, 0x45, 0x29, 0x4c, 0xee, 0x56, 0xde, 0xd7, 0x7e, 0x32, 0xb3,
+	0xb0, 0xd8, 0x58, 0x4a, 0x99, 0x7b, 0xcf, 0x65, 0x7e, 0xcf, 0x67, 0x83, 0x6f, 0x14, 0x73, 0x8f,
+	0x73, 0xea, 0xca, 0xcc, 0xfd, 0x8f, 0x05, 0xd8, 0x18, 0x03, 0xfc, 0x75, 0x33, 0xf7, 0x26, 0xd4,
+	0x90, 0xf2, 0x8a, 0x87, 0x71, 0x5a, 0x1c, 0x00, 0x12, 0xd1, 0x81, 0xc7, 0xa9, 0x3d, 0x55, 0x10,
+	0xd4, 0x3e, 0x73, 0x3e, 0xb5, 0xa7, 0x67, 0x14, 0xd4, 0x8e, 0x32, 0x4f, 0xfa, 0x1d, 0x98, 0xf5,
+	0xc3, 0x6e, 0xcc, 0x04, 0x29, 0xd7, 0x76, 0xb7, 0xc6, 0x41, 0x1c, 0xa2, 0x41, 0x40, 0x90, 0x47,
+	0x6d, 0xa9, 0x5e, 0xd2, 0xd6, 0x73, 0x57, 0x6b, 0xeb, 0xa7, 0xb0, 0x96, 0x08, 0x1c, 0x46, 0x1c,
+	0x37, 0x20, 0x14, 0x0b, 0x40, 0x12, 0x33, 0x41, 0xf4, 0xb5, 0xdd, 0xb5, 0x11, 0xcc, 0xfb, 0x6a,
+	0x24, 0xbc, 0x37, 0xf3, 0x47, 0x0e, 0xb9, 0x9a, 0x20, 0xb4, 0xc8, 0x3e, 0xb7, 0x6f, 0x49, 0xf3,
+	0x11, 0xca, 0x58, 0xb8, 0x0a, 0x65, 0xb4, 0x60, 0x55, 0x3c, 0x8e, 0x7a, 0x57, 0x9d, 0xcc, 0xbb,
+	0xd7, 0x84, 0x79, 0xc1, 0xb5, 0x87, 0xb0, 0x72, 0x82, 0x51, 0xc4, 0x8e, 0x30, 0x62, 0x29, 0x20,
+	0x4c, 0x06, 0xd8, 0x48, 0x2d, 0x13, 0xb4, 0xcc, 0xdd, 0x59, 0xcb, 0xdf, 0x9d, 0x18, 0x4c, 0x37,
+	0x8e, 0x22, 0x7e, 0x71, 0x2a, 0x91, 0x53, 0xc8, 0x5b, 0x7d, 0xc2, 0xa0, 0xdc, 0x50, 0x38, 0x7b,
+	0x12, 0xe6, 0x49, 0x2e, 0x8b, 0x9f, 0x64, 0x8f, 0xe3, 0x61, 0x86, 0xfc, 0x80, 0x1a, 0x8b, 0x13,
+	0x96, 0xd4, 0xf0, 0x3c, 0xf7, 0xa5, 0xe5, 0xe8, 0xec, 0xb2, 0x74, 0xe5, 0xd9, 0xe5, 0x7b, 0x99,
+	0x36, 0x4d, 0x99, 0x4a, 0xdc, 0x41, 0xd5, 0x61, 0xef, 0x3d, 0x4a, 0x16, 0xf4, 0x3b, 0x30, 0x77,
+	0x82, 0x91, 0x87, 0x23, 0x75, 0xbf, 0x98, 0xe3, 0xb6, 0xfc, 0x58, 0x68, 0xd9, 0x4a, 0xbb, 0xf9,
+	0xd7, 0x69, 0x58, 0xdd, 0xf3, 0xbc, 0xec, 0x0d, 0x71, 0x09, 0xda, 0xfc, 0x08, 0xaa, 0xaf, 0x40,
+	0x21, 0x43, 0x5b, 0x7d, 0x5f, 0x71, 0x96, 0xbc, 0xe6, 0xa7, 0x2f, 0x71, 0xcd, 0x57, 0x59, 0xf2,
+	0x93, 0xf3, 0x4f, 0xda, 0x92, 0xe9, 0x80, 0x07, 0x89, 0xe8, 0xc0, 0x2b, 0xf6, 0xac, 0x6a, 0x0f,
+	0x55, 0xc4, 0xb3, 0x97, 0xee, 0x59, 0x31, 0x32, 0x26, 0xa5, 0x5c, 0x46, 0xe1, 0x73, 0xa5, 0x14,
+	0xae, 0xff, 0x18, 0xe6, 0x94, 0x02, 0xe7, 0x89, 0xa5, 0xdd, 0xed, 0xd2, 0xbb, 0x5c, 0xbc, 0x3a,
+	0x25, 0x67, 0x95, 0x96, 0xb6, 0xb2, 0x6b, 0xae, 0xc1, 0xeb, 0x23, 0x49, 0x93, 0xec, 0xdf, 0x7c,
+	0x29, 0x13, 0x9a, 0xbd, 0x1e, 0xbe, 0x8e, 0x84, 0x5a, 0xf0, 0x9a, 0xf4, 0xd5, 0xc9, 0x6d, 0x29,
+	0xef, 0x84, 0x15, 0xb9, 0xf4, 0x28, 0xb3, 0x71, 0xbe, 0x00, 0x66, 0xae, 0xa5, 0x00, 0x66, 0x2f,
+	0x57, 0x00, 0x73, 0xd7, 0x5f, 0x00, 0xf3, 0x17, 0x15, 0xc0, 0xc2, 0x2b, 0x15, 0x40, 0x3e, 0xc9,
+	0xaa, 0x00, 0x7e, 0x5f, 0x81, 0x6f, 0x89, 0x49, 0x29, 0xc9, 0xcf, 0x25, 0xd2, 0x9f, 0xcf, 0x42,
+	0xe5, 0x6a, 0x59, 0x78, 0x0a, 0x8b, 0x62, 0x74, 0x2b, 0xcc, 0x4b, 0xef, 0x5d, 0x38, 0x2f, 0x95,
+	0x79, 0x6d, 0xd7, 0x05, 0xd6, 0x15, 0x06, 0xa5, 0xbf, 0x68, 0xf0, 0xed, 0x02, 0xa2, 0x1a, 0x90,
+	0xf6, 0xa1, 0x9e, 0x38, 0x48, 0xe3, 0x80, 0x19, 0xda, 0x84, 0x7c, 0x5f, 0x53, 0xae, 0x70, 0x23,
+	0xfd, 0xa7, 0xb0, 0x94, 0x80, 0xfc, 0x1a, 0xbb, 0x0c, 0x7b, 0x17, 0x0c, 0xb1, 0x72, 0x78, 0x55,
+	0xba, 0xf6, 0xe2, 0xb3, 0xec, 0x63, 0xf3, 0x0f, 0x15, 0xd8, 0x92, 0xee, 0x79, 0x42, 0x8f, 0xc7,
+	0x75, 0x9f, 0x74, 0xba, 0x01, 0xe6, 0xca, 0xff, 0xe7, 0xfc, 0xbd, 0x0e, 0xf3, 0x02, 0x24, 0x6d,
+	0xd7, 0x39, 0xfe, 0x78, 0xe0, 0xe9, 0x21, 0xac, 0xb8, 0x89, 0x53, 0x69, 0x72, 0x65, 0xab, 0xee,
+	0x5d, 0x98, 0xdc, 0x8b, 0x8e, 0x67, 0x37, 0xdc, 0x82, 0xa4, 0xf9, 0x06, 0xdc, 0x3a, 0xc7, 0x4a,
+	0x95, 0xfb, 0x7f, 0x35, 0xb8, 0xb9, 0x8f, 0x42, 0x17, 0x07, 0x3f, 0x8b, 0x19, 0x65, 0x28, 0xf4,
+	0xfc, 0xb0, 0x7d, 0x98, 0x99, 0xad, 0x27, 0x08, 0xdb, 0x43, 0x58, 0x1e, 0x86, 0x4d, 0x5e, 0xdc,
+	0x15, 0xd1, 0x98, 0x85, 0xd8, 0xe5, 0x3a, 0x52, 0x04, 0x4b, 0x5c, 0xdc, 0x8b, 0x2c, 0xfb, 0x78,
+	0x3d, 0x77, 0x59, 0xee, 0x85, 0x64, 0x26, 0xff, 0x42, 0xd2, 0xdc, 0x84, 0x8d, 0x31, 0x47, 0x56,
+	0x41, 0xf9, 0x42, 0x03, 0xe3, 0x3e, 0xa6, 0x6e, 0xe4, 0x1f, 0xe1, 0xab, 0xbc, 0x0e, 0xfd, 0x0a,
+	0xea, 0x1e, 0xa6, 0x6e, 0x9a, 0xe4, 0x4a, 0xf1, 0x5d, 0x7f, 0x4c, 0x92, 0xc7, 0xed, 0x69, 0xd7,
+	0x38, 0x5c, 0x92, 0xd7, 0x2f, 0x2a, 0xb0, 0x56, 0xa2, 0xa9, 0xba, 0xf3, 0x47, 0x30, 0x2f, 0x0f,
+	0x4a, 0x0d, 0x4d, 0xbc, 0xea, 0x7e, 0xe7, 0x9c, 0xd8, 0x1d, 0xca, 0x90, 0xf0, 0xcf, 0x09, 0x89,
+	0x95, 0xfe, 0x73, 0x58, 0xc9, 0x64, 0x93, 0x32, 0xc4, 0x62, 0xaa, 0x4e, 0xf0, 0xdd, 0x49, 0xd2,
+	0xf0, 0x44, 0x58, 0xd8, 0xcb, 0x2c, 0x2f, 0xd0, 0x31, 0x34, 0x50, 0xcc, 0x08, 0x75, 0x51, 0xe0,
+	0x87, 0x6d, 0xf9, 0xa1, 0x46, 0x66, 0xf7, 0x6e, 0x29, 0x7f, 0x97, 0xa3, 0xef, 0x0d, 0x21, 0xf8,
+	0x27, 0x1d, 0x7b, 0x19, 0xe5, 0x05, 0xcd, 0xdf, 0x69, 0x60, 0x3e, 0xf4, 0x29, 0x4b, 0x2d, 0x0e,
+	0x51, 0xc4, 0x7c, 0x7e, 0x01, 0xd1, 0x24, 0x83, 0x37, 0xa1, 0x3a, 0x1c, 0x09, 0x65, 0xfa, 0x86,
+	0x82, 0x6b, 0x21, 0x81, 0xe6, 0x9f, 0x2a, 0xb0, 0x39, 0xd6, 0x0b, 0x95, 0xa9, 0xdf, 0x80, 0x39,
+	0x7c, 0x9d, 0x1b, 0x46, 0xbc, 0x9b, 0x6a, 0xaa, 0x04, 0xbe, 0x37, 0xc9, 0xe6, 0x29, 0xfe, 0x27,
+	0x98, 0x21, 0x0f, 0x31, 0x64, 0xdf, 0x40, 0xc5, 0x57, 0xdc, 0xa1, 0x0f, 0x7c, 0xef, 0xfc, 0x37,
+	0xa9, 0x91, 0xbd, 0x2b, 0xaf, 0xb4, 0x77, 0xbf, 0xf8, 0xc9, 0x64, 0xb8, 0xf7, 0xbd, 0xe8, 0xf9,
+	0x0b, 0x73, 0xea, 0xcb, 0x17, 0xe6, 0xd4, 0x57, 0x2f, 0x4c, 0xed, 0xb7, 0x67, 0xa6, 0xf6, 0xe7,
+	0x33, 0x53, 0xfb, 0xdb, 0x99, 0xa9, 0x3d, 0x3f, 0x33, 0xb5, 0x7f, 0x9d, 0x99, 0xda, 0xbf, 0xcf,
+	0xcc, 0xa9, 0xaf, 0xce, 0x4c, 0xed, 0xf3, 0x97, 0xe6, 0xd4, 0xf3, 0x97, 0xe6, 0xd4, 0x97, 0x2f,
+	0xcd, 0xa9, 0xa7, 0x3f, 0x6c, 0x93, 0xa1, 0x2f, 0x3e, 0x39, 0xff, 0xcf, 0x88, 0x1f, 0x14, 0x44,
+	0x47, 0x73, 0x62, 0x1c, 0xf9, 0xfe, 0xff, 0x06, 0x00, 0x5a, 0xfd, 0x88, 0x57, 0xcd, 0x18, 0x00,
+	0x00,
 }
 
 func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
@@ -1567,6 +1592,14 @@ func (this *PollWorkflowTaskQueueResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.SuggestContinueAsNewReasons) != len(that1.SuggestContinueAsNewReasons) {
+		return false
+	}
+	for i := range this.SuggestContinueAsNewReasons {
+		if this.SuggestContinueAsNewReasons[i] != that1.SuggestContinueAsNewReasons[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *PollActivityTaskQueueRequest) Equal(that interface{}) bool {
@@ -2067,6 +2100,9 @@ func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
 	if !this.TaskQueueStatus.Equal(that1.TaskQueueStatus) {
 		return false
 	}
+	if !this.AutoscalingHint.Equal(that1.AutoscalingHint) {
+		return false
+	}
 	return true
 }
 func (this *ListTaskQueuePartitionsRequest) Equal(that interface{}) bool {
@@ -2152,7 +2188,7 @@ func (this *PollWorkflowTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 20)
+	s := make([]string, 0, 21)
 	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
 	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
 	if this.WorkflowExecution != nil {
@@ -2192,6 +2228,7 @@ func (this *PollWorkflowTaskQueueResponse) GoString() string {
 	if this.Queries != nil {
 		s = append(s, "Queries: "+mapStringForQueries+",\n")
 	}
+	s = append(s, "SuggestContinueAsNewReasons: "+fmt.Sprintf("%#v", this.SuggestContinueAsNewReasons)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2405,7 +2442,7 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
 	if this.Pollers != nil {
 		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
@@ -2413,6 +2450,9 @@ func (this *DescribeTaskQueueResponse) GoString() string {
 	if this.TaskQueueStatus != nil {
 		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
 	}
+	if this.AutoscalingHint != nil {
+		s = append(s, "AutoscalingHint: "+fmt.Sprintf("%#v", this.AutoscalingHint)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2528,6 +2568,17 @@ func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.SuggestContinueAsNewReasons) > 0 {
+		for iNdEx := len(m.SuggestContinueAsNewReasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SuggestContinueAsNewReasons[iNdEx])
+			copy(dAtA[i:], m.SuggestContinueAsNewReasons[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SuggestContinueAsNewReasons[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if len(m.Queries) > 0 {
 		for k := range m.Queries {
 			v := m.Queries[k]
@@ -3475,6 +3526,18 @@ func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.AutoscalingHint != nil {
+		{
+			size, err := m.AutoscalingHint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TaskQueueStatus != nil {
 		{
 			size, err := m.TaskQueueStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -3706,6 +3769,12 @@ func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
 			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
 		}
 	}
+	if len(m.SuggestContinueAsNewReasons) > 0 {
+		for _, s := range m.SuggestContinueAsNewReasons {
+			l = len(s)
+			n += 2 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4040,6 +4109,10 @@ func (m *DescribeTaskQueueResponse) Size() (n int) {
 		l = m.TaskQueueStatus.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.AutoscalingHint != nil {
+		l = m.AutoscalingHint.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -4131,6 +4204,7 @@ func (this *PollWorkflowTaskQueueResponse) String() string {
 		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Queries:` + mapStringForQueries + `,`,
+		`SuggestContinueAsNewReasons:` + fmt.Sprintf("%v", this.SuggestContinueAsNewReasons) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4315,6 +4389,7 @@ func (this *DescribeTaskQueueResponse) String() string {
 	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
 		`Pollers:` + repeatedStringForPollers + `,`,
 		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
+		`AutoscalingHint:` + strings.Replace(fmt.Sprintf("%v", this.AutoscalingHint), "TaskQueueAutoscalingHint", "v17.TaskQueueAutoscalingHint", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5137,6 +5212,38 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNewReasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuggestContinueAsNewReasons = append(m.SuggestContinueAsNewReasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7613,6 +7720,42 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoscalingHint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoscalingHint == nil {
+				m.AutoscalingHint = &v17.TaskQueueAutoscalingHint{}
+			}
+			if err := m.AutoscalingHint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
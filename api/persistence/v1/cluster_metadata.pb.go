@@ -34,8 +34,12 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/version/v1"
 )
 
@@ -43,6 +47,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -52,10 +57,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // data column
 type ClusterMetadata struct {
-	ClusterName       string          `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	HistoryShardCount int32           `protobuf:"varint,2,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
-	ClusterId         string          `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	VersionInfo       *v1.VersionInfo `protobuf:"bytes,4,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
+	ClusterName        string              `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	HistoryShardCount  int32               `protobuf:"varint,2,opt,name=history_shard_count,json=historyShardCount,proto3" json:"history_shard_count,omitempty"`
+	ClusterId          string              `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	VersionInfo        *v1.VersionInfo     `protobuf:"bytes,4,opt,name=version_info,json=versionInfo,proto3" json:"version_info,omitempty"`
+	ShardRebalancePlan *ShardRebalancePlan `protobuf:"bytes,5,opt,name=shard_rebalance_plan,json=shardRebalancePlan,proto3" json:"shard_rebalance_plan,omitempty"`
+	TlsRotation        *TLSRotation        `protobuf:"bytes,6,opt,name=tls_rotation,json=tlsRotation,proto3" json:"tls_rotation,omitempty"`
 }
 
 func (m *ClusterMetadata) Reset()      { *m = ClusterMetadata{} }
@@ -118,8 +125,294 @@ func (m *ClusterMetadata) GetVersionInfo() *v1.VersionInfo {
 	return nil
 }
 
+func (m *ClusterMetadata) GetShardRebalancePlan() *ShardRebalancePlan {
+	if m != nil {
+		return m.ShardRebalancePlan
+	}
+	return nil
+}
+
+func (m *ClusterMetadata) GetTlsRotation() *TLSRotation {
+	if m != nil {
+		return m.TlsRotation
+	}
+	return nil
+}
+
+// ShardRebalancePlan moves history shards away from the owners decided by hash ring.
+// Moves are applied in order, moves_per_minute every minute since start_time. Once cancel_time
+// is set, applied moves are undone in reverse order at the same rate.
+type ShardRebalancePlan struct {
+	StartTime      *time.Time   `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	MovesPerMinute int32        `protobuf:"varint,2,opt,name=moves_per_minute,json=movesPerMinute,proto3" json:"moves_per_minute,omitempty"`
+	Moves          []*ShardMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	CancelTime     *time.Time   `protobuf:"bytes,4,opt,name=cancel_time,json=cancelTime,proto3,stdtime" json:"cancel_time,omitempty"`
+}
+
+func (m *ShardRebalancePlan) Reset()      { *m = ShardRebalancePlan{} }
+func (*ShardRebalancePlan) ProtoMessage() {}
+func (*ShardRebalancePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{1}
+}
+func (m *ShardRebalancePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardRebalancePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardRebalancePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardRebalancePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardRebalancePlan.Merge(m, src)
+}
+func (m *ShardRebalancePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardRebalancePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardRebalancePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardRebalancePlan proto.InternalMessageInfo
+
+func (m *ShardRebalancePlan) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ShardRebalancePlan) GetMovesPerMinute() int32 {
+	if m != nil {
+		return m.MovesPerMinute
+	}
+	return 0
+}
+
+func (m *ShardRebalancePlan) GetMoves() []*ShardMove {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+func (m *ShardRebalancePlan) GetCancelTime() *time.Time {
+	if m != nil {
+		return m.CancelTime
+	}
+	return nil
+}
+
+type ShardMove struct {
+	ShardId     int32  `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	HostAddress string `protobuf:"bytes,2,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+}
+
+func (m *ShardMove) Reset()      { *m = ShardMove{} }
+func (*ShardMove) ProtoMessage() {}
+func (*ShardMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{2}
+}
+func (m *ShardMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardMove.Merge(m, src)
+}
+func (m *ShardMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardMove proto.InternalMessageInfo
+
+func (m *ShardMove) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *ShardMove) GetHostAddress() string {
+	if m != nil {
+		return m.HostAddress
+	}
+	return ""
+}
+
+// TLSRotation asks every host to reload the certificate of a TLS group from the files or the secret provider
+// it is configured with. Hosts swap in the new certificate only after handshakes with peers succeed with both
+// the current and the new certificate, and report the outcome in hosts.
+type TLSRotation struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// Incremented by every rotation, hosts rotate once per generation.
+	Generation int64                    `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	StartTime  *time.Time               `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	Hosts      []*TLSRotationHostStatus `protobuf:"bytes,4,rep,name=hosts,proto3" json:"hosts,omitempty"`
+}
+
+func (m *TLSRotation) Reset()      { *m = TLSRotation{} }
+func (*TLSRotation) ProtoMessage() {}
+func (*TLSRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{3}
+}
+func (m *TLSRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSRotation.Merge(m, src)
+}
+func (m *TLSRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSRotation proto.InternalMessageInfo
+
+func (m *TLSRotation) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *TLSRotation) GetGeneration() int64 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
+func (m *TLSRotation) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *TLSRotation) GetHosts() []*TLSRotationHostStatus {
+	if m != nil {
+		return m.Hosts
+	}
+	return nil
+}
+
+type TLSRotationHostStatus struct {
+	Service     string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	HostAddress string `protobuf:"bytes,2,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	Rotated     bool   `protobuf:"varint,3,opt,name=rotated,proto3" json:"rotated,omitempty"`
+	// Error the rotation failed with, the host keeps the current certificate.
+	Failure    string     `protobuf:"bytes,4,opt,name=failure,proto3" json:"failure,omitempty"`
+	UpdateTime *time.Time `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time,omitempty"`
+}
+
+func (m *TLSRotationHostStatus) Reset()      { *m = TLSRotationHostStatus{} }
+func (*TLSRotationHostStatus) ProtoMessage() {}
+func (*TLSRotationHostStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f4771d63f405884, []int{4}
+}
+func (m *TLSRotationHostStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSRotationHostStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TLSRotationHostStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TLSRotationHostStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSRotationHostStatus.Merge(m, src)
+}
+func (m *TLSRotationHostStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSRotationHostStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSRotationHostStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSRotationHostStatus proto.InternalMessageInfo
+
+func (m *TLSRotationHostStatus) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *TLSRotationHostStatus) GetHostAddress() string {
+	if m != nil {
+		return m.HostAddress
+	}
+	return ""
+}
+
+func (m *TLSRotationHostStatus) GetRotated() bool {
+	if m != nil {
+		return m.Rotated
+	}
+	return false
+}
+
+func (m *TLSRotationHostStatus) GetFailure() string {
+	if m != nil {
+		return m.Failure
+	}
+	return ""
+}
+
+func (m *TLSRotationHostStatus) GetUpdateTime() *time.Time {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterMetadata)(nil), "temporal.server.api.persistence.v1.ClusterMetadata")
+	proto.RegisterType((*ShardRebalancePlan)(nil), "temporal.server.api.persistence.v1.ShardRebalancePlan")
+	proto.RegisterType((*ShardMove)(nil), "temporal.server.api.persistence.v1.ShardMove")
+	proto.RegisterType((*TLSRotation)(nil), "temporal.server.api.persistence.v1.TLSRotation")
+	proto.RegisterType((*TLSRotationHostStatus)(nil), "temporal.server.api.persistence.v1.TLSRotationHostStatus")
 }
 
 func init() {
@@ -127,28 +420,51 @@ func init() {
 }
 
 var fileDescriptor_1f4771d63f405884 = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0xe3, 0xef, 0x03, 0xa4, 0xba, 0x95, 0x10, 0x61, 0x89, 0x90, 0xb8, 0x2a, 0x15, 0x48,
-	0x9d, 0x1c, 0x15, 0x58, 0x10, 0x1b, 0x1d, 0x50, 0x07, 0x18, 0x8a, 0xc4, 0xc0, 0x12, 0x99, 0xe6,
-	0xb6, 0x35, 0x6a, 0xe2, 0xc8, 0x76, 0x23, 0xb1, 0xf1, 0x08, 0x3c, 0x06, 0x8f, 0xc2, 0xc0, 0xd0,
-	0xb1, 0x23, 0x75, 0x17, 0xc6, 0x3e, 0x02, 0x4a, 0xe3, 0xfe, 0x59, 0xd8, 0x7c, 0xef, 0xf1, 0xf9,
-	0x1d, 0x5f, 0x5f, 0x7a, 0x65, 0x30, 0xc9, 0xa4, 0xe2, 0xa3, 0x50, 0xa3, 0xca, 0x51, 0x85, 0x3c,
-	0x13, 0x61, 0x86, 0x4a, 0x0b, 0x6d, 0x30, 0xed, 0x61, 0x98, 0xb7, 0xc2, 0xde, 0x68, 0xac, 0x0d,
-	0xaa, 0x28, 0x41, 0xc3, 0x63, 0x6e, 0x38, 0xcb, 0x94, 0x34, 0xd2, 0x6f, 0xac, 0xac, 0xac, 0xb4,
-	0x32, 0x9e, 0x09, 0xb6, 0x65, 0x65, 0x79, 0xeb, 0xe8, 0x6c, 0x8d, 0x2f, 0xb8, 0x79, 0x21, 0xca,
-	0xb4, 0x60, 0x26, 0xa8, 0x35, 0x1f, 0x60, 0x89, 0x6a, 0x7c, 0x11, 0xba, 0xdf, 0x2e, 0x53, 0xee,
-	0x5c, 0x88, 0x7f, 0x42, 0x6b, 0xab, 0xe0, 0x94, 0x27, 0x18, 0x90, 0x3a, 0x69, 0x56, 0xba, 0x55,
-	0xd7, 0xbb, 0xe7, 0x09, 0xfa, 0x8c, 0x1e, 0x0e, 0x85, 0x36, 0x52, 0xbd, 0x46, 0x7a, 0xc8, 0x55,
-	0x1c, 0xf5, 0xe4, 0x38, 0x35, 0xc1, 0xbf, 0x3a, 0x69, 0xee, 0x76, 0x0f, 0x9c, 0xf4, 0x50, 0x28,
-	0xed, 0x42, 0xf0, 0x8f, 0x29, 0x5d, 0x21, 0x45, 0x1c, 0xfc, 0x5f, 0x02, 0x2b, 0xae, 0xd3, 0x89,
-	0xfd, 0x5b, 0x5a, 0x73, 0x2f, 0x8c, 0x44, 0xda, 0x97, 0xc1, 0x4e, 0x9d, 0x34, 0xab, 0xe7, 0xa7,
-	0x6c, 0x3d, 0x67, 0x31, 0xa0, 0xbb, 0xc1, 0xf2, 0x16, 0x7b, 0x2c, 0x8f, 0x9d, 0xb4, 0x2f, 0xbb,
-	0xd5, 0x7c, 0x53, 0xdc, 0xbc, 0x4c, 0x66, 0xe0, 0x4d, 0x67, 0xe0, 0x2d, 0x66, 0x40, 0xde, 0x2c,
-	0x90, 0x0f, 0x0b, 0xe4, 0xd3, 0x02, 0x99, 0x58, 0x20, 0xdf, 0x16, 0xc8, 0x8f, 0x05, 0x6f, 0x61,
-	0x81, 0xbc, 0xcf, 0xc1, 0x9b, 0xcc, 0xc1, 0x9b, 0xce, 0xc1, 0x7b, 0xba, 0x1c, 0xc8, 0x4d, 0x94,
-	0x90, 0x7f, 0x2f, 0xe4, 0x7a, 0xab, 0x7c, 0xde, 0x5b, 0xfe, 0xe0, 0xc5, 0x6f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xeb, 0xca, 0x77, 0xc3, 0xc9, 0x01, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xde, 0x74, 0x77, 0xdb, 0xee, 0xa4, 0xf8, 0x63, 0xac, 0x10, 0x0b, 0xa6, 0xdb, 0x45, 0x61,
+	0x2f, 0x26, 0xb4, 0x8a, 0x50, 0x3c, 0x48, 0xdb, 0x83, 0x2e, 0x58, 0x2d, 0xd3, 0xe2, 0xc1, 0x4b,
+	0x98, 0x6e, 0xde, 0x66, 0x23, 0x49, 0x26, 0xcc, 0x4c, 0x02, 0xde, 0xbc, 0x09, 0x9e, 0xfa, 0x67,
+	0xf8, 0xa7, 0x08, 0x5e, 0x7a, 0x11, 0x7a, 0xd3, 0x6e, 0x2f, 0x1e, 0xfb, 0x27, 0xc8, 0xcc, 0x24,
+	0xed, 0x62, 0x15, 0xb7, 0xb7, 0xbc, 0x6f, 0xe6, 0x7b, 0xef, 0xcb, 0xf7, 0xbd, 0x04, 0x6d, 0x4a,
+	0x48, 0x73, 0xc6, 0x69, 0xe2, 0x0b, 0xe0, 0x25, 0x70, 0x9f, 0xe6, 0xb1, 0x9f, 0x03, 0x17, 0xb1,
+	0x90, 0x90, 0x0d, 0xc1, 0x2f, 0xd7, 0xfd, 0x61, 0x52, 0x08, 0x09, 0x3c, 0x48, 0x41, 0xd2, 0x90,
+	0x4a, 0xea, 0xe5, 0x9c, 0x49, 0x86, 0x7b, 0x35, 0xd5, 0x33, 0x54, 0x8f, 0xe6, 0xb1, 0x37, 0x45,
+	0xf5, 0xca, 0xf5, 0x95, 0xd5, 0x88, 0xb1, 0x28, 0x01, 0x5f, 0x33, 0x0e, 0x8b, 0x91, 0x2f, 0xe3,
+	0x14, 0x84, 0xa4, 0x69, 0x6e, 0x9a, 0xac, 0xac, 0x85, 0x90, 0x43, 0x16, 0x42, 0x36, 0x8c, 0x41,
+	0xf8, 0x11, 0x8b, 0x98, 0xc6, 0xf5, 0x53, 0x75, 0xe5, 0xe1, 0x85, 0x44, 0xa5, 0xad, 0x54, 0x03,
+	0x58, 0xa6, 0x74, 0xa5, 0x20, 0x04, 0x8d, 0xc0, 0x5c, 0xeb, 0x7d, 0x6e, 0xa2, 0x9b, 0x3b, 0x46,
+	0xe9, 0x6e, 0x25, 0x14, 0xaf, 0xa1, 0xa5, 0x5a, 0x7c, 0x46, 0x53, 0x70, 0xac, 0xae, 0xd5, 0xef,
+	0x10, 0xbb, 0xc2, 0x5e, 0xd3, 0x14, 0xb0, 0x87, 0xee, 0x8c, 0x63, 0x21, 0x19, 0xff, 0x10, 0x88,
+	0x31, 0xe5, 0x61, 0x30, 0x64, 0x45, 0x26, 0x9d, 0xb9, 0xae, 0xd5, 0x6f, 0x93, 0xdb, 0xd5, 0xd1,
+	0xbe, 0x3a, 0xd9, 0x51, 0x07, 0xf8, 0x3e, 0x42, 0x75, 0xcb, 0x38, 0x74, 0x9a, 0xba, 0x61, 0xa7,
+	0x42, 0x06, 0x21, 0x7e, 0x81, 0x96, 0x2a, 0x85, 0x41, 0x9c, 0x8d, 0x98, 0xd3, 0xea, 0x5a, 0x7d,
+	0x7b, 0xe3, 0x81, 0x77, 0xe1, 0x95, 0x32, 0xa9, 0xba, 0xe1, 0x95, 0xeb, 0xde, 0x5b, 0xf3, 0x38,
+	0xc8, 0x46, 0x8c, 0xd8, 0xe5, 0x65, 0x81, 0xc7, 0x68, 0xd9, 0xe8, 0xe1, 0x70, 0x48, 0x13, 0x9a,
+	0x0d, 0x21, 0xc8, 0x13, 0x9a, 0x39, 0x6d, 0xdd, 0xf0, 0xa9, 0xf7, 0x7f, 0xf3, 0x3d, 0xad, 0x9a,
+	0xd4, 0xf4, 0xbd, 0x84, 0x66, 0x04, 0x8b, 0x2b, 0x18, 0x26, 0x68, 0x49, 0x26, 0x22, 0xe0, 0x4c,
+	0x52, 0x19, 0xb3, 0xcc, 0x99, 0xd7, 0x13, 0xfc, 0x59, 0x26, 0x1c, 0xbc, 0xda, 0x27, 0x15, 0x8d,
+	0xd8, 0x32, 0x11, 0x75, 0xd1, 0xfb, 0x34, 0x87, 0xf0, 0xd5, 0xf1, 0xf8, 0x39, 0x42, 0x42, 0x52,
+	0x2e, 0x03, 0x19, 0x57, 0x69, 0xd8, 0x1b, 0x2b, 0x9e, 0xd9, 0x11, 0xaf, 0xde, 0x11, 0xef, 0xa0,
+	0xde, 0x91, 0xed, 0xd6, 0xd1, 0x8f, 0x55, 0x8b, 0x74, 0x34, 0x47, 0xa1, 0xb8, 0x8f, 0x6e, 0xa5,
+	0xac, 0x04, 0x11, 0xe4, 0x6a, 0x1f, 0xe3, 0xac, 0x90, 0x50, 0x45, 0x75, 0x43, 0xe3, 0x7b, 0xc0,
+	0x77, 0x35, 0x8a, 0x77, 0x50, 0x5b, 0x23, 0x4e, 0xb3, 0xdb, 0xec, 0xdb, 0x1b, 0x8f, 0x66, 0x36,
+	0x6c, 0x97, 0x95, 0x40, 0x0c, 0x17, 0x6f, 0x21, 0x7b, 0xa8, 0xc4, 0x27, 0x46, 0x70, 0x6b, 0x46,
+	0xc1, 0xc8, 0x90, 0x14, 0xdc, 0x1b, 0xa0, 0xce, 0x45, 0x5b, 0x7c, 0x0f, 0x2d, 0x9a, 0x50, 0xe3,
+	0x50, 0xbf, 0x7d, 0x9b, 0x2c, 0xe8, 0x7a, 0x10, 0xaa, 0x55, 0x1d, 0x33, 0x21, 0x03, 0x1a, 0x86,
+	0x1c, 0x84, 0xd0, 0x6f, 0xd5, 0x21, 0xb6, 0xc2, 0xb6, 0x0c, 0xd4, 0xfb, 0x6e, 0x21, 0x7b, 0xca,
+	0x71, 0xbc, 0x8c, 0xda, 0x11, 0x67, 0x45, 0x5e, 0xad, 0xb5, 0x29, 0xb0, 0x8b, 0x50, 0x04, 0x19,
+	0x70, 0x13, 0xa6, 0x6a, 0xd3, 0x24, 0x53, 0xc8, 0x1f, 0x19, 0x34, 0xaf, 0x9f, 0xc1, 0x1b, 0xd4,
+	0x56, 0xaa, 0x84, 0xd3, 0xd2, 0xce, 0x6e, 0x5e, 0x73, 0x51, 0x5e, 0x32, 0x21, 0xf7, 0x25, 0x95,
+	0x85, 0x20, 0xa6, 0x4f, 0xef, 0x9b, 0x85, 0xee, 0xfe, 0xf5, 0x02, 0x76, 0xd0, 0x82, 0xea, 0x19,
+	0x0f, 0xeb, 0x4f, 0xb7, 0x2e, 0x67, 0xb0, 0x4b, 0x91, 0xf5, 0x4e, 0x83, 0xf9, 0x4c, 0x17, 0x49,
+	0x5d, 0xaa, 0x93, 0x11, 0x8d, 0x93, 0x82, 0x9b, 0x48, 0x3b, 0xa4, 0x2e, 0x55, 0xe0, 0x45, 0x1e,
+	0x52, 0x09, 0xc6, 0x9d, 0xf6, 0xac, 0x81, 0x1b, 0x92, 0x82, 0xb7, 0xdf, 0x1f, 0x9f, 0xba, 0x8d,
+	0x93, 0x53, 0xb7, 0x71, 0x7e, 0xea, 0x5a, 0x1f, 0x27, 0xae, 0xf5, 0x65, 0xe2, 0x5a, 0x5f, 0x27,
+	0xae, 0x75, 0x3c, 0x71, 0xad, 0x9f, 0x13, 0xd7, 0xfa, 0x35, 0x71, 0x1b, 0xe7, 0x13, 0xd7, 0x3a,
+	0x3a, 0x73, 0x1b, 0xc7, 0x67, 0x6e, 0xe3, 0xe4, 0xcc, 0x6d, 0xbc, 0x7b, 0x12, 0xb1, 0x4b, 0x1f,
+	0x63, 0xf6, 0xef, 0xbf, 0xf1, 0xb3, 0xa9, 0xf2, 0x70, 0x5e, 0x2b, 0x7a, 0xfc, 0x7b, 0x00, 0x13,
+	0xe8, 0x8e, 0x35, 0xc6, 0x05, 0x00, 0x00,
 }
 
 func (this *ClusterMetadata) Equal(that interface{}) bool {
@@ -182,148 +498,1481 @@ func (this *ClusterMetadata) Equal(that interface{}) bool {
 	if !this.VersionInfo.Equal(that1.VersionInfo) {
 		return false
 	}
+	if !this.ShardRebalancePlan.Equal(that1.ShardRebalancePlan) {
+		return false
+	}
+	if !this.TlsRotation.Equal(that1.TlsRotation) {
+		return false
+	}
 	return true
 }
-func (this *ClusterMetadata) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ShardRebalancePlan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&persistence.ClusterMetadata{")
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
-	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
-	s = append(s, "ClusterId: "+fmt.Sprintf("%#v", this.ClusterId)+",\n")
-	if this.VersionInfo != nil {
-		s = append(s, "VersionInfo: "+fmt.Sprintf("%#v", this.VersionInfo)+",\n")
+
+	that1, ok := that.(*ShardRebalancePlan)
+	if !ok {
+		that2, ok := that.(ShardRebalancePlan)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringClusterMetadata(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *ClusterMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1.StartTime == nil {
+		if this.StartTime != nil {
+			return false
+		}
+	} else if !this.StartTime.Equal(*that1.StartTime) {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.MovesPerMinute != that1.MovesPerMinute {
+		return false
+	}
+	if len(this.Moves) != len(that1.Moves) {
+		return false
+	}
+	for i := range this.Moves {
+		if !this.Moves[i].Equal(that1.Moves[i]) {
+			return false
+		}
+	}
+	if that1.CancelTime == nil {
+		if this.CancelTime != nil {
+			return false
+		}
+	} else if !this.CancelTime.Equal(*that1.CancelTime) {
+		return false
+	}
+	return true
 }
+func (this *ShardMove) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ClusterMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ShardMove)
+	if !ok {
+		that2, ok := that.(ShardMove)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	return true
 }
+func (this *TLSRotation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ClusterMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+	that1, ok := that.(*TLSRotation)
+	if !ok {
+		that2, ok := that.(TLSRotation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Group != that1.Group {
+		return false
+	}
+	if this.Generation != that1.Generation {
+		return false
+	}
+	if that1.StartTime == nil {
+		if this.StartTime != nil {
+			return false
+		}
+	} else if !this.StartTime.Equal(*that1.StartTime) {
+		return false
+	}
+	if len(this.Hosts) != len(that1.Hosts) {
+		return false
+	}
+	for i := range this.Hosts {
+		if !this.Hosts[i].Equal(that1.Hosts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TLSRotationHostStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TLSRotationHostStatus)
+	if !ok {
+		that2, ok := that.(TLSRotationHostStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Service != that1.Service {
+		return false
+	}
+	if this.HostAddress != that1.HostAddress {
+		return false
+	}
+	if this.Rotated != that1.Rotated {
+		return false
+	}
+	if this.Failure != that1.Failure {
+		return false
+	}
+	if that1.UpdateTime == nil {
+		if this.UpdateTime != nil {
+			return false
+		}
+	} else if !this.UpdateTime.Equal(*that1.UpdateTime) {
+		return false
+	}
+	return true
+}
+func (this *ClusterMetadata) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&persistence.ClusterMetadata{")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "HistoryShardCount: "+fmt.Sprintf("%#v", this.HistoryShardCount)+",\n")
+	s = append(s, "ClusterId: "+fmt.Sprintf("%#v", this.ClusterId)+",\n")
+	if this.VersionInfo != nil {
+		s = append(s, "VersionInfo: "+fmt.Sprintf("%#v", this.VersionInfo)+",\n")
+	}
+	if this.ShardRebalancePlan != nil {
+		s = append(s, "ShardRebalancePlan: "+fmt.Sprintf("%#v", this.ShardRebalancePlan)+",\n")
+	}
+	if this.TlsRotation != nil {
+		s = append(s, "TlsRotation: "+fmt.Sprintf("%#v", this.TlsRotation)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShardRebalancePlan) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&persistence.ShardRebalancePlan{")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	s = append(s, "MovesPerMinute: "+fmt.Sprintf("%#v", this.MovesPerMinute)+",\n")
+	if this.Moves != nil {
+		s = append(s, "Moves: "+fmt.Sprintf("%#v", this.Moves)+",\n")
+	}
+	s = append(s, "CancelTime: "+fmt.Sprintf("%#v", this.CancelTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShardMove) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&persistence.ShardMove{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSRotation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&persistence.TLSRotation{")
+	s = append(s, "Group: "+fmt.Sprintf("%#v", this.Group)+",\n")
+	s = append(s, "Generation: "+fmt.Sprintf("%#v", this.Generation)+",\n")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	if this.Hosts != nil {
+		s = append(s, "Hosts: "+fmt.Sprintf("%#v", this.Hosts)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TLSRotationHostStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&persistence.TLSRotationHostStatus{")
+	s = append(s, "Service: "+fmt.Sprintf("%#v", this.Service)+",\n")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "Rotated: "+fmt.Sprintf("%#v", this.Rotated)+",\n")
+	s = append(s, "Failure: "+fmt.Sprintf("%#v", this.Failure)+",\n")
+	s = append(s, "UpdateTime: "+fmt.Sprintf("%#v", this.UpdateTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringClusterMetadata(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ClusterMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.TlsRotation != nil {
+		{
+			size, err := m.TlsRotation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ShardRebalancePlan != nil {
+		{
+			size, err := m.ShardRebalancePlan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.VersionInfo != nil {
 		{
 			size, err := m.VersionInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return 0, err
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HistoryShardCount != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.HistoryShardCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardRebalancePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardRebalancePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardRebalancePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancelTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CancelTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CancelTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Moves) > 0 {
+		for iNdEx := len(m.Moves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Moves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MovesPerMinute != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.MovesPerMinute))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hosts) > 0 {
+		for iNdEx := len(m.Hosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Generation != 0 {
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TLSRotationHostStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSRotationHostStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSRotationHostStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Failure) > 0 {
+		i -= len(m.Failure)
+		copy(dAtA[i:], m.Failure)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Failure)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Rotated {
+		i--
+		if m.Rotated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Service) > 0 {
+		i -= len(m.Service)
+		copy(dAtA[i:], m.Service)
+		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.Service)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClusterMetadata(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClusterMetadata(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClusterMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.HistoryShardCount != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.HistoryShardCount))
+	}
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.VersionInfo != nil {
+		l = m.VersionInfo.Size()
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.ShardRebalancePlan != nil {
+		l = m.ShardRebalancePlan.Size()
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.TlsRotation != nil {
+		l = m.TlsRotation.Size()
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *ShardRebalancePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.MovesPerMinute != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.MovesPerMinute))
+	}
+	if len(m.Moves) > 0 {
+		for _, e := range m.Moves {
+			l = e.Size()
+			n += 1 + l + sovClusterMetadata(uint64(l))
+		}
+	}
+	if m.CancelTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CancelTime)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *ShardMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.ShardId))
+	}
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *TLSRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.Generation != 0 {
+		n += 1 + sovClusterMetadata(uint64(m.Generation))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if len(m.Hosts) > 0 {
+		for _, e := range m.Hosts {
+			l = e.Size()
+			n += 1 + l + sovClusterMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TLSRotationHostStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Service)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.Rotated {
+		n += 2
+	}
+	l = len(m.Failure)
+	if l > 0 {
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	if m.UpdateTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateTime)
+		n += 1 + l + sovClusterMetadata(uint64(l))
+	}
+	return n
+}
+
+func sovClusterMetadata(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClusterMetadata(x uint64) (n int) {
+	return sovClusterMetadata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ClusterMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterMetadata{`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`HistoryShardCount:` + fmt.Sprintf("%v", this.HistoryShardCount) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`VersionInfo:` + strings.Replace(fmt.Sprintf("%v", this.VersionInfo), "VersionInfo", "v1.VersionInfo", 1) + `,`,
+		`ShardRebalancePlan:` + strings.Replace(this.ShardRebalancePlan.String(), "ShardRebalancePlan", "ShardRebalancePlan", 1) + `,`,
+		`TlsRotation:` + strings.Replace(this.TlsRotation.String(), "TLSRotation", "TLSRotation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardRebalancePlan) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMoves := "[]*ShardMove{"
+	for _, f := range this.Moves {
+		repeatedStringForMoves += strings.Replace(f.String(), "ShardMove", "ShardMove", 1) + ","
+	}
+	repeatedStringForMoves += "}"
+	s := strings.Join([]string{`&ShardRebalancePlan{`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`MovesPerMinute:` + fmt.Sprintf("%v", this.MovesPerMinute) + `,`,
+		`Moves:` + repeatedStringForMoves + `,`,
+		`CancelTime:` + strings.Replace(fmt.Sprintf("%v", this.CancelTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardMove) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardMove{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TLSRotation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHosts := "[]*TLSRotationHostStatus{"
+	for _, f := range this.Hosts {
+		repeatedStringForHosts += strings.Replace(f.String(), "TLSRotationHostStatus", "TLSRotationHostStatus", 1) + ","
+	}
+	repeatedStringForHosts += "}"
+	s := strings.Join([]string{`&TLSRotation{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Generation:` + fmt.Sprintf("%v", this.Generation) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Hosts:` + repeatedStringForHosts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TLSRotationHostStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TLSRotationHostStatus{`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`Rotated:` + fmt.Sprintf("%v", this.Rotated) + `,`,
+		`Failure:` + fmt.Sprintf("%v", this.Failure) + `,`,
+		`UpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.UpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringClusterMetadata(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryShardCount", wireType)
+			}
+			m.HistoryShardCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryShardCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionInfo == nil {
+				m.VersionInfo = &v1.VersionInfo{}
+			}
+			if err := m.VersionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardRebalancePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardRebalancePlan == nil {
+				m.ShardRebalancePlan = &ShardRebalancePlan{}
+			}
+			if err := m.ShardRebalancePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TlsRotation == nil {
+				m.TlsRotation = &TLSRotation{}
+			}
+			if err := m.TlsRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardRebalancePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardRebalancePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardRebalancePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovesPerMinute", wireType)
+			}
+			m.MovesPerMinute = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MovesPerMinute |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moves = append(m.Moves, &ShardMove{})
+			if err := m.Moves[len(m.Moves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CancelTime == nil {
+				m.CancelTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CancelTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClusterMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLSRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLSRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hosts = append(m.Hosts, &TLSRotationHostStatus{})
+			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClusterMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i -= size
-			i = encodeVarintClusterMetadata(dAtA, i, uint64(size))
+			if skippy < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.HistoryShardCount != 0 {
-		i = encodeVarintClusterMetadata(dAtA, i, uint64(m.HistoryShardCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintClusterMetadata(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintClusterMetadata(dAtA []byte, offset int, v uint64) int {
-	offset -= sovClusterMetadata(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ClusterMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovClusterMetadata(uint64(l))
-	}
-	if m.HistoryShardCount != 0 {
-		n += 1 + sovClusterMetadata(uint64(m.HistoryShardCount))
-	}
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovClusterMetadata(uint64(l))
-	}
-	if m.VersionInfo != nil {
-		l = m.VersionInfo.Size()
-		n += 1 + l + sovClusterMetadata(uint64(l))
 	}
-	return n
-}
 
-func sovClusterMetadata(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozClusterMetadata(x uint64) (n int) {
-	return sovClusterMetadata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ClusterMetadata) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ClusterMetadata{`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`HistoryShardCount:` + fmt.Sprintf("%v", this.HistoryShardCount) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`VersionInfo:` + strings.Replace(fmt.Sprintf("%v", this.VersionInfo), "VersionInfo", "v1.VersionInfo", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringClusterMetadata(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
+func (m *TLSRotationHostStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -346,15 +1995,15 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: TLSRotationHostStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TLSRotationHostStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -382,13 +2031,13 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.Service = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryShardCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
-			m.HistoryShardCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClusterMetadata
@@ -398,14 +2047,47 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoryShardCount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClusterMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClusterMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rotated = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -433,11 +2115,11 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.Failure = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -464,10 +2146,10 @@ func (m *ClusterMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionInfo == nil {
-				m.VersionInfo = &v1.VersionInfo{}
+			if m.UpdateTime == nil {
+				m.UpdateTime = new(time.Time)
 			}
-			if err := m.VersionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
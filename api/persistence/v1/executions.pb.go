@@ -82,6 +82,8 @@ type ShardInfo struct {
 	ClusterReplicationLevel      map[string]int64      `protobuf:"bytes,12,rep,name=cluster_replication_level,json=clusterReplicationLevel,proto3" json:"cluster_replication_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	ReplicationDlqAckLevel       map[string]int64      `protobuf:"bytes,13,rep,name=replication_dlq_ack_level,json=replicationDlqAckLevel,proto3" json:"replication_dlq_ack_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	VisibilityAckLevel           int64                 `protobuf:"varint,14,opt,name=visibility_ack_level,json=visibilityAckLevel,proto3" json:"visibility_ack_level,omitempty"`
+	// Id of the consistency marker shard is reconciled with after a restore.
+	RestoredConsistencyMarkerId int64 `protobuf:"varint,15,opt,name=restored_consistency_marker_id,json=restoredConsistencyMarkerId,proto3" json:"restored_consistency_marker_id,omitempty"`
 }
 
 func (m *ShardInfo) Reset()      { *m = ShardInfo{} }
@@ -214,6 +216,13 @@ func (m *ShardInfo) GetVisibilityAckLevel() int64 {
 	return 0
 }
 
+func (m *ShardInfo) GetRestoredConsistencyMarkerId() int64 {
+	if m != nil {
+		return m.RestoredConsistencyMarkerId
+	}
+	return 0
+}
+
 // execution column
 type WorkflowExecutionInfo struct {
 	NamespaceId                       string           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -268,6 +277,29 @@ type WorkflowExecutionInfo struct {
 	FirstExecutionRunId             string                  `protobuf:"bytes,55,opt,name=first_execution_run_id,json=firstExecutionRunId,proto3" json:"first_execution_run_id,omitempty"`
 	ExecutionStats                  *ExecutionStats         `protobuf:"bytes,56,opt,name=execution_stats,json=executionStats,proto3" json:"execution_stats,omitempty"`
 	WorkflowRunExpirationTime       *time.Time              `protobuf:"bytes,57,opt,name=workflow_run_expiration_time,json=workflowRunExpirationTime,proto3,stdtime" json:"workflow_run_expiration_time,omitempty"`
+	// Why the execution makes no progress, set by stuck execution check timer tasks and cleared
+	// once pending tasks are started. It is not replicated and only visible in visibility records.
+	StuckReason string `protobuf:"bytes,58,opt,name=stuck_reason,json=stuckReason,proto3" json:"stuck_reason,omitempty"`
+	// Notes added by operators through the admin service. They are not part of history, are not
+	// replicated and are exposed to visibility through reserved search attributes.
+	OperatorAnnotations []*OperatorAnnotation `protobuf:"bytes,59,rep,name=operator_annotations,json=operatorAnnotations,proto3" json:"operator_annotations,omitempty"`
+	// Latency breakdown of the most recently started activity and workflow tasks, recorded when
+	// enabled for the namespace. It is not replicated and is shown by admin DescribeMutableState.
+	TaskLatencyBreakdowns []*TaskLatencyBreakdown `protobuf:"bytes,60,rep,name=task_latency_breakdowns,json=taskLatencyBreakdowns,proto3" json:"task_latency_breakdowns,omitempty"`
+	// First event batch of a replicated execution which was too large to be replicated to remote
+	// clusters. It is not replicated, is recomputed when mutable state is rebuilt from history and
+	// is exposed through the memo of visibility records and DescribeWorkflowExecution.
+	ReplicationOversizedBatch *OversizedEventBatch `protobuf:"bytes,61,opt,name=replication_oversized_batch,json=replicationOversizedBatch,proto3" json:"replication_oversized_batch,omitempty"`
+	// Search attributes upserted by external systems through SearchAttributesService. They are not part of
+	// history, are not replicated and are added to the search attributes of visibility records and describe
+	// results. Keys upserted by the workflow afterwards are removed.
+	ExternalSearchAttributes map[string]*v12.Payload `protobuf:"bytes,62,rep,name=external_search_attributes,json=externalSearchAttributes,proto3" json:"external_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Latest value of each report published by the workflow with the workflow report marker, keyed by report name.
+	// It is rebuilt from the marker events of history and is returned by history DescribeWorkflowExecution.
+	WorkflowReports map[string]*v12.Payload `protobuf:"bytes,63,rep,name=workflow_reports,json=workflowReports,proto3" json:"workflow_reports,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Run which this run was reset from, set by the reset which created the run. It is rebuilt from the workflow
+	// task failed event written by the reset and is returned by history GetMutableState.
+	ResetBaseRunId string `protobuf:"bytes,64,opt,name=reset_base_run_id,json=resetBaseRunId,proto3" json:"reset_base_run_id,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
@@ -653,6 +685,290 @@ func (m *WorkflowExecutionInfo) GetWorkflowRunExpirationTime() *time.Time {
 	return nil
 }
 
+func (m *WorkflowExecutionInfo) GetStuckReason() string {
+	if m != nil {
+		return m.StuckReason
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetOperatorAnnotations() []*OperatorAnnotation {
+	if m != nil {
+		return m.OperatorAnnotations
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetTaskLatencyBreakdowns() []*TaskLatencyBreakdown {
+	if m != nil {
+		return m.TaskLatencyBreakdowns
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetReplicationOversizedBatch() *OversizedEventBatch {
+	if m != nil {
+		return m.ReplicationOversizedBatch
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetExternalSearchAttributes() map[string]*v12.Payload {
+	if m != nil {
+		return m.ExternalSearchAttributes
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetWorkflowReports() map[string]*v12.Payload {
+	if m != nil {
+		return m.WorkflowReports
+	}
+	return nil
+}
+
+func (m *WorkflowExecutionInfo) GetResetBaseRunId() string {
+	if m != nil {
+		return m.ResetBaseRunId
+	}
+	return ""
+}
+
+type OperatorAnnotation struct {
+	Time       *time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	Annotation string     `protobuf:"bytes,2,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	Tags       []string   `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Identity   string     `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *OperatorAnnotation) Reset()      { *m = OperatorAnnotation{} }
+func (*OperatorAnnotation) ProtoMessage() {}
+func (*OperatorAnnotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{2}
+}
+func (m *OperatorAnnotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorAnnotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorAnnotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorAnnotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorAnnotation.Merge(m, src)
+}
+func (m *OperatorAnnotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorAnnotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorAnnotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorAnnotation proto.InternalMessageInfo
+
+func (m *OperatorAnnotation) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *OperatorAnnotation) GetAnnotation() string {
+	if m != nil {
+		return m.Annotation
+	}
+	return ""
+}
+
+func (m *OperatorAnnotation) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *OperatorAnnotation) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+// TaskLatencyBreakdown tells where schedule-to-start latency of an activity or workflow task was spent.
+type TaskLatencyBreakdown struct {
+	// Scheduled event ID of the activity or workflow task.
+	ScheduledEventId int64      `protobuf:"varint,1,opt,name=scheduled_event_id,json=scheduledEventId,proto3" json:"scheduled_event_id,omitempty"`
+	StartedTime      *time.Time `protobuf:"bytes,2,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
+	// Time from the task being scheduled until it was started by a poller.
+	ScheduleToStartLatency *time.Duration `protobuf:"bytes,3,opt,name=schedule_to_start_latency,json=scheduleToStartLatency,proto3,stdduration" json:"schedule_to_start_latency,omitempty"`
+	// Time until the task reached the matching partition which matched it, i.e. transfer
+	// to matching and forwarding between partitions.
+	DispatchLatency *time.Duration `protobuf:"bytes,4,opt,name=dispatch_latency,json=dispatchLatency,proto3,stdduration" json:"dispatch_latency,omitempty"`
+	// Time the task waited in the matching partition which matched it.
+	QueueLatency *time.Duration `protobuf:"bytes,5,opt,name=queue_latency,json=queueLatency,proto3,stdduration" json:"queue_latency,omitempty"`
+	// Whether the task was matched with a waiting poller without being persisted to backlog.
+	SyncMatch bool `protobuf:"varint,6,opt,name=sync_match,json=syncMatch,proto3" json:"sync_match,omitempty"`
+	// Child partition the task was forwarded from.
+	ForwardedFrom string `protobuf:"bytes,7,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
+	// Child partition the poll which matched the task was forwarded from.
+	PollForwardedFrom string `protobuf:"bytes,8,opt,name=poll_forwarded_from,json=pollForwardedFrom,proto3" json:"poll_forwarded_from,omitempty"`
+}
+
+func (m *TaskLatencyBreakdown) Reset()      { *m = TaskLatencyBreakdown{} }
+func (*TaskLatencyBreakdown) ProtoMessage() {}
+func (*TaskLatencyBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{3}
+}
+func (m *TaskLatencyBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskLatencyBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskLatencyBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskLatencyBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskLatencyBreakdown.Merge(m, src)
+}
+func (m *TaskLatencyBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskLatencyBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskLatencyBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskLatencyBreakdown proto.InternalMessageInfo
+
+func (m *TaskLatencyBreakdown) GetScheduledEventId() int64 {
+	if m != nil {
+		return m.ScheduledEventId
+	}
+	return 0
+}
+
+func (m *TaskLatencyBreakdown) GetStartedTime() *time.Time {
+	if m != nil {
+		return m.StartedTime
+	}
+	return nil
+}
+
+func (m *TaskLatencyBreakdown) GetScheduleToStartLatency() *time.Duration {
+	if m != nil {
+		return m.ScheduleToStartLatency
+	}
+	return nil
+}
+
+func (m *TaskLatencyBreakdown) GetDispatchLatency() *time.Duration {
+	if m != nil {
+		return m.DispatchLatency
+	}
+	return nil
+}
+
+func (m *TaskLatencyBreakdown) GetQueueLatency() *time.Duration {
+	if m != nil {
+		return m.QueueLatency
+	}
+	return nil
+}
+
+func (m *TaskLatencyBreakdown) GetSyncMatch() bool {
+	if m != nil {
+		return m.SyncMatch
+	}
+	return false
+}
+
+func (m *TaskLatencyBreakdown) GetForwardedFrom() string {
+	if m != nil {
+		return m.ForwardedFrom
+	}
+	return ""
+}
+
+func (m *TaskLatencyBreakdown) GetPollForwardedFrom() string {
+	if m != nil {
+		return m.PollForwardedFrom
+	}
+	return ""
+}
+
+// OversizedEventBatch is an event batch exceeding the replication size limit.
+type OversizedEventBatch struct {
+	FirstEventId   int64 `protobuf:"varint,1,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
+	SizeBytes      int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	SizeLimitBytes int64 `protobuf:"varint,3,opt,name=size_limit_bytes,json=sizeLimitBytes,proto3" json:"size_limit_bytes,omitempty"`
+}
+
+func (m *OversizedEventBatch) Reset()      { *m = OversizedEventBatch{} }
+func (*OversizedEventBatch) ProtoMessage() {}
+func (*OversizedEventBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{4}
+}
+func (m *OversizedEventBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OversizedEventBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OversizedEventBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OversizedEventBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OversizedEventBatch.Merge(m, src)
+}
+func (m *OversizedEventBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *OversizedEventBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_OversizedEventBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OversizedEventBatch proto.InternalMessageInfo
+
+func (m *OversizedEventBatch) GetFirstEventId() int64 {
+	if m != nil {
+		return m.FirstEventId
+	}
+	return 0
+}
+
+func (m *OversizedEventBatch) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *OversizedEventBatch) GetSizeLimitBytes() int64 {
+	if m != nil {
+		return m.SizeLimitBytes
+	}
+	return 0
+}
+
 type ExecutionStats struct {
 	HistorySize int64 `protobuf:"varint,1,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
 }
@@ -660,7 +976,7 @@ type ExecutionStats struct {
 func (m *ExecutionStats) Reset()      { *m = ExecutionStats{} }
 func (*ExecutionStats) ProtoMessage() {}
 func (*ExecutionStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{2}
+	return fileDescriptor_67a714d0e7ba9f37, []int{5}
 }
 func (m *ExecutionStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +1023,7 @@ type WorkflowExecutionState struct {
 func (m *WorkflowExecutionState) Reset()      { *m = WorkflowExecutionState{} }
 func (*WorkflowExecutionState) ProtoMessage() {}
 func (*WorkflowExecutionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{3}
+	return fileDescriptor_67a714d0e7ba9f37, []int{6}
 }
 func (m *WorkflowExecutionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +1101,7 @@ type TransferTaskInfo struct {
 func (m *TransferTaskInfo) Reset()      { *m = TransferTaskInfo{} }
 func (*TransferTaskInfo) ProtoMessage() {}
 func (*TransferTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{4}
+	return fileDescriptor_67a714d0e7ba9f37, []int{7}
 }
 func (m *TransferTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +1246,7 @@ type ReplicationTaskInfo struct {
 func (m *ReplicationTaskInfo) Reset()      { *m = ReplicationTaskInfo{} }
 func (*ReplicationTaskInfo) ProtoMessage() {}
 func (*ReplicationTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{5}
+	return fileDescriptor_67a714d0e7ba9f37, []int{8}
 }
 func (m *ReplicationTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1050,7 +1366,7 @@ type VisibilityTaskInfo struct {
 func (m *VisibilityTaskInfo) Reset()      { *m = VisibilityTaskInfo{} }
 func (*VisibilityTaskInfo) ProtoMessage() {}
 func (*VisibilityTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{6}
+	return fileDescriptor_67a714d0e7ba9f37, []int{9}
 }
 func (m *VisibilityTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1146,7 +1462,7 @@ type TimerTaskInfo struct {
 func (m *TimerTaskInfo) Reset()      { *m = TimerTaskInfo{} }
 func (*TimerTaskInfo) ProtoMessage() {}
 func (*TimerTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{7}
+	return fileDescriptor_67a714d0e7ba9f37, []int{10}
 }
 func (m *TimerTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1292,12 +1608,13 @@ type ActivityInfo struct {
 	ScheduleId                  int64          `protobuf:"varint,30,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 	LastHeartbeatDetails        *v12.Payloads  `protobuf:"bytes,31,opt,name=last_heartbeat_details,json=lastHeartbeatDetails,proto3" json:"last_heartbeat_details,omitempty"`
 	LastHeartbeatUpdateTime     *time.Time     `protobuf:"bytes,32,opt,name=last_heartbeat_update_time,json=lastHeartbeatUpdateTime,proto3,stdtime" json:"last_heartbeat_update_time,omitempty"`
+	ActivityType                string         `protobuf:"bytes,33,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
 }
 
 func (m *ActivityInfo) Reset()      { *m = ActivityInfo{} }
 func (*ActivityInfo) ProtoMessage() {}
 func (*ActivityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{8}
+	return fileDescriptor_67a714d0e7ba9f37, []int{11}
 }
 func (m *ActivityInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1550,6 +1867,13 @@ func (m *ActivityInfo) GetLastHeartbeatUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ActivityInfo) GetActivityType() string {
+	if m != nil {
+		return m.ActivityType
+	}
+	return ""
+}
+
 // timer_map column
 type TimerInfo struct {
 	Version    int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -1563,7 +1887,7 @@ type TimerInfo struct {
 func (m *TimerInfo) Reset()      { *m = TimerInfo{} }
 func (*TimerInfo) ProtoMessage() {}
 func (*TimerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{9}
+	return fileDescriptor_67a714d0e7ba9f37, []int{12}
 }
 func (m *TimerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1646,7 +1970,7 @@ type ChildExecutionInfo struct {
 func (m *ChildExecutionInfo) Reset()      { *m = ChildExecutionInfo{} }
 func (*ChildExecutionInfo) ProtoMessage() {}
 func (*ChildExecutionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{10}
+	return fileDescriptor_67a714d0e7ba9f37, []int{13}
 }
 func (m *ChildExecutionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1770,7 +2094,7 @@ type RequestCancelInfo struct {
 func (m *RequestCancelInfo) Reset()      { *m = RequestCancelInfo{} }
 func (*RequestCancelInfo) ProtoMessage() {}
 func (*RequestCancelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{11}
+	return fileDescriptor_67a714d0e7ba9f37, []int{14}
 }
 func (m *RequestCancelInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1841,7 +2165,7 @@ type SignalInfo struct {
 func (m *SignalInfo) Reset()      { *m = SignalInfo{} }
 func (*SignalInfo) ProtoMessage() {}
 func (*SignalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{12}
+	return fileDescriptor_67a714d0e7ba9f37, []int{15}
 }
 func (m *SignalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1929,7 +2253,7 @@ type Checksum struct {
 func (m *Checksum) Reset()      { *m = Checksum{} }
 func (*Checksum) ProtoMessage() {}
 func (*Checksum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67a714d0e7ba9f37, []int{13}
+	return fileDescriptor_67a714d0e7ba9f37, []int{16}
 }
 func (m *Checksum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1986,8 +2310,13 @@ func init() {
 	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.persistence.v1.ShardInfo.ClusterTransferAckLevelEntry")
 	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.persistence.v1.ShardInfo.ReplicationDlqAckLevelEntry")
 	proto.RegisterType((*WorkflowExecutionInfo)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo")
+	proto.RegisterMapType((map[string]*v12.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.ExternalSearchAttributesEntry")
 	proto.RegisterMapType((map[string]*v12.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.MemoEntry")
 	proto.RegisterMapType((map[string]*v12.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.SearchAttributesEntry")
+	proto.RegisterMapType((map[string]*v12.Payload)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionInfo.WorkflowReportsEntry")
+	proto.RegisterType((*OperatorAnnotation)(nil), "temporal.server.api.persistence.v1.OperatorAnnotation")
+	proto.RegisterType((*TaskLatencyBreakdown)(nil), "temporal.server.api.persistence.v1.TaskLatencyBreakdown")
+	proto.RegisterType((*OversizedEventBatch)(nil), "temporal.server.api.persistence.v1.OversizedEventBatch")
 	proto.RegisterType((*ExecutionStats)(nil), "temporal.server.api.persistence.v1.ExecutionStats")
 	proto.RegisterType((*WorkflowExecutionState)(nil), "temporal.server.api.persistence.v1.WorkflowExecutionState")
 	proto.RegisterType((*TransferTaskInfo)(nil), "temporal.server.api.persistence.v1.TransferTaskInfo")
@@ -2007,207 +2336,239 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0xcd, 0x73, 0xdb, 0xc6,
-	0xf5, 0x86, 0x45, 0x89, 0xe4, 0x23, 0x45, 0x91, 0xd0, 0x17, 0x24, 0xdb, 0x94, 0xcc, 0xd8, 0x89,
-	0x1c, 0x3b, 0x94, 0x2d, 0x3b, 0xdf, 0x87, 0xdf, 0xd8, 0xb2, 0x9d, 0x90, 0x93, 0x38, 0x0e, 0xa4,
-	0xc4, 0x99, 0xfc, 0x26, 0xc3, 0x81, 0x80, 0xa5, 0x84, 0x9f, 0x40, 0x80, 0x06, 0x40, 0xda, 0xcc,
-	0xfc, 0x0e, 0x39, 0x74, 0x9a, 0x6b, 0x8e, 0x9d, 0xe9, 0xa9, 0xb7, 0x1e, 0x7a, 0xea, 0x4c, 0xff,
-	0x80, 0x4e, 0x2f, 0x3d, 0xe6, 0x98, 0x43, 0xa7, 0x6d, 0x94, 0x4b, 0x2f, 0x9d, 0xe6, 0x4f, 0xe8,
-	0xec, 0xdb, 0x5d, 0x60, 0x01, 0x42, 0x32, 0xe5, 0xc6, 0x87, 0xf4, 0x06, 0xec, 0xfb, 0xd8, 0xb7,
-	0x6f, 0xdf, 0x37, 0x00, 0x37, 0x43, 0xd2, 0xeb, 0x7b, 0xbe, 0xe1, 0x6c, 0x06, 0xc4, 0x1f, 0x12,
-	0x7f, 0xd3, 0xe8, 0xdb, 0x9b, 0x7d, 0xe2, 0x07, 0x76, 0x10, 0x12, 0xd7, 0x24, 0x9b, 0xc3, 0x1b,
-	0x9b, 0xe4, 0x29, 0x31, 0x07, 0xa1, 0xed, 0xb9, 0x41, 0xb3, 0xef, 0x7b, 0xa1, 0xa7, 0x36, 0x04,
-	0x51, 0x93, 0x11, 0x35, 0x8d, 0xbe, 0xdd, 0x94, 0x88, 0x9a, 0xc3, 0x1b, 0xab, 0xf5, 0x7d, 0xcf,
-	0xdb, 0x77, 0xc8, 0x26, 0x52, 0xec, 0x0d, 0xba, 0x9b, 0xd6, 0xc0, 0x37, 0x28, 0x13, 0xc6, 0x63,
-	0x75, 0x2d, 0x0d, 0x0f, 0xed, 0x1e, 0x09, 0x42, 0xa3, 0xd7, 0xe7, 0x08, 0x63, 0x0c, 0x9e, 0xf8,
-	0x46, 0x9f, 0x6e, 0xc2, 0xe1, 0x17, 0x2d, 0xd2, 0x27, 0xae, 0x45, 0x5c, 0xd3, 0x26, 0xc1, 0xe6,
-	0xbe, 0xb7, 0xef, 0xe1, 0x3a, 0x3e, 0x71, 0x94, 0x4b, 0xd1, 0xe1, 0xe8, 0xa9, 0x4c, 0xaf, 0xd7,
-	0xf3, 0x5c, 0x7a, 0xa0, 0x1e, 0x09, 0x02, 0x63, 0x9f, 0x64, 0x62, 0x11, 0x77, 0xd0, 0x0b, 0x28,
-	0xd2, 0x13, 0xcf, 0x3f, 0xec, 0x3a, 0xde, 0x13, 0x8e, 0x75, 0x39, 0x81, 0xd5, 0x35, 0x6c, 0x67,
-	0xe0, 0x93, 0x71, 0x66, 0x49, 0xb4, 0x03, 0x3b, 0x08, 0x3d, 0x7f, 0x34, 0x8e, 0xf6, 0x72, 0x02,
-	0x4d, 0x6c, 0x35, 0x8e, 0x77, 0x25, 0xeb, 0x7a, 0x22, 0x11, 0xd9, 0x89, 0x38, 0xea, 0xd5, 0x13,
-	0x51, 0x53, 0xa7, 0x79, 0xe5, 0x44, 0xe4, 0xd0, 0x08, 0x0e, 0x39, 0xe2, 0xb5, 0x2c, 0xc4, 0xe3,
-	0x8e, 0xd5, 0xf8, 0x2b, 0x40, 0x71, 0xe7, 0xc0, 0xf0, 0xad, 0x96, 0xdb, 0xf5, 0xd4, 0x15, 0x28,
-	0x04, 0xf4, 0xa5, 0x63, 0x5b, 0x9a, 0xb2, 0xae, 0x6c, 0x4c, 0xeb, 0x79, 0x7c, 0x6f, 0x59, 0x14,
-	0xe4, 0x1b, 0xee, 0x3e, 0xa1, 0xa0, 0xb3, 0xeb, 0xca, 0xc6, 0x94, 0x9e, 0xc7, 0xf7, 0x96, 0xa5,
-	0x2e, 0xc0, 0xb4, 0xf7, 0xc4, 0x25, 0xbe, 0x36, 0xb5, 0xae, 0x6c, 0x14, 0x75, 0xf6, 0xa2, 0x6e,
-	0xc1, 0xa2, 0x4f, 0xfa, 0x8e, 0x6d, 0xa2, 0x0d, 0x75, 0x0c, 0xf3, 0xb0, 0xe3, 0x90, 0x21, 0x71,
-	0xb4, 0x1c, 0x52, 0xcf, 0x4b, 0xc0, 0xdb, 0xe6, 0xe1, 0x07, 0x14, 0xa4, 0x5e, 0x03, 0x35, 0xf4,
-	0x0d, 0x37, 0xe8, 0x12, 0x5f, 0x22, 0x98, 0x46, 0x82, 0xaa, 0x80, 0xc8, 0xd8, 0x41, 0xe8, 0x39,
-	0xc4, 0xed, 0x04, 0xb6, 0x6b, 0x92, 0x8e, 0x4f, 0x5c, 0xf2, 0x44, 0x9b, 0x41, 0xb9, 0xab, 0x0c,
-	0xb2, 0x43, 0x01, 0x3a, 0x5d, 0x57, 0x6f, 0x43, 0x69, 0xd0, 0xb7, 0x8c, 0x90, 0x74, 0xa8, 0xdd,
-	0x6a, 0xf9, 0x75, 0x65, 0xa3, 0xb4, 0xb5, 0xda, 0x64, 0x36, 0xdb, 0x14, 0x36, 0xdb, 0xdc, 0x15,
-	0x46, 0x7d, 0x27, 0xf7, 0xcd, 0xdf, 0xd6, 0x14, 0x1d, 0x18, 0x11, 0x5d, 0x56, 0x3f, 0x86, 0x05,
-	0x4a, 0x2b, 0xc9, 0xc6, 0x78, 0x15, 0x26, 0xe4, 0x55, 0x43, 0x6a, 0x21, 0x3f, 0xb2, 0xbc, 0x0b,
-	0x75, 0xd7, 0xe8, 0x91, 0xa0, 0x6f, 0x98, 0xa4, 0xe3, 0x7a, 0xa1, 0xdd, 0x15, 0x0a, 0x1b, 0x52,
-	0xef, 0xf4, 0x5c, 0xad, 0x88, 0xa7, 0x3f, 0x1f, 0x61, 0x3d, 0x90, 0x90, 0x3e, 0x65, 0x38, 0xea,
-	0xd7, 0x0a, 0xac, 0x9a, 0xce, 0x20, 0x08, 0x89, 0xdf, 0xc9, 0x50, 0x20, 0xac, 0x4f, 0x6d, 0x94,
-	0xb6, 0xda, 0xcd, 0x67, 0x07, 0x81, 0x66, 0x64, 0x0b, 0xcd, 0x6d, 0xc6, 0x6f, 0x37, 0xa5, 0xf5,
-	0x7b, 0x6e, 0xe8, 0x8f, 0xf4, 0x65, 0x33, 0x1b, 0xaa, 0xfe, 0x42, 0x81, 0xe5, 0x48, 0x92, 0xa4,
-	0xae, 0xb4, 0x12, 0x8a, 0xf1, 0xde, 0xf3, 0x89, 0x21, 0x6b, 0x0e, 0x65, 0xe0, 0x3a, 0x5d, 0x30,
-	0x33, 0x10, 0xd4, 0x5f, 0x2a, 0xb0, 0x22, 0xc4, 0x90, 0xad, 0x90, 0x09, 0x52, 0xfe, 0x0f, 0xf4,
-	0xa1, 0xc7, 0xdc, 0x32, 0xf4, 0x91, 0x86, 0x52, 0x7d, 0xac, 0xc8, 0x02, 0x58, 0xce, 0x63, 0x49,
-	0x23, 0xb3, 0x28, 0x48, 0xeb, 0x74, 0x82, 0x48, 0x7b, 0xdc, 0x75, 0x1e, 0x27, 0xef, 0x65, 0xc9,
-	0xcf, 0x04, 0xaa, 0xd7, 0x61, 0x61, 0x68, 0x07, 0xf6, 0x9e, 0xed, 0xd8, 0xe1, 0x48, 0x12, 0xa0,
-	0x82, 0xc6, 0xa5, 0xc6, 0x30, 0x41, 0xb1, 0xda, 0x86, 0xf3, 0x27, 0x59, 0x80, 0x5a, 0x85, 0xa9,
-	0x43, 0x32, 0xc2, 0x28, 0x51, 0xd4, 0xe9, 0x23, 0x0d, 0x03, 0x43, 0xc3, 0x19, 0x10, 0x1e, 0x1e,
-	0xd8, 0xcb, 0x3b, 0x67, 0xdf, 0x52, 0x56, 0x4d, 0x58, 0x39, 0xf6, 0x1a, 0x33, 0x18, 0x5d, 0x97,
-	0x19, 0x9d, 0xe8, 0x57, 0xf2, 0x26, 0xb1, 0xc0, 0x99, 0x57, 0x74, 0x2a, 0x81, 0x5b, 0x70, 0xee,
-	0x04, 0x2d, 0x9f, 0x86, 0x55, 0xe3, 0x77, 0xe7, 0x60, 0xf1, 0x11, 0x0f, 0xe5, 0xf7, 0x44, 0x5a,
-	0xc6, 0x60, 0x7b, 0x11, 0xca, 0xb1, 0xeb, 0xf3, 0x80, 0x5b, 0xd4, 0x4b, 0xd1, 0x5a, 0xcb, 0x52,
-	0xd7, 0xa0, 0x24, 0xd2, 0x80, 0x88, 0xbb, 0x45, 0x1d, 0xc4, 0x52, 0xcb, 0x52, 0x9b, 0x30, 0xdf,
-	0x37, 0x7c, 0xe2, 0x86, 0x9d, 0x04, 0x2b, 0x16, 0x88, 0x6b, 0x0c, 0xf4, 0x40, 0x62, 0x78, 0x0d,
-	0x54, 0x8e, 0x2f, 0xf3, 0xcd, 0x21, 0x7a, 0x95, 0x41, 0x1e, 0xc5, 0xdc, 0x1b, 0x30, 0xcb, 0xb1,
-	0xfd, 0x81, 0x4b, 0x11, 0xa7, 0x99, 0x88, 0x6c, 0x51, 0x1f, 0xb8, 0x2d, 0x8b, 0x9e, 0xc2, 0x76,
-	0xed, 0xd0, 0x36, 0x42, 0x82, 0x69, 0x63, 0x06, 0x15, 0x50, 0x8a, 0xd6, 0x5a, 0x96, 0xfa, 0x36,
-	0xac, 0x98, 0x5e, 0xaf, 0xef, 0x10, 0xf4, 0x00, 0x32, 0xa4, 0x0c, 0xf7, 0x8c, 0xd0, 0x3c, 0xa0,
-	0xf8, 0x79, 0xc4, 0x5f, 0x8a, 0x11, 0xee, 0x51, 0xf8, 0x1d, 0x0a, 0x6e, 0x59, 0xea, 0x43, 0xa8,
-	0xa6, 0x49, 0x79, 0xb4, 0xbd, 0x1c, 0x3b, 0x0d, 0xf5, 0x16, 0x9e, 0xe0, 0xa8, 0xa7, 0xbc, 0xcf,
-	0x1e, 0x91, 0x8f, 0x3e, 0x97, 0x62, 0xac, 0x5e, 0x00, 0xa0, 0xc9, 0xb2, 0xf3, 0x78, 0x40, 0x06,
-	0x04, 0x83, 0x6b, 0x51, 0x2f, 0xd2, 0x95, 0x8f, 0xe9, 0x02, 0x55, 0x50, 0xa4, 0x99, 0x70, 0xd4,
-	0x27, 0xa8, 0x57, 0x0d, 0x98, 0x82, 0x04, 0x64, 0x77, 0xd4, 0x27, 0x54, 0xab, 0xea, 0x17, 0xb0,
-	0x1a, 0x61, 0x47, 0x35, 0x17, 0xc6, 0x3d, 0x6f, 0x10, 0x6a, 0x25, 0x14, 0x74, 0x65, 0xcc, 0x7c,
-	0xef, 0xf2, 0xba, 0xea, 0x4e, 0xee, 0x57, 0x34, 0x82, 0x69, 0x4f, 0xd2, 0xe6, 0xb1, 0xcb, 0x18,
-	0xd0, 0x7c, 0x13, 0xb1, 0xa7, 0x37, 0x20, 0x18, 0x97, 0x27, 0x63, 0x1c, 0x9d, 0x44, 0x1f, 0x44,
-	0x2c, 0xf7, 0xe0, 0x82, 0x45, 0xba, 0xc6, 0xc0, 0x91, 0x2c, 0x00, 0xf5, 0x21, 0x78, 0xcf, 0x4e,
-	0xc6, 0x7b, 0x95, 0x73, 0x11, 0xd6, 0xb2, 0x6b, 0x04, 0x87, 0x62, 0x8f, 0x97, 0x60, 0x36, 0x08,
-	0x0d, 0x3f, 0x8c, 0x52, 0x18, 0x8b, 0x32, 0x65, 0x5c, 0x14, 0x29, 0xeb, 0x2a, 0xa8, 0x8e, 0x11,
-	0x84, 0xdc, 0x1c, 0x50, 0x04, 0xdb, 0xd2, 0x6a, 0x88, 0x39, 0x47, 0x21, 0x78, 0x5d, 0x94, 0x6d,
-	0xcb, 0x52, 0x5f, 0x83, 0x79, 0x44, 0xee, 0xda, 0x7e, 0x44, 0x62, 0x5b, 0x9a, 0xca, 0x0a, 0x03,
-	0x0a, 0xba, 0x4f, 0x21, 0x48, 0xd2, 0xb2, 0x68, 0xb4, 0x43, 0xf4, 0xbe, 0xef, 0x99, 0x24, 0x08,
-	0x88, 0xc5, 0x2d, 0x67, 0x9e, 0x45, 0x3b, 0x0a, 0x7b, 0x28, 0x40, 0xcc, 0x2a, 0xfe, 0x07, 0x80,
-	0x89, 0x8c, 0xf9, 0x7c, 0x61, 0xc2, 0x7c, 0x5e, 0x44, 0x1a, 0xcc, 0xe3, 0x6d, 0x40, 0x31, 0x3a,
-	0x72, 0x89, 0xb1, 0x38, 0x21, 0x9b, 0x0a, 0xa5, 0xfc, 0x24, 0x2e, 0x33, 0xb6, 0x60, 0x31, 0x79,
-	0x37, 0x42, 0x8f, 0x4b, 0xac, 0x72, 0x7a, 0x22, 0xe9, 0x5c, 0xa8, 0xf3, 0x6d, 0x58, 0x49, 0xd2,
-	0x04, 0xe6, 0x01, 0xb1, 0x06, 0x0e, 0x86, 0x83, 0x65, 0xe6, 0x63, 0x32, 0xdd, 0x0e, 0x07, 0xb7,
-	0x2c, 0xf5, 0x4d, 0xd0, 0x52, 0xa4, 0xf4, 0x54, 0xcc, 0x9b, 0x35, 0xa4, 0x5c, 0x4c, 0x50, 0x32,
-	0x68, 0xcb, 0x52, 0x77, 0xd2, 0x72, 0x0a, 0x1b, 0x5a, 0x99, 0xcc, 0x86, 0x12, 0x07, 0x11, 0xc6,
-	0x33, 0x76, 0x78, 0x23, 0xa4, 0x8e, 0x1e, 0x6a, 0xab, 0x58, 0xd7, 0x25, 0x68, 0x6e, 0x33, 0x50,
-	0xc2, 0x0d, 0x13, 0x27, 0xc0, 0x6b, 0x38, 0x37, 0xe1, 0x35, 0x2c, 0x67, 0x9c, 0x12, 0xef, 0xc3,
-	0x80, 0xf3, 0xd9, 0xba, 0xe5, 0x1b, 0x9c, 0x9f, 0x70, 0x83, 0x95, 0xac, 0x0b, 0x60, 0x5b, 0x5c,
-	0x81, 0xaa, 0x69, 0xb8, 0x26, 0x71, 0x3a, 0x3e, 0x79, 0x3c, 0x20, 0x41, 0x48, 0x2c, 0xed, 0xc2,
-	0xba, 0xb2, 0x51, 0xd0, 0xe7, 0xd8, 0xba, 0x2e, 0x96, 0x55, 0x1f, 0x2e, 0x27, 0xa5, 0xf1, 0x7c,
-	0x7b, 0xdf, 0x76, 0x0d, 0x27, 0x2d, 0x56, 0x7d, 0x42, 0xb1, 0x2e, 0xca, 0x62, 0x7d, 0xc4, 0x99,
-	0x25, 0xc5, 0x1b, 0x33, 0x11, 0x2e, 0x25, 0x35, 0x91, 0x35, 0x8c, 0x8d, 0x09, 0x13, 0xe1, 0xc2,
-	0xb6, 0x2c, 0xf5, 0x55, 0xa8, 0x25, 0xcf, 0x45, 0x29, 0xd6, 0x91, 0x22, 0x79, 0x30, 0x86, 0x1b,
-	0x84, 0xb6, 0x79, 0x38, 0xea, 0x48, 0x01, 0xfa, 0x22, 0xc3, 0x65, 0x80, 0xdd, 0x28, 0x4c, 0xef,
-	0xc3, 0x3a, 0xc7, 0x8d, 0xec, 0x3c, 0xf4, 0x3a, 0xb1, 0x0b, 0x53, 0x2b, 0x6c, 0x4c, 0x66, 0x85,
-	0xe7, 0x19, 0x23, 0x71, 0xe0, 0x5d, 0x6f, 0x47, 0x38, 0x35, 0x35, 0x47, 0x0d, 0xf2, 0xc2, 0x00,
-	0x5f, 0x62, 0x0d, 0x11, 0x7f, 0x55, 0x3f, 0x81, 0x25, 0x9f, 0x84, 0xfe, 0xa8, 0xc3, 0x52, 0x9d,
-	0xd3, 0xb1, 0xdd, 0x90, 0xf8, 0x43, 0xc3, 0xd1, 0x2e, 0x4d, 0xb6, 0xf1, 0x02, 0x92, 0xb7, 0x18,
-	0x75, 0x8b, 0x13, 0xc7, 0x6c, 0x7b, 0xc6, 0x53, 0xbb, 0x37, 0xe8, 0xc5, 0x6c, 0x2f, 0x9f, 0x86,
-	0xed, 0x87, 0x8c, 0x3a, 0x62, 0x7b, 0x2b, 0xcd, 0x96, 0x1f, 0x23, 0xd0, 0x5e, 0xc6, 0x63, 0x25,
-	0xa8, 0xb8, 0x5f, 0x05, 0xea, 0x3b, 0xb4, 0x78, 0xa5, 0x54, 0x7b, 0x86, 0x79, 0xe8, 0x75, 0xbb,
-	0x1d, 0xd3, 0x23, 0xdd, 0xae, 0x6d, 0xda, 0x34, 0x9a, 0xbe, 0xb2, 0xae, 0x6c, 0x28, 0xfa, 0x32,
-	0x22, 0xdc, 0x61, 0xf0, 0xed, 0x18, 0xac, 0xf6, 0xa0, 0x91, 0x91, 0x1b, 0xc9, 0xd3, 0xbe, 0xcd,
-	0xc4, 0x65, 0x46, 0xba, 0x31, 0xa1, 0x91, 0xae, 0x8d, 0x25, 0xc9, 0x7b, 0x11, 0x27, 0xde, 0x48,
-	0xad, 0x31, 0x51, 0x5d, 0xcf, 0xed, 0xe0, 0x93, 0xb1, 0xe7, 0x90, 0x0e, 0xf1, 0x7d, 0xcf, 0xc7,
-	0x4c, 0x1e, 0x68, 0x57, 0xd6, 0xa7, 0x36, 0x8a, 0xfa, 0x39, 0x04, 0x3e, 0xf0, 0x5c, 0x5d, 0x20,
-	0xdd, 0xa3, 0x38, 0x34, 0xa7, 0x07, 0xea, 0x06, 0x54, 0x0f, 0x8c, 0x80, 0xd1, 0x77, 0xfa, 0x9e,
-	0x63, 0x9b, 0x23, 0xed, 0x55, 0xf4, 0xc3, 0xca, 0x81, 0x11, 0x20, 0xc5, 0x43, 0x5c, 0xa5, 0x49,
-	0xce, 0xf4, 0x3d, 0x37, 0xb2, 0x3f, 0xed, 0x2a, 0x5a, 0x6a, 0x99, 0x2e, 0x0a, 0x5b, 0xa2, 0xc5,
-	0x51, 0x60, 0xef, 0x53, 0xdf, 0x34, 0xbd, 0x81, 0x1b, 0x6a, 0x4d, 0x56, 0x1c, 0xb1, 0xb5, 0x6d,
-	0xba, 0xa4, 0x5e, 0x86, 0x32, 0xaf, 0x5d, 0x3a, 0x81, 0xfd, 0x25, 0xd1, 0x36, 0x29, 0xca, 0x9d,
-	0xb3, 0x9a, 0xa2, 0x97, 0xf8, 0xfa, 0x8e, 0xfd, 0x25, 0x6d, 0x3d, 0x6b, 0xc6, 0x20, 0xf4, 0x3a,
-	0x3e, 0x09, 0x48, 0xd8, 0xe9, 0x7b, 0xb6, 0x1b, 0x06, 0xda, 0xcd, 0xac, 0x4a, 0x28, 0x9a, 0x1b,
-	0x0c, 0x6f, 0x34, 0x75, 0x8a, 0xfd, 0x10, 0x91, 0xf5, 0x39, 0x4a, 0x2f, 0x2d, 0xa8, 0xff, 0x0f,
-	0xb5, 0x80, 0x18, 0xbe, 0x79, 0x40, 0x6d, 0xc1, 0xb7, 0xf7, 0x06, 0x21, 0x09, 0xb4, 0x5b, 0xd8,
-	0x91, 0x7c, 0x34, 0x49, 0x47, 0x92, 0x59, 0xd5, 0x36, 0x77, 0x90, 0xe5, 0xed, 0x88, 0x23, 0xeb,
-	0x4b, 0xaa, 0x41, 0x6a, 0x59, 0x7d, 0x04, 0xb9, 0x1e, 0xe9, 0x79, 0xda, 0xeb, 0xb8, 0xe1, 0xf6,
-	0xf3, 0x6f, 0xf8, 0x21, 0xe9, 0x79, 0x6c, 0x13, 0x64, 0xa8, 0x7e, 0x01, 0x35, 0x9e, 0x2f, 0x3b,
-	0x4c, 0x81, 0x36, 0x09, 0xb4, 0x37, 0x50, 0x53, 0xd7, 0x33, 0x77, 0x91, 0x4a, 0x47, 0x9e, 0x4d,
-	0xdf, 0x17, 0x74, 0x7a, 0x75, 0x98, 0x5a, 0x51, 0x6f, 0xc2, 0x12, 0xaf, 0x42, 0x22, 0x9b, 0xe6,
-	0xc5, 0xf1, 0x9b, 0x68, 0x00, 0xf3, 0x08, 0x8d, 0x44, 0x64, 0x45, 0xf2, 0xff, 0xc2, 0x5c, 0x8c,
-	0x1e, 0x84, 0x46, 0x18, 0x68, 0x6f, 0xa1, 0x44, 0x5b, 0x93, 0x9c, 0x3b, 0x62, 0xb6, 0x43, 0x29,
-	0xf5, 0x0a, 0x49, 0xbc, 0x27, 0xd2, 0x13, 0x15, 0x25, 0xed, 0x62, 0x6f, 0x9f, 0x36, 0x3d, 0xe9,
-	0x83, 0x94, 0x73, 0xad, 0x5a, 0xb0, 0x98, 0x79, 0xaf, 0x19, 0x9d, 0xd0, 0xeb, 0xc9, 0xe6, 0x6d,
-	0x2d, 0x69, 0x9c, 0x7c, 0xfe, 0x35, 0xbc, 0xd1, 0x7c, 0x68, 0x8c, 0x1c, 0xcf, 0xb0, 0xe4, 0xae,
-	0xeb, 0x33, 0x28, 0x46, 0x97, 0xf9, 0x93, 0x72, 0x6e, 0xe7, 0x0a, 0x73, 0xd5, 0x6a, 0x3b, 0x57,
-	0xa8, 0x56, 0x6b, 0xed, 0x5c, 0xe1, 0x5a, 0xf5, 0xb5, 0x76, 0xae, 0xf0, 0x5a, 0xb5, 0xd9, 0xce,
-	0x15, 0xae, 0x57, 0x6f, 0xb4, 0x73, 0x85, 0x1b, 0xd5, 0xad, 0x76, 0xae, 0xb0, 0x55, 0xbd, 0xd9,
-	0xb8, 0x09, 0x95, 0xa4, 0xba, 0xa9, 0x0f, 0x27, 0x1c, 0x54, 0x61, 0x3e, 0x2c, 0x39, 0x67, 0xe3,
-	0x5f, 0x0a, 0x2c, 0x8d, 0x19, 0x27, 0xa5, 0x26, 0x98, 0x00, 0x7d, 0x42, 0x4b, 0x42, 0x29, 0x01,
-	0x2a, 0x3c, 0x01, 0x22, 0x20, 0x4e, 0x80, 0x8b, 0x30, 0xc3, 0x4d, 0x89, 0x35, 0x7a, 0xd3, 0x3e,
-	0x1a, 0x4f, 0x1b, 0xa6, 0xa9, 0xc9, 0x10, 0xec, 0xea, 0x2a, 0x5b, 0xb7, 0x32, 0x4d, 0x06, 0x27,
-	0x81, 0x99, 0x4e, 0x82, 0x72, 0xe8, 0x8c, 0x85, 0x7a, 0x1f, 0x66, 0xe8, 0xc3, 0x20, 0xc0, 0x9e,
-	0xaf, 0xb2, 0xd5, 0x4c, 0x2a, 0xf1, 0x64, 0x2e, 0x83, 0x40, 0xe7, 0xd4, 0x8d, 0xbf, 0xe4, 0xa0,
-	0x2a, 0xe6, 0x02, 0x58, 0xa3, 0xff, 0x54, 0x0d, 0x6d, 0xac, 0x83, 0x29, 0x59, 0x07, 0xdb, 0x50,
-	0x64, 0x15, 0xe6, 0xa8, 0x4f, 0xb8, 0xe8, 0x2f, 0x9f, 0xac, 0x07, 0xac, 0x29, 0x47, 0x7d, 0xa2,
-	0x17, 0x42, 0xfe, 0x44, 0x9b, 0xe5, 0xd0, 0xf0, 0xf7, 0x49, 0xaa, 0x59, 0x66, 0x4d, 0x6d, 0x8d,
-	0x81, 0x52, 0xcd, 0x32, 0xc7, 0x97, 0x65, 0x9e, 0x61, 0xbd, 0x20, 0x83, 0x24, 0x9b, 0x65, 0x8e,
-	0xcd, 0x0f, 0x90, 0x67, 0xc7, 0x67, 0x8b, 0x2c, 0x0e, 0x24, 0x9b, 0xcf, 0x42, 0xba, 0xf9, 0x7c,
-	0x17, 0x56, 0x39, 0x0b, 0xf3, 0xc0, 0x76, 0xac, 0x78, 0x5b, 0xcf, 0x75, 0x46, 0xd8, 0xab, 0x16,
-	0xf4, 0x65, 0x86, 0xb1, 0x4d, 0x11, 0xc4, 0xee, 0x1f, 0xb9, 0xce, 0x88, 0xaa, 0x56, 0xae, 0xf9,
-	0x01, 0xcd, 0x14, 0x82, 0xb8, 0xce, 0xd7, 0x20, 0x2f, 0x1a, 0x89, 0x12, 0x1b, 0xe0, 0xf2, 0x57,
-	0x75, 0x19, 0xf2, 0xa2, 0x01, 0x2b, 0x23, 0x64, 0x26, 0x64, 0x7d, 0x57, 0x0b, 0xe6, 0xa4, 0xb1,
-	0x11, 0x46, 0x93, 0xd9, 0x49, 0x9b, 0x9a, 0x98, 0x10, 0xf3, 0xf3, 0x55, 0xa8, 0xf9, 0xc4, 0xf4,
-	0x7c, 0xab, 0x13, 0x03, 0xb0, 0x31, 0x2c, 0xe8, 0x55, 0x06, 0xf8, 0x34, 0x5a, 0x6f, 0xfc, 0x71,
-	0x0a, 0xe6, 0xa5, 0x01, 0xcc, 0xcf, 0xc6, 0xc2, 0x24, 0x15, 0x4f, 0x27, 0x55, 0x7c, 0x09, 0x2a,
-	0xa9, 0xe6, 0x95, 0x0d, 0x4a, 0xca, 0x5d, 0xb9, 0x71, 0x6d, 0xc0, 0xac, 0x4b, 0x9e, 0x4a, 0x48,
-	0x6c, 0x3a, 0x52, 0xa2, 0x8b, 0x02, 0x87, 0xd6, 0x14, 0x51, 0xa1, 0x6f, 0x5b, 0x68, 0x45, 0xb4,
-	0xa6, 0x10, 0x6b, 0x0c, 0x65, 0xcf, 0x37, 0x5c, 0xf3, 0xa0, 0x13, 0x7a, 0x87, 0x84, 0x5d, 0x77,
-	0x59, 0x2f, 0xb1, 0xb5, 0x5d, 0xba, 0xa4, 0x6e, 0xc2, 0x82, 0x4b, 0x58, 0xbe, 0x48, 0xa0, 0xce,
-	0x22, 0x6a, 0xcd, 0x25, 0x34, 0x0b, 0xdc, 0x91, 0x08, 0x24, 0x1b, 0x99, 0x93, 0x6d, 0xa4, 0x9d,
-	0x2b, 0x14, 0xab, 0xd0, 0xce, 0x15, 0xa0, 0x5a, 0x6a, 0xe7, 0x0a, 0xe5, 0xea, 0x6c, 0x3b, 0x57,
-	0xa8, 0x54, 0xe7, 0x1a, 0xbf, 0x3f, 0x0b, 0x6a, 0x7c, 0xa5, 0xff, 0x05, 0x57, 0x28, 0x69, 0x60,
-	0xe6, 0x59, 0x5e, 0x92, 0x7f, 0x3e, 0x2f, 0x69, 0xfc, 0x26, 0x07, 0xb3, 0x38, 0x23, 0xfd, 0xd9,
-	0xe8, 0xeb, 0x1e, 0x94, 0x79, 0xc3, 0xc5, 0xf8, 0x4c, 0x23, 0x9f, 0xc6, 0x31, 0x79, 0x85, 0xb7,
-	0x55, 0xc8, 0xa3, 0x14, 0xc6, 0x2f, 0x2a, 0x91, 0xda, 0x7e, 0xd1, 0x6c, 0x20, 0xbf, 0x19, 0xe4,
-	0x77, 0x63, 0xb2, 0xa4, 0xc7, 0xdb, 0x10, 0x64, 0x1f, 0x4d, 0x0a, 0xa4, 0x45, 0xf9, 0x76, 0xf3,
-	0xc9, 0xdb, 0xbd, 0x02, 0xd5, 0x28, 0x7c, 0x8a, 0x8e, 0xaf, 0x80, 0xad, 0xd1, 0x9c, 0x58, 0x17,
-	0xe3, 0x86, 0x15, 0x28, 0x44, 0x0e, 0xca, 0xbe, 0xce, 0xe4, 0x09, 0x77, 0x4e, 0xc9, 0x46, 0xe0,
-	0x59, 0x36, 0x52, 0x7a, 0x4e, 0x1b, 0xf9, 0xf5, 0x1c, 0x94, 0x6f, 0x9b, 0xa1, 0x3d, 0xb4, 0xc3,
-	0x11, 0x9a, 0x88, 0x74, 0x28, 0x25, 0x79, 0xa8, 0x37, 0x41, 0x8b, 0x63, 0x45, 0x6a, 0xf0, 0xca,
-	0x26, 0xd5, 0x8b, 0x11, 0x3c, 0x31, 0x77, 0x7d, 0x00, 0x73, 0x29, 0x42, 0x34, 0x9d, 0x89, 0xc7,
-	0xae, 0x95, 0x24, 0x5b, 0xf5, 0x3d, 0xa8, 0xa4, 0xa6, 0x13, 0xb9, 0x09, 0x4f, 0x3f, 0x1b, 0x24,
-	0x26, 0x11, 0x17, 0xf8, 0xa0, 0x8e, 0xc5, 0x3e, 0xe6, 0xa1, 0xc5, 0x20, 0x1a, 0x49, 0xb5, 0xf9,
-	0xe8, 0x31, 0x92, 0x7a, 0xe6, 0x34, 0x52, 0x97, 0x39, 0x2d, 0x93, 0x79, 0x1b, 0xca, 0x89, 0x39,
-	0xd2, 0xa4, 0x3e, 0x5d, 0x0a, 0xa4, 0xd9, 0xd1, 0x1a, 0x94, 0x0c, 0x7e, 0x57, 0x22, 0x58, 0x17,
-	0x75, 0x10, 0x4b, 0xac, 0x24, 0x90, 0x2a, 0x43, 0x3e, 0x8f, 0xf6, 0xa3, 0x9a, 0xf0, 0x73, 0x58,
-	0x39, 0x7e, 0xc2, 0x01, 0x93, 0x4d, 0x04, 0x96, 0x82, 0xec, 0xd9, 0x46, 0x8a, 0xb7, 0xe9, 0x78,
-	0x01, 0x39, 0xed, 0xf0, 0x5a, 0xe2, 0xbd, 0x4d, 0xe9, 0x05, 0xef, 0x5d, 0x58, 0xe2, 0xb2, 0xa6,
-	0x19, 0x4f, 0x38, 0xbc, 0x9e, 0x67, 0xb3, 0xd5, 0x24, 0xd7, 0x0f, 0xa0, 0x76, 0x40, 0x0c, 0x3f,
-	0xdc, 0x23, 0x46, 0x78, 0xda, 0x89, 0x75, 0x35, 0xa2, 0x14, 0xdc, 0xb2, 0x86, 0x6e, 0x95, 0xec,
-	0xa1, 0x5b, 0xe6, 0x1c, 0x8b, 0xe5, 0xc1, 0xac, 0x39, 0x16, 0xfb, 0xf2, 0x29, 0x46, 0x91, 0xb4,
-	0xdc, 0xae, 0xb2, 0x50, 0x12, 0x8a, 0xd8, 0xce, 0xea, 0x69, 0x79, 0xbc, 0x54, 0x4b, 0x8e, 0x97,
-	0x92, 0xa5, 0xa2, 0x9a, 0x2e, 0x15, 0x69, 0xb8, 0x8a, 0xfc, 0x80, 0xb8, 0x21, 0xad, 0xa6, 0xe6,
-	0xc5, 0xac, 0x8c, 0x7b, 0x03, 0x5b, 0xce, 0x9c, 0x69, 0x2c, 0x64, 0xce, 0x34, 0x8e, 0x1f, 0x69,
-	0x2d, 0xbe, 0x98, 0x91, 0xd6, 0xd2, 0x8b, 0x19, 0x69, 0x2d, 0x9f, 0x30, 0xd2, 0xda, 0x85, 0x45,
-	0x46, 0x95, 0x6e, 0x93, 0xb5, 0x09, 0xdd, 0x7b, 0x1e, 0xc9, 0x53, 0xd3, 0xa7, 0x13, 0x07, 0x65,
-	0x2b, 0x27, 0x0f, 0xca, 0x26, 0x98, 0x5c, 0xad, 0x3e, 0x7b, 0x72, 0xf5, 0x00, 0x54, 0xc6, 0x85,
-	0x7d, 0x28, 0x61, 0x7f, 0xbb, 0xf0, 0xd9, 0xf7, 0x7a, 0x32, 0xfc, 0x71, 0x20, 0x0d, 0x7f, 0xf7,
-	0xd9, 0x23, 0x2d, 0xc1, 0x43, 0x7f, 0xf4, 0x81, 0x11, 0x84, 0x7c, 0x85, 0xf6, 0x22, 0x12, 0x3f,
-	0x9a, 0x4b, 0x89, 0x1f, 0x9b, 0xda, 0x79, 0x34, 0xb5, 0xe5, 0x88, 0xea, 0x11, 0xc2, 0x23, 0x93,
-	0x4b, 0x17, 0x2d, 0x17, 0x32, 0x8b, 0x16, 0xb9, 0x5d, 0xa9, 0x8f, 0xb5, 0x2b, 0x9f, 0xc2, 0x12,
-	0x6e, 0x1d, 0x3b, 0xbc, 0x45, 0x42, 0xc3, 0x76, 0x02, 0x9c, 0x38, 0x8f, 0x1d, 0x6a, 0xac, 0xff,
-	0x0f, 0x74, 0xfc, 0x08, 0xf4, 0xbe, 0x20, 0xbf, 0xcb, 0xa8, 0xd5, 0x2f, 0x60, 0x35, 0xc5, 0x57,
-	0xfe, 0x66, 0xb3, 0x3e, 0xe9, 0xc7, 0x82, 0x04, 0xef, 0xf8, 0xe3, 0x4d, 0xe3, 0x4f, 0x0a, 0x14,
-	0xb1, 0x82, 0x7b, 0x46, 0x6a, 0x4e, 0x26, 0xb2, 0xb3, 0xe9, 0x44, 0x76, 0x1b, 0x4a, 0x68, 0xa0,
-	0xbc, 0x56, 0x98, 0x9a, 0xf4, 0x6f, 0x15, 0x46, 0x24, 0x52, 0x8f, 0x1c, 0x81, 0xd8, 0x6f, 0x37,
-	0x18, 0x54, 0x78, 0xf0, 0x59, 0x81, 0x02, 0x0b, 0x54, 0x51, 0x13, 0x9c, 0xc7, 0xf7, 0x96, 0xd5,
-	0xf8, 0x67, 0x0e, 0x54, 0x6c, 0x31, 0x93, 0x9f, 0xac, 0x4f, 0xac, 0x34, 0xe2, 0xcf, 0xc0, 0xd9,
-	0x95, 0x46, 0x04, 0x4f, 0x54, 0x1a, 0x49, 0x3d, 0x4c, 0xa5, 0xf5, 0xf0, 0x00, 0xe6, 0x52, 0x7c,
-	0x79, 0xe5, 0x30, 0x69, 0x21, 0x92, 0xdc, 0x55, 0x6d, 0xc2, 0xbc, 0xd8, 0x4e, 0xae, 0x99, 0xf9,
-	0x0c, 0x80, 0x83, 0xa4, 0xae, 0xfe, 0x12, 0x54, 0x04, 0x3e, 0x2f, 0xa1, 0x59, 0xff, 0x2f, 0x4a,
-	0x03, 0x9d, 0x8f, 0x68, 0x52, 0x65, 0x47, 0xfe, 0xf9, 0xcb, 0x8e, 0xcc, 0x89, 0x51, 0x21, 0x7b,
-	0x62, 0x74, 0x1e, 0x8a, 0x91, 0x4f, 0x89, 0xda, 0x21, 0x5a, 0x38, 0xe5, 0xb7, 0xec, 0xcf, 0xa2,
-	0x5f, 0x09, 0x58, 0xbe, 0xe6, 0x99, 0xa2, 0x84, 0xf5, 0xf7, 0xc6, 0x31, 0xf5, 0xfc, 0x43, 0xa4,
-	0xc0, 0x1c, 0xcd, 0x72, 0x88, 0xf8, 0xe9, 0x40, 0x5a, 0x1a, 0xfb, 0x45, 0xa0, 0x3c, 0xf6, 0x8b,
-	0x40, 0xe3, 0x0f, 0x0a, 0xd4, 0xf8, 0xb1, 0xb6, 0x31, 0x9d, 0xbe, 0x28, 0x73, 0xcb, 0x4c, 0xe4,
-	0x53, 0xd9, 0x1f, 0xa4, 0xd2, 0x72, 0xe7, 0xc6, 0xe5, 0xfe, 0xfa, 0x2c, 0xc0, 0x0e, 0x4e, 0xf3,
-	0x5f, 0xa0, 0x7f, 0x8c, 0x49, 0x2a, 0xd5, 0x87, 0x2a, 0xe4, 0xf0, 0x56, 0xd9, 0x2f, 0x1c, 0xf8,
-	0xac, 0xbe, 0x01, 0xd3, 0xb6, 0xdb, 0x1f, 0x84, 0x68, 0xd5, 0x93, 0x04, 0x4a, 0x86, 0x4e, 0xa5,
-	0x37, 0x3d, 0x37, 0xf4, 0x3d, 0x87, 0x1b, 0xb9, 0x78, 0x1d, 0xd3, 0x44, 0x7e, 0x5c, 0x13, 0x5f,
-	0x29, 0x50, 0xd8, 0x3e, 0x20, 0xe6, 0x61, 0x30, 0xe8, 0xa5, 0xf5, 0x30, 0x1d, 0xeb, 0xe1, 0x2e,
-	0xcc, 0x74, 0x1d, 0x63, 0xe8, 0xf9, 0x78, 0xea, 0xca, 0xd6, 0xb5, 0x93, 0x1b, 0x3b, 0xc1, 0xf1,
-	0x3e, 0xd2, 0xe8, 0x9c, 0x36, 0xfe, 0xdd, 0x66, 0x0a, 0xc7, 0x15, 0xec, 0xe5, 0xce, 0xff, 0x7d,
-	0xfb, 0x7d, 0xfd, 0xcc, 0x77, 0xdf, 0xd7, 0xcf, 0xfc, 0xf8, 0x7d, 0x5d, 0xf9, 0xea, 0xa8, 0xae,
-	0xfc, 0xf6, 0xa8, 0xae, 0xfc, 0xf9, 0xa8, 0xae, 0x7c, 0x7b, 0x54, 0x57, 0xfe, 0x7e, 0x54, 0x57,
-	0xfe, 0x71, 0x54, 0x3f, 0xf3, 0xe3, 0x51, 0x5d, 0xf9, 0xe6, 0x87, 0xfa, 0x99, 0x6f, 0x7f, 0xa8,
-	0x9f, 0xf9, 0xee, 0x87, 0xfa, 0x99, 0xcf, 0x6f, 0xed, 0x7b, 0xb1, 0x0c, 0xb6, 0x77, 0xfc, 0x5f,
-	0xb5, 0xef, 0x4a, 0xaf, 0x7b, 0x33, 0x18, 0x82, 0x6f, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xcc,
-	0x5f, 0x50, 0xe1, 0x8e, 0x2b, 0x00, 0x00,
+	// 3701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x4d, 0x73, 0x1b, 0x47,
+	0x76, 0x82, 0x08, 0x92, 0xc0, 0x03, 0x09, 0x82, 0xc3, 0xaf, 0x21, 0x2d, 0x81, 0x34, 0x6c, 0x79,
+	0xa9, 0xb5, 0x0c, 0x5a, 0x94, 0xd6, 0x9f, 0x49, 0x36, 0x22, 0x25, 0xad, 0xc1, 0x92, 0x65, 0xed,
+	0x90, 0x6b, 0x6d, 0x6d, 0x6a, 0x6b, 0x6a, 0x38, 0xd3, 0x20, 0x27, 0x1c, 0xcc, 0xc0, 0xdd, 0x0d,
+	0x52, 0x70, 0xe5, 0xb0, 0x87, 0xad, 0xec, 0x75, 0x2f, 0xa9, 0x4a, 0xe5, 0x96, 0xca, 0x25, 0xe7,
+	0x54, 0x25, 0xe7, 0xa4, 0x72, 0xc9, 0xd1, 0xc7, 0x3d, 0xa4, 0x2a, 0xb1, 0x7c, 0xc9, 0x65, 0x2b,
+	0xfb, 0x13, 0x52, 0xfd, 0xba, 0x7b, 0xbe, 0x30, 0xa4, 0x40, 0xc6, 0x3e, 0x78, 0x6f, 0x98, 0xf7,
+	0xd5, 0xaf, 0xbb, 0x5f, 0xbf, 0xaf, 0x6e, 0xc0, 0x3d, 0x4e, 0x7a, 0xfd, 0x88, 0x3a, 0xc1, 0x16,
+	0x23, 0xf4, 0x94, 0xd0, 0x2d, 0xa7, 0xef, 0x6f, 0xf5, 0x09, 0x65, 0x3e, 0xe3, 0x24, 0x74, 0xc9,
+	0xd6, 0xe9, 0xdd, 0x2d, 0xf2, 0x82, 0xb8, 0x03, 0xee, 0x47, 0x21, 0x6b, 0xf7, 0x69, 0xc4, 0x23,
+	0xa3, 0xa5, 0x99, 0xda, 0x92, 0xa9, 0xed, 0xf4, 0xfd, 0x76, 0x8a, 0xa9, 0x7d, 0x7a, 0x77, 0xad,
+	0x79, 0x14, 0x45, 0x47, 0x01, 0xd9, 0x42, 0x8e, 0xc3, 0x41, 0x77, 0xcb, 0x1b, 0x50, 0x47, 0x08,
+	0x91, 0x32, 0xd6, 0xd6, 0xf3, 0x78, 0xee, 0xf7, 0x08, 0xe3, 0x4e, 0xaf, 0xaf, 0x08, 0x46, 0x04,
+	0x9c, 0x51, 0xa7, 0x2f, 0x06, 0x51, 0xf8, 0xd7, 0x3d, 0xd2, 0x27, 0xa1, 0x47, 0x42, 0xd7, 0x27,
+	0x6c, 0xeb, 0x28, 0x3a, 0x8a, 0x10, 0x8e, 0xbf, 0x14, 0xc9, 0x9b, 0xf1, 0xe4, 0xc4, 0xac, 0xdc,
+	0xa8, 0xd7, 0x8b, 0x42, 0x31, 0xa1, 0x1e, 0x61, 0xcc, 0x39, 0x22, 0x85, 0x54, 0x24, 0x1c, 0xf4,
+	0x98, 0x20, 0x3a, 0x8b, 0xe8, 0x49, 0x37, 0x88, 0xce, 0x14, 0xd5, 0xad, 0x0c, 0x55, 0xd7, 0xf1,
+	0x83, 0x01, 0x25, 0xa3, 0xc2, 0xb2, 0x64, 0xc7, 0x3e, 0xe3, 0x11, 0x1d, 0x8e, 0x92, 0xbd, 0x95,
+	0x21, 0xd3, 0x43, 0x8d, 0xd2, 0xdd, 0x2e, 0xda, 0x9e, 0x58, 0x45, 0x39, 0x23, 0x45, 0xfa, 0xf6,
+	0x85, 0xa4, 0xb9, 0xd9, 0xfc, 0xe0, 0x42, 0x62, 0xee, 0xb0, 0x13, 0x45, 0x78, 0xa7, 0x88, 0xf0,
+	0xbc, 0x69, 0xb5, 0xfe, 0xa1, 0x06, 0xd5, 0xfd, 0x63, 0x87, 0x7a, 0x9d, 0xb0, 0x1b, 0x19, 0xab,
+	0x50, 0x61, 0xe2, 0xc3, 0xf6, 0x3d, 0xb3, 0xb4, 0x51, 0xda, 0x9c, 0xb4, 0xa6, 0xf1, 0xbb, 0xe3,
+	0x09, 0x14, 0x75, 0xc2, 0x23, 0x22, 0x50, 0xd7, 0x37, 0x4a, 0x9b, 0x13, 0xd6, 0x34, 0x7e, 0x77,
+	0x3c, 0x63, 0x11, 0x26, 0xa3, 0xb3, 0x90, 0x50, 0x73, 0x62, 0xa3, 0xb4, 0x59, 0xb5, 0xe4, 0x87,
+	0xb1, 0x0d, 0x4b, 0x94, 0xf4, 0x03, 0xdf, 0x45, 0x1b, 0xb2, 0x1d, 0xf7, 0xc4, 0x0e, 0xc8, 0x29,
+	0x09, 0xcc, 0x32, 0x72, 0x2f, 0xa4, 0x90, 0x0f, 0xdc, 0x93, 0x27, 0x02, 0x65, 0xdc, 0x01, 0x83,
+	0x53, 0x27, 0x64, 0x5d, 0x42, 0x53, 0x0c, 0x93, 0xc8, 0xd0, 0xd0, 0x98, 0x34, 0x35, 0xe3, 0x51,
+	0x40, 0x42, 0x9b, 0xf9, 0xa1, 0x4b, 0x6c, 0x4a, 0x42, 0x72, 0x66, 0x4e, 0xa1, 0xde, 0x0d, 0x89,
+	0xd9, 0x17, 0x08, 0x4b, 0xc0, 0x8d, 0x07, 0x50, 0x1b, 0xf4, 0x3d, 0x87, 0x13, 0x5b, 0xd8, 0xad,
+	0x39, 0xbd, 0x51, 0xda, 0xac, 0x6d, 0xaf, 0xb5, 0xa5, 0xcd, 0xb6, 0xb5, 0xcd, 0xb6, 0x0f, 0xb4,
+	0x51, 0xef, 0x94, 0x7f, 0xfb, 0x5f, 0xeb, 0x25, 0x0b, 0x24, 0x93, 0x00, 0x1b, 0x3f, 0x85, 0x45,
+	0xc1, 0x9b, 0xd2, 0x4d, 0xca, 0xaa, 0x8c, 0x29, 0x6b, 0x1e, 0xb9, 0xb5, 0xfe, 0x28, 0xf2, 0x21,
+	0x34, 0x43, 0xa7, 0x47, 0x58, 0xdf, 0x71, 0x89, 0x1d, 0x46, 0xdc, 0xef, 0xea, 0x05, 0x3b, 0x15,
+	0xa7, 0x33, 0x0a, 0xcd, 0x2a, 0xce, 0xfe, 0x46, 0x4c, 0xf5, 0x34, 0x45, 0xf4, 0xb9, 0xa4, 0x31,
+	0x7e, 0x53, 0x82, 0x35, 0x37, 0x18, 0x30, 0x4e, 0xa8, 0x5d, 0xb0, 0x80, 0xb0, 0x31, 0xb1, 0x59,
+	0xdb, 0xde, 0x6b, 0xbf, 0xda, 0x09, 0xb4, 0x63, 0x5b, 0x68, 0xef, 0x4a, 0x79, 0x07, 0xb9, 0x55,
+	0x7f, 0x14, 0x72, 0x3a, 0xb4, 0x56, 0xdc, 0x62, 0xac, 0xf1, 0xeb, 0x12, 0xac, 0xc4, 0x9a, 0x64,
+	0xd7, 0xca, 0xac, 0xa1, 0x1a, 0x3f, 0xb9, 0x9a, 0x1a, 0x7e, 0x2f, 0xa7, 0x83, 0x5a, 0xd3, 0x45,
+	0xb7, 0x80, 0xc0, 0xf8, 0xeb, 0x12, 0xac, 0x6a, 0x35, 0xd2, 0x56, 0x28, 0x15, 0x99, 0xf9, 0x7f,
+	0xac, 0x87, 0x95, 0x48, 0x2b, 0x58, 0x8f, 0x3c, 0x56, 0xac, 0xc7, 0x6a, 0x5a, 0x01, 0x2f, 0xf8,
+	0x22, 0xb5, 0x22, 0xb3, 0xa8, 0x48, 0xe7, 0x72, 0x8a, 0xa4, 0xc6, 0x78, 0x18, 0x7c, 0x91, 0xdd,
+	0x97, 0x65, 0x5a, 0x88, 0x34, 0xde, 0x85, 0xc5, 0x53, 0x9f, 0xf9, 0x87, 0x7e, 0xe0, 0xf3, 0x61,
+	0x4a, 0x81, 0x3a, 0x1a, 0x97, 0x91, 0xe0, 0x62, 0x8e, 0x5d, 0x68, 0x52, 0x22, 0x9c, 0x06, 0xf1,
+	0x6c, 0x37, 0x0a, 0x95, 0x2a, 0x43, 0xbb, 0xe7, 0xd0, 0x13, 0x42, 0x85, 0x17, 0x98, 0x43, 0xde,
+	0xd7, 0x34, 0xd5, 0x6e, 0x42, 0xf4, 0x29, 0xd2, 0x74, 0xbc, 0xb5, 0x3d, 0xb8, 0x71, 0x91, 0x19,
+	0x19, 0x0d, 0x98, 0x38, 0x21, 0x43, 0x74, 0x35, 0x55, 0x4b, 0xfc, 0x14, 0xbe, 0xe4, 0xd4, 0x09,
+	0x06, 0x44, 0xf9, 0x18, 0xf9, 0xf1, 0xd1, 0xf5, 0x0f, 0x4a, 0x6b, 0x2e, 0xac, 0x9e, 0x6b, 0x0b,
+	0x05, 0x82, 0xde, 0x4d, 0x0b, 0xba, 0xf0, 0x70, 0xa6, 0x07, 0x49, 0x14, 0x2e, 0xdc, 0xe7, 0x4b,
+	0x29, 0xdc, 0x81, 0xd7, 0x2e, 0xd8, 0xaa, 0xcb, 0x88, 0x6a, 0xfd, 0x4b, 0x0b, 0x96, 0x9e, 0xab,
+	0x78, 0xf0, 0x48, 0xc7, 0x76, 0xf4, 0xd8, 0xaf, 0xc3, 0x4c, 0xe2, 0x3f, 0x94, 0xd7, 0xae, 0x5a,
+	0xb5, 0x18, 0xd6, 0xf1, 0x8c, 0x75, 0xa8, 0xe9, 0x58, 0xa2, 0x9d, 0x77, 0xd5, 0x02, 0x0d, 0xea,
+	0x78, 0x46, 0x1b, 0x16, 0xfa, 0x0e, 0x25, 0x21, 0xb7, 0x33, 0xa2, 0xa4, 0x37, 0x9f, 0x97, 0xa8,
+	0xa7, 0x29, 0x81, 0x77, 0xc0, 0x50, 0xf4, 0x69, 0xb9, 0x65, 0x24, 0x6f, 0x48, 0xcc, 0xf3, 0x44,
+	0x7a, 0x0b, 0x66, 0x15, 0x35, 0x1d, 0x84, 0x82, 0x70, 0x52, 0xaa, 0x28, 0x81, 0xd6, 0x20, 0xec,
+	0x78, 0x62, 0x16, 0x7e, 0xe8, 0x73, 0xdf, 0xe1, 0x04, 0x63, 0xcf, 0x14, 0x2e, 0x40, 0x2d, 0x86,
+	0x75, 0x3c, 0xe3, 0x43, 0x58, 0x75, 0xa3, 0x5e, 0x3f, 0x20, 0x78, 0x8c, 0xc8, 0xa9, 0x10, 0x78,
+	0xe8, 0x70, 0xf7, 0x58, 0xd0, 0x4f, 0x23, 0xfd, 0x72, 0x42, 0xf0, 0x48, 0xe0, 0x77, 0x04, 0xba,
+	0xe3, 0x19, 0xcf, 0xa0, 0x91, 0x67, 0x55, 0x2e, 0xfb, 0x56, 0x72, 0xf2, 0xc4, 0x91, 0x53, 0x51,
+	0x52, 0x1c, 0xb7, 0x4f, 0xe4, 0x4f, 0x94, 0x63, 0xcd, 0xe5, 0x04, 0x1b, 0x37, 0x01, 0x44, 0xc4,
+	0xb5, 0xbf, 0x18, 0x90, 0x01, 0x41, 0x0f, 0x5d, 0xb5, 0xaa, 0x02, 0xf2, 0x53, 0x01, 0x10, 0x0b,
+	0x14, 0xaf, 0x0c, 0x1f, 0xf6, 0x09, 0xae, 0xab, 0x09, 0x72, 0x81, 0x34, 0xe6, 0x60, 0xd8, 0x27,
+	0x62, 0x55, 0x8d, 0x5f, 0xc2, 0x5a, 0x4c, 0x1d, 0x27, 0x6e, 0xe8, 0x3c, 0xa3, 0x01, 0x37, 0x6b,
+	0xa8, 0xe8, 0xea, 0x88, 0xf9, 0x3e, 0x54, 0xc9, 0xd9, 0x4e, 0xf9, 0x6f, 0x85, 0x1b, 0x34, 0xcf,
+	0xf2, 0xe6, 0x71, 0x20, 0x05, 0x88, 0xa0, 0x15, 0x8b, 0xa7, 0x83, 0x44, 0xf0, 0xcc, 0x78, 0x82,
+	0xe3, 0x99, 0x58, 0x83, 0x58, 0xe4, 0x21, 0xdc, 0xf4, 0x48, 0xd7, 0x19, 0x04, 0x29, 0x0b, 0xc0,
+	0xf5, 0xd0, 0xb2, 0x67, 0xc7, 0x93, 0xbd, 0xa6, 0xa4, 0x68, 0x6b, 0x39, 0x70, 0xd8, 0x89, 0x1e,
+	0xe3, 0x0d, 0x98, 0x65, 0xdc, 0xa1, 0x3c, 0x8e, 0x83, 0xd2, 0x55, 0xcd, 0x20, 0x50, 0xc7, 0xbd,
+	0xb7, 0xc1, 0x08, 0x1c, 0xc6, 0x95, 0x39, 0xa0, 0x0a, 0xbe, 0x67, 0xce, 0x23, 0xe5, 0x9c, 0xc0,
+	0xe0, 0x76, 0x09, 0xb1, 0x1d, 0xcf, 0x78, 0x07, 0x16, 0x90, 0xb8, 0xeb, 0xd3, 0x98, 0xc5, 0xf7,
+	0x4c, 0x43, 0x66, 0x17, 0x02, 0xf5, 0xd8, 0xa7, 0x8a, 0xa5, 0xe3, 0x09, 0x97, 0x89, 0xe4, 0x7d,
+	0x1a, 0xb9, 0x84, 0x31, 0xe2, 0x29, 0xcb, 0x59, 0x90, 0x2e, 0x53, 0xe0, 0x9e, 0x69, 0x94, 0xb4,
+	0x8a, 0x1f, 0x03, 0x48, 0x95, 0x31, 0x29, 0x58, 0x1c, 0x33, 0x29, 0xa8, 0x22, 0x8f, 0x80, 0x1a,
+	0x7b, 0x80, 0x6a, 0xd8, 0xe9, 0x3c, 0x65, 0x69, 0x4c, 0x31, 0x75, 0xc1, 0xf9, 0xb3, 0x24, 0x57,
+	0xd9, 0x86, 0xa5, 0xec, 0xde, 0xe8, 0x75, 0x5c, 0x96, 0xe9, 0xd7, 0x59, 0x6a, 0xcd, 0xf5, 0x72,
+	0x7e, 0x08, 0xab, 0x59, 0x1e, 0xe6, 0x1e, 0x13, 0x6f, 0x10, 0xa0, 0x3b, 0x58, 0x91, 0x67, 0x2c,
+	0xcd, 0xb7, 0xaf, 0xd0, 0x1d, 0xcf, 0x78, 0x1f, 0xcc, 0x1c, 0xab, 0x98, 0x95, 0x3c, 0xcd, 0x26,
+	0x72, 0x2e, 0x65, 0x38, 0x25, 0xb6, 0xe3, 0x19, 0xfb, 0x79, 0x3d, 0xb5, 0x0d, 0xad, 0x8e, 0x67,
+	0x43, 0x99, 0x89, 0x68, 0xe3, 0x19, 0x99, 0xbc, 0xc3, 0xc5, 0x41, 0xe7, 0xe6, 0x1a, 0x26, 0x87,
+	0x19, 0x9e, 0x07, 0x12, 0x95, 0x39, 0x86, 0x99, 0x19, 0xe0, 0x36, 0xbc, 0x36, 0xe6, 0x36, 0xac,
+	0x14, 0xcc, 0x12, 0xf7, 0xc3, 0x81, 0x1b, 0xc5, 0x6b, 0xab, 0x06, 0xb8, 0x31, 0xe6, 0x00, 0xab,
+	0x45, 0x1b, 0x20, 0x87, 0xb8, 0x0d, 0x0d, 0xd7, 0x09, 0x5d, 0x12, 0xd8, 0x94, 0x7c, 0x31, 0x20,
+	0x8c, 0x13, 0xcf, 0xbc, 0xb9, 0x51, 0xda, 0xac, 0x58, 0x73, 0x12, 0x6e, 0x69, 0xb0, 0x41, 0xe1,
+	0x56, 0x56, 0x9b, 0x88, 0xfa, 0x47, 0x7e, 0xe8, 0x04, 0x79, 0xb5, 0x9a, 0x63, 0xaa, 0xf5, 0x7a,
+	0x5a, 0xad, 0xcf, 0x94, 0xb0, 0xac, 0x7a, 0x23, 0x26, 0xa2, 0xb4, 0x14, 0x26, 0xb2, 0x8e, 0xbe,
+	0x31, 0x63, 0x22, 0x4a, 0xd9, 0x8e, 0x67, 0xfc, 0x10, 0xe6, 0xb3, 0xf3, 0x12, 0x1c, 0x1b, 0xc8,
+	0x91, 0x9d, 0x98, 0xa4, 0x65, 0xdc, 0x77, 0x4f, 0x86, 0x76, 0xca, 0x41, 0xbf, 0x2e, 0x69, 0x25,
+	0xe2, 0x20, 0x76, 0xd3, 0x47, 0xb0, 0xa1, 0x68, 0x63, 0x3b, 0xe7, 0x91, 0x9d, 0x1c, 0x61, 0x61,
+	0x85, 0xad, 0xf1, 0xac, 0xf0, 0x86, 0x14, 0xa4, 0x27, 0x7c, 0x10, 0xed, 0xeb, 0x43, 0x2d, 0xcc,
+	0xd1, 0x84, 0x69, 0x6d, 0x80, 0x6f, 0xc8, 0xaa, 0x4a, 0x7d, 0x1a, 0x3f, 0x83, 0x65, 0x4a, 0x38,
+	0x1d, 0xda, 0x32, 0xd4, 0x05, 0xb6, 0x1f, 0x72, 0x42, 0x4f, 0x9d, 0xc0, 0x7c, 0x73, 0xbc, 0x81,
+	0x17, 0x91, 0xbd, 0x23, 0xb9, 0x3b, 0x8a, 0x39, 0x11, 0xdb, 0x73, 0x5e, 0xf8, 0xbd, 0x41, 0x2f,
+	0x11, 0x7b, 0xeb, 0x32, 0x62, 0x3f, 0x95, 0xdc, 0xb1, 0xd8, 0xfb, 0x79, 0xb1, 0x6a, 0x1a, 0xcc,
+	0x7c, 0x0b, 0xa7, 0x95, 0xe1, 0x52, 0xe7, 0x8a, 0x19, 0x1f, 0xc1, 0xaa, 0xe4, 0x3a, 0x74, 0xdc,
+	0x93, 0xa8, 0xdb, 0xb5, 0xdd, 0x88, 0x74, 0xbb, 0xbe, 0xeb, 0x0b, 0x6f, 0xfa, 0x83, 0x8d, 0xd2,
+	0x66, 0xc9, 0x5a, 0x41, 0x82, 0x1d, 0x89, 0xdf, 0x4d, 0xd0, 0x46, 0x0f, 0x5a, 0x05, 0xb1, 0x91,
+	0xbc, 0xe8, 0xfb, 0x52, 0x5d, 0x69, 0xa4, 0x9b, 0x63, 0x1a, 0xe9, 0xfa, 0x48, 0x90, 0x7c, 0x14,
+	0x4b, 0x52, 0xd5, 0xd8, 0xba, 0x54, 0x35, 0x8c, 0x42, 0x1b, 0x7f, 0x39, 0x87, 0x01, 0xb1, 0x09,
+	0xa5, 0x11, 0xc5, 0x48, 0xce, 0xcc, 0xdb, 0x1b, 0x13, 0x9b, 0x55, 0x91, 0xf5, 0x72, 0x3a, 0x7c,
+	0x1a, 0x85, 0x96, 0x26, 0x7a, 0x24, 0x68, 0x44, 0x4c, 0x67, 0xc6, 0x26, 0x34, 0x8e, 0x1d, 0x26,
+	0xf9, 0xed, 0x7e, 0x14, 0xf8, 0xee, 0xd0, 0xfc, 0x21, 0x9e, 0xc3, 0xfa, 0xb1, 0xc3, 0x90, 0xe3,
+	0x19, 0x42, 0x45, 0x90, 0x73, 0x69, 0x14, 0xc6, 0xf6, 0x67, 0xbe, 0x8d, 0x96, 0x3a, 0x23, 0x80,
+	0xda, 0x96, 0x44, 0x72, 0xc4, 0xfc, 0x23, 0x71, 0x36, 0xdd, 0x68, 0x10, 0x72, 0xb3, 0x2d, 0x93,
+	0x23, 0x09, 0xdb, 0x15, 0x20, 0xe3, 0x16, 0xcc, 0xa8, 0xdc, 0xc5, 0x66, 0xfe, 0x97, 0xc4, 0xdc,
+	0x12, 0x24, 0x3b, 0xd7, 0xcd, 0x92, 0x55, 0x53, 0xf0, 0x7d, 0xff, 0x4b, 0x51, 0xbf, 0xce, 0x3b,
+	0x03, 0x1e, 0xd9, 0x94, 0x30, 0xc2, 0xed, 0x7e, 0xe4, 0x87, 0x9c, 0x99, 0xf7, 0x8a, 0x32, 0xa1,
+	0xb8, 0xf9, 0x70, 0x7a, 0xb7, 0x6d, 0x09, 0xea, 0x67, 0x48, 0x6c, 0xcd, 0x09, 0xfe, 0x14, 0xc0,
+	0xf8, 0x2b, 0x98, 0x67, 0xc4, 0xa1, 0xee, 0xb1, 0xb0, 0x05, 0xea, 0x1f, 0x0e, 0x38, 0x61, 0xe6,
+	0x7d, 0x2c, 0x6b, 0x3e, 0x1b, 0xa7, 0xac, 0x29, 0xcc, 0x6a, 0xdb, 0xfb, 0x28, 0xf2, 0x41, 0x2c,
+	0x51, 0x16, 0x37, 0x0d, 0x96, 0x03, 0x1b, 0xcf, 0xa1, 0xdc, 0x23, 0xbd, 0xc8, 0xfc, 0x11, 0x0e,
+	0xb8, 0x7b, 0xf5, 0x01, 0x3f, 0x25, 0xbd, 0x48, 0x0e, 0x82, 0x02, 0x8d, 0x5f, 0xc2, 0xbc, 0x8a,
+	0x97, 0xb6, 0x5c, 0x40, 0x9f, 0x30, 0xf3, 0x3d, 0x5c, 0xa9, 0x77, 0x0b, 0x47, 0x49, 0xa5, 0x8e,
+	0x2a, 0x9a, 0x7e, 0xa2, 0xf9, 0xac, 0xc6, 0x69, 0x0e, 0x62, 0xdc, 0x83, 0x65, 0x95, 0x85, 0xc4,
+	0x36, 0xad, 0x92, 0xe3, 0xf7, 0xd1, 0x00, 0x16, 0x10, 0x1b, 0xab, 0x28, 0x93, 0xe4, 0xbf, 0x80,
+	0xb9, 0x84, 0x9c, 0x71, 0x87, 0x33, 0xf3, 0x03, 0xd4, 0x68, 0x7b, 0x9c, 0x79, 0xc7, 0xc2, 0xf6,
+	0x05, 0xa7, 0x55, 0x27, 0x99, 0xef, 0x4c, 0x78, 0xa2, 0x83, 0xd1, 0x23, 0xf6, 0xe1, 0x65, 0xc3,
+	0x93, 0x35, 0xc8, 0x1f, 0x2e, 0x61, 0xc7, 0x7c, 0xe0, 0x0a, 0xbf, 0xef, 0xb0, 0x28, 0x34, 0x3f,
+	0x92, 0x75, 0x00, 0xc2, 0x2c, 0x04, 0x19, 0x3e, 0x2c, 0x46, 0x7d, 0x42, 0x1d, 0x1e, 0x51, 0xdb,
+	0x09, 0xc3, 0x88, 0x23, 0x37, 0x33, 0x3f, 0xc6, 0xfd, 0x7d, 0x6f, 0x9c, 0x79, 0x7e, 0xa6, 0xf8,
+	0x1f, 0xc4, 0xec, 0xd6, 0x42, 0x34, 0x02, 0x63, 0x46, 0x1f, 0x56, 0x30, 0x42, 0x04, 0x8e, 0xac,
+	0x6b, 0x0f, 0x29, 0x71, 0x4e, 0xbc, 0xe8, 0x2c, 0x64, 0xe6, 0x9f, 0xe0, 0x68, 0x1f, 0x8c, 0x33,
+	0x9a, 0x08, 0x26, 0x4f, 0xa4, 0x84, 0x1d, 0x2d, 0xc0, 0x5a, 0xe2, 0x05, 0x50, 0x66, 0x9c, 0xc1,
+	0x6b, 0xe9, 0x4e, 0x40, 0x84, 0x56, 0xf1, 0x25, 0xf1, 0x64, 0x19, 0x63, 0xfe, 0x29, 0xae, 0xf0,
+	0xfb, 0x63, 0xcd, 0x51, 0xb3, 0x26, 0x65, 0x8e, 0x95, 0xee, 0x32, 0xc4, 0x78, 0x44, 0x19, 0x7f,
+	0x53, 0x82, 0x35, 0xf2, 0x82, 0x13, 0x8a, 0xf1, 0x7d, 0xe4, 0xb4, 0xfe, 0x19, 0x4e, 0xf7, 0xf9,
+	0xd5, 0x0f, 0xcf, 0x23, 0x25, 0xbb, 0xf8, 0xd4, 0x9a, 0xe4, 0x1c, 0xb4, 0x31, 0x84, 0x46, 0x62,
+	0x73, 0xa4, 0x1f, 0x51, 0xce, 0xcc, 0x1f, 0xa3, 0x32, 0x4f, 0xaf, 0xae, 0x8c, 0x86, 0x5a, 0x52,
+	0xa0, 0xd4, 0x61, 0xee, 0x2c, 0x0b, 0x35, 0x6e, 0xc3, 0xbc, 0x74, 0x82, 0x87, 0x0e, 0x23, 0xfa,
+	0xec, 0xfd, 0x39, 0x1a, 0x64, 0x1d, 0x11, 0x3b, 0x0e, 0x23, 0x78, 0xec, 0xd6, 0x3c, 0x58, 0x2a,
+	0x9c, 0x58, 0x41, 0x01, 0xff, 0xa3, 0x6c, 0xcf, 0x61, 0x3d, 0xeb, 0x53, 0x55, 0xef, 0xf7, 0xf4,
+	0x6e, 0xfb, 0x99, 0x33, 0x0c, 0x22, 0xc7, 0x4b, 0x37, 0x0b, 0x7e, 0x0e, 0xd5, 0xd8, 0x07, 0x7d,
+	0xbb, 0x92, 0x03, 0xb8, 0x79, 0xe1, 0x06, 0x7d, 0xbb, 0xa3, 0xb9, 0xb0, 0x58, 0xb4, 0x03, 0xdf,
+	0xea, 0x20, 0x7b, 0xe5, 0xca, 0x5c, 0xa3, 0xb1, 0x57, 0xae, 0x34, 0x1a, 0xf3, 0x7b, 0xe5, 0xca,
+	0x9d, 0xc6, 0x3b, 0x7b, 0xe5, 0xca, 0x3b, 0x8d, 0xf6, 0x5e, 0xb9, 0xf2, 0x6e, 0xe3, 0xee, 0x5e,
+	0xb9, 0x72, 0xb7, 0xb1, 0xbd, 0x57, 0xae, 0x6c, 0x37, 0xee, 0xb5, 0xfe, 0xae, 0x04, 0xc6, 0xa8,
+	0x47, 0x30, 0xee, 0x43, 0x19, 0xbd, 0x5a, 0x69, 0x4c, 0xaf, 0x86, 0xd4, 0x46, 0x13, 0x20, 0x71,
+	0x4a, 0xba, 0x8f, 0x92, 0x40, 0x0c, 0x03, 0xca, 0xdc, 0x39, 0x62, 0xe6, 0x04, 0xa6, 0x08, 0xf8,
+	0xdb, 0x58, 0x83, 0x8a, 0xef, 0x91, 0x90, 0xfb, 0x7c, 0xa8, 0x3a, 0x24, 0xf1, 0x77, 0xeb, 0xf7,
+	0x13, 0xb0, 0x58, 0xe4, 0x40, 0xb0, 0xb1, 0x1d, 0xa7, 0xe1, 0x71, 0xa1, 0x5a, 0x92, 0x85, 0x6a,
+	0x8c, 0xd1, 0x85, 0xea, 0x2e, 0xcc, 0x64, 0x4a, 0x95, 0xeb, 0x63, 0x4e, 0xaa, 0xc6, 0x52, 0xe5,
+	0xc9, 0x2f, 0x60, 0x75, 0x34, 0x09, 0x56, 0xbe, 0xd1, 0x9c, 0x18, 0x2f, 0x69, 0x5c, 0x66, 0xd9,
+	0xf4, 0x57, 0xcd, 0x4b, 0x94, 0xb5, 0x9e, 0xcf, 0xfa, 0xd8, 0xac, 0xd1, 0x22, 0xcb, 0xe3, 0x89,
+	0x9c, 0xd3, 0x8c, 0x5a, 0xd6, 0x43, 0x98, 0xc5, 0x9c, 0x3e, 0x16, 0x34, 0x39, 0x9e, 0xa0, 0x19,
+	0xe4, 0xd2, 0x52, 0x6e, 0x02, 0xb0, 0x61, 0xe8, 0xda, 0x3d, 0xf4, 0xbc, 0x53, 0x98, 0x9b, 0x55,
+	0x05, 0xe4, 0x53, 0x01, 0x30, 0x6e, 0x41, 0xbd, 0x1b, 0xd1, 0x33, 0x87, 0x7a, 0xc4, 0xb3, 0xbb,
+	0x34, 0xea, 0x61, 0x83, 0xa9, 0x6a, 0xcd, 0xc6, 0xd0, 0xc7, 0x34, 0xea, 0x61, 0xdf, 0x2c, 0x0a,
+	0x02, 0x3b, 0x47, 0x5b, 0x51, 0x7d, 0xb3, 0x28, 0x08, 0x1e, 0xa7, 0xe9, 0x5b, 0xbf, 0x2e, 0xc1,
+	0x42, 0x81, 0xeb, 0x36, 0xde, 0x84, 0x7a, 0xae, 0x27, 0x21, 0xb7, 0x7a, 0xa6, 0x9b, 0xee, 0x47,
+	0x08, 0x9d, 0xfd, 0x2f, 0x89, 0x7d, 0x38, 0x14, 0x4e, 0x5b, 0xb6, 0x08, 0xab, 0x02, 0xb2, 0x33,
+	0xe4, 0x32, 0xe9, 0x44, 0x74, 0xe0, 0xf7, 0x7c, 0xae, 0x88, 0x26, 0x90, 0xa8, 0x2e, 0xe0, 0x4f,
+	0x04, 0x18, 0x29, 0x5b, 0xf7, 0xa0, 0x9e, 0x4d, 0x06, 0x44, 0x64, 0xce, 0xa4, 0x8f, 0x72, 0xf8,
+	0x74, 0xea, 0xd8, 0xfa, 0xdf, 0x12, 0x2c, 0x8f, 0x38, 0x5c, 0xc1, 0x4d, 0xb0, 0x3c, 0xa3, 0xc4,
+	0xe1, 0x24, 0x5d, 0x9e, 0x95, 0x54, 0x79, 0x86, 0x88, 0xa4, 0x3c, 0x5b, 0x82, 0x29, 0xe5, 0x6c,
+	0xe5, 0xf1, 0x99, 0xa4, 0x98, 0xda, 0xec, 0xc1, 0x24, 0x13, 0xb2, 0x50, 0xe3, 0xfa, 0xf6, 0xfd,
+	0x42, 0xf7, 0x8f, 0x97, 0x5d, 0x85, 0x8e, 0x1f, 0xf5, 0xb0, 0xa4, 0x08, 0xe3, 0x31, 0x4c, 0x89,
+	0x1f, 0x03, 0x86, 0x36, 0x56, 0xdf, 0x6e, 0x67, 0x1d, 0xcb, 0xc5, 0x52, 0x06, 0xcc, 0x52, 0xdc,
+	0xad, 0xff, 0x2c, 0x43, 0x43, 0x77, 0xad, 0xb1, 0x83, 0xf4, 0x6d, 0xb5, 0x5b, 0x93, 0x35, 0x98,
+	0x48, 0xaf, 0xc1, 0x2e, 0x54, 0x65, 0xff, 0x63, 0xd8, 0x27, 0x4a, 0xf5, 0xb7, 0x2e, 0x5e, 0x07,
+	0xec, 0x78, 0x0c, 0xfb, 0xc4, 0xaa, 0x70, 0xf5, 0x4b, 0x98, 0x24, 0x77, 0xe8, 0x11, 0xc9, 0xb5,
+	0x72, 0x65, 0xcb, 0x75, 0x5e, 0xa2, 0x72, 0xad, 0x5c, 0x45, 0x9f, 0xd6, 0x79, 0x4a, 0x76, 0x2a,
+	0x25, 0x26, 0xdb, 0xca, 0x55, 0xd4, 0x6a, 0x02, 0xf2, 0x58, 0xd4, 0x24, 0x50, 0x66, 0xa9, 0xd9,
+	0xd6, 0x68, 0x25, 0xdf, 0x1a, 0xfd, 0x18, 0xd6, 0x94, 0x08, 0xf7, 0xd8, 0x0f, 0xbc, 0x64, 0xd8,
+	0x28, 0x0c, 0x86, 0xd8, 0x49, 0xad, 0x58, 0x2b, 0x92, 0x62, 0x57, 0x10, 0xe8, 0xd1, 0x3f, 0x0b,
+	0x83, 0xa1, 0x58, 0xda, 0x74, 0x47, 0x0a, 0xd0, 0x4c, 0x81, 0x25, 0x5d, 0x28, 0x13, 0xa6, 0x75,
+	0x9b, 0xab, 0x86, 0x48, 0xfd, 0x69, 0xac, 0xc0, 0xb4, 0x6e, 0x0f, 0xce, 0x20, 0x66, 0x8a, 0xcb,
+	0xae, 0x60, 0x07, 0xe6, 0x52, 0x37, 0x23, 0xe8, 0x40, 0x67, 0xc7, 0x6d, 0xb9, 0x25, 0x8c, 0x02,
+	0x65, 0xbc, 0x2d, 0x92, 0x0a, 0x37, 0xa2, 0x9e, 0x9d, 0x20, 0xb0, 0x6d, 0x59, 0xb1, 0x1a, 0x12,
+	0xf1, 0x79, 0x0c, 0x6f, 0xfd, 0xdb, 0x04, 0x2c, 0xa4, 0xae, 0x07, 0xbe, 0x37, 0x16, 0x96, 0x5a,
+	0xe2, 0xc9, 0xec, 0x12, 0x8f, 0xba, 0xb1, 0xa9, 0x02, 0x37, 0xd6, 0x82, 0xd9, 0x90, 0xbc, 0x48,
+	0x11, 0xc9, 0xde, 0x7d, 0x4d, 0x00, 0x35, 0x8d, 0xa8, 0x14, 0xe2, 0xf8, 0xe7, 0x7b, 0x66, 0x45,
+	0x55, 0xbc, 0x1a, 0x26, 0x49, 0x0e, 0xa9, 0x13, 0xba, 0xc7, 0x36, 0x8f, 0x4e, 0x88, 0xdc, 0xee,
+	0x19, 0xab, 0x26, 0x61, 0x07, 0x02, 0x64, 0x6c, 0xc1, 0x62, 0x48, 0x64, 0x35, 0x93, 0x21, 0x9d,
+	0x45, 0xd2, 0xf9, 0x90, 0x88, 0x1a, 0x65, 0x27, 0xc5, 0x90, 0xb2, 0x91, 0xb9, 0xb4, 0x8d, 0xec,
+	0x95, 0x2b, 0xd5, 0x06, 0xec, 0x95, 0x2b, 0xd0, 0xa8, 0xed, 0x95, 0x2b, 0x33, 0x8d, 0xd9, 0xbd,
+	0x72, 0xa5, 0xde, 0x98, 0x6b, 0xfd, 0xd3, 0x75, 0x30, 0x92, 0x2d, 0xfd, 0x23, 0xd8, 0xc2, 0xd4,
+	0x0a, 0x4c, 0xbd, 0xea, 0x94, 0x4c, 0x5f, 0xed, 0x94, 0xb4, 0xfe, 0xbe, 0x0c, 0xb3, 0xe2, 0xc7,
+	0xf7, 0xc7, 0xa9, 0x3e, 0x82, 0x19, 0xd5, 0x0e, 0x94, 0x72, 0x26, 0x51, 0x4e, 0xeb, 0x9c, 0xb8,
+	0xa2, 0x9a, 0x7e, 0x28, 0xa3, 0xc6, 0x93, 0x0f, 0x83, 0xa4, 0x9a, 0xd2, 0xba, 0x15, 0x86, 0xf2,
+	0xa6, 0x50, 0xde, 0xdd, 0xf1, 0x82, 0x9e, 0x6a, 0x92, 0xa1, 0xf8, 0x85, 0xb3, 0x51, 0x60, 0x7a,
+	0x77, 0xa7, 0xb3, 0xbb, 0x7b, 0x1b, 0xe2, 0xe4, 0x31, 0x6e, 0x88, 0x57, 0xb0, 0x71, 0x37, 0xa7,
+	0xe1, 0xba, 0x19, 0xbe, 0x0a, 0x95, 0xf8, 0x80, 0xca, 0x07, 0x08, 0xd3, 0x44, 0x1d, 0xce, 0x94,
+	0x8d, 0xc0, 0xab, 0x6c, 0xa4, 0x76, 0x45, 0x1b, 0xf9, 0xd7, 0x39, 0x98, 0x79, 0xe0, 0x72, 0xff,
+	0xd4, 0xe7, 0x43, 0x34, 0x91, 0xd4, 0xa4, 0x4a, 0xd9, 0x49, 0xbd, 0x0f, 0x66, 0x3e, 0x57, 0x8e,
+	0xaf, 0x05, 0x65, 0x92, 0xb4, 0x94, 0xcd, 0x98, 0xf5, 0xad, 0xe0, 0x53, 0x98, 0xcb, 0x31, 0x9a,
+	0x13, 0x45, 0xad, 0xb0, 0xf3, 0x2e, 0x05, 0xeb, 0x59, 0xb1, 0xc6, 0x4f, 0xa0, 0x9e, 0xeb, 0x9d,
+	0x97, 0xc7, 0x9c, 0xfd, 0x2c, 0xcb, 0xf4, 0xc9, 0x6f, 0xaa, 0x6b, 0x24, 0xe9, 0xfb, 0x26, 0x55,
+	0xa2, 0x17, 0x5f, 0x98, 0xec, 0xa9, 0x8b, 0xb1, 0x58, 0xeb, 0xa9, 0xcb, 0x68, 0xad, 0x4b, 0x05,
+	0xa9, 0x73, 0xbe, 0x74, 0x98, 0xbe, 0x4a, 0xe9, 0xb0, 0x0e, 0x35, 0x47, 0xed, 0x95, 0x76, 0xd6,
+	0xa2, 0x2e, 0xd2, 0xdb, 0x87, 0x29, 0x41, 0x2a, 0x33, 0x54, 0xb7, 0xa5, 0x34, 0xce, 0x09, 0x0b,
+	0x4b, 0x0f, 0xdd, 0x7f, 0x87, 0xab, 0x95, 0x1e, 0xba, 0xf3, 0x9e, 0x93, 0xed, 0x06, 0x11, 0x23,
+	0x97, 0xbd, 0x5a, 0x4d, 0xc9, 0xde, 0x15, 0xfc, 0x5a, 0xf6, 0x01, 0x2c, 0x2b, 0x5d, 0xf3, 0x82,
+	0xc7, 0xbc, 0x5a, 0x5d, 0x40, 0xf6, 0x9c, 0xd4, 0x27, 0x30, 0x7f, 0x4c, 0x1c, 0xca, 0x0f, 0x89,
+	0xc3, 0x2f, 0x7b, 0x9f, 0xda, 0x88, 0x39, 0xb5, 0xb4, 0xa2, 0x2b, 0xa1, 0x7a, 0xf1, 0x95, 0x50,
+	0xe1, 0x2d, 0x8b, 0x8c, 0x83, 0x45, 0xb7, 0x2c, 0xf2, 0x71, 0x8f, 0xbe, 0x28, 0x13, 0xe9, 0x76,
+	0x43, 0xba, 0x12, 0xae, 0x7d, 0xbb, 0xcc, 0xa7, 0xd3, 0x97, 0x1f, 0xf3, 0xd9, 0xcb, 0x8f, 0x6c,
+	0xaa, 0x68, 0xe4, 0x53, 0x45, 0xe1, 0xae, 0xe2, 0x73, 0xa0, 0x4a, 0xe8, 0x05, 0x7d, 0x93, 0xa3,
+	0x4e, 0x83, 0x04, 0x17, 0x76, 0xdc, 0x17, 0x0b, 0x3b, 0xee, 0xe7, 0x5f, 0xb8, 0x2c, 0x7d, 0x37,
+	0x17, 0x2e, 0xcb, 0xdf, 0xcd, 0x85, 0xcb, 0xca, 0x05, 0x17, 0x2e, 0x07, 0xb0, 0x24, 0xb9, 0xf2,
+	0x4d, 0x5c, 0x73, 0xcc, 0xe3, 0xbd, 0x80, 0xec, 0xb9, 0xf6, 0xed, 0x85, 0xd7, 0x38, 0xab, 0x17,
+	0x5f, 0xe3, 0x8c, 0x71, 0xaf, 0xb2, 0xf6, 0xea, 0x7b, 0x95, 0xa7, 0x60, 0x48, 0x29, 0xf2, 0x1a,
+	0x5f, 0x3e, 0xe8, 0x54, 0x37, 0xb3, 0x1b, 0x59, 0xf7, 0xa7, 0x90, 0xc2, 0xfd, 0x3d, 0x96, 0x3f,
+	0x45, 0x0a, 0xce, 0xe9, 0xf0, 0x89, 0xb8, 0xe6, 0x97, 0x10, 0x51, 0x8b, 0xa4, 0xe4, 0x9d, 0x45,
+	0xea, 0x61, 0x93, 0x32, 0xb5, 0x1b, 0x68, 0x6a, 0x2b, 0x31, 0xd7, 0xf3, 0x48, 0x3e, 0x6a, 0x52,
+	0x26, 0x97, 0x4f, 0x5a, 0x6e, 0x16, 0x26, 0x2d, 0xe9, 0x72, 0xa5, 0x39, 0x52, 0xae, 0x7c, 0x0e,
+	0xcb, 0x38, 0x74, 0x72, 0xe0, 0x3d, 0xc2, 0x1d, 0x3f, 0x60, 0xe6, 0x7a, 0xd1, 0xa4, 0x46, 0x7a,
+	0x62, 0xcc, 0xc2, 0x27, 0x0a, 0x9f, 0x68, 0xf6, 0x87, 0x92, 0x5b, 0x5c, 0x65, 0xe7, 0xe4, 0xa6,
+	0x5f, 0x14, 0x6c, 0x8c, 0x7b, 0x95, 0x9d, 0x91, 0x9d, 0x7a, 0x5a, 0xf0, 0x06, 0xcc, 0xc6, 0x0e,
+	0x1f, 0x13, 0x18, 0x79, 0xbf, 0x3a, 0xa3, 0x81, 0x62, 0xb7, 0x5a, 0xff, 0x5e, 0x82, 0xaa, 0xa0,
+	0xa6, 0xaf, 0x88, 0xdf, 0xd9, 0x68, 0x77, 0x3d, 0x1f, 0xed, 0x1e, 0x40, 0x0d, 0xad, 0x58, 0x25,
+	0x14, 0x13, 0x63, 0xea, 0x0e, 0x92, 0x49, 0xc7, 0xa7, 0xb4, 0x9b, 0x92, 0xcf, 0x4f, 0x81, 0x27,
+	0x1e, 0x6a, 0x15, 0x2a, 0xd2, 0x9b, 0xc5, 0x95, 0xf2, 0x34, 0x7e, 0x77, 0xbc, 0xd6, 0xef, 0xcb,
+	0x60, 0x60, 0x1d, 0x9a, 0x7d, 0x75, 0x75, 0x61, 0x3a, 0x92, 0xbc, 0x64, 0x2a, 0x4e, 0x47, 0x62,
+	0x7c, 0x26, 0x1d, 0xc9, 0xae, 0xc3, 0x44, 0x7e, 0x1d, 0x9e, 0xc2, 0x5c, 0x4e, 0xae, 0x59, 0xbe,
+	0x4c, 0xdc, 0xaf, 0x67, 0x47, 0x15, 0x8d, 0x02, 0x3d, 0x5c, 0x3a, 0xb1, 0x56, 0x8d, 0x02, 0x85,
+	0x4a, 0x95, 0xfe, 0x6f, 0x42, 0x5d, 0xd3, 0xab, 0x3c, 0x5b, 0x36, 0x09, 0x74, 0xfe, 0x60, 0x0d,
+	0xc2, 0xa2, 0xdc, 0x64, 0xfa, 0xea, 0xb9, 0x49, 0x61, 0x5b, 0xa9, 0x52, 0xdc, 0x56, 0xba, 0x01,
+	0xd5, 0xf8, 0xe0, 0xe9, 0x04, 0x23, 0x06, 0x5c, 0xf2, 0x39, 0xd6, 0xcf, 0xe3, 0xd7, 0x70, 0x32,
+	0xa8, 0xab, 0x70, 0x52, 0xc3, 0x24, 0x7d, 0xf3, 0x9c, 0xa4, 0xff, 0x19, 0x72, 0x60, 0x20, 0x97,
+	0x81, 0x46, 0xbf, 0x9b, 0x4b, 0x81, 0x46, 0x5e, 0xb9, 0xcd, 0x8c, 0xbc, 0x72, 0x6b, 0xfd, 0x73,
+	0x09, 0xe6, 0xd5, 0xb4, 0x76, 0x31, 0xe6, 0x7e, 0x57, 0xe6, 0x56, 0x18, 0xed, 0x27, 0x8a, 0xdf,
+	0x54, 0xe4, 0xf5, 0x2e, 0x8f, 0xea, 0xfd, 0x9b, 0xeb, 0x00, 0xfb, 0x78, 0x21, 0xfd, 0x1d, 0x9e,
+	0x8f, 0x11, 0x4d, 0x53, 0x49, 0xa4, 0x01, 0x65, 0xdc, 0x55, 0xd9, 0x63, 0xc7, 0xdf, 0xc6, 0x7b,
+	0x30, 0xe9, 0x87, 0xfd, 0x01, 0x37, 0x27, 0xc7, 0xf4, 0xa6, 0x92, 0x5c, 0x68, 0xef, 0x46, 0x21,
+	0xa7, 0x51, 0xa0, 0x8c, 0x5c, 0x7f, 0x8e, 0xac, 0xc4, 0xf4, 0xe8, 0x4a, 0xfc, 0xaa, 0x04, 0x95,
+	0xdd, 0x63, 0xe2, 0x9e, 0xb0, 0x41, 0x2f, 0xbf, 0x0e, 0x93, 0xc9, 0x3a, 0x3c, 0x84, 0xa9, 0x6e,
+	0xe0, 0x9c, 0x46, 0x14, 0x67, 0x5d, 0xdf, 0xbe, 0x73, 0x71, 0xf5, 0xa7, 0x25, 0x3e, 0x46, 0x1e,
+	0x4b, 0xf1, 0x26, 0x2f, 0x46, 0x27, 0xb0, 0xa7, 0x21, 0x3f, 0x76, 0xfe, 0xf2, 0xab, 0xaf, 0x9b,
+	0xd7, 0x7e, 0xf7, 0x75, 0xf3, 0xda, 0x1f, 0xbe, 0x6e, 0x96, 0x7e, 0xf5, 0xb2, 0x59, 0xfa, 0xc7,
+	0x97, 0xcd, 0xd2, 0x7f, 0xbc, 0x6c, 0x96, 0xbe, 0x7a, 0xd9, 0x2c, 0xfd, 0xf7, 0xcb, 0x66, 0xe9,
+	0x7f, 0x5e, 0x36, 0xaf, 0xfd, 0xe1, 0x65, 0xb3, 0xf4, 0xdb, 0x6f, 0x9a, 0xd7, 0xbe, 0xfa, 0xa6,
+	0x79, 0xed, 0x77, 0xdf, 0x34, 0xaf, 0xfd, 0xe2, 0xfe, 0x51, 0x94, 0xe8, 0xe0, 0x47, 0xe7, 0xff,
+	0xbb, 0xe4, 0xe3, 0xd4, 0xe7, 0xe1, 0x14, 0xba, 0xe0, 0x7b, 0xff, 0x37, 0x00, 0x46, 0x69, 0xb8,
+	0x01, 0x96, 0x32, 0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -2299,6 +2660,9 @@ func (this *ShardInfo) Equal(that interface{}) bool {
 	if this.VisibilityAckLevel != that1.VisibilityAckLevel {
 		return false
 	}
+	if this.RestoredConsistencyMarkerId != that1.RestoredConsistencyMarkerId {
+		return false
+	}
 	return true
 }
 func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
@@ -2555,40 +2919,57 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	} else if !this.WorkflowRunExpirationTime.Equal(*that1.WorkflowRunExpirationTime) {
 		return false
 	}
-	return true
-}
-func (this *ExecutionStats) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.StuckReason != that1.StuckReason {
+		return false
 	}
-
-	that1, ok := that.(*ExecutionStats)
-	if !ok {
-		that2, ok := that.(ExecutionStats)
-		if ok {
-			that1 = &that2
-		} else {
+	if len(this.OperatorAnnotations) != len(that1.OperatorAnnotations) {
+		return false
+	}
+	for i := range this.OperatorAnnotations {
+		if !this.OperatorAnnotations[i].Equal(that1.OperatorAnnotations[i]) {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if len(this.TaskLatencyBreakdowns) != len(that1.TaskLatencyBreakdowns) {
 		return false
 	}
-	if this.HistorySize != that1.HistorySize {
+	for i := range this.TaskLatencyBreakdowns {
+		if !this.TaskLatencyBreakdowns[i].Equal(that1.TaskLatencyBreakdowns[i]) {
+			return false
+		}
+	}
+	if !this.ReplicationOversizedBatch.Equal(that1.ReplicationOversizedBatch) {
+		return false
+	}
+	if len(this.ExternalSearchAttributes) != len(that1.ExternalSearchAttributes) {
+		return false
+	}
+	for i := range this.ExternalSearchAttributes {
+		if !this.ExternalSearchAttributes[i].Equal(that1.ExternalSearchAttributes[i]) {
+			return false
+		}
+	}
+	if len(this.WorkflowReports) != len(that1.WorkflowReports) {
+		return false
+	}
+	for i := range this.WorkflowReports {
+		if !this.WorkflowReports[i].Equal(that1.WorkflowReports[i]) {
+			return false
+		}
+	}
+	if this.ResetBaseRunId != that1.ResetBaseRunId {
 		return false
 	}
 	return true
 }
-func (this *WorkflowExecutionState) Equal(that interface{}) bool {
+func (this *OperatorAnnotation) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*WorkflowExecutionState)
+	that1, ok := that.(*OperatorAnnotation)
 	if !ok {
-		that2, ok := that.(WorkflowExecutionState)
+		that2, ok := that.(OperatorAnnotation)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2600,21 +2981,184 @@ func (this *WorkflowExecutionState) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CreateRequestId != that1.CreateRequestId {
+	if that1.Time == nil {
+		if this.Time != nil {
+			return false
+		}
+	} else if !this.Time.Equal(*that1.Time) {
 		return false
 	}
-	if this.RunId != that1.RunId {
+	if this.Annotation != that1.Annotation {
 		return false
 	}
-	if this.State != that1.State {
+	if len(this.Tags) != len(that1.Tags) {
 		return false
 	}
-	if this.Status != that1.Status {
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
+	if this.Identity != that1.Identity {
 		return false
 	}
 	return true
 }
-func (this *TransferTaskInfo) Equal(that interface{}) bool {
+func (this *TaskLatencyBreakdown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskLatencyBreakdown)
+	if !ok {
+		that2, ok := that.(TaskLatencyBreakdown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScheduledEventId != that1.ScheduledEventId {
+		return false
+	}
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
+		}
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+		return false
+	}
+	if this.ScheduleToStartLatency != nil && that1.ScheduleToStartLatency != nil {
+		if *this.ScheduleToStartLatency != *that1.ScheduleToStartLatency {
+			return false
+		}
+	} else if this.ScheduleToStartLatency != nil {
+		return false
+	} else if that1.ScheduleToStartLatency != nil {
+		return false
+	}
+	if this.DispatchLatency != nil && that1.DispatchLatency != nil {
+		if *this.DispatchLatency != *that1.DispatchLatency {
+			return false
+		}
+	} else if this.DispatchLatency != nil {
+		return false
+	} else if that1.DispatchLatency != nil {
+		return false
+	}
+	if this.QueueLatency != nil && that1.QueueLatency != nil {
+		if *this.QueueLatency != *that1.QueueLatency {
+			return false
+		}
+	} else if this.QueueLatency != nil {
+		return false
+	} else if that1.QueueLatency != nil {
+		return false
+	}
+	if this.SyncMatch != that1.SyncMatch {
+		return false
+	}
+	if this.ForwardedFrom != that1.ForwardedFrom {
+		return false
+	}
+	if this.PollForwardedFrom != that1.PollForwardedFrom {
+		return false
+	}
+	return true
+}
+func (this *OversizedEventBatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OversizedEventBatch)
+	if !ok {
+		that2, ok := that.(OversizedEventBatch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FirstEventId != that1.FirstEventId {
+		return false
+	}
+	if this.SizeBytes != that1.SizeBytes {
+		return false
+	}
+	if this.SizeLimitBytes != that1.SizeLimitBytes {
+		return false
+	}
+	return true
+}
+func (this *ExecutionStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecutionStats)
+	if !ok {
+		that2, ok := that.(ExecutionStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HistorySize != that1.HistorySize {
+		return false
+	}
+	return true
+}
+func (this *WorkflowExecutionState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WorkflowExecutionState)
+	if !ok {
+		that2, ok := that.(WorkflowExecutionState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CreateRequestId != that1.CreateRequestId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	return true
+}
+func (this *TransferTaskInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
@@ -3011,6 +3555,9 @@ func (this *ActivityInfo) Equal(that interface{}) bool {
 	} else if !this.LastHeartbeatUpdateTime.Equal(*that1.LastHeartbeatUpdateTime) {
 		return false
 	}
+	if this.ActivityType != that1.ActivityType {
+		return false
+	}
 	return true
 }
 func (this *TimerInfo) Equal(that interface{}) bool {
@@ -3219,7 +3766,7 @@ func (this *ShardInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
+	s := make([]string, 0, 19)
 	s = append(s, "&persistence.ShardInfo{")
 	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
 	s = append(s, "RangeId: "+fmt.Sprintf("%#v", this.RangeId)+",\n")
@@ -3283,6 +3830,7 @@ func (this *ShardInfo) GoString() string {
 		s = append(s, "ReplicationDlqAckLevel: "+mapStringForReplicationDlqAckLevel+",\n")
 	}
 	s = append(s, "VisibilityAckLevel: "+fmt.Sprintf("%#v", this.VisibilityAckLevel)+",\n")
+	s = append(s, "RestoredConsistencyMarkerId: "+fmt.Sprintf("%#v", this.RestoredConsistencyMarkerId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3290,7 +3838,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 54)
+	s := make([]string, 0, 61)
 	s = append(s, "&persistence.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -3374,6 +3922,85 @@ func (this *WorkflowExecutionInfo) GoString() string {
 		s = append(s, "ExecutionStats: "+fmt.Sprintf("%#v", this.ExecutionStats)+",\n")
 	}
 	s = append(s, "WorkflowRunExpirationTime: "+fmt.Sprintf("%#v", this.WorkflowRunExpirationTime)+",\n")
+	s = append(s, "StuckReason: "+fmt.Sprintf("%#v", this.StuckReason)+",\n")
+	if this.OperatorAnnotations != nil {
+		s = append(s, "OperatorAnnotations: "+fmt.Sprintf("%#v", this.OperatorAnnotations)+",\n")
+	}
+	if this.TaskLatencyBreakdowns != nil {
+		s = append(s, "TaskLatencyBreakdowns: "+fmt.Sprintf("%#v", this.TaskLatencyBreakdowns)+",\n")
+	}
+	if this.ReplicationOversizedBatch != nil {
+		s = append(s, "ReplicationOversizedBatch: "+fmt.Sprintf("%#v", this.ReplicationOversizedBatch)+",\n")
+	}
+	keysForExternalSearchAttributes := make([]string, 0, len(this.ExternalSearchAttributes))
+	for k, _ := range this.ExternalSearchAttributes {
+		keysForExternalSearchAttributes = append(keysForExternalSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExternalSearchAttributes)
+	mapStringForExternalSearchAttributes := "map[string]*v12.Payload{"
+	for _, k := range keysForExternalSearchAttributes {
+		mapStringForExternalSearchAttributes += fmt.Sprintf("%#v: %#v,", k, this.ExternalSearchAttributes[k])
+	}
+	mapStringForExternalSearchAttributes += "}"
+	if this.ExternalSearchAttributes != nil {
+		s = append(s, "ExternalSearchAttributes: "+mapStringForExternalSearchAttributes+",\n")
+	}
+	keysForWorkflowReports := make([]string, 0, len(this.WorkflowReports))
+	for k, _ := range this.WorkflowReports {
+		keysForWorkflowReports = append(keysForWorkflowReports, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForWorkflowReports)
+	mapStringForWorkflowReports := "map[string]*v12.Payload{"
+	for _, k := range keysForWorkflowReports {
+		mapStringForWorkflowReports += fmt.Sprintf("%#v: %#v,", k, this.WorkflowReports[k])
+	}
+	mapStringForWorkflowReports += "}"
+	if this.WorkflowReports != nil {
+		s = append(s, "WorkflowReports: "+mapStringForWorkflowReports+",\n")
+	}
+	s = append(s, "ResetBaseRunId: "+fmt.Sprintf("%#v", this.ResetBaseRunId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OperatorAnnotation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&persistence.OperatorAnnotation{")
+	s = append(s, "Time: "+fmt.Sprintf("%#v", this.Time)+",\n")
+	s = append(s, "Annotation: "+fmt.Sprintf("%#v", this.Annotation)+",\n")
+	s = append(s, "Tags: "+fmt.Sprintf("%#v", this.Tags)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskLatencyBreakdown) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&persistence.TaskLatencyBreakdown{")
+	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	s = append(s, "ScheduleToStartLatency: "+fmt.Sprintf("%#v", this.ScheduleToStartLatency)+",\n")
+	s = append(s, "DispatchLatency: "+fmt.Sprintf("%#v", this.DispatchLatency)+",\n")
+	s = append(s, "QueueLatency: "+fmt.Sprintf("%#v", this.QueueLatency)+",\n")
+	s = append(s, "SyncMatch: "+fmt.Sprintf("%#v", this.SyncMatch)+",\n")
+	s = append(s, "ForwardedFrom: "+fmt.Sprintf("%#v", this.ForwardedFrom)+",\n")
+	s = append(s, "PollForwardedFrom: "+fmt.Sprintf("%#v", this.PollForwardedFrom)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OversizedEventBatch) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&persistence.OversizedEventBatch{")
+	s = append(s, "FirstEventId: "+fmt.Sprintf("%#v", this.FirstEventId)+",\n")
+	s = append(s, "SizeBytes: "+fmt.Sprintf("%#v", this.SizeBytes)+",\n")
+	s = append(s, "SizeLimitBytes: "+fmt.Sprintf("%#v", this.SizeLimitBytes)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3483,7 +4110,7 @@ func (this *ActivityInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 36)
+	s := make([]string, 0, 37)
 	s = append(s, "&persistence.ActivityInfo{")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "ScheduledEventBatchId: "+fmt.Sprintf("%#v", this.ScheduledEventBatchId)+",\n")
@@ -3525,6 +4152,7 @@ func (this *ActivityInfo) GoString() string {
 		s = append(s, "LastHeartbeatDetails: "+fmt.Sprintf("%#v", this.LastHeartbeatDetails)+",\n")
 	}
 	s = append(s, "LastHeartbeatUpdateTime: "+fmt.Sprintf("%#v", this.LastHeartbeatUpdateTime)+",\n")
+	s = append(s, "ActivityType: "+fmt.Sprintf("%#v", this.ActivityType)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3638,6 +4266,11 @@ func (m *ShardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RestoredConsistencyMarkerId != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.RestoredConsistencyMarkerId))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.VisibilityAckLevel != 0 {
 		i = encodeVarintExecutions(dAtA, i, uint64(m.VisibilityAckLevel))
 		i--
@@ -3798,13 +4431,133 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ResetBaseRunId) > 0 {
+		i -= len(m.ResetBaseRunId)
+		copy(dAtA[i:], m.ResetBaseRunId)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.ResetBaseRunId)))
+		i--
+		dAtA[i] = 0x4
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.WorkflowReports) > 0 {
+		for k := range m.WorkflowReports {
+			v := m.WorkflowReports[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintExecutions(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutions(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.ExternalSearchAttributes) > 0 {
+		for k := range m.ExternalSearchAttributes {
+			v := m.ExternalSearchAttributes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintExecutions(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutions(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutions(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if m.ReplicationOversizedBatch != nil {
+		{
+			size, err := m.ReplicationOversizedBatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.TaskLatencyBreakdowns) > 0 {
+		for iNdEx := len(m.TaskLatencyBreakdowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskLatencyBreakdowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecutions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.OperatorAnnotations) > 0 {
+		for iNdEx := len(m.OperatorAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorAnnotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecutions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.StuckReason) > 0 {
+		i -= len(m.StuckReason)
+		copy(dAtA[i:], m.StuckReason)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.StuckReason)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
 	if m.WorkflowRunExpirationTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
-		if err4 != nil {
-			return 0, err4
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
+		if err7 != nil {
+			return 0, err7
 		}
-		i -= n4
-		i = encodeVarintExecutions(dAtA, i, uint64(n4))
+		i -= n7
+		i = encodeVarintExecutions(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x3
 		i--
@@ -3964,12 +4717,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.WorkflowExecutionExpirationTime != nil {
-		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
-		if err10 != nil {
-			return 0, err10
+		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n10
-		i = encodeVarintExecutions(dAtA, i, uint64(n10))
+		i -= n13
+		i = encodeVarintExecutions(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -3991,24 +4744,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.RetryMaximumInterval != nil {
-		n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err11 != nil {
-			return 0, err11
+		n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n11
-		i = encodeVarintExecutions(dAtA, i, uint64(n11))
+		i -= n14
+		i = encodeVarintExecutions(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x2
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.RetryInitialInterval != nil {
-		n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err12 != nil {
-			return 0, err12
+		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n12
-		i = encodeVarintExecutions(dAtA, i, uint64(n12))
+		i -= n15
+		i = encodeVarintExecutions(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4022,12 +4775,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x98
 	}
 	if m.StickyScheduleToStartTimeout != nil {
-		n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
-		if err13 != nil {
-			return 0, err13
+		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n13
-		i = encodeVarintExecutions(dAtA, i, uint64(n13))
+		i -= n16
+		i = encodeVarintExecutions(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4061,12 +4814,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xfa
 	}
 	if m.WorkflowTaskOriginalScheduledTime != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
-		if err14 != nil {
-			return 0, err14
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n14
-		i = encodeVarintExecutions(dAtA, i, uint64(n14))
+		i -= n17
+		i = encodeVarintExecutions(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4085,24 +4838,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xe8
 	}
 	if m.WorkflowTaskScheduledTime != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
-		if err15 != nil {
-			return 0, err15
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n15
-		i = encodeVarintExecutions(dAtA, i, uint64(n15))
+		i -= n18
+		i = encodeVarintExecutions(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xe2
 	}
 	if m.WorkflowTaskStartedTime != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
-		if err16 != nil {
-			return 0, err16
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
+		if err19 != nil {
+			return 0, err19
 		}
-		i -= n16
-		i = encodeVarintExecutions(dAtA, i, uint64(n16))
+		i -= n19
+		i = encodeVarintExecutions(dAtA, i, uint64(n19))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4116,12 +4869,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowTaskTimeout != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
-		if err17 != nil {
-			return 0, err17
+		n20, err20 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n17
-		i = encodeVarintExecutions(dAtA, i, uint64(n17))
+		i -= n20
+		i = encodeVarintExecutions(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4149,24 +4902,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.LastUpdateTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err18 != nil {
-			return 0, err18
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n18
-		i = encodeVarintExecutions(dAtA, i, uint64(n18))
+		i -= n21
+		i = encodeVarintExecutions(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.StartTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err19 != nil {
-			return 0, err19
+		n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n19
-		i = encodeVarintExecutions(dAtA, i, uint64(n19))
+		i -= n22
+		i = encodeVarintExecutions(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4199,32 +4952,32 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.DefaultWorkflowTaskTimeout != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
-		if err20 != nil {
-			return 0, err20
+		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n20
-		i = encodeVarintExecutions(dAtA, i, uint64(n20))
+		i -= n23
+		i = encodeVarintExecutions(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.WorkflowRunTimeout != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
-		if err21 != nil {
-			return 0, err21
+		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
+		if err24 != nil {
+			return 0, err24
 		}
-		i -= n21
-		i = encodeVarintExecutions(dAtA, i, uint64(n21))
+		i -= n24
+		i = encodeVarintExecutions(dAtA, i, uint64(n24))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.WorkflowExecutionTimeout != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
-		if err22 != nil {
-			return 0, err22
+		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+		if err25 != nil {
+			return 0, err25
 		}
-		i -= n22
-		i = encodeVarintExecutions(dAtA, i, uint64(n22))
+		i -= n25
+		i = encodeVarintExecutions(dAtA, i, uint64(n25))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -4302,6 +5055,192 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OperatorAnnotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorAnnotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorAnnotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintExecutions(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Annotation) > 0 {
+		i -= len(m.Annotation)
+		copy(dAtA[i:], m.Annotation)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.Annotation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Time != nil {
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintExecutions(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskLatencyBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskLatencyBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskLatencyBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PollForwardedFrom) > 0 {
+		i -= len(m.PollForwardedFrom)
+		copy(dAtA[i:], m.PollForwardedFrom)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.PollForwardedFrom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ForwardedFrom) > 0 {
+		i -= len(m.ForwardedFrom)
+		copy(dAtA[i:], m.ForwardedFrom)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.ForwardedFrom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SyncMatch {
+		i--
+		if m.SyncMatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.QueueLatency != nil {
+		n28, err28 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.QueueLatency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.QueueLatency):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintExecutions(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DispatchLatency != nil {
+		n29, err29 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DispatchLatency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DispatchLatency):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintExecutions(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ScheduleToStartLatency != nil {
+		n30, err30 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartLatency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartLatency):])
+		if err30 != nil {
+			return 0, err30
+		}
+		i -= n30
+		i = encodeVarintExecutions(dAtA, i, uint64(n30))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartedTime != nil {
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err31 != nil {
+			return 0, err31
+		}
+		i -= n31
+		i = encodeVarintExecutions(dAtA, i, uint64(n31))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OversizedEventBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OversizedEventBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OversizedEventBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SizeLimitBytes != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.SizeLimitBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FirstEventId != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.FirstEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExecutionStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4408,12 +5347,12 @@ func (m *TransferTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.VisibilityTime != nil {
-		n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err24 != nil {
-			return 0, err24
+		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err32 != nil {
+			return 0, err32
 		}
-		i -= n24
-		i = encodeVarintExecutions(dAtA, i, uint64(n24))
+		i -= n32
+		i = encodeVarintExecutions(dAtA, i, uint64(n32))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -4608,12 +5547,12 @@ func (m *VisibilityTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err25 != nil {
-			return 0, err25
+		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err33 != nil {
+			return 0, err33
 		}
-		i -= n25
-		i = encodeVarintExecutions(dAtA, i, uint64(n25))
+		i -= n33
+		i = encodeVarintExecutions(dAtA, i, uint64(n33))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4677,12 +5616,12 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err26 != nil {
-			return 0, err26
+		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err34 != nil {
+			return 0, err34
 		}
-		i -= n26
-		i = encodeVarintExecutions(dAtA, i, uint64(n26))
+		i -= n34
+		i = encodeVarintExecutions(dAtA, i, uint64(n34))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -4765,13 +5704,22 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ActivityType) > 0 {
+		i -= len(m.ActivityType)
+		copy(dAtA[i:], m.ActivityType)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.ActivityType)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.LastHeartbeatUpdateTime != nil {
-		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
-		if err27 != nil {
-			return 0, err27
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
+		if err35 != nil {
+			return 0, err35
 		}
-		i -= n27
-		i = encodeVarintExecutions(dAtA, i, uint64(n27))
+		i -= n35
+		i = encodeVarintExecutions(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4850,12 +5798,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc9
 	}
 	if m.RetryExpirationTime != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
-		if err30 != nil {
-			return 0, err30
+		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n30
-		i = encodeVarintExecutions(dAtA, i, uint64(n30))
+		i -= n38
+		i = encodeVarintExecutions(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4869,24 +5817,24 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb8
 	}
 	if m.RetryMaximumInterval != nil {
-		n31, err31 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err31 != nil {
-			return 0, err31
+		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= n31
-		i = encodeVarintExecutions(dAtA, i, uint64(n31))
-		i--
+		i -= n39
+		i = encodeVarintExecutions(dAtA, i, uint64(n39))
+		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xb2
 	}
 	if m.RetryInitialInterval != nil {
-		n32, err32 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err32 != nil {
-			return 0, err32
+		n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err40 != nil {
+			return 0, err40
 		}
-		i -= n32
-		i = encodeVarintExecutions(dAtA, i, uint64(n32))
+		i -= n40
+		i = encodeVarintExecutions(dAtA, i, uint64(n40))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4952,42 +5900,42 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.HeartbeatTimeout != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err33 != nil {
-			return 0, err33
+		n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n33
-		i = encodeVarintExecutions(dAtA, i, uint64(n33))
+		i -= n41
+		i = encodeVarintExecutions(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0x6a
 	}
 	if m.StartToCloseTimeout != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
-		if err34 != nil {
-			return 0, err34
+		n42, err42 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n34
-		i = encodeVarintExecutions(dAtA, i, uint64(n34))
+		i -= n42
+		i = encodeVarintExecutions(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x62
 	}
 	if m.ScheduleToCloseTimeout != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
-		if err35 != nil {
-			return 0, err35
+		n43, err43 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n35
-		i = encodeVarintExecutions(dAtA, i, uint64(n35))
+		i -= n43
+		i = encodeVarintExecutions(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0x5a
 	}
 	if m.ScheduleToStartTimeout != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err36 != nil {
-			return 0, err36
+		n44, err44 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err44 != nil {
+			return 0, err44
 		}
-		i -= n36
-		i = encodeVarintExecutions(dAtA, i, uint64(n36))
+		i -= n44
+		i = encodeVarintExecutions(dAtA, i, uint64(n44))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -5006,12 +5954,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if m.StartedTime != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err37 != nil {
-			return 0, err37
+		n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err45 != nil {
+			return 0, err45
 		}
-		i -= n37
-		i = encodeVarintExecutions(dAtA, i, uint64(n37))
+		i -= n45
+		i = encodeVarintExecutions(dAtA, i, uint64(n45))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5033,12 +5981,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if m.ScheduledTime != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err39 != nil {
-			return 0, err39
+		n47, err47 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err47 != nil {
+			return 0, err47
 		}
-		i -= n39
-		i = encodeVarintExecutions(dAtA, i, uint64(n39))
+		i -= n47
+		i = encodeVarintExecutions(dAtA, i, uint64(n47))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5100,12 +6048,12 @@ func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.ExpiryTime != nil {
-		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err41 != nil {
-			return 0, err41
+		n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err49 != nil {
+			return 0, err49
 		}
-		i -= n41
-		i = encodeVarintExecutions(dAtA, i, uint64(n41))
+		i -= n49
+		i = encodeVarintExecutions(dAtA, i, uint64(n49))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5472,6 +6420,9 @@ func (m *ShardInfo) Size() (n int) {
 	if m.VisibilityAckLevel != 0 {
 		n += 1 + sovExecutions(uint64(m.VisibilityAckLevel))
 	}
+	if m.RestoredConsistencyMarkerId != 0 {
+		n += 1 + sovExecutions(uint64(m.RestoredConsistencyMarkerId))
+	}
 	return n
 }
 
@@ -5684,6 +6635,140 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime)
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	l = len(m.StuckReason)
+	if l > 0 {
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	if len(m.OperatorAnnotations) > 0 {
+		for _, e := range m.OperatorAnnotations {
+			l = e.Size()
+			n += 2 + l + sovExecutions(uint64(l))
+		}
+	}
+	if len(m.TaskLatencyBreakdowns) > 0 {
+		for _, e := range m.TaskLatencyBreakdowns {
+			l = e.Size()
+			n += 2 + l + sovExecutions(uint64(l))
+		}
+	}
+	if m.ReplicationOversizedBatch != nil {
+		l = m.ReplicationOversizedBatch.Size()
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	if len(m.ExternalSearchAttributes) > 0 {
+		for k, v := range m.ExternalSearchAttributes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovExecutions(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovExecutions(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovExecutions(uint64(mapEntrySize))
+		}
+	}
+	if len(m.WorkflowReports) > 0 {
+		for k, v := range m.WorkflowReports {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovExecutions(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovExecutions(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovExecutions(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ResetBaseRunId)
+	if l > 0 {
+		n += 2 + l + sovExecutions(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorAnnotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovExecutions(uint64(l))
+		}
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskLatencyBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovExecutions(uint64(m.ScheduledEventId))
+	}
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	if m.ScheduleToStartLatency != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartLatency)
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	if m.DispatchLatency != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DispatchLatency)
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	if m.QueueLatency != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.QueueLatency)
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	if m.SyncMatch {
+		n += 2
+	}
+	l = len(m.ForwardedFrom)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	l = len(m.PollForwardedFrom)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	return n
+}
+
+func (m *OversizedEventBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstEventId != 0 {
+		n += 1 + sovExecutions(uint64(m.FirstEventId))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovExecutions(uint64(m.SizeBytes))
+	}
+	if m.SizeLimitBytes != 0 {
+		n += 1 + sovExecutions(uint64(m.SizeLimitBytes))
+	}
 	return n
 }
 
@@ -6033,6 +7118,10 @@ func (m *ActivityInfo) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime)
 		n += 2 + l + sovExecutions(uint64(l))
 	}
+	l = len(m.ActivityType)
+	if l > 0 {
+		n += 2 + l + sovExecutions(uint64(l))
+	}
 	return n
 }
 
@@ -6254,6 +7343,7 @@ func (this *ShardInfo) String() string {
 		`ClusterReplicationLevel:` + mapStringForClusterReplicationLevel + `,`,
 		`ReplicationDlqAckLevel:` + mapStringForReplicationDlqAckLevel + `,`,
 		`VisibilityAckLevel:` + fmt.Sprintf("%v", this.VisibilityAckLevel) + `,`,
+		`RestoredConsistencyMarkerId:` + fmt.Sprintf("%v", this.RestoredConsistencyMarkerId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6262,6 +7352,16 @@ func (this *WorkflowExecutionInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForOperatorAnnotations := "[]*OperatorAnnotation{"
+	for _, f := range this.OperatorAnnotations {
+		repeatedStringForOperatorAnnotations += strings.Replace(f.String(), "OperatorAnnotation", "OperatorAnnotation", 1) + ","
+	}
+	repeatedStringForOperatorAnnotations += "}"
+	repeatedStringForTaskLatencyBreakdowns := "[]*TaskLatencyBreakdown{"
+	for _, f := range this.TaskLatencyBreakdowns {
+		repeatedStringForTaskLatencyBreakdowns += strings.Replace(f.String(), "TaskLatencyBreakdown", "TaskLatencyBreakdown", 1) + ","
+	}
+	repeatedStringForTaskLatencyBreakdowns += "}"
 	keysForSearchAttributes := make([]string, 0, len(this.SearchAttributes))
 	for k, _ := range this.SearchAttributes {
 		keysForSearchAttributes = append(keysForSearchAttributes, k)
@@ -6282,6 +7382,26 @@ func (this *WorkflowExecutionInfo) String() string {
 		mapStringForMemo += fmt.Sprintf("%v: %v,", k, this.Memo[k])
 	}
 	mapStringForMemo += "}"
+	keysForExternalSearchAttributes := make([]string, 0, len(this.ExternalSearchAttributes))
+	for k, _ := range this.ExternalSearchAttributes {
+		keysForExternalSearchAttributes = append(keysForExternalSearchAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExternalSearchAttributes)
+	mapStringForExternalSearchAttributes := "map[string]*v12.Payload{"
+	for _, k := range keysForExternalSearchAttributes {
+		mapStringForExternalSearchAttributes += fmt.Sprintf("%v: %v,", k, this.ExternalSearchAttributes[k])
+	}
+	mapStringForExternalSearchAttributes += "}"
+	keysForWorkflowReports := make([]string, 0, len(this.WorkflowReports))
+	for k, _ := range this.WorkflowReports {
+		keysForWorkflowReports = append(keysForWorkflowReports, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForWorkflowReports)
+	mapStringForWorkflowReports := "map[string]*v12.Payload{"
+	for _, k := range keysForWorkflowReports {
+		mapStringForWorkflowReports += fmt.Sprintf("%v: %v,", k, this.WorkflowReports[k])
+	}
+	mapStringForWorkflowReports += "}"
 	s := strings.Join([]string{`&WorkflowExecutionInfo{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
@@ -6333,6 +7453,55 @@ func (this *WorkflowExecutionInfo) String() string {
 		`FirstExecutionRunId:` + fmt.Sprintf("%v", this.FirstExecutionRunId) + `,`,
 		`ExecutionStats:` + strings.Replace(this.ExecutionStats.String(), "ExecutionStats", "ExecutionStats", 1) + `,`,
 		`WorkflowRunExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowRunExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StuckReason:` + fmt.Sprintf("%v", this.StuckReason) + `,`,
+		`OperatorAnnotations:` + repeatedStringForOperatorAnnotations + `,`,
+		`TaskLatencyBreakdowns:` + repeatedStringForTaskLatencyBreakdowns + `,`,
+		`ReplicationOversizedBatch:` + strings.Replace(this.ReplicationOversizedBatch.String(), "OversizedEventBatch", "OversizedEventBatch", 1) + `,`,
+		`ExternalSearchAttributes:` + mapStringForExternalSearchAttributes + `,`,
+		`WorkflowReports:` + mapStringForWorkflowReports + `,`,
+		`ResetBaseRunId:` + fmt.Sprintf("%v", this.ResetBaseRunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OperatorAnnotation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperatorAnnotation{`,
+		`Time:` + strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Annotation:` + fmt.Sprintf("%v", this.Annotation) + `,`,
+		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskLatencyBreakdown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskLatencyBreakdown{`,
+		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ScheduleToStartLatency:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartLatency), "Duration", "types.Duration", 1) + `,`,
+		`DispatchLatency:` + strings.Replace(fmt.Sprintf("%v", this.DispatchLatency), "Duration", "types.Duration", 1) + `,`,
+		`QueueLatency:` + strings.Replace(fmt.Sprintf("%v", this.QueueLatency), "Duration", "types.Duration", 1) + `,`,
+		`SyncMatch:` + fmt.Sprintf("%v", this.SyncMatch) + `,`,
+		`ForwardedFrom:` + fmt.Sprintf("%v", this.ForwardedFrom) + `,`,
+		`PollForwardedFrom:` + fmt.Sprintf("%v", this.PollForwardedFrom) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OversizedEventBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OversizedEventBatch{`,
+		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
+		`SizeBytes:` + fmt.Sprintf("%v", this.SizeBytes) + `,`,
+		`SizeLimitBytes:` + fmt.Sprintf("%v", this.SizeLimitBytes) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6476,6 +7645,7 @@ func (this *ActivityInfo) String() string {
 		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
 		`LastHeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatDetails), "Payloads", "v12.Payloads", 1) + `,`,
 		`LastHeartbeatUpdateTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatUpdateTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ActivityType:` + fmt.Sprintf("%v", this.ActivityType) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7297,6 +8467,25 @@ func (m *ShardInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoredConsistencyMarkerId", wireType)
+			}
+			m.RestoredConsistencyMarkerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestoredConsistencyMarkerId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -8847,11 +10036,834 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Memo[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 54:
+			m.Memo[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v13.VersionHistories{}
+			}
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstExecutionRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstExecutionRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionStats == nil {
+				m.ExecutionStats = &ExecutionStats{}
+			}
+			if err := m.ExecutionStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowRunExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowRunExpirationTime == nil {
+				m.WorkflowRunExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowRunExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StuckReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StuckReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAnnotations = append(m.OperatorAnnotations, &OperatorAnnotation{})
+			if err := m.OperatorAnnotations[len(m.OperatorAnnotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskLatencyBreakdowns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskLatencyBreakdowns = append(m.TaskLatencyBreakdowns, &TaskLatencyBreakdown{})
+			if err := m.TaskLatencyBreakdowns[len(m.TaskLatencyBreakdowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationOversizedBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReplicationOversizedBatch == nil {
+				m.ReplicationOversizedBatch = &OversizedEventBatch{}
+			}
+			if err := m.ReplicationOversizedBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalSearchAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalSearchAttributes == nil {
+				m.ExternalSearchAttributes = make(map[string]*v12.Payload)
+			}
+			var mapkey string
+			var mapvalue *v12.Payload
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v12.Payload{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExternalSearchAttributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowReports == nil {
+				m.WorkflowReports = make(map[string]*v12.Payload)
+			}
+			var mapkey string
+			var mapvalue *v12.Payload
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v12.Payload{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutions(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthExecutions
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.WorkflowReports[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 64:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetBaseRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResetBaseRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorAnnotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorAnnotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorAnnotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskLatencyBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskLatencyBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskLatencyBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8878,18 +10890,18 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v13.VersionHistories{}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
 			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 55:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstExecutionRunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartLatency", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExecutions
@@ -8899,27 +10911,31 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthExecutions
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthExecutions
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstExecutionRunId = string(dAtA[iNdEx:postIndex])
+			if m.ScheduleToStartLatency == nil {
+				m.ScheduleToStartLatency = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartLatency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 56:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchLatency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8946,16 +10962,16 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionStats == nil {
-				m.ExecutionStats = &ExecutionStats{}
+			if m.DispatchLatency == nil {
+				m.DispatchLatency = new(time.Duration)
 			}
-			if err := m.ExecutionStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.DispatchLatency, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 57:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowRunExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueLatency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8982,13 +10998,207 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowRunExpirationTime == nil {
-				m.WorkflowRunExpirationTime = new(time.Time)
+			if m.QueueLatency == nil {
+				m.QueueLatency = new(time.Duration)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowRunExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.QueueLatency, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SyncMatch = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollForwardedFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollForwardedFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OversizedEventBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OversizedEventBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OversizedEventBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
+			}
+			m.FirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeLimitBytes", wireType)
+			}
+			m.SizeLimitBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeLimitBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -11520,6 +13730,38 @@ func (m *ActivityInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
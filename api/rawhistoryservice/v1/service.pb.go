@@ -0,0 +1,160 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/rawhistoryservice/v1/service.proto
+
+package rawhistoryservice
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("temporal/server/api/rawhistoryservice/v1/service.proto", fileDescriptor_45a5677ae3aef616)
+}
+
+var fileDescriptor_45a5677ae3aef616 = []byte{
+	// 247 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x2b, 0x49, 0xcd, 0x2d,
+	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
+	0x2f, 0x4a, 0x2c, 0xcf, 0xc8, 0x2c, 0x2e, 0xc9, 0x2f, 0xaa, 0x04, 0x09, 0x66, 0x26, 0xa7, 0xea,
+	0x97, 0x19, 0xea, 0x43, 0x99, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x1a, 0x30, 0x7d, 0x7a,
+	0x10, 0x7d, 0x7a, 0x89, 0x05, 0x99, 0x7a, 0x18, 0xfa, 0xf4, 0xca, 0x0c, 0xa5, 0xec, 0x89, 0xb6,
+	0xa1, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x24, 0xbe, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x18,
+	0x6a, 0x95, 0xd1, 0x6d, 0x46, 0x2e, 0xc1, 0xa0, 0xc4, 0x72, 0x0f, 0x88, 0xfa, 0x60, 0x88, 0x7a,
+	0xa1, 0xa3, 0x8c, 0x5c, 0x72, 0xee, 0xa9, 0x25, 0xe1, 0xf9, 0x45, 0xd9, 0x69, 0x39, 0xf9, 0xe5,
+	0xae, 0x15, 0xa9, 0xc9, 0xa5, 0x25, 0x99, 0xf9, 0x79, 0x08, 0x95, 0x42, 0xfe, 0x7a, 0xc4, 0x3a,
+	0x52, 0x0f, 0xbf, 0x49, 0x41, 0x10, 0x87, 0x49, 0x05, 0x50, 0xcf, 0x40, 0x88, 0x0f, 0x95, 0x18,
+	0x9c, 0xca, 0x2e, 0x3c, 0x94, 0x63, 0xb8, 0xf1, 0x50, 0x8e, 0xe1, 0xc3, 0x43, 0x39, 0xc6, 0x86,
+	0x47, 0x72, 0x8c, 0x2b, 0x1e, 0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
+	0x83, 0x47, 0x72, 0x8c, 0x2f, 0x1e, 0xc9, 0x31, 0x7c, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
+	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x39, 0xa4, 0xe7, 0x23, 0xdc, 0x92,
+	0x99, 0x4f, 0x28, 0x68, 0xad, 0x31, 0x04, 0x93, 0xd8, 0xc0, 0x81, 0x6b, 0x0c, 0x18, 0x00, 0x66,
+	0x38, 0x2a, 0xca, 0x01, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RawHistoryServiceClient is the client API for RawHistoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RawHistoryServiceClient interface {
+	// GetWorkflowExecutionRawHistory returns raw history of a workflow execution, on the branch of the current
+	// version history, or on the branch of the version history which contains the given start and end events,
+	// e.g. a branch left behind by conflict resolution.
+	GetWorkflowExecutionRawHistory(ctx context.Context, in *GetWorkflowExecutionRawHistoryRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionRawHistoryResponse, error)
+}
+
+type rawHistoryServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRawHistoryServiceClient(cc *grpc.ClientConn) RawHistoryServiceClient {
+	return &rawHistoryServiceClient{cc}
+}
+
+func (c *rawHistoryServiceClient) GetWorkflowExecutionRawHistory(ctx context.Context, in *GetWorkflowExecutionRawHistoryRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionRawHistoryResponse, error) {
+	out := new(GetWorkflowExecutionRawHistoryResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.rawhistoryservice.v1.RawHistoryService/GetWorkflowExecutionRawHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RawHistoryServiceServer is the server API for RawHistoryService service.
+type RawHistoryServiceServer interface {
+	// GetWorkflowExecutionRawHistory returns raw history of a workflow execution, on the branch of the current
+	// version history, or on the branch of the version history which contains the given start and end events,
+	// e.g. a branch left behind by conflict resolution.
+	GetWorkflowExecutionRawHistory(context.Context, *GetWorkflowExecutionRawHistoryRequest) (*GetWorkflowExecutionRawHistoryResponse, error)
+}
+
+// UnimplementedRawHistoryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRawHistoryServiceServer struct {
+}
+
+func (*UnimplementedRawHistoryServiceServer) GetWorkflowExecutionRawHistory(ctx context.Context, req *GetWorkflowExecutionRawHistoryRequest) (*GetWorkflowExecutionRawHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionRawHistory not implemented")
+}
+
+func RegisterRawHistoryServiceServer(s *grpc.Server, srv RawHistoryServiceServer) {
+	s.RegisterService(&_RawHistoryService_serviceDesc, srv)
+}
+
+func _RawHistoryService_GetWorkflowExecutionRawHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionRawHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RawHistoryServiceServer).GetWorkflowExecutionRawHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.rawhistoryservice.v1.RawHistoryService/GetWorkflowExecutionRawHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RawHistoryServiceServer).GetWorkflowExecutionRawHistory(ctx, req.(*GetWorkflowExecutionRawHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RawHistoryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "temporal.server.api.rawhistoryservice.v1.RawHistoryService",
+	HandlerType: (*RawHistoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWorkflowExecutionRawHistory",
+			Handler:    _RawHistoryService_GetWorkflowExecutionRawHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "temporal/server/api/rawhistoryservice/v1/service.proto",
+}
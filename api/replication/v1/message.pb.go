@@ -41,6 +41,7 @@ import (
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v14 "go.temporal.io/api/common/v1"
+	v17 "go.temporal.io/api/enums/v1"
 	v15 "go.temporal.io/api/failure/v1"
 	v13 "go.temporal.io/api/history/v1"
 	v11 "go.temporal.io/api/namespace/v1"
@@ -1000,6 +1001,9 @@ type HistoryTaskV2Attributes struct {
 	Events              *v14.DataBlob             `protobuf:"bytes,6,opt,name=events,proto3" json:"events,omitempty"`
 	// New run events does not need version history since there is no prior events.
 	NewRunEvents *v14.DataBlob `protobuf:"bytes,7,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
+	// Set in place of events which are too large to be sent in one message,
+	// the events are then fetched chunk by chunk with GetReplicationEventChunk.
+	EventsChunkManifest *ReplicationEventChunkManifest `protobuf:"bytes,8,opt,name=events_chunk_manifest,json=eventsChunkManifest,proto3" json:"events_chunk_manifest,omitempty"`
 }
 
 func (m *HistoryTaskV2Attributes) Reset()      { *m = HistoryTaskV2Attributes{} }
@@ -1083,6 +1087,105 @@ func (m *HistoryTaskV2Attributes) GetNewRunEvents() *v14.DataBlob {
 	return nil
 }
 
+func (m *HistoryTaskV2Attributes) GetEventsChunkManifest() *ReplicationEventChunkManifest {
+	if m != nil {
+		return m.EventsChunkManifest
+	}
+	return nil
+}
+
+type ReplicationEventChunkManifest struct {
+	FirstEventId int64            `protobuf:"varint,1,opt,name=first_event_id,json=firstEventId,proto3" json:"first_event_id,omitempty"`
+	NextEventId  int64            `protobuf:"varint,2,opt,name=next_event_id,json=nextEventId,proto3" json:"next_event_id,omitempty"`
+	Version      int64            `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	EncodingType v17.EncodingType `protobuf:"varint,4,opt,name=encoding_type,json=encodingType,proto3,enum=temporal.api.enums.v1.EncodingType" json:"encoding_type,omitempty"`
+	EventsSize   int64            `protobuf:"varint,5,opt,name=events_size,json=eventsSize,proto3" json:"events_size,omitempty"`
+	ChunkSize    int32            `protobuf:"varint,6,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// CRC32 (IEEE) checksum of every chunk.
+	Checksums []uint32 `protobuf:"varint,7,rep,packed,name=checksums,proto3" json:"checksums,omitempty"`
+}
+
+func (m *ReplicationEventChunkManifest) Reset()      { *m = ReplicationEventChunkManifest{} }
+func (*ReplicationEventChunkManifest) ProtoMessage() {}
+func (*ReplicationEventChunkManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edd9fae2af6b0532, []int{11}
+}
+func (m *ReplicationEventChunkManifest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationEventChunkManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationEventChunkManifest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationEventChunkManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationEventChunkManifest.Merge(m, src)
+}
+func (m *ReplicationEventChunkManifest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationEventChunkManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationEventChunkManifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationEventChunkManifest proto.InternalMessageInfo
+
+func (m *ReplicationEventChunkManifest) GetFirstEventId() int64 {
+	if m != nil {
+		return m.FirstEventId
+	}
+	return 0
+}
+
+func (m *ReplicationEventChunkManifest) GetNextEventId() int64 {
+	if m != nil {
+		return m.NextEventId
+	}
+	return 0
+}
+
+func (m *ReplicationEventChunkManifest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ReplicationEventChunkManifest) GetEncodingType() v17.EncodingType {
+	if m != nil {
+		return m.EncodingType
+	}
+	return v17.ENCODING_TYPE_UNSPECIFIED
+}
+
+func (m *ReplicationEventChunkManifest) GetEventsSize() int64 {
+	if m != nil {
+		return m.EventsSize
+	}
+	return 0
+}
+
+func (m *ReplicationEventChunkManifest) GetChunkSize() int32 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *ReplicationEventChunkManifest) GetChecksums() []uint32 {
+	if m != nil {
+		return m.Checksums
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ReplicationTask)(nil), "temporal.server.api.replication.v1.ReplicationTask")
 	proto.RegisterType((*ReplicationToken)(nil), "temporal.server.api.replication.v1.ReplicationToken")
@@ -1095,6 +1198,7 @@ func init() {
 	proto.RegisterType((*SyncShardStatusTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncShardStatusTaskAttributes")
 	proto.RegisterType((*SyncActivityTaskAttributes)(nil), "temporal.server.api.replication.v1.SyncActivityTaskAttributes")
 	proto.RegisterType((*HistoryTaskV2Attributes)(nil), "temporal.server.api.replication.v1.HistoryTaskV2Attributes")
+	proto.RegisterType((*ReplicationEventChunkManifest)(nil), "temporal.server.api.replication.v1.ReplicationEventChunkManifest")
 }
 
 func init() {
@@ -1102,101 +1206,110 @@ func init() {
 }
 
 var fileDescriptor_edd9fae2af6b0532 = []byte{
-	// 1503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0xf2, 0xcd, 0xe1, 0xd3, 0xa3, 0xab, 0x2b, 0x8a, 0x80, 0x68, 0x89, 0xb0, 0xaf, 0xe5,
-	0x8b, 0x60, 0x69, 0x51, 0x45, 0x62, 0x3b, 0x08, 0x20, 0x39, 0x71, 0x44, 0x01, 0x76, 0x8c, 0xb5,
-	0x60, 0x03, 0x69, 0x36, 0x23, 0xee, 0x90, 0x5c, 0x88, 0xdc, 0x25, 0x66, 0x86, 0x54, 0x98, 0x2a,
-	0x40, 0x8a, 0x34, 0x09, 0xe0, 0xff, 0xe0, 0x20, 0x48, 0x95, 0xdf, 0xe1, 0xd2, 0x4d, 0x00, 0xa7,
-	0x4a, 0x4c, 0x37, 0x29, 0xdd, 0xa5, 0x0d, 0xe6, 0xb1, 0xe4, 0x2e, 0x97, 0xa4, 0x19, 0x07, 0xae,
-	0xd2, 0x71, 0xce, 0xe3, 0x3b, 0x33, 0x67, 0xce, 0x39, 0xdf, 0x2c, 0xc1, 0x0d, 0x86, 0x7b, 0x7d,
-	0x97, 0xa0, 0x6e, 0x8d, 0x62, 0x32, 0xc4, 0xa4, 0x86, 0xfa, 0x76, 0x8d, 0xe0, 0x7e, 0xd7, 0x6e,
-	0x22, 0x66, 0xbb, 0x4e, 0x6d, 0xb8, 0x5f, 0xeb, 0x61, 0x4a, 0x51, 0x1b, 0xeb, 0x7d, 0xe2, 0x32,
-	0x17, 0x56, 0x3d, 0x0f, 0x5d, 0x7a, 0xe8, 0xa8, 0x6f, 0xeb, 0x3e, 0x0f, 0x7d, 0xb8, 0x5f, 0xbe,
-	0xdc, 0x76, 0xdd, 0x76, 0x17, 0xd7, 0x84, 0xc7, 0xd9, 0xa0, 0x55, 0x63, 0x76, 0x0f, 0x53, 0x86,
-	0x7a, 0x7d, 0x09, 0x52, 0xde, 0xb5, 0x70, 0x1f, 0x3b, 0x16, 0x76, 0x9a, 0x36, 0xa6, 0xb5, 0xb6,
-	0xdb, 0x76, 0x85, 0x5c, 0xfc, 0x52, 0x26, 0xfa, 0xbc, 0x9d, 0x61, 0x67, 0xd0, 0xa3, 0x7c, 0x4f,
-	0xfe, 0x80, 0xd2, 0xfe, 0xda, 0x52, 0x7b, 0x86, 0xe8, 0xb9, 0x32, 0x7c, 0x6f, 0x9e, 0x61, 0xc7,
-	0xa6, 0xcc, 0x25, 0xa3, 0xd0, 0x71, 0xcb, 0x57, 0x26, 0xd6, 0xdc, 0xac, 0xe9, 0xf6, 0x7a, 0x73,
-	0x92, 0xe2, 0x0b, 0xce, 0xad, 0x1c, 0xd4, 0xc3, 0xb4, 0x8f, 0x9a, 0x38, 0x6c, 0x78, 0x3d, 0x60,
-	0xb8, 0x2c, 0xd1, 0xe5, 0xab, 0x01, 0xd3, 0x85, 0x1b, 0x0c, 0x9a, 0xb5, 0x90, 0xdd, 0x1d, 0x90,
-	0x70, 0xe0, 0xea, 0x8f, 0x49, 0x50, 0x30, 0xa6, 0xe1, 0x4e, 0x11, 0x3d, 0x87, 0xf7, 0x41, 0x9a,
-	0xe7, 0xc5, 0x64, 0xa3, 0x3e, 0x2e, 0x69, 0x3b, 0xda, 0x5e, 0xbe, 0xbe, 0xaf, 0xcf, 0xbb, 0x5e,
-	0x91, 0x46, 0x7d, 0xb8, 0xaf, 0xcf, 0x20, 0x9c, 0x8e, 0xfa, 0xd8, 0x48, 0x31, 0xf5, 0x0b, 0x5e,
-	0x01, 0x79, 0xea, 0x0e, 0x48, 0x13, 0x9b, 0x02, 0xd6, 0xb6, 0x4a, 0x91, 0x1d, 0x6d, 0x2f, 0x6a,
-	0x64, 0xa5, 0x94, 0x7b, 0x34, 0x2c, 0x38, 0x02, 0x5b, 0x93, 0x04, 0x49, 0x43, 0xc4, 0x18, 0xb1,
-	0xcf, 0x06, 0x0c, 0xd3, 0x52, 0x74, 0x47, 0xdb, 0xcb, 0xd4, 0x6f, 0xeb, 0x6f, 0x2e, 0x32, 0xfd,
-	0xbe, 0x07, 0xc2, 0x71, 0x0f, 0x27, 0x10, 0xc7, 0x6b, 0xc6, 0xa6, 0x33, 0x5f, 0x05, 0x29, 0xd8,
-	0x54, 0x79, 0x0c, 0x05, 0x8e, 0x89, 0xc0, 0x37, 0x57, 0x09, 0x7c, 0x2c, 0x21, 0x42, 0x61, 0x37,
-	0x3a, 0xf3, 0x14, 0xf0, 0x7b, 0x0d, 0xec, 0xd2, 0x91, 0xd3, 0x34, 0x69, 0x07, 0x11, 0xcb, 0xa4,
-	0x0c, 0xb1, 0x01, 0x0d, 0xc5, 0x8f, 0x8b, 0xf8, 0x87, 0xab, 0xc4, 0x7f, 0x38, 0x72, 0x9a, 0x0f,
-	0x39, 0xd6, 0x43, 0x01, 0x15, 0xda, 0xc7, 0x36, 0x5d, 0x66, 0x00, 0xbf, 0xd1, 0x80, 0xb0, 0x30,
-	0x51, 0x93, 0xd9, 0x43, 0x9b, 0x85, 0x73, 0x91, 0x10, 0x7b, 0xf9, 0x68, 0xd5, 0xbd, 0x1c, 0x2a,
-	0x9c, 0xd0, 0x46, 0xca, 0x74, 0xa1, 0x16, 0x7e, 0xa7, 0x81, 0x1d, 0xef, 0x2e, 0x7a, 0x98, 0x21,
-	0x0b, 0x31, 0x14, 0xda, 0x48, 0x72, 0xf5, 0xa4, 0xa8, 0x4b, 0xb9, 0xa7, 0xa0, 0xc2, 0x49, 0xe9,
-	0x2c, 0x33, 0x80, 0x5f, 0x81, 0x72, 0xa0, 0x32, 0x86, 0x75, 0xff, 0x3e, 0x52, 0xab, 0x57, 0xa5,
-	0xaf, 0x38, 0x1e, 0xd5, 0x83, 0x55, 0xd9, 0x99, 0xaf, 0x3a, 0xca, 0x02, 0x30, 0x8d, 0x55, 0x7d,
-	0xaa, 0x81, 0xa2, 0xbf, 0xcd, 0xdc, 0x73, 0xec, 0xc0, 0x2d, 0x90, 0x92, 0xd5, 0x63, 0x5b, 0xa2,
-	0x51, 0xe3, 0x46, 0x52, 0xac, 0x1b, 0x16, 0xbc, 0x09, 0xb6, 0xba, 0x88, 0x32, 0x93, 0x60, 0x46,
-	0x6c, 0x3c, 0xc4, 0x96, 0xa9, 0x1a, 0x7f, 0xda, 0x7f, 0xff, 0xe5, 0x06, 0x86, 0xa7, 0xbf, 0x27,
-	0xd5, 0x3e, 0xd7, 0x3e, 0x71, 0x9b, 0x98, 0xd2, 0xa0, 0x6b, 0x74, 0xea, 0xfa, 0xc0, 0xd3, 0x4f,
-	0x5c, 0xab, 0xa7, 0xa0, 0x30, 0x53, 0x86, 0xf0, 0x10, 0x64, 0xbc, 0xda, 0xb6, 0x7b, 0x72, 0x9e,
-	0x64, 0xea, 0x65, 0x5d, 0x52, 0x81, 0xee, 0x51, 0x81, 0x7e, 0xea, 0x51, 0xc1, 0x51, 0xec, 0xc9,
-	0x6f, 0x97, 0x35, 0x03, 0x48, 0x27, 0x2e, 0xae, 0xfe, 0x1c, 0x01, 0xeb, 0xbe, 0xb3, 0xab, 0x70,
-	0x14, 0x7e, 0x01, 0x2e, 0xf9, 0xd2, 0x2c, 0x6e, 0x88, 0x96, 0xb4, 0x9d, 0xe8, 0x5e, 0xa6, 0x7e,
-	0xb0, 0xca, 0xa5, 0xcc, 0x8c, 0x2d, 0xa3, 0x48, 0x82, 0x02, 0xfa, 0x4f, 0xb2, 0xb8, 0x05, 0x52,
-	0x1d, 0x44, 0xcd, 0x9e, 0x4b, 0xb0, 0x48, 0x5a, 0xca, 0x48, 0x76, 0x10, 0xbd, 0xe7, 0x12, 0x0c,
-	0x4d, 0x70, 0x29, 0xd4, 0xf9, 0x6a, 0xd2, 0x1c, 0xbc, 0x45, 0xa7, 0x1b, 0x85, 0x99, 0xce, 0xae,
-	0xfe, 0x12, 0x4c, 0x98, 0x98, 0xb0, 0x4e, 0xcb, 0x85, 0xbb, 0x20, 0x3b, 0x9d, 0xb1, 0xaa, 0x66,
-	0xd2, 0x46, 0x66, 0x22, 0x6b, 0x58, 0xf0, 0x32, 0xc8, 0x5c, 0xb8, 0xe4, 0xbc, 0xd5, 0x75, 0x2f,
-	0xbc, 0x33, 0xa6, 0x0d, 0xe0, 0x89, 0x1a, 0x16, 0xdc, 0x00, 0x09, 0x32, 0x70, 0xbc, 0x52, 0x48,
-	0x1b, 0x71, 0x32, 0x70, 0x1a, 0x16, 0xbc, 0xe3, 0x27, 0x8d, 0x98, 0x20, 0x8d, 0xff, 0x2d, 0x27,
-	0x8d, 0x39, 0x4c, 0xb1, 0x09, 0x92, 0x1e, 0x45, 0xc4, 0x45, 0x72, 0x13, 0x4c, 0x92, 0x43, 0x09,
-	0x24, 0x87, 0x98, 0x50, 0xdb, 0x75, 0xc4, 0x14, 0x8a, 0x1a, 0xde, 0x92, 0x93, 0x4b, 0xcb, 0x26,
-	0x94, 0x99, 0x78, 0x88, 0x1d, 0xc6, 0x3d, 0x93, 0x92, 0x5c, 0x84, 0xf4, 0x13, 0x2e, 0x6c, 0x58,
-	0xb0, 0x0a, 0x72, 0x0e, 0xfe, 0xd2, 0x67, 0x94, 0x12, 0x46, 0x19, 0x2e, 0xf4, 0x6c, 0x76, 0x41,
-	0x96, 0x36, 0x3b, 0xd8, 0x1a, 0x74, 0xb1, 0x68, 0xa8, 0xb4, 0x34, 0x99, 0xc8, 0x1a, 0x56, 0xf5,
-	0x59, 0x14, 0x6c, 0x2e, 0xe0, 0x17, 0x88, 0xc0, 0xfa, 0x34, 0xb7, 0x6e, 0x1f, 0x13, 0x91, 0x7a,
-	0xc5, 0x9f, 0x37, 0x96, 0xa7, 0x62, 0x82, 0xf9, 0x99, 0xe7, 0x67, 0x40, 0x27, 0x24, 0x83, 0x79,
-	0x10, 0x99, 0x5c, 0x49, 0xc4, 0xb6, 0xe0, 0x87, 0x20, 0x66, 0x3b, 0x2d, 0x57, 0xb1, 0xe3, 0xde,
-	0x34, 0x06, 0x07, 0x9f, 0xf8, 0x07, 0x02, 0xf0, 0x32, 0x30, 0x84, 0x17, 0x3c, 0x02, 0x89, 0xa6,
-	0xeb, 0xb4, 0xec, 0xb6, 0x2a, 0xbd, 0xff, 0xaf, 0xe2, 0x7f, 0x47, 0x78, 0x18, 0xca, 0x13, 0xb6,
-	0x00, 0xf4, 0x77, 0xa0, 0xc2, 0x93, 0xa4, 0xf5, 0x7e, 0x10, 0x6f, 0x11, 0x4d, 0xfb, 0xea, 0x54,
-	0x81, 0xfb, 0x9b, 0x5a, 0x8a, 0xe0, 0x55, 0x90, 0x97, 0xd8, 0x66, 0xb0, 0x0c, 0x72, 0x52, 0xfa,
-	0x48, 0x15, 0xc3, 0x75, 0x50, 0xe4, 0x2f, 0x1d, 0x77, 0x88, 0xc9, 0xc4, 0x50, 0x96, 0x43, 0xc1,
-	0x93, 0x2b, 0xd3, 0xea, 0xd3, 0x28, 0xd8, 0x98, 0xcb, 0xd8, 0xf0, 0x1a, 0x28, 0x30, 0x44, 0xda,
-	0x98, 0x99, 0xcd, 0xee, 0x80, 0x32, 0x4c, 0xe4, 0x4c, 0x49, 0x1b, 0x79, 0x29, 0xbe, 0xa3, 0xa4,
-	0xa1, 0x6e, 0x8a, 0xbc, 0xb1, 0x9b, 0xa2, 0x4b, 0xba, 0x29, 0xe6, 0xef, 0xa6, 0x70, 0x55, 0xc7,
-	0x57, 0xa9, 0xea, 0x44, 0xb8, 0xaa, 0x7d, 0x9d, 0x93, 0x0c, 0x76, 0xce, 0x2d, 0x90, 0x54, 0xd4,
-	0x23, 0x4a, 0x3d, 0x53, 0xdf, 0x09, 0x5e, 0x98, 0x52, 0xfa, 0xd8, 0xcb, 0xf0, 0x1c, 0xe0, 0x31,
-	0x28, 0x38, 0xf8, 0xc2, 0xe4, 0x5b, 0xf7, 0x30, 0xc0, 0x8a, 0x18, 0x39, 0x07, 0x5f, 0x18, 0x03,
-	0x47, 0x2d, 0x4f, 0x62, 0xa9, 0x54, 0x31, 0x7d, 0x12, 0x4b, 0x65, 0x8a, 0xd9, 0x93, 0x58, 0x2a,
-	0x5b, 0xcc, 0x9d, 0xc4, 0x52, 0xb9, 0x62, 0xfe, 0x24, 0x96, 0xca, 0x17, 0x0b, 0xd5, 0x6f, 0x23,
-	0x60, 0x7b, 0x29, 0x85, 0xff, 0x5b, 0x6e, 0xab, 0xfa, 0x83, 0x06, 0xb6, 0x97, 0xbe, 0xf0, 0x78,
-	0x8f, 0xa8, 0x67, 0xb6, 0xca, 0x84, 0x1a, 0xef, 0x39, 0x29, 0x55, 0x89, 0x08, 0xbc, 0x19, 0x22,
-	0xc1, 0x37, 0xc3, 0x0c, 0x55, 0x47, 0xdf, 0x82, 0xaa, 0x7f, 0x8d, 0x83, 0xf2, 0xe2, 0xc7, 0xdf,
-	0xbb, 0x24, 0x20, 0x5f, 0xea, 0x62, 0xc1, 0x42, 0x9f, 0x1d, 0xec, 0xf1, 0xd0, 0x60, 0x87, 0x9f,
-	0x82, 0xfc, 0xd4, 0x44, 0x1c, 0x3e, 0xb1, 0xe2, 0xe1, 0x73, 0x13, 0x3f, 0xae, 0x81, 0xdb, 0x80,
-	0x67, 0x83, 0x30, 0x19, 0x49, 0xde, 0x61, 0x5a, 0x49, 0x04, 0x4b, 0x66, 0x3d, 0xb5, 0x88, 0x92,
-	0x5a, 0x31, 0x4a, 0x46, 0x79, 0x89, 0x18, 0x0f, 0xc0, 0xba, 0x78, 0x94, 0x74, 0x30, 0x22, 0xec,
-	0x0c, 0x23, 0x26, 0xb1, 0xd2, 0x2b, 0x62, 0x5d, 0xe2, 0xce, 0xc7, 0x9e, 0xaf, 0x40, 0xbc, 0x05,
-	0x92, 0x16, 0x66, 0xc8, 0xee, 0xd2, 0xf9, 0x6d, 0x2c, 0xbf, 0x6f, 0x79, 0x17, 0x3f, 0x40, 0xa3,
-	0xae, 0x8b, 0x2c, 0x6a, 0x78, 0x0e, 0x3c, 0xef, 0x88, 0x71, 0x6b, 0x56, 0xca, 0xc8, 0x72, 0x52,
-	0x4b, 0x7e, 0x58, 0xb1, 0x4f, 0xf5, 0xf1, 0x59, 0xca, 0xce, 0x83, 0x56, 0x4a, 0x8e, 0x7d, 0x57,
-	0xfe, 0x34, 0x32, 0xdc, 0x4b, 0x2d, 0xe0, 0x0d, 0xf0, 0x1f, 0x01, 0xc2, 0x0b, 0x00, 0x13, 0xd3,
-	0xb6, 0xb0, 0xc3, 0x6c, 0x36, 0x2a, 0xe5, 0xc4, 0xdd, 0x43, 0xae, 0x7b, 0x2c, 0x54, 0x0d, 0xa5,
-	0x81, 0x8f, 0x41, 0x41, 0xdd, 0xfc, 0x64, 0x36, 0xe5, 0x45, 0x64, 0x7d, 0x2e, 0x09, 0xfb, 0x46,
-	0x94, 0xe2, 0x06, 0x6f, 0x52, 0xe5, 0x87, 0x81, 0x75, 0xf5, 0xcf, 0x08, 0xd8, 0x5c, 0xf0, 0x8e,
-	0xf7, 0xbf, 0x5c, 0xb4, 0xc0, 0xcb, 0xe5, 0x1d, 0x8e, 0x9d, 0x16, 0xd8, 0x98, 0x39, 0xa8, 0x69,
-	0x33, 0xdc, 0xe3, 0x1f, 0x8d, 0xfc, 0x09, 0x5c, 0xff, 0x7b, 0xc7, 0x6d, 0x30, 0xdc, 0x33, 0xd6,
-	0x87, 0x21, 0x19, 0x85, 0x1f, 0x80, 0x84, 0x98, 0x59, 0xde, 0x17, 0xe0, 0xc2, 0xe2, 0xf8, 0x18,
-	0x31, 0x74, 0xd4, 0x75, 0xcf, 0x0c, 0x65, 0x0f, 0xef, 0x82, 0xbc, 0x47, 0x13, 0x0a, 0x21, 0xb9,
-	0x22, 0x42, 0x56, 0xb2, 0x84, 0x98, 0x8b, 0xf4, 0xc8, 0x7e, 0xfe, 0xb2, 0xb2, 0xf6, 0xe2, 0x65,
-	0x65, 0xed, 0xf5, 0xcb, 0x8a, 0xf6, 0xf5, 0xb8, 0xa2, 0xfd, 0x34, 0xae, 0x68, 0xcf, 0xc6, 0x15,
-	0xed, 0xf9, 0xb8, 0xa2, 0xfd, 0x3e, 0xae, 0x68, 0x7f, 0x8c, 0x2b, 0x6b, 0xaf, 0xc7, 0x15, 0xed,
-	0xc9, 0xab, 0xca, 0xda, 0xf3, 0x57, 0x95, 0xb5, 0x17, 0xaf, 0x2a, 0x6b, 0x9f, 0x1f, 0xb4, 0xdd,
-	0x69, 0x1c, 0xdb, 0x5d, 0xfc, 0x57, 0xd6, 0x6d, 0x82, 0xfb, 0x6a, 0x75, 0x96, 0x10, 0x7d, 0x73,
-	0xf0, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0xb4, 0x0f, 0x2d, 0x02, 0x13, 0x00, 0x00,
+	// 1639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
+	0x16, 0x37, 0x25, 0x59, 0x1f, 0xa3, 0x4f, 0x8f, 0xd7, 0x6b, 0x59, 0x58, 0x2b, 0xb6, 0x36, 0xd9,
+	0x38, 0x8b, 0x05, 0x15, 0xcb, 0x87, 0xdd, 0x24, 0x8b, 0x05, 0x6c, 0x6f, 0xb2, 0x96, 0x01, 0x67,
+	0x03, 0xda, 0x48, 0x80, 0x5e, 0xd8, 0x31, 0x39, 0x92, 0x08, 0x4b, 0xa4, 0xc0, 0x19, 0xc9, 0x55,
+	0x4e, 0x05, 0x7a, 0xe8, 0xa5, 0x05, 0xf2, 0x3f, 0xa4, 0x28, 0x0a, 0x14, 0xe8, 0xdf, 0x91, 0x63,
+	0x2e, 0x05, 0xd2, 0x53, 0x1b, 0xe7, 0xd2, 0x53, 0x91, 0x3f, 0xa1, 0x98, 0x0f, 0x4a, 0xa4, 0x28,
+	0x29, 0x6c, 0x8a, 0x9c, 0x7a, 0xd3, 0xbc, 0x8f, 0xdf, 0x9b, 0x79, 0xf3, 0xde, 0xfb, 0x0d, 0x05,
+	0x6e, 0x53, 0xdc, 0xeb, 0x3b, 0x2e, 0xea, 0xd6, 0x09, 0x76, 0x87, 0xd8, 0xad, 0xa3, 0xbe, 0x55,
+	0x77, 0x71, 0xbf, 0x6b, 0x19, 0x88, 0x5a, 0x8e, 0x5d, 0x1f, 0xee, 0xd6, 0x7b, 0x98, 0x10, 0xd4,
+	0xc6, 0x6a, 0xdf, 0x75, 0xa8, 0x03, 0x6b, 0x9e, 0x87, 0x2a, 0x3c, 0x54, 0xd4, 0xb7, 0x54, 0x9f,
+	0x87, 0x3a, 0xdc, 0xad, 0x5c, 0x6b, 0x3b, 0x4e, 0xbb, 0x8b, 0xeb, 0xdc, 0xe3, 0x7c, 0xd0, 0xaa,
+	0x53, 0xab, 0x87, 0x09, 0x45, 0xbd, 0xbe, 0x00, 0xa9, 0x6c, 0x9b, 0xb8, 0x8f, 0x6d, 0x13, 0xdb,
+	0x86, 0x85, 0x49, 0xbd, 0xed, 0xb4, 0x1d, 0x2e, 0xe7, 0xbf, 0xa4, 0x89, 0x3a, 0x6b, 0x67, 0xd8,
+	0x1e, 0xf4, 0x08, 0xdb, 0x93, 0x3f, 0xa0, 0xb0, 0xbf, 0xb9, 0xd0, 0x9e, 0x22, 0x72, 0x21, 0x0d,
+	0xff, 0x31, 0xcb, 0xb0, 0x63, 0x11, 0xea, 0xb8, 0xa3, 0xd0, 0x71, 0x2b, 0xd7, 0xc7, 0xd6, 0xcc,
+	0xcc, 0x70, 0x7a, 0xbd, 0x19, 0x49, 0xa9, 0xd4, 0x02, 0x56, 0xe3, 0xa8, 0xc2, 0x3c, 0xb4, 0x41,
+	0x66, 0x63, 0xa3, 0x1e, 0x26, 0x7d, 0x64, 0xe0, 0x30, 0xd8, 0xad, 0x80, 0xe1, 0xa2, 0xcb, 0xa8,
+	0xdc, 0x08, 0x98, 0xce, 0x3d, 0x44, 0xd0, 0xac, 0x85, 0xac, 0xee, 0xc0, 0x0d, 0x07, 0xae, 0x7d,
+	0x9d, 0x02, 0x45, 0x6d, 0x12, 0xee, 0x0c, 0x91, 0x0b, 0xf8, 0x10, 0x64, 0x58, 0xee, 0x74, 0x3a,
+	0xea, 0xe3, 0xb2, 0xb2, 0xa5, 0xec, 0x14, 0x1a, 0xbb, 0xea, 0xac, 0x12, 0xe0, 0x87, 0x56, 0x87,
+	0xbb, 0xea, 0x14, 0xc2, 0xd9, 0xa8, 0x8f, 0xb5, 0x34, 0x95, 0xbf, 0xe0, 0x75, 0x50, 0x20, 0xce,
+	0xc0, 0x35, 0xb0, 0xce, 0x61, 0x2d, 0xb3, 0x1c, 0xdb, 0x52, 0x76, 0xe2, 0x5a, 0x4e, 0x48, 0x99,
+	0x47, 0xd3, 0x84, 0x23, 0xb0, 0x31, 0x4e, 0x90, 0x30, 0x44, 0x94, 0xba, 0xd6, 0xf9, 0x80, 0x62,
+	0x52, 0x8e, 0x6f, 0x29, 0x3b, 0xd9, 0xc6, 0x3d, 0xf5, 0xdd, 0x85, 0xa8, 0x3e, 0xf4, 0x40, 0x18,
+	0xee, 0xfe, 0x18, 0xe2, 0x68, 0x49, 0x5b, 0xb7, 0x67, 0xab, 0x20, 0x01, 0xeb, 0x32, 0x8f, 0xa1,
+	0xc0, 0x09, 0x1e, 0xf8, 0x4e, 0x94, 0xc0, 0x47, 0x02, 0x22, 0x14, 0x76, 0xad, 0x33, 0x4b, 0x01,
+	0xbf, 0x54, 0xc0, 0x36, 0x19, 0xd9, 0x86, 0x4e, 0x3a, 0xc8, 0x35, 0x75, 0x42, 0x11, 0x1d, 0x90,
+	0x50, 0xfc, 0x65, 0x1e, 0x7f, 0x3f, 0x4a, 0xfc, 0xd3, 0x91, 0x6d, 0x9c, 0x32, 0xac, 0x53, 0x0e,
+	0x15, 0xda, 0xc7, 0x26, 0x59, 0x64, 0x00, 0x3f, 0x53, 0x00, 0xb7, 0xd0, 0x91, 0x41, 0xad, 0xa1,
+	0x45, 0xc3, 0xb9, 0x48, 0xf2, 0xbd, 0xfc, 0x27, 0xea, 0x5e, 0xf6, 0x25, 0x4e, 0x68, 0x23, 0x15,
+	0x32, 0x57, 0x0b, 0xbf, 0x50, 0xc0, 0x96, 0x77, 0x17, 0x3d, 0x4c, 0x91, 0x89, 0x28, 0x0a, 0x6d,
+	0x24, 0x15, 0x3d, 0x29, 0xf2, 0x52, 0x4e, 0x24, 0x54, 0x38, 0x29, 0x9d, 0x45, 0x06, 0xf0, 0x29,
+	0xa8, 0x04, 0x2a, 0x63, 0xd8, 0xf0, 0xef, 0x23, 0x1d, 0xbd, 0x2a, 0x7d, 0xc5, 0xf1, 0xb8, 0x11,
+	0xac, 0xca, 0xce, 0x6c, 0xd5, 0x41, 0x0e, 0x80, 0x49, 0xac, 0xda, 0x73, 0x05, 0x94, 0xfc, 0x6d,
+	0xe6, 0x5c, 0x60, 0x1b, 0x6e, 0x80, 0xb4, 0xa8, 0x1e, 0xcb, 0xe4, 0x8d, 0xba, 0xac, 0xa5, 0xf8,
+	0xba, 0x69, 0xc2, 0x3b, 0x60, 0xa3, 0x8b, 0x08, 0xd5, 0x5d, 0x4c, 0x5d, 0x0b, 0x0f, 0xb1, 0xa9,
+	0xcb, 0xc6, 0x9f, 0xf4, 0xdf, 0x9f, 0x99, 0x81, 0xe6, 0xe9, 0x4f, 0x84, 0xda, 0xe7, 0xda, 0x77,
+	0x1d, 0x03, 0x13, 0x12, 0x74, 0x8d, 0x4f, 0x5c, 0x1f, 0x79, 0xfa, 0xb1, 0x6b, 0xed, 0x0c, 0x14,
+	0xa7, 0xca, 0x10, 0xee, 0x83, 0xac, 0x57, 0xdb, 0x56, 0x4f, 0xcc, 0x93, 0x6c, 0xa3, 0xa2, 0x0a,
+	0xba, 0x50, 0x3d, 0xba, 0x50, 0xcf, 0x3c, 0xba, 0x38, 0x48, 0x3c, 0xfb, 0xf1, 0x9a, 0xa2, 0x01,
+	0xe1, 0xc4, 0xc4, 0xb5, 0xef, 0x62, 0x60, 0xd5, 0x77, 0x76, 0x19, 0x8e, 0xc0, 0x8f, 0xc1, 0x8a,
+	0x2f, 0xcd, 0xfc, 0x86, 0x48, 0x59, 0xd9, 0x8a, 0xef, 0x64, 0x1b, 0x7b, 0x51, 0x2e, 0x65, 0x6a,
+	0x6c, 0x69, 0x25, 0x37, 0x28, 0x20, 0xbf, 0x27, 0x8b, 0x1b, 0x20, 0xdd, 0x41, 0x44, 0xef, 0x39,
+	0x2e, 0xe6, 0x49, 0x4b, 0x6b, 0xa9, 0x0e, 0x22, 0x27, 0x8e, 0x8b, 0xa1, 0x0e, 0x56, 0x42, 0x9d,
+	0x2f, 0x27, 0xcd, 0xde, 0x7b, 0x74, 0xba, 0x56, 0x9c, 0xea, 0xec, 0xda, 0xf7, 0xc1, 0x84, 0xf1,
+	0x09, 0x6b, 0xb7, 0x1c, 0xb8, 0x0d, 0x72, 0x93, 0x19, 0x2b, 0x6b, 0x26, 0xa3, 0x65, 0xc7, 0xb2,
+	0xa6, 0x09, 0xaf, 0x81, 0xec, 0xa5, 0xe3, 0x5e, 0xb4, 0xba, 0xce, 0xa5, 0x77, 0xc6, 0x8c, 0x06,
+	0x3c, 0x51, 0xd3, 0x84, 0x6b, 0x20, 0xe9, 0x0e, 0x6c, 0xaf, 0x14, 0x32, 0xda, 0xb2, 0x3b, 0xb0,
+	0x9b, 0x26, 0x3c, 0xf4, 0x93, 0x46, 0x82, 0x93, 0xc6, 0xdf, 0x16, 0x93, 0xc6, 0x0c, 0xa6, 0x58,
+	0x07, 0x29, 0x8f, 0x22, 0x96, 0x79, 0x72, 0x93, 0x54, 0x90, 0x43, 0x19, 0xa4, 0x86, 0xd8, 0x25,
+	0x96, 0x63, 0xf3, 0x29, 0x14, 0xd7, 0xbc, 0x25, 0x23, 0x97, 0x96, 0xe5, 0x12, 0xaa, 0xe3, 0x21,
+	0xb6, 0x29, 0xf3, 0x4c, 0x09, 0x72, 0xe1, 0xd2, 0xfb, 0x4c, 0xd8, 0x34, 0x61, 0x0d, 0xe4, 0x6d,
+	0xfc, 0x89, 0xcf, 0x28, 0xcd, 0x8d, 0xb2, 0x4c, 0xe8, 0xd9, 0x6c, 0x83, 0x1c, 0x31, 0x3a, 0xd8,
+	0x1c, 0x74, 0x31, 0x6f, 0xa8, 0x8c, 0x30, 0x19, 0xcb, 0x9a, 0x66, 0xed, 0x45, 0x1c, 0xac, 0xcf,
+	0xe1, 0x17, 0x88, 0xc0, 0xea, 0x24, 0xb7, 0x4e, 0x1f, 0xbb, 0x3c, 0xf5, 0x92, 0x3f, 0x6f, 0x2f,
+	0x4e, 0xc5, 0x18, 0xf3, 0xff, 0x9e, 0x9f, 0x06, 0xed, 0x90, 0x0c, 0x16, 0x40, 0x6c, 0x7c, 0x25,
+	0x31, 0xcb, 0x84, 0xff, 0x06, 0x09, 0xcb, 0x6e, 0x39, 0x92, 0x1d, 0x77, 0x26, 0x31, 0x18, 0xf8,
+	0xd8, 0x3f, 0x10, 0x80, 0x95, 0x81, 0xc6, 0xbd, 0xe0, 0x01, 0x48, 0x1a, 0x8e, 0xdd, 0xb2, 0xda,
+	0xb2, 0xf4, 0xfe, 0x1e, 0xc5, 0xff, 0x90, 0x7b, 0x68, 0xd2, 0x13, 0xb6, 0x00, 0xf4, 0x77, 0xa0,
+	0xc4, 0x13, 0xa4, 0xf5, 0xcf, 0x20, 0xde, 0x3c, 0x9a, 0xf6, 0xd5, 0xa9, 0x04, 0x5f, 0x71, 0xa7,
+	0x45, 0xf0, 0x06, 0x28, 0x08, 0x6c, 0x3d, 0x58, 0x06, 0x79, 0x21, 0x7d, 0x2c, 0x8b, 0xe1, 0x16,
+	0x28, 0xb1, 0x97, 0x8e, 0x33, 0xc4, 0xee, 0xd8, 0x50, 0x94, 0x43, 0xd1, 0x93, 0x4b, 0xd3, 0xda,
+	0xf3, 0x38, 0x58, 0x9b, 0xc9, 0xd8, 0xf0, 0x26, 0x28, 0x52, 0xe4, 0xb6, 0x31, 0xd5, 0x8d, 0xee,
+	0x80, 0x50, 0xec, 0x8a, 0x99, 0x92, 0xd1, 0x0a, 0x42, 0x7c, 0x28, 0xa5, 0xa1, 0x6e, 0x8a, 0xbd,
+	0xb3, 0x9b, 0xe2, 0x0b, 0xba, 0x29, 0xe1, 0xef, 0xa6, 0x70, 0x55, 0x2f, 0x47, 0xa9, 0xea, 0x64,
+	0xb8, 0xaa, 0x7d, 0x9d, 0x93, 0x0a, 0x76, 0xce, 0x5d, 0x90, 0x92, 0xd4, 0xc3, 0x4b, 0x3d, 0xdb,
+	0xd8, 0x0a, 0x5e, 0x98, 0x54, 0xfa, 0xd8, 0x4b, 0xf3, 0x1c, 0xe0, 0x11, 0x28, 0xda, 0xf8, 0x52,
+	0x67, 0x5b, 0xf7, 0x30, 0x40, 0x44, 0x8c, 0xbc, 0x8d, 0x2f, 0xb5, 0x81, 0x2d, 0x97, 0xc7, 0x89,
+	0x74, 0xba, 0x94, 0x39, 0x4e, 0xa4, 0xb3, 0xa5, 0xdc, 0x71, 0x22, 0x9d, 0x2b, 0xe5, 0x8f, 0x13,
+	0xe9, 0x7c, 0xa9, 0x70, 0x9c, 0x48, 0x17, 0x4a, 0xc5, 0xda, 0xe7, 0x31, 0xb0, 0xb9, 0x90, 0xc2,
+	0xff, 0x28, 0xb7, 0x55, 0xfb, 0x4a, 0x01, 0x9b, 0x0b, 0x5f, 0x78, 0xac, 0x47, 0xe4, 0x33, 0x5b,
+	0x66, 0x42, 0x8e, 0xf7, 0xbc, 0x90, 0xca, 0x44, 0x04, 0xde, 0x0c, 0xb1, 0xe0, 0x9b, 0x61, 0x8a,
+	0xaa, 0xe3, 0xef, 0x41, 0xd5, 0x3f, 0x2c, 0x83, 0xca, 0xfc, 0xc7, 0xdf, 0x87, 0x24, 0x20, 0x5f,
+	0xea, 0x12, 0xc1, 0x42, 0x9f, 0x1e, 0xec, 0xcb, 0xa1, 0xc1, 0x0e, 0xff, 0x07, 0x0a, 0x13, 0x13,
+	0x7e, 0xf8, 0x64, 0xc4, 0xc3, 0xe7, 0xc7, 0x7e, 0x4c, 0x03, 0x37, 0x01, 0xcb, 0x86, 0x4b, 0x45,
+	0x24, 0x71, 0x87, 0x19, 0x29, 0xe1, 0x2c, 0x99, 0xf3, 0xd4, 0x3c, 0x4a, 0x3a, 0x62, 0x94, 0xac,
+	0xf4, 0xe2, 0x31, 0x1e, 0x81, 0x55, 0xfe, 0x28, 0xe9, 0x60, 0xe4, 0xd2, 0x73, 0x8c, 0xa8, 0xc0,
+	0xca, 0x44, 0xc4, 0x5a, 0x61, 0xce, 0x47, 0x9e, 0x2f, 0x47, 0xbc, 0x0b, 0x52, 0x26, 0xa6, 0xc8,
+	0xea, 0x92, 0xd9, 0x6d, 0x2c, 0x3f, 0x6a, 0x87, 0xbb, 0xea, 0x23, 0x34, 0xea, 0x3a, 0xc8, 0x24,
+	0x9a, 0xe7, 0xc0, 0xf2, 0x8e, 0x28, 0xb3, 0xa6, 0xe5, 0xac, 0x28, 0x27, 0xb9, 0x64, 0x87, 0xe5,
+	0xfb, 0x94, 0x1f, 0x9f, 0xe5, 0xdc, 0x2c, 0x68, 0xa9, 0x64, 0xd8, 0x0f, 0xc4, 0x4f, 0x2d, 0xcb,
+	0xbc, 0xe4, 0x02, 0xde, 0x06, 0x7f, 0xe2, 0x20, 0xac, 0x00, 0xb0, 0xab, 0x5b, 0x26, 0xb6, 0xa9,
+	0x45, 0x47, 0xe5, 0x3c, 0xbf, 0x7b, 0xc8, 0x74, 0x4f, 0xb8, 0xaa, 0x29, 0x35, 0xf0, 0x09, 0x28,
+	0xca, 0x9b, 0x1f, 0xcf, 0xa6, 0x02, 0x8f, 0xac, 0xce, 0x24, 0x61, 0xdf, 0x88, 0x92, 0xdc, 0xe0,
+	0x4d, 0xaa, 0xc2, 0x30, 0xb0, 0xae, 0xfd, 0x12, 0x07, 0xeb, 0x73, 0xde, 0xf1, 0xfe, 0x97, 0x8b,
+	0x12, 0x78, 0xb9, 0x7c, 0xc0, 0xb1, 0xd3, 0x02, 0x6b, 0x53, 0x07, 0xd5, 0x2d, 0x8a, 0x7b, 0xec,
+	0xa3, 0x91, 0x3d, 0x81, 0x1b, 0xbf, 0xed, 0xb8, 0x4d, 0x8a, 0x7b, 0xda, 0xea, 0x30, 0x24, 0x23,
+	0xf0, 0x5f, 0x20, 0xc9, 0x67, 0x96, 0xf7, 0x05, 0x38, 0xb7, 0x38, 0xfe, 0x8b, 0x28, 0x3a, 0xe8,
+	0x3a, 0xe7, 0x9a, 0xb4, 0x87, 0x0f, 0x40, 0xc1, 0xa3, 0x09, 0x89, 0x90, 0x8a, 0x88, 0x90, 0x13,
+	0x2c, 0x71, 0x5f, 0xe0, 0x0c, 0xc0, 0x9a, 0xf0, 0xd7, 0x8d, 0xce, 0xc0, 0xbe, 0xd0, 0x7b, 0xc8,
+	0xb6, 0x5a, 0x98, 0xd0, 0x72, 0x3a, 0xfa, 0x97, 0xa0, 0xef, 0x9d, 0xc1, 0x51, 0x0f, 0x19, 0xd2,
+	0x89, 0x04, 0xd2, 0x56, 0x05, 0x7e, 0x40, 0x58, 0xfb, 0x36, 0x06, 0x36, 0x17, 0xba, 0xcd, 0x98,
+	0xfc, 0x4a, 0x94, 0xc9, 0x1f, 0x5b, 0x38, 0xf9, 0xe3, 0xc1, 0xf1, 0x75, 0x04, 0xf2, 0xd8, 0x36,
+	0x1c, 0xd3, 0xb2, 0xdb, 0xfe, 0xd7, 0xf5, 0x5f, 0x83, 0x39, 0x1c, 0xbf, 0x25, 0xef, 0x4b, 0x5b,
+	0xfe, 0xb4, 0xce, 0x61, 0xdf, 0x8a, 0x15, 0x9a, 0x4c, 0x23, 0xb1, 0x9e, 0x62, 0x39, 0x07, 0x81,
+	0x10, 0x9d, 0x5a, 0x4f, 0xf9, 0xf4, 0x12, 0x09, 0xe6, 0xfa, 0x24, 0x6f, 0xe7, 0x0c, 0x97, 0x70,
+	0xf5, 0x5f, 0x40, 0xc6, 0xe8, 0x60, 0xe3, 0x82, 0x0c, 0x7a, 0xec, 0x26, 0xe3, 0x3b, 0x79, 0x6d,
+	0x22, 0x38, 0xb0, 0x5e, 0xbe, 0xae, 0x2e, 0xbd, 0x7a, 0x5d, 0x5d, 0x7a, 0xfb, 0xba, 0xaa, 0x7c,
+	0x7a, 0x55, 0x55, 0xbe, 0xb9, 0xaa, 0x2a, 0x2f, 0xae, 0xaa, 0xca, 0xcb, 0xab, 0xaa, 0xf2, 0xd3,
+	0x55, 0x55, 0xf9, 0xf9, 0xaa, 0xba, 0xf4, 0xf6, 0xaa, 0xaa, 0x3c, 0x7b, 0x53, 0x5d, 0x7a, 0xf9,
+	0xa6, 0xba, 0xf4, 0xea, 0x4d, 0x75, 0xe9, 0xa3, 0xbd, 0xb6, 0x33, 0x39, 0x88, 0xe5, 0xcc, 0xff,
+	0x4f, 0xf2, 0x9e, 0x8b, 0xfb, 0x72, 0x75, 0x9e, 0xe4, 0xc3, 0x6d, 0xef, 0xd7, 0x01, 0x00, 0xda,
+	0xcf, 0xb7, 0x49, 0xcb, 0x14, 0x00, 0x00,
 }
 
 func (this *ReplicationTask) Equal(that interface{}) bool {
@@ -1819,6 +1932,56 @@ func (this *HistoryTaskV2Attributes) Equal(that interface{}) bool {
 	if !this.NewRunEvents.Equal(that1.NewRunEvents) {
 		return false
 	}
+	if !this.EventsChunkManifest.Equal(that1.EventsChunkManifest) {
+		return false
+	}
+	return true
+}
+func (this *ReplicationEventChunkManifest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplicationEventChunkManifest)
+	if !ok {
+		that2, ok := that.(ReplicationEventChunkManifest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FirstEventId != that1.FirstEventId {
+		return false
+	}
+	if this.NextEventId != that1.NextEventId {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.EncodingType != that1.EncodingType {
+		return false
+	}
+	if this.EventsSize != that1.EventsSize {
+		return false
+	}
+	if this.ChunkSize != that1.ChunkSize {
+		return false
+	}
+	if len(this.Checksums) != len(that1.Checksums) {
+		return false
+	}
+	for i := range this.Checksums {
+		if this.Checksums[i] != that1.Checksums[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReplicationTask) GoString() string {
@@ -2045,7 +2208,7 @@ func (this *HistoryTaskV2Attributes) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 12)
 	s = append(s, "&repication.HistoryTaskV2Attributes{")
 	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
@@ -2060,6 +2223,25 @@ func (this *HistoryTaskV2Attributes) GoString() string {
 	if this.NewRunEvents != nil {
 		s = append(s, "NewRunEvents: "+fmt.Sprintf("%#v", this.NewRunEvents)+",\n")
 	}
+	if this.EventsChunkManifest != nil {
+		s = append(s, "EventsChunkManifest: "+fmt.Sprintf("%#v", this.EventsChunkManifest)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReplicationEventChunkManifest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&repication.ReplicationEventChunkManifest{")
+	s = append(s, "FirstEventId: "+fmt.Sprintf("%#v", this.FirstEventId)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "EncodingType: "+fmt.Sprintf("%#v", this.EncodingType)+",\n")
+	s = append(s, "EventsSize: "+fmt.Sprintf("%#v", this.EventsSize)+",\n")
+	s = append(s, "ChunkSize: "+fmt.Sprintf("%#v", this.ChunkSize)+",\n")
+	s = append(s, "Checksums: "+fmt.Sprintf("%#v", this.Checksums)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2891,6 +3073,18 @@ func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.EventsChunkManifest != nil {
+		{
+			size, err := m.EventsChunkManifest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.NewRunEvents != nil {
 		{
 			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
@@ -2958,6 +3152,77 @@ func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ReplicationEventChunkManifest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationEventChunkManifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationEventChunkManifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checksums) > 0 {
+		dAtA25 := make([]byte, len(m.Checksums)*10)
+		var j24 int
+		for _, num := range m.Checksums {
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
+		}
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintMessage(dAtA, i, uint64(j24))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ChunkSize != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ChunkSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EventsSize != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.EventsSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EncodingType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.EncodingType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FirstEventId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.FirstEventId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -3384,6 +3649,44 @@ func (m *HistoryTaskV2Attributes) Size() (n int) {
 		l = m.NewRunEvents.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if m.EventsChunkManifest != nil {
+		l = m.EventsChunkManifest.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplicationEventChunkManifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstEventId != 0 {
+		n += 1 + sovMessage(uint64(m.FirstEventId))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovMessage(uint64(m.NextEventId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMessage(uint64(m.Version))
+	}
+	if m.EncodingType != 0 {
+		n += 1 + sovMessage(uint64(m.EncodingType))
+	}
+	if m.EventsSize != 0 {
+		n += 1 + sovMessage(uint64(m.EventsSize))
+	}
+	if m.ChunkSize != 0 {
+		n += 1 + sovMessage(uint64(m.ChunkSize))
+	}
+	if len(m.Checksums) > 0 {
+		l = 0
+		for _, e := range m.Checksums {
+			l += sovMessage(uint64(e))
+		}
+		n += 1 + sovMessage(uint64(l)) + l
+	}
 	return n
 }
 
@@ -3625,6 +3928,23 @@ func (this *HistoryTaskV2Attributes) String() string {
 		`VersionHistoryItems:` + repeatedStringForVersionHistoryItems + `,`,
 		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v14.DataBlob", 1) + `,`,
 		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v14.DataBlob", 1) + `,`,
+		`EventsChunkManifest:` + strings.Replace(this.EventsChunkManifest.String(), "ReplicationEventChunkManifest", "ReplicationEventChunkManifest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicationEventChunkManifest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicationEventChunkManifest{`,
+		`FirstEventId:` + fmt.Sprintf("%v", this.FirstEventId) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`EncodingType:` + fmt.Sprintf("%v", this.EncodingType) + `,`,
+		`EventsSize:` + fmt.Sprintf("%v", this.EventsSize) + `,`,
+		`ChunkSize:` + fmt.Sprintf("%v", this.ChunkSize) + `,`,
+		`Checksums:` + fmt.Sprintf("%v", this.Checksums) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6223,6 +6543,285 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsChunkManifest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EventsChunkManifest == nil {
+				m.EventsChunkManifest = &ReplicationEventChunkManifest{}
+			}
+			if err := m.EventsChunkManifest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicationEventChunkManifest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationEventChunkManifest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationEventChunkManifest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstEventId", wireType)
+			}
+			m.FirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodingType", wireType)
+			}
+			m.EncodingType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EncodingType |= v17.EncodingType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsSize", wireType)
+			}
+			m.EventsSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventsSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
+			}
+			m.ChunkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Checksums = append(m.Checksums, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMessage
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Checksums) == 0 {
+					m.Checksums = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Checksums = append(m.Checksums, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
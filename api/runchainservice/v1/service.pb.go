@@ -0,0 +1,157 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/runchainservice/v1/service.proto
+
+package runchainservice
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("temporal/server/api/runchainservice/v1/service.proto", fileDescriptor_7fe44e58886a661d)
+}
+
+var fileDescriptor_7fe44e58886a661d = []byte{
+	// 227 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x29, 0x49, 0xcd, 0x2d,
+	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
+	0x2f, 0x2a, 0xcd, 0x4b, 0xce, 0x48, 0xcc, 0xcc, 0x03, 0x09, 0x65, 0x26, 0xa7, 0xea, 0x97, 0x19,
+	0xea, 0x43, 0x99, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x6a, 0x30, 0x5d, 0x7a, 0x10, 0x5d,
+	0x7a, 0x89, 0x05, 0x99, 0x7a, 0x68, 0xba, 0xf4, 0xca, 0x0c, 0xa5, 0x6c, 0x89, 0x34, 0xbd, 0x28,
+	0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x24, 0xbe, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x18, 0x6a, 0x8d,
+	0xd1, 0x1c, 0x46, 0x2e, 0xfe, 0xa0, 0xd2, 0x3c, 0x67, 0x90, 0xea, 0x60, 0x88, 0x6a, 0xa1, 0x0e,
+	0x46, 0x2e, 0x6e, 0xf7, 0xd4, 0x12, 0x98, 0xb0, 0x90, 0x95, 0x1e, 0x71, 0x6e, 0xd1, 0x43, 0xd2,
+	0x14, 0x04, 0xb1, 0x4e, 0xca, 0x9a, 0x2c, 0xbd, 0x10, 0x27, 0x2a, 0x31, 0x38, 0x15, 0x5d, 0x78,
+	0x28, 0xc7, 0x70, 0xe3, 0xa1, 0x1c, 0xc3, 0x87, 0x87, 0x72, 0x8c, 0x0d, 0x8f, 0xe4, 0x18, 0x57,
+	0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
+	0x5f, 0x3c, 0x92, 0x63, 0xf8, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
+	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x49, 0xcf, 0x47, 0x58, 0x9b, 0x99, 0x8f, 0x3f, 0x64,
+	0xac, 0xd1, 0x84, 0x92, 0xd8, 0xc0, 0x21, 0x63, 0x0c, 0x18, 0x00, 0x24, 0x3c, 0x0b, 0x78, 0xb8,
+	0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RunChainServiceClient is the client API for RunChainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RunChainServiceClient interface {
+	// GetRunChain returns most recent runs of the workflow, found in visibility, and links each of them to
+	// the run it follows.
+	GetRunChain(ctx context.Context, in *GetRunChainRequest, opts ...grpc.CallOption) (*GetRunChainResponse, error)
+}
+
+type runChainServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRunChainServiceClient(cc *grpc.ClientConn) RunChainServiceClient {
+	return &runChainServiceClient{cc}
+}
+
+func (c *runChainServiceClient) GetRunChain(ctx context.Context, in *GetRunChainRequest, opts ...grpc.CallOption) (*GetRunChainResponse, error) {
+	out := new(GetRunChainResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.runchainservice.v1.RunChainService/GetRunChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RunChainServiceServer is the server API for RunChainService service.
+type RunChainServiceServer interface {
+	// GetRunChain returns most recent runs of the workflow, found in visibility, and links each of them to
+	// the run it follows.
+	GetRunChain(context.Context, *GetRunChainRequest) (*GetRunChainResponse, error)
+}
+
+// UnimplementedRunChainServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRunChainServiceServer struct {
+}
+
+func (*UnimplementedRunChainServiceServer) GetRunChain(ctx context.Context, req *GetRunChainRequest) (*GetRunChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunChain not implemented")
+}
+
+func RegisterRunChainServiceServer(s *grpc.Server, srv RunChainServiceServer) {
+	s.RegisterService(&_RunChainService_serviceDesc, srv)
+}
+
+func _RunChainService_GetRunChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunChainServiceServer).GetRunChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.runchainservice.v1.RunChainService/GetRunChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunChainServiceServer).GetRunChain(ctx, req.(*GetRunChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RunChainService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "temporal.server.api.runchainservice.v1.RunChainService",
+	HandlerType: (*RunChainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRunChain",
+			Handler:    _RunChainService_GetRunChain_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "temporal/server/api/runchainservice/v1/service.proto",
+}
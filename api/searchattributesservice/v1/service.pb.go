@@ -0,0 +1,160 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/searchattributesservice/v1/service.proto
+
+package searchattributesservice
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("temporal/server/api/searchattributesservice/v1/service.proto", fileDescriptor_8a1bc199b5f10bd0)
+}
+
+var fileDescriptor_8a1bc199b5f10bd0 = []byte{
+	// 248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x29, 0x49, 0xcd, 0x2d,
+	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
+	0x2f, 0x4e, 0x4d, 0x2c, 0x4a, 0xce, 0x48, 0x2c, 0x29, 0x29, 0xca, 0x4c, 0x2a, 0x2d, 0x49, 0x2d,
+	0x06, 0x49, 0x65, 0x26, 0xa7, 0xea, 0x97, 0x19, 0xea, 0x43, 0x99, 0x7a, 0x05, 0x45, 0xf9, 0x25,
+	0xf9, 0x42, 0x7a, 0x30, 0xdd, 0x7a, 0x10, 0xdd, 0x7a, 0x89, 0x05, 0x99, 0x7a, 0x38, 0x74, 0xeb,
+	0x95, 0x19, 0x4a, 0xb9, 0x92, 0x68, 0x5b, 0x51, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x49, 0x7c, 0x51,
+	0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x31, 0xd4, 0x5a, 0xa3, 0xb7, 0x8c, 0x5c, 0xe2, 0xc1, 0x60, 0x5d,
+	0x8e, 0x70, 0x5d, 0xc1, 0x10, 0x5d, 0x42, 0x17, 0x19, 0xb9, 0xe4, 0x42, 0x0b, 0x8a, 0x53, 0x8b,
+	0x4a, 0xc2, 0xf3, 0x8b, 0xb2, 0xd3, 0x72, 0xf2, 0xcb, 0xd1, 0x55, 0x0a, 0x85, 0x92, 0xe8, 0x6c,
+	0x3d, 0xfc, 0xe6, 0x05, 0x41, 0x1c, 0x29, 0x15, 0x46, 0x6d, 0x63, 0x21, 0x7e, 0x56, 0x62, 0x70,
+	0x6a, 0x62, 0xbc, 0xf0, 0x50, 0x8e, 0xe1, 0xc6, 0x43, 0x39, 0x86, 0x0f, 0x0f, 0xe5, 0x18, 0x1b,
+	0x1e, 0xc9, 0x31, 0xae, 0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
+	0x0f, 0x1e, 0xc9, 0x31, 0xbe, 0x78, 0x24, 0xc7, 0xf0, 0xe1, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
+	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xe5, 0x93, 0x9e, 0x8f, 0x70, 0x52,
+	0x66, 0x3e, 0x71, 0x61, 0x6e, 0x8d, 0x43, 0x2a, 0x89, 0x0d, 0x1c, 0xf6, 0xc6, 0x80, 0x01, 0x00,
+	0x48, 0x9d, 0x15, 0x51, 0x32, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SearchAttributesServiceClient is the client API for SearchAttributesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SearchAttributesServiceClient interface {
+	// UpsertWorkflowSearchAttributes upserts indexed search attributes of a running workflow execution on behalf
+	// of an external system. The attributes are applied by history without a history event and without a workflow
+	// task, the workflow does not observe them. Values the workflow upserts later for the same keys replace them.
+	UpsertWorkflowSearchAttributes(ctx context.Context, in *UpsertWorkflowSearchAttributesRequest, opts ...grpc.CallOption) (*UpsertWorkflowSearchAttributesResponse, error)
+}
+
+type searchAttributesServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSearchAttributesServiceClient(cc *grpc.ClientConn) SearchAttributesServiceClient {
+	return &searchAttributesServiceClient{cc}
+}
+
+func (c *searchAttributesServiceClient) UpsertWorkflowSearchAttributes(ctx context.Context, in *UpsertWorkflowSearchAttributesRequest, opts ...grpc.CallOption) (*UpsertWorkflowSearchAttributesResponse, error) {
+	out := new(UpsertWorkflowSearchAttributesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.searchattributesservice.v1.SearchAttributesService/UpsertWorkflowSearchAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchAttributesServiceServer is the server API for SearchAttributesService service.
+type SearchAttributesServiceServer interface {
+	// UpsertWorkflowSearchAttributes upserts indexed search attributes of a running workflow execution on behalf
+	// of an external system. The attributes are applied by history without a history event and without a workflow
+	// task, the workflow does not observe them. Values the workflow upserts later for the same keys replace them.
+	UpsertWorkflowSearchAttributes(context.Context, *UpsertWorkflowSearchAttributesRequest) (*UpsertWorkflowSearchAttributesResponse, error)
+}
+
+// UnimplementedSearchAttributesServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSearchAttributesServiceServer struct {
+}
+
+func (*UnimplementedSearchAttributesServiceServer) UpsertWorkflowSearchAttributes(ctx context.Context, req *UpsertWorkflowSearchAttributesRequest) (*UpsertWorkflowSearchAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertWorkflowSearchAttributes not implemented")
+}
+
+func RegisterSearchAttributesServiceServer(s *grpc.Server, srv SearchAttributesServiceServer) {
+	s.RegisterService(&_SearchAttributesService_serviceDesc, srv)
+}
+
+func _SearchAttributesService_UpsertWorkflowSearchAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertWorkflowSearchAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchAttributesServiceServer).UpsertWorkflowSearchAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.searchattributesservice.v1.SearchAttributesService/UpsertWorkflowSearchAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchAttributesServiceServer).UpsertWorkflowSearchAttributes(ctx, req.(*UpsertWorkflowSearchAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SearchAttributesService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "temporal.server.api.searchattributesservice.v1.SearchAttributesService",
+	HandlerType: (*SearchAttributesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpsertWorkflowSearchAttributes",
+			Handler:    _SearchAttributesService_UpsertWorkflowSearchAttributes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "temporal/server/api/searchattributesservice/v1/service.proto",
+}
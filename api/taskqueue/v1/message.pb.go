@@ -0,0 +1,622 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/taskqueue/v1/message.proto
+
+package taskqueue
+
+import (
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TaskQueueAutoscalingHint recommends worker count of task queue, or of a single partition of it,
+// from dispatch rate and backlog growth.
+type TaskQueueAutoscalingHint struct {
+	// Number of tasks dispatched to pollers per second.
+	DispatchRate float64 `protobuf:"fixed64,1,opt,name=dispatch_rate,json=dispatchRate,proto3" json:"dispatch_rate,omitempty"`
+	// Change of backlog per second, negative when backlog is draining.
+	BacklogGrowthRate float64 `protobuf:"fixed64,2,opt,name=backlog_growth_rate,json=backlogGrowthRate,proto3" json:"backlog_growth_rate,omitempty"`
+	// Number of tasks per second workers need to handle to keep up and drain backlog within target.
+	DemandRate       float64 `protobuf:"fixed64,3,opt,name=demand_rate,json=demandRate,proto3" json:"demand_rate,omitempty"`
+	BacklogCountHint int64   `protobuf:"varint,4,opt,name=backlog_count_hint,json=backlogCountHint,proto3" json:"backlog_count_hint,omitempty"`
+	// Number of pollers which polled in last few minutes.
+	CurrentWorkers int32 `protobuf:"varint,5,opt,name=current_workers,json=currentWorkers,proto3" json:"current_workers,omitempty"`
+	// Ratio of demand to dispatch rate, above 1 means task queue is under provisioned.
+	Utilization        float64 `protobuf:"fixed64,6,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	RecommendedWorkers int32   `protobuf:"varint,7,opt,name=recommended_workers,json=recommendedWorkers,proto3" json:"recommended_workers,omitempty"`
+}
+
+func (m *TaskQueueAutoscalingHint) Reset()      { *m = TaskQueueAutoscalingHint{} }
+func (*TaskQueueAutoscalingHint) ProtoMessage() {}
+func (*TaskQueueAutoscalingHint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b64ab0f85f299, []int{0}
+}
+func (m *TaskQueueAutoscalingHint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskQueueAutoscalingHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskQueueAutoscalingHint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskQueueAutoscalingHint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskQueueAutoscalingHint.Merge(m, src)
+}
+func (m *TaskQueueAutoscalingHint) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskQueueAutoscalingHint) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskQueueAutoscalingHint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskQueueAutoscalingHint proto.InternalMessageInfo
+
+func (m *TaskQueueAutoscalingHint) GetDispatchRate() float64 {
+	if m != nil {
+		return m.DispatchRate
+	}
+	return 0
+}
+
+func (m *TaskQueueAutoscalingHint) GetBacklogGrowthRate() float64 {
+	if m != nil {
+		return m.BacklogGrowthRate
+	}
+	return 0
+}
+
+func (m *TaskQueueAutoscalingHint) GetDemandRate() float64 {
+	if m != nil {
+		return m.DemandRate
+	}
+	return 0
+}
+
+func (m *TaskQueueAutoscalingHint) GetBacklogCountHint() int64 {
+	if m != nil {
+		return m.BacklogCountHint
+	}
+	return 0
+}
+
+func (m *TaskQueueAutoscalingHint) GetCurrentWorkers() int32 {
+	if m != nil {
+		return m.CurrentWorkers
+	}
+	return 0
+}
+
+func (m *TaskQueueAutoscalingHint) GetUtilization() float64 {
+	if m != nil {
+		return m.Utilization
+	}
+	return 0
+}
+
+func (m *TaskQueueAutoscalingHint) GetRecommendedWorkers() int32 {
+	if m != nil {
+		return m.RecommendedWorkers
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TaskQueueAutoscalingHint)(nil), "temporal.server.api.taskqueue.v1.TaskQueueAutoscalingHint")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/taskqueue/v1/message.proto", fileDescriptor_4e9b64ab0f85f299)
+}
+
+var fileDescriptor_4e9b64ab0f85f299 = []byte{
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3f, 0x4e, 0xf3, 0x30,
+	0x1c, 0x86, 0xe3, 0xf6, 0x6b, 0x3f, 0xc9, 0xe5, 0x6f, 0xba, 0x64, 0x32, 0x11, 0x0c, 0x74, 0x40,
+	0x0e, 0x15, 0x23, 0x13, 0x30, 0xc0, 0x4a, 0x84, 0x84, 0xc4, 0x12, 0xb9, 0x89, 0x49, 0xad, 0x24,
+	0x76, 0xb0, 0x9d, 0x56, 0x62, 0xe2, 0x08, 0x1c, 0x83, 0x33, 0x70, 0x02, 0xc6, 0x8e, 0x1d, 0x69,
+	0xba, 0x30, 0xf6, 0x08, 0xa8, 0x4e, 0x1a, 0x75, 0x61, 0xf4, 0xf3, 0x3e, 0x7a, 0xfd, 0x93, 0x5e,
+	0x88, 0x35, 0xcd, 0x72, 0x21, 0x49, 0xea, 0x29, 0x2a, 0x27, 0x54, 0x7a, 0x24, 0x67, 0x9e, 0x26,
+	0x2a, 0x79, 0x29, 0x68, 0x41, 0xbd, 0xc9, 0xd0, 0xcb, 0xa8, 0x52, 0x24, 0xa6, 0x38, 0x97, 0x42,
+	0x0b, 0xdb, 0xdd, 0xf8, 0xb8, 0xf2, 0x31, 0xc9, 0x19, 0x6e, 0x7c, 0x3c, 0x19, 0x1e, 0x7f, 0xb6,
+	0xa0, 0xf3, 0x40, 0x54, 0x72, 0xbf, 0x06, 0x57, 0x85, 0x16, 0x2a, 0x24, 0x29, 0xe3, 0xf1, 0x1d,
+	0xe3, 0xda, 0x3e, 0x81, 0xbb, 0x11, 0x53, 0x39, 0xd1, 0xe1, 0x38, 0x90, 0x44, 0x53, 0x07, 0xb8,
+	0x60, 0x00, 0xfc, 0x9d, 0x0d, 0xf4, 0x89, 0xa6, 0x36, 0x86, 0xfd, 0x11, 0x09, 0x93, 0x54, 0xc4,
+	0x41, 0x2c, 0xc5, 0x54, 0xd7, 0x6a, 0xcb, 0xa8, 0x87, 0x75, 0x74, 0x6b, 0x12, 0xe3, 0x1f, 0xc1,
+	0x5e, 0x44, 0x33, 0xc2, 0xa3, 0xca, 0x6b, 0x1b, 0x0f, 0x56, 0xc8, 0x08, 0x67, 0xd0, 0xde, 0x14,
+	0x86, 0xa2, 0xe0, 0x3a, 0x18, 0x33, 0xae, 0x9d, 0x7f, 0x2e, 0x18, 0xb4, 0xfd, 0x83, 0x3a, 0xb9,
+	0x59, 0x07, 0xe6, 0xc6, 0x53, 0xb8, 0x1f, 0x16, 0x52, 0x52, 0xae, 0x83, 0xa9, 0x90, 0x09, 0x95,
+	0xca, 0xe9, 0xb8, 0x60, 0xd0, 0xf1, 0xf7, 0x6a, 0xfc, 0x58, 0x51, 0xdb, 0x85, 0xbd, 0x42, 0xb3,
+	0x94, 0xbd, 0x12, 0xcd, 0x04, 0x77, 0xba, 0xe6, 0xdf, 0x6d, 0x64, 0x7b, 0xb0, 0x2f, 0x69, 0x28,
+	0xb2, 0x8c, 0xf2, 0x88, 0x46, 0x4d, 0xdd, 0x7f, 0x53, 0x67, 0x6f, 0x45, 0x75, 0xe5, 0xf5, 0xf3,
+	0x6c, 0x81, 0xac, 0xf9, 0x02, 0x59, 0xab, 0x05, 0x02, 0x6f, 0x25, 0x02, 0x1f, 0x25, 0x02, 0x5f,
+	0x25, 0x02, 0xb3, 0x12, 0x81, 0xef, 0x12, 0x81, 0x9f, 0x12, 0x59, 0xab, 0x12, 0x81, 0xf7, 0x25,
+	0xb2, 0x66, 0x4b, 0x64, 0xcd, 0x97, 0xc8, 0x7a, 0x3a, 0x8f, 0x45, 0xb3, 0x23, 0x66, 0xe2, 0xaf,
+	0x29, 0x2f, 0x9b, 0xc7, 0xa8, 0x6b, 0xd6, 0xbc, 0xf8, 0x1d, 0x00, 0x92, 0x41, 0xaa, 0x3d, 0xff,
+	0x01, 0x00, 0x00,
+}
+
+func (this *TaskQueueAutoscalingHint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskQueueAutoscalingHint)
+	if !ok {
+		that2, ok := that.(TaskQueueAutoscalingHint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DispatchRate != that1.DispatchRate {
+		return false
+	}
+	if this.BacklogGrowthRate != that1.BacklogGrowthRate {
+		return false
+	}
+	if this.DemandRate != that1.DemandRate {
+		return false
+	}
+	if this.BacklogCountHint != that1.BacklogCountHint {
+		return false
+	}
+	if this.CurrentWorkers != that1.CurrentWorkers {
+		return false
+	}
+	if this.Utilization != that1.Utilization {
+		return false
+	}
+	if this.RecommendedWorkers != that1.RecommendedWorkers {
+		return false
+	}
+	return true
+}
+func (this *TaskQueueAutoscalingHint) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&taskqueue.TaskQueueAutoscalingHint{")
+	s = append(s, "DispatchRate: "+fmt.Sprintf("%#v", this.DispatchRate)+",\n")
+	s = append(s, "BacklogGrowthRate: "+fmt.Sprintf("%#v", this.BacklogGrowthRate)+",\n")
+	s = append(s, "DemandRate: "+fmt.Sprintf("%#v", this.DemandRate)+",\n")
+	s = append(s, "BacklogCountHint: "+fmt.Sprintf("%#v", this.BacklogCountHint)+",\n")
+	s = append(s, "CurrentWorkers: "+fmt.Sprintf("%#v", this.CurrentWorkers)+",\n")
+	s = append(s, "Utilization: "+fmt.Sprintf("%#v", this.Utilization)+",\n")
+	s = append(s, "RecommendedWorkers: "+fmt.Sprintf("%#v", this.RecommendedWorkers)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TaskQueueAutoscalingHint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskQueueAutoscalingHint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskQueueAutoscalingHint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecommendedWorkers != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.RecommendedWorkers))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Utilization != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Utilization))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.CurrentWorkers != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.CurrentWorkers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BacklogCountHint != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.BacklogCountHint))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DemandRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DemandRate))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.BacklogGrowthRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.BacklogGrowthRate))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.DispatchRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DispatchRate))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TaskQueueAutoscalingHint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DispatchRate != 0 {
+		n += 9
+	}
+	if m.BacklogGrowthRate != 0 {
+		n += 9
+	}
+	if m.DemandRate != 0 {
+		n += 9
+	}
+	if m.BacklogCountHint != 0 {
+		n += 1 + sovMessage(uint64(m.BacklogCountHint))
+	}
+	if m.CurrentWorkers != 0 {
+		n += 1 + sovMessage(uint64(m.CurrentWorkers))
+	}
+	if m.Utilization != 0 {
+		n += 9
+	}
+	if m.RecommendedWorkers != 0 {
+		n += 1 + sovMessage(uint64(m.RecommendedWorkers))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TaskQueueAutoscalingHint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskQueueAutoscalingHint{`,
+		`DispatchRate:` + fmt.Sprintf("%v", this.DispatchRate) + `,`,
+		`BacklogGrowthRate:` + fmt.Sprintf("%v", this.BacklogGrowthRate) + `,`,
+		`DemandRate:` + fmt.Sprintf("%v", this.DemandRate) + `,`,
+		`BacklogCountHint:` + fmt.Sprintf("%v", this.BacklogCountHint) + `,`,
+		`CurrentWorkers:` + fmt.Sprintf("%v", this.CurrentWorkers) + `,`,
+		`Utilization:` + fmt.Sprintf("%v", this.Utilization) + `,`,
+		`RecommendedWorkers:` + fmt.Sprintf("%v", this.RecommendedWorkers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TaskQueueAutoscalingHint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueueAutoscalingHint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueueAutoscalingHint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DispatchRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DispatchRate = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogGrowthRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.BacklogGrowthRate = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DemandRate = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			}
+			m.BacklogCountHint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCountHint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWorkers", wireType)
+			}
+			m.CurrentWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentWorkers |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Utilization = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedWorkers", wireType)
+			}
+			m.RecommendedWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecommendedWorkers |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMessage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMessage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMessage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMessage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMessage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMessage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMessage = fmt.Errorf("proto: unexpected end of group")
+)
@@ -249,6 +249,58 @@ func (m *RawHistoryContinuation) GetVersionHistories() *v1.VersionHistories {
 	return nil
 }
 
+type HistoryBranchContinuation struct {
+	PersistenceToken []byte `protobuf:"bytes,1,opt,name=persistence_token,json=persistenceToken,proto3" json:"persistence_token,omitempty"`
+	// Tree of the last branch of the previous page, it is described in the previous page already.
+	LastTreeId string `protobuf:"bytes,2,opt,name=last_tree_id,json=lastTreeId,proto3" json:"last_tree_id,omitempty"`
+}
+
+func (m *HistoryBranchContinuation) Reset()      { *m = HistoryBranchContinuation{} }
+func (*HistoryBranchContinuation) ProtoMessage() {}
+func (*HistoryBranchContinuation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_020fff7d28118bec, []int{2}
+}
+func (m *HistoryBranchContinuation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryBranchContinuation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryBranchContinuation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryBranchContinuation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryBranchContinuation.Merge(m, src)
+}
+func (m *HistoryBranchContinuation) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryBranchContinuation) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryBranchContinuation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryBranchContinuation proto.InternalMessageInfo
+
+func (m *HistoryBranchContinuation) GetPersistenceToken() []byte {
+	if m != nil {
+		return m.PersistenceToken
+	}
+	return nil
+}
+
+func (m *HistoryBranchContinuation) GetLastTreeId() string {
+	if m != nil {
+		return m.LastTreeId
+	}
+	return ""
+}
+
 type Task struct {
 	NamespaceId     string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowId      string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
@@ -263,7 +315,7 @@ type Task struct {
 func (m *Task) Reset()      { *m = Task{} }
 func (*Task) ProtoMessage() {}
 func (*Task) Descriptor() ([]byte, []int) {
-	return fileDescriptor_020fff7d28118bec, []int{2}
+	return fileDescriptor_020fff7d28118bec, []int{3}
 }
 func (m *Task) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +409,7 @@ type QueryTask struct {
 func (m *QueryTask) Reset()      { *m = QueryTask{} }
 func (*QueryTask) ProtoMessage() {}
 func (*QueryTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_020fff7d28118bec, []int{3}
+	return fileDescriptor_020fff7d28118bec, []int{4}
 }
 func (m *QueryTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,6 +462,7 @@ func (m *QueryTask) GetTaskId() string {
 func init() {
 	proto.RegisterType((*HistoryContinuation)(nil), "temporal.server.api.token.v1.HistoryContinuation")
 	proto.RegisterType((*RawHistoryContinuation)(nil), "temporal.server.api.token.v1.RawHistoryContinuation")
+	proto.RegisterType((*HistoryBranchContinuation)(nil), "temporal.server.api.token.v1.HistoryBranchContinuation")
 	proto.RegisterType((*Task)(nil), "temporal.server.api.token.v1.Task")
 	proto.RegisterType((*QueryTask)(nil), "temporal.server.api.token.v1.QueryTask")
 }
@@ -419,49 +472,51 @@ func init() {
 }
 
 var fileDescriptor_020fff7d28118bec = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xc6, 0x9b, 0x75, 0xeb, 0x9a, 0x7f, 0xbb, 0x77, 0x6d, 0xa6, 0xbd, 0xab, 0xd0, 0xc8, 0x4a,
-	0xe1, 0x50, 0x06, 0x4a, 0x19, 0x9c, 0x10, 0x27, 0x40, 0x48, 0x0b, 0xb7, 0x45, 0x15, 0x48, 0x48,
-	0x10, 0x79, 0x8d, 0xbb, 0x59, 0xdd, 0x9c, 0xcc, 0x76, 0x32, 0x7a, 0xe3, 0x23, 0x70, 0xe4, 0xc4,
-	0x99, 0x8f, 0xc2, 0x71, 0xc7, 0x1d, 0x59, 0x76, 0xe1, 0xc6, 0x3e, 0x02, 0xb2, 0x13, 0x27, 0xd5,
-	0x28, 0x02, 0x71, 0x6b, 0x9f, 0xff, 0x93, 0xc7, 0xf6, 0xf3, 0x93, 0x0d, 0xdb, 0x02, 0x1f, 0x47,
-	0x21, 0x43, 0x47, 0x03, 0x8e, 0x59, 0x82, 0xd9, 0x00, 0x45, 0x64, 0x20, 0xc2, 0x09, 0xa6, 0x83,
-	0x64, 0x67, 0x70, 0x8c, 0x39, 0x47, 0x07, 0xd8, 0x89, 0x58, 0x28, 0x42, 0x6b, 0x53, 0x7b, 0x9d,
-	0xcc, 0xeb, 0xa0, 0x88, 0x38, 0xca, 0xeb, 0x24, 0x3b, 0x37, 0xee, 0xcf, 0x4b, 0x3a, 0x24, 0x5c,
-	0x84, 0x6c, 0xfa, 0x4b, 0x56, 0xef, 0xc7, 0x02, 0xac, 0xed, 0x66, 0xc3, 0xe7, 0x21, 0x15, 0x84,
-	0xc6, 0x48, 0x90, 0x90, 0x5a, 0xeb, 0x50, 0x63, 0x31, 0xf5, 0x49, 0xd0, 0x31, 0xba, 0x46, 0xdf,
-	0xf4, 0x96, 0x58, 0x4c, 0xdd, 0xc0, 0xba, 0x03, 0xff, 0x8d, 0x09, 0xe3, 0xc2, 0xc7, 0x09, 0xa6,
-	0x42, 0x8e, 0x17, 0xba, 0x46, 0xbf, 0xea, 0x35, 0x95, 0xfa, 0x42, 0x8a, 0x6e, 0x60, 0xf5, 0x60,
-	0x85, 0xe2, 0xf7, 0x33, 0xa6, 0xaa, 0x32, 0x35, 0xa4, 0xa8, 0x3d, 0x0e, 0xac, 0x11, 0xee, 0x9f,
-	0x86, 0x6c, 0x32, 0x3e, 0x0a, 0x4f, 0x7d, 0x16, 0x53, 0x4a, 0xe8, 0x41, 0x67, 0xa9, 0x6b, 0xf4,
-	0xeb, 0x5e, 0x9b, 0xf0, 0xd7, 0xf9, 0xc4, 0xcb, 0x06, 0xd6, 0x3d, 0x68, 0x47, 0x98, 0x71, 0xc2,
-	0x05, 0xa6, 0x23, 0xec, 0xab, 0xe3, 0x76, 0x6a, 0x5d, 0xa3, 0xdf, 0xf4, 0x5a, 0x33, 0x83, 0xa1,
-	0xd4, 0xad, 0x13, 0xd8, 0x10, 0x0c, 0x51, 0x4e, 0xe4, 0xfa, 0xc5, 0x1a, 0x02, 0xf1, 0x49, 0x67,
-	0xb9, 0x6b, 0xf4, 0x1b, 0x0f, 0x1f, 0x3b, 0xf3, 0x3a, 0xcc, 0x5b, 0x72, 0x92, 0x1d, 0x67, 0xa8,
-	0x3f, 0xd7, 0xfb, 0x18, 0x22, 0x3e, 0x71, 0xe9, 0x38, 0xf4, 0xd6, 0xc5, 0xbc, 0x91, 0x75, 0x0b,
-	0x9a, 0xfb, 0x0c, 0xd1, 0xd1, 0x61, 0xbe, 0xb5, 0xba, 0xda, 0x5a, 0x23, 0xd3, 0xd4, 0xae, 0x5e,
-	0x2e, 0xd6, 0xcd, 0x16, 0xf4, 0x3e, 0x57, 0xe1, 0x7f, 0x0f, 0x9d, 0xce, 0x2b, 0x7d, 0x13, 0x4c,
-	0x8a, 0x8e, 0x31, 0x8f, 0xd0, 0x08, 0xe7, 0xbd, 0x97, 0x82, 0xb5, 0x05, 0x8d, 0xe2, 0x28, 0x79,
-	0xf1, 0xa6, 0x07, 0x5a, 0x72, 0x83, 0x19, 0x66, 0xd5, 0x6b, 0xcc, 0xb8, 0x40, 0x6c, 0x06, 0xc7,
-	0x62, 0xc6, 0x4c, 0xa9, 0x33, 0x3c, 0x66, 0x5d, 0x89, 0xac, 0x34, 0xa4, 0x8a, 0x47, 0xd5, 0x6b,
-	0x97, 0xd6, 0x57, 0xd9, 0xc0, 0xea, 0x42, 0x13, 0xd3, 0xa0, 0xcc, 0xac, 0x29, 0x23, 0x60, 0x1a,
-	0xe8, 0xc4, 0x6d, 0x68, 0x97, 0x0e, 0x9d, 0xb7, 0xac, 0x6c, 0xab, 0xda, 0xa6, 0xd3, 0xe6, 0xd2,
-	0xad, 0xff, 0x86, 0xee, 0x5b, 0x68, 0xe7, 0x71, 0x7e, 0x46, 0x8c, 0x60, 0xde, 0x31, 0x15, 0xd7,
-	0x07, 0x7f, 0xe2, 0x9a, 0x2f, 0xb8, 0xab, 0xbf, 0xf3, 0x5a, 0xc9, 0x35, 0xa5, 0xf7, 0x69, 0x01,
-	0x16, 0x35, 0xd2, 0xa2, 0xfd, 0xf2, 0x26, 0x34, 0x0a, 0xcd, 0x0d, 0xfe, 0x99, 0xc9, 0x16, 0x34,
-	0xf8, 0xe8, 0x10, 0x07, 0xf1, 0x11, 0x2e, 0x81, 0x80, 0x96, 0xdc, 0xc0, 0xba, 0x0b, 0xad, 0xc2,
-	0x80, 0x84, 0x3c, 0x94, 0x50, 0x2c, 0x96, 0xbc, 0x55, 0xad, 0x3f, 0xcd, 0x64, 0x99, 0x85, 0x46,
-	0x82, 0x24, 0x44, 0x4c, 0x35, 0x08, 0xd3, 0x03, 0x2d, 0xb9, 0x81, 0x75, 0x1b, 0x56, 0xca, 0x3b,
-	0x30, 0x8d, 0xb0, 0x82, 0x60, 0x7a, 0x4d, 0x2d, 0x0e, 0xa7, 0x11, 0x96, 0xa6, 0x22, 0x45, 0x99,
-	0xea, 0x99, 0x49, 0x8b, 0xd2, 0xd4, 0x1b, 0x83, 0xb9, 0x17, 0x63, 0x36, 0xfd, 0xdb, 0x7a, 0x6e,
-	0x02, 0xc8, 0x4b, 0xe7, 0x9f, 0xc4, 0x38, 0xc6, 0x79, 0x3b, 0xa6, 0x54, 0xf6, 0xa4, 0x60, 0x6d,
-	0xc0, 0xb2, 0x1a, 0x17, 0xed, 0xd4, 0xe4, 0x5f, 0x37, 0x78, 0xf6, 0xee, 0xec, 0xc2, 0xae, 0x9c,
-	0x5f, 0xd8, 0x95, 0xab, 0x0b, 0xdb, 0xf8, 0x90, 0xda, 0xc6, 0x97, 0xd4, 0x36, 0xbe, 0xa6, 0xb6,
-	0x71, 0x96, 0xda, 0xc6, 0xb7, 0xd4, 0x36, 0xbe, 0xa7, 0x76, 0xe5, 0x2a, 0xb5, 0x8d, 0x8f, 0x97,
-	0x76, 0xe5, 0xec, 0xd2, 0xae, 0x9c, 0x5f, 0xda, 0x95, 0x37, 0xfd, 0x83, 0xb0, 0xc4, 0x4f, 0xc2,
-	0x79, 0x2f, 0xe9, 0x13, 0xf5, 0x63, 0xbf, 0xa6, 0x1e, 0xbf, 0x47, 0x3f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x82, 0x84, 0xbc, 0x27, 0x76, 0x05, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0xa6, 0x4d, 0xe3, 0x97, 0x94, 0x26, 0xae, 0x4a, 0x03, 0x2a, 0x6e, 0x08, 0x0c,
+	0xa1, 0x20, 0x87, 0xc2, 0x84, 0x98, 0x28, 0x42, 0xaa, 0xd9, 0x6a, 0x45, 0x20, 0x21, 0x81, 0x75,
+	0x8d, 0x2f, 0xed, 0x91, 0xf4, 0xec, 0xde, 0x9d, 0x5d, 0xb2, 0xf1, 0x11, 0x18, 0x99, 0x98, 0xf9,
+	0x28, 0x8c, 0x1d, 0x3b, 0xd2, 0x74, 0x61, 0xa3, 0x1f, 0x01, 0xdd, 0xd9, 0x17, 0x87, 0x92, 0x0a,
+	0xc4, 0x96, 0xfc, 0xde, 0xdf, 0xcf, 0xcf, 0xef, 0xe7, 0x33, 0x6c, 0x0a, 0x7c, 0x18, 0x85, 0x0c,
+	0x0d, 0x3b, 0x1c, 0xb3, 0x04, 0xb3, 0x0e, 0x8a, 0x48, 0x47, 0x84, 0x03, 0x4c, 0x3b, 0xc9, 0x56,
+	0xe7, 0x10, 0x73, 0x8e, 0xf6, 0xb1, 0x13, 0xb1, 0x50, 0x84, 0xd6, 0xba, 0xce, 0x3a, 0x69, 0xd6,
+	0x41, 0x11, 0x71, 0x54, 0xd6, 0x49, 0xb6, 0x6e, 0x3e, 0x98, 0xd5, 0xe9, 0x80, 0x70, 0x11, 0xb2,
+	0xd1, 0x1f, 0xbd, 0x5a, 0x3f, 0xe7, 0x60, 0x65, 0x27, 0x2d, 0x3e, 0x0f, 0xa9, 0x20, 0x34, 0x46,
+	0x82, 0x84, 0xd4, 0x5a, 0x85, 0x12, 0x8b, 0xa9, 0x4f, 0x82, 0x86, 0xd1, 0x34, 0xda, 0xa6, 0xb7,
+	0xc0, 0x62, 0xea, 0x06, 0xd6, 0x5d, 0xb8, 0xd6, 0x27, 0x8c, 0x0b, 0x1f, 0x27, 0x98, 0x0a, 0x59,
+	0x9e, 0x6b, 0x1a, 0xed, 0xa2, 0x57, 0x55, 0xf4, 0x85, 0x84, 0x6e, 0x60, 0xb5, 0x60, 0x89, 0xe2,
+	0x0f, 0x53, 0xa1, 0xa2, 0x0a, 0x55, 0x24, 0xd4, 0x19, 0x07, 0x56, 0x08, 0xf7, 0x8f, 0x43, 0x36,
+	0xe8, 0x0f, 0xc3, 0x63, 0x9f, 0xc5, 0x94, 0x12, 0xba, 0xdf, 0x58, 0x68, 0x1a, 0xed, 0xb2, 0x57,
+	0x27, 0xfc, 0x75, 0x56, 0xf1, 0xd2, 0x82, 0x75, 0x1f, 0xea, 0x11, 0x66, 0x9c, 0x70, 0x81, 0x69,
+	0x0f, 0xfb, 0xea, 0x71, 0x1b, 0xa5, 0xa6, 0xd1, 0xae, 0x7a, 0xb5, 0xa9, 0x42, 0x57, 0x72, 0xeb,
+	0x08, 0xd6, 0x04, 0x43, 0x94, 0x13, 0x79, 0xff, 0xc9, 0x3d, 0x04, 0xe2, 0x83, 0xc6, 0x62, 0xd3,
+	0x68, 0x57, 0x1e, 0x3d, 0x71, 0x66, 0xed, 0x30, 0xdb, 0x92, 0x93, 0x6c, 0x39, 0x5d, 0x7d, 0xb9,
+	0x9e, 0xa3, 0x8b, 0xf8, 0xc0, 0xa5, 0xfd, 0xd0, 0x5b, 0x15, 0xb3, 0x4a, 0xd6, 0x6d, 0xa8, 0xee,
+	0x31, 0x44, 0x7b, 0x07, 0xd9, 0x68, 0x65, 0x35, 0x5a, 0x25, 0x65, 0x6a, 0xaa, 0x97, 0xf3, 0x65,
+	0xb3, 0x06, 0xad, 0x2f, 0x45, 0xb8, 0xee, 0xa1, 0xe3, 0x59, 0x4b, 0x5f, 0x07, 0x93, 0xa2, 0x43,
+	0xcc, 0x23, 0xd4, 0xc3, 0xd9, 0xde, 0x73, 0x60, 0x6d, 0x40, 0x65, 0xf2, 0x28, 0xd9, 0xe2, 0x4d,
+	0x0f, 0x34, 0x72, 0x83, 0x29, 0x67, 0xc5, 0x4b, 0xce, 0xb8, 0x40, 0x6c, 0x4a, 0xc7, 0x7c, 0xea,
+	0x4c, 0xd1, 0x29, 0x1f, 0xd3, 0xa9, 0x44, 0xae, 0x34, 0xa4, 0xca, 0x47, 0xd1, 0xab, 0xe7, 0xd1,
+	0x57, 0x69, 0xc1, 0x6a, 0x42, 0x15, 0xd3, 0x20, 0xef, 0x59, 0x52, 0x41, 0xc0, 0x34, 0xd0, 0x1d,
+	0x37, 0xa1, 0x9e, 0x27, 0x74, 0xbf, 0x45, 0x15, 0x5b, 0xd6, 0x31, 0xdd, 0x6d, 0xa6, 0xdd, 0xf2,
+	0x15, 0x76, 0xdf, 0x42, 0x3d, 0x6b, 0xe7, 0xa7, 0xc6, 0x08, 0xe6, 0x0d, 0x53, 0x79, 0x7d, 0xf8,
+	0x37, 0xaf, 0xd9, 0x0d, 0x77, 0xf4, 0x75, 0x5e, 0x2d, 0xb9, 0x44, 0x5a, 0xef, 0xe1, 0x46, 0x26,
+	0x67, 0x5b, 0xc9, 0xfb, 0x4d, 0xd1, 0xcc, 0x41, 0x8d, 0x2b, 0x06, 0x6d, 0x42, 0x75, 0x88, 0xb8,
+	0xf0, 0x05, 0xc3, 0x78, 0x4a, 0x99, 0x64, 0x5d, 0x86, 0xb1, 0x1b, 0xb4, 0x3e, 0xcf, 0xc1, 0xbc,
+	0x7e, 0x7d, 0x26, 0xa6, 0xf3, 0x53, 0x57, 0x99, 0x30, 0x37, 0xf8, 0x6f, 0xff, 0x1b, 0x50, 0xe1,
+	0xbd, 0x03, 0x1c, 0xc4, 0x43, 0x9c, 0xcb, 0x07, 0x8d, 0xdc, 0xc0, 0xba, 0x07, 0xb5, 0x49, 0x00,
+	0x09, 0xb9, 0x40, 0xa1, 0xbc, 0x2f, 0x78, 0xcb, 0x9a, 0x3f, 0x4b, 0xb1, 0xec, 0x85, 0x7a, 0x82,
+	0x24, 0x44, 0x8c, 0xb4, 0x74, 0xd3, 0x03, 0x8d, 0xdc, 0xc0, 0xba, 0x03, 0x4b, 0xf9, 0x79, 0x1b,
+	0x45, 0x58, 0x09, 0x37, 0xbd, 0xaa, 0x86, 0xdd, 0x51, 0x84, 0x65, 0x68, 0xd2, 0x45, 0x85, 0xca,
+	0x69, 0x48, 0x43, 0x19, 0x6a, 0xf5, 0xc1, 0xdc, 0x8d, 0x31, 0x1b, 0xfd, 0xeb, 0x7a, 0x6e, 0x01,
+	0xc8, 0x03, 0xee, 0x1f, 0xc5, 0x38, 0xc6, 0xd9, 0x76, 0x4c, 0x49, 0x76, 0x25, 0xb0, 0xd6, 0x60,
+	0x51, 0x95, 0x27, 0xdb, 0x29, 0xc9, 0xbf, 0x6e, 0xb0, 0xfd, 0xee, 0xe4, 0xcc, 0x2e, 0x9c, 0x9e,
+	0xd9, 0x85, 0x8b, 0x33, 0xdb, 0xf8, 0x38, 0xb6, 0x8d, 0xaf, 0x63, 0xdb, 0xf8, 0x36, 0xb6, 0x8d,
+	0x93, 0xb1, 0x6d, 0x7c, 0x1f, 0xdb, 0xc6, 0x8f, 0xb1, 0x5d, 0xb8, 0x18, 0xdb, 0xc6, 0xa7, 0x73,
+	0xbb, 0x70, 0x72, 0x6e, 0x17, 0x4e, 0xcf, 0xed, 0xc2, 0x9b, 0xf6, 0x7e, 0x98, 0xbf, 0x6a, 0x24,
+	0x9c, 0xf5, 0xd5, 0x7e, 0xaa, 0x7e, 0xec, 0x95, 0xd4, 0x87, 0xf6, 0xf1, 0xaf, 0x01, 0x00, 0xd3,
+	0x68, 0x0e, 0x29, 0xe2, 0x05, 0x00, 0x00,
 }
 
 func (this *HistoryContinuation) Equal(that interface{}) bool {
@@ -554,6 +609,33 @@ func (this *RawHistoryContinuation) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HistoryBranchContinuation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryBranchContinuation)
+	if !ok {
+		that2, ok := that.(HistoryBranchContinuation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PersistenceToken, that1.PersistenceToken) {
+		return false
+	}
+	if this.LastTreeId != that1.LastTreeId {
+		return false
+	}
+	return true
+}
 func (this *Task) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -667,6 +749,17 @@ func (this *RawHistoryContinuation) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *HistoryBranchContinuation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&token.HistoryBranchContinuation{")
+	s = append(s, "PersistenceToken: "+fmt.Sprintf("%#v", this.PersistenceToken)+",\n")
+	s = append(s, "LastTreeId: "+fmt.Sprintf("%#v", this.LastTreeId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *Task) GoString() string {
 	if this == nil {
 		return "nil"
@@ -863,6 +956,43 @@ func (m *RawHistoryContinuation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoryBranchContinuation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryBranchContinuation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryBranchContinuation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastTreeId) > 0 {
+		i -= len(m.LastTreeId)
+		copy(dAtA[i:], m.LastTreeId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.LastTreeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PersistenceToken) > 0 {
+		i -= len(m.PersistenceToken)
+		copy(dAtA[i:], m.PersistenceToken)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PersistenceToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Task) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1068,6 +1198,23 @@ func (m *RawHistoryContinuation) Size() (n int) {
 	return n
 }
 
+func (m *HistoryBranchContinuation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PersistenceToken)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.LastTreeId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
 func (m *Task) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1168,6 +1315,17 @@ func (this *RawHistoryContinuation) String() string {
 	}, "")
 	return s
 }
+func (this *HistoryBranchContinuation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryBranchContinuation{`,
+		`PersistenceToken:` + fmt.Sprintf("%v", this.PersistenceToken) + `,`,
+		`LastTreeId:` + fmt.Sprintf("%v", this.LastTreeId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Task) String() string {
 	if this == nil {
 		return "nil"
@@ -1747,6 +1905,125 @@ func (m *RawHistoryContinuation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HistoryBranchContinuation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryBranchContinuation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryBranchContinuation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PersistenceToken = append(m.PersistenceToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.PersistenceToken == nil {
+				m.PersistenceToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTreeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastTreeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Task) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
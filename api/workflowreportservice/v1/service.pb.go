@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: temporal/server/api/workflowreportservice/v1/service.proto
+
+package workflowreportservice
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("temporal/server/api/workflowreportservice/v1/service.proto", fileDescriptor_c0c30f24ce6a9905)
+}
+
+var fileDescriptor_c0c30f24ce6a9905 = []byte{
+	// 235 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x2a, 0x49, 0xcd, 0x2d,
+	0xc8, 0x2f, 0x4a, 0xcc, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0x2c, 0xc8, 0xd4,
+	0x2f, 0xcf, 0x2f, 0xca, 0x4e, 0xcb, 0xc9, 0x2f, 0x2f, 0x4a, 0x2d, 0xc8, 0x2f, 0x2a, 0x01, 0x49,
+	0x64, 0x26, 0xa7, 0xea, 0x97, 0x19, 0xea, 0x43, 0x99, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42,
+	0x3a, 0x30, 0xbd, 0x7a, 0x10, 0xbd, 0x7a, 0x89, 0x05, 0x99, 0x7a, 0x58, 0xf5, 0xea, 0x95, 0x19,
+	0x4a, 0x39, 0x93, 0x64, 0x53, 0x51, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x49, 0x7c, 0x51, 0x6a, 0x71,
+	0x41, 0x7e, 0x5e, 0x31, 0xd4, 0x4a, 0xa3, 0xc3, 0x8c, 0x5c, 0xa2, 0xe1, 0x50, 0x3d, 0x41, 0x60,
+	0x3d, 0xc1, 0x10, 0x3d, 0x42, 0x2b, 0x19, 0xb9, 0x84, 0xdc, 0x53, 0x4b, 0x50, 0x25, 0x8b, 0x85,
+	0xdc, 0xf5, 0x48, 0x71, 0xa4, 0x1e, 0xa6, 0x09, 0x41, 0x10, 0x87, 0x48, 0x79, 0x50, 0x6e, 0x10,
+	0xc4, 0x27, 0x4a, 0x0c, 0x4e, 0x75, 0x17, 0x1e, 0xca, 0x31, 0xdc, 0x78, 0x28, 0xc7, 0xf0, 0xe1,
+	0xa1, 0x1c, 0x63, 0xc3, 0x23, 0x39, 0xc6, 0x15, 0x8f, 0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
+	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x17, 0x8f, 0xe4, 0x18, 0x3e, 0x3c, 0x92, 0x63,
+	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x3c, 0xd2,
+	0xf3, 0x11, 0x6e, 0xc8, 0xcc, 0x27, 0x26, 0x18, 0xad, 0xb1, 0x4a, 0x24, 0xb1, 0x81, 0x03, 0xd3,
+	0x18, 0x30, 0x00, 0x6e, 0x71, 0x52, 0xb1, 0xfd, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WorkflowReportServiceClient is the client API for WorkflowReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkflowReportServiceClient interface {
+	// GetWorkflowReports returns the latest value of each report published by a workflow execution.
+	GetWorkflowReports(ctx context.Context, in *GetWorkflowReportsRequest, opts ...grpc.CallOption) (*GetWorkflowReportsResponse, error)
+}
+
+type workflowReportServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkflowReportServiceClient(cc *grpc.ClientConn) WorkflowReportServiceClient {
+	return &workflowReportServiceClient{cc}
+}
+
+func (c *workflowReportServiceClient) GetWorkflowReports(ctx context.Context, in *GetWorkflowReportsRequest, opts ...grpc.CallOption) (*GetWorkflowReportsResponse, error) {
+	out := new(GetWorkflowReportsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.workflowreportservice.v1.WorkflowReportService/GetWorkflowReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkflowReportServiceServer is the server API for WorkflowReportService service.
+type WorkflowReportServiceServer interface {
+	// GetWorkflowReports returns the latest value of each report published by a workflow execution.
+	GetWorkflowReports(context.Context, *GetWorkflowReportsRequest) (*GetWorkflowReportsResponse, error)
+}
+
+// UnimplementedWorkflowReportServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkflowReportServiceServer struct {
+}
+
+func (*UnimplementedWorkflowReportServiceServer) GetWorkflowReports(ctx context.Context, req *GetWorkflowReportsRequest) (*GetWorkflowReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowReports not implemented")
+}
+
+func RegisterWorkflowReportServiceServer(s *grpc.Server, srv WorkflowReportServiceServer) {
+	s.RegisterService(&_WorkflowReportService_serviceDesc, srv)
+}
+
+func _WorkflowReportService_GetWorkflowReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowReportServiceServer).GetWorkflowReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.workflowreportservice.v1.WorkflowReportService/GetWorkflowReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowReportServiceServer).GetWorkflowReports(ctx, req.(*GetWorkflowReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WorkflowReportService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "temporal.server.api.workflowreportservice.v1.WorkflowReportService",
+	HandlerType: (*WorkflowReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWorkflowReports",
+			Handler:    _WorkflowReportService_GetWorkflowReports_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "temporal/server/api/workflowreportservice/v1/service.proto",
+}
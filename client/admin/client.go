@@ -288,6 +288,272 @@ func (c *clientImpl) ResendReplicationTasks(
 	return client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetIntakeOutcome(
+	ctx context.Context,
+	request *adminservice.GetIntakeOutcomeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetIntakeOutcomeResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetIntakeOutcome(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetShardOwnershipReport(
+	ctx context.Context,
+	request *adminservice.GetShardOwnershipReportRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetShardOwnershipReportResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetShardOwnershipReport(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartShardRebalance(
+	ctx context.Context,
+	request *adminservice.StartShardRebalanceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartShardRebalanceResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.StartShardRebalance(ctx, request, opts...)
+}
+
+func (c *clientImpl) CancelShardRebalance(
+	ctx context.Context,
+	request *adminservice.CancelShardRebalanceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelShardRebalanceResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CancelShardRebalance(ctx, request, opts...)
+}
+
+func (c *clientImpl) RotateTLSCertificate(
+	ctx context.Context,
+	request *adminservice.RotateTLSCertificateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateTLSCertificateResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RotateTLSCertificate(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTLSRotation(
+	ctx context.Context,
+	request *adminservice.DescribeTLSRotationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTLSRotationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeTLSRotation(ctx, request, opts...)
+}
+
+func (c *clientImpl) AnnotateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.AnnotateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AnnotateWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.AnnotateWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ApplyOperatorAction(
+	ctx context.Context,
+	request *adminservice.ApplyOperatorActionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ApplyOperatorActionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ApplyOperatorAction(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetReplicationEventChunk(
+	ctx context.Context,
+	request *adminservice.GetReplicationEventChunkRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationEventChunkResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetReplicationEventChunk(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartReplayCheck(
+	ctx context.Context,
+	request *adminservice.StartReplayCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartReplayCheckResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.StartReplayCheck(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeReplayCheck(
+	ctx context.Context,
+	request *adminservice.DescribeReplayCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeReplayCheckResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeReplayCheck(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListNamespaceRegistrations(
+	ctx context.Context,
+	request *adminservice.ListNamespaceRegistrationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespaceRegistrationsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListNamespaceRegistrations(ctx, request, opts...)
+}
+
+func (c *clientImpl) ApproveNamespaceRegistration(
+	ctx context.Context,
+	request *adminservice.ApproveNamespaceRegistrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ApproveNamespaceRegistrationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ApproveNamespaceRegistration(ctx, request, opts...)
+}
+
+func (c *clientImpl) RejectNamespaceRegistration(
+	ctx context.Context,
+	request *adminservice.RejectNamespaceRegistrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RejectNamespaceRegistrationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RejectNamespaceRegistration(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListHistoryBranches(
+	ctx context.Context,
+	request *adminservice.ListHistoryBranchesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListHistoryBranchesResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListHistoryBranches(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetTaskQueueAutoscalingHint(
+	ctx context.Context,
+	request *adminservice.GetTaskQueueAutoscalingHintRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetTaskQueueAutoscalingHintResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetTaskQueueAutoscalingHint(ctx, request, opts...)
+}
+
+func (c *clientImpl) RecordConsistencyMarker(
+	ctx context.Context,
+	request *adminservice.RecordConsistencyMarkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RecordConsistencyMarkerResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.RecordConsistencyMarker(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListConsistencyMarkers(
+	ctx context.Context,
+	request *adminservice.ListConsistencyMarkersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListConsistencyMarkersResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListConsistencyMarkers(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetCertificateChains(
+	ctx context.Context,
+	request *adminservice.GetCertificateChainsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetCertificateChainsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetCertificateChains(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -326,3 +326,326 @@ func (c *metricClient) ResendReplicationTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) GetIntakeOutcome(
+	ctx context.Context,
+	request *adminservice.GetIntakeOutcomeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetIntakeOutcomeResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetIntakeOutcomeScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetIntakeOutcomeScope, metrics.ClientLatency)
+	resp, err := c.client.GetIntakeOutcome(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetIntakeOutcomeScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetShardOwnershipReport(
+	ctx context.Context,
+	request *adminservice.GetShardOwnershipReportRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetShardOwnershipReportResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetShardOwnershipReportScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetShardOwnershipReportScope, metrics.ClientLatency)
+	resp, err := c.client.GetShardOwnershipReport(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetShardOwnershipReportScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StartShardRebalance(
+	ctx context.Context,
+	request *adminservice.StartShardRebalanceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartShardRebalanceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStartShardRebalanceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStartShardRebalanceScope, metrics.ClientLatency)
+	resp, err := c.client.StartShardRebalance(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStartShardRebalanceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) CancelShardRebalance(
+	ctx context.Context,
+	request *adminservice.CancelShardRebalanceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelShardRebalanceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCancelShardRebalanceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCancelShardRebalanceScope, metrics.ClientLatency)
+	resp, err := c.client.CancelShardRebalance(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCancelShardRebalanceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RotateTLSCertificate(
+	ctx context.Context,
+	request *adminservice.RotateTLSCertificateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateTLSCertificateResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRotateTLSCertificateScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRotateTLSCertificateScope, metrics.ClientLatency)
+	resp, err := c.client.RotateTLSCertificate(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRotateTLSCertificateScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeTLSRotation(
+	ctx context.Context,
+	request *adminservice.DescribeTLSRotationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTLSRotationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeTLSRotationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeTLSRotationScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeTLSRotation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeTLSRotationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) AnnotateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.AnnotateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AnnotateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientAnnotateWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientAnnotateWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.AnnotateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientAnnotateWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ApplyOperatorAction(
+	ctx context.Context,
+	request *adminservice.ApplyOperatorActionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ApplyOperatorActionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientApplyOperatorActionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientApplyOperatorActionScope, metrics.ClientLatency)
+	resp, err := c.client.ApplyOperatorAction(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientApplyOperatorActionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetReplicationEventChunk(
+	ctx context.Context,
+	request *adminservice.GetReplicationEventChunkRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationEventChunkResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetReplicationEventChunkScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetReplicationEventChunkScope, metrics.ClientLatency)
+	resp, err := c.client.GetReplicationEventChunk(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetReplicationEventChunkScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StartReplayCheck(
+	ctx context.Context,
+	request *adminservice.StartReplayCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartReplayCheckResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStartReplayCheckScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStartReplayCheckScope, metrics.ClientLatency)
+	resp, err := c.client.StartReplayCheck(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStartReplayCheckScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeReplayCheck(
+	ctx context.Context,
+	request *adminservice.DescribeReplayCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeReplayCheckResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeReplayCheckScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeReplayCheckScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeReplayCheck(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeReplayCheckScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListNamespaceRegistrations(
+	ctx context.Context,
+	request *adminservice.ListNamespaceRegistrationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespaceRegistrationsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListNamespaceRegistrationsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListNamespaceRegistrationsScope, metrics.ClientLatency)
+	resp, err := c.client.ListNamespaceRegistrations(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListNamespaceRegistrationsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ApproveNamespaceRegistration(
+	ctx context.Context,
+	request *adminservice.ApproveNamespaceRegistrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ApproveNamespaceRegistrationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientApproveNamespaceRegistrationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientApproveNamespaceRegistrationScope, metrics.ClientLatency)
+	resp, err := c.client.ApproveNamespaceRegistration(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientApproveNamespaceRegistrationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RejectNamespaceRegistration(
+	ctx context.Context,
+	request *adminservice.RejectNamespaceRegistrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RejectNamespaceRegistrationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRejectNamespaceRegistrationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRejectNamespaceRegistrationScope, metrics.ClientLatency)
+	resp, err := c.client.RejectNamespaceRegistration(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRejectNamespaceRegistrationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListHistoryBranches(
+	ctx context.Context,
+	request *adminservice.ListHistoryBranchesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListHistoryBranchesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListHistoryBranchesScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListHistoryBranchesScope, metrics.ClientLatency)
+	resp, err := c.client.ListHistoryBranches(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListHistoryBranchesScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetTaskQueueAutoscalingHint(
+	ctx context.Context,
+	request *adminservice.GetTaskQueueAutoscalingHintRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetTaskQueueAutoscalingHintResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetTaskQueueAutoscalingHintScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetTaskQueueAutoscalingHintScope, metrics.ClientLatency)
+	resp, err := c.client.GetTaskQueueAutoscalingHint(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetTaskQueueAutoscalingHintScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RecordConsistencyMarker(
+	ctx context.Context,
+	request *adminservice.RecordConsistencyMarkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RecordConsistencyMarkerResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRecordConsistencyMarkerScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRecordConsistencyMarkerScope, metrics.ClientLatency)
+	resp, err := c.client.RecordConsistencyMarker(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRecordConsistencyMarkerScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListConsistencyMarkers(
+	ctx context.Context,
+	request *adminservice.ListConsistencyMarkersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListConsistencyMarkersResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListConsistencyMarkersScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListConsistencyMarkersScope, metrics.ClientLatency)
+	resp, err := c.client.ListConsistencyMarkers(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListConsistencyMarkersScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetCertificateChains(
+	ctx context.Context,
+	request *adminservice.GetCertificateChainsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetCertificateChainsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetCertificateChainsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetCertificateChainsScope, metrics.ClientLatency)
+	resp, err := c.client.GetCertificateChains(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetCertificateChainsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
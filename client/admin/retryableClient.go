@@ -301,3 +301,307 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) GetIntakeOutcome(
+	ctx context.Context,
+	request *adminservice.GetIntakeOutcomeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetIntakeOutcomeResponse, error) {
+
+	var resp *adminservice.GetIntakeOutcomeResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetIntakeOutcome(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetShardOwnershipReport(
+	ctx context.Context,
+	request *adminservice.GetShardOwnershipReportRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetShardOwnershipReportResponse, error) {
+
+	var resp *adminservice.GetShardOwnershipReportResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetShardOwnershipReport(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartShardRebalance(
+	ctx context.Context,
+	request *adminservice.StartShardRebalanceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartShardRebalanceResponse, error) {
+
+	var resp *adminservice.StartShardRebalanceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartShardRebalance(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) CancelShardRebalance(
+	ctx context.Context,
+	request *adminservice.CancelShardRebalanceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelShardRebalanceResponse, error) {
+
+	var resp *adminservice.CancelShardRebalanceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.CancelShardRebalance(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RotateTLSCertificate(
+	ctx context.Context,
+	request *adminservice.RotateTLSCertificateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RotateTLSCertificateResponse, error) {
+
+	var resp *adminservice.RotateTLSCertificateResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RotateTLSCertificate(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeTLSRotation(
+	ctx context.Context,
+	request *adminservice.DescribeTLSRotationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTLSRotationResponse, error) {
+
+	var resp *adminservice.DescribeTLSRotationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTLSRotation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) AnnotateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.AnnotateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AnnotateWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.AnnotateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.AnnotateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ApplyOperatorAction(
+	ctx context.Context,
+	request *adminservice.ApplyOperatorActionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ApplyOperatorActionResponse, error) {
+
+	var resp *adminservice.ApplyOperatorActionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ApplyOperatorAction(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetReplicationEventChunk(
+	ctx context.Context,
+	request *adminservice.GetReplicationEventChunkRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationEventChunkResponse, error) {
+
+	var resp *adminservice.GetReplicationEventChunkResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationEventChunk(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartReplayCheck(
+	ctx context.Context,
+	request *adminservice.StartReplayCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartReplayCheckResponse, error) {
+
+	var resp *adminservice.StartReplayCheckResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartReplayCheck(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeReplayCheck(
+	ctx context.Context,
+	request *adminservice.DescribeReplayCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeReplayCheckResponse, error) {
+
+	var resp *adminservice.DescribeReplayCheckResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeReplayCheck(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListNamespaceRegistrations(
+	ctx context.Context,
+	request *adminservice.ListNamespaceRegistrationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNamespaceRegistrationsResponse, error) {
+
+	var resp *adminservice.ListNamespaceRegistrationsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListNamespaceRegistrations(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ApproveNamespaceRegistration(
+	ctx context.Context,
+	request *adminservice.ApproveNamespaceRegistrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ApproveNamespaceRegistrationResponse, error) {
+
+	var resp *adminservice.ApproveNamespaceRegistrationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ApproveNamespaceRegistration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RejectNamespaceRegistration(
+	ctx context.Context,
+	request *adminservice.RejectNamespaceRegistrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RejectNamespaceRegistrationResponse, error) {
+
+	var resp *adminservice.RejectNamespaceRegistrationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RejectNamespaceRegistration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListHistoryBranches(
+	ctx context.Context,
+	request *adminservice.ListHistoryBranchesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListHistoryBranchesResponse, error) {
+
+	var resp *adminservice.ListHistoryBranchesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListHistoryBranches(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetTaskQueueAutoscalingHint(
+	ctx context.Context,
+	request *adminservice.GetTaskQueueAutoscalingHintRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetTaskQueueAutoscalingHintResponse, error) {
+
+	var resp *adminservice.GetTaskQueueAutoscalingHintResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetTaskQueueAutoscalingHint(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RecordConsistencyMarker(
+	ctx context.Context,
+	request *adminservice.RecordConsistencyMarkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RecordConsistencyMarkerResponse, error) {
+
+	var resp *adminservice.RecordConsistencyMarkerResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RecordConsistencyMarker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListConsistencyMarkers(
+	ctx context.Context,
+	request *adminservice.ListConsistencyMarkersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListConsistencyMarkersResponse, error) {
+
+	var resp *adminservice.ListConsistencyMarkersResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListConsistencyMarkers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetCertificateChains(
+	ctx context.Context,
+	request *adminservice.GetCertificateChainsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetCertificateChainsResponse, error) {
+
+	var resp *adminservice.GetCertificateChainsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetCertificateChains(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
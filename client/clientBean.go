@@ -80,7 +80,8 @@ func NewClientBean(factory Factory, clusterMetadata cluster.Metadata) (Bean, err
 			continue
 		}
 
-		adminClient, err := factory.NewAdminClientWithTimeout(
+		adminClient, err := factory.NewRemoteAdminClientWithTimeout(
+			clusterName,
 			info.RPCAddress,
 			admin.DefaultTimeout,
 			admin.DefaultLargeTimeout,
@@ -89,7 +90,8 @@ func NewClientBean(factory Factory, clusterMetadata cluster.Metadata) (Bean, err
 			return nil, err
 		}
 
-		remoteFrontendClient, err := factory.NewFrontendClientWithTimeout(
+		remoteFrontendClient, err := factory.NewRemoteFrontendClientWithTimeout(
+			clusterName,
 			info.RPCAddress,
 			frontend.DefaultTimeout,
 			frontend.DefaultLongPollTimeout,
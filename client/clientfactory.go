@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -58,6 +59,8 @@ type (
 		NewMatchingClientWithTimeout(namespaceIDToName NamespaceIDToNameFunc, timeout time.Duration, longPollTimeout time.Duration) (matching.Client, error)
 		NewFrontendClientWithTimeout(rpcAddress string, timeout time.Duration, longPollTimeout time.Duration) (frontend.Client, error)
 		NewAdminClientWithTimeout(rpcAddress string, timeout time.Duration, largeTimeout time.Duration) (admin.Client, error)
+		NewRemoteFrontendClientWithTimeout(clusterName string, rpcAddress string, timeout time.Duration, longPollTimeout time.Duration) (frontend.Client, error)
+		NewRemoteAdminClientWithTimeout(clusterName string, rpcAddress string, timeout time.Duration, largeTimeout time.Duration) (admin.Client, error)
 	}
 
 	// NamespaceIDToNameFunc maps a namespaceID to namespace name. Returns error when mapping is not possible.
@@ -66,6 +69,7 @@ type (
 	rpcClientFactory struct {
 		rpcFactory            common.RPCFactory
 		monitor               membership.Monitor
+		historyResolver       membership.ServiceResolver
 		metricsClient         metrics.Client
 		dynConfig             *dynamicconfig.Collection
 		numberOfHistoryShards int32
@@ -74,9 +78,11 @@ type (
 )
 
 // NewRPCClientFactory creates an instance of client factory that knows how to dispatch RPC calls.
+// History clients route requests with historyResolver, so they agree on shard owners with shard controller.
 func NewRPCClientFactory(
 	rpcFactory common.RPCFactory,
 	monitor membership.Monitor,
+	historyResolver membership.ServiceResolver,
 	metricsClient metrics.Client,
 	dc *dynamicconfig.Collection,
 	numberOfHistoryShards int32,
@@ -85,6 +91,7 @@ func NewRPCClientFactory(
 	return &rpcClientFactory{
 		rpcFactory:            rpcFactory,
 		monitor:               monitor,
+		historyResolver:       historyResolver,
 		metricsClient:         metricsClient,
 		dynConfig:             dc,
 		numberOfHistoryShards: numberOfHistoryShards,
@@ -105,13 +112,8 @@ func (cf *rpcClientFactory) NewFrontendClient(rpcAddress string) (frontend.Clien
 }
 
 func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (history.Client, error) {
-	resolver, err := cf.monitor.GetResolver(common.HistoryServiceName)
-	if err != nil {
-		return nil, err
-	}
-
 	keyResolver := func(key string) (string, error) {
-		host, err := resolver.Lookup(key)
+		host, err := cf.historyResolver.Lookup(key)
 		if err != nil {
 			return "", err
 		}
@@ -171,14 +173,34 @@ func (cf *rpcClientFactory) NewFrontendClientWithTimeout(
 	rpcAddress string,
 	timeout time.Duration,
 	longPollTimeout time.Duration,
+) (frontend.Client, error) {
+	return cf.newFrontendClientWithTimeout(func() *grpc.ClientConn {
+		return cf.rpcFactory.CreateFrontendGRPCConnection(rpcAddress)
+	}, timeout, longPollTimeout)
+}
+
+func (cf *rpcClientFactory) NewRemoteFrontendClientWithTimeout(
+	clusterName string,
+	rpcAddress string,
+	timeout time.Duration,
+	longPollTimeout time.Duration,
+) (frontend.Client, error) {
+	return cf.newFrontendClientWithTimeout(func() *grpc.ClientConn {
+		return cf.rpcFactory.CreateRemoteFrontendGRPCConnection(clusterName, rpcAddress)
+	}, timeout, longPollTimeout)
+}
+
+func (cf *rpcClientFactory) newFrontendClientWithTimeout(
+	createConnection func() *grpc.ClientConn,
+	timeout time.Duration,
+	longPollTimeout time.Duration,
 ) (frontend.Client, error) {
 	keyResolver := func(key string) (string, error) {
 		return clientKeyConnection, nil
 	}
 
 	clientProvider := func(clientKey string) (interface{}, error) {
-		connection := cf.rpcFactory.CreateFrontendGRPCConnection(rpcAddress)
-		return workflowservice.NewWorkflowServiceClient(connection), nil
+		return workflowservice.NewWorkflowServiceClient(createConnection()), nil
 	}
 
 	client := frontend.NewClient(timeout, longPollTimeout, common.NewClientCache(keyResolver, clientProvider))
@@ -192,14 +214,34 @@ func (cf *rpcClientFactory) NewAdminClientWithTimeout(
 	rpcAddress string,
 	timeout time.Duration,
 	largeTimeout time.Duration,
+) (admin.Client, error) {
+	return cf.newAdminClientWithTimeout(func() *grpc.ClientConn {
+		return cf.rpcFactory.CreateFrontendGRPCConnection(rpcAddress)
+	}, timeout, largeTimeout)
+}
+
+func (cf *rpcClientFactory) NewRemoteAdminClientWithTimeout(
+	clusterName string,
+	rpcAddress string,
+	timeout time.Duration,
+	largeTimeout time.Duration,
+) (admin.Client, error) {
+	return cf.newAdminClientWithTimeout(func() *grpc.ClientConn {
+		return cf.rpcFactory.CreateRemoteFrontendGRPCConnection(clusterName, rpcAddress)
+	}, timeout, largeTimeout)
+}
+
+func (cf *rpcClientFactory) newAdminClientWithTimeout(
+	createConnection func() *grpc.ClientConn,
+	timeout time.Duration,
+	largeTimeout time.Duration,
 ) (admin.Client, error) {
 	keyResolver := func(key string) (string, error) {
 		return clientKeyConnection, nil
 	}
 
 	clientProvider := func(clientKey string) (interface{}, error) {
-		connection := cf.rpcFactory.CreateFrontendGRPCConnection(rpcAddress)
-		return adminservice.NewAdminServiceClient(connection), nil
+		return adminservice.NewAdminServiceClient(createConnection()), nil
 	}
 
 	client := admin.NewClient(timeout, largeTimeout, common.NewClientCache(keyResolver, clientProvider))
@@ -626,3 +626,71 @@ func (c *metricClient) RefreshWorkflowTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) AnnotateWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.AnnotateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.AnnotateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientAnnotateWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientAnnotateWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.AnnotateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientAnnotateWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpsertWorkflowSearchAttributesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientUpsertWorkflowSearchAttributesScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpsertWorkflowSearchAttributesScope, metrics.ClientLatency)
+	resp, err := c.client.UpsertWorkflowSearchAttributes(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpsertWorkflowSearchAttributesScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ApplyOperatorAction(
+	ctx context.Context,
+	request *historyservice.ApplyOperatorActionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ApplyOperatorActionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientApplyOperatorActionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientApplyOperatorActionScope, metrics.ClientLatency)
+	resp, err := c.client.ApplyOperatorAction(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientApplyOperatorActionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetReplicationEventChunk(
+	ctx context.Context,
+	request *historyservice.GetReplicationEventChunkRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetReplicationEventChunkResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationEventChunkScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetReplicationEventChunkScope, metrics.ClientLatency)
+	resp, err := c.client.GetReplicationEventChunk(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationEventChunkScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
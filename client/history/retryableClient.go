@@ -626,3 +626,71 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) AnnotateWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.AnnotateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.AnnotateWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.AnnotateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.AnnotateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowSearchAttributesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpsertWorkflowSearchAttributesResponse, error) {
+
+	var resp *historyservice.UpsertWorkflowSearchAttributesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpsertWorkflowSearchAttributes(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ApplyOperatorAction(
+	ctx context.Context,
+	request *historyservice.ApplyOperatorActionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ApplyOperatorActionResponse, error) {
+
+	var resp *historyservice.ApplyOperatorActionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ApplyOperatorAction(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetReplicationEventChunk(
+	ctx context.Context,
+	request *historyservice.GetReplicationEventChunkRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetReplicationEventChunkResponse, error) {
+
+	var resp *historyservice.GetReplicationEventChunkResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationEventChunk(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -101,3 +101,12 @@ func (u *uri) Query() map[string][]string {
 func (u *uri) String() string {
 	return u.url.String()
 }
+
+// GetCredentialsRef returns the credentials reference carried by the URI, empty if none is set.
+// Archivers which support per namespace credentials use it to select the credentials for the URI.
+func GetCredentialsRef(URI URI) string {
+	if refs := URI.Query()[URIQueryCredentials]; len(refs) != 0 {
+		return refs[0]
+	}
+	return ""
+}
@@ -150,3 +150,14 @@ func (s *URISuite) TestURI() {
 		}
 	}
 }
+
+func (s *URISuite) TestGetCredentialsRef() {
+	URI, err := NewURI("s3://tenant-bucket/path?credentials=tenant-profile")
+	s.NoError(err)
+	s.Equal("tenant-profile", GetCredentialsRef(URI))
+	s.Equal("/path", URI.Path())
+
+	URI, err = NewURI("s3://tenant-bucket/path")
+	s.NoError(err)
+	s.Empty(GetCredentialsRef(URI))
+}
@@ -26,6 +26,7 @@ package archiver
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 
 	enumspb "go.temporal.io/api/enums/v1"
@@ -50,6 +51,7 @@ type (
 		ReadEnabled() bool
 		GetNamespaceDefaultState() enumspb.ArchivalState
 		GetNamespaceDefaultURI() string
+		ValidateDestination(URI URI) error
 	}
 
 	archivalMetadata struct {
@@ -63,6 +65,13 @@ type (
 		enableRead            dynamicconfig.BoolPropertyFn
 		namespaceDefaultState enumspb.ArchivalState
 		namespaceDefaultURI   string
+		allowedDestinations   []archivalDestination
+	}
+
+	archivalDestination struct {
+		scheme          string
+		hostname        string
+		credentialsRefs map[string]struct{}
 	}
 
 	// ArchivalState represents the archival state of the cluster
@@ -87,6 +96,8 @@ func NewArchivalMetadata(
 	visibilityState string,
 	visibilityReadEnabled bool,
 	namespaceDefaults *config.ArchivalNamespaceDefaults,
+	historyAllowedDestinations []config.ArchivalDestination,
+	visibilityAllowedDestinations []config.ArchivalDestination,
 ) ArchivalMetadata {
 	historyConfig := NewArchivalConfig(
 		historyState,
@@ -94,6 +105,7 @@ func NewArchivalMetadata(
 		dc.GetBoolProperty(dynamicconfig.EnableReadFromHistoryArchival, historyReadEnabled),
 		namespaceDefaults.History.State,
 		namespaceDefaults.History.URI,
+		historyAllowedDestinations,
 	)
 
 	visibilityConfig := NewArchivalConfig(
@@ -102,6 +114,7 @@ func NewArchivalMetadata(
 		dc.GetBoolProperty(dynamicconfig.EnableReadFromVisibilityArchival, visibilityReadEnabled),
 		namespaceDefaults.Visibility.State,
 		namespaceDefaults.Visibility.URI,
+		visibilityAllowedDestinations,
 	)
 
 	return &archivalMetadata{
@@ -125,6 +138,7 @@ func NewArchivalConfig(
 	enableRead dynamicconfig.BoolPropertyFn,
 	namespaceDefaultStateStr string,
 	namespaceDefaultURI string,
+	allowedDestinations []config.ArchivalDestination,
 ) ArchivalConfig {
 	staticClusterState, err := getClusterArchivalState(staticClusterStateStr)
 	if err != nil {
@@ -134,6 +148,10 @@ func NewArchivalConfig(
 	if err != nil {
 		panic(err)
 	}
+	destinations, err := getArchivalDestinations(allowedDestinations)
+	if err != nil {
+		panic(err)
+	}
 
 	return &archivalConfig{
 		staticClusterState:    staticClusterState,
@@ -141,6 +159,7 @@ func NewArchivalConfig(
 		enableRead:            enableRead,
 		namespaceDefaultState: namespaceDefaultState,
 		namespaceDefaultURI:   namespaceDefaultURI,
+		allowedDestinations:   destinations,
 	}
 }
 
@@ -193,6 +212,65 @@ func (a *archivalConfig) GetNamespaceDefaultURI() string {
 	return a.namespaceDefaultURI
 }
 
+// ValidateDestination checks that a namespace is allowed to archive to URI.
+// The namespace default URI is always allowed. If no destinations are configured any URI without
+// credentials reference is allowed, otherwise URI must match the scheme and bucket of a destination
+// which lists the credentials reference of URI.
+func (a *archivalConfig) ValidateDestination(URI URI) error {
+	if URI.String() == a.namespaceDefaultURI {
+		return nil
+	}
+
+	credentialsRef := GetCredentialsRef(URI)
+	if len(a.allowedDestinations) == 0 {
+		if len(credentialsRef) != 0 {
+			return ErrCredentialsRefNotAllowed
+		}
+		return nil
+	}
+
+	destinationMatched := false
+	for _, destination := range a.allowedDestinations {
+		if destination.scheme != URI.Scheme() {
+			continue
+		}
+		if len(destination.hostname) != 0 && destination.hostname != URI.Hostname() {
+			continue
+		}
+		destinationMatched = true
+		if _, ok := destination.credentialsRefs[credentialsRef]; ok || len(credentialsRef) == 0 {
+			return nil
+		}
+	}
+	if destinationMatched {
+		return ErrCredentialsRefNotAllowed
+	}
+	return ErrDestinationNotAllowed
+}
+
+func getArchivalDestinations(destinations []config.ArchivalDestination) ([]archivalDestination, error) {
+	result := make([]archivalDestination, 0, len(destinations))
+	for _, destination := range destinations {
+		URI, err := url.Parse(destination.URI)
+		if err != nil {
+			return nil, err
+		}
+		if len(URI.Scheme) == 0 {
+			return nil, fmt.Errorf("archival destination %q has no scheme", destination.URI)
+		}
+		credentialsRefs := make(map[string]struct{}, len(destination.CredentialsRefs))
+		for _, ref := range destination.CredentialsRefs {
+			credentialsRefs[ref] = struct{}{}
+		}
+		result = append(result, archivalDestination{
+			scheme:          URI.Scheme,
+			hostname:        URI.Hostname(),
+			credentialsRefs: credentialsRefs,
+		})
+	}
+	return result, nil
+}
+
 func getClusterArchivalState(str string) (ArchivalState, error) {
 	str = strings.TrimSpace(strings.ToLower(str))
 	switch str {
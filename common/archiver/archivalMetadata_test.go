@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	archivalMetadataSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestArchivalMetadataSuite(t *testing.T) {
+	suite.Run(t, new(archivalMetadataSuite))
+}
+
+func (s *archivalMetadataSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *archivalMetadataSuite) TestValidateDestination_NoAllowedDestinations() {
+	archivalConfig := s.newArchivalConfig(nil)
+
+	s.NoError(archivalConfig.ValidateDestination(s.newURI("s3://any-bucket/path")))
+	s.Equal(ErrCredentialsRefNotAllowed, archivalConfig.ValidateDestination(s.newURI("s3://any-bucket/path?credentials=tenant")))
+}
+
+func (s *archivalMetadataSuite) TestValidateDestination_AllowedDestinations() {
+	archivalConfig := s.newArchivalConfig([]config.ArchivalDestination{
+		{URI: "s3://tenant-bucket", CredentialsRefs: []string{"tenant"}},
+		{URI: "file://"},
+	})
+
+	testCases := []struct {
+		URI      string
+		expected error
+	}{
+		{URI: "s3://default-bucket/path", expected: nil},
+		{URI: "s3://tenant-bucket/path", expected: nil},
+		{URI: "s3://tenant-bucket/path?credentials=tenant", expected: nil},
+		{URI: "s3://tenant-bucket/path?credentials=other-tenant", expected: ErrCredentialsRefNotAllowed},
+		{URI: "s3://other-bucket/path", expected: ErrDestinationNotAllowed},
+		{URI: "gs://tenant-bucket/path", expected: ErrDestinationNotAllowed},
+		{URI: "file:///tmp/archival", expected: nil},
+	}
+	for _, tc := range testCases {
+		s.Equal(tc.expected, archivalConfig.ValidateDestination(s.newURI(tc.URI)), tc.URI)
+	}
+}
+
+func (s *archivalMetadataSuite) newArchivalConfig(
+	allowedDestinations []config.ArchivalDestination,
+) ArchivalConfig {
+	dc := dynamicconfig.NewNopCollection()
+	return NewArchivalConfig(
+		"enabled",
+		dc.GetStringProperty(dynamicconfig.HistoryArchivalState, "enabled"),
+		dc.GetBoolProperty(dynamicconfig.EnableReadFromHistoryArchival, true),
+		"disabled",
+		"s3://default-bucket/path",
+		allowedDestinations,
+	)
+}
+
+func (s *archivalMetadataSuite) newURI(URIString string) URI {
+	URI, err := NewURI(URIString)
+	s.NoError(err)
+	return URI
+}
@@ -44,6 +44,12 @@ const (
 	ErrReasonReadHistory = "failed to read history batches"
 	// ErrReasonHistoryMutated is the error reason for mutated history
 	ErrReasonHistoryMutated = "history was mutated"
+
+	// URIQueryCredentials is the URI query parameter carrying the credentials reference of a namespace archival destination
+	URIQueryCredentials = "credentials"
+
+	// ProbeObjectNamePrefix is the name prefix of objects written, read back and deleted when probing an archival URI
+	ProbeObjectNamePrefix = ".temporal-archival-probe-"
 )
 
 var (
@@ -63,4 +69,12 @@ var (
 	ErrNextPageTokenCorrupted = errors.New("next page token is corrupted")
 	// ErrHistoryNotExist is the error for non-exist history
 	ErrHistoryNotExist = errors.New("requested workflow history does not exist")
+	// ErrDestinationNotAllowed is the error for URI not matching any of the allowed archival destinations
+	ErrDestinationNotAllowed = errors.New("URI is not an allowed archival destination")
+	// ErrCredentialsRefNotAllowed is the error for credentials reference not allowed for the archival destination
+	ErrCredentialsRefNotAllowed = errors.New("credentials reference is not allowed for the archival destination")
+	// ErrCredentialsRefNotSupported is the error for credentials reference in URI of an archiver which does not support it
+	ErrCredentialsRefNotSupported = errors.New("credentials reference is not supported by the archiver")
+	// ErrProbeObjectMismatch is the error for probe object read back with different content
+	ErrProbeObjectMismatch = errors.New("probe object read back with different content")
 )
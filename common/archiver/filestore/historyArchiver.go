@@ -25,11 +25,14 @@
 // Filestore History Archiver will archive workflow histories to local disk.
 
 // Each Archive() request results in a file named in the format of
-// hash(namespaceID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
-
-// The Get() method retrieves the archived histories from the directory specified in the
-// URI. It optionally takes in a NextPageToken which specifies the workflow close failover
+// hash(namespaceID, workflowID, runID)_version.history being created in the namespaceID/hash(workflowID)
+// partition of the specified directory. The file is written under a temporary name and renamed once
+// complete, so that readers never see a partially written history. Workflow histories stored in that
+// file are encoded in JSON format, and gzip compressed with a .gz suffix if compression is enabled.
+
+// The Get() method retrieves the archived histories from the partition of the workflow in the directory
+// specified in the URI. Histories archived directly in the specified directory, before partitioning
+// was introduced, are still found. It optionally takes in a NextPageToken which specifies the workflow close failover
 // version and the index of the first history batch that should be returned. Instead of
 // NextPageToken, caller can also provide a close failover version, in which case, Get() method
 // will return history batches starting from the beginning of that history version. If neither
@@ -44,6 +47,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
@@ -78,6 +82,7 @@ type (
 		container *archiver.HistoryBootstrapContainer
 		fileMode  os.FileMode
 		dirMode   os.FileMode
+		gzip      bool
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -114,6 +119,7 @@ func newHistoryArchiver(
 		container:       container,
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
+		gzip:            config.Gzip,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -176,14 +182,17 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
-	dirPath := URI.Path()
-	if err = mkdirAll(dirPath, h.dirMode); err != nil {
+	partitionDir := historyPartitionDir(URI.Path(), request.NamespaceID, request.WorkflowID)
+	if err = mkdirAll(partitionDir, h.dirMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
 		return err
 	}
 
 	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
-	if err := writeFile(path.Join(dirPath, filename), encodedHistoryBatches, h.fileMode); err != nil {
+	if h.gzip {
+		filename += gzipExtension
+	}
+	if err := writeArchiveFile(path.Join(partitionDir, filename), encodedHistoryBatches, h.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
 	}
@@ -219,33 +228,22 @@ func (h *historyArchiver) Get(
 		if err != nil {
 			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
 		}
-	} else if request.CloseFailoverVersion != nil {
-		token = &getHistoryToken{
-			CloseFailoverVersion: *request.CloseFailoverVersion,
-			NextBatchIdx:         0,
-		}
 	} else {
-		highestVersion, err := getHighestVersion(dirPath, request)
+		token, err = locateHistory(dirPath, request)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
-		token = &getHistoryToken{
-			CloseFailoverVersion: *highestVersion,
-			NextBatchIdx:         0,
-		}
 	}
 
-	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
-	filepath := path.Join(dirPath, filename)
-	exists, err = fileExists(filepath)
+	filepath, err := getHistoryFilepath(dirPath, request, token)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
-	if !exists {
+	if filepath == "" {
 		return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
 	}
 
-	encodedHistoryBatches, err := readFile(filepath)
+	encodedHistoryBatches, err := readArchiveFile(filepath)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
@@ -286,9 +284,21 @@ func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 		return archiver.ErrURISchemeMismatch
 	}
 
+	if archiver.GetCredentialsRef(URI) != "" {
+		return archiver.ErrCredentialsRefNotSupported
+	}
+
 	return validateDirPath(URI.Path())
 }
 
+// ProbeURI writes, reads back and deletes a probe file in the directory of URI
+func (h *historyArchiver) ProbeURI(_ context.Context, URI archiver.URI) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return err
+	}
+	return probeDir(URI.Path(), h.dirMode, h.fileMode)
+}
+
 func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIterator) (*archiverspb.HistoryBlob, error) {
 	historyBlob, err := historyIterator.Next()
 	op := func() error {
@@ -307,6 +317,66 @@ func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIte
 	return historyBlob, nil
 }
 
+// locateHistory returns the token of the requested close failover version, or of the highest close
+// failover version if none is requested, in the partition of the workflow or, for histories archived
+// before partitioning was introduced, in dirPath.
+func locateHistory(dirPath string, request *archiver.GetHistoryRequest) (*getHistoryToken, error) {
+	if request.CloseFailoverVersion != nil {
+		return &getHistoryToken{
+			CloseFailoverVersion: *request.CloseFailoverVersion,
+		}, nil
+	}
+
+	var highestVersion *int64
+	for _, dir := range historyDirs(dirPath, request) {
+		version, err := getHighestVersion(dir, request)
+		if err == archiver.ErrHistoryNotExist || os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if highestVersion == nil || *version > *highestVersion {
+			highestVersion = version
+		}
+	}
+	if highestVersion == nil {
+		return nil, archiver.ErrHistoryNotExist
+	}
+	return &getHistoryToken{
+		CloseFailoverVersion: *highestVersion,
+	}, nil
+}
+
+// getHistoryFilepath returns the path of the history file referred to by token,
+// or an empty string if it doesn't exist.
+func getHistoryFilepath(dirPath string, request *archiver.GetHistoryRequest, token *getHistoryToken) (string, error) {
+	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion)
+	for _, dir := range historyDirs(dirPath, request) {
+		for _, name := range []string{filename + gzipExtension, filename} {
+			filepath := path.Join(dir, name)
+			exists, err := fileExists(filepath)
+			if err != nil {
+				return "", err
+			}
+			if exists {
+				return filepath, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// historyDirs returns the directories the history may be archived in, its partition first
+func historyDirs(dirPath string, request *archiver.GetHistoryRequest) []string {
+	return []string{historyPartitionDir(dirPath, request.NamespaceID, request.WorkflowID), dirPath}
+}
+
+// historyPartitionDir returns the directory histories of the workflow are archived in
+func historyPartitionDir(dirPath string, namespaceID string, workflowID string) string {
+	return path.Join(dirPath, namespaceID, hash(workflowID))
+}
+
 func getHighestVersion(dirPath string, request *archiver.GetHistoryRequest) (*int64, error) {
 	filenames, err := listFilesByPrefix(dirPath, constructHistoryFilenamePrefix(request.NamespaceID, request.WorkflowID, request.RunID))
 	if err != nil {
@@ -315,7 +385,7 @@ func getHighestVersion(dirPath string, request *archiver.GetHistoryRequest) (*in
 
 	var highestVersion *int64
 	for _, filename := range filenames {
-		version, err := extractCloseFailoverVersion(filename)
+		version, err := extractCloseFailoverVersion(strings.TrimSuffix(filename, gzipExtension))
 		if err != nil {
 			continue
 		}
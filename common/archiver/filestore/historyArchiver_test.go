@@ -43,6 +43,7 @@ import (
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/service/config"
@@ -114,6 +115,10 @@ func (s *historyArchiverSuite) TestValidateURI() {
 			URI:         "file://",
 			expectedErr: errEmptyDirectoryPath,
 		},
+		{
+			URI:         "file:///a/b/c?credentials=tenant",
+			expectedErr: archiver.ErrCredentialsRefNotSupported,
+		},
 		{
 			URI:         "file:///a/b/c",
 			expectedErr: nil,
@@ -128,6 +133,26 @@ func (s *historyArchiverSuite) TestValidateURI() {
 	}
 }
 
+func (s *historyArchiverSuite) TestProbeURI() {
+	dir, err := ioutil.TempDir("", "TestProbeURI")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + path.Join(dir, "archival"))
+	s.NoError(err)
+	s.NoError(historyArchiver.ProbeURI(context.Background(), URI))
+	files, err := listFiles(path.Join(dir, "archival"))
+	s.NoError(err)
+	s.Empty(files)
+
+	filepath := path.Join(dir, "file")
+	s.NoError(writeFile(filepath, []byte("file"), testFileMode))
+	URI, err = archiver.NewURI("file://" + filepath)
+	s.NoError(err)
+	s.Error(historyArchiver.ProbeURI(context.Background(), URI))
+}
+
 func (s *historyArchiverSuite) TestArchive_Fail_InvalidURI() {
 	historyArchiver := s.newTestHistoryArchiver(nil)
 	request := &archiver.ArchiveHistoryRequest{
@@ -330,8 +355,13 @@ func (s *historyArchiverSuite) TestArchive_Success() {
 	err = historyArchiver.Archive(context.Background(), URI, request)
 	s.NoError(err)
 
+	partitionDir := path.Join(dir, testNamespaceID, hash(testWorkflowID))
 	expectedFilename := constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
-	s.assertFileExists(path.Join(dir, expectedFilename))
+	s.assertFileExists(path.Join(partitionDir, expectedFilename))
+	// no temporary file is left behind
+	filenames, err := listFiles(partitionDir)
+	s.NoError(err)
+	s.Equal([]string{expectedFilename}, filenames)
 }
 
 func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
@@ -516,7 +546,7 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.NoError(err)
 
 	expectedFilename := constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
-	s.assertFileExists(path.Join(dir, expectedFilename))
+	s.assertFileExists(path.Join(dir, testNamespaceID, hash(testWorkflowID), expectedFilename))
 
 	getRequest := &archiver.GetHistoryRequest{
 		NamespaceID: testNamespaceID,
@@ -531,6 +561,130 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndGet_Gzip() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir, err := ioutil.TempDir("", "TestArchiveAndGetGzip")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	historyArchiver.gzip = true
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+	s.NoError(err)
+
+	expectedFilename := constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion) + gzipExtension
+	filepath := path.Join(dir, testNamespaceID, hash(testWorkflowID), expectedFilename)
+	s.assertFileExists(filepath)
+	data, err := readFile(filepath)
+	s.NoError(err)
+	s.Equal([]byte{0x1f, 0x8b}, data[:2])
+
+	getRequest := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    1,
+	}
+	var historyBatches []*historypb.History
+	for {
+		response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+		s.NoError(err)
+		historyBatches = append(historyBatches, response.HistoryBatches...)
+		if response.NextPageToken == nil {
+			break
+		}
+		getRequest.NextPageToken = response.NextPageToken
+	}
+	s.Equal(s.historyBatchesV100, historyBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_PartitionedAndLegacy() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir, err := ioutil.TempDir("", "TestGetPartitionedAndLegacy")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	// version 1 was archived before partitioning was introduced
+	data, err := encodeHistories(s.historyBatchesV1)
+	s.NoError(err)
+	filename := constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, 1)
+	s.NoError(writeFile(path.Join(dir, filename), data, testFileMode))
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	})
+	s.NoError(err)
+
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, request)
+	s.NoError(err)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+	request.CloseFailoverVersion = convert.Int64Ptr(1)
+	response, err = historyArchiver.Get(context.Background(), URI, request)
+	s.NoError(err)
+	s.Equal(s.historyBatchesV1, response.HistoryBatches)
+
+	request.CloseFailoverVersion = convert.Int64Ptr(10)
+	response, err = historyArchiver.Get(context.Background(), URI, request)
+	s.Error(err)
+	s.IsType(&serviceerror.NotFound{}, err)
+	s.Nil(response)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -0,0 +1,319 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+)
+
+// Archived visibility records are partitioned by namespace and date:
+//   <URI path>/<namespaceID>/<yyyy-mm-dd>/<archived file>
+// Each partition also contains manifest files which index the archived files in that partition,
+// so that queries only need to read the manifests instead of every archived file.
+// A manifest is a file named <kind>-<sequence>.manifest, containing one JSON encoded manifestEntry
+// per line. Once a manifest reaches the configured max size, a manifest with the next sequence
+// number is started. Manifests are appended to under a lock of the <kind>.lock file of the partition,
+// so that hosts sharing the directory don't interleave their entries.
+
+const (
+	visibilityManifestKind = "visibility"
+
+	partitionDateLayout     = "2006-01-02"
+	manifestExtension       = ".manifest"
+	lockExtension           = ".lock"
+	visibilityFileExtension = ".visibility"
+	gzipExtension           = ".gz"
+	tempFileExtension       = ".tmp"
+
+	defaultManifestMaxSize = 1024 * 1024 // 1MB
+)
+
+type (
+	manifestEntry struct {
+		File                 string                          `json:"file"`
+		WorkflowID           string                          `json:"workflowId"`
+		RunID                string                          `json:"runId"`
+		CloseFailoverVersion int64                           `json:"closeFailoverVersion,omitempty"`
+		CloseTime            *time.Time                      `json:"closeTime,omitempty"`
+		WorkflowTypeName     string                          `json:"workflowTypeName,omitempty"`
+		Status               enumspb.WorkflowExecutionStatus `json:"status,omitempty"`
+	}
+
+	manifestWriter struct {
+		kind     string
+		maxSize  int64
+		fileMode os.FileMode
+
+		sync.Mutex
+		// last known manifest sequence number by partition directory, other hosts may have started later ones
+		sequences map[string]int
+	}
+)
+
+func newManifestWriter(kind string, maxSize int64, fileMode os.FileMode) *manifestWriter {
+	if maxSize <= 0 {
+		maxSize = defaultManifestMaxSize
+	}
+	return &manifestWriter{
+		kind:      kind,
+		maxSize:   maxSize,
+		fileMode:  fileMode,
+		sequences: make(map[string]int),
+	}
+}
+
+// append adds an entry to the current manifest of the partition directory, starting a new manifest
+// if the current one has reached the max size.
+func (w *manifestWriter) append(partitionDir string, entry *manifestEntry) (retErr error) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	w.Lock()
+	defer w.Unlock()
+
+	lock, err := os.OpenFile(path.Join(partitionDir, w.kind+lockExtension), os.O_RDWR|os.O_CREATE, w.fileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := lock.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	if err := lockFile(lock); err != nil {
+		return err
+	}
+
+	sequence, ok := w.sequences[partitionDir]
+	if !ok {
+		if sequence, err = latestManifestSequence(partitionDir, w.kind); err != nil {
+			return err
+		}
+	}
+	// skip manifests which reached the max size, including the ones started by other hosts
+	var filepath string
+	for {
+		filepath = path.Join(partitionDir, constructManifestFilename(w.kind, sequence))
+		info, err := os.Stat(filepath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err != nil || info.Size() < w.maxSize {
+			break
+		}
+		sequence++
+	}
+	w.sequences[partitionDir] = sequence
+	return appendFile(filepath, data, w.fileMode)
+}
+
+// readManifests returns the entries of all manifests of the given kind in the partition directory.
+// Lines which can't be decoded, e.g. the partial last line of a manifest being written, are skipped.
+// If a file is indexed more than once, the last entry wins.
+func readManifests(partitionDir string, kind string) ([]*manifestEntry, error) {
+	filenames, err := listFilesByPrefix(partitionDir, kind+"-")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	sort.Strings(filenames)
+
+	var entries []*manifestEntry
+	indexByFile := make(map[string]int)
+	for _, filename := range filenames {
+		if !strings.HasSuffix(filename, manifestExtension) {
+			continue
+		}
+		data, err := readFile(path.Join(partitionDir, filename))
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range bytes.Split(data, []byte{'\n'}) {
+			entry := &manifestEntry{}
+			if err := json.Unmarshal(line, entry); err != nil || entry.File == "" {
+				continue
+			}
+			if idx, ok := indexByFile[entry.File]; ok {
+				entries[idx] = entry
+				continue
+			}
+			indexByFile[entry.File] = len(entries)
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// listPartitions returns the date partitions in the namespace directory, latest first.
+// Names which are not valid partition dates, e.g. files archived before partitioning was introduced,
+// are returned separately.
+func listPartitions(namespaceDir string) (partitions []time.Time, others []string, err error) {
+	filenames, err := listFiles(namespaceDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	for _, name := range filenames {
+		if partition, err := parsePartition(name); err == nil {
+			partitions = append(partitions, partition)
+		} else {
+			others = append(others, name)
+		}
+	}
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].After(partitions[j])
+	})
+	return partitions, others, nil
+}
+
+func latestManifestSequence(partitionDir string, kind string) (int, error) {
+	filenames, err := listFilesByPrefix(partitionDir, kind+"-")
+	if err != nil {
+		return 0, err
+	}
+	latest := 0
+	for _, filename := range filenames {
+		if !strings.HasSuffix(filename, manifestExtension) {
+			continue
+		}
+		sequence, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(filename, kind+"-"), manifestExtension))
+		if err != nil {
+			continue
+		}
+		if sequence > latest {
+			latest = sequence
+		}
+	}
+	return latest, nil
+}
+
+func constructManifestFilename(kind string, sequence int) string {
+	return fmt.Sprintf("%s-%06d%s", kind, sequence, manifestExtension)
+}
+
+func partitionName(t time.Time) string {
+	return t.UTC().Format(partitionDateLayout)
+}
+
+func parsePartition(name string) (time.Time, error) {
+	return time.Parse(partitionDateLayout, name)
+}
+
+func newVisibilityManifestEntry(filename string, record *archiverspb.ArchiveVisibilityRequest) *manifestEntry {
+	return &manifestEntry{
+		File:             filename,
+		WorkflowID:       record.GetWorkflowId(),
+		RunID:            record.GetRunId(),
+		CloseTime:        record.CloseTime,
+		WorkflowTypeName: record.GetWorkflowTypeName(),
+		Status:           record.GetStatus(),
+	}
+}
+
+// visibilityRecord returns the part of the visibility record kept in the manifest,
+// which is enough to evaluate queries against.
+func (e *manifestEntry) visibilityRecord() *archiverspb.ArchiveVisibilityRequest {
+	return &archiverspb.ArchiveVisibilityRequest{
+		WorkflowId:       e.WorkflowID,
+		RunId:            e.RunID,
+		CloseTime:        e.CloseTime,
+		WorkflowTypeName: e.WorkflowTypeName,
+		Status:           e.Status,
+	}
+}
+
+// Compressed files
+
+// writeArchiveFile writes the file under a temporary name and renames it once complete, so that readers
+// never see a partially written file. Temporary names start with a dot, so they match no file name prefix.
+func writeArchiveFile(filepath string, data []byte, fileMode os.FileMode) error {
+	if strings.HasSuffix(filepath, gzipExtension) {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+		data = buf.Bytes()
+	}
+	tempFilepath := path.Join(path.Dir(filepath), "."+path.Base(filepath)+"."+uuid.New()+tempFileExtension)
+	if err := writeFile(tempFilepath, data, fileMode); err != nil {
+		os.Remove(tempFilepath)
+		return err
+	}
+	return os.Rename(tempFilepath, filepath)
+}
+
+func readArchiveFile(filepath string) ([]byte, error) {
+	data, err := readFile(filepath)
+	if err != nil || !strings.HasSuffix(filepath, gzipExtension) {
+		return data, err
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+func appendFile(filepath string, data []byte, fileMode os.FileMode) (retErr error) {
+	f, err := os.OpenFile(filepath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, fileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = f.Write(data)
+	return err
+}
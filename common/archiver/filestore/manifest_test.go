@@ -0,0 +1,181 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const testManifestKind = "test"
+
+type manifestSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestManifestSuite(t *testing.T) {
+	suite.Run(t, new(manifestSuite))
+}
+
+func (s *manifestSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *manifestSuite) TestAppendAndRead() {
+	dir, err := ioutil.TempDir("", "TestAppendAndRead")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	entries, err := readManifests(filepath.Join(dir, "not-exist"), testManifestKind)
+	s.NoError(err)
+	s.Empty(entries)
+
+	writer := newManifestWriter(testManifestKind, 0, testFileMode)
+	s.Equal(int64(defaultManifestMaxSize), writer.maxSize)
+	s.NoError(writer.append(dir, &manifestEntry{File: "file-1", WorkflowID: "wid", RunID: "rid-1", CloseFailoverVersion: 1}))
+	s.NoError(writer.append(dir, &manifestEntry{File: "file-2", WorkflowID: "wid", RunID: "rid-2", CloseFailoverVersion: 2}))
+	// a file archived again replaces its previous entry
+	s.NoError(writer.append(dir, &manifestEntry{File: "file-1", WorkflowID: "wid", RunID: "rid-1", CloseFailoverVersion: 3}))
+
+	// corrupted lines, e.g. a partially written entry, are skipped
+	s.NoError(appendFile(filepath.Join(dir, constructManifestFilename(testManifestKind, 0)), []byte("{\"file\":\"fi"), testFileMode))
+	// manifests of other kinds are not read
+	s.NoError(newManifestWriter(visibilityManifestKind, 0, testFileMode).append(dir, &manifestEntry{File: "file-3"}))
+
+	entries, err = readManifests(dir, testManifestKind)
+	s.NoError(err)
+	s.Equal([]*manifestEntry{
+		{File: "file-1", WorkflowID: "wid", RunID: "rid-1", CloseFailoverVersion: 3},
+		{File: "file-2", WorkflowID: "wid", RunID: "rid-2", CloseFailoverVersion: 2},
+	}, entries)
+}
+
+func (s *manifestSuite) TestRotation() {
+	dir, err := ioutil.TempDir("", "TestRotation")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	writer := newManifestWriter(visibilityManifestKind, 1, testFileMode)
+	s.NoError(writer.append(dir, &manifestEntry{File: "file-1"}))
+	s.NoError(writer.append(dir, &manifestEntry{File: "file-2"}))
+
+	// a new writer, e.g. after a restart, continues with the latest manifest
+	writer = newManifestWriter(visibilityManifestKind, 1, testFileMode)
+	s.NoError(writer.append(dir, &manifestEntry{File: "file-3"}))
+
+	filenames, err := listFiles(dir)
+	s.NoError(err)
+	s.ElementsMatch([]string{
+		"visibility-000000.manifest",
+		"visibility-000001.manifest",
+		"visibility-000002.manifest",
+		"visibility.lock",
+	}, filenames)
+
+	entries, err := readManifests(dir, visibilityManifestKind)
+	s.NoError(err)
+	s.Equal([]*manifestEntry{{File: "file-1"}, {File: "file-2"}, {File: "file-3"}}, entries)
+}
+
+func (s *manifestSuite) TestAppend_Concurrent() {
+	dir, err := ioutil.TempDir("", "TestAppendConcurrent")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	// writers of different hosts sharing the directory don't interleave their entries
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		writer := newManifestWriter(visibilityManifestKind, 512, testFileMode)
+		wg.Add(1)
+		go func(writerID int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				s.NoError(writer.append(dir, &manifestEntry{File: fmt.Sprintf("file-%v-%v", writerID, j), WorkflowID: "wid"}))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	entries, err := readManifests(dir, visibilityManifestKind)
+	s.NoError(err)
+	s.Len(entries, 200)
+}
+
+func (s *manifestSuite) TestListPartitions() {
+	dir, err := ioutil.TempDir("", "TestListPartitions")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	partitions, others, err := listPartitions(filepath.Join(dir, "not-exist"))
+	s.NoError(err)
+	s.Empty(partitions)
+	s.Empty(others)
+
+	for _, name := range []string{"2020-08-22", "2020-12-01", "2019-01-31"} {
+		s.NoError(mkdirAll(filepath.Join(dir, name), testDirMode))
+	}
+	s.NoError(writeFile(filepath.Join(dir, "10_123.visibility"), []byte("legacy"), testFileMode))
+
+	partitions, others, err = listPartitions(dir)
+	s.NoError(err)
+	s.Equal([]time.Time{
+		time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, 8, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC),
+	}, partitions)
+	s.Equal([]string{"10_123.visibility"}, others)
+	s.Equal("2020-08-22", partitionName(time.Date(2020, 8, 22, 23, 0, 0, 0, time.UTC)))
+}
+
+func (s *manifestSuite) TestWriteReadArchiveFile() {
+	dir, err := ioutil.TempDir("", "TestWriteReadArchiveFile")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"file", "file" + gzipExtension} {
+		fpath := filepath.Join(dir, name)
+		s.NoError(writeArchiveFile(fpath, []byte("file contents"), testFileMode))
+		data, err := readArchiveFile(fpath)
+		s.NoError(err)
+		s.Equal([]byte("file contents"), data)
+	}
+	// no temporary file is left behind
+	filenames, err := listFiles(dir)
+	s.NoError(err)
+	s.ElementsMatch([]string{"file", "file" + gzipExtension}, filenames)
+
+	compressed, err := readFile(filepath.Join(dir, "file"+gzipExtension))
+	s.NoError(err)
+	s.NotEqual([]byte("file contents"), compressed)
+}
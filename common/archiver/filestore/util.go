@@ -31,6 +31,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -112,6 +113,17 @@ func readFile(filepath string) ([]byte, error) {
 	return ioutil.ReadFile(filepath)
 }
 
+func probeDir(dirPath string, dirMode os.FileMode, fileMode os.FileMode) error {
+	if err := mkdirAll(dirPath, dirMode); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+	return archiver.Probe(
+		func(name string, data []byte) error { return writeFile(path.Join(dirPath, name), data, fileMode) },
+		func(name string) ([]byte, error) { return readFile(path.Join(dirPath, name)) },
+		func(name string) error { return os.Remove(path.Join(dirPath, name)) },
+	)
+}
+
 func listFiles(dirPath string) ([]string, error) {
 	if info, err := os.Stat(dirPath); err != nil {
 		return nil, err
@@ -199,7 +211,7 @@ func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) strin
 }
 
 func constructVisibilityFilename(closeTimestamp *time.Time, runID string) string {
-	return fmt.Sprintf("%v_%s.visibility", timestamp.TimeValue(closeTimestamp).UnixNano(), hash(runID))
+	return fmt.Sprintf("%v_%s%s", timestamp.TimeValue(closeTimestamp).UnixNano(), hash(runID), visibilityFileExtension)
 }
 
 func hash(s string) string {
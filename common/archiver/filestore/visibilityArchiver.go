@@ -47,6 +47,7 @@ import (
 
 const (
 	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errWriteManifest          = "failed to write manifest"
 )
 
 type (
@@ -54,6 +55,8 @@ type (
 		container   *archiver.VisibilityBootstrapContainer
 		fileMode    os.FileMode
 		dirMode     os.FileMode
+		gzip        bool
+		manifest    *manifestWriter
 		queryParser QueryParser
 	}
 
@@ -87,6 +90,8 @@ func NewVisibilityArchiver(
 		container:   container,
 		fileMode:    os.FileMode(fileMode),
 		dirMode:     os.FileMode(dirMode),
+		gzip:        config.Gzip,
+		manifest:    newManifestWriter(visibilityManifestKind, config.ManifestMaxSize, os.FileMode(fileMode)),
 		queryParser: NewQueryParser(),
 	}, nil
 }
@@ -116,8 +121,10 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 
-	dirPath := path.Join(URI.Path(), request.GetNamespaceId())
-	if err = mkdirAll(dirPath, v.dirMode); err != nil {
+	// Records are partitioned by the date of their close time, so that queries on close time
+	// only need to read the manifests of the matching partitions.
+	partitionDir := path.Join(URI.Path(), request.GetNamespaceId(), partitionName(timestamp.TimeValue(request.CloseTime)))
+	if err = mkdirAll(partitionDir, v.dirMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
 		return err
 	}
@@ -131,11 +138,19 @@ func (v *visibilityArchiver) Archive(
 	// The filename has the format: closeTimestamp_hash(runID).visibility
 	// This format allows the archiver to sort all records without reading the file contents
 	filename := constructVisibilityFilename(request.CloseTime, request.GetRunId())
-	if err := writeFile(path.Join(dirPath, filename), encodedVisibilityRecord, v.fileMode); err != nil {
+	if v.gzip {
+		filename += gzipExtension
+	}
+	if err := writeArchiveFile(path.Join(partitionDir, filename), encodedVisibilityRecord, v.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
 	}
 
+	if err := v.manifest.append(partitionDir, newVisibilityManifestEntry(filename, request)); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteManifest), tag.Error(err))
+		return err
+	}
+
 	return nil
 }
 
@@ -192,7 +207,7 @@ func (v *visibilityArchiver) query(
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
-	files, err := listFiles(dirPath)
+	files, err := listVisibilityFiles(dirPath, request.parsedQuery, token)
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
@@ -207,7 +222,7 @@ func (v *visibilityArchiver) query(
 
 	response := &archiver.QueryVisibilityResponse{}
 	for idx, file := range files {
-		encodedRecord, err := readFile(path.Join(dirPath, file))
+		encodedRecord, err := readArchiveFile(path.Join(dirPath, file))
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
@@ -248,9 +263,57 @@ func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 		return archiver.ErrURISchemeMismatch
 	}
 
+	if archiver.GetCredentialsRef(URI) != "" {
+		return archiver.ErrCredentialsRefNotSupported
+	}
+
 	return validateDirPath((URI.Path()))
 }
 
+// ProbeURI writes, reads back and deletes a probe file in the directory of URI
+func (v *visibilityArchiver) ProbeURI(_ context.Context, URI archiver.URI) error {
+	if err := v.ValidateURI(URI); err != nil {
+		return err
+	}
+	return probeDir(URI.Path(), v.dirMode, v.fileMode)
+}
+
+// listVisibilityFiles returns the paths, relative to the namespace directory, of the visibility records
+// which may match the query. Records in date partitions are filtered using the partition manifests,
+// while records archived directly in the namespace directory are all returned.
+func listVisibilityFiles(namespaceDir string, query *parsedQuery, token *queryVisibilityToken) ([]string, error) {
+	partitions, others, err := listPartitions(namespaceDir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, name := range others {
+		// the namespace directory may also hold history partitions if both archivers share the directory
+		if strings.HasSuffix(strings.TrimSuffix(name, gzipExtension), visibilityFileExtension) {
+			files = append(files, name)
+		}
+	}
+	for _, partition := range partitions {
+		if !partition.Add(24*time.Hour).After(query.earliestCloseTime) || partition.After(query.latestCloseTime) {
+			continue
+		}
+		if token != nil && partition.After(token.LastCloseTime) {
+			continue
+		}
+		name := partitionName(partition)
+		entries, err := readManifests(path.Join(namespaceDir, name), visibilityManifestKind)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if matchQuery(entry.visibilityRecord(), query) {
+				files = append(files, path.Join(name, entry.File))
+			}
+		}
+	}
+	return files, nil
+}
+
 type parsedVisFilename struct {
 	name        string
 	closeTime   time.Time
@@ -258,11 +321,12 @@ type parsedVisFilename struct {
 }
 
 // sortAndFilterFiles sort visibility record file names based on close timestamp (desc) and use hashed runID to break ties.
-// if a nextPageToken is give, it only returns filenames that have a smaller close timestamp
+// if a nextPageToken is give, it only returns filenames that have a smaller close timestamp.
+// File names may be prefixed with the partition directory and suffixed with the gzip extension.
 func sortAndFilterFiles(filenames []string, token *queryVisibilityToken) ([]string, error) {
 	var parsedFilenames []*parsedVisFilename
 	for _, name := range filenames {
-		pieces := strings.FieldsFunc(name, func(r rune) bool {
+		pieces := strings.FieldsFunc(strings.TrimSuffix(path.Base(name), gzipExtension), func(r rune) bool {
 			return r == '_' || r == '.'
 		})
 		if len(pieces) != 3 {
@@ -110,6 +110,10 @@ func (s *visibilityArchiverSuite) TestValidateURI() {
 			URI:         "file://",
 			expectedErr: errEmptyDirectoryPath,
 		},
+		{
+			URI:         "file:///a/b/c?credentials=tenant",
+			expectedErr: archiver.ErrCredentialsRefNotSupported,
+		},
 		{
 			URI:         "file:///a/b/c",
 			expectedErr: nil,
@@ -195,9 +199,14 @@ func (s *visibilityArchiverSuite) TestArchive_Success() {
 	s.NoError(err)
 
 	expectedFilename := constructVisibilityFilename(closeTimestamp, testRunID)
-	filepath := path.Join(dir, testNamespaceID, expectedFilename)
+	partitionDir := path.Join(dir, testNamespaceID, closeTimestamp.Format("2006-01-02"))
+	filepath := path.Join(partitionDir, expectedFilename)
 	s.assertFileExists(filepath)
 
+	entries, err := readManifests(partitionDir, visibilityManifestKind)
+	s.NoError(err)
+	s.Equal([]*manifestEntry{newVisibilityManifestEntry(expectedFilename, request)}, entries)
+
 	data, err := readFile(filepath)
 	s.NoError(err)
 
@@ -500,6 +509,87 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[1]), executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_PartitionedAndLegacy() {
+	dir, err := ioutil.TempDir("", "TestArchiveAndQueryPartitioned")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	day := time.Date(2020, 8, 22, 0, 0, 0, 0, time.UTC)
+	newRecord := func(runID string, closeTime time.Time) *archiverspb.ArchiveVisibilityRequest {
+		return &archiverspb.ArchiveVisibilityRequest{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            runID,
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.TimePtr(closeTime.Add(-time.Minute)),
+			CloseTime:        timestamp.TimePtr(closeTime),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			HistoryLength:    10,
+		}
+	}
+	// the first record was archived before partitioning was introduced
+	records := []*archiverspb.ArchiveVisibilityRequest{
+		newRecord("run-0", day.Add(22*time.Hour)),
+		newRecord("run-1", day.Add(-time.Hour)),
+		newRecord("run-2", day.Add(time.Hour)),
+		newRecord("run-3", day.Add(25*time.Hour)),
+		newRecord("run-4", day.Add(49*time.Hour)),
+	}
+	records[2].Status = enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+	data, err := encode(records[0])
+	s.NoError(err)
+	s.NoError(os.MkdirAll(path.Join(dir, testNamespaceID), testDirMode))
+	s.NoError(writeFile(path.Join(dir, testNamespaceID, constructVisibilityFilename(records[0].CloseTime, "run-0")), data, testFileMode))
+	// histories archived to the same directory are partitioned by workflow in the namespace directory
+	s.NoError(os.MkdirAll(historyPartitionDir(dir, testNamespaceID, testWorkflowID), testDirMode))
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	visibilityArchiver.gzip = true
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	for _, record := range records[1:] {
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+	s.assertFileExists(path.Join(dir, testNamespaceID, "2020-08-23", constructVisibilityFilename(records[3].CloseTime, "run-3")+gzipExtension))
+
+	mockParser := NewMockQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: day,
+		latestCloseTime:   day.Add(48 * time.Hour),
+		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED),
+	}, nil).AnyTimes()
+	visibilityArchiver.queryParser = mockParser
+
+	files, err := listVisibilityFiles(path.Join(dir, testNamespaceID), &parsedQuery{
+		earliestCloseTime: day,
+		latestCloseTime:   day.Add(48 * time.Hour),
+		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED),
+	}, nil)
+	s.NoError(err)
+	s.ElementsMatch([]string{
+		constructVisibilityFilename(records[0].CloseTime, "run-0"),
+		path.Join("2020-08-23", constructVisibilityFilename(records[3].CloseTime, "run-3")+gzipExtension),
+	}, files)
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "parsed by mockParser",
+	}
+	executions := []*workflowpb.WorkflowExecutionInfo{}
+	for len(executions) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request)
+		s.NoError(err)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal([]*workflowpb.WorkflowExecutionInfo{
+		convertToExecutionInfo(records[3]),
+		convertToExecutionInfo(records[0]),
+	}, executions)
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
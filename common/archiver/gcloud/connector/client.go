@@ -28,12 +28,15 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"hash/crc32"
 	"io"
 	"io/ioutil"
 	"os"
 	"regexp"
 
 	"cloud.google.com/go/storage"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/api/iterator"
 
 	"go.temporal.io/server/common/archiver"
@@ -42,6 +45,12 @@ import (
 
 const (
 	bucketNameRegExpRaw = "^gs:\\/\\/[^:\\/\n?]+"
+
+	// components of parallel composed objects are uploaded under this directory of the sink path,
+	// so that they are never matched by queries of the archivers
+	composeComponentsDir             = ".compose"
+	defaultParallelComposeComponents = 8
+	maxParallelComposeComponents     = 32
 )
 
 var (
@@ -49,6 +58,7 @@ var (
 	ErrBucketNotFound = errors.New("bucket not found")
 	errObjectNotFound = errors.New("object not found")
 	bucketNameRegExp  = regexp.MustCompile(bucketNameRegExpRaw)
+	crc32cTable       = crc32.MakeTable(crc32.Castagnoli)
 )
 
 type (
@@ -63,10 +73,14 @@ type (
 		Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) ([]string, error)
 		QueryWithFilters(ctx context.Context, URI archiver.URI, fileNamePrefix string, pageSize, offset int, filters []Precondition) ([]string, bool, int, error)
 		Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error)
+		Delete(ctx context.Context, URI archiver.URI, fileName string) error
 	}
 
 	storageWrapper struct {
-		client GcloudStorageClient
+		client                    GcloudStorageClient
+		uploadChunkSize           int
+		parallelComposeThreshold  int
+		parallelComposeComponents int
 	}
 )
 
@@ -76,18 +90,22 @@ type (
 // You can find more info about "Google Setting Up Authentication for Server to Server Production Applications" under the following link
 // https://cloud.google.com/docs/authentication/production
 func NewClient(ctx context.Context, config *config.GstorageArchiver) (Client, error) {
+	if err := validateUploadConfig(config); err != nil {
+		return nil, err
+	}
+
 	if credentialsPath := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"); credentialsPath != "" {
 		clientDelegate, err := newClientDelegateWithCredentials(ctx, credentialsPath)
-		return &storageWrapper{client: clientDelegate}, err
+		return newStorageWrapper(clientDelegate, config), err
 	}
 
 	if config.CredentialsPath != "" {
 		clientDelegate, err := newClientDelegateWithCredentials(ctx, config.CredentialsPath)
-		return &storageWrapper{client: clientDelegate}, err
+		return newStorageWrapper(clientDelegate, config), err
 	}
 
 	clientDelegate, err := newDefaultClientDelegate(ctx)
-	return &storageWrapper{client: clientDelegate}, err
+	return newStorageWrapper(clientDelegate, config), err
 
 }
 
@@ -96,13 +114,60 @@ func NewClientWithParams(clientD GcloudStorageClient) (Client, error) {
 	return &storageWrapper{client: clientD}, nil
 }
 
+// NewClientWithConfig return a gcloudstorage.Client based on input parameters which uploads files as configured
+func NewClientWithConfig(clientD GcloudStorageClient, config *config.GstorageArchiver) (Client, error) {
+	if err := validateUploadConfig(config); err != nil {
+		return nil, err
+	}
+	return newStorageWrapper(clientD, config), nil
+}
+
+func newStorageWrapper(clientD GcloudStorageClient, config *config.GstorageArchiver) *storageWrapper {
+	parallelComposeComponents := config.ParallelComposeComponents
+	if parallelComposeComponents == 0 {
+		parallelComposeComponents = defaultParallelComposeComponents
+	}
+	return &storageWrapper{
+		client:                    clientD,
+		uploadChunkSize:           config.UploadChunkSize,
+		parallelComposeThreshold:  config.ParallelComposeThreshold,
+		parallelComposeComponents: parallelComposeComponents,
+	}
+}
+
+func validateUploadConfig(config *config.GstorageArchiver) error {
+	if config.UploadChunkSize < 0 {
+		return errors.New("uploadChunkSize must not be negative")
+	}
+	if config.ParallelComposeThreshold < 0 {
+		return errors.New("parallelComposeThreshold must not be negative")
+	}
+	if config.ParallelComposeComponents < 0 || config.ParallelComposeComponents > maxParallelComposeComponents {
+		return fmt.Errorf("parallelComposeComponents must be between 0 and %d", maxParallelComposeComponents)
+	}
+	return nil
+}
+
 // Upload push a file to gcloud storage bucket (sinkPath)
 // example:
 // Upload(ctx, mockBucketHandleClient, "gs://my-bucket-cad/temporal_archival/development", "45273645-fileName.history", fileReader)
+// Files larger than the parallel compose threshold are split into components which are uploaded in parallel
+// and composed into the file. The CRC32C checksum of the file is sent along, so corrupted uploads are rejected.
 func (s *storageWrapper) Upload(ctx context.Context, URI archiver.URI, fileName string, file []byte) (err error) {
 	bucket := s.client.Bucket(URI.Hostname())
-	writer := bucket.Object(formatSinkPath(URI.Path()) + "/" + fileName).NewWriter(ctx)
-	_, err = io.Copy(writer, bytes.NewReader(file))
+	if s.parallelComposeThreshold > 0 && len(file) > s.parallelComposeThreshold {
+		return s.composeUpload(ctx, bucket, formatSinkPath(URI.Path()), fileName, file)
+	}
+	return s.upload(ctx, bucket, formatSinkPath(URI.Path())+"/"+fileName, file)
+}
+
+func (s *storageWrapper) upload(ctx context.Context, bucket BucketHandleWrapper, objectName string, data []byte) (err error) {
+	writer := bucket.Object(objectName).NewWriter(ctx)
+	if s.uploadChunkSize > 0 {
+		writer.SetChunkSize(s.uploadChunkSize)
+	}
+	writer.SetCRC32C(crc32.Checksum(data, crc32cTable))
+	_, err = io.Copy(writer, bytes.NewReader(data))
 	if err == nil {
 		err = writer.Close()
 	}
@@ -110,6 +175,36 @@ func (s *storageWrapper) Upload(ctx context.Context, URI archiver.URI, fileName
 	return err
 }
 
+func (s *storageWrapper) composeUpload(ctx context.Context, bucket BucketHandleWrapper, sinkPath string, fileName string, data []byte) error {
+	componentSize := (len(data) + s.parallelComposeComponents - 1) / s.parallelComposeComponents
+	var components []string
+	g, gctx := errgroup.WithContext(ctx)
+	for offset := 0; offset < len(data); offset += componentSize {
+		end := offset + componentSize
+		if end > len(data) {
+			end = len(data)
+		}
+		component := fmt.Sprintf("%s/%s/%s.%d", sinkPath, composeComponentsDir, fileName, len(components))
+		componentData := data[offset:end]
+		components = append(components, component)
+		g.Go(func() error {
+			return s.upload(gctx, bucket, component, componentData)
+		})
+	}
+	defer func() {
+		// components are not needed anymore once composed, or have to be uploaded again on retry
+		for _, component := range components {
+			_ = bucket.Object(component).Delete(ctx)
+		}
+	}()
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	_, err := bucket.Compose(ctx, sinkPath+"/"+fileName, components, crc32.Checksum(data, crc32cTable))
+	return err
+}
+
 // Exist check if a bucket or an object exist
 // If fileName is empty, then 'Exist' function will only check if the given bucket exist.
 func (s *storageWrapper) Exist(ctx context.Context, URI archiver.URI, fileName string) (exists bool, err error) {
@@ -142,6 +237,12 @@ func (s *storageWrapper) Get(ctx context.Context, URI archiver.URI, fileName str
 	return nil, err
 }
 
+// Delete removes a file
+func (s *storageWrapper) Delete(ctx context.Context, URI archiver.URI, fileName string) error {
+	bucket := s.client.Bucket(URI.Hostname())
+	return bucket.Object(formatSinkPath(URI.Path()) + "/" + fileName).Delete(ctx)
+}
+
 // Query, retieves file names by provided storage query
 func (s *storageWrapper) Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) (fileNames []string, err error) {
 	fileNames = make([]string, 0)
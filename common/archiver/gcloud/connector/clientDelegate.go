@@ -51,6 +51,7 @@ type (
 		Object(name string) ObjectHandleWrapper
 		Objects(ctx context.Context, q *storage.Query) ObjectIteratorWrapper
 		Attrs(ctx context.Context) (*storage.BucketAttrs, error)
+		Compose(ctx context.Context, dst string, srcs []string, crc32c uint32) (*storage.ObjectAttrs, error)
 	}
 
 	bucketDelegate struct {
@@ -64,6 +65,7 @@ type (
 		NewWriter(ctx context.Context) WriterWrapper
 		NewReader(ctx context.Context) (ReaderWrapper, error)
 		Attrs(ctx context.Context) (*storage.ObjectAttrs, error)
+		Delete(ctx context.Context) error
 	}
 
 	objectDelegate struct {
@@ -77,6 +79,8 @@ type (
 		Close() error
 		Write(p []byte) (n int, err error)
 		CloseWithError(err error) error
+		SetChunkSize(chunkSize int)
+		SetCRC32C(crc32c uint32)
 	}
 
 	writerDelegate struct {
@@ -168,6 +172,20 @@ func (b *bucketDelegate) Attrs(ctx context.Context) (*storage.BucketAttrs, error
 	return b.bucket.Attrs(ctx)
 }
 
+// Compose concatenates the source objects of the bucket into the destination object dst.
+// At most 32 source objects can be composed at once. The checksum of the destination object
+// is sent along, the compose is rejected if it does not match the composed data.
+func (b *bucketDelegate) Compose(ctx context.Context, dst string, srcs []string, crc32c uint32) (*storage.ObjectAttrs, error) {
+	srcHandles := make([]*storage.ObjectHandle, 0, len(srcs))
+	for _, src := range srcs {
+		srcHandles = append(srcHandles, b.bucket.Object(src))
+	}
+	composer := b.bucket.Object(dst).ComposerFrom(srcHandles...)
+	composer.CRC32C = crc32c
+	composer.SendCRC32C = true
+	return composer.Run(ctx)
+}
+
 // Next returns the next result. Its second return value is iterator.Done if
 // there are no more results. Once Next returns iterator.Done, all subsequent
 // calls will return iterator.Done.
@@ -212,6 +230,11 @@ func (o *objectDelegate) Attrs(ctx context.Context) (attrs *storage.ObjectAttrs,
 	return o.object.Attrs(ctx)
 }
 
+// Delete deletes the single specified object.
+func (o *objectDelegate) Delete(ctx context.Context) error {
+	return o.object.Delete(ctx)
+}
+
 // Close completes the write operation and flushes any buffered data.
 // If Close doesn't return an error, metadata about the written object
 // can be retrieved by calling Attrs.
@@ -237,6 +260,21 @@ func (w *writerDelegate) CloseWithError(err error) error {
 	return w.writer.CloseWithError(err)
 }
 
+// SetChunkSize sets the maximum number of bytes of the object that the writer sends in a single request
+// of the resumable upload. Zero disables chunking and uploads the object in a single request.
+// It must be called before the first Write call.
+func (w *writerDelegate) SetChunkSize(chunkSize int) {
+	w.writer.ChunkSize = chunkSize
+}
+
+// SetCRC32C sets the CRC32C checksum of the object which is sent along with the upload,
+// the upload is rejected if it does not match the received data.
+// It must be called before the first Write call.
+func (w *writerDelegate) SetCRC32C(crc32c uint32) {
+	w.writer.CRC32C = crc32c
+	w.writer.SendCRC32C = true
+}
+
 // Close closes the Reader. It must be called when done reading.
 func (r *readerDelegate) Close() error {
 	return r.reader.Close()
@@ -28,6 +28,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"hash/crc32"
 	"io"
 	"io/ioutil"
 	"os"
@@ -84,6 +86,7 @@ func (s *clientSuite) TestUpload() {
 	mockStorageClient.On("Bucket", "my-bucket-cad").Return(mockBucketHandleClient).Times(1)
 	mockBucketHandleClient.On("Object", "temporal_archival/development/myfile.history").Return(mockObjectHandler).Times(1)
 	mockObjectHandler.On("NewWriter", ctx).Return(mockWriter).Times(1)
+	mockWriter.On("SetCRC32C", crc32.Checksum([]byte("{}"), crc32.MakeTable(crc32.Castagnoli))).Times(1)
 	mockWriter.On("Write", mock.Anything).Return(2, nil).Times(2)
 	mockWriter.On("Close").Return(nil).Times(1)
 
@@ -105,6 +108,7 @@ func (s *clientSuite) TestUploadWriterCloseError() {
 	mockStorageClient.On("Bucket", "my-bucket-cad").Return(mockBucketHandleClient).Times(1)
 	mockBucketHandleClient.On("Object", "temporal_archival/development/myfile.history").Return(mockObjectHandler).Times(1)
 	mockObjectHandler.On("NewWriter", ctx).Return(mockWriter).Times(1)
+	mockWriter.On("SetCRC32C", crc32.Checksum([]byte("{}"), crc32.MakeTable(crc32.Castagnoli))).Times(1)
 	mockWriter.On("Write", mock.Anything).Return(2, nil).Times(2)
 	mockWriter.On("Close").Return(errors.New("Not Found")).Times(1)
 
@@ -113,6 +117,86 @@ func (s *clientSuite) TestUploadWriterCloseError() {
 	s.Require().EqualError(err, "Not Found")
 }
 
+func (s *clientSuite) TestUploadParallelCompose() {
+	ctx := context.Background()
+	crc32cTable := crc32.MakeTable(crc32.Castagnoli)
+	file := []byte("0123456789")
+
+	mockStorageClient := &mocks.GcloudStorageClient{}
+	mockBucketHandleClient := &mocks.BucketHandleWrapper{}
+	storageWrapper, err := connector.NewClientWithConfig(mockStorageClient, &config.GstorageArchiver{
+		UploadChunkSize:           256 * 1024,
+		ParallelComposeThreshold:  8,
+		ParallelComposeComponents: 3,
+	})
+	s.Require().NoError(err)
+
+	mockStorageClient.On("Bucket", "my-bucket-cad").Return(mockBucketHandleClient).Times(1)
+	var components []string
+	for i, data := range []string{"0123", "4567", "89"} {
+		component := fmt.Sprintf("temporal_archival/development/.compose/myfile.history.%d", i)
+		components = append(components, component)
+		mockObjectHandler := &mocks.ObjectHandleWrapper{}
+		mockWriter := &mocks.WriterWrapper{}
+		mockBucketHandleClient.On("Object", component).Return(mockObjectHandler)
+		mockObjectHandler.On("NewWriter", mock.Anything).Return(mockWriter).Times(1)
+		mockObjectHandler.On("Delete", ctx).Return(nil).Times(1)
+		mockWriter.On("SetChunkSize", 256*1024).Times(1)
+		mockWriter.On("SetCRC32C", crc32.Checksum([]byte(data), crc32cTable)).Times(1)
+		mockWriter.On("Write", []byte(data)).Return(len(data), nil).Times(1)
+		mockWriter.On("Close").Return(nil).Times(1)
+		defer mockObjectHandler.AssertExpectations(s.T())
+		defer mockWriter.AssertExpectations(s.T())
+	}
+	mockBucketHandleClient.On("Compose", ctx, "temporal_archival/development/myfile.history", components, crc32.Checksum(file, crc32cTable)).
+		Return(&storage.ObjectAttrs{}, nil).Times(1)
+
+	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/development")
+	s.Require().NoError(err)
+	s.Require().NoError(storageWrapper.Upload(ctx, URI, "myfile.history", file))
+	mockBucketHandleClient.AssertExpectations(s.T())
+}
+
+func (s *clientSuite) TestUploadParallelComposeComponentError() {
+	ctx := context.Background()
+
+	mockStorageClient := &mocks.GcloudStorageClient{}
+	mockBucketHandleClient := &mocks.BucketHandleWrapper{}
+	mockObjectHandler := &mocks.ObjectHandleWrapper{}
+	mockWriter := &mocks.WriterWrapper{}
+	storageWrapper, err := connector.NewClientWithConfig(mockStorageClient, &config.GstorageArchiver{
+		ParallelComposeThreshold:  1,
+		ParallelComposeComponents: 2,
+	})
+	s.Require().NoError(err)
+
+	mockStorageClient.On("Bucket", "my-bucket-cad").Return(mockBucketHandleClient).Times(1)
+	mockBucketHandleClient.On("Object", mock.Anything).Return(mockObjectHandler)
+	mockObjectHandler.On("NewWriter", mock.Anything).Return(mockWriter).Times(2)
+	mockObjectHandler.On("Delete", ctx).Return(nil).Times(2)
+	mockWriter.On("SetCRC32C", mock.Anything).Times(2)
+	mockWriter.On("Write", mock.Anything).Return(1, nil).Times(2)
+	mockWriter.On("Close").Return(errors.New("Not Found")).Times(2)
+
+	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/development")
+	s.Require().NoError(err)
+	err = storageWrapper.Upload(ctx, URI, "myfile.history", []byte("{}"))
+	s.Require().EqualError(err, "Not Found")
+	mockBucketHandleClient.AssertNotCalled(s.T(), "Compose", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockObjectHandler.AssertExpectations(s.T())
+}
+
+func (s *clientSuite) TestNewClientWithConfig_InvalidConfig() {
+	for _, cfg := range []*config.GstorageArchiver{
+		{UploadChunkSize: -1},
+		{ParallelComposeThreshold: -1},
+		{ParallelComposeComponents: 33},
+	} {
+		_, err := connector.NewClientWithConfig(&mocks.GcloudStorageClient{}, cfg)
+		s.Error(err)
+	}
+}
+
 func (s *clientSuite) TestExist() {
 	ctx := context.Background()
 	testCases := []struct {
@@ -64,6 +64,29 @@ func (_m *BucketHandleWrapper) Attrs(ctx context.Context) (*storage.BucketAttrs,
 	return r0, r1
 }
 
+// Compose provides a mock function with given fields: ctx, dst, srcs, crc32c
+func (_m *BucketHandleWrapper) Compose(ctx context.Context, dst string, srcs []string, crc32c uint32) (*storage.ObjectAttrs, error) {
+	ret := _m.Called(ctx, dst, srcs, crc32c)
+
+	var r0 *storage.ObjectAttrs
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, uint32) *storage.ObjectAttrs); ok {
+		r0 = rf(ctx, dst, srcs, crc32c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*storage.ObjectAttrs)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, uint32) error); ok {
+		r1 = rf(ctx, dst, srcs, crc32c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Object provides a mock function with given fields: name
 func (_m *BucketHandleWrapper) Object(name string) connector.ObjectHandleWrapper {
 	ret := _m.Called(name)
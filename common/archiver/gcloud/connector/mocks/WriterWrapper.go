@@ -61,6 +61,16 @@ func (_m *WriterWrapper) CloseWithError(err error) error {
 	return r0
 }
 
+// SetCRC32C provides a mock function with given fields: crc32c
+func (_m *WriterWrapper) SetCRC32C(crc32c uint32) {
+	_m.Called(crc32c)
+}
+
+// SetChunkSize provides a mock function with given fields: chunkSize
+func (_m *WriterWrapper) SetChunkSize(chunkSize int) {
+	_m.Called(chunkSize)
+}
+
 // Write provides a mock function with given fields: p
 func (_m *WriterWrapper) Write(p []byte) (int, error) {
 	ret := _m.Called(p)
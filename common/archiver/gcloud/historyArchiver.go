@@ -53,15 +53,16 @@ const (
 	// URIScheme is the scheme for the gcloud storage implementation
 	URIScheme = "gs"
 
-	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
-	errEncodeHistory      = "failed to encode history batches"
-	errBucketHistory      = "failed to get google storage bucket handle"
-	errWriteFile          = "failed to write history to google storage"
+	defaultTargetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+	errEncodeHistory             = "failed to encode history batches"
+	errBucketHistory             = "failed to get google storage bucket handle"
+	errWriteFile                 = "failed to write history to google storage"
 )
 
 type historyArchiver struct {
-	container     *archiver.HistoryBootstrapContainer
-	gcloudStorage connector.Client
+	container             *archiver.HistoryBootstrapContainer
+	gcloudStorage         connector.Client
+	targetHistoryBlobSize int
 
 	// only set in test code
 	historyIterator archiver.HistoryIterator
@@ -86,16 +87,21 @@ func NewHistoryArchiver(
 ) (archiver.HistoryArchiver, error) {
 	storage, err := connector.NewClient(context.Background(), config)
 	if err == nil {
-		return newHistoryArchiver(container, nil, storage), nil
+		historyArchiver := newHistoryArchiver(container, nil, storage)
+		if config.TargetHistoryBlobSize > 0 {
+			historyArchiver.targetHistoryBlobSize = config.TargetHistoryBlobSize
+		}
+		return historyArchiver, nil
 	}
 	return nil, err
 }
 
-func newHistoryArchiver(container *archiver.HistoryBootstrapContainer, historyIterator archiver.HistoryIterator, storage connector.Client) archiver.HistoryArchiver {
+func newHistoryArchiver(container *archiver.HistoryBootstrapContainer, historyIterator archiver.HistoryIterator, storage connector.Client) *historyArchiver {
 	return &historyArchiver{
-		container:       container,
-		gcloudStorage:   storage,
-		historyIterator: historyIterator,
+		container:             container,
+		gcloudStorage:         storage,
+		targetHistoryBlobSize: defaultTargetHistoryBlobSize,
+		historyIterator:       historyIterator,
 	}
 }
 
@@ -143,7 +149,7 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 	historyIterator := h.historyIterator
 	var progress progress
 	if historyIterator == nil { // will only be set by testing code
-		historyIterator, _ = loadHistoryIterator(ctx, request, h.container.HistoryV2Manager, featureCatalog, &progress)
+		historyIterator, _ = loadHistoryIterator(ctx, request, h.container.HistoryV2Manager, h.targetHistoryBlobSize, featureCatalog, &progress)
 	}
 
 	encoder := codec.NewJSONPBEncoder()
@@ -294,6 +300,14 @@ func (h *historyArchiver) ValidateURI(URI archiver.URI) (err error) {
 	return
 }
 
+// ProbeURI writes, reads back and deletes a probe object in the bucket of URI
+func (h *historyArchiver) ProbeURI(ctx context.Context, URI archiver.URI) error {
+	if err := h.validateURI(URI); err != nil {
+		return err
+	}
+	return probe(ctx, h.gcloudStorage, URI)
+}
+
 func (h *historyArchiver) validateURI(URI archiver.URI) (err error) {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -303,6 +317,10 @@ func (h *historyArchiver) validateURI(URI archiver.URI) (err error) {
 		return archiver.ErrInvalidURI
 	}
 
+	if archiver.GetCredentialsRef(URI) != "" {
+		return archiver.ErrCredentialsRefNotSupported
+	}
+
 	return
 }
 
@@ -378,7 +396,7 @@ func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.UR
 	return highestVersion, highestVersionPart, lowestVersionPart, nil
 }
 
-func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, historyManager persistence.HistoryManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *progress) (historyIterator archiver.HistoryIterator, err error) {
+func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, historyManager persistence.HistoryManager, targetHistoryBlobSize int, featureCatalog *archiver.ArchiveFeatureCatalog, progress *progress) (historyIterator archiver.HistoryIterator, err error) {
 
 	defer func() {
 		if err != nil || historyIterator == nil {
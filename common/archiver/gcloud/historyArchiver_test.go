@@ -119,6 +119,10 @@ func (h *historyArchiverSuite) TestValidateURI() {
 			URI:         "gs:/my-bucket-cad/temporal_archival/development",
 			expectedErr: archiver.ErrInvalidURI,
 		},
+		{
+			URI:         "gs://my-bucket-cad/temporal_archival/development?credentials=tenant",
+			expectedErr: archiver.ErrCredentialsRefNotSupported,
+		},
 		{
 			URI:         "gs://my-bucket-cad/temporal_archival/development",
 			expectedErr: nil,
@@ -44,6 +44,14 @@ import (
 	"go.temporal.io/server/common/codec"
 )
 
+func probe(ctx context.Context, storage connector.Client, URI archiver.URI) error {
+	return archiver.Probe(
+		func(name string, data []byte) error { return storage.Upload(ctx, URI, name, data) },
+		func(name string) ([]byte, error) { return storage.Get(ctx, URI, name) },
+		func(name string) error { return storage.Delete(ctx, URI, name) },
+	)
+}
+
 func encode(message proto.Message) ([]byte, error) {
 	encoder := codec.NewJSONPBEncoder()
 	return encoder.Encode(message)
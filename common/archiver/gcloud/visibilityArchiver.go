@@ -257,6 +257,14 @@ func (v *visibilityArchiver) ValidateURI(URI archiver.URI) (err error) {
 	return
 }
 
+// ProbeURI writes, reads back and deletes a probe object in the bucket of URI
+func (v *visibilityArchiver) ProbeURI(ctx context.Context, URI archiver.URI) error {
+	if err := v.validateURI(URI); err != nil {
+		return err
+	}
+	return probe(ctx, v.gcloudStorage, URI)
+}
+
 func (v *visibilityArchiver) validateURI(URI archiver.URI) (err error) {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -266,5 +274,9 @@ func (v *visibilityArchiver) validateURI(URI archiver.URI) (err error) {
 		return archiver.ErrInvalidURI
 	}
 
+	if archiver.GetCredentialsRef(URI) != "" {
+		return archiver.ErrCredentialsRefNotSupported
+	}
+
 	return
 }
@@ -109,6 +109,10 @@ func (s *visibilityArchiverSuite) TestValidateVisibilityURI() {
 			URI:         "gs:/my-bucket-cad/temporal_archival/visibility",
 			expectedErr: archiver.ErrInvalidURI,
 		},
+		{
+			URI:         "gs://my-bucket-cad/temporal_archival/visibility?credentials=tenant",
+			expectedErr: archiver.ErrCredentialsRefNotSupported,
+		},
 		{
 			URI:         "gs://my-bucket-cad/temporal_archival/visibility",
 			expectedErr: nil,
@@ -136,4 +136,11 @@ type (
 		// ValidateURI is used to define what a valid URI for an implementation is.
 		ValidateURI(URI) error
 	}
+
+	// URIProber is implemented by archivers which are able to check end-to-end access to a URI
+	URIProber interface {
+		// ProbeURI writes, reads back and deletes a probe object under the URI, so that broken credentials
+		// or missing permissions are reported when archival is configured rather than on the first archival.
+		ProbeURI(context.Context, URI) error
+	}
 )
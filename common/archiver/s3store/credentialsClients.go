@@ -0,0 +1,78 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+type (
+	// credentialsClients creates and caches s3 clients for credentials references of namespace archival URIs.
+	// A credentials reference is the name of a profile in the AWS shared config and credentials files.
+	credentialsClients struct {
+		config *aws.Config
+
+		sync.Mutex
+		clients map[string]s3iface.S3API
+	}
+)
+
+func newCredentialsClients(
+	config *aws.Config,
+) *credentialsClients {
+	return &credentialsClients{
+		config:  config,
+		clients: make(map[string]s3iface.S3API),
+	}
+}
+
+// get returns s3 client using the credentials of the given profile
+func (c *credentialsClients) get(
+	credentialsRef string,
+) (s3iface.S3API, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if client, ok := c.clients[credentialsRef]; ok {
+		return client, nil
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *c.config,
+		Profile:           credentialsRef,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, err
+	}
+	client := s3.New(sess)
+	c.clients[credentialsRef] = client
+	return client, nil
+}
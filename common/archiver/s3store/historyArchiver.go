@@ -72,6 +72,8 @@ type (
 	historyArchiver struct {
 		container *archiver.HistoryBootstrapContainer
 		s3cli     s3iface.S3API
+		// s3 clients for URIs with credentials reference
+		credentialsClients *credentialsClients
 		// only set in test code
 		historyIterator archiver.HistoryIterator
 		config          *config.S3Archiver
@@ -117,9 +119,10 @@ func newHistoryArchiver(
 	}
 
 	return &historyArchiver{
-		container:       container,
-		s3cli:           s3.New(sess),
-		historyIterator: historyIterator,
+		container:          container,
+		s3cli:              s3.New(sess),
+		credentialsClients: newCredentialsClients(s3Config),
+		historyIterator:    historyIterator,
 	}, nil
 }
 func (h *historyArchiver) Archive(
@@ -157,6 +160,12 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
+	s3cli, err := h.getS3Client(URI)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
 	var progress uploadProgress
 	historyIterator := h.historyIterator
 	if historyIterator == nil { // will only be set by testing code
@@ -187,7 +196,7 @@ func (h *historyArchiver) Archive(
 		}
 		key := constructHistoryKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, progress.BatchIdx)
 
-		exists, err := keyExists(ctx, h.s3cli, URI, key)
+		exists, err := keyExists(ctx, s3cli, URI, key)
 		if err != nil {
 			logger := logger.WithTags(tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
 			if isRetryableError(err) {
@@ -201,7 +210,7 @@ func (h *historyArchiver) Archive(
 		if exists {
 			scope.IncCounter(metrics.HistoryArchiverBlobExistsCount)
 		} else {
-			if err := upload(ctx, h.s3cli, URI, key, encodedHistoryBlob); err != nil {
+			if err := upload(ctx, s3cli, URI, key, encodedHistoryBlob); err != nil {
 				logger := logger.WithTags(tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
 				if isRetryableError(err) {
 					logger.Error(archiver.ArchiveTransientErrorMsg)
@@ -272,7 +281,11 @@ func (h *historyArchiver) Get(
 		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidGetHistoryRequest.Error())
 	}
 
-	var err error
+	s3cli, err := h.getS3Client(URI)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
 	var token *getHistoryToken
 	if request.NextPageToken != nil {
 		token, err = deserializeGetHistoryToken(request.NextPageToken)
@@ -284,7 +297,7 @@ func (h *historyArchiver) Get(
 			CloseFailoverVersion: *request.CloseFailoverVersion,
 		}
 	} else {
-		highestVersion, err := h.getHighestVersion(ctx, URI, request)
+		highestVersion, err := h.getHighestVersion(ctx, s3cli, URI, request)
 		if err != nil {
 			return nil, serviceerror.NewInvalidArgument(err.Error())
 		}
@@ -303,7 +316,7 @@ func (h *historyArchiver) Get(
 		}
 		key := constructHistoryKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion, token.BatchIdx)
 
-		encodedRecord, err := download(ctx, h.s3cli, URI, key)
+		encodedRecord, err := download(ctx, s3cli, URI, key)
 		if err != nil {
 			if isRetryableError(err) {
 				return nil, &serviceerror.Internal{Message: err.Error()}
@@ -349,7 +362,32 @@ func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if err != nil {
 		return err
 	}
-	return bucketExists(context.TODO(), h.s3cli, URI)
+	s3cli, err := h.getS3Client(URI)
+	if err != nil {
+		return err
+	}
+	return bucketExists(context.TODO(), s3cli, URI)
+}
+
+// ProbeURI writes, reads back and deletes a probe object in the bucket of URI
+func (h *historyArchiver) ProbeURI(ctx context.Context, URI archiver.URI) error {
+	if err := softValidateURI(URI); err != nil {
+		return err
+	}
+	s3cli, err := h.getS3Client(URI)
+	if err != nil {
+		return err
+	}
+	return probeBucket(ctx, s3cli, URI)
+}
+
+// getS3Client returns s3 client for the credentials reference of URI, the default client is used if URI has none
+func (h *historyArchiver) getS3Client(URI archiver.URI) (s3iface.S3API, error) {
+	credentialsRef := archiver.GetCredentialsRef(URI)
+	if len(credentialsRef) == 0 {
+		return h.s3cli, nil
+	}
+	return h.credentialsClients.get(credentialsRef)
 }
 
 func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIterator) (*archiverspb.HistoryBlob, error) {
@@ -370,11 +408,11 @@ func getNextHistoryBlob(ctx context.Context, historyIterator archiver.HistoryIte
 	return historyBlob, nil
 }
 
-func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.URI, request *archiver.GetHistoryRequest) (*int64, error) {
+func (h *historyArchiver) getHighestVersion(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, request *archiver.GetHistoryRequest) (*int64, error) {
 	ctx, cancel := ensureContextTimeout(ctx)
 	defer cancel()
 	var prefix = constructHistoryKeyPrefix(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID) + "/"
-	results, err := h.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+	results, err := s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
 		Bucket:    aws.String(URI.Hostname()),
 		Prefix:    aws.String(prefix),
 		Delimiter: aws.String("/"),
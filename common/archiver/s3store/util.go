@@ -129,6 +129,23 @@ func keyExists(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key s
 	return true, nil
 }
 
+func probeBucket(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI) error {
+	key := func(name string) string { return strings.TrimLeft(URI.Path()+"/"+name, "/") }
+	return archiver.Probe(
+		func(name string, data []byte) error { return upload(ctx, s3cli, URI, key(name), data) },
+		func(name string) ([]byte, error) { return download(ctx, s3cli, URI, key(name)) },
+		func(name string) error {
+			ctx, cancel := ensureContextTimeout(ctx)
+			defer cancel()
+			_, err := s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+				Bucket: aws.String(URI.Hostname()),
+				Key:    aws.String(key(name)),
+			})
+			return err
+		},
+	)
+}
+
 func isNotFoundError(err error) bool {
 	aerr, ok := err.(awserr.Error)
 	return ok && (aerr.Code() == "NotFound")
@@ -47,6 +47,8 @@ type (
 		container   *archiver.VisibilityBootstrapContainer
 		s3cli       s3iface.S3API
 		queryParser QueryParser
+		// s3 clients for URIs with credentials reference
+		credentialsClients *credentialsClients
 	}
 
 	queryVisibilityRequest struct {
@@ -93,9 +95,10 @@ func newVisibilityArchiver(
 		return nil, err
 	}
 	return &visibilityArchiver{
-		container:   container,
-		s3cli:       s3.New(sess),
-		queryParser: NewQueryParser(),
+		container:          container,
+		s3cli:              s3.New(sess),
+		queryParser:        NewQueryParser(),
+		credentialsClients: newCredentialsClients(s3Config),
 	}, nil
 }
 
@@ -136,6 +139,12 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 
+	s3cli, err := v.getS3Client(URI)
+	if err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidURI
+		return err
+	}
+
 	encodedVisibilityRecord, err := encode(request)
 	if err != nil {
 		archiveFailReason = errEncodeVisibilityRecord
@@ -145,7 +154,7 @@ func (v *visibilityArchiver) Archive(
 	// Upload archive to all indexes
 	for _, element := range indexes {
 		key := constructTimestampIndex(URI.Path(), request.GetNamespaceId(), element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.GetRunId())
-		if err := upload(ctx, v.s3cli, URI, key, encodedVisibilityRecord); err != nil {
+		if err := upload(ctx, s3cli, URI, key, encodedVisibilityRecord); err != nil {
 			archiveFailReason = errWriteKey
 			return err
 		}
@@ -195,6 +204,10 @@ func (v *visibilityArchiver) query(
 ) (*archiver.QueryVisibilityResponse, error) {
 	ctx, cancel := ensureContextTimeout(ctx)
 	defer cancel()
+	s3cli, err := v.getS3Client(URI)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
 	var token *string
 	if request.nextPageToken != nil {
 		token = deserializeQueryVisibilityToken(request.nextPageToken)
@@ -213,7 +226,7 @@ func (v *visibilityArchiver) query(
 		prefix = constructTimeBasedSearchKey(URI.Path(), request.namespaceID, primaryIndex, *primaryIndexValue, secondaryIndexKeyStartTimeout, *request.parsedQuery.startTime, *request.parsedQuery.searchPrecision)
 	}
 
-	results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+	results, err := s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
 		Bucket:            aws.String(URI.Hostname()),
 		Prefix:            aws.String(prefix),
 		MaxKeys:           aws.Int64(int64(request.pageSize)),
@@ -234,7 +247,7 @@ func (v *visibilityArchiver) query(
 		response.NextPageToken = serializeQueryVisibilityToken(*results.NextContinuationToken)
 	}
 	for _, item := range results.Contents {
-		encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
+		encodedRecord, err := download(ctx, s3cli, URI, *item.Key)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
@@ -253,5 +266,30 @@ func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	if err != nil {
 		return err
 	}
-	return bucketExists(context.TODO(), v.s3cli, URI)
+	s3cli, err := v.getS3Client(URI)
+	if err != nil {
+		return err
+	}
+	return bucketExists(context.TODO(), s3cli, URI)
+}
+
+// ProbeURI writes, reads back and deletes a probe object in the bucket of URI
+func (v *visibilityArchiver) ProbeURI(ctx context.Context, URI archiver.URI) error {
+	if err := softValidateURI(URI); err != nil {
+		return err
+	}
+	s3cli, err := v.getS3Client(URI)
+	if err != nil {
+		return err
+	}
+	return probeBucket(ctx, s3cli, URI)
+}
+
+// getS3Client returns s3 client for the credentials reference of URI, the default client is used if URI has none
+func (v *visibilityArchiver) getS3Client(URI archiver.URI) (s3iface.S3API, error) {
+	credentialsRef := archiver.GetCredentialsRef(URI)
+	if len(credentialsRef) == 0 {
+		return v.s3cli, nil
+	}
+	return v.credentialsClients.get(credentialsRef)
 }
@@ -25,8 +25,11 @@
 package archiver
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 
+	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
@@ -45,8 +48,33 @@ var (
 	errEmptyStartTime        = errors.New("StartTime is empty")
 	errEmptyCloseTime        = errors.New("CloseTime is empty")
 	errEmptyQuery            = errors.New("Query string is empty")
+
+	probeObjectData = []byte("temporal archival probe")
 )
 
+// Probe writes, reads back and deletes a probe object using the given storage operations. Every probe
+// uses an object of its own name, so concurrent probes of the same URI don't interfere.
+// The probe object is deleted even if reading it back fails, the first failed step is returned.
+func Probe(write func(name string, data []byte) error, read func(name string) ([]byte, error), remove func(name string) error) error {
+	name := ProbeObjectNamePrefix + uuid.New()
+	if err := write(name, probeObjectData); err != nil {
+		return fmt.Errorf("failed to write probe object: %v", err)
+	}
+
+	data, readErr := read(name)
+	removeErr := remove(name)
+	if readErr != nil {
+		return fmt.Errorf("failed to read probe object: %v", readErr)
+	}
+	if !bytes.Equal(data, probeObjectData) {
+		return ErrProbeObjectMismatch
+	}
+	if removeErr != nil {
+		return fmt.Errorf("failed to delete probe object: %v", removeErr)
+	}
+	return nil
+}
+
 // TagLoggerWithArchiveHistoryRequestAndURI tags logger with fields in the archive history request and the URI
 func TagLoggerWithArchiveHistoryRequestAndURI(logger log.Logger, request *ArchiveHistoryRequest, URI string) log.Logger {
 	return logger.WithTags(
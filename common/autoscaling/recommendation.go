@@ -0,0 +1,61 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package autoscaling
+
+import (
+	"math"
+
+	"go.temporal.io/server/common"
+)
+
+// epsilon absorbs float rounding so that utilization of exactly 1 does not recommend an extra worker
+const epsilon = 1e-9
+
+// RecommendWorkers returns utilization of current workers and number of workers needed to keep up with
+// demand, which is the rate of tasks workers need to handle, given the rate they currently handle.
+// Utilization above 1 means task queue is under provisioned.
+func RecommendWorkers(
+	demandRate float64,
+	dispatchRate float64,
+	workers int,
+) (float64, int) {
+
+	switch {
+	case demandRate == 0:
+		// nothing to do, keep current workers and let autoscaler apply its own scale down policy
+		return 0, workers
+	case dispatchRate == 0:
+		// tasks are piling up but nothing is dispatched, capacity of workers is unknown so ask for one more
+		recommendedWorkers := workers + 1
+		return float64(recommendedWorkers) / float64(common.MaxInt(workers, 1)), recommendedWorkers
+	default:
+		utilization := demandRate / dispatchRate
+		recommendedWorkers := common.MaxInt(
+			int(math.Ceil(float64(common.MaxInt(workers, 1))*utilization-epsilon)),
+			1,
+		)
+		return utilization, recommendedWorkers
+	}
+}
@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clock
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+)
+
+type (
+	// TimeSkipper is implemented by time sources which skip time on their own. Components report
+	// the work in progress, which must not observe time jumps, and the deadlines they wait for.
+	TimeSkipper interface {
+		// LockSkipping prevents skipping time until UnlockSkipping is called with the same key,
+		// or until lease has passed in real time, e.g. because the worker holding the task is gone
+		LockSkipping(key string, lease time.Duration)
+		// UnlockSkipping releases the lock acquired with key, unknown key is ignored
+		UnlockSkipping(key string)
+		// SetDeadline registers the next point in time owner waits for, zero time removes the deadline
+		SetDeadline(owner interface{}, deadline time.Time)
+	}
+
+	// AutoSkippingTimeSource is a SkewedTimeSource which advances itself to the earliest registered
+	// deadline once nothing has locked skipping and nothing has changed for idle period of real time
+	AutoSkippingTimeSource struct {
+		*SkewedTimeSource
+
+		idlePeriod time.Duration
+		status     int32
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+
+		lock       sync.Mutex
+		locks      map[string]time.Time
+		deadlines  map[interface{}]time.Time
+		lastChange time.Time
+	}
+)
+
+var _ TimeSkipper = (*AutoSkippingTimeSource)(nil)
+
+// NewAutoSkippingTimeSource returns a time source that serves real wall clock time and,
+// once started, skips time when idle. Idle period has to cover the real time it takes
+// to hand a task to a polling worker, otherwise time may be skipped in between.
+func NewAutoSkippingTimeSource(idlePeriod time.Duration) *AutoSkippingTimeSource {
+	return &AutoSkippingTimeSource{
+		SkewedTimeSource: NewSkewedTimeSource(),
+		idlePeriod:       idlePeriod,
+		status:           common.DaemonStatusInitialized,
+		shutdownCh:       make(chan struct{}),
+		locks:            make(map[string]time.Time),
+		deadlines:        make(map[interface{}]time.Time),
+		lastChange:       time.Now(),
+	}
+}
+
+// Start starts skipping time when idle
+func (ts *AutoSkippingTimeSource) Start() {
+	if !atomic.CompareAndSwapInt32(&ts.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	ts.shutdownWG.Add(1)
+	go ts.skipLoop()
+}
+
+// Stop stops skipping time, time source keeps serving time with the offset skipped so far
+func (ts *AutoSkippingTimeSource) Stop() {
+	if !atomic.CompareAndSwapInt32(&ts.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(ts.shutdownCh)
+	ts.shutdownWG.Wait()
+}
+
+// LockSkipping prevents skipping time until unlocked or until lease has passed in real time
+func (ts *AutoSkippingTimeSource) LockSkipping(key string, lease time.Duration) {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	now := time.Now()
+	ts.locks[key] = now.Add(lease)
+	ts.lastChange = now
+}
+
+// UnlockSkipping releases the lock acquired with key
+func (ts *AutoSkippingTimeSource) UnlockSkipping(key string) {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	if _, ok := ts.locks[key]; !ok {
+		return
+	}
+	delete(ts.locks, key)
+	ts.lastChange = time.Now()
+}
+
+// SetDeadline registers the next point in time owner waits for, zero time removes the deadline
+func (ts *AutoSkippingTimeSource) SetDeadline(owner interface{}, deadline time.Time) {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	if deadline.IsZero() {
+		delete(ts.deadlines, owner)
+	} else {
+		ts.deadlines[owner] = deadline
+	}
+	ts.lastChange = time.Now()
+}
+
+func (ts *AutoSkippingTimeSource) skipLoop() {
+	defer ts.shutdownWG.Done()
+
+	ticker := time.NewTicker(ts.idlePeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ts.shutdownCh:
+			return
+		case <-ticker.C:
+			ts.skipIfIdle()
+		}
+	}
+}
+
+// skipIfIdle advances time to the earliest deadline which is still ahead, if idle
+func (ts *AutoSkippingTimeSource) skipIfIdle() {
+	now := ts.Now()
+	next, ok := ts.nextDeadlineIfIdle(now, time.Now())
+	if !ok {
+		return
+	}
+	ts.Advance(next.Sub(now))
+}
+
+func (ts *AutoSkippingTimeSource) nextDeadlineIfIdle(now time.Time, realNow time.Time) (time.Time, bool) {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	for key, expiry := range ts.locks {
+		if !expiry.After(realNow) {
+			delete(ts.locks, key)
+		}
+	}
+	if len(ts.locks) > 0 || realNow.Sub(ts.lastChange) < ts.idlePeriod {
+		return time.Time{}, false
+	}
+
+	var next time.Time
+	for _, deadline := range ts.deadlines {
+		if deadline.After(now) && (next.IsZero() || deadline.Before(next)) {
+			next = deadline
+		}
+	}
+	if next.IsZero() {
+		return time.Time{}, false
+	}
+
+	// components need idle period again to react to the skip, e.g. to start tasks of fired timers
+	ts.lastChange = realNow
+	return next, true
+}
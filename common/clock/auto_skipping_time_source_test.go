@@ -0,0 +1,62 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoSkippingTimeSource_NextDeadlineIfIdle(t *testing.T) {
+	ts := NewAutoSkippingTimeSource(time.Second)
+	now := ts.Now()
+	realNow := time.Now()
+
+	ts.SetDeadline("past", now.Add(-time.Minute))
+	ts.SetDeadline("later", now.Add(time.Hour))
+	ts.SetDeadline("sooner", now.Add(time.Minute))
+
+	_, ok := ts.nextDeadlineIfIdle(now, realNow)
+	require.False(t, ok, "deadlines just changed")
+
+	next, ok := ts.nextDeadlineIfIdle(now, realNow.Add(2*time.Second))
+	require.True(t, ok)
+	require.Equal(t, now.Add(time.Minute), next)
+
+	ts.SetDeadline("sooner", time.Time{})
+	ts.LockSkipping("task", time.Minute)
+	_, ok = ts.nextDeadlineIfIdle(now, realNow.Add(10*time.Second))
+	require.False(t, ok, "skipping is locked")
+
+	next, ok = ts.nextDeadlineIfIdle(now, realNow.Add(2*time.Minute))
+	require.True(t, ok, "lock lease has expired")
+	require.Equal(t, now.Add(time.Hour), next)
+
+	ts.UnlockSkipping("unknown")
+	_, ok = ts.nextDeadlineIfIdle(now, realNow.Add(2*time.Minute))
+	require.False(t, ok, "skip needs idle period again")
+}
@@ -0,0 +1,96 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// ChangeNotifier is implemented by time sources whose notion of current time can jump,
+	// so that components waiting for a point in time can re-evaluate their deadlines
+	ChangeNotifier interface {
+		// Changed returns channel which is closed on the next change of current time
+		Changed() <-chan struct{}
+	}
+
+	// SkewedTimeSource serves real wall-clock time shifted by an offset, which can be advanced
+	// by tests to skip time, e.g. to fire long running timers in seconds
+	SkewedTimeSource struct {
+		sync.RWMutex
+		offset    time.Duration
+		changedCh chan struct{}
+	}
+)
+
+var _ TimeSource = (*SkewedTimeSource)(nil)
+var _ ChangeNotifier = (*SkewedTimeSource)(nil)
+
+// NewSkewedTimeSource returns a time source that serves
+// real wall clock time until it is advanced
+func NewSkewedTimeSource() *SkewedTimeSource {
+	return &SkewedTimeSource{
+		changedCh: make(chan struct{}),
+	}
+}
+
+// Now return the real current time shifted by offset
+func (ts *SkewedTimeSource) Now() time.Time {
+	ts.RLock()
+	defer ts.RUnlock()
+
+	return time.Now().UTC().Add(ts.offset)
+}
+
+// Offset returns how far the time source is ahead of real wall clock time
+func (ts *SkewedTimeSource) Offset() time.Duration {
+	ts.RLock()
+	defer ts.RUnlock()
+
+	return ts.offset
+}
+
+// Advance moves the current time forward by duration and notifies listeners, negative duration is ignored
+func (ts *SkewedTimeSource) Advance(duration time.Duration) {
+	if duration <= 0 {
+		return
+	}
+
+	ts.Lock()
+	defer ts.Unlock()
+
+	ts.offset += duration
+	close(ts.changedCh)
+	ts.changedCh = make(chan struct{})
+}
+
+// Changed returns channel which is closed on the next advance of current time
+func (ts *SkewedTimeSource) Changed() <-chan struct{} {
+	ts.RLock()
+	defer ts.RUnlock()
+
+	return ts.changedCh
+}
@@ -51,6 +51,18 @@ const (
 	EndMessageID int64 = 1<<63 - 1
 )
 
+const (
+	// WorkflowReportMarkerName is the marker name with which workflows publish named report values on workflow task
+	// completion. Each entry of the marker details is a report with a single payload. History keeps the latest value
+	// of each report in mutable state, so that it is served by DescribeWorkflowExecution without querying a worker.
+	WorkflowReportMarkerName = "__temporal_report"
+	// ReplicationOversizedMemoKey is the memo key with which history flags executions of global namespaces that
+	// appended an event batch too large to be replicated to remote clusters. Its value is the encoded size of the
+	// first such batch and the limit it exceeded. The flag is kept outside of the memo of the execution and only
+	// added to visibility records and DescribeWorkflowExecution responses.
+	ReplicationOversizedMemoKey = "__temporal_replication_oversized"
+)
+
 const (
 	// FrontendServiceName is the name of the frontend service
 	FrontendServiceName = "frontend"
@@ -46,17 +46,33 @@ const (
 
 	VisibilityTaskKey = "VisibilityTaskKey"
 
-	CustomStringField     = "CustomStringField"
-	CustomKeywordField    = "CustomKeywordField"
-	CustomIntField        = "CustomIntField"
-	CustomDoubleField     = "CustomDoubleField"
-	CustomBoolField       = "CustomBoolField"
-	CustomDatetimeField   = "CustomDatetimeField"
-	TemporalChangeVersion = "TemporalChangeVersion"
-	CustomNamespace       = "CustomNamespace"
-	Operator              = "Operator"
+	CustomStringField              = "CustomStringField"
+	CustomKeywordField             = "CustomKeywordField"
+	CustomIntField                 = "CustomIntField"
+	CustomDoubleField              = "CustomDoubleField"
+	CustomBoolField                = "CustomBoolField"
+	CustomDatetimeField            = "CustomDatetimeField"
+	TemporalChangeVersion          = "TemporalChangeVersion"
+	TemporalStuckReason            = "TemporalStuckReason"
+	TemporalOperatorAnnotations    = "TemporalOperatorAnnotations"
+	TemporalOperatorAnnotationTags = "TemporalOperatorAnnotationTags"
+	CustomNamespace                = "CustomNamespace"
+	Operator                       = "Operator"
 )
 
+// valid values of TemporalStuckReason, set by history when stuck execution detection is enabled
+const (
+	// StuckReasonActivityNotStarted is set when an activity was scheduled but no worker picked it up
+	StuckReasonActivityNotStarted = "ActivityNotStarted"
+	// StuckReasonWorkflowTaskNotStarted is set when a workflow task was scheduled but no worker picked it up
+	StuckReasonWorkflowTaskNotStarted = "WorkflowTaskNotStarted"
+	// StuckReasonWorkflowTaskRetrying is set when the workflow task keeps failing or timing out
+	StuckReasonWorkflowTaskRetrying = "WorkflowTaskRetrying"
+)
+
+// StuckReasons is the list of all valid values of TemporalStuckReason
+var StuckReasons = []string{StuckReasonActivityNotStarted, StuckReasonWorkflowTaskNotStarted, StuckReasonWorkflowTaskRetrying}
+
 // valid non-indexed fields on ES
 const (
 	Memo = "Memo"
@@ -70,16 +86,19 @@ var defaultIndexedKeys = createDefaultIndexedKeys()
 
 func createDefaultIndexedKeys() map[string]interface{} {
 	defaultIndexedKeys := map[string]interface{}{
-		CustomStringField:     enumspb.INDEXED_VALUE_TYPE_STRING,
-		CustomKeywordField:    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		CustomIntField:        enumspb.INDEXED_VALUE_TYPE_INT,
-		CustomDoubleField:     enumspb.INDEXED_VALUE_TYPE_DOUBLE,
-		CustomBoolField:       enumspb.INDEXED_VALUE_TYPE_BOOL,
-		CustomDatetimeField:   enumspb.INDEXED_VALUE_TYPE_DATETIME,
-		TemporalChangeVersion: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		BinaryChecksums:       enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		CustomNamespace:       enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		Operator:              enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		CustomStringField:              enumspb.INDEXED_VALUE_TYPE_STRING,
+		CustomKeywordField:             enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		CustomIntField:                 enumspb.INDEXED_VALUE_TYPE_INT,
+		CustomDoubleField:              enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		CustomBoolField:                enumspb.INDEXED_VALUE_TYPE_BOOL,
+		CustomDatetimeField:            enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		TemporalChangeVersion:          enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalStuckReason:            enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalOperatorAnnotations:    enumspb.INDEXED_VALUE_TYPE_STRING,
+		TemporalOperatorAnnotationTags: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		BinaryChecksums:                enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		CustomNamespace:                enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		Operator:                       enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 	}
 	for k, v := range systemIndexedKeys {
 		defaultIndexedKeys[k] = v
@@ -113,3 +132,20 @@ func IsSystemIndexedKey(key string) bool {
 	_, ok := systemIndexedKeys[key]
 	return ok
 }
+
+// serverManagedIndexedKeys are search attributes kept in Attr like custom ones but written only by history,
+// e.g. from operator annotations stored outside of history events
+var serverManagedIndexedKeys = map[string]struct{}{
+	TemporalStuckReason:            {},
+	TemporalOperatorAnnotations:    {},
+	TemporalOperatorAnnotationTags: {},
+}
+
+// IsReadOnlyIndexedKey return true if key can not be written by workflows and clients
+func IsReadOnlyIndexedKey(key string) bool {
+	if IsSystemIndexedKey(key) {
+		return true
+	}
+	_, ok := serverManagedIndexedKeys[key]
+	return ok
+}
@@ -49,6 +49,15 @@ type (
 		Count(ctx context.Context, index, query string) (int64, error)
 		RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error)
 		PutMapping(ctx context.Context, index, root, key, valueType string) error
+		GetAliasIndices(ctx context.Context, alias string) ([]string, error)
+		CreateIndexWithAlias(ctx context.Context, index, alias string, rolloverConfig *RolloverConfig) (bool, error)
+		RolloverIndex(ctx context.Context, alias string, conditions map[string]interface{}) (*RolloverResult, error)
+		PutLifecyclePolicy(ctx context.Context, policyName string, rolloverConfig *RolloverConfig) (bool, error)
+		CreateRolloverIndex(ctx context.Context, index, alias string, rolloverConfig *RolloverConfig) (bool, error)
+		Reindex(ctx context.Context, sourceIndex, destIndex string) (int64, error)
+		ReplaceIndexWithAlias(ctx context.Context, alias, index string) (bool, error)
+		IndexExists(ctx context.Context, indexName string) (bool, error)
+		IndexPutSettings(ctx context.Context, indexName string, bodyString string) (bool, error)
 	}
 
 	CLIClient interface {
@@ -74,6 +74,96 @@ func (mr *MockClientMockRecorder) Count(ctx, index, query interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockClient)(nil).Count), ctx, index, query)
 }
 
+// CreateIndexWithAlias mocks base method.
+func (m *MockClient) CreateIndexWithAlias(ctx context.Context, index, alias string, rolloverConfig *RolloverConfig) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIndexWithAlias", ctx, index, alias, rolloverConfig)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIndexWithAlias indicates an expected call of CreateIndexWithAlias.
+func (mr *MockClientMockRecorder) CreateIndexWithAlias(ctx, index, alias, rolloverConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIndexWithAlias", reflect.TypeOf((*MockClient)(nil).CreateIndexWithAlias), ctx, index, alias, rolloverConfig)
+}
+
+// CreateRolloverIndex mocks base method.
+func (m *MockClient) CreateRolloverIndex(ctx context.Context, index, alias string, rolloverConfig *RolloverConfig) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRolloverIndex", ctx, index, alias, rolloverConfig)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRolloverIndex indicates an expected call of CreateRolloverIndex.
+func (mr *MockClientMockRecorder) CreateRolloverIndex(ctx, index, alias, rolloverConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRolloverIndex", reflect.TypeOf((*MockClient)(nil).CreateRolloverIndex), ctx, index, alias, rolloverConfig)
+}
+
+// GetAliasIndices mocks base method.
+func (m *MockClient) GetAliasIndices(ctx context.Context, alias string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAliasIndices", ctx, alias)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAliasIndices indicates an expected call of GetAliasIndices.
+func (mr *MockClientMockRecorder) GetAliasIndices(ctx, alias interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAliasIndices", reflect.TypeOf((*MockClient)(nil).GetAliasIndices), ctx, alias)
+}
+
+// IndexExists mocks base method.
+func (m *MockClient) IndexExists(ctx context.Context, indexName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexExists", ctx, indexName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexExists indicates an expected call of IndexExists.
+func (mr *MockClientMockRecorder) IndexExists(ctx, indexName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexExists", reflect.TypeOf((*MockClient)(nil).IndexExists), ctx, indexName)
+}
+
+// IndexPutSettings mocks base method.
+func (m *MockClient) IndexPutSettings(ctx context.Context, indexName, bodyString string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexPutSettings", ctx, indexName, bodyString)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexPutSettings indicates an expected call of IndexPutSettings.
+func (mr *MockClientMockRecorder) IndexPutSettings(ctx, indexName, bodyString interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexPutSettings", reflect.TypeOf((*MockClient)(nil).IndexPutSettings), ctx, indexName, bodyString)
+}
+
+// PutLifecyclePolicy mocks base method.
+func (m *MockClient) PutLifecyclePolicy(ctx context.Context, policyName string, rolloverConfig *RolloverConfig) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutLifecyclePolicy", ctx, policyName, rolloverConfig)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutLifecyclePolicy indicates an expected call of PutLifecyclePolicy.
+func (mr *MockClientMockRecorder) PutLifecyclePolicy(ctx, policyName, rolloverConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLifecyclePolicy", reflect.TypeOf((*MockClient)(nil).PutLifecyclePolicy), ctx, policyName, rolloverConfig)
+}
+
 // PutMapping mocks base method.
 func (m *MockClient) PutMapping(ctx context.Context, index, root, key, valueType string) error {
 	m.ctrl.T.Helper()
@@ -88,6 +178,51 @@ func (mr *MockClientMockRecorder) PutMapping(ctx, index, root, key, valueType in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMapping", reflect.TypeOf((*MockClient)(nil).PutMapping), ctx, index, root, key, valueType)
 }
 
+// Reindex mocks base method.
+func (m *MockClient) Reindex(ctx context.Context, sourceIndex, destIndex string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reindex", ctx, sourceIndex, destIndex)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reindex indicates an expected call of Reindex.
+func (mr *MockClientMockRecorder) Reindex(ctx, sourceIndex, destIndex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reindex", reflect.TypeOf((*MockClient)(nil).Reindex), ctx, sourceIndex, destIndex)
+}
+
+// ReplaceIndexWithAlias mocks base method.
+func (m *MockClient) ReplaceIndexWithAlias(ctx context.Context, alias, index string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceIndexWithAlias", ctx, alias, index)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceIndexWithAlias indicates an expected call of ReplaceIndexWithAlias.
+func (mr *MockClientMockRecorder) ReplaceIndexWithAlias(ctx, alias, index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceIndexWithAlias", reflect.TypeOf((*MockClient)(nil).ReplaceIndexWithAlias), ctx, alias, index)
+}
+
+// RolloverIndex mocks base method.
+func (m *MockClient) RolloverIndex(ctx context.Context, alias string, conditions map[string]interface{}) (*RolloverResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RolloverIndex", ctx, alias, conditions)
+	ret0, _ := ret[0].(*RolloverResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RolloverIndex indicates an expected call of RolloverIndex.
+func (mr *MockClientMockRecorder) RolloverIndex(ctx, alias, conditions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RolloverIndex", reflect.TypeOf((*MockClient)(nil).RolloverIndex), ctx, alias, conditions)
+}
+
 // RunBulkProcessor mocks base method.
 func (m *MockClient) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +184,83 @@ func (c *clientV6) CreateIndex(ctx context.Context, index string) (bool, error)
 	return resp.Acknowledged, nil
 }
 
+func (c *clientV6) GetAliasIndices(ctx context.Context, alias string) ([]string, error) {
+	result, err := c.esClient.Aliases().Alias(alias).Do(ctx)
+	if err != nil {
+		if elastic6.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return result.IndicesByAlias(alias), nil
+}
+
+func (c *clientV6) CreateIndexWithAlias(ctx context.Context, index, alias string, rolloverConfig *RolloverConfig) (bool, error) {
+	resp, err := c.esClient.CreateIndex(index).BodyJson(buildCreateIndexWithAliasBody(alias, rolloverConfig)).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
+func (c *clientV6) CreateRolloverIndex(ctx context.Context, index, alias string, rolloverConfig *RolloverConfig) (bool, error) {
+	resp, err := c.esClient.CreateIndex(index).BodyJson(buildCreateRolloverIndexBody(alias, rolloverConfig)).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
+func (c *clientV6) Reindex(ctx context.Context, sourceIndex, destIndex string) (int64, error) {
+	// external versioning keeps documents which were already updated in destIndex
+	resp, err := c.esClient.Reindex().
+		Source(elastic6.NewReindexSource().Index(sourceIndex)).
+		Destination(elastic6.NewReindexDestination().Index(destIndex).VersionType(versionTypeExternal)).
+		Conflicts("proceed").
+		WaitForCompletion(true).
+		Refresh("true").
+		Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Created + resp.Updated, nil
+}
+
+func (c *clientV6) ReplaceIndexWithAlias(ctx context.Context, alias, index string) (bool, error) {
+	resp, err := c.esClient.Alias().
+		Action(elastic6.NewAliasRemoveIndexAction(alias)).
+		Action(elastic6.NewAliasAddAction(alias).Index(index).IsWriteIndex(true)).
+		Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
+func (c *clientV6) RolloverIndex(ctx context.Context, alias string, conditions map[string]interface{}) (*RolloverResult, error) {
+	resp, err := c.esClient.RolloverIndex(alias).Conditions(conditions).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &RolloverResult{
+		OldIndex:   resp.OldIndex,
+		NewIndex:   resp.NewIndex,
+		RolledOver: resp.RolledOver,
+	}, nil
+}
+
+func (c *clientV6) PutLifecyclePolicy(ctx context.Context, policyName string, rolloverConfig *RolloverConfig) (bool, error) {
+	body, err := buildILMPolicyBody(rolloverConfig)
+	if err != nil {
+		return false, err
+	}
+	resp, err := c.esClient.XPackIlmPutLifecycle().Policy(policyName).BodyString(body).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
 func (c *clientV6) buildPutMappingBody(root, key, valueType string) map[string]interface{} {
 	body := make(map[string]interface{})
 	if len(root) != 0 {
@@ -175,6 +175,83 @@ func (c *clientV7) CreateIndex(ctx context.Context, index string) (bool, error)
 	return resp.Acknowledged, nil
 }
 
+func (c *clientV7) GetAliasIndices(ctx context.Context, alias string) ([]string, error) {
+	result, err := c.esClient.Aliases().Alias(alias).Do(ctx)
+	if err != nil {
+		if elastic.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return result.IndicesByAlias(alias), nil
+}
+
+func (c *clientV7) CreateIndexWithAlias(ctx context.Context, index, alias string, rolloverConfig *RolloverConfig) (bool, error) {
+	resp, err := c.esClient.CreateIndex(index).BodyJson(buildCreateIndexWithAliasBody(alias, rolloverConfig)).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
+func (c *clientV7) CreateRolloverIndex(ctx context.Context, index, alias string, rolloverConfig *RolloverConfig) (bool, error) {
+	resp, err := c.esClient.CreateIndex(index).BodyJson(buildCreateRolloverIndexBody(alias, rolloverConfig)).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
+func (c *clientV7) Reindex(ctx context.Context, sourceIndex, destIndex string) (int64, error) {
+	// external versioning keeps documents which were already updated in destIndex
+	resp, err := c.esClient.Reindex().
+		Source(elastic.NewReindexSource().Index(sourceIndex)).
+		Destination(elastic.NewReindexDestination().Index(destIndex).VersionType(versionTypeExternal)).
+		Conflicts("proceed").
+		WaitForCompletion(true).
+		Refresh("true").
+		Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Created + resp.Updated, nil
+}
+
+func (c *clientV7) ReplaceIndexWithAlias(ctx context.Context, alias, index string) (bool, error) {
+	resp, err := c.esClient.Alias().
+		Action(elastic.NewAliasRemoveIndexAction(alias)).
+		Action(elastic.NewAliasAddAction(alias).Index(index).IsWriteIndex(true)).
+		Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
+func (c *clientV7) RolloverIndex(ctx context.Context, alias string, conditions map[string]interface{}) (*RolloverResult, error) {
+	resp, err := c.esClient.RolloverIndex(alias).Conditions(conditions).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &RolloverResult{
+		OldIndex:   resp.OldIndex,
+		NewIndex:   resp.NewIndex,
+		RolledOver: resp.RolledOver,
+	}, nil
+}
+
+func (c *clientV7) PutLifecyclePolicy(ctx context.Context, policyName string, rolloverConfig *RolloverConfig) (bool, error) {
+	body, err := buildILMPolicyBody(rolloverConfig)
+	if err != nil {
+		return false, err
+	}
+	resp, err := c.esClient.XPackIlmPutLifecycle().Policy(policyName).BodyString(body).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
+
 func (c *clientV7) buildPutMappingBody(root, key, valueType string) map[string]interface{} {
 	body := make(map[string]interface{})
 	if len(root) != 0 {
@@ -25,7 +25,9 @@
 package elasticsearch
 
 import (
+	"fmt"
 	"net/url"
+	"time"
 
 	"go.temporal.io/server/common"
 )
@@ -39,6 +41,34 @@ type (
 		Password          string                  `yaml:"password"`
 		Indices           map[string]string       `yaml:"indices"` //nolint:govet
 		AWSRequestSigning AWSRequestSigningConfig `yaml:"aws-request-signing"`
+		// EXPERIMENTAL - Rollover enables time or size based rollover of the visibility index.
+		// When enabled, the configured visibility index name is used as a write alias.
+		Rollover RolloverConfig `yaml:"rollover"`
+	}
+
+	// RolloverConfig contains the settings for rolling over an index behind a write alias.
+	// Concrete indices are named <alias>-000001, <alias>-000002, ... and pick up mappings from the index template,
+	// so the template index_patterns must match them. Reads through the alias fan out across all rolled over indices.
+	// Updates and deletes go to the index which already holds the record, so executions which are open at the time
+	// of rollover don't get a second record in the new write index. If a concrete index with the alias name exists,
+	// the indexer migrates it on start: writes to it are blocked, its documents are copied into the first rollover
+	// index and it is replaced by the alias.
+	RolloverConfig struct {
+		// Enabled turns on alias management and rollover for the visibility index
+		Enabled bool `yaml:"enabled"`
+		// MaxAge rolls over the write index when it is older than this duration
+		MaxAge time.Duration `yaml:"maxAge"`
+		// MaxDocs rolls over the write index when it contains more documents than this number
+		MaxDocs int64 `yaml:"maxDocs"`
+		// MaxSize rolls over the write index when its primary shards are larger than this size (e.g. "50gb")
+		MaxSize string `yaml:"maxSize"`
+		// CheckInterval is how often rollover conditions are evaluated. Defaults to 5 minutes.
+		// Ignored when ILMPolicy is set, because ElasticSearch evaluates the conditions itself.
+		CheckInterval time.Duration `yaml:"checkInterval"`
+		// ILMPolicy is the name of the index lifecycle management policy to attach to new indices.
+		// If set, the policy is created (or updated) with a rollover action built from the conditions above
+		// and ElasticSearch performs rollover on its own. Requires ElasticSearch with X-Pack ILM.
+		ILMPolicy string `yaml:"ilmPolicy"`
 	}
 
 	// AWSRequestSigningConfig represents configuration for signing ES requests to AWS
@@ -72,3 +102,37 @@ type (
 func (cfg *Config) GetVisibilityIndex() string {
 	return cfg.Indices[common.VisibilityAppName]
 }
+
+// Validate validates the rollover config
+func (cfg *RolloverConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.MaxAge <= 0 && cfg.MaxDocs <= 0 && cfg.MaxSize == "" {
+		return fmt.Errorf("elasticsearch rollover is enabled but none of maxAge, maxDocs or maxSize is set")
+	}
+	return nil
+}
+
+// Conditions returns rollover conditions in the format expected by the ElasticSearch rollover API
+func (cfg *RolloverConfig) Conditions() map[string]interface{} {
+	conditions := make(map[string]interface{})
+	if cfg.MaxAge > 0 {
+		conditions["max_age"] = fmt.Sprintf("%ds", int64(cfg.MaxAge/time.Second))
+	}
+	if cfg.MaxDocs > 0 {
+		conditions["max_docs"] = cfg.MaxDocs
+	}
+	if cfg.MaxSize != "" {
+		conditions["max_size"] = cfg.MaxSize
+	}
+	return conditions
+}
+
+// GetCheckInterval returns the interval between rollover checks
+func (cfg *RolloverConfig) GetCheckInterval() time.Duration {
+	if cfg.CheckInterval <= 0 {
+		return defaultRolloverCheckInterval
+	}
+	return cfg.CheckInterval
+}
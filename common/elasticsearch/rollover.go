@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultRolloverCheckInterval = 5 * time.Minute
+	rolloverFirstIndexSuffix     = "-000001"
+)
+
+type (
+	// RolloverResult is the outcome of a rollover request
+	RolloverResult struct {
+		OldIndex   string
+		NewIndex   string
+		RolledOver bool
+	}
+)
+
+// GetInitialRolloverIndexName returns the name of the first concrete index behind the write alias.
+// ElasticSearch derives names of subsequent indices by incrementing the numeric suffix.
+func GetInitialRolloverIndexName(alias string) string {
+	return alias + rolloverFirstIndexSuffix
+}
+
+// buildCreateIndexWithAliasBody returns the body of the create index request which binds index to alias as write index.
+func buildCreateIndexWithAliasBody(alias string, cfg *RolloverConfig) map[string]interface{} {
+	body := buildCreateRolloverIndexBody(alias, cfg)
+	body["aliases"] = map[string]interface{}{
+		alias: map[string]interface{}{
+			"is_write_index": true,
+		},
+	}
+	return body
+}
+
+// buildCreateRolloverIndexBody returns the body of the create index request for an index which is going to be
+// bound to alias later, e.g. when a concrete index which already has the alias name is migrated.
+func buildCreateRolloverIndexBody(alias string, cfg *RolloverConfig) map[string]interface{} {
+	body := make(map[string]interface{})
+	if cfg != nil && cfg.ILMPolicy != "" {
+		body["settings"] = map[string]interface{}{
+			"index.lifecycle.name":           cfg.ILMPolicy,
+			"index.lifecycle.rollover_alias": alias,
+		}
+	}
+	return body
+}
+
+// buildILMPolicyBody returns the body of the put lifecycle request with a single hot phase rollover action.
+func buildILMPolicyBody(cfg *RolloverConfig) (string, error) {
+	body := map[string]interface{}{
+		"policy": map[string]interface{}{
+			"phases": map[string]interface{}{
+				"hot": map[string]interface{}{
+					"actions": map[string]interface{}{
+						"rollover": cfg.Conditions(),
+					},
+				},
+			},
+		},
+	}
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("unable to build ILM policy: %w", err)
+	}
+	return string(bytes), nil
+}
@@ -100,8 +100,8 @@ func (sv *SearchAttributesValidator) ValidateSearchAttributes(input *commonpb.Se
 				Error("invalid search attribute value")
 			return serviceerror.NewInvalidArgument(fmt.Sprintf("%v is not a valid search attribute value for key %s", invalidValue, key))
 		}
-		// verify: key is not system reserved
-		if definition.IsSystemIndexedKey(key) {
+		// verify: key is not system reserved or written by server only
+		if definition.IsReadOnlyIndexedKey(key) {
 			sv.logger.WithTags(tag.ESKey(key), tag.WorkflowNamespace(namespace)).
 				Error("illegal update of system reserved attribute")
 			return serviceerror.NewInvalidArgument(fmt.Sprintf("%s is read-only Temporal reservered attribute", key))
@@ -25,6 +25,8 @@
 package failure
 
 import (
+	"math"
+
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	failurepb "go.temporal.io/api/failure/v1"
@@ -68,11 +70,102 @@ func NewTimeoutFailure(message string, timeoutType enumspb.TimeoutType) *failure
 	return f
 }
 
+// TruncationLimits are the sizes failures are truncated to, zero means no limit
+type TruncationLimits struct {
+	// MaxSize is the max encoded size of the failure. Failures above it keep only their source, type,
+	// and messages and stack traces of total size up to MaxSize, like with Truncate.
+	MaxSize int
+	// MaxMessageSize is the max size of the message of the failure and of each of its causes
+	MaxMessageSize int
+	// MaxStackTraceSize is the max size of the stack trace of the failure and of each of its causes
+	MaxStackTraceSize int
+}
+
 func Truncate(f *failurepb.Failure, maxSize int) *failurepb.Failure {
 	if f == nil {
 		return nil
 	}
 
+	newFailure := newTruncatedFailure(f)
+
+	if len(f.Message) > maxSize {
+		newFailure.Message = f.Message[:maxSize]
+		return newFailure
+	}
+	newFailure.Message = f.Message
+	maxSize -= len(newFailure.Message)
+
+	if len(f.StackTrace) > maxSize {
+		newFailure.StackTrace = f.StackTrace[:maxSize]
+		return newFailure
+	}
+	newFailure.StackTrace = f.StackTrace
+	maxSize -= len(newFailure.StackTrace)
+
+	newFailure.Cause = Truncate(f.Cause, maxSize)
+
+	return newFailure
+}
+
+// TruncateWithLimits truncates message and stack trace of the failure and of each of its causes to the limits,
+// limiting their sizes keeps room for root causes within the total size. It returns the failure unchanged and false
+// if it is within the limits.
+func TruncateWithLimits(f *failurepb.Failure, limits TruncationLimits) (*failurepb.Failure, bool) {
+	if !exceedsLimits(f, limits) {
+		return f, false
+	}
+	maxSize := limits.MaxSize
+	if maxSize <= 0 {
+		maxSize = math.MaxInt32
+	}
+	return truncateWithLimits(f, limits, maxSize), true
+}
+
+func exceedsLimits(f *failurepb.Failure, limits TruncationLimits) bool {
+	if limits.MaxSize > 0 && f.Size() > limits.MaxSize {
+		return true
+	}
+	for ; f != nil; f = f.Cause {
+		if limits.MaxMessageSize > 0 && len(f.Message) > limits.MaxMessageSize ||
+			limits.MaxStackTraceSize > 0 && len(f.StackTrace) > limits.MaxStackTraceSize {
+			return true
+		}
+	}
+	return false
+}
+
+func truncateWithLimits(f *failurepb.Failure, limits TruncationLimits, maxSize int) *failurepb.Failure {
+	if f == nil {
+		return nil
+	}
+
+	newFailure := newTruncatedFailure(f)
+
+	newFailure.Message = truncateString(f.Message, limits.MaxMessageSize, maxSize)
+	maxSize -= len(newFailure.Message)
+	newFailure.StackTrace = truncateString(f.StackTrace, limits.MaxStackTraceSize, maxSize)
+	maxSize -= len(newFailure.StackTrace)
+	if maxSize <= 0 {
+		return newFailure
+	}
+
+	newFailure.Cause = truncateWithLimits(f.Cause, limits, maxSize)
+
+	return newFailure
+}
+
+func truncateString(s string, limit int, maxSize int) string {
+	if limit > 0 && limit < maxSize {
+		maxSize = limit
+	}
+	if len(s) > maxSize {
+		return s[:maxSize]
+	}
+	return s
+}
+
+// newTruncatedFailure returns failure with the source and failure info of f which are kept by truncation
+func newTruncatedFailure(f *failurepb.Failure) *failurepb.Failure {
 	newFailure := &failurepb.Failure{
 		Source: f.Source,
 	}
@@ -91,21 +184,5 @@ func Truncate(f *failurepb.Failure, maxSize int) *failurepb.Failure {
 		}}
 	}
 
-	if len(f.Message) > maxSize {
-		newFailure.Message = f.Message[:maxSize]
-		return newFailure
-	}
-	newFailure.Message = f.Message
-	maxSize -= len(newFailure.Message)
-
-	if len(f.StackTrace) > maxSize {
-		newFailure.StackTrace = f.StackTrace[:maxSize]
-		return newFailure
-	}
-	newFailure.StackTrace = f.StackTrace
-	maxSize -= len(newFailure.StackTrace)
-
-	newFailure.Cause = Truncate(f.Cause, maxSize)
-
 	return newFailure
 }
@@ -28,6 +28,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
 	failurepb "go.temporal.io/api/failure/v1"
 )
 
@@ -63,3 +64,62 @@ func TestTruncate(t *testing.T) {
 	assert.NotNil(newFailure.GetServerFailureInfo())
 	assert.True(newFailure.GetServerFailureInfo().GetNonRetryable())
 }
+
+func TestTruncateWithLimits(t *testing.T) {
+	assert := assert.New(t)
+
+	root := &failurepb.Failure{
+		Message:    "root cause",
+		StackTrace: "root stack trace",
+		FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+			Type:         "RootError",
+			NonRetryable: true,
+		}},
+	}
+	f := &failurepb.Failure{
+		Source:     "GoSDK",
+		Message:    "very long message of the wrapping failure",
+		StackTrace: "very long stack trace of the wrapping failure",
+		Cause:      root,
+	}
+
+	newFailure, truncated := TruncateWithLimits(f, TruncationLimits{MaxSize: 1000, MaxMessageSize: 100})
+	assert.False(truncated)
+	assert.Equal(f, newFailure)
+
+	// failures above max size lose their details even if their messages and stack traces fit
+	f.FailureInfo = &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+		Type:    "WrappingError",
+		Details: &commonpb.Payloads{Payloads: []*commonpb.Payload{{Data: make([]byte, 1000)}}},
+	}}
+	newFailure, truncated = TruncateWithLimits(f, TruncationLimits{MaxSize: 1000})
+	assert.True(truncated)
+	assert.Equal("WrappingError", newFailure.GetApplicationFailureInfo().GetType())
+	assert.Nil(newFailure.GetApplicationFailureInfo().GetDetails())
+	assert.Equal(f.GetMessage(), newFailure.GetMessage())
+	assert.Equal(root.GetStackTrace(), newFailure.GetCause().GetStackTrace())
+
+	// greedy truncation to total size loses the root cause
+	assert.Nil(Truncate(f, 60).GetCause())
+
+	newFailure, truncated = TruncateWithLimits(f, TruncationLimits{MaxSize: 60, MaxMessageSize: 10, MaxStackTraceSize: 10})
+	assert.True(truncated)
+	assert.Equal(f.GetSource(), newFailure.GetSource())
+	assert.Equal("very long ", newFailure.GetMessage())
+	assert.Equal("very long ", newFailure.GetStackTrace())
+	assert.Equal("root cause", newFailure.GetCause().GetMessage())
+	assert.Equal("root stack", newFailure.GetCause().GetStackTrace())
+	assert.Equal("RootError", newFailure.GetCause().GetApplicationFailureInfo().GetType())
+	assert.True(newFailure.GetCause().GetApplicationFailureInfo().GetNonRetryable())
+
+	newFailure, truncated = TruncateWithLimits(f, TruncationLimits{MaxStackTraceSize: 4})
+	assert.True(truncated)
+	assert.Equal(f.GetMessage(), newFailure.GetMessage())
+	assert.Equal("very", newFailure.GetStackTrace())
+	assert.Equal(root.GetMessage(), newFailure.GetCause().GetMessage())
+	assert.Equal("root", newFailure.GetCause().GetStackTrace())
+
+	newFailure, truncated = TruncateWithLimits(nil, TruncationLimits{MaxSize: 1})
+	assert.False(truncated)
+	assert.Nil(newFailure)
+}
@@ -27,6 +27,7 @@ package headers
 import (
 	"context"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -34,6 +35,20 @@ const (
 	ClientNameHeaderName              = "client-name"
 	ClientVersionHeaderName           = "client-version"
 	SupportedServerVersionsHeaderName = "supported-server-versions"
+
+	// NamespaceQuotaUtilizationHeaderName is the response header which warns the caller that namespace is close to its rate limit
+	NamespaceQuotaUtilizationHeaderName = "namespace-quota-utilization"
+	// LongPollTimeoutHeaderName is the response header which advertises long poll timeout configured for the API and namespace
+	LongPollTimeoutHeaderName = "long-poll-timeout"
+	// SuggestContinueAsNewHeaderName is the workflow task response header which suggests workflow to continue-as-new,
+	// its value lists reasons of the suggestion
+	SuggestContinueAsNewHeaderName = "suggest-continue-as-new"
+	// VisibilityConsistencyTokenHeaderName is the response header of mutating workflow APIs which carries token identifying
+	// the mutated run, and the ListWorkflowExecutions request header which asks to wait until visibility reflects it
+	VisibilityConsistencyTokenHeaderName = "visibility-consistency-token"
+	// RequestVisibilityConsistencyTokenHeaderName is the request header of mutating workflow APIs which asks for
+	// visibility consistency token of the mutated run, the token is not computed for callers which don't ask for it
+	RequestVisibilityConsistencyTokenHeaderName = "request-visibility-consistency-token"
 )
 
 var (
@@ -64,6 +79,16 @@ func GetValues(ctx context.Context, headerNames ...string) []string {
 	return headerValues
 }
 
+// ForwardResponseHeader sets header received in response of downstream service call as response header of ctx.
+// It is noop if header is not present in received metadata.
+func ForwardResponseHeader(ctx context.Context, md metadata.MD, headerName string) error {
+	values := md.Get(headerName)
+	if len(values) == 0 {
+		return nil
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(headerName, values[0]))
+}
+
 // PropagateVersions propagates version headers from incoming context to outgoing context.
 // It copies all version headers to outgoing context only if they are exist in incoming context
 // and doesn't exist in outgoing context already.
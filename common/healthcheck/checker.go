@@ -0,0 +1,190 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package healthcheck
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	checkInterval = 5 * time.Second
+)
+
+type (
+	// Checker serves grpc.health.v1.Health for a temporal role. A role is reported as SERVING
+	// only after the host has joined the membership ring of the role and while persistence
+	// is reachable, and as NOT_SERVING once the checker is stopped during host shutdown.
+	Checker struct {
+		role                   string
+		serviceNames           []string
+		membershipMonitor      membership.Monitor
+		clusterMetadataManager persistence.ClusterMetadataManager
+		logger                 log.Logger
+
+		server *health.Server
+
+		sync.Mutex
+		status     healthpb.HealthCheckResponse_ServingStatus
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+	}
+)
+
+// NewChecker creates a health checker for the given role. Status is reported for the empty
+// service name, which stands for the whole server, for the role name, and for serviceNames.
+func NewChecker(
+	role string,
+	membershipMonitor membership.Monitor,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	logger log.Logger,
+	serviceNames ...string,
+) *Checker {
+
+	c := &Checker{
+		role:                   role,
+		serviceNames:           append([]string{"", role}, serviceNames...),
+		membershipMonitor:      membershipMonitor,
+		clusterMetadataManager: clusterMetadataManager,
+		logger:                 logger.WithTags(tag.Service(role)),
+		server:                 health.NewServer(),
+		status:                 healthpb.HealthCheckResponse_NOT_SERVING,
+		shutdownCh:             make(chan struct{}),
+	}
+	c.setServingStatus(c.status)
+	return c
+}
+
+// Register registers the health service on the grpc server
+func (c *Checker) Register(server *grpc.Server) {
+	healthpb.RegisterHealthServer(server, c.server)
+}
+
+// Start starts the periodic health evaluation, it must be called after the host joined membership
+func (c *Checker) Start() {
+	c.shutdownWG.Add(1)
+	go c.checkLoop()
+}
+
+// Stop reports the role as NOT_SERVING for good, so load balancers stop routing to this
+// host while it drains
+func (c *Checker) Stop() {
+	c.Lock()
+	select {
+	case <-c.shutdownCh:
+		c.Unlock()
+		return
+	default:
+		close(c.shutdownCh)
+	}
+	c.Unlock()
+
+	c.shutdownWG.Wait()
+	c.server.Shutdown()
+	c.logger.Info("Health status updated.", tag.Value(healthpb.HealthCheckResponse_NOT_SERVING.String()))
+}
+
+func (c *Checker) checkLoop() {
+	defer c.shutdownWG.Done()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.shutdownCh:
+			return
+		case <-timer.C:
+			c.updateStatus(c.check())
+			timer.Reset(checkInterval)
+		}
+	}
+}
+
+func (c *Checker) check() healthpb.HealthCheckResponse_ServingStatus {
+	if !c.isMember() {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	if _, err := c.clusterMetadataManager.GetClusterMembers(&persistence.GetClusterMembersRequest{
+		PageSize: 1,
+	}); err != nil {
+		c.logger.Warn("Health check failed to reach persistence.", tag.Error(err))
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+func (c *Checker) isMember() bool {
+	self, err := c.membershipMonitor.WhoAmI()
+	if err != nil {
+		return false
+	}
+	resolver, err := c.membershipMonitor.GetResolver(c.role)
+	if err != nil {
+		return false
+	}
+	for _, member := range resolver.Members() {
+		if member.GetAddress() == self.GetAddress() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Checker) updateStatus(
+	status healthpb.HealthCheckResponse_ServingStatus,
+) {
+	c.Lock()
+	defer c.Unlock()
+
+	select {
+	case <-c.shutdownCh:
+		return
+	default:
+	}
+	if c.status == status {
+		return
+	}
+	c.status = status
+	c.setServingStatus(status)
+	c.logger.Info("Health status updated.", tag.Value(status.String()))
+}
+
+func (c *Checker) setServingStatus(
+	status healthpb.HealthCheckResponse_ServingStatus,
+) {
+	for _, serviceName := range c.serviceNames {
+		c.server.SetServingStatus(serviceName, status)
+	}
+}
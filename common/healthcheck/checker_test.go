@@ -0,0 +1,158 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	checkerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller             *gomock.Controller
+		membershipMonitor      *membership.MockMonitor
+		serviceResolver        *membership.MockServiceResolver
+		clusterMetadataManager *mocks.MockClusterMetadataManager
+		checker                *Checker
+	}
+)
+
+const (
+	testServiceName = "temporal.api.workflowservice.v1.HistoryService"
+	testSelfAddress = "127.0.0.1:7234"
+)
+
+func TestCheckerSuite(t *testing.T) {
+	suite.Run(t, new(checkerSuite))
+}
+
+func (s *checkerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.membershipMonitor = membership.NewMockMonitor(s.controller)
+	s.serviceResolver = membership.NewMockServiceResolver(s.controller)
+	s.clusterMetadataManager = mocks.NewMockClusterMetadataManager(s.controller)
+
+	s.membershipMonitor.EXPECT().WhoAmI().Return(membership.NewHostInfo(testSelfAddress, nil), nil).AnyTimes()
+	s.membershipMonitor.EXPECT().GetResolver(common.HistoryServiceName).Return(s.serviceResolver, nil).AnyTimes()
+
+	s.checker = NewChecker(
+		common.HistoryServiceName,
+		s.membershipMonitor,
+		s.clusterMetadataManager,
+		loggerimpl.NewNopLogger(),
+		testServiceName,
+	)
+}
+
+func (s *checkerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *checkerSuite) TestNotServingBeforeFirstCheck() {
+	s.assertStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+func (s *checkerSuite) TestUnknownService() {
+	_, err := s.checker.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	s.Error(err)
+}
+
+func (s *checkerSuite) TestCheck_Serving() {
+	s.serviceResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo("127.0.0.2:7234", nil),
+		membership.NewHostInfo(testSelfAddress, nil),
+	})
+	s.clusterMetadataManager.EXPECT().GetClusterMembers(gomock.Any()).Return(&persistence.GetClusterMembersResponse{}, nil)
+
+	s.checker.updateStatus(s.checker.check())
+	s.assertStatus(healthpb.HealthCheckResponse_SERVING)
+}
+
+func (s *checkerSuite) TestCheck_NotJoinedMembership() {
+	s.serviceResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo("127.0.0.2:7234", nil),
+	})
+
+	s.Equal(healthpb.HealthCheckResponse_NOT_SERVING, s.checker.check())
+}
+
+func (s *checkerSuite) TestCheck_PersistenceUnavailable() {
+	s.serviceResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo(testSelfAddress, nil),
+	}).Times(2)
+	gomock.InOrder(
+		s.clusterMetadataManager.EXPECT().GetClusterMembers(gomock.Any()).Return(&persistence.GetClusterMembersResponse{}, nil),
+		s.clusterMetadataManager.EXPECT().GetClusterMembers(gomock.Any()).Return(nil, errors.New("some random error")),
+	)
+
+	s.checker.updateStatus(s.checker.check())
+	s.assertStatus(healthpb.HealthCheckResponse_SERVING)
+	s.checker.updateStatus(s.checker.check())
+	s.assertStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+func (s *checkerSuite) TestStop_NotServingForGood() {
+	s.serviceResolver.EXPECT().Members().Return([]*membership.HostInfo{
+		membership.NewHostInfo(testSelfAddress, nil),
+	}).AnyTimes()
+	s.clusterMetadataManager.EXPECT().GetClusterMembers(gomock.Any()).Return(&persistence.GetClusterMembersResponse{}, nil).AnyTimes()
+
+	s.checker.updateStatus(s.checker.check())
+	s.assertStatus(healthpb.HealthCheckResponse_SERVING)
+
+	s.checker.Start()
+	s.checker.Stop()
+	s.checker.Stop()
+	s.assertStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	s.checker.updateStatus(healthpb.HealthCheckResponse_SERVING)
+	s.assertStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+func (s *checkerSuite) assertStatus(
+	expected healthpb.HealthCheckResponse_ServingStatus,
+) {
+	for _, service := range []string{"", common.HistoryServiceName, testServiceName} {
+		resp, err := s.checker.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		s.NoError(err)
+		s.Equal(expected, resp.GetStatus(), service)
+	}
+}
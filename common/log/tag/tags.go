@@ -170,6 +170,11 @@ func BlobSizeViolationOperation(operation string) Tag {
 	return newStringTag("blob-size-violation-operation", operation)
 }
 
+// BlobSizeViolationField returns tag for BlobSizeViolationField
+func BlobSizeViolationField(field string) Tag {
+	return newStringTag("blob-size-violation-field", field)
+}
+
 // namespace related
 
 // WorkflowNamespaceID returns tag for WorkflowNamespaceID
@@ -187,6 +192,16 @@ func WorkflowNamespaceIDs(namespaceIDs interface{}) Tag {
 	return newObjectTag("wf-namespace-ids", namespaceIDs)
 }
 
+// NamespaceRegistrationID returns tag for NamespaceRegistrationID
+func NamespaceRegistrationID(registrationID int64) Tag {
+	return newInt64("namespace-registration-id", registrationID)
+}
+
+// NamespaceRegistrationReviewer returns tag for NamespaceRegistrationReviewer
+func NamespaceRegistrationReviewer(identity string) Tag {
+	return newStringTag("namespace-registration-reviewer", identity)
+}
+
 // history event ID related
 
 // WorkflowEventID returns tag for WorkflowEventID
@@ -290,6 +305,11 @@ func WorkflowSignalCount(signalCount int64) Tag {
 	return newInt64("wf-signal-count", signalCount)
 }
 
+// WorkflowSignalName returns tag for SignalName
+func WorkflowSignalName(signalName string) Tag {
+	return newStringTag("wf-signal-name", signalName)
+}
+
 // WorkflowHistorySize returns tag for HistorySize
 func WorkflowHistorySize(historySize int) Tag {
 	return newInt("wf-history-size", historySize)
@@ -519,6 +539,11 @@ func TaskType(taskType enumsspb.TaskType) Tag {
 	return newStringTag("queue-task-type", taskType.String())
 }
 
+// TaskCategory returns tag for the category of a custom task
+func TaskCategory(category string) Tag {
+	return newStringTag("queue-task-category", category)
+}
+
 // TaskVersion returns tag for TaskVersion
 func TaskVersion(taskVersion int64) Tag {
 	return newInt64("queue-task-version", taskVersion)
@@ -614,6 +639,16 @@ func ESDocID(id string) Tag {
 	return newStringTag("es-doc-id", id)
 }
 
+// ESIndex returns tag for ESIndex
+func ESIndex(index string) Tag {
+	return newStringTag("es-index", index)
+}
+
+// ESAlias returns tag for ESAlias
+func ESAlias(alias string) Tag {
+	return newStringTag("es-alias", alias)
+}
+
 // LoggingCallAtKey is reserved tag
 const LoggingCallAtKey = "logging-call-at"
 
@@ -649,6 +684,11 @@ func KafkaOffset(offset int64) Tag {
 	return newInt64("kafka-offset", offset)
 }
 
+// IntakePartition returns tag for partition of the intake queue
+func IntakePartition(partition int32) Tag {
+	return newInt32("intake-partition", partition)
+}
+
 // TokenLastEventID returns tag for TokenLastEventID
 func TokenLastEventID(id int64) Tag {
 	return newInt64("token-last-event-id", id)
@@ -62,6 +62,7 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskReroute         = workflowAction("reroute-activitytask")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
@@ -107,6 +108,7 @@ var (
 	ComponentEventsCache              = component("events-cache")
 	ComponentTransferQueue            = component("transfer-queue-processor")
 	ComponentVisibilityQueue          = component("visibility-queue-processor")
+	ComponentCustomTaskQueue          = component("custom-task-queue-processor")
 	ComponentTimerQueue               = component("timer-queue-processor")
 	ComponentTimerBuilder             = component("timer-builder")
 	ComponentReplicatorQueue          = component("replicator-queue-processor")
@@ -121,10 +123,14 @@ var (
 	ComponentIndexer                  = component("indexer")
 	ComponentIndexerProcessor         = component("indexer-processor")
 	ComponentIndexerESProcessor       = component("indexer-es-processor")
+	ComponentIndexerRollover          = component("indexer-rollover")
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentCanary                   = component("canary")
+	ComponentIntakeProcessor          = component("intake-processor")
 	ComponentWorker                   = component("worker")
+	ComponentLeaderElector            = component("leader-elector")
 	ComponentServiceResolver          = component("service-resolver")
 	ComponentMetadataInitializer      = component("metadata-initializer")
 	VersionChecker                    = component("version-checker")
@@ -0,0 +1,312 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+
+	"go.temporal.io/server/common"
+)
+
+const (
+	// GRPCResolverScheme is gRPC target scheme of frontend addresses resolved by membership and DNS,
+	// target is "membership://<service>/<host:port>", where service is the name of local service
+	// whose membership monitor is used and host:port is the configured frontend address
+	GRPCResolverScheme = "membership"
+
+	// dnsGRPCResolverService is prefix of the service part of targets which are resolved by DNS only
+	dnsGRPCResolverService = "dns-"
+
+	defaultGRPCResolverRefreshInterval = 10 * time.Second
+	grpcResolverLookupTimeout          = 5 * time.Second
+)
+
+type (
+	grpcResolverBuilder struct {
+		sync.RWMutex
+		settings map[string]*grpcResolverSettings
+	}
+
+	// grpcResolverSettings are resolver settings of one local service
+	grpcResolverSettings struct {
+		useMembership   bool
+		refreshInterval time.Duration
+		serviceConfig   string
+		monitor         Monitor
+	}
+
+	// grpcResolver resolves addresses of frontend hosts for gRPC round robin balancer. Frontend hosts are
+	// taken from membership ring and updated on membership changes. When membership is not used or ring
+	// has no frontend hosts, configured host is re-resolved with DNS every refresh interval instead,
+	// so connections are spread over all frontend replicas behind the name.
+	grpcResolver struct {
+		builder  *grpcResolverBuilder
+		service  string
+		hostPort string
+		cc       resolver.ClientConn
+		lookup   func(ctx context.Context, host string) ([]string, error)
+
+		resolveNow chan struct{}
+		shutdown   chan struct{}
+		closeOnce  sync.Once
+		doneWG     sync.WaitGroup
+
+		lastAddresses []string
+	}
+)
+
+var grpcResolvers = &grpcResolverBuilder{
+	settings: make(map[string]*grpcResolverSettings),
+}
+
+func init() {
+	resolver.Register(grpcResolvers)
+}
+
+// GRPCResolverTarget returns gRPC target which resolves frontend hostPort by DNS and, if useMembership is set,
+// by membership monitor of the service. Non-empty serviceConfig is passed to connections along with addresses,
+// it's ignored by connections which disable service config lookup.
+func GRPCResolverTarget(
+	service string,
+	hostPort string,
+	useMembership bool,
+	refreshInterval time.Duration,
+	serviceConfig string,
+) string {
+	grpcResolvers.Lock()
+	defer grpcResolvers.Unlock()
+
+	settings := grpcResolvers.getSettingsLocked(service)
+	settings.useMembership = useMembership
+	settings.refreshInterval = refreshInterval
+	settings.serviceConfig = serviceConfig
+	return fmt.Sprintf("%s://%s/%s", GRPCResolverScheme, service, hostPort)
+}
+
+// GRPCDNSResolverTarget returns gRPC target which re-resolves hostPort by DNS every refresh interval
+func GRPCDNSResolverTarget(hostPort string, refreshInterval time.Duration) string {
+	// targets with the same interval share settings, so they don't override settings of other targets
+	service := fmt.Sprintf("%s%v", dnsGRPCResolverService, refreshInterval)
+
+	grpcResolvers.Lock()
+	defer grpcResolvers.Unlock()
+
+	grpcResolvers.getSettingsLocked(service).refreshInterval = refreshInterval
+	return fmt.Sprintf("%s://%s/%s", GRPCResolverScheme, service, hostPort)
+}
+
+// SetGRPCResolverMonitor sets membership monitor used by gRPC targets of the service
+func SetGRPCResolverMonitor(service string, monitor Monitor) {
+	grpcResolvers.Lock()
+	defer grpcResolvers.Unlock()
+
+	grpcResolvers.getSettingsLocked(service).monitor = monitor
+}
+
+func (b *grpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	if _, _, err := net.SplitHostPort(target.Endpoint); err != nil {
+		return nil, fmt.Errorf("invalid %s target %q: %v", GRPCResolverScheme, target.Endpoint, err)
+	}
+
+	r := &grpcResolver{
+		builder:    b,
+		service:    target.Authority,
+		hostPort:   target.Endpoint,
+		cc:         cc,
+		lookup:     net.DefaultResolver.LookupHost,
+		resolveNow: make(chan struct{}, 1),
+		shutdown:   make(chan struct{}),
+	}
+	r.update()
+	r.doneWG.Add(1)
+	go r.watch()
+	return r, nil
+}
+
+func (b *grpcResolverBuilder) Scheme() string {
+	return GRPCResolverScheme
+}
+
+func (b *grpcResolverBuilder) getSettingsLocked(service string) *grpcResolverSettings {
+	settings, ok := b.settings[service]
+	if !ok {
+		settings = &grpcResolverSettings{}
+		b.settings[service] = settings
+	}
+	return settings
+}
+
+// getMonitor returns membership monitor of the service, or nil if the service doesn't resolve frontend
+// hosts by membership or its monitor is not created yet
+func (b *grpcResolverBuilder) getMonitor(service string) Monitor {
+	b.RLock()
+	defer b.RUnlock()
+
+	if settings, ok := b.settings[service]; ok && settings.useMembership {
+		return settings.monitor
+	}
+	return nil
+}
+
+func (b *grpcResolverBuilder) getServiceConfig(service string) string {
+	b.RLock()
+	defer b.RUnlock()
+
+	if settings, ok := b.settings[service]; ok {
+		return settings.serviceConfig
+	}
+	return ""
+}
+
+func (b *grpcResolverBuilder) getRefreshInterval(service string) time.Duration {
+	b.RLock()
+	defer b.RUnlock()
+
+	if settings, ok := b.settings[service]; ok && settings.refreshInterval > 0 {
+		return settings.refreshInterval
+	}
+	return defaultGRPCResolverRefreshInterval
+}
+
+func (r *grpcResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolveNow <- struct{}{}:
+	default:
+	}
+}
+
+func (r *grpcResolver) Close() {
+	r.closeOnce.Do(func() {
+		close(r.shutdown)
+	})
+	r.doneWG.Wait()
+}
+
+func (r *grpcResolver) watch() {
+	defer r.doneWG.Done()
+
+	ticker := time.NewTicker(r.builder.getRefreshInterval(r.service))
+	defer ticker.Stop()
+
+	listenerName := fmt.Sprintf("grpc-resolver-%p", r)
+	membershipChanged := make(chan *ChangedEvent, 1)
+	var listenedMonitor Monitor
+	defer func() {
+		if listenedMonitor != nil {
+			_ = listenedMonitor.RemoveListener(common.FrontendServiceName, listenerName)
+		}
+	}()
+
+	for {
+		// monitor is set once the service creates it, start listening as soon as it's available
+		if listenedMonitor == nil {
+			if monitor := r.builder.getMonitor(r.service); monitor != nil {
+				if err := monitor.AddListener(common.FrontendServiceName, listenerName, membershipChanged); err == nil {
+					listenedMonitor = monitor
+				}
+			}
+		}
+
+		select {
+		case <-r.shutdown:
+			return
+		case <-ticker.C:
+		case <-r.resolveNow:
+		case <-membershipChanged:
+		}
+		r.update()
+	}
+}
+
+func (r *grpcResolver) update() {
+	addresses, err := r.resolve()
+	if err != nil {
+		r.cc.ReportError(err)
+		return
+	}
+	if equalStringSlices(addresses, r.lastAddresses) {
+		return
+	}
+
+	r.lastAddresses = addresses
+	state := resolver.State{}
+	for _, address := range addresses {
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: address})
+	}
+	if serviceConfig := r.builder.getServiceConfig(r.service); serviceConfig != "" {
+		state.ServiceConfig = r.cc.ParseServiceConfig(serviceConfig)
+	}
+	r.cc.UpdateState(state)
+}
+
+// resolve returns sorted frontend addresses from membership, or from DNS if membership has none
+func (r *grpcResolver) resolve() ([]string, error) {
+	var addresses []string
+	if monitor := r.builder.getMonitor(r.service); monitor != nil {
+		if frontendResolver, err := monitor.GetResolver(common.FrontendServiceName); err == nil {
+			for _, member := range frontendResolver.Members() {
+				addresses = append(addresses, member.GetAddress())
+			}
+		}
+	}
+
+	if len(addresses) == 0 {
+		host, port, err := net.SplitHostPort(r.hostPort)
+		if err != nil {
+			return nil, err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), grpcResolverLookupTimeout)
+		defer cancel()
+		ips, err := r.lookup(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			addresses = append(addresses, net.JoinHostPort(ip, port))
+		}
+	}
+
+	sort.Strings(addresses)
+	return addresses, nil
+}
+
+func equalStringSlices(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
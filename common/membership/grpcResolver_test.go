@@ -0,0 +1,200 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/serviceconfig"
+
+	"go.temporal.io/server/common"
+)
+
+type (
+	grpcResolverSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller *gomock.Controller
+		builder    *grpcResolverBuilder
+		cc         *testClientConn
+
+		lock sync.Mutex
+		ips  []string
+	}
+
+	testClientConn struct {
+		resolver.ClientConn
+		states chan resolver.State
+	}
+)
+
+func TestGRPCResolverSuite(t *testing.T) {
+	suite.Run(t, new(grpcResolverSuite))
+}
+
+func (s *grpcResolverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.builder = &grpcResolverBuilder{settings: make(map[string]*grpcResolverSettings)}
+	s.cc = &testClientConn{states: make(chan resolver.State, 10)}
+}
+
+func (s *grpcResolverSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *grpcResolverSuite) newResolver() *grpcResolver {
+	r := &grpcResolver{
+		builder:    s.builder,
+		service:    common.WorkerServiceName,
+		hostPort:   "frontend:7233",
+		cc:         s.cc,
+		lookup:     s.lookup,
+		resolveNow: make(chan struct{}, 1),
+		shutdown:   make(chan struct{}),
+	}
+	r.update()
+	r.doneWG.Add(1)
+	go r.watch()
+	return r
+}
+
+func (s *grpcResolverSuite) lookup(_ context.Context, host string) ([]string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.Equal("frontend", host)
+	return s.ips, nil
+}
+
+func (s *grpcResolverSuite) setIPs(ips ...string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.ips = ips
+}
+
+func (s *grpcResolverSuite) nextAddresses() []string {
+	select {
+	case state := <-s.cc.states:
+		var addresses []string
+		for _, address := range state.Addresses {
+			addresses = append(addresses, address.Addr)
+		}
+		return addresses
+	case <-time.After(5 * time.Second):
+		s.FailNow("resolver didn't update state")
+		return nil
+	}
+}
+
+func (s *grpcResolverSuite) TestDNS() {
+	s.setIPs("10.0.0.2", "10.0.0.1")
+	r := s.newResolver()
+	defer r.Close()
+	s.Equal([]string{"10.0.0.1:7233", "10.0.0.2:7233"}, s.nextAddresses())
+
+	s.setIPs("10.0.0.3", "10.0.0.2", "10.0.0.1", "2001:db8::1")
+	r.ResolveNow(resolver.ResolveNowOptions{})
+	s.Equal([]string{"10.0.0.1:7233", "10.0.0.2:7233", "10.0.0.3:7233", "[2001:db8::1]:7233"}, s.nextAddresses())
+}
+
+func (s *grpcResolverSuite) TestMembership() {
+	s.setIPs("10.0.0.1")
+	frontendResolver := NewMockServiceResolver(s.controller)
+	monitor := NewMockMonitor(s.controller)
+	monitor.EXPECT().GetResolver(common.FrontendServiceName).Return(frontendResolver, nil).AnyTimes()
+
+	var membershipChanged chan<- *ChangedEvent
+	listenerAdded := make(chan struct{})
+	monitor.EXPECT().AddListener(common.FrontendServiceName, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ string, _ string, ch chan<- *ChangedEvent) error {
+			membershipChanged = ch
+			close(listenerAdded)
+			return nil
+		})
+	monitor.EXPECT().RemoveListener(common.FrontendServiceName, gomock.Any()).Return(nil)
+
+	hostA := NewHostInfo("10.0.1.1:7233", nil)
+	hostB := NewHostInfo("10.0.1.2:7233", nil)
+	frontendResolver.EXPECT().Members().Return([]*HostInfo{hostA}).Times(1)
+	frontendResolver.EXPECT().Members().Return([]*HostInfo{hostA, hostB}).AnyTimes()
+
+	s.builder.getSettingsLocked(common.WorkerServiceName).useMembership = true
+	s.builder.getSettingsLocked(common.WorkerServiceName).monitor = monitor
+	r := s.newResolver()
+	s.Equal([]string{"10.0.1.1:7233"}, s.nextAddresses())
+
+	<-listenerAdded
+	membershipChanged <- &ChangedEvent{HostsAdded: []*HostInfo{hostB}}
+	s.Equal([]string{"10.0.1.1:7233", "10.0.1.2:7233"}, s.nextAddresses())
+	r.Close()
+}
+
+func (s *grpcResolverSuite) TestMembershipNotUsed() {
+	s.setIPs("10.0.0.1")
+	s.builder.getSettingsLocked(common.WorkerServiceName).monitor = NewMockMonitor(s.controller)
+
+	r := s.newResolver()
+	defer r.Close()
+	s.Equal([]string{"10.0.0.1:7233"}, s.nextAddresses())
+}
+
+func (s *grpcResolverSuite) TestServiceConfig() {
+	s.setIPs("10.0.0.1")
+	s.builder.getSettingsLocked(common.WorkerServiceName).serviceConfig = `{"loadBalancingConfig": [{"pick_first":{}}]}`
+
+	r := s.newResolver()
+	defer r.Close()
+	select {
+	case state := <-s.cc.states:
+		s.NotNil(state.ServiceConfig)
+		s.NoError(state.ServiceConfig.Err)
+	case <-time.After(5 * time.Second):
+		s.FailNow("resolver didn't update state")
+	}
+}
+
+func (s *grpcResolverSuite) TestDNSTarget() {
+	s.Equal("membership://dns-30s/frontend:7233", GRPCDNSResolverTarget("frontend:7233", 30*time.Second))
+	s.Equal(30*time.Second, grpcResolvers.getRefreshInterval("dns-30s"))
+	s.Nil(grpcResolvers.getMonitor("dns-30s"))
+}
+
+func (c *testClientConn) UpdateState(state resolver.State) {
+	c.states <- state
+}
+
+func (c *testClientConn) ReportError(error) {}
+
+func (c *testClientConn) ParseServiceConfig(serviceConfig string) *serviceconfig.ParseResult {
+	return &serviceconfig.ParseResult{Config: &struct{ serviceconfig.Config }{}}
+}
@@ -78,6 +78,9 @@ type (
 		RemoveListener(service string, name string) error
 		// GetReachableMembers returns addresses of all members of the ring
 		GetReachableMembers() ([]string, error)
+		// GetReachableMemberVersions returns server build versions of all members of the ring
+		// keyed by member address, version is empty for members which do not advertise it
+		GetReachableMemberVersions() (map[string]string, error)
 		// GetMemberCount returns the number of reachable members
 		// currently in this node's membership list for the given role
 		GetMemberCount(role string) (int, error)
@@ -100,6 +100,21 @@ func (mr *MockMonitorMockRecorder) GetMemberCount(role interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberCount", reflect.TypeOf((*MockMonitor)(nil).GetMemberCount), role)
 }
 
+// GetReachableMemberVersions mocks base method.
+func (m *MockMonitor) GetReachableMemberVersions() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReachableMemberVersions")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReachableMemberVersions indicates an expected call of GetReachableMemberVersions.
+func (mr *MockMonitorMockRecorder) GetReachableMemberVersions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReachableMemberVersions", reflect.TypeOf((*MockMonitor)(nil).GetReachableMemberVersions))
+}
+
 // GetReachableMembers mocks base method.
 func (m *MockMonitor) GetReachableMembers() ([]string, error) {
 	m.ctrl.T.Helper()
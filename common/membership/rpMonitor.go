@@ -35,6 +35,7 @@ import (
 	"time"
 
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/primitives"
 
 	"github.com/pborman/uuid"
@@ -134,6 +135,10 @@ func (rpo *ringpopMonitor) Start() {
 		rpo.logger.Fatal("unable to set ring pop ServiceRole label", tag.Error(err))
 	}
 
+	if err = labels.Set(RoleVersion, headers.ServerVersion); err != nil {
+		rpo.logger.Fatal("unable to set ring pop ServerVersion label", tag.Error(err))
+	}
+
 	for _, ring := range rpo.rings {
 		ring.Start()
 	}
@@ -367,6 +372,21 @@ func (rpo *ringpopMonitor) GetReachableMembers() ([]string, error) {
 	return rpo.rp.GetReachableMembers()
 }
 
+func (rpo *ringpopMonitor) GetReachableMemberVersions() (map[string]string, error) {
+	members, err := rpo.rp.GetReachableMemberObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]string, len(members))
+	for _, member := range members {
+		// members which predate the version label are reported with an empty version
+		version, _ := member.Label(RoleVersion)
+		versions[member.Address] = version
+	}
+	return versions, nil
+}
+
 func (rpo *ringpopMonitor) GetMemberCount(service string) (int, error) {
 	ring, err := rpo.GetResolver(service)
 	if err != nil {
@@ -376,10 +396,10 @@ func (rpo *ringpopMonitor) GetMemberCount(service string) (int, error) {
 }
 
 func replaceServicePort(address string, servicePort int) (string, error) {
-	parts := strings.Split(address, ":")
-	if len(parts) != 2 {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
 		return "", ErrIncorrectAddressFormat
 	}
 
-	return fmt.Sprintf("%s:%v", parts[0], servicePort), nil
+	return net.JoinHostPort(host, strconv.Itoa(servicePort)), nil
 }
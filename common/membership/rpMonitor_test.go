@@ -32,6 +32,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber/tchannel-go"
 
 	"go.temporal.io/server/common/log/loggerimpl"
 )
@@ -118,3 +119,35 @@ func (s *RpoSuite) testCompareMembers(curr []string, new []string, hasDiff bool)
 		s.True(ok)
 	}
 }
+
+func (s *RpoSuite) TestReplaceServicePort() {
+	address, err := replaceServicePort("10.0.0.1:6933", 7233)
+	s.NoError(err)
+	s.Equal("10.0.0.1:7233", address)
+
+	address, err = replaceServicePort("[2001:db8::1]:6933", 7233)
+	s.NoError(err)
+	s.Equal("[2001:db8::1]:7233", address)
+
+	_, err = replaceServicePort("2001:db8::1", 7233)
+	s.Equal(ErrIncorrectAddressFormat, err)
+}
+
+func (s *RpoSuite) TestBuildBroadcastHostPort_IPv6() {
+	peerInfo := tchannel.LocalPeerInfo{PeerInfo: tchannel.PeerInfo{HostPort: "[2001:db8::1]:6933"}}
+	hostPort, err := BuildBroadcastHostPort(peerInfo, "")
+	s.NoError(err)
+	s.Equal("[2001:db8::1]:6933", hostPort)
+
+	peerInfo = tchannel.LocalPeerInfo{PeerInfo: tchannel.PeerInfo{HostPort: "[::]:6933"}}
+	_, err = BuildBroadcastHostPort(peerInfo, "")
+	s.Error(err)
+
+	hostPort, err = BuildBroadcastHostPort(peerInfo, "[2001:db8::2]")
+	s.NoError(err)
+	s.Equal("[2001:db8::2]:6933", hostPort)
+
+	hostPort, err = BuildBroadcastHostPort(peerInfo, "10.0.0.2")
+	s.NoError(err)
+	s.Equal("10.0.0.2:6933", hostPort)
+}
@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/service/config"
 )
 
 const (
@@ -55,6 +56,10 @@ const (
 	// the service can be accessed.
 	RolePort = "servicePort"
 
+	// RoleVersion label is set by every single service as soon as it bootstraps its
+	// ringpop instance. The data for this key is the server build version of the member.
+	RoleVersion = "serverVersion"
+
 	minRefreshInternal     = time.Second * 4
 	defaultRefreshInterval = time.Second * 10
 	replicaPoints          = 100
@@ -402,13 +407,13 @@ func BuildBroadcastHostPort(listenerPeerInfo tchannel.LocalPeerInfo, broadcastAd
 	// Broadcast IP override
 	if broadcastAddress != "" {
 		// Parse supplied broadcastAddress override
-		ip := net.ParseIP(broadcastAddress)
-		if ip == nil || ip.To4() == nil {
+		ip := config.ParseIP(broadcastAddress)
+		if ip == nil {
 			return "", errors.New("broadcastAddress set but unknown failure encountered while parsing")
 		}
 
 		// If no errors, use the parsed IP with the port from our listener
-		return ip.To4().String() + ":" + port, nil
+		return net.JoinHostPort(ip.String(), port), nil
 	}
 
 	listenerIp := net.ParseIP(listenerIpString)
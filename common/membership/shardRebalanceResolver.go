@@ -0,0 +1,263 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// ShardRebalancePlanProvider returns the persisted shard rebalance plan, nil if there is no plan
+	ShardRebalancePlanProvider func() (*persistencespb.ShardRebalancePlan, error)
+
+	// shardRebalanceResolver overrides history shard owners decided by hash ring with the moves of
+	// persisted shard rebalance plan. Moves in effect are derived only from the plan and time, so shard
+	// controllers and history clients of all hosts agree on shard owners.
+	shardRebalanceResolver struct {
+		ServiceResolver
+
+		planProvider ShardRebalancePlanProvider
+		timeSource   clock.TimeSource
+		logger       log.Logger
+
+		refreshing int32
+
+		sync.Mutex
+		plan        *persistencespb.ShardRebalancePlan
+		refreshedAt time.Time
+		owners      *shardRebalanceOwners
+	}
+
+	shardRebalanceOwners struct {
+		computedAt   time.Time
+		appliedMoves int
+		owners       map[string]*HostInfo
+	}
+)
+
+const (
+	// shardRebalanceRefreshInterval is how often the plan is reloaded and overridden shard owners
+	// are recomputed, so new plans and membership changes are picked up
+	shardRebalanceRefreshInterval = 10 * time.Second
+	// ShardRebalanceActivationDelay is how long after being persisted a plan, or its cancellation, takes effect,
+	// so all hosts have loaded it by the time shard owners change
+	ShardRebalanceActivationDelay = 2 * shardRebalanceRefreshInterval
+)
+
+var _ ServiceResolver = (*shardRebalanceResolver)(nil)
+
+// NewShardRebalanceResolver wraps history service resolver with resolver which applies moves of
+// shard rebalance plan returned by planProvider. The plan is loaded once on creation and reloaded
+// in background every refresh interval.
+func NewShardRebalanceResolver(
+	resolver ServiceResolver,
+	planProvider ShardRebalancePlanProvider,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) ServiceResolver {
+	r := &shardRebalanceResolver{
+		ServiceResolver: resolver,
+		planProvider:    planProvider,
+		timeSource:      timeSource,
+		logger:          logger,
+	}
+	r.refreshPlan()
+	return r
+}
+
+// NewHistoryServiceResolver returns history service resolver of monitor which applies shard rebalance plan
+func NewHistoryServiceResolver(
+	monitor Monitor,
+	planProvider ShardRebalancePlanProvider,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) (ServiceResolver, error) {
+	resolver, err := monitor.GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return NewShardRebalanceResolver(resolver, planProvider, timeSource, logger), nil
+}
+
+// NewShardRebalancePlan returns plan which moves surplus shards, those with highest IDs, of hosts above
+// target to hosts below target. Shard owners are taken from hash ring of resolver, hosts not listed in
+// target or not members of hash ring neither give nor take shards.
+func NewShardRebalancePlan(
+	resolver ServiceResolver,
+	numberOfShards int32,
+	target map[string]int32,
+	movesPerMinute int32,
+	startTime time.Time,
+) (*persistencespb.ShardRebalancePlan, error) {
+	members := make(map[string]struct{})
+	for _, member := range resolver.Members() {
+		members[member.GetAddress()] = struct{}{}
+	}
+	var addresses []string
+	for address := range target {
+		if _, ok := members[address]; ok {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	shardsByHost := make(map[string][]int32)
+	for shardID := int32(1); shardID <= numberOfShards; shardID++ {
+		host, err := resolver.Lookup(convert.Int32ToString(shardID))
+		if err != nil {
+			return nil, err
+		}
+		shardsByHost[host.GetAddress()] = append(shardsByHost[host.GetAddress()], shardID)
+	}
+
+	var surplus []int32
+	for _, address := range addresses {
+		shards := shardsByHost[address]
+		if excess := len(shards) - int(target[address]); excess > 0 {
+			surplus = append(surplus, shards[len(shards)-excess:]...)
+		}
+	}
+
+	var moves []*persistencespb.ShardMove
+	for _, address := range addresses {
+		for deficit := int(target[address]) - len(shardsByHost[address]); deficit > 0 && len(surplus) > 0; deficit-- {
+			moves = append(moves, &persistencespb.ShardMove{ShardId: surplus[0], HostAddress: address})
+			surplus = surplus[1:]
+		}
+	}
+
+	return &persistencespb.ShardRebalancePlan{
+		StartTime:      &startTime,
+		MovesPerMinute: movesPerMinute,
+		Moves:          moves,
+	}, nil
+}
+
+// AppliedShardMoves returns number of leading moves of the plan in effect at given time
+func AppliedShardMoves(plan *persistencespb.ShardRebalancePlan, now time.Time) int {
+	if plan.GetStartTime() == nil || plan.GetMovesPerMinute() <= 0 {
+		return 0
+	}
+
+	total := len(plan.GetMoves())
+	applied := common.MinInt(shardMovesBetween(plan, *plan.GetStartTime(), now), total)
+	if cancelTime := plan.GetCancelTime(); cancelTime != nil && !now.Before(*cancelTime) {
+		applied = common.MinInt(shardMovesBetween(plan, *plan.GetStartTime(), *cancelTime), total)
+		applied -= shardMovesBetween(plan, *cancelTime, now)
+	}
+	return common.MaxInt(applied, 0)
+}
+
+// shardMovesBetween returns number of moves allowed from the beginning of the minute of from
+// to the end of the minute of to
+func shardMovesBetween(plan *persistencespb.ShardRebalancePlan, from time.Time, to time.Time) int {
+	if to.Before(from) {
+		return 0
+	}
+	return int(plan.GetMovesPerMinute()) * (int(to.Sub(from)/time.Minute) + 1)
+}
+
+// Lookup returns owner of the shard
+func (r *shardRebalanceResolver) Lookup(key string) (*HostInfo, error) {
+	if host, ok := r.getOwners()[key]; ok {
+		return host, nil
+	}
+	return r.ServiceResolver.Lookup(key)
+}
+
+func (r *shardRebalanceResolver) getOwners() map[string]*HostInfo {
+	now := r.timeSource.Now()
+
+	r.Lock()
+	defer r.Unlock()
+
+	if now.Sub(r.refreshedAt) >= shardRebalanceRefreshInterval && atomic.CompareAndSwapInt32(&r.refreshing, 0, 1) {
+		go func() {
+			defer atomic.StoreInt32(&r.refreshing, 0)
+			r.refreshPlan()
+		}()
+	}
+
+	appliedMoves := AppliedShardMoves(r.plan, now)
+	if appliedMoves == 0 {
+		return nil
+	}
+	if r.owners == nil || r.owners.appliedMoves != appliedMoves || now.Sub(r.owners.computedAt) >= shardRebalanceRefreshInterval {
+		r.owners = &shardRebalanceOwners{
+			computedAt:   now,
+			appliedMoves: appliedMoves,
+			owners:       r.computeOwners(r.plan.GetMoves()[:appliedMoves]),
+		}
+	}
+	return r.owners.owners
+}
+
+func (r *shardRebalanceResolver) refreshPlan() {
+	plan, err := r.planProvider()
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		plan, err = nil, nil
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	// failed refresh keeps the last known plan, it is retried after refresh interval
+	r.refreshedAt = r.timeSource.Now()
+	if err != nil {
+		r.logger.Warn("Unable to load shard rebalance plan.", tag.Error(err))
+		return
+	}
+	r.plan = plan
+	r.owners = nil
+}
+
+// computeOwners returns shards moved to other host than the one decided by hash ring, moves to hosts
+// which are not members of hash ring are skipped
+func (r *shardRebalanceResolver) computeOwners(moves []*persistencespb.ShardMove) map[string]*HostInfo {
+	members := make(map[string]*HostInfo)
+	for _, member := range r.Members() {
+		members[member.GetAddress()] = member
+	}
+
+	owners := make(map[string]*HostInfo)
+	for _, move := range moves {
+		if host, ok := members[move.GetHostAddress()]; ok {
+			owners[convert.Int32ToString(move.GetShardId())] = host
+		}
+	}
+	return owners
+}
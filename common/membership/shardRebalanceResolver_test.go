@@ -0,0 +1,203 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+)
+
+type shardRebalanceResolverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	controller   *gomock.Controller
+	mockResolver *MockServiceResolver
+	timeSource   *clock.EventTimeSource
+	startTime    time.Time
+
+	hostA *HostInfo
+	hostB *HostInfo
+}
+
+func TestShardRebalanceResolverSuite(t *testing.T) {
+	suite.Run(t, new(shardRebalanceResolverSuite))
+}
+
+func (s *shardRebalanceResolverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockResolver = NewMockServiceResolver(s.controller)
+	s.startTime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.timeSource = clock.NewEventTimeSource().Update(s.startTime)
+
+	s.hostA = NewHostInfo("10.0.0.1:7234", nil)
+	s.hostB = NewHostInfo("10.0.0.2:7234", nil)
+
+	// hash ring assigns shards 1-6 to host A and shards 7-8 to host B
+	s.mockResolver.EXPECT().Members().Return([]*HostInfo{s.hostA, s.hostB}).AnyTimes()
+	s.mockResolver.EXPECT().Lookup(gomock.Any()).DoAndReturn(func(key string) (*HostInfo, error) {
+		shardID, err := strconv.Atoi(key)
+		s.NoError(err)
+		if shardID <= 6 {
+			return s.hostA, nil
+		}
+		return s.hostB, nil
+	}).AnyTimes()
+}
+
+func (s *shardRebalanceResolverSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *shardRebalanceResolverSuite) newResolver(plan *persistencespb.ShardRebalancePlan) *shardRebalanceResolver {
+	return NewShardRebalanceResolver(
+		s.mockResolver,
+		func() (*persistencespb.ShardRebalancePlan, error) {
+			return plan, nil
+		},
+		s.timeSource,
+		log.NewNoop(),
+	).(*shardRebalanceResolver)
+}
+
+func (s *shardRebalanceResolverSuite) newPlan(target map[string]int32, movesPerMinute int32) *persistencespb.ShardRebalancePlan {
+	plan, err := NewShardRebalancePlan(s.mockResolver, 8, target, movesPerMinute, s.startTime)
+	s.NoError(err)
+	return plan
+}
+
+func (s *shardRebalanceResolverSuite) owners(resolver ServiceResolver) []string {
+	var owners []string
+	for shardID := 1; shardID <= 8; shardID++ {
+		host, err := resolver.Lookup(strconv.Itoa(shardID))
+		s.NoError(err)
+		owners = append(owners, host.GetAddress())
+	}
+	return owners
+}
+
+func (s *shardRebalanceResolverSuite) TestNewShardRebalancePlan() {
+	a, b := s.hostA.GetAddress(), s.hostB.GetAddress()
+
+	plan := s.newPlan(map[string]int32{a: 4, b: 4}, 1)
+	s.Equal([]*persistencespb.ShardMove{{ShardId: 5, HostAddress: b}, {ShardId: 6, HostAddress: b}}, plan.GetMoves())
+	s.Equal(int32(1), plan.GetMovesPerMinute())
+	s.Equal(s.startTime, *plan.GetStartTime())
+
+	// hosts which are not members of hash ring neither give nor take shards
+	plan = s.newPlan(map[string]int32{a: 2, "10.0.0.3:7234": 4}, 1)
+	s.Empty(plan.GetMoves())
+}
+
+func (s *shardRebalanceResolverSuite) TestLookup_NoPlan() {
+	resolver := s.newResolver(nil)
+
+	a, b := s.hostA.GetAddress(), s.hostB.GetAddress()
+	s.Equal([]string{a, a, a, a, a, a, b, b}, s.owners(resolver))
+}
+
+func (s *shardRebalanceResolverSuite) TestLookup_MovesLimitedByRate() {
+	a, b := s.hostA.GetAddress(), s.hostB.GetAddress()
+	resolver := s.newResolver(s.newPlan(map[string]int32{a: 4, b: 4}, 1))
+
+	s.timeSource.Update(s.startTime.Add(-time.Second))
+	s.Equal([]string{a, a, a, a, a, a, b, b}, s.owners(resolver))
+
+	s.timeSource.Update(s.startTime.Add(30 * time.Second))
+	s.Equal([]string{a, a, a, a, b, a, b, b}, s.owners(resolver))
+
+	s.timeSource.Update(s.startTime.Add(90 * time.Second))
+	s.Equal([]string{a, a, a, a, b, b, b, b}, s.owners(resolver))
+
+	s.timeSource.Update(s.startTime.Add(time.Hour))
+	s.Equal([]string{a, a, a, a, b, b, b, b}, s.owners(resolver))
+}
+
+func (s *shardRebalanceResolverSuite) TestLookup_CancelUndoesMovesGradually() {
+	a, b := s.hostA.GetAddress(), s.hostB.GetAddress()
+	plan := s.newPlan(map[string]int32{a: 4, b: 4}, 1)
+	cancelTime := s.startTime.Add(time.Hour)
+	plan.CancelTime = &cancelTime
+	resolver := s.newResolver(plan)
+
+	s.timeSource.Update(cancelTime.Add(-time.Second))
+	s.Equal([]string{a, a, a, a, b, b, b, b}, s.owners(resolver))
+
+	s.timeSource.Update(cancelTime.Add(30 * time.Second))
+	s.Equal([]string{a, a, a, a, b, a, b, b}, s.owners(resolver))
+
+	s.timeSource.Update(cancelTime.Add(90 * time.Second))
+	s.Equal([]string{a, a, a, a, a, a, b, b}, s.owners(resolver))
+}
+
+func (s *shardRebalanceResolverSuite) TestLookup_MoveToLeftHostSkipped() {
+	a := s.hostA.GetAddress()
+	plan := &persistencespb.ShardRebalancePlan{
+		StartTime:      &s.startTime,
+		MovesPerMinute: 10,
+		Moves:          []*persistencespb.ShardMove{{ShardId: 1, HostAddress: "10.0.0.3:7234"}},
+	}
+	resolver := s.newResolver(plan)
+
+	host, err := resolver.Lookup("1")
+	s.NoError(err)
+	s.Equal(a, host.GetAddress())
+}
+
+func (s *shardRebalanceResolverSuite) TestRefreshPlan() {
+	a, b := s.hostA.GetAddress(), s.hostB.GetAddress()
+	plan := s.newPlan(map[string]int32{a: 4, b: 4}, 10)
+	var planErr error
+	resolver := NewShardRebalanceResolver(
+		s.mockResolver,
+		func() (*persistencespb.ShardRebalancePlan, error) {
+			return plan, planErr
+		},
+		s.timeSource,
+		log.NewNoop(),
+	).(*shardRebalanceResolver)
+	s.Equal([]string{a, a, a, a, b, b, b, b}, s.owners(resolver))
+
+	// failed refresh keeps last known plan
+	planErr = serviceerror.NewUnavailable("unavailable")
+	resolver.refreshPlan()
+	s.Equal([]string{a, a, a, a, b, b, b, b}, s.owners(resolver))
+
+	// missing cluster metadata means there is no plan
+	planErr = serviceerror.NewNotFound("not found")
+	resolver.refreshPlan()
+	s.Equal([]string{a, a, a, a, a, a, b, b}, s.owners(resolver))
+}
@@ -74,10 +74,11 @@ const (
 
 // Common tags for all services
 const (
-	OperationTagName   = "operation"
-	ServiceRoleTagName = "service_role"
-	StatsTypeTagName   = "stats_type"
-	CacheTypeTagName   = "cache_type"
+	OperationTagName    = "operation"
+	ServiceRoleTagName  = "service_role"
+	StatsTypeTagName    = "stats_type"
+	CacheTypeTagName    = "cache_type"
+	SQLOperationTagName = "sql_operation"
 )
 
 // This package should hold all the metrics and tags for temporal
@@ -176,6 +177,11 @@ const (
 	// PersistenceRangeCompleteVisibilityTaskScope tracks CompleteVisibilityTasks calls made by service to persistence layer
 	PersistenceRangeCompleteVisibilityTaskScope
 
+	// PersistenceGetCustomTasksScope tracks GetCustomTasks calls made by service to persistence layer
+	PersistenceGetCustomTasksScope
+	// PersistenceRangeCompleteCustomTaskScope tracks RangeCompleteCustomTask calls made by service to persistence layer
+	PersistenceRangeCompleteCustomTaskScope
+
 	// PersistenceGetReplicationTaskScope tracks GetReplicationTask calls made by service to persistence layer
 	PersistenceGetReplicationTaskScope
 	// PersistenceGetReplicationTasksScope tracks GetReplicationTasks calls made by service to persistence layer
@@ -364,6 +370,14 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientAnnotateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientAnnotateWorkflowExecutionScope
+	// HistoryClientUpsertWorkflowSearchAttributesScope tracks RPC calls to history service
+	HistoryClientUpsertWorkflowSearchAttributesScope
+	// HistoryClientApplyOperatorActionScope tracks RPC calls to history service
+	HistoryClientApplyOperatorActionScope
+	// HistoryClientGetReplicationEventChunkScope tracks RPC calls to history service
+	HistoryClientGetReplicationEventChunkScope
 	// MatchingClientPollWorkflowTaskQueueScope tracks RPC calls to matching service
 	MatchingClientPollWorkflowTaskQueueScope
 	// MatchingClientPollActivityTaskQueueScope tracks RPC calls to matching service
@@ -494,6 +508,44 @@ const (
 	AdminClientRefreshWorkflowTasksScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
+	// AdminClientGetIntakeOutcomeScope tracks RPC calls to admin service
+	AdminClientGetIntakeOutcomeScope
+	// AdminClientGetShardOwnershipReportScope tracks RPC calls to admin service
+	AdminClientGetShardOwnershipReportScope
+	// AdminClientStartShardRebalanceScope tracks RPC calls to admin service
+	AdminClientStartShardRebalanceScope
+	// AdminClientCancelShardRebalanceScope tracks RPC calls to admin service
+	AdminClientCancelShardRebalanceScope
+	// AdminClientRotateTLSCertificateScope tracks RPC calls to admin service
+	AdminClientRotateTLSCertificateScope
+	// AdminClientDescribeTLSRotationScope tracks RPC calls to admin service
+	AdminClientDescribeTLSRotationScope
+	// AdminClientAnnotateWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientAnnotateWorkflowExecutionScope
+	// AdminClientApplyOperatorActionScope tracks RPC calls to admin service
+	AdminClientApplyOperatorActionScope
+	// AdminClientGetReplicationEventChunkScope tracks RPC calls to admin service
+	AdminClientGetReplicationEventChunkScope
+	// AdminClientStartReplayCheckScope tracks RPC calls to admin service
+	AdminClientStartReplayCheckScope
+	// AdminClientDescribeReplayCheckScope tracks RPC calls to admin service
+	AdminClientDescribeReplayCheckScope
+	// AdminClientListNamespaceRegistrationsScope tracks RPC calls to admin service
+	AdminClientListNamespaceRegistrationsScope
+	// AdminClientApproveNamespaceRegistrationScope tracks RPC calls to admin service
+	AdminClientApproveNamespaceRegistrationScope
+	// AdminClientRejectNamespaceRegistrationScope tracks RPC calls to admin service
+	AdminClientRejectNamespaceRegistrationScope
+	// AdminClientListHistoryBranchesScope tracks RPC calls to admin service
+	AdminClientListHistoryBranchesScope
+	// AdminClientGetTaskQueueAutoscalingHintScope tracks RPC calls to admin service
+	AdminClientGetTaskQueueAutoscalingHintScope
+	// AdminClientRecordConsistencyMarkerScope tracks RPC calls to admin service
+	AdminClientRecordConsistencyMarkerScope
+	// AdminClientListConsistencyMarkersScope tracks RPC calls to admin service
+	AdminClientListConsistencyMarkersScope
+	// AdminClientGetCertificateChainsScope tracks RPC calls to admin service
+	AdminClientGetCertificateChainsScope
 	// DCRedirectionDeprecateNamespaceScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateNamespaceScope
 	// DCRedirectionDescribeNamespaceScope tracks RPC calls for dc redirection
@@ -642,6 +694,9 @@ const (
 	// ElasticsearchDeleteWorkflowExecutionsScope tracks DeleteWorkflowExecution calls made by service to persistence layer
 	ElasticsearchDeleteWorkflowExecutionsScope
 
+	// PersistenceSQLStatementScope tracks statements executed by SQL persistence plugins
+	PersistenceSQLStatementScope
+
 	// SequentialTaskProcessingScope is used by sequential task processing logic
 	SequentialTaskProcessingScope
 	// ParallelTaskProcessingScope is used by parallel task processing logic
@@ -707,6 +762,44 @@ const (
 	AdminPurgeDLQMessagesScope
 	// AdminMergeDLQMessagesScope is the metric scope for admin.AdminMergeDLQMessagesScope
 	AdminMergeDLQMessagesScope
+	// AdminGetIntakeOutcomeScope is the metric scope for admin.GetIntakeOutcome
+	AdminGetIntakeOutcomeScope
+	// AdminGetShardOwnershipReportScope is the metric scope for admin.GetShardOwnershipReport
+	AdminGetShardOwnershipReportScope
+	// AdminStartShardRebalanceScope is the metric scope for admin.StartShardRebalance
+	AdminStartShardRebalanceScope
+	// AdminCancelShardRebalanceScope is the metric scope for admin.CancelShardRebalance
+	AdminCancelShardRebalanceScope
+	// AdminRotateTLSCertificateScope is the metric scope for admin.RotateTLSCertificate
+	AdminRotateTLSCertificateScope
+	// AdminDescribeTLSRotationScope is the metric scope for admin.DescribeTLSRotation
+	AdminDescribeTLSRotationScope
+	// AdminAnnotateWorkflowExecutionScope is the metric scope for admin.AnnotateWorkflowExecution
+	AdminAnnotateWorkflowExecutionScope
+	// AdminApplyOperatorActionScope is the metric scope for admin.ApplyOperatorAction
+	AdminApplyOperatorActionScope
+	// AdminGetReplicationEventChunkScope is the metric scope for admin.GetReplicationEventChunk
+	AdminGetReplicationEventChunkScope
+	// AdminStartReplayCheckScope is the metric scope for admin.StartReplayCheck
+	AdminStartReplayCheckScope
+	// AdminDescribeReplayCheckScope is the metric scope for admin.DescribeReplayCheck
+	AdminDescribeReplayCheckScope
+	// AdminListNamespaceRegistrationsScope is the metric scope for admin.ListNamespaceRegistrations
+	AdminListNamespaceRegistrationsScope
+	// AdminApproveNamespaceRegistrationScope is the metric scope for admin.ApproveNamespaceRegistration
+	AdminApproveNamespaceRegistrationScope
+	// AdminRejectNamespaceRegistrationScope is the metric scope for admin.RejectNamespaceRegistration
+	AdminRejectNamespaceRegistrationScope
+	// AdminListHistoryBranchesScope is the metric scope for admin.ListHistoryBranches
+	AdminListHistoryBranchesScope
+	// AdminGetTaskQueueAutoscalingHintScope is the metric scope for admin.GetTaskQueueAutoscalingHint
+	AdminGetTaskQueueAutoscalingHintScope
+	// AdminRecordConsistencyMarkerScope is the metric scope for admin.RecordConsistencyMarker
+	AdminRecordConsistencyMarkerScope
+	// AdminListConsistencyMarkersScope is the metric scope for admin.ListConsistencyMarkers
+	AdminListConsistencyMarkersScope
+	// AdminGetCertificateChainsScope is the metric scope for admin.GetCertificateChains
+	AdminGetCertificateChainsScope
 
 	NumAdminScopes
 )
@@ -795,6 +888,8 @@ const (
 	VersionCheckScope
 	// AuthorizationScope is the scope used by all metric emitted by authorization code
 	AuthorizationScope
+	// CallerRateLimiterScope is the scope used by frontend per caller rate limiter
+	CallerRateLimiterScope
 
 	NumFrontendScopes
 )
@@ -865,6 +960,14 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope is the scope used by refresh workflow tasks API
 	HistoryRefreshWorkflowTasksScope
+	// HistoryAnnotateWorkflowExecutionScope is the scope used by AnnotateWorkflowExecution API
+	HistoryAnnotateWorkflowExecutionScope
+	// HistoryUpsertWorkflowSearchAttributesScope is the scope used by UpsertWorkflowSearchAttributes API
+	HistoryUpsertWorkflowSearchAttributesScope
+	// HistoryApplyOperatorActionScope is the scope used by ApplyOperatorAction API
+	HistoryApplyOperatorActionScope
+	// HistoryGetReplicationEventChunkScope is the scope used by GetReplicationEventChunk API
+	HistoryGetReplicationEventChunkScope
 	// TaskPriorityAssignerScope is the scope used by all metric emitted by task priority assigner
 	TaskPriorityAssignerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -921,6 +1024,9 @@ const (
 	// VisibilityTaskDeleteExecutionScope is the scope used for delete by visibility queue processor
 	VisibilityTaskDeleteExecutionScope
 
+	// CustomQueueProcessorScope is the scope used by all metric emitted by the queue processors of custom task categories
+	CustomQueueProcessorScope
+
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerActiveQueueProcessorScope is the scope used by all metric emitted by timer queue processor
@@ -941,6 +1047,8 @@ const (
 	TimerActiveTaskWorkflowBackoffTimerScope
 	// TimerActiveTaskDeleteHistoryEventScope is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerActiveTaskDeleteHistoryEventScope
+	// TimerActiveTaskStuckExecutionCheckScope is the scope used by metric emitted by timer queue processor for processing stuck execution checks
+	TimerActiveTaskStuckExecutionCheckScope
 	// TimerStandbyTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
 	TimerStandbyTaskActivityTimeoutScope
 	// TimerStandbyTaskWorkflowTaskTimeoutScope is the scope used by metric emitted by timer queue processor for processing workflow task timeouts
@@ -955,6 +1063,8 @@ const (
 	TimerStandbyTaskDeleteHistoryEventScope
 	// TimerStandbyTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerStandbyTaskWorkflowBackoffTimerScope
+	// TimerStandbyTaskStuckExecutionCheckScope is the scope used by metric emitted by timer queue processor for processing stuck execution checks
+	TimerStandbyTaskStuckExecutionCheckScope
 	// HistoryEventNotificationScope is the scope used by shard history event nitification
 	HistoryEventNotificationScope
 	// ReplicatorQueueProcessorScope is the scope used by all metric emitted by replicator queue processor
@@ -1060,6 +1170,8 @@ const (
 	ESProcessorScope
 	// IndexProcessorScope is scope used by all metric emitted by index processor
 	IndexProcessorScope
+	// IndexRolloverScope is scope used by all metric emitted by index rollover manager
+	IndexRolloverScope
 	// ArchiverDeleteHistoryActivityScope is scope used by all metrics emitted by archiver.DeleteHistoryActivity
 	ArchiverDeleteHistoryActivityScope
 	// ArchiverUploadHistoryActivityScope is scope used by all metrics emitted by archiver.UploadHistoryActivity
@@ -1082,6 +1194,28 @@ const (
 	HistoryScavengerScope
 	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
 	ParentClosePolicyProcessorScope
+	// ScannerLeaderElectionScope is scope used by all metrics emitted by leader election of worker.Scanner
+	ScannerLeaderElectionScope
+	// BatcherLeaderElectionScope is scope used by all metrics emitted by leader election of worker.Batcher
+	BatcherLeaderElectionScope
+	// CanaryLeaderElectionScope is scope used by all metrics emitted by leader election of worker.Canary
+	CanaryLeaderElectionScope
+	// CanaryTimerScope is scope used by metrics of timer probe of worker.Canary
+	CanaryTimerScope
+	// CanaryActivityScope is scope used by metrics of activity probe of worker.Canary
+	CanaryActivityScope
+	// CanaryChildWorkflowScope is scope used by metrics of child workflow probe of worker.Canary
+	CanaryChildWorkflowScope
+	// CanarySignalScope is scope used by metrics of signal probe of worker.Canary
+	CanarySignalScope
+	// CanaryQueryScope is scope used by metrics of query probe of worker.Canary
+	CanaryQueryScope
+	// CanaryCronScope is scope used by metrics of cron probe of worker.Canary
+	CanaryCronScope
+	// IntakeProcessorScope is scope used by all metrics emitted by worker.IntakeProcessor
+	IntakeProcessorScope
+	// IntakeProcessorLeaderElectionScope is scope used by all metrics emitted by leader election of worker.IntakeProcessor
+	IntakeProcessorLeaderElectionScope
 
 	NumWorkerScopes
 )
@@ -1111,6 +1245,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetVisibilityTasksScope:                       {operation: "GetVisibilityTasks"},
 		PersistenceCompleteVisibilityTaskScope:                   {operation: "CompleteVisibilityTask"},
 		PersistenceRangeCompleteVisibilityTaskScope:              {operation: "RangeCompleteVisibilityTask"},
+		PersistenceGetCustomTasksScope:                           {operation: "GetCustomTasks"},
+		PersistenceRangeCompleteCustomTaskScope:                  {operation: "RangeCompleteCustomTask"},
 		PersistenceGetReplicationTaskScope:                       {operation: "GetReplicationTask"},
 		PersistenceGetReplicationTasksScope:                      {operation: "GetReplicationTasks"},
 		PersistenceCompleteReplicationTaskScope:                  {operation: "CompleteReplicationTask"},
@@ -1216,6 +1352,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientAnnotateWorkflowExecutionScope:           {operation: "HistoryClientAnnotateWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpsertWorkflowSearchAttributesScope:      {operation: "HistoryClientUpsertWorkflowSearchAttributes", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientApplyOperatorActionScope:                 {operation: "HistoryClientApplyOperatorAction", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientGetReplicationEventChunkScope:            {operation: "HistoryClientGetReplicationEventChunk", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollWorkflowTaskQueueScope:              {operation: "MatchingClientPollWorkflowTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollActivityTaskQueueScope:              {operation: "MatchingClientPollActivityTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
@@ -1277,6 +1417,25 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetIntakeOutcomeScope:                      {operation: "AdminClientGetIntakeOutcome", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetShardOwnershipReportScope:               {operation: "AdminClientGetShardOwnershipReport", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStartShardRebalanceScope:                   {operation: "AdminClientStartShardRebalance", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientCancelShardRebalanceScope:                  {operation: "AdminClientCancelShardRebalance", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRotateTLSCertificateScope:                  {operation: "AdminClientRotateTLSCertificate", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeTLSRotationScope:                   {operation: "AdminClientDescribeTLSRotation", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientAnnotateWorkflowExecutionScope:             {operation: "AdminClientAnnotateWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientApplyOperatorActionScope:                   {operation: "AdminClientApplyOperatorAction", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetReplicationEventChunkScope:              {operation: "AdminClientGetReplicationEventChunk", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStartReplayCheckScope:                      {operation: "AdminClientStartReplayCheck", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeReplayCheckScope:                   {operation: "AdminClientDescribeReplayCheck", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListNamespaceRegistrationsScope:            {operation: "AdminClientListNamespaceRegistrations", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientApproveNamespaceRegistrationScope:          {operation: "AdminClientApproveNamespaceRegistration", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRejectNamespaceRegistrationScope:           {operation: "AdminClientRejectNamespaceRegistration", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListHistoryBranchesScope:                   {operation: "AdminClientListHistoryBranches", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetTaskQueueAutoscalingHintScope:           {operation: "AdminClientGetTaskQueueAutoscalingHint", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRecordConsistencyMarkerScope:               {operation: "AdminClientRecordConsistencyMarker", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListConsistencyMarkersScope:                {operation: "AdminClientListConsistencyMarkers", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetCertificateChainsScope:                  {operation: "AdminClientGetCertificateChains", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetDLQMessagesScope:                        {operation: "AdminClientGetDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
@@ -1346,6 +1505,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ElasticsearchScanWorkflowExecutionsScope:                   {operation: "ScanWorkflowExecutions"},
 		ElasticsearchCountWorkflowExecutionsScope:                  {operation: "CountWorkflowExecutions"},
 		ElasticsearchDeleteWorkflowExecutionsScope:                 {operation: "DeleteWorkflowExecution"},
+		PersistenceSQLStatementScope:                               {operation: "SQLStatement"},
 		SequentialTaskProcessingScope:                              {operation: "SequentialTaskProcessing"},
 		ParallelTaskProcessingScope:                                {operation: "ParallelTaskProcessing"},
 		TaskSchedulerScope:                                         {operation: "TaskScheduler"},
@@ -1379,6 +1539,25 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminReapplyEventsScope:                    {operation: "ReapplyEvents"},
 		AdminRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
 		AdminResendReplicationTasksScope:           {operation: "ResendReplicationTasks"},
+		AdminGetIntakeOutcomeScope:                 {operation: "GetIntakeOutcome"},
+		AdminGetShardOwnershipReportScope:          {operation: "GetShardOwnershipReport"},
+		AdminStartShardRebalanceScope:              {operation: "StartShardRebalance"},
+		AdminCancelShardRebalanceScope:             {operation: "CancelShardRebalance"},
+		AdminRotateTLSCertificateScope:             {operation: "RotateTLSCertificate"},
+		AdminDescribeTLSRotationScope:              {operation: "DescribeTLSRotation"},
+		AdminAnnotateWorkflowExecutionScope:        {operation: "AnnotateWorkflowExecution"},
+		AdminApplyOperatorActionScope:              {operation: "ApplyOperatorAction"},
+		AdminGetReplicationEventChunkScope:         {operation: "GetReplicationEventChunk"},
+		AdminStartReplayCheckScope:                 {operation: "StartReplayCheck"},
+		AdminDescribeReplayCheckScope:              {operation: "DescribeReplayCheck"},
+		AdminListNamespaceRegistrationsScope:       {operation: "ListNamespaceRegistrations"},
+		AdminApproveNamespaceRegistrationScope:     {operation: "ApproveNamespaceRegistration"},
+		AdminRejectNamespaceRegistrationScope:      {operation: "RejectNamespaceRegistration"},
+		AdminListHistoryBranchesScope:              {operation: "ListHistoryBranches"},
+		AdminGetTaskQueueAutoscalingHintScope:      {operation: "GetTaskQueueAutoscalingHint"},
+		AdminRecordConsistencyMarkerScope:          {operation: "RecordConsistencyMarker"},
+		AdminListConsistencyMarkersScope:           {operation: "ListConsistencyMarkers"},
+		AdminGetCertificateChainsScope:             {operation: "GetCertificateChains"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollWorkflowTaskQueueScope:              {operation: "PollWorkflowTaskQueue"},
@@ -1421,6 +1600,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendGetSearchAttributesScope:                {operation: "GetSearchAttributes"},
 		VersionCheckScope:                               {operation: "VersionCheck"},
 		AuthorizationScope:                              {operation: "Authorization"},
+		CallerRateLimiterScope:                          {operation: "CallerRateLimiter"},
 	},
 	// History Scope Names
 	History: {
@@ -1459,6 +1639,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryShardControllerScope:                            {operation: "ShardController"},
 		HistoryReapplyEventsScope:                              {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                       {operation: "RefreshWorkflowTasks"},
+		HistoryAnnotateWorkflowExecutionScope:                  {operation: "AnnotateWorkflowExecution"},
+		HistoryUpsertWorkflowSearchAttributesScope:             {operation: "UpsertWorkflowSearchAttributes"},
+		HistoryApplyOperatorActionScope:                        {operation: "ApplyOperatorAction"},
+		HistoryGetReplicationEventChunkScope:                   {operation: "GetReplicationEventChunk"},
 		TaskPriorityAssignerScope:                              {operation: "TaskPriorityAssigner"},
 		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                      {operation: "TransferActiveQueueProcessor"},
@@ -1488,6 +1672,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		VisibilityTaskCloseExecutionScope:  {operation: "VisibilityTaskCloseExecution"},
 		VisibilityTaskDeleteExecutionScope: {operation: "VisibilityTaskDeleteExecution"},
 
+		CustomQueueProcessorScope: {operation: "CustomQueueProcessor"},
+
 		TimerQueueProcessorScope:                  {operation: "TimerQueueProcessor"},
 		TimerActiveQueueProcessorScope:            {operation: "TimerActiveQueueProcessor"},
 		TimerStandbyQueueProcessorScope:           {operation: "TimerStandbyQueueProcessor"},
@@ -1498,6 +1684,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerActiveTaskActivityRetryTimerScope:    {operation: "TimerActiveTaskActivityRetryTimer"},
 		TimerActiveTaskWorkflowBackoffTimerScope:  {operation: "TimerActiveTaskWorkflowBackoffTimer"},
 		TimerActiveTaskDeleteHistoryEventScope:    {operation: "TimerActiveTaskDeleteHistoryEvent"},
+		TimerActiveTaskStuckExecutionCheckScope:   {operation: "TimerActiveTaskStuckExecutionCheck"},
 		TimerStandbyTaskActivityTimeoutScope:      {operation: "TimerStandbyTaskActivityTimeout"},
 		TimerStandbyTaskWorkflowTaskTimeoutScope:  {operation: "TimerStandbyTaskWorkflowTaskTimeout"},
 		TimerStandbyTaskUserTimerScope:            {operation: "TimerStandbyTaskUserTimer"},
@@ -1505,6 +1692,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerStandbyTaskActivityRetryTimerScope:   {operation: "TimerStandbyTaskActivityRetryTimer"},
 		TimerStandbyTaskWorkflowBackoffTimerScope: {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
 		TimerStandbyTaskDeleteHistoryEventScope:   {operation: "TimerStandbyTaskDeleteHistoryEvent"},
+		TimerStandbyTaskStuckExecutionCheckScope:  {operation: "TimerStandbyTaskStuckExecutionCheck"},
 		HistoryEventNotificationScope:             {operation: "HistoryEventNotification"},
 		ReplicatorQueueProcessorScope:             {operation: "ReplicatorQueueProcessor"},
 		ReplicatorTaskHistoryScope:                {operation: "ReplicatorTaskHistory"},
@@ -1559,6 +1747,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		SyncActivityTaskScope:                  {operation: "SyncActivityTask"},
 		ESProcessorScope:                       {operation: "ESProcessor"},
 		IndexProcessorScope:                    {operation: "IndexProcessor"},
+		IndexRolloverScope:                     {operation: "IndexRollover"},
 		ArchiverDeleteHistoryActivityScope:     {operation: "ArchiverDeleteHistoryActivity"},
 		ArchiverUploadHistoryActivityScope:     {operation: "ArchiverUploadHistoryActivity"},
 		ArchiverArchiveVisibilityActivityScope: {operation: "ArchiverArchiveVisibilityActivity"},
@@ -1570,6 +1759,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryScavengerScope:                  {operation: "historyscavenger"},
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
+		ScannerLeaderElectionScope:             {operation: "ScannerLeaderElection"},
+		BatcherLeaderElectionScope:             {operation: "BatcherLeaderElection"},
+		CanaryLeaderElectionScope:              {operation: "CanaryLeaderElection"},
+		CanaryTimerScope:                       {operation: "CanaryTimer"},
+		CanaryActivityScope:                    {operation: "CanaryActivity"},
+		CanaryChildWorkflowScope:               {operation: "CanaryChildWorkflow"},
+		CanarySignalScope:                      {operation: "CanarySignal"},
+		CanaryQueryScope:                       {operation: "CanaryQuery"},
+		CanaryCronScope:                        {operation: "CanaryCron"},
+		IntakeProcessorScope:                   {operation: "IntakeProcessor"},
+		IntakeProcessorLeaderElectionScope:     {operation: "IntakeProcessorLeaderElection"},
 	},
 }
 
@@ -1609,6 +1809,11 @@ const (
 	PersistenceErrNamespaceAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
 	PersistenceSampledCounter
+	PersistenceDuplicateHistoryAppendCounter
+	PersistenceHistoryChecksumMismatchCounter
+	PersistenceSQLStatementRequests
+	PersistenceSQLStatementFailures
+	PersistenceSQLStatementLatency
 
 	ClientRequests
 	ClientFailures
@@ -1620,12 +1825,18 @@ const (
 
 	ServiceAuthorizationLatency
 
+	NamespaceQuotaUtilization
+	NamespaceQuotaWarningCounter
+
 	NamespaceCachePrepareCallbacksLatency
 	NamespaceCacheCallbacksLatency
 
 	HistorySize
 	HistoryCount
 	EventBlobSize
+	EventBlobSizeNearLimit
+	EventBlobSizeExceedsLimit
+	FailureTruncated
 
 	ArchivalConfigFailures
 
@@ -1714,6 +1925,7 @@ const (
 	TaskDiscarded
 	TaskAttemptTimer
 	TaskStandbyRetryCounter
+	TaskShedCounter
 	TaskNotActiveCounter
 	TaskLimitExceededCounter
 	TaskBatchCompleteCounter
@@ -1723,6 +1935,10 @@ const (
 
 	TransferTaskMissingEventCounter
 
+	VisibilityLoadSheddingGauge
+	VisibilityLoadSheddingTransitionCounter
+	VisibilityQueueLag
+
 	TransferTaskThrottledCounter
 	TimerTaskThrottledCounter
 
@@ -1748,6 +1964,9 @@ const (
 	StaleMutableStateCounter
 	AutoResetPointsLimitExceededCounter
 	AutoResetPointCorruptionCounter
+	HistoryBranchCount
+	HistoryBranchLimitExceededCounter
+	SignalDeadLetteredCounter
 	ConcurrencyUpdateFailureCounter
 	ServiceErrTaskAlreadyStartedCounter
 	ServiceErrShardOwnershipLostCounter
@@ -1804,6 +2023,8 @@ const (
 	CacheLatency
 	CacheMissCounter
 	AcquireLockFailedCounter
+	AcquireLockLatency
+	AcquireLockContendedCounter
 	WorkflowContextCleared
 	MutableStateSize
 	ExecutionInfoSize
@@ -1849,6 +2070,12 @@ const (
 	ReplicationTasksLag
 	ReplicationTasksFetched
 	ReplicationTasksReturned
+	ReplicationTasksOversized
+	ReplicationEventBatchOversized
+	ReplicationTasksChunked
+	ReplicationChunksFetched
+	ReplicationChunkedTasksAssembled
+	ReplicationChunkChecksumFailures
 	ReplicationTasksAppliedLatency
 	ReplicationDLQFailed
 	ReplicationDLQMaxLevelGauge
@@ -1876,6 +2103,8 @@ const (
 	ReplicationTaskCleanupFailure
 	MutableStateChecksumMismatch
 	MutableStateChecksumInvalidated
+	StuckExecutionsDetectedCount
+	StuckExecutionsRecoveredCount
 
 	ESBulkProcessorRequests
 	ESBulkProcessorRetries
@@ -1915,6 +2144,15 @@ const (
 	LocalToRemoteMatchPerTaskQueueCounter
 	RemoteToLocalMatchPerTaskQueueCounter
 	RemoteToRemoteMatchPerTaskQueueCounter
+	ParkedPollersPerTaskQueueGauge
+	PollShedPerTaskQueueCounter
+	RecommendedWorkersPerTaskQueueGauge
+	WorkerUtilizationPerTaskQueueGauge
+	DispatchRatePerTaskQueueGauge
+	BacklogGrowthRatePerTaskQueueGauge
+	TaskTombstonesPerTaskQueueCounter
+	TaskRangeDeletesPerTaskQueueCounter
+	TaskPointDeletesPerTaskQueueCounter
 
 	NumMatchingMetrics
 )
@@ -1933,6 +2171,8 @@ const (
 	ESProcessorProcessMsgLatency
 	IndexProcessorCorruptedData
 	IndexProcessorProcessMsgLatency
+	IndexRolloverCount
+	IndexRolloverFailures
 	ArchiverNonRetryableErrorCount
 	ArchiverStartedCount
 	ArchiverStoppedCount
@@ -1977,6 +2217,16 @@ const (
 	ParentClosePolicyProcessorSuccess
 	ParentClosePolicyProcessorFailures
 	NamespaceReplicationEnqueueDLQCount
+	LeadershipAcquiredCount
+	LeadershipLostCount
+	LeaderElectionFailures
+	IsLeaderGauge
+	CanarySuccessCount
+	CanaryFailureCount
+	CanaryLatency
+	IntakeProcessedCount
+	IntakeRejectedCount
+	IntakeLag
 
 	NumWorkerMetrics
 )
@@ -2018,6 +2268,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrNamespaceAlreadyExistsCounter:         {metricName: "persistence_errors_namespace_already_exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence_errors_bad_request", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence_sampled", metricType: Counter},
+		PersistenceDuplicateHistoryAppendCounter:            {metricName: "persistence_duplicate_history_append", metricType: Counter},
+		PersistenceHistoryChecksumMismatchCounter:           {metricName: "persistence_history_checksum_mismatch", metricType: Counter},
+		PersistenceSQLStatementRequests:                     {metricName: "persistence_sql_statement_requests", metricType: Counter},
+		PersistenceSQLStatementFailures:                     {metricName: "persistence_sql_statement_errors", metricType: Counter},
+		PersistenceSQLStatementLatency:                      {metricName: "persistence_sql_statement_latency", metricType: Timer},
 		ClientRequests:                                      {metricName: "client_requests", metricType: Counter},
 		ClientFailures:                                      {metricName: "client_errors", metricType: Counter},
 		ClientLatency:                                       {metricName: "client_latency", metricType: Timer},
@@ -2025,11 +2280,16 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ClientRedirectionFailures:                           {metricName: "client_redirection_errors", metricType: Counter},
 		ClientRedirectionLatency:                            {metricName: "client_redirection_latency", metricType: Timer},
 		ServiceAuthorizationLatency:                         {metricName: "service_authorization_latency", metricType: Timer},
+		NamespaceQuotaUtilization:                           {metricName: "namespace_quota_utilization", metricType: Gauge},
+		NamespaceQuotaWarningCounter:                        {metricName: "namespace_quota_warning", metricType: Counter},
 		NamespaceCachePrepareCallbacksLatency:               {metricName: "namespace_cache_prepare_callbacks_latency", metricType: Timer},
 		NamespaceCacheCallbacksLatency:                      {metricName: "namespace_cache_callbacks_latency", metricType: Timer},
 		HistorySize:                                         {metricName: "history_size", metricType: Timer},
 		HistoryCount:                                        {metricName: "history_count", metricType: Timer},
 		EventBlobSize:                                       {metricName: "event_blob_size", metricType: Timer},
+		EventBlobSizeNearLimit:                              {metricName: "event_blob_size_near_limit", metricType: Counter},
+		EventBlobSizeExceedsLimit:                           {metricName: "event_blob_size_exceeds_limit", metricType: Counter},
+		FailureTruncated:                                    {metricName: "failure_truncated", metricType: Counter},
 		ArchivalConfigFailures:                              {metricName: "archivalconfig_failures", metricType: Counter},
 		ElasticsearchRequests:                               {metricName: "elasticsearch_requests", metricType: Counter},
 		ElasticsearchFailures:                               {metricName: "elasticsearch_errors", metricType: Counter},
@@ -2143,12 +2403,16 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskFailures:                                      {metricName: "task_errors", metricType: Counter},
 		TaskDiscarded:                                     {metricName: "task_errors_discarded", metricType: Counter},
 		TaskStandbyRetryCounter:                           {metricName: "task_errors_standby_retry_counter", metricType: Counter},
+		TaskShedCounter:                                   {metricName: "task_errors_shed_counter", metricType: Counter},
 		TaskNotActiveCounter:                              {metricName: "task_errors_not_active_counter", metricType: Counter},
 		TaskLimitExceededCounter:                          {metricName: "task_errors_limit_exceeded_counter", metricType: Counter},
 		TaskProcessingLatency:                             {metricName: "task_latency_processing", metricType: Timer},
 		TaskQueueLatency:                                  {metricName: "task_latency_queue", metricType: Timer},
 		TransferTaskMissingEventCounter:                   {metricName: "transfer_task_missing_event_counter", metricType: Counter},
 		TaskBatchCompleteCounter:                          {metricName: "task_batch_complete_counter", metricType: Counter},
+		VisibilityLoadSheddingGauge:                       {metricName: "visibility_load_shedding", metricType: Gauge},
+		VisibilityLoadSheddingTransitionCounter:           {metricName: "visibility_load_shedding_transition", metricType: Counter},
+		VisibilityQueueLag:                                {metricName: "visibility_queue_lag", metricType: Timer},
 		TaskRedispatchQueuePendingTasksTimer:              {metricName: "task_redispatch_queue_pending_tasks", metricType: Timer},
 		TransferTaskThrottledCounter:                      {metricName: "transfer_task_throttled_counter", metricType: Counter},
 		TimerTaskThrottledCounter:                         {metricName: "timer_task_throttled_counter", metricType: Counter},
@@ -2174,6 +2438,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		StaleMutableStateCounter:                          {metricName: "stale_mutable_state", metricType: Counter},
 		AutoResetPointsLimitExceededCounter:               {metricName: "auto_reset_points_exceed_limit", metricType: Counter},
 		AutoResetPointCorruptionCounter:                   {metricName: "auto_reset_point_corruption", metricType: Counter},
+		HistoryBranchCount:                                {metricName: "history_branch_count", metricType: Timer},
+		HistoryBranchLimitExceededCounter:                 {metricName: "history_branch_limit_exceeded", metricType: Counter},
+		SignalDeadLetteredCounter:                         {metricName: "signal_dead_lettered", metricType: Counter},
 		ConcurrencyUpdateFailureCounter:                   {metricName: "concurrency_update_failure", metricType: Counter},
 		ServiceErrShardOwnershipLostCounter:               {metricName: "service_errors_shard_ownership_lost", metricType: Counter},
 		ServiceErrTaskAlreadyStartedCounter:               {metricName: "service_errors_task_already_started", metricType: Counter},
@@ -2230,6 +2497,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CacheLatency:                                      {metricName: "cache_latency", metricType: Timer},
 		CacheMissCounter:                                  {metricName: "cache_miss", metricType: Counter},
 		AcquireLockFailedCounter:                          {metricName: "acquire_lock_failed", metricType: Counter},
+		AcquireLockLatency:                                {metricName: "acquire_lock_latency", metricType: Timer},
+		AcquireLockContendedCounter:                       {metricName: "acquire_lock_contended", metricType: Counter},
 		WorkflowContextCleared:                            {metricName: "workflow_context_cleared", metricType: Counter},
 		MutableStateSize:                                  {metricName: "mutable_state_size", metricType: Timer},
 		ExecutionInfoSize:                                 {metricName: "execution_info_size", metricType: Timer},
@@ -2275,6 +2544,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTasksLag:                               {metricName: "replication_tasks_lag", metricType: Timer},
 		ReplicationTasksFetched:                           {metricName: "replication_tasks_fetched", metricType: Timer},
 		ReplicationTasksReturned:                          {metricName: "replication_tasks_returned", metricType: Timer},
+		ReplicationTasksOversized:                         {metricName: "replication_tasks_oversized", metricType: Counter},
+		ReplicationEventBatchOversized:                    {metricName: "replication_event_batch_oversized", metricType: Counter},
+		ReplicationTasksChunked:                           {metricName: "replication_tasks_chunked", metricType: Counter},
+		ReplicationChunksFetched:                          {metricName: "replication_chunks_fetched", metricType: Counter},
+		ReplicationChunkedTasksAssembled:                  {metricName: "replication_chunked_tasks_assembled", metricType: Counter},
+		ReplicationChunkChecksumFailures:                  {metricName: "replication_chunk_checksum_failures", metricType: Counter},
 		ReplicationTasksAppliedLatency:                    {metricName: "replication_tasks_applied_latency", metricType: Timer},
 		ReplicationDLQFailed:                              {metricName: "replication_dlq_enqueue_failed", metricType: Counter},
 		ReplicationDLQMaxLevelGauge:                       {metricName: "replication_dlq_max_level", metricType: Gauge},
@@ -2302,6 +2577,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTaskCleanupFailure:                     {metricName: "replication_task_cleanup_failed", metricType: Counter},
 		MutableStateChecksumMismatch:                      {metricName: "mutable_state_checksum_mismatch", metricType: Counter},
 		MutableStateChecksumInvalidated:                   {metricName: "mutable_state_checksum_invalidated", metricType: Counter},
+		StuckExecutionsDetectedCount:                      {metricName: "stuck_executions_detected", metricType: Counter},
+		StuckExecutionsRecoveredCount:                     {metricName: "stuck_executions_recovered", metricType: Counter},
 
 		ESBulkProcessorRequests:       {metricName: "es_bulk_processor_requests"},
 		ESBulkProcessorRetries:        {metricName: "es_bulk_processor_retries"},
@@ -2337,6 +2614,15 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		LocalToRemoteMatchPerTaskQueueCounter:     {metricName: "local_to_remote_matches_per_tl", metricRollupName: "local_to_remote_matches"},
 		RemoteToLocalMatchPerTaskQueueCounter:     {metricName: "remote_to_local_matches_per_tl", metricRollupName: "remote_to_local_matches"},
 		RemoteToRemoteMatchPerTaskQueueCounter:    {metricName: "remote_to_remote_matches_per_tl", metricRollupName: "remote_to_remote_matches"},
+		ParkedPollersPerTaskQueueGauge:            {metricName: "parked_pollers_per_tl", metricType: Gauge},
+		PollShedPerTaskQueueCounter:               {metricName: "poll_shed_per_tl", metricRollupName: "poll_shed"},
+		RecommendedWorkersPerTaskQueueGauge:       {metricName: "recommended_workers_per_tl", metricType: Gauge},
+		WorkerUtilizationPerTaskQueueGauge:        {metricName: "worker_utilization_per_tl", metricType: Gauge},
+		DispatchRatePerTaskQueueGauge:             {metricName: "dispatch_rate_per_tl", metricType: Gauge},
+		BacklogGrowthRatePerTaskQueueGauge:        {metricName: "backlog_growth_rate_per_tl", metricType: Gauge},
+		TaskTombstonesPerTaskQueueCounter:         {metricName: "task_tombstones_per_tl", metricRollupName: "task_tombstones"},
+		TaskRangeDeletesPerTaskQueueCounter:       {metricName: "task_range_deletes_per_tl", metricRollupName: "task_range_deletes"},
+		TaskPointDeletesPerTaskQueueCounter:       {metricName: "task_point_deletes_per_tl", metricRollupName: "task_point_deletes"},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
@@ -2351,6 +2637,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ESProcessorProcessMsgLatency:                  {metricName: "es_processor_process_msg_latency", metricType: Timer},
 		IndexProcessorCorruptedData:                   {metricName: "index_processor_corrupted_data"},
 		IndexProcessorProcessMsgLatency:               {metricName: "index_processor_process_msg_latency", metricType: Timer},
+		IndexRolloverCount:                            {metricName: "index_rollover", metricType: Counter},
+		IndexRolloverFailures:                         {metricName: "index_rollover_errors", metricType: Counter},
 		ArchiverNonRetryableErrorCount:                {metricName: "archiver_non_retryable_error"},
 		ArchiverStartedCount:                          {metricName: "archiver_started"},
 		ArchiverStoppedCount:                          {metricName: "archiver_stopped"},
@@ -2395,6 +2683,16 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ParentClosePolicyProcessorSuccess:             {metricName: "parent_close_policy_processor_requests", metricType: Counter},
 		ParentClosePolicyProcessorFailures:            {metricName: "parent_close_policy_processor_errors", metricType: Counter},
 		NamespaceReplicationEnqueueDLQCount:           {metricName: "namespace_replication_dlq_enqueue_requests", metricType: Counter},
+		LeadershipAcquiredCount:                       {metricName: "leadership_acquired", metricType: Counter},
+		LeadershipLostCount:                           {metricName: "leadership_lost", metricType: Counter},
+		LeaderElectionFailures:                        {metricName: "leader_election_errors", metricType: Counter},
+		IsLeaderGauge:                                 {metricName: "is_leader", metricType: Gauge},
+		CanarySuccessCount:                            {metricName: "canary_success", metricType: Counter},
+		CanaryFailureCount:                            {metricName: "canary_failures", metricType: Counter},
+		CanaryLatency:                                 {metricName: "canary_latency", metricType: Timer},
+		IntakeProcessedCount:                          {metricName: "intake_processed", metricType: Counter},
+		IntakeRejectedCount:                           {metricName: "intake_rejected", metricType: Counter},
+		IntakeLag:                                     {metricName: "intake_lag", metricType: Timer},
 	},
 }
 
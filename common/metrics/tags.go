@@ -91,6 +91,10 @@ type (
 	statsTypeTag struct {
 		value string
 	}
+
+	sqlOperationTag struct {
+		value string
+	}
 )
 
 // NamespaceTag returns a new namespace tag. For timers, this also ensures that we
@@ -283,3 +287,21 @@ func (d statsTypeTag) Key() string {
 func (d statsTypeTag) Value() string {
 	return d.value
 }
+
+// SQLOperationTag returns a new tag of the logical operation a SQL statement is executed by
+func SQLOperationTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return sqlOperationTag{value}
+}
+
+// Key returns the key of the SQL operation tag
+func (d sqlOperationTag) Key() string {
+	return SQLOperationTagName
+}
+
+// Value returns the value of the SQL operation tag
+func (d sqlOperationTag) Value() string {
+	return d.value
+}
@@ -396,6 +396,43 @@ func (_m *ExecutionManager) RangeCompleteVisibilityTask(request *persistence.Ran
 	return r0
 }
 
+// GetCustomTasks provides a mock function with given fields: request
+func (_m *ExecutionManager) GetCustomTasks(request *persistence.GetCustomTasksRequest) (*persistence.GetCustomTasksResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetCustomTasksResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetCustomTasksRequest) *persistence.GetCustomTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetCustomTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetCustomTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RangeCompleteCustomTask provides a mock function with given fields: request
+func (_m *ExecutionManager) RangeCompleteCustomTask(request *persistence.RangeCompleteCustomTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.RangeCompleteCustomTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetReplicationTask provides a mock function with given fields: request
 func (_m *ExecutionManager) GetReplicationTask(request *persistence.GetReplicationTaskRequest) (*persistence.GetReplicationTaskResponse, error) {
 	ret := _m.Called(request)
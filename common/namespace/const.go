@@ -24,10 +24,16 @@
 
 package namespace
 
+import (
+	"time"
+)
+
 const (
 	// MinRetentionDays is the minimal retention days for any namespace
 	MinRetentionDays = 1
 
 	// MaxBadBinaries is the maximal number of bad client binaries stored in a namespace
 	MaxBadBinaries = 10
+
+	archivalURIProbeTimeout = 10 * time.Second
 )
@@ -74,11 +74,25 @@ type (
 			ctx context.Context,
 			updateRequest *workflowservice.UpdateNamespaceRequest,
 		) (*workflowservice.UpdateNamespaceResponse, error)
+		ListRegistrations(
+			ctx context.Context,
+			pageSize int,
+			pageToken []byte,
+		) ([]*persistence.NamespaceRegistration, []byte, error)
+		ApproveRegistration(
+			ctx context.Context,
+			registrationID int64,
+		) error
+		RejectRegistration(
+			ctx context.Context,
+			registrationID int64,
+		) error
 	}
 
 	// HandlerImpl is the namespace operation handler implementation
 	HandlerImpl struct {
 		maxBadBinaryCount      dynamicconfig.IntPropertyFnWithNamespaceFilter
+		enableArchivalURIProbe dynamicconfig.BoolPropertyFn
 		logger                 log.Logger
 		metadataMgr            persistence.MetadataManager
 		clusterMetadata        cluster.Metadata
@@ -86,24 +100,36 @@ type (
 		namespaceAttrValidator *AttrValidatorImpl
 		archivalMetadata       archiver.ArchivalMetadata
 		archiverProvider       provider.ArchiverProvider
+		registrationApprover   RegistrationApprover
+		registrationStore      persistence.NamespaceRegistrationStore
 	}
 )
 
+const (
+	// maxPendingNamespaceRegistrations bounds the number of registrations which wait for approval
+	maxPendingNamespaceRegistrations      = 1000
+	pendingNamespaceRegistrationsPageSize = 100
+)
+
 var _ Handler = (*HandlerImpl)(nil)
 
 // NewHandler create a new namespace handler
 func NewHandler(
 	minRetentionDays int,
 	maxBadBinaryCount dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	enableArchivalURIProbe dynamicconfig.BoolPropertyFn,
 	logger log.Logger,
 	metadataMgr persistence.MetadataManager,
 	clusterMetadata cluster.Metadata,
 	namespaceReplicator Replicator,
 	archivalMetadata archiver.ArchivalMetadata,
 	archiverProvider provider.ArchiverProvider,
+	registrationApprover RegistrationApprover,
+	registrationStore persistence.NamespaceRegistrationStore,
 ) *HandlerImpl {
 	return &HandlerImpl{
 		maxBadBinaryCount:      maxBadBinaryCount,
+		enableArchivalURIProbe: enableArchivalURIProbe,
 		logger:                 logger,
 		metadataMgr:            metadataMgr,
 		clusterMetadata:        clusterMetadata,
@@ -111,15 +137,26 @@ func NewHandler(
 		namespaceAttrValidator: newAttrValidator(clusterMetadata, int32(minRetentionDays)),
 		archivalMetadata:       archivalMetadata,
 		archiverProvider:       archiverProvider,
+		registrationApprover:   registrationApprover,
+		registrationStore:      registrationStore,
 	}
 }
 
 // RegisterNamespace register a new namespace
 func (d *HandlerImpl) RegisterNamespace(
-	_ context.Context,
+	ctx context.Context,
 	registerRequest *workflowservice.RegisterNamespaceRequest,
 ) (*workflowservice.RegisterNamespaceResponse, error) {
 
+	return d.registerNamespace(ctx, registerRequest, true)
+}
+
+func (d *HandlerImpl) registerNamespace(
+	ctx context.Context,
+	registerRequest *workflowservice.RegisterNamespaceRequest,
+	review bool,
+) (*workflowservice.RegisterNamespaceResponse, error) {
+
 	if !d.clusterMetadata.IsGlobalNamespaceEnabled() {
 		if registerRequest.GetIsGlobalNamespace() {
 			return nil, serviceerror.NewInvalidArgument("Cannot register global namespace when not enabled")
@@ -146,6 +183,12 @@ func (d *HandlerImpl) RegisterNamespace(
 		return nil, err
 	}
 
+	if review {
+		if err := d.reviewRegistration(ctx, registerRequest); err != nil {
+			return nil, err
+		}
+	}
+
 	var activeClusterName string
 	// input validation on cluster names
 	if registerRequest.GetActiveClusterName() != "" {
@@ -178,6 +221,9 @@ func (d *HandlerImpl) RegisterNamespace(
 		if err != nil {
 			return nil, err
 		}
+		if err := d.probeArchivalURI(currentHistoryArchivalState, nextHistoryArchivalState, d.getHistoryArchiver); err != nil {
+			return nil, err
+		}
 	}
 
 	currentVisibilityArchivalState := neverEnabledState()
@@ -198,6 +244,9 @@ func (d *HandlerImpl) RegisterNamespace(
 		if err != nil {
 			return nil, err
 		}
+		if err := d.probeArchivalURI(currentVisibilityArchivalState, nextVisibilityArchivalState, d.getVisibilityArchiver); err != nil {
+			return nil, err
+		}
 	}
 
 	info := &persistencespb.NamespaceInfo{
@@ -283,6 +332,166 @@ func (d *HandlerImpl) RegisterNamespace(
 	return &workflowservice.RegisterNamespaceResponse{}, nil
 }
 
+func (d *HandlerImpl) reviewRegistration(
+	ctx context.Context,
+	registerRequest *workflowservice.RegisterNamespaceRequest,
+) error {
+
+	if d.registrationApprover == nil {
+		return nil
+	}
+	requester, isAdmin := getRegistrationRequester(ctx)
+	if isAdmin {
+		return nil
+	}
+
+	decision, err := d.registrationApprover.Review(ctx, requester, registerRequest)
+	if err != nil {
+		d.logger.Error("Unable to review namespace registration.",
+			tag.WorkflowNamespace(registerRequest.GetNamespace()),
+			tag.Error(err),
+		)
+		return serviceerror.NewUnavailable(fmt.Sprintf("Unable to review namespace registration: %v.", err))
+	}
+
+	switch decision {
+	case RegistrationDecisionApproved:
+		// the request may have been pending before, it does not wait for approval anymore
+		registration, _, err := d.findPendingRegistration(registerRequest.GetNamespace())
+		if err != nil {
+			return err
+		}
+		if registration != nil {
+			return d.registrationStore.DeleteRegistration(registration.RegistrationID)
+		}
+		return nil
+	case RegistrationDecisionPending:
+		registrationID, err := d.addPendingRegistration(requester, registerRequest)
+		if err != nil {
+			return err
+		}
+		d.logger.Info("Namespace registration is pending approval.",
+			tag.WorkflowNamespace(registerRequest.GetNamespace()),
+			tag.NamespaceRegistrationID(registrationID),
+		)
+		return serviceerror.NewPermissionDenied(
+			fmt.Sprintf("Namespace registration is pending approval, registration ID: %d.", registrationID),
+		)
+	default:
+		return errNamespaceRegistrationRejected
+	}
+}
+
+// addPendingRegistration persists the registration unless the namespace already waits for approval
+func (d *HandlerImpl) addPendingRegistration(
+	requester string,
+	registerRequest *workflowservice.RegisterNamespaceRequest,
+) (int64, error) {
+
+	registration, count, err := d.findPendingRegistration(registerRequest.GetNamespace())
+	if err != nil {
+		return 0, err
+	}
+	if registration != nil {
+		return registration.RegistrationID, nil
+	}
+	if count >= maxPendingNamespaceRegistrations {
+		return 0, errTooManyPendingNamespaceRegistrations
+	}
+
+	return d.registrationStore.AddRegistration(&persistence.NamespaceRegistration{
+		Requester:   requester,
+		Request:     registerRequest,
+		CreatedTime: time.Now().UTC(),
+	})
+}
+
+// findPendingRegistration returns the pending registration of the namespace and the number of registrations scanned
+func (d *HandlerImpl) findPendingRegistration(
+	namespace string,
+) (*persistence.NamespaceRegistration, int, error) {
+
+	count := 0
+	var pageToken []byte
+	for count < maxPendingNamespaceRegistrations {
+		registrations, nextPageToken, err := d.registrationStore.ListRegistrations(pendingNamespaceRegistrationsPageSize, pageToken)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, registration := range registrations {
+			if registration.Request.GetNamespace() == namespace {
+				return registration, count, nil
+			}
+			count++
+		}
+		if len(nextPageToken) == 0 {
+			break
+		}
+		pageToken = nextPageToken
+	}
+	return nil, count, nil
+}
+
+// ListRegistrations lists registrations which wait for approval
+func (d *HandlerImpl) ListRegistrations(
+	_ context.Context,
+	pageSize int,
+	pageToken []byte,
+) ([]*persistence.NamespaceRegistration, []byte, error) {
+
+	if pageSize <= 0 {
+		pageSize = pendingNamespaceRegistrationsPageSize
+	}
+	return d.registrationStore.ListRegistrations(pageSize, pageToken)
+}
+
+// ApproveRegistration registers the namespace of a pending registration, the request is not reviewed again
+func (d *HandlerImpl) ApproveRegistration(
+	ctx context.Context,
+	registrationID int64,
+) error {
+
+	registration, err := d.registrationStore.GetRegistration(registrationID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := d.registerNamespace(ctx, registration.Request, false); err != nil {
+		if _, ok := err.(*serviceerror.NamespaceAlreadyExists); !ok {
+			return err
+		}
+		// the namespace was registered after the registration got pending, it cannot be approved anymore
+		if deleteErr := d.registrationStore.DeleteRegistration(registrationID); deleteErr != nil {
+			return deleteErr
+		}
+		return err
+	}
+
+	d.logger.Info("Namespace registration is approved.",
+		tag.WorkflowNamespace(registration.Request.GetNamespace()),
+		tag.NamespaceRegistrationID(registrationID),
+	)
+	return d.registrationStore.DeleteRegistration(registrationID)
+}
+
+// RejectRegistration deletes a pending registration
+func (d *HandlerImpl) RejectRegistration(
+	_ context.Context,
+	registrationID int64,
+) error {
+
+	registration, err := d.registrationStore.GetRegistration(registrationID)
+	if err != nil {
+		return err
+	}
+
+	d.logger.Info("Namespace registration is rejected.",
+		tag.WorkflowNamespace(registration.Request.GetNamespace()),
+		tag.NamespaceRegistrationID(registrationID),
+	)
+	return d.registrationStore.DeleteRegistration(registrationID)
+}
+
 // ListNamespaces list all namespaces
 func (d *HandlerImpl) ListNamespaces(
 	ctx context.Context,
@@ -395,6 +604,9 @@ func (d *HandlerImpl) UpdateNamespace(
 		if err != nil {
 			return nil, err
 		}
+		if err := d.probeArchivalURI(currentHistoryArchivalState, nextHistoryArchivalState, d.getHistoryArchiver); err != nil {
+			return nil, err
+		}
 	}
 
 	currentVisibilityArchivalState := &ArchivalState{
@@ -414,6 +626,9 @@ func (d *HandlerImpl) UpdateNamespace(
 		if err != nil {
 			return nil, err
 		}
+		if err := d.probeArchivalURI(currentVisibilityArchivalState, nextVisibilityArchivalState, d.getVisibilityArchiver); err != nil {
+			return nil, err
+		}
 	}
 
 	// whether active cluster is changed
@@ -737,6 +952,10 @@ func (d *HandlerImpl) validateHistoryArchivalURI(URIString string) error {
 		return err
 	}
 
+	if err := d.archivalMetadata.GetHistoryConfig().ValidateDestination(URI); err != nil {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+
 	archiver, err := d.archiverProvider.GetHistoryArchiver(URI.Scheme(), common.FrontendServiceName)
 	if err != nil {
 		return err
@@ -751,6 +970,10 @@ func (d *HandlerImpl) validateVisibilityArchivalURI(URIString string) error {
 		return err
 	}
 
+	if err := d.archivalMetadata.GetVisibilityConfig().ValidateDestination(URI); err != nil {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+
 	archiver, err := d.archiverProvider.GetVisibilityArchiver(URI.Scheme(), common.FrontendServiceName)
 	if err != nil {
 		return err
@@ -758,3 +981,47 @@ func (d *HandlerImpl) validateVisibilityArchivalURI(URIString string) error {
 
 	return archiver.ValidateURI(URI)
 }
+
+func (d *HandlerImpl) getHistoryArchiver(scheme string) (interface{}, error) {
+	return d.archiverProvider.GetHistoryArchiver(scheme, common.FrontendServiceName)
+}
+
+func (d *HandlerImpl) getVisibilityArchiver(scheme string) (interface{}, error) {
+	return d.archiverProvider.GetVisibilityArchiver(scheme, common.FrontendServiceName)
+}
+
+// probeArchivalURI checks end-to-end access to the URI of the next archival state if probing is enabled,
+// the archiver supports it and the change enables archival or its URI, so that broken credentials are
+// reported when archival is configured. Namespace updates which keep or disable archival are not probed.
+func (d *HandlerImpl) probeArchivalURI(
+	current *ArchivalState,
+	next *ArchivalState,
+	getArchiver func(scheme string) (interface{}, error),
+) error {
+	if !d.enableArchivalURIProbe() || next == nil || next.State != enumspb.ARCHIVAL_STATE_ENABLED {
+		return nil
+	}
+	if current != nil && current.State == enumspb.ARCHIVAL_STATE_ENABLED && current.URI == next.URI {
+		return nil
+	}
+
+	URI, err := archiver.NewURI(next.URI)
+	if err != nil {
+		return err
+	}
+	a, err := getArchiver(URI.Scheme())
+	if err != nil {
+		return err
+	}
+	prober, ok := a.(archiver.URIProber)
+	if !ok {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), archivalURIProbeTimeout)
+	defer cancel()
+	if err := prober.ProbeURI(ctx, URI); err != nil {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Archival URI %v is not accessible: %v", URI, err))
+	}
+	return nil
+}
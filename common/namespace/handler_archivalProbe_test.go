@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	archivalProbeSuite struct {
+		suite.Suite
+
+		handler *HandlerImpl
+		prober  *testURIProber
+	}
+
+	testURIProber struct {
+		probed []string
+		err    error
+	}
+)
+
+const testProbeURI = "test://archival/URI"
+
+func TestArchivalProbeSuite(t *testing.T) {
+	s := new(archivalProbeSuite)
+	suite.Run(t, s)
+}
+
+func (s *archivalProbeSuite) SetupTest() {
+	s.handler = &HandlerImpl{
+		enableArchivalURIProbe: dynamicconfig.GetBoolPropertyFn(true),
+	}
+	s.prober = &testURIProber{}
+}
+
+func (s *archivalProbeSuite) TestProbeArchivalURI_Enabled() {
+	testCases := []*ArchivalState{
+		neverEnabledState(),
+		{State: enumspb.ARCHIVAL_STATE_DISABLED, URI: testProbeURI},
+		{State: enumspb.ARCHIVAL_STATE_ENABLED, URI: "test://another/URI"},
+	}
+	for _, current := range testCases {
+		s.prober.probed = nil
+		next := &ArchivalState{State: enumspb.ARCHIVAL_STATE_ENABLED, URI: testProbeURI}
+		s.NoError(s.handler.probeArchivalURI(current, next, s.getArchiver))
+		s.Equal([]string{testProbeURI}, s.prober.probed)
+	}
+}
+
+func (s *archivalProbeSuite) TestProbeArchivalURI_NotEnabled() {
+	enabled := &ArchivalState{State: enumspb.ARCHIVAL_STATE_ENABLED, URI: testProbeURI}
+	disabled := &ArchivalState{State: enumspb.ARCHIVAL_STATE_DISABLED, URI: testProbeURI}
+
+	s.NoError(s.handler.probeArchivalURI(enabled, enabled, s.getArchiver))
+	s.NoError(s.handler.probeArchivalURI(enabled, disabled, s.getArchiver))
+	s.NoError(s.handler.probeArchivalURI(neverEnabledState(), disabled, s.getArchiver))
+	s.NoError(s.handler.probeArchivalURI(neverEnabledState(), neverEnabledState(), s.getArchiver))
+	s.Empty(s.prober.probed)
+}
+
+func (s *archivalProbeSuite) TestProbeArchivalURI_OptIn() {
+	s.handler.enableArchivalURIProbe = dynamicconfig.GetBoolPropertyFn(false)
+	next := &ArchivalState{State: enumspb.ARCHIVAL_STATE_ENABLED, URI: testProbeURI}
+	s.NoError(s.handler.probeArchivalURI(neverEnabledState(), next, s.getArchiver))
+	s.Empty(s.prober.probed)
+}
+
+func (s *archivalProbeSuite) TestProbeArchivalURI_NotSupported() {
+	next := &ArchivalState{State: enumspb.ARCHIVAL_STATE_ENABLED, URI: testProbeURI}
+	s.NoError(s.handler.probeArchivalURI(neverEnabledState(), next, func(string) (interface{}, error) {
+		return struct{}{}, nil
+	}))
+}
+
+func (s *archivalProbeSuite) TestProbeArchivalURI_Failed() {
+	s.prober.err = errors.New("access denied")
+	next := &ArchivalState{State: enumspb.ARCHIVAL_STATE_ENABLED, URI: testProbeURI}
+	err := s.handler.probeArchivalURI(neverEnabledState(), next, s.getArchiver)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Contains(err.Error(), "access denied")
+}
+
+func (s *archivalProbeSuite) getArchiver(scheme string) (interface{}, error) {
+	s.Equal("test", scheme)
+	return s.prober, nil
+}
+
+func (p *testURIProber) ProbeURI(_ context.Context, URI archiver.URI) error {
+	p.probed = append(p.probed, URI.String())
+	return p.err
+}
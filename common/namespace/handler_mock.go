@@ -34,6 +34,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	workflowservice "go.temporal.io/api/workflowservice/v1"
+	persistence "go.temporal.io/server/common/persistence"
 )
 
 // MockHandler is a mock of Handler interface.
@@ -59,6 +60,20 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// ApproveRegistration mocks base method.
+func (m *MockHandler) ApproveRegistration(ctx context.Context, registrationID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveRegistration", ctx, registrationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveRegistration indicates an expected call of ApproveRegistration.
+func (mr *MockHandlerMockRecorder) ApproveRegistration(ctx, registrationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveRegistration", reflect.TypeOf((*MockHandler)(nil).ApproveRegistration), ctx, registrationID)
+}
+
 // DeprecateNamespace mocks base method.
 func (m *MockHandler) DeprecateNamespace(ctx context.Context, deprecateRequest *workflowservice.DeprecateNamespaceRequest) (*workflowservice.DeprecateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -104,6 +119,22 @@ func (mr *MockHandlerMockRecorder) ListNamespaces(ctx, listRequest interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaces", reflect.TypeOf((*MockHandler)(nil).ListNamespaces), ctx, listRequest)
 }
 
+// ListRegistrations mocks base method.
+func (m *MockHandler) ListRegistrations(ctx context.Context, pageSize int, pageToken []byte) ([]*persistence.NamespaceRegistration, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegistrations", ctx, pageSize, pageToken)
+	ret0, _ := ret[0].([]*persistence.NamespaceRegistration)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRegistrations indicates an expected call of ListRegistrations.
+func (mr *MockHandlerMockRecorder) ListRegistrations(ctx, pageSize, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegistrations", reflect.TypeOf((*MockHandler)(nil).ListRegistrations), ctx, pageSize, pageToken)
+}
+
 // RegisterNamespace mocks base method.
 func (m *MockHandler) RegisterNamespace(ctx context.Context, registerRequest *workflowservice.RegisterNamespaceRequest) (*workflowservice.RegisterNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -119,6 +150,20 @@ func (mr *MockHandlerMockRecorder) RegisterNamespace(ctx, registerRequest interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNamespace", reflect.TypeOf((*MockHandler)(nil).RegisterNamespace), ctx, registerRequest)
 }
 
+// RejectRegistration mocks base method.
+func (m *MockHandler) RejectRegistration(ctx context.Context, registrationID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectRegistration", ctx, registrationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectRegistration indicates an expected call of RejectRegistration.
+func (mr *MockHandlerMockRecorder) RejectRegistration(ctx, registrationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectRegistration", reflect.TypeOf((*MockHandler)(nil).RejectRegistration), ctx, registrationID)
+}
+
 // UpdateNamespace mocks base method.
 func (m *MockHandler) UpdateNamespace(ctx context.Context, updateRequest *workflowservice.UpdateNamespaceRequest) (*workflowservice.UpdateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -110,17 +110,22 @@ func (s *namespaceHandlerCommonSuite) SetupTest() {
 		"",
 		false,
 		&config.ArchivalNamespaceDefaults{},
+		nil,
+		nil,
 	)
 	s.mockArchiverProvider = &provider.MockArchiverProvider{}
 	s.handler = NewHandler(
 		s.minRetentionDays,
 		dc.GetIntPropertyFilteredByNamespace(s.maxBadBinaryCount),
+		dc.GetBoolPropertyFn(true),
 		logger,
 		s.metadataMgr,
 		s.ClusterMetadata,
 		s.mockNamespaceReplicator,
 		s.archivalMetadata,
 		s.mockArchiverProvider,
+		nil,
+		nil,
 	)
 }
 
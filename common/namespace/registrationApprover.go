@@ -0,0 +1,190 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination registrationApprover_mock.go
+
+package namespace
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/codec"
+)
+
+type (
+	// RegistrationDecision is the outcome of the review of a namespace registration request
+	RegistrationDecision int
+
+	// RegistrationApprover reviews namespace registration requests made by callers without system admin role.
+	// Pending requests are persisted until they are confirmed either by the approver returning
+	// RegistrationDecisionApproved when the caller retries, or by an admin through the admin API.
+	RegistrationApprover interface {
+		Review(
+			ctx context.Context,
+			requester string,
+			registerRequest *workflowservice.RegisterNamespaceRequest,
+		) (RegistrationDecision, error)
+	}
+
+	manualRegistrationApprover struct{}
+
+	webhookRegistrationApprover struct {
+		url        string
+		httpClient *http.Client
+		encoder    *codec.JSONPBEncoder
+	}
+
+	webhookRegistrationRequest struct {
+		Requester string          `json:"requester"`
+		Request   json.RawMessage `json:"request"`
+	}
+
+	webhookRegistrationResponse struct {
+		Decision string `json:"decision"`
+	}
+)
+
+const (
+	// RegistrationDecisionApproved means namespace can be registered
+	RegistrationDecisionApproved RegistrationDecision = iota + 1
+	// RegistrationDecisionPending means request is recorded and waits for approval
+	RegistrationDecisionPending
+	// RegistrationDecisionRejected means namespace must not be registered
+	RegistrationDecisionRejected
+)
+
+const (
+	defaultRegistrationWebhookTimeout = 10 * time.Second
+
+	webhookDecisionApproved = "approved"
+	webhookDecisionPending  = "pending"
+	webhookDecisionRejected = "rejected"
+)
+
+var _ RegistrationApprover = (*manualRegistrationApprover)(nil)
+var _ RegistrationApprover = (*webhookRegistrationApprover)(nil)
+
+// NewManualRegistrationApprover creates a registration approver which leaves every request pending,
+// pending requests are approved or rejected through the admin API.
+func NewManualRegistrationApprover() RegistrationApprover {
+	return &manualRegistrationApprover{}
+}
+
+// Review returns RegistrationDecisionPending for every request
+func (a *manualRegistrationApprover) Review(
+	_ context.Context,
+	_ string,
+	_ *workflowservice.RegisterNamespaceRequest,
+) (RegistrationDecision, error) {
+	return RegistrationDecisionPending, nil
+}
+
+// NewWebhookRegistrationApprover creates a registration approver which posts every request to the webhook url.
+// The webhook must respond with HTTP 200 and a JSON body {"decision": "approved"|"pending"|"rejected"}.
+func NewWebhookRegistrationApprover(
+	url string,
+	timeout time.Duration,
+) RegistrationApprover {
+	if timeout <= 0 {
+		timeout = defaultRegistrationWebhookTimeout
+	}
+	return &webhookRegistrationApprover{
+		url:        url,
+		httpClient: &http.Client{Timeout: timeout},
+		encoder:    codec.NewJSONPBEncoder(),
+	}
+}
+
+// Review posts registration request to the webhook and converts the response to decision
+func (a *webhookRegistrationApprover) Review(
+	ctx context.Context,
+	requester string,
+	registerRequest *workflowservice.RegisterNamespaceRequest,
+) (RegistrationDecision, error) {
+
+	request, err := a.encoder.Encode(registerRequest)
+	if err != nil {
+		return 0, err
+	}
+	body, err := json.Marshal(webhookRegistrationRequest{
+		Requester: requester,
+		Request:   request,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.httpClient.Do(httpRequest)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("namespace registration webhook returned status %d", resp.StatusCode)
+	}
+
+	var webhookResponse webhookRegistrationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&webhookResponse); err != nil {
+		return 0, err
+	}
+
+	switch webhookResponse.Decision {
+	case webhookDecisionApproved:
+		return RegistrationDecisionApproved, nil
+	case webhookDecisionPending:
+		return RegistrationDecisionPending, nil
+	case webhookDecisionRejected:
+		return RegistrationDecisionRejected, nil
+	default:
+		return 0, fmt.Errorf("namespace registration webhook returned unknown decision %q", webhookResponse.Decision)
+	}
+}
+
+// getRegistrationRequester returns the subject of the caller and whether the caller is exempt from review.
+// Without authorization claims callers can't be told apart, so registrations are not gated.
+func getRegistrationRequester(
+	ctx context.Context,
+) (string, bool) {
+
+	claims, ok := ctx.Value(authorization.ContextKeyMappedClaims).(*authorization.Claims)
+	if !ok || claims == nil {
+		return "", true
+	}
+	return claims.Subject, claims.System&authorization.RoleAdmin != 0
+}
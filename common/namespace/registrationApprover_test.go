@@ -0,0 +1,246 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	registrationApproverSuite struct {
+		suite.Suite
+
+		controller          *gomock.Controller
+		mockApprover        *MockRegistrationApprover
+		mockStore           *persistence.MockNamespaceRegistrationStore
+		mockClusterMetadata *cluster.MockMetadata
+		mockMetadataMgr     *mocks.MetadataManager
+		handler             *HandlerImpl
+	}
+)
+
+func TestRegistrationApproverSuite(t *testing.T) {
+	s := new(registrationApproverSuite)
+	suite.Run(t, s)
+}
+
+func (s *registrationApproverSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockApprover = NewMockRegistrationApprover(s.controller)
+	s.mockStore = persistence.NewMockNamespaceRegistrationStore(s.controller)
+	s.mockClusterMetadata = cluster.NewMockMetadata(s.controller)
+	s.mockMetadataMgr = &mocks.MetadataManager{}
+	s.handler = &HandlerImpl{
+		logger:               loggerimpl.NewNopLogger(),
+		metadataMgr:          s.mockMetadataMgr,
+		clusterMetadata:      s.mockClusterMetadata,
+		registrationApprover: s.mockApprover,
+		registrationStore:    s.mockStore,
+	}
+}
+
+func (s *registrationApproverSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockMetadataMgr.AssertExpectations(s.T())
+}
+
+func (s *registrationApproverSuite) TestReviewRegistration_NoApprover() {
+	s.handler.registrationApprover = nil
+	s.NoError(s.handler.reviewRegistration(context.Background(), &workflowservice.RegisterNamespaceRequest{}))
+}
+
+func (s *registrationApproverSuite) TestReviewRegistration_AdminBypass() {
+	ctx := context.WithValue(context.Background(), authorization.ContextKeyMappedClaims, &authorization.Claims{
+		Subject: "admin",
+		System:  authorization.RoleAdmin,
+	})
+	s.NoError(s.handler.reviewRegistration(ctx, &workflowservice.RegisterNamespaceRequest{}))
+}
+
+func (s *registrationApproverSuite) TestReviewRegistration_NoClaims() {
+	s.NoError(s.handler.reviewRegistration(context.Background(), &workflowservice.RegisterNamespaceRequest{}))
+}
+
+func (s *registrationApproverSuite) TestReviewRegistration_Decisions() {
+	ctx := s.userContext()
+	request := &workflowservice.RegisterNamespaceRequest{Namespace: "test-namespace"}
+
+	s.mockApprover.EXPECT().Review(ctx, "user", request).Return(RegistrationDecisionApproved, nil)
+	s.mockStore.EXPECT().ListRegistrations(pendingNamespaceRegistrationsPageSize, nil).Return(nil, nil, nil)
+	s.NoError(s.handler.reviewRegistration(ctx, request))
+
+	s.mockApprover.EXPECT().Review(ctx, "user", request).Return(RegistrationDecisionPending, nil)
+	s.mockStore.EXPECT().ListRegistrations(pendingNamespaceRegistrationsPageSize, nil).Return(nil, nil, nil)
+	s.mockStore.EXPECT().AddRegistration(gomock.Any()).DoAndReturn(func(registration *persistence.NamespaceRegistration) (int64, error) {
+		s.Equal("user", registration.Requester)
+		s.Equal(request, registration.Request)
+		return 7, nil
+	})
+	err := s.handler.reviewRegistration(ctx, request)
+	s.IsType(&serviceerror.PermissionDenied{}, err)
+	s.Contains(err.Error(), "registration ID: 7")
+
+	s.mockApprover.EXPECT().Review(ctx, "user", request).Return(RegistrationDecisionRejected, nil)
+	s.Equal(errNamespaceRegistrationRejected, s.handler.reviewRegistration(ctx, request))
+
+	s.mockApprover.EXPECT().Review(ctx, "user", request).Return(RegistrationDecision(0), errors.New("some error"))
+	s.IsType(&serviceerror.Unavailable{}, s.handler.reviewRegistration(ctx, request))
+}
+
+func (s *registrationApproverSuite) TestReviewRegistration_AlreadyPending() {
+	ctx := s.userContext()
+	request := &workflowservice.RegisterNamespaceRequest{Namespace: "test-namespace"}
+
+	s.mockApprover.EXPECT().Review(ctx, "user", request).Return(RegistrationDecisionPending, nil)
+	s.mockStore.EXPECT().ListRegistrations(pendingNamespaceRegistrationsPageSize, nil).Return(
+		[]*persistence.NamespaceRegistration{s.newRegistration(3, "other-namespace")}, []byte{1}, nil,
+	)
+	s.mockStore.EXPECT().ListRegistrations(pendingNamespaceRegistrationsPageSize, []byte{1}).Return(
+		[]*persistence.NamespaceRegistration{s.newRegistration(5, "test-namespace")}, nil, nil,
+	)
+	err := s.handler.reviewRegistration(ctx, request)
+	s.IsType(&serviceerror.PermissionDenied{}, err)
+	s.Contains(err.Error(), "registration ID: 5")
+}
+
+func (s *registrationApproverSuite) TestReviewRegistration_TooManyPending() {
+	ctx := s.userContext()
+	request := &workflowservice.RegisterNamespaceRequest{Namespace: "test-namespace"}
+
+	registrations := make([]*persistence.NamespaceRegistration, pendingNamespaceRegistrationsPageSize)
+	for i := range registrations {
+		registrations[i] = s.newRegistration(int64(i+1), "other-namespace")
+	}
+	s.mockApprover.EXPECT().Review(ctx, "user", request).Return(RegistrationDecisionPending, nil)
+	s.mockStore.EXPECT().ListRegistrations(pendingNamespaceRegistrationsPageSize, gomock.Any()).Return(
+		registrations, []byte{1}, nil,
+	).Times(maxPendingNamespaceRegistrations / pendingNamespaceRegistrationsPageSize)
+	s.Equal(errTooManyPendingNamespaceRegistrations, s.handler.reviewRegistration(ctx, request))
+}
+
+func (s *registrationApproverSuite) TestReviewRegistration_ApprovedDeletesPending() {
+	ctx := s.userContext()
+	request := &workflowservice.RegisterNamespaceRequest{Namespace: "test-namespace"}
+
+	s.mockApprover.EXPECT().Review(ctx, "user", request).Return(RegistrationDecisionApproved, nil)
+	s.mockStore.EXPECT().ListRegistrations(pendingNamespaceRegistrationsPageSize, nil).Return(
+		[]*persistence.NamespaceRegistration{s.newRegistration(5, "test-namespace")}, nil, nil,
+	)
+	s.mockStore.EXPECT().DeleteRegistration(int64(5)).Return(nil)
+	s.NoError(s.handler.reviewRegistration(ctx, request))
+}
+
+func (s *registrationApproverSuite) TestApproveRegistration_NamespaceExists() {
+	s.mockStore.EXPECT().GetRegistration(int64(5)).Return(s.newRegistration(5, "test-namespace"), nil)
+	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(false)
+	s.mockMetadataMgr.On("GetNamespace", &persistence.GetNamespaceRequest{Name: "test-namespace"}).
+		Return(&persistence.GetNamespaceResponse{}, nil).Once()
+	s.mockStore.EXPECT().DeleteRegistration(int64(5)).Return(nil)
+
+	err := s.handler.ApproveRegistration(context.Background(), 5)
+	s.IsType(&serviceerror.NamespaceAlreadyExists{}, err)
+}
+
+func (s *registrationApproverSuite) TestApproveRegistration_NotFound() {
+	s.mockStore.EXPECT().GetRegistration(int64(5)).Return(nil, serviceerror.NewNotFound("not found"))
+	s.IsType(&serviceerror.NotFound{}, s.handler.ApproveRegistration(context.Background(), 5))
+}
+
+func (s *registrationApproverSuite) TestRejectRegistration() {
+	s.mockStore.EXPECT().GetRegistration(int64(5)).Return(s.newRegistration(5, "test-namespace"), nil)
+	s.mockStore.EXPECT().DeleteRegistration(int64(5)).Return(nil)
+	s.NoError(s.handler.RejectRegistration(context.Background(), 5))
+}
+
+func (s *registrationApproverSuite) TestWebhookRegistrationApprover() {
+	var decision string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Requester string                 `json:"requester"`
+			Request   map[string]interface{} `json:"request"`
+		}
+		s.NoError(json.NewDecoder(r.Body).Decode(&body))
+		s.Equal("user", body.Requester)
+		s.Equal("test-namespace", body.Request["namespace"])
+		_ = json.NewEncoder(w).Encode(map[string]string{"decision": decision})
+	}))
+	defer server.Close()
+
+	approver := NewWebhookRegistrationApprover(server.URL, 0)
+	request := &workflowservice.RegisterNamespaceRequest{Namespace: "test-namespace"}
+
+	testCases := []struct {
+		decision string
+		expected RegistrationDecision
+	}{
+		{decision: "approved", expected: RegistrationDecisionApproved},
+		{decision: "pending", expected: RegistrationDecisionPending},
+		{decision: "rejected", expected: RegistrationDecisionRejected},
+	}
+	for _, tc := range testCases {
+		decision = tc.decision
+		result, err := approver.Review(context.Background(), "user", request)
+		s.NoError(err)
+		s.Equal(tc.expected, result)
+	}
+
+	decision = "unknown"
+	_, err := approver.Review(context.Background(), "user", request)
+	s.Error(err)
+}
+
+func (s *registrationApproverSuite) userContext() context.Context {
+	return context.WithValue(context.Background(), authorization.ContextKeyMappedClaims, &authorization.Claims{
+		Subject: "user",
+		System:  authorization.RoleWriter,
+	})
+}
+
+func (s *registrationApproverSuite) newRegistration(
+	registrationID int64,
+	namespace string,
+) *persistence.NamespaceRegistration {
+	return &persistence.NamespaceRegistration{
+		RegistrationID: registrationID,
+		Requester:      "user",
+		Request:        &workflowservice.RegisterNamespaceRequest{Namespace: namespace},
+	}
+}
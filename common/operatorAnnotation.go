@@ -0,0 +1,127 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// operatorAnnotation is the JSON form of annotations in TemporalOperatorAnnotations search attribute
+	operatorAnnotation struct {
+		Time       time.Time `json:"time"`
+		Annotation string    `json:"annotation"`
+		Tags       []string  `json:"tags,omitempty"`
+		Identity   string    `json:"identity,omitempty"`
+	}
+)
+
+const (
+	// maxOperatorAnnotations is the max number of most recent annotations kept on the execution
+	maxOperatorAnnotations = 20
+	// maxOperatorAnnotationLength is the max length of annotation text
+	maxOperatorAnnotationLength = 1000
+)
+
+// ValidateOperatorAnnotation validates annotation text and tags operator attaches to an execution.
+func ValidateOperatorAnnotation(annotation string, tags []string) error {
+	if annotation == "" {
+		return serviceerror.NewInvalidArgument("Annotation is not set on request.")
+	}
+	if len(annotation) > maxOperatorAnnotationLength {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Annotation exceeds %d characters.", maxOperatorAnnotationLength))
+	}
+	for _, t := range tags {
+		if t == "" {
+			return serviceerror.NewInvalidArgument("Annotation tag is empty.")
+		}
+	}
+	return nil
+}
+
+// AppendOperatorAnnotation appends annotation to the annotations of an execution and keeps only the most recent ones.
+func AppendOperatorAnnotation(
+	annotations []*persistencespb.OperatorAnnotation,
+	annotation *persistencespb.OperatorAnnotation,
+) []*persistencespb.OperatorAnnotation {
+
+	annotations = append(annotations, annotation)
+	if len(annotations) > maxOperatorAnnotations {
+		annotations = annotations[len(annotations)-maxOperatorAnnotations:]
+	}
+	return annotations
+}
+
+// EncodeOperatorAnnotations encodes annotations of an execution to TemporalOperatorAnnotations and
+// TemporalOperatorAnnotationTags search attributes of its visibility record.
+func EncodeOperatorAnnotations(
+	annotations []*persistencespb.OperatorAnnotation,
+) (map[string]*commonpb.Payload, error) {
+
+	encodedAnnotations := make([]*operatorAnnotation, 0, len(annotations))
+	tags := []string{}
+	seenTags := make(map[string]struct{})
+	for _, annotation := range annotations {
+		encodedAnnotations = append(encodedAnnotations, &operatorAnnotation{
+			Time:       timestamp.TimeValue(annotation.GetTime()),
+			Annotation: annotation.GetAnnotation(),
+			Tags:       annotation.GetTags(),
+			Identity:   annotation.GetIdentity(),
+		})
+		for _, t := range annotation.GetTags() {
+			if _, ok := seenTags[t]; !ok {
+				seenTags[t] = struct{}{}
+				tags = append(tags, t)
+			}
+		}
+	}
+
+	encoded, err := json.Marshal(encodedAnnotations)
+	if err != nil {
+		return nil, err
+	}
+	annotationsPayload, err := payload.Encode(string(encoded))
+	if err != nil {
+		return nil, err
+	}
+	tagsPayload, err := payload.Encode(tags)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]*commonpb.Payload{
+		definition.TemporalOperatorAnnotations:    annotationsPayload,
+		definition.TemporalOperatorAnnotationTags: tagsPayload,
+	}, nil
+}
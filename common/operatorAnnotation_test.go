@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/payload"
+)
+
+func TestValidateOperatorAnnotation(t *testing.T) {
+	testCases := []struct {
+		name       string
+		annotation string
+		tags       []string
+		wantErr    bool
+	}{
+		{
+			name:       "annotation with tags",
+			annotation: "paused pending vendor fix, ticket X",
+			tags:       []string{"vendor-outage"},
+		},
+		{
+			name:    "without annotation",
+			tags:    []string{"vendor-outage"},
+			wantErr: true,
+		},
+		{
+			name:       "empty tag",
+			annotation: "incident",
+			tags:       []string{""},
+			wantErr:    true,
+		},
+		{
+			name:       "too long annotation",
+			annotation: strings.Repeat("a", maxOperatorAnnotationLength+1),
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateOperatorAnnotation(tc.annotation, tc.tags)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAppendOperatorAnnotation(t *testing.T) {
+	annotatedTime := time.Date(2020, 8, 23, 12, 0, 0, 0, time.UTC)
+	annotations := AppendOperatorAnnotation(nil, &persistencespb.OperatorAnnotation{
+		Time:       &annotatedTime,
+		Annotation: "paused pending vendor fix",
+		Tags:       []string{"vendor-outage", "payments"},
+		Identity:   "operator",
+	})
+
+	for i := 0; i < maxOperatorAnnotations; i++ {
+		updateTime := annotatedTime.Add(time.Duration(i+1) * time.Minute)
+		annotations = AppendOperatorAnnotation(annotations, &persistencespb.OperatorAnnotation{
+			Time:       &updateTime,
+			Annotation: fmt.Sprintf("update %d", i),
+			Tags:       []string{"payments"},
+		})
+	}
+
+	// only the most recent annotations are kept
+	require.Len(t, annotations, maxOperatorAnnotations)
+	require.Equal(t, "update 0", annotations[0].GetAnnotation())
+	require.Equal(t, fmt.Sprintf("update %d", maxOperatorAnnotations-1), annotations[maxOperatorAnnotations-1].GetAnnotation())
+}
+
+func TestEncodeOperatorAnnotations(t *testing.T) {
+	annotatedTime := time.Date(2020, 8, 23, 12, 0, 0, 0, time.UTC)
+	searchAttributes, err := EncodeOperatorAnnotations([]*persistencespb.OperatorAnnotation{
+		{
+			Time:       &annotatedTime,
+			Annotation: "incident",
+			Tags:       []string{"vendor-outage", "payments"},
+			Identity:   "operator",
+		},
+		{
+			Time:       &annotatedTime,
+			Annotation: "update",
+			Tags:       []string{"payments"},
+		},
+	})
+	require.NoError(t, err)
+
+	var encodedAnnotations string
+	require.NoError(t, payload.Decode(searchAttributes[definition.TemporalOperatorAnnotations], &encodedAnnotations))
+	var annotations []*operatorAnnotation
+	require.NoError(t, json.Unmarshal([]byte(encodedAnnotations), &annotations))
+	require.Equal(t, []*operatorAnnotation{
+		{
+			Time:       annotatedTime,
+			Annotation: "incident",
+			Tags:       []string{"vendor-outage", "payments"},
+			Identity:   "operator",
+		},
+		{
+			Time:       annotatedTime,
+			Annotation: "update",
+			Tags:       []string{"payments"},
+		},
+	}, annotations)
+
+	var tags []string
+	require.NoError(t, payload.Decode(searchAttributes[definition.TemporalOperatorAnnotationTags], &tags))
+	require.Equal(t, []string{"vendor-outage", "payments"}, tags)
+}
@@ -43,10 +43,10 @@ import (
 const (
 	// below are templates for history_node table
 	v2templateUpsertData = `INSERT INTO history_node (` +
-		`tree_id, branch_id, node_id, txn_id, data, data_encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?) `
+		`tree_id, branch_id, node_id, txn_id, data, data_encoding, data_checksum) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?) `
 
-	v2templateReadData = `SELECT node_id, txn_id, data, data_encoding FROM history_node ` +
+	v2templateReadData = `SELECT node_id, txn_id, data, data_encoding, data_checksum FROM history_node ` +
 		`WHERE tree_id = ? AND branch_id = ? AND node_id >= ? AND node_id < ? `
 
 	v2templateRangeDeleteData = `DELETE FROM history_node WHERE tree_id = ? AND branch_id = ? AND node_id >= ? `
@@ -108,7 +108,7 @@ func (h *cassandraHistoryV2Persistence) AppendHistoryNodes(
 
 	if !request.IsNewBranch {
 		query := h.session.Query(v2templateUpsertData,
-			branchInfo.TreeId, branchInfo.BranchId, request.NodeID, request.TransactionID, request.Events.Data, request.Events.EncodingType.String())
+			branchInfo.TreeId, branchInfo.BranchId, request.NodeID, request.TransactionID, request.Events.Data, request.Events.EncodingType.String(), request.EventsChecksum)
 		if err := query.Exec(); err != nil {
 			return convertCommonErrors("AppendHistoryNodes", err)
 		}
@@ -131,7 +131,7 @@ func (h *cassandraHistoryV2Persistence) AppendHistoryNodes(
 	batch.Query(v2templateInsertTree,
 		branchInfo.TreeId, branchInfo.BranchId, treeInfoDataBlob.Data, treeInfoDataBlob.EncodingType.String())
 	batch.Query(v2templateUpsertData,
-		branchInfo.TreeId, branchInfo.BranchId, request.NodeID, request.TransactionID, request.Events.Data, request.Events.EncodingType.String())
+		branchInfo.TreeId, branchInfo.BranchId, request.NodeID, request.TransactionID, request.Events.Data, request.Events.EncodingType.String(), request.EventsChecksum)
 	if err = h.session.ExecuteBatch(batch); err != nil {
 		return convertCommonErrors("AppendHistoryNodes", err)
 	}
@@ -166,13 +166,15 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(
 	pagingToken := iter.PageState()
 
 	history := make([]*commonpb.DataBlob, 0, request.PageSize)
+	checksums := make([][]byte, 0, request.PageSize)
 
 	for {
 		var data []byte
 		var encoding string
+		var checksum []byte
 		nodeID := int64(0)
 		txnID := int64(0)
-		if !iter.Scan(&nodeID, &txnID, &data, &encoding) {
+		if !iter.Scan(&nodeID, &txnID, &data, &encoding, &checksum) {
 			break
 		}
 		if txnID < lastTxnID {
@@ -200,6 +202,7 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(
 			lastNodeID = nodeID
 			eventBlob := p.NewDataBlob(data, encoding)
 			history = append(history, eventBlob)
+			checksums = append(checksums, checksum)
 		}
 	}
 
@@ -209,6 +212,7 @@ func (h *cassandraHistoryV2Persistence) ReadHistoryBranch(
 
 	return &p.InternalReadHistoryBranchResponse{
 		History:           history,
+		HistoryChecksums:  checksums,
 		NextPageToken:     pagingToken,
 		LastNodeID:        lastNodeID,
 		LastTransactionID: lastTxnID,
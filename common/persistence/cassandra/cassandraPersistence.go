@@ -81,6 +81,9 @@ const (
 	rowTypeVisibilityTaskNamespaceID = "10000000-6000-f000-f000-000000000000"
 	rowTypeVisibilityTaskWorkflowID  = "20000000-6000-f000-f000-000000000000"
 	rowTypeVisibilityTaskRunID       = "30000000-6000-f000-f000-000000000000"
+	// Row constants for custom task row. Category will be used as WorkflowID.
+	rowTypeCustomTaskNamespaceID = "10000000-7000-f000-f000-000000000000"
+	rowTypeCustomTaskRunID       = "30000000-7000-f000-f000-000000000000"
 	// Row Constants for Replication Task DLQ Row. Source cluster name will be used as WorkflowID.
 	rowTypeDLQNamespaceID = "10000000-6000-f000-f000-000000000000"
 	rowTypeDLQRunID       = "30000000-6000-f000-f000-000000000000"
@@ -101,6 +104,7 @@ const (
 	rowTypeReplicationTask
 	rowTypeDLQ
 	rowTypeVisibilityTask
+	rowTypeCustomTask
 )
 
 const (
@@ -179,6 +183,10 @@ const (
 		`shard_id, type, namespace_id, workflow_id, run_id, visibility_task_data, visibility_task_encoding, visibility_ts, task_id) ` +
 		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
+	templateCreateCustomTaskQuery = `INSERT INTO executions (` +
+		`shard_id, type, namespace_id, workflow_id, run_id, custom_task_data, custom_task_encoding, visibility_ts, task_id) ` +
+		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
 	templateCreateTimerTaskQuery = `INSERT INTO executions (` +
 		`shard_id, type, namespace_id, workflow_id, run_id, timer, timer_encoding, visibility_ts, task_id) ` +
 		`VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)`
@@ -502,6 +510,17 @@ const (
 		`and task_id > ? ` +
 		`and task_id <= ?`
 
+	templateGetCustomTasksQuery = `SELECT custom_task_data, custom_task_encoding ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and namespace_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id > ? ` +
+		`and task_id <= ?`
+
 	templateGetReplicationTaskQuery = `SELECT replication, replication_encoding ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -561,6 +580,8 @@ const (
 		`and task_id > ? ` +
 		`and task_id <= ?`
 
+	templateRangeCompleteCustomTaskQuery = templateRangeCompleteTransferTaskQuery
+
 	templateCompleteReplicationTaskBeforeQuery = `DELETE FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -1741,6 +1762,7 @@ func (d *cassandraPersistence) AddTasks(request *p.AddTasksRequest) error {
 		request.TimerTasks,
 		request.ReplicationTasks,
 		request.VisibilityTasks,
+		nil,
 	); err != nil {
 		return err
 	}
@@ -1927,6 +1949,48 @@ func (d *cassandraPersistence) GetVisibilityTasks(request *p.GetVisibilityTasksR
 	return response, nil
 }
 
+func (d *cassandraPersistence) GetCustomTasks(request *p.GetCustomTasksRequest) (*p.GetCustomTasksResponse, error) {
+
+	// Reading custom tasks need to be quorum level consistent, otherwise we could lose task
+	query := d.session.Query(templateGetCustomTasksQuery,
+		d.shardID,
+		rowTypeCustomTask,
+		rowTypeCustomTaskNamespaceID,
+		request.Category,
+		rowTypeCustomTaskRunID,
+		defaultVisibilityTimestamp,
+		request.ReadLevel,
+		request.MaxReadLevel,
+	).PageSize(request.BatchSize).PageState(request.NextPageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, serviceerror.NewInternal("GetCustomTasks operation failed.  Not able to create query iterator.")
+	}
+
+	response := &p.GetCustomTasksResponse{}
+	var data []byte
+	var encoding string
+
+	for iter.Scan(&data, &encoding) {
+		t, err := serialization.CustomTaskInfoFromBlob(data, encoding)
+		if err != nil {
+			return nil, convertCommonErrors("GetCustomTasks", err)
+		}
+
+		response.Tasks = append(response.Tasks, t)
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		return nil, convertCommonErrors("GetCustomTasks", err)
+	}
+
+	return response, nil
+}
+
 func (d *cassandraPersistence) GetReplicationTask(request *p.GetReplicationTaskRequest) (*p.GetReplicationTaskResponse, error) {
 	shardID := d.shardID
 	taskID := request.TaskID
@@ -2093,6 +2157,29 @@ func (d *cassandraPersistence) RangeCompleteVisibilityTask(request *p.RangeCompl
 	return nil
 }
 
+func (d *cassandraPersistence) RangeCompleteCustomTask(request *p.RangeCompleteCustomTaskRequest) error {
+	query := d.session.Query(templateRangeCompleteCustomTaskQuery,
+		d.shardID,
+		rowTypeCustomTask,
+		rowTypeCustomTaskNamespaceID,
+		request.Category,
+		rowTypeCustomTaskRunID,
+		defaultVisibilityTimestamp,
+		request.ExclusiveBeginTaskID,
+		request.InclusiveEndTaskID,
+	)
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return serviceerror.NewResourceExhausted(fmt.Sprintf("RangeCompleteCustomTask operation failed. Error: %v", err))
+		}
+		return serviceerror.NewInternal(fmt.Sprintf("RangeCompleteCustomTask operation failed. Error: %v", err))
+	}
+
+	return nil
+}
+
 func (d *cassandraPersistence) CompleteReplicationTask(request *p.CompleteReplicationTaskRequest) error {
 	query := d.session.Query(templateCompleteReplicationTaskQuery,
 		d.shardID,
@@ -2548,6 +2635,8 @@ PopulateTasks:
 		task = make(map[string]interface{}) // Reinitialize map as initialized fails on unmarshalling
 	}
 
+	// warnings are only available until the iterator is closed
+	response.TombstoneCount = tombstoneCount(iter.Warnings())
 	if err := iter.Close(); err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("GetTasks operation failed. Error: %v", err))
 	}
@@ -26,6 +26,8 @@ package cassandra
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -157,6 +159,7 @@ func applyWorkflowMutationBatch(
 		workflowMutation.TimerTasks,
 		workflowMutation.ReplicationTasks,
 		workflowMutation.VisibilityTasks,
+		workflowMutation.CustomTasks,
 	)
 }
 
@@ -269,6 +272,7 @@ func applyWorkflowSnapshotBatchAsReset(
 		workflowSnapshot.TimerTasks,
 		workflowSnapshot.ReplicationTasks,
 		workflowSnapshot.VisibilityTasks,
+		workflowSnapshot.CustomTasks,
 	)
 }
 
@@ -377,6 +381,7 @@ func applyWorkflowSnapshotBatchAsNew(
 		workflowSnapshot.TimerTasks,
 		workflowSnapshot.ReplicationTasks,
 		workflowSnapshot.VisibilityTasks,
+		workflowSnapshot.CustomTasks,
 	)
 }
 
@@ -512,6 +517,7 @@ func applyTasks(
 	timerTasks []p.Task,
 	replicationTasks []p.Task,
 	visibilityTasks []p.Task,
+	customTasks []p.Task,
 ) error {
 
 	if err := createTransferTasks(
@@ -558,6 +564,17 @@ func applyTasks(
 		return err
 	}
 
+	if err := createCustomTasks(
+		batch,
+		customTasks,
+		shardID,
+		namespaceID,
+		workflowID,
+		runID,
+	); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -783,6 +800,51 @@ func createVisibilityTasks(
 	return nil
 }
 
+func createCustomTasks(
+	batch *gocql.Batch,
+	customTasks []p.Task,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	runID string,
+) error {
+
+	for _, task := range customTasks {
+		customTask, ok := task.(*p.CustomTask)
+		if !ok || customTask.Category == "" {
+			return serviceerror.NewInternal(fmt.Sprintf("createCustomTasks failed. Invalid custom task: %v", task))
+		}
+
+		datablob, err := serialization.CustomTaskInfoToBlob(&serialization.CustomTaskInfo{
+			NamespaceId:    namespaceID,
+			WorkflowId:     workflowID,
+			RunId:          runID,
+			Category:       customTask.Category,
+			Version:        customTask.Version,
+			TaskId:         customTask.TaskID,
+			VisibilityTime: timestamp.TimePtr(customTask.VisibilityTimestamp),
+			Data:           customTask.Data,
+		})
+		if err != nil {
+			return err
+		}
+
+		// tasks of a category are kept together, the category is used as workflow ID of the rows
+		batch.Query(templateCreateCustomTaskQuery,
+			shardID,
+			rowTypeCustomTask,
+			rowTypeCustomTaskNamespaceID,
+			customTask.Category,
+			rowTypeCustomTaskRunID,
+			datablob.Data,
+			datablob.EncodingType.String(),
+			defaultVisibilityTimestamp,
+			task.GetTaskID())
+	}
+
+	return nil
+}
+
 func createTimerTasks(
 	batch *gocql.Batch,
 	timerTasks []p.Task,
@@ -827,6 +889,9 @@ func createTimerTasks(
 		case *p.DeleteHistoryEventTask:
 			// noop
 
+		case *p.StuckExecutionCheckTask:
+			// noop
+
 		default:
 			return serviceerror.NewInternal(fmt.Sprintf("Unknow timer type: %v", task.GetType()))
 		}
@@ -1550,3 +1615,19 @@ func isThrottlingError(err error) bool {
 	}
 	return false
 }
+
+// tombstoneWarningRegex matches the tombstone count of warnings cassandra sends for reads scanning more tombstones
+// than tombstone_warn_threshold, e.g. "Read 10 live rows and 1001 tombstone cells for query ..."
+var tombstoneWarningRegex = regexp.MustCompile(`(\d+) tombstone`)
+
+// tombstoneCount returns the number of tombstones scanned according to the warnings of a query
+func tombstoneCount(warnings []string) int {
+	count := 0
+	for _, warning := range warnings {
+		if match := tombstoneWarningRegex.FindStringSubmatch(warning); match != nil {
+			n, _ := strconv.Atoi(match[1])
+			count += n
+		}
+	}
+	return count
+}
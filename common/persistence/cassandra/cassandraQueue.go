@@ -99,14 +99,13 @@ func newQueue(
 
 func (q *cassandraQueue) EnqueueMessage(
 	blob commonpb.DataBlob,
-) error {
+) (int64, error) {
 	lastMessageID, err := q.getLastMessageID(q.queueType)
 	if err != nil {
-		return err
+		return persistence.EmptyQueueMessageID, err
 	}
 
-	_, err = q.tryEnqueue(q.queueType, lastMessageID+1, blob)
-	return err
+	return q.tryEnqueue(q.queueType, lastMessageID+1, blob)
 }
 
 func (q *cassandraQueue) EnqueueMessageToDLQ(
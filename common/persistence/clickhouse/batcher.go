@@ -0,0 +1,161 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clickhouse
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+var errBatcherStopped = errors.New("clickhouse batcher is stopped")
+
+type (
+	// batcher groups rows inserted concurrently into batches, ClickHouse handles few large inserts much better
+	// than many small ones. Callers wait until their batch is inserted, so visibility tasks are acked only
+	// after their records are stored.
+	batcher struct {
+		client        *httpClient
+		statement     string
+		settings      map[string]string
+		batchSize     int
+		flushInterval time.Duration
+		timeout       time.Duration
+		logger        log.Logger
+
+		requestCh  chan *insertRequest
+		shutdownCh chan struct{}
+		stopOnce   sync.Once
+		wg         sync.WaitGroup
+	}
+
+	insertRequest struct {
+		row    []byte
+		doneCh chan error
+	}
+)
+
+func newBatcher(client *httpClient, cfg *storeConfig, logger log.Logger) *batcher {
+	settings := map[string]string{}
+	if cfg.asyncInsert {
+		// ClickHouse buffers the batches further and acknowledges them once flushed to table
+		settings["async_insert"] = "1"
+		settings["wait_for_async_insert"] = "1"
+	}
+	return &batcher{
+		client:        client,
+		statement:     "INSERT INTO " + cfg.table + " FORMAT JSONEachRow",
+		settings:      settings,
+		batchSize:     cfg.batchSize,
+		flushInterval: cfg.flushInterval,
+		timeout:       cfg.timeout,
+		logger:        logger,
+		requestCh:     make(chan *insertRequest, cfg.batchSize),
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+func (b *batcher) start() {
+	b.wg.Add(1)
+	go b.loop()
+}
+
+func (b *batcher) stop() {
+	b.stopOnce.Do(func() {
+		close(b.shutdownCh)
+		b.wg.Wait()
+	})
+}
+
+// insert adds JSON encoded row to the next batch and waits until the batch is inserted
+func (b *batcher) insert(ctx context.Context, row []byte) error {
+	request := &insertRequest{row: row, doneCh: make(chan error, 1)}
+	select {
+	case b.requestCh <- request:
+	case <-b.shutdownCh:
+		return errBatcherStopped
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-request.doneCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *batcher) loop() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	var batch []*insertRequest
+	for {
+		select {
+		case request := <-b.requestCh:
+			batch = append(batch, request)
+			if len(batch) >= b.batchSize {
+				b.flush(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				b.flush(batch)
+				batch = nil
+			}
+		case <-b.shutdownCh:
+			for _, request := range batch {
+				request.doneCh <- errBatcherStopped
+			}
+			return
+		}
+	}
+}
+
+func (b *batcher) flush(batch []*insertRequest) {
+	var data bytes.Buffer
+	for _, request := range batch {
+		data.Write(request.row)
+		data.WriteByte('\n')
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+	err := b.client.exec(ctx, b.statement, data.Bytes(), b.settings)
+	cancel()
+	if err != nil {
+		b.logger.Warn("Failed to insert visibility records to clickhouse.", tag.Error(err), tag.Counter(len(batch)))
+	}
+	for _, request := range batch {
+		request.doneCh <- err
+	}
+}
@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clickhouse
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// maxErrorLength limits size of ClickHouse error messages returned to callers
+	maxErrorLength = 1024
+)
+
+type (
+	// httpClient talks to ClickHouse over its HTTP interface
+	httpClient struct {
+		httpClient *http.Client
+		url        string
+		database   string
+		user       string
+		password   string
+		// settings are ClickHouse settings sent with every request
+		settings map[string]string
+	}
+)
+
+func newHTTPClient(cfg *storeConfig) *httpClient {
+	return &httpClient{
+		httpClient: &http.Client{Timeout: cfg.timeout},
+		url:        cfg.url,
+		database:   cfg.database,
+		user:       cfg.user,
+		password:   cfg.password,
+		settings: map[string]string{
+			// 64-bit integers are quoted in JSON output by default
+			"output_format_json_quote_64bit_integers": "0",
+		},
+	}
+}
+
+// exec runs statement which doesn't return rows, data is sent as statement input, e.g. rows to insert
+func (c *httpClient) exec(ctx context.Context, statement string, data []byte, settings map[string]string) error {
+	params := url.Values{"query": []string{statement}}
+	for name, value := range settings {
+		params.Set(name, value)
+	}
+	body, err := c.do(ctx, params, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	return body.Close()
+}
+
+// query runs select statement formatted as JSONEachRow and calls decode with every returned row
+func (c *httpClient) query(ctx context.Context, statement string, decode func(row []byte) error) error {
+	body, err := c.do(ctx, url.Values{}, bytes.NewBufferString(statement+" FORMAT JSONEachRow"))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		row := scanner.Bytes()
+		if len(bytes.TrimSpace(row)) == 0 {
+			continue
+		}
+		if err := decode(row); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func (c *httpClient) do(ctx context.Context, params url.Values, body io.Reader) (io.ReadCloser, error) {
+	params.Set("database", c.database)
+	for name, value := range c.settings {
+		params.Set(name, value)
+	}
+	request, err := http.NewRequest(http.MethodPost, c.url+"/?"+params.Encode(), body)
+	if err != nil {
+		return nil, err
+	}
+	request = request.WithContext(ctx)
+	if c.user != "" {
+		request.Header.Set("X-ClickHouse-User", c.user)
+		request.Header.Set("X-ClickHouse-Key", c.password)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		defer response.Body.Close()
+		message, _ := ioutil.ReadAll(io.LimitReader(response.Body, maxErrorLength))
+		return nil, fmt.Errorf("clickhouse returned status %d: %s", response.StatusCode, bytes.TrimSpace(message))
+	}
+	return response.Body, nil
+}
+
+func decodeRow(row []byte, v interface{}) error {
+	if err := json.Unmarshal(row, v); err != nil {
+		return fmt.Errorf("unable to decode clickhouse row: %v", err)
+	}
+	return nil
+}
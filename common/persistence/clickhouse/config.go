@@ -0,0 +1,166 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clickhouse
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// options of customVisibilityStore config
+const (
+	// OptionURL is the URL of ClickHouse HTTP interface
+	OptionURL = "url"
+	// OptionDatabase is the database of the visibility table
+	OptionDatabase = "database"
+	// OptionTable is the name of the visibility table
+	OptionTable = "table"
+	// OptionUser and OptionPassword are credentials of ClickHouse user
+	OptionUser     = "user"
+	OptionPassword = "password"
+	// OptionTimeout is the timeout of ClickHouse requests
+	OptionTimeout = "timeout"
+	// OptionBatchSize is the max number of rows inserted with single request
+	OptionBatchSize = "batchSize"
+	// OptionFlushInterval is the max time rows wait to be batched before they are inserted
+	OptionFlushInterval = "flushInterval"
+	// OptionAsyncInsert enables server side buffering of inserts, see async_insert setting of ClickHouse
+	OptionAsyncInsert = "asyncInsert"
+	// OptionCreateTable creates the visibility table on start if it doesn't exist
+	OptionCreateTable = "createTable"
+)
+
+const (
+	defaultURL           = "http://127.0.0.1:8123"
+	defaultDatabase      = "temporal_visibility"
+	defaultTable         = "executions"
+	defaultTimeout       = 10 * time.Second
+	defaultBatchSize     = 1000
+	defaultFlushInterval = 200 * time.Millisecond
+)
+
+var identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type (
+	storeConfig struct {
+		url           string
+		database      string
+		table         string
+		user          string
+		password      string
+		timeout       time.Duration
+		batchSize     int
+		flushInterval time.Duration
+		asyncInsert   bool
+		createTable   bool
+	}
+)
+
+func newStoreConfig(options map[string]string) (*storeConfig, error) {
+	cfg := &storeConfig{
+		url:           defaultURL,
+		database:      defaultDatabase,
+		table:         defaultTable,
+		user:          options[OptionUser],
+		password:      options[OptionPassword],
+		timeout:       defaultTimeout,
+		batchSize:     defaultBatchSize,
+		flushInterval: defaultFlushInterval,
+		asyncInsert:   true,
+	}
+	if value := options[OptionURL]; value != "" {
+		cfg.url = value
+	}
+	if value := options[OptionDatabase]; value != "" {
+		cfg.database = value
+	}
+	if value := options[OptionTable]; value != "" {
+		cfg.table = value
+	}
+	for _, name := range []string{cfg.database, cfg.table} {
+		if !identifierRegexp.MatchString(name) {
+			return nil, fmt.Errorf("clickhouse database and table names must be identifiers, got %q", name)
+		}
+	}
+
+	var err error
+	if cfg.timeout, err = parseDurationOption(options, OptionTimeout, cfg.timeout); err != nil {
+		return nil, err
+	}
+	if cfg.flushInterval, err = parseDurationOption(options, OptionFlushInterval, cfg.flushInterval); err != nil {
+		return nil, err
+	}
+	if cfg.batchSize, err = parseIntOption(options, OptionBatchSize, cfg.batchSize); err != nil {
+		return nil, err
+	}
+	if cfg.batchSize <= 0 {
+		return nil, fmt.Errorf("clickhouse option %v must be positive", OptionBatchSize)
+	}
+	if cfg.asyncInsert, err = parseBoolOption(options, OptionAsyncInsert, cfg.asyncInsert); err != nil {
+		return nil, err
+	}
+	if cfg.createTable, err = parseBoolOption(options, OptionCreateTable, false); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func parseDurationOption(options map[string]string, name string, defaultValue time.Duration) (time.Duration, error) {
+	value, ok := options[name]
+	if !ok || value == "" {
+		return defaultValue, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("clickhouse option %v: %v", name, err)
+	}
+	return duration, nil
+}
+
+func parseIntOption(options map[string]string, name string, defaultValue int) (int, error) {
+	value, ok := options[name]
+	if !ok || value == "" {
+		return defaultValue, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("clickhouse option %v: %v", name, err)
+	}
+	return number, nil
+}
+
+func parseBoolOption(options map[string]string, name string, defaultValue bool) (bool, error) {
+	value, ok := options[name]
+	if !ok || value == "" {
+		return defaultValue, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("clickhouse option %v: %v", name, err)
+	}
+	return b, nil
+}
@@ -0,0 +1,413 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clickhouse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+)
+
+const (
+	// missingValue is compared with CloseTime to find open executions, e.g. CloseTime = missing
+	missingValue = "missing"
+)
+
+type (
+	// visibilityQuery is a visibility query translated to ClickHouse SQL
+	visibilityQuery struct {
+		where string
+		sort  sortKey
+	}
+
+	// sortKey is the order of listed executions, run id breaks ties of the sort column,
+	// so that the next page starts right after the last execution of the previous one
+	sortKey struct {
+		column     string
+		descending bool
+	}
+)
+
+var defaultSortKey = sortKey{column: "start_time", descending: true}
+
+// columns maps system search attributes to table columns
+var columns = map[string]string{
+	definition.NamespaceID:     "namespace_id",
+	definition.WorkflowID:      "workflow_id",
+	definition.RunID:           "run_id",
+	definition.WorkflowType:    "workflow_type",
+	definition.TaskQueue:       "task_queue",
+	definition.ExecutionStatus: "status",
+	definition.StartTime:       "start_time",
+	definition.ExecutionTime:   "execution_time",
+	definition.CloseTime:       "close_time",
+	definition.HistoryLength:   "history_length",
+}
+
+var timeColumns = map[string]bool{
+	"start_time":     true,
+	"execution_time": true,
+	"close_time":     true,
+}
+
+var comparisonOperators = map[string]bool{
+	sqlparser.EqualStr:        true,
+	sqlparser.NotEqualStr:     true,
+	sqlparser.LessThanStr:     true,
+	sqlparser.LessEqualStr:    true,
+	sqlparser.GreaterThanStr:  true,
+	sqlparser.GreaterEqualStr: true,
+	sqlparser.InStr:           true,
+	sqlparser.NotInStr:        true,
+	sqlparser.LikeStr:         true,
+	sqlparser.NotLikeStr:      true,
+}
+
+// translateQuery translates visibility query, i.e. where clause optionally followed by order by clause as validated
+// by frontend, to ClickHouse SQL. Literals are re-encoded, so the result is safe to embed in a statement.
+func translateQuery(query string) (*visibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	result := &visibilityQuery{sort: defaultSortKey}
+	if query == "" {
+		return result, nil
+	}
+
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("select * from dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("select * from dummy where %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse query: %v", err)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errors.New("query is not a select query")
+	}
+
+	if sel.Where != nil {
+		if result.where, err = translateExpr(sel.Where.Expr); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(sel.OrderBy) > 1 {
+		return nil, errors.New("only one field can be used to sort")
+	}
+	if len(sel.OrderBy) == 1 {
+		column, _, err := translateColumn(sel.OrderBy[0].Expr, nil)
+		if err != nil {
+			return nil, err
+		}
+		result.sort = sortKey{column: column, descending: sel.OrderBy[0].Direction == sqlparser.DescScr}
+	}
+	return result, nil
+}
+
+func (k sortKey) orderBy() string {
+	direction := "ASC"
+	if k.descending {
+		direction = "DESC"
+	}
+	if k.column == "run_id" {
+		return "run_id " + direction
+	}
+	return fmt.Sprintf("%s %s, run_id %s", k.column, direction, direction)
+}
+
+// after returns condition which selects executions following the one with the given sort value and run id
+func (k sortKey) after(sortValue json.RawMessage, runID string) (string, error) {
+	operator := ">"
+	if k.descending {
+		operator = "<"
+	}
+	if k.column == "run_id" {
+		return fmt.Sprintf("run_id %s %s", operator, quoteString(runID)), nil
+	}
+	value, err := jsonValueLiteral(sortValue)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s, run_id) %s (%s, %s)", k.column, operator, value, quoteString(runID)), nil
+}
+
+// jsonValueLiteral encodes number or string returned by ClickHouse as literal,
+// values come from page tokens, i.e. from callers, so they are validated rather than embedded as is
+func jsonValueLiteral(data json.RawMessage) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", fmt.Errorf("invalid sort value: %v", err)
+	}
+	if decoder.More() {
+		return "", fmt.Errorf("invalid sort value %s", data)
+	}
+	switch value := value.(type) {
+	case string:
+		return quoteString(value), nil
+	case json.Number:
+		if number, err := value.Int64(); err == nil {
+			return strconv.FormatInt(number, 10), nil
+		}
+		number, err := value.Float64()
+		if err != nil {
+			return "", fmt.Errorf("invalid sort value %q", value)
+		}
+		return strconv.FormatFloat(number, 'g', -1, 64), nil
+	default:
+		return "", fmt.Errorf("invalid sort value %s", data)
+	}
+}
+
+func translateExpr(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return translateBinaryExpr("AND", expr.Left, expr.Right)
+	case *sqlparser.OrExpr:
+		return translateBinaryExpr("OR", expr.Left, expr.Right)
+	case *sqlparser.ParenExpr:
+		inner, err := translateExpr(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "(" + inner + ")", nil
+	case *sqlparser.ComparisonExpr:
+		return translateComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return translateRangeCond(expr)
+	default:
+		return "", fmt.Errorf("unsupported expression %q", sqlparser.String(expr))
+	}
+}
+
+func translateBinaryExpr(operator string, left, right sqlparser.Expr) (string, error) {
+	leftSQL, err := translateExpr(left)
+	if err != nil {
+		return "", err
+	}
+	rightSQL, err := translateExpr(right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s", leftSQL, operator, rightSQL), nil
+}
+
+func translateComparisonExpr(expr *sqlparser.ComparisonExpr) (string, error) {
+	if !comparisonOperators[expr.Operator] {
+		return "", fmt.Errorf("unsupported operator %q", expr.Operator)
+	}
+
+	// CloseTime = missing selects open executions, which have zero close time
+	if colName, ok := expr.Right.(*sqlparser.ColName); ok && colName.Name.EqualString(missingValue) {
+		column, _, err := translateColumn(expr.Left, nil)
+		if err != nil {
+			return "", err
+		}
+		if column != "close_time" {
+			return "", errors.New("only CloseTime can be compared with missing")
+		}
+		switch expr.Operator {
+		case sqlparser.EqualStr:
+			return "close_time = 0", nil
+		case sqlparser.NotEqualStr:
+			return "close_time != 0", nil
+		default:
+			return "", fmt.Errorf("unsupported operator %q for missing", expr.Operator)
+		}
+	}
+
+	var values []*sqlparser.SQLVal
+	switch right := expr.Right.(type) {
+	case *sqlparser.SQLVal:
+		values = append(values, right)
+	case sqlparser.ValTuple:
+		if expr.Operator != sqlparser.InStr && expr.Operator != sqlparser.NotInStr {
+			return "", fmt.Errorf("unexpected list of values for operator %q", expr.Operator)
+		}
+		for _, item := range right {
+			value, ok := item.(*sqlparser.SQLVal)
+			if !ok {
+				return "", fmt.Errorf("unsupported value %q", sqlparser.String(item))
+			}
+			values = append(values, value)
+		}
+	case sqlparser.BoolVal:
+		value := sqlparser.NewIntVal([]byte("0"))
+		if right {
+			value = sqlparser.NewIntVal([]byte("1"))
+		}
+		values = append(values, value)
+	default:
+		return "", fmt.Errorf("unsupported value %q", sqlparser.String(expr.Right))
+	}
+
+	column, isAttribute, err := translateColumn(expr.Left, values[0])
+	if err != nil {
+		return "", err
+	}
+	literals := make([]string, len(values))
+	for i, value := range values {
+		if literals[i], err = translateValue(column, isAttribute, value); err != nil {
+			return "", err
+		}
+	}
+
+	operator := strings.ToUpper(expr.Operator)
+	if expr.Operator == sqlparser.InStr || expr.Operator == sqlparser.NotInStr {
+		return fmt.Sprintf("%s %s (%s)", column, operator, strings.Join(literals, ", ")), nil
+	}
+	return fmt.Sprintf("%s %s %s", column, operator, literals[0]), nil
+}
+
+func translateRangeCond(expr *sqlparser.RangeCond) (string, error) {
+	from, ok := expr.From.(*sqlparser.SQLVal)
+	if !ok {
+		return "", fmt.Errorf("unsupported value %q", sqlparser.String(expr.From))
+	}
+	to, ok := expr.To.(*sqlparser.SQLVal)
+	if !ok {
+		return "", fmt.Errorf("unsupported value %q", sqlparser.String(expr.To))
+	}
+	column, isAttribute, err := translateColumn(expr.Left, from)
+	if err != nil {
+		return "", err
+	}
+	fromSQL, err := translateValue(column, isAttribute, from)
+	if err != nil {
+		return "", err
+	}
+	toSQL, err := translateValue(column, isAttribute, to)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s AND %s", column, strings.ToUpper(expr.Operator), fromSQL, toSQL), nil
+}
+
+// translateColumn returns expression selecting the column of search attribute. Custom search attributes
+// are extracted from JSON, typed by the value they are compared with. Order by of custom search attributes
+// without value compares raw JSON.
+func translateColumn(expr sqlparser.Expr, value *sqlparser.SQLVal) (string, bool, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", false, fmt.Errorf("unsupported expression %q", sqlparser.String(expr))
+	}
+
+	name := colName.Name.String()
+	if qualifier := colName.Qualifier.Name.String(); qualifier != "" {
+		name = qualifier + "." + name
+	}
+	if !strings.HasPrefix(name, definition.Attr+".") {
+		column, ok := columns[name]
+		if !ok {
+			return "", false, fmt.Errorf("unknown search attribute %q", name)
+		}
+		return column, false, nil
+	}
+
+	attribute := quoteString(strings.TrimPrefix(name, definition.Attr+"."))
+	if value == nil {
+		return fmt.Sprintf("JSONExtractRaw(search_attributes, %s)", attribute), true, nil
+	}
+	switch value.Type {
+	case sqlparser.IntVal:
+		return fmt.Sprintf("JSONExtractInt(search_attributes, %s)", attribute), true, nil
+	case sqlparser.FloatVal:
+		return fmt.Sprintf("JSONExtractFloat(search_attributes, %s)", attribute), true, nil
+	default:
+		return fmt.Sprintf("JSONExtractString(search_attributes, %s)", attribute), true, nil
+	}
+}
+
+func translateValue(column string, isAttribute bool, value *sqlparser.SQLVal) (string, error) {
+	switch value.Type {
+	case sqlparser.IntVal:
+		number, err := strconv.ParseInt(string(value.Val), 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid number %q", value.Val)
+		}
+		return strconv.FormatInt(number, 10), nil
+	case sqlparser.FloatVal:
+		number, err := strconv.ParseFloat(string(value.Val), 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid number %q", value.Val)
+		}
+		return strconv.FormatFloat(number, 'g', -1, 64), nil
+	case sqlparser.StrVal:
+		if isAttribute {
+			return quoteString(string(value.Val)), nil
+		}
+		return translateStringValue(column, string(value.Val))
+	default:
+		return "", fmt.Errorf("unsupported value %q", sqlparser.String(value))
+	}
+}
+
+// translateStringValue converts string values of system search attributes, times are accepted in RFC3339 format
+// or as Unix nanoseconds and statuses by their name, e.g. 'Running'.
+func translateStringValue(column string, value string) (string, error) {
+	switch {
+	case timeColumns[column]:
+		if nanos, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return strconv.FormatInt(nanos, 10), nil
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return "", fmt.Errorf("invalid time %q, expected RFC3339 format or Unix nanoseconds", value)
+		}
+		return strconv.FormatInt(parsed.UnixNano(), 10), nil
+	case column == "status":
+		if number, err := strconv.ParseInt(value, 10, 32); err == nil {
+			return strconv.FormatInt(number, 10), nil
+		}
+		for name, status := range enumspb.WorkflowExecutionStatus_value {
+			if strings.EqualFold(name, value) {
+				return strconv.Itoa(int(status)), nil
+			}
+		}
+		return "", fmt.Errorf("invalid execution status %q", value)
+	case column == "history_length":
+		return "", fmt.Errorf("invalid history length %q", value)
+	default:
+		return quoteString(value), nil
+	}
+}
+
+// quoteString encodes s as ClickHouse string literal
+func quoteString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
@@ -0,0 +1,130 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clickhouse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateQuery(t *testing.T) {
+	tests := []struct {
+		query   string
+		where   string
+		orderBy string
+	}{
+		{
+			query:   "",
+			orderBy: defaultSortKey.orderBy(),
+		},
+		{
+			query:   "WorkflowType = 'order' and ExecutionStatus = 1",
+			where:   "workflow_type = 'order' AND status = 1",
+			orderBy: defaultSortKey.orderBy(),
+		},
+		{
+			query:   "ExecutionStatus = 'Running' or (CloseTime != missing and HistoryLength > 100)",
+			where:   "status = 1 OR (close_time != 0 AND history_length > 100)",
+			orderBy: defaultSortKey.orderBy(),
+		},
+		{
+			query:   "CloseTime = missing order by StartTime asc",
+			where:   "close_time = 0",
+			orderBy: "start_time ASC, run_id ASC",
+		},
+		{
+			query:   "StartTime between '2020-01-01T00:00:00Z' and 1577923200000000000",
+			where:   "start_time BETWEEN 1577836800000000000 AND 1577923200000000000",
+			orderBy: defaultSortKey.orderBy(),
+		},
+		{
+			query:   "WorkflowId in ('a', 'b') and TaskQueue like 'queue%'",
+			where:   "workflow_id IN ('a', 'b') AND task_queue LIKE 'queue%'",
+			orderBy: defaultSortKey.orderBy(),
+		},
+		{
+			query:   "`Attr.CustomKeywordField` = 'it''s' and `Attr.CustomIntField` >= 5 and `Attr.CustomDoubleField` < 1.5",
+			where:   `JSONExtractString(search_attributes, 'CustomKeywordField') = 'it\'s' AND JSONExtractInt(search_attributes, 'CustomIntField') >= 5 AND JSONExtractFloat(search_attributes, 'CustomDoubleField') < 1.5`,
+			orderBy: defaultSortKey.orderBy(),
+		},
+		{
+			query:   "Attr.CustomBoolField = true order by Attr.CustomIntField desc",
+			where:   "JSONExtractInt(search_attributes, 'CustomBoolField') = 1",
+			orderBy: "JSONExtractRaw(search_attributes, 'CustomIntField') DESC, run_id DESC",
+		},
+		{
+			query:   "order by CloseTime desc",
+			orderBy: "close_time DESC, run_id DESC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := translateQuery(tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.where, query.where)
+			assert.Equal(t, tt.orderBy, query.sort.orderBy())
+		})
+	}
+}
+
+func TestTranslateQuery_Invalid(t *testing.T) {
+	for _, query := range []string{
+		"WorkflowType = 'a' union select 1",
+		"UnknownField = 1",
+		"WorkflowType = concat('a', 'b')",
+		"StartTime > 'yesterday'",
+		"ExecutionStatus = 'Sleeping'",
+		"WorkflowId = missing",
+		"WorkflowId is null",
+		"not WorkflowId = 'a'",
+		"order by StartTime, RunId",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := translateQuery(query)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSortKeyAfter(t *testing.T) {
+	after, err := sortKey{column: "close_time", descending: true}.after([]byte("1577836800000000000"), "run-id")
+	require.NoError(t, err)
+	assert.Equal(t, "(close_time, run_id) < (1577836800000000000, 'run-id')", after)
+
+	after, err = sortKey{column: "JSONExtractRaw(search_attributes, 'CustomKeywordField')"}.after([]byte(`"\"it's\""`), "run-id")
+	require.NoError(t, err)
+	assert.Equal(t, `(JSONExtractRaw(search_attributes, 'CustomKeywordField'), run_id) > ('"it\'s"', 'run-id')`, after)
+
+	after, err = sortKey{column: "run_id"}.after(nil, "run-id")
+	require.NoError(t, err)
+	assert.Equal(t, "run_id > 'run-id'", after)
+
+	for _, value := range []string{"", "1; DROP TABLE executions", "[1]", "null"} {
+		_, err = defaultSortKey.after([]byte(value), "run-id")
+		assert.Error(t, err, value)
+	}
+}
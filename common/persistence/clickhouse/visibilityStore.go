@@ -0,0 +1,511 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clickhouse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/payload"
+	p "go.temporal.io/server/common/persistence"
+)
+
+const (
+	persistenceName = "clickhouse"
+
+	defaultPageSize = 1000
+
+	// tombstoneRetention is how long tombstones are kept, long enough for merges to drop the records they hide
+	tombstoneRetention = 7 * 24 * time.Hour
+
+	selectColumns = "namespace_id, workflow_id, run_id, workflow_type, task_queue, status, start_time, execution_time, " +
+		"close_time, history_length, memo, memo_encoding, search_attributes"
+)
+
+type (
+	clickhouseVisibilityStore struct {
+		client  *httpClient
+		batcher *batcher
+		table   string
+		timeout time.Duration
+		logger  log.Logger
+	}
+
+	// executionRow is a row of the visibility table. Rows are never updated in place, every change of execution
+	// inserts a new row and ReplacingMergeTree keeps the one with the highest version, i.e. visibility task id.
+	// Deletion inserts a tombstone row. Rows of closed executions expire once the namespace retention passes.
+	executionRow struct {
+		NamespaceID      string `json:"namespace_id"`
+		WorkflowID       string `json:"workflow_id"`
+		RunID            string `json:"run_id"`
+		WorkflowType     string `json:"workflow_type"`
+		TaskQueue        string `json:"task_queue"`
+		Status           int32  `json:"status"`
+		StartTime        int64  `json:"start_time"`
+		ExecutionTime    int64  `json:"execution_time"`
+		CloseTime        int64  `json:"close_time"`
+		HistoryLength    int64  `json:"history_length"`
+		Memo             []byte `json:"memo,omitempty"`
+		MemoEncoding     string `json:"memo_encoding"`
+		SearchAttributes string `json:"search_attributes"`
+		ExpireTime       int64  `json:"expire_time,omitempty"`
+		Version          int64  `json:"version,omitempty"`
+		IsDeleted        uint8  `json:"is_deleted,omitempty"`
+		// SortValue is the value of the sort column, it is selected by list queries only
+		SortValue json.RawMessage `json:"sort_value,omitempty"`
+	}
+
+	// clickhousePageToken holds the sort key of the last execution of the previous page
+	clickhousePageToken struct {
+		SortValue json.RawMessage `json:",omitempty"`
+		RunID     string
+	}
+)
+
+var _ p.VisibilityStore = (*clickhouseVisibilityStore)(nil)
+
+// NewVisibilityStore creates a visibility store backed by ClickHouse, options are those of customVisibilityStore config
+func NewVisibilityStore(options map[string]string, logger log.Logger) (p.VisibilityStore, error) {
+	cfg, err := newStoreConfig(options)
+	if err != nil {
+		return nil, err
+	}
+
+	client := newHTTPClient(cfg)
+	if cfg.createTable {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.timeout)
+		defer cancel()
+		if err := client.exec(ctx, createTableStatement(cfg), nil, nil); err != nil {
+			return nil, fmt.Errorf("unable to create clickhouse visibility table: %v", err)
+		}
+	}
+
+	store := &clickhouseVisibilityStore{
+		client:  client,
+		batcher: newBatcher(client, cfg, logger),
+		table:   cfg.table,
+		timeout: cfg.timeout,
+		logger:  logger,
+	}
+	store.batcher.start()
+	return store, nil
+}
+
+// createTableStatement returns DDL of the visibility table. Rows are deduplicated by the sorting key, i.e. namespace
+// and run id, skip indexes let filters by other columns read only the granules which can match. TTL removes only
+// rows with expire time, which is set for closed executions and tombstones.
+func createTableStatement(cfg *storeConfig) string {
+	return fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	namespace_id LowCardinality(String),
+	workflow_id String,
+	run_id String,
+	workflow_type LowCardinality(String),
+	task_queue LowCardinality(String),
+	status UInt8,
+	start_time Int64,
+	execution_time Int64,
+	close_time Int64,
+	history_length Int64,
+	memo String,
+	memo_encoding LowCardinality(String),
+	search_attributes String,
+	expire_time Int64,
+	version Int64,
+	is_deleted UInt8,
+	INDEX idx_workflow_id workflow_id TYPE bloom_filter GRANULARITY 4,
+	INDEX idx_workflow_type workflow_type TYPE set(1000) GRANULARITY 4,
+	INDEX idx_status status TYPE set(16) GRANULARITY 4,
+	INDEX idx_start_time start_time TYPE minmax GRANULARITY 4,
+	INDEX idx_close_time close_time TYPE minmax GRANULARITY 4
+) ENGINE = ReplacingMergeTree(version)
+ORDER BY (namespace_id, run_id)
+TTL toDateTime(intDiv(expire_time, 1000000000)) WHERE expire_time != 0`, cfg.table)
+}
+
+func (v *clickhouseVisibilityStore) Close() {
+	v.batcher.stop()
+}
+
+func (v *clickhouseVisibilityStore) GetName() string {
+	return persistenceName
+}
+
+// Deprecated.
+func (v *clickhouseVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRecordWorkflowExecutionStartedRequest) error {
+	return v.RecordWorkflowExecutionStartedV2(request)
+}
+
+func (v *clickhouseVisibilityStore) RecordWorkflowExecutionStartedV2(request *p.InternalRecordWorkflowExecutionStartedRequest) error {
+	return v.insert(v.newExecutionRow(request.InternalVisibilityRequestBase))
+}
+
+// Deprecated.
+func (v *clickhouseVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
+	return v.RecordWorkflowExecutionClosedV2(request)
+}
+
+func (v *clickhouseVisibilityStore) RecordWorkflowExecutionClosedV2(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
+	row := v.newExecutionRow(request.InternalVisibilityRequestBase)
+	row.CloseTime = request.CloseTimestamp
+	row.HistoryLength = request.HistoryLength
+	if request.RetentionSeconds > 0 {
+		row.ExpireTime = request.CloseTimestamp + request.RetentionSeconds*int64(time.Second)
+	}
+	return v.insert(row)
+}
+
+// Deprecated.
+func (v *clickhouseVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWorkflowExecutionRequest) error {
+	return v.UpsertWorkflowExecutionV2(request)
+}
+
+func (v *clickhouseVisibilityStore) UpsertWorkflowExecutionV2(request *p.InternalUpsertWorkflowExecutionRequest) error {
+	return v.insert(v.newExecutionRow(request.InternalVisibilityRequestBase))
+}
+
+// Deprecated.
+func (v *clickhouseVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	return v.DeleteWorkflowExecutionV2(request)
+}
+
+func (v *clickhouseVisibilityStore) DeleteWorkflowExecutionV2(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	return v.insert(&executionRow{
+		NamespaceID: request.NamespaceID,
+		WorkflowID:  request.WorkflowID,
+		RunID:       request.RunID,
+		// tombstone replaces any of the records it hides
+		ExpireTime: time.Now().Add(tombstoneRetention).UnixNano(),
+		Version:    math.MaxInt64,
+		IsDeleted:  1,
+	})
+}
+
+func (v *clickhouseVisibilityStore) newExecutionRow(request *p.InternalVisibilityRequestBase) *executionRow {
+	row := &executionRow{
+		NamespaceID:      request.NamespaceID,
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		WorkflowType:     request.WorkflowTypeName,
+		TaskQueue:        request.TaskQueue,
+		Status:           int32(request.Status),
+		StartTime:        request.StartTimestamp,
+		ExecutionTime:    request.ExecutionTimestamp,
+		Memo:             request.Memo.GetData(),
+		SearchAttributes: "{}",
+		Version:          request.TaskID,
+	}
+	if len(row.Memo) > 0 {
+		row.MemoEncoding = request.Memo.GetEncodingType().String()
+	}
+
+	attributes := make(map[string]interface{}, len(request.SearchAttributes))
+	for name, attributePayload := range request.SearchAttributes {
+		var value interface{}
+		// payload.Decode will set value and type to interface{} only if search attributes are serialized using JSON.
+		if err := payload.Decode(attributePayload, &value); err != nil {
+			v.logger.Error("Error when decode search attribute payload.", tag.Error(err), tag.ESField(name))
+			continue
+		}
+		attributes[name] = value
+	}
+	if data, err := json.Marshal(attributes); err == nil {
+		row.SearchAttributes = string(data)
+	} else {
+		v.logger.Error("Unable to encode search attributes.", tag.Error(err))
+	}
+	return row
+}
+
+func (v *clickhouseVisibilityStore) insert(row *executionRow) error {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("Unable to encode visibility record: %v", err))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), v.timeout)
+	defer cancel()
+	if err := v.batcher.insert(ctx, data); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("Unable to insert visibility record: %v", err))
+	}
+	return nil
+}
+
+func (v *clickhouseVisibilityStore) ListOpenWorkflowExecutions(
+	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return v.listByTimeRange(request, "close_time = 0", false)
+}
+
+func (v *clickhouseVisibilityStore) ListClosedWorkflowExecutions(
+	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return v.listByTimeRange(request, "close_time != 0", true)
+}
+
+func (v *clickhouseVisibilityStore) ListOpenWorkflowExecutionsByType(
+	request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return v.listByTimeRange(&request.ListWorkflowExecutionsRequest,
+		"close_time = 0 AND workflow_type = "+quoteString(request.WorkflowTypeName), false)
+}
+
+func (v *clickhouseVisibilityStore) ListClosedWorkflowExecutionsByType(
+	request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return v.listByTimeRange(&request.ListWorkflowExecutionsRequest,
+		"close_time != 0 AND workflow_type = "+quoteString(request.WorkflowTypeName), true)
+}
+
+func (v *clickhouseVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
+	request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return v.listByTimeRange(&request.ListWorkflowExecutionsRequest,
+		"close_time = 0 AND workflow_id = "+quoteString(request.WorkflowID), false)
+}
+
+func (v *clickhouseVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
+	request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return v.listByTimeRange(&request.ListWorkflowExecutionsRequest,
+		"close_time != 0 AND workflow_id = "+quoteString(request.WorkflowID), true)
+}
+
+func (v *clickhouseVisibilityStore) ListClosedWorkflowExecutionsByStatus(
+	request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return v.listByTimeRange(&request.ListWorkflowExecutionsRequest,
+		"close_time != 0 AND status = "+strconv.Itoa(int(request.Status)), true)
+}
+
+func (v *clickhouseVisibilityStore) GetClosedWorkflowExecution(
+	request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
+	execution := request.Execution
+	where := fmt.Sprintf("close_time != 0 AND workflow_id = %s AND run_id = %s",
+		quoteString(execution.GetWorkflowId()), quoteString(execution.GetRunId()))
+	rows, err := v.selectExecutions(request.NamespaceID, where, defaultSortKey, 1)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("GetClosedWorkflowExecution operation failed. Select failed: %v", err))
+	}
+	if len(rows) == 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+			execution.GetWorkflowId(), execution.GetRunId()))
+	}
+	return &p.InternalGetClosedWorkflowExecutionResponse{Execution: rows[0]}, nil
+}
+
+func (v *clickhouseVisibilityStore) ListWorkflowExecutions(
+	request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query, err := translateQuery(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Error when parse query: %v", err))
+	}
+	return v.list("ListWorkflowExecutions", request.NamespaceID, query.where, query.sort, request.PageSize, request.NextPageToken)
+}
+
+func (v *clickhouseVisibilityStore) ScanWorkflowExecutions(
+	request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query, err := translateQuery(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Error when parse query: %v", err))
+	}
+	// scan doesn't guarantee order, sorting by primary key is the cheapest stable order
+	return v.list("ScanWorkflowExecutions", request.NamespaceID, query.where, sortKey{column: "run_id"}, request.PageSize, request.NextPageToken)
+}
+
+func (v *clickhouseVisibilityStore) CountWorkflowExecutions(
+	request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
+	query, err := translateQuery(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Error when parse query: %v", err))
+	}
+
+	statement := fmt.Sprintf("SELECT count() AS count FROM %s FINAL WHERE %s", v.table, v.whereClause(request.NamespaceID, query.where))
+	ctx, cancel := context.WithTimeout(context.Background(), v.timeout)
+	defer cancel()
+	var result struct {
+		Count int64 `json:"count"`
+	}
+	if err := v.client.query(ctx, statement, func(row []byte) error {
+		return decodeRow(row, &result)
+	}); err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("CountWorkflowExecutions failed. Error: %v", err))
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: result.Count}, nil
+}
+
+func (v *clickhouseVisibilityStore) listByTimeRange(
+	request *p.ListWorkflowExecutionsRequest,
+	filter string,
+	isClosed bool,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	timeColumn := "start_time"
+	if isClosed {
+		timeColumn = "close_time"
+	}
+	where := fmt.Sprintf("%s AND %s BETWEEN %d AND %d", filter, timeColumn, request.EarliestStartTime, request.LatestStartTime)
+	sort := sortKey{column: timeColumn, descending: true}
+	return v.list("ListWorkflowExecutions", request.NamespaceID, where, sort, request.PageSize, request.NextPageToken)
+}
+
+func (v *clickhouseVisibilityStore) list(
+	opName string,
+	namespaceID string,
+	where string,
+	sort sortKey,
+	pageSize int,
+	nextPageToken []byte,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	token, err := deserializePageToken(nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	if token != nil {
+		after, err := sort.after(token.SortValue, token.RunID)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid page token: %v", err))
+		}
+		if where != "" {
+			where = fmt.Sprintf("(%s) AND %s", where, after)
+		} else {
+			where = after
+		}
+	}
+
+	// one more row tells whether there is a next page
+	rows, err := v.selectRows(namespaceID, where, sort, pageSize+1)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("%v operation failed. Select failed: %v", opName, err))
+	}
+
+	response := &p.InternalListWorkflowExecutionsResponse{}
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		last := rows[pageSize-1]
+		response.NextPageToken, err = serializePageToken(&clickhousePageToken{SortValue: last.SortValue, RunID: last.RunID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, row := range rows {
+		response.Executions = append(response.Executions, v.convertRowToVisibilityRecord(row))
+	}
+	return response, nil
+}
+
+func (v *clickhouseVisibilityStore) selectExecutions(
+	namespaceID string,
+	where string,
+	sort sortKey,
+	limit int,
+) ([]*p.VisibilityWorkflowExecutionInfo, error) {
+	rows, err := v.selectRows(namespaceID, where, sort, limit)
+	if err != nil {
+		return nil, err
+	}
+	executions := make([]*p.VisibilityWorkflowExecutionInfo, 0, len(rows))
+	for _, row := range rows {
+		executions = append(executions, v.convertRowToVisibilityRecord(row))
+	}
+	return executions, nil
+}
+
+func (v *clickhouseVisibilityStore) selectRows(
+	namespaceID string,
+	where string,
+	sort sortKey,
+	limit int,
+) ([]*executionRow, error) {
+	statement := fmt.Sprintf("SELECT %s, %s AS sort_value FROM %s FINAL WHERE %s ORDER BY %s LIMIT %d",
+		selectColumns, sort.column, v.table, v.whereClause(namespaceID, where), sort.orderBy(), limit)
+
+	ctx, cancel := context.WithTimeout(context.Background(), v.timeout)
+	defer cancel()
+	var rows []*executionRow
+	err := v.client.query(ctx, statement, func(data []byte) error {
+		var row executionRow
+		if err := decodeRow(data, &row); err != nil {
+			return err
+		}
+		rows = append(rows, &row)
+		return nil
+	})
+	return rows, err
+}
+
+func (v *clickhouseVisibilityStore) whereClause(namespaceID string, filter string) string {
+	clauses := []string{"namespace_id = " + quoteString(namespaceID), "is_deleted = 0"}
+	if filter != "" {
+		clauses = append(clauses, "("+filter+")")
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+func (v *clickhouseVisibilityStore) convertRowToVisibilityRecord(row *executionRow) *p.VisibilityWorkflowExecutionInfo {
+	record := &p.VisibilityWorkflowExecutionInfo{
+		WorkflowID:    row.WorkflowID,
+		RunID:         row.RunID,
+		TypeName:      row.WorkflowType,
+		StartTime:     time.Unix(0, row.StartTime).UTC(),
+		ExecutionTime: time.Unix(0, row.ExecutionTime).UTC(),
+		Memo:          p.NewDataBlob(row.Memo, row.MemoEncoding),
+		TaskQueue:     row.TaskQueue,
+		Status:        enumspb.WorkflowExecutionStatus(row.Status),
+	}
+	if row.CloseTime != 0 {
+		record.CloseTime = time.Unix(0, row.CloseTime).UTC()
+		record.HistoryLength = row.HistoryLength
+	}
+	if row.SearchAttributes != "" {
+		if err := json.Unmarshal([]byte(row.SearchAttributes), &record.SearchAttributes); err != nil {
+			v.logger.Error("Unable to decode search attributes.", tag.Error(err), tag.WorkflowRunID(row.RunID))
+		}
+	}
+	return record
+}
+
+func deserializePageToken(data []byte) (*clickhousePageToken, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	token := &clickhousePageToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unable to deserialize page token. err: %v", err))
+	}
+	return token, nil
+}
+
+func serializePageToken(token *clickhousePageToken) ([]byte, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("unable to serialize page token. err: %v", err))
+	}
+	return data, nil
+}
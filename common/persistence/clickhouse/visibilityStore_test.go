@@ -0,0 +1,261 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clickhouse
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/payload"
+	p "go.temporal.io/server/common/persistence"
+)
+
+type (
+	// fakeClickHouse records statements sent to ClickHouse HTTP interface and answers selects with rows
+	fakeClickHouse struct {
+		sync.Mutex
+		statements []string
+		inserted   []executionRow
+		rows       []string
+	}
+)
+
+func (f *fakeClickHouse) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	body, _ := ioutil.ReadAll(r.Body)
+	if statement := r.URL.Query().Get("query"); statement != "" {
+		f.statements = append(f.statements, statement)
+		for _, line := range strings.Split(strings.TrimSpace(string(body)), "\n") {
+			if line == "" {
+				continue
+			}
+			var row executionRow
+			if err := json.Unmarshal([]byte(line), &row); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			f.inserted = append(f.inserted, row)
+		}
+		return
+	}
+	f.statements = append(f.statements, string(body))
+	_, _ = w.Write([]byte(strings.Join(f.rows, "\n")))
+}
+
+func newTestStore(t *testing.T, server *httptest.Server, options map[string]string) *clickhouseVisibilityStore {
+	options[OptionURL] = server.URL
+	store, err := NewVisibilityStore(options, loggerimpl.NewNopLogger())
+	require.NoError(t, err)
+	return store.(*clickhouseVisibilityStore)
+}
+
+func TestVisibilityStore_Record(t *testing.T) {
+	fake := &fakeClickHouse{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	store := newTestStore(t, server, map[string]string{OptionCreateTable: "true", OptionFlushInterval: "10ms"})
+	defer store.Close()
+
+	attribute, err := payload.Encode("gold")
+	require.NoError(t, err)
+	base := &p.InternalVisibilityRequestBase{
+		NamespaceID:      "namespace-id",
+		WorkflowID:       "workflow-id",
+		RunID:            "run-id",
+		WorkflowTypeName: "order",
+		StartTimestamp:   100,
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		TaskID:           7,
+		Memo:             p.NewDataBlob([]byte("memo"), enumspb.ENCODING_TYPE_PROTO3.String()),
+		SearchAttributes: map[string]*commonpb.Payload{"CustomKeywordField": attribute},
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, store.RecordWorkflowExecutionStartedV2(&p.InternalRecordWorkflowExecutionStartedRequest{InternalVisibilityRequestBase: base}))
+	}()
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, store.RecordWorkflowExecutionClosedV2(&p.InternalRecordWorkflowExecutionClosedRequest{
+			InternalVisibilityRequestBase: base,
+			CloseTimestamp:                200,
+			HistoryLength:                 11,
+			RetentionSeconds:              86400,
+		}))
+	}()
+	wg.Wait()
+	require.NoError(t, store.DeleteWorkflowExecutionV2(&p.VisibilityDeleteWorkflowExecutionRequest{
+		NamespaceID: "namespace-id",
+		WorkflowID:  "workflow-id",
+		RunID:       "run-id",
+		TaskID:      8,
+	}))
+
+	fake.Lock()
+	defer fake.Unlock()
+	assert.Contains(t, fake.statements[0], "CREATE TABLE IF NOT EXISTS executions")
+	assert.Contains(t, fake.statements[0], "TTL toDateTime(intDiv(expire_time, 1000000000)) WHERE expire_time != 0")
+	require.Len(t, fake.inserted, 3)
+	var closed executionRow
+	for _, row := range fake.inserted[:2] {
+		assert.Equal(t, "order", row.WorkflowType)
+		assert.Equal(t, []byte("memo"), row.Memo)
+		assert.Equal(t, `{"CustomKeywordField":"gold"}`, row.SearchAttributes)
+		if row.CloseTime != 0 {
+			closed = row
+		} else {
+			// open executions never expire
+			assert.Zero(t, row.ExpireTime)
+		}
+	}
+	assert.Equal(t, int64(200), closed.CloseTime)
+	assert.Equal(t, int64(11), closed.HistoryLength)
+	assert.Equal(t, int64(200)+int64(24*time.Hour), closed.ExpireTime)
+	assert.Equal(t, uint8(1), fake.inserted[2].IsDeleted)
+	assert.NotZero(t, fake.inserted[2].ExpireTime)
+}
+
+func TestVisibilityStore_List(t *testing.T) {
+	fake := &fakeClickHouse{rows: []string{
+		`{"namespace_id":"namespace-id","workflow_id":"w1","run_id":"r1","workflow_type":"order","status":1,"start_time":100,"memo":"bWVtbw==","memo_encoding":"Proto3","search_attributes":"{\"CustomIntField\":5}","sort_value":100}`,
+		`{"namespace_id":"namespace-id","workflow_id":"w2","run_id":"r2","workflow_type":"order","status":2,"start_time":50,"close_time":60,"history_length":3,"search_attributes":"{}","sort_value":50}`,
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	store := newTestStore(t, server, map[string]string{})
+	defer store.Close()
+
+	response, err := store.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+		NamespaceID: "namespace-id",
+		PageSize:    1,
+		Query:       "WorkflowType = 'order'",
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Executions, 1)
+	execution := response.Executions[0]
+	assert.Equal(t, "w1", execution.WorkflowID)
+	assert.Equal(t, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, execution.Status)
+	assert.Equal(t, []byte("memo"), execution.Memo.GetData())
+	assert.Equal(t, float64(5), execution.SearchAttributes["CustomIntField"])
+	assert.True(t, execution.CloseTime.IsZero())
+	assert.Equal(t, `{"SortValue":100,"RunID":"r1"}`, string(response.NextPageToken))
+
+	_, err = store.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   "namespace-id",
+		PageSize:      1,
+		NextPageToken: response.NextPageToken,
+	})
+	require.NoError(t, err)
+
+	fake.rows = []string{`{"count":42}`}
+	count, err := store.CountWorkflowExecutions(&p.CountWorkflowExecutionsRequest{NamespaceID: "namespace-id", Query: "CloseTime = missing"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), count.Count)
+
+	fake.rows = nil
+	_, err = store.GetClosedWorkflowExecution(&p.GetClosedWorkflowExecutionRequest{
+		NamespaceID: "namespace-id",
+		Execution:   commonpb.WorkflowExecution{WorkflowId: "w1", RunId: "r1"},
+	})
+	assert.IsType(t, &serviceerror.NotFound{}, err)
+
+	_, err = store.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{NamespaceID: "namespace-id", Query: "Unknown = 1"})
+	assert.IsType(t, &serviceerror.InvalidArgument{}, err)
+
+	// sort value of page token is not embedded as is
+	_, err = store.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   "namespace-id",
+		NextPageToken: []byte(`{"SortValue":{"a":1},"RunID":"r1"}`),
+	})
+	assert.IsType(t, &serviceerror.InvalidArgument{}, err)
+
+	_, err = store.ScanWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   "namespace-id",
+		PageSize:      10,
+		NextPageToken: []byte(`{"RunID":"r1'"}`),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"SELECT " + selectColumns + ", start_time AS sort_value FROM executions FINAL WHERE namespace_id = 'namespace-id' AND is_deleted = 0 " +
+			"AND (workflow_type = 'order') ORDER BY start_time DESC, run_id DESC LIMIT 2 FORMAT JSONEachRow",
+		"SELECT " + selectColumns + ", start_time AS sort_value FROM executions FINAL WHERE namespace_id = 'namespace-id' AND is_deleted = 0 " +
+			"AND ((start_time, run_id) < (100, 'r1')) ORDER BY start_time DESC, run_id DESC LIMIT 2 FORMAT JSONEachRow",
+		"SELECT count() AS count FROM executions FINAL WHERE namespace_id = 'namespace-id' AND is_deleted = 0 AND (close_time = 0) FORMAT JSONEachRow",
+		"SELECT " + selectColumns + ", start_time AS sort_value FROM executions FINAL WHERE namespace_id = 'namespace-id' AND is_deleted = 0 AND " +
+			"(close_time != 0 AND workflow_id = 'w1' AND run_id = 'r1') ORDER BY start_time DESC, run_id DESC LIMIT 1 FORMAT JSONEachRow",
+		"SELECT " + selectColumns + ", run_id AS sort_value FROM executions FINAL WHERE namespace_id = 'namespace-id' AND is_deleted = 0 " +
+			"AND (run_id > 'r1\\'') ORDER BY run_id ASC LIMIT 11 FORMAT JSONEachRow",
+	}, fake.statements)
+}
+
+func TestVisibilityStore_InsertError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Code: 60. DB::Exception: Table doesn't exist", http.StatusNotFound)
+	}))
+	defer server.Close()
+	store := newTestStore(t, server, map[string]string{OptionFlushInterval: "10ms"})
+	defer store.Close()
+
+	err := store.UpsertWorkflowExecutionV2(&p.InternalUpsertWorkflowExecutionRequest{
+		InternalVisibilityRequestBase: &p.InternalVisibilityRequestBase{NamespaceID: "namespace-id"},
+	})
+	assert.IsType(t, &serviceerror.Internal{}, err)
+	assert.Contains(t, err.Error(), "Table doesn't exist")
+}
+
+func TestNewStoreConfig(t *testing.T) {
+	cfg, err := newStoreConfig(map[string]string{OptionBatchSize: "10", OptionAsyncInsert: "false"})
+	require.NoError(t, err)
+	assert.Equal(t, 10, cfg.batchSize)
+	assert.False(t, cfg.asyncInsert)
+	assert.Equal(t, defaultTable, cfg.table)
+
+	for _, options := range []map[string]string{
+		{OptionBatchSize: "0"},
+		{OptionTimeout: "soon"},
+		{OptionTable: "executions; DROP TABLE executions"},
+	} {
+		_, err := newStoreConfig(options)
+		assert.Error(t, err)
+	}
+}
@@ -52,6 +52,21 @@ type (
 		GetNamespaceReplicationQueue() persistence.NamespaceReplicationQueue
 		SetNamespaceReplicationQueue(persistence.NamespaceReplicationQueue)
 
+		GetSignalDLQ() persistence.SignalDLQ
+		SetSignalDLQ(persistence.SignalDLQ)
+
+		GetNamespaceRegistrationStore() persistence.NamespaceRegistrationStore
+		SetNamespaceRegistrationStore(persistence.NamespaceRegistrationStore)
+
+		GetIntakeQueue() persistence.IntakeQueue
+		SetIntakeQueue(persistence.IntakeQueue)
+
+		GetConsistencyMarkerStore() persistence.ConsistencyMarkerStore
+		SetConsistencyMarkerStore(persistence.ConsistencyMarkerStore)
+
+		GetLeaderLeaseStore() persistence.LeaderLeaseStore
+		SetLeaderLeaseStore(persistence.LeaderLeaseStore)
+
 		GetShardManager() persistence.ShardManager
 		SetShardManager(persistence.ShardManager)
 
@@ -60,6 +75,8 @@ type (
 
 		GetExecutionManager(int32) (persistence.ExecutionManager, error)
 		SetExecutionManager(int32, persistence.ExecutionManager)
+
+		GetShardJournal(int32) (persistence.ShardJournal, error)
 	}
 
 	// BeanImpl stores persistence managers
@@ -69,12 +86,19 @@ type (
 		taskManager               persistence.TaskManager
 		visibilityManager         persistence.VisibilityManager
 		namespaceReplicationQueue persistence.NamespaceReplicationQueue
+		signalDLQ                 persistence.SignalDLQ
+		registrationStore         persistence.NamespaceRegistrationStore
+		intakeQueue               persistence.IntakeQueue
+		consistencyMarkerStore    persistence.ConsistencyMarkerStore
+		leaderLeaseStore          persistence.LeaderLeaseStore
 		shardManager              persistence.ShardManager
 		historyManager            persistence.HistoryManager
 		executionManagerFactory   persistence.ExecutionManagerFactory
+		shardJournalFactory       persistence.ShardJournalFactory
 
 		sync.RWMutex
 		shardIDToExecutionManager map[int32]persistence.ExecutionManager
+		shardIDToShardJournal     map[int32]persistence.ShardJournal
 	}
 )
 
@@ -109,6 +133,31 @@ func NewBeanFromFactory(
 		return nil, err
 	}
 
+	signalDLQ, err := factory.NewSignalDLQ()
+	if err != nil {
+		return nil, err
+	}
+
+	registrationStore, err := factory.NewNamespaceRegistrationStore()
+	if err != nil {
+		return nil, err
+	}
+
+	intakeQueue, err := factory.NewIntakeQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	consistencyMarkerStore, err := factory.NewConsistencyMarkerStore()
+	if err != nil {
+		return nil, err
+	}
+
+	leaderLeaseStore, err := factory.NewLeaderLeaseStore()
+	if err != nil {
+		return nil, err
+	}
+
 	shardMgr, err := factory.NewShardManager()
 	if err != nil {
 		return nil, err
@@ -125,9 +174,15 @@ func NewBeanFromFactory(
 		taskMgr,
 		visibilityMgr,
 		namespaceReplicationQueue,
+		signalDLQ,
+		registrationStore,
+		intakeQueue,
+		consistencyMarkerStore,
+		leaderLeaseStore,
 		shardMgr,
 		historyMgr,
 		factory,
+		factory,
 	), nil
 }
 
@@ -138,9 +193,15 @@ func NewBean(
 	taskManager persistence.TaskManager,
 	visibilityManager persistence.VisibilityManager,
 	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
+	signalDLQ persistence.SignalDLQ,
+	registrationStore persistence.NamespaceRegistrationStore,
+	intakeQueue persistence.IntakeQueue,
+	consistencyMarkerStore persistence.ConsistencyMarkerStore,
+	leaderLeaseStore persistence.LeaderLeaseStore,
 	shardManager persistence.ShardManager,
 	historyManager persistence.HistoryManager,
 	executionManagerFactory persistence.ExecutionManagerFactory,
+	shardJournalFactory persistence.ShardJournalFactory,
 ) *BeanImpl {
 	return &BeanImpl{
 		clusterMetadataManager:    clusterMetadataManager,
@@ -148,11 +209,18 @@ func NewBean(
 		taskManager:               taskManager,
 		visibilityManager:         visibilityManager,
 		namespaceReplicationQueue: namespaceReplicationQueue,
+		signalDLQ:                 signalDLQ,
+		registrationStore:         registrationStore,
+		intakeQueue:               intakeQueue,
+		consistencyMarkerStore:    consistencyMarkerStore,
+		leaderLeaseStore:          leaderLeaseStore,
 		shardManager:              shardManager,
 		historyManager:            historyManager,
 		executionManagerFactory:   executionManagerFactory,
+		shardJournalFactory:       shardJournalFactory,
 
 		shardIDToExecutionManager: make(map[int32]persistence.ExecutionManager),
+		shardIDToShardJournal:     make(map[int32]persistence.ShardJournal),
 	}
 }
 
@@ -255,6 +323,106 @@ func (s *BeanImpl) SetNamespaceReplicationQueue(
 	s.namespaceReplicationQueue = namespaceReplicationQueue
 }
 
+// GetSignalDLQ get SignalDLQ
+func (s *BeanImpl) GetSignalDLQ() persistence.SignalDLQ {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.signalDLQ
+}
+
+// SetSignalDLQ set SignalDLQ
+func (s *BeanImpl) SetSignalDLQ(
+	signalDLQ persistence.SignalDLQ,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.signalDLQ = signalDLQ
+}
+
+// GetNamespaceRegistrationStore get NamespaceRegistrationStore
+func (s *BeanImpl) GetNamespaceRegistrationStore() persistence.NamespaceRegistrationStore {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.registrationStore
+}
+
+// SetNamespaceRegistrationStore set NamespaceRegistrationStore
+func (s *BeanImpl) SetNamespaceRegistrationStore(
+	registrationStore persistence.NamespaceRegistrationStore,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.registrationStore = registrationStore
+}
+
+// GetIntakeQueue get IntakeQueue
+func (s *BeanImpl) GetIntakeQueue() persistence.IntakeQueue {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.intakeQueue
+}
+
+// SetIntakeQueue set IntakeQueue
+func (s *BeanImpl) SetIntakeQueue(
+	intakeQueue persistence.IntakeQueue,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.intakeQueue = intakeQueue
+}
+
+// GetConsistencyMarkerStore get ConsistencyMarkerStore
+func (s *BeanImpl) GetConsistencyMarkerStore() persistence.ConsistencyMarkerStore {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.consistencyMarkerStore
+}
+
+// SetConsistencyMarkerStore set ConsistencyMarkerStore
+func (s *BeanImpl) SetConsistencyMarkerStore(
+	consistencyMarkerStore persistence.ConsistencyMarkerStore,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.consistencyMarkerStore = consistencyMarkerStore
+}
+
+// GetLeaderLeaseStore get LeaderLeaseStore
+func (s *BeanImpl) GetLeaderLeaseStore() persistence.LeaderLeaseStore {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.leaderLeaseStore
+}
+
+// SetLeaderLeaseStore set LeaderLeaseStore
+func (s *BeanImpl) SetLeaderLeaseStore(
+	leaderLeaseStore persistence.LeaderLeaseStore,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.leaderLeaseStore = leaderLeaseStore
+}
+
 // GetShardManager get ShardManager
 func (s *BeanImpl) GetShardManager() persistence.ShardManager {
 
@@ -337,6 +505,36 @@ func (s *BeanImpl) SetExecutionManager(
 	s.shardIDToExecutionManager[shardID] = executionManager
 }
 
+// GetShardJournal get ShardJournal
+func (s *BeanImpl) GetShardJournal(
+	shardID int32,
+) (persistence.ShardJournal, error) {
+
+	s.RLock()
+	shardJournal, ok := s.shardIDToShardJournal[shardID]
+	if ok {
+		s.RUnlock()
+		return shardJournal, nil
+	}
+	s.RUnlock()
+
+	s.Lock()
+	defer s.Unlock()
+
+	shardJournal, ok = s.shardIDToShardJournal[shardID]
+	if ok {
+		return shardJournal, nil
+	}
+
+	shardJournal, err := s.shardJournalFactory.NewShardJournal(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.shardIDToShardJournal[shardID] = shardJournal
+	return shardJournal, nil
+}
+
 // Close cleanup connections
 func (s *BeanImpl) Close() {
 
@@ -354,4 +552,7 @@ func (s *BeanImpl) Close() {
 	for _, executionMgr := range s.shardIDToExecutionManager {
 		executionMgr.Close()
 	}
+	for _, shardJournal := range s.shardIDToShardJournal {
+		shardJournal.Close()
+	}
 }
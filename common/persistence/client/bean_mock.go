@@ -84,6 +84,20 @@ func (mr *MockBeanMockRecorder) GetClusterMetadataManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterMetadataManager", reflect.TypeOf((*MockBean)(nil).GetClusterMetadataManager))
 }
 
+// GetConsistencyMarkerStore mocks base method.
+func (m *MockBean) GetConsistencyMarkerStore() persistence.ConsistencyMarkerStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsistencyMarkerStore")
+	ret0, _ := ret[0].(persistence.ConsistencyMarkerStore)
+	return ret0
+}
+
+// GetConsistencyMarkerStore indicates an expected call of GetConsistencyMarkerStore.
+func (mr *MockBeanMockRecorder) GetConsistencyMarkerStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsistencyMarkerStore", reflect.TypeOf((*MockBean)(nil).GetConsistencyMarkerStore))
+}
+
 // GetExecutionManager mocks base method.
 func (m *MockBean) GetExecutionManager(arg0 int32) (persistence.ExecutionManager, error) {
 	m.ctrl.T.Helper()
@@ -113,6 +127,34 @@ func (mr *MockBeanMockRecorder) GetHistoryManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryManager", reflect.TypeOf((*MockBean)(nil).GetHistoryManager))
 }
 
+// GetIntakeQueue mocks base method.
+func (m *MockBean) GetIntakeQueue() persistence.IntakeQueue {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIntakeQueue")
+	ret0, _ := ret[0].(persistence.IntakeQueue)
+	return ret0
+}
+
+// GetIntakeQueue indicates an expected call of GetIntakeQueue.
+func (mr *MockBeanMockRecorder) GetIntakeQueue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIntakeQueue", reflect.TypeOf((*MockBean)(nil).GetIntakeQueue))
+}
+
+// GetLeaderLeaseStore mocks base method.
+func (m *MockBean) GetLeaderLeaseStore() persistence.LeaderLeaseStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderLeaseStore")
+	ret0, _ := ret[0].(persistence.LeaderLeaseStore)
+	return ret0
+}
+
+// GetLeaderLeaseStore indicates an expected call of GetLeaderLeaseStore.
+func (mr *MockBeanMockRecorder) GetLeaderLeaseStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderLeaseStore", reflect.TypeOf((*MockBean)(nil).GetLeaderLeaseStore))
+}
+
 // GetMetadataManager mocks base method.
 func (m *MockBean) GetMetadataManager() persistence.MetadataManager {
 	m.ctrl.T.Helper()
@@ -127,6 +169,20 @@ func (mr *MockBeanMockRecorder) GetMetadataManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadataManager", reflect.TypeOf((*MockBean)(nil).GetMetadataManager))
 }
 
+// GetNamespaceRegistrationStore mocks base method.
+func (m *MockBean) GetNamespaceRegistrationStore() persistence.NamespaceRegistrationStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceRegistrationStore")
+	ret0, _ := ret[0].(persistence.NamespaceRegistrationStore)
+	return ret0
+}
+
+// GetNamespaceRegistrationStore indicates an expected call of GetNamespaceRegistrationStore.
+func (mr *MockBeanMockRecorder) GetNamespaceRegistrationStore() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceRegistrationStore", reflect.TypeOf((*MockBean)(nil).GetNamespaceRegistrationStore))
+}
+
 // GetNamespaceReplicationQueue mocks base method.
 func (m *MockBean) GetNamespaceReplicationQueue() persistence.NamespaceReplicationQueue {
 	m.ctrl.T.Helper()
@@ -141,6 +197,21 @@ func (mr *MockBeanMockRecorder) GetNamespaceReplicationQueue() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationQueue", reflect.TypeOf((*MockBean)(nil).GetNamespaceReplicationQueue))
 }
 
+// GetShardJournal mocks base method.
+func (m *MockBean) GetShardJournal(arg0 int32) (persistence.ShardJournal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShardJournal", arg0)
+	ret0, _ := ret[0].(persistence.ShardJournal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShardJournal indicates an expected call of GetShardJournal.
+func (mr *MockBeanMockRecorder) GetShardJournal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardJournal", reflect.TypeOf((*MockBean)(nil).GetShardJournal), arg0)
+}
+
 // GetShardManager mocks base method.
 func (m *MockBean) GetShardManager() persistence.ShardManager {
 	m.ctrl.T.Helper()
@@ -155,6 +226,20 @@ func (mr *MockBeanMockRecorder) GetShardManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShardManager", reflect.TypeOf((*MockBean)(nil).GetShardManager))
 }
 
+// GetSignalDLQ mocks base method.
+func (m *MockBean) GetSignalDLQ() persistence.SignalDLQ {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignalDLQ")
+	ret0, _ := ret[0].(persistence.SignalDLQ)
+	return ret0
+}
+
+// GetSignalDLQ indicates an expected call of GetSignalDLQ.
+func (mr *MockBeanMockRecorder) GetSignalDLQ() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignalDLQ", reflect.TypeOf((*MockBean)(nil).GetSignalDLQ))
+}
+
 // GetTaskManager mocks base method.
 func (m *MockBean) GetTaskManager() persistence.TaskManager {
 	m.ctrl.T.Helper()
@@ -195,6 +280,18 @@ func (mr *MockBeanMockRecorder) SetClusterMetadataManager(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClusterMetadataManager", reflect.TypeOf((*MockBean)(nil).SetClusterMetadataManager), arg0)
 }
 
+// SetConsistencyMarkerStore mocks base method.
+func (m *MockBean) SetConsistencyMarkerStore(arg0 persistence.ConsistencyMarkerStore) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetConsistencyMarkerStore", arg0)
+}
+
+// SetConsistencyMarkerStore indicates an expected call of SetConsistencyMarkerStore.
+func (mr *MockBeanMockRecorder) SetConsistencyMarkerStore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConsistencyMarkerStore", reflect.TypeOf((*MockBean)(nil).SetConsistencyMarkerStore), arg0)
+}
+
 // SetExecutionManager mocks base method.
 func (m *MockBean) SetExecutionManager(arg0 int32, arg1 persistence.ExecutionManager) {
 	m.ctrl.T.Helper()
@@ -219,6 +316,30 @@ func (mr *MockBeanMockRecorder) SetHistoryManager(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHistoryManager", reflect.TypeOf((*MockBean)(nil).SetHistoryManager), arg0)
 }
 
+// SetIntakeQueue mocks base method.
+func (m *MockBean) SetIntakeQueue(arg0 persistence.IntakeQueue) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetIntakeQueue", arg0)
+}
+
+// SetIntakeQueue indicates an expected call of SetIntakeQueue.
+func (mr *MockBeanMockRecorder) SetIntakeQueue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIntakeQueue", reflect.TypeOf((*MockBean)(nil).SetIntakeQueue), arg0)
+}
+
+// SetLeaderLeaseStore mocks base method.
+func (m *MockBean) SetLeaderLeaseStore(arg0 persistence.LeaderLeaseStore) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLeaderLeaseStore", arg0)
+}
+
+// SetLeaderLeaseStore indicates an expected call of SetLeaderLeaseStore.
+func (mr *MockBeanMockRecorder) SetLeaderLeaseStore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLeaderLeaseStore", reflect.TypeOf((*MockBean)(nil).SetLeaderLeaseStore), arg0)
+}
+
 // SetMetadataManager mocks base method.
 func (m *MockBean) SetMetadataManager(arg0 persistence.MetadataManager) {
 	m.ctrl.T.Helper()
@@ -231,6 +352,18 @@ func (mr *MockBeanMockRecorder) SetMetadataManager(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMetadataManager", reflect.TypeOf((*MockBean)(nil).SetMetadataManager), arg0)
 }
 
+// SetNamespaceRegistrationStore mocks base method.
+func (m *MockBean) SetNamespaceRegistrationStore(arg0 persistence.NamespaceRegistrationStore) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNamespaceRegistrationStore", arg0)
+}
+
+// SetNamespaceRegistrationStore indicates an expected call of SetNamespaceRegistrationStore.
+func (mr *MockBeanMockRecorder) SetNamespaceRegistrationStore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNamespaceRegistrationStore", reflect.TypeOf((*MockBean)(nil).SetNamespaceRegistrationStore), arg0)
+}
+
 // SetNamespaceReplicationQueue mocks base method.
 func (m *MockBean) SetNamespaceReplicationQueue(arg0 persistence.NamespaceReplicationQueue) {
 	m.ctrl.T.Helper()
@@ -255,6 +388,18 @@ func (mr *MockBeanMockRecorder) SetShardManager(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShardManager", reflect.TypeOf((*MockBean)(nil).SetShardManager), arg0)
 }
 
+// SetSignalDLQ mocks base method.
+func (m *MockBean) SetSignalDLQ(arg0 persistence.SignalDLQ) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSignalDLQ", arg0)
+}
+
+// SetSignalDLQ indicates an expected call of SetSignalDLQ.
+func (mr *MockBeanMockRecorder) SetSignalDLQ(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSignalDLQ", reflect.TypeOf((*MockBean)(nil).SetSignalDLQ), arg0)
+}
+
 // SetTaskManager mocks base method.
 func (m *MockBean) SetTaskManager(arg0 persistence.TaskManager) {
 	m.ctrl.T.Helper()
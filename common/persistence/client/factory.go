@@ -62,6 +62,18 @@ type (
 		NewVisibilityManager() (p.VisibilityManager, error)
 		// NewNamespaceReplicationQueue returns a new queue for namespace replication
 		NewNamespaceReplicationQueue() (p.NamespaceReplicationQueue, error)
+		// NewSignalDLQ returns a new queue for undeliverable signals
+		NewSignalDLQ() (p.SignalDLQ, error)
+		// NewNamespaceRegistrationStore returns a new store for namespace registrations which wait for approval
+		NewNamespaceRegistrationStore() (p.NamespaceRegistrationStore, error)
+		// NewIntakeQueue returns a new queue for requests accepted in async mode
+		NewIntakeQueue() (p.IntakeQueue, error)
+		// NewConsistencyMarkerStore returns a new store for cluster wide consistency markers
+		NewConsistencyMarkerStore() (p.ConsistencyMarkerStore, error)
+		// NewLeaderLeaseStore returns a new store for leases of singleton jobs
+		NewLeaderLeaseStore() (p.LeaderLeaseStore, error)
+		// NewShardJournal returns a new write-ahead journal for a given shardID
+		NewShardJournal(shardID int32) (p.ShardJournal, error)
 		// NewClusterMetadata returns a new manager for cluster specific metadata
 		NewClusterMetadataManager() (p.ClusterMetadataManager, error)
 	}
@@ -199,7 +211,7 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit, f.config.HistoryChecksumVerification, f.metricsClient)
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -309,6 +321,109 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 	return p.NewNamespaceReplicationQueue(result, f.clusterName, f.metricsClient, f.logger), nil
 }
 
+func (f *factoryImpl) NewSignalDLQ() (p.SignalDLQ, error) {
+	ds := f.datastores[storeTypeQueue]
+	return p.NewSignalDLQ(func(queueType p.QueueType) (p.Queue, error) {
+		result, err := ds.factory.NewQueue(queueType)
+		if err != nil {
+			return nil, err
+		}
+		if ds.ratelimit != nil {
+			result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		}
+		if f.metricsClient != nil {
+			result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
+		}
+		return result, nil
+	}), nil
+}
+
+func (f *factoryImpl) NewIntakeQueue() (p.IntakeQueue, error) {
+	ds := f.datastores[storeTypeQueue]
+	partitions := make([]p.Queue, p.IntakeQueuePartitionCount)
+	for partition := range partitions {
+		result, err := ds.factory.NewQueue(p.IntakeQueueType(int32(partition)))
+		if err != nil {
+			return nil, err
+		}
+		if ds.ratelimit != nil {
+			result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		}
+		if f.metricsClient != nil {
+			result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
+		}
+		partitions[partition] = result
+	}
+
+	return p.NewIntakeQueue(partitions), nil
+}
+
+func (f *factoryImpl) NewNamespaceRegistrationStore() (p.NamespaceRegistrationStore, error) {
+	ds := f.datastores[storeTypeQueue]
+	return p.NewNamespaceRegistrationStore(func() (p.Queue, error) {
+		result, err := ds.factory.NewQueue(p.NamespaceRegistrationQueueType)
+		if err != nil {
+			return nil, err
+		}
+		if ds.ratelimit != nil {
+			result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		}
+		if f.metricsClient != nil {
+			result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
+		}
+		return result, nil
+	}), nil
+}
+
+func (f *factoryImpl) NewConsistencyMarkerStore() (p.ConsistencyMarkerStore, error) {
+	ds := f.datastores[storeTypeQueue]
+	result, err := ds.factory.NewQueue(p.ConsistencyMarkerQueueType)
+	if err != nil {
+		return nil, err
+	}
+	if ds.ratelimit != nil {
+		result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+	}
+	if f.metricsClient != nil {
+		result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
+	}
+
+	return p.NewConsistencyMarkerStore(result), nil
+}
+
+func (f *factoryImpl) NewLeaderLeaseStore() (p.LeaderLeaseStore, error) {
+	ds := f.datastores[storeTypeQueue]
+	return p.NewLeaderLeaseStore(func(queueType p.QueueType) (p.Queue, error) {
+		result, err := ds.factory.NewQueue(queueType)
+		if err != nil {
+			return nil, err
+		}
+		if ds.ratelimit != nil {
+			result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		}
+		if f.metricsClient != nil {
+			result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
+		}
+		return result, nil
+	}), nil
+}
+
+func (f *factoryImpl) NewShardJournal(shardID int32) (p.ShardJournal, error) {
+	ds := f.datastores[storeTypeQueue]
+	result, err := ds.factory.NewQueue(p.ShardJournalQueueType(shardID))
+	if err != nil {
+		return nil, err
+	}
+	if ds.ratelimit != nil {
+		result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+	}
+	if f.metricsClient != nil {
+		result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
+	}
+
+	return p.NewShardJournal(result), nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
@@ -346,7 +461,7 @@ func (f *factoryImpl) init(
 		case defaultCfg.Cassandra != nil:
 			defaultDataStore.factory = cassandra.NewFactory(*defaultCfg.Cassandra, r, clusterName, f.logger)
 		case defaultCfg.SQL != nil:
-			defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, r, clusterName, f.logger)
+			defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, r, clusterName, f.metricsClient, f.logger)
 		case defaultCfg.CustomDataStoreConfig != nil:
 			defaultDataStore.factory = f.abstractDataStoreFactory.NewFactory(*defaultCfg.CustomDataStoreConfig, clusterName, f.logger)
 		default:
@@ -360,9 +475,15 @@ func (f *factoryImpl) init(
 		case visibilityCfg.Cassandra != nil:
 			visibilityDataStore.factory = cassandra.NewFactory(*visibilityCfg.Cassandra, r, clusterName, f.logger)
 		case visibilityCfg.SQL != nil:
-			visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, r, clusterName, f.logger)
+			visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, r, clusterName, f.metricsClient, f.logger)
+		case visibilityCfg.CustomVisibilityStore != nil:
+			factory, err := newVisibilityStorePluginFactory(*visibilityCfg.CustomVisibilityStore, clusterName, f.logger)
+			if err != nil {
+				return fmt.Errorf("invalid config: %w", err)
+			}
+			visibilityDataStore.factory = factory
 		default:
-			return fmt.Errorf("invalid config: one of cassandra or sql params must be specified for visibility store")
+			return fmt.Errorf("invalid config: one of cassandra, sql or custom visibility store params must be specified for visibility store")
 		}
 		return nil
 	})
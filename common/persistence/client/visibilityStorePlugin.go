@@ -0,0 +1,85 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"fmt"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	// VisibilityStorePlugin creates visibility stores backed by datastores the server doesn't support natively,
+	// e.g. ClickHouse, BigQuery or Snowflake. The plugin is selected by name with customVisibilityStore config
+	// of the datastore used for visibility. Stores are wrapped with rate limiting, sampling and metrics the same
+	// way as built-in ones.
+	VisibilityStorePlugin interface {
+		NewVisibilityStore(cfg config.CustomDatastoreConfig, clusterName string, logger log.Logger) (p.VisibilityStore, error)
+	}
+
+	// visibilityStorePluginFactory is the DataStoreFactory of the visibility datastore implemented by a plugin,
+	// it vends only visibility stores.
+	visibilityStorePluginFactory struct {
+		DataStoreFactory
+		plugin      VisibilityStorePlugin
+		cfg         config.CustomDatastoreConfig
+		clusterName string
+		logger      log.Logger
+	}
+)
+
+var visibilityStorePlugins = map[string]VisibilityStorePlugin{}
+
+// RegisterVisibilityStorePlugin registers a visibility store plugin, which is used if its name is configured
+// as customVisibilityStore of the visibility datastore. It must be called before the server is started.
+func RegisterVisibilityStorePlugin(pluginName string, plugin VisibilityStorePlugin) {
+	if _, ok := visibilityStorePlugins[pluginName]; ok {
+		panic("visibility store plugin " + pluginName + " already registered")
+	}
+	visibilityStorePlugins[pluginName] = plugin
+}
+
+func newVisibilityStorePluginFactory(cfg config.CustomDatastoreConfig, clusterName string, logger log.Logger) (DataStoreFactory, error) {
+	plugin, ok := visibilityStorePlugins[cfg.Name]
+	if !ok {
+		return nil, fmt.Errorf("visibility store plugin %q is not registered", cfg.Name)
+	}
+	return &visibilityStorePluginFactory{
+		plugin:      plugin,
+		cfg:         cfg,
+		clusterName: clusterName,
+		logger:      logger,
+	}, nil
+}
+
+// NewVisibilityStore returns a new visibility store created by the plugin
+func (f *visibilityStorePluginFactory) NewVisibilityStore() (p.VisibilityStore, error) {
+	return f.plugin.NewVisibilityStore(f.cfg, f.clusterName, f.logger)
+}
+
+// Close closes the factory, stores created by the plugin are closed by their managers
+func (f *visibilityStorePluginFactory) Close() {}
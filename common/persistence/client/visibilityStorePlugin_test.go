@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/loggerimpl"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	testVisibilityStorePlugin struct {
+		cfg config.CustomDatastoreConfig
+	}
+
+	testVisibilityStore struct {
+		p.VisibilityStore
+		name string
+	}
+
+	testDataStoreFactory struct{}
+)
+
+func (t *testVisibilityStorePlugin) NewVisibilityStore(cfg config.CustomDatastoreConfig, _ string, _ log.Logger) (p.VisibilityStore, error) {
+	t.cfg = cfg
+	return &testVisibilityStore{name: cfg.Name}, nil
+}
+
+func (s *testVisibilityStore) GetName() string {
+	return s.name
+}
+
+func (f *testDataStoreFactory) NewFactory(config.CustomDatastoreConfig, string, log.Logger) DataStoreFactory {
+	return nil
+}
+
+func TestVisibilityStorePlugin(t *testing.T) {
+	plugin := &testVisibilityStorePlugin{}
+	RegisterVisibilityStorePlugin("test-visibility", plugin)
+	defer delete(visibilityStorePlugins, "test-visibility")
+	assert.Panics(t, func() { RegisterVisibilityStorePlugin("test-visibility", plugin) })
+
+	cfg := &config.Persistence{
+		DefaultStore:    "default",
+		VisibilityStore: "visibility",
+		DataStores: map[string]config.DataStore{
+			"default": {CustomDataStoreConfig: &config.CustomDatastoreConfig{Name: "default"}},
+			"visibility": {CustomVisibilityStore: &config.CustomDatastoreConfig{
+				Name:    "test-visibility",
+				Options: map[string]string{"endpoint": "clickhouse:9000"},
+			}},
+		},
+	}
+	factory := NewFactory(cfg, nil, nil, &testDataStoreFactory{}, "active", nil, loggerimpl.NewNopLogger())
+
+	manager, err := factory.NewVisibilityManager()
+	require.NoError(t, err)
+	assert.Equal(t, "test-visibility", manager.GetName())
+	assert.Equal(t, "clickhouse:9000", plugin.cfg.Options["endpoint"])
+
+	_, err = newVisibilityStorePluginFactory(config.CustomDatastoreConfig{Name: "unknown"}, "active", loggerimpl.NewNopLogger())
+	assert.EqualError(t, err, `visibility store plugin "unknown" is not registered`)
+}
@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination consistencyMarker_mock.go -self_package go.temporal.io/server/common/persistence
+
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type (
+	// ConsistencyMarkerStore stores cluster wide consistency markers. A marker captures the ack levels of
+	// all shards and the queue states at a point in time, backup tooling snapshots it along with the
+	// tables, so that a restore from backups taken at slightly different times per table can be
+	// reconciled against it.
+	ConsistencyMarkerStore interface {
+		RecordMarker(marker *ConsistencyMarker) (int64, error)
+		GetMarker(markerID int64) (*ConsistencyMarker, error)
+		// ListMarkers returns markers ordered by marker ID
+		ListMarkers(pageSize int, pageToken []byte) ([]*ConsistencyMarker, []byte, error)
+		DeleteMarker(markerID int64) error
+	}
+
+	// ConsistencyMarker is a point in time record of the shard and queue states of the cluster
+	ConsistencyMarker struct {
+		// MarkerID is assigned by the queue, it is set on read only
+		MarkerID    int64
+		CreatedTime time.Time
+		Description string
+		Shards      []*persistencespb.ShardInfo
+		// NamespaceReplicationAckLevels are the ack levels of the namespace replication queue by cluster
+		NamespaceReplicationAckLevels map[string]int64
+	}
+
+	consistencyMarkerStoreImpl struct {
+		queue Queue
+	}
+
+	// consistencyMarkerBlob is the stored form of ConsistencyMarker, shard infos are proto encoded
+	consistencyMarkerBlob struct {
+		CreatedTime                   time.Time        `json:"createdTime"`
+		Description                   string           `json:"description"`
+		Shards                        [][]byte         `json:"shards"`
+		NamespaceReplicationAckLevels map[string]int64 `json:"namespaceReplicationAckLevels,omitempty"`
+	}
+)
+
+var _ ConsistencyMarkerStore = (*consistencyMarkerStoreImpl)(nil)
+
+// NewConsistencyMarkerStore creates a new ConsistencyMarkerStore instance
+func NewConsistencyMarkerStore(queue Queue) ConsistencyMarkerStore {
+	return &consistencyMarkerStoreImpl{
+		queue: queue,
+	}
+}
+
+func (s *consistencyMarkerStoreImpl) RecordMarker(marker *ConsistencyMarker) (int64, error) {
+	blob := &consistencyMarkerBlob{
+		CreatedTime:                   marker.CreatedTime,
+		Description:                   marker.Description,
+		NamespaceReplicationAckLevels: marker.NamespaceReplicationAckLevels,
+	}
+	for _, shardInfo := range marker.Shards {
+		shard, err := shardInfo.Marshal()
+		if err != nil {
+			return EmptyQueueMessageID, fmt.Errorf("failed to encode shard info: %v", err)
+		}
+		blob.Shards = append(blob.Shards, shard)
+	}
+	data, err := json.Marshal(blob)
+	if err != nil {
+		return EmptyQueueMessageID, fmt.Errorf("failed to encode marker: %v", err)
+	}
+
+	return s.queue.EnqueueMessageToDLQ(commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_JSON,
+		Data:         data,
+	})
+}
+
+func (s *consistencyMarkerStoreImpl) GetMarker(markerID int64) (*ConsistencyMarker, error) {
+	messages, _, err := s.queue.ReadMessagesFromDLQ(markerID-1, markerID, 1, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("consistency marker %d not found", markerID))
+	}
+	return decodeConsistencyMarker(messages[0])
+}
+
+func (s *consistencyMarkerStoreImpl) ListMarkers(
+	pageSize int,
+	pageToken []byte,
+) ([]*ConsistencyMarker, []byte, error) {
+
+	messages, token, err := s.queue.ReadMessagesFromDLQ(EmptyQueueMessageID, math.MaxInt64, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	markers := make([]*ConsistencyMarker, 0, len(messages))
+	for _, message := range messages {
+		marker, err := decodeConsistencyMarker(message)
+		if err != nil {
+			return nil, nil, err
+		}
+		markers = append(markers, marker)
+	}
+	return markers, token, nil
+}
+
+func (s *consistencyMarkerStoreImpl) DeleteMarker(markerID int64) error {
+	return s.queue.DeleteMessageFromDLQ(markerID)
+}
+
+func decodeConsistencyMarker(message *QueueMessage) (*ConsistencyMarker, error) {
+	var blob consistencyMarkerBlob
+	if err := json.Unmarshal(message.Data, &blob); err != nil {
+		return nil, fmt.Errorf("failed to decode marker: %v", err)
+	}
+
+	marker := &ConsistencyMarker{
+		MarkerID:                      message.ID,
+		CreatedTime:                   blob.CreatedTime,
+		Description:                   blob.Description,
+		NamespaceReplicationAckLevels: blob.NamespaceReplicationAckLevels,
+	}
+	for _, shard := range blob.Shards {
+		shardInfo := &persistencespb.ShardInfo{}
+		if err := shardInfo.Unmarshal(shard); err != nil {
+			return nil, fmt.Errorf("failed to decode shard info: %v", err)
+		}
+		marker.Shards = append(marker.Shards, shardInfo)
+	}
+	return marker, nil
+}
@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func TestConsistencyMarkerStore(t *testing.T) {
+	store := NewConsistencyMarkerStore(&inMemoryDLQ{})
+	createdTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	newMarker := func(description string) *ConsistencyMarker {
+		return &ConsistencyMarker{
+			CreatedTime: createdTime,
+			Description: description,
+			Shards: []*persistencespb.ShardInfo{
+				{
+					ShardId:             1,
+					RangeId:             10,
+					TransferAckLevel:    100,
+					TimerAckLevelTime:   timestamp.TimePtr(createdTime.Add(-time.Minute)),
+					ReplicationAckLevel: 90,
+					VisibilityAckLevel:  95,
+					ClusterTransferAckLevel: map[string]int64{
+						"active": 100,
+					},
+				},
+				{ShardId: 2, RangeId: 20, TransferAckLevel: 200},
+			},
+			NamespaceReplicationAckLevels: map[string]int64{"standby": 7},
+		}
+	}
+
+	markerID, err := store.RecordMarker(newMarker("first"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), markerID)
+	markerID, err = store.RecordMarker(newMarker("second"))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), markerID)
+
+	marker, err := store.GetMarker(2)
+	require.NoError(t, err)
+	expected := newMarker("second")
+	expected.MarkerID = 2
+	require.Equal(t, expected, marker)
+
+	markers, token, err := store.ListMarkers(1, nil)
+	require.NoError(t, err)
+	require.Len(t, markers, 1)
+	require.Equal(t, "first", markers[0].Description)
+	markers, _, err = store.ListMarkers(1, token)
+	require.NoError(t, err)
+	require.Len(t, markers, 1)
+	require.Equal(t, "second", markers[0].Description)
+
+	require.NoError(t, store.DeleteMarker(1))
+	_, err = store.GetMarker(1)
+	require.IsType(t, &serviceerror.NotFound{}, err)
+	markers, _, err = store.ListMarkers(10, nil)
+	require.NoError(t, err)
+	require.Len(t, markers, 1)
+}
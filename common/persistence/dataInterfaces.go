@@ -52,8 +52,28 @@ type QueueType int32
 // Negative numbers are reserved for DLQ
 const (
 	NamespaceReplicationQueueType QueueType = iota + 1
+	// ConsistencyMarkerQueueType stores cluster wide consistency markers in its DLQ
+	ConsistencyMarkerQueueType
+	// NamespaceRegistrationQueueType stores namespace registrations which wait for approval in its DLQ
+	NamespaceRegistrationQueueType
 )
 
+// ShardJournalQueueTypeBase is added to the shard ID to get the queue type of the journal of a history shard,
+// so that every shard journal is stored in its own queue
+const ShardJournalQueueTypeBase QueueType = 1 << 24
+
+// IntakeQueueTypeBase is added to the partition to get the queue type of a partition of the intake queue,
+// rejected requests are stored in the DLQ of the partition
+const IntakeQueueTypeBase QueueType = 1 << 25
+
+// SignalDLQQueueTypeBase is added to the hash of the namespace ID to get the queue type of the signal DLQ
+// of the namespace, undeliverable signals are stored in the DLQ of the queue
+const SignalDLQQueueTypeBase QueueType = 1 << 26
+
+// LeaderLeaseQueueTypeBase is added to the lease ID to get the queue type of the lease of a singleton job,
+// so that every lease is stored in its own queue
+const LeaderLeaseQueueTypeBase QueueType = 1 << 27
+
 // Create Workflow Execution Mode
 const (
 	// Fail if current record exists
@@ -243,6 +263,15 @@ type (
 		Version             int64
 	}
 
+	// CustomTask identifies a task of a custom task category, it is persisted in the queue of its category
+	CustomTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+		Category            string
+		Data                []byte
+	}
+
 	// CloseExecutionTask identifies a transfer task for deletion of execution
 	CloseExecutionTask struct {
 		VisibilityTimestamp time.Time
@@ -353,6 +382,13 @@ type (
 		WorkflowBackoffType enumsspb.WorkflowBackoffType
 	}
 
+	// StuckExecutionCheckTask identifies a timer task checking whether an execution is stuck
+	StuckExecutionCheckTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// HistoryReplicationTask is the replication task created for shipping history replication events to other clusters
 	HistoryReplicationTask struct {
 		VisibilityTimestamp time.Time
@@ -572,6 +608,7 @@ type (
 		ReplicationTasks []Task
 		TimerTasks       []Task
 		VisibilityTasks  []Task
+		CustomTasks      []Task
 
 		Condition int64
 		Checksum  *persistencespb.Checksum
@@ -594,6 +631,7 @@ type (
 		ReplicationTasks []Task
 		TimerTasks       []Task
 		VisibilityTasks  []Task
+		CustomTasks      []Task
 
 		Condition int64
 		Checksum  *persistencespb.Checksum
@@ -663,6 +701,21 @@ type (
 		NextPageToken []byte
 	}
 
+	// GetCustomTasksRequest is used to read tasks from the queue of a custom task category
+	GetCustomTasksRequest struct {
+		Category      string
+		ReadLevel     int64
+		MaxReadLevel  int64
+		BatchSize     int
+		NextPageToken []byte
+	}
+
+	// GetCustomTasksResponse is the response to GetCustomTasksRequest
+	GetCustomTasksResponse struct {
+		Tasks         []*serialization.CustomTaskInfo
+		NextPageToken []byte
+	}
+
 	// GetReplicationTaskRequest is the request for GetReplicationTask
 	GetReplicationTaskRequest struct {
 		ShardID int32
@@ -710,6 +763,13 @@ type (
 		InclusiveEndTaskID   int64
 	}
 
+	// RangeCompleteCustomTaskRequest is used to complete a range of tasks in the queue of a custom task category
+	RangeCompleteCustomTaskRequest struct {
+		Category             string
+		ExclusiveBeginTaskID int64
+		InclusiveEndTaskID   int64
+	}
+
 	// CompleteReplicationTaskRequest is used to complete a task in the replication task queue
 	CompleteReplicationTaskRequest struct {
 		TaskID int64
@@ -830,6 +890,9 @@ type (
 	// GetTasksResponse is the response to GetTasksRequests
 	GetTasksResponse struct {
 		Tasks []*persistencespb.AllocatedTaskInfo
+		// TombstoneCount is the number of tombstones the store reported to scan while reading the tasks,
+		// it's only reported by stores warning about tombstone heavy reads
+		TombstoneCount int
 	}
 
 	// CompleteTaskRequest is used to complete a task
@@ -1005,6 +1068,8 @@ type (
 	AppendHistoryNodesResponse struct {
 		// the size of the event data that has been appended
 		Size int
+		// whether the node has identical content to the previous append to the branch and was deduplicated
+		IsDuplicate bool
 	}
 
 	// ReadHistoryBranchRequest is used to read a history branch
@@ -1259,6 +1324,10 @@ type (
 		GetVisibilityTasks(request *GetVisibilityTasksRequest) (*GetVisibilityTasksResponse, error)
 		CompleteVisibilityTask(request *CompleteVisibilityTaskRequest) error
 		RangeCompleteVisibilityTask(request *RangeCompleteVisibilityTaskRequest) error
+
+		// custom tasks
+		GetCustomTasks(request *GetCustomTasksRequest) (*GetCustomTasksResponse, error)
+		RangeCompleteCustomTask(request *RangeCompleteCustomTaskRequest) error
 	}
 
 	// ExecutionManagerFactory creates an instance of ExecutionManager for a given shard
@@ -1528,6 +1597,41 @@ func (a *ResetWorkflowTask) SetVisibilityTimestamp(timestamp time.Time) {
 	a.VisibilityTimestamp = timestamp
 }
 
+// GetType returns the type of the CustomTask
+func (a *CustomTask) GetType() enumsspb.TaskType {
+	return serialization.TaskTypeCustom
+}
+
+// GetVersion returns the version of the CustomTask
+func (a *CustomTask) GetVersion() int64 {
+	return a.Version
+}
+
+// SetVersion returns the version of the CustomTask
+func (a *CustomTask) SetVersion(version int64) {
+	a.Version = version
+}
+
+// GetTaskID returns the sequence ID of the CustomTask
+func (a *CustomTask) GetTaskID() int64 {
+	return a.TaskID
+}
+
+// SetTaskID sets the sequence ID of the CustomTask
+func (a *CustomTask) SetTaskID(id int64) {
+	a.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (a *CustomTask) GetVisibilityTimestamp() time.Time {
+	return a.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (a *CustomTask) SetVisibilityTimestamp(timestamp time.Time) {
+	a.VisibilityTimestamp = timestamp
+}
+
 // GetType returns the type of the close execution task
 func (a *CloseExecutionTask) GetType() enumsspb.TaskType {
 	return enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION
@@ -1773,6 +1877,41 @@ func (r *WorkflowBackoffTimerTask) SetVisibilityTimestamp(t time.Time) {
 	r.VisibilityTimestamp = t
 }
 
+// GetType returns the type of the stuck execution check task
+func (r *StuckExecutionCheckTask) GetType() enumsspb.TaskType {
+	return enumsspb.TASK_TYPE_STUCK_EXECUTION_CHECK
+}
+
+// GetVersion returns the version of the stuck execution check task
+func (r *StuckExecutionCheckTask) GetVersion() int64 {
+	return r.Version
+}
+
+// SetVersion returns the version of the stuck execution check task
+func (r *StuckExecutionCheckTask) SetVersion(version int64) {
+	r.Version = version
+}
+
+// GetTaskID returns the sequence ID.
+func (r *StuckExecutionCheckTask) GetTaskID() int64 {
+	return r.TaskID
+}
+
+// SetTaskID sets the sequence ID.
+func (r *StuckExecutionCheckTask) SetTaskID(id int64) {
+	r.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (r *StuckExecutionCheckTask) GetVisibilityTimestamp() time.Time {
+	return r.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp sets the visibility time stamp
+func (r *StuckExecutionCheckTask) SetVisibilityTimestamp(t time.Time) {
+	r.VisibilityTimestamp = t
+}
+
 // GetType returns the type of the timeout task.
 func (u *WorkflowTimeoutTask) GetType() enumsspb.TaskType {
 	return enumsspb.TASK_TYPE_WORKFLOW_RUN_TIMEOUT
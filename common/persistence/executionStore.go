@@ -163,6 +163,10 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		VersionHistories:                  info.VersionHistories,
 		WorkflowRunExpirationTime:         info.WorkflowRunExpirationTime,
 		WorkflowExecutionExpirationTime:   info.WorkflowExecutionExpirationTime,
+		StuckReason:                       info.StuckReason,
+		OperatorAnnotations:               info.OperatorAnnotations,
+		TaskLatencyBreakdowns:             info.TaskLatencyBreakdowns,
+		ReplicationOversizedBatch:         info.ReplicationOversizedBatch,
 	}
 
 	if newInfo.AutoResetPoints == nil {
@@ -278,6 +282,10 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		SearchAttributes:                  info.SearchAttributes,
 		WorkflowRunExpirationTime:         info.WorkflowRunExpirationTime,
 		WorkflowExecutionExpirationTime:   info.WorkflowExecutionExpirationTime,
+		StuckReason:                       info.StuckReason,
+		OperatorAnnotations:               info.OperatorAnnotations,
+		TaskLatencyBreakdowns:             info.TaskLatencyBreakdowns,
+		ReplicationOversizedBatch:         info.ReplicationOversizedBatch,
 
 		ExecutionStats:   info.ExecutionStats,
 		VersionHistories: info.VersionHistories,
@@ -394,6 +402,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 		ReplicationTasks: input.ReplicationTasks,
 		TimerTasks:       input.TimerTasks,
 		VisibilityTasks:  input.VisibilityTasks,
+		CustomTasks:      input.CustomTasks,
 
 		Condition: input.Condition,
 		Checksum:  input.Checksum,
@@ -431,6 +440,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 		ReplicationTasks: input.ReplicationTasks,
 		TimerTasks:       input.TimerTasks,
 		VisibilityTasks:  input.VisibilityTasks,
+		CustomTasks:      input.CustomTasks,
 
 		Condition: input.Condition,
 		Checksum:  input.Checksum,
@@ -549,6 +559,19 @@ func (m *executionManagerImpl) RangeCompleteVisibilityTask(
 	return m.persistence.RangeCompleteVisibilityTask(request)
 }
 
+// Custom task related methods
+func (m *executionManagerImpl) GetCustomTasks(
+	request *GetCustomTasksRequest,
+) (*GetCustomTasksResponse, error) {
+	return m.persistence.GetCustomTasks(request)
+}
+
+func (m *executionManagerImpl) RangeCompleteCustomTask(
+	request *RangeCompleteCustomTaskRequest,
+) error {
+	return m.persistence.RangeCompleteCustomTask(request)
+}
+
 // Replication task related methods
 func (m *executionManagerImpl) GetReplicationTask(
 	request *GetReplicationTaskRequest,
@@ -0,0 +1,102 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type (
+	// historyAppendDeduper remembers the content hash of the last node appended to recently written branches.
+	// An append of identical content to the same node reuses the transaction ID of the previous attempt,
+	// so it overwrites the row written by an attempt which failed ambiguously instead of creating another
+	// node with a higher transaction ID which would have to be trimmed later.
+	historyAppendDeduper struct {
+		sync.Mutex
+		records map[string]historyAppendRecord
+		// keys is a ring buffer of records keys in insertion order, used to evict the oldest record
+		keys []string
+		next int
+	}
+
+	historyAppendRecord struct {
+		nodeID        int64
+		transactionID int64
+		contentHash   [sha256.Size]byte
+	}
+)
+
+const (
+	historyAppendDeduperCapacity = 16384
+)
+
+func newHistoryAppendDeduper(
+	capacity int,
+) *historyAppendDeduper {
+
+	return &historyAppendDeduper{
+		records: make(map[string]historyAppendRecord, capacity),
+		keys:    make([]string, capacity),
+	}
+}
+
+// resolveTransactionID returns the transaction ID the append should be written with, whether the append
+// is a duplicate of the previous append to the branch and whether the branch was appended to recently.
+// Previous appends to branches which were not appended to recently can only be found in persistence.
+func (d *historyAppendDeduper) resolveTransactionID(
+	branch *persistencespb.HistoryBranch,
+	nodeID int64,
+	transactionID int64,
+	data []byte,
+) (int64, bool, bool) {
+
+	key := branch.GetTreeId() + branch.GetBranchId()
+	record := historyAppendRecord{
+		nodeID:        nodeID,
+		transactionID: transactionID,
+		contentHash:   sha256.Sum256(data),
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	prev, ok := d.records[key]
+	if ok && prev.nodeID == record.nodeID && prev.contentHash == record.contentHash {
+		return prev.transactionID, true, true
+	}
+
+	if !ok {
+		if evicted := d.keys[d.next]; evicted != "" {
+			delete(d.records, evicted)
+		}
+		d.keys[d.next] = key
+		d.next = (d.next + 1) % len(d.keys)
+	}
+	d.records[key] = record
+	return transactionID, false, ok
+}
@@ -0,0 +1,176 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	historyAppendDeduperSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		deduper *historyAppendDeduper
+		branch  *persistencespb.HistoryBranch
+	}
+
+	// historyNodeStore keeps the latest node of each node ID of a single branch
+	historyNodeStore struct {
+		HistoryStore
+
+		nodes   map[int64]*commonpb.DataBlob
+		appends int
+		reads   int
+	}
+)
+
+func (h *historyNodeStore) AppendHistoryNodes(request *InternalAppendHistoryNodesRequest) error {
+	h.appends++
+	h.nodes[request.NodeID] = request.Events
+	return nil
+}
+
+func (h *historyNodeStore) ReadHistoryBranch(request *InternalReadHistoryBranchRequest) (*InternalReadHistoryBranchResponse, error) {
+	h.reads++
+	resp := &InternalReadHistoryBranchResponse{}
+	if node, ok := h.nodes[request.MinNodeID]; ok {
+		resp.History = append(resp.History, node)
+	}
+	return resp, nil
+}
+
+func TestHistoryAppendDeduperSuite(t *testing.T) {
+	s := new(historyAppendDeduperSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyAppendDeduperSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.deduper = newHistoryAppendDeduper(2)
+	s.branch = &persistencespb.HistoryBranch{TreeId: "tree-1", BranchId: "branch-1"}
+}
+
+func (s *historyAppendDeduperSuite) TestResolveTransactionID_IdenticalContent() {
+	transactionID, isDuplicate, isKnownBranch := s.deduper.resolveTransactionID(s.branch, 5, 100, []byte("events"))
+	s.Equal(int64(100), transactionID)
+	s.False(isDuplicate)
+	s.False(isKnownBranch)
+
+	transactionID, isDuplicate, isKnownBranch = s.deduper.resolveTransactionID(s.branch, 5, 101, []byte("events"))
+	s.Equal(int64(100), transactionID)
+	s.True(isDuplicate)
+	s.True(isKnownBranch)
+}
+
+func (s *historyAppendDeduperSuite) TestResolveTransactionID_DifferentContent() {
+	s.deduper.resolveTransactionID(s.branch, 5, 100, []byte("events"))
+
+	transactionID, isDuplicate, isKnownBranch := s.deduper.resolveTransactionID(s.branch, 5, 101, []byte("other events"))
+	s.Equal(int64(101), transactionID)
+	s.False(isDuplicate)
+	s.True(isKnownBranch)
+
+	transactionID, isDuplicate, isKnownBranch = s.deduper.resolveTransactionID(s.branch, 7, 102, []byte("other events"))
+	s.Equal(int64(102), transactionID)
+	s.False(isDuplicate)
+	s.True(isKnownBranch)
+}
+
+func (s *historyAppendDeduperSuite) TestResolveTransactionID_DifferentBranch() {
+	s.deduper.resolveTransactionID(s.branch, 5, 100, []byte("events"))
+
+	otherBranch := &persistencespb.HistoryBranch{TreeId: "tree-1", BranchId: "branch-2"}
+	transactionID, isDuplicate, isKnownBranch := s.deduper.resolveTransactionID(otherBranch, 5, 101, []byte("events"))
+	s.Equal(int64(101), transactionID)
+	s.False(isDuplicate)
+	s.False(isKnownBranch)
+}
+
+func (s *historyAppendDeduperSuite) TestResolveTransactionID_Eviction() {
+	s.deduper.resolveTransactionID(s.branch, 5, 100, []byte("events"))
+	s.deduper.resolveTransactionID(&persistencespb.HistoryBranch{TreeId: "tree-2", BranchId: "branch-1"}, 5, 101, []byte("events"))
+	s.deduper.resolveTransactionID(&persistencespb.HistoryBranch{TreeId: "tree-3", BranchId: "branch-1"}, 5, 102, []byte("events"))
+	s.Len(s.deduper.records, 2)
+
+	transactionID, isDuplicate, isKnownBranch := s.deduper.resolveTransactionID(s.branch, 5, 103, []byte("events"))
+	s.Equal(int64(103), transactionID)
+	s.False(isDuplicate)
+	s.False(isKnownBranch)
+}
+
+func (s *historyAppendDeduperSuite) TestAppendHistoryNodes_PersistedDuplicate() {
+	store := &historyNodeStore{nodes: make(map[int64]*commonpb.DataBlob)}
+	newManager := func() *historyV2ManagerImpl {
+		return NewHistoryV2ManagerImpl(
+			store,
+			loggerimpl.NewNopLogger(),
+			dynamicconfig.GetIntPropertyFn(1024),
+			dynamicconfig.GetStringPropertyFn(HistoryChecksumVerificationOff),
+			nil,
+		).(*historyV2ManagerImpl)
+	}
+	branchToken, err := NewHistoryBranchTokenByBranchID("tree-1", "branch-1")
+	s.NoError(err)
+	request := func(eventID int64, transactionID int64) *AppendHistoryNodesRequest {
+		return &AppendHistoryNodesRequest{
+			BranchToken:   branchToken,
+			Events:        []*historypb.HistoryEvent{{EventId: eventID, Version: 1}},
+			TransactionID: transactionID,
+			ShardID:       convert.Int32Ptr(1),
+		}
+	}
+
+	manager := newManager()
+	resp, err := manager.AppendHistoryNodes(request(5, 100))
+	s.NoError(err)
+	s.False(resp.IsDuplicate)
+	s.Equal(1, store.appends)
+	s.Equal(1, store.reads)
+
+	// branch appended to recently is deduplicated without reading persistence
+	resp, err = manager.AppendHistoryNodes(request(6, 101))
+	s.NoError(err)
+	s.False(resp.IsDuplicate)
+	s.Equal(2, store.appends)
+	s.Equal(1, store.reads)
+
+	// retry of the append on another host finds the node written by the previous attempt
+	resp, err = newManager().AppendHistoryNodes(request(6, 102))
+	s.NoError(err)
+	s.True(resp.IsDuplicate)
+	s.Equal(2, store.appends)
+	s.Equal(2, store.reads)
+}
@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/checksum"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	// HistoryChecksumVerificationOff disables checksum verification of history events read from persistence
+	HistoryChecksumVerificationOff = "off"
+	// HistoryChecksumVerificationLog logs checksum mismatch of history events read from persistence
+	HistoryChecksumVerificationLog = "log"
+	// HistoryChecksumVerificationMetric logs and emits metric for checksum mismatch of history events read from persistence
+	HistoryChecksumVerificationMetric = "metric"
+	// HistoryChecksumVerificationFail logs, emits metric and fails read of history events with checksum mismatch
+	HistoryChecksumVerificationFail = "fail"
+
+	historyEventsChecksumVersion int32 = 1
+)
+
+var (
+	errHistoryEventsChecksumMismatch = serviceerror.NewDataLoss("History events checksum mismatch.")
+)
+
+type (
+	// rawHistoryEvents is history events batch already serialized to proto3 binary
+	rawHistoryEvents []byte
+)
+
+// Marshal returns the serialized history events batch as is
+func (e rawHistoryEvents) Marshal() ([]byte, error) {
+	return e, nil
+}
+
+func generateHistoryEventsChecksum(
+	blob *commonpb.DataBlob,
+) ([]byte, error) {
+
+	eventsChecksum, err := checksum.GenerateCRC32(rawHistoryEvents(blob.Data), historyEventsChecksumVersion)
+	if err != nil {
+		return nil, err
+	}
+	return eventsChecksum.Marshal()
+}
+
+func (m *historyV2ManagerImpl) historyChecksumVerificationMode() string {
+	if m.checksumVerification == nil {
+		return HistoryChecksumVerificationMetric
+	}
+	return m.checksumVerification()
+}
+
+// verifyHistoryEventsChecksums verifies history events batches read from a branch against checksums stored
+// along with them, to detect corrupted or truncated batches before they are replayed or replicated.
+// Batches persisted without checksum are not verified.
+func (m *historyV2ManagerImpl) verifyHistoryEventsChecksums(
+	treeID string,
+	branchID string,
+	blobs []*commonpb.DataBlob,
+	checksums [][]byte,
+) error {
+
+	mode := m.historyChecksumVerificationMode()
+	if mode == HistoryChecksumVerificationOff {
+		return nil
+	}
+
+	for i, blob := range blobs {
+		if i >= len(checksums) || len(checksums[i]) == 0 {
+			continue
+		}
+
+		eventsChecksum := &persistencespb.Checksum{}
+		err := eventsChecksum.Unmarshal(checksums[i])
+		if err == nil {
+			err = checksum.Verify(rawHistoryEvents(blob.Data), eventsChecksum)
+		}
+		if err == nil {
+			continue
+		}
+
+		m.logger.Error("History events checksum verification failed.",
+			tag.WorkflowTreeID(treeID), tag.WorkflowBranchID(branchID), tag.Error(err))
+		if mode == HistoryChecksumVerificationLog {
+			continue
+		}
+		if m.metricsClient != nil {
+			m.metricsClient.IncCounter(metrics.PersistenceReadHistoryBranchScope, metrics.PersistenceHistoryChecksumMismatchCounter)
+		}
+		if mode == HistoryChecksumVerificationFail {
+			return errHistoryEventsChecksumMismatch
+		}
+	}
+	return nil
+}
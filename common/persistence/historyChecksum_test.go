@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	historyChecksumSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		manager *historyV2ManagerImpl
+		mode    string
+	}
+)
+
+func TestHistoryChecksumSuite(t *testing.T) {
+	s := new(historyChecksumSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyChecksumSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mode = HistoryChecksumVerificationFail
+	s.manager = NewHistoryV2ManagerImpl(
+		nil,
+		loggerimpl.NewNopLogger(),
+		dynamicconfig.GetIntPropertyFn(1024),
+		func(opts ...dynamicconfig.FilterOption) string { return s.mode },
+		nil,
+	).(*historyV2ManagerImpl)
+}
+
+func (s *historyChecksumSuite) TestVerifyHistoryEventsChecksums() {
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("events")}
+	eventsChecksum, err := generateHistoryEventsChecksum(blob)
+	s.NoError(err)
+
+	truncatedBlob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("even")}
+	blobs := []*commonpb.DataBlob{blob, truncatedBlob}
+
+	err = s.manager.verifyHistoryEventsChecksums("tree-1", "branch-1", blobs[:1], [][]byte{eventsChecksum})
+	s.NoError(err)
+
+	// batches persisted without checksum are not verified
+	err = s.manager.verifyHistoryEventsChecksums("tree-1", "branch-1", blobs, [][]byte{eventsChecksum, nil})
+	s.NoError(err)
+	err = s.manager.verifyHistoryEventsChecksums("tree-1", "branch-1", blobs, nil)
+	s.NoError(err)
+
+	err = s.manager.verifyHistoryEventsChecksums("tree-1", "branch-1", blobs, [][]byte{eventsChecksum, eventsChecksum})
+	s.Equal(errHistoryEventsChecksumMismatch, err)
+	err = s.manager.verifyHistoryEventsChecksums("tree-1", "branch-1", blobs[:1], [][]byte{[]byte("invalid checksum")})
+	s.Equal(errHistoryEventsChecksumMismatch, err)
+
+	for _, mode := range []string{HistoryChecksumVerificationOff, HistoryChecksumVerificationLog, HistoryChecksumVerificationMetric} {
+		s.mode = mode
+		err = s.manager.verifyHistoryEventsChecksums("tree-1", "branch-1", blobs, [][]byte{eventsChecksum, eventsChecksum})
+		s.NoError(err)
+	}
+}
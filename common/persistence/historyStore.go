@@ -25,6 +25,7 @@
 package persistence
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/pborman/uuid"
@@ -37,6 +38,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
@@ -49,6 +51,9 @@ type (
 		logger                log.Logger
 		pagingTokenSerializer *jsonHistoryTokenSerializer
 		transactionSizeLimit  dynamicconfig.IntPropertyFn
+		checksumVerification  dynamicconfig.StringPropertyFn
+		metricsClient         metrics.Client
+		appendDeduper         *historyAppendDeduper
 	}
 )
 
@@ -64,6 +69,8 @@ func NewHistoryV2ManagerImpl(
 	persistence HistoryStore,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	checksumVerification dynamicconfig.StringPropertyFn,
+	metricsClient metrics.Client,
 ) HistoryManager {
 
 	return &historyV2ManagerImpl{
@@ -72,6 +79,9 @@ func NewHistoryV2ManagerImpl(
 		logger:                logger,
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
+		checksumVerification:  checksumVerification,
+		metricsClient:         metricsClient,
+		appendDeduper:         newHistoryAppendDeduper(historyAppendDeduperCapacity),
 	}
 }
 
@@ -209,28 +219,79 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 			Msg: fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, sizeLimit),
 		}
 	}
+	eventsChecksum, err := generateHistoryEventsChecksum(blob)
+	if err != nil {
+		return nil, err
+	}
 	shardID, err := getShardID(request.ShardID)
 	if err != nil {
 		m.logger.Error("shardID is not set in append history nodes operation", tag.Error(err))
 		return nil, serviceerror.NewInternal(err.Error())
 	}
+	transactionID, isDuplicate, isKnownBranch := m.appendDeduper.resolveTransactionID(branch, nodeID, request.TransactionID, blob.Data)
+	if !isKnownBranch && !request.IsNewBranch {
+		// branch was not appended to by this host recently, e.g. after shard movement or restart,
+		// so look for a node written by a previous attempt in persistence
+		isDuplicate, err = m.isPersistedHistoryNode(branch, nodeID, blob, shardID)
+		if err != nil {
+			return nil, err
+		}
+		if isDuplicate {
+			return &AppendHistoryNodesResponse{
+				Size:        size,
+				IsDuplicate: true,
+			}, nil
+		}
+	}
 	req := &InternalAppendHistoryNodesRequest{
-		IsNewBranch:   request.IsNewBranch,
-		Info:          request.Info,
-		BranchInfo:    branch,
-		NodeID:        nodeID,
-		Events:        blob,
-		TransactionID: request.TransactionID,
-		ShardID:       shardID,
+		IsNewBranch:    request.IsNewBranch,
+		Info:           request.Info,
+		BranchInfo:     branch,
+		NodeID:         nodeID,
+		Events:         blob,
+		EventsChecksum: eventsChecksum,
+		TransactionID:  transactionID,
+		ShardID:        shardID,
 	}
 
 	err = m.persistence.AppendHistoryNodes(req)
+	if _, ok := err.(*ConditionFailedError); ok && isDuplicate {
+		// row written by the previous attempt with identical content already exists
+		err = nil
+	}
 
 	return &AppendHistoryNodesResponse{
-		Size: size,
+		Size:        size,
+		IsDuplicate: isDuplicate,
 	}, err
 }
 
+// isPersistedHistoryNode returns whether the node of the branch is persisted with identical content
+func (m *historyV2ManagerImpl) isPersistedHistoryNode(
+	branch *persistencespb.HistoryBranch,
+	nodeID int64,
+	blob *commonpb.DataBlob,
+	shardID int32,
+) (bool, error) {
+
+	resp, err := m.persistence.ReadHistoryBranch(&InternalReadHistoryBranchRequest{
+		TreeID:            branch.GetTreeId(),
+		BranchID:          branch.GetBranchId(),
+		MinNodeID:         nodeID,
+		MaxNodeID:         nodeID + 1,
+		PageSize:          1,
+		LastNodeID:        nodeID - 1,
+		LastTransactionID: defaultLastTransactionID,
+		ShardID:           shardID,
+	})
+	if err != nil {
+		return false, err
+	}
+	// nodes with the same node ID are read in descending order of transaction ID,
+	// so the first one is the node readers see
+	return len(resp.History) != 0 && bytes.Equal(resp.History[0].Data, blob.Data), nil
+}
+
 // ReadHistoryBranchByBatch returns history node data for a branch by batch
 // Pagination is implemented here, the actual minNodeID passing to persistence layer is calculated along with token's LastNodeID
 func (m *historyV2ManagerImpl) ReadHistoryBranchByBatch(
@@ -379,6 +440,9 @@ func (m *historyV2ManagerImpl) readRawHistoryBranch(
 	if err != nil {
 		return nil, nil, 0, nil, err
 	}
+	if err := m.verifyHistoryEventsChecksums(req.TreeID, req.BranchID, resp.History, resp.HistoryChecksums); err != nil {
+		return nil, nil, 0, nil, err
+	}
 	if len(resp.History) == 0 && len(request.NextPageToken) == 0 {
 		return nil, nil, 0, nil, serviceerror.NewNotFound("Workflow execution history not found.")
 	}
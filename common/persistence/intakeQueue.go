@@ -0,0 +1,371 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination intakeQueue_mock.go -self_package go.temporal.io/server/common/persistence
+
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common"
+)
+
+const (
+	// IntakeQueuePartitionCount is the number of partitions of the intake queue. All requests of a workflow are
+	// stored in the same partition, so the count can't be changed while the queue has messages.
+	IntakeQueuePartitionCount = 16
+
+	// intakeQueueAckLevelKey is the key of the ack level of intake processor in queue metadata
+	intakeQueueAckLevelKey = "intake-processor"
+	// intakeEnqueueMaxAttempts bounds retries of enqueue which lost the race for the next message ID
+	intakeEnqueueMaxAttempts = 3
+	// intakeDLQPageSize is the page size used to look up rejected messages
+	intakeDLQPageSize = 100
+)
+
+const (
+	// IntakeMessageStatePending means the message is not applied yet
+	IntakeMessageStatePending IntakeMessageState = iota + 1
+	// IntakeMessageStateApplied means the message is applied
+	IntakeMessageStateApplied
+	// IntakeMessageStateRejected means the message can't be applied and is in the dead-letter queue
+	IntakeMessageStateRejected
+)
+
+type (
+	// IntakeQueue stores StartWorkflowExecution and SignalWorkflowExecution requests accepted by frontend
+	// in async mode, until the intake processor applies them. The queue is partitioned by workflow, messages
+	// of a partition are processed in order of message ID.
+	IntakeQueue interface {
+		// Enqueue stores the message and returns the token to look up its outcome with
+		Enqueue(message *IntakeMessage) (string, error)
+		// ReadMessages returns up to maxCount messages of the partition after lastMessageID
+		ReadMessages(partition int32, lastMessageID int64, maxCount int) ([]*IntakeMessage, error)
+		// Ack records messages of the partition up to messageID as processed and deletes them
+		Ack(partition int32, messageID int64) error
+		// GetAckLevel returns ID of the last processed message of the partition
+		GetAckLevel(partition int32) (int64, error)
+		// Reject moves a message which can't be applied to the dead-letter queue of its partition
+		Reject(message *IntakeMessage, cause error) error
+		// GetOutcome returns the outcome of the message of the namespace the token was issued for
+		GetOutcome(namespaceID string, token string) (*IntakeOutcome, error)
+	}
+
+	// IntakeMessage is a request accepted to the intake queue, exactly one of the requests is set
+	IntakeMessage struct {
+		// Partition and MessageID are assigned by the queue, they are set on read only
+		Partition     int32
+		MessageID     int64
+		NamespaceID   string
+		StartRequest  *workflowservice.StartWorkflowExecutionRequest
+		SignalRequest *workflowservice.SignalWorkflowExecutionRequest
+		EnqueueTime   time.Time
+	}
+
+	// IntakeMessageState is the processing state of an intake message
+	IntakeMessageState int
+
+	// IntakeOutcome is the outcome of an intake message
+	IntakeOutcome struct {
+		State IntakeMessageState
+		// Failure is the error the message was rejected with
+		Failure string
+	}
+
+	intakeQueueImpl struct {
+		partitions []Queue
+	}
+
+	// intakeBlob is the stored form of IntakeMessage, requests are proto encoded. Rejected messages
+	// keep their original message ID and the failure in the dead-letter queue.
+	intakeBlob struct {
+		NamespaceID   string    `json:"namespaceId"`
+		StartRequest  []byte    `json:"startRequest,omitempty"`
+		SignalRequest []byte    `json:"signalRequest,omitempty"`
+		EnqueueTime   time.Time `json:"enqueueTime"`
+		MessageID     int64     `json:"messageId,omitempty"`
+		Failure       string    `json:"failure,omitempty"`
+	}
+)
+
+var _ IntakeQueue = (*intakeQueueImpl)(nil)
+
+// NewIntakeQueue creates a new IntakeQueue instance from the queues of its partitions
+func NewIntakeQueue(partitions []Queue) IntakeQueue {
+	return &intakeQueueImpl{
+		partitions: partitions,
+	}
+}
+
+// IntakeQueueType returns the queue type of the partition of the intake queue
+func IntakeQueueType(partition int32) QueueType {
+	return IntakeQueueTypeBase + QueueType(partition)
+}
+
+// IntakePartition returns the partition of the intake queue requests of the workflow are stored in
+func IntakePartition(namespaceID string, workflowID string) int32 {
+	return common.WorkflowIDToHistoryShard(namespaceID, workflowID, IntakeQueuePartitionCount) - 1
+}
+
+func (q *intakeQueueImpl) Enqueue(message *IntakeMessage) (string, error) {
+	blob, err := encodeIntakeMessage(message)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(blob)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode message: %v", err)
+	}
+
+	partition := IntakePartition(message.NamespaceID, intakeMessageWorkflowID(message))
+	for attempt := 1; ; attempt++ {
+		messageID, err := q.partitions[partition].EnqueueMessage(commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_JSON,
+			Data:         data,
+		})
+		if err == nil {
+			return intakeToken(partition, messageID), nil
+		}
+		if _, ok := err.(*ConditionFailedError); !ok || attempt == intakeEnqueueMaxAttempts {
+			return "", err
+		}
+	}
+}
+
+func (q *intakeQueueImpl) ReadMessages(
+	partition int32,
+	lastMessageID int64,
+	maxCount int,
+) ([]*IntakeMessage, error) {
+
+	queueMessages, err := q.partitions[partition].ReadMessages(lastMessageID, maxCount)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*IntakeMessage, 0, len(queueMessages))
+	for _, queueMessage := range queueMessages {
+		var blob intakeBlob
+		if err := json.Unmarshal(queueMessage.Data, &blob); err != nil {
+			return nil, fmt.Errorf("failed to decode message: %v", err)
+		}
+		message, err := decodeIntakeMessage(&blob)
+		if err != nil {
+			return nil, err
+		}
+		message.Partition = partition
+		message.MessageID = queueMessage.ID
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+func (q *intakeQueueImpl) Ack(
+	partition int32,
+	messageID int64,
+) error {
+	if err := q.partitions[partition].UpdateAckLevel(messageID, intakeQueueAckLevelKey); err != nil {
+		return err
+	}
+	return q.partitions[partition].DeleteMessagesBefore(messageID + 1)
+}
+
+func (q *intakeQueueImpl) GetAckLevel(
+	partition int32,
+) (int64, error) {
+	ackLevels, err := q.partitions[partition].GetAckLevels()
+	if err != nil {
+		return EmptyQueueMessageID, err
+	}
+	ackLevel, ok := ackLevels[intakeQueueAckLevelKey]
+	if !ok {
+		return EmptyQueueMessageID, nil
+	}
+	return ackLevel, nil
+}
+
+func (q *intakeQueueImpl) Reject(
+	message *IntakeMessage,
+	cause error,
+) error {
+	blob, err := encodeIntakeMessage(message)
+	if err != nil {
+		return err
+	}
+	blob.MessageID = message.MessageID
+	blob.Failure = cause.Error()
+	data, err := json.Marshal(blob)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %v", err)
+	}
+
+	_, err = q.partitions[message.Partition].EnqueueMessageToDLQ(commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_JSON,
+		Data:         data,
+	})
+	return err
+}
+
+func (q *intakeQueueImpl) GetOutcome(
+	namespaceID string,
+	token string,
+) (*IntakeOutcome, error) {
+	partition, messageID, err := parseIntakeToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	ackLevel, err := q.GetAckLevel(partition)
+	if err != nil {
+		return nil, err
+	}
+	if messageID > ackLevel {
+		messages, err := q.ReadMessages(partition, messageID-1, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(messages) == 0 || messages[0].MessageID != messageID || messages[0].NamespaceID != namespaceID {
+			return nil, serviceerror.NewNotFound("Intake request not found.")
+		}
+		return &IntakeOutcome{State: IntakeMessageStatePending}, nil
+	}
+
+	// messages of a partition are rejected in order of message ID, so the dead-letter queue is sorted by it
+	var pageToken []byte
+	for {
+		dlqMessages, nextPageToken, err := q.partitions[partition].ReadMessagesFromDLQ(
+			EmptyQueueMessageID,
+			MaxQueueMessageID,
+			intakeDLQPageSize,
+			pageToken,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, dlqMessage := range dlqMessages {
+			var blob intakeBlob
+			if err := json.Unmarshal(dlqMessage.Data, &blob); err != nil {
+				return nil, fmt.Errorf("failed to decode message: %v", err)
+			}
+			if blob.MessageID < messageID {
+				continue
+			}
+			if blob.MessageID > messageID {
+				return &IntakeOutcome{State: IntakeMessageStateApplied}, nil
+			}
+			if blob.NamespaceID != namespaceID {
+				return nil, serviceerror.NewNotFound("Intake request not found.")
+			}
+			return &IntakeOutcome{State: IntakeMessageStateRejected, Failure: blob.Failure}, nil
+		}
+		if len(nextPageToken) == 0 {
+			return &IntakeOutcome{State: IntakeMessageStateApplied}, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func encodeIntakeMessage(
+	message *IntakeMessage,
+) (*intakeBlob, error) {
+	blob := &intakeBlob{
+		NamespaceID: message.NamespaceID,
+		EnqueueTime: message.EnqueueTime,
+	}
+	var err error
+	switch {
+	case message.StartRequest != nil:
+		blob.StartRequest, err = proto.Marshal(message.StartRequest)
+	case message.SignalRequest != nil:
+		blob.SignalRequest, err = proto.Marshal(message.SignalRequest)
+	default:
+		return nil, fmt.Errorf("intake message has no request")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode intake request: %v", err)
+	}
+	return blob, nil
+}
+
+func decodeIntakeMessage(
+	blob *intakeBlob,
+) (*IntakeMessage, error) {
+	message := &IntakeMessage{
+		NamespaceID: blob.NamespaceID,
+		EnqueueTime: blob.EnqueueTime,
+	}
+	var err error
+	if len(blob.StartRequest) > 0 {
+		message.StartRequest = &workflowservice.StartWorkflowExecutionRequest{}
+		err = proto.Unmarshal(blob.StartRequest, message.StartRequest)
+	} else {
+		message.SignalRequest = &workflowservice.SignalWorkflowExecutionRequest{}
+		err = proto.Unmarshal(blob.SignalRequest, message.SignalRequest)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode intake request: %v", err)
+	}
+	return message, nil
+}
+
+func intakeMessageWorkflowID(
+	message *IntakeMessage,
+) string {
+	if message.StartRequest != nil {
+		return message.StartRequest.GetWorkflowId()
+	}
+	return message.SignalRequest.GetWorkflowExecution().GetWorkflowId()
+}
+
+func intakeToken(
+	partition int32,
+	messageID int64,
+) string {
+	return fmt.Sprintf("%d.%d", partition, messageID)
+}
+
+func parseIntakeToken(
+	token string,
+) (int32, int64, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) == 2 {
+		partition, partitionErr := strconv.ParseInt(parts[0], 10, 32)
+		messageID, messageIDErr := strconv.ParseInt(parts[1], 10, 64)
+		if partitionErr == nil && messageIDErr == nil && partition >= 0 && partition < IntakeQueuePartitionCount {
+			return int32(partition), messageID, nil
+		}
+	}
+	return 0, 0, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid intake token: %v.", token))
+}
@@ -0,0 +1,246 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/payloads"
+)
+
+type (
+	intakeQueueSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		partitions  []*inMemoryQueue
+		intakeQueue IntakeQueue
+	}
+)
+
+func TestIntakeQueueSuite(t *testing.T) {
+	s := new(intakeQueueSuite)
+	suite.Run(t, s)
+}
+
+func (s *intakeQueueSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.partitions = nil
+	var partitions []Queue
+	for i := 0; i < IntakeQueuePartitionCount; i++ {
+		partition := &inMemoryQueue{ackLevels: make(map[string]int64)}
+		s.partitions = append(s.partitions, partition)
+		partitions = append(partitions, partition)
+	}
+	s.intakeQueue = NewIntakeQueue(partitions)
+}
+
+func (s *intakeQueueSuite) TestEnqueueAndReadMessages() {
+	enqueueTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	start := &IntakeMessage{
+		NamespaceID: "namespace-id",
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:  "namespace",
+			WorkflowId: "workflow",
+			RequestId:  "request-1",
+			Input:      payloads.EncodeString("input"),
+		},
+		EnqueueTime: enqueueTime,
+	}
+	signal := &IntakeMessage{
+		NamespaceID: "namespace-id",
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         "namespace",
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "workflow"},
+			SignalName:        "signal",
+			RequestId:         "request-2",
+		},
+		EnqueueTime: enqueueTime,
+	}
+	partition := IntakePartition("namespace-id", "workflow")
+	token, err := s.intakeQueue.Enqueue(start)
+	s.NoError(err)
+	s.Equal(fmt.Sprintf("%d.1", partition), token)
+	token, err = s.intakeQueue.Enqueue(signal)
+	s.NoError(err)
+	s.Equal(fmt.Sprintf("%d.2", partition), token)
+	_, err = s.intakeQueue.Enqueue(&IntakeMessage{NamespaceID: "namespace-id"})
+	s.Error(err)
+
+	messages, err := s.intakeQueue.ReadMessages(partition, EmptyQueueMessageID, 10)
+	s.NoError(err)
+	s.Len(messages, 2)
+	start.Partition = partition
+	start.MessageID = 1
+	signal.Partition = partition
+	signal.MessageID = 2
+	s.Equal(start, messages[0])
+	s.Equal(signal, messages[1])
+
+	messages, err = s.intakeQueue.ReadMessages(partition, 1, 10)
+	s.NoError(err)
+	s.Len(messages, 1)
+	s.Equal(signal, messages[0])
+}
+
+func (s *intakeQueueSuite) TestEnqueue_PartitionsByWorkflow() {
+	used := make(map[int32]struct{})
+	for i := 0; i < 100; i++ {
+		workflowID := fmt.Sprintf("workflow-%d", i)
+		_, err := s.intakeQueue.Enqueue(s.newStartMessage("namespace-id", workflowID))
+		s.NoError(err)
+		partition := IntakePartition("namespace-id", workflowID)
+		s.True(partition >= 0 && partition < IntakeQueuePartitionCount)
+		used[partition] = struct{}{}
+	}
+	s.True(len(used) > 1)
+
+	count := 0
+	for _, partition := range s.partitions {
+		count += len(partition.messages)
+	}
+	s.Equal(100, count)
+}
+
+func (s *intakeQueueSuite) TestAck() {
+	partition := IntakePartition("namespace-id", "workflow")
+	ackLevel, err := s.intakeQueue.GetAckLevel(partition)
+	s.NoError(err)
+	s.Equal(EmptyQueueMessageID, ackLevel)
+
+	for i := 0; i < 3; i++ {
+		_, err := s.intakeQueue.Enqueue(s.newStartMessage("namespace-id", "workflow"))
+		s.NoError(err)
+	}
+	s.NoError(s.intakeQueue.Ack(partition, 2))
+
+	ackLevel, err = s.intakeQueue.GetAckLevel(partition)
+	s.NoError(err)
+	s.Equal(int64(2), ackLevel)
+	messages, err := s.intakeQueue.ReadMessages(partition, EmptyQueueMessageID, 10)
+	s.NoError(err)
+	s.Len(messages, 1)
+	s.Equal(int64(3), messages[0].MessageID)
+}
+
+func (s *intakeQueueSuite) TestGetOutcome() {
+	partition := IntakePartition("namespace-id", "workflow")
+	var tokens []string
+	for i := 0; i < 2*intakeDLQPageSize; i++ {
+		token, err := s.intakeQueue.Enqueue(s.newStartMessage("namespace-id", "workflow"))
+		s.NoError(err)
+		tokens = append(tokens, token)
+	}
+	messages, err := s.intakeQueue.ReadMessages(partition, EmptyQueueMessageID, 2*intakeDLQPageSize)
+	s.NoError(err)
+	// every third message is rejected, the rest is applied
+	for i, message := range messages[:len(messages)-1] {
+		if i%3 == 0 {
+			s.NoError(s.intakeQueue.Reject(message, serviceerror.NewInvalidArgument("invalid request")))
+		}
+	}
+	s.NoError(s.intakeQueue.Ack(partition, messages[len(messages)-2].MessageID))
+
+	outcome, err := s.intakeQueue.GetOutcome("namespace-id", tokens[len(tokens)-1])
+	s.NoError(err)
+	s.Equal(&IntakeOutcome{State: IntakeMessageStatePending}, outcome)
+	outcome, err = s.intakeQueue.GetOutcome("namespace-id", tokens[150])
+	s.NoError(err)
+	s.Equal(&IntakeOutcome{State: IntakeMessageStateRejected, Failure: "invalid request"}, outcome)
+	outcome, err = s.intakeQueue.GetOutcome("namespace-id", tokens[151])
+	s.NoError(err)
+	s.Equal(&IntakeOutcome{State: IntakeMessageStateApplied}, outcome)
+	outcome, err = s.intakeQueue.GetOutcome("namespace-id", tokens[1])
+	s.NoError(err)
+	s.Equal(&IntakeOutcome{State: IntakeMessageStateApplied}, outcome)
+
+	_, err = s.intakeQueue.GetOutcome("other-namespace-id", tokens[len(tokens)-1])
+	s.IsType(&serviceerror.NotFound{}, err)
+	_, err = s.intakeQueue.GetOutcome("other-namespace-id", tokens[0])
+	s.IsType(&serviceerror.NotFound{}, err)
+	_, err = s.intakeQueue.GetOutcome("namespace-id", fmt.Sprintf("%d.1000", partition))
+	s.IsType(&serviceerror.NotFound{}, err)
+	for _, token := range []string{"", "1", "a.1", "1.a", "-1.1", fmt.Sprintf("%d.1", IntakeQueuePartitionCount)} {
+		_, err = s.intakeQueue.GetOutcome("namespace-id", token)
+		s.IsType(&serviceerror.InvalidArgument{}, err)
+	}
+}
+
+func (s *intakeQueueSuite) newStartMessage(
+	namespaceID string,
+	workflowID string,
+) *IntakeMessage {
+	return &IntakeMessage{
+		NamespaceID:  namespaceID,
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{WorkflowId: workflowID},
+	}
+}
+
+func (q *inMemoryQueue) UpdateAckLevel(messageID int64, clusterName string) error {
+	q.ackLevels[clusterName] = messageID
+	return nil
+}
+
+func (q *inMemoryQueue) GetAckLevels() (map[string]int64, error) {
+	return q.ackLevels, nil
+}
+
+func (q *inMemoryQueue) EnqueueMessageToDLQ(blob commonpb.DataBlob) (int64, error) {
+	messageID := int64(len(q.dlq) + 1)
+	q.dlq = append(q.dlq, &QueueMessage{ID: messageID, Data: blob.Data, Encoding: blob.EncodingType.String()})
+	return messageID, nil
+}
+
+func (q *inMemoryQueue) ReadMessagesFromDLQ(
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*QueueMessage, []byte, error) {
+	offset := 0
+	if len(pageToken) > 0 {
+		offset, _ = strconv.Atoi(string(pageToken))
+	}
+	var messages []*QueueMessage
+	for _, message := range q.dlq[offset:] {
+		if message.ID > firstMessageID && message.ID <= lastMessageID && len(messages) < pageSize {
+			messages = append(messages, message)
+		}
+	}
+	var nextPageToken []byte
+	if offset+len(messages) < len(q.dlq) {
+		nextPageToken = []byte(strconv.Itoa(offset + len(messages)))
+	}
+	return messages, nextPageToken, nil
+}
@@ -0,0 +1,247 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination leaderLease_mock.go -self_package go.temporal.io/server/common/persistence
+
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+type (
+	// LeaderLeaseStore stores leases of singleton jobs which must run on at most one host of the cluster.
+	// A lease is held by one owner until it expires or is released, every tenure of an owner gets a fencing
+	// token which is greater than the tokens of all previous tenures.
+	LeaderLeaseStore interface {
+		// AcquireLease acquires or renews the lease for the owner unless another owner holds a lease which
+		// is not expired, it returns the lease in effect after the attempt
+		AcquireLease(leaseID int32, owner string, ttl time.Duration) (*LeaderLease, error)
+		// ReleaseLease releases the lease if it is still held by the owner with the fencing token
+		ReleaseLease(leaseID int32, owner string, token int64) error
+	}
+
+	// LeaderLease is a lease of a singleton job
+	LeaderLease struct {
+		// Owner is the identity of the holder of the lease, it is empty if the lease was released
+		Owner      string
+		Token      int64
+		ExpiryTime time.Time
+	}
+
+	// LeaderLeaseQueueProvider returns the queue of the queue type, the queue stores the records of a lease
+	LeaderLeaseQueueProvider func(queueType QueueType) (Queue, error)
+
+	// leaderLeaseStoreImpl stores every acquisition, renewal and release of a lease as a record of the lease
+	// queue. A record takes effect only if it directly follows the record its writer based it on, so the
+	// queue, which assigns message IDs with insert-if-absent, is used as compare-and-swap. The fencing token
+	// of a tenure is the message ID of the record which started it.
+	leaderLeaseStoreImpl struct {
+		queueProvider LeaderLeaseQueueProvider
+		sync.Mutex
+		queues map[QueueType]Queue
+	}
+
+	// leaderLeaseBlob is the stored form of a lease record
+	leaderLeaseBlob struct {
+		Owner      string    `json:"owner,omitempty"`
+		ExpiryTime time.Time `json:"expiryTime"`
+		// PreviousID is the message ID of the last record of the queue when the record was written
+		PreviousID int64 `json:"previousId"`
+		// NewTenure is whether the record starts a tenure, the token of the tenure is the ID of the record
+		NewTenure bool  `json:"newTenure,omitempty"`
+		Token     int64 `json:"token,omitempty"`
+	}
+
+	// leaderLeaseRecord is the lease record in effect and the ID of the last record of the queue
+	leaderLeaseRecord struct {
+		id     int64
+		lease  *LeaderLease
+		lastID int64
+	}
+)
+
+const (
+	// leaderLeaseReadPageSize is the page size used to read the records of a lease, records before the one
+	// in effect are trimmed on every acquisition so the queue holds only a few records
+	leaderLeaseReadPageSize = 100
+)
+
+var _ LeaderLeaseStore = (*leaderLeaseStoreImpl)(nil)
+
+// NewLeaderLeaseStore creates a new LeaderLeaseStore instance, queues of leases are created on first use
+func NewLeaderLeaseStore(queueProvider LeaderLeaseQueueProvider) LeaderLeaseStore {
+	return &leaderLeaseStoreImpl{
+		queueProvider: queueProvider,
+		queues:        make(map[QueueType]Queue),
+	}
+}
+
+// LeaderLeaseQueueType returns the queue type of the lease
+func LeaderLeaseQueueType(leaseID int32) QueueType {
+	return LeaderLeaseQueueTypeBase + QueueType(leaseID)
+}
+
+func (s *leaderLeaseStoreImpl) AcquireLease(leaseID int32, owner string, ttl time.Duration) (*LeaderLease, error) {
+	queue, err := s.getQueue(leaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.readLease(queue)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	blob := &leaderLeaseBlob{
+		Owner:      owner,
+		ExpiryTime: now.Add(ttl),
+		PreviousID: current.lastID,
+	}
+	switch {
+	case current.lease != nil && current.lease.Owner == owner && now.Before(current.lease.ExpiryTime):
+		blob.Token = current.lease.Token
+	case current.lease != nil && current.lease.Owner != "" && now.Before(current.lease.ExpiryTime):
+		return current.lease, nil
+	default:
+		blob.NewTenure = true
+	}
+
+	if _, err := s.enqueue(queue, blob); err != nil {
+		// lost the race to another writer, the lease in effect is read below
+		if _, ok := err.(*ConditionFailedError); !ok {
+			return nil, err
+		}
+	}
+
+	current, err = s.readLease(queue)
+	if err != nil {
+		return nil, err
+	}
+	if current.lease != nil && current.lease.Owner == owner {
+		if err := queue.DeleteMessagesBefore(current.id); err != nil {
+			return nil, err
+		}
+	}
+	return current.lease, nil
+}
+
+func (s *leaderLeaseStoreImpl) ReleaseLease(leaseID int32, owner string, token int64) error {
+	queue, err := s.getQueue(leaseID)
+	if err != nil {
+		return err
+	}
+
+	current, err := s.readLease(queue)
+	if err != nil {
+		return err
+	}
+	if current.lease == nil || current.lease.Owner != owner || current.lease.Token != token {
+		return nil
+	}
+
+	_, err = s.enqueue(queue, &leaderLeaseBlob{
+		ExpiryTime: time.Now().UTC(),
+		PreviousID: current.lastID,
+	})
+	if _, ok := err.(*ConditionFailedError); ok {
+		// another writer changed the lease, it is not held by the owner anymore
+		return nil
+	}
+	return err
+}
+
+func (s *leaderLeaseStoreImpl) enqueue(queue Queue, blob *leaderLeaseBlob) (int64, error) {
+	data, err := json.Marshal(blob)
+	if err != nil {
+		return EmptyQueueMessageID, fmt.Errorf("failed to encode lease: %v", err)
+	}
+	return queue.EnqueueMessage(commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_JSON,
+		Data:         data,
+	})
+}
+
+// readLease reads all records of the lease queue and returns the record in effect. The first record of the
+// queue is in effect, as records are only trimmed before the one in effect, any later record is in effect
+// if it was based on the record directly preceding it.
+func (s *leaderLeaseStoreImpl) readLease(queue Queue) (*leaderLeaseRecord, error) {
+	current := &leaderLeaseRecord{
+		id:     EmptyQueueMessageID,
+		lastID: EmptyQueueMessageID,
+	}
+	for {
+		messages, err := queue.ReadMessages(current.lastID, leaderLeaseReadPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range messages {
+			var blob leaderLeaseBlob
+			if err := json.Unmarshal(message.Data, &blob); err != nil {
+				return nil, fmt.Errorf("failed to decode lease: %v", err)
+			}
+			if current.lastID == EmptyQueueMessageID || blob.PreviousID == current.lastID {
+				token := blob.Token
+				if blob.NewTenure {
+					token = message.ID
+				}
+				current.id = message.ID
+				current.lease = &LeaderLease{
+					Owner:      blob.Owner,
+					Token:      token,
+					ExpiryTime: blob.ExpiryTime,
+				}
+			}
+			current.lastID = message.ID
+		}
+
+		if len(messages) < leaderLeaseReadPageSize {
+			return current, nil
+		}
+	}
+}
+
+func (s *leaderLeaseStoreImpl) getQueue(leaseID int32) (Queue, error) {
+	queueType := LeaderLeaseQueueType(leaseID)
+
+	s.Lock()
+	defer s.Unlock()
+	if queue, ok := s.queues[queueType]; ok {
+		return queue, nil
+	}
+	queue, err := s.queueProvider(queueType)
+	if err != nil {
+		return nil, err
+	}
+	s.queues[queueType] = queue
+	return queue, nil
+}
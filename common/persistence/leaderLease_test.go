@@ -0,0 +1,89 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaderLeaseStore(t *testing.T) {
+	queue := &inMemoryQueue{lastMessageID: EmptyQueueMessageID}
+	store := NewLeaderLeaseStore(func(queueType QueueType) (Queue, error) {
+		require.Equal(t, LeaderLeaseQueueTypeBase+3, queueType)
+		return queue, nil
+	})
+
+	lease, err := store.AcquireLease(3, "host-a", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "host-a", lease.Owner)
+	token := lease.Token
+
+	// lease is held by host-a until it expires
+	lease, err = store.AcquireLease(3, "host-b", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "host-a", lease.Owner)
+
+	// renewal keeps the token and trims records before the one in effect
+	lease, err = store.AcquireLease(3, "host-a", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "host-a", lease.Owner)
+	require.Equal(t, token, lease.Token)
+	require.Len(t, queue.messages, 1)
+
+	// record which is not based on the last record does not take effect
+	_, err = store.(*leaderLeaseStoreImpl).enqueue(queue, &leaderLeaseBlob{
+		Owner:      "host-b",
+		ExpiryTime: time.Now().Add(time.Minute),
+		PreviousID: EmptyQueueMessageID,
+		NewTenure:  true,
+	})
+	require.NoError(t, err)
+	lease, err = store.AcquireLease(3, "host-a", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "host-a", lease.Owner)
+	require.Equal(t, token, lease.Token)
+
+	// release with stale token is ignored
+	require.NoError(t, store.ReleaseLease(3, "host-a", token-1))
+	lease, err = store.AcquireLease(3, "host-b", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "host-a", lease.Owner)
+
+	require.NoError(t, store.ReleaseLease(3, "host-a", token))
+	lease, err = store.AcquireLease(3, "host-b", -time.Second)
+	require.NoError(t, err)
+	require.Equal(t, "host-b", lease.Owner)
+	require.Greater(t, lease.Token, token)
+	token = lease.Token
+
+	// expired lease is acquired by another owner with a new token
+	lease, err = store.AcquireLease(3, "host-a", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "host-a", lease.Owner)
+	require.Greater(t, lease.Token, token)
+}
@@ -0,0 +1,196 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination namespaceRegistrations_mock.go -self_package go.temporal.io/server/common/persistence
+
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+type (
+	// NamespaceRegistrationStore stores namespace registrations requested by callers without system admin role
+	// until they are approved or rejected.
+	NamespaceRegistrationStore interface {
+		AddRegistration(registration *NamespaceRegistration) (int64, error)
+		GetRegistration(registrationID int64) (*NamespaceRegistration, error)
+		// ListRegistrations returns registrations ordered by registration ID
+		ListRegistrations(pageSize int, pageToken []byte) ([]*NamespaceRegistration, []byte, error)
+		DeleteRegistration(registrationID int64) error
+	}
+
+	// NamespaceRegistration is a namespace registration which waits for approval
+	NamespaceRegistration struct {
+		// RegistrationID is assigned by the queue, it is set on read only
+		RegistrationID int64
+		// Requester is the subject of the caller who requested the registration
+		Requester   string
+		Request     *workflowservice.RegisterNamespaceRequest
+		CreatedTime time.Time
+	}
+
+	// NamespaceRegistrationQueueProvider returns the queue which stores namespace registrations in its DLQ
+	NamespaceRegistrationQueueProvider func() (Queue, error)
+
+	namespaceRegistrationStoreImpl struct {
+		queueProvider NamespaceRegistrationQueueProvider
+
+		sync.Mutex
+		queue Queue
+	}
+
+	// namespaceRegistrationBlob is the stored form of NamespaceRegistration, the request is proto encoded
+	namespaceRegistrationBlob struct {
+		Requester   string    `json:"requester"`
+		Request     []byte    `json:"request"`
+		CreatedTime time.Time `json:"createdTime"`
+	}
+)
+
+var _ NamespaceRegistrationStore = (*namespaceRegistrationStoreImpl)(nil)
+
+// NewNamespaceRegistrationStore creates a new NamespaceRegistrationStore instance, the queue is created on first use
+func NewNamespaceRegistrationStore(queueProvider NamespaceRegistrationQueueProvider) NamespaceRegistrationStore {
+	return &namespaceRegistrationStoreImpl{
+		queueProvider: queueProvider,
+	}
+}
+
+func (s *namespaceRegistrationStoreImpl) AddRegistration(registration *NamespaceRegistration) (int64, error) {
+	queue, err := s.getQueue()
+	if err != nil {
+		return EmptyQueueMessageID, err
+	}
+
+	request, err := proto.Marshal(registration.Request)
+	if err != nil {
+		return EmptyQueueMessageID, fmt.Errorf("failed to encode register namespace request: %v", err)
+	}
+	data, err := json.Marshal(&namespaceRegistrationBlob{
+		Requester:   registration.Requester,
+		Request:     request,
+		CreatedTime: registration.CreatedTime,
+	})
+	if err != nil {
+		return EmptyQueueMessageID, fmt.Errorf("failed to encode registration: %v", err)
+	}
+
+	return queue.EnqueueMessageToDLQ(commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_JSON,
+		Data:         data,
+	})
+}
+
+func (s *namespaceRegistrationStoreImpl) GetRegistration(registrationID int64) (*NamespaceRegistration, error) {
+	queue, err := s.getQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	messages, _, err := queue.ReadMessagesFromDLQ(registrationID-1, registrationID, 1, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("namespace registration %d not found", registrationID))
+	}
+	return decodeNamespaceRegistration(messages[0])
+}
+
+func (s *namespaceRegistrationStoreImpl) ListRegistrations(
+	pageSize int,
+	pageToken []byte,
+) ([]*NamespaceRegistration, []byte, error) {
+
+	queue, err := s.getQueue()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages, token, err := queue.ReadMessagesFromDLQ(EmptyQueueMessageID, math.MaxInt64, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	registrations := make([]*NamespaceRegistration, 0, len(messages))
+	for _, message := range messages {
+		registration, err := decodeNamespaceRegistration(message)
+		if err != nil {
+			return nil, nil, err
+		}
+		registrations = append(registrations, registration)
+	}
+	return registrations, token, nil
+}
+
+func (s *namespaceRegistrationStoreImpl) DeleteRegistration(registrationID int64) error {
+	queue, err := s.getQueue()
+	if err != nil {
+		return err
+	}
+	return queue.DeleteMessageFromDLQ(registrationID)
+}
+
+func (s *namespaceRegistrationStoreImpl) getQueue() (Queue, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.queue != nil {
+		return s.queue, nil
+	}
+	queue, err := s.queueProvider()
+	if err != nil {
+		return nil, err
+	}
+	s.queue = queue
+	return queue, nil
+}
+
+func decodeNamespaceRegistration(message *QueueMessage) (*NamespaceRegistration, error) {
+	var blob namespaceRegistrationBlob
+	if err := json.Unmarshal(message.Data, &blob); err != nil {
+		return nil, fmt.Errorf("failed to decode registration: %v", err)
+	}
+
+	request := &workflowservice.RegisterNamespaceRequest{}
+	if err := proto.Unmarshal(blob.Request, request); err != nil {
+		return nil, fmt.Errorf("failed to decode register namespace request: %v", err)
+	}
+	return &NamespaceRegistration{
+		RegistrationID: message.ID,
+		Requester:      blob.Requester,
+		Request:        request,
+		CreatedTime:    blob.CreatedTime,
+	}, nil
+}
@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+func TestNamespaceRegistrationStore(t *testing.T) {
+	providerCalls := 0
+	store := NewNamespaceRegistrationStore(func() (Queue, error) {
+		providerCalls++
+		return &inMemoryDLQ{}, nil
+	})
+	createdTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	newRegistration := func(namespace string) *NamespaceRegistration {
+		return &NamespaceRegistration{
+			Requester: "user",
+			Request: &workflowservice.RegisterNamespaceRequest{
+				Namespace:   namespace,
+				Description: "description",
+				OwnerEmail:  "user@example.com",
+			},
+			CreatedTime: createdTime,
+		}
+	}
+
+	registrationID, err := store.AddRegistration(newRegistration("first"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), registrationID)
+	registrationID, err = store.AddRegistration(newRegistration("second"))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), registrationID)
+
+	registration, err := store.GetRegistration(2)
+	require.NoError(t, err)
+	expected := newRegistration("second")
+	expected.RegistrationID = 2
+	require.Equal(t, expected, registration)
+
+	registrations, token, err := store.ListRegistrations(1, nil)
+	require.NoError(t, err)
+	require.Len(t, registrations, 1)
+	require.Equal(t, "first", registrations[0].Request.GetNamespace())
+	registrations, _, err = store.ListRegistrations(1, token)
+	require.NoError(t, err)
+	require.Len(t, registrations, 1)
+	require.Equal(t, "second", registrations[0].Request.GetNamespace())
+
+	require.NoError(t, store.DeleteRegistration(1))
+	_, err = store.GetRegistration(1)
+	require.IsType(t, &serviceerror.NotFound{}, err)
+	registrations, _, err = store.ListRegistrations(10, nil)
+	require.NoError(t, err)
+	require.Len(t, registrations, 1)
+
+	require.Equal(t, 1, providerCalls)
+}
+
+func TestNamespaceRegistrationStore_QueueError(t *testing.T) {
+	queueErr := errors.New("some error")
+	store := NewNamespaceRegistrationStore(func() (Queue, error) {
+		return nil, queueErr
+	})
+	_, err := store.GetRegistration(1)
+	require.Equal(t, queueErr, err)
+}
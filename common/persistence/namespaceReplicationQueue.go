@@ -120,7 +120,8 @@ func (q *namespaceReplicationQueueImpl) Publish(message interface{}) error {
 	if err != nil {
 		return fmt.Errorf("failed to encode message: %v", err)
 	}
-	return q.queue.EnqueueMessage(blob)
+	_, err = q.queue.EnqueueMessage(blob)
+	return err
 }
 
 func (q *namespaceReplicationQueueImpl) PublishToDLQ(message interface{}) error {
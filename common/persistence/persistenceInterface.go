@@ -128,6 +128,10 @@ type (
 		GetVisibilityTasks(request *GetVisibilityTasksRequest) (*GetVisibilityTasksResponse, error)
 		CompleteVisibilityTask(request *CompleteVisibilityTaskRequest) error
 		RangeCompleteVisibilityTask(request *RangeCompleteVisibilityTaskRequest) error
+
+		// custom tasks
+		GetCustomTasks(request *GetCustomTasksRequest) (*GetCustomTasksResponse, error)
+		RangeCompleteCustomTask(request *RangeCompleteCustomTaskRequest) error
 	}
 
 	// HistoryStore is to manager workflow history events
@@ -152,7 +156,11 @@ type (
 		GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
 	}
 
-	// VisibilityStore is the store interface for visibility
+	// VisibilityStore is the store interface for visibility. Besides the built-in stores it can be implemented
+	// outside of the server and plugged in with client.RegisterVisibilityStorePlugin. Memo and search attributes
+	// of the requests are passed through as they are, list responses are paginated with opaque NextPageToken.
+	// Implementations which don't support list queries return serviceerror.Unimplemented from
+	// ListWorkflowExecutions, ScanWorkflowExecutions and CountWorkflowExecutions.
 	VisibilityStore interface {
 		Closeable
 		GetName() string
@@ -184,7 +192,7 @@ type (
 	// Queue is a store to enqueue and get messages
 	Queue interface {
 		Closeable
-		EnqueueMessage(blob commonpb.DataBlob) error
+		EnqueueMessage(blob commonpb.DataBlob) (int64, error)
 		ReadMessages(lastMessageID int64, maxCount int) ([]*QueueMessage, error)
 		DeleteMessagesBefore(messageID int64) error
 		UpdateAckLevel(messageID int64, clusterName string) error
@@ -291,6 +299,7 @@ type (
 		TimerTasks       []Task
 		ReplicationTasks []Task
 		VisibilityTasks  []Task
+		CustomTasks      []Task
 
 		Condition int64
 
@@ -315,6 +324,7 @@ type (
 		TimerTasks       []Task
 		ReplicationTasks []Task
 		VisibilityTasks  []Task
+		CustomTasks      []Task
 
 		Condition int64
 
@@ -345,6 +355,8 @@ type (
 		NodeID int64
 		// The events to be appended
 		Events *commonpb.DataBlob
+		// Serialized checksum of the events blob
+		EventsChecksum []byte
 		// Requested TransactionID for conditional update
 		TransactionID int64
 		// Used in sharded data stores to identify which shard to use
@@ -426,6 +438,8 @@ type (
 	InternalReadHistoryBranchResponse struct {
 		// History events
 		History []*commonpb.DataBlob
+		// Serialized checksums of history events blobs, nil for blob persisted without checksum
+		HistoryChecksums [][]byte
 		// Pagination token
 		NextPageToken []byte
 		// LastNodeID is the last known node ID attached to a history node
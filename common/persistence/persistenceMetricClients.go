@@ -422,6 +422,20 @@ func (p *workflowExecutionPersistenceClient) GetVisibilityTasks(request *GetVisi
 	return response, err
 }
 
+func (p *workflowExecutionPersistenceClient) GetCustomTasks(request *GetCustomTasksRequest) (*GetCustomTasksResponse, error) {
+	p.metricClient.IncCounter(metrics.PersistenceGetCustomTasksScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceGetCustomTasksScope, metrics.PersistenceLatency)
+	response, err := p.persistence.GetCustomTasks(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceGetCustomTasksScope, err)
+	}
+
+	return response, err
+}
+
 func (p *workflowExecutionPersistenceClient) GetReplicationTask(request *GetReplicationTaskRequest) (*GetReplicationTaskResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetReplicationTaskScope, metrics.PersistenceRequests)
 
@@ -506,6 +520,20 @@ func (p *workflowExecutionPersistenceClient) RangeCompleteVisibilityTask(request
 	return err
 }
 
+func (p *workflowExecutionPersistenceClient) RangeCompleteCustomTask(request *RangeCompleteCustomTaskRequest) error {
+	p.metricClient.IncCounter(metrics.PersistenceRangeCompleteCustomTaskScope, metrics.PersistenceRequests)
+
+	sw := p.metricClient.StartTimer(metrics.PersistenceRangeCompleteCustomTaskScope, metrics.PersistenceLatency)
+	err := p.persistence.RangeCompleteCustomTask(request)
+	sw.Stop()
+
+	if err != nil {
+		p.updateErrorMetric(metrics.PersistenceRangeCompleteCustomTaskScope, err)
+	}
+
+	return err
+}
+
 func (p *workflowExecutionPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteReplicationTaskScope, metrics.PersistenceRequests)
 
@@ -1245,6 +1273,9 @@ func (p *historyV2PersistenceClient) AppendHistoryNodes(request *AppendHistoryNo
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceAppendHistoryNodesScope, err)
 	}
+	if resp != nil && resp.IsDuplicate {
+		p.metricClient.IncCounter(metrics.PersistenceAppendHistoryNodesScope, metrics.PersistenceDuplicateHistoryAppendCounter)
+	}
 	return resp, err
 }
 
@@ -1350,18 +1381,18 @@ func (p *historyV2PersistenceClient) updateErrorMetric(scope int, err error) {
 	}
 }
 
-func (p *queuePersistenceClient) EnqueueMessage(blob commonpb.DataBlob) error {
+func (p *queuePersistenceClient) EnqueueMessage(blob commonpb.DataBlob) (int64, error) {
 	p.metricClient.IncCounter(metrics.PersistenceEnqueueMessageScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceEnqueueMessageScope, metrics.PersistenceLatency)
-	err := p.persistence.EnqueueMessage(blob)
+	messageID, err := p.persistence.EnqueueMessage(blob)
 	sw.Stop()
 
 	if err != nil {
 		p.metricClient.IncCounter(metrics.PersistenceEnqueueMessageScope, metrics.PersistenceFailures)
 	}
 
-	return err
+	return messageID, err
 }
 
 func (p *queuePersistenceClient) ReadMessages(lastMessageID int64, maxCount int) ([]*QueueMessage, error) {
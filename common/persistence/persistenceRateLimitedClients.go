@@ -330,6 +330,15 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetVisibilityTasks(reque
 	return response, err
 }
 
+func (p *workflowExecutionRateLimitedPersistenceClient) GetCustomTasks(request *GetCustomTasksRequest) (*GetCustomTasksResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+
+	response, err := p.persistence.GetCustomTasks(request)
+	return response, err
+}
+
 func (p *workflowExecutionRateLimitedPersistenceClient) GetReplicationTask(request *GetReplicationTaskRequest) (*GetReplicationTaskResponse, error) {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return nil, ErrPersistenceLimitExceeded
@@ -384,6 +393,15 @@ func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteVisibilityT
 	return err
 }
 
+func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteCustomTask(request *RangeCompleteCustomTaskRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+
+	err := p.persistence.RangeCompleteCustomTask(request)
+	return err
+}
+
 func (p *workflowExecutionRateLimitedPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
 	if ok := p.rateLimiter.Allow(); !ok {
 		return ErrPersistenceLimitExceeded
@@ -874,9 +892,9 @@ func (p *historyV2RateLimitedPersistenceClient) GetAllHistoryTreeBranches(reques
 	return response, err
 }
 
-func (p *queueRateLimitedPersistenceClient) EnqueueMessage(blob commonpb.DataBlob) error {
+func (p *queueRateLimitedPersistenceClient) EnqueueMessage(blob commonpb.DataBlob) (int64, error) {
 	if ok := p.rateLimiter.Allow(); !ok {
-		return ErrPersistenceLimitExceeded
+		return EmptyQueueMessageID, ErrPersistenceLimitExceeded
 	}
 
 	return p.persistence.EnqueueMessage(blob)
@@ -217,6 +217,15 @@ func VisibilityTaskInfoFromBlob(blob []byte, encoding string) (*persistencespb.V
 	return result, proto3Decode(blob, encoding, result)
 }
 
+func CustomTaskInfoToBlob(info *CustomTaskInfo) (commonpb.DataBlob, error) {
+	return proto3Encode(info)
+}
+
+func CustomTaskInfoFromBlob(blob []byte, encoding string) (*CustomTaskInfo, error) {
+	result := &CustomTaskInfo{}
+	return result, proto3Decode(blob, encoding, result)
+}
+
 func ChecksumToBlob(checksum *persistencespb.Checksum) (commonpb.DataBlob, error) {
 	// nil is replaced with empty object because it is not supported for "checksum" field in DB.
 	if checksum == nil {
@@ -0,0 +1,143 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+type (
+	// ShardJournal is the journal of a history shard. The owner of the shard appends the executions of mutable
+	// state updates, so that the next owner of the shard is able to warm its caches with the executions that were
+	// in flight instead of rebuilding them on first access.
+	ShardJournal interface {
+		Closeable
+		// Append appends the entry and returns the entry ID assigned by the queue
+		Append(entry *ShardJournalEntry) (int64, error)
+		// ReadEntries returns up to maxCount entries after the entry ID ordered by entry ID
+		ReadEntries(lastEntryID int64, maxCount int) ([]*ShardJournalEntry, error)
+		// TrimEntries deletes the entries before the entry ID
+		TrimEntries(entryID int64) error
+	}
+
+	// ShardJournalFactory creates the journal of a given shard
+	ShardJournalFactory interface {
+		NewShardJournal(shardID int32) (ShardJournal, error)
+	}
+
+	// ShardJournalEntry records the executions of a mutable state update
+	ShardJournalEntry struct {
+		// EntryID is assigned by the queue, it is set on read only
+		EntryID     int64
+		RangeID     int64
+		CreatedTime time.Time
+		Executions  []ShardJournalExecution
+	}
+
+	// ShardJournalExecution identifies an execution of a journal entry
+	ShardJournalExecution struct {
+		NamespaceID string `json:"namespaceId"`
+		WorkflowID  string `json:"workflowId"`
+		RunID       string `json:"runId"`
+		// PendingTasks is whether the update created transfer or timer tasks, which may still be in flight
+		PendingTasks bool `json:"pendingTasks,omitempty"`
+	}
+
+	shardJournalImpl struct {
+		queue Queue
+	}
+
+	// shardJournalEntryBlob is the stored form of ShardJournalEntry
+	shardJournalEntryBlob struct {
+		RangeID     int64                   `json:"rangeId"`
+		CreatedTime time.Time               `json:"createdTime"`
+		Executions  []ShardJournalExecution `json:"executions"`
+	}
+)
+
+var _ ShardJournal = (*shardJournalImpl)(nil)
+
+// NewShardJournal creates a new ShardJournal instance
+func NewShardJournal(queue Queue) ShardJournal {
+	return &shardJournalImpl{
+		queue: queue,
+	}
+}
+
+// ShardJournalQueueType returns the queue type of the journal of the shard
+func ShardJournalQueueType(shardID int32) QueueType {
+	return ShardJournalQueueTypeBase + QueueType(shardID)
+}
+
+func (j *shardJournalImpl) Append(entry *ShardJournalEntry) (int64, error) {
+	data, err := json.Marshal(&shardJournalEntryBlob{
+		RangeID:     entry.RangeID,
+		CreatedTime: entry.CreatedTime,
+		Executions:  entry.Executions,
+	})
+	if err != nil {
+		return EmptyQueueMessageID, fmt.Errorf("failed to encode journal entry: %v", err)
+	}
+
+	return j.queue.EnqueueMessage(commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_JSON,
+		Data:         data,
+	})
+}
+
+func (j *shardJournalImpl) ReadEntries(lastEntryID int64, maxCount int) ([]*ShardJournalEntry, error) {
+	messages, err := j.queue.ReadMessages(lastEntryID, maxCount)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*ShardJournalEntry, 0, len(messages))
+	for _, message := range messages {
+		var blob shardJournalEntryBlob
+		if err := json.Unmarshal(message.Data, &blob); err != nil {
+			return nil, fmt.Errorf("failed to decode journal entry: %v", err)
+		}
+		entries = append(entries, &ShardJournalEntry{
+			EntryID:     message.ID,
+			RangeID:     blob.RangeID,
+			CreatedTime: blob.CreatedTime,
+			Executions:  blob.Executions,
+		})
+	}
+	return entries, nil
+}
+
+func (j *shardJournalImpl) TrimEntries(entryID int64) error {
+	return j.queue.DeleteMessagesBefore(entryID)
+}
+
+func (j *shardJournalImpl) Close() {
+	j.queue.Close()
+}
@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+type (
+	inMemoryQueue struct {
+		Queue
+		lastMessageID int64
+		messages      []*QueueMessage
+		ackLevels     map[string]int64
+		dlq           []*QueueMessage
+	}
+)
+
+func TestShardJournal(t *testing.T) {
+	journal := NewShardJournal(&inMemoryQueue{lastMessageID: EmptyQueueMessageID})
+	createdTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	newEntry := func(workflowID string) *ShardJournalEntry {
+		return &ShardJournalEntry{
+			RangeID:     10,
+			CreatedTime: createdTime,
+			Executions:  []ShardJournalExecution{{NamespaceID: "namespace", WorkflowID: workflowID, RunID: "run"}},
+		}
+	}
+	for i, workflowID := range []string{"workflow1", "workflow2", "workflow3"} {
+		entryID, err := journal.Append(newEntry(workflowID))
+		require.NoError(t, err)
+		require.Equal(t, int64(i), entryID)
+	}
+
+	entries, err := journal.ReadEntries(0, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	expected := newEntry("workflow2")
+	expected.EntryID = 1
+	require.Equal(t, expected, entries[0])
+	require.Equal(t, int64(2), entries[1].EntryID)
+
+	require.NoError(t, journal.TrimEntries(2))
+	entries, err = journal.ReadEntries(EmptyQueueMessageID, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "workflow3", entries[0].Executions[0].WorkflowID)
+
+	require.Equal(t, QueueType(ShardJournalQueueTypeBase+5), ShardJournalQueueType(5))
+}
+
+func (q *inMemoryQueue) EnqueueMessage(blob commonpb.DataBlob) (int64, error) {
+	q.lastMessageID++
+	q.messages = append(q.messages, &QueueMessage{ID: q.lastMessageID, Data: blob.Data, Encoding: blob.EncodingType.String()})
+	return q.lastMessageID, nil
+}
+
+func (q *inMemoryQueue) ReadMessages(lastMessageID int64, maxCount int) ([]*QueueMessage, error) {
+	var messages []*QueueMessage
+	for _, message := range q.messages {
+		if message.ID > lastMessageID && len(messages) < maxCount {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+func (q *inMemoryQueue) DeleteMessagesBefore(messageID int64) error {
+	var messages []*QueueMessage
+	for _, message := range q.messages {
+		if message.ID >= messageID {
+			messages = append(messages, message)
+		}
+	}
+	q.messages = messages
+	return nil
+}
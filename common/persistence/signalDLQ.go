@@ -0,0 +1,194 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination signalDLQ_mock.go -self_package go.temporal.io/server/common/persistence
+
+package persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/gogo/protobuf/proto"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+)
+
+type (
+	// SignalDLQ stores user signals which could not be applied to their workflow executions,
+	// so that operators are able to inspect and re-deliver them. Every namespace has its own queue.
+	SignalDLQ interface {
+		Publish(message *SignalDLQMessage) error
+		// GetMessages returns messages of the namespace up to lastMessageID
+		GetMessages(namespaceID string, lastMessageID int64, pageSize int, pageToken []byte) ([]*SignalDLQMessage, []byte, error)
+		DeleteMessage(namespaceID string, messageID int64) error
+	}
+
+	// SignalDLQMessage is a signal captured in the signal DLQ
+	SignalDLQMessage struct {
+		// MessageID is assigned by the queue, it is set on read only
+		MessageID   int64
+		NamespaceID string
+		Request     *historyservice.SignalWorkflowExecutionRequest
+		// Reason is the error the signal failed with
+		Reason      string
+		EnqueueTime time.Time
+	}
+
+	// SignalDLQQueueProvider returns the queue of the queue type, the DLQ of the queue stores the signals
+	SignalDLQQueueProvider func(queueType QueueType) (Queue, error)
+
+	signalDLQImpl struct {
+		queueProvider SignalDLQQueueProvider
+
+		sync.Mutex
+		queues map[QueueType]Queue
+	}
+
+	// signalDLQBlob is the stored form of SignalDLQMessage, the request is proto encoded
+	signalDLQBlob struct {
+		NamespaceID string    `json:"namespaceId"`
+		Request     []byte    `json:"request"`
+		Reason      string    `json:"reason"`
+		EnqueueTime time.Time `json:"enqueueTime"`
+	}
+)
+
+const (
+	// signalDLQQueueTypeCount is the number of queue types namespace IDs are hashed to
+	signalDLQQueueTypeCount = 1 << 24
+)
+
+var _ SignalDLQ = (*signalDLQImpl)(nil)
+
+// NewSignalDLQ creates a new SignalDLQ instance, queues of namespaces are created on first use
+func NewSignalDLQ(queueProvider SignalDLQQueueProvider) SignalDLQ {
+	return &signalDLQImpl{
+		queueProvider: queueProvider,
+		queues:        make(map[QueueType]Queue),
+	}
+}
+
+// SignalDLQQueueType returns the queue type of the signal DLQ of the namespace
+func SignalDLQQueueType(namespaceID string) QueueType {
+	return SignalDLQQueueTypeBase + QueueType(farm.Fingerprint32([]byte(namespaceID))%signalDLQQueueTypeCount)
+}
+
+func (q *signalDLQImpl) Publish(message *SignalDLQMessage) error {
+	queue, err := q.getQueue(message.NamespaceID)
+	if err != nil {
+		return err
+	}
+
+	request, err := proto.Marshal(message.Request)
+	if err != nil {
+		return fmt.Errorf("failed to encode signal request: %v", err)
+	}
+	data, err := json.Marshal(&signalDLQBlob{
+		NamespaceID: message.NamespaceID,
+		Request:     request,
+		Reason:      message.Reason,
+		EnqueueTime: message.EnqueueTime,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %v", err)
+	}
+
+	_, err = queue.EnqueueMessageToDLQ(commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_JSON,
+		Data:         data,
+	})
+	return err
+}
+
+func (q *signalDLQImpl) GetMessages(
+	namespaceID string,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*SignalDLQMessage, []byte, error) {
+
+	queue, err := q.getQueue(namespaceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	queueMessages, token, err := queue.ReadMessagesFromDLQ(EmptyQueueMessageID, lastMessageID, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var messages []*SignalDLQMessage
+	for _, queueMessage := range queueMessages {
+		var blob signalDLQBlob
+		if err := json.Unmarshal(queueMessage.Data, &blob); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode message: %v", err)
+		}
+		// namespaces whose IDs hash to the same queue type share the queue
+		if blob.NamespaceID != namespaceID {
+			continue
+		}
+
+		request := &historyservice.SignalWorkflowExecutionRequest{}
+		if err := proto.Unmarshal(blob.Request, request); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode signal request: %v", err)
+		}
+		messages = append(messages, &SignalDLQMessage{
+			MessageID:   queueMessage.ID,
+			NamespaceID: blob.NamespaceID,
+			Request:     request,
+			Reason:      blob.Reason,
+			EnqueueTime: blob.EnqueueTime,
+		})
+	}
+	return messages, token, nil
+}
+
+func (q *signalDLQImpl) DeleteMessage(namespaceID string, messageID int64) error {
+	queue, err := q.getQueue(namespaceID)
+	if err != nil {
+		return err
+	}
+	return queue.DeleteMessageFromDLQ(messageID)
+}
+
+func (q *signalDLQImpl) getQueue(namespaceID string) (Queue, error) {
+	queueType := SignalDLQQueueType(namespaceID)
+
+	q.Lock()
+	defer q.Unlock()
+	if queue, ok := q.queues[queueType]; ok {
+		return queue, nil
+	}
+	queue, err := q.queueProvider(queueType)
+	if err != nil {
+		return nil, err
+	}
+	q.queues[queueType] = queue
+	return queue, nil
+}
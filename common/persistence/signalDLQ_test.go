@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/payloads"
+)
+
+type (
+	signalDLQSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		queues    map[QueueType]*inMemoryDLQ
+		signalDLQ SignalDLQ
+	}
+
+	// inMemoryDLQ implements DLQ part of Queue, the other methods panic
+	inMemoryDLQ struct {
+		Queue
+		messages []*QueueMessage
+	}
+)
+
+func TestSignalDLQSuite(t *testing.T) {
+	s := new(signalDLQSuite)
+	suite.Run(t, s)
+}
+
+func (s *signalDLQSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.queues = make(map[QueueType]*inMemoryDLQ)
+	s.signalDLQ = NewSignalDLQ(func(queueType QueueType) (Queue, error) {
+		queue := &inMemoryDLQ{}
+		s.queues[queueType] = queue
+		return queue, nil
+	})
+}
+
+func (s *signalDLQSuite) TestPublishAndGetMessages() {
+	enqueueTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	newMessage := func(namespaceID string, workflowID string) *SignalDLQMessage {
+		return &SignalDLQMessage{
+			NamespaceID: namespaceID,
+			Request: &historyservice.SignalWorkflowExecutionRequest{
+				NamespaceId: namespaceID,
+				SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+					WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+					SignalName:        "signal",
+					Input:             payloads.EncodeString("input"),
+				},
+			},
+			Reason:      "exceeded workflow execution limit for signal events",
+			EnqueueTime: enqueueTime,
+		}
+	}
+	s.NoError(s.signalDLQ.Publish(newMessage("namespace1", "workflow1")))
+	s.NoError(s.signalDLQ.Publish(newMessage("namespace2", "workflow2")))
+	s.NoError(s.signalDLQ.Publish(newMessage("namespace1", "workflow3")))
+
+	s.Len(s.queues, 2)
+	s.Len(s.queues[SignalDLQQueueType("namespace1")].messages, 2)
+	s.Len(s.queues[SignalDLQQueueType("namespace2")].messages, 1)
+
+	messages, _, err := s.signalDLQ.GetMessages("namespace1", 10, 10, nil)
+	s.NoError(err)
+	s.Len(messages, 2)
+	expected := newMessage("namespace1", "workflow3")
+	expected.MessageID = 2
+	s.Equal(expected, messages[1])
+
+	messages, _, err = s.signalDLQ.GetMessages("namespace2", 10, 10, nil)
+	s.NoError(err)
+	s.Len(messages, 1)
+	s.Equal(int64(1), messages[0].MessageID)
+
+	messages, _, err = s.signalDLQ.GetMessages("namespace1", 1, 10, nil)
+	s.NoError(err)
+	s.Len(messages, 1)
+
+	s.NoError(s.signalDLQ.DeleteMessage("namespace1", 1))
+	messages, _, err = s.signalDLQ.GetMessages("namespace1", 10, 10, nil)
+	s.NoError(err)
+	s.Len(messages, 1)
+	s.Equal("workflow3", messages[0].Request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+	messages, _, err = s.signalDLQ.GetMessages("namespace2", 10, 10, nil)
+	s.NoError(err)
+	s.Len(messages, 1)
+}
+
+func (s *signalDLQSuite) TestGetMessages_SharedQueue() {
+	// namespaces whose IDs hash to the same queue type share the queue
+	queue := &inMemoryDLQ{}
+	signalDLQ := NewSignalDLQ(func(queueType QueueType) (Queue, error) {
+		return queue, nil
+	})
+	for _, namespaceID := range []string{"namespace1", "namespace2", "namespace1"} {
+		s.NoError(signalDLQ.Publish(&SignalDLQMessage{
+			NamespaceID: namespaceID,
+			Request:     &historyservice.SignalWorkflowExecutionRequest{NamespaceId: namespaceID},
+		}))
+	}
+
+	messages, _, err := signalDLQ.GetMessages("namespace2", 10, 10, nil)
+	s.NoError(err)
+	s.Len(messages, 1)
+	s.Equal(int64(2), messages[0].MessageID)
+}
+
+func (q *inMemoryDLQ) EnqueueMessageToDLQ(blob commonpb.DataBlob) (int64, error) {
+	messageID := int64(len(q.messages) + 1)
+	q.messages = append(q.messages, &QueueMessage{ID: messageID, Data: blob.Data, Encoding: blob.EncodingType.String()})
+	return messageID, nil
+}
+
+func (q *inMemoryDLQ) ReadMessagesFromDLQ(firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) ([]*QueueMessage, []byte, error) {
+	if len(pageToken) > 0 {
+		firstMessageID = int64(pageToken[0])
+	}
+	var messages []*QueueMessage
+	for _, message := range q.messages {
+		if message != nil && message.ID > firstMessageID && message.ID <= lastMessageID && len(messages) < pageSize {
+			messages = append(messages, message)
+		}
+	}
+	var token []byte
+	if len(messages) == pageSize {
+		token = []byte{byte(messages[len(messages)-1].ID)}
+	}
+	return messages, token, nil
+}
+
+func (q *inMemoryDLQ) DeleteMessageFromDLQ(messageID int64) error {
+	q.messages[messageID-1] = nil
+	return nil
+}
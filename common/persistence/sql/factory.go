@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/resolver"
@@ -57,25 +58,27 @@ type (
 	dbConn struct {
 		sync.Mutex
 		sqlplugin.DB
-		refCnt   int
-		cfg      *config.SQL
-		resolver resolver.ServiceResolver
+		refCnt        int
+		cfg           *config.SQL
+		resolver      resolver.ServiceResolver
+		metricsClient metrics.Client
 	}
 )
 
 // NewFactory returns an instance of a factory object which can be used to create
-// datastores backed by any kind of SQL store
+// datastores backed by any kind of SQL store, metrics client is optional
 func NewFactory(
 	cfg config.SQL,
 	r resolver.ServiceResolver,
 	clusterName string,
+	metricsClient metrics.Client,
 	logger log.Logger,
 ) *Factory {
 	return &Factory{
 		cfg:         cfg,
 		clusterName: clusterName,
 		logger:      logger,
-		dbConn:      newRefCountedDBConn(&cfg, r),
+		dbConn:      newRefCountedDBConn(&cfg, r, metricsClient),
 	}
 }
 
@@ -161,8 +164,8 @@ func (f *Factory) Close() {
 // uses reference counting to decide when to close the
 // underlying connection object. The reference count gets incremented
 // everytime get() is called and decremented everytime Close() is called
-func newRefCountedDBConn(cfg *config.SQL, r resolver.ServiceResolver) dbConn {
-	return dbConn{cfg: cfg, resolver: r}
+func newRefCountedDBConn(cfg *config.SQL, r resolver.ServiceResolver, metricsClient metrics.Client) dbConn {
+	return dbConn{cfg: cfg, resolver: r, metricsClient: metricsClient}
 }
 
 // get returns a mysql db connection and increments a reference count
@@ -172,7 +175,7 @@ func (c *dbConn) get() (sqlplugin.DB, error) {
 	c.Lock()
 	defer c.Unlock()
 	if c.refCnt == 0 {
-		conn, err := NewSQLDB(c.cfg, c.resolver)
+		conn, err := NewSQLDB(c.cfg, c.resolver, c.metricsClient)
 		if err != nil {
 			return nil, err
 		}
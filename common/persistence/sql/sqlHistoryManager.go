@@ -26,6 +26,7 @@ package sql
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -90,6 +91,7 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(
 		TxnID:        request.TransactionID,
 		Data:         request.Events.Data,
 		DataEncoding: request.Events.EncodingType.String(),
+		DataChecksum: request.EventsChecksum,
 		ShardID:      request.ShardID,
 	}
 
@@ -116,6 +118,9 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(
 		return m.txExecute(ctx, "AppendHistoryNodes", func(tx sqlplugin.Tx) error {
 			result, err := tx.InsertIntoHistoryNode(ctx, nodeRow)
 			if err != nil {
+				if m.db.IsDupEntryError(err) {
+					return &p.ConditionFailedError{Msg: fmt.Sprintf("AppendHistoryNodes: row already exist: %v", err)}
+				}
 				return err
 			}
 			rowsAffected, err := result.RowsAffected()
@@ -196,6 +201,7 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(
 	}
 
 	history := make([]*commonpb.DataBlob, 0, request.PageSize)
+	checksums := make([][]byte, 0, request.PageSize)
 
 	for _, row := range rows {
 		eventBlob := p.NewDataBlob(row.Data, row.DataEncoding)
@@ -232,6 +238,7 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(
 			lastTxnID = row.TxnID
 			lastNodeID = row.NodeID
 			history = append(history, eventBlob)
+			checksums = append(checksums, row.DataChecksum)
 			eventBlob = &commonpb.DataBlob{}
 		}
 	}
@@ -243,6 +250,7 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(
 
 	return &p.InternalReadHistoryBranchResponse{
 		History:           history,
+		HistoryChecksums:  checksums,
 		NextPageToken:     pagingToken,
 		LastNodeID:        lastNodeID,
 		LastTransactionID: lastTxnID,
@@ -464,9 +472,73 @@ func (m *sqlHistoryV2Manager) GetAllHistoryTreeBranches(
 	request *p.GetAllHistoryTreeBranchesRequest,
 ) (*p.GetAllHistoryTreeBranchesResponse, error) {
 
-	// TODO https://github.com/uber/cadence/issues/2458
-	// Implement it when we need
-	panic("not implemented yet")
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+
+	// the first page starts after the zero UUIDs of shard 0, shard IDs start with 1
+	page := sqlplugin.HistoryTreeBranchPage{
+		TreeID:   make(primitives.UUID, 16),
+		BranchID: make(primitives.UUID, 16),
+		Limit:    request.PageSize,
+	}
+	if len(request.NextPageToken) != 0 {
+		var token historyTreeBranchPageToken
+		if err := token.deserialize(request.NextPageToken); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("GetAllHistoryTreeBranches: invalid next page token. Error: %v", err))
+		}
+		page.ShardID = token.ShardID
+		page.TreeID = token.TreeID
+		page.BranchID = token.BranchID
+	}
+
+	rows, err := m.db.PaginateBranchesFromHistoryTree(ctx, page)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("GetAllHistoryTreeBranches operation failed. Error: %v", err))
+	}
+
+	branches := make([]p.HistoryBranchDetail, 0, len(rows))
+	for _, row := range rows {
+		treeInfo, err := serialization.HistoryTreeInfoFromBlob(row.Data, row.DataEncoding)
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, p.HistoryBranchDetail{
+			TreeID:   row.TreeID.String(),
+			BranchID: row.BranchID.String(),
+			ForkTime: treeInfo.ForkTime,
+			Info:     treeInfo.Info,
+		})
+	}
+
+	response := &p.GetAllHistoryTreeBranchesResponse{
+		Branches: branches,
+	}
+	if len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		token := historyTreeBranchPageToken{
+			ShardID:  lastRow.ShardID,
+			TreeID:   lastRow.TreeID,
+			BranchID: lastRow.BranchID,
+		}
+		if response.NextPageToken, err = token.serialize(); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("GetAllHistoryTreeBranches operation failed. Error: %v", err))
+		}
+	}
+	return response, nil
+}
+
+type historyTreeBranchPageToken struct {
+	ShardID  int32
+	TreeID   primitives.UUID
+	BranchID primitives.UUID
+}
+
+func (t *historyTreeBranchPageToken) serialize() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+func (t *historyTreeBranchPageToken) deserialize(payload []byte) error {
+	return json.Unmarshal(payload, t)
 }
 
 // GetHistoryTree returns all branch information of a tree
@@ -75,30 +75,28 @@ func newQueue(
 
 func (q *sqlQueue) EnqueueMessage(
 	blob commonpb.DataBlob,
-) error {
+) (int64, error) {
 	ctx, cancel := newExecutionContext()
 	defer cancel()
+	messageID := persistence.EmptyQueueMessageID + 1
 	err := q.txExecute(ctx, "EnqueueMessage", func(tx sqlplugin.Tx) error {
 		lastMessageID, err := tx.GetLastEnqueuedMessageIDForUpdate(ctx, q.queueType)
 		switch err {
 		case nil:
-			_, err = tx.InsertIntoMessages(ctx, []sqlplugin.QueueMessageRow{
-				newQueueRow(q.queueType, lastMessageID+1, blob),
-			})
-			return err
+			messageID = lastMessageID + 1
 		case sql.ErrNoRows:
-			_, err = tx.InsertIntoMessages(ctx, []sqlplugin.QueueMessageRow{
-				newQueueRow(q.queueType, persistence.EmptyQueueMessageID+1, blob),
-			})
-			return err
 		default:
 			return fmt.Errorf("failed to get last enqueued message id: %v", err)
 		}
+		_, err = tx.InsertIntoMessages(ctx, []sqlplugin.QueueMessageRow{
+			newQueueRow(q.queueType, messageID, blob),
+		})
+		return err
 	})
 	if err != nil {
-		return serviceerror.NewInternal(err.Error())
+		return persistence.EmptyQueueMessageID, serviceerror.NewInternal(err.Error())
 	}
-	return nil
+	return messageID, nil
 }
 
 func (q *sqlQueue) ReadMessages(
@@ -60,6 +60,7 @@ func (m *sqlExecutionManager) AddTasks(
 				request.ReplicationTasks,
 				request.TimerTasks,
 				request.VisibilityTasks,
+				nil,
 			)
 		})
 }
@@ -602,6 +603,63 @@ func (m *sqlExecutionManager) RangeCompleteVisibilityTask(
 	return nil
 }
 
+func (m *sqlExecutionManager) GetCustomTasks(
+	request *p.GetCustomTasksRequest,
+) (*p.GetCustomTasksResponse, error) {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	readLevel := request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if readLevel, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := m.db.RangeSelectFromCustomTasks(ctx, sqlplugin.CustomTasksRangeFilter{
+		ShardID:   m.shardID,
+		Category:  request.Category,
+		MinTaskID: readLevel,
+		MaxTaskID: request.MaxReadLevel,
+		PageSize:  request.BatchSize,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("GetCustomTasks operation failed. Select failed. Error: %v", err))
+	}
+
+	resp := &p.GetCustomTasksResponse{Tasks: make([]*serialization.CustomTaskInfo, len(rows))}
+	for i, row := range rows {
+		info, err := serialization.CustomTaskInfoFromBlob(row.Data, row.DataEncoding)
+		if err != nil {
+			return nil, err
+		}
+		resp.Tasks[i] = info
+	}
+	if len(rows) != 0 && len(rows) == request.BatchSize {
+		if lastTaskID := rows[len(rows)-1].TaskID; lastTaskID < request.MaxReadLevel {
+			resp.NextPageToken = serializePageToken(lastTaskID)
+		}
+	}
+
+	return resp, nil
+}
+
+func (m *sqlExecutionManager) RangeCompleteCustomTask(
+	request *p.RangeCompleteCustomTaskRequest,
+) error {
+	ctx, cancel := newExecutionContext()
+	defer cancel()
+	if _, err := m.db.RangeDeleteFromCustomTasks(ctx, sqlplugin.CustomTasksRangeFilter{
+		ShardID:   m.shardID,
+		Category:  request.Category,
+		MinTaskID: request.ExclusiveBeginTaskID,
+		MaxTaskID: request.InclusiveEndTaskID,
+	}); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("RangeCompleteCustomTask operation failed. Error: %v", err))
+	}
+	return nil
+}
+
 type timerTaskPageToken struct {
 	TaskID    int64
 	Timestamp time.Time
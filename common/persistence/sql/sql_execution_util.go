@@ -102,6 +102,7 @@ func applyWorkflowMutationTx(
 		workflowMutation.TimerTasks,
 		workflowMutation.ReplicationTasks,
 		workflowMutation.VisibilityTasks,
+		workflowMutation.CustomTasks,
 	); err != nil {
 		return err
 	}
@@ -260,6 +261,7 @@ func applyWorkflowSnapshotTxAsReset(
 		workflowSnapshot.TimerTasks,
 		workflowSnapshot.ReplicationTasks,
 		workflowSnapshot.VisibilityTasks,
+		workflowSnapshot.CustomTasks,
 	); err != nil {
 		return err
 	}
@@ -448,6 +450,7 @@ func (m *sqlExecutionManager) applyWorkflowSnapshotTxAsNew(
 		workflowSnapshot.TimerTasks,
 		workflowSnapshot.ReplicationTasks,
 		workflowSnapshot.VisibilityTasks,
+		workflowSnapshot.CustomTasks,
 	); err != nil {
 		return err
 	}
@@ -538,6 +541,7 @@ func applyTasks(
 	timerTasks []p.Task,
 	replicationTasks []p.Task,
 	visibilityTasks []p.Task,
+	customTasks []p.Task,
 ) error {
 
 	if err := createTransferTasks(ctx,
@@ -580,6 +584,16 @@ func applyTasks(
 		return serviceerror.NewInternal(fmt.Sprintf("applyTasks failed. Failed to create timer tasks. Error: %v", err))
 	}
 
+	if err := createCustomTasks(ctx,
+		tx,
+		customTasks,
+		shardID,
+		namespaceID,
+		workflowID,
+		runID); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("applyTasks failed. Failed to create custom tasks. Error: %v", err))
+	}
+
 	return nil
 }
 
@@ -960,6 +974,9 @@ func createTimerTasks(
 		case *p.DeleteHistoryEventTask:
 			// noop
 
+		case *p.StuckExecutionCheckTask:
+			// noop
+
 		default:
 			return serviceerror.NewInternal(fmt.Sprintf("createTimerTasks failed. Unknown timer task: %v", task.GetType()))
 		}
@@ -1344,3 +1361,61 @@ func updateExecution(
 
 	return nil
 }
+
+func createCustomTasks(
+	ctx context.Context,
+	tx sqlplugin.Tx,
+	customTasks []p.Task,
+	shardID int32,
+	namespaceID string,
+	workflowID string,
+	runID string,
+) error {
+
+	if len(customTasks) == 0 {
+		return nil
+	}
+
+	customTasksRows := make([]sqlplugin.CustomTasksRow, len(customTasks))
+	for i, task := range customTasks {
+		customTask, ok := task.(*p.CustomTask)
+		if !ok || customTask.Category == "" {
+			return serviceerror.NewInternal(fmt.Sprintf("createCustomTasks failed. Invalid custom task: %v", task))
+		}
+
+		blob, err := serialization.CustomTaskInfoToBlob(&serialization.CustomTaskInfo{
+			NamespaceId:    namespaceID,
+			WorkflowId:     workflowID,
+			RunId:          runID,
+			Category:       customTask.Category,
+			Version:        customTask.Version,
+			TaskId:         customTask.TaskID,
+			VisibilityTime: timestamp.TimePtr(customTask.VisibilityTimestamp.UTC()),
+			Data:           customTask.Data,
+		})
+		if err != nil {
+			return err
+		}
+
+		customTasksRows[i].ShardID = shardID
+		customTasksRows[i].Category = customTask.Category
+		customTasksRows[i].TaskID = customTask.TaskID
+		customTasksRows[i].Data = blob.Data
+		customTasksRows[i].DataEncoding = blob.EncodingType.String()
+	}
+
+	result, err := tx.InsertIntoCustomTasks(ctx, customTasksRows)
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("createCustomTasks failed. Error: %v", err))
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("createCustomTasks failed. Could not verify number of rows inserted. Error: %v", err))
+	}
+
+	if int(rowsAffected) != len(customTasks) {
+		return serviceerror.NewInternal(fmt.Sprintf("createCustomTasks failed. Inserted %v instead of %v rows into custom_tasks.", rowsAffected, len(customTasks)))
+	}
+	return nil
+}
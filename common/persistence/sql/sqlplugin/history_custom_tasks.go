@@ -0,0 +1,61 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+)
+
+type (
+	// CustomTasksRow represents a row in custom_tasks table
+	CustomTasksRow struct {
+		ShardID      int32
+		Category     string
+		TaskID       int64
+		Data         []byte
+		DataEncoding string
+	}
+
+	// CustomTasksRangeFilter contains the column names within custom_tasks table that
+	// can be used to filter results through a WHERE clause
+	CustomTasksRangeFilter struct {
+		ShardID   int32
+		Category  string
+		MinTaskID int64
+		MaxTaskID int64
+		PageSize  int
+	}
+
+	// HistoryCustomTask is the SQL persistence interface for tasks of custom task categories
+	HistoryCustomTask interface {
+		// InsertIntoCustomTasks inserts rows into custom_tasks table.
+		InsertIntoCustomTasks(ctx context.Context, rows []CustomTasksRow) (sql.Result, error)
+		// RangeSelectFromCustomTasks returns rows that match filter criteria from custom_tasks table.
+		RangeSelectFromCustomTasks(ctx context.Context, filter CustomTasksRangeFilter) ([]CustomTasksRow, error)
+		// RangeDeleteFromCustomTasks deletes one or more rows from custom_tasks table.
+		RangeDeleteFromCustomTasks(ctx context.Context, filter CustomTasksRangeFilter) (sql.Result, error)
+	}
+)
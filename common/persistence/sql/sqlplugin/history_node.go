@@ -41,6 +41,7 @@ type (
 		TxnID        int64
 		Data         []byte
 		DataEncoding string
+		DataChecksum []byte
 	}
 
 	// HistoryNodeSelectFilter contains the column names within history_node table that
@@ -48,6 +48,15 @@ type (
 		TreeID  primitives.UUID
 	}
 
+	// HistoryTreeBranchPage is a page of history tree branches, ordered by shard, tree and branch.
+	// The page starts after the given branch.
+	HistoryTreeBranchPage struct {
+		ShardID  int32
+		TreeID   primitives.UUID
+		BranchID primitives.UUID
+		Limit    int
+	}
+
 	// HistoryTreeDeleteFilter contains the column names within history_tree table that
 	// can be used to filter results through a WHERE clause
 	HistoryTreeDeleteFilter struct {
@@ -60,6 +69,7 @@ type (
 	HistoryTree interface {
 		InsertIntoHistoryTree(ctx context.Context, row *HistoryTreeRow) (sql.Result, error)
 		SelectFromHistoryTree(ctx context.Context, filter HistoryTreeSelectFilter) ([]HistoryTreeRow, error)
+		PaginateBranchesFromHistoryTree(ctx context.Context, page HistoryTreeBranchPage) ([]HistoryTreeRow, error)
 		DeleteFromHistoryTree(ctx context.Context, filter HistoryTreeDeleteFilter) (sql.Result, error)
 	}
 )
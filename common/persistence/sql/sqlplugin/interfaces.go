@@ -28,6 +28,7 @@ import (
 	"context"
 	"database/sql"
 
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/service/config"
 )
@@ -35,7 +36,7 @@ import (
 type (
 	// Plugin defines the interface for any SQL database that needs to implement
 	Plugin interface {
-		CreateDB(cfg *config.SQL, r resolver.ServiceResolver) (DB, error)
+		CreateDB(cfg *config.SQL, r resolver.ServiceResolver, metricsClient metrics.Client) (DB, error)
 		CreateAdminDB(cfg *config.SQL, r resolver.ServiceResolver) (AdminDB, error)
 	}
 
@@ -69,6 +70,7 @@ type (
 		HistoryReplicationTask
 		HistoryReplicationDLQTask
 		HistoryVisibilityTask
+		HistoryCustomTask
 	}
 
 	// AdminCRUD defines admin operations for CLI and test suites
@@ -123,6 +123,19 @@ func (s *StoreTestSuite) TestBuildDSN() {
 			outIsolationVal: "'repeatable-read'",
 			outURLPath:      "test:pass@tcp(192.168.0.1:3306)/db1?",
 		},
+		{
+			in: config.SQL{
+				User:              "test",
+				Password:          "pass",
+				ConnectProtocol:   "tcp",
+				ConnectAddr:       "192.168.0.1:3306",
+				DatabaseName:      "db1",
+				InterpolateParams: true,
+			},
+			outIsolationKey: "transaction_isolation",
+			outIsolationVal: "'READ-COMMITTED'",
+			outURLPath:      "test:pass@tcp(192.168.0.1:3306)/db1?",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -136,6 +149,9 @@ func (s *StoreTestSuite) TestBuildDSN() {
 		qry, err := url.Parse("?" + tokens[1])
 		s.NoError(err)
 		wantAttrs := buildExpectedURLParams(tc.in.ConnectAttributes, tc.outIsolationKey, tc.outIsolationVal)
+		if tc.in.InterpolateParams {
+			wantAttrs[interpolateParamsAttrName] = []string{"true"}
+		}
 		s.Equal(wantAttrs, qry.Query(), "invalid dsn url params")
 	}
 }
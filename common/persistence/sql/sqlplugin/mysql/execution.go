@@ -142,6 +142,14 @@ ORDER BY task_id LIMIT ?`
 	deleteVisibilityTaskQuery      = `DELETE FROM visibility_tasks WHERE shard_id = ? AND task_id = ?`
 	rangeDeleteVisibilityTaskQuery = `DELETE FROM visibility_tasks WHERE shard_id = ? AND task_id > ? AND task_id <= ?`
 
+	createCustomTasksQuery = `INSERT INTO custom_tasks(shard_id, category, task_id, data, data_encoding) 
+ VALUES(:shard_id, :category, :task_id, :data, :data_encoding)`
+
+	getCustomTasksQuery = `SELECT task_id, data, data_encoding 
+ FROM custom_tasks WHERE shard_id = ? AND category = ? AND task_id > ? AND task_id <= ? ORDER BY task_id LIMIT ?`
+
+	rangeDeleteCustomTaskQuery = `DELETE FROM custom_tasks WHERE shard_id = ? AND category = ? AND task_id > ? AND task_id <= ?`
+
 	bufferedEventsColumns     = `shard_id, namespace_id, workflow_id, run_id, data, data_encoding`
 	createBufferedEventsQuery = `INSERT INTO buffered_events(` + bufferedEventsColumns + `)
 VALUES (:shard_id, :namespace_id, :workflow_id, :run_id, :data, :data_encoding)`
@@ -775,3 +783,52 @@ func (mdb *db) RangeDeleteFromVisibilityTasks(
 		filter.MaxTaskID,
 	)
 }
+
+// InsertIntoCustomTasks inserts one or more rows into custom_tasks table
+func (mdb *db) InsertIntoCustomTasks(
+	ctx context.Context,
+	rows []sqlplugin.CustomTasksRow,
+) (sql.Result, error) {
+	return mdb.conn.NamedExecContext(ctx,
+		createCustomTasksQuery,
+		rows,
+	)
+}
+
+// RangeSelectFromCustomTasks reads one or more rows from custom_tasks table
+func (mdb *db) RangeSelectFromCustomTasks(
+	ctx context.Context,
+	filter sqlplugin.CustomTasksRangeFilter,
+) ([]sqlplugin.CustomTasksRow, error) {
+	var rows []sqlplugin.CustomTasksRow
+	if err := mdb.conn.SelectContext(ctx,
+		&rows,
+		getCustomTasksQuery,
+		filter.ShardID,
+		filter.Category,
+		filter.MinTaskID,
+		filter.MaxTaskID,
+		filter.PageSize,
+	); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].ShardID = filter.ShardID
+		rows[i].Category = filter.Category
+	}
+	return rows, nil
+}
+
+// RangeDeleteFromCustomTasks deletes one or more rows from custom_tasks table
+func (mdb *db) RangeDeleteFromCustomTasks(
+	ctx context.Context,
+	filter sqlplugin.CustomTasksRangeFilter,
+) (sql.Result, error) {
+	return mdb.conn.ExecContext(ctx,
+		rangeDeleteCustomTaskQuery,
+		filter.ShardID,
+		filter.Category,
+		filter.MinTaskID,
+		filter.MaxTaskID,
+	)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/iancoleman/strcase"
 	"github.com/jmoiron/sqlx"
 
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/resolver"
@@ -48,6 +49,8 @@ const (
 	defaultIsolationLevel        = "'READ-COMMITTED'"
 	// customTLSName is the name used if a custom tls configuration is created
 	customTLSName = "tls-custom"
+	// interpolateParamsAttrName interpolates the query parameters client side instead of preparing statements
+	interpolateParamsAttrName = "interpolateParams"
 )
 
 var dsnAttrOverrides = map[string]string{
@@ -65,12 +68,12 @@ func init() {
 }
 
 // CreateDB initialize the db object
-func (p *plugin) CreateDB(cfg *config.SQL, r resolver.ServiceResolver) (sqlplugin.DB, error) {
+func (p *plugin) CreateDB(cfg *config.SQL, r resolver.ServiceResolver, metricsClient metrics.Client) (sqlplugin.DB, error) {
 	conn, err := p.createDBConnection(cfg, r)
 	if err != nil {
 		return nil, err
 	}
-	db := newDB(conn, nil)
+	db := newDB(conn, nil, sqlplugin.NewStatements(cfg, metricsClient))
 	return db, nil
 }
 
@@ -80,7 +83,7 @@ func (p *plugin) CreateAdminDB(cfg *config.SQL, r resolver.ServiceResolver) (sql
 	if err != nil {
 		return nil, err
 	}
-	db := newDB(conn, nil)
+	db := newDB(conn, nil, nil)
 	return db, nil
 }
 
@@ -140,6 +143,9 @@ func buildDSNAttrs(cfg *config.SQL) string {
 	for k, v := range dsnAttrOverrides {
 		attrs[k] = v
 	}
+	if cfg.InterpolateParams {
+		attrs[interpolateParamsAttrName] = "true"
+	}
 
 	first := true
 	var buf bytes.Buffer
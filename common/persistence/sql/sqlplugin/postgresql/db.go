@@ -35,10 +35,11 @@ import (
 
 // db represents a logical connection to mysql database
 type db struct {
-	db        *sqlx.DB
-	tx        *sqlx.Tx
-	conn      sqlplugin.Conn
-	converter DataConverter
+	db         *sqlx.DB
+	tx         *sqlx.Tx
+	conn       sqlplugin.Conn
+	statements *sqlplugin.Statements
+	converter  DataConverter
 }
 
 var _ sqlplugin.DB = (*db)(nil)
@@ -55,12 +56,15 @@ func (pdb *db) IsDupEntryError(err error) bool {
 
 // newDB returns an instance of DB, which is a logical
 // connection to the underlying postgresql database
-func newDB(xdb *sqlx.DB, tx *sqlx.Tx) *db {
-	mdb := &db{db: xdb, tx: tx}
+func newDB(xdb *sqlx.DB, tx *sqlx.Tx, statements *sqlplugin.Statements) *db {
+	mdb := &db{db: xdb, tx: tx, statements: statements}
 	mdb.conn = xdb
 	if tx != nil {
 		mdb.conn = tx
 	}
+	if statements != nil {
+		mdb.conn = statements.Conn(xdb, tx)
+	}
 	mdb.converter = &converter{}
 	return mdb
 }
@@ -71,7 +75,7 @@ func (pdb *db) BeginTx(ctx context.Context) (sqlplugin.Tx, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newDB(pdb.db, xtx), nil
+	return newDB(pdb.db, xtx, pdb.statements), nil
 }
 
 // Commit commits a previously started transaction
@@ -142,6 +142,14 @@ ORDER BY task_id LIMIT $5`
 	deleteVisibilityTaskQuery      = `DELETE FROM visibility_tasks WHERE shard_id = $1 AND task_id = $2`
 	rangeDeleteVisibilityTaskQuery = `DELETE FROM visibility_tasks WHERE shard_id = $1 AND task_id > $2 AND task_id <= $3`
 
+	createCustomTasksQuery = `INSERT INTO custom_tasks(shard_id, category, task_id, data, data_encoding) 
+ VALUES(:shard_id, :category, :task_id, :data, :data_encoding)`
+
+	getCustomTasksQuery = `SELECT task_id, data, data_encoding 
+ FROM custom_tasks WHERE shard_id = $1 AND category = $2 AND task_id > $3 AND task_id <= $4 ORDER BY task_id LIMIT $5`
+
+	rangeDeleteCustomTaskQuery = `DELETE FROM custom_tasks WHERE shard_id = $1 AND category = $2 AND task_id > $3 AND task_id <= $4`
+
 	bufferedEventsColumns     = `shard_id, namespace_id, workflow_id, run_id, data, data_encoding`
 	createBufferedEventsQuery = `INSERT INTO buffered_events(` + bufferedEventsColumns + `)
 VALUES (:shard_id, :namespace_id, :workflow_id, :run_id, :data, :data_encoding)`
@@ -778,3 +786,52 @@ func (pdb *db) RangeDeleteFromVisibilityTasks(
 		filter.MaxTaskID,
 	)
 }
+
+// InsertIntoCustomTasks inserts one or more rows into custom_tasks table
+func (pdb *db) InsertIntoCustomTasks(
+	ctx context.Context,
+	rows []sqlplugin.CustomTasksRow,
+) (sql.Result, error) {
+	return pdb.conn.NamedExecContext(ctx,
+		createCustomTasksQuery,
+		rows,
+	)
+}
+
+// RangeSelectFromCustomTasks reads one or more rows from custom_tasks table
+func (pdb *db) RangeSelectFromCustomTasks(
+	ctx context.Context,
+	filter sqlplugin.CustomTasksRangeFilter,
+) ([]sqlplugin.CustomTasksRow, error) {
+	var rows []sqlplugin.CustomTasksRow
+	if err := pdb.conn.SelectContext(ctx,
+		&rows,
+		getCustomTasksQuery,
+		filter.ShardID,
+		filter.Category,
+		filter.MinTaskID,
+		filter.MaxTaskID,
+		filter.PageSize,
+	); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].ShardID = filter.ShardID
+		rows[i].Category = filter.Category
+	}
+	return rows, nil
+}
+
+// RangeDeleteFromCustomTasks deletes one or more rows from custom_tasks table
+func (pdb *db) RangeDeleteFromCustomTasks(
+	ctx context.Context,
+	filter sqlplugin.CustomTasksRangeFilter,
+) (sql.Result, error) {
+	return pdb.conn.ExecContext(ctx,
+		rangeDeleteCustomTaskQuery,
+		filter.ShardID,
+		filter.Category,
+		filter.MinTaskID,
+		filter.MaxTaskID,
+	)
+}
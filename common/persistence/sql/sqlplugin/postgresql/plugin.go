@@ -31,6 +31,7 @@ import (
 	"github.com/iancoleman/strcase"
 	"github.com/jmoiron/sqlx"
 
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/resolver"
@@ -54,12 +55,12 @@ func init() {
 }
 
 // CreateDB initialize the db object
-func (d *plugin) CreateDB(cfg *config.SQL, r resolver.ServiceResolver) (sqlplugin.DB, error) {
+func (d *plugin) CreateDB(cfg *config.SQL, r resolver.ServiceResolver, metricsClient metrics.Client) (sqlplugin.DB, error) {
 	conn, err := d.createDBConnection(cfg, r)
 	if err != nil {
 		return nil, err
 	}
-	db := newDB(conn, nil)
+	db := newDB(conn, nil, sqlplugin.NewStatements(cfg, metricsClient))
 	return db, nil
 }
 
@@ -69,7 +70,7 @@ func (d *plugin) CreateAdminDB(cfg *config.SQL, r resolver.ServiceResolver) (sql
 	if err != nil {
 		return nil, err
 	}
-	db := newDB(conn, nil)
+	db := newDB(conn, nil, nil)
 	return db, nil
 }
 
@@ -78,6 +79,12 @@ func (d *plugin) CreateAdminDB(cfg *config.SQL, r resolver.ServiceResolver) (sql
 // SQL database and the object can be used to perform CRUD operations on
 // the tables in the database
 func (d *plugin) createDBConnection(cfg *config.SQL, r resolver.ServiceResolver) (*sqlx.DB, error) {
+	if cfg.InterpolateParams {
+		if err := validateTransactionPoolingAttrs(cfg.ConnectAttributes); err != nil {
+			return nil, err
+		}
+	}
+
 	db, err := sqlx.Connect(PluginName, buildDSN(cfg, r))
 	if err != nil {
 		return nil, err
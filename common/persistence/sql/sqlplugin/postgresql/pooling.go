@@ -0,0 +1,57 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package postgresql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// transactionPoolingAttrs are the connect attributes allowed with interpolateParams. They are either consumed
+// by the driver or are run-time parameters which poolers track and restore on every server connection, any other
+// attribute would be sent as a session level parameter only applied to whichever server connection is picked first.
+// NOTE: lib/pq always sends extra_float_digits, PgBouncer needs it listed in ignore_startup_parameters.
+var transactionPoolingAttrs = map[string]struct{}{
+	"connect_timeout":                {},
+	"fallback_application_name":      {},
+	"disable_prepared_binary_result": {},
+	"krbsrvname":                     {},
+	"krbspn":                         {},
+	"application_name":               {},
+	"client_encoding":                {},
+	"datestyle":                      {},
+	"timezone":                       {},
+	"standard_conforming_strings":    {},
+}
+
+func validateTransactionPoolingAttrs(attrs map[string]string) error {
+	for k := range attrs {
+		key := strings.ToLower(strings.TrimSpace(k))
+		if _, ok := transactionPoolingAttrs[key]; !ok {
+			return fmt.Errorf("connection attr %v is a session level parameter which is not supported with interpolateParams", key)
+		}
+	}
+	return nil
+}
@@ -43,6 +43,9 @@ const (
 	postgreSQLCA   = "sslrootcert"
 	postgreSQLKey  = "sslkey"
 	postgreSQLCert = "sslcert"
+
+	// postgreSQLBinaryParameters sends the parameters along with the unnamed statement in a single round trip
+	postgreSQLBinaryParameters = "binary_parameters"
 )
 
 func buildDSNAttr(cfg *config.SQL) url.Values {
@@ -66,6 +69,10 @@ func buildDSNAttr(cfg *config.SQL) url.Values {
 		parameters.Set(postgreSQLSSLMode, postgreSQLSSLModeNoop)
 	}
 
+	if cfg.InterpolateParams {
+		parameters.Set(postgreSQLBinaryParameters, "yes")
+	}
+
 	for k, v := range cfg.ConnectAttributes {
 		key := strings.TrimSpace(k)
 		value := strings.TrimSpace(v)
@@ -0,0 +1,255 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/service/config"
+)
+
+// statementCallerDepth is the number of frames searched for the caller of a statement
+const statementCallerDepth = 4
+
+var closureSuffix = regexp.MustCompile(`(\.func\d+)+(\.\d+)*$`)
+
+type (
+	// Statements executes the statements of a database connection pool and of its transactions,
+	// it emits metrics of each statement tagged by the plugin method executing it and caches
+	// prepared statements if configured
+	Statements struct {
+		preparedStatements cache.Cache
+		metricsClient      metrics.Client
+
+		operations sync.Map // [statementCallerDepth]uintptr -> operation name
+	}
+
+	statementConn struct {
+		statements *Statements
+		db         *sqlx.DB
+		tx         *sqlx.Tx
+		conn       Conn
+	}
+
+	statementKey struct {
+		query string
+		named bool
+	}
+)
+
+var _ Conn = (*statementConn)(nil)
+
+// NewStatements creates the statements of a database connection pool, metrics client is optional
+func NewStatements(cfg *config.SQL, metricsClient metrics.Client) *Statements {
+	s := &Statements{metricsClient: metricsClient}
+	if cfg.PreparedStatementCacheSize > 0 && !cfg.InterpolateParams {
+		// the cache evicts once it reaches its max size
+		s.preparedStatements = cache.New(cfg.PreparedStatementCacheSize+1, &cache.Options{
+			Pin: true,
+			RemovedFunc: func(value interface{}) {
+				_ = value.(io.Closer).Close()
+			},
+		})
+	}
+	return s
+}
+
+// Conn returns the connection executing statements in tx, or in db if tx is nil
+func (s *Statements) Conn(db *sqlx.DB, tx *sqlx.Tx) Conn {
+	c := &statementConn{statements: s, db: db, tx: tx, conn: db}
+	if tx != nil {
+		c.conn = tx
+	}
+	return c
+}
+
+func (c *statementConn) Rebind(query string) string {
+	return c.conn.Rebind(query)
+}
+
+func (c *statementConn) ExecContext(ctx context.Context, query string, args ...interface{}) (result sql.Result, err error) {
+	defer c.statements.observe(c.statements.callerOperation(), time.Now(), &err)
+
+	if stmt, release := c.preparedStatement(ctx, query); stmt != nil {
+		defer release()
+		return stmt.ExecContext(ctx, args...)
+	}
+	return c.conn.ExecContext(ctx, query, args...)
+}
+
+func (c *statementConn) NamedExecContext(ctx context.Context, query string, arg interface{}) (result sql.Result, err error) {
+	defer c.statements.observe(c.statements.callerOperation(), time.Now(), &err)
+
+	if stmt, release := c.preparedNamedStatement(ctx, query); stmt != nil {
+		defer release()
+		return stmt.ExecContext(ctx, arg)
+	}
+	return c.conn.NamedExecContext(ctx, query, arg)
+}
+
+func (c *statementConn) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) (err error) {
+	defer c.statements.observe(c.statements.callerOperation(), time.Now(), &err)
+
+	if stmt, release := c.preparedStatement(ctx, query); stmt != nil {
+		defer release()
+		return stmt.GetContext(ctx, dest, args...)
+	}
+	return c.conn.GetContext(ctx, dest, query, args...)
+}
+
+func (c *statementConn) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) (err error) {
+	defer c.statements.observe(c.statements.callerOperation(), time.Now(), &err)
+
+	if stmt, release := c.preparedStatement(ctx, query); stmt != nil {
+		defer release()
+		return stmt.SelectContext(ctx, dest, args...)
+	}
+	return c.conn.SelectContext(ctx, dest, query, args...)
+}
+
+// preparedStatement returns the cached prepared statement of the query and the function releasing it,
+// nil if statements are not prepared or the statement could not be prepared, the statement is then
+// executed without being prepared ahead. Transactions only use statements prepared outside of them.
+func (c *statementConn) preparedStatement(ctx context.Context, query string) (*sqlx.Stmt, func()) {
+	value, release := c.getOrPrepare(statementKey{query: query}, func() (io.Closer, error) {
+		return c.db.PreparexContext(ctx, query)
+	})
+	if value == nil {
+		return nil, nil
+	}
+
+	stmt := value.(*sqlx.Stmt)
+	if c.tx != nil {
+		stmt = c.tx.StmtxContext(ctx, stmt)
+	}
+	return stmt, release
+}
+
+func (c *statementConn) preparedNamedStatement(ctx context.Context, query string) (*sqlx.NamedStmt, func()) {
+	value, release := c.getOrPrepare(statementKey{query: query, named: true}, func() (io.Closer, error) {
+		return c.db.PrepareNamedContext(ctx, query)
+	})
+	if value == nil {
+		return nil, nil
+	}
+
+	stmt := value.(*sqlx.NamedStmt)
+	if c.tx != nil {
+		stmt = c.tx.NamedStmtContext(ctx, stmt)
+	}
+	return stmt, release
+}
+
+func (c *statementConn) getOrPrepare(key statementKey, prepare func() (io.Closer, error)) (interface{}, func()) {
+	preparedStatements := c.statements.preparedStatements
+	if preparedStatements == nil {
+		return nil, nil
+	}
+
+	value := preparedStatements.Get(key)
+	if value == nil && c.tx != nil {
+		// preparing takes another connection of the pool while the transaction holds one,
+		// which could block once the pool is exhausted by transactions
+		return nil, nil
+	}
+	if value == nil {
+		stmt, err := prepare()
+		if err != nil {
+			// the statement fails again when executed, which reports the error
+			return nil, nil
+		}
+		value, err = preparedStatements.PutIfNotExist(key, stmt)
+		if err != nil {
+			// cache is full of statements in use
+			_ = stmt.Close()
+			return nil, nil
+		}
+		if value != stmt {
+			// prepared concurrently
+			_ = stmt.Close()
+		}
+	}
+	return value, func() { preparedStatements.Release(key) }
+}
+
+func (s *Statements) observe(operation string, startTime time.Time, err *error) {
+	if s.metricsClient == nil {
+		return
+	}
+
+	scope := s.metricsClient.Scope(metrics.PersistenceSQLStatementScope, metrics.SQLOperationTag(operation))
+	scope.IncCounter(metrics.PersistenceSQLStatementRequests)
+	scope.RecordTimer(metrics.PersistenceSQLStatementLatency, time.Since(startTime))
+	if *err != nil && *err != sql.ErrNoRows {
+		scope.IncCounter(metrics.PersistenceSQLStatementFailures)
+	}
+}
+
+// callerOperation returns the name of the plugin method executing the statement, e.g. InsertIntoExecutions
+func (s *Statements) callerOperation() string {
+	if s.metricsClient == nil {
+		return ""
+	}
+
+	var pcs [statementCallerDepth]uintptr
+	// skip runtime.Callers and callerOperation
+	runtime.Callers(2, pcs[:])
+	if operation, ok := s.operations.Load(pcs); ok {
+		return operation.(string)
+	}
+
+	operation := ""
+	frames := runtime.CallersFrames(pcs[:])
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" && !strings.Contains(frame.Function, "sqlplugin.(*statementConn)") {
+			operation = operationName(frame.Function)
+			break
+		}
+		if !more {
+			break
+		}
+	}
+	s.operations.Store(pcs, operation)
+	return operation
+}
+
+// operationName returns the method or function name of the fully qualified function name,
+// closures are named by the function defining them
+func operationName(function string) string {
+	function = closureSuffix.ReplaceAllString(function, "")
+	return function[strings.LastIndex(function, ".")+1:]
+}
@@ -0,0 +1,243 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	statementsSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		driver *countingDriver
+		db     *sqlx.DB
+		scope  tally.TestScope
+	}
+
+	// countingDriver counts the statements prepared and closed, statements of query "fail" fail
+	countingDriver struct {
+		sync.Mutex
+		prepared map[string]int
+		closed   map[string]int
+	}
+
+	countingConn struct {
+		driver *countingDriver
+	}
+
+	countingStmt struct {
+		driver *countingDriver
+		query  string
+	}
+
+	countingTx struct{}
+
+	emptyRows struct{}
+)
+
+var errStatementFailed = errors.New("statement failed")
+
+func TestStatementsSuite(t *testing.T) {
+	suite.Run(t, new(statementsSuite))
+}
+
+func (s *statementsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.driver = &countingDriver{prepared: make(map[string]int), closed: make(map[string]int)}
+	s.db = sqlx.NewDb(sql.OpenDB(s.driver), "mysql")
+	s.db.SetMaxOpenConns(1)
+	s.scope = tally.NewTestScope("test", nil)
+}
+
+func (s *statementsSuite) TearDownTest() {
+	s.NoError(s.db.Close())
+}
+
+func (s *statementsSuite) TestPreparedStatementCache() {
+	statements := NewStatements(&config.SQL{PreparedStatementCacheSize: 1}, nil)
+	conn := statements.Conn(s.db, nil)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := conn.ExecContext(ctx, "query1", i)
+		s.NoError(err)
+	}
+	s.Equal(1, s.driver.preparedCount("query1"))
+
+	var ids []int64
+	s.NoError(conn.SelectContext(ctx, &ids, "query2"))
+	s.Equal(1, s.driver.preparedCount("query2"))
+	// least recently used statement is closed
+	s.Eventually(func() bool { return s.driver.closedCount("query1") == 1 }, time.Second, 10*time.Millisecond)
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	s.NoError(err)
+	txConn := statements.Conn(s.db, tx)
+	_, err = txConn.NamedExecContext(ctx, "query3 :id", map[string]interface{}{"id": 1})
+	s.NoError(err)
+	s.NoError(txConn.SelectContext(ctx, &ids, "query2"))
+	s.NoError(tx.Commit())
+	s.Equal(1, s.driver.preparedCount("query2"))
+	// statements are not prepared in transactions
+	s.Nil(statements.preparedStatements.Get(statementKey{query: "query3 :id", named: true}))
+}
+
+func (s *statementsSuite) TestInterpolateParams() {
+	statements := NewStatements(&config.SQL{PreparedStatementCacheSize: 10, InterpolateParams: true}, nil)
+	s.Nil(statements.preparedStatements)
+
+	statements = NewStatements(&config.SQL{}, nil)
+	s.Nil(statements.preparedStatements)
+	conn := statements.Conn(s.db, nil)
+	for i := 0; i < 2; i++ {
+		_, err := conn.ExecContext(context.Background(), "query1", i)
+		s.NoError(err)
+	}
+	// statements are prepared by database/sql on each execution
+	s.Equal(2, s.driver.preparedCount("query1"))
+}
+
+func (s *statementsSuite) TestMetrics() {
+	statements := NewStatements(&config.SQL{}, metrics.NewClient(s.scope, metrics.Common))
+	conn := statements.Conn(s.db, nil)
+	ctx := context.Background()
+
+	_, err := conn.ExecContext(ctx, "query1")
+	s.NoError(err)
+	var id int64
+	s.Equal(sql.ErrNoRows, conn.GetContext(ctx, &id, "query2"))
+	_, err = conn.ExecContext(ctx, "fail")
+	s.Equal(errStatementFailed, err)
+
+	snapshot := s.scope.Snapshot()
+	tags := "namespace=all,operation=SQLStatement,sql_operation=TestMetrics"
+	s.EqualValues(3, snapshot.Counters()["test.persistence_sql_statement_requests+"+tags].Value())
+	s.EqualValues(1, snapshot.Counters()["test.persistence_sql_statement_errors+"+tags].Value())
+	s.Len(snapshot.Timers()["test.persistence_sql_statement_latency+"+tags].Values(), 3)
+}
+
+func (s *statementsSuite) TestOperationName() {
+	s.Equal("InsertIntoExecutions", operationName("go.temporal.io/server/common/persistence/sql/sqlplugin/mysql.(*db).InsertIntoExecutions"))
+	s.Equal("ReplaceIntoActivityInfoMaps", operationName("go.temporal.io/server/common/persistence/sql/sqlplugin/mysql.(*db).ReplaceIntoActivityInfoMaps.func1"))
+	s.Equal("lockShard", operationName("go.temporal.io/server/common/persistence/sql.lockShard.func2.1"))
+}
+
+func (d *countingDriver) Open(string) (driver.Conn, error) {
+	return &countingConn{driver: d}, nil
+}
+
+func (d *countingDriver) Connect(context.Context) (driver.Conn, error) {
+	return &countingConn{driver: d}, nil
+}
+
+func (d *countingDriver) Driver() driver.Driver {
+	return d
+}
+
+func (d *countingDriver) preparedCount(query string) int {
+	d.Lock()
+	defer d.Unlock()
+	return d.prepared[query]
+}
+
+func (d *countingDriver) closedCount(query string) int {
+	d.Lock()
+	defer d.Unlock()
+	return d.closed[query]
+}
+
+func (c *countingConn) Prepare(query string) (driver.Stmt, error) {
+	c.driver.Lock()
+	defer c.driver.Unlock()
+	c.driver.prepared[query]++
+	return &countingStmt{driver: c.driver, query: query}, nil
+}
+
+func (c *countingConn) Close() error {
+	return nil
+}
+
+func (c *countingConn) Begin() (driver.Tx, error) {
+	return countingTx{}, nil
+}
+
+func (s *countingStmt) Close() error {
+	s.driver.Lock()
+	defer s.driver.Unlock()
+	s.driver.closed[s.query]++
+	return nil
+}
+
+func (s *countingStmt) NumInput() int {
+	return -1
+}
+
+func (s *countingStmt) Exec([]driver.Value) (driver.Result, error) {
+	if s.query == "fail" {
+		return nil, errStatementFailed
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *countingStmt) Query([]driver.Value) (driver.Rows, error) {
+	return emptyRows{}, nil
+}
+
+func (countingTx) Commit() error {
+	return nil
+}
+
+func (countingTx) Rollback() error {
+	return nil
+}
+
+func (emptyRows) Columns() []string {
+	return []string{"id"}
+}
+
+func (emptyRows) Close() error {
+	return nil
+}
+
+func (emptyRows) Next([]driver.Value) error {
+	return io.EOF
+}
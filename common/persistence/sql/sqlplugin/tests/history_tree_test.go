@@ -25,7 +25,9 @@
 package tests
 
 import (
+	"bytes"
 	"math/rand"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -203,6 +205,43 @@ func (s *historyTreeSuite) TestInsertDeleteSelect() {
 	s.Equal([]sqlplugin.HistoryTreeRow(nil), rows)
 }
 
+func (s *historyTreeSuite) TestInsertPaginate() {
+	shardID := rand.Int31()
+	treeID := primitives.NewUUID()
+
+	var trees []sqlplugin.HistoryTreeRow
+	for i := 0; i < 3; i++ {
+		tree := s.newRandomTreeRow(shardID, treeID, primitives.NewUUID())
+		result, err := s.store.InsertIntoHistoryTree(newExecutionContext(), &tree)
+		s.NoError(err)
+		rowsAffected, err := result.RowsAffected()
+		s.NoError(err)
+		s.Equal(1, int(rowsAffected))
+		trees = append(trees, tree)
+	}
+	sort.Slice(trees, func(i, j int) bool {
+		return bytes.Compare(trees[i].BranchID, trees[j].BranchID) < 0
+	})
+
+	rows, err := s.store.PaginateBranchesFromHistoryTree(newExecutionContext(), sqlplugin.HistoryTreeBranchPage{
+		ShardID:  shardID,
+		TreeID:   make(primitives.UUID, 16),
+		BranchID: make(primitives.UUID, 16),
+		Limit:    2,
+	})
+	s.NoError(err)
+	s.Equal(trees[:2], rows)
+
+	rows, err = s.store.PaginateBranchesFromHistoryTree(newExecutionContext(), sqlplugin.HistoryTreeBranchPage{
+		ShardID:  shardID,
+		TreeID:   treeID,
+		BranchID: rows[1].BranchID,
+		Limit:    1,
+	})
+	s.NoError(err)
+	s.Equal(trees[2:], rows)
+}
+
 func (s *historyTreeSuite) newRandomTreeRow(
 	shardID int32,
 	treeID primitives.UUID,
@@ -61,7 +61,7 @@ func TestMySQLNamespaceSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -78,7 +78,7 @@ func TestMySQLQueueMessageSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -95,7 +95,7 @@ func TestMySQLQueueMetadataSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -112,7 +112,7 @@ func TestMySQLMatchingTaskSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -129,7 +129,7 @@ func TestMySQLMatchingTaskQueueSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -146,7 +146,7 @@ func TestMySQLHistoryShardSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -163,7 +163,7 @@ func TestMySQLHistoryNodeSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -180,7 +180,7 @@ func TestMySQLHistoryTreeSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -197,7 +197,7 @@ func TestMySQLHistoryCurrentExecutionSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -214,7 +214,7 @@ func TestMySQLHistoryExecutionSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -231,7 +231,7 @@ func TestMySQLHistoryTransferTaskSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -248,7 +248,7 @@ func TestMySQLHistoryTimerTaskSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -265,7 +265,7 @@ func TestMySQLHistoryReplicationTaskSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -282,7 +282,7 @@ func TestMySQLHistoryReplicationDLQTaskSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -299,7 +299,7 @@ func TestMySQLHistoryExecutionBufferSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -316,7 +316,7 @@ func TestMySQLHistoryExecutionActivitySuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -333,7 +333,7 @@ func TestMySQLHistoryExecutionChildWorkflowSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -350,7 +350,7 @@ func TestMySQLHistoryExecutionTimerSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -367,7 +367,7 @@ func TestMySQLHistoryExecutionRequestCancelSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -384,7 +384,7 @@ func TestMySQLHistoryExecutionSignalSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -401,7 +401,7 @@ func TestMySQLHistoryExecutionSignalRequestSuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -418,7 +418,7 @@ func TestMySQLVisibilitySuite(t *testing.T) {
 	cfg := NewMySQLConfig()
 	SetupMySQLDatabase(cfg)
 	SetupMySQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
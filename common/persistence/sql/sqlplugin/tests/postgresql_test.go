@@ -61,7 +61,7 @@ func TestPostgreSQLNamespaceSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create PostgreSQL DB: %v", err)
 	}
@@ -78,7 +78,7 @@ func TestPostgreSQLQueueMessageSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create PostgreSQL DB: %v", err)
 	}
@@ -95,7 +95,7 @@ func TestPostgreSQLQueueMetadataSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create PostgreSQL DB: %v", err)
 	}
@@ -112,7 +112,7 @@ func TestPostgreSQLMatchingTaskSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create PostgreSQL DB: %v", err)
 	}
@@ -129,7 +129,7 @@ func TestPostgreSQLMatchingTaskQueueSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create PostgreSQL DB: %v", err)
 	}
@@ -146,7 +146,7 @@ func TestPostgreSQLHistoryShardSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -163,7 +163,7 @@ func TestPostgreSQLHistoryNodeSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -180,7 +180,7 @@ func TestPostgreSQLHistoryTreeSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -197,7 +197,7 @@ func TestPostgreSQLHistoryCurrentExecutionSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -214,7 +214,7 @@ func TestPostgreSQLHistoryExecutionSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -231,7 +231,7 @@ func TestPostgreSQLHistoryTransferTaskSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -248,7 +248,7 @@ func TestPostgreSQLHistoryTimerTaskSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -265,7 +265,7 @@ func TestPostgreSQLHistoryReplicationTaskSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -282,7 +282,7 @@ func TestPostgreSQLHistoryReplicationDLQTaskSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -299,7 +299,7 @@ func TestPostgreSQLHistoryExecutionBufferSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -316,7 +316,7 @@ func TestPostgreSQLHistoryExecutionActivitySuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -333,7 +333,7 @@ func TestPostgreSQLHistoryExecutionChildWorkflowSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -350,7 +350,7 @@ func TestPostgreSQLHistoryExecutionTimerSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -367,7 +367,7 @@ func TestPostgreSQLHistoryExecutionRequestCancelSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -384,7 +384,7 @@ func TestPostgreSQLHistoryExecutionSignalSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -401,7 +401,7 @@ func TestPostgreSQLHistoryExecutionSignalRequestSuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
@@ -418,7 +418,7 @@ func TestPostgreSQLVisibilitySuite(t *testing.T) {
 	cfg := NewPostgreSQLConfig()
 	SetupPostgreSQLDatabase(cfg)
 	SetupPostgreSQLSchema(cfg)
-	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver())
+	store, err := sql.NewSQLDB(cfg, resolver.NewNoopResolver(), nil)
 	if err != nil {
 		t.Fatalf("unable to create MySQL DB: %v", err)
 	}
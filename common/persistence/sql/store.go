@@ -27,6 +27,7 @@ package sql
 import (
 	"fmt"
 
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/service/config"
@@ -45,15 +46,15 @@ func RegisterPlugin(pluginName string, plugin sqlplugin.Plugin) {
 // NewSQLDB creates a returns a reference to a logical connection to the
 // underlying SQL database. The returned object is to tied to a single
 // SQL database and the object can be used to perform CRUD operations on
-// the tables in the database
-func NewSQLDB(cfg *config.SQL, r resolver.ServiceResolver) (sqlplugin.DB, error) {
+// the tables in the database. Metrics client is optional, statement metrics are emitted if set.
+func NewSQLDB(cfg *config.SQL, r resolver.ServiceResolver, metricsClient metrics.Client) (sqlplugin.DB, error) {
 	plugin, ok := supportedPlugins[cfg.PluginName]
 
 	if !ok {
 		return nil, fmt.Errorf("not supported plugin %v, only supported: %v", cfg.PluginName, supportedPlugins)
 	}
 
-	return plugin.CreateDB(cfg, r)
+	return plugin.CreateDB(cfg, r, metricsClient)
 }
 
 // NewSQLAdminDB returns a AdminDB
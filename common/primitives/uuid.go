@@ -27,11 +27,32 @@ package primitives
 import (
 	"database/sql/driver"
 	"encoding/hex"
+	"math/rand"
+	"sync"
 
 	guuid "github.com/google/uuid"
 	"github.com/pborman/uuid"
 )
 
+type (
+	// UUIDGenerator generates random UUIDs, e.g. run IDs
+	UUIDGenerator interface {
+		New() string
+	}
+
+	randomUUIDGenerator struct{}
+
+	seededUUIDGenerator struct {
+		reader *seededReader
+	}
+
+	// seededReader is a goroutine safe source of pseudo random bytes for uuid generation
+	seededReader struct {
+		sync.Mutex
+		rand *rand.Rand
+	}
+)
+
 // UUID represents a 16-byte universally unique identifier
 // this type is a wrapper around google/uuid with the following differences
 //  - type is a byte slice instead of [16]byte
@@ -167,3 +188,35 @@ func (u UUID) encodeHex(dst []byte) {
 	dst[23] = '-'
 	hex.Encode(dst[24:], u[10:])
 }
+
+// NewUUIDGenerator creates a generator of crypto random UUIDs
+func NewUUIDGenerator() UUIDGenerator {
+	return randomUUIDGenerator{}
+}
+
+// New returns a new random UUID
+func (randomUUIDGenerator) New() string {
+	return uuid.New()
+}
+
+// NewSeededUUIDGenerator creates a generator of a pseudo random UUID sequence derived from seed.
+// UUIDs generated in the same order are identical between runs, which allows golden file comparisons.
+// It must only be used in tests.
+func NewSeededUUIDGenerator(seed int64) UUIDGenerator {
+	return &seededUUIDGenerator{
+		reader: &seededReader{rand: rand.New(rand.NewSource(seed))},
+	}
+}
+
+// New returns the next UUID of the sequence
+func (g *seededUUIDGenerator) New() string {
+	// reading from math/rand never fails
+	return guuid.Must(guuid.NewRandomFromReader(g.reader)).String()
+}
+
+func (r *seededReader) Read(p []byte) (int, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.rand.Read(p)
+}
@@ -32,13 +32,16 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/elasticsearch"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/messaging"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
@@ -53,25 +56,31 @@ type (
 		Logger          log.Logger
 		ThrottledLogger log.Logger
 
-		MetricsScope                 tally.Scope
-		MembershipFactoryInitializer MembershipFactoryInitializerFunc
-		RPCFactory                   common.RPCFactory
-		AbstractDatastoreFactory     persistenceClient.AbstractDataStoreFactory
-		PersistenceConfig            config.Persistence
-		ClusterMetadata              cluster.Metadata
-		ReplicatorConfig             config.Replicator
-		MetricsClient                metrics.Client
-		MessagingClient              messaging.Client
-		ESClient                     elasticsearch.Client
-		ESConfig                     *elasticsearch.Config
-		DynamicConfig                dynamicconfig.Client
-		DCRedirectionPolicy          config.DCRedirectionPolicy
-		PublicClient                 sdkclient.Client
-		ArchivalMetadata             archiver.ArchivalMetadata
-		ArchiverProvider             provider.ArchiverProvider
-		Authorizer                   authorization.Authorizer
-		ClaimMapper                  authorization.ClaimMapper
-		PersistenceServiceResolver   resolver.ServiceResolver
+		MetricsScope                  tally.Scope
+		MembershipFactoryInitializer  MembershipFactoryInitializerFunc
+		RPCFactory                    common.RPCFactory
+		AbstractDatastoreFactory      persistenceClient.AbstractDataStoreFactory
+		PersistenceConfig             config.Persistence
+		ClusterMetadata               cluster.Metadata
+		ReplicatorConfig              config.Replicator
+		MetricsClient                 metrics.Client
+		MessagingClient               messaging.Client
+		ESClient                      elasticsearch.Client
+		ESConfig                      *elasticsearch.Config
+		DynamicConfig                 dynamicconfig.Client
+		DCRedirectionPolicy           config.DCRedirectionPolicy
+		PublicClient                  sdkclient.Client
+		ArchivalMetadata              archiver.ArchivalMetadata
+		ArchiverProvider              provider.ArchiverProvider
+		Authorizer                    authorization.Authorizer
+		ClaimMapper                   authorization.ClaimMapper
+		NamespaceRegistrationApprover namespace.RegistrationApprover
+		RedactionHashKey              []byte
+		PersistenceServiceResolver    resolver.ServiceResolver
+		// TimeSource overrides wall clock of service, nil means real time
+		TimeSource clock.TimeSource
+		// UUIDGenerator overrides generator of run IDs, nil means crypto random UUIDs
+		UUIDGenerator primitives.UUIDGenerator
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
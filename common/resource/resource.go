@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/primitives"
 )
 
 type (
@@ -65,6 +66,7 @@ type (
 
 		GetNamespaceCache() cache.NamespaceCache
 		GetTimeSource() clock.TimeSource
+		GetUUIDGenerator() primitives.UUIDGenerator
 		GetPayloadSerializer() persistence.PayloadSerializer
 		GetMetricsClient() metrics.Client
 		GetArchiverProvider() provider.ArchiverProvider
@@ -98,6 +100,11 @@ type (
 		GetTaskManager() persistence.TaskManager
 		GetVisibilityManager() persistence.VisibilityManager
 		GetNamespaceReplicationQueue() persistence.NamespaceReplicationQueue
+		GetSignalDLQ() persistence.SignalDLQ
+		GetNamespaceRegistrationStore() persistence.NamespaceRegistrationStore
+		GetIntakeQueue() persistence.IntakeQueue
+		GetConsistencyMarkerStore() persistence.ConsistencyMarkerStore
+		GetLeaderLeaseStore() persistence.LeaderLeaseStore
 		GetShardManager() persistence.ShardManager
 		GetHistoryManager() persistence.HistoryManager
 		GetExecutionManager(int32) (persistence.ExecutionManager, error)
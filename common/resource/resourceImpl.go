@@ -25,6 +25,7 @@
 package resource
 
 import (
+	"fmt"
 	"math/rand"
 	"net"
 	"os"
@@ -35,6 +36,7 @@ import (
 	"github.com/uber/tchannel-go"
 	sdkclient "go.temporal.io/sdk/client"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
@@ -43,6 +45,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
@@ -54,6 +57,8 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
@@ -83,6 +88,7 @@ type (
 
 		namespaceCache    cache.NamespaceCache
 		timeSource        clock.TimeSource
+		uuidGenerator     primitives.UUIDGenerator
 		payloadSerializer persistence.PayloadSerializer
 		metricsClient     metrics.Client
 		messagingClient   messaging.Client
@@ -127,6 +133,7 @@ type (
 		// internal vars
 		runtimeMetricsReporter *metrics.RuntimeMetricsReporter
 		rpcFactory             common.RPCFactory
+		tlsRotationWatcher     *tlsRotationWatcher
 	}
 )
 
@@ -151,6 +158,15 @@ func New(
 		return nil, err
 	}
 
+	var timeSource clock.TimeSource = clock.NewRealTimeSource()
+	if params.TimeSource != nil {
+		timeSource = params.TimeSource
+	}
+	uuidGenerator := primitives.NewUUIDGenerator()
+	if params.UUIDGenerator != nil {
+		uuidGenerator = params.UUIDGenerator
+	}
+
 	grpcListener := params.RPCFactory.GetGRPCListener()
 
 	ringpopChannel := params.RPCFactory.GetRingpopChannel()
@@ -189,12 +205,31 @@ func New(
 	if err != nil {
 		return nil, err
 	}
+	membership.SetGRPCResolverMonitor(params.Name, membershipMonitor)
+
+	clusterMetadataManager := persistenceBean.GetClusterMetadataManager()
+	historyServiceResolver, err := membership.NewHistoryServiceResolver(
+		membershipMonitor,
+		func() (*persistencespb.ShardRebalancePlan, error) {
+			metadata, err := clusterMetadataManager.GetClusterMetadata()
+			if err != nil {
+				return nil, err
+			}
+			return metadata.GetShardRebalancePlan(), nil
+		},
+		timeSource,
+		logger,
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	dynamicCollection := dynamicconfig.NewCollection(params.DynamicConfig, logger)
 	clientBean, err := client.NewClientBean(
 		client.NewRPCClientFactory(
 			params.RPCFactory,
 			membershipMonitor,
+			historyServiceResolver,
 			params.MetricsClient,
 			dynamicCollection,
 			numShards,
@@ -216,14 +251,24 @@ func New(
 		return nil, err
 	}
 
-	historyServiceResolver, err := membershipMonitor.GetResolver(common.HistoryServiceName)
+	workerServiceResolver, err := membershipMonitor.GetResolver(common.WorkerServiceName)
 	if err != nil {
 		return nil, err
 	}
 
-	workerServiceResolver, err := membershipMonitor.GetResolver(common.WorkerServiceName)
-	if err != nil {
-		return nil, err
+	var tlsRotation *tlsRotationWatcher
+	if provider, ok := params.RPCFactory.(encryption.CertRotatorProvider); ok && provider.GetCertRotator() != nil {
+		tlsRotation = newTLSRotationWatcher(
+			params.Name,
+			provider.GetCertRotator(),
+			clusterMetadataManager,
+			map[string][]membership.ServiceResolver{
+				encryption.TLSGroupInternode: {historyServiceResolver, matchingServiceResolver},
+				encryption.TLSGroupFrontend:  {frontendServiceResolver},
+			},
+			timeSource,
+			logger,
+		)
 	}
 
 	visibilityMgr, err := visibilityManagerInitializer(
@@ -241,28 +286,52 @@ func New(
 		logger,
 	)
 
+	frontendRetryPolicy, frontendIsRetryable, err := getClientRetryPolicy(
+		params.RPCFactory,
+		common.FrontendServiceName,
+		common.CreateFrontendServiceRetryPolicy(),
+	)
+	if err != nil {
+		return nil, err
+	}
 	frontendRawClient := clientBean.GetFrontendClient()
 	frontendClient := frontend.NewRetryableClient(
 		frontendRawClient,
-		common.CreateFrontendServiceRetryPolicy(),
-		common.IsWhitelistServiceTransientError,
+		frontendRetryPolicy,
+		frontendIsRetryable,
 	)
 
+	matchingRetryPolicy, matchingIsRetryable, err := getClientRetryPolicy(
+		params.RPCFactory,
+		common.MatchingServiceName,
+		common.CreateMatchingServiceRetryPolicy(),
+	)
+	if err != nil {
+		return nil, err
+	}
 	matchingRawClient, err := clientBean.GetMatchingClient(namespaceCache.GetNamespaceName)
 	if err != nil {
 		return nil, err
 	}
 	matchingClient := matching.NewRetryableClient(
 		matchingRawClient,
-		common.CreateMatchingServiceRetryPolicy(),
-		common.IsWhitelistServiceTransientError,
+		matchingRetryPolicy,
+		matchingIsRetryable,
 	)
 
+	historyRetryPolicy, historyIsRetryable, err := getClientRetryPolicy(
+		params.RPCFactory,
+		common.HistoryServiceName,
+		common.CreateHistoryServiceRetryPolicy(),
+	)
+	if err != nil {
+		return nil, err
+	}
 	historyRawClient := clientBean.GetHistoryClient()
 	historyClient := history.NewRetryableClient(
 		historyRawClient,
-		common.CreateHistoryServiceRetryPolicy(),
-		common.IsWhitelistServiceTransientError,
+		historyRetryPolicy,
+		historyIsRetryable,
 	)
 
 	historyArchiverBootstrapContainer := &archiver.HistoryBootstrapContainer{
@@ -300,7 +369,8 @@ func New(
 		// other common resources
 
 		namespaceCache:    namespaceCache,
-		timeSource:        clock.NewRealTimeSource(),
+		timeSource:        timeSource,
+		uuidGenerator:     uuidGenerator,
 		payloadSerializer: persistence.NewPayloadSerializer(),
 		metricsClient:     params.MetricsClient,
 		messagingClient:   params.MessagingClient,
@@ -349,7 +419,8 @@ func New(
 			logger,
 			params.InstanceID,
 		),
-		rpcFactory: params.RPCFactory,
+		rpcFactory:         params.RPCFactory,
+		tlsRotationWatcher: tlsRotation,
 	}
 	return impl, nil
 }
@@ -376,6 +447,9 @@ func (h *Impl) Start() {
 		h.logger.WithTags(tag.Error(err)).Fatal("fail to get host info from membership monitor")
 	}
 	h.hostInfo = hostInfo
+	if h.tlsRotationWatcher != nil {
+		h.tlsRotationWatcher.start(hostInfo.GetAddress())
+	}
 
 	// The service is now started up
 	h.logger.Info("Service resources started", tag.Address(hostInfo.GetAddress()))
@@ -394,6 +468,9 @@ func (h *Impl) Stop() {
 		return
 	}
 
+	if h.tlsRotationWatcher != nil {
+		h.tlsRotationWatcher.stop()
+	}
 	h.namespaceCache.Stop()
 	h.membershipMonitor.Stop()
 	h.ringpopChannel.Close()
@@ -434,6 +511,11 @@ func (h *Impl) GetTimeSource() clock.TimeSource {
 	return h.timeSource
 }
 
+// GetUUIDGenerator return generator of run IDs
+func (h *Impl) GetUUIDGenerator() primitives.UUIDGenerator {
+	return h.uuidGenerator
+}
+
 // GetPayloadSerializer return binary payload serializer
 func (h *Impl) GetPayloadSerializer() persistence.PayloadSerializer {
 	return h.payloadSerializer
@@ -571,6 +653,31 @@ func (h *Impl) GetNamespaceReplicationQueue() persistence.NamespaceReplicationQu
 	return h.persistenceBean.GetNamespaceReplicationQueue()
 }
 
+// GetSignalDLQ return signal DLQ
+func (h *Impl) GetSignalDLQ() persistence.SignalDLQ {
+	return h.persistenceBean.GetSignalDLQ()
+}
+
+// GetNamespaceRegistrationStore return namespace registration store
+func (h *Impl) GetNamespaceRegistrationStore() persistence.NamespaceRegistrationStore {
+	return h.persistenceBean.GetNamespaceRegistrationStore()
+}
+
+// GetIntakeQueue return intake queue
+func (h *Impl) GetIntakeQueue() persistence.IntakeQueue {
+	return h.persistenceBean.GetIntakeQueue()
+}
+
+// GetConsistencyMarkerStore return consistency marker store
+func (h *Impl) GetConsistencyMarkerStore() persistence.ConsistencyMarkerStore {
+	return h.persistenceBean.GetConsistencyMarkerStore()
+}
+
+// GetLeaderLeaseStore return leader lease store
+func (h *Impl) GetLeaderLeaseStore() persistence.LeaderLeaseStore {
+	return h.persistenceBean.GetLeaderLeaseStore()
+}
+
 // GetShardManager return shard manager
 func (h *Impl) GetShardManager() persistence.ShardManager {
 	return h.persistenceBean.GetShardManager()
@@ -610,3 +717,23 @@ func (h *Impl) GetThrottledLogger() log.Logger {
 func (h *Impl) GetGRPCListener() net.Listener {
 	return h.grpcListener
 }
+
+// getClientRetryPolicy returns retry policy of internode client calling targetService, defaults can be
+// overridden in rpc config of the service
+func getClientRetryPolicy(
+	rpcFactory common.RPCFactory,
+	targetService string,
+	policy backoff.RetryPolicy,
+) (backoff.RetryPolicy, backoff.IsRetryable, error) {
+
+	isRetryable := common.IsWhitelistServiceTransientError
+	provider, ok := rpcFactory.(common.ClientRetryPolicyProvider)
+	if !ok {
+		return policy, isRetryable, nil
+	}
+	policy, isRetryable, err := provider.GetClientRetryPolicy(targetService, policy, isRetryable)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid %v client retry policy: %w", targetService, err)
+	}
+	return policy, isRetryable, nil
+}
@@ -57,6 +57,7 @@ import (
 	"go.temporal.io/server/common/mocks"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/primitives"
 )
 
 type (
@@ -69,6 +70,7 @@ type (
 
 		NamespaceCache    *cache.MockNamespaceCache
 		TimeSource        clock.TimeSource
+		UUIDGenerator     primitives.UUIDGenerator
 		PayloadSerializer persistence.PayloadSerializer
 		MetricsClient     metrics.Client
 		ArchivalMetadata  *archiver.MockArchivalMetadata
@@ -99,6 +101,11 @@ type (
 		TaskMgr                   *mocks.TaskManager
 		VisibilityMgr             *mocks.VisibilityManager
 		NamespaceReplicationQueue persistence.NamespaceReplicationQueue
+		SignalDLQ                 *persistence.MockSignalDLQ
+		RegistrationStore         *persistence.MockNamespaceRegistrationStore
+		IntakeQueue               *persistence.MockIntakeQueue
+		ConsistencyMarkerStore    *persistence.MockConsistencyMarkerStore
+		LeaderLeaseStore          *persistence.MockLeaderLeaseStore
 		ShardMgr                  *mocks.ShardManager
 		HistoryMgr                *mocks.HistoryV2Manager
 		ExecutionMgr              *mocks.ExecutionManager
@@ -152,6 +159,11 @@ func NewTest(
 	namespaceReplicationQueue := persistence.NewMockNamespaceReplicationQueue(controller)
 	namespaceReplicationQueue.EXPECT().Start().AnyTimes()
 	namespaceReplicationQueue.EXPECT().Stop().AnyTimes()
+	signalDLQ := persistence.NewMockSignalDLQ(controller)
+	registrationStore := persistence.NewMockNamespaceRegistrationStore(controller)
+	intakeQueue := persistence.NewMockIntakeQueue(controller)
+	consistencyMarkerStore := persistence.NewMockConsistencyMarkerStore(controller)
+	leaderLeaseStore := persistence.NewMockLeaderLeaseStore(controller)
 	persistenceBean := persistenceClient.NewMockBean(controller)
 	persistenceBean.EXPECT().GetMetadataManager().Return(metadataMgr).AnyTimes()
 	persistenceBean.EXPECT().GetTaskManager().Return(taskMgr).AnyTimes()
@@ -160,6 +172,11 @@ func NewTest(
 	persistenceBean.EXPECT().GetShardManager().Return(shardMgr).AnyTimes()
 	persistenceBean.EXPECT().GetExecutionManager(gomock.Any()).Return(executionMgr, nil).AnyTimes()
 	persistenceBean.EXPECT().GetNamespaceReplicationQueue().Return(namespaceReplicationQueue).AnyTimes()
+	persistenceBean.EXPECT().GetSignalDLQ().Return(signalDLQ).AnyTimes()
+	persistenceBean.EXPECT().GetNamespaceRegistrationStore().Return(registrationStore).AnyTimes()
+	persistenceBean.EXPECT().GetIntakeQueue().Return(intakeQueue).AnyTimes()
+	persistenceBean.EXPECT().GetConsistencyMarkerStore().Return(consistencyMarkerStore).AnyTimes()
+	persistenceBean.EXPECT().GetLeaderLeaseStore().Return(leaderLeaseStore).AnyTimes()
 	persistenceBean.EXPECT().GetClusterMetadataManager().Return(clusterMetadataManager).AnyTimes()
 
 	membershipMonitor := membership.NewMockMonitor(controller)
@@ -182,6 +199,7 @@ func NewTest(
 
 		NamespaceCache:    cache.NewMockNamespaceCache(controller),
 		TimeSource:        clock.NewRealTimeSource(),
+		UUIDGenerator:     primitives.NewUUIDGenerator(),
 		PayloadSerializer: persistence.NewPayloadSerializer(),
 		MetricsClient:     metrics.NewClient(scope, serviceMetricsIndex),
 		ArchivalMetadata:  &archiver.MockArchivalMetadata{},
@@ -212,6 +230,11 @@ func NewTest(
 		TaskMgr:                   taskMgr,
 		VisibilityMgr:             visibilityMgr,
 		NamespaceReplicationQueue: namespaceReplicationQueue,
+		SignalDLQ:                 signalDLQ,
+		RegistrationStore:         registrationStore,
+		IntakeQueue:               intakeQueue,
+		ConsistencyMarkerStore:    consistencyMarkerStore,
+		LeaderLeaseStore:          leaderLeaseStore,
 		ShardMgr:                  shardMgr,
 		HistoryMgr:                historyMgr,
 		ExecutionMgr:              executionMgr,
@@ -272,6 +295,11 @@ func (s *Test) GetTimeSource() clock.TimeSource {
 	return s.TimeSource
 }
 
+// GetUUIDGenerator for testing
+func (s *Test) GetUUIDGenerator() primitives.UUIDGenerator {
+	return s.UUIDGenerator
+}
+
 // GetPayloadSerializer for testing
 func (s *Test) GetPayloadSerializer() persistence.PayloadSerializer {
 	return s.PayloadSerializer
@@ -405,6 +433,31 @@ func (s *Test) GetNamespaceReplicationQueue() persistence.NamespaceReplicationQu
 	return s.NamespaceReplicationQueue
 }
 
+// GetSignalDLQ for testing
+func (s *Test) GetSignalDLQ() persistence.SignalDLQ {
+	return s.SignalDLQ
+}
+
+// GetNamespaceRegistrationStore for testing
+func (s *Test) GetNamespaceRegistrationStore() persistence.NamespaceRegistrationStore {
+	return s.RegistrationStore
+}
+
+// GetIntakeQueue for testing
+func (s *Test) GetIntakeQueue() persistence.IntakeQueue {
+	return s.IntakeQueue
+}
+
+// GetConsistencyMarkerStore for testing
+func (s *Test) GetConsistencyMarkerStore() persistence.ConsistencyMarkerStore {
+	return s.ConsistencyMarkerStore
+}
+
+// GetLeaderLeaseStore for testing
+func (s *Test) GetLeaderLeaseStore() persistence.LeaderLeaseStore {
+	return s.LeaderLeaseStore
+}
+
 // GetShardManager for testing
 func (s *Test) GetShardManager() persistence.ShardManager {
 	return s.ShardMgr
@@ -0,0 +1,219 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resource
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/rpc/encryption"
+)
+
+const (
+	tlsRotationCheckInterval = 30 * time.Second
+	// tlsRotationMaxPeers bounds the number of peers the certificate is verified with on every host
+	tlsRotationMaxPeers = 5
+	// hosts report concurrently with versioned cluster metadata updates, conflicting updates are retried
+	tlsRotationReportAttempts      = 5
+	tlsRotationReportRetryInterval = time.Second
+)
+
+var errTLSRotationReportConflict = errors.New("cluster metadata was updated concurrently")
+
+type (
+	// tlsRotationWatcher rotates certificates of the host when a new generation of TLS rotation is
+	// started through admin API, and reports the outcome to cluster metadata
+	tlsRotationWatcher struct {
+		serviceName            string
+		rotator                encryption.CertRotator
+		clusterMetadataManager persistence.ClusterMetadataManager
+		peerResolvers          map[string][]membership.ServiceResolver
+		timeSource             clock.TimeSource
+		logger                 log.Logger
+
+		hostAddress string
+		generation  int64
+		shutdownCh  chan struct{}
+		shutdownWG  sync.WaitGroup
+	}
+)
+
+func newTLSRotationWatcher(
+	serviceName string,
+	rotator encryption.CertRotator,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	peerResolvers map[string][]membership.ServiceResolver,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) *tlsRotationWatcher {
+	return &tlsRotationWatcher{
+		serviceName:            serviceName,
+		rotator:                rotator,
+		clusterMetadataManager: clusterMetadataManager,
+		peerResolvers:          peerResolvers,
+		timeSource:             timeSource,
+		logger:                 logger,
+		shutdownCh:             make(chan struct{}),
+	}
+}
+
+func (w *tlsRotationWatcher) start(hostAddress string) {
+	w.hostAddress = hostAddress
+	// certificates are loaded from their files on start, rotations started before are already in effect
+	metadata, err := w.clusterMetadataManager.GetClusterMetadata()
+	if err != nil {
+		w.logger.Warn("Unable to load TLS rotation.", tag.Error(err))
+	} else {
+		w.generation = metadata.GetTlsRotation().GetGeneration()
+	}
+
+	w.shutdownWG.Add(1)
+	go w.watchLoop()
+}
+
+func (w *tlsRotationWatcher) stop() {
+	close(w.shutdownCh)
+	w.shutdownWG.Wait()
+}
+
+func (w *tlsRotationWatcher) watchLoop() {
+	defer w.shutdownWG.Done()
+
+	ticker := time.NewTicker(tlsRotationCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.checkRotation()
+		case <-w.shutdownCh:
+			return
+		}
+	}
+}
+
+func (w *tlsRotationWatcher) checkRotation() {
+	metadata, err := w.clusterMetadataManager.GetClusterMetadata()
+	if err != nil {
+		w.logger.Warn("Unable to load TLS rotation.", tag.Error(err))
+		return
+	}
+	rotation := metadata.GetTlsRotation()
+	if rotation.GetGeneration() <= w.generation {
+		return
+	}
+	// every generation is tried once, failed rotation is retried by starting a new generation
+	w.generation = rotation.GetGeneration()
+
+	updateTime := w.timeSource.Now()
+	status := &persistencespb.TLSRotationHostStatus{
+		Service:     w.serviceName,
+		HostAddress: w.hostAddress,
+		UpdateTime:  &updateTime,
+	}
+	rotated, err := w.rotator.RotateCertificate(rotation.GetGroup(), w.peers(rotation.GetGroup()))
+	switch {
+	case err != nil:
+		status.Failure = err.Error()
+		w.logger.Error("Unable to rotate TLS certificate.", tag.Value(rotation.GetGroup()), tag.Error(err))
+	case rotated:
+		status.Rotated = true
+		w.logger.Info("Rotated TLS certificate.", tag.Value(rotation.GetGroup()))
+	default:
+		w.logger.Info("TLS certificate is unchanged.", tag.Value(rotation.GetGroup()))
+	}
+
+	if err := w.report(rotation.GetGeneration(), status); err != nil {
+		w.logger.Warn("Unable to report TLS rotation.", tag.Error(err))
+	}
+}
+
+// peers returns addresses of up to tlsRotationMaxPeers other hosts serving the TLS group
+func (w *tlsRotationWatcher) peers(group string) []string {
+	var peers []string
+	for _, resolver := range w.peerResolvers[group] {
+		for _, member := range resolver.Members() {
+			if member.GetAddress() != w.hostAddress {
+				peers = append(peers, member.GetAddress())
+			}
+		}
+	}
+	rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+	if len(peers) > tlsRotationMaxPeers {
+		peers = peers[:tlsRotationMaxPeers]
+	}
+	return peers
+}
+
+func (w *tlsRotationWatcher) report(generation int64, status *persistencespb.TLSRotationHostStatus) error {
+	for attempt := 0; attempt < tlsRotationReportAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(backoff.JitDuration(tlsRotationReportRetryInterval, 0.5)):
+			case <-w.shutdownCh:
+				return nil
+			}
+		}
+
+		metadata, err := w.clusterMetadataManager.GetClusterMetadata()
+		if err != nil {
+			return err
+		}
+		rotation := metadata.GetTlsRotation()
+		if rotation.GetGeneration() != generation {
+			// superseded by a newer rotation
+			return nil
+		}
+		hosts := make([]*persistencespb.TLSRotationHostStatus, 0, len(rotation.Hosts)+1)
+		for _, host := range rotation.Hosts {
+			if host.GetService() != status.GetService() || host.GetHostAddress() != status.GetHostAddress() {
+				hosts = append(hosts, host)
+			}
+		}
+		rotation.Hosts = append(hosts, status)
+
+		applied, err := w.clusterMetadataManager.SaveClusterMetadata(&persistence.SaveClusterMetadataRequest{
+			ClusterMetadata: metadata.ClusterMetadata,
+			Version:         metadata.Version,
+		})
+		if err != nil {
+			return err
+		}
+		if applied {
+			return nil
+		}
+	}
+	return errTLSRotationReportConflict
+}
@@ -29,6 +29,8 @@ import (
 
 	"github.com/uber/tchannel-go"
 	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/backoff"
 )
 
 type (
@@ -39,6 +41,30 @@ type (
 		GetGRPCListener() net.Listener
 		GetRingpopChannel() *tchannel.Channel
 		CreateFrontendGRPCConnection(hostName string) *grpc.ClientConn
+		CreateRemoteFrontendGRPCConnection(clusterName string, hostName string) *grpc.ClientConn
 		CreateInternodeGRPCConnection(hostName string) *grpc.ClientConn
 	}
+
+	// ServerInterceptorProvider is implemented by RPCFactory which carries additional gRPC interceptors registered
+	// by the application embedding the server, services chain them after their built-in interceptors
+	ServerInterceptorProvider interface {
+		GetFrontendServerInterceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor)
+		GetInternodeServerInterceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor)
+	}
+
+	// GRPCServerDrainer is implemented by RPCFactory which stops gRPC servers of the service gracefully,
+	// services stop their servers abruptly otherwise
+	GRPCServerDrainer interface {
+		DrainGRPCServer(server *grpc.Server)
+	}
+
+	// ClientRetryPolicyProvider is implemented by RPCFactory which carries retry policies configured for clients
+	// the service uses to call other roles, policy and isRetryable are the defaults for the target
+	ClientRetryPolicyProvider interface {
+		GetClientRetryPolicy(
+			targetService string,
+			policy backoff.RetryPolicy,
+			isRetryable backoff.IsRetryable,
+		) (backoff.RetryPolicy, backoff.IsRetryable, error)
+	}
 )
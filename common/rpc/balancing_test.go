@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/examples/helloworld/helloworld"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/service/config"
+)
+
+type balancingSuite struct {
+	suite.Suite
+}
+
+func TestBalancingSuite(t *testing.T) {
+	suite.Run(t, &balancingSuite{})
+}
+
+func (s *balancingSuite) newFactory(balancing config.FrontendClientBalancing) *TestFactory {
+	cfg := &config.RPC{BindOnIP: "127.0.0.1", FrontendClientBalancing: balancing}
+	return &TestFactory{
+		RPCFactory:  NewFactory(cfg, nil, "tester", loggerimpl.NewNopLogger(), nil),
+		serverUsage: Internode,
+	}
+}
+
+func (s *balancingSuite) TestServiceConfig() {
+	s.Equal(DefaultServiceConfig, ServiceConfig(""))
+	s.Equal(DefaultServiceConfig, ServiceConfig(config.BalancingPolicyRoundRobin))
+	s.Equal(`{"loadBalancingConfig": [{"pick_first":{}}]}`, ServiceConfig(config.BalancingPolicyPickFirst))
+}
+
+func (s *balancingSuite) TestSayHello() {
+	for _, balancing := range []config.FrontendClientBalancing{
+		{},
+		{Policy: config.BalancingPolicyPickFirst},
+		{Policy: config.BalancingPolicyRoundRobin, DNSRefreshInterval: time.Second},
+		{Policy: config.BalancingPolicyPickFirst, DNSRefreshInterval: time.Second},
+	} {
+		factory := s.newFactory(balancing)
+		grpcServer, port := startHelloWorldServer(s.Suite, factory)
+		conn := factory.CreateFrontendGRPCConnection("127.0.0.1:" + port)
+
+		reply, err := helloworld.NewGreeterClient(conn).SayHello(context.Background(), &helloworld.HelloRequest{Name: "frontend"})
+		s.NoError(err, balancing)
+		s.Equal("Hello frontend", reply.GetMessage(), balancing)
+
+		_ = conn.Close()
+		grpcServer.Stop()
+	}
+}
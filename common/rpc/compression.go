@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+	// registers gzip compressor, servers accept and respond with any registered compressor
+	_ "google.golang.org/grpc/encoding/gzip"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+// defaultMaxReceiveMessageSize is the max size of received messages gRPC uses when it isn't configured
+const defaultMaxReceiveMessageSize = 4 * 1024 * 1024
+
+var (
+	errZstdMessageTooLarge = errors.New("zstd: decompressed message exceeds max receive message size")
+
+	zstdCompressorLock       sync.Mutex
+	registeredZstdCompressor *zstdCompressor
+)
+
+type (
+	// zstdCompressor is gRPC compressor which compresses whole messages with zstd and decompresses them as a stream,
+	// so memory used to decode a message is bounded by maxDecodedSize. Encoder is shared since its EncodeAll is
+	// safe for concurrent use, stream decoders are pooled.
+	zstdCompressor struct {
+		encoder  *zstd.Encoder
+		decoders chan *zstd.Decoder
+
+		sync.RWMutex
+		maxDecodedSize int64
+	}
+
+	// zstdWriter buffers the message and compresses it to the underlying writer on Close
+	zstdWriter struct {
+		bytes.Buffer
+		encoder *zstd.Encoder
+		w       io.Writer
+	}
+
+	// zstdReader decompresses a message, it returns its decoder to the pool once the message is read,
+	// or fails and returns it as soon as the message grows over the limit
+	zstdReader struct {
+		compressor *zstdCompressor
+		decoder    *zstd.Decoder
+		remaining  int64
+	}
+)
+
+// registerZstdCompressor registers zstd compressor with gRPC, so servers accept zstd compressed messages and
+// connections are able to send them. Compressor is registered once per process, decompressed messages are
+// limited to the largest maxReceiveMessageSize of the services which configure zstd.
+func registerZstdCompressor(maxReceiveMessageSize int) {
+	if maxReceiveMessageSize <= 0 {
+		maxReceiveMessageSize = defaultMaxReceiveMessageSize
+	}
+
+	zstdCompressorLock.Lock()
+	defer zstdCompressorLock.Unlock()
+
+	if registeredZstdCompressor == nil {
+		registeredZstdCompressor = newZstdCompressor(maxReceiveMessageSize)
+		encoding.RegisterCompressor(registeredZstdCompressor)
+		return
+	}
+	registeredZstdCompressor.raiseMaxDecodedSize(maxReceiveMessageSize)
+}
+
+func newZstdCompressor(maxDecodedSize int) *zstdCompressor {
+	encoder, err := zstd.NewWriter(nil, zstd.WithZeroFrames(true))
+	if err != nil {
+		panic(err)
+	}
+	return &zstdCompressor{
+		encoder:        encoder,
+		decoders:       make(chan *zstd.Decoder, runtime.GOMAXPROCS(0)),
+		maxDecodedSize: int64(maxDecodedSize),
+	}
+}
+
+func (c *zstdCompressor) Name() string {
+	return config.CompressionZstd
+}
+
+func (c *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return &zstdWriter{encoder: c.encoder, w: w}, nil
+}
+
+func (c *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	maxDecodedSize := c.getMaxDecodedSize()
+
+	var decoder *zstd.Decoder
+	select {
+	case decoder = <-c.decoders:
+	default:
+		var err error
+		// window of the stream, i.e. memory held by decoder, is limited to the max message size as well
+		decoder, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(uint64(maxDecodedSize)))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := decoder.Reset(r); err != nil {
+		decoder.Close()
+		return nil, err
+	}
+
+	reader := &zstdReader{
+		compressor: c,
+		decoder:    decoder,
+		remaining:  maxDecodedSize,
+	}
+	// reader which is abandoned before the end of the message still holds the decoder goroutine
+	runtime.SetFinalizer(reader, (*zstdReader).release)
+	return reader, nil
+}
+
+func (c *zstdCompressor) putDecoder(decoder *zstd.Decoder) {
+	select {
+	case c.decoders <- decoder:
+	default:
+		decoder.Close()
+	}
+}
+
+func (c *zstdCompressor) getMaxDecodedSize() int64 {
+	c.RLock()
+	defer c.RUnlock()
+	return c.maxDecodedSize
+}
+
+func (c *zstdCompressor) raiseMaxDecodedSize(maxDecodedSize int) {
+	c.Lock()
+	defer c.Unlock()
+	if int64(maxDecodedSize) <= c.maxDecodedSize {
+		return
+	}
+	c.maxDecodedSize = int64(maxDecodedSize)
+
+	// pooled decoders limit window to the old size
+	for {
+		select {
+		case decoder := <-c.decoders:
+			decoder.Close()
+		default:
+			return
+		}
+	}
+}
+
+func (r *zstdReader) Read(p []byte) (int, error) {
+	if r.decoder == nil {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+
+	n, err := r.decoder.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		r.release()
+		return n, errZstdMessageTooLarge
+	}
+	if err != nil {
+		r.release()
+	}
+	return n, err
+}
+
+func (r *zstdReader) release() {
+	if r.decoder == nil {
+		return
+	}
+	r.compressor.putDecoder(r.decoder)
+	r.decoder = nil
+}
+
+func (w *zstdWriter) Close() error {
+	_, err := w.w.Write(w.encoder.EncodeAll(w.Bytes(), nil))
+	return err
+}
@@ -0,0 +1,139 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/examples/helloworld/helloworld"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/service/config"
+)
+
+type compressionSuite struct {
+	suite.Suite
+}
+
+func TestCompressionSuite(t *testing.T) {
+	suite.Run(t, &compressionSuite{})
+}
+
+func (s *compressionSuite) newFactory(compression string) *TestFactory {
+	cfg := &config.RPC{BindOnIP: "127.0.0.1", Compression: compression}
+	return &TestFactory{
+		RPCFactory:  NewFactory(cfg, nil, "tester", loggerimpl.NewNopLogger(), nil),
+		serverUsage: Internode,
+	}
+}
+
+func (s *compressionSuite) TestZstdCompressor() {
+	compressor := newZstdCompressor(defaultMaxReceiveMessageSize)
+	message := []byte(strings.Repeat("history event batch ", 1024))
+
+	compressed := s.compress(compressor, message)
+	s.Less(len(compressed), len(message))
+
+	for i := 0; i < 3; i++ {
+		r, err := compressor.Decompress(bytes.NewReader(compressed))
+		s.NoError(err)
+		decompressed, err := ioutil.ReadAll(r)
+		s.NoError(err)
+		s.Equal(message, decompressed)
+		// decoder is back in the pool once the message is read
+		s.Len(compressor.decoders, 1)
+	}
+
+	r, err := compressor.Decompress(strings.NewReader("not zstd"))
+	s.NoError(err)
+	_, err = ioutil.ReadAll(r)
+	s.Error(err)
+}
+
+func (s *compressionSuite) TestZstdCompressor_MaxDecodedSize() {
+	compressor := newZstdCompressor(16 * 1024)
+	message := bytes.Repeat([]byte{0}, 64*1024)
+
+	r, err := compressor.Decompress(bytes.NewReader(s.compress(compressor, message)))
+	s.NoError(err)
+	decompressed, err := ioutil.ReadAll(r)
+	s.Error(err)
+	s.True(len(decompressed) <= len(message))
+	s.Len(compressor.decoders, 1)
+
+	// stream with small window is not rejected upfront but stops decoding once it grows over the limit
+	var streamed bytes.Buffer
+	encoder, err := zstd.NewWriter(&streamed, zstd.WithWindowSize(1024))
+	s.NoError(err)
+	_, err = encoder.Write(message)
+	s.NoError(err)
+	s.NoError(encoder.Close())
+	r, err = compressor.Decompress(&streamed)
+	s.NoError(err)
+	decompressed, err = ioutil.ReadAll(r)
+	s.Equal(errZstdMessageTooLarge, err)
+	s.Len(decompressed, 16*1024+1)
+	s.Len(compressor.decoders, 1)
+
+	compressor.raiseMaxDecodedSize(128 * 1024)
+	compressor.raiseMaxDecodedSize(8 * 1024)
+	s.Equal(int64(128*1024), compressor.getMaxDecodedSize())
+	r, err = compressor.Decompress(bytes.NewReader(s.compress(compressor, message)))
+	s.NoError(err)
+	decompressed, err = ioutil.ReadAll(r)
+	s.NoError(err)
+	s.Equal(message, decompressed)
+}
+
+func (s *compressionSuite) compress(compressor *zstdCompressor, message []byte) []byte {
+	var compressed bytes.Buffer
+	w, err := compressor.Compress(&compressed)
+	s.NoError(err)
+	_, err = w.Write(message)
+	s.NoError(err)
+	s.NoError(w.Close())
+	return compressed.Bytes()
+}
+
+func (s *compressionSuite) TestSayHello() {
+	for _, compression := range []string{"", config.CompressionGzip, config.CompressionZstd} {
+		grpcServer, port := startHelloWorldServer(s.Suite, s.newFactory(compression))
+		conn := s.newFactory(compression).CreateInternodeGRPCConnection("127.0.0.1:" + port)
+
+		name := strings.Repeat("a", 64*1024)
+		reply, err := helloworld.NewGreeterClient(conn).SayHello(context.Background(), &helloworld.HelloRequest{Name: name})
+		s.NoError(err, compression)
+		s.Equal("Hello "+name, reply.GetMessage(), compression)
+
+		_ = conn.Close()
+		grpcServer.Stop()
+	}
+}
@@ -0,0 +1,98 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/examples/helloworld/helloworld"
+)
+
+type (
+	drainSuite struct {
+		suite.Suite
+	}
+
+	// slowGreeter replies after delay, like long poll which completes while server drains
+	slowGreeter struct {
+		helloworld.UnimplementedGreeterServer
+		delay    time.Duration
+		received chan struct{}
+	}
+)
+
+func TestDrainSuite(t *testing.T) {
+	suite.Run(t, &drainSuite{})
+}
+
+func (g *slowGreeter) SayHello(ctx context.Context, in *helloworld.HelloRequest) (*helloworld.HelloReply, error) {
+	close(g.received)
+	select {
+	case <-time.After(g.delay):
+		return &helloworld.HelloReply{Message: "Hello " + in.Name}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// startSlowServer starts server and sends request to it, it returns the server and channel of the request result
+func (s *drainSuite) startSlowServer(delay time.Duration) (*grpc.Server, <-chan error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	greeter := &slowGreeter{delay: delay, received: make(chan struct{})}
+	server := grpc.NewServer()
+	helloworld.RegisterGreeterServer(server, greeter)
+	go func() { _ = server.Serve(listener) }()
+
+	conn, err := Dial(listener.Addr().String(), nil)
+	s.NoError(err)
+	result := make(chan error, 1)
+	go func() {
+		defer func() { _ = conn.Close() }()
+		_, err := helloworld.NewGreeterClient(conn).SayHello(context.Background(), &helloworld.HelloRequest{Name: "drain"})
+		result <- err
+	}()
+	<-greeter.received
+	return server, result
+}
+
+func (s *drainSuite) TestGracefulStop_RequestCompletes() {
+	server, result := s.startSlowServer(100 * time.Millisecond)
+	s.True(GracefulStop(server, 5*time.Second))
+	s.NoError(<-result)
+}
+
+func (s *drainSuite) TestGracefulStop_Timeout() {
+	server, result := s.startSlowServer(time.Minute)
+	start := time.Now()
+	s.False(GracefulStop(server, 100*time.Millisecond))
+	s.Less(int64(time.Since(start)), int64(5*time.Second))
+	s.Error(<-result)
+}
@@ -0,0 +1,376 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+// AWSCertProviderPluginName is the name of the built-in cert provider plugin which loads key material
+// from AWS Secrets Manager or decrypts it with AWS KMS, configured by RootTLS.AWS.
+const AWSCertProviderPluginName = "aws"
+
+const (
+	awsRefreshInterval      = time.Hour
+	awsRefreshRetryInterval = 10 * time.Second
+)
+
+var _ CertProvider = (*awsCertProvider)(nil)
+var _ ClientCertProvider = (*awsCertProvider)(nil)
+
+type (
+	awsCertProviderPlugin struct{}
+
+	// awsCertProvider presents a certificate whose private key is loaded from AWS, it is never written
+	// to disk. The key material is reloaded in the background once per refresh interval, group settings,
+	// revocation checks and explicitly configured CAs are handled by the local store.
+	awsCertProvider struct {
+		sync.RWMutex
+		*localStoreCertProvider
+
+		secrets         secretsmanageriface.SecretsManagerAPI
+		kms             kmsiface.KMSAPI
+		source          *config.AWSCertificate
+		refreshInterval time.Duration
+
+		cert         *tls.Certificate
+		caPool       *x509.CertPool
+		refreshAt    time.Time
+		isRefreshing bool
+	}
+
+	// awsSecretValue is the JSON value of a Secrets Manager secret holding key material
+	awsSecretValue struct {
+		Certificate    string `json:"certificate"`
+		PrivateKey     string `json:"privateKey"`
+		CACertificates string `json:"caCertificates"`
+	}
+)
+
+func (p *awsCertProviderPlugin) CreateCertProviders(settings *config.RootTLS) (*CertProviders, error) {
+	awsSettings := &settings.AWS
+	if !isAWSCertificateConfigured(&awsSettings.Internode) && !isAWSCertificateConfigured(&awsSettings.Frontend) {
+		return nil, errors.New("secret or encrypted key of internode or frontend certificate is required")
+	}
+	for group, source := range map[string]*config.AWSCertificate{"internode": &awsSettings.Internode, "frontend": &awsSettings.Frontend} {
+		if err := validateAWSCertificate(source); err != nil {
+			return nil, fmt.Errorf("invalid aws settings of %v certificate: %w", group, err)
+		}
+	}
+
+	awsConfig := &aws.Config{Region: aws.String(awsSettings.Region)}
+	if awsSettings.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(awsSettings.Endpoint)
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create aws session: %w", err)
+	}
+	secretsClient := secretsmanager.New(sess)
+	kmsClient := kms.New(sess)
+
+	// groups without key material in AWS are loaded from the local store
+	certProviders := newLocalStoreCertProviders(settings)
+	if isAWSCertificateConfigured(&awsSettings.Internode) {
+		internodeProvider := newAWSCertProvider(secretsClient, kmsClient, &awsSettings.Internode, awsSettings.RefreshInterval,
+			&localStoreCertProvider{tlsSettings: &settings.Internode})
+		certProviders.Internode = internodeProvider
+		certProviders.InternodeClient = internodeProvider
+		if settings.SystemWorker.CertFile == "" && settings.SystemWorker.CertData == "" {
+			// system workers present the internode certificate and use frontend client settings
+			internodeProvider.legacyWorkerSettings = &settings.Frontend.Client
+			certProviders.SystemWorker = internodeProvider
+		}
+	}
+	if isAWSCertificateConfigured(&awsSettings.Frontend) {
+		certProviders.Frontend = newAWSCertProvider(secretsClient, kmsClient, &awsSettings.Frontend, awsSettings.RefreshInterval,
+			&localStoreCertProvider{tlsSettings: &settings.Frontend})
+	}
+	return certProviders, nil
+}
+
+func isAWSCertificateConfigured(source *config.AWSCertificate) bool {
+	return source.SecretID != "" || source.EncryptedKeyFile != "" || source.EncryptedKeyData != ""
+}
+
+func validateAWSCertificate(source *config.AWSCertificate) error {
+	sources := 0
+	for _, value := range []string{source.SecretID, source.EncryptedKeyFile, source.EncryptedKeyData} {
+		if value != "" {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return errors.New("only one of secretId, encryptedKeyFile and encryptedKeyData can be specified")
+	}
+	return nil
+}
+
+func newAWSCertProvider(
+	secrets secretsmanageriface.SecretsManagerAPI,
+	kms kmsiface.KMSAPI,
+	source *config.AWSCertificate,
+	refreshInterval time.Duration,
+	localStore *localStoreCertProvider,
+) *awsCertProvider {
+	if refreshInterval <= 0 {
+		refreshInterval = awsRefreshInterval
+	}
+	return &awsCertProvider{
+		localStoreCertProvider: localStore,
+		secrets:                secrets,
+		kms:                    kms,
+		source:                 source,
+		refreshInterval:        refreshInterval,
+	}
+}
+
+func (p *awsCertProvider) IsEnabled() bool {
+	return true
+}
+
+func (p *awsCertProvider) FetchServerCertificate() (*tls.Certificate, error) {
+	cert, _, err := p.fetchKeyMaterial()
+	return cert, err
+}
+
+func (p *awsCertProvider) FetchClientCertificate(bool) (*tls.Certificate, error) {
+	cert, _, err := p.fetchKeyMaterial()
+	return cert, err
+}
+
+func (p *awsCertProvider) FetchClientCAs() (*x509.CertPool, error) {
+	serverSettings := &p.tlsSettings.Server
+	if len(serverSettings.ClientCAFiles) != 0 || len(serverSettings.ClientCAData) != 0 {
+		return p.localStoreCertProvider.FetchClientCAs()
+	}
+	_, caPool, err := p.fetchKeyMaterial()
+	if caPool == nil && err == nil {
+		return p.localStoreCertProvider.FetchClientCAs()
+	}
+	return caPool, err
+}
+
+func (p *awsCertProvider) FetchServerRootCAsForClient(isWorker bool) (*x509.CertPool, error) {
+	clientSettings := p.getClientTLSSettings(isWorker)
+	if len(clientSettings.RootCAFiles) != 0 || len(clientSettings.RootCAData) != 0 {
+		return p.localStoreCertProvider.FetchServerRootCAsForClient(isWorker)
+	}
+	_, caPool, err := p.fetchKeyMaterial()
+	if caPool == nil && err == nil {
+		return p.localStoreCertProvider.FetchServerRootCAsForClient(isWorker)
+	}
+	return caPool, err
+}
+
+// fetchKeyMaterial returns the certificate and CAs loaded from AWS. They are loaded synchronously by the first
+// handshake and refreshed in the background afterwards, so that handshakes are not blocked on AWS.
+func (p *awsCertProvider) fetchKeyMaterial() (*tls.Certificate, *x509.CertPool, error) {
+	p.RLock()
+	cert, caPool := p.cert, p.caPool
+	isStale := !p.isRefreshing && !time.Now().Before(p.refreshAt)
+	p.RUnlock()
+
+	if cert == nil {
+		return p.loadKeyMaterial()
+	}
+
+	if isStale {
+		p.Lock()
+		// check again, other handshake might have started the refresh while waiting for the lock
+		if !p.isRefreshing && !time.Now().Before(p.refreshAt) {
+			p.isRefreshing = true
+			go p.refresh()
+		}
+		p.Unlock()
+	}
+	return cert, caPool, nil
+}
+
+func (p *awsCertProvider) loadKeyMaterial() (*tls.Certificate, *x509.CertPool, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	// check again, other handshake might have loaded the key material while waiting for the lock
+	if p.cert != nil {
+		return p.cert, p.caPool, nil
+	}
+
+	cert, caPool, err := p.load()
+	if err != nil {
+		return nil, nil, err
+	}
+	p.cert = cert
+	p.caPool = caPool
+	p.refreshAt = time.Now().Add(p.refreshInterval)
+	return p.cert, p.caPool, nil
+}
+
+// refresh reloads the key material in the background. Current certificate is kept if loading fails,
+// it is retried after awsRefreshRetryInterval.
+func (p *awsCertProvider) refresh() {
+	cert, caPool, err := p.load()
+
+	p.Lock()
+	defer p.Unlock()
+	if err == nil {
+		p.cert = cert
+		p.caPool = caPool
+		p.refreshAt = time.Now().Add(p.refreshInterval)
+	} else {
+		p.refreshAt = time.Now().Add(awsRefreshRetryInterval)
+	}
+	p.isRefreshing = false
+}
+
+func (p *awsCertProvider) load() (*tls.Certificate, *x509.CertPool, error) {
+	if p.source.SecretID != "" {
+		return p.loadSecret()
+	}
+	cert, err := p.loadEncryptedKey()
+	return cert, nil, err
+}
+
+// loadSecret loads the certificate, private key and optional CAs of a Secrets Manager secret
+func (p *awsCertProvider) loadSecret() (*tls.Certificate, *x509.CertPool, error) {
+	input := &secretsmanager.GetSecretValueInput{SecretId: aws.String(p.source.SecretID)}
+	if p.source.VersionStage != "" {
+		input.VersionStage = aws.String(p.source.VersionStage)
+	}
+	output, err := p.secrets.GetSecretValue(input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading secret %q failed: %w", p.source.SecretID, err)
+	}
+
+	secretBytes := output.SecretBinary
+	if output.SecretString != nil {
+		secretBytes = []byte(*output.SecretString)
+	}
+	var value awsSecretValue
+	if err := json.Unmarshal(secretBytes, &value); err != nil {
+		return nil, nil, fmt.Errorf("secret %q could not be decoded: %w", p.source.SecretID, err)
+	}
+
+	cert, err := parseKeyPair([]byte(value.Certificate), []byte(value.PrivateKey))
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading certificate of secret %q failed: %w", p.source.SecretID, err)
+	}
+	if value.CACertificates == "" {
+		return cert, nil, nil
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM([]byte(value.CACertificates)) {
+		return nil, nil, fmt.Errorf("unable to parse CA certificates of secret %q", p.source.SecretID)
+	}
+	return cert, caPool, nil
+}
+
+// loadEncryptedKey decrypts the private key with KMS and pairs it with the certificate of the server settings
+func (p *awsCertProvider) loadEncryptedKey() (*tls.Certificate, error) {
+	var ciphertext []byte
+	var err error
+	if p.source.EncryptedKeyFile != "" {
+		ciphertext, err = ioutil.ReadFile(p.source.EncryptedKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		ciphertext, err = base64.StdEncoding.DecodeString(p.source.EncryptedKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("encrypted private key could not be decoded: %w", err)
+		}
+	}
+
+	input := &kms.DecryptInput{CiphertextBlob: ciphertext}
+	if p.source.KMSKeyID != "" {
+		input.KeyId = aws.String(p.source.KMSKeyID)
+	}
+	if len(p.source.EncryptionContext) != 0 {
+		input.EncryptionContext = aws.StringMap(p.source.EncryptionContext)
+	}
+	output, err := p.kms.Decrypt(input)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting private key failed: %w", err)
+	}
+	keyBytes := output.Plaintext
+	defer zeroBytes(keyBytes)
+
+	settings := &p.tlsSettings.Server
+	var certBytes []byte
+	if settings.CertFile != "" {
+		certBytes, err = ioutil.ReadFile(settings.CertFile)
+		if err != nil {
+			return nil, err
+		}
+	} else if settings.CertData != "" {
+		certBytes, err = base64.StdEncoding.DecodeString(settings.CertData)
+		if err != nil {
+			return nil, fmt.Errorf("TLS public certificate could not be decoded: %w", err)
+		}
+	} else {
+		return nil, errors.New("certificate of encrypted private key is not configured")
+	}
+	certBytes, err = normalizeCertificatesPEM(certBytes)
+	if err != nil {
+		return nil, fmt.Errorf("TLS public certificate could not be loaded: %w", err)
+	}
+	return parseKeyPair(certBytes, keyBytes)
+}
+
+func parseKeyPair(certPEM []byte, keyPEM []byte) (*tls.Certificate, error) {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+// zeroBytes overwrites decrypted key material, so that it does not linger in memory after it was parsed
+func zeroBytes(data []byte) {
+	for i := range data {
+		data[i] = 0
+	}
+}
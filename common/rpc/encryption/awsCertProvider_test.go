@@ -0,0 +1,197 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	fakeSecretsManager struct {
+		secretsmanageriface.SecretsManagerAPI
+		sync.Mutex
+		values map[string]string
+	}
+
+	fakeKMS struct {
+		kmsiface.KMSAPI
+		context map[string]*string
+	}
+)
+
+func (f *fakeSecretsManager) GetSecretValue(input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	value, ok := f.values[*input.SecretId]
+	if !ok {
+		return nil, errors.New("secret not found")
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(value)}, nil
+}
+
+func (f *fakeSecretsManager) setValue(secretID string, value string) {
+	f.Lock()
+	defer f.Unlock()
+	f.values[secretID] = value
+}
+
+// Decrypt "decrypts" ciphertexts by reversing them
+func (f *fakeKMS) Decrypt(input *kms.DecryptInput) (*kms.DecryptOutput, error) {
+	f.context = input.EncryptionContext
+	plaintext := make([]byte, len(input.CiphertextBlob))
+	for i, b := range input.CiphertextBlob {
+		plaintext[len(plaintext)-1-i] = b
+	}
+	return &kms.DecryptOutput{Plaintext: plaintext}, nil
+}
+
+func TestAWSCertProvider_Secret(t *testing.T) {
+	secrets := &fakeSecretsManager{values: map[string]string{"internode": awsTestSecret(t, "first", true)}}
+	provider := newAWSCertProvider(secrets, nil, &config.AWSCertificate{SecretID: "internode"}, time.Nanosecond,
+		&localStoreCertProvider{tlsSettings: &config.GroupTLS{}})
+
+	cert, err := provider.FetchServerCertificate()
+	require.NoError(t, err)
+	assert.Equal(t, "first", cert.Leaf.Subject.CommonName)
+	caPool, err := provider.FetchClientCAs()
+	require.NoError(t, err)
+	assert.NotNil(t, caPool)
+
+	// rotated secret is loaded in the background
+	secrets.setValue("internode", awsTestSecret(t, "second", false))
+	assert.Eventually(t, func() bool {
+		cert, err := provider.FetchClientCertificate(false)
+		return err == nil && cert.Leaf.Subject.CommonName == "second"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// current certificate is kept if the secret fails to load
+	secrets.setValue("internode", "invalid")
+	time.Sleep(10 * time.Millisecond)
+	cert, err = provider.FetchServerCertificate()
+	require.NoError(t, err)
+	assert.Equal(t, "second", cert.Leaf.Subject.CommonName)
+
+	_, err = newAWSCertProvider(secrets, nil, &config.AWSCertificate{SecretID: "missing"}, 0,
+		&localStoreCertProvider{tlsSettings: &config.GroupTLS{}}).FetchServerCertificate()
+	assert.Error(t, err)
+}
+
+func TestAWSCertProvider_EncryptedKey(t *testing.T) {
+	certPEM, keyPEM := awsTestKeyPair(t, "encrypted")
+	ciphertext := make([]byte, len(keyPEM))
+	for i, b := range keyPEM {
+		ciphertext[len(ciphertext)-1-i] = b
+	}
+
+	kmsClient := &fakeKMS{}
+	source := &config.AWSCertificate{
+		EncryptedKeyData:  base64.StdEncoding.EncodeToString(ciphertext),
+		EncryptionContext: map[string]string{"purpose": "frontend"},
+	}
+	groupSettings := &config.GroupTLS{Server: config.ServerTLS{CertData: base64.StdEncoding.EncodeToString(certPEM)}}
+	provider := newAWSCertProvider(nil, kmsClient, source, 0, &localStoreCertProvider{tlsSettings: groupSettings})
+
+	cert, err := provider.FetchServerCertificate()
+	require.NoError(t, err)
+	assert.Equal(t, "encrypted", cert.Leaf.Subject.CommonName)
+	assert.Equal(t, "frontend", *kmsClient.context["purpose"])
+
+	_, err = newAWSCertProvider(nil, kmsClient, source, 0,
+		&localStoreCertProvider{tlsSettings: &config.GroupTLS{}}).FetchServerCertificate()
+	assert.Error(t, err)
+}
+
+func TestAWSCertProviderPlugin(t *testing.T) {
+	_, err := (&awsCertProviderPlugin{}).CreateCertProviders(&config.RootTLS{})
+	assert.Error(t, err)
+
+	settings := &config.RootTLS{AWS: config.AWSTLS{
+		Region:    "us-west-2",
+		Frontend:  config.AWSCertificate{SecretID: "frontend", EncryptedKeyData: "a2V5"},
+		Internode: config.AWSCertificate{SecretID: "internode"},
+	}}
+	_, err = (&awsCertProviderPlugin{}).CreateCertProviders(settings)
+	assert.Error(t, err)
+
+	settings.AWS.Frontend = config.AWSCertificate{}
+	certProviders, err := (&awsCertProviderPlugin{}).CreateCertProviders(settings)
+	require.NoError(t, err)
+	assert.IsType(t, &awsCertProvider{}, certProviders.Internode)
+	assert.IsType(t, &awsCertProvider{}, certProviders.SystemWorker)
+	assert.IsType(t, &localStoreCertProvider{}, certProviders.Frontend)
+}
+
+// awsTestSecret returns the JSON value of a secret with a self-signed certificate, optionally trusted as CA
+func awsTestSecret(t *testing.T, commonName string, withCA bool) string {
+	certPEM, keyPEM := awsTestKeyPair(t, commonName)
+	value := awsSecretValue{Certificate: string(certPEM), PrivateKey: string(keyPEM)}
+	if withCA {
+		value.CACertificates = string(certPEM)
+	}
+	secret, err := json.Marshal(&value)
+	require.NoError(t, err)
+	return string(secret)
+}
+
+func awsTestKeyPair(t *testing.T, commonName string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
@@ -0,0 +1,152 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+)
+
+const (
+	// CertRoleInternodeClient is the role of the certificate presented by internode clients
+	CertRoleInternodeClient = "internode_client"
+	// CertRoleHTTPServer is the role of the certificate presented by the pprof and metrics HTTP endpoints
+	CertRoleHTTPServer = "http_server"
+)
+
+type (
+	// CertChainReporter is implemented by TLS config providers which are able to report the certificate
+	// chains they currently present, so that operators can verify what a node serves after rotation.
+	CertChainReporter interface {
+		GetCertChains() ([]CertChain, error)
+	}
+
+	// CertChain is a loaded certificate along with the intermediates presented with it, leaf first.
+	CertChain struct {
+		// Role is one of the CertRole constants
+		Role         string            `json:"role"`
+		Certificates []CertificateInfo `json:"certificates"`
+	}
+
+	// CertificateInfo describes a single certificate of a chain.
+	CertificateInfo struct {
+		Subject      string    `json:"subject"`
+		Issuer       string    `json:"issuer"`
+		SerialNumber string    `json:"serialNumber"`
+		NotBefore    time.Time `json:"notBefore"`
+		NotAfter     time.Time `json:"notAfter"`
+		DNSNames     []string  `json:"dnsNames,omitempty"`
+		IPAddresses  []string  `json:"ipAddresses,omitempty"`
+		URIs         []string  `json:"uris,omitempty"`
+	}
+)
+
+var _ CertChainReporter = (*localStoreTlsProvider)(nil)
+
+// GetCertChains returns the certificate chains presented by the servers and clients of the enabled TLS groups.
+func (s *localStoreTlsProvider) GetCertChains() ([]CertChain, error) {
+	var chains []CertChain
+	add := func(role string, fetch func() (*tls.Certificate, error)) error {
+		cert, err := fetch()
+		if err != nil {
+			return fmt.Errorf("unable to fetch %v certificate: %w", role, err)
+		}
+		chain, err := newCertChain(role, cert)
+		if err != nil || chain == nil {
+			return err
+		}
+		chains = append(chains, *chain)
+		return nil
+	}
+	fetchClientCertificate := func(provider ClientCertProvider, isWorker bool) func() (*tls.Certificate, error) {
+		return func() (*tls.Certificate, error) {
+			return provider.FetchClientCertificate(isWorker)
+		}
+	}
+
+	if s.internodeCertProvider.IsEnabled() {
+		if err := add(CertRoleInternodeServer, s.internodeCertProvider.FetchServerCertificate); err != nil {
+			return nil, err
+		}
+		if err := add(CertRoleInternodeClient, fetchClientCertificate(s.internodeClientCertProvider, false)); err != nil {
+			return nil, err
+		}
+		// system workers connect to frontend only if internode TLS is enabled, see GetFrontendClientConfig
+		if s.frontendCertProvider.GetSettings().Server.RequireClientAuth {
+			if err := add(CertRoleSystemWorkerClient, fetchClientCertificate(s.workerCertProvider, true)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if s.frontendCertProvider.IsEnabled() {
+		if err := add(CertRoleFrontendServer, s.frontendCertProvider.FetchServerCertificate); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.httpCertProvider != nil && s.httpCertProvider.IsEnabled() {
+		if err := add(CertRoleHTTPServer, s.httpCertProvider.FetchServerCertificate); err != nil {
+			return nil, err
+		}
+	}
+
+	return chains, nil
+}
+
+func newCertChain(role string, cert *tls.Certificate) (*CertChain, error) {
+	if cert == nil || len(cert.Certificate) == 0 {
+		return nil, nil
+	}
+	chain := &CertChain{Role: role}
+	for _, raw := range cert.Certificate {
+		parsed, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %v certificate: %w", role, err)
+		}
+		chain.Certificates = append(chain.Certificates, newCertificateInfo(parsed))
+	}
+	return chain, nil
+}
+
+func newCertificateInfo(cert *x509.Certificate) CertificateInfo {
+	info := CertificateInfo{
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		SerialNumber: fmt.Sprintf("%X", cert.SerialNumber),
+		NotBefore:    cert.NotBefore,
+		NotAfter:     cert.NotAfter,
+		DNSNames:     cert.DNSNames,
+	}
+	for _, ip := range cert.IPAddresses {
+		info.IPAddresses = append(info.IPAddresses, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		info.URIs = append(info.URIs, uri.String())
+	}
+	return info
+}
@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+)
+
+// validateServerCertChain validates the chain of the server certificate against the roots its clients
+// are configured with, so that a broken chain is reported when the config is created rather than at handshake
+func validateServerCertChain(role string, certProvider CertProvider, clientProvider ClientCertProvider, isWorker bool) error {
+	cert, err := certProvider.FetchServerCertificate()
+	if err != nil {
+		return fmt.Errorf("loading server tls certificate failed: %v", err)
+	}
+	roots, err := clientProvider.FetchServerRootCAsForClient(isWorker)
+	if err != nil {
+		return fmt.Errorf("failed to load client ca: %v", err)
+	}
+	return validateCertChain(role, cert, roots)
+}
+
+// validateClientCertChain validates the chain of the client certificate against the client CAs of the server,
+// it is only presented if the server requires client authentication
+func validateClientCertChain(role string, clientProvider ClientCertProvider, isWorker bool, serverProvider CertProvider) error {
+	if !serverProvider.GetSettings().Server.RequireClientAuth {
+		return nil
+	}
+	cert, err := clientProvider.FetchClientCertificate(isWorker)
+	if err != nil {
+		return err
+	}
+	roots, err := serverProvider.FetchClientCAs()
+	if err != nil {
+		return fmt.Errorf("failed to fetch client CAs: %v", err)
+	}
+	return validateCertChain(role, cert, roots)
+}
+
+// validateCertChain checks that each certificate of the chain is issued by the certificate following it,
+// and that the chain verifies against roots unless they are nil. The error names the broken link.
+func validateCertChain(role string, cert *tls.Certificate, roots *x509.CertPool) error {
+	if cert == nil || len(cert.Certificate) == 0 {
+		return nil
+	}
+	chain := make([]*x509.Certificate, len(cert.Certificate))
+	for i, raw := range cert.Certificate {
+		parsed, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("invalid %v certificate chain: unable to parse certificate %d: %w", role, i, err)
+		}
+		chain[i] = parsed
+	}
+
+	for i := 0; i < len(chain)-1; i++ {
+		if err := chain[i].CheckSignatureFrom(chain[i+1]); err != nil {
+			message := fmt.Sprintf("invalid %v certificate chain: certificate %d %q is not issued by certificate %d %q: %v",
+				role, i, chain[i].Subject.String(), i+1, chain[i+1].Subject.String(), err)
+			for j, candidate := range chain {
+				if j != i && j != i+1 && chain[i].CheckSignatureFrom(candidate) == nil {
+					message += fmt.Sprintf(", its issuer is certificate %d, the chain must be ordered from the leaf to the root", j)
+					break
+				}
+			}
+			return errors.New(message)
+		}
+	}
+
+	if roots == nil {
+		return nil
+	}
+	intermediates := x509.NewCertPool()
+	for _, ca := range chain[1:] {
+		intermediates.AddCert(ca)
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err == nil {
+		return nil
+	}
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	if errors.As(err, &unknownAuthorityErr) {
+		last := chain[len(chain)-1]
+		return fmt.Errorf("invalid %v certificate chain: issuer %q of certificate %d %q is not a configured root CA",
+			role, last.Issuer.String(), len(chain)-1, last.Subject.String())
+	}
+	var invalidErr x509.CertificateInvalidError
+	if errors.As(err, &invalidErr) && invalidErr.Cert != nil {
+		return fmt.Errorf("invalid %v certificate chain: certificate %q: %w", role, invalidErr.Cert.Subject.String(), err)
+	}
+	return fmt.Errorf("invalid %v certificate chain: %w", role, err)
+}
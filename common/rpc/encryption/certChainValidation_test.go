@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCertChain(t *testing.T) {
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	root, err := GenerateSelfSignedX509CAWithKey("root", nil, rootKey)
+	require.NoError(t, err)
+	parsedRoot, err := x509.ParseCertificate(root.Certificate[0])
+	require.NoError(t, err)
+
+	intermediateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	intermediateTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "intermediate"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	intermediateDER, err := x509.CreateCertificate(rand.Reader, intermediateTemplate, parsedRoot, intermediateKey.Public(), rootKey)
+	require.NoError(t, err)
+	intermediate := &tls.Certificate{Certificate: [][]byte{intermediateDER}, PrivateKey: intermediateKey}
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leaf, err := GenerateServerX509UsingCAWithKey("127.0.0.1", intermediate, leafKey)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(parsedRoot)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	other, err := GenerateSelfSignedX509CAWithKey("other", nil, otherKey)
+	require.NoError(t, err)
+	otherRoots := x509.NewCertPool()
+	parsedOther, err := x509.ParseCertificate(other.Certificate[0])
+	require.NoError(t, err)
+	otherRoots.AddCert(parsedOther)
+
+	chain := &tls.Certificate{Certificate: [][]byte{leaf.Certificate[0], intermediateDER}}
+	assert.NoError(t, validateCertChain(CertRoleInternodeServer, chain, roots))
+	assert.NoError(t, validateCertChain(CertRoleInternodeServer, chain, nil))
+	assert.NoError(t, validateCertChain(CertRoleInternodeServer, nil, roots))
+
+	// the root may be part of the chain as well
+	withRoot := &tls.Certificate{Certificate: [][]byte{leaf.Certificate[0], intermediateDER, root.Certificate[0]}}
+	assert.NoError(t, validateCertChain(CertRoleInternodeServer, withRoot, roots))
+
+	misordered := &tls.Certificate{Certificate: [][]byte{leaf.Certificate[0], root.Certificate[0], intermediateDER}}
+	err = validateCertChain(CertRoleInternodeServer, misordered, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `certificate 0 "CN=127.0.0.1,O=TemporalTechnologiesTesting,C=USA" is not issued by certificate 1`)
+	assert.Contains(t, err.Error(), "its issuer is certificate 2")
+
+	err = validateCertChain(CertRoleFrontendServer, chain, otherRoots)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid frontend_server certificate chain: issuer "CN=root,O=TemporalTechnologiesTesting,C=USA" of certificate 1 "CN=intermediate" is not a configured root CA`)
+
+	// intermediate is missing
+	missing := &tls.Certificate{Certificate: [][]byte{leaf.Certificate[0]}}
+	err = validateCertChain(CertRoleInternodeServer, missing, roots)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `issuer "CN=intermediate" of certificate 0`)
+}
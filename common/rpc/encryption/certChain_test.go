@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+func TestGetCertChains(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "cert-chains")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	provider, err := NewTLSConfigProviderFromConfig(config.RootTLS{
+		DevMode: config.DevModeTLS{AutoGenerate: true, HostName: "temporal.local", OutputDir: outputDir},
+	})
+	require.NoError(t, err)
+	reporter, ok := provider.(CertChainReporter)
+	require.True(t, ok)
+
+	chains, err := reporter.GetCertChains()
+	require.NoError(t, err)
+	var roles []string
+	for _, chain := range chains {
+		roles = append(roles, chain.Role)
+		require.NotEmpty(t, chain.Certificates)
+		leaf := chain.Certificates[0]
+		assert.NotEmpty(t, leaf.SerialNumber)
+		assert.True(t, leaf.NotAfter.After(leaf.NotBefore))
+		assert.NotEqual(t, leaf.Subject, leaf.Issuer)
+	}
+	assert.Equal(t, []string{CertRoleInternodeServer, CertRoleInternodeClient, CertRoleSystemWorkerClient, CertRoleFrontendServer}, roles)
+	assert.Contains(t, chains[0].Certificates[0].DNSNames, "temporal.local")
+
+	provider, err = NewTLSConfigProviderFromConfig(config.RootTLS{})
+	require.NoError(t, err)
+	chains, err = provider.(CertChainReporter).GetCertChains()
+	require.NoError(t, err)
+	assert.Empty(t, chains)
+}
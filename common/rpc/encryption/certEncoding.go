@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+const (
+	pemCertificateType = "CERTIFICATE"
+	pemPKCS7Type       = "PKCS7"
+)
+
+var (
+	oidSignedDataContentType = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+
+	errUnknownCertEncoding = errors.New("certificate encoding could not be detected, expected PEM, DER or PKCS#7")
+)
+
+type (
+	// pkcs7SignedData is PKCS#7 SignedData, see RFC 2315. Only the certificates are used, which is
+	// what a "certs-only" bundle exported by most PKI systems carries
+	pkcs7SignedData struct {
+		Version          int
+		DigestAlgorithms asn1.RawValue
+		ContentInfo      asn1.RawValue
+		Certificates     asn1.RawValue `asn1:"tag:0,optional"`
+		CRLs             asn1.RawValue `asn1:"tag:1,optional"`
+		SignerInfos      asn1.RawValue
+	}
+)
+
+// normalizeCertificatesPEM converts certificates encoded as DER, PKCS#7 DER or PEM armored PKCS#7 to
+// PEM so they can be consumed by tls.X509KeyPair and x509.CertPool. PEM input without PKCS#7 blocks
+// is returned unchanged
+func normalizeCertificatesPEM(data []byte) ([]byte, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return data, nil
+	}
+
+	if block, _ := pem.Decode(data); block != nil {
+		return expandPKCS7PEM(data)
+	}
+
+	certs, err := parseDERCertificates(data)
+	if err != nil {
+		return nil, err
+	}
+	return encodeCertificatesPEM(certs), nil
+}
+
+// expandPKCS7PEM replaces PKCS7 blocks with the CERTIFICATE blocks they contain, other blocks are kept as is
+func expandPKCS7PEM(data []byte) ([]byte, error) {
+	var result bytes.Buffer
+	foundPKCS7 := false
+	rest := data
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != pemPKCS7Type {
+			if err := pem.Encode(&result, block); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		foundPKCS7 = true
+		certs, err := parsePKCS7Certificates(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		result.Write(encodeCertificatesPEM(certs))
+	}
+
+	if !foundPKCS7 {
+		return data, nil
+	}
+	return result.Bytes(), nil
+}
+
+// parseDERCertificates parses either a PKCS#7 bundle or one or more concatenated DER certificates
+func parseDERCertificates(der []byte) ([]*x509.Certificate, error) {
+	if certs, err := parsePKCS7Certificates(der); err == nil {
+		return certs, nil
+	}
+	certs, err := x509.ParseCertificates(der)
+	if err != nil || len(certs) == 0 {
+		return nil, errUnknownCertEncoding
+	}
+	return certs, nil
+}
+
+func parsePKCS7Certificates(der []byte) ([]*x509.Certificate, error) {
+	var contentInfo pkcs12ContentInfo
+	rest, err := asn1.Unmarshal(der, &contentInfo)
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#7 certificate bundle could not be parsed: %v", err)
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("PKCS#7 certificate bundle could not be parsed: trailing data")
+	}
+	if !contentInfo.ContentType.Equal(oidSignedDataContentType) {
+		return nil, fmt.Errorf("PKCS#7 content type %v is not supported, expected signed data", contentInfo.ContentType)
+	}
+
+	var signedData pkcs7SignedData
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, &signedData); err != nil {
+		return nil, fmt.Errorf("PKCS#7 certificate bundle could not be parsed: %v", err)
+	}
+	certs, err := x509.ParseCertificates(signedData.Certificates.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#7 certificate bundle could not be parsed: %v", err)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("PKCS#7 certificate bundle does not contain any certificates")
+	}
+	return certs, nil
+}
+
+func encodeCertificatesPEM(certs []*x509.Certificate) []byte {
+	var result bytes.Buffer
+	for _, cert := range certs {
+		_ = pem.Encode(&result, &pem.Block{Type: pemCertificateType, Bytes: cert.Raw})
+	}
+	return result.Bytes()
+}
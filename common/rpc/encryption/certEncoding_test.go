@@ -0,0 +1,131 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeCertificatesPEM(t *testing.T) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca, err := GenerateSelfSignedX509CAWithKey("ca", nil, caKey)
+	require.NoError(t, err)
+	serverKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	server, err := GenerateServerX509UsingCAWithKey("server", ca, serverKey)
+	require.NoError(t, err)
+
+	caDER := ca.Certificate[0]
+	serverDER := server.Certificate[0]
+	bundlePEM := append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: serverDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})...)
+	pkcs7DER := testPKCS7CertsOnly(t, serverDER, caDER)
+
+	tests := map[string][]byte{
+		"PEM":            bundlePEM,
+		"DER":            append(append([]byte{}, serverDER...), caDER...),
+		"PKCS#7 DER":     pkcs7DER,
+		"PKCS#7 PEM":     pem.EncodeToMemory(&pem.Block{Type: "PKCS7", Bytes: pkcs7DER}),
+		"PKCS#7 and PEM": append(pem.EncodeToMemory(&pem.Block{Type: "PKCS7", Bytes: testPKCS7CertsOnly(t, serverDER)}), bundlePEM[len(bundlePEM)/2:]...),
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			normalized, err := normalizeCertificatesPEM(data)
+			require.NoError(t, err)
+			assert.Equal(t, bundlePEM, normalized)
+		})
+	}
+
+	// the leaf is listed first, so the normalized bundle pairs with its key
+	keyDER, err := x509.MarshalECPrivateKey(serverKey)
+	require.NoError(t, err)
+	normalized, err := normalizeCertificatesPEM(pkcs7DER)
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(normalized, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	require.NoError(t, err)
+	assert.Len(t, cert.Certificate, 2)
+
+	caPool, err := buildCAPoolFromData([]string{base64.StdEncoding.EncodeToString(caDER)})
+	require.NoError(t, err)
+	require.NotNil(t, caPool)
+	leaf, err := x509.ParseCertificate(serverDER)
+	require.NoError(t, err)
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: caPool})
+	assert.NoError(t, err)
+}
+
+func TestNormalizeCertificatesPEM_Errors(t *testing.T) {
+	_, err := normalizeCertificatesPEM([]byte("not a certificate"))
+	assert.Equal(t, errUnknownCertEncoding, err)
+
+	_, err = normalizeCertificatesPEM(pem.EncodeToMemory(&pem.Block{Type: "PKCS7", Bytes: []byte{1, 2, 3}}))
+	assert.Error(t, err)
+
+	dataDER, err := asn1.Marshal(pkcs12ContentInfo{ContentType: oidDataContentType})
+	require.NoError(t, err)
+	_, err = normalizeCertificatesPEM(pem.EncodeToMemory(&pem.Block{Type: "PKCS7", Bytes: dataDER}))
+	assert.EqualError(t, err, "PKCS#7 content type 1.2.840.113549.1.7.1 is not supported, expected signed data")
+
+	_, err = buildCAPoolFromData([]string{base64.StdEncoding.EncodeToString([]byte("garbage"))})
+	assert.Error(t, err)
+}
+
+// testPKCS7CertsOnly builds a degenerate "certs-only" PKCS#7 SignedData, the format produced by
+// `openssl crl2pkcs7 -nocrl`
+func testPKCS7CertsOnly(t *testing.T, certs ...[]byte) []byte {
+	var certBytes []byte
+	for _, cert := range certs {
+		certBytes = append(certBytes, cert...)
+	}
+	contentInfo, err := asn1.Marshal(pkcs12ContentInfo{ContentType: oidDataContentType})
+	require.NoError(t, err)
+	signedData, err := asn1.Marshal(pkcs7SignedData{
+		Version:          1,
+		DigestAlgorithms: asn1.RawValue{Tag: asn1.TagSet, IsCompound: true},
+		ContentInfo:      asn1.RawValue{FullBytes: contentInfo},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: certBytes},
+		SignerInfos:      asn1.RawValue{Tag: asn1.TagSet, IsCompound: true},
+	})
+	require.NoError(t, err)
+	result, err := asn1.Marshal(pkcs12ContentInfo{
+		ContentType: oidSignedDataContentType,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: signedData},
+	})
+	require.NoError(t, err)
+	return result
+}
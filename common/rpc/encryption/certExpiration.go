@@ -0,0 +1,179 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// CertRoleInternodeServer is the role of the certificate presented by internode servers
+	CertRoleInternodeServer = "internode_server"
+	// CertRoleFrontendServer is the role of the certificate presented by the frontend server
+	CertRoleFrontendServer = "frontend_server"
+	// CertRoleSystemWorkerClient is the role of the certificate presented by system workers to frontend
+	CertRoleSystemWorkerClient = "system_worker_client"
+	// CertRoleCA is the role of the CA certificates the presented certificates chain to
+	CertRoleCA = "ca"
+)
+
+type (
+	// CertExpirationChecker is implemented by TLS config providers which are able to report
+	// the expiration of the certificates they currently present and of the CAs issuing them.
+	CertExpirationChecker interface {
+		GetCertExpirations() ([]CertExpiration, error)
+	}
+
+	// CertExpiration is the expiration of a loaded certificate.
+	CertExpiration struct {
+		// Role is one of the CertRole constants
+		Role     string
+		Subject  string
+		NotAfter time.Time
+	}
+
+	certExpirationCollector struct {
+		expirations []CertExpiration
+		seenCAs     map[string]struct{}
+	}
+)
+
+var _ CertExpirationChecker = (*localStoreTlsProvider)(nil)
+
+// DaysUntil returns the number of days until the certificate expires, negative if it already has.
+func (e CertExpiration) DaysUntil(now time.Time) float64 {
+	return e.NotAfter.Sub(now).Hours() / 24
+}
+
+// GetCertExpirations returns the expirations of the certificates of the enabled TLS groups. CAs are
+// those the certificates chain to when verified with the roots their peers are configured with,
+// as well as the intermediates presented along with them.
+func (s *localStoreTlsProvider) GetCertExpirations() ([]CertExpiration, error) {
+	collector := &certExpirationCollector{seenCAs: make(map[string]struct{})}
+
+	if s.internodeCertProvider.IsEnabled() {
+		cert, err := s.internodeCertProvider.FetchServerCertificate()
+		if err != nil {
+			return nil, err
+		}
+		roots, err := s.internodeClientCertProvider.FetchServerRootCAsForClient(false)
+		if err != nil {
+			return nil, err
+		}
+		if err := collector.add(CertRoleInternodeServer, cert, roots); err != nil {
+			return nil, err
+		}
+
+		// system workers connect to frontend only if internode TLS is enabled, see GetFrontendClientConfig
+		if s.frontendCertProvider.GetSettings().Server.RequireClientAuth {
+			cert, err := s.workerCertProvider.FetchClientCertificate(true)
+			if err != nil {
+				return nil, err
+			}
+			roots, err := s.frontendCertProvider.FetchClientCAs()
+			if err != nil {
+				return nil, err
+			}
+			if err := collector.add(CertRoleSystemWorkerClient, cert, roots); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if s.frontendCertProvider.IsEnabled() {
+		cert, err := s.frontendCertProvider.FetchServerCertificate()
+		if err != nil {
+			return nil, err
+		}
+		roots, err := s.workerCertProvider.FetchServerRootCAsForClient(true)
+		if err != nil {
+			return nil, err
+		}
+		if err := collector.add(CertRoleFrontendServer, cert, roots); err != nil {
+			return nil, err
+		}
+	}
+
+	return collector.expirations, nil
+}
+
+func (c *certExpirationCollector) add(role string, cert *tls.Certificate, roots *x509.CertPool) error {
+	if cert == nil || len(cert.Certificate) == 0 {
+		return nil
+	}
+	chain := make([]*x509.Certificate, len(cert.Certificate))
+	for i, raw := range cert.Certificate {
+		parsed, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("unable to parse %v certificate: %w", role, err)
+		}
+		chain[i] = parsed
+	}
+	leaf := chain[0]
+	c.expirations = append(c.expirations, CertExpiration{Role: role, Subject: leaf.Subject.String(), NotAfter: leaf.NotAfter})
+
+	cas := chain[1:]
+	if roots != nil {
+		intermediates := x509.NewCertPool()
+		for _, ca := range cas {
+			intermediates.AddCert(ca)
+		}
+		// an expired chain doesn't verify, its leaf is reported nevertheless
+		verifiedChains, _ := leaf.Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		})
+		for _, verifiedChain := range verifiedChains {
+			cas = append(cas, verifiedChain[1:]...)
+		}
+	}
+	for _, ca := range cas {
+		if _, ok := c.seenCAs[string(ca.Raw)]; ok {
+			continue
+		}
+		c.seenCAs[string(ca.Raw)] = struct{}{}
+		c.expirations = append(c.expirations, CertExpiration{Role: CertRoleCA, Subject: ca.Subject.String(), NotAfter: ca.NotAfter})
+	}
+	return nil
+}
+
+// ExpiringCerts returns the expirations within window from now, the soonest first.
+func ExpiringCerts(expirations []CertExpiration, now time.Time, window time.Duration) []CertExpiration {
+	var expiring []CertExpiration
+	for _, expiration := range expirations {
+		if expiration.NotAfter.Before(now.Add(window)) {
+			expiring = append(expiring, expiration)
+		}
+	}
+	sort.Slice(expiring, func(i, j int) bool {
+		return expiring[i].NotAfter.Before(expiring[j].NotAfter)
+	})
+	return expiring
+}
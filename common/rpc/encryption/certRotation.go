@@ -0,0 +1,203 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	// TLSGroupInternode is the TLS group of connections between Temporal services
+	TLSGroupInternode = "internode"
+	// TLSGroupFrontend is the TLS group of connections of external clients and system workers to frontend
+	TLSGroupFrontend = "frontend"
+
+	handshakeProbeTimeout = 5 * time.Second
+)
+
+type (
+	// CertRotator is implemented by TLS config providers which are able to reload certificates
+	// from their configured files at runtime without restarting the host.
+	CertRotator interface {
+		// RotateCertificate reloads the certificate of the TLS group and swaps it in for new connections.
+		// Handshakes with the peers, given by address, must succeed with both the current and the new certificate,
+		// so that hosts which did not rotate yet keep connecting during the transition.
+		// It returns false if the certificate didn't change.
+		RotateCertificate(group string, peers []string) (bool, error)
+	}
+
+	// CertRotatorProvider is implemented by RPC factories which expose the cert rotator of their TLS config provider
+	CertRotatorProvider interface {
+		// GetCertRotator returns nil if TLS config provider is not able to rotate certificates
+		GetCertRotator() CertRotator
+	}
+)
+
+var _ CertRotator = (*localStoreTlsProvider)(nil)
+
+func (s *localStoreTlsProvider) RotateCertificate(group string, peers []string) (bool, error) {
+	var certProvider CertProvider
+	var clientProvider ClientCertProvider
+	var isWorker bool
+	switch group {
+	case TLSGroupInternode:
+		certProvider, clientProvider, isWorker = s.internodeCertProvider, s.internodeClientCertProvider, false
+	case TLSGroupFrontend:
+		certProvider, clientProvider, isWorker = s.frontendCertProvider, s.workerCertProvider, true
+	default:
+		return false, fmt.Errorf("unknown TLS group %q", group)
+	}
+
+	localProvider, ok := certProvider.(*localStoreCertProvider)
+	if !ok {
+		// cert provider plugins refresh certificates from their secret stores themselves
+		return false, fmt.Errorf("certificate of TLS group %q is rotated by its cert provider", group)
+	}
+	if !localProvider.IsEnabled() {
+		return false, fmt.Errorf("TLS is not enabled for TLS group %q", group)
+	}
+
+	cert, err := localProvider.reloadServerCertificate()
+	if err != nil {
+		return false, err
+	}
+	current, err := localProvider.FetchServerCertificate()
+	if err != nil {
+		return false, fmt.Errorf("loading current certificate failed: %v", err)
+	}
+	if isSameCertificate(current, cert) {
+		return false, nil
+	}
+
+	if err := verifyServerCertificateChain(cert, clientProvider, isWorker); err != nil {
+		return false, fmt.Errorf("new certificate is not trusted by peers: %v", err)
+	}
+	requireClientAuth := localProvider.GetSettings().Server.RequireClientAuth
+	for _, peer := range peers {
+		if group == TLSGroupFrontend || !requireClientAuth {
+			// certificate presented to frontend peers is the one of system worker, which doesn't rotate
+			if err := verifyPeerHandshake(peer, nil, localProvider, clientProvider, isWorker); err != nil {
+				return false, fmt.Errorf("handshake with %v failed: %v", peer, err)
+			}
+			continue
+		}
+		if err := verifyPeerHandshake(peer, current, localProvider, clientProvider, isWorker); err != nil {
+			return false, fmt.Errorf("handshake with %v with current certificate failed: %v", peer, err)
+		}
+		if err := verifyPeerHandshake(peer, cert, localProvider, clientProvider, isWorker); err != nil {
+			return false, fmt.Errorf("handshake with %v with new certificate failed: %v", peer, err)
+		}
+	}
+
+	localProvider.setCertificate(cert)
+	// legacy system worker config presents the internode certificate to frontend
+	if workerProvider, ok := s.workerCertProvider.(*localStoreCertProvider); ok &&
+		group == TLSGroupInternode && workerProvider.isLegacyWorkerConfig {
+		workerProvider.setCertificate(cert)
+	}
+	return true, nil
+}
+
+func isSameCertificate(a *tls.Certificate, b *tls.Certificate) bool {
+	if a == nil || b == nil || len(a.Certificate) == 0 || len(b.Certificate) == 0 {
+		return false
+	}
+	return bytes.Equal(a.Certificate[0], b.Certificate[0])
+}
+
+// verifyServerCertificateChain verifies the certificate chains to the root CAs clients of the group trust
+func verifyServerCertificateChain(cert *tls.Certificate, clientProvider ClientCertProvider, isWorker bool) error {
+	if clientProvider.DisableHostVerification(isWorker) {
+		return nil
+	}
+	if len(cert.Certificate) == 0 {
+		return errors.New("certificate is empty")
+	}
+	roots, err := clientProvider.FetchServerRootCAsForClient(isWorker)
+	if err != nil {
+		return fmt.Errorf("failed to load client ca: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return err
+	}
+	intermediates := x509.NewCertPool()
+	for _, der := range cert.Certificate[1:] {
+		intermediate, err := x509.ParseCertificate(der)
+		if err != nil {
+			return err
+		}
+		intermediates.AddCert(intermediate)
+	}
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	return err
+}
+
+// verifyPeerHandshake runs a handshake with the peer configured the same way as the clients of the TLS group,
+// presenting cert as client certificate if it is given
+func verifyPeerHandshake(
+	address string,
+	cert *tls.Certificate,
+	certProvider CertProvider,
+	clientProvider ClientCertProvider,
+	isWorker bool,
+) error {
+	requireClientAuth := certProvider.GetSettings().Server.RequireClientAuth
+	clientConfig, err := newClientTLSConfig(clientProvider, certProvider.GetSettings(), requireClientAuth, isWorker)
+	if err != nil {
+		return err
+	}
+	if cert != nil {
+		clientConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return cert, nil
+		}
+	}
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: handshakeProbeTimeout}, "tcp", address, clientConfig)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// with TLS 1.3 the server verifies client certificate after the client completes the handshake,
+	// rejection is only observed on read
+	_ = conn.SetReadDeadline(time.Now().Add(handshakeProbeTimeout))
+	if _, err := conn.Read(make([]byte, 1)); err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
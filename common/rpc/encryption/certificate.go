@@ -25,6 +25,7 @@
 package encryption
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/tls"
@@ -35,6 +36,8 @@ import (
 	"net"
 	"strings"
 	"time"
+
+	"go.temporal.io/server/common/service/config"
 )
 
 // GenerateSelfSignedUseEverywhereX509 generates a TLS serverCert that is self-signed
@@ -44,6 +47,16 @@ func GenerateSelfSignedUseEverywhereX509(commonName string, keyLengthBits int) (
 
 // GenerateSelfSignedX509CA generates a TLS serverCert that is self-signed
 func GenerateSelfSignedX509CA(commonName string, extUsage []x509.ExtKeyUsage, keyLengthBits int) (*tls.Certificate, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, keyLengthBits)
+	if err != nil {
+		return &tls.Certificate{}, err
+	}
+
+	return GenerateSelfSignedX509CAWithKey(commonName, extUsage, privateKey)
+}
+
+// GenerateSelfSignedX509CAWithKey generates a TLS serverCert that is self-signed with the RSA or ECDSA private key
+func GenerateSelfSignedX509CAWithKey(commonName string, extUsage []x509.ExtKeyUsage, privateKey crypto.Signer) (*tls.Certificate, error) {
 	now := time.Now().UTC()
 
 	template := &x509.Certificate{
@@ -58,27 +71,10 @@ func GenerateSelfSignedX509CA(commonName string, extUsage []x509.ExtKeyUsage, ke
 		BasicConstraintsValid: true,
 		IsCA:                  true,
 		ExtKeyUsage:           extUsage,
-		KeyUsage: x509.KeyUsageCertSign | x509.KeyUsageKeyEncipherment |
-			x509.KeyUsageDigitalSignature,
+		KeyUsage:              x509.KeyUsageCertSign | keyUsage(privateKey),
 	}
 
-	if ip := net.ParseIP(commonName).To4(); ip != nil {
-		template.IPAddresses = []net.IP{ip}
-
-		if ip.IsLoopback() {
-			template.DNSNames = []string{"localhost"}
-		}
-	}
-
-	if strings.ToLower(commonName) == "localhost" {
-		template.IPAddresses = []net.IP{net.IPv6loopback, net.IPv4(127, 0, 0, 1)}
-		template.DNSNames = []string{"localhost"}
-	}
-
-	privateKey, err := rsa.GenerateKey(rand.Reader, keyLengthBits)
-	if err != nil {
-		return &tls.Certificate{}, err
-	}
+	setSubjectAlternativeNames(template, commonName)
 
 	cert, err := x509.CreateCertificate(rand.Reader, template, template, privateKey.Public(), privateKey)
 	if err != nil {
@@ -94,6 +90,18 @@ func GenerateSelfSignedX509CA(commonName string, extUsage []x509.ExtKeyUsage, ke
 
 // GenerateServerX509UsingCA generates a TLS serverCert that is self-signed
 func GenerateServerX509UsingCA(commonName string, ca *tls.Certificate) (*tls.Certificate, *rsa.PrivateKey, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 4096)
+	if err != nil {
+		return &tls.Certificate{}, nil, err
+	}
+
+	tlsCert, err := GenerateServerX509UsingCAWithKey(commonName, ca, privateKey)
+	return tlsCert, privateKey, err
+}
+
+// GenerateServerX509UsingCAWithKey generates a TLS serverCert for the RSA or ECDSA private key signed by the CA,
+// the CA can use either RSA or ECDSA key as well
+func GenerateServerX509UsingCAWithKey(commonName string, ca *tls.Certificate, privateKey crypto.Signer) (*tls.Certificate, error) {
 	now := time.Now().UTC()
 
 	i := mathrand.Int63n(100000000000000000)
@@ -111,37 +119,47 @@ func GenerateServerX509UsingCA(commonName string, ca *tls.Certificate) (*tls.Cer
 		KeyUsage:              x509.KeyUsageDigitalSignature,
 	}
 
-	if ip := net.ParseIP(commonName).To4(); ip != nil {
-		template.IPAddresses = []net.IP{ip}
-
-		if ip.IsLoopback() {
-			template.DNSNames = []string{"localhost"}
-		}
-	}
-
-	if strings.ToLower(commonName) == "localhost" {
-		template.IPAddresses = []net.IP{net.IPv6loopback, net.IPv4(127, 0, 0, 1)}
-		template.DNSNames = []string{"localhost"}
-	}
-
-	privateKey, err := rsa.GenerateKey(rand.Reader, 4096)
-	if err != nil {
-		return &tls.Certificate{}, nil, err
-	}
+	setSubjectAlternativeNames(template, commonName)
 
 	caCert, err := x509.ParseCertificate(ca.Certificate[0])
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	cert, err := x509.CreateCertificate(rand.Reader, template, caCert, privateKey.Public(), ca.PrivateKey)
 	if err != nil {
-		return &tls.Certificate{}, nil, err
+		return &tls.Certificate{}, err
 	}
 
 	var tlsCert tls.Certificate
 	tlsCert.Certificate = append(tlsCert.Certificate, cert)
 	tlsCert.PrivateKey = privateKey
 
-	return &tlsCert, privateKey, err
+	return &tlsCert, nil
+}
+
+func setSubjectAlternativeNames(template *x509.Certificate, commonName string) {
+	if ip := config.ParseIP(commonName); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+
+		if ip.IsLoopback() {
+			template.DNSNames = []string{"localhost"}
+		}
+	}
+
+	if strings.ToLower(commonName) == "localhost" {
+		template.IPAddresses = []net.IP{net.IPv6loopback, net.IPv4(127, 0, 0, 1)}
+		template.DNSNames = []string{"localhost"}
+	} else if len(template.IPAddresses) == 0 && commonName != "" {
+		// host names are verified against SANs only
+		template.DNSNames = []string{commonName}
+	}
+}
+
+// keyUsage returns usage of the private key besides signing certificates, key encipherment applies to RSA keys only
+func keyUsage(privateKey crypto.Signer) x509.KeyUsage {
+	if _, ok := privateKey.(*rsa.PrivateKey); ok {
+		return x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature
+	}
+	return x509.KeyUsageDigitalSignature
 }
@@ -0,0 +1,131 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+var errClientCertificateNotAllowed = errors.New("client certificate is not allowed")
+
+type (
+	// clientCertAllowlist rejects client certificates whose subject DN and subject alternative names
+	// are all missing from the allowlist of the server
+	clientCertAllowlist struct {
+		dns  map[string]struct{}
+		sans map[string]struct{}
+	}
+)
+
+// newClientCertAllowlist returns nil if the server settings allow clients with any certificate
+func newClientCertAllowlist(settings *config.ServerTLS) *clientCertAllowlist {
+	if len(settings.AllowedClientDNs) == 0 && len(settings.AllowedClientSANs) == 0 {
+		return nil
+	}
+
+	allowlist := &clientCertAllowlist{
+		dns:  make(map[string]struct{}, len(settings.AllowedClientDNs)),
+		sans: make(map[string]struct{}, len(settings.AllowedClientSANs)),
+	}
+	for _, dn := range settings.AllowedClientDNs {
+		allowlist.dns[dn] = struct{}{}
+	}
+	for _, san := range settings.AllowedClientSANs {
+		allowlist.sans[san] = struct{}{}
+	}
+	return allowlist
+}
+
+// VerifyPeerCertificate is called after the client certificate chains have been verified,
+// it rejects the handshake unless the client certificate is allowed
+func (a *clientCertAllowlist) VerifyPeerCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	var cert *x509.Certificate
+	if len(verifiedChains) > 0 && len(verifiedChains[0]) > 0 {
+		cert = verifiedChains[0][0]
+	} else if len(rawCerts) > 0 {
+		// chains of cert providers verifying client certificates themselves are not passed
+		parsedCert, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return err
+		}
+		cert = parsedCert
+	} else {
+		return fmt.Errorf("%w: no certificate presented", errClientCertificateNotAllowed)
+	}
+
+	if a.isAllowed(cert) {
+		return nil
+	}
+	return fmt.Errorf("%w: subject %q", errClientCertificateNotAllowed, cert.Subject.String())
+}
+
+func (a *clientCertAllowlist) isAllowed(cert *x509.Certificate) bool {
+	if _, ok := a.dns[cert.Subject.String()]; ok {
+		return true
+	}
+	if len(a.sans) == 0 {
+		return false
+	}
+
+	for _, name := range cert.DNSNames {
+		if _, ok := a.sans[name]; ok {
+			return true
+		}
+	}
+	for _, address := range cert.IPAddresses {
+		if _, ok := a.sans[address.String()]; ok {
+			return true
+		}
+	}
+	for _, email := range cert.EmailAddresses {
+		if _, ok := a.sans[email]; ok {
+			return true
+		}
+	}
+	for _, uri := range cert.URIs {
+		if _, ok := a.sans[uri.String()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// chainPeerCertificateVerifiers returns a function running the verifiers in order until one fails
+func chainPeerCertificateVerifiers(
+	verifiers ...func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error,
+) func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+		for _, verify := range verifiers {
+			if err := verify(rawCerts, verifiedChains); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
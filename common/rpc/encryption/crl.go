@@ -0,0 +1,213 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRevocationListRefreshInterval = time.Hour
+	revocationListFetchTimeout           = 10 * time.Second
+)
+
+var errCertificateRevoked = errors.New("certificate has been revoked")
+
+type (
+	// revocationChecker rejects peer certificates revoked by a certificate revocation list,
+	// the list is reloaded from its source once the refresh interval has passed
+	revocationChecker struct {
+		sync.RWMutex
+
+		source          string
+		refreshInterval time.Duration
+
+		lists        []*revocationList
+		loadedAt     time.Time
+		isRefreshing bool
+	}
+
+	revocationList struct {
+		crl     *pkix.CertificateList
+		issuer  string
+		revoked map[string]struct{}
+	}
+)
+
+// newRevocationChecker loads the certificate revocation list from source, which is either
+// a file path or a http(s) URL
+func newRevocationChecker(source string, refreshInterval time.Duration) (*revocationChecker, error) {
+	if refreshInterval <= 0 {
+		refreshInterval = defaultRevocationListRefreshInterval
+	}
+	c := &revocationChecker{
+		source:          source,
+		refreshInterval: refreshInterval,
+	}
+
+	lists, err := loadRevocationLists(source)
+	if err != nil {
+		return nil, err
+	}
+	c.lists = lists
+	c.loadedAt = time.Now()
+	return c, nil
+}
+
+// VerifyPeerCertificate is called after the peer certificate chains have been verified,
+// it rejects the handshake if any certificate of the chains has been revoked by its issuer
+func (c *revocationChecker) VerifyPeerCertificate(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+	lists := c.getLists()
+	for _, chain := range verifiedChains {
+		// the last certificate of the chain is the trusted root
+		for i := 0; i < len(chain)-1; i++ {
+			if isRevoked(lists, chain[i], chain[i+1]) {
+				return fmt.Errorf("%w: serial number %v, subject %q", errCertificateRevoked, chain[i].SerialNumber, chain[i].Subject.String())
+			}
+		}
+	}
+	return nil
+}
+
+func (c *revocationChecker) getLists() []*revocationList {
+	c.RLock()
+	lists := c.lists
+	isStale := !c.isRefreshing && time.Since(c.loadedAt) >= c.refreshInterval
+	c.RUnlock()
+
+	if isStale {
+		c.Lock()
+		// check again, other handshake might have started the refresh while waiting for the lock
+		if !c.isRefreshing && time.Since(c.loadedAt) >= c.refreshInterval {
+			c.isRefreshing = true
+			go c.refresh()
+		}
+		c.Unlock()
+	}
+	return lists
+}
+
+// refresh reloads the lists in the background, so that handshakes are not blocked on fetching them.
+// Previously loaded lists are kept if loading fails, it is retried after the refresh interval.
+func (c *revocationChecker) refresh() {
+	lists, err := loadRevocationLists(c.source)
+
+	c.Lock()
+	defer c.Unlock()
+	if err == nil {
+		c.lists = lists
+	}
+	c.loadedAt = time.Now()
+	c.isRefreshing = false
+}
+
+func isRevoked(lists []*revocationList, cert *x509.Certificate, issuer *x509.Certificate) bool {
+	for _, list := range lists {
+		if list.issuer != issuer.Subject.String() {
+			continue
+		}
+		// lists not signed by the issuer of the certificate are ignored
+		if err := issuer.CheckCRLSignature(list.crl); err != nil { //nolint:staticcheck
+			continue
+		}
+		if _, ok := list.revoked[cert.SerialNumber.String()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func loadRevocationLists(source string) ([]*revocationList, error) {
+	data, err := fetchRevocationLists(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate revocation list %q: %w", source, err)
+	}
+
+	var ders [][]byte
+	if bytes.Contains(data, []byte("-----BEGIN")) {
+		for {
+			var block *pem.Block
+			block, data = pem.Decode(data)
+			if block == nil {
+				break
+			}
+			if block.Type == "X509 CRL" {
+				ders = append(ders, block.Bytes)
+			}
+		}
+		if len(ders) == 0 {
+			return nil, fmt.Errorf("certificate revocation list %q contains no X509 CRL PEM block", source)
+		}
+	} else {
+		ders = append(ders, data)
+	}
+
+	lists := make([]*revocationList, 0, len(ders))
+	for _, der := range ders {
+		crl, err := x509.ParseDERCRL(der) //nolint:staticcheck
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate revocation list %q: %w", source, err)
+		}
+		var issuer pkix.Name
+		issuer.FillFromRDNSequence(&crl.TBSCertList.Issuer)
+		list := &revocationList{
+			crl:     crl,
+			issuer:  issuer.String(),
+			revoked: make(map[string]struct{}, len(crl.TBSCertList.RevokedCertificates)),
+		}
+		for _, revoked := range crl.TBSCertList.RevokedCertificates {
+			list.revoked[revoked.SerialNumber.String()] = struct{}{}
+		}
+		lists = append(lists, list)
+	}
+	return lists, nil
+}
+
+func fetchRevocationLists(source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return ioutil.ReadFile(source)
+	}
+
+	client := http.Client{Timeout: revocationListFetchTimeout}
+	resp, err := client.Get(source)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %q", resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
@@ -0,0 +1,199 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+// DevModeCertProviderPluginName is the name of the built-in cert provider plugin which generates
+// an in-memory CA and certificates of all roles at startup, configured by RootTLS.DevMode.
+const DevModeCertProviderPluginName = "devMode"
+
+const (
+	devModeHostName       = "localhost"
+	devModeCACommonName   = "Temporal Development CA"
+	devModeCAKeyBits      = 2048
+	devModeCACertFile     = "ca.pem"
+	devModeClientCertFile = "client.pem"
+	devModeClientKeyFile  = "client.key"
+)
+
+var _ CertProvider = (*devModeCertProvider)(nil)
+var _ ClientCertProvider = (*devModeCertProvider)(nil)
+
+type (
+	devModeCertProviderPlugin struct{}
+
+	// devModeCertProvider presents a generated certificate and trusts the generated CA only. Group settings
+	// and revocation checks are handled by the local store, client auth is always required.
+	devModeCertProvider struct {
+		*localStoreCertProvider
+
+		cert   *tls.Certificate
+		caPool *x509.CertPool
+	}
+)
+
+func (p *devModeCertProviderPlugin) CreateCertProviders(settings *config.RootTLS) (*CertProviders, error) {
+	hostName := settings.DevMode.HostName
+	if hostName == "" {
+		hostName = devModeHostName
+	}
+
+	ca, err := GenerateSelfSignedX509CA(devModeCACommonName, nil, devModeCAKeyBits)
+	if err != nil {
+		return nil, fmt.Errorf("generating CA failed: %w", err)
+	}
+	caCert, err := x509.ParseCertificate(ca.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	caPool := x509.NewCertPool()
+	caPool.AddCert(caCert)
+
+	internodeCert, err := generateDevModeCertificate(hostName, ca)
+	if err != nil {
+		return nil, fmt.Errorf("generating internode certificate failed: %w", err)
+	}
+	frontendCert, err := generateDevModeCertificate(hostName, ca)
+	if err != nil {
+		return nil, fmt.Errorf("generating frontend certificate failed: %w", err)
+	}
+	workerCert, err := generateDevModeCertificate(hostName, ca)
+	if err != nil {
+		return nil, fmt.Errorf("generating system worker certificate failed: %w", err)
+	}
+
+	if settings.DevMode.OutputDir != "" {
+		clientCert, err := generateDevModeCertificate(hostName, ca)
+		if err != nil {
+			return nil, fmt.Errorf("generating client certificate failed: %w", err)
+		}
+		if err := writeDevModeCertificates(settings.DevMode.OutputDir, ca, clientCert); err != nil {
+			return nil, fmt.Errorf("writing certificates to %q failed: %w", settings.DevMode.OutputDir, err)
+		}
+	}
+
+	internodeSettings := devModeGroupSettings(&settings.Internode)
+	frontendSettings := devModeGroupSettings(&settings.Frontend)
+	internodeProvider := &devModeCertProvider{
+		localStoreCertProvider: &localStoreCertProvider{tlsSettings: internodeSettings},
+		cert:                   internodeCert,
+		caPool:                 caPool,
+	}
+	return &CertProviders{
+		Internode:       internodeProvider,
+		InternodeClient: internodeProvider,
+		Frontend: &devModeCertProvider{
+			localStoreCertProvider: &localStoreCertProvider{tlsSettings: frontendSettings},
+			cert:                   frontendCert,
+			caPool:                 caPool,
+		},
+		// system workers use frontend client settings, as with the implicit system worker config of the local store
+		SystemWorker: &devModeCertProvider{
+			localStoreCertProvider: &localStoreCertProvider{tlsSettings: internodeSettings, legacyWorkerSettings: &frontendSettings.Client},
+			cert:                   workerCert,
+			caPool:                 caPool,
+		},
+	}, nil
+}
+
+// devModeGroupSettings returns copy of the group settings requiring client auth, certificate sources are ignored
+func devModeGroupSettings(groupSettings *config.GroupTLS) *config.GroupTLS {
+	settings := *groupSettings
+	settings.Server.RequireClientAuth = true
+	return &settings
+}
+
+func generateDevModeCertificate(hostName string, ca *tls.Certificate) (*tls.Certificate, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := GenerateServerX509UsingCAWithKey(hostName, ca, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+// writeDevModeCertificates writes the CA certificate and the client certificate with its key as PEM files
+func writeDevModeCertificates(dir string, ca *tls.Certificate, clientCert *tls.Certificate) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(clientCert.PrivateKey)
+	if err != nil {
+		return err
+	}
+
+	files := map[string]*pem.Block{
+		devModeCACertFile:     {Type: "CERTIFICATE", Bytes: ca.Certificate[0]},
+		devModeClientCertFile: {Type: "CERTIFICATE", Bytes: clientCert.Certificate[0]},
+		devModeClientKeyFile:  {Type: "PRIVATE KEY", Bytes: keyBytes},
+	}
+	for name, block := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), pem.EncodeToMemory(block), 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *devModeCertProvider) IsEnabled() bool {
+	return true
+}
+
+func (p *devModeCertProvider) FetchServerCertificate() (*tls.Certificate, error) {
+	return p.cert, nil
+}
+
+func (p *devModeCertProvider) FetchClientCertificate(bool) (*tls.Certificate, error) {
+	return p.cert, nil
+}
+
+func (p *devModeCertProvider) FetchClientCAs() (*x509.CertPool, error) {
+	return p.caPool, nil
+}
+
+func (p *devModeCertProvider) FetchServerRootCAsForClient(bool) (*x509.CertPool, error) {
+	return p.caPool, nil
+}
@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+func TestDevModeCertProvider(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "dev-mode-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	provider, err := NewTLSConfigProviderFromConfig(config.RootTLS{
+		DevMode: config.DevModeTLS{AutoGenerate: true, OutputDir: outputDir},
+	})
+	require.NoError(t, err)
+
+	internodeServerConfig, err := provider.GetInternodeServerConfig()
+	require.NoError(t, err)
+	require.NotNil(t, internodeServerConfig)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, internodeServerConfig.ClientAuth)
+	internodeClientConfig, err := provider.GetInternodeClientConfig()
+	require.NoError(t, err)
+	assert.NoError(t, devModeHandshake(internodeServerConfig, internodeClientConfig))
+
+	frontendServerConfig, err := provider.GetFrontendServerConfig()
+	require.NoError(t, err)
+	workerClientConfig, err := provider.GetFrontendClientConfig()
+	require.NoError(t, err)
+	assert.NoError(t, devModeHandshake(frontendServerConfig, workerClientConfig))
+
+	// SDK clients connect with the written CA and client certificate
+	clientCert, err := tls.LoadX509KeyPair(filepath.Join(outputDir, devModeClientCertFile), filepath.Join(outputDir, devModeClientKeyFile))
+	require.NoError(t, err)
+	caBytes, err := ioutil.ReadFile(filepath.Join(outputDir, devModeCACertFile))
+	require.NoError(t, err)
+	caPool := x509.NewCertPool()
+	require.True(t, caPool.AppendCertsFromPEM(caBytes))
+	sdkClientConfig := &tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      caPool,
+		ServerName:   devModeHostName,
+	}
+	assert.NoError(t, devModeHandshake(frontendServerConfig, sdkClientConfig))
+
+	// clients without certificate are rejected
+	assert.Error(t, devModeHandshake(frontendServerConfig, &tls.Config{RootCAs: caPool, ServerName: devModeHostName}))
+}
+
+func TestDevModeCertProvider_HostName(t *testing.T) {
+	certProviders, err := (&devModeCertProviderPlugin{}).CreateCertProviders(&config.RootTLS{
+		DevMode: config.DevModeTLS{AutoGenerate: true, HostName: "temporal.dev.local"},
+	})
+	require.NoError(t, err)
+	cert, err := certProviders.Frontend.FetchServerCertificate()
+	require.NoError(t, err)
+	assert.NoError(t, cert.Leaf.VerifyHostname("temporal.dev.local"))
+}
+
+func TestDevModeCertProvider_ConflictingPlugin(t *testing.T) {
+	_, err := NewTLSConfigProviderFromConfig(config.RootTLS{
+		CertProvider: VaultCertProviderPluginName,
+		DevMode:      config.DevModeTLS{AutoGenerate: true},
+	})
+	assert.Error(t, err)
+}
+
+// devModeHandshake runs TLS handshake of the client and server configs over an in-memory connection
+func devModeHandshake(serverConfig *tls.Config, clientConfig *tls.Config) error {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	clientConfig = clientConfig.Clone()
+	if clientConfig.ServerName == "" {
+		clientConfig.ServerName = devModeHostName
+	}
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- tls.Server(serverConn, serverConfig).Handshake()
+		serverConn.Close()
+	}()
+	clientErr := tls.Client(clientConn, clientConfig).Handshake()
+	clientConn.Close()
+	if err := <-serverErr; err != nil {
+		return err
+	}
+	return clientErr
+}
@@ -0,0 +1,296 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+// KubernetesCertProviderPluginName is the name of the built-in cert provider plugin which loads
+// certificates from mounted Kubernetes TLS secrets, configured by RootTLS.Kubernetes.
+const KubernetesCertProviderPluginName = "kubernetes"
+
+const (
+	kubernetesSecretRefreshInterval = 30 * time.Second
+
+	kubernetesSecretCertFile = "tls.crt"
+	kubernetesSecretKeyFile  = "tls.key"
+	kubernetesSecretCAFile   = "ca.crt"
+	// kubernetesSecretDataDir is the symlink the kubelet atomically swaps to the directory of an updated secret
+	kubernetesSecretDataDir = "..data"
+)
+
+var _ CertProvider = (*kubernetesCertProvider)(nil)
+var _ ClientCertProvider = (*kubernetesCertProvider)(nil)
+var _ ClientVerifyingCertProvider = (*kubernetesCertProvider)(nil)
+var _ ServerVerifyingClientCertProvider = (*kubernetesCertProvider)(nil)
+
+type (
+	kubernetesCertProviderPlugin struct{}
+
+	// kubernetesCertProvider presents the certificate of a mounted secret and trusts its CA. Handshakes
+	// check the secret for updates once per refresh interval and reload it after the kubelet swapped it in,
+	// group settings, revocation checks and explicitly configured CAs are handled by the local store.
+	kubernetesCertProvider struct {
+		sync.RWMutex
+		*localStoreCertProvider
+
+		secretDir       string
+		refreshInterval time.Duration
+
+		cert    *tls.Certificate
+		caPool  *x509.CertPool
+		version string
+		checkAt time.Time
+	}
+
+	// kubernetesPeerVerifier verifies certificate chains of peers against the CAs fetched at the time of the
+	// handshake, CAs of TLS configs are fixed once they are created and would miss a rotated CA of the secret
+	kubernetesPeerVerifier struct {
+		fetchCAs func() (*x509.CertPool, error)
+		keyUsage x509.ExtKeyUsage
+	}
+)
+
+func (p *kubernetesCertProviderPlugin) CreateCertProviders(settings *config.RootTLS) (*CertProviders, error) {
+	k8sSettings := &settings.Kubernetes
+	if k8sSettings.InternodeSecretDir == "" && k8sSettings.FrontendSecretDir == "" && k8sSettings.SystemWorkerSecretDir == "" {
+		return nil, errors.New("secret directory of internode, frontend or system worker certificate is required")
+	}
+
+	// groups without secret are loaded from the local store
+	certProviders := newLocalStoreCertProviders(settings)
+	if k8sSettings.InternodeSecretDir != "" {
+		internodeProvider := newKubernetesCertProvider(k8sSettings.InternodeSecretDir, k8sSettings.RefreshInterval,
+			&localStoreCertProvider{tlsSettings: &settings.Internode})
+		certProviders.Internode = internodeProvider
+		certProviders.InternodeClient = internodeProvider
+		if k8sSettings.SystemWorkerSecretDir == "" && settings.SystemWorker.CertFile == "" && settings.SystemWorker.CertData == "" {
+			// system workers present the internode certificate and use frontend client settings
+			internodeProvider.legacyWorkerSettings = &settings.Frontend.Client
+			certProviders.SystemWorker = internodeProvider
+		}
+	}
+	if k8sSettings.FrontendSecretDir != "" {
+		certProviders.Frontend = newKubernetesCertProvider(k8sSettings.FrontendSecretDir, k8sSettings.RefreshInterval,
+			&localStoreCertProvider{tlsSettings: &settings.Frontend})
+	}
+	if k8sSettings.SystemWorkerSecretDir != "" {
+		certProviders.SystemWorker = newKubernetesCertProvider(k8sSettings.SystemWorkerSecretDir, k8sSettings.RefreshInterval,
+			&localStoreCertProvider{workerTLSSettings: &settings.SystemWorker})
+	}
+	return certProviders, nil
+}
+
+func newKubernetesCertProvider(
+	secretDir string,
+	refreshInterval time.Duration,
+	localStore *localStoreCertProvider,
+) *kubernetesCertProvider {
+	if refreshInterval <= 0 {
+		refreshInterval = kubernetesSecretRefreshInterval
+	}
+	return &kubernetesCertProvider{
+		localStoreCertProvider: localStore,
+		secretDir:              secretDir,
+		refreshInterval:        refreshInterval,
+	}
+}
+
+func (p *kubernetesCertProvider) IsEnabled() bool {
+	return true
+}
+
+func (p *kubernetesCertProvider) FetchServerCertificate() (*tls.Certificate, error) {
+	cert, _, err := p.fetchSecret()
+	return cert, err
+}
+
+func (p *kubernetesCertProvider) FetchClientCertificate(bool) (*tls.Certificate, error) {
+	cert, _, err := p.fetchSecret()
+	return cert, err
+}
+
+func (p *kubernetesCertProvider) FetchClientCAs() (*x509.CertPool, error) {
+	serverSettings := &p.tlsSettings.Server
+	if len(serverSettings.ClientCAFiles) != 0 || len(serverSettings.ClientCAData) != 0 {
+		return p.localStoreCertProvider.FetchClientCAs()
+	}
+	_, caPool, err := p.fetchSecret()
+	return caPool, err
+}
+
+func (p *kubernetesCertProvider) FetchServerRootCAsForClient(isWorker bool) (*x509.CertPool, error) {
+	clientSettings := p.getClientTLSSettings(isWorker)
+	if len(clientSettings.RootCAFiles) != 0 || len(clientSettings.RootCAData) != 0 {
+		return p.localStoreCertProvider.FetchServerRootCAsForClient(isWorker)
+	}
+	_, caPool, err := p.fetchSecret()
+	return caPool, err
+}
+
+// FetchClientVerifier verifies clients against the client CAs of the current secret
+func (p *kubernetesCertProvider) FetchClientVerifier() (PeerCertificateVerifier, error) {
+	return &kubernetesPeerVerifier{
+		fetchCAs: p.FetchClientCAs,
+		keyUsage: x509.ExtKeyUsageClientAuth,
+	}, nil
+}
+
+// FetchServerVerifierForClient verifies servers against the root CAs of the current secret,
+// host names of servers are verified by the TLS config of the client
+func (p *kubernetesCertProvider) FetchServerVerifierForClient(isWorker bool) (PeerCertificateVerifier, error) {
+	return &kubernetesPeerVerifier{
+		fetchCAs: func() (*x509.CertPool, error) {
+			return p.FetchServerRootCAsForClient(isWorker)
+		},
+		keyUsage: x509.ExtKeyUsageServerAuth,
+	}, nil
+}
+
+func (v *kubernetesPeerVerifier) VerifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return errors.New("peer presented no certificate")
+	}
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, rawCert := range rawCerts {
+		cert, err := x509.ParseCertificate(rawCert)
+		if err != nil {
+			return fmt.Errorf("parsing peer certificate failed: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	caPool, err := v.fetchCAs()
+	if err != nil {
+		return err
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         caPool,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{v.keyUsage},
+	}); err != nil {
+		return fmt.Errorf("verifying peer certificate failed: %w", err)
+	}
+	return nil
+}
+
+// fetchSecret returns the certificate and CAs of the secret, reloading them if the secret was updated
+// since the last check. The loaded secret keeps being presented if an update fails to load.
+func (p *kubernetesCertProvider) fetchSecret() (*tls.Certificate, *x509.CertPool, error) {
+	p.RLock()
+	cert, caPool, checkAt := p.cert, p.caPool, p.checkAt
+	p.RUnlock()
+	if cert != nil && time.Now().Before(checkAt) {
+		return cert, caPool, nil
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	// check again, other handshake might have reloaded the secret while waiting for the lock
+	if p.cert != nil && time.Now().Before(p.checkAt) {
+		return p.cert, p.caPool, nil
+	}
+	p.checkAt = time.Now().Add(p.refreshInterval)
+
+	dataDir, version, err := kubernetesSecretVersion(p.secretDir)
+	if err == nil && p.cert != nil && version == p.version {
+		return p.cert, p.caPool, nil
+	}
+	if err == nil {
+		cert, caPool, err = loadKubernetesSecret(dataDir)
+	}
+	if err != nil {
+		if p.cert != nil {
+			return p.cert, p.caPool, nil
+		}
+		return nil, nil, fmt.Errorf("loading kubernetes secret %q failed: %w", p.secretDir, err)
+	}
+
+	p.cert = cert
+	p.caPool = caPool
+	p.version = version
+	return p.cert, p.caPool, nil
+}
+
+// kubernetesSecretVersion returns the directory the files of the secret are read from and its version.
+// Files of secrets mounted by the kubelet are read through the resolved data directory, so that they all
+// come from the same update, which is identified by the directory. Otherwise the files are read from the
+// secret directory and identified by their modification times.
+func kubernetesSecretVersion(secretDir string) (string, string, error) {
+	if dataDir, err := filepath.EvalSymlinks(filepath.Join(secretDir, kubernetesSecretDataDir)); err == nil {
+		return dataDir, dataDir, nil
+	}
+
+	certInfo, err := os.Stat(filepath.Join(secretDir, kubernetesSecretCertFile))
+	if err != nil {
+		return "", "", err
+	}
+	keyInfo, err := os.Stat(filepath.Join(secretDir, kubernetesSecretKeyFile))
+	if err != nil {
+		return "", "", err
+	}
+	return secretDir, fmt.Sprintf("%d/%d", certInfo.ModTime().UnixNano(), keyInfo.ModTime().UnixNano()), nil
+}
+
+// loadKubernetesSecret loads the certificate of the secret and its CAs, which are nil without ca.crt file
+func loadKubernetesSecret(dir string) (*tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(filepath.Join(dir, kubernetesSecretCertFile), filepath.Join(dir, kubernetesSecretKeyFile))
+	if err != nil {
+		return nil, nil, err
+	}
+	cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caBytes, err := ioutil.ReadFile(filepath.Join(dir, kubernetesSecretCAFile))
+	if os.IsNotExist(err) {
+		return &cert, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caBytes) {
+		return nil, nil, fmt.Errorf("unable to parse certs in %v", kubernetesSecretCAFile)
+	}
+	return &cert, caPool, nil
+}
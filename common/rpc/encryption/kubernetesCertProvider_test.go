@@ -0,0 +1,173 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+func TestKubernetesCertProvider_SecretSwap(t *testing.T) {
+	secretDir, err := ioutil.TempDir("", "k8s-secret")
+	require.NoError(t, err)
+	defer os.RemoveAll(secretDir)
+
+	// mount the secret the way the kubelet does
+	writeKubernetesSecretData(t, secretDir, "..2021_01_01", "first")
+	require.NoError(t, os.Symlink("..2021_01_01", filepath.Join(secretDir, "..data")))
+	for _, file := range []string{kubernetesSecretCertFile, kubernetesSecretKeyFile, kubernetesSecretCAFile} {
+		require.NoError(t, os.Symlink(filepath.Join("..data", file), filepath.Join(secretDir, file)))
+	}
+
+	provider := newKubernetesCertProvider(secretDir, time.Nanosecond, &localStoreCertProvider{tlsSettings: &config.GroupTLS{}})
+	cert, err := provider.FetchServerCertificate()
+	require.NoError(t, err)
+	assert.Equal(t, "first", cert.Leaf.Subject.CommonName)
+	caPool, err := provider.FetchClientCAs()
+	require.NoError(t, err)
+	assert.NotNil(t, caPool)
+
+	// swap in an updated secret
+	writeKubernetesSecretData(t, secretDir, "..2021_02_01", "second")
+	require.NoError(t, os.Symlink("..2021_02_01", filepath.Join(secretDir, "..data_tmp")))
+	require.NoError(t, os.Rename(filepath.Join(secretDir, "..data_tmp"), filepath.Join(secretDir, "..data")))
+	cert, err = provider.FetchClientCertificate(false)
+	require.NoError(t, err)
+	assert.Equal(t, "second", cert.Leaf.Subject.CommonName)
+
+	// an invalid update keeps the current certificate
+	dataDir := filepath.Join(secretDir, "..2021_03_01")
+	require.NoError(t, os.Mkdir(dataDir, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dataDir, kubernetesSecretCertFile), []byte("invalid"), 0600))
+	require.NoError(t, os.Symlink("..2021_03_01", filepath.Join(secretDir, "..data_tmp")))
+	require.NoError(t, os.Rename(filepath.Join(secretDir, "..data_tmp"), filepath.Join(secretDir, "..data")))
+	cert, err = provider.FetchServerCertificate()
+	require.NoError(t, err)
+	assert.Equal(t, "second", cert.Leaf.Subject.CommonName)
+}
+
+func TestKubernetesCertProvider_PeerVerifierReloadsCA(t *testing.T) {
+	secretDir, err := ioutil.TempDir("", "k8s-secret")
+	require.NoError(t, err)
+	defer os.RemoveAll(secretDir)
+
+	writeKubernetesSecretData(t, secretDir, "..2021_01_01", "first")
+	require.NoError(t, os.Symlink("..2021_01_01", filepath.Join(secretDir, "..data")))
+	provider := newKubernetesCertProvider(secretDir, time.Nanosecond, &localStoreCertProvider{tlsSettings: &config.GroupTLS{}})
+	firstCert, err := provider.FetchServerCertificate()
+	require.NoError(t, err)
+
+	clientVerifier, err := provider.FetchClientVerifier()
+	require.NoError(t, err)
+	serverVerifier, err := provider.FetchServerVerifierForClient(false)
+	require.NoError(t, err)
+	assert.NoError(t, clientVerifier.VerifyPeerCertificate(firstCert.Certificate, nil))
+	assert.NoError(t, serverVerifier.VerifyPeerCertificate(firstCert.Certificate, nil))
+
+	// verifiers created before the rotation trust the CA of the updated secret only
+	writeKubernetesSecretData(t, secretDir, "..2021_02_01", "second")
+	require.NoError(t, os.Symlink("..2021_02_01", filepath.Join(secretDir, "..data_tmp")))
+	require.NoError(t, os.Rename(filepath.Join(secretDir, "..data_tmp"), filepath.Join(secretDir, "..data")))
+	secondCert, err := provider.FetchServerCertificate()
+	require.NoError(t, err)
+	assert.NoError(t, clientVerifier.VerifyPeerCertificate(secondCert.Certificate, nil))
+	assert.NoError(t, serverVerifier.VerifyPeerCertificate(secondCert.Certificate, nil))
+	assert.Error(t, clientVerifier.VerifyPeerCertificate(firstCert.Certificate, nil))
+	assert.Error(t, serverVerifier.VerifyPeerCertificate(firstCert.Certificate, nil))
+	assert.Error(t, clientVerifier.VerifyPeerCertificate(nil, nil))
+}
+
+func TestKubernetesCertProvider_PlainDirectory(t *testing.T) {
+	secretDir, err := ioutil.TempDir("", "k8s-secret")
+	require.NoError(t, err)
+	defer os.RemoveAll(secretDir)
+	writeKubernetesSecretData(t, secretDir, "", "plain")
+	require.NoError(t, os.Remove(filepath.Join(secretDir, kubernetesSecretCAFile)))
+
+	provider := newKubernetesCertProvider(secretDir, 0, &localStoreCertProvider{tlsSettings: &config.GroupTLS{}})
+	cert, err := provider.FetchServerCertificate()
+	require.NoError(t, err)
+	assert.Equal(t, "plain", cert.Leaf.Subject.CommonName)
+	caPool, err := provider.FetchClientCAs()
+	require.NoError(t, err)
+	assert.Nil(t, caPool)
+
+	_, err = newKubernetesCertProvider(filepath.Join(secretDir, "missing"), 0, &localStoreCertProvider{}).FetchServerCertificate()
+	assert.Error(t, err)
+}
+
+func TestKubernetesCertProviderPlugin(t *testing.T) {
+	_, err := (&kubernetesCertProviderPlugin{}).CreateCertProviders(&config.RootTLS{})
+	assert.Error(t, err)
+
+	settings := &config.RootTLS{Kubernetes: config.KubernetesTLS{InternodeSecretDir: "/etc/temporal/internode"}}
+	certProviders, err := (&kubernetesCertProviderPlugin{}).CreateCertProviders(settings)
+	require.NoError(t, err)
+	assert.IsType(t, &kubernetesCertProvider{}, certProviders.Internode)
+	assert.IsType(t, &kubernetesCertProvider{}, certProviders.SystemWorker)
+	assert.IsType(t, &localStoreCertProvider{}, certProviders.Frontend)
+}
+
+// writeKubernetesSecretData writes a self-signed certificate, its key and itself as CA to a directory of secretDir
+func writeKubernetesSecretData(t *testing.T, secretDir string, dataDir string, commonName string) {
+	dir := filepath.Join(secretDir, dataDir)
+	require.NoError(t, os.MkdirAll(dir, 0700))
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, kubernetesSecretCertFile), certPEM, 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, kubernetesSecretKeyFile), keyPEM, 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, kubernetesSecretCAFile), certPEM, 0600))
+}
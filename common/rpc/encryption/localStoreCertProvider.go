@@ -32,6 +32,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"sync"
+	"time"
 
 	"go.temporal.io/server/common/service/config"
 )
@@ -48,7 +49,17 @@ type localStoreCertProvider struct {
 	serverCert *tls.Certificate
 	clientCert *tls.Certificate
 	clientCAs  *x509.CertPool
-	serverCAs  *x509.CertPool
+	// root CAs of internode clients and of system workers are kept apart, since the same provider
+	// serves both when the worker settings are taken from the frontend client settings
+	serverCAs       *x509.CertPool
+	workerServerCAs *x509.CertPool
+
+	bundleCert *tls.Certificate
+	bundleCAs  *x509.CertPool
+
+	clientRevocationChecker       *revocationChecker
+	serverRevocationChecker       *revocationChecker
+	workerServerRevocationChecker *revocationChecker
 
 	isLegacyWorkerConfig bool
 	legacyWorkerSettings *config.ClientTLS
@@ -58,14 +69,21 @@ func (s *localStoreCertProvider) GetSettings() *config.GroupTLS {
 	return s.tlsSettings
 }
 
+func (s *localStoreCertProvider) IsEnabled() bool {
+	return s.tlsSettings.IsEnabled()
+}
+
 func (s *localStoreCertProvider) FetchServerCertificate() (*tls.Certificate, error) {
-	return s.FetchCertificate(&s.serverCert, s.tlsSettings.Server.CertFile, s.tlsSettings.Server.CertData,
-		s.tlsSettings.Server.KeyFile, s.tlsSettings.Server.KeyData)
+	return s.fetchServerSettingsCertificate(&s.serverCert)
 }
 
 func (s *localStoreCertProvider) FetchClientCAs() (*x509.CertPool, error) {
 	if len(s.tlsSettings.Server.ClientCAFiles) == 0 && len(s.tlsSettings.Server.ClientCAData) == 0 {
-		return nil, nil
+		if s.tlsSettings.Server.CertBundleFile == "" {
+			return nil, nil
+		}
+		_, bundleCAs, err := s.fetchCertBundle()
+		return bundleCAs, err
 	}
 
 	s.RLock()
@@ -111,21 +129,30 @@ func (s *localStoreCertProvider) FetchServerRootCAsForClient(isWorker bool) (*x5
 	rootCAData := clientSettings.RootCAData
 
 	if len(rootCAFiles) == 0 && len(rootCAData) == 0 {
-		return nil, nil
+		if isWorker || s.tlsSettings.Server.CertBundleFile == "" {
+			return nil, nil
+		}
+		_, bundleCAs, err := s.fetchCertBundle()
+		return bundleCAs, err
+	}
+
+	cachedCAs := &s.serverCAs
+	if isWorker {
+		cachedCAs = &s.workerServerCAs
 	}
 
 	s.RLock()
-	if s.serverCAs != nil {
+	if *cachedCAs != nil {
 		defer s.RUnlock()
-		return s.serverCAs, nil
+		return *cachedCAs, nil
 	}
 
 	s.RUnlock()
 	s.Lock()
 	defer s.Unlock()
 
-	if s.serverCAs != nil {
-		return s.serverCAs, nil
+	if *cachedCAs != nil {
+		return *cachedCAs, nil
 	}
 
 	serverCAPoolFromFiles, err := buildCAPoolFromFiles(rootCAFiles)
@@ -143,36 +170,83 @@ func (s *localStoreCertProvider) FetchServerRootCAsForClient(isWorker bool) (*x5
 	}
 
 	if serverCAPoolFromData != nil {
-		s.serverCAs = serverCAPoolFromData
+		*cachedCAs = serverCAPoolFromData
 	} else {
-		s.serverCAs = serverCAPoolFromFiles
+		*cachedCAs = serverCAPoolFromFiles
+	}
+
+	return *cachedCAs, nil
+}
+
+func (s *localStoreCertProvider) FetchClientRevocationChecker() (RevocationChecker, error) {
+	settings := &s.tlsSettings.Server
+	return s.fetchRevocationChecker(&s.clientRevocationChecker,
+		settings.CertificateRevocationList, settings.CertificateRevocationListRefreshInterval)
+}
+
+func (s *localStoreCertProvider) FetchServerRevocationCheckerForClient(isWorker bool) (RevocationChecker, error) {
+	clientSettings := s.getClientTLSSettings(isWorker)
+	cachedChecker := &s.serverRevocationChecker
+	if isWorker {
+		cachedChecker = &s.workerServerRevocationChecker
+	}
+	return s.fetchRevocationChecker(cachedChecker,
+		clientSettings.CertificateRevocationList, clientSettings.CertificateRevocationListRefreshInterval)
+}
+
+func (s *localStoreCertProvider) fetchRevocationChecker(
+	cachedChecker **revocationChecker,
+	source string,
+	refreshInterval time.Duration,
+) (RevocationChecker, error) {
+	if source == "" {
+		return nil, nil
+	}
+
+	s.RLock()
+	if *cachedChecker != nil {
+		defer s.RUnlock()
+		return *cachedChecker, nil
 	}
 
-	return s.serverCAs, nil
+	s.RUnlock()
+	s.Lock()
+	defer s.Unlock()
+
+	if *cachedChecker != nil {
+		return *cachedChecker, nil
+	}
+
+	checker, err := newRevocationChecker(source, refreshInterval)
+	if err != nil {
+		return nil, err
+	}
+	*cachedChecker = checker
+	return *cachedChecker, nil
 }
 
 func (s *localStoreCertProvider) FetchClientCertificate(isWorker bool) (*tls.Certificate, error) {
 	if isWorker {
 		return s.fetchWorkerCertificate()
 	} else {
-		return s.FetchCertificate(&s.clientCert, s.tlsSettings.Server.CertFile, s.tlsSettings.Server.CertData,
-			s.tlsSettings.Server.KeyFile, s.tlsSettings.Server.KeyData)
+		return s.fetchServerSettingsCertificate(&s.clientCert)
 	}
 }
 
 func (s *localStoreCertProvider) fetchWorkerCertificate() (*tls.Certificate, error) {
 	if s.isLegacyWorkerConfig {
-		return s.FetchCertificate(&s.clientCert, s.tlsSettings.Server.CertFile, s.tlsSettings.Server.CertData,
-			s.tlsSettings.Server.KeyFile, s.tlsSettings.Server.KeyData)
+		return s.fetchServerSettingsCertificate(&s.clientCert)
 	} else {
 		return s.FetchCertificate(&s.clientCert, s.workerTLSSettings.CertFile, s.workerTLSSettings.CertData,
-			s.workerTLSSettings.KeyFile, s.workerTLSSettings.KeyData)
+			s.workerTLSSettings.KeyFile, s.workerTLSSettings.KeyData,
+			s.workerTLSSettings.KeyPassword, s.workerTLSSettings.KeyPasswordFile)
 	}
 }
 
 func (s *localStoreCertProvider) FetchCertificate(cachedCert **tls.Certificate,
 	certFile string, certData string,
-	keyFile string, keyData string) (*tls.Certificate, error) {
+	keyFile string, keyData string,
+	keyPassword string, keyPasswordFile string) (*tls.Certificate, error) {
 	if certFile == "" && certData == "" {
 		return nil, nil
 	}
@@ -195,6 +269,19 @@ func (s *localStoreCertProvider) FetchCertificate(cachedCert **tls.Certificate,
 		return *cachedCert, nil
 	}
 
+	cert, err := loadCertificate(certFile, certData, keyFile, keyData, keyPassword, keyPasswordFile)
+	if err != nil {
+		return nil, err
+	}
+	*cachedCert = cert
+	return *cachedCert, nil
+}
+
+// loadCertificate reads the certificate from its file or decodes it from its data, bypassing the cache
+func loadCertificate(
+	certFile string, certData string,
+	keyFile string, keyData string,
+	keyPassword string, keyPasswordFile string) (*tls.Certificate, error) {
 	var certBytes []byte
 	var keyBytes []byte
 	var err error
@@ -223,15 +310,126 @@ func (s *localStoreCertProvider) FetchCertificate(cachedCert **tls.Certificate,
 		}
 	}
 
+	certBytes, err = normalizeCertificatesPEM(certBytes)
+	if err != nil {
+		return nil, fmt.Errorf("TLS public certificate could not be loaded: %w", err)
+	}
+
+	password, err := loadPassword("keyPassword", keyPassword, keyPasswordFile)
+	if err != nil {
+		return nil, err
+	}
+	keyBytes, err = decryptPrivateKeyPEM(keyBytes, password)
+	if err != nil {
+		return nil, err
+	}
+
 	cert, err := tls.X509KeyPair(certBytes, keyBytes)
 	if err != nil {
 		return nil, fmt.Errorf("loading tls certificate failed: %v", err)
 	}
+	return &cert, nil
+}
 
-	*cachedCert = &cert
+// fetchServerSettingsCertificate returns the certificate configured by the server settings,
+// either loaded from the certificate bundle or from the cert and key artifacts
+func (s *localStoreCertProvider) fetchServerSettingsCertificate(cachedCert **tls.Certificate) (*tls.Certificate, error) {
+	settings := &s.tlsSettings.Server
+	if settings.CertBundleFile == "" {
+		return s.FetchCertificate(cachedCert, settings.CertFile, settings.CertData,
+			settings.KeyFile, settings.KeyData,
+			settings.KeyPassword, settings.KeyPasswordFile)
+	}
+
+	s.RLock()
+	if *cachedCert != nil {
+		defer s.RUnlock()
+		return *cachedCert, nil
+	}
+	s.RUnlock()
+
+	bundleCert, _, err := s.fetchCertBundle()
+	if err != nil {
+		return nil, err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if *cachedCert == nil {
+		*cachedCert = bundleCert
+	}
 	return *cachedCert, nil
 }
 
+func (s *localStoreCertProvider) fetchCertBundle() (*tls.Certificate, *x509.CertPool, error) {
+	s.RLock()
+	if s.bundleCert != nil {
+		defer s.RUnlock()
+		return s.bundleCert, s.bundleCAs, nil
+	}
+
+	s.RUnlock()
+	s.Lock()
+	defer s.Unlock()
+
+	if s.bundleCert != nil {
+		return s.bundleCert, s.bundleCAs, nil
+	}
+
+	cert, cas, err := loadCertBundle(&s.tlsSettings.Server)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(cas) != 0 {
+		s.bundleCAs = x509.NewCertPool()
+		for _, ca := range cas {
+			s.bundleCAs.AddCert(ca)
+		}
+	}
+	s.bundleCert = cert
+	return s.bundleCert, s.bundleCAs, nil
+}
+
+// loadCertBundle reads the certificate and the certificate authorities from the bundle file, bypassing the cache
+func loadCertBundle(settings *config.ServerTLS) (*tls.Certificate, []*x509.Certificate, error) {
+	if settings.CertFile != "" || settings.CertData != "" || settings.KeyFile != "" || settings.KeyData != "" {
+		return nil, nil, errors.New("Cannot specify certBundleFile together with certFile, certData, keyFile or keyData properties")
+	}
+	bundleBytes, err := ioutil.ReadFile(settings.CertBundleFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	password, err := loadPassword("certBundlePassword", settings.CertBundlePassword, settings.CertBundlePasswordFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	return decodePKCS12(bundleBytes, password)
+}
+
+// reloadServerCertificate reads the certificate configured by the server settings from its files again,
+// certificates configured by data don't change without restart
+func (s *localStoreCertProvider) reloadServerCertificate() (*tls.Certificate, error) {
+	settings := &s.tlsSettings.Server
+	if settings.CertBundleFile != "" {
+		cert, _, err := loadCertBundle(settings)
+		return cert, err
+	}
+	if settings.CertFile == "" || settings.KeyFile == "" {
+		return nil, errors.New("certificate configured by certData or keyData can't be reloaded, configure certFile and keyFile or a cert provider plugin")
+	}
+	return loadCertificate(settings.CertFile, "", settings.KeyFile, "", settings.KeyPassword, settings.KeyPasswordFile)
+}
+
+// setCertificate replaces the loaded certificate, it takes precedence over configured certificate file or data
+func (s *localStoreCertProvider) setCertificate(cert *tls.Certificate) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.serverCert = cert
+	s.clientCert = cert
+}
+
 func (s *localStoreCertProvider) ServerName(isWorker bool) string {
 	return s.getClientTLSSettings(isWorker).ServerName
 }
@@ -263,6 +461,10 @@ func buildCAPoolFromData(caData []string) (*x509.CertPool, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to decode ca cert: %v", err)
 		}
+		caBytes, err = normalizeCertificatesPEM(caBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load ca cert: %v", err)
+		}
 
 		if !caPool.AppendCertsFromPEM(caBytes) {
 			return nil, errors.New("unknown failure constructing cert pool for ca")
@@ -291,6 +493,10 @@ func buildCAPoolFromFiles(caFiles []string) (*x509.CertPool, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to read ca cert from file '%v': %v", ca, err)
 		}
+		caBytes, err = normalizeCertificatesPEM(caBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load ca cert from file '%v': %v", ca, err)
+		}
 
 		if !caPool.AppendCertsFromPEM(caBytes) {
 			return nil, errors.New("unknown failure constructing cert pool for ca")
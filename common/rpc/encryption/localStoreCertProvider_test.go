@@ -0,0 +1,83 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+func TestFetchCAsPerDirection(t *testing.T) {
+	encodeCA := func(name string) (string, *x509.CertPool) {
+		ca, err := GenerateSelfSignedX509CA(name, nil, 2048)
+		require.NoError(t, err)
+		parsed, err := x509.ParseCertificate(ca.Certificate[0])
+		require.NoError(t, err)
+		pool := x509.NewCertPool()
+		pool.AddCert(parsed)
+		return base64.StdEncoding.EncodeToString(ca.Certificate[0]), pool
+	}
+	clientCA, clientPool := encodeCA("client-ca")
+	internodeRootCA, internodeRootPool := encodeCA("internode-root-ca")
+	frontendRootCA, frontendRootPool := encodeCA("frontend-root-ca")
+
+	tlsSettings := &config.RootTLS{
+		Internode: config.GroupTLS{
+			Server: config.ServerTLS{ClientCAData: []string{clientCA}},
+			Client: config.ClientTLS{RootCAData: []string{internodeRootCA}},
+		},
+		Frontend: config.GroupTLS{
+			Client: config.ClientTLS{RootCAData: []string{frontendRootCA}},
+		},
+	}
+	provider := &localStoreCertProvider{
+		tlsSettings:          &tlsSettings.Internode,
+		isLegacyWorkerConfig: true,
+		legacyWorkerSettings: &tlsSettings.Frontend.Client,
+	}
+
+	// workers fetching first must not make internode clients trust the frontend root CAs, and vice versa
+	workerRoots, err := provider.FetchServerRootCAsForClient(true)
+	require.NoError(t, err)
+	assert.True(t, workerRoots.Equal(frontendRootPool))
+
+	internodeRoots, err := provider.FetchServerRootCAsForClient(false)
+	require.NoError(t, err)
+	assert.True(t, internodeRoots.Equal(internodeRootPool))
+
+	workerRoots, err = provider.FetchServerRootCAsForClient(true)
+	require.NoError(t, err)
+	assert.True(t, workerRoots.Equal(frontendRootPool))
+
+	clientCAs, err := provider.FetchClientCAs()
+	require.NoError(t, err)
+	assert.True(t, clientCAs.Equal(clientPool))
+}
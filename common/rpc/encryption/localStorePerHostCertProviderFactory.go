@@ -25,6 +25,7 @@
 package encryption
 
 import (
+	"sort"
 	"strings"
 
 	"go.temporal.io/server/common/service/config"
@@ -32,9 +33,19 @@ import (
 
 var _ PerHostCertProviderFactory = (*localStorePerHostCertProviderFactory)(nil)
 
-type localStorePerHostCertProviderFactory struct {
-	certProviderCache map[string]*localStoreCertProvider
-}
+type (
+	localStorePerHostCertProviderFactory struct {
+		certProviderCache map[string]*localStoreCertProvider
+		// wildcards are sorted by descending suffix length for longest match
+		wildcards []wildcardCertProvider
+	}
+
+	wildcardCertProvider struct {
+		// suffix is the domain of the wildcard with its leading dot, e.g. ".frontend.example.com"
+		suffix       string
+		certProvider *localStoreCertProvider
+	}
+)
 
 func newLocalStorePerHostCertProviderFactory(overrides map[string]config.ServerTLS) PerHostCertProviderFactory {
 	factory := &localStorePerHostCertProviderFactory{}
@@ -45,12 +56,21 @@ func newLocalStorePerHostCertProviderFactory(overrides map[string]config.ServerT
 	factory.certProviderCache = make(map[string]*localStoreCertProvider, len(overrides))
 
 	for host, settings := range overrides {
-		factory.certProviderCache[strings.ToLower(host)] = &localStoreCertProvider{
+		host = strings.ToLower(host)
+		certProvider := &localStoreCertProvider{
 			tlsSettings: &config.GroupTLS{
 				Server: settings,
 			},
 		}
+		if strings.HasPrefix(host, "*.") {
+			factory.wildcards = append(factory.wildcards, wildcardCertProvider{suffix: host[1:], certProvider: certProvider})
+			continue
+		}
+		factory.certProviderCache[host] = certProvider
 	}
+	sort.Slice(factory.wildcards, func(i, j int) bool {
+		return len(factory.wildcards[i].suffix) > len(factory.wildcards[j].suffix)
+	})
 
 	return factory
 }
@@ -60,10 +80,17 @@ func (f *localStorePerHostCertProviderFactory) GetCertProvider(hostName string)
 		return nil, nil
 	}
 
-	cachedCertProvider, ok := f.certProviderCache[strings.ToLower(hostName)]
-	if !ok {
-		return nil, nil
+	hostName = strings.ToLower(hostName)
+	cachedCertProvider, ok := f.certProviderCache[hostName]
+	if ok {
+		return cachedCertProvider, nil
+	}
+
+	for _, wildcard := range f.wildcards {
+		if strings.HasSuffix(hostName, wildcard.suffix) {
+			return wildcard.certProvider, nil
+		}
 	}
 
-	return cachedCertProvider, nil
+	return nil, nil
 }
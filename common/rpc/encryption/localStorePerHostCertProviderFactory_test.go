@@ -0,0 +1,65 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+func TestLocalStorePerHostCertProviderFactory(t *testing.T) {
+	overrides := map[string]config.ServerTLS{
+		"frontend.example.com":      {CertFile: "exact"},
+		"*.example.com":             {CertFile: "domain"},
+		"*.Frontend.example.com":    {CertFile: "frontend"},
+		"*.us.frontend.example.com": {CertFile: "us"},
+	}
+	factory := newLocalStorePerHostCertProviderFactory(overrides)
+
+	for hostName, certFile := range map[string]string{
+		"frontend.example.com":        "exact",
+		"FRONTEND.example.com":        "exact",
+		"history.example.com":         "domain",
+		"a.frontend.example.com":      "frontend",
+		"a.eu.frontend.example.com":   "frontend",
+		"a.us.frontend.example.com":   "us",
+		"a.b.us.frontend.example.com": "us",
+	} {
+		certProvider, err := factory.GetCertProvider(hostName)
+		assert.NoError(t, err)
+		if assert.NotNil(t, certProvider, hostName) {
+			assert.Equal(t, certFile, certProvider.(*localStoreCertProvider).tlsSettings.Server.CertFile, hostName)
+		}
+	}
+
+	for _, hostName := range []string{"example.com", "frontend.example.org", "localhost"} {
+		certProvider, err := factory.GetCertProvider(hostName)
+		assert.NoError(t, err)
+		assert.Nil(t, certProvider, hostName)
+	}
+}
@@ -27,6 +27,7 @@ package encryption
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -34,6 +35,9 @@ import (
 	"go.temporal.io/server/common/service/config"
 )
 
+var _ RemoteClusterTLSConfigProvider = (*localStoreTlsProvider)(nil)
+var _ HTTPTLSConfigProvider = (*localStoreTlsProvider)(nil)
+
 type localStoreTlsProvider struct {
 	sync.RWMutex
 
@@ -43,6 +47,7 @@ type localStoreTlsProvider struct {
 	internodeClientCertProvider ClientCertProvider
 	frontendCertProvider        CertProvider
 	workerCertProvider          ClientCertProvider
+	httpCertProvider            CertProvider
 
 	frontendPerHostCertProviderFactory PerHostCertProviderFactory
 
@@ -50,9 +55,71 @@ type localStoreTlsProvider struct {
 	internodeClientConfig *tls.Config
 	frontendServerConfig  *tls.Config
 	frontendClientConfig  *tls.Config
+	httpServerConfig      *tls.Config
+
+	remoteClusters map[string]*remoteClusterTLS
+}
+
+// remoteClusterTLS holds the client cert provider and the client config of a remote cluster
+type remoteClusterTLS struct {
+	certProvider   ClientCertProvider
+	isAuthRequired bool
+	clientConfig   *tls.Config
 }
 
+// NewLocalStoreTlsProvider creates a TLS config provider loading certificates from the files
+// and data configured in tlsConfig.
 func NewLocalStoreTlsProvider(tlsConfig *config.RootTLS) (TLSConfigProvider, error) {
+	return NewTLSConfigProviderFromCertProviders(tlsConfig, newLocalStoreCertProviders(tlsConfig))
+}
+
+// NewTLSConfigProviderFromCertProviders creates a TLS config provider building the TLS configs of the groups
+// from the given cert providers.
+func NewTLSConfigProviderFromCertProviders(tlsConfig *config.RootTLS, certProviders *CertProviders) (TLSConfigProvider, error) {
+	if certProviders == nil ||
+		certProviders.Internode == nil ||
+		certProviders.InternodeClient == nil ||
+		certProviders.Frontend == nil ||
+		certProviders.SystemWorker == nil {
+		return nil, errors.New("internode, internode client, frontend and system worker cert providers are required")
+	}
+
+	return &localStoreTlsProvider{
+		internodeCertProvider:              certProviders.Internode,
+		internodeClientCertProvider:        certProviders.InternodeClient,
+		frontendCertProvider:               certProviders.Frontend,
+		workerCertProvider:                 certProviders.SystemWorker,
+		httpCertProvider:                   &localStoreCertProvider{tlsSettings: &tlsConfig.HTTP},
+		frontendPerHostCertProviderFactory: certProviders.FrontendPerHost,
+		RWMutex:                            sync.RWMutex{},
+		settings:                           tlsConfig,
+		remoteClusters:                     newRemoteClustersTLS(tlsConfig.RemoteClusters),
+	}, nil
+}
+
+// newRemoteClustersTLS creates cert providers of the remote clusters, their certificates are loaded
+// the same way as the ones of system workers, regardless of the cert provider plugin
+func newRemoteClustersTLS(settings map[string]config.RemoteClusterTLS) map[string]*remoteClusterTLS {
+	remoteClusters := make(map[string]*remoteClusterTLS, len(settings))
+	for clusterName, remoteSettings := range settings {
+		workerSettings := &config.WorkerTLS{
+			CertFile:        remoteSettings.CertFile,
+			KeyFile:         remoteSettings.KeyFile,
+			CertData:        remoteSettings.CertData,
+			KeyData:         remoteSettings.KeyData,
+			KeyPassword:     remoteSettings.KeyPassword,
+			KeyPasswordFile: remoteSettings.KeyPasswordFile,
+			Client:          remoteSettings.Client,
+		}
+		remoteClusters[clusterName] = &remoteClusterTLS{
+			certProvider:   &localStoreCertProvider{workerTLSSettings: workerSettings},
+			isAuthRequired: remoteSettings.CertFile != "" || remoteSettings.CertData != "",
+		}
+	}
+	return remoteClusters
+}
+
+func newLocalStoreCertProviders(tlsConfig *config.RootTLS) *CertProviders {
 	internodeProvider := &localStoreCertProvider{tlsSettings: &tlsConfig.Internode}
 	var workerProvider ClientCertProvider
 	if tlsConfig.SystemWorker.CertFile != "" || tlsConfig.SystemWorker.CertData != "" { // explcit system worker config
@@ -64,25 +131,26 @@ func NewLocalStoreTlsProvider(tlsConfig *config.RootTLS) (TLSConfigProvider, err
 		workerProvider = internodeWorkerProvider
 	}
 
-	return &localStoreTlsProvider{
-		internodeCertProvider:              internodeProvider,
-		internodeClientCertProvider:        internodeProvider,
-		frontendCertProvider:               &localStoreCertProvider{tlsSettings: &tlsConfig.Frontend},
-		workerCertProvider:                 workerProvider,
-		frontendPerHostCertProviderFactory: newLocalStorePerHostCertProviderFactory(tlsConfig.Frontend.PerHostOverrides),
-		RWMutex:                            sync.RWMutex{},
-		settings:                           tlsConfig,
-	}, nil
+	return &CertProviders{
+		Internode:       internodeProvider,
+		InternodeClient: internodeProvider,
+		Frontend:        &localStoreCertProvider{tlsSettings: &tlsConfig.Frontend},
+		FrontendPerHost: newLocalStorePerHostCertProviderFactory(tlsConfig.Frontend.PerHostOverrides),
+		SystemWorker:    workerProvider,
+	}
 }
 
 func (s *localStoreTlsProvider) GetInternodeClientConfig() (*tls.Config, error) {
 	return s.getOrCreateConfig(
 		&s.internodeClientConfig,
 		func() (*tls.Config, error) {
-			return newClientTLSConfig(s.internodeClientCertProvider,
+			if err := validateClientCertChain(CertRoleInternodeClient, s.internodeClientCertProvider, false, s.internodeCertProvider); err != nil {
+				return nil, err
+			}
+			return newClientTLSConfig(s.internodeClientCertProvider, s.internodeCertProvider.GetSettings(),
 				s.internodeCertProvider.GetSettings().Server.RequireClientAuth, false)
 		},
-		s.internodeCertProvider.GetSettings().IsEnabled(),
+		s.internodeCertProvider.IsEnabled(),
 	)
 }
 
@@ -90,10 +158,30 @@ func (s *localStoreTlsProvider) GetFrontendClientConfig() (*tls.Config, error) {
 	return s.getOrCreateConfig(
 		&s.frontendClientConfig,
 		func() (*tls.Config, error) {
-			return newClientTLSConfig(s.workerCertProvider,
+			if err := validateClientCertChain(CertRoleSystemWorkerClient, s.workerCertProvider, true, s.frontendCertProvider); err != nil {
+				return nil, err
+			}
+			return newClientTLSConfig(s.workerCertProvider, s.frontendCertProvider.GetSettings(),
 				s.frontendCertProvider.GetSettings().Server.RequireClientAuth, true)
 		},
-		s.internodeCertProvider.GetSettings().IsEnabled(),
+		s.internodeCertProvider.IsEnabled(),
+	)
+}
+
+// GetRemoteClusterClientConfig returns the client config of the remote cluster, nil if the cluster has
+// no TLS settings of its own
+func (s *localStoreTlsProvider) GetRemoteClusterClientConfig(clusterName string) (*tls.Config, error) {
+	remoteCluster, ok := s.remoteClusters[clusterName]
+	if !ok {
+		return nil, nil
+	}
+	return s.getOrCreateConfig(
+		&remoteCluster.clientConfig,
+		func() (*tls.Config, error) {
+			return newClientTLSConfig(remoteCluster.certProvider, s.frontendCertProvider.GetSettings(),
+				remoteCluster.isAuthRequired, true)
+		},
+		true,
 	)
 }
 
@@ -101,18 +189,35 @@ func (s *localStoreTlsProvider) GetFrontendServerConfig() (*tls.Config, error) {
 	return s.getOrCreateConfig(
 		&s.frontendServerConfig,
 		func() (*tls.Config, error) {
+			if err := validateServerCertChain(CertRoleFrontendServer, s.frontendCertProvider, s.workerCertProvider, true); err != nil {
+				return nil, err
+			}
 			return newServerTLSConfig(s.frontendCertProvider, s.frontendPerHostCertProviderFactory)
 		},
-		s.frontendCertProvider.GetSettings().IsEnabled())
+		s.frontendCertProvider.IsEnabled())
 }
 
 func (s *localStoreTlsProvider) GetInternodeServerConfig() (*tls.Config, error) {
 	return s.getOrCreateConfig(
 		&s.internodeServerConfig,
 		func() (*tls.Config, error) {
+			if err := validateServerCertChain(CertRoleInternodeServer, s.internodeCertProvider, s.internodeClientCertProvider, false); err != nil {
+				return nil, err
+			}
 			return newServerTLSConfig(s.internodeCertProvider, nil)
 		},
-		s.internodeCertProvider.GetSettings().IsEnabled())
+		s.internodeCertProvider.IsEnabled())
+}
+
+// GetHTTPServerConfig returns the TLS config of the HTTP endpoints, their certificates are loaded
+// from the local store regardless of the cert provider plugin
+func (s *localStoreTlsProvider) GetHTTPServerConfig() (*tls.Config, error) {
+	return s.getOrCreateConfig(
+		&s.httpServerConfig,
+		func() (*tls.Config, error) {
+			return newServerTLSConfig(s.httpCertProvider, nil)
+		},
+		s.httpCertProvider.IsEnabled())
 }
 
 func (s *localStoreTlsProvider) getOrCreateConfig(
@@ -154,7 +259,11 @@ func newServerTLSConfig(
 	certProvider CertProvider,
 	perHostCertProviderFactory PerHostCertProviderFactory,
 ) (*tls.Config, error) {
-	tlsConfig, err := getServerTLSConfigFromCertProvider(certProvider)
+	// per host settings only override the server certificates, versions and cipher suites are set for the group
+	groupSettings := certProvider.GetSettings()
+	// OCSP responses are shared by the per host configs, which are created on each handshake
+	ocspResponses := newOCSPCache()
+	tlsConfig, err := getServerTLSConfigFromCertProvider(certProvider, groupSettings, ocspResponses)
 	if err != nil {
 		return nil, err
 	}
@@ -172,14 +281,18 @@ func newServerTLSConfig(
 				return nil, nil
 			}
 
-			return getServerTLSConfigFromCertProvider(perHostCertProvider)
+			return getServerTLSConfigFromCertProvider(perHostCertProvider, groupSettings, ocspResponses)
 		}
 	}
 
 	return tlsConfig, nil
 }
 
-func getServerTLSConfigFromCertProvider(certProvider CertProvider) (*tls.Config, error) {
+func getServerTLSConfigFromCertProvider(
+	certProvider CertProvider,
+	groupSettings *config.GroupTLS,
+	ocspResponses *ocspCache,
+) (*tls.Config, error) {
 	// Get serverCert from disk
 	serverCert, err := certProvider.FetchServerCertificate()
 	if err != nil {
@@ -194,31 +307,99 @@ func getServerTLSConfigFromCertProvider(certProvider CertProvider) (*tls.Config,
 	// Default to NoClientAuth
 	clientAuthType := tls.NoClientCert
 	var clientCaPool *x509.CertPool
+	var clientVerifier PeerCertificateVerifier
+	var revocationChecker RevocationChecker
 
 	// If mTLS enabled
 	if certProvider.GetSettings().Server.RequireClientAuth {
 		clientAuthType = tls.RequireAndVerifyClientCert
+		if verifyingProvider, ok := certProvider.(ClientVerifyingCertProvider); ok {
+			// client certificate chains are verified by the verifier of the provider
+			verifier, err := verifyingProvider.FetchClientVerifier()
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch client certificate verifier: %v", err)
+			}
+			if verifier == nil {
+				return nil, errors.New("cert provider verifying client certificates returned no verifier")
+			}
+			clientAuthType = tls.RequireAnyClientCert
+			clientVerifier = verifier
+		} else {
+			ca, err := certProvider.FetchClientCAs()
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch client CAs: %v", err)
+			}
 
-		ca, err := certProvider.FetchClientCAs()
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch client CAs: %v", err)
+			clientCaPool = ca
 		}
 
-		clientCaPool = ca
+		checker, err := certProvider.FetchClientRevocationChecker()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch client certificate revocation list: %v", err)
+		}
+		revocationChecker = checker
 	}
 
-	return auth.NewTLSConfigWithClientAuthAndCAs(clientAuthType, []tls.Certificate{*serverCert}, clientCaPool), nil
+	tlsConfig := auth.NewTLSConfigWithClientAuthAndCAs(clientAuthType, nil, clientCaPool)
+	if err := applyGroupSettings(tlsConfig, groupSettings); err != nil {
+		return nil, err
+	}
+	tlsConfig.VerifyPeerCertificate = combinePeerCertificateVerifiers(clientVerifier, revocationChecker)
+	if allowlist := newClientCertAllowlist(&certProvider.GetSettings().Server); allowlist != nil && clientAuthType != tls.NoClientCert {
+		if tlsConfig.VerifyPeerCertificate == nil {
+			tlsConfig.VerifyPeerCertificate = allowlist.VerifyPeerCertificate
+		} else {
+			// chains are verified by the checker of the provider first
+			tlsConfig.VerifyPeerCertificate = chainPeerCertificateVerifiers(
+				tlsConfig.VerifyPeerCertificate, allowlist.VerifyPeerCertificate)
+		}
+	}
+	ocspVerifier, err := newOCSPVerifier(&certProvider.GetSettings().Server, ocspResponses)
+	if err != nil {
+		return nil, err
+	}
+	if ocspVerifier != nil {
+		if tlsConfig.VerifyPeerCertificate == nil {
+			tlsConfig.VerifyPeerCertificate = ocspVerifier.VerifyPeerCertificate
+		} else {
+			tlsConfig.VerifyPeerCertificate = chainPeerCertificateVerifiers(
+				tlsConfig.VerifyPeerCertificate, ocspVerifier.VerifyPeerCertificate)
+		}
+	}
+	stapler := newOCSPStapler(&certProvider.GetSettings().Server, ocspResponses)
+	if stapler != nil {
+		// fetch the response of the server certificate ahead of the first handshake
+		stapler.staple(serverCert)
+	}
+	// certificate is fetched on each handshake, so that rotated certificate is presented on new connections
+	tlsConfig.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		cert, err := certProvider.FetchServerCertificate()
+		if err != nil || stapler == nil {
+			return cert, err
+		}
+		return stapler.staple(cert), nil
+	}
+	return tlsConfig, nil
 }
 
-func newClientTLSConfig(clientProvider ClientCertProvider, isAuthRequired bool, isWorker bool) (*tls.Config, error) {
+func newClientTLSConfig(
+	clientProvider ClientCertProvider,
+	groupSettings *config.GroupTLS,
+	isAuthRequired bool,
+	isWorker bool,
+) (*tls.Config, error) {
 	// Optional ServerCA for client if not already trusted by host
 	serverCa, err := clientProvider.FetchServerRootCAsForClient(isWorker)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load client ca: %v", err)
 	}
 
+	revocationChecker, err := clientProvider.FetchServerRevocationCheckerForClient(isWorker)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate revocation list: %v", err)
+	}
+
 	// mTLS enabled, present certificate
-	var clientCerts []tls.Certificate
 	if isAuthRequired {
 		cert, err := clientProvider.FetchClientCertificate(isWorker)
 		if err != nil {
@@ -228,13 +409,82 @@ func newClientTLSConfig(clientProvider ClientCertProvider, isAuthRequired bool,
 		if cert == nil {
 			return nil, fmt.Errorf("client auth required, but no certificate provided")
 		}
-		clientCerts = []tls.Certificate{*cert}
 	}
 
-	return auth.NewTLSConfigWithCertsAndCAs(
-		clientCerts,
+	tlsConfig := auth.NewTLSConfigWithCertsAndCAs(
+		nil,
 		serverCa,
 		clientProvider.ServerName(isWorker),
 		!clientProvider.DisableHostVerification(isWorker),
-	), nil
+	)
+	if err := applyGroupSettings(tlsConfig, groupSettings); err != nil {
+		return nil, err
+	}
+	var serverVerifier PeerCertificateVerifier
+	if verifyingProvider, ok := clientProvider.(ServerVerifyingClientCertProvider); ok {
+		if serverVerifier, err = verifyingProvider.FetchServerVerifierForClient(isWorker); err != nil {
+			return nil, fmt.Errorf("failed to fetch server certificate verifier: %v", err)
+		}
+		if serverVerifier == nil {
+			return nil, errors.New("cert provider verifying server certificates returned no verifier")
+		}
+		// server certificate chains are verified by the verifier of the provider, host names are verified here
+		if !tlsConfig.InsecureSkipVerify {
+			tlsConfig.InsecureSkipVerify = true
+			tlsConfig.VerifyConnection = verifyServerHostName
+		}
+	}
+	tlsConfig.VerifyPeerCertificate = combinePeerCertificateVerifiers(serverVerifier, revocationChecker)
+	if isAuthRequired {
+		// certificate is fetched on each handshake, so that rotated certificate is presented on new connections
+		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return clientProvider.FetchClientCertificate(isWorker)
+		}
+	}
+	return tlsConfig, nil
+}
+
+// verifyServerHostName verifies the certificate of the server is issued for the name the client requested,
+// as clients verifying server certificate chains themselves skip the verification of the TLS stack
+func verifyServerHostName(state tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+	return state.PeerCertificates[0].VerifyHostname(state.ServerName)
+}
+
+// combinePeerCertificateVerifiers returns a function running the verifier of the cert provider and the
+// revocation checker in order, nil is returned if there are neither
+func combinePeerCertificateVerifiers(
+	verifier PeerCertificateVerifier,
+	revocationChecker RevocationChecker,
+) func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	switch {
+	case verifier != nil && revocationChecker != nil:
+		return chainPeerCertificateVerifiers(verifier.VerifyPeerCertificate, revocationChecker.VerifyPeerCertificate)
+	case verifier != nil:
+		return verifier.VerifyPeerCertificate
+	case revocationChecker != nil:
+		return revocationChecker.VerifyPeerCertificate
+	default:
+		return nil
+	}
+}
+
+// applyGroupSettings restricts the TLS versions and cipher suites of tlsConfig to the ones configured for the group
+func applyGroupSettings(tlsConfig *tls.Config, groupSettings *config.GroupTLS) error {
+	minVersion, err := groupSettings.GetMinVersion()
+	if err != nil {
+		return err
+	}
+	if minVersion != 0 {
+		tlsConfig.MinVersion = minVersion
+	}
+
+	cipherSuites, err := groupSettings.GetCipherSuites()
+	if err != nil {
+		return err
+	}
+	tlsConfig.CipherSuites = cipherSuites
+	return nil
 }
@@ -0,0 +1,309 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+	"golang.org/x/sync/singleflight"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	defaultOCSPCacheTTL = time.Hour
+	ocspFetchTimeout    = 5 * time.Second
+	// failed queries are cached for a short while, so that an unreachable responder
+	// does not stall every handshake
+	ocspFailureRetryInterval = time.Minute
+	// ocspCacheMaxSize bounds the number of certificates whose status is cached, least recently
+	// used entries are evicted first
+	ocspCacheMaxSize = 10000
+	// ocspRefreshAheadRatio is the part of the lifetime of a cached response after which it is
+	// refreshed in the background, while handshakes keep using the cached response
+	ocspRefreshAheadRatio = 0.75
+)
+
+var (
+	errOCSPCertificateRevoked = errors.New("certificate has been revoked according to OCSP")
+	errOCSPStatusUnknown      = errors.New("certificate status could not be determined by OCSP")
+)
+
+type (
+	// ocspCache caches OCSP responses of certificates by their issuer and serial number, it is shared by
+	// stapling of server certificates and verification of client certificates of a server TLS config.
+	// Concurrent queries for the same certificate are collapsed into one.
+	ocspCache struct {
+		entries cache.Cache
+		queries singleflight.Group
+	}
+
+	// ocspResponder queries the status of certificates, the responses are cached
+	ocspResponder struct {
+		cache *ocspCache
+		url   string
+		ttl   time.Duration
+	}
+
+	ocspCacheEntry struct {
+		response  *ocsp.Response
+		raw       []byte
+		err       error
+		refreshAt time.Time
+		expiresAt time.Time
+	}
+
+	// ocspStapler attaches the OCSP response of the server certificate to handshakes
+	ocspStapler struct {
+		*ocspResponder
+	}
+
+	// ocspVerifier rejects client certificates which are revoked according to the OCSP responders of their issuers
+	ocspVerifier struct {
+		*ocspResponder
+		isHardFail bool
+	}
+)
+
+func newOCSPCache() *ocspCache {
+	return &ocspCache{
+		entries: cache.NewLRU(ocspCacheMaxSize),
+	}
+}
+
+func newOCSPResponder(settings *config.ServerTLS, cache *ocspCache) *ocspResponder {
+	ttl := settings.OCSPCacheTTL
+	if ttl <= 0 {
+		ttl = defaultOCSPCacheTTL
+	}
+	return &ocspResponder{
+		cache: cache,
+		url:   settings.OCSPResponderURL,
+		ttl:   ttl,
+	}
+}
+
+// newOCSPStapler returns nil if stapling is not enabled by the server settings
+func newOCSPStapler(settings *config.ServerTLS, cache *ocspCache) *ocspStapler {
+	if !settings.EnableOCSPStapling {
+		return nil
+	}
+	return &ocspStapler{ocspResponder: newOCSPResponder(settings, cache)}
+}
+
+// newOCSPVerifier returns nil if verification of client certificates is not enabled by the server settings
+func newOCSPVerifier(settings *config.ServerTLS, cache *ocspCache) (*ocspVerifier, error) {
+	if !settings.VerifyClientOCSP || !settings.RequireClientAuth {
+		return nil, nil
+	}
+	isHardFail, err := settings.IsOCSPHardFail()
+	if err != nil {
+		return nil, err
+	}
+	return &ocspVerifier{ocspResponder: newOCSPResponder(settings, cache), isHardFail: isHardFail}, nil
+}
+
+// staple returns a copy of cert with its OCSP response attached. The certificate is returned as is
+// if its issuer is not part of the chain or its status is not known to be good. Handshakes never wait
+// for the responder, the response is fetched and refreshed in the background.
+func (s *ocspStapler) staple(cert *tls.Certificate) *tls.Certificate {
+	if cert == nil || len(cert.Certificate) < 2 {
+		return cert
+	}
+	leaf := cert.Leaf
+	if leaf == nil {
+		parsed, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return cert
+		}
+		leaf = parsed
+	}
+	issuer, err := x509.ParseCertificate(cert.Certificate[1])
+	if err != nil {
+		return cert
+	}
+
+	entry, ok := s.getCached(leaf, issuer)
+	if !ok || entry.err != nil || entry.response.Status != ocsp.Good {
+		return cert
+	}
+	stapled := *cert
+	stapled.OCSPStaple = entry.raw
+	return &stapled
+}
+
+// VerifyPeerCertificate is called after the client certificate chains have been verified,
+// it rejects the handshake if the client certificate is revoked, or if its status cannot be
+// determined and the failure policy is hard
+func (v *ocspVerifier) VerifyPeerCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	var leaf, issuer *x509.Certificate
+	if len(verifiedChains) > 0 && len(verifiedChains[0]) > 1 {
+		leaf, issuer = verifiedChains[0][0], verifiedChains[0][1]
+	} else if len(rawCerts) > 1 {
+		// chains of cert providers verifying client certificates themselves are not passed,
+		// the issuer has to be presented by the client then
+		parsedLeaf, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return err
+		}
+		parsedIssuer, err := x509.ParseCertificate(rawCerts[1])
+		if err != nil {
+			return err
+		}
+		leaf, issuer = parsedLeaf, parsedIssuer
+	} else {
+		return v.onFailure(fmt.Errorf("%w: issuer of the client certificate is unknown", errOCSPStatusUnknown))
+	}
+
+	entry := v.get(leaf, issuer)
+	if entry.err != nil {
+		return v.onFailure(fmt.Errorf("%w: %v", errOCSPStatusUnknown, entry.err))
+	}
+	switch entry.response.Status {
+	case ocsp.Good:
+		return nil
+	case ocsp.Revoked:
+		return fmt.Errorf("%w: serial number %v, subject %q", errOCSPCertificateRevoked, leaf.SerialNumber, leaf.Subject.String())
+	default:
+		return v.onFailure(fmt.Errorf("%w: serial number %v, subject %q", errOCSPStatusUnknown, leaf.SerialNumber, leaf.Subject.String()))
+	}
+}
+
+func (v *ocspVerifier) onFailure(err error) error {
+	if v.isHardFail {
+		return err
+	}
+	return nil
+}
+
+// get returns the cached response for cert. The responder is queried if there is none or it has expired,
+// concurrent handshakes wait for the same query. A response due for refresh is refreshed in the background.
+func (r *ocspResponder) get(cert *x509.Certificate, issuer *x509.Certificate) *ocspCacheEntry {
+	if entry, ok := r.getCached(cert, issuer); ok {
+		return entry
+	}
+	return r.refresh(cert, issuer)
+}
+
+// getCached returns the cached response for cert without waiting for the responder, the response
+// is fetched in the background if there is none or it is due for refresh
+func (r *ocspResponder) getCached(cert *x509.Certificate, issuer *x509.Certificate) (*ocspCacheEntry, bool) {
+	now := time.Now()
+	entry, ok := r.cache.entries.Get(ocspCacheKey(cert, issuer)).(*ocspCacheEntry)
+	if !ok || !now.Before(entry.refreshAt) {
+		go r.refresh(cert, issuer)
+	}
+	if !ok || !now.Before(entry.expiresAt) {
+		return nil, false
+	}
+	return entry, true
+}
+
+// refresh queries the responder for cert, concurrent refreshes of the same certificate share one query.
+// A valid response is kept if the query fails.
+func (r *ocspResponder) refresh(cert *x509.Certificate, issuer *x509.Certificate) *ocspCacheEntry {
+	key := ocspCacheKey(cert, issuer)
+	result, _, _ := r.cache.queries.Do(key, func() (interface{}, error) {
+		entry := r.fetch(cert, issuer)
+		if entry.err != nil {
+			if cached, ok := r.cache.entries.Get(key).(*ocspCacheEntry); ok && cached.err == nil && time.Now().Before(cached.expiresAt) {
+				retried := *cached
+				retried.refreshAt = entry.refreshAt
+				entry = &retried
+			}
+		}
+		r.cache.entries.Put(key, entry)
+		return entry, nil
+	})
+	return result.(*ocspCacheEntry)
+}
+
+func (r *ocspResponder) fetch(cert *x509.Certificate, issuer *x509.Certificate) *ocspCacheEntry {
+	now := time.Now()
+	raw, response, err := r.query(cert, issuer)
+	if err != nil {
+		retryAt := now.Add(ocspFailureRetryInterval)
+		return &ocspCacheEntry{err: err, refreshAt: retryAt, expiresAt: retryAt}
+	}
+
+	expiresAt := now.Add(r.ttl)
+	if !response.NextUpdate.IsZero() && response.NextUpdate.Before(expiresAt) {
+		expiresAt = response.NextUpdate
+	}
+	refreshAt := now.Add(time.Duration(float64(expiresAt.Sub(now)) * ocspRefreshAheadRatio))
+	return &ocspCacheEntry{response: response, raw: raw, refreshAt: refreshAt, expiresAt: expiresAt}
+}
+
+func (r *ocspResponder) query(cert *x509.Certificate, issuer *x509.Certificate) ([]byte, *ocsp.Response, error) {
+	responderURL := r.url
+	if responderURL == "" {
+		if len(cert.OCSPServer) == 0 {
+			return nil, nil, fmt.Errorf("certificate %q names no OCSP responder", cert.Subject.String())
+		}
+		responderURL = cert.OCSPServer[0]
+	}
+
+	request, err := ocsp.CreateRequest(cert, issuer, &ocsp.RequestOptions{Hash: crypto.SHA1})
+	if err != nil {
+		return nil, nil, err
+	}
+	client := http.Client{Timeout: ocspFetchTimeout}
+	resp, err := client.Post(responderURL, "application/ocsp-request", bytes.NewReader(request))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query OCSP responder %q: %w", responderURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("OCSP responder %q returned unexpected response status %q", responderURL, resp.Status)
+	}
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response of OCSP responder %q: %w", responderURL, err)
+	}
+
+	// the signature of the response is verified against the issuer
+	response, err := ocsp.ParseResponseForCert(raw, cert, issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse response of OCSP responder %q: %w", responderURL, err)
+	}
+	return raw, response, nil
+}
+
+func ocspCacheKey(cert *x509.Certificate, issuer *x509.Certificate) string {
+	issuerHash := sha256.Sum256(issuer.RawSubjectPublicKeyInfo)
+	return hex.EncodeToString(issuerHash[:]) + "/" + cert.SerialNumber.String()
+}
@@ -0,0 +1,153 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+type ocspTestResponder struct {
+	*httptest.Server
+	queries int32
+	release chan struct{}
+	fail    int32
+}
+
+func newOCSPTestCertificate(t *testing.T) (*tls.Certificate, *x509.Certificate, *x509.Certificate, *rsa.PrivateKey) {
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	caCert, err := GenerateSelfSignedX509CAWithKey("ocsp-ca", nil, caKey)
+	require.NoError(t, err)
+	cert, err := GenerateServerX509UsingCAWithKey("127.0.0.1", caCert, caKey)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	issuer, err := x509.ParseCertificate(caCert.Certificate[0])
+	require.NoError(t, err)
+	chain := &tls.Certificate{
+		Certificate: [][]byte{cert.Certificate[0], caCert.Certificate[0]},
+		PrivateKey:  cert.PrivateKey,
+	}
+	return chain, leaf, issuer, caKey
+}
+
+func newOCSPTestResponder(t *testing.T, leaf *x509.Certificate, issuer *x509.Certificate, issuerKey *rsa.PrivateKey) *ocspTestResponder {
+	response, err := ocsp.CreateResponse(issuer, issuer, ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: leaf.SerialNumber,
+		ThisUpdate:   time.Now().Add(-time.Minute),
+		NextUpdate:   time.Now().Add(time.Hour),
+	}, issuerKey)
+	require.NoError(t, err)
+
+	responder := &ocspTestResponder{release: make(chan struct{})}
+	responder.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&responder.queries, 1)
+		<-responder.release
+		if atomic.LoadInt32(&responder.fail) != 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(response)
+	}))
+	return responder
+}
+
+func TestOCSPResponder_ConcurrentQueriesAreCollapsed(t *testing.T) {
+	_, leaf, issuer, issuerKey := newOCSPTestCertificate(t)
+	server := newOCSPTestResponder(t, leaf, issuer, issuerKey)
+	defer server.Close()
+	responder := newOCSPResponder(&config.ServerTLS{OCSPResponderURL: server.URL}, newOCSPCache())
+
+	var waitGroup sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			entry := responder.get(leaf, issuer)
+			assert.NoError(t, entry.err)
+			assert.Equal(t, ocsp.Good, entry.response.Status)
+		}()
+	}
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&server.queries) == 1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(server.release)
+	waitGroup.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.queries))
+
+	// the cached response is used
+	assert.NoError(t, responder.get(leaf, issuer).err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&server.queries))
+}
+
+func TestOCSPStapler_DoesNotWaitForResponder(t *testing.T) {
+	cert, leaf, issuer, issuerKey := newOCSPTestCertificate(t)
+	server := newOCSPTestResponder(t, leaf, issuer, issuerKey)
+	defer server.Close()
+	stapler := newOCSPStapler(&config.ServerTLS{EnableOCSPStapling: true, OCSPResponderURL: server.URL}, newOCSPCache())
+
+	// the responder blocks, the certificate is presented without staple meanwhile
+	assert.Empty(t, stapler.staple(cert).OCSPStaple)
+	close(server.release)
+	assert.Eventually(t, func() bool { return len(stapler.staple(cert).OCSPStaple) != 0 }, time.Second, time.Millisecond)
+}
+
+func TestOCSPResponder_FailedRefreshKeepsResponse(t *testing.T) {
+	_, leaf, issuer, issuerKey := newOCSPTestCertificate(t)
+	server := newOCSPTestResponder(t, leaf, issuer, issuerKey)
+	defer server.Close()
+	close(server.release)
+	responder := newOCSPResponder(&config.ServerTLS{OCSPResponderURL: server.URL}, newOCSPCache())
+
+	require.NoError(t, responder.get(leaf, issuer).err)
+	atomic.StoreInt32(&server.fail, 1)
+	entry := responder.refresh(leaf, issuer)
+	assert.NoError(t, entry.err)
+	assert.Equal(t, ocsp.Good, entry.response.Status)
+	assert.True(t, entry.refreshAt.Before(time.Now().Add(ocspFailureRetryInterval+time.Second)))
+}
+
+func TestOCSPCache_IsBounded(t *testing.T) {
+	cache := newOCSPCache()
+	for i := 0; i < ocspCacheMaxSize+10; i++ {
+		cache.entries.Put(i, &ocspCacheEntry{})
+	}
+	assert.LessOrEqual(t, cache.entries.Size(), ocspCacheMaxSize)
+}
@@ -0,0 +1,419 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto"
+	"crypto/des"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"hash"
+	"unicode/utf16"
+)
+
+var (
+	oidDataContentType          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidEncryptedDataContentType = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 6}
+
+	oidKeyBag              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 1}
+	oidPKCS8ShroudedKeyBag = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertBag             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidX509CertificateType = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+
+	oidPBEWithSHAAnd3KeyTripleDESCBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+	oidPBEWithSHAAnd40BitRC2CBC      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 6}
+
+	oidSHA1   = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+
+	errCertBundlePassword = errors.New("certificate bundle could not be decrypted, password is incorrect")
+)
+
+const (
+	pkcs12KeyID   = 1
+	pkcs12IVID    = 2
+	pkcs12MacID   = 3
+	pkcs12Version = 3
+)
+
+type (
+	// pfxPdu is PKCS#12 PFX, see RFC 7292
+	pfxPdu struct {
+		Version  int
+		AuthSafe pkcs12ContentInfo
+		MacData  pkcs12MacData `asn1:"optional"`
+	}
+
+	pkcs12ContentInfo struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue `asn1:"tag:0,explicit,optional"`
+	}
+
+	pkcs12MacData struct {
+		Mac        pkcs12DigestInfo
+		MacSalt    []byte
+		Iterations int `asn1:"optional,default:1"`
+	}
+
+	pkcs12DigestInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		Digest    []byte
+	}
+
+	pkcs12EncryptedData struct {
+		Version              int
+		EncryptedContentInfo pkcs12EncryptedContentInfo
+	}
+
+	pkcs12EncryptedContentInfo struct {
+		ContentType                asn1.ObjectIdentifier
+		ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+		EncryptedContent           []byte `asn1:"tag:0,optional"`
+	}
+
+	pkcs12SafeBag struct {
+		ID         asn1.ObjectIdentifier
+		Value      asn1.RawValue     `asn1:"tag:0,explicit"`
+		Attributes []pkcs12Attribute `asn1:"set,optional"`
+	}
+
+	pkcs12Attribute struct {
+		ID    asn1.ObjectIdentifier
+		Value asn1.RawValue `asn1:"set"`
+	}
+
+	pkcs12CertBag struct {
+		ID   asn1.ObjectIdentifier
+		Data []byte `asn1:"tag:0,explicit"`
+	}
+
+	// pkcs12PBEParams are the parameters of legacy PKCS#12 password based encryption
+	pkcs12PBEParams struct {
+		Salt       []byte
+		Iterations int
+	}
+)
+
+// decodePKCS12 decodes a PKCS#12 bundle protected with password integrity mode. It returns the certificate
+// matching the single private key of the bundle, with the other certificates of the bundle appended to its chain,
+// and the other certificates on their own as they are usually the Certificate Authorities of the certificate.
+// Bags encrypted with PBES2, as exported by default by OpenSSL 3 and recent Java versions, and with legacy
+// 3DES are supported.
+func decodePKCS12(data []byte, password string) (*tls.Certificate, []*x509.Certificate, error) {
+	var pfx pfxPdu
+	if rest, err := asn1.Unmarshal(data, &pfx); err != nil {
+		return nil, nil, fmt.Errorf("certificate bundle could not be parsed: %v", err)
+	} else if len(rest) != 0 {
+		return nil, nil, errors.New("certificate bundle has trailing data")
+	}
+	if pfx.Version != pkcs12Version {
+		return nil, nil, fmt.Errorf("certificate bundle has unsupported version %d", pfx.Version)
+	}
+	if !pfx.AuthSafe.ContentType.Equal(oidDataContentType) {
+		return nil, nil, errors.New("certificate bundle must use password integrity mode")
+	}
+
+	var authSafe []byte
+	if _, err := asn1.Unmarshal(pfx.AuthSafe.Content.Bytes, &authSafe); err != nil {
+		return nil, nil, fmt.Errorf("certificate bundle could not be parsed: %v", err)
+	}
+	bmpPassword, err := bmpString(password)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(pfx.MacData.Mac.Algorithm.Algorithm) != 0 {
+		if err := verifyPKCS12Mac(&pfx.MacData, authSafe, bmpPassword); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var contents []pkcs12ContentInfo
+	if _, err := asn1.Unmarshal(authSafe, &contents); err != nil {
+		return nil, nil, fmt.Errorf("certificate bundle could not be parsed: %v", err)
+	}
+
+	var keys []crypto.Signer
+	var certs []*x509.Certificate
+	for _, content := range contents {
+		bags, err := decodePKCS12SafeContents(content, password, bmpPassword)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, bag := range bags {
+			switch {
+			case bag.ID.Equal(oidKeyBag):
+				key, err := parsePKCS12PrivateKey(bag.Value.Bytes)
+				if err != nil {
+					return nil, nil, err
+				}
+				keys = append(keys, key)
+			case bag.ID.Equal(oidPKCS8ShroudedKeyBag):
+				var keyInfo encryptedPrivateKeyInfo
+				if _, err := asn1.Unmarshal(bag.Value.Bytes, &keyInfo); err != nil {
+					return nil, nil, fmt.Errorf("certificate bundle private key could not be parsed: %v", err)
+				}
+				der, err := decryptPKCS12Data(keyInfo.Algorithm, keyInfo.EncryptedData, password, bmpPassword)
+				if err != nil {
+					return nil, nil, err
+				}
+				key, err := parsePKCS12PrivateKey(der)
+				if err != nil {
+					return nil, nil, err
+				}
+				keys = append(keys, key)
+			case bag.ID.Equal(oidCertBag):
+				var certBag pkcs12CertBag
+				if _, err := asn1.Unmarshal(bag.Value.Bytes, &certBag); err != nil {
+					return nil, nil, fmt.Errorf("certificate bundle certificate could not be parsed: %v", err)
+				}
+				if !certBag.ID.Equal(oidX509CertificateType) {
+					continue
+				}
+				cert, err := x509.ParseCertificate(certBag.Data)
+				if err != nil {
+					return nil, nil, fmt.Errorf("certificate bundle certificate could not be parsed: %v", err)
+				}
+				certs = append(certs, cert)
+			}
+		}
+	}
+
+	if len(keys) != 1 {
+		return nil, nil, fmt.Errorf("certificate bundle must contain exactly one private key, found %d", len(keys))
+	}
+	return buildPKCS12Certificate(keys[0], certs)
+}
+
+func decodePKCS12SafeContents(content pkcs12ContentInfo, password string, bmpPassword []byte) ([]pkcs12SafeBag, error) {
+	var safeContents []byte
+	switch {
+	case content.ContentType.Equal(oidDataContentType):
+		if _, err := asn1.Unmarshal(content.Content.Bytes, &safeContents); err != nil {
+			return nil, fmt.Errorf("certificate bundle could not be parsed: %v", err)
+		}
+	case content.ContentType.Equal(oidEncryptedDataContentType):
+		var encryptedData pkcs12EncryptedData
+		if _, err := asn1.Unmarshal(content.Content.Bytes, &encryptedData); err != nil {
+			return nil, fmt.Errorf("certificate bundle could not be parsed: %v", err)
+		}
+		contentInfo := encryptedData.EncryptedContentInfo
+		var err error
+		safeContents, err = decryptPKCS12Data(contentInfo.ContentEncryptionAlgorithm, contentInfo.EncryptedContent, password, bmpPassword)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("certificate bundle has unsupported content type %v", content.ContentType)
+	}
+
+	var bags []pkcs12SafeBag
+	if _, err := asn1.Unmarshal(safeContents, &bags); err != nil {
+		// garbage decrypted with a wrong password when the bundle has no MAC
+		return nil, errCertBundlePassword
+	}
+	return bags, nil
+}
+
+func decryptPKCS12Data(algorithm pkix.AlgorithmIdentifier, data []byte, password string, bmpPassword []byte) ([]byte, error) {
+	var plaintext []byte
+	var err error
+	switch {
+	case algorithm.Algorithm.Equal(oidPBES2):
+		// PBES2 derives keys from the password itself rather than from its BMPString encoding
+		plaintext, err = decryptPBES2(algorithm, data, []byte(password))
+	case algorithm.Algorithm.Equal(oidPBEWithSHAAnd3KeyTripleDESCBC):
+		plaintext, err = decryptPKCS12TripleDES(algorithm, data, bmpPassword)
+	case algorithm.Algorithm.Equal(oidPBEWithSHAAnd40BitRC2CBC):
+		return nil, errors.New("certificate bundle is encrypted with RC2 which is not supported, export it with AES-256 or 3DES")
+	default:
+		return nil, fmt.Errorf("certificate bundle is encrypted with unsupported algorithm %v", algorithm.Algorithm)
+	}
+
+	if err == errPBES2Decryption {
+		return nil, errCertBundlePassword
+	} else if err != nil {
+		return nil, fmt.Errorf("certificate bundle could not be decrypted: %v", err)
+	}
+	return plaintext, nil
+}
+
+func decryptPKCS12TripleDES(algorithm pkix.AlgorithmIdentifier, data []byte, bmpPassword []byte) ([]byte, error) {
+	var params pkcs12PBEParams
+	if _, err := asn1.Unmarshal(algorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, fmt.Errorf("encryption parameters could not be parsed: %v", err)
+	}
+
+	key := pkcs12KDF(sha1.New, bmpPassword, params.Salt, params.Iterations, pkcs12KeyID, 24)
+	iv := pkcs12KDF(sha1.New, bmpPassword, params.Salt, params.Iterations, pkcs12IVID, des.BlockSize)
+	blockCipher, err := des.NewTripleDESCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return decryptCBC(blockCipher, iv, data)
+}
+
+func verifyPKCS12Mac(macData *pkcs12MacData, message []byte, bmpPassword []byte) error {
+	var hashFn func() hash.Hash
+	switch algorithm := macData.Mac.Algorithm.Algorithm; {
+	case algorithm.Equal(oidSHA1):
+		hashFn = sha1.New
+	case algorithm.Equal(oidSHA256):
+		hashFn = sha256.New
+	case algorithm.Equal(oidSHA384):
+		hashFn = sha512.New384
+	case algorithm.Equal(oidSHA512):
+		hashFn = sha512.New
+	default:
+		return fmt.Errorf("certificate bundle uses unsupported MAC algorithm %v", algorithm)
+	}
+
+	key := pkcs12KDF(hashFn, bmpPassword, macData.MacSalt, macData.Iterations, pkcs12MacID, hashFn().Size())
+	mac := hmac.New(hashFn, key)
+	_, _ = mac.Write(message)
+	if !hmac.Equal(mac.Sum(nil), macData.Mac.Digest) {
+		return errCertBundlePassword
+	}
+	return nil
+}
+
+func parsePKCS12PrivateKey(der []byte) (crypto.Signer, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("certificate bundle private key could not be parsed: %v", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("certificate bundle private key of type %T is not supported", key)
+	}
+	return signer, nil
+}
+
+func buildPKCS12Certificate(key crypto.Signer, certs []*x509.Certificate) (*tls.Certificate, []*x509.Certificate, error) {
+	publicKey, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok {
+		return nil, nil, fmt.Errorf("certificate bundle private key of type %T is not supported", key)
+	}
+
+	var leaf *x509.Certificate
+	var cas []*x509.Certificate
+	for _, cert := range certs {
+		if leaf == nil && publicKey.Equal(cert.PublicKey) {
+			leaf = cert
+		} else {
+			cas = append(cas, cert)
+		}
+	}
+	if leaf == nil {
+		return nil, nil, errors.New("certificate bundle does not contain the certificate of its private key")
+	}
+
+	certificate := &tls.Certificate{
+		Certificate: [][]byte{leaf.Raw},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}
+	for _, ca := range cas {
+		certificate.Certificate = append(certificate.Certificate, ca.Raw)
+	}
+	return certificate, cas, nil
+}
+
+// bmpString encodes the password as null terminated big endian UTF-16, see RFC 7292 appendix B.1
+func bmpString(password string) ([]byte, error) {
+	encoded := make([]byte, 0, 2*len(password)+2)
+	for _, r := range password {
+		if r > 0xFFFF {
+			return nil, errors.New("certificate bundle password must only contain characters of the basic multilingual plane")
+		}
+		r1 := utf16.Encode([]rune{r})[0]
+		encoded = append(encoded, byte(r1>>8), byte(r1))
+	}
+	return append(encoded, 0, 0), nil
+}
+
+// pkcs12KDF derives size bytes of key material from the password, see RFC 7292 appendix B.2
+func pkcs12KDF(hashFn func() hash.Hash, password []byte, salt []byte, iterations int, id byte, size int) []byte {
+	h := hashFn()
+	u := h.Size()
+	v := h.BlockSize()
+
+	d := make([]byte, v)
+	for i := range d {
+		d[i] = id
+	}
+	i := append(fillPKCS12Block(salt, v), fillPKCS12Block(password, v)...)
+
+	var out []byte
+	for len(out) < size {
+		h.Reset()
+		_, _ = h.Write(d)
+		_, _ = h.Write(i)
+		a := h.Sum(nil)
+		for j := 1; j < iterations; j++ {
+			h.Reset()
+			_, _ = h.Write(a)
+			a = h.Sum(a[:0])
+		}
+		out = append(out, a[:u]...)
+		if len(out) >= size {
+			break
+		}
+
+		b := fillPKCS12Block(a, v)[:v]
+		for start := 0; start < len(i); start += v {
+			// I_j = (I_j + B + 1) mod 2^(8v)
+			carry := 1
+			for k := v - 1; k >= 0; k-- {
+				sum := int(i[start+k]) + int(b[k]) + carry
+				i[start+k] = byte(sum)
+				carry = sum >> 8
+			}
+		}
+	}
+	return out[:size]
+}
+
+// fillPKCS12Block repeats data up to the smallest multiple of v which is not shorter than data
+func fillPKCS12Block(data []byte, v int) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+	length := v * ((len(data) + v - 1) / v)
+	filled := make([]byte, length)
+	for i := range filled {
+		filled[i] = data[i%len(data)]
+	}
+	return filled
+}
@@ -0,0 +1,283 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const encryptedPKCS8BlockType = "ENCRYPTED PRIVATE KEY"
+
+var (
+	oidPBES2  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidHMACWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}
+	oidHMACWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}
+
+	oidAES128CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidDESEDE3CBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+
+	errKeyPasswordRequired  = errors.New("TLS private key is encrypted but no key password is configured")
+	errKeyPasswordIncorrect = errors.New("TLS private key could not be decrypted, key password is incorrect")
+	errPBES2Decryption      = errors.New("decryption failed, password is incorrect")
+)
+
+type (
+	// encryptedPrivateKeyInfo is PKCS#8 EncryptedPrivateKeyInfo, see RFC 5208
+	encryptedPrivateKeyInfo struct {
+		Algorithm     pkix.AlgorithmIdentifier
+		EncryptedData []byte
+	}
+
+	// pbes2Params is PBES2-params, see RFC 8018
+	pbes2Params struct {
+		KeyDerivationFunc pkix.AlgorithmIdentifier
+		EncryptionScheme  pkix.AlgorithmIdentifier
+	}
+
+	// pbkdf2Params is PBKDF2-params, see RFC 8018
+	pbkdf2Params struct {
+		Salt           []byte
+		IterationCount int
+		KeyLength      int                      `asn1:"optional"`
+		PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+	}
+)
+
+// loadPassword returns the password named by property, either set directly or read from a file.
+// Trailing line breaks of the file are ignored.
+func loadPassword(property string, password string, passwordFile string) (string, error) {
+	if password != "" && passwordFile != "" {
+		return "", fmt.Errorf("Cannot specify both %s and %sFile properties", property, property)
+	}
+	if passwordFile == "" {
+		return password, nil
+	}
+
+	passwordBytes, err := ioutil.ReadFile(passwordFile)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(passwordBytes), "\r\n"), nil
+}
+
+// decryptPrivateKeyPEM decrypts the encrypted PEM blocks of the private key with the password, so that
+// the result can be loaded by tls.X509KeyPair. Both PKCS#8 "ENCRYPTED PRIVATE KEY" blocks using PBES2
+// and legacy OpenSSL blocks with "Proc-Type: 4,ENCRYPTED" header are supported.
+// Blocks which are not encrypted are returned as is.
+func decryptPrivateKeyPEM(keyPEM []byte, password string) ([]byte, error) {
+	var decrypted bytes.Buffer
+	encrypted := false
+	rest := keyPEM
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		var err error
+		switch {
+		case block.Type == encryptedPKCS8BlockType:
+			encrypted = true
+			block, err = decryptPKCS8Block(block, password)
+		case x509.IsEncryptedPEMBlock(block): //nolint:staticcheck
+			encrypted = true
+			block, err = decryptLegacyBlock(block, password)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := pem.Encode(&decrypted, block); err != nil {
+			return nil, err
+		}
+	}
+
+	if !encrypted {
+		return keyPEM, nil
+	}
+	return decrypted.Bytes(), nil
+}
+
+func decryptLegacyBlock(block *pem.Block, password string) (*pem.Block, error) {
+	if password == "" {
+		return nil, errKeyPasswordRequired
+	}
+
+	der, err := x509.DecryptPEMBlock(block, []byte(password)) //nolint:staticcheck
+	if err == x509.IncorrectPasswordError {
+		return nil, errKeyPasswordIncorrect
+	} else if err != nil {
+		return nil, fmt.Errorf("TLS private key could not be decrypted: %v", err)
+	}
+	return &pem.Block{Type: block.Type, Bytes: der}, nil
+}
+
+func decryptPKCS8Block(block *pem.Block, password string) (*pem.Block, error) {
+	if password == "" {
+		return nil, errKeyPasswordRequired
+	}
+
+	var keyInfo encryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(block.Bytes, &keyInfo); err != nil {
+		return nil, fmt.Errorf("TLS private key could not be parsed: %v", err)
+	}
+	der, err := decryptPBES2(keyInfo.Algorithm, keyInfo.EncryptedData, []byte(password))
+	if err == errPBES2Decryption {
+		return nil, errKeyPasswordIncorrect
+	} else if err != nil {
+		return nil, fmt.Errorf("TLS private key could not be decrypted: %v", err)
+	}
+	// a wrong password may still yield valid padding by chance
+	if _, err := x509.ParsePKCS8PrivateKey(der); err != nil {
+		return nil, errKeyPasswordIncorrect
+	}
+	return &pem.Block{Type: "PRIVATE KEY", Bytes: der}, nil
+}
+
+// decryptPBES2 decrypts data encrypted with PBES2 using PBKDF2 key derivation and a CBC mode cipher,
+// see RFC 8018. errPBES2Decryption is returned when the data can't be decrypted with the password.
+func decryptPBES2(algorithm pkix.AlgorithmIdentifier, data []byte, password []byte) ([]byte, error) {
+	if !algorithm.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("unsupported encryption scheme %v, only PBES2 is supported", algorithm.Algorithm)
+	}
+
+	var params pbes2Params
+	if _, err := asn1.Unmarshal(algorithm.Parameters.FullBytes, &params); err != nil {
+		return nil, fmt.Errorf("encryption parameters could not be parsed: %v", err)
+	}
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, fmt.Errorf("unsupported key derivation function %v, only PBKDF2 is supported",
+			params.KeyDerivationFunc.Algorithm)
+	}
+
+	var kdfParams pbkdf2Params
+	if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdfParams); err != nil {
+		return nil, fmt.Errorf("key derivation parameters could not be parsed: %v", err)
+	}
+	prf, err := pbkdf2PRF(kdfParams.PRF.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	newCipher, keySize, err := pbes2Cipher(params.EncryptionScheme.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if kdfParams.KeyLength != 0 && kdfParams.KeyLength != keySize {
+		return nil, fmt.Errorf("invalid key length %d for cipher %v", kdfParams.KeyLength, params.EncryptionScheme.Algorithm)
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, fmt.Errorf("cipher parameters could not be parsed: %v", err)
+	}
+
+	key := pbkdf2.Key(password, kdfParams.Salt, kdfParams.IterationCount, keySize, prf)
+	blockCipher, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return decryptCBC(blockCipher, iv, data)
+}
+
+// decryptCBC decrypts data and removes its PKCS#7 padding, a wrong key yields invalid padding in most cases
+func decryptCBC(blockCipher cipher.Block, iv []byte, data []byte) ([]byte, error) {
+	if len(iv) != blockCipher.BlockSize() || len(data) == 0 || len(data)%blockCipher.BlockSize() != 0 {
+		return nil, errors.New("invalid encrypted data")
+	}
+	plaintext := make([]byte, len(data))
+	cipher.NewCBCDecrypter(blockCipher, iv).CryptBlocks(plaintext, data)
+
+	plaintext, ok := unpadPKCS7(plaintext, blockCipher.BlockSize())
+	if !ok {
+		return nil, errPBES2Decryption
+	}
+	return plaintext, nil
+}
+
+func pbkdf2PRF(oid asn1.ObjectIdentifier) (func() hash.Hash, error) {
+	switch {
+	case len(oid) == 0, oid.Equal(oidHMACWithSHA1):
+		return sha1.New, nil
+	case oid.Equal(oidHMACWithSHA256):
+		return sha256.New, nil
+	case oid.Equal(oidHMACWithSHA384):
+		return sha512.New384, nil
+	case oid.Equal(oidHMACWithSHA512):
+		return sha512.New, nil
+	default:
+		return nil, fmt.Errorf("unsupported PBKDF2 pseudorandom function %v", oid)
+	}
+}
+
+func pbes2Cipher(oid asn1.ObjectIdentifier) (func([]byte) (cipher.Block, error), int, error) {
+	switch {
+	case oid.Equal(oidAES128CBC):
+		return aes.NewCipher, 16, nil
+	case oid.Equal(oidAES192CBC):
+		return aes.NewCipher, 24, nil
+	case oid.Equal(oidAES256CBC):
+		return aes.NewCipher, 32, nil
+	case oid.Equal(oidDESEDE3CBC):
+		return des.NewTripleDESCipher, 24, nil
+	default:
+		return nil, 0, fmt.Errorf("unsupported cipher %v", oid)
+	}
+}
+
+func unpadPKCS7(data []byte, blockSize int) ([]byte, bool) {
+	padding := int(data[len(data)-1])
+	if padding == 0 || padding > blockSize || padding > len(data) {
+		return nil, false
+	}
+	for _, b := range data[len(data)-padding:] {
+		if int(b) != padding {
+			return nil, false
+		}
+	}
+	return data[:len(data)-padding], true
+}
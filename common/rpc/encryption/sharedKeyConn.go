@@ -0,0 +1,263 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+)
+
+const (
+	sharedKeyNonceSize = 32
+	// sharedKeyMaxFrameSize is the max number of plaintext bytes sealed in one frame
+	sharedKeyMaxFrameSize    = 16 * 1024
+	sharedKeyFrameHeaderSize = 4
+)
+
+var (
+	// sharedKeyProtocolHeader starts every connection encrypted with shared key, it identifies the protocol
+	// to peers and to port multiplexers which can't inspect the encrypted traffic
+	sharedKeyProtocolHeader = []byte("TSK1")
+
+	errSharedKeyProtocol       = errors.New("peer does not use shared key protocol")
+	errSharedKeyAuthentication = errors.New("peer failed shared key authentication")
+	errSharedKeyFrameTooLarge  = errors.New("shared key frame exceeds max size")
+)
+
+type (
+	// sharedKeyConn encrypts and authenticates traffic of the connection with AES-GCM. Both peers exchange
+	// random nonces on first use of the connection and derive per direction session keys from the shared key,
+	// so peer without the shared key can neither read the traffic nor send frames which are accepted.
+	sharedKeyConn struct {
+		net.Conn
+		key      []byte
+		isClient bool
+
+		handshakeOnce sync.Once
+		handshakeErr  error
+
+		readLock    sync.Mutex
+		readAEAD    cipher.AEAD
+		readCounter uint64
+		readBuffer  []byte
+
+		writeLock    sync.Mutex
+		writeAEAD    cipher.AEAD
+		writeCounter uint64
+	}
+
+	sharedKeyListener struct {
+		net.Listener
+		key []byte
+	}
+)
+
+// DecodeSharedKey decodes base64 encoded AES-128, AES-192 or AES-256 key
+func DecodeSharedKey(encodedKey string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("shared key is not base64 encoded: %v", err)
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("shared key must be 16, 24 or 32 bytes long, got %v bytes", len(key))
+	}
+}
+
+// SharedKeyClient returns client side of connection encrypted with shared key
+func SharedKeyClient(conn net.Conn, key []byte) net.Conn {
+	return &sharedKeyConn{Conn: conn, key: key, isClient: true}
+}
+
+// SharedKeyServer returns server side of connection encrypted with shared key
+func SharedKeyServer(conn net.Conn, key []byte) net.Conn {
+	return &sharedKeyConn{Conn: conn, key: key, isClient: false}
+}
+
+// NewSharedKeyListener returns listener which accepts connections encrypted with shared key
+func NewSharedKeyListener(listener net.Listener, key []byte) net.Listener {
+	return &sharedKeyListener{Listener: listener, key: key}
+}
+
+func (l *sharedKeyListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return SharedKeyServer(conn, l.key), nil
+}
+
+func (c *sharedKeyConn) Read(b []byte) (int, error) {
+	if err := c.handshake(); err != nil {
+		return 0, err
+	}
+
+	c.readLock.Lock()
+	defer c.readLock.Unlock()
+
+	for len(c.readBuffer) == 0 {
+		if err := c.readFrame(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(b, c.readBuffer)
+	c.readBuffer = c.readBuffer[n:]
+	return n, nil
+}
+
+func (c *sharedKeyConn) Write(b []byte) (int, error) {
+	if err := c.handshake(); err != nil {
+		return 0, err
+	}
+
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	written := 0
+	for written < len(b) {
+		size := len(b) - written
+		if size > sharedKeyMaxFrameSize {
+			size = sharedKeyMaxFrameSize
+		}
+		if err := c.writeFrame(b[written : written+size]); err != nil {
+			return written, err
+		}
+		written += size
+	}
+	return written, nil
+}
+
+func (c *sharedKeyConn) readFrame() error {
+	header := make([]byte, sharedKeyFrameHeaderSize)
+	if _, err := io.ReadFull(c.Conn, header); err != nil {
+		return err
+	}
+	size := binary.BigEndian.Uint32(header)
+	if size > sharedKeyMaxFrameSize+uint32(c.readAEAD.Overhead()) {
+		return errSharedKeyFrameTooLarge
+	}
+	frame := make([]byte, size)
+	if _, err := io.ReadFull(c.Conn, frame); err != nil {
+		return err
+	}
+
+	plaintext, err := c.readAEAD.Open(frame[:0], sharedKeyFrameNonce(c.readAEAD, c.readCounter), frame, header)
+	if err != nil {
+		return errSharedKeyAuthentication
+	}
+	c.readCounter++
+	c.readBuffer = plaintext
+	return nil
+}
+
+func (c *sharedKeyConn) writeFrame(plaintext []byte) error {
+	frame := make([]byte, sharedKeyFrameHeaderSize, sharedKeyFrameHeaderSize+len(plaintext)+c.writeAEAD.Overhead())
+	binary.BigEndian.PutUint32(frame, uint32(len(plaintext)+c.writeAEAD.Overhead()))
+	frame = c.writeAEAD.Seal(frame, sharedKeyFrameNonce(c.writeAEAD, c.writeCounter), plaintext, frame[:sharedKeyFrameHeaderSize])
+	c.writeCounter++
+	_, err := c.Conn.Write(frame)
+	return err
+}
+
+func (c *sharedKeyConn) handshake() error {
+	c.handshakeOnce.Do(func() {
+		c.handshakeErr = c.doHandshake()
+	})
+	return c.handshakeErr
+}
+
+func (c *sharedKeyConn) doHandshake() error {
+	localHello := make([]byte, len(sharedKeyProtocolHeader)+sharedKeyNonceSize)
+	copy(localHello, sharedKeyProtocolHeader)
+	if _, err := rand.Read(localHello[len(sharedKeyProtocolHeader):]); err != nil {
+		return err
+	}
+	if _, err := c.Conn.Write(localHello); err != nil {
+		return err
+	}
+	remoteHello := make([]byte, len(localHello))
+	if _, err := io.ReadFull(c.Conn, remoteHello); err != nil {
+		return err
+	}
+	if !bytes.Equal(remoteHello[:len(sharedKeyProtocolHeader)], sharedKeyProtocolHeader) {
+		return errSharedKeyProtocol
+	}
+
+	localNonce := localHello[len(sharedKeyProtocolHeader):]
+	remoteNonce := remoteHello[len(sharedKeyProtocolHeader):]
+
+	clientNonce, serverNonce := localNonce, remoteNonce
+	if !c.isClient {
+		clientNonce, serverNonce = remoteNonce, localNonce
+	}
+	clientAEAD, err := c.sessionAEAD("client", clientNonce, serverNonce)
+	if err != nil {
+		return err
+	}
+	serverAEAD, err := c.sessionAEAD("server", clientNonce, serverNonce)
+	if err != nil {
+		return err
+	}
+
+	if c.isClient {
+		c.writeAEAD, c.readAEAD = clientAEAD, serverAEAD
+	} else {
+		c.writeAEAD, c.readAEAD = serverAEAD, clientAEAD
+	}
+	return nil
+}
+
+// sessionAEAD derives key of one direction of the connection from the shared key and nonces of both peers
+func (c *sharedKeyConn) sessionAEAD(direction string, clientNonce []byte, serverNonce []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(direction))
+	mac.Write(clientNonce)
+	mac.Write(serverNonce)
+
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func sharedKeyFrameNonce(aead cipher.AEAD, counter uint64) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], counter)
+	return nonce
+}
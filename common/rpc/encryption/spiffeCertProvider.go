@@ -0,0 +1,264 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+// SPIFFECertProviderPluginName is the name of the built-in cert provider plugin which presents X.509 SVIDs
+// of the SPIFFE workload API and verifies peers by their SPIFFE IDs, configured by RootTLS.SPIFFE.
+const SPIFFECertProviderPluginName = "spiffe"
+
+var _ CertProvider = (*spiffeCertProvider)(nil)
+var _ ClientCertProvider = (*spiffeCertProvider)(nil)
+var (
+	_ ClientVerifyingCertProvider       = (*spiffeCertProvider)(nil)
+	_ ServerVerifyingClientCertProvider = (*spiffeCertProvider)(nil)
+)
+
+type (
+	spiffeCertProviderPlugin struct{}
+
+	// spiffeCertProvider presents the X.509 SVID of the workload. Servers and clients verify certificate chains
+	// of peers against the current bundle of the trust domain and authorize them by their SPIFFE IDs instead
+	// of their host names. Group settings and server names are handled by the local store.
+	spiffeCertProvider struct {
+		*localStoreCertProvider
+
+		source              *spiffeX509Source
+		authorizedClientIDs []*url.URL
+		authorizedServerIDs []*url.URL
+	}
+
+	// spiffePeerVerifier verifies the certificate chain of a peer and authorizes its SPIFFE ID,
+	// any workload of the trust domain of the SVID is authorized if no IDs are configured
+	spiffePeerVerifier struct {
+		source        *spiffeX509Source
+		authorizedIDs []*url.URL
+	}
+)
+
+func (p *spiffeCertProviderPlugin) CreateCertProviders(settings *config.RootTLS) (*CertProviders, error) {
+	spiffeSettings := &settings.SPIFFE
+	if !spiffeSettings.Internode.Enabled && !spiffeSettings.Frontend.Enabled {
+		return nil, errors.New("internode or frontend must be enabled")
+	}
+	if spiffeSettings.SPIFFEID != "" {
+		if _, err := parseSPIFFEID(spiffeSettings.SPIFFEID); err != nil {
+			return nil, err
+		}
+	}
+	source, err := newSPIFFEX509Source(spiffeSettings.WorkloadAPIAddress, spiffeSettings.SPIFFEID)
+	if err != nil {
+		return nil, err
+	}
+
+	// groups which are not enabled are loaded from the local store
+	certProviders := newLocalStoreCertProviders(settings)
+	if spiffeSettings.Internode.Enabled {
+		internodeProvider, err := newSPIFFECertProvider(source, &spiffeSettings.Internode, &settings.Internode)
+		if err != nil {
+			return nil, err
+		}
+		certProviders.Internode = internodeProvider
+		certProviders.InternodeClient = internodeProvider
+	}
+	if spiffeSettings.Frontend.Enabled {
+		frontendProvider, err := newSPIFFECertProvider(source, &spiffeSettings.Frontend, &settings.Frontend)
+		if err != nil {
+			return nil, err
+		}
+		certProviders.Frontend = frontendProvider
+		if settings.SystemWorker.CertFile == "" && settings.SystemWorker.CertData == "" {
+			// system workers present the SVID, authorize frontend and use frontend client settings
+			workerProvider, err := newSPIFFECertProvider(source, &spiffeSettings.Frontend, &settings.Internode)
+			if err != nil {
+				return nil, err
+			}
+			workerProvider.legacyWorkerSettings = &settings.Frontend.Client
+			certProviders.SystemWorker = workerProvider
+		}
+	}
+
+	source.start()
+	return certProviders, nil
+}
+
+func newSPIFFECertProvider(
+	source *spiffeX509Source,
+	spiffeSettings *config.SPIFFEGroupTLS,
+	tlsSettings *config.GroupTLS,
+) (*spiffeCertProvider, error) {
+	authorizedClientIDs, err := parseSPIFFEIDs(spiffeSettings.AuthorizedClientIDs)
+	if err != nil {
+		return nil, err
+	}
+	authorizedServerIDs, err := parseSPIFFEIDs(spiffeSettings.AuthorizedServerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spiffeCertProvider{
+		localStoreCertProvider: &localStoreCertProvider{tlsSettings: tlsSettings},
+		source:                 source,
+		authorizedClientIDs:    authorizedClientIDs,
+		authorizedServerIDs:    authorizedServerIDs,
+	}, nil
+}
+
+func (p *spiffeCertProvider) IsEnabled() bool {
+	return true
+}
+
+func (p *spiffeCertProvider) FetchServerCertificate() (*tls.Certificate, error) {
+	svid, _, err := p.source.fetch()
+	return svid, err
+}
+
+func (p *spiffeCertProvider) FetchClientCertificate(bool) (*tls.Certificate, error) {
+	svid, _, err := p.source.fetch()
+	return svid, err
+}
+
+func (p *spiffeCertProvider) FetchClientCAs() (*x509.CertPool, error) {
+	_, bundle, err := p.source.fetch()
+	return bundle, err
+}
+
+func (p *spiffeCertProvider) FetchServerRootCAsForClient(bool) (*x509.CertPool, error) {
+	_, bundle, err := p.source.fetch()
+	return bundle, err
+}
+
+// FetchClientVerifier verifies clients against the bundle of the trust domain, which is rotated by the workload API
+// while client CAs of TLS configs are fixed once they are created
+func (p *spiffeCertProvider) FetchClientVerifier() (PeerCertificateVerifier, error) {
+	return &spiffePeerVerifier{source: p.source, authorizedIDs: p.authorizedClientIDs}, nil
+}
+
+// FetchServerVerifierForClient verifies servers against the bundle of the trust domain and authorizes them
+// by their SPIFFE ID instead of their host names
+func (p *spiffeCertProvider) FetchServerVerifierForClient(bool) (PeerCertificateVerifier, error) {
+	return &spiffePeerVerifier{source: p.source, authorizedIDs: p.authorizedServerIDs}, nil
+}
+
+// FetchClientRevocationChecker returns no checker, SVIDs are short-lived and are not revoked
+func (p *spiffeCertProvider) FetchClientRevocationChecker() (RevocationChecker, error) {
+	return nil, nil
+}
+
+// FetchServerRevocationCheckerForClient returns no checker, SVIDs are short-lived and are not revoked
+func (p *spiffeCertProvider) FetchServerRevocationCheckerForClient(bool) (RevocationChecker, error) {
+	return nil, nil
+}
+
+// DisableHostVerification returns true, servers are verified by spiffePeerVerifier instead
+func (p *spiffeCertProvider) DisableHostVerification(bool) bool {
+	return true
+}
+
+func (v *spiffePeerVerifier) VerifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return errors.New("peer presented no certificate")
+	}
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, rawCert := range rawCerts {
+		cert, err := x509.ParseCertificate(rawCert)
+		if err != nil {
+			return fmt.Errorf("parsing peer certificate failed: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	svid, bundle, err := v.source.fetch()
+	if err != nil {
+		return err
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		Roots:         bundle,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("verifying peer certificate against SPIFFE bundle failed: %w", err)
+	}
+
+	peerID, err := spiffeIDOf(certs[0])
+	if err != nil {
+		return err
+	}
+	authorizedIDs := v.authorizedIDs
+	if len(authorizedIDs) == 0 {
+		trustDomain := &url.URL{Scheme: "spiffe", Host: svid.Leaf.URIs[0].Host}
+		authorizedIDs = []*url.URL{trustDomain}
+	}
+	for _, authorizedID := range authorizedIDs {
+		if peerID.Host == authorizedID.Host && (authorizedID.Path == "" || peerID.Path == authorizedID.Path) {
+			return nil
+		}
+	}
+	return fmt.Errorf("SPIFFE ID %s of peer is not authorized", peerID)
+}
+
+// spiffeIDOf returns the SPIFFE ID of an X.509 SVID, which is its only URI SAN
+func spiffeIDOf(cert *x509.Certificate) (*url.URL, error) {
+	if len(cert.URIs) != 1 {
+		return nil, fmt.Errorf("certificate %q is not an X.509 SVID, it must have exactly one URI SAN", cert.Subject)
+	}
+	return parseSPIFFEID(cert.URIs[0].String())
+}
+
+func parseSPIFFEIDs(ids []string) ([]*url.URL, error) {
+	var parsed []*url.URL
+	for _, id := range ids {
+		spiffeID, err := parseSPIFFEID(id)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, spiffeID)
+	}
+	return parsed, nil
+}
+
+// parseSPIFFEID parses an ID of the form spiffe://trust-domain/path, the path is optional
+func parseSPIFFEID(id string) (*url.URL, error) {
+	spiffeID, err := url.Parse(id)
+	if err != nil || spiffeID.Scheme != "spiffe" || spiffeID.Host == "" || spiffeID.Port() != "" ||
+		spiffeID.User != nil || spiffeID.RawQuery != "" || spiffeID.Fragment != "" {
+		return nil, fmt.Errorf("invalid SPIFFE ID %q", id)
+	}
+	return spiffeID, nil
+}
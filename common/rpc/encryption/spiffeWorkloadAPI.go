@@ -0,0 +1,350 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// spiffeEndpointSocketEnv is the environment variable the workload API address defaults to
+	spiffeEndpointSocketEnv = "SPIFFE_ENDPOINT_SOCKET"
+	// spiffeFetchX509SVIDMethod streams the X.509 SVIDs of the workload and every update of them
+	spiffeFetchX509SVIDMethod = "/SpiffeWorkloadAPI/FetchX509SVID"
+	// spiffeSecurityHeader must be sent with every workload API request
+	spiffeSecurityHeader = "workload.spiffe.io"
+	// spiffeFetchTimeout is how long handshakes wait for the first SVID of the workload API
+	spiffeFetchTimeout = 30 * time.Second
+	// spiffeWatchRetryInterval is how long to wait before reconnecting to the workload API after the stream broke
+	spiffeWatchRetryInterval = 5 * time.Second
+)
+
+// field numbers of the X509SVIDResponse and X509SVID messages of the workload API
+const (
+	spiffeResponseSVIDsField = 1
+	spiffeSVIDIDField        = 1
+	spiffeSVIDCertsField     = 2
+	spiffeSVIDKeyField       = 3
+	spiffeSVIDBundleField    = 4
+)
+
+var spiffeFetchX509SVIDStreamDesc = grpc.StreamDesc{
+	StreamName:    "FetchX509SVID",
+	ServerStreams: true,
+}
+
+type (
+	// spiffeX509Source watches the X.509 SVID and bundle of the workload on the SPIFFE workload API. Messages
+	// of the workload API are decoded from the protobuf wire format directly, its protos are not compiled in.
+	spiffeX509Source struct {
+		sync.RWMutex
+
+		network  string
+		address  string
+		spiffeID string
+
+		svid    *tls.Certificate
+		bundle  *x509.CertPool
+		lastErr error
+		ready   chan struct{}
+	}
+
+	// spiffeRawCodec passes the serialized messages of the workload API through as is
+	spiffeRawCodec struct{}
+)
+
+func newSPIFFEX509Source(address string, spiffeID string) (*spiffeX509Source, error) {
+	if address == "" {
+		address = os.Getenv(spiffeEndpointSocketEnv)
+	}
+	if address == "" {
+		return nil, fmt.Errorf("workload API address is required, configure it or set %s", spiffeEndpointSocketEnv)
+	}
+	endpoint, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid workload API address %q: %v", address, err)
+	}
+
+	source := &spiffeX509Source{
+		spiffeID: spiffeID,
+		ready:    make(chan struct{}),
+	}
+	switch endpoint.Scheme {
+	case "unix":
+		source.network, source.address = "unix", endpoint.Path
+		if source.address == "" {
+			// opaque form, e.g. unix:agent.sock
+			source.address = endpoint.Opaque
+		}
+	case "tcp":
+		source.network, source.address = "tcp", endpoint.Host
+	default:
+		return nil, fmt.Errorf("invalid workload API address %q: scheme must be unix or tcp", address)
+	}
+	if source.address == "" {
+		return nil, fmt.Errorf("invalid workload API address %q", address)
+	}
+	return source, nil
+}
+
+// start watches the workload API in the background for the lifetime of the process
+func (s *spiffeX509Source) start() {
+	go func() {
+		for {
+			err := s.watch(context.Background())
+			s.Lock()
+			s.lastErr = err
+			s.Unlock()
+			time.Sleep(spiffeWatchRetryInterval)
+		}
+	}()
+}
+
+// fetch returns the current SVID and bundle, waiting for the first ones to be received.
+// The last SVID is presented until it expires if the workload API becomes unavailable.
+func (s *spiffeX509Source) fetch() (*tls.Certificate, *x509.CertPool, error) {
+	select {
+	case <-s.ready:
+	case <-time.After(spiffeFetchTimeout):
+		s.RLock()
+		defer s.RUnlock()
+		return nil, nil, fmt.Errorf("no X.509 SVID received from workload API: %v", s.lastErr)
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+	if !time.Now().Before(s.svid.Leaf.NotAfter) {
+		return nil, nil, fmt.Errorf("X.509 SVID %s expired, last workload API error: %v", s.svid.Leaf.URIs[0], s.lastErr)
+	}
+	return s.svid, s.bundle, nil
+}
+
+func (s *spiffeX509Source) watch(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, "passthrough:///workload-api",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, s.network, s.address)
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, spiffeSecurityHeader, "true")
+	stream, err := conn.NewStream(ctx, &spiffeFetchX509SVIDStreamDesc, spiffeFetchX509SVIDMethod,
+		grpc.ForceCodec(spiffeRawCodec{}))
+	if err != nil {
+		return err
+	}
+	// X509SVIDRequest has no fields
+	if err := stream.SendMsg([]byte{}); err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+
+	for {
+		var response []byte
+		if err := stream.RecvMsg(&response); err != nil {
+			return err
+		}
+		svid, bundle, err := s.parseResponse(response)
+		if err != nil {
+			return err
+		}
+
+		s.Lock()
+		isFirst := s.svid == nil
+		s.svid = svid
+		s.bundle = bundle
+		s.lastErr = nil
+		s.Unlock()
+		if isFirst {
+			close(s.ready)
+		}
+	}
+}
+
+// parseResponse returns the configured SVID of the X509SVIDResponse, or the first one if none is configured,
+// and the bundle of its trust domain
+func (s *spiffeX509Source) parseResponse(response []byte) (*tls.Certificate, *x509.CertPool, error) {
+	var svids [][]byte
+	err := forEachProtoField(response, func(field uint64, value []byte) error {
+		if field == spiffeResponseSVIDsField {
+			svids = append(svids, value)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("decoding X509SVIDResponse failed: %v", err)
+	}
+
+	for _, svid := range svids {
+		var id string
+		var certs, key, bundle []byte
+		err := forEachProtoField(svid, func(field uint64, value []byte) error {
+			switch field {
+			case spiffeSVIDIDField:
+				id = string(value)
+			case spiffeSVIDCertsField:
+				certs = value
+			case spiffeSVIDKeyField:
+				key = value
+			case spiffeSVIDBundleField:
+				bundle = value
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding X509SVID failed: %v", err)
+		}
+		if s.spiffeID != "" && id != s.spiffeID {
+			continue
+		}
+		return parseSVID(id, certs, key, bundle)
+	}
+
+	if s.spiffeID != "" {
+		return nil, nil, fmt.Errorf("workload is not entitled to X.509 SVID %s", s.spiffeID)
+	}
+	return nil, nil, errors.New("workload is not entitled to any X.509 SVID")
+}
+
+// parseSVID parses the ASN.1 DER encoded certificate chain, PKCS#8 private key and bundle certificates of an SVID
+func parseSVID(id string, certsDER []byte, keyDER []byte, bundleDER []byte) (*tls.Certificate, *x509.CertPool, error) {
+	certs, err := x509.ParseCertificates(certsDER)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing X.509 SVID %s failed: %v", id, err)
+	}
+	if len(certs) == 0 {
+		return nil, nil, fmt.Errorf("X.509 SVID %s has no certificate", id)
+	}
+	if _, err := spiffeIDOf(certs[0]); err != nil {
+		return nil, nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(keyDER)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing private key of X.509 SVID %s failed: %v", id, err)
+	}
+	if _, ok := key.(crypto.Signer); !ok {
+		return nil, nil, fmt.Errorf("private key of X.509 SVID %s cannot sign", id)
+	}
+	bundleCerts, err := x509.ParseCertificates(bundleDER)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing bundle of X.509 SVID %s failed: %v", id, err)
+	}
+
+	svid := &tls.Certificate{PrivateKey: key, Leaf: certs[0]}
+	for _, cert := range certs {
+		svid.Certificate = append(svid.Certificate, cert.Raw)
+	}
+	bundle := x509.NewCertPool()
+	for _, cert := range bundleCerts {
+		bundle.AddCert(cert)
+	}
+	return svid, bundle, nil
+}
+
+// forEachProtoField calls fn with the length-delimited fields of a message in the protobuf wire format,
+// fields of other wire types are skipped
+func forEachProtoField(message []byte, fn func(field uint64, value []byte) error) error {
+	for len(message) > 0 {
+		tag, n := binary.Uvarint(message)
+		if n <= 0 {
+			return errors.New("invalid field tag")
+		}
+		message = message[n:]
+
+		var size uint64
+		switch tag & 7 {
+		case 0: // varint
+			if _, n = binary.Uvarint(message); n <= 0 {
+				return errors.New("invalid varint")
+			}
+			size = uint64(n)
+		case 1: // 64-bit
+			size = 8
+		case 2: // length-delimited
+			length, n := binary.Uvarint(message)
+			if n <= 0 || length > uint64(len(message)-n) {
+				return errors.New("invalid length")
+			}
+			if err := fn(tag>>3, message[n:n+int(length)]); err != nil {
+				return err
+			}
+			size = uint64(n) + length
+		case 5: // 32-bit
+			size = 4
+		default:
+			return fmt.Errorf("unsupported wire type %d", tag&7)
+		}
+		if size > uint64(len(message)) {
+			return errors.New("truncated message")
+		}
+		message = message[size:]
+	}
+	return nil
+}
+
+func (spiffeRawCodec) Marshal(v interface{}) ([]byte, error) {
+	message, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+	return message, nil
+}
+
+func (spiffeRawCodec) Unmarshal(data []byte, v interface{}) error {
+	message, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+	*message = append((*message)[:0], data...)
+	return nil
+}
+
+// Name is the name of the proto codec, so that the content type matches the workload API
+func (spiffeRawCodec) Name() string {
+	return "proto"
+}
@@ -27,6 +27,7 @@ package encryption
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"fmt"
 
 	"go.temporal.io/server/common/service/config"
 )
@@ -40,30 +41,140 @@ type (
 		GetFrontendClientConfig() (*tls.Config, error)
 	}
 
+	// RemoteClusterTLSConfigProvider is optionally implemented by TLS config providers which configure clients
+	// of remote clusters separately. A nil config is returned for clusters without their own settings, clients
+	// of those use the frontend client config.
+	RemoteClusterTLSConfigProvider interface {
+		GetRemoteClusterClientConfig(clusterName string) (*tls.Config, error)
+	}
+
+	// HTTPTLSConfigProvider is optionally implemented by TLS config providers which configure the HTTP endpoints
+	// serving pprof and metrics. A nil config is returned if TLS is not enabled for them.
+	HTTPTLSConfigProvider interface {
+		GetHTTPServerConfig() (*tls.Config, error)
+	}
+
 	// CertProvider is a common interface to load raw TLS/X509 primitives.
 	CertProvider interface {
 		FetchServerCertificate() (*tls.Certificate, error)
 		FetchClientCAs() (*x509.CertPool, error)
+		FetchClientRevocationChecker() (RevocationChecker, error)
 		GetSettings() *config.GroupTLS
+		// IsEnabled returns whether TLS is enabled for the group of the provider
+		IsEnabled() bool
 	}
 
 	// ClientCertProvider is an interface to load raw TLS/X509 primitives for configuring clients.
 	ClientCertProvider interface {
 		FetchClientCertificate(isWorker bool) (*tls.Certificate, error)
 		FetchServerRootCAsForClient(isWorker bool) (*x509.CertPool, error)
+		FetchServerRevocationCheckerForClient(isWorker bool) (RevocationChecker, error)
 		ServerName(isWorker bool) string
 		DisableHostVerification(isWorker bool) bool
 	}
 
+	// RevocationChecker rejects peer certificates which have been revoked by their issuer.
+	RevocationChecker interface {
+		VerifyPeerCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error
+	}
+
+	// PeerCertificateVerifier verifies certificate chains and identities of peers in place of the TLS stack.
+	PeerCertificateVerifier interface {
+		VerifyPeerCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error
+	}
+
+	// ClientVerifyingCertProvider is optionally implemented by cert providers which verify certificate chains of
+	// clients themselves, e.g. against trust bundles rotated at runtime. Servers of such providers accept any client
+	// certificate during the handshake and verify it with the verifier of the provider instead of client CAs.
+	ClientVerifyingCertProvider interface {
+		FetchClientVerifier() (PeerCertificateVerifier, error)
+	}
+
+	// ServerVerifyingClientCertProvider is optionally implemented by client cert providers which verify certificate
+	// chains of servers themselves. Clients of such providers verify servers with the verifier of the provider
+	// instead of server root CAs.
+	ServerVerifyingClientCertProvider interface {
+		FetchServerVerifierForClient(isWorker bool) (PeerCertificateVerifier, error)
+	}
+
 	// PerHostCertProviderFactory creates a CertProvider in the context of a specific Domain.
 	PerHostCertProviderFactory interface {
 		GetCertProvider(hostName string) (CertProvider, error)
 	}
 
+	// CertProviderPlugin creates the cert providers of the TLS groups, so that certificates can be
+	// supplied from custom sources, e.g. an HSM or an internal PKI service. TLS configs are built from
+	// the cert providers the same way as for the local store, honoring versions, cipher suites and
+	// revocation checks of the groups.
+	CertProviderPlugin interface {
+		CreateCertProviders(settings *config.RootTLS) (*CertProviders, error)
+	}
+
+	// CertProviders are the cert providers TLS configs are built from.
+	CertProviders struct {
+		// Internode provides the certificates of internode servers
+		Internode CertProvider
+		// InternodeClient provides the certificates of internode clients
+		InternodeClient ClientCertProvider
+		// Frontend provides the certificates of the frontend server
+		Frontend CertProvider
+		// FrontendPerHost provides per host name certificates of the frontend server. Optional.
+		FrontendPerHost PerHostCertProviderFactory
+		// SystemWorker provides the certificates of system workers connecting to frontend
+		SystemWorker ClientCertProvider
+	}
+
 	tlsConfigConstructor func() (*tls.Config, error)
+
+	localStoreCertProviderPlugin struct{}
 )
 
+// LocalStoreCertProviderPluginName is the name of the cert provider plugin which loads
+// certificates from the files and data configured in RootTLS. It is used if none is configured.
+const LocalStoreCertProviderPluginName = "localStore"
+
+var certProviderPlugins = map[string]CertProviderPlugin{
+	LocalStoreCertProviderPluginName: &localStoreCertProviderPlugin{},
+	VaultCertProviderPluginName:      &vaultCertProviderPlugin{},
+	SPIFFECertProviderPluginName:     &spiffeCertProviderPlugin{},
+	KubernetesCertProviderPluginName: &kubernetesCertProviderPlugin{},
+	AWSCertProviderPluginName:        &awsCertProviderPlugin{},
+	DevModeCertProviderPluginName:    &devModeCertProviderPlugin{},
+}
+
+// RegisterCertProviderPlugin registers a cert provider plugin, which is used if its name is configured
+// as the cert provider of RootTLS. It must be called before the server is started.
+func RegisterCertProviderPlugin(pluginName string, plugin CertProviderPlugin) {
+	if _, ok := certProviderPlugins[pluginName]; ok {
+		panic("cert provider plugin " + pluginName + " already registered")
+	}
+	certProviderPlugins[pluginName] = plugin
+}
+
 // NewTLSConfigProviderFromConfig creates a new TLS Config provider from RootTLS config
 func NewTLSConfigProviderFromConfig(encryptionSettings config.RootTLS) (TLSConfigProvider, error) {
-	return NewLocalStoreTlsProvider(&encryptionSettings)
+	pluginName := encryptionSettings.CertProvider
+	if encryptionSettings.DevMode.AutoGenerate {
+		if pluginName != "" && pluginName != DevModeCertProviderPluginName {
+			return nil, fmt.Errorf("dev mode certificates conflict with cert provider plugin %q", pluginName)
+		}
+		pluginName = DevModeCertProviderPluginName
+	}
+	if pluginName == "" {
+		pluginName = LocalStoreCertProviderPluginName
+	}
+	plugin, ok := certProviderPlugins[pluginName]
+	if !ok {
+		return nil, fmt.Errorf("cert provider plugin %q is not registered", pluginName)
+	}
+
+	certProviders, err := plugin.CreateCertProviders(&encryptionSettings)
+	if err != nil {
+		return nil, fmt.Errorf("cert provider plugin %q: %w", pluginName, err)
+	}
+	return NewTLSConfigProviderFromCertProviders(&encryptionSettings, certProviders)
+}
+
+func (p *localStoreCertProviderPlugin) CreateCertProviders(settings *config.RootTLS) (*CertProviders, error) {
+	return newLocalStoreCertProviders(settings), nil
 }
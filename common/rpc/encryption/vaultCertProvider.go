@@ -0,0 +1,275 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+// VaultCertProviderPluginName is the name of the built-in cert provider plugin which issues
+// certificates from the PKI secrets engine of HashiCorp Vault, configured by RootTLS.Vault.
+const VaultCertProviderPluginName = "vault"
+
+// vaultRenewRetryInterval is how long a failed renewal waits before it is retried, the current
+// certificate keeps being presented until it expires
+const vaultRenewRetryInterval = 10 * time.Second
+
+var _ CertProvider = (*vaultCertProvider)(nil)
+var _ ClientCertProvider = (*vaultCertProvider)(nil)
+
+type (
+	vaultCertProviderPlugin struct{}
+
+	// vaultCertProvider presents certificates issued by Vault and trusts the CA chain of the issuer.
+	// Certificates are renewed in the background by the first handshake after the renewal time,
+	// group settings, revocation checks and explicitly configured CAs are handled by the local store.
+	vaultCertProvider struct {
+		sync.RWMutex
+		*localStoreCertProvider
+
+		client      *vaultClient
+		role        string
+		request     vaultIssueRequest
+		renewBefore time.Duration
+
+		cert       *tls.Certificate
+		caPool     *x509.CertPool
+		renewAt    time.Time
+		isRenewing bool
+	}
+)
+
+func (p *vaultCertProviderPlugin) CreateCertProviders(settings *config.RootTLS) (*CertProviders, error) {
+	vaultSettings := &settings.Vault
+	if vaultSettings.Internode.CommonName == "" && vaultSettings.Frontend.CommonName == "" {
+		return nil, errors.New("common name of internode or frontend certificate is required")
+	}
+	client, err := newVaultClient(vaultSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	// groups without vault certificate are loaded from the local store
+	certProviders := newLocalStoreCertProviders(settings)
+	if vaultSettings.Internode.CommonName != "" {
+		internodeProvider, err := newVaultCertProvider(client, vaultSettings, &vaultSettings.Internode, &settings.Internode)
+		if err != nil {
+			return nil, err
+		}
+		certProviders.Internode = internodeProvider
+		certProviders.InternodeClient = internodeProvider
+		if settings.SystemWorker.CertFile == "" && settings.SystemWorker.CertData == "" {
+			// system workers present the internode certificate and use frontend client settings
+			internodeProvider.legacyWorkerSettings = &settings.Frontend.Client
+			certProviders.SystemWorker = internodeProvider
+		}
+	}
+	if vaultSettings.Frontend.CommonName != "" {
+		frontendProvider, err := newVaultCertProvider(client, vaultSettings, &vaultSettings.Frontend, &settings.Frontend)
+		if err != nil {
+			return nil, err
+		}
+		certProviders.Frontend = frontendProvider
+	}
+	return certProviders, nil
+}
+
+func newVaultCertProvider(
+	client *vaultClient,
+	vaultSettings *config.VaultTLS,
+	certSettings *config.VaultCertificate,
+	tlsSettings *config.GroupTLS,
+) (*vaultCertProvider, error) {
+	role := certSettings.Role
+	if role == "" {
+		role = vaultSettings.Role
+	}
+	if role == "" {
+		return nil, fmt.Errorf("vault role of certificate %q is required", certSettings.CommonName)
+	}
+
+	request := vaultIssueRequest{
+		CommonName: certSettings.CommonName,
+		AltNames:   strings.Join(certSettings.AltNames, ","),
+		IPSANs:     strings.Join(certSettings.IPSANs, ","),
+	}
+	if vaultSettings.TTL > 0 {
+		request.TTL = fmt.Sprintf("%ds", int64(vaultSettings.TTL/time.Second))
+	}
+
+	return &vaultCertProvider{
+		localStoreCertProvider: &localStoreCertProvider{tlsSettings: tlsSettings},
+		client:                 client,
+		role:                   role,
+		request:                request,
+		renewBefore:            vaultSettings.RenewBefore,
+	}, nil
+}
+
+func (p *vaultCertProvider) IsEnabled() bool {
+	return true
+}
+
+func (p *vaultCertProvider) FetchServerCertificate() (*tls.Certificate, error) {
+	return p.fetchCertificate()
+}
+
+func (p *vaultCertProvider) FetchClientCertificate(bool) (*tls.Certificate, error) {
+	return p.fetchCertificate()
+}
+
+func (p *vaultCertProvider) FetchClientCAs() (*x509.CertPool, error) {
+	serverSettings := &p.tlsSettings.Server
+	if len(serverSettings.ClientCAFiles) != 0 || len(serverSettings.ClientCAData) != 0 {
+		return p.localStoreCertProvider.FetchClientCAs()
+	}
+	return p.fetchCAPool()
+}
+
+func (p *vaultCertProvider) FetchServerRootCAsForClient(isWorker bool) (*x509.CertPool, error) {
+	clientSettings := p.getClientTLSSettings(isWorker)
+	if len(clientSettings.RootCAFiles) != 0 || len(clientSettings.RootCAData) != 0 {
+		return p.localStoreCertProvider.FetchServerRootCAsForClient(isWorker)
+	}
+	return p.fetchCAPool()
+}
+
+func (p *vaultCertProvider) fetchCAPool() (*x509.CertPool, error) {
+	if _, err := p.fetchCertificate(); err != nil {
+		return nil, err
+	}
+
+	p.RLock()
+	defer p.RUnlock()
+	return p.caPool, nil
+}
+
+// fetchCertificate returns the current certificate, issuing one synchronously only if there is
+// none or it has expired. Once the renewal time has passed, the certificate is renewed in the background.
+func (p *vaultCertProvider) fetchCertificate() (*tls.Certificate, error) {
+	p.RLock()
+	cert := p.cert
+	now := time.Now()
+	isStale := !p.isRenewing && !now.Before(p.renewAt)
+	p.RUnlock()
+
+	if cert == nil || !now.Before(cert.Leaf.NotAfter) {
+		return p.issueCertificate()
+	}
+
+	if isStale {
+		p.Lock()
+		// check again, other handshake might have started the renewal while waiting for the lock
+		if !p.isRenewing && !time.Now().Before(p.renewAt) {
+			p.isRenewing = true
+			go p.renew()
+		}
+		p.Unlock()
+	}
+	return cert, nil
+}
+
+func (p *vaultCertProvider) issueCertificate() (*tls.Certificate, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	// check again, other handshake might have issued the certificate while waiting for the lock
+	if p.cert != nil && time.Now().Before(p.cert.Leaf.NotAfter) {
+		return p.cert, nil
+	}
+
+	cert, caPool, err := p.issue()
+	if err != nil {
+		return nil, err
+	}
+	p.storeCertificate(cert, caPool)
+	return p.cert, nil
+}
+
+// renew issues a new certificate in the background, so that handshakes are not blocked on Vault.
+// Current certificate is kept if issuing fails, it is retried after vaultRenewRetryInterval.
+func (p *vaultCertProvider) renew() {
+	cert, caPool, err := p.issue()
+
+	p.Lock()
+	defer p.Unlock()
+	if err == nil {
+		p.storeCertificate(cert, caPool)
+	} else {
+		p.renewAt = time.Now().Add(vaultRenewRetryInterval)
+	}
+	p.isRenewing = false
+}
+
+// storeCertificate swaps in the issued certificate and schedules its renewal, must be called under the write lock
+func (p *vaultCertProvider) storeCertificate(cert *tls.Certificate, caPool *x509.CertPool) {
+	lifetime := time.Until(cert.Leaf.NotAfter)
+	renewBefore := p.renewBefore
+	if renewBefore <= 0 || renewBefore >= lifetime {
+		renewBefore = lifetime / 3
+	}
+
+	p.cert = cert
+	p.caPool = caPool
+	p.renewAt = cert.Leaf.NotAfter.Add(-renewBefore)
+}
+
+func (p *vaultCertProvider) issue() (*tls.Certificate, *x509.CertPool, error) {
+	request := p.request
+	issued, err := p.client.issueCertificate(p.role, &request)
+	if err != nil {
+		return nil, nil, fmt.Errorf("issuing certificate %q failed: %w", request.CommonName, err)
+	}
+
+	caChain := issued.CAChain
+	if len(caChain) == 0 && issued.IssuingCA != "" {
+		caChain = []string{issued.IssuingCA}
+	}
+	certPEM := strings.Join(append([]string{issued.Certificate}, caChain...), "\n")
+	cert, err := tls.X509KeyPair([]byte(certPEM), []byte(issued.PrivateKey))
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading certificate issued by vault failed: %v", err)
+	}
+	cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caPool := x509.NewCertPool()
+	for _, ca := range caChain {
+		if !caPool.AppendCertsFromPEM([]byte(ca)) {
+			return nil, nil, errors.New("parsing CA chain issued by vault failed")
+		}
+	}
+	return &cert, caPool, nil
+}
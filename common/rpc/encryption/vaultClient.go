@@ -0,0 +1,286 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	vaultAuthMethodToken      = "token"
+	vaultAuthMethodAppRole    = "approle"
+	vaultAuthMethodKubernetes = "kubernetes"
+
+	defaultVaultPKIMount     = "pki"
+	defaultKubernetesJWTFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+	vaultRequestTimeout = 10 * time.Second
+	// tokens are replaced by logging in again shortly before their lease expires
+	vaultTokenExpiryMargin = 30 * time.Second
+)
+
+type (
+	// vaultClient issues certificates from the PKI secrets engine of Vault, logging in with
+	// the configured auth method whenever the token is missing, expired or rejected
+	vaultClient struct {
+		sync.Mutex
+
+		settings   *config.VaultTLS
+		address    string
+		httpClient *http.Client
+
+		token          string
+		tokenExpiresAt time.Time
+	}
+
+	vaultIssueRequest struct {
+		CommonName string `json:"common_name"`
+		AltNames   string `json:"alt_names,omitempty"`
+		IPSANs     string `json:"ip_sans,omitempty"`
+		TTL        string `json:"ttl,omitempty"`
+	}
+
+	vaultIssuedCertificate struct {
+		Certificate string   `json:"certificate"`
+		IssuingCA   string   `json:"issuing_ca"`
+		CAChain     []string `json:"ca_chain"`
+		PrivateKey  string   `json:"private_key"`
+	}
+
+	vaultAuth struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int64  `json:"lease_duration"`
+	}
+
+	vaultResponse struct {
+		Auth   *vaultAuth      `json:"auth"`
+		Data   json.RawMessage `json:"data"`
+		Errors []string        `json:"errors"`
+	}
+)
+
+func newVaultClient(settings *config.VaultTLS) (*vaultClient, error) {
+	if settings.Address == "" {
+		return nil, errors.New("vault address is required")
+	}
+	switch settings.AuthMethod {
+	case vaultAuthMethodToken:
+		if settings.Token == "" && settings.TokenFile == "" {
+			return nil, errors.New("token or tokenFile is required by token auth method")
+		}
+	case vaultAuthMethodAppRole:
+		if settings.RoleID == "" || (settings.SecretID == "" && settings.SecretIDFile == "") {
+			return nil, errors.New("roleId and either secretId or secretIdFile are required by approle auth method")
+		}
+	case vaultAuthMethodKubernetes:
+		if settings.KubernetesRole == "" {
+			return nil, errors.New("kubernetesRole is required by kubernetes auth method")
+		}
+	default:
+		return nil, fmt.Errorf("unknown vault auth method %q", settings.AuthMethod)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if settings.CAFile != "" {
+		caPool, err := buildCAPoolFromFiles([]string{settings.CAFile})
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: caPool}
+	}
+
+	return &vaultClient{
+		settings: settings,
+		address:  strings.TrimRight(settings.Address, "/"),
+		httpClient: &http.Client{
+			Timeout:   vaultRequestTimeout,
+			Transport: transport,
+		},
+	}, nil
+}
+
+// issueCertificate issues a new certificate and private key with the PKI role
+func (c *vaultClient) issueCertificate(role string, request *vaultIssueRequest) (*vaultIssuedCertificate, error) {
+	mount := c.settings.Mount
+	if mount == "" {
+		mount = defaultVaultPKIMount
+	}
+	path := "/v1/" + strings.Trim(mount, "/") + "/issue/" + role
+
+	token, err := c.getToken()
+	if err != nil {
+		return nil, err
+	}
+	var response vaultResponse
+	status, err := c.post(path, token, request, &response)
+	if status == http.StatusForbidden {
+		// token might have been revoked or rotated, login again once before giving up
+		c.invalidateToken(token)
+		if token, err = c.getToken(); err != nil {
+			return nil, err
+		}
+		response = vaultResponse{}
+		_, err = c.post(path, token, request, &response)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var issued vaultIssuedCertificate
+	if err := json.Unmarshal(response.Data, &issued); err != nil {
+		return nil, fmt.Errorf("decoding certificate issued by vault failed: %w", err)
+	}
+	if issued.Certificate == "" || issued.PrivateKey == "" {
+		return nil, errors.New("vault returned no certificate or private key")
+	}
+	return &issued, nil
+}
+
+func (c *vaultClient) getToken() (string, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.token != "" && (c.tokenExpiresAt.IsZero() || time.Now().Before(c.tokenExpiresAt)) {
+		return c.token, nil
+	}
+
+	token, leaseDuration, err := c.login()
+	if err != nil {
+		return "", err
+	}
+	c.token = token
+	c.tokenExpiresAt = time.Time{}
+	if leaseDuration > 0 {
+		margin := vaultTokenExpiryMargin
+		if margin > leaseDuration/2 {
+			margin = leaseDuration / 2
+		}
+		c.tokenExpiresAt = time.Now().Add(leaseDuration - margin)
+	}
+	return c.token, nil
+}
+
+func (c *vaultClient) invalidateToken(token string) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.token == token {
+		c.token = ""
+	}
+}
+
+// login returns the token of the configured auth method and its lease duration, zero if it does not expire.
+// Token, secret ID and JWT files are read on each login, so that credentials rotated on disk are picked up.
+func (c *vaultClient) login() (string, time.Duration, error) {
+	var body map[string]string
+	switch c.settings.AuthMethod {
+	case vaultAuthMethodToken:
+		token, err := loadPassword("token", c.settings.Token, c.settings.TokenFile)
+		if err != nil {
+			return "", 0, err
+		}
+		return token, 0, nil
+	case vaultAuthMethodAppRole:
+		secretID, err := loadPassword("secretId", c.settings.SecretID, c.settings.SecretIDFile)
+		if err != nil {
+			return "", 0, err
+		}
+		body = map[string]string{"role_id": c.settings.RoleID, "secret_id": secretID}
+	case vaultAuthMethodKubernetes:
+		jwtFile := c.settings.JWTFile
+		if jwtFile == "" {
+			jwtFile = defaultKubernetesJWTFile
+		}
+		jwt, err := loadPassword("jwt", "", jwtFile)
+		if err != nil {
+			return "", 0, err
+		}
+		body = map[string]string{"role": c.settings.KubernetesRole, "jwt": jwt}
+	}
+
+	authMount := c.settings.AuthMount
+	if authMount == "" {
+		authMount = c.settings.AuthMethod
+	}
+	var response vaultResponse
+	if _, err := c.post("/v1/auth/"+strings.Trim(authMount, "/")+"/login", "", body, &response); err != nil {
+		return "", 0, err
+	}
+	if response.Auth == nil || response.Auth.ClientToken == "" {
+		return "", 0, errors.New("vault login returned no token")
+	}
+	return response.Auth.ClientToken, time.Duration(response.Auth.LeaseDuration) * time.Second, nil
+}
+
+// post sends the request to Vault and decodes its response, it returns the status code along with
+// an error describing the errors reported by Vault if the request did not succeed
+func (c *vaultClient) post(path string, token string, body interface{}, response *vaultResponse) (int, error) {
+	requestBody, err := json.Marshal(body)
+	if err != nil {
+		return 0, err
+	}
+	request, err := http.NewRequest(http.MethodPost, c.address+path, bytes.NewReader(requestBody))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		request.Header.Set("X-Vault-Token", token)
+	}
+	if c.settings.Namespace != "" {
+		request.Header.Set("X-Vault-Namespace", c.settings.Namespace)
+	}
+
+	httpResponse, err := c.httpClient.Do(request)
+	if err != nil {
+		return 0, fmt.Errorf("vault request %s failed: %w", path, err)
+	}
+	defer httpResponse.Body.Close()
+	responseBody, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return httpResponse.StatusCode, fmt.Errorf("vault request %s failed: %w", path, err)
+	}
+	if len(responseBody) != 0 {
+		if err := json.Unmarshal(responseBody, response); err != nil && httpResponse.StatusCode == http.StatusOK {
+			return httpResponse.StatusCode, fmt.Errorf("decoding response of vault request %s failed: %w", path, err)
+		}
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return httpResponse.StatusCode, fmt.Errorf("vault request %s failed with status %d: %s",
+			path, httpResponse.StatusCode, strings.Join(response.Errors, "; "))
+	}
+	return httpResponse.StatusCode, nil
+}
@@ -27,6 +27,7 @@ package rpc
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"time"
 
 	"github.com/gogo/status"
@@ -50,13 +51,17 @@ const (
 
 	// minConnectTimeout is the minimum amount of time we are willing to give a connection to complete.
 	minConnectTimeout = 20 * time.Second
+
+	// defaultDrainTimeout is how long gRPC server waits for in-flight requests on shutdown by default.
+	defaultDrainTimeout = 10 * time.Second
 )
 
 // Dial creates a client connection to the given target with default options.
 // The hostName syntax is defined in
 // https://github.com/grpc/grpc/blob/master/doc/naming.md.
 // e.g. to use dns resolver, a "dns:///" prefix should be applied to the target.
-func Dial(hostName string, tlsConfig *tls.Config) (*grpc.ClientConn, error) {
+// Passed options are applied on top of the default options.
+func Dial(hostName string, tlsConfig *tls.Config, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	// Default to insecure
 	grpcSecureOpt := grpc.WithInsecure()
 	if tlsConfig != nil {
@@ -74,7 +79,7 @@ func Dial(hostName string, tlsConfig *tls.Config) (*grpc.ClientConn, error) {
 	}
 	cp.Backoff.MaxDelay = MaxBackoffDelay
 
-	return grpc.Dial(hostName,
+	dialOpts := []grpc.DialOption{
 		grpcSecureOpt,
 		grpc.WithChainUnaryInterceptor(
 			versionHeadersInterceptor,
@@ -82,7 +87,39 @@ func Dial(hostName string, tlsConfig *tls.Config) (*grpc.ClientConn, error) {
 		grpc.WithDefaultServiceConfig(DefaultServiceConfig),
 		grpc.WithDisableServiceConfig(),
 		grpc.WithConnectParams(cp),
-	)
+	}
+	return grpc.Dial(hostName, append(dialOpts, opts...)...)
+}
+
+// GracefulStop stops the server from accepting new connections, sends GOAWAY to clients of open connections
+// and waits for their in-flight requests to complete until timeout. Connections still open after timeout are
+// closed, in which case false is returned.
+func GracefulStop(server *grpc.Server, timeout time.Duration) bool {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-stopped:
+		return true
+	case <-timer.C:
+		server.Stop()
+		<-stopped
+		return false
+	}
+}
+
+// ServiceConfig returns gRPC connection service config which balances calls with the given policy,
+// DefaultServiceConfig is returned for empty policy.
+func ServiceConfig(balancingPolicy string) string {
+	if balancingPolicy == "" {
+		return DefaultServiceConfig
+	}
+	return fmt.Sprintf(`{"loadBalancingConfig": [{%q:{}}]}`, balancingPolicy)
 }
 
 func errorInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
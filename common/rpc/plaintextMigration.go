@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bufio"
+	"net"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type (
+	// plaintextMigrationCredentials accept both TLS and plaintext connections on the same port, so running
+	// cluster can move to TLS node by node. Connections starting with TLS handshake are handed to the wrapped
+	// TLS credentials, other connections are served in plaintext.
+	plaintextMigrationCredentials struct {
+		credentials.TransportCredentials
+		plaintext credentials.TransportCredentials
+	}
+)
+
+func newPlaintextMigrationCredentials(tlsCredentials credentials.TransportCredentials) credentials.TransportCredentials {
+	return &plaintextMigrationCredentials{
+		TransportCredentials: tlsCredentials,
+		plaintext:            insecure.NewCredentials(),
+	}
+}
+
+func (c *plaintextMigrationCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	// gRPC server bounds handshake with connection deadline, so peek doesn't block forever
+	reader := bufio.NewReader(conn)
+	prefix, err := reader.Peek(1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn = &peekedConn{Conn: conn, reader: reader}
+	if prefix[0] == tlsHandshakeRecordType {
+		return c.TransportCredentials.ServerHandshake(conn)
+	}
+	return c.plaintext.ServerHandshake(conn)
+}
+
+func (c *plaintextMigrationCredentials) Clone() credentials.TransportCredentials {
+	return newPlaintextMigrationCredentials(c.TransportCredentials.Clone())
+}
@@ -0,0 +1,84 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/rpc/encryption"
+	"go.temporal.io/server/common/service/config"
+)
+
+type plaintextMigrationSuite struct {
+	suite.Suite
+
+	tlsFactory encryption.TLSConfigProvider
+}
+
+func TestPlaintextMigrationSuite(t *testing.T) {
+	suite.Run(t, &plaintextMigrationSuite{})
+}
+
+func (s *plaintextMigrationSuite) SetupSuite() {
+	var err error
+	s.tlsFactory, err = encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+		DevMode: config.DevModeTLS{AutoGenerate: true},
+	})
+	s.NoError(err)
+}
+
+func (s *plaintextMigrationSuite) newFactory(migration string) *TestFactory {
+	cfg := &config.RPC{BindOnIP: "127.0.0.1", InternodePlaintextMigration: migration}
+	return &TestFactory{
+		RPCFactory:  NewFactory(cfg, nil, "tester", loggerimpl.NewNopLogger(), s.tlsFactory),
+		serverUsage: Internode,
+	}
+}
+
+func (s *plaintextMigrationSuite) TestTLSOnly() {
+	runHelloWorldTest(s.Suite, "localhost", s.newFactory(""), s.newFactory(""), true)
+	runHelloWorldTest(s.Suite, "localhost", s.newFactory(""), s.newFactory(config.PlaintextMigrationDialPlaintext), false)
+}
+
+func (s *plaintextMigrationSuite) TestAcceptPlaintext() {
+	for _, migration := range []string{config.PlaintextMigrationDialPlaintext, config.PlaintextMigrationDialTLS} {
+		for _, clientMigration := range []string{"", config.PlaintextMigrationDialPlaintext, config.PlaintextMigrationDialTLS} {
+			runHelloWorldTest(s.Suite, "localhost", s.newFactory(migration), s.newFactory(clientMigration), true)
+		}
+	}
+}
+
+func (s *plaintextMigrationSuite) TestDialPlaintext() {
+	tlsConfig, err := s.newFactory(config.PlaintextMigrationDialPlaintext).GetInternodeClientTlsConfig()
+	s.NoError(err)
+	s.Nil(tlsConfig)
+
+	tlsConfig, err = s.newFactory(config.PlaintextMigrationDialTLS).GetInternodeClientTlsConfig()
+	s.NoError(err)
+	s.NotNil(tlsConfig)
+}
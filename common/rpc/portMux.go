@@ -0,0 +1,288 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/cryptobyte"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// portMux serves gRPC, membership and HTTP protocols on a single listener. Protocol of every accepted
+	// connection is detected from its first bytes and the connection is handed to listener of the protocol.
+	portMux struct {
+		root   net.Listener
+		logger log.Logger
+
+		grpc       *muxListener
+		membership *muxListener
+		http       *muxListener
+
+		sync.Mutex
+		openListeners int
+	}
+
+	muxListener struct {
+		mux     *portMux
+		conns   chan net.Conn
+		onClose func()
+
+		closeOnce sync.Once
+		closed    chan struct{}
+	}
+
+	// peekedConn replays bytes read while detecting protocol of the connection
+	peekedConn struct {
+		net.Conn
+		reader io.Reader
+	}
+)
+
+const (
+	// portMuxDetectTimeout is how long connection can take to send the bytes which identify its protocol
+	portMuxDetectTimeout = 10 * time.Second
+	portMuxPrefixSize    = 4
+	// tlsHandshakeRecordType starts TLS connections, which are gRPC unless their ClientHello offers membershipALPNProtocol
+	tlsHandshakeRecordType = 0x16
+	tlsRecordHeaderSize    = 5
+	// tlsMaxRecordSize is the max size of TLS plaintext record, which carries the ClientHello
+	tlsMaxRecordSize          = 1 << 14
+	tlsClientHelloMessageType = 1
+	tlsALPNExtensionType      = 16
+)
+
+var (
+	errMuxListenerClosed = errors.New("mux listener is closed")
+
+	http2Preface  = []byte("PRI ")
+	http1Prefixes = [][]byte{
+		[]byte("GET "), []byte("HEAD"), []byte("POST"), []byte("PUT "), []byte("DELE"),
+		[]byte("OPTI"), []byte("PATC"), []byte("TRAC"), []byte("CONN"),
+	}
+)
+
+func newPortMux(root net.Listener, logger log.Logger) *portMux {
+	mux := &portMux{
+		root:   root,
+		logger: logger,
+	}
+	// HTTP is optional, so root listener is closed when services stop serving gRPC and membership
+	mux.grpc = newMuxListener(mux, mux.listenerClosed)
+	mux.membership = newMuxListener(mux, mux.listenerClosed)
+	mux.http = newMuxListener(mux, func() {})
+	mux.openListeners = 2
+	go mux.serve()
+	return mux
+}
+
+func newMuxListener(mux *portMux, onClose func()) *muxListener {
+	return &muxListener{
+		mux:     mux,
+		conns:   make(chan net.Conn),
+		onClose: onClose,
+		closed:  make(chan struct{}),
+	}
+}
+
+func (m *portMux) serve() {
+	for {
+		conn, err := m.root.Accept()
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				time.Sleep(5 * time.Millisecond)
+				continue
+			}
+			m.close()
+			return
+		}
+		go m.dispatch(conn)
+	}
+}
+
+func (m *portMux) dispatch(conn net.Conn) {
+	_ = conn.SetReadDeadline(time.Now().Add(portMuxDetectTimeout))
+	listener, reader, err := m.detect(bufio.NewReader(conn))
+	_ = conn.SetReadDeadline(time.Time{})
+	if err != nil {
+		m.logger.Debug("Unable to detect protocol of connection.", tag.Error(err), tag.Address(conn.RemoteAddr().String()))
+		_ = conn.Close()
+		return
+	}
+
+	timer := time.NewTimer(portMuxDetectTimeout)
+	defer timer.Stop()
+	select {
+	case listener.conns <- &peekedConn{Conn: conn, reader: reader}:
+	case <-listener.closed:
+		_ = conn.Close()
+	case <-timer.C:
+		// nobody serves the protocol
+		_ = conn.Close()
+	}
+}
+
+// detect returns listener of the protocol of the connection along with reader replaying the bytes read to detect it.
+// TLS connections are read up to the end of their ClientHello, as only its ALPN tells membership from gRPC.
+func (m *portMux) detect(reader *bufio.Reader) (*muxListener, io.Reader, error) {
+	prefix, err := reader.Peek(portMuxPrefixSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	if prefix[0] != tlsHandshakeRecordType {
+		return m.listenerFor(prefix), reader, nil
+	}
+
+	header := make([]byte, tlsRecordHeaderSize)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, nil, err
+	}
+	length := int(header[3])<<8 | int(header[4])
+	if length > tlsMaxRecordSize {
+		return nil, nil, errors.New("tls record is too large")
+	}
+	record := make([]byte, tlsRecordHeaderSize+length)
+	copy(record, header)
+	if _, err := io.ReadFull(reader, record[tlsRecordHeaderSize:]); err != nil {
+		return nil, nil, err
+	}
+
+	replay := io.MultiReader(bytes.NewReader(record), reader)
+	if clientHelloOffersProtocol(record[tlsRecordHeaderSize:], membershipALPNProtocol) {
+		return m.membership, replay, nil
+	}
+	return m.grpc, replay, nil
+}
+
+// listenerFor detects protocol from first bytes of connection. Anything other than gRPC and HTTP is membership,
+// which is either TChannel or encrypted with shared key.
+func (m *portMux) listenerFor(prefix []byte) *muxListener {
+	if prefix[0] == tlsHandshakeRecordType || bytes.Equal(prefix, http2Preface) {
+		return m.grpc
+	}
+	for _, method := range http1Prefixes {
+		if bytes.Equal(prefix, method) {
+			return m.http
+		}
+	}
+	return m.membership
+}
+
+// listenerClosed closes root listener once both gRPC and membership listeners are closed
+func (m *portMux) listenerClosed() {
+	m.Lock()
+	defer m.Unlock()
+
+	m.openListeners--
+	if m.openListeners == 0 {
+		_ = m.root.Close()
+	}
+}
+
+func (m *portMux) close() {
+	m.grpc.Close()
+	m.membership.Close()
+	m.http.Close()
+}
+
+func (l *muxListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.closed:
+		return nil, errMuxListenerClosed
+	}
+}
+
+func (l *muxListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		l.onClose()
+	})
+	return nil
+}
+
+func (l *muxListener) Addr() net.Addr {
+	return l.mux.root.Addr()
+}
+
+func (c *peekedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+// clientHelloOffersProtocol returns true if the handshake message is a ClientHello offering the ALPN protocol,
+// malformed and fragmented messages are treated as not offering it
+func clientHelloOffersProtocol(message []byte, protocol string) bool {
+	input := cryptobyte.String(message)
+	var messageType uint8
+	var hello cryptobyte.String
+	if !input.ReadUint8(&messageType) || messageType != tlsClientHelloMessageType || !input.ReadUint24LengthPrefixed(&hello) {
+		return false
+	}
+
+	var ignored cryptobyte.String
+	var extensions cryptobyte.String
+	if !hello.Skip(2+32) || // version and random
+		!hello.ReadUint8LengthPrefixed(&ignored) || // session id
+		!hello.ReadUint16LengthPrefixed(&ignored) || // cipher suites
+		!hello.ReadUint8LengthPrefixed(&ignored) || // compression methods
+		!hello.ReadUint16LengthPrefixed(&extensions) {
+		return false
+	}
+
+	for !extensions.Empty() {
+		var extensionType uint16
+		var extension cryptobyte.String
+		if !extensions.ReadUint16(&extensionType) || !extensions.ReadUint16LengthPrefixed(&extension) {
+			return false
+		}
+		if extensionType != tlsALPNExtensionType {
+			continue
+		}
+		var protocols cryptobyte.String
+		if !extension.ReadUint16LengthPrefixed(&protocols) {
+			return false
+		}
+		for !protocols.Empty() {
+			var name cryptobyte.String
+			if !protocols.ReadUint8LengthPrefixed(&name) {
+				return false
+			}
+			if string(name) == protocol {
+				return true
+			}
+		}
+	}
+	return false
+}
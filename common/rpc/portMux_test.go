@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/tchannel-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/examples/helloworld/helloworld"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/service/config"
+)
+
+type portMuxSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestPortMuxSuite(t *testing.T) {
+	suite.Run(t, new(portMuxSuite))
+}
+
+func (s *portMuxSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *portMuxSuite) TestConsolidatedPort() {
+	s.testConsolidatedPort(config.MembershipEncryption{})
+}
+
+func (s *portMuxSuite) TestConsolidatedPort_SharedKey() {
+	s.testConsolidatedPort(config.MembershipEncryption{SharedKey: "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="})
+}
+
+func (s *portMuxSuite) testConsolidatedPort(encryption config.MembershipEncryption) {
+	membershipConfig := &config.Membership{Encryption: encryption}
+	factory := NewFactory(&config.RPC{BindOnIP: "127.0.0.1", ConsolidatePorts: true}, membershipConfig, "tester", loggerimpl.NewNopLogger(), nil)
+
+	// gRPC
+	server := grpc.NewServer()
+	helloworld.RegisterGreeterServer(server, &HelloServer{})
+	listener := factory.GetGRPCListener()
+	address := listener.Addr().String()
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := Dial(address, nil)
+	s.NoError(err)
+	defer conn.Close()
+	reply, err := helloworld.NewGreeterClient(conn).SayHello(context.Background(), &helloworld.HelloRequest{Name: "mux"})
+	s.NoError(err)
+	s.Equal("Hello mux", reply.GetMessage())
+
+	// HTTP
+	handler := http.NewServeMux()
+	handler.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("metrics"))
+	})
+	factory.ServeConsolidatedHTTP(handler)
+
+	resp, err := http.Get("http://" + address + "/metrics")
+	s.NoError(err)
+	body, err := ioutil.ReadAll(resp.Body)
+	s.NoError(err)
+	s.NoError(resp.Body.Close())
+	s.Equal("metrics", string(body))
+
+	// membership
+	ringpopChannel := factory.GetRingpopChannel()
+	s.Equal(address, ringpopChannel.PeerInfo().HostPort)
+
+	clientFactory := NewFactory(rpcTestCfgDefault, membershipConfig, "tester", loggerimpl.NewNopLogger(), nil)
+	_, dialer, err := clientFactory.getRingpopEncryption(nil)
+	s.NoError(err)
+	clientChannel, err := tchannel.NewChannel("tester-client", &tchannel.ChannelOptions{Dialer: dialer})
+	s.NoError(err)
+	defer clientChannel.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s.NoError(clientChannel.Ping(ctx, address))
+
+	// port is closed once gRPC and membership stop
+	server.Stop()
+	ringpopChannel.Close()
+	s.Eventually(func() bool {
+		conn, err := net.Dial("tcp", address)
+		if err == nil {
+			_ = conn.Close()
+		}
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (s *portMuxSuite) TestListenerFor() {
+	mux := &portMux{}
+	mux.grpc = newMuxListener(mux, func() {})
+	mux.membership = newMuxListener(mux, func() {})
+	mux.http = newMuxListener(mux, func() {})
+
+	s.Same(mux.grpc, mux.listenerFor([]byte("PRI ")))
+	s.Same(mux.grpc, mux.listenerFor([]byte{0x16, 0x03, 0x01, 0x02}))
+	s.Same(mux.http, mux.listenerFor([]byte("GET ")))
+	s.Same(mux.http, mux.listenerFor([]byte("POST")))
+	s.Same(mux.membership, mux.listenerFor([]byte{0x00, 0x8a, 0x01, 0x00}))
+	s.Same(mux.membership, mux.listenerFor([]byte("TSK1")))
+}
+
+func (s *portMuxSuite) TestClientHelloOffersProtocol() {
+	clientHello := func(protocols ...string) []byte {
+		client, server := net.Pipe()
+		defer server.Close()
+		go func() {
+			defer client.Close()
+			_ = tls.Client(client, &tls.Config{InsecureSkipVerify: true, NextProtos: protocols}).Handshake()
+		}()
+		record := make([]byte, tlsRecordHeaderSize)
+		_, err := io.ReadFull(server, record)
+		s.NoError(err)
+		s.Equal(byte(tlsHandshakeRecordType), record[0])
+		message := make([]byte, int(record[3])<<8|int(record[4]))
+		_, err = io.ReadFull(server, message)
+		s.NoError(err)
+		return message
+	}
+
+	s.True(clientHelloOffersProtocol(clientHello(membershipALPNProtocol), membershipALPNProtocol))
+	s.True(clientHelloOffersProtocol(clientHello("h2", membershipALPNProtocol), membershipALPNProtocol))
+	s.False(clientHelloOffersProtocol(clientHello("h2"), membershipALPNProtocol))
+	s.False(clientHelloOffersProtocol(clientHello(), membershipALPNProtocol))
+
+	message := clientHello(membershipALPNProtocol)
+	s.False(clientHelloOffersProtocol(message[:len(message)/2], membershipALPNProtocol))
+	s.False(clientHelloOffersProtocol([]byte{2, 0, 0, 0}, membershipALPNProtocol))
+}
@@ -0,0 +1,96 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc/codes"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/service/config"
+)
+
+// GetClientRetryPolicy returns retry policy of clients calling targetService, i.e. the default policy and retryable
+// error check overridden by settings configured for the target in clientRetryPolicies of rpc config
+func (d *RPCFactory) GetClientRetryPolicy(
+	targetService string,
+	policy backoff.RetryPolicy,
+	isRetryable backoff.IsRetryable,
+) (backoff.RetryPolicy, backoff.IsRetryable, error) {
+
+	retryConfig, ok := d.config.ClientRetryPolicies[targetService]
+	if !ok {
+		return policy, isRetryable, nil
+	}
+	return newClientRetryPolicy(&retryConfig, policy, isRetryable)
+}
+
+func newClientRetryPolicy(
+	retryConfig *config.ClientRetryPolicy,
+	policy backoff.RetryPolicy,
+	isRetryable backoff.IsRetryable,
+) (backoff.RetryPolicy, backoff.IsRetryable, error) {
+
+	if defaultPolicy, ok := policy.(*backoff.ExponentialRetryPolicy); ok {
+		// copy to keep the default policy intact, it may be shared
+		exponentialPolicy := *defaultPolicy
+		if retryConfig.InitialInterval > 0 {
+			exponentialPolicy.SetInitialInterval(retryConfig.InitialInterval)
+		}
+		if retryConfig.BackoffCoefficient > 0 {
+			exponentialPolicy.SetBackoffCoefficient(retryConfig.BackoffCoefficient)
+		}
+		if retryConfig.MaximumInterval > 0 {
+			exponentialPolicy.SetMaximumInterval(retryConfig.MaximumInterval)
+		}
+		if retryConfig.ExpirationInterval > 0 {
+			exponentialPolicy.SetExpirationInterval(retryConfig.ExpirationInterval)
+		}
+		if retryConfig.MaximumAttempts > 0 {
+			exponentialPolicy.SetMaximumAttempts(retryConfig.MaximumAttempts)
+		}
+		policy = &exponentialPolicy
+	}
+
+	if len(retryConfig.RetryableCodes) > 0 {
+		retryableCodes := make(map[codes.Code]struct{}, len(retryConfig.RetryableCodes))
+		for _, name := range retryConfig.RetryableCodes {
+			var code codes.Code
+			if err := code.UnmarshalJSON([]byte(strconv.Quote(strings.ToUpper(name)))); err != nil {
+				return nil, nil, fmt.Errorf("invalid retryable code %q: %w", name, err)
+			}
+			retryableCodes[code] = struct{}{}
+		}
+		isRetryable = func(err error) bool {
+			_, ok := retryableCodes[serviceerror.ToStatus(err).Code()]
+			return ok
+		}
+	}
+	return policy, isRetryable, nil
+}
@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/service/config"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+)
+
+type clientRetrySuite struct {
+	suite.Suite
+}
+
+func TestClientRetrySuite(t *testing.T) {
+	suite.Run(t, &clientRetrySuite{})
+}
+
+func (s *clientRetrySuite) newFactory(policies map[string]config.ClientRetryPolicy) *RPCFactory {
+	cfg := &config.RPC{BindOnIP: "127.0.0.1", ClientRetryPolicies: policies}
+	return NewFactory(cfg, nil, "tester", loggerimpl.NewNopLogger(), nil)
+}
+
+func (s *clientRetrySuite) TestDefaultPolicy() {
+	factory := s.newFactory(map[string]config.ClientRetryPolicy{
+		common.MatchingServiceName: {MaximumAttempts: 3},
+	})
+	defaultPolicy := common.CreateHistoryServiceRetryPolicy()
+
+	policy, isRetryable, err := factory.GetClientRetryPolicy(common.HistoryServiceName, defaultPolicy, common.IsWhitelistServiceTransientError)
+	s.NoError(err)
+	s.Equal(defaultPolicy, policy)
+	s.True(isRetryable(serviceerror.NewUnavailable("unavailable")))
+	s.False(isRetryable(serviceerror.NewNotFound("not found")))
+}
+
+func (s *clientRetrySuite) TestConfiguredPolicy() {
+	factory := s.newFactory(map[string]config.ClientRetryPolicy{
+		common.HistoryServiceName: {
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 3,
+			MaximumInterval:    5 * time.Second,
+			MaximumAttempts:    4,
+			RetryableCodes:     []string{"UNAVAILABLE", "aborted"},
+		},
+	})
+	defaultPolicy := common.CreateHistoryServiceRetryPolicy()
+
+	policy, isRetryable, err := factory.GetClientRetryPolicy(common.HistoryServiceName, defaultPolicy, common.IsWhitelistServiceTransientError)
+	s.NoError(err)
+	s.assertDelay(time.Second, policy.ComputeNextDelay(0, 1))
+	s.assertDelay(3*time.Second, policy.ComputeNextDelay(0, 2))
+	s.assertDelay(5*time.Second, policy.ComputeNextDelay(0, 3))
+	s.assertDelay(5*time.Second, policy.ComputeNextDelay(0, 4))
+	s.Equal(backoff.NoBackoff, policy.ComputeNextDelay(0, 5))
+	// default expiration interval is kept
+	s.Equal(backoff.NoBackoff, policy.ComputeNextDelay(time.Minute, 1))
+	// default policy is not modified
+	s.assertDelay(50*time.Millisecond, defaultPolicy.ComputeNextDelay(0, 1))
+	s.NotEqual(backoff.NoBackoff, defaultPolicy.ComputeNextDelay(0, 5))
+
+	s.True(isRetryable(serviceerror.NewUnavailable("unavailable")))
+	s.True(isRetryable(serviceerrors.NewShardOwnershipLost("owner", "current")))
+	s.False(isRetryable(serviceerror.NewResourceExhausted("busy")))
+	s.False(isRetryable(serviceerror.NewInternal("internal")))
+}
+
+func (s *clientRetrySuite) TestInvalidRetryableCode() {
+	factory := s.newFactory(map[string]config.ClientRetryPolicy{
+		common.FrontendServiceName: {RetryableCodes: []string{"ResourceExhausted"}},
+	})
+
+	_, _, err := factory.GetClientRetryPolicy(common.FrontendServiceName, common.CreateFrontendServiceRetryPolicy(), common.IsWhitelistServiceTransientError)
+	s.Error(err)
+}
+
+// assertDelay asserts delay is the expected interval with jitter applied
+func (s *clientRetrySuite) assertDelay(expected time.Duration, delay time.Duration) {
+	s.True(delay >= expected*8/10 && delay <= expected, "delay %v, expected %v", delay, expected)
+}
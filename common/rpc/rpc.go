@@ -25,46 +25,72 @@
 package rpc
 
 import (
+	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/uber/tchannel-go"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/service/config"
 )
 
+// membershipALPNProtocol is offered by membership TLS connections, which tells them from gRPC on consolidated port
+const membershipALPNProtocol = "temporal-membership"
+
+var errMembershipInternodeTLSNotConfigured = errors.New("membership encryption uses internode TLS but internode TLS is not configured")
+
 // RPCFactory is an implementation of service.RPCFactory interface
 type RPCFactory struct {
-	config      *config.RPC
-	serviceName string
-	logger      log.Logger
+	config           *config.RPC
+	membershipConfig *config.Membership
+	serviceName      string
+	logger           log.Logger
 
 	sync.Mutex
-	grpcListener   net.Listener
-	ringpopChannel *tchannel.Channel
-	tlsFactory     encryption.TLSConfigProvider
+	grpcListener          net.Listener
+	ringpopChannel        *tchannel.Channel
+	portMux               *portMux
+	tlsFactory            encryption.TLSConfigProvider
+	frontendInterceptors  serverInterceptors
+	internodeInterceptors serverInterceptors
+}
+
+// serverInterceptors are gRPC interceptors registered by the application embedding the server
+type serverInterceptors struct {
+	unary  []grpc.UnaryServerInterceptor
+	stream []grpc.StreamServerInterceptor
 }
 
 // NewFactory builds a new RPCFactory
 // conforming to the underlying configuration
-func NewFactory(cfg *config.RPC, sName string, logger log.Logger, tlsProvider encryption.TLSConfigProvider) *RPCFactory {
-	return newFactory(cfg, sName, logger, tlsProvider)
+func NewFactory(cfg *config.RPC, membershipCfg *config.Membership, sName string, logger log.Logger, tlsProvider encryption.TLSConfigProvider) *RPCFactory {
+	return newFactory(cfg, membershipCfg, sName, logger, tlsProvider)
 }
 
-func newFactory(cfg *config.RPC, sName string, logger log.Logger, tlsProvider encryption.TLSConfigProvider) *RPCFactory {
-	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger, tlsFactory: tlsProvider}
+func newFactory(cfg *config.RPC, membershipCfg *config.Membership, sName string, logger log.Logger, tlsProvider encryption.TLSConfigProvider) *RPCFactory {
+	factory := &RPCFactory{config: cfg, membershipConfig: membershipCfg, serviceName: sName, logger: logger, tlsFactory: tlsProvider}
+	if cfg.Compression == config.CompressionZstd {
+		registerZstdCompressor(cfg.MaxReceiveMessageSize)
+	}
 	return factory
 }
 
 func (d *RPCFactory) GetFrontendGRPCServerOptions() ([]grpc.ServerOption, error) {
-	var opts []grpc.ServerOption
+	opts := d.getServerOptions()
 
 	if d.tlsFactory != nil {
 		serverConfig, err := d.tlsFactory.GetFrontendServerConfig()
@@ -80,6 +106,93 @@ func (d *RPCFactory) GetFrontendGRPCServerOptions() ([]grpc.ServerOption, error)
 	return opts, nil
 }
 
+// RegisterFrontendInterceptors adds gRPC interceptors to the frontend server, e.g. custom auth, quota or tracing
+// middleware. They are chained after the built-in interceptors, so they see only authorized requests.
+// Interceptors must be registered before the service is started.
+func (d *RPCFactory) RegisterFrontendInterceptors(unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) {
+	d.Lock()
+	defer d.Unlock()
+
+	d.frontendInterceptors.unary = append(d.frontendInterceptors.unary, unary...)
+	d.frontendInterceptors.stream = append(d.frontendInterceptors.stream, stream...)
+}
+
+// RegisterInternodeInterceptors adds gRPC interceptors to history and matching servers, they are chained after
+// the built-in interceptors. Interceptors must be registered before the service is started.
+func (d *RPCFactory) RegisterInternodeInterceptors(unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) {
+	d.Lock()
+	defer d.Unlock()
+
+	d.internodeInterceptors.unary = append(d.internodeInterceptors.unary, unary...)
+	d.internodeInterceptors.stream = append(d.internodeInterceptors.stream, stream...)
+}
+
+// GetFrontendServerInterceptors returns gRPC interceptors registered for the frontend server
+func (d *RPCFactory) GetFrontendServerInterceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	d.Lock()
+	defer d.Unlock()
+
+	return d.frontendInterceptors.unary, d.frontendInterceptors.stream
+}
+
+// GetInternodeServerInterceptors returns gRPC interceptors registered for history and matching servers
+func (d *RPCFactory) GetInternodeServerInterceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	d.Lock()
+	defer d.Unlock()
+
+	return d.internodeInterceptors.unary, d.internodeInterceptors.stream
+}
+
+// getServerOptions returns options of all gRPC servers of the service, regardless of their TLS settings
+func (d *RPCFactory) getServerOptions() []grpc.ServerOption {
+	settings := d.config.KeepAlive
+	opts := []grpc.ServerOption{
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             settings.MinClientPingInterval,
+			PermitWithoutStream: settings.PermitPingWithoutStream,
+		}),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    settings.ServerPingInterval,
+			Timeout: settings.ServerPingTimeout,
+		}),
+	}
+	if d.config.MaxReceiveMessageSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(d.config.MaxReceiveMessageSize))
+	}
+	if d.config.MaxSendMessageSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(d.config.MaxSendMessageSize))
+	}
+	return opts
+}
+
+// getDialOptions returns options of all gRPC connections dialed by the service
+func (d *RPCFactory) getDialOptions() []grpc.DialOption {
+	var opts []grpc.DialOption
+	settings := d.config.KeepAlive
+	if settings.ClientPingInterval > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                settings.ClientPingInterval,
+			Timeout:             settings.ClientPingTimeout,
+			PermitWithoutStream: settings.PermitPingWithoutStream,
+		}))
+	}
+
+	var callOpts []grpc.CallOption
+	if d.config.MaxReceiveMessageSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(d.config.MaxReceiveMessageSize))
+	}
+	if d.config.MaxSendMessageSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallSendMsgSize(d.config.MaxSendMessageSize))
+	}
+	if d.config.Compression != "" {
+		callOpts = append(callOpts, grpc.UseCompressor(d.config.Compression))
+	}
+	if len(callOpts) > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+	return opts
+}
+
 func (d *RPCFactory) GetFrontendClientTlsConfig() (*tls.Config, error) {
 	if d.tlsFactory != nil {
 		return d.tlsFactory.GetFrontendClientConfig()
@@ -88,8 +201,24 @@ func (d *RPCFactory) GetFrontendClientTlsConfig() (*tls.Config, error) {
 	return nil, nil
 }
 
+// GetRemoteClusterClientTlsConfig returns the TLS config of clients connecting to frontend of the remote cluster,
+// clusters without TLS settings of their own use the frontend client config
+func (d *RPCFactory) GetRemoteClusterClientTlsConfig(clusterName string) (*tls.Config, error) {
+	if d.tlsFactory == nil {
+		return nil, nil
+	}
+
+	if remoteProvider, ok := d.tlsFactory.(encryption.RemoteClusterTLSConfigProvider); ok {
+		clientConfig, err := remoteProvider.GetRemoteClusterClientConfig(clusterName)
+		if err != nil || clientConfig != nil {
+			return clientConfig, err
+		}
+	}
+	return d.tlsFactory.GetFrontendClientConfig()
+}
+
 func (d *RPCFactory) GetInternodeGRPCServerOptions() ([]grpc.ServerOption, error) {
-	var opts []grpc.ServerOption
+	opts := d.getServerOptions()
 
 	if d.tlsFactory != nil {
 		serverConfig, err := d.tlsFactory.GetInternodeServerConfig()
@@ -99,6 +228,11 @@ func (d *RPCFactory) GetInternodeGRPCServerOptions() ([]grpc.ServerOption, error
 		if serverConfig == nil {
 			return opts, nil
 		}
+		if d.config.InternodePlaintextMigration != "" {
+			d.logger.Warn("Internode gRPC server accepts plaintext connections during TLS migration.", tag.Service(d.serviceName))
+			opts = append(opts, grpc.Creds(newPlaintextMigrationCredentials(credentials.NewTLS(serverConfig))))
+			return opts, nil
+		}
 		opts = append(opts, grpc.Creds(credentials.NewTLS(serverConfig)))
 	}
 
@@ -106,6 +240,9 @@ func (d *RPCFactory) GetInternodeGRPCServerOptions() ([]grpc.ServerOption, error
 }
 
 func (d *RPCFactory) GetInternodeClientTlsConfig() (*tls.Config, error) {
+	if d.config.InternodePlaintextMigration == config.PlaintextMigrationDialPlaintext {
+		return nil, nil
+	}
 	if d.tlsFactory != nil {
 		return d.tlsFactory.GetInternodeClientConfig()
 	}
@@ -113,6 +250,28 @@ func (d *RPCFactory) GetInternodeClientTlsConfig() (*tls.Config, error) {
 	return nil, nil
 }
 
+// DrainGRPCServer stops the server gracefully, giving in-flight requests the configured drain timeout to complete
+func (d *RPCFactory) DrainGRPCServer(server *grpc.Server) {
+	timeout := d.config.DrainTimeout
+	if timeout == 0 {
+		timeout = defaultDrainTimeout
+	}
+
+	d.logger.Info("Draining gRPC server.", tag.Service(d.serviceName))
+	if !GracefulStop(server, timeout) {
+		d.logger.Warn("Closed gRPC connections with requests in flight after drain timeout.", tag.Service(d.serviceName))
+	}
+}
+
+// GetCertChains returns the certificate chains presented by this node, nil if the TLS provider can't report them
+func (d *RPCFactory) GetCertChains() ([]encryption.CertChain, error) {
+	if reporter, ok := d.tlsFactory.(encryption.CertChainReporter); ok {
+		return reporter.GetCertChains()
+	}
+
+	return nil, nil
+}
+
 // GetGRPCListener returns cached dispatcher for gRPC inbound or creates one
 func (d *RPCFactory) GetGRPCListener() net.Listener {
 	if d.grpcListener != nil {
@@ -122,8 +281,22 @@ func (d *RPCFactory) GetGRPCListener() net.Listener {
 	d.Lock()
 	defer d.Unlock()
 
+	if d.grpcListener == nil && d.config.ConsolidatePorts {
+		d.grpcListener = d.getPortMux().grpc
+	}
+
+	if d.grpcListener == nil && d.config.GRPCSocket != "" {
+		var err error
+		d.grpcListener, err = listenUnix(d.config.GRPCSocket)
+		if err != nil {
+			d.logger.Fatal("Failed to start gRPC listener", tag.Error(err), tag.Service(d.serviceName), tag.Address(d.config.GRPCSocket))
+		}
+
+		d.logger.Info("Created gRPC listener", tag.Service(d.serviceName), tag.Address(d.config.GRPCSocket))
+	}
+
 	if d.grpcListener == nil {
-		hostAddress := fmt.Sprintf("%v:%v", getListenIP(d.config, d.logger), d.config.GRPCPort)
+		hostAddress := getListenHostPort(d.config, d.config.GRPCPort, d.logger)
 		var err error
 		d.grpcListener, err = net.Listen("tcp", hostAddress)
 
@@ -137,6 +310,34 @@ func (d *RPCFactory) GetGRPCListener() net.Listener {
 	return d.grpcListener
 }
 
+// GetFrontendHTTPListener creates listener of HTTP/JSON gateway of the frontend, which is secured with
+// frontend server TLS config. Nil listener is returned when HTTP port is not configured.
+func (d *RPCFactory) GetFrontendHTTPListener() (net.Listener, error) {
+	if d.config.HTTPPort == 0 {
+		return nil, nil
+	}
+
+	var serverConfig *tls.Config
+	if d.tlsFactory != nil {
+		var err error
+		if serverConfig, err = d.tlsFactory.GetFrontendServerConfig(); err != nil {
+			return nil, err
+		}
+	}
+
+	hostAddress := getListenHostPort(d.config, d.config.HTTPPort, d.logger)
+	listener, err := net.Listen("tcp", hostAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start HTTP listener on %v: %w", hostAddress, err)
+	}
+	if serverConfig != nil {
+		listener = tls.NewListener(listener, serverConfig)
+	}
+
+	d.logger.Info("Created HTTP listener", tag.Service(d.serviceName), tag.Address(hostAddress))
+	return listener, nil
+}
+
 // GetRingpopChannel return a cached ringpop dispatcher
 func (d *RPCFactory) GetRingpopChannel() *tchannel.Channel {
 	if d.ringpopChannel != nil {
@@ -148,30 +349,155 @@ func (d *RPCFactory) GetRingpopChannel() *tchannel.Channel {
 
 	if d.ringpopChannel == nil {
 		ringpopServiceName := fmt.Sprintf("%v-ringpop", d.serviceName)
-		ringpopHostAddress := fmt.Sprintf("%v:%v", getListenIP(d.config, d.logger), d.config.MembershipPort)
+		ringpopHostAddress := getListenHostPort(d.config, d.config.MembershipPort, d.logger)
 
+		var listener net.Listener
 		var err error
-		d.ringpopChannel, err = tchannel.NewChannel(ringpopServiceName, nil)
+		if d.config.ConsolidatePorts {
+			listener = d.getPortMux().membership
+		} else {
+			listener, err = net.Listen("tcp", ringpopHostAddress)
+			if err != nil {
+				d.logger.Fatal("Failed to start ringpop listener", tag.Error(err), tag.Address(ringpopHostAddress))
+			}
+		}
+
+		listener, dialer, err := d.getRingpopEncryption(listener)
+		if err != nil {
+			d.logger.Fatal("Failed to configure ringpop encryption", tag.Error(err))
+		}
+
+		d.ringpopChannel, err = tchannel.NewChannel(ringpopServiceName, &tchannel.ChannelOptions{Dialer: dialer})
 		if err != nil {
 			d.logger.Fatal("Failed to create ringpop TChannel", tag.Error(err))
 		}
 
-		err = d.ringpopChannel.ListenAndServe(ringpopHostAddress)
+		err = d.ringpopChannel.Serve(listener)
 		if err != nil {
-			d.logger.Fatal("Failed to start ringpop listener", tag.Error(err), tag.Address(ringpopHostAddress))
+			d.logger.Fatal("Failed to start ringpop listener", tag.Error(err), tag.Address(listener.Addr().String()))
 		}
 	}
 
 	return d.ringpopChannel
 }
 
+// ServeConsolidatedHTTP serves HTTP handler on the consolidated port,
+// it's no-op if ports of the service are not consolidated
+func (d *RPCFactory) ServeConsolidatedHTTP(handler http.Handler) {
+	if !d.config.ConsolidatePorts {
+		return
+	}
+
+	d.Lock()
+	listener := d.getPortMux().http
+	d.Unlock()
+
+	go func() {
+		if err := http.Serve(listener, handler); err != nil && err != errMuxListenerClosed {
+			d.logger.Error("Failed to serve HTTP on consolidated port", tag.Error(err))
+		}
+	}()
+}
+
+// getPortMux returns multiplexer of the consolidated port, which serves gRPC, membership and HTTP,
+// caller must hold the lock
+func (d *RPCFactory) getPortMux() *portMux {
+	if d.portMux == nil {
+		hostAddress := getListenHostPort(d.config, d.config.GRPCPort, d.logger)
+		listener, err := net.Listen("tcp", hostAddress)
+		if err != nil {
+			d.logger.Fatal("Failed to start consolidated port listener", tag.Error(err), tag.Service(d.serviceName), tag.Address(hostAddress))
+		}
+
+		d.portMux = newPortMux(listener, d.logger)
+		d.logger.Info("Created consolidated port listener", tag.Service(d.serviceName), tag.Address(hostAddress))
+	}
+	return d.portMux
+}
+
+// getRingpopEncryption wraps ringpop listener and returns dialer of ringpop connections, which encrypt
+// and authenticate membership gossip as configured. Nil dialer means default unencrypted dialer.
+func (d *RPCFactory) getRingpopEncryption(
+	listener net.Listener,
+) (net.Listener, func(ctx context.Context, network, hostPort string) (net.Conn, error), error) {
+	if d.membershipConfig == nil {
+		return listener, nil, nil
+	}
+
+	settings := d.membershipConfig.Encryption
+	switch {
+	case settings.UseInternodeTLS:
+		if d.tlsFactory == nil {
+			return nil, nil, errMembershipInternodeTLSNotConfigured
+		}
+		serverConfig, err := d.tlsFactory.GetInternodeServerConfig()
+		if err != nil {
+			return nil, nil, err
+		}
+		clientConfig, err := d.tlsFactory.GetInternodeClientConfig()
+		if err != nil {
+			return nil, nil, err
+		}
+		if serverConfig == nil || clientConfig == nil {
+			return nil, nil, errMembershipInternodeTLSNotConfigured
+		}
+		serverConfig = serverConfig.Clone()
+		serverConfig.NextProtos = []string{membershipALPNProtocol}
+		clientConfig = clientConfig.Clone()
+		clientConfig.NextProtos = []string{membershipALPNProtocol}
+		dialer := &tls.Dialer{Config: clientConfig}
+		return tls.NewListener(listener, serverConfig), dialer.DialContext, nil
+
+	case settings.SharedKey != "":
+		key, err := encryption.DecodeSharedKey(settings.SharedKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		dialer := &net.Dialer{}
+		dial := func(ctx context.Context, network, hostPort string) (net.Conn, error) {
+			conn, err := dialer.DialContext(ctx, network, hostPort)
+			if err != nil {
+				return nil, err
+			}
+			return encryption.SharedKeyClient(conn, key), nil
+		}
+		return encryption.NewSharedKeyListener(listener, key), dial, nil
+
+	default:
+		return listener, nil, nil
+	}
+}
+
 func (d *RPCFactory) getTLSFactory() encryption.TLSConfigProvider {
 	return d.tlsFactory
 }
 
+// GetCertRotator returns the cert rotator of TLS config provider, nil if the provider doesn't rotate certificates
+func (d *RPCFactory) GetCertRotator() encryption.CertRotator {
+	if rotator, ok := d.tlsFactory.(encryption.CertRotator); ok {
+		return rotator
+	}
+	return nil
+}
+
+// listenUnix listens on unix domain socket, socket file left behind by previous process is removed first
+func listenUnix(path string) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	return net.Listen("unix", path)
+}
+
+// getListenHostPort returns address to listen on, IPv6 address is enclosed in brackets
+func getListenHostPort(cfg *config.RPC, port int, logger log.Logger) string {
+	return net.JoinHostPort(getListenIP(cfg, logger).String(), strconv.Itoa(port))
+}
+
 func getListenIP(cfg *config.RPC, logger log.Logger) net.IP {
-	if cfg.BindOnLocalHost && len(cfg.BindOnIP) > 0 {
-		logger.Fatal("ListenIP failed, bindOnLocalHost and bindOnIP are mutually exclusive")
+	if err := cfg.Validate(); err != nil {
+		logger.Fatal("ListenIP failed", tag.Error(err))
 	}
 
 	if cfg.BindOnLocalHost {
@@ -179,11 +505,15 @@ func getListenIP(cfg *config.RPC, logger log.Logger) net.IP {
 	}
 
 	if len(cfg.BindOnIP) > 0 {
-		ip := net.ParseIP(cfg.BindOnIP)
-		if ip != nil && ip.To4() != nil {
-			return ip.To4()
+		return config.ParseIP(cfg.BindOnIP)
+	}
+
+	if len(cfg.BindOnInterface) > 0 {
+		ip, err := config.InterfaceIP(cfg.BindOnInterface)
+		if err != nil {
+			logger.Fatal("ListenIP failed, unable to get address of bindOnInterface", tag.Error(err))
 		}
-		logger.Fatal("ListenIP failed, unable to parse bindOnIP value or it is not IPv4 address", tag.Address(cfg.BindOnIP))
+		return ip
 	}
 	ip, err := config.ListenIP()
 	if err != nil {
@@ -203,25 +533,41 @@ func (d *RPCFactory) CreateFrontendGRPCConnection(hostName string) *grpc.ClientC
 		}
 	}
 
-	return d.dial(hostName, tlsClientConfig)
+	return d.dialFrontend(hostName, tlsClientConfig)
+}
+
+// CreateRemoteFrontendGRPCConnection creates connection for gRPC calls to frontend of the remote cluster
+func (d *RPCFactory) CreateRemoteFrontendGRPCConnection(clusterName string, hostName string) *grpc.ClientConn {
+	tlsClientConfig, err := d.GetRemoteClusterClientTlsConfig(clusterName)
+	if err != nil {
+		d.logger.Fatal("Failed to create tls config for grpc connection", tag.Error(err), tag.ClusterName(clusterName))
+	}
+
+	return d.dialFrontend(hostName, tlsClientConfig)
 }
 
 // CreateGRPCConnection creates connection for gRPC calls
 func (d *RPCFactory) CreateInternodeGRPCConnection(hostName string) *grpc.ClientConn {
-	var tlsClientConfig *tls.Config
-	var err error
-	if d.tlsFactory != nil {
-		tlsClientConfig, err = d.tlsFactory.GetInternodeClientConfig()
-		if err != nil {
-			d.logger.Fatal("Failed to create tls config for grpc connection", tag.Error(err))
-		}
+	tlsClientConfig, err := d.GetInternodeClientTlsConfig()
+	if err != nil {
+		d.logger.Fatal("Failed to create tls config for grpc connection", tag.Error(err))
 	}
 
 	return d.dial(hostName, tlsClientConfig)
 }
 
-func (d *RPCFactory) dial(hostName string, tlsClientConfig *tls.Config) *grpc.ClientConn {
-	connection, err := Dial(hostName, tlsClientConfig)
+// dialFrontend creates connection to frontend, which is balanced and re-resolved as configured
+func (d *RPCFactory) dialFrontend(hostName string, tlsClientConfig *tls.Config) *grpc.ClientConn {
+	settings := d.config.FrontendClientBalancing
+	if settings.DNSRefreshInterval > 0 && !strings.Contains(hostName, "://") {
+		hostName = membership.GRPCDNSResolverTarget(hostName, settings.DNSRefreshInterval)
+	}
+
+	return d.dial(hostName, tlsClientConfig, grpc.WithDefaultServiceConfig(ServiceConfig(settings.Policy)))
+}
+
+func (d *RPCFactory) dial(hostName string, tlsClientConfig *tls.Config, opts ...grpc.DialOption) *grpc.ClientConn {
+	connection, err := Dial(hostName, tlsClientConfig, append(d.getDialOptions(), opts...)...)
 	if err != nil {
 		d.logger.Fatal("Failed to create gRPC connection", tag.Error(err))
 	}
@@ -120,6 +120,10 @@ func dialHello(s suite.Suite, hostport string, clientFactory *TestFactory, serve
 	}
 
 	s.NoError(err)
+	return dialHelloWithTLSConfig(s, hostport, cfg)
+}
+
+func dialHelloWithTLSConfig(s suite.Suite, hostport string, cfg *tls.Config) error {
 	clientConn, err := Dial(hostport, cfg)
 	s.NoError(err)
 	client := helloworld.NewGreeterClient(clientConn)
@@ -0,0 +1,83 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/examples/helloworld/helloworld"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/service/config"
+)
+
+type interceptorsSuite struct {
+	suite.Suite
+}
+
+func TestInterceptorsSuite(t *testing.T) {
+	suite.Run(t, &interceptorsSuite{})
+}
+
+func (s *interceptorsSuite) TestRegisterInterceptors() {
+	var calls []string
+	recordCall := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			calls = append(calls, name)
+			return handler(ctx, req)
+		}
+	}
+
+	factory := NewFactory(&config.RPC{BindOnIP: "127.0.0.1"}, nil, "tester", loggerimpl.NewNopLogger(), nil)
+	factory.RegisterFrontendInterceptors([]grpc.UnaryServerInterceptor{recordCall("first")}, nil)
+	factory.RegisterFrontendInterceptors([]grpc.UnaryServerInterceptor{recordCall("second")}, nil)
+	factory.RegisterInternodeInterceptors([]grpc.UnaryServerInterceptor{recordCall("internode")}, nil)
+
+	var provider common.ServerInterceptorProvider = factory
+	unary, stream := provider.GetFrontendServerInterceptors()
+	s.Len(unary, 2)
+	s.Empty(stream)
+	unary, _ = provider.GetInternodeServerInterceptors()
+	s.Len(unary, 1)
+
+	unary, _ = provider.GetFrontendServerInterceptors()
+	opts, err := factory.GetFrontendGRPCServerOptions()
+	s.NoError(err)
+	server := grpc.NewServer(append(opts, grpc.ChainUnaryInterceptor(unary...))...)
+	helloworld.RegisterGreeterServer(server, &HelloServer{})
+	listener := factory.GetGRPCListener()
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn := factory.CreateFrontendGRPCConnection(listener.Addr().String())
+	defer func() { _ = conn.Close() }()
+	_, err = helloworld.NewGreeterClient(conn).SayHello(context.Background(), &helloworld.HelloRequest{Name: "interceptors"})
+	s.NoError(err)
+	s.Equal([]string{"first", "second"}, calls)
+}
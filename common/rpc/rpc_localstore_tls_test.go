@@ -26,15 +26,48 @@ package rpc
 
 import (
 	"bytes"
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
 	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
 	"encoding/pem"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally/prometheus"
+	"github.com/uber/tchannel-go"
+	"golang.org/x/crypto/ocsp"
+	"golang.org/x/crypto/pbkdf2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/loggerimpl"
@@ -68,6 +101,42 @@ type localStoreRPCSuite struct {
 	frontendClientChain   CertChain
 }
 
+// memoryCertProviderPlugin issues certificates from an in memory CA, standing in for an external source
+type memoryCertProviderPlugin struct{}
+
+type memoryCertProvider struct {
+	settings   *config.GroupTLS
+	serverName string
+	cert       *tls.Certificate
+	caPool     *x509.CertPool
+}
+
+var registerMemoryCertProviderPluginOnce sync.Once
+
+// vaultServer emulates the approle login and the certificate issuing of the Vault PKI secrets engine
+type vaultServer struct {
+	sync.Mutex
+	*httptest.Server
+
+	caCert *tls.Certificate
+	caPEM  string
+	tokens map[string]struct{}
+	logins int
+}
+
+// workloadAPIServer emulates the X.509 SVID stream of the SPIFFE workload API, responses are
+// passed through as is and pushed to the stream by the test
+type workloadAPIServer struct {
+	*grpc.Server
+
+	address   string
+	socketDir string
+	responses chan []byte
+}
+
+// workloadAPIRawCodec passes the serialized messages of the workload API through as is
+type workloadAPIRawCodec struct{}
+
 type CertChain struct {
 	CertPubFile string
 	CertKeyFile string
@@ -88,7 +157,7 @@ func (s *localStoreRPCSuite) SetupSuite() {
 
 	provider, err := encryption.NewTLSConfigProviderFromConfig(serverCfgInsecure.TLS)
 	s.NoError(err)
-	insecureFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	insecureFactory := NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider)
 	s.NotNil(insecureFactory)
 	s.insecureRPCFactory = i(insecureFactory)
 
@@ -197,17 +266,17 @@ func (s *localStoreRPCSuite) setupFrontend(internodeChain CertChain, frontendCha
 
 	provider, err := encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLS.TLS)
 	s.NoError(err)
-	frontendMutualTLSFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	frontendMutualTLSFactory := NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider)
 	s.NotNil(frontendMutualTLSFactory)
 
 	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreServerTLS.TLS)
 	s.NoError(err)
-	frontendServerTLSFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	frontendServerTLSFactory := NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider)
 	s.NotNil(frontendServerTLSFactory)
 
 	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLSSystemWorker.TLS)
 	s.NoError(err)
-	frontendSystemWorkerMutualTLSFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	frontendSystemWorkerMutualTLSFactory := NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider)
 	s.NotNil(frontendSystemWorkerMutualTLSFactory)
 
 	s.frontendMutualTLSRPCFactory = f(frontendMutualTLSFactory)
@@ -297,17 +366,17 @@ func (s *localStoreRPCSuite) setupInternode(internodeChain CertChain, frontendCh
 
 	provider, err := encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLS.TLS)
 	s.NoError(err)
-	internodeMutualTLSFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	internodeMutualTLSFactory := NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider)
 	s.NotNil(internodeMutualTLSFactory)
 
 	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreServerTLS.TLS)
 	s.NoError(err)
-	internodeServerTLSFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	internodeServerTLSFactory := NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider)
 	s.NotNil(internodeServerTLSFactory)
 
 	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreAltMutualTLS.TLS)
 	s.NoError(err)
-	internodeMutualAltTLSFactory := NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	internodeMutualAltTLSFactory := NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider)
 	s.NotNil(internodeMutualAltTLSFactory)
 
 	s.internodeMutualTLSRPCFactory = i(internodeMutualTLSFactory)
@@ -344,6 +413,37 @@ func (s *localStoreRPCSuite) GenerateTestChain(tempDir string, commonName string
 	return CertChain{CaPubFile: caPubFile, CertPubFile: certPubFile, CertKeyFile: certPrivFile}
 }
 
+// generateTestChainWithKey generates chain with RSA or ECDSA keys, server key is written in PEM block of keyBlock
+func (s *localStoreRPCSuite) generateTestChainWithKey(
+	tempDir string,
+	commonName string,
+	caKey crypto.Signer,
+	serverKey crypto.Signer,
+	keyBlock func(crypto.Signer) *pem.Block,
+) CertChain {
+	caCert, err := encryption.GenerateSelfSignedX509CAWithKey("undefined", nil, caKey)
+	s.NoError(err)
+
+	serverCert, err := encryption.GenerateServerX509UsingCAWithKey(commonName, caCert, serverKey)
+	s.NoError(err)
+
+	caPubFile := tempDir + "/ca_pub.pem"
+	certPubFile := tempDir + "/cert_pub.pem"
+	certPrivFile := tempDir + "/cert_priv.pem"
+
+	s.pemEncodeToFile(caPubFile, &pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: caCert.Certificate[0],
+	})
+	s.pemEncodeToFile(certPubFile, &pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: serverCert.Certificate[0],
+	})
+	s.pemEncodeToFile(certPrivFile, keyBlock(serverKey))
+
+	return CertChain{CaPubFile: caPubFile, CertPubFile: certPubFile, CertKeyFile: certPrivFile}
+}
+
 func (s *localStoreRPCSuite) pemEncodeToFile(file string, block *pem.Block) {
 	pemBuffer := new(bytes.Buffer)
 	err := pem.Encode(pemBuffer, block)
@@ -408,3 +508,1461 @@ func (s *localStoreRPCSuite) TestServerTLSInternodeToFrontendAlt() {
 func (s *localStoreRPCSuite) TestMutualTLSSystemWorker() {
 	runHelloWorldTest(s.Suite, "127.0.0.1", s.frontendSystemWorkerMutualTLSRPCFactory, s.frontendSystemWorkerMutualTLSRPCFactory, true)
 }
+
+func (s *localStoreRPCSuite) TestMutualTLSRemoteCluster() {
+	newFactory := func(remoteCluster config.RemoteClusterTLS) *RPCFactory {
+		// local frontend clients trust neither the CA of the remote frontend nor present a certificate it accepts
+		provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+			Internode: config.GroupTLS{
+				Server: config.ServerTLS{
+					CertFile: s.frontendAltChain.CertPubFile,
+					KeyFile:  s.frontendAltChain.CertKeyFile,
+				},
+			},
+			Frontend: config.GroupTLS{
+				Client: config.ClientTLS{
+					RootCAFiles: []string{s.frontendAltChain.CaPubFile},
+				},
+			},
+			RemoteClusters: map[string]config.RemoteClusterTLS{"remote": remoteCluster},
+		})
+		s.NoError(err)
+		return NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider)
+	}
+
+	server, port := startHelloWorldServer(s.Suite, s.frontendMutualTLSRPCFactory)
+	defer server.Stop()
+	hostport := "127.0.0.1:" + port
+
+	factory := newFactory(config.RemoteClusterTLS{
+		CertFile: s.internodeChain.CertPubFile,
+		KeyFile:  s.internodeChain.CertKeyFile,
+		Client: config.ClientTLS{
+			RootCAFiles: []string{s.frontendChain.CaPubFile},
+		},
+	})
+	remoteConfig, err := factory.GetRemoteClusterClientTlsConfig("remote")
+	s.NoError(err)
+	s.NoError(dialHelloWithTLSConfig(s.Suite, hostport, remoteConfig))
+
+	// clusters without settings of their own use the frontend client config
+	otherConfig, err := factory.GetRemoteClusterClientTlsConfig("other")
+	s.NoError(err)
+	frontendConfig, err := factory.GetFrontendClientTlsConfig()
+	s.NoError(err)
+	s.Equal(frontendConfig, otherConfig)
+	s.Error(dialHelloWithTLSConfig(s.Suite, hostport, otherConfig))
+
+	// remote frontend requires a client certificate
+	factory = newFactory(config.RemoteClusterTLS{
+		Client: config.ClientTLS{
+			RootCAFiles: []string{s.frontendChain.CaPubFile},
+		},
+	})
+	remoteConfig, err = factory.GetRemoteClusterClientTlsConfig("remote")
+	s.NoError(err)
+	s.Error(dialHelloWithTLSConfig(s.Suite, hostport, remoteConfig))
+}
+
+func (s *localStoreRPCSuite) TestMutualTLSClientCertAllowlist() {
+	clientCert, err := tls.LoadX509KeyPair(s.internodeChain.CertPubFile, s.internodeChain.CertKeyFile)
+	s.NoError(err)
+	clientX509Cert, err := x509.ParseCertificate(clientCert.Certificate[0])
+	s.NoError(err)
+
+	newFactory := func(allowedDNs []string, allowedSANs []string) *TestFactory {
+		provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+			Internode: config.GroupTLS{
+				Server: config.ServerTLS{
+					CertFile:          s.internodeChain.CertPubFile,
+					KeyFile:           s.internodeChain.CertKeyFile,
+					ClientCAFiles:     []string{s.internodeChain.CaPubFile},
+					RequireClientAuth: true,
+					AllowedClientDNs:  allowedDNs,
+					AllowedClientSANs: allowedSANs,
+				},
+			},
+		})
+		s.NoError(err)
+		return i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	}
+
+	runHelloWorldTest(s.Suite, "127.0.0.1", newFactory([]string{clientX509Cert.Subject.String()}, nil), s.internodeMutualTLSRPCFactory, true)
+	runHelloWorldTest(s.Suite, "127.0.0.1", newFactory([]string{"CN=other"}, []string{"other.example.org", "127.0.0.1"}), s.internodeMutualTLSRPCFactory, true)
+	runHelloWorldTest(s.Suite, "127.0.0.1", newFactory([]string{"CN=other"}, []string{"other.example.org"}), s.internodeMutualTLSRPCFactory, false)
+	runHelloWorldTest(s.Suite, "127.0.0.1", newFactory(nil, []string{"spiffe://example.org/other"}), s.internodeMutualTLSRPCFactory, false)
+}
+
+func (s *localStoreRPCSuite) TestMembershipInternodeTLSConsolidatedPort() {
+	provider := s.internodeMutualTLSRPCFactory.getTLSFactory()
+	membershipConfig := &config.Membership{Encryption: config.MembershipEncryption{UseInternodeTLS: true}}
+	factory := i(NewFactory(&config.RPC{BindOnIP: "127.0.0.1", ConsolidatePorts: true}, membershipConfig, "tester", s.logger, provider))
+
+	// gRPC and membership TLS connections share the port
+	server, port := startHelloWorldServer(s.Suite, factory)
+	defer server.Stop()
+	s.NoError(dialHello(s.Suite, "127.0.0.1:"+port, s.internodeMutualTLSRPCFactory, Internode))
+
+	ringpopChannel := factory.GetRingpopChannel()
+	defer ringpopChannel.Close()
+	s.Equal("127.0.0.1:"+port, ringpopChannel.PeerInfo().HostPort)
+
+	ping := func(clientFactory *RPCFactory) error {
+		_, dialer, err := clientFactory.getRingpopEncryption(nil)
+		s.NoError(err)
+		clientChannel, err := tchannel.NewChannel("tester-client", &tchannel.ChannelOptions{Dialer: dialer})
+		s.NoError(err)
+		defer clientChannel.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return clientChannel.Ping(ctx, ringpopChannel.PeerInfo().HostPort)
+	}
+	s.NoError(ping(NewFactory(rpcTestCfgDefault, membershipConfig, "tester", s.logger, provider)))
+	// gossip from hosts without the internode client certificate is rejected
+	s.Error(ping(NewFactory(rpcTestCfgDefault, membershipConfig, "tester", s.logger, s.internodeServerTLSRPCFactory.getTLSFactory())))
+}
+
+func (s *localStoreRPCSuite) TestCertExpirations() {
+	provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+		Internode: config.GroupTLS{
+			Server: config.ServerTLS{
+				CertFile:          s.internodeChain.CertPubFile,
+				KeyFile:           s.internodeChain.CertKeyFile,
+				ClientCAFiles:     []string{s.internodeChain.CaPubFile},
+				RequireClientAuth: true,
+			},
+			Client: config.ClientTLS{
+				RootCAFiles: []string{s.internodeChain.CaPubFile},
+			},
+		},
+		Frontend: config.GroupTLS{
+			Server: config.ServerTLS{
+				CertFile:          s.frontendChain.CertPubFile,
+				KeyFile:           s.frontendChain.CertKeyFile,
+				ClientCAFiles:     []string{s.internodeChain.CaPubFile},
+				RequireClientAuth: true,
+			},
+			Client: config.ClientTLS{
+				RootCAFiles: []string{s.frontendChain.CaPubFile},
+			},
+		},
+	})
+	s.NoError(err)
+	checker, ok := provider.(encryption.CertExpirationChecker)
+	s.True(ok)
+
+	expirations, err := checker.GetCertExpirations()
+	s.NoError(err)
+	roles := make(map[string]int)
+	for _, expiration := range expirations {
+		roles[expiration.Role]++
+		s.Contains(expiration.Subject, "CN=")
+	}
+	// CAs of internode and frontend are reported once each, though the internode CA issues the worker cert too
+	s.Equal(map[string]int{
+		encryption.CertRoleInternodeServer:    1,
+		encryption.CertRoleSystemWorkerClient: 1,
+		encryption.CertRoleFrontendServer:     1,
+		encryption.CertRoleCA:                 2,
+	}, roles)
+
+	// test certificates expire in 3 years
+	now := time.Now()
+	for _, expiration := range expirations {
+		s.InDelta(now.AddDate(3, 0, 0).Sub(now).Hours()/24, expiration.DaysUntil(now), 1)
+	}
+	s.Empty(encryption.ExpiringCerts(expirations, now, 2*365*24*time.Hour))
+	expiring := encryption.ExpiringCerts(expirations, now.AddDate(2, 0, 0), 2*365*24*time.Hour)
+	s.Len(expiring, len(expirations))
+	for i := 1; i < len(expiring); i++ {
+		s.False(expiring[i].NotAfter.Before(expiring[i-1].NotAfter))
+	}
+
+	provider, err = encryption.NewTLSConfigProviderFromConfig(serverCfgInsecure.TLS)
+	s.NoError(err)
+	expirations, err = provider.(encryption.CertExpirationChecker).GetCertExpirations()
+	s.NoError(err)
+	s.Empty(expirations)
+}
+
+func (s *localStoreRPCSuite) TestMutualTLSHTTPEndpoints() {
+	provider, err := encryption.NewTLSConfigProviderFromConfig(serverCfgInsecure.TLS)
+	s.NoError(err)
+	tlsConfig, err := provider.(encryption.HTTPTLSConfigProvider).GetHTTPServerConfig()
+	s.NoError(err)
+	s.Nil(tlsConfig)
+
+	provider, err = encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+		HTTP: config.GroupTLS{
+			Server: config.ServerTLS{
+				CertFile:          s.internodeChain.CertPubFile,
+				KeyFile:           s.internodeChain.CertKeyFile,
+				ClientCAFiles:     []string{s.frontendClientChain.CaPubFile},
+				RequireClientAuth: true,
+			},
+		},
+	})
+	s.NoError(err)
+	tlsConfig, err = provider.(encryption.HTTPTLSConfigProvider).GetHTTPServerConfig()
+	s.NoError(err)
+	s.NotNil(tlsConfig)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	address := listener.Addr().String()
+	s.NoError(listener.Close())
+	metricsConfig := &config.Metrics{Prometheus: &prometheus.Configuration{ListenAddress: address}}
+	scope := metricsConfig.NewScopeWithTLS(s.logger, nil, tlsConfig)
+	scope.Counter("http_tls_test").Inc(1)
+
+	caPEM, err := ioutil.ReadFile(s.internodeChain.CaPubFile)
+	s.NoError(err)
+	rootCAs := x509.NewCertPool()
+	s.True(rootCAs.AppendCertsFromPEM(caPEM))
+	clientCert, err := tls.LoadX509KeyPair(s.frontendClientChain.CertPubFile, s.frontendClientChain.CertKeyFile)
+	s.NoError(err)
+	get := func(clientConfig *tls.Config) (*http.Response, error) {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientConfig}}
+		return client.Get("https://" + address + "/metrics")
+	}
+
+	var resp *http.Response
+	s.Eventually(func() bool {
+		resp, err = get(&tls.Config{RootCAs: rootCAs, Certificates: []tls.Certificate{clientCert}})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	defer resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	_, err = get(&tls.Config{RootCAs: rootCAs})
+	s.Error(err)
+	// plain HTTP requests are rejected by the TLS server
+	plainResp, err := http.Get("http://" + address + "/metrics")
+	s.NoError(err)
+	defer plainResp.Body.Close()
+	s.Equal(http.StatusBadRequest, plainResp.StatusCode)
+}
+
+func (s *localStoreRPCSuite) TestRotateCertificate() {
+	rotatedCertDir, err := ioutil.TempDir("", "localStoreRPCSuiteRotated")
+	s.NoError(err)
+	defer os.RemoveAll(rotatedCertDir)
+	rotatedChain := s.GenerateTestChain(rotatedCertDir, "127.0.0.1")
+
+	installedCertDir, err := ioutil.TempDir("", "localStoreRPCSuiteInstalled")
+	s.NoError(err)
+	defer os.RemoveAll(installedCertDir)
+	certFile := installedCertDir + "/cert_pub.pem"
+	keyFile := installedCertDir + "/cert_priv.pem"
+	install := func(chain CertChain) {
+		for source, target := range map[string]string{chain.CertPubFile: certFile, chain.CertKeyFile: keyFile} {
+			data, err := ioutil.ReadFile(source)
+			s.NoError(err)
+			s.NoError(ioutil.WriteFile(target, data, os.FileMode(0600)))
+		}
+	}
+	install(s.internodeChain)
+
+	internodeTLS := func(certFile string, keyFile string, trustedCAs []string) config.RootTLS {
+		return config.RootTLS{
+			Internode: config.GroupTLS{
+				Server: config.ServerTLS{
+					CertFile:          certFile,
+					KeyFile:           keyFile,
+					ClientCAData:      trustedCAs,
+					RequireClientAuth: true,
+				},
+				Client: config.ClientTLS{
+					RootCAData: trustedCAs,
+				},
+			},
+		}
+	}
+	// peers trust both current and rotated certificate during transition
+	trustedCAs := []string{convertFileToBase64(s.internodeChain.CaPubFile), convertFileToBase64(rotatedChain.CaPubFile)}
+	serverProvider, err := encryption.NewTLSConfigProviderFromConfig(internodeTLS(certFile, keyFile, trustedCAs))
+	s.NoError(err)
+	// gRPC listener of factory is closed with the server, every server needs its own factory
+	newServerFactory := func() *TestFactory {
+		return i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, serverProvider))
+	}
+	// client which trusts only rotated certificate
+	clientProvider, err := encryption.NewTLSConfigProviderFromConfig(internodeTLS(
+		rotatedChain.CertPubFile, rotatedChain.CertKeyFile, []string{convertFileToBase64(rotatedChain.CaPubFile)}))
+	s.NoError(err)
+	rotatedClientFactory := i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, clientProvider))
+
+	peerProvider, err := encryption.NewTLSConfigProviderFromConfig(internodeTLS(
+		s.internodeChain.CertPubFile, s.internodeChain.CertKeyFile, trustedCAs))
+	s.NoError(err)
+	peer, peerPort := startHelloWorldServer(s.Suite, i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, peerProvider)))
+	defer peer.Stop()
+	// peer which was not configured to trust rotated certificate
+	stalePeerProvider, err := encryption.NewTLSConfigProviderFromConfig(internodeTLS(
+		s.internodeChain.CertPubFile, s.internodeChain.CertKeyFile, []string{convertFileToBase64(s.internodeChain.CaPubFile)}))
+	s.NoError(err)
+	stalePeer, stalePeerPort := startHelloWorldServer(s.Suite, i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, stalePeerProvider)))
+	defer stalePeer.Stop()
+	peers := []string{"127.0.0.1:" + peerPort}
+
+	runHelloWorldTest(s.Suite, "127.0.0.1", newServerFactory(), rotatedClientFactory, false)
+
+	rotator, ok := serverProvider.(encryption.CertRotator)
+	s.True(ok)
+	rotated, err := rotator.RotateCertificate(encryption.TLSGroupInternode, peers)
+	s.NoError(err)
+	s.False(rotated)
+	// peers don't trust the certificate authority of alt chain
+	install(s.frontendAltChain)
+	_, err = rotator.RotateCertificate(encryption.TLSGroupInternode, peers)
+	s.Error(err)
+	install(rotatedChain)
+	_, err = rotator.RotateCertificate(encryption.TLSGroupInternode, []string{"127.0.0.1:" + stalePeerPort})
+	s.Error(err)
+	runHelloWorldTest(s.Suite, "127.0.0.1", newServerFactory(), rotatedClientFactory, false)
+
+	rotated, err = rotator.RotateCertificate(encryption.TLSGroupInternode, peers)
+	s.NoError(err)
+	s.True(rotated)
+	runHelloWorldTest(s.Suite, "127.0.0.1", newServerFactory(), rotatedClientFactory, true)
+	runHelloWorldTest(s.Suite, "127.0.0.1", newServerFactory(), newServerFactory(), true)
+
+	// certificate data is part of static config and can't be reloaded
+	dataTLS := internodeTLS("", "", trustedCAs)
+	dataTLS.Internode.Server.CertData = convertFileToBase64(s.internodeChain.CertPubFile)
+	dataTLS.Internode.Server.KeyData = convertFileToBase64(s.internodeChain.CertKeyFile)
+	dataProvider, err := encryption.NewTLSConfigProviderFromConfig(dataTLS)
+	s.NoError(err)
+	_, err = dataProvider.(encryption.CertRotator).RotateCertificate(encryption.TLSGroupInternode, peers)
+	s.Error(err)
+}
+
+func (s *localStoreRPCSuite) TestServerTLSPrivateKeyFormats() {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	ecdsaCAKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	s.NoError(err)
+
+	pkcs8 := func(key crypto.Signer) *pem.Block {
+		keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+		s.NoError(err)
+		return &pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}
+	}
+	sec1 := func(key crypto.Signer) *pem.Block {
+		keyBytes, err := x509.MarshalECPrivateKey(key.(*ecdsa.PrivateKey))
+		s.NoError(err)
+		return &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}
+	}
+
+	testCases := []struct {
+		name      string
+		caKey     crypto.Signer
+		serverKey crypto.Signer
+		keyBlock  func(crypto.Signer) *pem.Block
+	}{
+		{name: "RSA PKCS#8", caKey: rsaKey, serverKey: rsaKey, keyBlock: pkcs8},
+		{name: "ECDSA PKCS#8", caKey: ecdsaCAKey, serverKey: ecdsaKey, keyBlock: pkcs8},
+		{name: "ECDSA SEC1", caKey: ecdsaCAKey, serverKey: ecdsaKey, keyBlock: sec1},
+		{name: "ECDSA SEC1 signed by RSA CA", caKey: rsaKey, serverKey: ecdsaKey, keyBlock: sec1},
+	}
+
+	for _, tc := range testCases {
+		certDir, err := ioutil.TempDir("", "localStoreRPCSuiteKeyFormat")
+		s.NoError(err)
+		chain := s.generateTestChainWithKey(certDir, "127.0.0.1", tc.caKey, tc.serverKey, tc.keyBlock)
+
+		fromFiles := config.ServerTLS{
+			CertFile: chain.CertPubFile,
+			KeyFile:  chain.CertKeyFile,
+		}
+		fromData := config.ServerTLS{
+			CertData: convertFileToBase64(chain.CertPubFile),
+			KeyData:  convertFileToBase64(chain.CertKeyFile),
+		}
+		for _, serverTLS := range []config.ServerTLS{fromFiles, fromData} {
+			provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+				Internode: config.GroupTLS{
+					Server: serverTLS,
+					Client: config.ClientTLS{
+						RootCAFiles: []string{chain.CaPubFile},
+					},
+				},
+			})
+			s.NoError(err, tc.name)
+			factory := i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+			runHelloWorldTest(s.Suite, "127.0.0.1", factory, factory, true)
+		}
+
+		s.NoError(os.RemoveAll(certDir))
+	}
+}
+
+func (s *localStoreRPCSuite) TestServerTLSEncryptedPrivateKey() {
+	const password = "s3cret"
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+
+	legacy := func(key crypto.Signer) *pem.Block {
+		block, err := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", //nolint:staticcheck
+			x509.MarshalPKCS1PrivateKey(key.(*rsa.PrivateKey)), []byte(password), x509.PEMCipherAES256)
+		s.NoError(err)
+		return block
+	}
+	pkcs8 := func(prf asn1.ObjectIdentifier, keySize int) func(crypto.Signer) *pem.Block {
+		return func(key crypto.Signer) *pem.Block {
+			return s.encryptPKCS8(key, password, prf, keySize)
+		}
+	}
+	hmacWithSHA256 := asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+
+	passwordDir, err := ioutil.TempDir("", "localStoreRPCSuiteKeyPassword")
+	s.NoError(err)
+	defer func() { s.NoError(os.RemoveAll(passwordDir)) }()
+	passwordFile := passwordDir + "/password"
+	s.NoError(ioutil.WriteFile(passwordFile, []byte(password+"\n"), os.FileMode(0600)))
+
+	testCases := []struct {
+		name      string
+		serverKey crypto.Signer
+		keyBlock  func(crypto.Signer) *pem.Block
+	}{
+		{name: "RSA legacy PEM encryption", serverKey: rsaKey, keyBlock: legacy},
+		{name: "RSA PKCS#8 PBES2 SHA1 AES-128", serverKey: rsaKey, keyBlock: pkcs8(nil, 16)},
+		{name: "ECDSA PKCS#8 PBES2 SHA256 AES-256", serverKey: ecdsaKey, keyBlock: pkcs8(hmacWithSHA256, 32)},
+	}
+
+	for _, tc := range testCases {
+		certDir, err := ioutil.TempDir("", "localStoreRPCSuiteEncryptedKey")
+		s.NoError(err)
+		chain := s.generateTestChainWithKey(certDir, "127.0.0.1", rsaKey, tc.serverKey, tc.keyBlock)
+
+		newFactory := func(serverTLS config.ServerTLS) *TestFactory {
+			provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+				Internode: config.GroupTLS{
+					Server: serverTLS,
+					Client: config.ClientTLS{
+						RootCAFiles: []string{chain.CaPubFile},
+					},
+				},
+			})
+			s.NoError(err, tc.name)
+			return i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+		}
+
+		validConfigs := []config.ServerTLS{
+			{CertFile: chain.CertPubFile, KeyFile: chain.CertKeyFile, KeyPassword: password},
+			{CertFile: chain.CertPubFile, KeyFile: chain.CertKeyFile, KeyPasswordFile: passwordFile},
+			{CertData: convertFileToBase64(chain.CertPubFile), KeyData: convertFileToBase64(chain.CertKeyFile), KeyPassword: password},
+		}
+		for _, serverTLS := range validConfigs {
+			factory := newFactory(serverTLS)
+			runHelloWorldTest(s.Suite, "127.0.0.1", factory, factory, true)
+		}
+
+		invalidConfigs := []config.ServerTLS{
+			{CertFile: chain.CertPubFile, KeyFile: chain.CertKeyFile},
+			{CertFile: chain.CertPubFile, KeyFile: chain.CertKeyFile, KeyPassword: "wrong"},
+			{CertFile: chain.CertPubFile, KeyFile: chain.CertKeyFile, KeyPassword: password, KeyPasswordFile: passwordFile},
+		}
+		for _, serverTLS := range invalidConfigs {
+			_, err := newFactory(serverTLS).GetInternodeGRPCServerOptions()
+			s.Error(err, tc.name)
+		}
+
+		s.NoError(os.RemoveAll(certDir))
+	}
+}
+
+func (s *localStoreRPCSuite) TestMutualTLSCertBundle() {
+	const password = "s3cret"
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+	serverKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	caCert, err := encryption.GenerateSelfSignedX509CAWithKey("undefined", nil, caKey)
+	s.NoError(err)
+	serverCert, err := encryption.GenerateServerX509UsingCAWithKey("127.0.0.1", caCert, serverKey)
+	s.NoError(err)
+
+	bundleDir, err := ioutil.TempDir("", "localStoreRPCSuiteCertBundle")
+	s.NoError(err)
+	defer func() { s.NoError(os.RemoveAll(bundleDir)) }()
+	bundleFile := bundleDir + "/bundle.p12"
+	bundle := s.encodePKCS12(serverKey, password, serverCert.Certificate[0], caCert.Certificate[0])
+	s.NoError(ioutil.WriteFile(bundleFile, bundle, os.FileMode(0600)))
+	passwordFile := bundleDir + "/password"
+	s.NoError(ioutil.WriteFile(passwordFile, []byte(password), os.FileMode(0600)))
+	keyFile := bundleDir + "/key.pem"
+	s.pemEncodeToFile(keyFile, s.encryptPKCS8(serverKey, password, nil, 16))
+
+	newFactory := func(serverTLS config.ServerTLS) *TestFactory {
+		serverTLS.RequireClientAuth = true
+		provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+			Internode: config.GroupTLS{
+				Server: serverTLS,
+			},
+		})
+		s.NoError(err)
+		return i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	}
+
+	// bundled CA authenticates both server and client certificate
+	for _, serverTLS := range []config.ServerTLS{
+		{CertBundleFile: bundleFile, CertBundlePassword: password},
+		{CertBundleFile: bundleFile, CertBundlePasswordFile: passwordFile},
+	} {
+		factory := newFactory(serverTLS)
+		runHelloWorldTest(s.Suite, "127.0.0.1", factory, factory, true)
+	}
+
+	for _, serverTLS := range []config.ServerTLS{
+		{CertBundleFile: bundleFile},
+		{CertBundleFile: bundleFile, CertBundlePassword: "wrong"},
+		{CertBundleFile: bundleFile, CertBundlePassword: password, CertBundlePasswordFile: passwordFile},
+		{CertBundleFile: bundleFile, CertBundlePassword: password, KeyFile: keyFile, KeyPassword: password},
+	} {
+		_, err := newFactory(serverTLS).GetInternodeGRPCServerOptions()
+		s.Error(err)
+	}
+}
+
+func (s *localStoreRPCSuite) TestServerTLSMinVersionAndCipherSuites() {
+	const (
+		suiteAES128 = tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256
+		suiteAES256 = tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384
+	)
+
+	newProvider := func(minVersion string, cipherSuites ...string) encryption.TLSConfigProvider {
+		provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+			Internode: config.GroupTLS{
+				Server: config.ServerTLS{
+					CertFile: s.internodeChain.CertPubFile,
+					KeyFile:  s.internodeChain.CertKeyFile,
+				},
+				Client: config.ClientTLS{
+					RootCAFiles: []string{s.internodeChain.CaPubFile},
+				},
+				MinVersion:   minVersion,
+				CipherSuites: cipherSuites,
+			},
+		})
+		s.NoError(err)
+		return provider
+	}
+	handshake := s.internodeHandshake
+	maxTLS12 := func(c *tls.Config) { c.MaxVersion = tls.VersionTLS12 }
+
+	state, err := handshake(newProvider(""), maxTLS12)
+	s.NoError(err)
+	s.Equal(uint16(tls.VersionTLS12), state.Version)
+
+	provider := newProvider("1.3")
+	state, err = handshake(provider, nil)
+	s.NoError(err)
+	s.Equal(uint16(tls.VersionTLS13), state.Version)
+	_, err = handshake(provider, maxTLS12)
+	s.Error(err)
+	factory := i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	runHelloWorldTest(s.Suite, "127.0.0.1", factory, factory, true)
+
+	provider = newProvider("1.2", tls.CipherSuiteName(suiteAES256))
+	state, err = handshake(provider, maxTLS12)
+	s.NoError(err)
+	s.Equal(suiteAES256, state.CipherSuite)
+	_, err = handshake(provider, func(c *tls.Config) {
+		c.MaxVersion = tls.VersionTLS12
+		c.CipherSuites = []uint16{suiteAES128}
+	})
+	s.Error(err)
+	factory = i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	runHelloWorldTest(s.Suite, "127.0.0.1", factory, factory, true)
+
+	_, err = newProvider("1.1").GetInternodeServerConfig()
+	s.Error(err)
+	_, err = newProvider("", "TLS_RSA_WITH_RC4_128_SHA").GetInternodeClientConfig()
+	s.Error(err)
+}
+
+func (s *localStoreRPCSuite) TestMutualTLSCertificateRevocationList() {
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+	caCert, err := encryption.GenerateSelfSignedX509CAWithKey("undefined", nil, caKey)
+	s.NoError(err)
+	otherCAKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+	otherCACert, err := encryption.GenerateSelfSignedX509CAWithKey("other", nil, otherCAKey)
+	s.NoError(err)
+	cert, err := encryption.GenerateServerX509UsingCAWithKey("127.0.0.1", caCert, caKey)
+	s.NoError(err)
+	parsedCert, err := x509.ParseCertificate(cert.Certificate[0])
+	s.NoError(err)
+
+	crlDir, err := ioutil.TempDir("", "localStoreRPCSuiteCRL")
+	s.NoError(err)
+	defer func() { s.NoError(os.RemoveAll(crlDir)) }()
+	caFile := crlDir + "/ca.pem"
+	s.pemEncodeToFile(caFile, &pem.Block{Type: "CERTIFICATE", Bytes: caCert.Certificate[0]})
+	certFile := crlDir + "/cert.pem"
+	s.pemEncodeToFile(certFile, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+	keyFile := crlDir + "/key.pem"
+	s.pemEncodeToFile(keyFile, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(caKey)})
+
+	createCRL := func(ca *tls.Certificate, serialNumbers ...*big.Int) []byte {
+		issuer, err := x509.ParseCertificate(ca.Certificate[0])
+		s.NoError(err)
+		var revoked []pkix.RevokedCertificate
+		for _, serialNumber := range serialNumbers {
+			revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: serialNumber, RevocationTime: time.Now()})
+		}
+		crl, err := issuer.CreateCRL(rand.Reader, ca.PrivateKey, revoked, time.Now(), time.Now().Add(time.Hour)) //nolint:staticcheck
+		s.NoError(err)
+		return crl
+	}
+	writeCRL := func(name string, crls ...[]byte) string {
+		file := crlDir + "/" + name
+		pemBuffer := new(bytes.Buffer)
+		for _, crl := range crls {
+			s.NoError(pem.Encode(pemBuffer, &pem.Block{Type: "X509 CRL", Bytes: crl}))
+		}
+		s.NoError(ioutil.WriteFile(file, pemBuffer.Bytes(), os.FileMode(0644)))
+		return file
+	}
+	revokedCRL := createCRL(caCert, big.NewInt(1), parsedCert.SerialNumber)
+	notRevokedCRL := createCRL(caCert, big.NewInt(1))
+	otherCACRL := createCRL(otherCACert, parsedCert.SerialNumber)
+
+	newProvider := func(serverCRL string, clientCRL string, refreshInterval time.Duration) encryption.TLSConfigProvider {
+		provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+			Internode: config.GroupTLS{
+				Server: config.ServerTLS{
+					CertFile:                                 certFile,
+					KeyFile:                                  keyFile,
+					ClientCAFiles:                            []string{caFile},
+					RequireClientAuth:                        true,
+					CertificateRevocationList:                serverCRL,
+					CertificateRevocationListRefreshInterval: refreshInterval,
+				},
+				Client: config.ClientTLS{
+					RootCAFiles:                              []string{caFile},
+					CertificateRevocationList:                clientCRL,
+					CertificateRevocationListRefreshInterval: refreshInterval,
+				},
+			},
+		})
+		s.NoError(err)
+		return provider
+	}
+
+	// revoked certificate is rejected by server and by client
+	_, err = s.internodeHandshake(newProvider(writeCRL("revoked.pem", revokedCRL), "", 0), nil)
+	s.Error(err)
+	_, err = s.internodeHandshake(newProvider("", writeCRL("revoked.pem", revokedCRL), 0), nil)
+	s.Error(err)
+
+	// lists of other issuers are ignored
+	multipleCRLs := writeCRL("multiple.pem", notRevokedCRL, otherCACRL)
+	provider := newProvider(multipleCRLs, multipleCRLs, 0)
+	_, err = s.internodeHandshake(provider, nil)
+	s.NoError(err)
+	factory := i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	runHelloWorldTest(s.Suite, "127.0.0.1", factory, factory, true)
+
+	// DER encoded list served over http
+	revokedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(revokedCRL)
+	}))
+	defer revokedServer.Close()
+	_, err = s.internodeHandshake(newProvider(revokedServer.URL, "", 0), nil)
+	s.Error(err)
+
+	// list is reloaded after the refresh interval
+	refreshedCRL := writeCRL("refreshed.pem", notRevokedCRL)
+	provider = newProvider(refreshedCRL, "", time.Millisecond)
+	_, err = s.internodeHandshake(provider, nil)
+	s.NoError(err)
+	writeCRL("refreshed.pem", revokedCRL)
+	s.Eventually(func() bool {
+		_, err := s.internodeHandshake(provider, nil)
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = newProvider(crlDir+"/missing.pem", "", 0).GetInternodeServerConfig()
+	s.Error(err)
+	_, err = newProvider("", keyFile, 0).GetInternodeClientConfig()
+	s.Error(err)
+}
+
+func (s *localStoreRPCSuite) TestMutualTLSOCSP() {
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+	caCert, err := encryption.GenerateSelfSignedX509CAWithKey("undefined", nil, caKey)
+	s.NoError(err)
+	issuer, err := x509.ParseCertificate(caCert.Certificate[0])
+	s.NoError(err)
+	cert, err := encryption.GenerateServerX509UsingCAWithKey("127.0.0.1", caCert, caKey)
+	s.NoError(err)
+	parsedCert, err := x509.ParseCertificate(cert.Certificate[0])
+	s.NoError(err)
+
+	ocspDir, err := ioutil.TempDir("", "localStoreRPCSuiteOCSP")
+	s.NoError(err)
+	defer func() { s.NoError(os.RemoveAll(ocspDir)) }()
+	caFile := ocspDir + "/ca.pem"
+	s.pemEncodeToFile(caFile, &pem.Block{Type: "CERTIFICATE", Bytes: caCert.Certificate[0]})
+	// the issuer follows the certificate, so that its OCSP response can be stapled
+	certFile := ocspDir + "/cert.pem"
+	chainBuffer := new(bytes.Buffer)
+	s.NoError(pem.Encode(chainBuffer, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}))
+	s.NoError(pem.Encode(chainBuffer, &pem.Block{Type: "CERTIFICATE", Bytes: caCert.Certificate[0]}))
+	s.NoError(ioutil.WriteFile(certFile, chainBuffer.Bytes(), os.FileMode(0644)))
+	keyFile := ocspDir + "/key.pem"
+	s.pemEncodeToFile(keyFile, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(caKey)})
+
+	newResponder := func(status int) *httptest.Server {
+		response, err := ocsp.CreateResponse(issuer, issuer, ocsp.Response{
+			Status:       status,
+			SerialNumber: parsedCert.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+			RevokedAt:    time.Now().Add(-time.Minute),
+		}, caKey)
+		s.NoError(err)
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(response)
+		}))
+	}
+	goodResponder := newResponder(ocsp.Good)
+	defer goodResponder.Close()
+	revokedResponder := newResponder(ocsp.Revoked)
+	defer revokedResponder.Close()
+	unreachableResponder := newResponder(ocsp.Good)
+	unreachableResponder.Close()
+
+	newProvider := func(responderURL string, failurePolicy string) encryption.TLSConfigProvider {
+		provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+			Internode: config.GroupTLS{
+				Server: config.ServerTLS{
+					CertFile:           certFile,
+					KeyFile:            keyFile,
+					ClientCAFiles:      []string{caFile},
+					RequireClientAuth:  true,
+					EnableOCSPStapling: true,
+					VerifyClientOCSP:   true,
+					OCSPResponderURL:   responderURL,
+					OCSPFailurePolicy:  failurePolicy,
+				},
+				Client: config.ClientTLS{
+					RootCAFiles: []string{caFile},
+				},
+			},
+		})
+		s.NoError(err)
+		return provider
+	}
+
+	// good status is stapled once it is fetched in the background and client is accepted
+	provider := newProvider(goodResponder.URL, config.OCSPFailurePolicyHard)
+	var state tls.ConnectionState
+	s.Eventually(func() bool {
+		state, err = s.internodeHandshake(provider, nil)
+		s.NoError(err)
+		return len(state.OCSPResponse) != 0
+	}, 5*time.Second, 10*time.Millisecond)
+	stapled, err := ocsp.ParseResponseForCert(state.OCSPResponse, parsedCert, issuer)
+	s.NoError(err)
+	s.Equal(ocsp.Good, stapled.Status)
+	factory := i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	runHelloWorldTest(s.Suite, "127.0.0.1", factory, factory, true)
+
+	// revoked client certificate is rejected regardless of the failure policy
+	_, err = s.internodeHandshake(newProvider(revokedResponder.URL, ""), nil)
+	s.Error(err)
+
+	// unreachable responder is tolerated by soft fail only
+	state, err = s.internodeHandshake(newProvider(unreachableResponder.URL, config.OCSPFailurePolicySoft), nil)
+	s.NoError(err)
+	s.Empty(state.OCSPResponse)
+	_, err = s.internodeHandshake(newProvider(unreachableResponder.URL, config.OCSPFailurePolicyHard), nil)
+	s.Error(err)
+
+	_, err = newProvider(goodResponder.URL, "strict").GetInternodeServerConfig()
+	s.Error(err)
+}
+
+func (s *localStoreRPCSuite) TestMutualTLSCertProviderPlugin() {
+	registerMemoryCertProviderPluginOnce.Do(func() {
+		encryption.RegisterCertProviderPlugin("memory", &memoryCertProviderPlugin{})
+	})
+	s.Panics(func() {
+		encryption.RegisterCertProviderPlugin(encryption.LocalStoreCertProviderPluginName, &memoryCertProviderPlugin{})
+	})
+
+	_, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{CertProvider: "unknown"})
+	s.Error(err)
+	_, err = encryption.NewTLSConfigProviderFromConfig(config.RootTLS{CertProvider: "memory"})
+	s.Error(err)
+	_, err = encryption.NewTLSConfigProviderFromCertProviders(&config.RootTLS{}, &encryption.CertProviders{})
+	s.Error(err)
+
+	provider, err := encryption.NewTLSConfigProviderFromConfig(config.RootTLS{
+		CertProvider:        "memory",
+		CertProviderOptions: map[string]string{"serverName": "127.0.0.1"},
+	})
+	s.NoError(err)
+	serverConfig, err := provider.GetInternodeServerConfig()
+	s.NoError(err)
+	s.Equal(tls.RequireAndVerifyClientCert, serverConfig.ClientAuth)
+
+	internodeFactory := i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	frontendFactory := f(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	runHelloWorldTest(s.Suite, "127.0.0.1", internodeFactory, internodeFactory, true)
+	runHelloWorldTest(s.Suite, "127.0.0.1", frontendFactory, frontendFactory, true)
+	// certificates of the local store are not trusted by the plugin CA
+	runHelloWorldTest(s.Suite, "127.0.0.1", s.internodeMutualTLSRPCFactory, internodeFactory, false)
+}
+
+func (s *localStoreRPCSuite) TestMutualTLSVaultCertProvider() {
+	vault := s.newVaultServer()
+	defer vault.Close()
+
+	settings := config.RootTLS{
+		Internode: config.GroupTLS{
+			Server: config.ServerTLS{RequireClientAuth: true},
+		},
+		Frontend: config.GroupTLS{
+			Server: config.ServerTLS{RequireClientAuth: true},
+		},
+		CertProvider: encryption.VaultCertProviderPluginName,
+		Vault: config.VaultTLS{
+			Address:     vault.URL,
+			Role:        "temporal",
+			TTL:         time.Hour,
+			RenewBefore: time.Hour - 2*time.Second,
+			AuthMethod:  "approle",
+			RoleID:      "role",
+			SecretID:    "secret",
+			Internode:   config.VaultCertificate{CommonName: "127.0.0.1", IPSANs: []string{"127.0.0.1"}},
+			Frontend:    config.VaultCertificate{CommonName: "localhost", IPSANs: []string{"127.0.0.1"}},
+		},
+	}
+
+	invalidSettings := settings
+	invalidSettings.Vault.Address = ""
+	_, err := encryption.NewTLSConfigProviderFromConfig(invalidSettings)
+	s.Error(err)
+	invalidSettings = settings
+	invalidSettings.Vault.AuthMethod = "unknown"
+	_, err = encryption.NewTLSConfigProviderFromConfig(invalidSettings)
+	s.Error(err)
+	invalidSettings = settings
+	invalidSettings.Vault.Role = ""
+	_, err = encryption.NewTLSConfigProviderFromConfig(invalidSettings)
+	s.Error(err)
+	invalidSettings = settings
+	invalidSettings.Vault.SecretID = "invalid"
+	provider, err := encryption.NewTLSConfigProviderFromConfig(invalidSettings)
+	s.NoError(err)
+	_, err = provider.GetInternodeServerConfig()
+	s.Error(err)
+
+	provider, err = encryption.NewTLSConfigProviderFromConfig(settings)
+	s.NoError(err)
+	serverConfig, err := provider.GetInternodeServerConfig()
+	s.NoError(err)
+	s.Equal(tls.RequireAndVerifyClientCert, serverConfig.ClientAuth)
+	cert, err := serverConfig.GetCertificate(nil)
+	s.NoError(err)
+	s.Equal("127.0.0.1", cert.Leaf.Subject.CommonName)
+	s.Equal("temporal", cert.Leaf.Subject.Organization[0])
+
+	internodeFactory := i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	frontendFactory := f(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	runHelloWorldTest(s.Suite, "127.0.0.1", internodeFactory, internodeFactory, true)
+	runHelloWorldTest(s.Suite, "127.0.0.1", frontendFactory, frontendFactory, true)
+	// certificates of the local store are not trusted by the vault CA
+	runHelloWorldTest(s.Suite, "127.0.0.1", s.internodeMutualTLSRPCFactory, internodeFactory, false)
+
+	// revoked token is replaced by logging in again when the certificate is renewed in the background
+	vault.Lock()
+	vault.tokens = map[string]struct{}{}
+	vault.Unlock()
+	s.Eventually(func() bool {
+		renewed, err := serverConfig.GetCertificate(nil)
+		s.NoError(err)
+		return renewed.Leaf.SerialNumber.Cmp(cert.Leaf.SerialNumber) != 0
+	}, 10*time.Second, 100*time.Millisecond)
+	vault.Lock()
+	s.Equal(2, vault.logins)
+	vault.Unlock()
+	renewed, err := serverConfig.GetCertificate(nil)
+	s.NoError(err)
+	state, err := s.internodeHandshake(provider, nil)
+	s.NoError(err)
+	s.Equal(renewed.Leaf.Raw, state.PeerCertificates[0].Raw)
+}
+
+func (s *localStoreRPCSuite) TestMutualTLSSPIFFECertProvider() {
+	workloadAPI := s.newWorkloadAPIServer()
+	defer workloadAPI.stop()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	caCert, err := encryption.GenerateSelfSignedX509CAWithKey("spiffe-ca", nil, caKey)
+	s.NoError(err)
+	svidCert, svidKey := s.issueSVID(caCert, "spiffe://example.org/temporal")
+	workloadAPI.responses <- encodeX509SVIDResponse("spiffe://example.org/temporal", svidCert, svidKey, caCert.Certificate[0])
+
+	settings := config.RootTLS{
+		Internode: config.GroupTLS{
+			Server: config.ServerTLS{RequireClientAuth: true},
+		},
+		Frontend: config.GroupTLS{
+			Server: config.ServerTLS{RequireClientAuth: true},
+		},
+		CertProvider: encryption.SPIFFECertProviderPluginName,
+		SPIFFE: config.SPIFFETLS{
+			WorkloadAPIAddress: workloadAPI.address,
+			Internode: config.SPIFFEGroupTLS{
+				Enabled:             true,
+				AuthorizedClientIDs: []string{"spiffe://example.org/temporal"},
+				AuthorizedServerIDs: []string{"spiffe://example.org/temporal"},
+			},
+			Frontend: config.SPIFFEGroupTLS{
+				Enabled:             true,
+				AuthorizedClientIDs: []string{"spiffe://example.org"},
+			},
+		},
+	}
+
+	invalidSettings := settings
+	invalidSettings.SPIFFE.Internode.Enabled = false
+	invalidSettings.SPIFFE.Frontend.Enabled = false
+	_, err = encryption.NewTLSConfigProviderFromConfig(invalidSettings)
+	s.Error(err)
+	invalidSettings = settings
+	invalidSettings.SPIFFE.WorkloadAPIAddress = "http://127.0.0.1:8081"
+	_, err = encryption.NewTLSConfigProviderFromConfig(invalidSettings)
+	s.Error(err)
+	invalidSettings = settings
+	invalidSettings.SPIFFE.Frontend.AuthorizedServerIDs = []string{"https://example.org/temporal"}
+	_, err = encryption.NewTLSConfigProviderFromConfig(invalidSettings)
+	s.Error(err)
+
+	provider, err := encryption.NewTLSConfigProviderFromConfig(settings)
+	s.NoError(err)
+	serverConfig, err := provider.GetInternodeServerConfig()
+	s.NoError(err)
+	s.Equal(tls.RequireAnyClientCert, serverConfig.ClientAuth)
+	cert, err := serverConfig.GetCertificate(nil)
+	s.NoError(err)
+	s.Equal("spiffe://example.org/temporal", cert.Leaf.URIs[0].String())
+	clientConfig, err := provider.GetInternodeClientConfig()
+	s.NoError(err)
+	s.True(clientConfig.InsecureSkipVerify)
+	s.NotNil(clientConfig.VerifyPeerCertificate)
+
+	internodeFactory := i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	frontendFactory := f(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	runHelloWorldTest(s.Suite, "127.0.0.1", internodeFactory, internodeFactory, true)
+	runHelloWorldTest(s.Suite, "127.0.0.1", frontendFactory, frontendFactory, true)
+	// certificates of the local store are not trusted by the SPIFFE bundle, neither by clients nor by servers
+	runHelloWorldTest(s.Suite, "127.0.0.1", s.internodeMutualTLSRPCFactory, internodeFactory, false)
+	internodeServerFactory := i(NewFactory(rpcTestCfgDefault, nil, "tester", s.logger, provider))
+	runHelloWorldTest(s.Suite, "127.0.0.1", internodeServerFactory, s.internodeMutualTLSRPCFactory, false)
+
+	// SVIDs of the trust domain with other SPIFFE IDs are only authorized by frontend
+	otherCert, _ := s.issueSVID(caCert, "spiffe://example.org/other")
+	frontendServerConfig, err := provider.GetFrontendServerConfig()
+	s.NoError(err)
+	err = frontendServerConfig.VerifyPeerCertificate([][]byte{otherCert}, nil)
+	s.NoError(err)
+	err = serverConfig.VerifyPeerCertificate([][]byte{otherCert}, nil)
+	s.Error(err)
+
+	// SVIDs and bundles rotated by the workload API are used by new handshakes
+	rotatedCAKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	rotatedCACert, err := encryption.GenerateSelfSignedX509CAWithKey("spiffe-rotated-ca", nil, rotatedCAKey)
+	s.NoError(err)
+	rotatedCert, rotatedKey := s.issueSVID(rotatedCACert, "spiffe://example.org/temporal")
+	workloadAPI.responses <- encodeX509SVIDResponse(
+		"spiffe://example.org/temporal", rotatedCert, rotatedKey, rotatedCACert.Certificate[0])
+	s.Eventually(func() bool {
+		rotated, err := serverConfig.GetCertificate(nil)
+		s.NoError(err)
+		return bytes.Equal(rotated.Certificate[0], rotatedCert)
+	}, 10*time.Second, 100*time.Millisecond)
+	state, err := s.internodeHandshake(provider, nil)
+	s.NoError(err)
+	s.Equal(rotatedCert, state.PeerCertificates[0].Raw)
+	err = frontendServerConfig.VerifyPeerCertificate([][]byte{otherCert}, nil)
+	s.Error(err)
+}
+
+// internodeHandshake runs a handshake between internode server and client configured by provider,
+// client config is further adjusted by configureClient
+func (s *localStoreRPCSuite) internodeHandshake(
+	provider encryption.TLSConfigProvider,
+	configureClient func(*tls.Config),
+) (tls.ConnectionState, error) {
+	serverConfig, err := provider.GetInternodeServerConfig()
+	s.NoError(err)
+	clientConfig, err := provider.GetInternodeClientConfig()
+	s.NoError(err)
+	clientConfig = clientConfig.Clone()
+	clientConfig.ServerName = "127.0.0.1"
+	if configureClient != nil {
+		configureClient(clientConfig)
+	}
+
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+	serverErrCh := make(chan error, 1)
+	go func() {
+		server := tls.Server(serverConn, serverConfig)
+		err := server.Handshake()
+		if err == nil {
+			// with TLS 1.3 client certificate is verified after client completes the handshake,
+			// the failure is only observed by server
+			_, err = server.Write([]byte{0})
+		}
+		serverErrCh <- err
+		_ = serverConn.Close()
+	}()
+	client := tls.Client(clientConn, clientConfig)
+	clientErr := client.Handshake()
+	if clientErr == nil {
+		_, clientErr = client.Read(make([]byte, 1))
+	}
+	// unblock the server if client fails first
+	_ = clientConn.Close()
+	serverErr := <-serverErrCh
+	if clientErr != nil {
+		return client.ConnectionState(), clientErr
+	}
+	return client.ConnectionState(), serverErr
+}
+
+// encodePKCS12 encodes the key shrouded with PBES2 and the certificates into a PKCS#12 bundle without MAC
+func (s *localStoreRPCSuite) encodePKCS12(key crypto.Signer, password string, certs ...[]byte) []byte {
+	type safeBag struct {
+		ID    asn1.ObjectIdentifier
+		Value asn1.RawValue
+	}
+	type certBag struct {
+		ID   asn1.ObjectIdentifier
+		Data asn1.RawValue
+	}
+	type contentInfo struct {
+		ContentType asn1.ObjectIdentifier
+		Content     asn1.RawValue
+	}
+	type pfx struct {
+		Version  int
+		AuthSafe contentInfo
+	}
+	explicit := func(value interface{}) asn1.RawValue {
+		valueBytes, err := asn1.Marshal(value)
+		s.NoError(err)
+		return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: valueBytes}
+	}
+	oidData := asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+
+	bags := []safeBag{{
+		ID:    asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2},
+		Value: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: s.encryptPKCS8(key, password, nil, 32).Bytes},
+	}}
+	for _, cert := range certs {
+		bags = append(bags, safeBag{
+			ID: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3},
+			Value: explicit(certBag{
+				ID:   asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1},
+				Data: explicit(cert),
+			}),
+		})
+	}
+	safeContents, err := asn1.Marshal(bags)
+	s.NoError(err)
+	authSafe, err := asn1.Marshal([]contentInfo{{ContentType: oidData, Content: explicit(safeContents)}})
+	s.NoError(err)
+	bundle, err := asn1.Marshal(pfx{Version: 3, AuthSafe: contentInfo{ContentType: oidData, Content: explicit(authSafe)}})
+	s.NoError(err)
+	return bundle
+}
+
+// encryptPKCS8 encrypts the key as PKCS#8 EncryptedPrivateKeyInfo using PBES2 with PBKDF2 and AES-CBC,
+// the same way as "openssl pkcs8 -topk8 -v2 aes-256-cbc" does.
+func (s *localStoreRPCSuite) encryptPKCS8(key crypto.Signer, password string, prf asn1.ObjectIdentifier, keySize int) *pem.Block {
+	type pbkdf2Params struct {
+		Salt           []byte
+		IterationCount int
+		PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+	}
+	type pbes2Params struct {
+		KeyDerivationFunc pkix.AlgorithmIdentifier
+		EncryptionScheme  pkix.AlgorithmIdentifier
+	}
+	type encryptedPrivateKeyInfo struct {
+		Algorithm     pkix.AlgorithmIdentifier
+		EncryptedData []byte
+	}
+	aesCBC := map[int]asn1.ObjectIdentifier{
+		16: {2, 16, 840, 1, 101, 3, 4, 1, 2},
+		32: {2, 16, 840, 1, 101, 3, 4, 1, 42},
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	s.NoError(err)
+	salt := make([]byte, 16)
+	iv := make([]byte, aes.BlockSize)
+	_, err = rand.Read(salt)
+	s.NoError(err)
+	_, err = rand.Read(iv)
+	s.NoError(err)
+	const iterations = 2048
+
+	hashFn := sha1.New
+	kdfParams := pbkdf2Params{Salt: salt, IterationCount: iterations}
+	if prf != nil {
+		hashFn = sha256.New
+		kdfParams.PRF = pkix.AlgorithmIdentifier{Algorithm: prf, Parameters: asn1.NullRawValue}
+	}
+	block, err := aes.NewCipher(pbkdf2.Key([]byte(password), salt, iterations, keySize, hashFn))
+	s.NoError(err)
+	padding := aes.BlockSize - len(der)%aes.BlockSize
+	plaintext := append(der, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	encrypted := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, plaintext)
+
+	kdfParamsBytes, err := asn1.Marshal(kdfParams)
+	s.NoError(err)
+	ivBytes, err := asn1.Marshal(iv)
+	s.NoError(err)
+	schemeBytes, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{
+			Algorithm:  asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12},
+			Parameters: asn1.RawValue{FullBytes: kdfParamsBytes},
+		},
+		EncryptionScheme: pkix.AlgorithmIdentifier{
+			Algorithm:  aesCBC[keySize],
+			Parameters: asn1.RawValue{FullBytes: ivBytes},
+		},
+	})
+	s.NoError(err)
+	keyInfo, err := asn1.Marshal(encryptedPrivateKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{
+			Algorithm:  asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13},
+			Parameters: asn1.RawValue{FullBytes: schemeBytes},
+		},
+		EncryptedData: encrypted,
+	})
+	s.NoError(err)
+	return &pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: keyInfo}
+}
+
+func (p *memoryCertProviderPlugin) CreateCertProviders(settings *config.RootTLS) (*encryption.CertProviders, error) {
+	serverName := settings.CertProviderOptions["serverName"]
+	if serverName == "" {
+		return nil, errors.New("serverName option is required")
+	}
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	caCert, err := encryption.GenerateSelfSignedX509CAWithKey("undefined", nil, caKey)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := encryption.GenerateServerX509UsingCAWithKey(serverName, caCert, key)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := x509.ParseCertificate(caCert.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	caPool := x509.NewCertPool()
+	caPool.AddCert(ca)
+
+	provider := &memoryCertProvider{
+		settings: &config.GroupTLS{
+			Server: config.ServerTLS{RequireClientAuth: true},
+		},
+		serverName: serverName,
+		cert:       cert,
+		caPool:     caPool,
+	}
+	return &encryption.CertProviders{
+		Internode:       provider,
+		InternodeClient: provider,
+		Frontend:        provider,
+		SystemWorker:    provider,
+	}, nil
+}
+
+func (p *memoryCertProvider) FetchServerCertificate() (*tls.Certificate, error) {
+	return p.cert, nil
+}
+
+func (p *memoryCertProvider) FetchClientCAs() (*x509.CertPool, error) {
+	return p.caPool, nil
+}
+
+func (p *memoryCertProvider) FetchClientRevocationChecker() (encryption.RevocationChecker, error) {
+	return nil, nil
+}
+
+func (p *memoryCertProvider) GetSettings() *config.GroupTLS {
+	return p.settings
+}
+
+func (p *memoryCertProvider) IsEnabled() bool {
+	return true
+}
+
+func (p *memoryCertProvider) FetchClientCertificate(bool) (*tls.Certificate, error) {
+	return p.cert, nil
+}
+
+func (p *memoryCertProvider) FetchServerRootCAsForClient(bool) (*x509.CertPool, error) {
+	return p.caPool, nil
+}
+
+func (p *memoryCertProvider) FetchServerRevocationCheckerForClient(bool) (encryption.RevocationChecker, error) {
+	return nil, nil
+}
+
+func (p *memoryCertProvider) ServerName(bool) string {
+	return p.serverName
+}
+
+func (p *memoryCertProvider) DisableHostVerification(bool) bool {
+	return false
+}
+
+func (s *localStoreRPCSuite) newVaultServer() *vaultServer {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	caCert, err := encryption.GenerateSelfSignedX509CAWithKey("undefined", nil, caKey)
+	s.NoError(err)
+
+	vault := &vaultServer{
+		caCert: caCert,
+		caPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Certificate[0]})),
+		tokens: map[string]struct{}{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/auth/approle/login", vault.login)
+	mux.HandleFunc("/v1/pki/issue/temporal", vault.issue)
+	vault.Server = httptest.NewServer(mux)
+	return vault
+}
+
+func (v *vaultServer) login(w http.ResponseWriter, r *http.Request) {
+	var request map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil ||
+		request["role_id"] != "role" || request["secret_id"] != "secret" {
+		v.writeErrors(w, http.StatusBadRequest, "invalid role or secret ID")
+		return
+	}
+
+	v.Lock()
+	defer v.Unlock()
+	v.logins++
+	token := fmt.Sprintf("token-%d", v.logins)
+	v.tokens[token] = struct{}{}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"auth": map[string]interface{}{"client_token": token, "lease_duration": 3600},
+	})
+}
+
+func (v *vaultServer) issue(w http.ResponseWriter, r *http.Request) {
+	v.Lock()
+	_, isValidToken := v.tokens[r.Header.Get("X-Vault-Token")]
+	v.Unlock()
+	if !isValidToken {
+		v.writeErrors(w, http.StatusForbidden, "permission denied")
+		return
+	}
+	var request map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		v.writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	ttl, err := time.ParseDuration(request["ttl"])
+	if err != nil {
+		v.writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(now.UnixNano()),
+		Subject: pkix.Name{
+			CommonName:   request["common_name"],
+			Organization: []string{"temporal"},
+		},
+		NotBefore:   now.Add(-time.Minute),
+		NotAfter:    now.Add(ttl),
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+	}
+	if request["common_name"] == "localhost" {
+		template.DNSNames = []string{"localhost"}
+	}
+	for _, ip := range strings.Split(request["ip_sans"], ",") {
+		template.IPAddresses = append(template.IPAddresses, net.ParseIP(ip))
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		v.writeErrors(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	caCert, err := x509.ParseCertificate(v.caCert.Certificate[0])
+	if err != nil {
+		v.writeErrors(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, caCert, key.Public(), v.caCert.PrivateKey)
+	if err != nil {
+		v.writeErrors(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		v.writeErrors(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"data": map[string]interface{}{
+			"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})),
+			"issuing_ca":  v.caPEM,
+			"ca_chain":    []string{v.caPEM},
+			"private_key": string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes})),
+		},
+	})
+}
+
+func (v *vaultServer) writeErrors(w http.ResponseWriter, status int, errors ...string) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": errors})
+}
+
+func (s *localStoreRPCSuite) newWorkloadAPIServer() *workloadAPIServer {
+	socketDir, err := ioutil.TempDir("", "workload-api")
+	s.NoError(err)
+	socket := socketDir + "/agent.sock"
+	listener, err := net.Listen("unix", socket)
+	s.NoError(err)
+
+	workloadAPI := &workloadAPIServer{
+		Server:    grpc.NewServer(grpc.CustomCodec(workloadAPIRawCodec{})),
+		address:   "unix://" + socket,
+		socketDir: socketDir,
+		responses: make(chan []byte, 10),
+	}
+	workloadAPI.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "SpiffeWorkloadAPI",
+		HandlerType: (*interface{})(nil),
+		Streams: []grpc.StreamDesc{{
+			StreamName:    "FetchX509SVID",
+			ServerStreams: true,
+			Handler: func(_ interface{}, stream grpc.ServerStream) error {
+				return workloadAPI.fetchX509SVID(stream)
+			},
+		}},
+	}, nil)
+	go func() { _ = workloadAPI.Serve(listener) }()
+	return workloadAPI
+}
+
+func (w *workloadAPIServer) fetchX509SVID(stream grpc.ServerStream) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	if len(md.Get("workload.spiffe.io")) == 0 {
+		return status.Error(codes.InvalidArgument, "security header missing from request")
+	}
+	var request []byte
+	if err := stream.RecvMsg(&request); err != nil {
+		return err
+	}
+	for {
+		select {
+		case response := <-w.responses:
+			if err := stream.SendMsg(response); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (w *workloadAPIServer) stop() {
+	w.Stop()
+	_ = os.RemoveAll(w.socketDir)
+}
+
+// issueSVID returns the ASN.1 DER encoded X.509 SVID and its PKCS#8 private key
+func (s *localStoreRPCSuite) issueSVID(caCert *tls.Certificate, id string) ([]byte, crypto.Signer) {
+	spiffeID, err := url.Parse(id)
+	s.NoError(err)
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(now.UnixNano()),
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		URIs:         []*url.URL{spiffeID},
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	ca, err := x509.ParseCertificate(caCert.Certificate[0])
+	s.NoError(err)
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, ca, key.Public(), caCert.PrivateKey)
+	s.NoError(err)
+	return certBytes, key
+}
+
+// encodeX509SVIDResponse encodes an X509SVIDResponse with a single SVID in the protobuf wire format
+func encodeX509SVIDResponse(id string, cert []byte, key crypto.Signer, bundle []byte) []byte {
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		panic(err)
+	}
+	var svid []byte
+	svid = appendProtoBytes(svid, 1, []byte(id))
+	svid = appendProtoBytes(svid, 2, cert)
+	svid = appendProtoBytes(svid, 3, keyBytes)
+	svid = appendProtoBytes(svid, 4, bundle)
+	return appendProtoBytes(nil, 1, svid)
+}
+
+func appendProtoBytes(message []byte, field uint64, value []byte) []byte {
+	varint := make([]byte, binary.MaxVarintLen64)
+	message = append(message, varint[:binary.PutUvarint(varint, field<<3|2)]...)
+	message = append(message, varint[:binary.PutUvarint(varint, uint64(len(value)))]...)
+	return append(message, value...)
+}
+
+func (workloadAPIRawCodec) Marshal(v interface{}) ([]byte, error) {
+	return v.([]byte), nil
+}
+
+func (workloadAPIRawCodec) Unmarshal(data []byte, v interface{}) error {
+	*v.(*[]byte) = append([]byte(nil), data...)
+	return nil
+}
+
+func (workloadAPIRawCodec) String() string {
+	return "proto"
+}
@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/service/config"
+)
+
+type membershipEncryptionSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestMembershipEncryptionSuite(t *testing.T) {
+	suite.Run(t, new(membershipEncryptionSuite))
+}
+
+func (s *membershipEncryptionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *membershipEncryptionSuite) newFactory(encryption config.MembershipEncryption) *RPCFactory {
+	return NewFactory(rpcTestCfgDefault, &config.Membership{Encryption: encryption}, "tester", loggerimpl.NewNopLogger(), nil)
+}
+
+// startEchoServer echoes everything read from accepted connections until read fails
+func (s *membershipEncryptionSuite) startEchoServer(factory *RPCFactory) net.Listener {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	listener, _, err = factory.getRingpopEncryption(listener)
+	s.NoError(err)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return listener
+}
+
+func (s *membershipEncryptionSuite) TestNoEncryption() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	defer listener.Close()
+
+	wrapped, dialer, err := s.newFactory(config.MembershipEncryption{}).getRingpopEncryption(listener)
+	s.NoError(err)
+	s.Equal(listener, wrapped)
+	s.Nil(dialer)
+}
+
+func (s *membershipEncryptionSuite) TestInternodeTLSNotConfigured() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	defer listener.Close()
+
+	_, _, err = s.newFactory(config.MembershipEncryption{UseInternodeTLS: true}).getRingpopEncryption(listener)
+	s.Equal(errMembershipInternodeTLSNotConfigured, err)
+}
+
+func (s *membershipEncryptionSuite) TestSharedKey() {
+	factory := s.newFactory(config.MembershipEncryption{SharedKey: "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="})
+	listener := s.startEchoServer(factory)
+	defer listener.Close()
+
+	_, dialer, err := factory.getRingpopEncryption(nil)
+	s.NoError(err)
+	conn, err := dialer(context.Background(), "tcp", listener.Addr().String())
+	s.NoError(err)
+	defer conn.Close()
+
+	// larger than single frame
+	message := bytes.Repeat([]byte("gossip"), 10000)
+	go func() {
+		_, _ = conn.Write(message)
+	}()
+	received := make([]byte, len(message))
+	_, err = io.ReadFull(conn, received)
+	s.NoError(err)
+	s.Equal(message, received)
+}
+
+func (s *membershipEncryptionSuite) TestSharedKeyMismatch() {
+	serverFactory := s.newFactory(config.MembershipEncryption{SharedKey: "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="})
+	listener := s.startEchoServer(serverFactory)
+	defer listener.Close()
+
+	clientFactory := s.newFactory(config.MembershipEncryption{SharedKey: "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="})
+	_, dialer, err := clientFactory.getRingpopEncryption(nil)
+	s.NoError(err)
+	conn, err := dialer(context.Background(), "tcp", listener.Addr().String())
+	s.NoError(err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("gossip"))
+	s.NoError(err)
+	_, err = conn.Read(make([]byte, 6))
+	s.Error(err)
+}
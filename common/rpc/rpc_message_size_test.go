@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/examples/helloworld/helloworld"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/service/config"
+)
+
+type messageSizeSuite struct {
+	suite.Suite
+}
+
+func TestMessageSizeSuite(t *testing.T) {
+	suite.Run(t, &messageSizeSuite{})
+}
+
+func (s *messageSizeSuite) newFactory(maxReceiveMessageSize int) *TestFactory {
+	cfg := &config.RPC{BindOnIP: "127.0.0.1", MaxReceiveMessageSize: maxReceiveMessageSize}
+	return &TestFactory{
+		RPCFactory:  NewFactory(cfg, nil, "tester", loggerimpl.NewNopLogger(), nil),
+		serverUsage: Internode,
+	}
+}
+
+func (s *messageSizeSuite) sayHello(server *TestFactory, client *TestFactory, nameSize int) error {
+	grpcServer, port := startHelloWorldServer(s.Suite, server)
+	defer grpcServer.Stop()
+
+	conn := client.CreateInternodeGRPCConnection("127.0.0.1:" + port)
+	defer func() { _ = conn.Close() }()
+	_, err := helloworld.NewGreeterClient(conn).SayHello(context.Background(), &helloworld.HelloRequest{
+		Name: strings.Repeat("a", nameSize),
+	})
+	return err
+}
+
+func (s *messageSizeSuite) TestServerMaxReceiveMessageSize() {
+	s.NoError(s.sayHello(s.newFactory(16*1024), s.newFactory(0), 8*1024))
+	s.Error(s.sayHello(s.newFactory(16*1024), s.newFactory(0), 32*1024))
+	s.NoError(s.sayHello(s.newFactory(8*1024*1024), s.newFactory(8*1024*1024), 6*1024*1024))
+}
+
+func (s *messageSizeSuite) TestClientMaxReceiveMessageSize() {
+	s.NoError(s.sayHello(s.newFactory(0), s.newFactory(16*1024), 8*1024))
+	s.Error(s.sayHello(s.newFactory(0), s.newFactory(16*1024), 32*1024))
+}
@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/examples/helloworld/helloworld"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/service/config"
+)
+
+type unixSocketSuite struct {
+	suite.Suite
+}
+
+func TestUnixSocketSuite(t *testing.T) {
+	suite.Run(t, &unixSocketSuite{})
+}
+
+func (s *unixSocketSuite) TestDialUnixSocket() {
+	socketPath := filepath.Join(s.T().TempDir(), "frontend.sock")
+
+	// socket file left behind by previous process
+	stale, err := net.Listen("unix", socketPath)
+	s.NoError(err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	s.NoError(stale.Close())
+
+	server := NewFactory(&config.RPC{GRPCSocket: socketPath}, nil, "tester", loggerimpl.NewNopLogger(), nil)
+	opts, err := server.GetInternodeGRPCServerOptions()
+	s.NoError(err)
+	grpcServer := grpc.NewServer(opts...)
+	helloworld.RegisterGreeterServer(grpcServer, &HelloServer{})
+	listener := server.GetGRPCListener()
+	s.Equal("unix", listener.Addr().Network())
+	go func() { _ = grpcServer.Serve(listener) }()
+	defer grpcServer.Stop()
+
+	client := NewFactory(&config.RPC{BindOnIP: "127.0.0.1"}, nil, "tester", loggerimpl.NewNopLogger(), nil)
+	conn := client.CreateInternodeGRPCConnection("unix://" + socketPath)
+	defer func() { _ = conn.Close() }()
+	reply, err := helloworld.NewGreeterClient(conn).SayHello(context.Background(), &helloworld.HelloRequest{Name: "socket"})
+	s.NoError(err)
+	s.Equal("Hello socket", reply.GetMessage())
+}
@@ -26,6 +26,7 @@ package config
 
 import (
 	"errors"
+	"net/url"
 
 	"go.temporal.io/server/common"
 )
@@ -40,6 +41,14 @@ func (a *Archival) Validate(namespaceDefaults *ArchivalNamespaceDefaults) error
 		return errors.New("Invalid visibility archival config")
 	}
 
+	if !areArchivalDestinationsValid(a.History.AllowedDestinations) {
+		return errors.New("Invalid history archival allowed destinations")
+	}
+
+	if !areArchivalDestinationsValid(a.Visibility.AllowedDestinations) {
+		return errors.New("Invalid visibility archival allowed destinations")
+	}
+
 	return nil
 }
 
@@ -57,3 +66,15 @@ func isArchivalConfigValid(
 	validDisabled := !archivalEnabled && !enableRead && namespaceDefaultStatus != common.ArchivalEnabled && !URISet && !specifiedProvider
 	return validEnable || validDisabled
 }
+
+func areArchivalDestinationsValid(
+	destinations []ArchivalDestination,
+) bool {
+	for _, destination := range destinations {
+		URI, err := url.Parse(destination.URI)
+		if err != nil || len(URI.Scheme) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+// Validate validates the bootstrap config
+func (b *Bootstrap) Validate() error {
+	names := make(map[string]struct{}, len(b.Namespaces))
+	for _, ns := range b.Namespaces {
+		if ns.Name == "" {
+			return fmt.Errorf("invalid bootstrap config: namespace name is empty")
+		}
+		if ns.Name == common.SystemLocalNamespace {
+			return fmt.Errorf("invalid bootstrap config: namespace %q is reserved by system", ns.Name)
+		}
+		if _, ok := names[ns.Name]; ok {
+			return fmt.Errorf("invalid bootstrap config: namespace %q is defined more than once", ns.Name)
+		}
+		names[ns.Name] = struct{}{}
+		if ns.Retention < 0 || ns.Retention > common.MaxWorkflowRetentionPeriod {
+			return fmt.Errorf("invalid bootstrap config: retention of namespace %q must be between 0 and %v", ns.Name, common.MaxWorkflowRetentionPeriod)
+		}
+	}
+
+	for name, valueType := range b.SearchAttributes {
+		if _, err := GetIndexedValueType(valueType); err != nil {
+			return fmt.Errorf("invalid bootstrap config: search attribute %q: %v", name, err)
+		}
+	}
+
+	for name := range b.DynamicConfig {
+		if _, ok := dynamicconfig.GetKeyByName(name); !ok {
+			return fmt.Errorf("invalid bootstrap config: unknown dynamic config key %q", name)
+		}
+	}
+
+	return nil
+}
+
+// GetIndexedValueType converts case insensitive type name (e.g. keyword) to search attribute type
+func GetIndexedValueType(name string) (enumspb.IndexedValueType, error) {
+	for typeName, value := range enumspb.IndexedValueType_value {
+		if value != int32(enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED) && strings.EqualFold(typeName, name) {
+			return enumspb.IndexedValueType(value), nil
+		}
+	}
+	return enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, fmt.Errorf("unknown search attribute type %q", name)
+}
@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+func TestBootstrap_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   Bootstrap
+		wantErr bool
+	}{
+		{
+			name:  "Empty",
+			input: Bootstrap{},
+		},
+		{
+			name: "Valid",
+			input: Bootstrap{
+				Namespaces:       []BootstrapNamespace{{Name: "default", Retention: 72 * time.Hour}},
+				SearchAttributes: map[string]string{"CustomerId": "keyword"},
+				DynamicConfig:    map[string]interface{}{"frontend.rps": 100},
+			},
+		},
+		{
+			name:    "Empty Namespace Name",
+			input:   Bootstrap{Namespaces: []BootstrapNamespace{{}}},
+			wantErr: true,
+		},
+		{
+			name:    "System Namespace",
+			input:   Bootstrap{Namespaces: []BootstrapNamespace{{Name: "temporal-system"}}},
+			wantErr: true,
+		},
+		{
+			name:    "Duplicate Namespace",
+			input:   Bootstrap{Namespaces: []BootstrapNamespace{{Name: "default"}, {Name: "default"}}},
+			wantErr: true,
+		},
+		{
+			name:    "Retention Too Long",
+			input:   Bootstrap{Namespaces: []BootstrapNamespace{{Name: "default", Retention: 365 * 24 * time.Hour}}},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown Search Attribute Type",
+			input:   Bootstrap{SearchAttributes: map[string]string{"CustomerId": "uuid"}},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown Dynamic Config Key",
+			input:   Bootstrap{DynamicConfig: map[string]interface{}{"frontend.unknownKey": 1}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetIndexedValueType(t *testing.T) {
+	t.Parallel()
+
+	valueType, err := GetIndexedValueType("Keyword")
+	require.NoError(t, err)
+	require.Equal(t, enumspb.INDEXED_VALUE_TYPE_KEYWORD, valueType)
+
+	valueType, err = GetIndexedValueType("datetime")
+	require.NoError(t, err)
+	require.Equal(t, enumspb.INDEXED_VALUE_TYPE_DATETIME, valueType)
+
+	_, err = GetIndexedValueType("unspecified")
+	require.Error(t, err)
+}
@@ -26,6 +26,7 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/uber-go/tally/m3"
@@ -63,12 +64,19 @@ type (
 		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
+		// Bootstrap describes namespaces, search attributes and dynamic config values reconciled at server startup
+		Bootstrap Bootstrap `yaml:"bootstrap"`
 	}
 
 	// Service contains the service specific config items
 	Service struct {
 		// RPC is the rpc configuration
 		RPC RPC `yaml:"rpc"`
+		// InternodeTLS overrides global internode TLS settings for the service, i.e. certificates its gRPC server
+		// presents to other roles and its clients present to them, e.g. when roles running in one process are
+		// bound to interfaces of different network zones. Optional, defaults to global internode settings.
+		// Certificate data from dynamic config is rotated for global settings only.
+		InternodeTLS *GroupTLS `yaml:"internodeTLS"`
 		// Deprecated. Use Metrics in global section instead.
 		Metrics Metrics `yaml:"metrics"`
 	}
@@ -87,10 +95,105 @@ type (
 		MembershipPort int `yaml:"membershipPort"`
 		// BindOnLocalHost is true if localhost is the bind address
 		BindOnLocalHost bool `yaml:"bindOnLocalHost"`
-		// BindOnIP can be used to bind service on specific ip (eg. `0.0.0.0`) -
-		// check net.ParseIP for supported syntax, only IPv4 is supported,
-		// mutually exclusive with `BindOnLocalHost` option
+		// BindOnIP can be used to bind service on specific ip (eg. `0.0.0.0`, `::` or `[2001:db8::1]`) -
+		// check net.ParseIP for supported syntax, IPv6 address can be enclosed in brackets,
+		// mutually exclusive with `BindOnLocalHost` and `BindOnInterface` options
 		BindOnIP string `yaml:"bindOnIP"`
+		// BindOnInterface can be used to bind service on address of network interface (eg. `eth0`),
+		// IPv4 address is used if interface has both IPv4 and IPv6 addresses,
+		// mutually exclusive with `BindOnLocalHost` and `BindOnIP` options
+		BindOnInterface string `yaml:"bindOnInterface"`
+		// Optional - address communicated to other nodes to connect to the service on, overrides global membership
+		// broadcastAddress for the service, e.g. when roles running in one process are bound to different interfaces
+		BroadcastAddress string `yaml:"broadcastAddress"`
+		// ConsolidatePorts serves membership, gRPC and HTTP metrics endpoint of the service on GRPCPort,
+		// MembershipPort is not used then
+		ConsolidatePorts bool `yaml:"consolidatePorts"`
+		// KeepAlive controls keepalive pings of gRPC connections of the service, e.g. to keep long poll
+		// connections through load balancers and NATs which drop idle connections alive
+		KeepAlive GRPCKeepAlive `yaml:"keepAlive"`
+		// Optional - max size in bytes of messages received by gRPC servers of the service and by connections it dials.
+		// Defaults to 4MB.
+		MaxReceiveMessageSize int `yaml:"maxReceiveMessageSize"`
+		// Optional - max size in bytes of messages sent by gRPC servers of the service and by connections it dials.
+		// Defaults to unlimited for servers and to 2GB for connections.
+		MaxSendMessageSize int `yaml:"maxSendMessageSize"`
+		// Optional - port of HTTP/JSON gateway to WorkflowService, it is served by frontend only and uses TLS and
+		// authorization of the frontend gRPC server. Gateway is disabled if not set.
+		HTTPPort int `yaml:"httpPort"`
+		// Optional - compressor of requests sent over gRPC connections dialed by the service, i.e. to other nodes
+		// and to frontends of remote clusters, one of gzip or zstd. Servers always accept gzip, zstd is accepted
+		// only by processes which configure it, so it has to be enabled on servers before clients use it.
+		// Servers compress responses the same way as requests. Defaults to no compression.
+		Compression string `yaml:"compression"`
+		// Optional - path of unix domain socket the gRPC server of the service listens on instead of GRPCPort,
+		// e.g. for sidecar proxy which terminates TLS on GRPCPort and forwards to the socket. Other nodes and clients
+		// still dial GRPCPort, clients on the same host can dial the socket with unix:// address.
+		// Mutually exclusive with `ConsolidatePorts` option.
+		GRPCSocket string `yaml:"grpcSocket"`
+		// Optional - retry policies of clients the service uses to call other roles, keyed by the target role,
+		// one of frontend, history or matching. Roles without a policy are retried with built-in defaults.
+		ClientRetryPolicies map[string]ClientRetryPolicy `yaml:"clientRetryPolicies"`
+		// Optional - moves running cluster from plaintext to internode TLS without stopping all nodes at once,
+		// one of dialPlaintext or dialTLS. In both modes gRPC server of the service accepts TLS and plaintext
+		// connections on the same port, and clients of the service dial other nodes in plaintext or with TLS
+		// respectively. Roll nodes to dialPlaintext, then to dialTLS, then drop the setting. Membership gossip
+		// is not affected. Defaults to accepting only connections matching internode TLS settings.
+		InternodePlaintextMigration string `yaml:"internodePlaintextMigration"`
+		// Optional - how long gRPC server of the service waits on shutdown for in-flight requests, including long
+		// polls, after it stops accepting connections and sends GOAWAY to clients. Connections are closed once it
+		// elapses. Defaults to 10s.
+		DrainTimeout time.Duration `yaml:"drainTimeout"`
+		// Optional - balancing of connections the service dials to frontends of this and remote clusters
+		FrontendClientBalancing FrontendClientBalancing `yaml:"frontendClientBalancing"`
+	}
+
+	// FrontendClientBalancing controls how calls of a frontend client are spread over frontend hosts
+	FrontendClientBalancing struct {
+		// Optional - gRPC balancer of the connection, one of round_robin or pick_first. Defaults to round_robin.
+		Policy string `yaml:"policy"`
+		// Optional - interval to re-resolve frontend host name with DNS, so connections follow frontend replicas
+		// behind headless services as they are added and removed. Addresses with gRPC scheme (eg. `dns:///`) are
+		// dialed as is. Defaults to resolving the name only when connection is lost.
+		DNSRefreshInterval time.Duration `yaml:"dnsRefreshInterval"`
+	}
+
+	// ClientRetryPolicy contains retry settings of internode client calling a role, settings which are not set
+	// keep built-in defaults of the role. Retries stop once either MaximumAttempts or ExpirationInterval is reached.
+	ClientRetryPolicy struct {
+		// Optional - backoff before the first retry
+		InitialInterval time.Duration `yaml:"initialInterval"`
+		// Optional - rate at which backoff grows with each retry
+		BackoffCoefficient float64 `yaml:"backoffCoefficient"`
+		// Optional - cap of backoff between retries
+		MaximumInterval time.Duration `yaml:"maximumInterval"`
+		// Optional - max time spent retrying a call
+		ExpirationInterval time.Duration `yaml:"expirationInterval"`
+		// Optional - max number of attempts of a call, including the first one
+		MaximumAttempts int `yaml:"maximumAttempts"`
+		// Optional - gRPC status codes of errors which are retried, e.g. UNAVAILABLE or RESOURCE_EXHAUSTED
+		RetryableCodes []string `yaml:"retryableCodes"`
+	}
+
+	// GRPCKeepAlive contains keepalive settings of gRPC servers of the service and of connections it dials
+	GRPCKeepAlive struct {
+		// Optional - minimum interval at which clients are allowed to ping the server,
+		// clients pinging more often are disconnected. Defaults to 5 minutes.
+		MinClientPingInterval time.Duration `yaml:"minClientPingInterval"`
+		// PermitPingWithoutStream allows clients to ping the server when there are no active calls,
+		// and makes clients of the service ping when there are no active calls
+		PermitPingWithoutStream bool `yaml:"permitPingWithoutStream"`
+		// Optional - interval after which the server pings idle clients. Defaults to 2 hours.
+		ServerPingInterval time.Duration `yaml:"serverPingInterval"`
+		// Optional - time the server waits for ping acknowledgement before closing the connection.
+		// Defaults to 20 seconds.
+		ServerPingTimeout time.Duration `yaml:"serverPingTimeout"`
+		// Optional - interval after which clients of the service ping idle servers, at least 10 seconds.
+		// Clients don't ping servers if not set.
+		ClientPingInterval time.Duration `yaml:"clientPingInterval"`
+		// Optional - time clients of the service wait for ping acknowledgement before closing the connection.
+		// Defaults to 20 seconds.
+		ClientPingTimeout time.Duration `yaml:"clientPingTimeout"`
 	}
 
 	// Global contains config items that apply process-wide to all services
@@ -115,9 +218,206 @@ type (
 		Frontend GroupTLS `yaml:"frontend"`
 		// SystemWorker controls TLS setting for System Workers connecting to Frontend.
 		SystemWorker WorkerTLS `yaml:"systemWorker"`
+		// HTTP controls TLS settings of the HTTP endpoints serving pprof and prometheus metrics, only the
+		// server settings, versions and cipher suites are used. Optional, endpoints are served over plain
+		// HTTP unless enabled.
+		HTTP GroupTLS `yaml:"http"`
+		// RemoteClusters controls TLS settings of replication clients connecting to frontend of remote clusters,
+		// keyed by the cluster name of ClusterMetadata. Clusters not listed use the Frontend client settings.
+		RemoteClusters map[string]RemoteClusterTLS `yaml:"remoteClusters"`
+
+		// CertProvider is the name of the cert provider plugin the certificates are loaded with, plugins are
+		// registered with encryption.RegisterCertProviderPlugin. Optional, defaults to "localStore" which loads
+		// the certificates configured above.
+		CertProvider string `yaml:"certProvider"`
+		// CertProviderOptions are passed as is to the cert provider plugin. Optional.
+		CertProviderOptions map[string]string `yaml:"certProviderOptions"`
+		// Vault configures the "vault" cert provider, which issues short-lived internode and frontend
+		// certificates from the PKI secrets engine of HashiCorp Vault and renews them before they expire.
+		Vault VaultTLS `yaml:"vault"`
+		// SPIFFE configures the "spiffe" cert provider, which presents X.509 SVIDs fetched from the SPIFFE
+		// workload API and verifies peers by their SPIFFE IDs instead of their host names.
+		SPIFFE SPIFFETLS `yaml:"spiffe"`
+		// Kubernetes configures the "kubernetes" cert provider, which loads certificates from mounted
+		// Kubernetes TLS secrets and reloads them when the kubelet swaps in updated secrets.
+		Kubernetes KubernetesTLS `yaml:"kubernetes"`
+		// AWS configures the "aws" cert provider, which loads certificates and private keys from AWS Secrets
+		// Manager or decrypts private keys wrapped by AWS KMS, so that plaintext keys are only kept in memory.
+		AWS AWSTLS `yaml:"aws"`
+		// DevMode configures the "devMode" cert provider, which generates an in-memory CA and certificates
+		// of all roles at startup. It is meant for local development only.
+		DevMode DevModeTLS `yaml:"devMode"`
+		// ExpirationChecks controls how the expiration of the loaded certificates is reported.
+		ExpirationChecks CertExpirationValidation `yaml:"expirationChecks"`
+	}
+
+	// CertExpirationValidation contains settings for periodic checks of certificate expiration.
+	// Days until expiry of every certificate are emitted as gauges, and warnings are logged
+	// for certificates expiring within WarningWindow.
+	CertExpirationValidation struct {
+		// WarningWindow is the time before expiry warnings are logged from. Optional, defaults to 30 days.
+		WarningWindow time.Duration `yaml:"warningWindow"`
+		// CheckInterval is the interval the certificates are checked with. Optional, defaults to 1 hour.
+		CheckInterval time.Duration `yaml:"checkInterval"`
+	}
+
+	// VaultTLS contains the settings of the cert provider issuing certificates from HashiCorp Vault.
+	// Client auth, server names and host verification are still configured by the TLS groups. Client CAs
+	// and root CAs of the groups default to the CA chain returned by Vault unless configured explicitly.
+	VaultTLS struct {
+		// Address of the Vault server, e.g. https://vault.example.com:8200
+		Address string `yaml:"address"`
+		// Optional - Vault Enterprise namespace the PKI secrets engine and auth method are mounted in.
+		Namespace string `yaml:"namespace"`
+		// Optional - The path to the file containing the PEM-encoded CA certificates used to verify the Vault server.
+		CAFile string `yaml:"caFile"`
+		// Optional - Path the PKI secrets engine is mounted at, defaults to "pki".
+		Mount string `yaml:"mount"`
+		// Name of the PKI role certificates are issued with, can be overridden per TLS group.
+		Role string `yaml:"role"`
+		// Optional - TTL requested for issued certificates, defaults to the TTL of the role.
+		TTL time.Duration `yaml:"ttl"`
+		// Optional - How long before expiry certificates are renewed, defaults to a third of their lifetime.
+		RenewBefore time.Duration `yaml:"renewBefore"`
+
+		// Method used to authenticate with Vault, one of "token", "approle" or "kubernetes".
+		AuthMethod string `yaml:"authMethod"`
+		// Optional - Path the auth method is mounted at, defaults to the name of the auth method.
+		AuthMount string `yaml:"authMount"`
+		// The Vault token, or the path to the file containing it, used by the "token" auth method.
+		// Cannot specify both Token and TokenFile.
+		Token     string `yaml:"token"`
+		TokenFile string `yaml:"tokenFile"`
+		// The role ID and the secret ID, or the path to the file containing it, used by the "approle" auth method.
+		// Cannot specify both SecretID and SecretIDFile.
+		RoleID       string `yaml:"roleId"`
+		SecretID     string `yaml:"secretId"`
+		SecretIDFile string `yaml:"secretIdFile"`
+		// The Vault role and the path to the service account token used by the "kubernetes" auth method.
+		// JWTFile defaults to /var/run/secrets/kubernetes.io/serviceaccount/token.
+		KubernetesRole string `yaml:"kubernetesRole"`
+		JWTFile        string `yaml:"jwtFile"`
+
+		// Internode configures the certificates of internode servers and clients, also presented by
+		// system workers to frontend unless SystemWorker certificates are configured.
+		Internode VaultCertificate `yaml:"internode"`
+		// Frontend configures the certificate of the frontend server.
+		Frontend VaultCertificate `yaml:"frontend"`
+	}
+
+	// VaultCertificate contains the names a certificate is issued for by Vault. Certificates of TLS
+	// groups without a common name are loaded from the files and data configured for the group.
+	VaultCertificate struct {
+		// Common name of the certificate.
+		CommonName string `yaml:"commonName"`
+		// Optional - DNS names and email addresses the certificate is issued for besides the common name.
+		AltNames []string `yaml:"altNames"`
+		// Optional - IP addresses the certificate is issued for.
+		IPSANs []string `yaml:"ipSans"`
+		// Optional - Name of the PKI role overriding the role of the Vault settings.
+		Role string `yaml:"role"`
+	}
+
+	// KubernetesTLS contains the directories Kubernetes TLS secrets are mounted at, using the standard layout
+	// of the tls.crt and tls.key files and an optional ca.crt file, e.g. as written by cert-manager. The CA
+	// certificates are trusted for client auth and by clients of the group unless CAs are configured explicitly.
+	// Certificates of groups without secret directory are loaded from the files and data configured for the group.
+	KubernetesTLS struct {
+		// Directory of the secret with the certificate of internode servers and clients, also presented by
+		// system workers to frontend unless SystemWorker certificates are configured.
+		InternodeSecretDir string `yaml:"internodeSecretDir"`
+		// Directory of the secret with the certificate of the frontend server.
+		FrontendSecretDir string `yaml:"frontendSecretDir"`
+		// Directory of the secret with the certificate system workers present to frontend.
+		SystemWorkerSecretDir string `yaml:"systemWorkerSecretDir"`
+		// Optional - How often handshakes check the secrets for updates, defaults to 30 seconds.
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
 	}
 
-	// GroupTLS contains an instance client and server TLS settings
+	// AWSTLS contains the settings of the cert provider loading key material from AWS. Credentials are resolved
+	// by the default credential chain of the AWS SDK. The key material is reloaded once per refresh interval in
+	// the background, the loaded certificate keeps being presented if a reload fails. Certificates of groups
+	// without AWS key material are loaded from the files and data configured for the group.
+	AWSTLS struct {
+		// Region of the Secrets Manager and KMS endpoints.
+		Region string `yaml:"region"`
+		// Optional - Endpoint overriding the default endpoints of Secrets Manager and KMS, e.g. of a VPC endpoint.
+		Endpoint string `yaml:"endpoint"`
+		// Optional - How often the key material is reloaded, defaults to 1 hour.
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// Key material of internode servers and clients, also presented by system workers to frontend.
+		Internode AWSCertificate `yaml:"internode"`
+		// Key material of the frontend server.
+		Frontend AWSCertificate `yaml:"frontend"`
+	}
+
+	// AWSCertificate references the key material of a TLS group stored in AWS, either a Secrets Manager secret
+	// or a private key encrypted by KMS, which is presented with the certificate configured for the group.
+	AWSCertificate struct {
+		// ID or ARN of the Secrets Manager secret. Its value is a JSON object with the PEM encoded "certificate"
+		// and "privateKey" properties and an optional "caCertificates" property, whose CAs are trusted for client
+		// auth and by clients of the group unless CAs are configured explicitly.
+		SecretID string `yaml:"secretId"`
+		// Optional - Version stage of the secret, defaults to AWSCURRENT.
+		VersionStage string `yaml:"versionStage"`
+		// The path to the file containing the ciphertext of the PEM encoded private key, as returned by the
+		// Encrypt API of KMS. Cannot be combined with SecretID or EncryptedKeyData.
+		EncryptedKeyFile string `yaml:"encryptedKeyFile"`
+		// Base64 encoded ciphertext of the PEM encoded private key. Cannot be combined with SecretID or
+		// EncryptedKeyFile.
+		EncryptedKeyData string `yaml:"encryptedKeyData"`
+		// Optional - ID or ARN of the KMS key the private key was encrypted with, required for asymmetric keys.
+		KMSKeyID string `yaml:"kmsKeyId"`
+		// Optional - Encryption context the private key was encrypted with.
+		EncryptionContext map[string]string `yaml:"encryptionContext"`
+	}
+
+	// DevModeTLS contains the settings of the cert provider which generates a CA and certificates of internode,
+	// frontend and system worker roles in memory at startup, so that the mTLS code path can be exercised locally
+	// without managing PEM files. Client auth is required by internode and frontend servers, the CA is regenerated
+	// on every start, so it must not be used for clusters of more than one process.
+	DevModeTLS struct {
+		// AutoGenerate enables the "devMode" cert provider, which is used unless other cert provider is configured.
+		AutoGenerate bool `yaml:"autoGenerate"`
+		// Optional - Host name the server certificates are issued for, defaults to localhost.
+		HostName string `yaml:"hostName"`
+		// Optional - Directory the generated CA certificate and a client certificate with its key are written to
+		// as PEM files, so that SDK clients and tctl are able to connect to the frontend.
+		OutputDir string `yaml:"outputDir"`
+	}
+
+	// SPIFFETLS contains the settings of the cert provider sourcing X.509 SVIDs from the SPIFFE workload API,
+	// e.g. of a SPIRE agent. Certificates of peers are verified against the X.509 bundle of the trust domain
+	// and authorized by their SPIFFE IDs, CAs, revocation lists and host verification of enabled groups are
+	// ignored. Client auth is still configured by the TLS groups.
+	SPIFFETLS struct {
+		// Optional - Address of the workload API, e.g. unix:///run/spire/sockets/agent.sock or tcp://127.0.0.1:8081,
+		// defaults to the SPIFFE_ENDPOINT_SOCKET environment variable.
+		WorkloadAPIAddress string `yaml:"workloadApiAddress"`
+		// Optional - SPIFFE ID of the SVID to present if the workload is entitled to several, defaults to the first one.
+		SPIFFEID string `yaml:"spiffeId"`
+		// Internode configures SPIFFE identities of internode servers and clients.
+		Internode SPIFFEGroupTLS `yaml:"internode"`
+		// Frontend configures SPIFFE identities of the frontend server and of system workers connecting to it,
+		// system workers present the SVID unless SystemWorker certificates are configured.
+		Frontend SPIFFEGroupTLS `yaml:"frontend"`
+	}
+
+	// SPIFFEGroupTLS contains the SPIFFE IDs accepted from peers of a TLS group. An ID without path, e.g.
+	// spiffe://example.org, accepts any workload of the trust domain. Certificates of groups which are not
+	// enabled are loaded from the files and data configured for the group.
+	SPIFFEGroupTLS struct {
+		// Enabled presents the SVID and verifies peers by SPIFFE ID for the group.
+		Enabled bool `yaml:"enabled"`
+		// Optional - SPIFFE IDs of clients accepted by servers, defaults to any workload of the trust domain.
+		AuthorizedClientIDs []string `yaml:"authorizedClientIds"`
+		// Optional - SPIFFE IDs of servers accepted by clients, defaults to any workload of the trust domain.
+		AuthorizedServerIDs []string `yaml:"authorizedServerIds"`
+	}
+
+	// GroupTLS contains an instance client and server TLS settings. The CAs used by clients to verify the
+	// servers they dial (Client.RootCAFiles) and the CAs used by servers to verify the clients dialing them
+	// (Server.ClientCAFiles) are independent pools, so that CAs can be migrated one direction at a time.
 	GroupTLS struct {
 		// Client handles client TLS settings
 		Client ClientTLS `yaml:"client"`
@@ -126,9 +426,20 @@ type (
 
 		// PerHostOverrides contains per-hostname TLS settings that
 		// are used for external clients connecting to the Temporal Cluster on that
-		// specific hostname. Host names are case insensitive. Optional. If not present,
-		// uses configuration supplied by Server field.
+		// specific hostname. Host names are case insensitive. A host name starting with "*."
+		// matches any host name under that domain, e.g. "*.frontend.example.com" matches
+		// "a.frontend.example.com" and "b.us.frontend.example.com". Exact host names take
+		// precedence over wildcards, and the longest matching wildcard wins. Optional. If not
+		// present, uses configuration supplied by Server field.
 		PerHostOverrides map[string]ServerTLS `yaml:"hostOverrides"`
+
+		// MinVersion is the minimum TLS version accepted by servers and offered by clients of the group,
+		// either "1.2" or "1.3". Optional, defaults to "1.2".
+		MinVersion string `yaml:"minVersion"`
+		// CipherSuites restricts the cipher suites negotiated for TLS 1.2 connections of the group, using
+		// the IANA names, e.g. TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256. Optional, defaults to the Go defaults.
+		// TLS 1.3 cipher suites are not configurable.
+		CipherSuites []string `yaml:"cipherSuites"`
 	}
 
 	// ServerTLS contains items to load server TLS configuration
@@ -136,6 +447,8 @@ type (
 		// The path to the file containing the PEM-encoded public key of the certificate to use.
 		CertFile string `yaml:"certFile"`
 		// The path to the file containing the PEM-encoded private key of the certificate to use.
+		// RSA keys in PKCS#1 or PKCS#8 format and ECDSA keys in SEC1 or PKCS#8 format are supported.
+		// The key can be encrypted either as PKCS#8 using PBES2 or with legacy OpenSSL PEM encryption.
 		KeyFile string `yaml:"keyFile"`
 		// A list of paths to files containing the PEM-encoded public key of the Certificate Authorities you wish to trust for client authentication.
 		// This value is ignored if `requireClientAuth` is not enabled. Cannot specify both ClientCAFiles and ClientCAData
@@ -147,8 +460,54 @@ type (
 		KeyData      string   `yaml:"keyData"`
 		ClientCAData []string `yaml:"clientCaData"`
 
+		// The password to decrypt an encrypted private key, or the path to the file containing it.
+		// Cannot specify both KeyPassword and KeyPasswordFile.
+		KeyPassword     string `yaml:"keyPassword"`
+		KeyPasswordFile string `yaml:"keyPasswordFile"`
+
+		// The path to a PKCS#12 (.p12 or .pfx) bundle containing the certificate, its private key and optionally
+		// the certificates of its Certificate Authorities, as an alternative to the cert and key artifacts above.
+		// The bundled CA certificates are trusted for client authentication unless ClientCAFiles or ClientCAData is set,
+		// and by internode clients unless RootCAFiles or RootCAData of the client settings is set.
+		CertBundleFile string `yaml:"certBundleFile"`
+		// The password of the bundle, or the path to the file containing it.
+		// Cannot specify both CertBundlePassword and CertBundlePasswordFile.
+		CertBundlePassword     string `yaml:"certBundlePassword"`
+		CertBundlePasswordFile string `yaml:"certBundlePasswordFile"`
+
 		// Requires clients to authenticate with a certificate when connecting, otherwise known as mutual TLS.
 		RequireClientAuth bool `yaml:"requireClientAuth"`
+
+		// Optional - The path or http(s) URL of a PEM or DER encoded certificate revocation list of the client Certificate Authorities.
+		// Client certificates revoked by their issuer are rejected. This value is ignored if `requireClientAuth` is not enabled.
+		CertificateRevocationList string `yaml:"certificateRevocationList"`
+		// Optional - How often the certificate revocation list is reloaded, defaults to 1 hour.
+		CertificateRevocationListRefreshInterval time.Duration `yaml:"certificateRevocationListRefreshInterval"`
+
+		// Optional - Subject distinguished names of the client certificates accepted, in the RFC 2253 form
+		// produced by Go, e.g. "CN=worker,OU=temporal,O=Example". Clients are accepted if either their DN or any
+		// of their subject alternative names is allowed, certificates are accepted regardless of their
+		// identity if neither list is set. These values are ignored if `requireClientAuth` is not enabled.
+		AllowedClientDNs []string `yaml:"allowedClientDNs"`
+		// Optional - Subject alternative names of the client certificates accepted, i.e. DNS names,
+		// IP addresses, email addresses and URIs, e.g. spiffe://example.org/worker.
+		AllowedClientSANs []string `yaml:"allowedClientSANs"`
+
+		// Optional - Staples the OCSP response of the server certificate to handshakes, so that clients do not need
+		// to query the OCSP responder of its issuer themselves. The certificate of the issuer must follow the server
+		// certificate in CertFile or CertData.
+		EnableOCSPStapling bool `yaml:"enableOcspStapling"`
+		// Optional - Checks the status of client certificates with the OCSP responders of their issuers.
+		// This value is ignored if `requireClientAuth` is not enabled.
+		VerifyClientOCSP bool `yaml:"verifyClientOcsp"`
+		// Optional - The http(s) URL of the OCSP responder queried instead of the responders named by the certificates.
+		OCSPResponderURL string `yaml:"ocspResponderUrl"`
+		// Optional - Either "soft" or "hard", defaults to "soft". When the status of a client certificate cannot be
+		// determined, e.g. the responder is unreachable, "soft" accepts the certificate and "hard" rejects it.
+		// Certificates reported as revoked are always rejected.
+		OCSPFailurePolicy string `yaml:"ocspFailurePolicy"`
+		// Optional - How long OCSP responses without a next update time are cached, defaults to 1 hour.
+		OCSPCacheTTL time.Duration `yaml:"ocspCacheTtl"`
 	}
 
 	// ClientTLS contains TLS configuration for clients within the Temporal Cluster to connect to Temporal nodes.
@@ -164,12 +523,19 @@ type (
 		DisableHostVerification bool `yaml:"disableHostVerification"`
 
 		// Optional - A list of paths to files containing the PEM-encoded public key of the Certificate Authorities that are used to validate the server's TLS certificate
+		// These CAs are not trusted for client authentication, see ServerTLS.ClientCAFiles for that.
 		// You cannot specify both RootCAFiles and RootCAData
 		RootCAFiles []string `yaml:"rootCaFiles"`
 
 		// Optional - A list of base64 PEM-encoded public keys of the Certificate Authorities that are used to validate the server's TLS certificate.
 		// You cannot specify both RootCAFiles and RootCAData
 		RootCAData []string `yaml:"rootCaData"`
+
+		// Optional - The path or http(s) URL of a PEM or DER encoded certificate revocation list of the server Certificate Authorities.
+		// Server certificates revoked by their issuer are rejected. This value is ignored if `disableHostVerification` is enabled.
+		CertificateRevocationList string `yaml:"certificateRevocationList"`
+		// Optional - How often the certificate revocation list is reloaded, defaults to 1 hour.
+		CertificateRevocationListRefreshInterval time.Duration `yaml:"certificateRevocationListRefreshInterval"`
 	}
 
 	// WorkerTLS contains TLS configuration for system workers within the Temporal Cluster to connect to Temporal frontend.
@@ -182,19 +548,58 @@ type (
 		// You cannot specify both a Data and a File for the same artifact (e.g. setting CertFile and CertData)
 		CertData string `yaml:"certData"`
 		KeyData  string `yaml:"keyData"`
+		// The password to decrypt an encrypted private key, or the path to the file containing it.
+		// Cannot specify both KeyPassword and KeyPasswordFile.
+		KeyPassword     string `yaml:"keyPassword"`
+		KeyPasswordFile string `yaml:"keyPasswordFile"`
 
 		// Client TLS settings for system workers
 		Client ClientTLS `yaml:"client"`
 	}
 
+	// RemoteClusterTLS contains TLS configuration for clients within the Temporal Cluster to connect to frontend
+	// of a remote cluster, e.g. when the remote cluster certificates are issued by a different CA.
+	RemoteClusterTLS struct {
+		// The path to the file containing the PEM-encoded public key of the client certificate presented to the remote cluster.
+		// Optional, no client certificate is presented if not set.
+		CertFile string `yaml:"certFile"`
+		// The path to the file containing the PEM-encoded private key of the client certificate presented to the remote cluster.
+		KeyFile string `yaml:"keyFile"`
+		// Base64 equivalents of the above artifacts.
+		// You cannot specify both a Data and a File for the same artifact (e.g. setting CertFile and CertData)
+		CertData string `yaml:"certData"`
+		KeyData  string `yaml:"keyData"`
+		// The password to decrypt an encrypted private key, or the path to the file containing it.
+		// Cannot specify both KeyPassword and KeyPasswordFile.
+		KeyPassword     string `yaml:"keyPassword"`
+		KeyPasswordFile string `yaml:"keyPasswordFile"`
+
+		// Client TLS settings for the remote cluster, i.e. root CAs and server name of its frontend
+		Client ClientTLS `yaml:"client"`
+	}
+
 	// Membership contains config items related to the membership layer of temporal
 	Membership struct {
 		// MaxJoinDuration is the max wait time to join the gossip ring
 		MaxJoinDuration time.Duration `yaml:"maxJoinDuration"`
 		// BroadcastAddress is used as the address that is communicated to remote nodes to connect on.
 		// This is generally used when BindOnIP would be the same across several nodes (ie: 0.0.0.0)
-		// and for nat traversal scenarios. Check net.ParseIP for supported syntax, only IPv4 is supported.
+		// and for nat traversal scenarios. Check net.ParseIP for supported syntax, IPv6 address can be
+		// enclosed in brackets.
 		BroadcastAddress string `yaml:"broadcastAddress"`
+		// Encryption controls encryption and authentication of membership gossip
+		Encryption MembershipEncryption `yaml:"encryption"`
+	}
+
+	// MembershipEncryption contains the settings to encrypt and authenticate membership gossip.
+	// UseInternodeTLS and SharedKey are mutually exclusive, gossip is not encrypted if neither is set.
+	// All hosts of the cluster must use the same settings.
+	MembershipEncryption struct {
+		// UseInternodeTLS secures gossip with TLS using internode server and client settings
+		UseInternodeTLS bool `yaml:"useInternodeTLS"`
+		// SharedKey is base64 encoded 16, 24 or 32 bytes long key shared by all hosts of the cluster,
+		// gossip is encrypted and authenticated with AES-GCM using session keys derived from it
+		SharedKey string `yaml:"sharedKey"`
 	}
 
 	// Persistence contains the configuration for data store / persistence layer
@@ -214,6 +619,8 @@ type (
 		VisibilityConfig *VisibilityConfig `yaml:"-" json:"-"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// HistoryChecksumVerification is how checksum mismatch of history events read from persistence is handled
+		HistoryChecksumVerification dynamicconfig.StringPropertyFn `yaml:"-" json:"-"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -224,6 +631,9 @@ type (
 		SQL *SQL `yaml:"sql"`
 		// Custom contains the config for custom datastore implementation
 		CustomDataStoreConfig *CustomDatastoreConfig `yaml:"customDatastore"`
+		// CustomVisibilityStore contains the config for visibility store implemented by registered plugin,
+		// it can be used only by the datastore used for visibility records
+		CustomVisibilityStore *CustomDatastoreConfig `yaml:"customVisibilityStore"`
 		// ElasticSearch contains the config for a ElasticSearch datastore
 		ElasticSearch *elasticsearch.Config `yaml:"elasticsearch"`
 	}
@@ -271,6 +681,9 @@ type (
 		TLS *auth.TLS `yaml:"tls"`
 		// Consistency configuration (defaults to LOCAL_QUORUM / LOCAL_SERIAL for all stores if this field not set)
 		Consistency *CassandraStoreConsistency `yaml:"consistency"`
+		// TableOptions are the expected table options of each group of tables, they are applied by the
+		// update-table-options command of temporal-cassandra-tool and checked against the deployed tables at startup
+		TableOptions *CassandraTableOptions `yaml:"tableOptions"`
 	}
 
 	// CassandraStoreConsistency enables you to set the consistency settings for each Cassandra Persistence Store for Temporal
@@ -288,6 +701,27 @@ type (
 		SerialConsistency string `yaml:"serialConsistency"`
 	}
 
+	// CassandraTableOptions sets the table options of the history, visibility and task tables
+	CassandraTableOptions struct {
+		// History are the options of the history_node and history_tree tables
+		History *CassandraTableGroupOptions `yaml:"history"`
+		// Visibility are the options of the open_executions and closed_executions tables
+		Visibility *CassandraTableGroupOptions `yaml:"visibility"`
+		// Tasks are the options of the tasks table
+		Tasks *CassandraTableGroupOptions `yaml:"tasks"`
+	}
+
+	// CassandraTableGroupOptions are the options of a group of tables, unset options are left unchanged
+	CassandraTableGroupOptions struct {
+		// Compaction is the compaction strategy "class" and its sub-options, e.g. class: TimeWindowCompactionStrategy,
+		// compaction_window_unit: DAYS, compaction_window_size: 1
+		Compaction map[string]string `yaml:"compaction"`
+		// GCGraceSeconds is the time tombstones are kept before being purged by compactions
+		GCGraceSeconds *int `yaml:"gcGraceSeconds"`
+		// DefaultTimeToLive is the TTL in seconds of the rows written without one, 0 disables it
+		DefaultTimeToLive *int `yaml:"defaultTimeToLive"`
+	}
+
 	// SQL is the configuration for connecting to a SQL backed datastore
 	SQL struct {
 		// User is the username to be used for the conn
@@ -316,13 +750,23 @@ type (
 		TaskScanPartitions int `yaml:"taskScanPartitions"`
 		// TLS is the configuration for TLS connections
 		TLS *auth.TLS `yaml:"tls"`
+		// Optional - PreparedStatementCacheSize is the max number of statements kept prepared on the database,
+		// statements are executed without being prepared ahead if it's not set.
+		PreparedStatementCacheSize int `yaml:"preparedStatementCacheSize"`
+		// InterpolateParams sends the query parameters along with the statements instead of preparing them,
+		// which is required by proxies pooling connections per transaction, e.g. ProxySQL, PgBouncer in
+		// transaction mode or RDS Proxy. MySQL interpolates the parameters client side, PostgreSQL sends them in
+		// the same round trip and rejects connect attributes which would be sent as session level run-time
+		// parameters. It cannot be set together with PreparedStatementCacheSize.
+		InterpolateParams bool `yaml:"interpolateParams"`
 	}
 
 	// CustomDatastoreConfig is the configuration for connecting to a custom datastore that is not supported by temporal core
 	CustomDatastoreConfig struct {
 		// Name of the custom datastore
 		Name string `yaml:"name"`
-		// Options is a set of key-value attributes that can be used by AbstractDatastoreFactory implementation
+		// Options is a set of key-value attributes that can be used by AbstractDatastoreFactory or
+		// VisibilityStorePlugin implementation
 		Options map[string]string `yaml:"options"`
 	}
 
@@ -422,6 +866,8 @@ type (
 		EnableRead bool `yaml:"enableRead"`
 		// Provider contains the config for all history archivers
 		Provider *HistoryArchiverProvider `yaml:"provider"`
+		// AllowedDestinations restricts history archival URIs namespaces can use, any URI is allowed if empty
+		AllowedDestinations []ArchivalDestination `yaml:"allowedDestinations"`
 	}
 
 	// HistoryArchiverProvider contains the config for all history archivers
@@ -439,6 +885,16 @@ type (
 		EnableRead bool `yaml:"enableRead"`
 		// Provider contains the config for all visibility archivers
 		Provider *VisibilityArchiverProvider `yaml:"provider"`
+		// AllowedDestinations restricts visibility archival URIs namespaces can use, any URI is allowed if empty
+		AllowedDestinations []ArchivalDestination `yaml:"allowedDestinations"`
+	}
+
+	// ArchivalDestination is an archival location namespaces are allowed to archive to
+	ArchivalDestination struct {
+		// URI is the scheme and optionally the bucket of the destination, e.g. "s3://tenant-bucket" or "gs://"
+		URI string `yaml:"URI"`
+		// CredentialsRefs are the credentials references namespaces may use with this destination
+		CredentialsRefs []string `yaml:"credentialsRefs"`
 	}
 
 	// VisibilityArchiverProvider contains the config for all visibility archivers
@@ -452,11 +908,28 @@ type (
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// Gzip enables gzip compression of archived files.
+		Gzip bool `yaml:"gzip"`
+		// ManifestMaxSize is the size in bytes at which the manifest of a date partition is rotated.
+		// Optional, defaults to 1MiB.
+		ManifestMaxSize int64 `yaml:"manifestMaxSize"`
 	}
 
 	// GstorageArchiver contain the config for google storage archiver
 	GstorageArchiver struct {
 		CredentialsPath string `yaml:"credentialsPath"`
+		// UploadChunkSize is the size in bytes of the chunks objects are sent in by resumable uploads,
+		// a failed chunk is retried without sending the whole object again. Optional, defaults to 16MiB.
+		UploadChunkSize int `yaml:"uploadChunkSize"`
+		// ParallelComposeThreshold is the size in bytes above which objects are split into components
+		// uploaded in parallel and composed into the object. Optional, 0 disables parallel compose.
+		ParallelComposeThreshold int `yaml:"parallelComposeThreshold"`
+		// ParallelComposeComponents is the number of components of parallel composed objects, at most 32.
+		// Optional, defaults to 8.
+		ParallelComposeComponents int `yaml:"parallelComposeComponents"`
+		// TargetHistoryBlobSize is the target size in bytes of the parts workflow histories are archived in.
+		// Fewer, larger parts speed up archival of very large histories. Optional, defaults to 2MiB.
+		TargetHistoryBlobSize int `yaml:"targetHistoryBlobSize"`
 	}
 
 	// S3Archiver contains the config for S3 archiver
@@ -468,10 +941,17 @@ type (
 
 	// PublicClient is config for connecting to temporal frontend
 	PublicClient struct {
-		// HostPort is the host port to connect on. Host can be DNS name
+		// HostPort is the host port to connect on. Host can be DNS name, connections are spread over
+		// all addresses it resolves to. Target with gRPC scheme (eg. `dns:///`) is used as is.
 		HostPort string `yaml:"hostPort" validate:"nonzero"`
 		// interval to refresh DNS. Default to 10s
 		RefreshInterval time.Duration `yaml:"RefreshInterval"`
+		// ResolveFromMembership connects to frontend hosts found in membership ring and updates
+		// connections on membership changes, HostPort is used only when ring has no frontend hosts
+		ResolveFromMembership bool `yaml:"resolveFromMembership"`
+		// Optional - gRPC balancer of the connection, one of round_robin or pick_first. Defaults to round_robin,
+		// not applied to HostPort with gRPC scheme.
+		BalancingPolicy string `yaml:"balancingPolicy"`
 	}
 
 	// NamespaceDefaults is the default config for each namespace
@@ -480,6 +960,32 @@ type (
 		Archival ArchivalNamespaceDefaults `yaml:"archival"`
 	}
 
+	// Bootstrap contains data which server reconciles at startup, so fresh clusters
+	// are fully provisioned without running any scripts after the server is started
+	Bootstrap struct {
+		// Namespaces are registered if they don't exist, existing namespaces are left untouched
+		Namespaces []BootstrapNamespace `yaml:"namespaces"`
+		// SearchAttributes is a map of search attribute name to its type (e.g. Keyword, Int, Datetime),
+		// which is added to the valid search attributes in dynamic config if missing
+		SearchAttributes map[string]string `yaml:"searchAttributes"`
+		// DynamicConfig is a map of dynamic config key to the value which is written to dynamic config if it differs
+		DynamicConfig map[string]interface{} `yaml:"dynamicConfig"`
+	}
+
+	// BootstrapNamespace describes namespace registered at server startup
+	BootstrapNamespace struct {
+		// Name is the name of the namespace
+		Name string `yaml:"name"`
+		// Description is the description of the namespace
+		Description string `yaml:"description"`
+		// OwnerEmail is the email of the namespace owner
+		OwnerEmail string `yaml:"ownerEmail"`
+		// Retention is the workflow execution retention period, defaults to 1 day
+		Retention time.Duration `yaml:"retention"`
+		// Data is the namespace data
+		Data map[string]string `yaml:"data"`
+	}
+
 	// ArchivalNamespaceDefaults is the default archival config for each namespace
 	ArchivalNamespaceDefaults struct {
 		// History is the namespace default history archival config for each namespace
@@ -508,6 +1014,29 @@ type (
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
 		PermissionsClaimName string         `yaml:"permissionsClaimName"`
+		// Gating of namespace registrations requested by callers without system admin role
+		NamespaceRegistration NamespaceRegistration `yaml:"namespaceRegistration"`
+		// Redaction of payloads returned to callers without admin or worker role
+		Redaction Redaction `yaml:"redaction"`
+	}
+
+	// Redaction contains the config for payload redaction rules of frontend
+	Redaction struct {
+		// HashKey is the secret key of the HMAC which payloads redacted with hash action are replaced with.
+		// Payloads are stripped instead if it is not set.
+		HashKey string `yaml:"hashKey"`
+	}
+
+	// NamespaceRegistration contains the config for approval of namespace registrations
+	NamespaceRegistration struct {
+		// RequireApproval gates registrations without an approval webhook. Every RegisterNamespace request
+		// from a caller without system admin role then waits for approval through the admin API.
+		RequireApproval bool `yaml:"requireApproval"`
+		// ApprovalWebhookURL is called for every RegisterNamespace request from a caller without system admin role.
+		// Registration is gated if this or RequireApproval is set.
+		ApprovalWebhookURL string `yaml:"approvalWebhookURL"`
+		// ApprovalWebhookTimeout is the timeout of a single webhook call. Defaults to 10 seconds.
+		ApprovalWebhookTimeout time.Duration `yaml:"approvalWebhookTimeout"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
@@ -529,6 +1058,37 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.Bootstrap.Validate(); err != nil {
+		return err
+	}
+
+	for name, service := range c.Services {
+		if err := service.RPC.Validate(); err != nil {
+			return fmt.Errorf("service %q: %w", name, err)
+		}
+		if service.InternodeTLS != nil {
+			if err := service.InternodeTLS.Validate(); err != nil {
+				return fmt.Errorf("service %q: internode tls: %w", name, err)
+			}
+		}
+	}
+
+	if err := validateBalancingPolicy(c.PublicClient.BalancingPolicy); err != nil {
+		return fmt.Errorf("public client: %w", err)
+	}
+
+	if err := c.Global.TLS.Internode.Validate(); err != nil {
+		return fmt.Errorf("internode tls: %w", err)
+	}
+
+	if err := c.Global.TLS.Frontend.Validate(); err != nil {
+		return fmt.Errorf("frontend tls: %w", err)
+	}
+
+	if err := c.Global.TLS.HTTP.Validate(); err != nil {
+		return fmt.Errorf("http tls: %w", err)
+	}
+
 	return nil
 }
 
@@ -539,5 +1099,5 @@ func (c *Config) String() string {
 }
 
 func (r *GroupTLS) IsEnabled() bool {
-	return r.Server.KeyFile != "" || r.Server.KeyData != ""
+	return r.Server.KeyFile != "" || r.Server.KeyData != "" || r.Server.CertBundleFile != ""
 }
@@ -25,6 +25,7 @@
 package config
 
 import (
+	"crypto/tls"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -36,3 +37,73 @@ func TestToString(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, cfg.String())
 }
+
+func TestValidate_ConsolidatePorts(t *testing.T) {
+	var cfg Config
+	err := Load("", "../../../config", "", &cfg)
+	assert.NoError(t, err)
+
+	frontend := cfg.Services["frontend"]
+	frontend.RPC.ConsolidatePorts = true
+	cfg.Services["frontend"] = frontend
+	cfg.Global.Membership.Encryption.SharedKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Global.Membership.Encryption = MembershipEncryption{UseInternodeTLS: true}
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestValidate_TLS(t *testing.T) {
+	var cfg Config
+	err := Load("", "../../../config", "", &cfg)
+	assert.NoError(t, err)
+
+	cfg.Global.TLS.Internode.MinVersion = "1.3"
+	cfg.Global.TLS.Frontend.MinVersion = "1.2"
+	cfg.Global.TLS.Frontend.CipherSuites = []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Global.TLS.Internode.CipherSuites = []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}
+	assert.Error(t, cfg.Validate())
+
+	cfg.Global.TLS.Internode.CipherSuites = nil
+	cfg.Global.TLS.HTTP.MinVersion = "1.1"
+	assert.Error(t, cfg.Validate())
+}
+
+func TestGroupTLS(t *testing.T) {
+	var tlsCfg GroupTLS
+	minVersion, err := tlsCfg.GetMinVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(0), minVersion)
+	cipherSuites, err := tlsCfg.GetCipherSuites()
+	assert.NoError(t, err)
+	assert.Nil(t, cipherSuites)
+
+	tlsCfg.MinVersion = "1.3"
+	minVersion, err = tlsCfg.GetMinVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS13), minVersion)
+
+	tlsCfg.CipherSuites = []string{"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"}
+	cipherSuites, err = tlsCfg.GetCipherSuites()
+	assert.NoError(t, err)
+	assert.Equal(t, []uint16{tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384}, cipherSuites)
+
+	for _, minVersion := range []string{"1.0", "1.1", "TLS1.2"} {
+		tlsCfg := GroupTLS{MinVersion: minVersion}
+		assert.Error(t, tlsCfg.Validate())
+	}
+	for _, cipherSuite := range []string{"TLS_RSA_WITH_RC4_128_SHA", "TLS_AES_128_GCM_SHA256", "unknown"} {
+		tlsCfg := GroupTLS{CipherSuites: []string{cipherSuite}}
+		assert.Error(t, tlsCfg.Validate())
+	}
+	for _, host := range []string{"frontend.example.com", "*.frontend.example.com", "*.com"} {
+		tlsCfg := GroupTLS{PerHostOverrides: map[string]ServerTLS{host: {}}}
+		assert.NoError(t, tlsCfg.Validate())
+	}
+	for _, host := range []string{"*", "*.", "*frontend.example.com", "a.*.example.com", "*.*.example.com"} {
+		tlsCfg := GroupTLS{PerHostOverrides: map[string]ServerTLS{host: {}}}
+		assert.Error(t, tlsCfg.Validate())
+	}
+}
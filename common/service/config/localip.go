@@ -37,6 +37,7 @@ import (
 // -1 for any unknown IP addreseses.
 // +300 for IPv4 addresses
 // +100 for non-local addresses, extra +100 for "up" interaces.
+// Link-local addresses are not considered non-local, since they are not routable.
 func scoreAddr(iface net.Interface, addr net.Addr) (int, net.IP) {
 	var ip net.IP
 	if netAddr, ok := addr.(*net.IPNet); ok {
@@ -51,7 +52,7 @@ func scoreAddr(iface net.Interface, addr net.Addr) (int, net.IP) {
 	if ip.To4() != nil {
 		score += 300
 	}
-	if iface.Flags&net.FlagLoopback == 0 && !ip.IsLoopback() {
+	if iface.Flags&net.FlagLoopback == 0 && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() {
 		score += 100
 		if iface.Flags&net.FlagUp != 0 {
 			score += 100
@@ -36,6 +36,7 @@ import (
 func TestScoreAddr(t *testing.T) {
 	ipv4 := net.ParseIP("10.0.1.2")
 	ipv6 := net.ParseIP("2001:db8:a0b:12f0::1")
+	ipv6LinkLocal := net.ParseIP("fe80::1")
 
 	tests := []struct {
 		msg    string
@@ -92,6 +93,20 @@ func TestScoreAddr(t *testing.T) {
 			want:   100,
 			wantIP: ipv6,
 		},
+		{
+			msg:    "non-local up ipv6 address",
+			iface:  net.Interface{Flags: net.FlagUp},
+			addr:   &net.IPNet{IP: ipv6},
+			want:   200,
+			wantIP: ipv6,
+		},
+		{
+			msg:    "link-local up ipv6 address",
+			iface:  net.Interface{Flags: net.FlagUp},
+			addr:   &net.IPNet{IP: ipv6LinkLocal},
+			want:   0,
+			wantIP: ipv6LinkLocal,
+		},
 		{
 			msg:   "unknown address type",
 			iface: net.Interface{},
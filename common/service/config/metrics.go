@@ -25,7 +25,11 @@
 package config
 
 import (
+	"crypto/tls"
 	"fmt"
+	"net"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/cactus/go-statsd-client/statsd"
@@ -39,6 +43,9 @@ import (
 	statsdreporter "go.temporal.io/server/common/metrics/tally/statsd"
 )
 
+// defaultPrometheusHandlerPath is the path tally serves prometheus metrics on when config doesn't specify one
+const defaultPrometheusHandlerPath = "/metrics"
+
 // tally sanitizer options that satisfy both Prometheus and M3 restrictions.
 // This will rename metrics at the tally emission level, so metrics name we
 // use maybe different from what gets emitted. In the current implementation
@@ -77,6 +84,12 @@ var (
 // Current priority order is:
 // customReporter > m3 > statsd > prometheus
 func (c *Metrics) NewScope(logger log.Logger, customReporter tally.BaseStatsReporter) tally.Scope {
+	return c.NewScopeWithTLS(logger, customReporter, nil)
+}
+
+// NewScopeWithTLS builds a new tally scope the same way as NewScope, prometheus
+// metrics with their own listen address are served over TLS if tlsConfig is set
+func (c *Metrics) NewScopeWithTLS(logger log.Logger, customReporter tally.BaseStatsReporter, tlsConfig *tls.Config) tally.Scope {
 	if c == nil {
 		c = &Metrics{}
 	}
@@ -90,11 +103,23 @@ func (c *Metrics) NewScope(logger log.Logger, customReporter tally.BaseStatsRepo
 		return c.newStatsdScope(logger)
 	}
 	if c.Prometheus != nil {
-		return c.newPrometheusScope(logger)
+		return c.newPrometheusScope(logger, tlsConfig)
 	}
 	return tally.NoopScope
 }
 
+// PrometheusHandlerPath returns path of prometheus metrics endpoint registered with default HTTP handler,
+// or empty string if prometheus metrics have their own listen address or are not configured
+func (c *Metrics) PrometheusHandlerPath() string {
+	if c.Prometheus == nil || strings.TrimSpace(c.Prometheus.ListenAddress) != "" {
+		return ""
+	}
+	if path := strings.TrimSpace(c.Prometheus.HandlerPath); path != "" {
+		return path
+	}
+	return defaultPrometheusHandlerPath
+}
+
 func (c *Metrics) newCustomReporterScope(logger log.Logger, customReporter tally.BaseStatsReporter) tally.Scope {
 	options := tally.ScopeOptions{Tags: c.Tags, Prefix: c.Prefix}
 	switch reporter := customReporter.(type) {
@@ -159,17 +184,24 @@ func (c *Metrics) newStatsdScope(logger log.Logger) tally.Scope {
 
 // newPrometheusScope returns a new prometheus scope with
 // a default reporting interval of a second
-func (c *Metrics) newPrometheusScope(logger log.Logger) tally.Scope {
-	reporter, err := c.Prometheus.NewReporter(
-		prometheus.ConfigurationOptions{
-			Registry: prom.NewRegistry(),
-			OnError: func(err error) {
-				logger.Warn("error in prometheus reporter", tag.Error(err))
+func (c *Metrics) newPrometheusScope(logger log.Logger, tlsConfig *tls.Config) tally.Scope {
+	onError := func(err error) {
+		logger.Warn("error in prometheus reporter", tag.Error(err))
+	}
+	var reporter prometheus.Reporter
+	if tlsConfig != nil && strings.TrimSpace(c.Prometheus.ListenAddress) != "" {
+		reporter = c.newPrometheusTLSReporter(tlsConfig, onError)
+	} else {
+		var err error
+		reporter, err = c.Prometheus.NewReporter(
+			prometheus.ConfigurationOptions{
+				Registry: prom.NewRegistry(),
+				OnError:  onError,
 			},
-		},
-	)
-	if err != nil {
-		logger.Fatal("error creating prometheus reporter", tag.Error(err))
+		)
+		if err != nil {
+			logger.Fatal("error creating prometheus reporter", tag.Error(err))
+		}
 	}
 	scopeOpts := tally.ScopeOptions{
 		Tags:            c.Tags,
@@ -181,3 +213,52 @@ func (c *Metrics) newPrometheusScope(logger log.Logger) tally.Scope {
 	scope, _ := tally.NewRootScope(scopeOpts, time.Second)
 	return scope
 }
+
+// newPrometheusTLSReporter creates a prometheus reporter configured the same way as tally does,
+// serving metrics over TLS on the listen address instead of plain HTTP
+func (c *Metrics) newPrometheusTLSReporter(tlsConfig *tls.Config, onError func(error)) prometheus.Reporter {
+	opts := prometheus.Options{
+		Registerer:      prom.NewRegistry(),
+		OnRegisterError: onError,
+	}
+	switch c.Prometheus.TimerType {
+	case "summary":
+		opts.DefaultTimerType = prometheus.SummaryTimerType
+	case "histogram":
+		opts.DefaultTimerType = prometheus.HistogramTimerType
+	}
+	for _, bucket := range c.Prometheus.DefaultHistogramBuckets {
+		opts.DefaultHistogramBuckets = append(opts.DefaultHistogramBuckets, bucket.Upper)
+	}
+	if len(c.Prometheus.DefaultSummaryObjectives) > 0 {
+		opts.DefaultSummaryObjectives = make(map[float64]float64, len(c.Prometheus.DefaultSummaryObjectives))
+		for _, objective := range c.Prometheus.DefaultSummaryObjectives {
+			opts.DefaultSummaryObjectives[objective.Percentile] = objective.AllowedError
+		}
+	}
+	reporter := prometheus.NewReporter(opts)
+
+	path := defaultPrometheusHandlerPath
+	if handlerPath := strings.TrimSpace(c.Prometheus.HandlerPath); handlerPath != "" {
+		path = handlerPath
+	}
+	mux := http.NewServeMux()
+	mux.Handle(path, reporter.HTTPHandler())
+	go func() {
+		network := c.Prometheus.ListenNetwork
+		if network == "" {
+			network = "tcp"
+		}
+		listener, err := net.Listen(network, strings.TrimSpace(c.Prometheus.ListenAddress))
+		if err != nil {
+			onError(err)
+			return
+		}
+		defer listener.Close()
+
+		if err := http.Serve(tls.NewListener(listener, tlsConfig), mux); err != nil {
+			onError(err)
+		}
+	}()
+	return reporter
+}
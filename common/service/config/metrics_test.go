@@ -148,3 +148,17 @@ func (s *MetricsSuite) TestUnsupportedReporter() {
 	scope := config.NewScope(loggerimpl.NewNopLogger(), UnsupportedNullStatsReporter)
 	s.Nil(scope)
 }
+
+func (s *MetricsSuite) TestPrometheusHandlerPath() {
+	config := &Metrics{}
+	s.Equal("", config.PrometheusHandlerPath())
+
+	config.Prometheus = &prometheus.Configuration{}
+	s.Equal("/metrics", config.PrometheusHandlerPath())
+
+	config.Prometheus.HandlerPath = "/prometheus"
+	s.Equal("/prometheus", config.PrometheusHandlerPath())
+
+	config.Prometheus.ListenAddress = "127.0.0.1:9090"
+	s.Equal("", config.PrometheusHandlerPath())
+}
@@ -25,6 +25,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -55,6 +56,18 @@ func (c *Persistence) Validate() error {
 		if !ok {
 			return fmt.Errorf("persistence config: missing config for datastore %v", st)
 		}
+		if ds.CustomVisibilityStore != nil {
+			if st == c.DefaultStore {
+				return fmt.Errorf("persistence config: datastore %v: custom visibility store can be used only for visibility", st)
+			}
+			if ds.SQL != nil || ds.Cassandra != nil {
+				return fmt.Errorf("persistence config: datastore %v: custom visibility store can't be specified along with cassandra or sql", st)
+			}
+			if ds.CustomVisibilityStore.Name == "" {
+				return fmt.Errorf("persistence config: datastore %v: custom visibility store name must be specified", st)
+			}
+			continue
+		}
 		if ds.SQL == nil && ds.Cassandra == nil {
 			return fmt.Errorf("persistence config: datastore %v: must provide config for one of cassandra or sql stores", st)
 		}
@@ -64,6 +77,11 @@ func (c *Persistence) Validate() error {
 		if ds.SQL != nil && ds.SQL.TaskScanPartitions == 0 {
 			ds.SQL.TaskScanPartitions = 1
 		}
+		if ds.SQL != nil {
+			if err := ds.SQL.validate(); err != nil {
+				return err
+			}
+		}
 		if ds.Cassandra != nil {
 			if err := ds.Cassandra.validate(); err != nil {
 				return err
@@ -114,7 +132,54 @@ func ensureStoreConsistencyNotNil(c *CassandraStoreConsistency) *CassandraStoreC
 }
 
 func (c *Cassandra) validate() error {
-	return c.Consistency.validate()
+	if err := c.Consistency.validate(); err != nil {
+		return err
+	}
+	return c.TableOptions.Validate()
+}
+
+// Validate validates the table options of each group
+func (c *CassandraTableOptions) Validate() error {
+	if c == nil {
+		return nil
+	}
+	groups := map[string]*CassandraTableGroupOptions{
+		"history":    c.History,
+		"visibility": c.Visibility,
+		"tasks":      c.Tasks,
+	}
+	for name, group := range groups {
+		if err := group.validate(); err != nil {
+			return fmt.Errorf("cassandra %v table options: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (c *CassandraTableGroupOptions) validate() error {
+	if c == nil {
+		return nil
+	}
+	if len(c.Compaction) > 0 && c.Compaction["class"] == "" {
+		return errors.New("compaction class is required")
+	}
+	if c.GCGraceSeconds != nil && *c.GCGraceSeconds < 0 {
+		return fmt.Errorf("negative gcGraceSeconds %v", *c.GCGraceSeconds)
+	}
+	if c.DefaultTimeToLive != nil && *c.DefaultTimeToLive < 0 {
+		return fmt.Errorf("negative defaultTimeToLive %v", *c.DefaultTimeToLive)
+	}
+	return nil
+}
+
+func (c *SQL) validate() error {
+	if c.PreparedStatementCacheSize < 0 {
+		return fmt.Errorf("persistence config: negative sql preparedStatementCacheSize %v", c.PreparedStatementCacheSize)
+	}
+	if c.PreparedStatementCacheSize > 0 && c.InterpolateParams {
+		return errors.New("persistence config: sql preparedStatementCacheSize cannot be set with interpolateParams")
+	}
+	return nil
 }
 
 func (c *CassandraStoreConsistency) validate() error {
@@ -230,3 +230,162 @@ func TestCassandraStoreConsistency_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestSQL_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   *SQL
+		wantErr bool
+	}{
+		{
+			name:  "Default",
+			input: &SQL{},
+		},
+		{
+			name:  "Prepared Statement Cache",
+			input: &SQL{PreparedStatementCacheSize: 100},
+		},
+		{
+			name:  "Interpolate Params",
+			input: &SQL{InterpolateParams: true},
+		},
+		{
+			name:    "Negative Prepared Statement Cache",
+			input:   &SQL{PreparedStatementCacheSize: -1},
+			wantErr: true,
+		},
+		{
+			name:    "Prepared Statement Cache With Interpolate Params",
+			input:   &SQL{PreparedStatementCacheSize: 100, InterpolateParams: true},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("SQL.validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCassandraTableOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	negative := -1
+	day := 86400
+	tests := []struct {
+		name    string
+		input   *CassandraTableOptions
+		wantErr bool
+	}{
+		{
+			name:  "Nil",
+			input: nil,
+		},
+		{
+			name: "Valid",
+			input: &CassandraTableOptions{
+				History: &CassandraTableGroupOptions{
+					Compaction:     map[string]string{"class": "LeveledCompactionStrategy"},
+					GCGraceSeconds: &day,
+				},
+				Visibility: &CassandraTableGroupOptions{
+					Compaction:        map[string]string{"class": "TimeWindowCompactionStrategy", "compaction_window_unit": "DAYS"},
+					DefaultTimeToLive: &day,
+				},
+			},
+		},
+		{
+			name: "Missing Compaction Class",
+			input: &CassandraTableOptions{
+				Tasks: &CassandraTableGroupOptions{Compaction: map[string]string{"tombstone_threshold": "0.2"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative GC Grace Seconds",
+			input: &CassandraTableOptions{
+				History: &CassandraTableGroupOptions{GCGraceSeconds: &negative},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Negative Default Time To Live",
+			input: &CassandraTableOptions{
+				Visibility: &CassandraTableGroupOptions{DefaultTimeToLive: &negative},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("CassandraTableOptions.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPersistence_Validate_CustomVisibilityStore(t *testing.T) {
+	t.Parallel()
+
+	sqlStore := DataStore{SQL: &SQL{PluginName: "mysql", TaskScanPartitions: 1}}
+	customStore := DataStore{CustomVisibilityStore: &CustomDatastoreConfig{Name: "clickhouse"}}
+	tests := []struct {
+		name    string
+		input   *Persistence
+		wantErr bool
+	}{
+		{
+			name: "Custom Visibility Store",
+			input: &Persistence{
+				DefaultStore:    "default",
+				VisibilityStore: "visibility",
+				DataStores:      map[string]DataStore{"default": sqlStore, "visibility": customStore},
+			},
+		},
+		{
+			name: "Custom Default Store",
+			input: &Persistence{
+				DefaultStore:    "visibility",
+				VisibilityStore: "visibility",
+				DataStores:      map[string]DataStore{"visibility": customStore},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Missing Name",
+			input: &Persistence{
+				DefaultStore:    "default",
+				VisibilityStore: "visibility",
+				DataStores: map[string]DataStore{
+					"default":    sqlStore,
+					"visibility": {CustomVisibilityStore: &CustomDatastoreConfig{}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Custom And SQL Visibility Store",
+			input: &Persistence{
+				DefaultStore:    "default",
+				VisibilityStore: "visibility",
+				DataStores: map[string]DataStore{
+					"default":    sqlStore,
+					"visibility": {SQL: sqlStore.SQL, CustomVisibilityStore: customStore.CustomVisibilityStore},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.input.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Persistence.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@
 package config
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net/http"
 
@@ -41,6 +42,8 @@ type (
 	PProfInitializerImpl struct {
 		PProf  *PProf
 		Logger log.Logger
+		// TLSConfig serves pprof over TLS if set
+		TLSConfig *tls.Config
 	}
 )
 
@@ -72,7 +75,7 @@ func (initializer *PProfInitializerImpl) Start() error {
 	if atomic.CompareAndSwapInt32(&pprofStatus, pprofNotInitialized, pprofInitialized) {
 		go func() {
 			initializer.Logger.Info("PProf listen on ", tag.Port(port))
-			err := http.ListenAndServe(fmt.Sprintf("localhost:%d", port), nil)
+			err := initializer.listenAndServe(fmt.Sprintf("localhost:%d", port))
 			if err != nil {
 				initializer.Logger.Error("listen and serve err", tag.Error(err))
 			}
@@ -80,3 +83,15 @@ func (initializer *PProfInitializerImpl) Start() error {
 	}
 	return nil
 }
+
+func (initializer *PProfInitializerImpl) listenAndServe(addr string) error {
+	if initializer.TLSConfig == nil {
+		return http.ListenAndServe(addr, nil)
+	}
+	listener, err := tls.Listen("tcp", addr, initializer.TLSConfig)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+	return http.Serve(listener, nil)
+}
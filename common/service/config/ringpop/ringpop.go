@@ -26,7 +26,6 @@ package ringpop
 
 import (
 	"fmt"
-	"net"
 	"sync"
 	"time"
 
@@ -36,6 +35,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/service/config"
 )
 
@@ -72,9 +72,17 @@ func NewRingpopFactory(
 
 // ValidateRingpopConfig validates that ringpop config is parseable and valid
 func ValidateRingpopConfig(rpConfig *config.Membership) error {
-	if rpConfig.BroadcastAddress != "" && net.ParseIP(rpConfig.BroadcastAddress) == nil {
+	if rpConfig.BroadcastAddress != "" && config.ParseIP(rpConfig.BroadcastAddress) == nil {
 		return fmt.Errorf("ringpop config malformed `broadcastAddress` param")
 	}
+	if rpConfig.Encryption.UseInternodeTLS && rpConfig.Encryption.SharedKey != "" {
+		return fmt.Errorf("ringpop config `encryption` params `useInternodeTLS` and `sharedKey` are mutually exclusive")
+	}
+	if rpConfig.Encryption.SharedKey != "" {
+		if _, err := encryption.DecodeSharedKey(rpConfig.Encryption.SharedKey); err != nil {
+			return fmt.Errorf("ringpop config malformed `encryption.sharedKey` param: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -83,6 +83,29 @@ func (s *RingpopSuite) TestInvalidConfig() {
 	s.NoError(ValidateRingpopConfig(&cfg))
 	cfg.BroadcastAddress = "sjhdfskdjhf"
 	s.Error(ValidateRingpopConfig(&cfg))
+	cfg.BroadcastAddress = "2001:db8::1"
+	s.NoError(ValidateRingpopConfig(&cfg))
+	cfg.BroadcastAddress = "[2001:db8::1]"
+	s.NoError(ValidateRingpopConfig(&cfg))
+}
+
+func (s *RingpopSuite) TestEncryptionConfig() {
+	var cfg config.Membership
+	err := yaml.Unmarshal([]byte(`
+encryption:
+  sharedKey: "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="`), &cfg)
+	s.NoError(err)
+	s.NoError(ValidateRingpopConfig(&cfg))
+
+	cfg.Encryption.UseInternodeTLS = true
+	s.Error(ValidateRingpopConfig(&cfg))
+
+	cfg.Encryption.SharedKey = ""
+	s.NoError(ValidateRingpopConfig(&cfg))
+
+	cfg.Encryption.UseInternodeTLS = false
+	cfg.Encryption.SharedKey = "c2hvcnQ="
+	s.Error(ValidateRingpopConfig(&cfg))
 }
 
 func getHostsConfig() string {
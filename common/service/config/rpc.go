@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMinClientPingInterval is the minimum interval at which gRPC servers allow clients to ping by default
+	DefaultMinClientPingInterval = 5 * time.Minute
+
+	// CompressionGzip is the name of gzip gRPC compressor
+	CompressionGzip = "gzip"
+	// CompressionZstd is the name of zstd gRPC compressor
+	CompressionZstd = "zstd"
+
+	// BalancingPolicyRoundRobin is the name of gRPC balancer which spreads calls over all resolved addresses
+	BalancingPolicyRoundRobin = "round_robin"
+	// BalancingPolicyPickFirst is the name of gRPC balancer which sends all calls to the first reachable address
+	BalancingPolicyPickFirst = "pick_first"
+
+	// PlaintextMigrationDialPlaintext accepts TLS and plaintext internode connections and dials plaintext ones
+	PlaintextMigrationDialPlaintext = "dialPlaintext"
+	// PlaintextMigrationDialTLS accepts TLS and plaintext internode connections and dials TLS ones
+	PlaintextMigrationDialTLS = "dialTLS"
+)
+
+// Validate validates the rpc config
+func (r *RPC) Validate() error {
+	bindOptions := 0
+	if r.BindOnLocalHost {
+		bindOptions++
+	}
+	if r.BindOnIP != "" {
+		bindOptions++
+		if ParseIP(r.BindOnIP) == nil {
+			return fmt.Errorf("invalid rpc config: unable to parse bindOnIP %q", r.BindOnIP)
+		}
+	}
+	if r.BindOnInterface != "" {
+		bindOptions++
+	}
+	if bindOptions > 1 {
+		return fmt.Errorf("invalid rpc config: bindOnLocalHost, bindOnIP and bindOnInterface are mutually exclusive")
+	}
+	if r.BroadcastAddress != "" && ParseIP(r.BroadcastAddress) == nil {
+		return fmt.Errorf("invalid rpc config: unable to parse broadcastAddress %q", r.BroadcastAddress)
+	}
+	if r.DrainTimeout < 0 {
+		return fmt.Errorf("invalid rpc config: drainTimeout must not be negative")
+	}
+	if r.MaxReceiveMessageSize < 0 || r.MaxSendMessageSize < 0 {
+		return fmt.Errorf("invalid rpc config: maxReceiveMessageSize and maxSendMessageSize must not be negative")
+	}
+	if r.GRPCSocket != "" && r.ConsolidatePorts {
+		return fmt.Errorf("invalid rpc config: grpcSocket and consolidatePorts are mutually exclusive")
+	}
+	switch r.Compression {
+	case "", CompressionGzip, CompressionZstd:
+	default:
+		return fmt.Errorf("invalid rpc config: unknown compression %q, must be one of %s or %s", r.Compression, CompressionGzip, CompressionZstd)
+	}
+	switch r.InternodePlaintextMigration {
+	case "", PlaintextMigrationDialPlaintext, PlaintextMigrationDialTLS:
+	default:
+		return fmt.Errorf("invalid rpc config: unknown internodePlaintextMigration %q, must be one of %s or %s",
+			r.InternodePlaintextMigration, PlaintextMigrationDialPlaintext, PlaintextMigrationDialTLS)
+	}
+	if err := validateBalancingPolicy(r.FrontendClientBalancing.Policy); err != nil {
+		return err
+	}
+	if r.FrontendClientBalancing.DNSRefreshInterval < 0 {
+		return fmt.Errorf("invalid rpc config: frontendClientBalancing.dnsRefreshInterval must not be negative")
+	}
+	return r.KeepAlive.Validate()
+}
+
+func validateBalancingPolicy(policy string) error {
+	switch policy {
+	case "", BalancingPolicyRoundRobin, BalancingPolicyPickFirst:
+		return nil
+	default:
+		return fmt.Errorf("invalid rpc config: unknown balancing policy %q, must be one of %s or %s", policy, BalancingPolicyRoundRobin, BalancingPolicyPickFirst)
+	}
+}
+
+// Validate validates the gRPC keepalive config
+func (k *GRPCKeepAlive) Validate() error {
+	if k.MinClientPingInterval < 0 || k.ServerPingInterval < 0 || k.ServerPingTimeout < 0 ||
+		k.ClientPingInterval < 0 || k.ClientPingTimeout < 0 {
+		return fmt.Errorf("invalid rpc config: keepAlive intervals and timeouts must not be negative")
+	}
+	minClientPingInterval := k.MinClientPingInterval
+	if minClientPingInterval == 0 {
+		minClientPingInterval = DefaultMinClientPingInterval
+	}
+	if k.ClientPingInterval != 0 && k.ClientPingInterval < minClientPingInterval {
+		return fmt.Errorf("invalid rpc config: keepAlive clientPingInterval %v is shorter than minClientPingInterval %v, "+
+			"servers would disconnect clients for pinging too often", k.ClientPingInterval, minClientPingInterval)
+	}
+	return nil
+}
+
+// ParseIP parses IPv4 or IPv6 address, IPv6 address can be enclosed in brackets.
+// IPv4 address is returned in its 4-byte representation.
+func ParseIP(address string) net.IP {
+	if strings.HasPrefix(address, "[") && strings.HasSuffix(address, "]") {
+		address = address[1 : len(address)-1]
+	}
+	ip := net.ParseIP(address)
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip
+}
+
+// InterfaceIP returns IP address of the network interface to bind to. IPv4 address is preferred
+// on dual-stack interfaces, link-local addresses are skipped since they are not routable.
+func InterfaceIP(name string) (net.IP, error) {
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, err
+	}
+
+	var ipv6 net.IP
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.IsLinkLocalUnicast() {
+			continue
+		}
+		if ip4 := ipNet.IP.To4(); ip4 != nil {
+			return ip4, nil
+		}
+		if ipv6 == nil {
+			ipv6 = ipNet.IP
+		}
+	}
+	if ipv6 == nil {
+		return nil, fmt.Errorf("interface %q has no routable IP address", name)
+	}
+	return ipv6, nil
+}
@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPC_Validate(t *testing.T) {
+	assert.NoError(t, (&RPC{}).Validate())
+	assert.NoError(t, (&RPC{BindOnLocalHost: true}).Validate())
+	assert.NoError(t, (&RPC{BindOnIP: "10.0.0.1"}).Validate())
+	assert.NoError(t, (&RPC{BindOnIP: "::"}).Validate())
+	assert.NoError(t, (&RPC{BindOnIP: "[2001:db8::1]"}).Validate())
+	assert.NoError(t, (&RPC{BindOnInterface: "eth0"}).Validate())
+
+	assert.Error(t, (&RPC{BindOnIP: "not-an-ip"}).Validate())
+	assert.Error(t, (&RPC{BindOnLocalHost: true, BindOnIP: "10.0.0.1"}).Validate())
+	assert.Error(t, (&RPC{BindOnIP: "10.0.0.1", BindOnInterface: "eth0"}).Validate())
+
+	assert.NoError(t, (&RPC{BindOnInterface: "eth1", BroadcastAddress: "10.0.1.1"}).Validate())
+	assert.Error(t, (&RPC{BroadcastAddress: "eth1"}).Validate())
+
+	assert.NoError(t, (&RPC{KeepAlive: GRPCKeepAlive{ClientPingInterval: 10 * time.Minute}}).Validate())
+	assert.NoError(t, (&RPC{KeepAlive: GRPCKeepAlive{MinClientPingInterval: 30 * time.Second, ClientPingInterval: 30 * time.Second}}).Validate())
+	assert.Error(t, (&RPC{KeepAlive: GRPCKeepAlive{ClientPingInterval: 30 * time.Second}}).Validate())
+	assert.Error(t, (&RPC{KeepAlive: GRPCKeepAlive{ServerPingTimeout: -time.Second}}).Validate())
+
+	assert.NoError(t, (&RPC{MaxReceiveMessageSize: 16 * 1024 * 1024, MaxSendMessageSize: 16 * 1024 * 1024}).Validate())
+	assert.Error(t, (&RPC{MaxReceiveMessageSize: -1}).Validate())
+
+	assert.NoError(t, (&RPC{DrainTimeout: time.Minute}).Validate())
+	assert.Error(t, (&RPC{DrainTimeout: -time.Second}).Validate())
+
+	assert.NoError(t, (&RPC{Compression: CompressionGzip}).Validate())
+	assert.NoError(t, (&RPC{Compression: CompressionZstd}).Validate())
+	assert.Error(t, (&RPC{Compression: "snappy"}).Validate())
+
+	assert.NoError(t, (&RPC{GRPCSocket: "/var/run/temporal/frontend.sock"}).Validate())
+	assert.Error(t, (&RPC{GRPCSocket: "/var/run/temporal/frontend.sock", ConsolidatePorts: true}).Validate())
+
+	assert.NoError(t, (&RPC{InternodePlaintextMigration: PlaintextMigrationDialPlaintext}).Validate())
+	assert.NoError(t, (&RPC{InternodePlaintextMigration: PlaintextMigrationDialTLS}).Validate())
+	assert.Error(t, (&RPC{InternodePlaintextMigration: "plaintext"}).Validate())
+
+	assert.NoError(t, (&RPC{FrontendClientBalancing: FrontendClientBalancing{Policy: BalancingPolicyPickFirst, DNSRefreshInterval: time.Minute}}).Validate())
+	assert.Error(t, (&RPC{FrontendClientBalancing: FrontendClientBalancing{Policy: "least_request"}}).Validate())
+	assert.Error(t, (&RPC{FrontendClientBalancing: FrontendClientBalancing{DNSRefreshInterval: -time.Second}}).Validate())
+}
+
+func TestParseIP(t *testing.T) {
+	assert.Equal(t, net.IP{10, 0, 0, 1}, ParseIP("10.0.0.1"))
+	assert.Equal(t, net.ParseIP("2001:db8::1"), ParseIP("2001:db8::1"))
+	assert.Equal(t, net.ParseIP("2001:db8::1"), ParseIP("[2001:db8::1]"))
+	assert.Nil(t, ParseIP("[10.0.0.1"))
+	assert.Nil(t, ParseIP("eth0"))
+}
+
+func TestInterfaceIP(t *testing.T) {
+	interfaces, err := net.Interfaces()
+	assert.NoError(t, err)
+	for _, iface := range interfaces {
+		if iface.Flags&net.FlagLoopback == 0 {
+			continue
+		}
+		ip, err := InterfaceIP(iface.Name)
+		assert.NoError(t, err)
+		assert.True(t, ip.IsLoopback())
+	}
+
+	_, err = InterfaceIP("no-such-interface")
+	assert.Error(t, err)
+}
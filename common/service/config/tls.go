@@ -0,0 +1,129 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+)
+
+const (
+	// OCSPFailurePolicySoft accepts client certificates whose OCSP status cannot be determined
+	OCSPFailurePolicySoft = "soft"
+	// OCSPFailurePolicyHard rejects client certificates whose OCSP status cannot be determined
+	OCSPFailurePolicyHard = "hard"
+)
+
+// Validate validates the TLS version, cipher suite and OCSP settings of the group
+func (r *GroupTLS) Validate() error {
+	minVersion, err := r.GetMinVersion()
+	if err != nil {
+		return err
+	}
+	cipherSuites, err := r.GetCipherSuites()
+	if err != nil {
+		return err
+	}
+	if minVersion == tls.VersionTLS13 && len(cipherSuites) > 0 {
+		return fmt.Errorf("invalid tls config: cipherSuites cannot be used with minVersion 1.3, TLS 1.3 cipher suites are not configurable")
+	}
+	if _, err := r.Server.IsOCSPHardFail(); err != nil {
+		return err
+	}
+	for host, override := range r.PerHostOverrides {
+		if err := validateHostOverride(host); err != nil {
+			return err
+		}
+		if _, err := override.IsOCSPHardFail(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsOCSPHardFail returns whether client certificates whose OCSP status cannot be determined are rejected
+func (r *ServerTLS) IsOCSPHardFail() (bool, error) {
+	switch r.OCSPFailurePolicy {
+	case "", OCSPFailurePolicySoft:
+		return false, nil
+	case OCSPFailurePolicyHard:
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid tls config: unsupported ocspFailurePolicy %q, must be either %v or %v",
+			r.OCSPFailurePolicy, OCSPFailurePolicySoft, OCSPFailurePolicyHard)
+	}
+}
+
+// validateHostOverride checks that a host name of PerHostOverrides either has no wildcard
+// or starts with a single "*." label
+func validateHostOverride(host string) error {
+	suffix := strings.TrimPrefix(host, "*.")
+	if suffix == "" || strings.Contains(suffix, "*") {
+		return fmt.Errorf("invalid tls config: unsupported hostOverrides entry %q, wildcards are only allowed as a leading \"*.\" label", host)
+	}
+	return nil
+}
+
+// GetMinVersion returns the minimum TLS version of the group, 0 if not configured
+func (r *GroupTLS) GetMinVersion() (uint16, error) {
+	switch r.MinVersion {
+	case "":
+		return 0, nil
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("invalid tls config: unsupported minVersion %q, must be either 1.2 or 1.3", r.MinVersion)
+	}
+}
+
+// GetCipherSuites returns the IDs of the cipher suites of the group, nil if not configured.
+// Only secure cipher suites usable with TLS 1.2 are accepted.
+func (r *GroupTLS) GetCipherSuites() ([]uint16, error) {
+	if len(r.CipherSuites) == 0 {
+		return nil, nil
+	}
+
+	supported := make(map[string]uint16)
+	for _, suite := range tls.CipherSuites() {
+		for _, version := range suite.SupportedVersions {
+			if version == tls.VersionTLS12 {
+				supported[suite.Name] = suite.ID
+			}
+		}
+	}
+
+	ids := make([]uint16, 0, len(r.CipherSuites))
+	for _, name := range r.CipherSuites {
+		id, ok := supported[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid tls config: unsupported cipher suite %q", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -27,9 +27,13 @@
 package dynamicconfig
 
 import (
+	"errors"
 	"time"
 )
 
+// ErrUpdateNotSupported is returned by UpdateValue of clients which are read-only
+var ErrUpdateNotSupported = errors.New("dynamic config client does not support updates")
+
 // Client allows fetching values from a dynamic configuration system NOTE: This does not have async
 // options right now. In the interest of keeping it minimal, we can add when requirement arises.
 type Client interface {
@@ -46,6 +50,7 @@ type Client interface {
 	GetDurationValue(
 		name Key, filters map[Filter]interface{}, defaultValue time.Duration,
 	) (time.Duration, error)
-	// UpdateValue takes value as map and updates by overriding. It doesn't support update with filters.
+	// UpdateValue takes value as map and updates by overriding the value without constraints, values with
+	// constraints are kept. It doesn't support update with filters.
 	UpdateValue(name Key, value interface{}) error
 }
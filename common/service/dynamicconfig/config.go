@@ -98,6 +98,9 @@ type IntPropertyFnWithShardIDFilter func(shardID int32) int
 // FloatPropertyFn is a wrapper to get float property from dynamic config
 type FloatPropertyFn func(opts ...FilterOption) float64
 
+// FloatPropertyFnWithNamespaceFilter is a wrapper to get float property from dynamic config with namespace as filter
+type FloatPropertyFnWithNamespaceFilter func(namespace string) float64
+
 // FloatPropertyFnWithShardIDFilter is a wrapper to get float property from dynamic config with shardID as filter
 type FloatPropertyFnWithShardIDFilter func(shardID int32) float64
 
@@ -244,6 +247,18 @@ func (c *Collection) GetFloat64Property(key Key, defaultValue float64) FloatProp
 	}
 }
 
+// GetFloat64PropertyFilteredByNamespace gets property with namespace filter and asserts that it's a float64
+func (c *Collection) GetFloat64PropertyFilteredByNamespace(key Key, defaultValue float64) FloatPropertyFnWithNamespaceFilter {
+	return func(namespace string) float64 {
+		val, err := c.client.GetFloatValue(key, getFilterMap(NamespaceFilter(namespace)), defaultValue)
+		if err != nil {
+			c.logError(key, err)
+		}
+		c.logValue(key, val, defaultValue, float64CompareEquals)
+		return val
+	}
+}
+
 // GetFloat64PropertyFilteredByShardID gets property with shardID filter and asserts that it's a float64
 func (c *Collection) GetFloat64PropertyFilteredByShardID(key Key, defaultValue float64) FloatPropertyFnWithShardIDFilter {
 	return func(shardID int32) float64 {
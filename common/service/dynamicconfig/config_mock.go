@@ -52,6 +52,11 @@ func GetFloatPropertyFn(value float64) func(opts ...FilterOption) float64 {
 	return func(...FilterOption) float64 { return value }
 }
 
+// GetFloatPropertyFnFilteredByNamespace returns value as FloatPropertyFnWithNamespaceFilter
+func GetFloatPropertyFnFilteredByNamespace(value float64) func(namespace string) float64 {
+	return func(namespace string) float64 { return value }
+}
+
 // GetBoolPropertyFn returns value as BoolPropertyFn
 func GetBoolPropertyFn(value bool) func(opts ...FilterOption) bool {
 	return func(...FilterOption) bool { return value }
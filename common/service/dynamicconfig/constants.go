@@ -39,6 +39,16 @@ func (k Key) String() string {
 	return keyName
 }
 
+// GetKeyByName returns the key which is used in dynamic config source under the given name
+func GetKeyByName(name string) (Key, bool) {
+	for key, keyName := range keys {
+		if key != unknownKey && keyName == name {
+			return key, true
+		}
+	}
+	return unknownKey, false
+}
+
 // Mapping from Key to keyName, where keyName are used dynamic config source.
 var keys = map[Key]string{
 	unknownKey: "unknownKey",
@@ -68,6 +78,7 @@ var keys = map[Key]string{
 	EnableReadFromVisibilityArchival:       "system.enableReadFromVisibilityArchival",
 	EnableNamespaceNotActiveAutoForwarding: "system.enableNamespaceNotActiveAutoForwarding",
 	TransactionSizeLimit:                   "system.transactionSizeLimit",
+	HistoryChecksumVerification:            "system.historyChecksumVerification",
 	MinRetentionDays:                       "system.minRetentionDays",
 	DisallowQuery:                          "system.disallowQuery",
 	EnableBatcher:                          "worker.enableBatcher",
@@ -77,41 +88,67 @@ var keys = map[Key]string{
 	EnableAuthorization:                    "system.enableAuthorization",
 
 	// size limit
-	BlobSizeLimitError:     "limit.blobSize.error",
-	BlobSizeLimitWarn:      "limit.blobSize.warn",
-	HistorySizeLimitError:  "limit.historySize.error",
-	HistorySizeLimitWarn:   "limit.historySize.warn",
-	HistoryCountLimitError: "limit.historyCount.error",
-	HistoryCountLimitWarn:  "limit.historyCount.warn",
-	MaxIDLengthLimit:       "limit.maxIDLength",
+	BlobSizeLimitError:         "limit.blobSize.error",
+	BlobSizeLimitWarn:          "limit.blobSize.warn",
+	BlobSizeLimitOverrides:     "limit.blobSize.overrides",
+	FailureMessageSizeLimit:    "limit.failureMessageSize",
+	FailureStackTraceSizeLimit: "limit.failureStackTraceSize",
+	HistorySizeLimitError:      "limit.historySize.error",
+	HistorySizeLimitWarn:       "limit.historySize.warn",
+	HistoryCountLimitError:     "limit.historyCount.error",
+	HistoryCountLimitWarn:      "limit.historyCount.warn",
+	MaxIDLengthLimit:           "limit.maxIDLength",
+
+	HistorySizeSuggestContinueAsNew:  "limit.historySize.suggestContinueAsNew",
+	HistoryCountSuggestContinueAsNew: "limit.historyCount.suggestContinueAsNew",
 
 	// frontend settings
-	FrontendPersistenceMaxQPS:             "frontend.persistenceMaxQPS",
-	FrontendPersistenceGlobalMaxQPS:       "frontend.persistenceGlobalMaxQPS",
-	FrontendVisibilityMaxPageSize:         "frontend.visibilityMaxPageSize",
-	FrontendVisibilityListMaxQPS:          "frontend.visibilityListMaxQPS",
-	FrontendESVisibilityListMaxQPS:        "frontend.esVisibilityListMaxQPS",
-	FrontendMaxBadBinaries:                "frontend.maxBadBinaries",
-	FrontendESIndexMaxResultWindow:        "frontend.esIndexMaxResultWindow",
-	FrontendHistoryMaxPageSize:            "frontend.historyMaxPageSize",
-	FrontendRPS:                           "frontend.rps",
-	FrontendMaxNamespaceRPSPerInstance:    "frontend.namespacerps",
-	FrontendGlobalNamespaceRPS:            "frontend.globalNamespacerps",
-	FrontendHistoryMgrNumConns:            "frontend.historyMgrNumConns",
-	FrontendShutdownDrainDuration:         "frontend.shutdownDrainDuration",
-	DisableListVisibilityByFilter:         "frontend.disableListVisibilityByFilter",
-	FrontendThrottledLogRPS:               "frontend.throttledLogRPS",
-	EnableClientVersionCheck:              "frontend.enableClientVersionCheck",
-	ValidSearchAttributes:                 "frontend.validSearchAttributes",
-	SendRawWorkflowHistory:                "frontend.sendRawWorkflowHistory",
-	SearchAttributesNumberOfKeysLimit:     "frontend.searchAttributesNumberOfKeysLimit",
-	SearchAttributesSizeOfValueLimit:      "frontend.searchAttributesSizeOfValueLimit",
-	SearchAttributesTotalSizeLimit:        "frontend.searchAttributesTotalSizeLimit",
-	VisibilityArchivalQueryMaxPageSize:    "frontend.visibilityArchivalQueryMaxPageSize",
-	VisibilityArchivalQueryMaxRangeInDays: "frontend.visibilityArchivalQueryMaxRangeInDays",
-	VisibilityArchivalQueryMaxQPS:         "frontend.visibilityArchivalQueryMaxQPS",
-	EnableServerVersionCheck:              "frontend.enableServerVersionCheck",
-	EnableTokenNamespaceEnforcement:       "frontend.enableTokenNamespaceEnforcement",
+	FrontendPersistenceMaxQPS:              "frontend.persistenceMaxQPS",
+	FrontendPersistenceGlobalMaxQPS:        "frontend.persistenceGlobalMaxQPS",
+	FrontendVisibilityMaxPageSize:          "frontend.visibilityMaxPageSize",
+	FrontendVisibilityListMaxQPS:           "frontend.visibilityListMaxQPS",
+	FrontendESVisibilityListMaxQPS:         "frontend.esVisibilityListMaxQPS",
+	FrontendVisibilityConsistencyMaxWait:   "frontend.visibilityConsistencyMaxWait",
+	FrontendEnableVisibilityFederation:     "frontend.enableVisibilityFederation",
+	FrontendMaxBadBinaries:                 "frontend.maxBadBinaries",
+	FrontendEnableArchivalURIProbe:         "frontend.enableArchivalURIProbe",
+	FrontendEnableSearchAttributesUpsert:   "frontend.enableSearchAttributesUpsert",
+	FrontendESIndexMaxResultWindow:         "frontend.esIndexMaxResultWindow",
+	FrontendHistoryMaxPageSize:             "frontend.historyMaxPageSize",
+	FrontendRPS:                            "frontend.rps",
+	FrontendMaxNamespaceRPSPerInstance:     "frontend.namespacerps",
+	FrontendGlobalNamespaceRPS:             "frontend.globalNamespacerps",
+	FrontendNamespaceQuotaWarningThreshold: "frontend.namespaceQuotaWarningThreshold",
+	FrontendMaxPollersPerIdentity:          "frontend.maxPollersPerIdentity",
+	FrontendMaxPollersPerIP:                "frontend.maxPollersPerIP",
+	FrontendPropagatedRequestHeaders:       "frontend.propagatedRequestHeaders",
+	FrontendHistoryMgrNumConns:             "frontend.historyMgrNumConns",
+	FrontendShutdownDrainDuration:          "frontend.shutdownDrainDuration",
+	FrontendShutdownRequestDrainDuration:   "frontend.shutdownRequestDrainDuration",
+	DisableListVisibilityByFilter:          "frontend.disableListVisibilityByFilter",
+	FrontendThrottledLogRPS:                "frontend.throttledLogRPS",
+	EnableClientVersionCheck:               "frontend.enableClientVersionCheck",
+	ValidSearchAttributes:                  "frontend.validSearchAttributes",
+	SendRawWorkflowHistory:                 "frontend.sendRawWorkflowHistory",
+	AllowRawWorkflowHistoryRequests:        "frontend.allowRawWorkflowHistoryRequests",
+	FrontendPollWorkflowTaskQueueTimeout:   "frontend.pollWorkflowTaskQueueTimeout",
+	FrontendPollActivityTaskQueueTimeout:   "frontend.pollActivityTaskQueueTimeout",
+	FrontendGetHistoryLongPollTimeout:      "frontend.getWorkflowExecutionHistoryLongPollTimeout",
+	FrontendRedactionRules:                 "frontend.redactionRules",
+	FrontendFailureDetailEncodings:         "frontend.failureDetailEncodings",
+	FrontendRunChainMaxLength:              "frontend.runChainMaxLength",
+	FrontendCallerRPS:                      "frontend.callerRPS",
+	FrontendCallerRPSOverrides:             "frontend.callerRPSOverrides",
+	FrontendCallerAPIRPS:                   "frontend.callerAPIRPS",
+	FrontendEnableAsyncIntake:              "frontend.enableAsyncIntake",
+	SearchAttributesNumberOfKeysLimit:      "frontend.searchAttributesNumberOfKeysLimit",
+	SearchAttributesSizeOfValueLimit:       "frontend.searchAttributesSizeOfValueLimit",
+	SearchAttributesTotalSizeLimit:         "frontend.searchAttributesTotalSizeLimit",
+	VisibilityArchivalQueryMaxPageSize:     "frontend.visibilityArchivalQueryMaxPageSize",
+	VisibilityArchivalQueryMaxRangeInDays:  "frontend.visibilityArchivalQueryMaxRangeInDays",
+	VisibilityArchivalQueryMaxQPS:          "frontend.visibilityArchivalQueryMaxQPS",
+	EnableServerVersionCheck:               "frontend.enableServerVersionCheck",
+	EnableTokenNamespaceEnforcement:        "frontend.enableTokenNamespaceEnforcement",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
@@ -135,6 +172,11 @@ var keys = map[Key]string{
 	MatchingForwarderMaxRatePerSecond:       "matching.forwarderMaxRatePerSecond",
 	MatchingForwarderMaxChildrenPerNode:     "matching.forwarderMaxChildrenPerNode",
 	MatchingShutdownDrainDuration:           "matching.shutdownDrainDuration",
+	MatchingHistoryDegradedLatencyThreshold: "matching.historyDegradedLatencyThreshold",
+	MatchingDegradedLongPollExpiration:      "matching.degradedLongPollExpirationInterval",
+	MatchingAutoscalingHintInterval:         "matching.autoscalingHintInterval",
+	MatchingAutoscalingBacklogDrainTarget:   "matching.autoscalingBacklogDrainTarget",
+	MatchingTaskPointDelete:                 "matching.taskPointDelete",
 
 	// history settings
 	HistoryRPS:                                           "history.rps",
@@ -147,6 +189,8 @@ var keys = map[Key]string{
 	HistoryMaxAutoResetPoints:                            "history.historyMaxAutoResetPoints",
 	HistoryCacheMaxSize:                                  "history.cacheMaxSize",
 	HistoryCacheTTL:                                      "history.cacheTTL",
+	HistoryLockContentionThreshold:                       "history.lockContentionThreshold",
+	HistoryLockContentionMaxTracked:                      "history.lockContentionMaxTracked",
 	HistoryShutdownDrainDuration:                         "history.shutdownDrainDuration",
 	EventsCacheInitialSize:                               "history.eventsCacheInitialSize",
 	EventsCacheMaxSize:                                   "history.eventsCacheMaxSize",
@@ -198,6 +242,8 @@ var keys = map[Key]string{
 	TransferProcessorMaxRedispatchQueueSize:              "history.transferProcessorMaxRedispatchQueueSize",
 	TransferProcessorEnablePriorityTaskProcessor:         "history.transferProcessorEnablePriorityTaskProcessor",
 	TransferProcessorVisibilityArchivalTimeLimit:         "history.transferProcessorVisibilityArchivalTimeLimit",
+	TransferProcessorHistoryArchivalTimeLimit:            "history.transferProcessorHistoryArchivalTimeLimit",
+	ArchiveHistoryOnClose:                                "history.archiveHistoryOnClose",
 
 	VisibilityTaskBatchSize:                                "history.visibilityTaskBatchSize",
 	VisibilityProcessorFailoverMaxPollRPS:                  "history.visibilityProcessorFailoverMaxPollRPS",
@@ -216,6 +262,22 @@ var keys = map[Key]string{
 	VisibilityProcessorMaxRedispatchQueueSize:              "history.visibilityProcessorMaxRedispatchQueueSize",
 	VisibilityProcessorEnablePriorityTaskProcessor:         "history.visibilityProcessorEnablePriorityTaskProcessor",
 	VisibilityProcessorVisibilityArchivalTimeLimit:         "history.visibilityProcessorVisibilityArchivalTimeLimit",
+	VisibilityProcessorLoadSheddingFailureThreshold:        "history.visibilityProcessorLoadSheddingFailureThreshold",
+	VisibilityProcessorLoadSheddingProbeInterval:           "history.visibilityProcessorLoadSheddingProbeInterval",
+	VisibilityProcessorCatchUpRPS:                          "history.visibilityProcessorCatchUpRPS",
+
+	CustomTaskBatchSize:                                "history.customTaskBatchSize",
+	CustomProcessorMaxPollRPS:                          "history.customProcessorMaxPollRPS",
+	CustomTaskWorkerCount:                              "history.customTaskWorkerCount",
+	CustomTaskMaxRetryCount:                            "history.customTaskMaxRetryCount",
+	CustomProcessorMaxPollInterval:                     "history.customProcessorMaxPollInterval",
+	CustomProcessorMaxPollIntervalJitterCoefficient:    "history.customProcessorMaxPollIntervalJitterCoefficient",
+	CustomProcessorUpdateAckInterval:                   "history.customProcessorUpdateAckInterval",
+	CustomProcessorUpdateAckIntervalJitterCoefficient:  "history.customProcessorUpdateAckIntervalJitterCoefficient",
+	CustomProcessorRedispatchInterval:                  "history.customProcessorRedispatchInterval",
+	CustomProcessorRedispatchIntervalJitterCoefficient: "history.customProcessorRedispatchIntervalJitterCoefficient",
+	CustomProcessorMaxRedispatchQueueSize:              "history.customProcessorMaxRedispatchQueueSize",
+	CustomProcessorEnablePriorityTaskProcessor:         "history.customProcessorEnablePriorityTaskProcessor",
 
 	ReplicatorTaskBatchSize:                                "history.replicatorTaskBatchSize",
 	ReplicatorTaskWorkerCount:                              "history.replicatorTaskWorkerCount",
@@ -232,9 +294,16 @@ var keys = map[Key]string{
 	ReplicatorProcessorEnablePriorityTaskProcessor:         "history.replicatorProcessorEnablePriorityTaskProcessor",
 	MaximumBufferedEventsBatch:                             "history.maximumBufferedEventsBatch",
 	MaximumSignalsPerExecution:                             "history.maximumSignalsPerExecution",
+	EnableSignalDLQ:                                        "history.enableSignalDLQ",
+	MaximumHistoryBranchesPerWorkflow:                      "history.maximumHistoryBranchesPerWorkflow",
 	ShardUpdateMinInterval:                                 "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                                   "history.shardSyncMinInterval",
 	ShardSyncTimerJitterCoefficient:                        "history.shardSyncMinInterval",
+	ShardJournalEnabled:                                    "history.shardJournalEnabled",
+	ShardJournalMaxEntries:                                 "history.shardJournalMaxEntries",
+	ShardJournalWarmUpRPS:                                  "history.shardJournalWarmUpRPS",
+	RestoreConsistencyMarkerID:                             "history.restoreConsistencyMarkerID",
+	RestoreConsistencyMarkerTrimTasks:                      "history.restoreConsistencyMarkerTrimTasks",
 	DefaultEventEncoding:                                   "history.defaultEventEncoding",
 	EnableParentClosePolicy:                                "history.enableParentClosePolicy",
 	NumArchiveSystemWorkflows:                              "history.numArchiveSystemWorkflows",
@@ -272,6 +341,20 @@ var keys = map[Key]string{
 	SkipReapplicationByNamespaceId:                         "history.SkipReapplicationByNamespaceId",
 	DefaultActivityRetryPolicy:                             "history.defaultActivityRetryPolicy",
 	DefaultWorkflowRetryPolicy:                             "history.defaultWorkflowRetryPolicy",
+	RetryBackoffJitterCoefficient:                          "history.retryBackoffJitterCoefficient",
+	CronMaxJitterDuration:                                  "history.cronMaxJitterDuration",
+	ActivityTaskQueueReroutes:                              "history.activityTaskQueueReroutes",
+	WorkflowReportsTotalSizeLimit:                          "history.workflowReportsTotalSizeLimit",
+	ReplicationEventBatchSizeLimit:                         "history.replicationEventBatchSizeLimit",
+	ReplicationChunkedTransferEnabled:                      "history.replicationChunkedTransferEnabled",
+	ReplicationEventChunkSize:                              "history.replicationEventChunkSize",
+	ReplicationChunkedEventsCacheSize:                      "history.replicationChunkedEventsCacheSize",
+	ReplicationChunkedEventsCacheTTL:                       "history.replicationChunkedEventsCacheTTL",
+	EnableTaskLatencyBreakdown:                             "history.enableTaskLatencyBreakdown",
+	EnableStuckExecutionDetection:                          "history.enableStuckExecutionDetection",
+	StuckActivityStartThreshold:                            "history.stuckActivityStartThreshold",
+	StuckWorkflowTaskStartThreshold:                        "history.stuckWorkflowTaskStartThreshold",
+	StuckWorkflowTaskAttemptThreshold:                      "history.stuckWorkflowTaskAttemptThreshold",
 	VisibilityQueue:                                        "history.visibilityQueue",
 	VisibilityProcessorEnabled:                             "history.visibilityProcessorEnabled",
 
@@ -305,6 +388,11 @@ var keys = map[Key]string{
 	TaskQueueScannerEnabled:                         "worker.taskQueueScannerEnabled",
 	HistoryScannerEnabled:                           "worker.historyScannerEnabled",
 	ExecutionsScannerEnabled:                        "worker.executionsScannerEnabled",
+	WorkerLeaderElectionRefreshInterval:             "worker.leaderElectionRefreshInterval",
+	WorkerLeaderLeaseTTL:                            "worker.leaderLeaseTTL",
+	EnableCanary:                                    "worker.enableCanary",
+	EnableIntakeProcessor:                           "worker.enableIntakeProcessor",
+	IntakeProcessorRPS:                              "worker.intakeProcessorRPS",
 }
 
 const (
@@ -348,6 +436,9 @@ const (
 	EnableNamespaceNotActiveAutoForwarding
 	// TransactionSizeLimit is the largest allowed transaction size to persistence
 	TransactionSizeLimit
+	// HistoryChecksumVerification is how checksum mismatch of history events read from persistence is handled,
+	// one of off, log, metric (log and emit metric) and fail (log, emit metric and fail the read)
+	HistoryChecksumVerification
 	// MinRetentionDays is the minimal allowed retention days for namespace
 	MinRetentionDays
 	// DisallowQuery is the key to disallow query for a namespace
@@ -360,6 +451,14 @@ const (
 	BlobSizeLimitError
 	// BlobSizeLimitWarn is the per event blob size limit for warning
 	BlobSizeLimitWarn
+	// BlobSizeLimitOverrides maps API or command type to its own warn and error blob size limits,
+	// e.g. {"StartWorkflowExecution": {"warn": 262144, "error": 1048576}}
+	BlobSizeLimitOverrides
+	// FailureMessageSizeLimit is the size reported failures and each of their causes have their message truncated to
+	FailureMessageSizeLimit
+	// FailureStackTraceSizeLimit is the size reported failures and each of their causes have their stack trace
+	// truncated to
+	FailureStackTraceSizeLimit
 	// HistorySizeLimitError is the per workflow execution history size limit
 	HistorySizeLimitError
 	// HistorySizeLimitWarn is the per workflow execution history size limit for warning
@@ -368,6 +467,12 @@ const (
 	HistoryCountLimitError
 	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
 	HistoryCountLimitWarn
+	// HistorySizeSuggestContinueAsNew is the per workflow execution history size above which
+	// workflow task responses suggest continue-as-new, 0 disables the suggestion
+	HistorySizeSuggestContinueAsNew
+	// HistoryCountSuggestContinueAsNew is the per workflow execution history event count above which
+	// workflow task responses suggest continue-as-new, 0 disables the suggestion
+	HistoryCountSuggestContinueAsNew
 
 	// MaxIDLengthLimit is the length limit for various IDs, including: Namespace, TaskQueue, WorkflowID, ActivityID, TimerID,
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
@@ -395,21 +500,79 @@ const (
 	FrontendMaxNamespaceRPSPerInstance
 	// FrontendGlobalNamespaceRPS is workflow namespace rate limit per second for the whole cluster
 	FrontendGlobalNamespaceRPS
+	// FrontendNamespaceQuotaWarningThreshold is the utilization of namespace rate limit at which
+	// start workflow responses carry a quota warning header, 0 disables the warning
+	FrontendNamespaceQuotaWarningThreshold
+	// FrontendMaxPollersPerIdentity is the max number of concurrent long polls of namespace
+	// from the same worker identity on a frontend host, 0 means no limit
+	FrontendMaxPollersPerIdentity
+	// FrontendMaxPollersPerIP is the max number of concurrent long polls of namespace
+	// from the same client IP on a frontend host, 0 means no limit
+	FrontendMaxPollersPerIP
+	// FrontendPropagatedRequestHeaders maps gRPC request headers to the keys under which their values are
+	// captured into header and memo of started workflows of namespace
+	FrontendPropagatedRequestHeaders
 	// FrontendHistoryMgrNumConns is for persistence cluster.NumConns
 	FrontendHistoryMgrNumConns
 	// FrontendThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	FrontendThrottledLogRPS
 	// FrontendShutdownDrainDuration is the duration of traffic drain during shutdown
 	FrontendShutdownDrainDuration
+	// FrontendShutdownRequestDrainDuration is the max time in-flight requests are given to complete during shutdown,
+	// after long polls are completed and clients are told to reconnect elsewhere
+	FrontendShutdownRequestDrainDuration
 	// EnableClientVersionCheck enables client version check for frontend
 	EnableClientVersionCheck
 
+	// FrontendVisibilityConsistencyMaxWait is the max time ListWorkflowExecutions waits for the visibility record
+	// referenced by the visibility consistency token of the request
+	FrontendVisibilityConsistencyMaxWait
+	// FrontendEnableVisibilityFederation is whether ListWorkflowExecutions of global namespace active in other cluster
+	// also queries visibility of the active cluster and merges results of both clusters
+	FrontendEnableVisibilityFederation
 	// FrontendMaxBadBinaries is the max number of bad binaries in namespace config
 	FrontendMaxBadBinaries
+	// FrontendEnableArchivalURIProbe is whether to write, read back and delete a probe object under archival URIs
+	// when archival is enabled for a namespace. The probe runs with the archival credentials of frontend, which
+	// need to match those of history and worker hosts for the probe to be meaningful.
+	FrontendEnableArchivalURIProbe
+	// FrontendEnableSearchAttributesUpsert is whether external callers can upsert search attributes of running workflows
+	FrontendEnableSearchAttributesUpsert
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes
 	// SendRawWorkflowHistory is whether to enable raw history retrieving
 	SendRawWorkflowHistory
+	// AllowRawWorkflowHistoryRequests is whether callers of namespace, e.g. SDK replayers, can get raw history
+	// from frontend RawHistoryService
+	AllowRawWorkflowHistoryRequests
+	// FrontendPollWorkflowTaskQueueTimeout is the long poll timeout of PollWorkflowTaskQueue, zero keeps the caller deadline
+	FrontendPollWorkflowTaskQueueTimeout
+	// FrontendPollActivityTaskQueueTimeout is the long poll timeout of PollActivityTaskQueue, zero keeps the caller deadline
+	FrontendPollActivityTaskQueueTimeout
+	// FrontendGetHistoryLongPollTimeout is the long poll timeout of GetWorkflowExecutionHistory waiting for new events,
+	// zero keeps the caller deadline
+	FrontendGetHistoryLongPollTimeout
+	// FrontendRedactionRules maps payload field, header, memo or search attribute to "strip" or "hash" action
+	// applied when events and executions are returned to callers without admin or worker role,
+	// e.g. {"input": "strip", "result": "hash", "header.auth-token": "strip"}
+	FrontendRedactionRules
+	// FrontendFailureDetailEncodings is comma separated list of payload encodings accepted in failure details,
+	// failures with details of other encodings are rejected, empty list accepts all encodings
+	FrontendFailureDetailEncodings
+	// FrontendRunChainMaxLength is the max number of most recent runs reported in run chain of a workflow
+	FrontendRunChainMaxLength
+	// FrontendCallerRPS is the rate limit per caller identity (auth claims subject, client cert subject or
+	// client IP) across all APIs of a frontend host, zero means no limit
+	FrontendCallerRPS
+	// FrontendCallerRPSOverrides maps caller identity to its rate limit overriding FrontendCallerRPS,
+	// e.g. {"batch-jobs": 50}
+	FrontendCallerRPSOverrides
+	// FrontendCallerAPIRPS maps API name to the rate limit of that API per caller identity,
+	// e.g. {"StartWorkflowExecution": 100, "ListWorkflowExecutions": 10}
+	FrontendCallerAPIRPS
+	// FrontendEnableAsyncIntake is whether IntakeService accepts start and signal requests of the namespace to the
+	// intake queue, from which intake processor of worker service applies them
+	FrontendEnableAsyncIntake
 	// SearchAttributesNumberOfKeysLimit is the limit of number of keys
 	SearchAttributesNumberOfKeysLimit
 	// SearchAttributesSizeOfValueLimit is the size limit of each value
@@ -471,6 +634,19 @@ const (
 	MatchingForwarderMaxChildrenPerNode
 	// MatchingShutdownDrainDuration is the duration of traffic drain during shutdown
 	MatchingShutdownDrainDuration
+	// MatchingHistoryDegradedLatencyThreshold is the average latency of history calls starting dispatched tasks
+	// above which history is considered degraded and polls are shed, 0 disables shedding
+	MatchingHistoryDegradedLatencyThreshold
+	// MatchingDegradedLongPollExpiration is the long poll expiration interval used while history is degraded,
+	// polls which get no task in this interval are rejected with a retry hint
+	MatchingDegradedLongPollExpiration
+	// MatchingAutoscalingHintInterval is the interval at which worker autoscaling hints of a task queue are recomputed
+	MatchingAutoscalingHintInterval
+	// MatchingAutoscalingBacklogDrainTarget is the time in which recommended worker count should drain task queue backlog
+	MatchingAutoscalingBacklogDrainTarget
+	// MatchingTaskPointDelete deletes every task of a task queue once it's completed instead of range deleting
+	// completed tasks in batches, for task queues whose reads scan too many range tombstones
+	MatchingTaskPointDelete
 
 	// key for history
 
@@ -492,6 +668,10 @@ const (
 	HistoryCacheMaxSize
 	// HistoryCacheTTL is TTL of history cache
 	HistoryCacheTTL
+	// HistoryLockContentionThreshold is the workflow lock wait time above which the workflow is tracked as contended
+	HistoryLockContentionThreshold
+	// HistoryLockContentionMaxTracked is max number of contended workflows tracked by one history host
+	HistoryLockContentionMaxTracked
 	// HistoryShutdownDrainDuration is the duration of traffic drain during shutdown
 	HistoryShutdownDrainDuration
 	// EventsCacheInitialSize is initial size of events cache
@@ -596,6 +776,11 @@ const (
 	TransferProcessorEnablePriorityTaskProcessor
 	// TransferProcessorVisibilityArchivalTimeLimit is the upper time limit for archiving visibility records
 	TransferProcessorVisibilityArchivalTimeLimit
+	// TransferProcessorHistoryArchivalTimeLimit is the upper time limit for archiving history when workflow closes
+	TransferProcessorHistoryArchivalTimeLimit
+	// ArchiveHistoryOnClose indicates whether history should be archived inline when workflow closes rather than
+	// when retention expires, this is intended for namespaces with very short retention
+	ArchiveHistoryOnClose
 
 	// VisibilityTaskBatchSize is batch size for visibilityQueueProcessor
 	VisibilityTaskBatchSize
@@ -631,6 +816,38 @@ const (
 	VisibilityProcessorEnablePriorityTaskProcessor
 	// VisibilityProcessorVisibilityArchivalTimeLimit is the upper time limit for archiving visibility records
 	VisibilityProcessorVisibilityArchivalTimeLimit
+	// VisibilityProcessorLoadSheddingFailureThreshold is the number of consecutive visibility store unavailable errors
+	// after which visibilityQueueProcessor stops writing to the store and leaves tasks in the visibility task queue, 0 disables shedding
+	VisibilityProcessorLoadSheddingFailureThreshold
+	// VisibilityProcessorLoadSheddingProbeInterval is the interval at which a shedding visibilityQueueProcessor probes the store for recovery
+	VisibilityProcessorLoadSheddingProbeInterval
+	// VisibilityProcessorCatchUpRPS is the max rate per shard at which visibility tasks buffered during a store outage are replayed
+	VisibilityProcessorCatchUpRPS
+
+	// CustomTaskBatchSize is batch size for customTaskQueueProcessor
+	CustomTaskBatchSize
+	// CustomProcessorMaxPollRPS is max poll rate per second for customTaskQueueProcessor
+	CustomProcessorMaxPollRPS
+	// CustomTaskWorkerCount is number of worker for customTaskQueueProcessor
+	CustomTaskWorkerCount
+	// CustomTaskMaxRetryCount is max times of retry for customTaskQueueProcessor
+	CustomTaskMaxRetryCount
+	// CustomProcessorMaxPollInterval max poll interval for customTaskQueueProcessor
+	CustomProcessorMaxPollInterval
+	// CustomProcessorMaxPollIntervalJitterCoefficient is the max poll interval jitter coefficient
+	CustomProcessorMaxPollIntervalJitterCoefficient
+	// CustomProcessorUpdateAckInterval is update interval for customTaskQueueProcessor
+	CustomProcessorUpdateAckInterval
+	// CustomProcessorUpdateAckIntervalJitterCoefficient is the update interval jitter coefficient
+	CustomProcessorUpdateAckIntervalJitterCoefficient
+	// CustomProcessorRedispatchInterval is the redispatch interval for customTaskQueueProcessor
+	CustomProcessorRedispatchInterval
+	// CustomProcessorRedispatchIntervalJitterCoefficient is the redispatch interval jitter coefficient
+	CustomProcessorRedispatchIntervalJitterCoefficient
+	// CustomProcessorMaxRedispatchQueueSize is the threshold of the number of tasks in the redispatch queue for customTaskQueueProcessor
+	CustomProcessorMaxRedispatchQueueSize
+	// CustomProcessorEnablePriorityTaskProcessor indicates whether priority task processor should be used for customTaskQueueProcessor
+	CustomProcessorEnablePriorityTaskProcessor
 
 	// ReplicatorTaskBatchSize is batch size for ReplicatorProcessor
 	ReplicatorTaskBatchSize
@@ -662,12 +879,29 @@ const (
 	MaximumBufferedEventsBatch
 	// MaximumSignalsPerExecution is max number of signals supported by single execution
 	MaximumSignalsPerExecution
+	// EnableSignalDLQ is whether user signals which can't be applied to their execution, e.g. because it exceeds
+	// maximum signals, are captured in the signal DLQ instead of failing
+	EnableSignalDLQ
+	// MaximumHistoryBranchesPerWorkflow is max number of live history branches a workflow reset can fork to
+	MaximumHistoryBranchesPerWorkflow
 	// ShardUpdateMinInterval is the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
 	ShardSyncMinInterval
 	// ShardSyncTimerJitterCoefficient is the sync shard jitter coefficient
 	ShardSyncTimerJitterCoefficient
+	// ShardJournalEnabled enables the write-ahead journal of shards, which new shard owners warm their caches with
+	ShardJournalEnabled
+	// ShardJournalMaxEntries is the number of most recent entries kept in the journal of a shard
+	ShardJournalMaxEntries
+	// ShardJournalWarmUpRPS is the rate at which new shard owners load journaled executions into their caches
+	ShardJournalWarmUpRPS
+	// RestoreConsistencyMarkerID is the consistency marker shards are reconciled with when they are acquired after
+	// the cluster is restored from backups, ack levels ahead of the marker are rewound so that tasks created after
+	// the marker are replayed. Each shard is reconciled once per marker, 0 disables the restore mode.
+	RestoreConsistencyMarkerID
+	// RestoreConsistencyMarkerTrimTasks deletes tasks processed before the consistency marker when shards are restored
+	RestoreConsistencyMarkerTrimTasks
 	// DefaultEventEncoding is the encoding type for history events
 	DefaultEventEncoding
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
@@ -680,6 +914,45 @@ const (
 	// DefaultWorkflowRetryPolicy represents the out-of-box retry policy for unset fields
 	// where the user has set an explicit RetryPolicy, but not specified all the fields
 	DefaultWorkflowRetryPolicy
+	// RetryBackoffJitterCoefficient is the max portion of activity and workflow retry backoff which is randomly
+	// taken off the backoff, so retries which failed together don't fire together
+	RetryBackoffJitterCoefficient
+	// CronMaxJitterDuration is the max random delay added to the next cron run, so cron workflows with
+	// the same schedule don't start together
+	CronMaxJitterDuration
+	// ActivityTaskQueueReroutes maps task queue to activity types whose tasks are pushed to another task queue,
+	// e.g. {"payments": {"ChargeCard": "payments-canary"}}, so worker fleets can be swapped without code changes.
+	// It applies to activities scheduled or retried afterwards, pending activities are moved by RerouteActivities
+	// operator action.
+	ActivityTaskQueueReroutes
+	// WorkflowReportsTotalSizeLimit is the size limit of all reports published by a workflow, which are kept in
+	// its mutable state
+	WorkflowReportsTotalSizeLimit
+	// ReplicationEventBatchSizeLimit is the size of event batches above which replication to remote clusters is
+	// expected to fail, it should be kept below the max receive message size of the remote clusters
+	ReplicationEventBatchSizeLimit
+	// ReplicationChunkedTransferEnabled is whether event batches above ReplicationEventBatchSizeLimit are transferred
+	// to remote clusters in chunks, it must be enabled only once all clusters are upgraded to understand chunks
+	ReplicationChunkedTransferEnabled
+	// ReplicationEventChunkSize is the size of chunks in which oversized event batches are transferred
+	ReplicationEventChunkSize
+	// ReplicationChunkedEventsCacheSize is the max number of oversized event batches per shard kept serialized
+	// while their chunks are fetched
+	ReplicationChunkedEventsCacheSize
+	// ReplicationChunkedEventsCacheTTL is how long serialized oversized event batches are kept for chunk fetches
+	ReplicationChunkedEventsCacheTTL
+	// EnableTaskLatencyBreakdown is whether latency breakdown of activity and workflow tasks reported by matching
+	// is recorded in execution info of their workflows
+	EnableTaskLatencyBreakdown
+	// EnableStuckExecutionDetection is whether executions whose workflow tasks or activities are not started
+	// are flagged with the TemporalStuckReason search attribute
+	EnableStuckExecutionDetection
+	// StuckActivityStartThreshold is how long a scheduled activity may wait to be started before its workflow is flagged as stuck
+	StuckActivityStartThreshold
+	// StuckWorkflowTaskStartThreshold is how long a scheduled workflow task may wait to be started before its workflow is flagged as stuck
+	StuckWorkflowTaskStartThreshold
+	// StuckWorkflowTaskAttemptThreshold is the workflow task attempt count at which a workflow is flagged as stuck
+	StuckWorkflowTaskAttemptThreshold
 
 	// HistoryMaxAutoResetPoints is the key for max number of auto reset points stored in mutableState
 	HistoryMaxAutoResetPoints
@@ -775,6 +1048,17 @@ const (
 	HistoryScannerEnabled
 	// ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner
 	ExecutionsScannerEnabled
+	// WorkerLeaderElectionRefreshInterval is the interval at which worker renews or tries to acquire leases of singleton jobs
+	WorkerLeaderElectionRefreshInterval
+	// WorkerLeaderLeaseTTL is the time after which lease of a singleton job expires unless renewed by its leader
+	WorkerLeaderLeaseTTL
+	// EnableCanary decides whether worker runs built-in canary workflows, which continuously exercise timers,
+	// activities, signals, queries, child workflows and cron in the system namespace
+	EnableCanary
+	// EnableIntakeProcessor decides whether worker applies requests accepted by frontend to the intake queue
+	EnableIntakeProcessor
+	// IntakeProcessorRPS is the max rate at which requests from the intake queue are applied
+	IntakeProcessorRPS
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
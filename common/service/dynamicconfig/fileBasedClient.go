@@ -202,10 +202,13 @@ func (fc *fileBasedClient) UpdateValue(name Key, value interface{}) error {
 		return fmt.Errorf("failed to decode dynamic config %v", err)
 	}
 
-	cVal := &constrainedValue{
-		Value: value,
+	values := []*constrainedValue{{Value: value}}
+	for _, cVal := range currentValues[keyName] {
+		if len(cVal.Constraints) != 0 {
+			values = append(values, cVal)
+		}
 	}
-	currentValues[keyName] = []*constrainedValue{cVal}
+	currentValues[keyName] = values
 	newBytes, _ := yaml.Marshal(currentValues)
 
 	err = ioutil.WriteFile(fc.config.Filepath, newBytes, fileMode)
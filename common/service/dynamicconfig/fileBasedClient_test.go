@@ -25,6 +25,8 @@
 package dynamicconfig
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
@@ -390,3 +392,43 @@ func (s *fileBasedClientSuite) TestUpdateConfig() {
 	err = client.UpdateValue(key, v)
 	s.NoError(err)
 }
+
+func (s *fileBasedClientSuite) TestUpdateConfig_KeepsConstrainedValues() {
+	file, err := ioutil.TempFile("", "dynamicconfig")
+	s.NoError(err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(`
+testGetBoolPropertyKey:
+- value: false
+  constraints: {}
+- value: true
+  constraints:
+    namespace: samples-namespace
+`)
+	s.NoError(err)
+	s.NoError(file.Close())
+
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	client, err := NewFileBasedClient(&FileBasedClientConfig{
+		Filepath:     file.Name(),
+		PollInterval: time.Second * 5,
+	}, log.NewNoop(), doneCh)
+	s.NoError(err)
+
+	s.NoError(client.UpdateValue(testGetBoolPropertyKey, true))
+	v, err := client.GetBoolValue(testGetBoolPropertyKey, nil, false)
+	s.NoError(err)
+	s.True(v)
+	v, err = client.GetBoolValue(testGetBoolPropertyKey, map[Filter]interface{}{Namespace: "samples-namespace"}, false)
+	s.NoError(err)
+	s.True(v)
+
+	s.NoError(client.UpdateValue(testGetBoolPropertyKey, false))
+	v, err = client.GetBoolValue(testGetBoolPropertyKey, nil, true)
+	s.NoError(err)
+	s.False(v)
+	v, err = client.GetBoolValue(testGetBoolPropertyKey, map[Filter]interface{}{Namespace: "samples-namespace"}, false)
+	s.NoError(err)
+	s.True(v)
+}
@@ -76,7 +76,7 @@ func (mc *nopClient) GetDurationValue(
 }
 
 func (mc *nopClient) UpdateValue(name Key, value interface{}) error {
-	return errors.New("unable to update key")
+	return ErrUpdateNotSupported
 }
 
 // NewNopClient creates a nop client
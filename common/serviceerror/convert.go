@@ -58,6 +58,11 @@ func FromStatus(st *status.Status) error {
 		case *errordetails.RetryReplicationFailure:
 			return newRetryReplication(st, errDetails)
 		}
+	case codes.ResourceExhausted:
+		switch errDetails := errDetails.(type) {
+		case *errordetails.HistoryDegradedFailure:
+			return newHistoryDegraded(st, errDetails)
+		}
 	}
 
 	return serviceerror.FromStatus(st)
@@ -27,6 +27,7 @@ package serviceerror
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.temporal.io/api/serviceerror"
@@ -48,3 +49,16 @@ func TestFromToStatus(t *testing.T) {
 	assert.Equal(t, err.Message, solErr.Message)
 	assert.Equal(t, err.OwnerHost, solErr.OwnerHost)
 }
+
+func TestFromToStatus_HistoryDegraded(t *testing.T) {
+	err := NewHistoryDegraded(5 * time.Second)
+
+	st := serviceerror.ToStatus(err)
+	err1 := FromStatus(st)
+	var hdErr *HistoryDegraded
+	if !errors.As(err1, &hdErr) {
+		assert.Fail(t, "Returned error is not of type *HistoryDegraded")
+	}
+	assert.Equal(t, err.Message, hdErr.Message)
+	assert.Equal(t, 5*time.Second, hdErr.RetryAfter)
+}
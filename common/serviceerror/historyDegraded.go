@@ -0,0 +1,80 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serviceerror
+
+import (
+	"time"
+
+	"github.com/gogo/status"
+	"google.golang.org/grpc/codes"
+
+	"go.temporal.io/server/api/errordetails/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// HistoryDegraded represents poll rejected by matching while history service is degraded,
+	// RetryAfter is the hint when to retry the poll.
+	HistoryDegraded struct {
+		Message    string
+		RetryAfter time.Duration
+		st         *status.Status
+	}
+)
+
+// NewHistoryDegraded returns new HistoryDegraded error.
+func NewHistoryDegraded(retryAfter time.Duration) *HistoryDegraded {
+	return &HistoryDegraded{
+		Message:    "History service is degraded.",
+		RetryAfter: retryAfter,
+	}
+}
+
+// Error returns string message.
+func (e *HistoryDegraded) Error() string {
+	return e.Message
+}
+
+func (e *HistoryDegraded) Status() *status.Status {
+	if e.st != nil {
+		return e.st
+	}
+
+	st := status.New(codes.ResourceExhausted, e.Message)
+	st, _ = st.WithDetails(
+		&errordetails.HistoryDegradedFailure{
+			RetryAfter: &e.RetryAfter,
+		},
+	)
+	return st
+}
+
+func newHistoryDegraded(st *status.Status, errDetails *errordetails.HistoryDegradedFailure) *HistoryDegraded {
+	return &HistoryDegraded{
+		Message:    st.Message(),
+		RetryAfter: timestamp.DurationValue(errDetails.GetRetryAfter()),
+		st:         st,
+	}
+}
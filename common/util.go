@@ -118,8 +118,6 @@ const (
 )
 
 var (
-	// ErrBlobSizeExceedsLimit is error for event blob size exceeds limit
-	ErrBlobSizeExceedsLimit = serviceerror.NewInvalidArgument("Blob data size exceeds limit.")
 	// ErrContextTimeoutTooShort is error for setting a very short context timeout when calling a long poll API
 	ErrContextTimeoutTooShort = serviceerror.NewInvalidArgument("Context timeout is too short.")
 	// ErrContextTimeoutNotSet is error for not setting a context timeout when calling a long poll API
@@ -347,20 +345,21 @@ func GenerateRandomString(n int) string {
 // CreateMatchingPollWorkflowTaskQueueResponse create response for matching's PollWorkflowTaskQueue
 func CreateMatchingPollWorkflowTaskQueueResponse(historyResponse *historyservice.RecordWorkflowTaskStartedResponse, workflowExecution *commonpb.WorkflowExecution, token []byte) *matchingservice.PollWorkflowTaskQueueResponse {
 	matchingResp := &matchingservice.PollWorkflowTaskQueueResponse{
-		TaskToken:                  token,
-		WorkflowExecution:          workflowExecution,
-		WorkflowType:               historyResponse.WorkflowType,
-		PreviousStartedEventId:     historyResponse.PreviousStartedEventId,
-		StartedEventId:             historyResponse.StartedEventId,
-		Attempt:                    historyResponse.GetAttempt(),
-		NextEventId:                historyResponse.NextEventId,
-		StickyExecutionEnabled:     historyResponse.StickyExecutionEnabled,
-		WorkflowTaskInfo:           historyResponse.WorkflowTaskInfo,
-		WorkflowExecutionTaskQueue: historyResponse.WorkflowExecutionTaskQueue,
-		BranchToken:                historyResponse.BranchToken,
-		ScheduledTime:              historyResponse.ScheduledTime,
-		StartedTime:                historyResponse.StartedTime,
-		Queries:                    historyResponse.Queries,
+		TaskToken:                   token,
+		WorkflowExecution:           workflowExecution,
+		WorkflowType:                historyResponse.WorkflowType,
+		PreviousStartedEventId:      historyResponse.PreviousStartedEventId,
+		StartedEventId:              historyResponse.StartedEventId,
+		Attempt:                     historyResponse.GetAttempt(),
+		NextEventId:                 historyResponse.NextEventId,
+		StickyExecutionEnabled:      historyResponse.StickyExecutionEnabled,
+		WorkflowTaskInfo:            historyResponse.WorkflowTaskInfo,
+		WorkflowExecutionTaskQueue:  historyResponse.WorkflowExecutionTaskQueue,
+		BranchToken:                 historyResponse.BranchToken,
+		ScheduledTime:               historyResponse.ScheduledTime,
+		StartedTime:                 historyResponse.StartedTime,
+		Queries:                     historyResponse.Queries,
+		SuggestContinueAsNewReasons: historyResponse.SuggestContinueAsNewReasons,
 	}
 
 	return matchingResp
@@ -564,7 +563,7 @@ func CreateHistoryStartWorkflowRequest(
 }
 
 // CheckEventBlobSizeLimit checks if a blob data exceeds limits. It logs a warning if it exceeds warnLimit,
-// and return ErrBlobSizeExceedsLimit if it exceeds errorLimit.
+// and returns error naming the operation and the field if it exceeds errorLimit.
 func CheckEventBlobSizeLimit(
 	actualSize int,
 	warnLimit int,
@@ -574,7 +573,8 @@ func CheckEventBlobSizeLimit(
 	runID string,
 	scope metrics.Scope,
 	logger log.Logger,
-	blobSizeViolationOperationTag tag.Tag,
+	operation string,
+	field string,
 ) error {
 	scope.RecordTimer(metrics.EventBlobSize, time.Duration(actualSize))
 
@@ -585,16 +585,87 @@ func CheckEventBlobSizeLimit(
 				tag.WorkflowID(workflowID),
 				tag.WorkflowRunID(runID),
 				tag.WorkflowSize(int64(actualSize)),
-				blobSizeViolationOperationTag)
+				tag.BlobSizeViolationOperation(operation),
+				tag.BlobSizeViolationField(field))
 		}
 
 		if actualSize > errorLimit {
-			return ErrBlobSizeExceedsLimit
+			scope.IncCounter(metrics.EventBlobSizeExceedsLimit)
+			return NewBlobSizeExceedsLimitError(operation, field, actualSize, errorLimit)
 		}
+		scope.IncCounter(metrics.EventBlobSizeNearLimit)
 	}
 	return nil
 }
 
+// NewBlobSizeExceedsLimitError returns error for blob data of operation field exceeding size limit
+func NewBlobSizeExceedsLimitError(
+	operation string,
+	field string,
+	actualSize int,
+	limit int,
+) error {
+	if field == "" {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"Blob data size of %v exceeds limit: %v bytes, limit is %v bytes.", operation, actualSize, limit))
+	}
+	return serviceerror.NewInvalidArgument(fmt.Sprintf(
+		"Blob data size of %v in %v exceeds limit: %v bytes, limit is %v bytes.", field, operation, actualSize, limit))
+}
+
+// GetBlobSizeLimits returns warn and error blob size limits of operation. Limits configured for the operation
+// in overrides, e.g. {"StartWorkflowExecution": {"warn": 262144, "error": 1048576}}, take precedence over
+// the given limits.
+func GetBlobSizeLimits(
+	overrides map[string]interface{},
+	operation string,
+	warnLimit int,
+	errorLimit int,
+) (int, int) {
+	override, ok := overrides[operation].(map[string]interface{})
+	if !ok {
+		return warnLimit, errorLimit
+	}
+	if limit, ok := blobSizeLimitValue(override["warn"]); ok {
+		warnLimit = limit
+	}
+	if limit, ok := blobSizeLimitValue(override["error"]); ok {
+		errorLimit = limit
+	}
+	return warnLimit, errorLimit
+}
+
+// GetActivityTaskQueueReroute returns the task queue activity tasks of activityType scheduled on taskQueue are pushed
+// to. Reroutes map task queue to activity types and their target task queues, e.g. {"payments": {"ChargeCard":
+// "payments-canary"}}, activity types which are not rerouted stay on taskQueue.
+func GetActivityTaskQueueReroute(
+	reroutes map[string]interface{},
+	taskQueue string,
+	activityType string,
+) string {
+	activityTypes, ok := reroutes[taskQueue].(map[string]interface{})
+	if !ok {
+		return taskQueue
+	}
+	if targetTaskQueue, ok := activityTypes[activityType].(string); ok && targetTaskQueue != "" {
+		return targetTaskQueue
+	}
+	return taskQueue
+}
+
+func blobSizeLimitValue(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	default:
+		return 0, false
+	}
+}
+
 // ValidateLongPollContextTimeout check if the context timeout for a long poll handler is too short or below a normal value.
 // If the timeout is not set or too short, it logs an error, and return ErrContextTimeoutNotSet or ErrContextTimeoutTooShort
 // accordingly. If the timeout is only below a normal value, it just logs an info and return nil.
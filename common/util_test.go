@@ -35,6 +35,7 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
@@ -333,3 +334,52 @@ func TestOverrideWorkflowTaskTimeout_Finite(t *testing.T) {
 	defaultTimeoutFn = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(defaultTimeout)
 	require.Equal(t, MaxWorkflowTaskStartToCloseTimeout, OverrideWorkflowTaskTimeout("random domain", taskTimeout, runTimeout, defaultTimeoutFn))
 }
+
+func TestGetBlobSizeLimits(t *testing.T) {
+	overrides := map[string]interface{}{
+		"StartWorkflowExecution":  map[string]interface{}{"warn": 10, "error": 20},
+		"SignalWorkflowExecution": map[string]interface{}{"error": float64(30)},
+		"QueryWorkflow":           "invalid",
+	}
+
+	warn, err := GetBlobSizeLimits(overrides, "StartWorkflowExecution", 1, 2)
+	require.Equal(t, 10, warn)
+	require.Equal(t, 20, err)
+
+	warn, err = GetBlobSizeLimits(overrides, "SignalWorkflowExecution", 1, 2)
+	require.Equal(t, 1, warn)
+	require.Equal(t, 30, err)
+
+	warn, err = GetBlobSizeLimits(overrides, "QueryWorkflow", 1, 2)
+	require.Equal(t, 1, warn)
+	require.Equal(t, 2, err)
+
+	warn, err = GetBlobSizeLimits(nil, "StartWorkflowExecution", 1, 2)
+	require.Equal(t, 1, warn)
+	require.Equal(t, 2, err)
+}
+
+func TestGetActivityTaskQueueReroute(t *testing.T) {
+	reroutes := map[string]interface{}{
+		"payments": map[string]interface{}{"ChargeCard": "payments-canary", "Refund": ""},
+		"invalid":  "payments-canary",
+	}
+
+	require.Equal(t, "payments-canary", GetActivityTaskQueueReroute(reroutes, "payments", "ChargeCard"))
+	require.Equal(t, "payments", GetActivityTaskQueueReroute(reroutes, "payments", "Refund"))
+	require.Equal(t, "payments", GetActivityTaskQueueReroute(reroutes, "payments", "SendReceipt"))
+	require.Equal(t, "orders", GetActivityTaskQueueReroute(reroutes, "orders", "ChargeCard"))
+	require.Equal(t, "invalid", GetActivityTaskQueueReroute(reroutes, "invalid", "ChargeCard"))
+	require.Equal(t, "payments", GetActivityTaskQueueReroute(nil, "payments", "ChargeCard"))
+}
+
+func TestCheckEventBlobSizeLimit(t *testing.T) {
+	scope := metrics.NoopScope(metrics.Frontend)
+
+	require.NoError(t, CheckEventBlobSizeLimit(10, 10, 20, "namespace-id", "workflow-id", "run-id", scope, nil, "StartWorkflowExecution", "Input"))
+	require.NoError(t, CheckEventBlobSizeLimit(20, 10, 20, "namespace-id", "workflow-id", "run-id", scope, nil, "StartWorkflowExecution", "Input"))
+
+	err := CheckEventBlobSizeLimit(21, 10, 20, "namespace-id", "workflow-id", "run-id", scope, nil, "StartWorkflowExecution", "Input")
+	require.IsType(t, &serviceerror.InvalidArgument{}, err)
+	require.Equal(t, "Blob data size of Input in StartWorkflowExecution exceeds limit: 21 bytes, limit is 20 bytes.", err.Error())
+}
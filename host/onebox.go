@@ -55,6 +55,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/rpc"
@@ -114,6 +115,7 @@ type (
 		workerConfig                     *WorkerConfig
 		mockAdminClient                  map[string]adminClient.Client
 		namespaceReplicationTaskExecutor namespace.ReplicationTaskExecutor
+		uuidGenerator                    primitives.UUIDGenerator
 	}
 
 	// HistoryConfig contains configs for history service
@@ -147,6 +149,8 @@ type (
 		WorkerConfig                     *WorkerConfig
 		MockAdminClient                  map[string]adminClient.Client
 		NamespaceReplicationTaskExecutor namespace.ReplicationTaskExecutor
+		// UUIDGenerator overrides generator of run IDs, nil means crypto random UUIDs
+		UUIDGenerator primitives.UUIDGenerator
 	}
 
 	membershipFactoryImpl struct {
@@ -179,6 +183,7 @@ func NewTemporal(params *TemporalParams) Temporal {
 		workerConfig:                     params.WorkerConfig,
 		mockAdminClient:                  params.MockAdminClient,
 		namespaceReplicationTaskExecutor: params.NamespaceReplicationTaskExecutor,
+		uuidGenerator:                    params.UUIDGenerator,
 	}
 }
 
@@ -441,6 +446,7 @@ func (c *temporalImpl) startHistory(
 		params.Name = common.HistoryServiceName
 		params.Logger = c.logger
 		params.ThrottledLogger = c.logger
+		params.UUIDGenerator = c.uuidGenerator
 		params.RPCFactory = newRPCFactoryImpl(common.HistoryServiceName, grpcPort, membershipPorts[i], c.logger)
 		params.MetricsScope = tally.NewTestScope(common.HistoryServiceName, make(map[string]string))
 		params.MembershipFactoryInitializer = func(x persistenceClient.Bean, y log.Logger) (resource.MembershipMonitorFactory, error) {
@@ -785,6 +791,10 @@ func (c *rpcFactoryImpl) CreateFrontendGRPCConnection(hostName string) *grpc.Cli
 	return c.CreateGRPCConnection(hostName)
 }
 
+func (c *rpcFactoryImpl) CreateRemoteFrontendGRPCConnection(clusterName string, hostName string) *grpc.ClientConn {
+	return c.CreateGRPCConnection(hostName)
+}
+
 func (c *rpcFactoryImpl) CreateInternodeGRPCConnection(hostName string) *grpc.ClientConn {
 	return c.CreateGRPCConnection(hostName)
 }
@@ -84,6 +84,10 @@ func (s *simpleMonitor) GetReachableMembers() ([]string, error) {
 	return nil, nil
 }
 
+func (s *simpleMonitor) GetReachableMemberVersions() (map[string]string, error) {
+	return nil, nil
+}
+
 func (s *simpleMonitor) GetMemberCount(service string) (int, error) {
 	return 0, nil
 }
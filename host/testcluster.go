@@ -52,6 +52,7 @@ import (
 	persistencetests "go.temporal.io/server/common/persistence/persistence-tests"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
 )
@@ -87,6 +88,9 @@ type (
 		ESConfig              *elasticsearch.Config
 		WorkerConfig          *WorkerConfig
 		MockAdminClient       map[string]adminClient.Client
+		// DeterministicIDSeed makes run IDs, and task tokens built from them, deterministic
+		// for golden history comparisons, 0 keeps them random
+		DeterministicIDSeed int64
 	}
 
 	// MessagingClientConfig is the config for messaging config
@@ -216,6 +220,9 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		MockAdminClient:                  options.MockAdminClient,
 		NamespaceReplicationTaskExecutor: namespace.NewReplicationTaskExecutor(testBase.MetadataManager, logger),
 	}
+	if options.DeterministicIDSeed != 0 {
+		temporalParams.UUIDGenerator = primitives.NewSeededUUIDGenerator(options.DeterministicIDSeed)
+	}
 
 	err := newPProfInitializerImpl(logger, pprofTestPort).Start()
 	if err != nil {
@@ -253,7 +260,7 @@ func newArchiverBase(enabled bool, logger log.Logger) *ArchiverBase {
 	dcCollection := dynamicconfig.NewNopCollection()
 	if !enabled {
 		return &ArchiverBase{
-			metadata: archiver.NewArchivalMetadata(dcCollection, "", false, "", false, &config.ArchivalNamespaceDefaults{}),
+			metadata: archiver.NewArchivalMetadata(dcCollection, "", false, "", false, &config.ArchivalNamespaceDefaults{}, nil, nil),
 			provider: provider.NewArchiverProvider(nil, nil),
 		}
 	}
@@ -288,7 +295,7 @@ func newArchiverBase(enabled bool, logger log.Logger) *ArchiverBase {
 				State: "enabled",
 				URI:   "testScheme://test/visibility/archive/path",
 			},
-		}),
+		}, nil, nil),
 		provider:                 provider,
 		historyStoreDirectory:    historyStoreDirectory,
 		visibilityStoreDirectory: visibilityStoreDirectory,
@@ -0,0 +1 @@
+ALTER TABLE history_node ADD data_checksum VARBINARY(64);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE cluster_membership MODIFY rpc_address VARCHAR(45) NOT NULL;
\ No newline at end of file
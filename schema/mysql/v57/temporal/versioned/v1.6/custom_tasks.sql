@@ -0,0 +1,9 @@
+CREATE TABLE custom_tasks(
+  shard_id INT NOT NULL,
+  category VARCHAR(255) NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data MEDIUMBLOB NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, category, task_id)
+);
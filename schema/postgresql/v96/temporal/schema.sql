@@ -135,6 +135,16 @@ CREATE TABLE visibility_tasks(
   PRIMARY KEY (shard_id, task_id)
 );
 
+CREATE TABLE custom_tasks(
+  shard_id INTEGER NOT NULL,
+  category VARCHAR(255) NOT NULL,
+  task_id BIGINT NOT NULL,
+  --
+  data BYTEA NOT NULL,
+  data_encoding VARCHAR(16) NOT NULL,
+  PRIMARY KEY (shard_id, category, task_id)
+);
+
 CREATE TABLE activity_info_maps (
 -- each row corresponds to one key of one map<string, ActivityInfo>
   shard_id INTEGER NOT NULL,
@@ -216,6 +226,7 @@ CREATE TABLE history_node (
   --
   data           BYTEA NOT NULL,
   data_encoding  VARCHAR(16) NOT NULL,
+  data_checksum  BYTEA,
   PRIMARY KEY (shard_id, tree_id, branch_id, node_id, txn_id)
 );
 
@@ -259,7 +270,7 @@ CREATE TABLE cluster_membership
 (
     membership_partition INTEGER NOT NULL,
     host_id              BYTEA NOT NULL,
-    rpc_address          VARCHAR(15) NOT NULL,
+    rpc_address          VARCHAR(45) NOT NULL,
     rpc_port             SMALLINT NOT NULL,
     role                 SMALLINT NOT NULL,
     session_start        TIMESTAMP DEFAULT '1970-01-01 00:00:01',
@@ -0,0 +1 @@
+ALTER TABLE history_node ADD data_checksum BYTEA;
\ No newline at end of file
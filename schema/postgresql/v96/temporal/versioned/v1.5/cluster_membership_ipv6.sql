@@ -0,0 +1 @@
+ALTER TABLE cluster_membership ALTER COLUMN rpc_address TYPE VARCHAR(45);
\ No newline at end of file
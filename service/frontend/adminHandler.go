@@ -38,30 +38,49 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	clusterspb "go.temporal.io/server/api/cluster/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/autoscaling"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/messaging"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/common/xdc"
+	"go.temporal.io/server/service/worker/batcher"
 )
 
 const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
+
+	defaultHistoryBranchesPageSize = 1000
+	defaultMinHistoryBranchCount   = 2
+	historyBranchSizeReadPageSize  = 1000
+
+	// markers carry the ack levels of all shards, keep pages small
+	defaultConsistencyMarkersPageSize = 10
 )
 
 type (
@@ -74,6 +93,7 @@ type (
 		params                *resource.BootstrapParams
 		config                *Config
 		namespaceDLQHandler   namespace.DLQMessageHandler
+		namespaceHandler      namespace.Handler
 		eventSerializder      persistence.PayloadSerializer
 	}
 )
@@ -83,6 +103,12 @@ var (
 
 	adminServiceRetryPolicy = common.CreateAdminServiceRetryPolicy()
 	resendStartEventID      = int64(0)
+
+	intakeOutcomeStates = map[persistence.IntakeMessageState]enumsspb.IntakeOutcomeState{
+		persistence.IntakeMessageStatePending:  enumsspb.INTAKE_OUTCOME_STATE_PENDING,
+		persistence.IntakeMessageStateApplied:  enumsspb.INTAKE_OUTCOME_STATE_APPLIED,
+		persistence.IntakeMessageStateRejected: enumsspb.INTAKE_OUTCOME_STATE_REJECTED,
+	}
 )
 
 // NewAdminHandler creates a gRPC handler for the workflowservice
@@ -90,6 +116,7 @@ func NewAdminHandler(
 	resource resource.Resource,
 	params *resource.BootstrapParams,
 	config *Config,
+	replicationMessageSink messaging.Producer,
 ) *AdminHandler {
 
 	namespaceReplicationTaskExecutor := namespace.NewReplicationTaskExecutor(
@@ -107,6 +134,19 @@ func NewAdminHandler(
 			resource.GetNamespaceReplicationQueue(),
 			resource.GetLogger(),
 		),
+		namespaceHandler: namespace.NewHandler(
+			config.MinRetentionDays(),
+			config.MaxBadBinaries,
+			config.EnableArchivalURIProbe,
+			resource.GetLogger(),
+			resource.GetMetadataManager(),
+			resource.GetClusterMetadata(),
+			namespace.NewNamespaceReplicator(replicationMessageSink, resource.GetLogger()),
+			resource.GetArchivalMetadata(),
+			resource.GetArchiverProvider(),
+			params.NamespaceRegistrationApprover,
+			resource.GetNamespaceRegistrationStore(),
+		),
 		eventSerializder: persistence.NewPayloadSerializer(),
 	}
 }
@@ -222,18 +262,21 @@ func (adh *AdminHandler) DescribeMutableState(ctx context.Context, request *admi
 
 	historyAddr := historyHost.GetAddress()
 	historyResponse, err := adh.GetHistoryClient().DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
-		NamespaceId: namespaceID,
-		Execution:   request.Execution,
+		NamespaceId:           namespaceID,
+		Execution:             request.Execution,
+		IncludePendingDetails: request.GetIncludePendingDetails(),
+		PendingTimerCount:     request.GetPendingTimerCount(),
 	})
 
 	if err != nil {
 		return nil, err
 	}
 	return &adminservice.DescribeMutableStateResponse{
-		ShardId:              shardIDStr,
-		HistoryAddr:          historyAddr,
-		DatabaseMutableState: historyResponse.GetDatabaseMutableState(),
-		CacheMutableState:    historyResponse.GetCacheMutableState(),
+		ShardId:                 shardIDStr,
+		HistoryAddr:             historyAddr,
+		DatabaseMutableState:    historyResponse.GetDatabaseMutableState(),
+		CacheMutableState:       historyResponse.GetCacheMutableState(),
+		PendingExecutionDetails: historyResponse.GetPendingExecutionDetails(),
 	}, err
 }
 
@@ -325,6 +368,7 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *admin
 		NamespaceCache:        resp.GetNamespaceCache(),
 		ShardControllerStatus: resp.GetShardControllerStatus(),
 		Address:               resp.GetAddress(),
+		ContendedWorkflows:    resp.GetContendedWorkflows(),
 	}, err
 }
 
@@ -504,9 +548,11 @@ func (adh *AdminHandler) DescribeCluster(ctx context.Context, _ *adminservice.De
 	}
 
 	return &adminservice.DescribeClusterResponse{
-		SupportedClients: headers.SupportedClients,
-		ServerVersion:    headers.ServerVersion,
-		MembershipInfo:   membershipInfo,
+		SupportedClients:   headers.SupportedClients,
+		ServerVersion:      headers.ServerVersion,
+		MembershipInfo:     membershipInfo,
+		Environment:        adh.getClusterEnvironment(),
+		ServerCapabilities: newClientFeatureChecker(adh.config).capabilities(),
 	}, nil
 }
 
@@ -603,6 +649,33 @@ func (adh *AdminHandler) GetDLQReplicationMessages(ctx context.Context, request
 	return &adminservice.GetDLQReplicationMessagesResponse{ReplicationTasks: resp.GetReplicationTasks()}, nil
 }
 
+// GetReplicationEventChunk returns a chunk of the events of a history replication task too large to be sent in one message
+func (adh *AdminHandler) GetReplicationEventChunk(ctx context.Context, request *adminservice.GetReplicationEventChunkRequest) (_ *adminservice.GetReplicationEventChunkResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminGetReplicationEventChunkScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetTaskInfo() == nil {
+		return nil, adh.error(errEmptyReplicationInfo, scope)
+	}
+	if request.GetChunkIndex() < 0 || request.GetChunkSize() <= 0 {
+		return nil, adh.error(errInvalidReplicationEventChunk, scope)
+	}
+
+	resp, err := adh.GetHistoryClient().GetReplicationEventChunk(ctx, &historyservice.GetReplicationEventChunkRequest{
+		NamespaceId: request.GetTaskInfo().GetNamespaceId(),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.GetReplicationEventChunkResponse{Data: resp.GetData()}, nil
+}
+
 // ReapplyEvents applies stale events to the current workflow and the current run
 func (adh *AdminHandler) ReapplyEvents(ctx context.Context, request *adminservice.ReapplyEventsRequest) (_ *adminservice.ReapplyEventsResponse, retError error) {
 	defer log.CapturePanic(adh.GetLogger(), &retError)
@@ -684,6 +757,23 @@ func (adh *AdminHandler) GetDLQMessages(
 			ReplicationTasks: resp.GetReplicationTasks(),
 			NextPageToken:    resp.GetNextPageToken(),
 		}, err
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL:
+		if request.GetNamespace() == "" {
+			return nil, adh.error(errNamespaceNotSet, scope)
+		}
+		messages, token, err := adh.getSignalDLQMessages(
+			request.GetNamespace(),
+			request.GetInclusiveEndMessageId(),
+			int(request.GetMaximumPageSize()),
+			request.GetNextPageToken())
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return &adminservice.GetDLQMessagesResponse{
+			Type:           request.GetType(),
+			SignalMessages: messages,
+			NextPageToken:  token,
+		}, nil
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE:
 		op = func() error {
 			select {
@@ -745,6 +835,14 @@ func (adh *AdminHandler) PurgeDLQMessages(
 		}
 
 		return &adminservice.PurgeDLQMessagesResponse{}, err
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL:
+		if request.GetNamespace() == "" {
+			return nil, adh.error(errNamespaceNotSet, scope)
+		}
+		if err := adh.purgeSignalDLQMessages(request.GetNamespace(), request.GetInclusiveEndMessageId()); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return &adminservice.PurgeDLQMessagesResponse{}, nil
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE:
 		op = func() error {
 			select {
@@ -802,6 +900,25 @@ func (adh *AdminHandler) MergeDLQMessages(
 		return &adminservice.MergeDLQMessagesResponse{
 			NextPageToken: request.GetNextPageToken(),
 		}, nil
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL:
+		if request.GetNamespace() == "" {
+			return nil, adh.error(errNamespaceNotSet, scope)
+		}
+		if request.GetMaximumPageSize() <= 0 {
+			request.MaximumPageSize = common.ReadDLQMessagesPageSize
+		}
+		token, err := adh.mergeSignalDLQMessages(
+			ctx,
+			request.GetNamespace(),
+			request.GetInclusiveEndMessageId(),
+			int(request.GetMaximumPageSize()),
+			request.GetNextPageToken())
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		return &adminservice.MergeDLQMessagesResponse{
+			NextPageToken: token,
+		}, nil
 	case enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE:
 
 		op = func() error {
@@ -861,6 +978,88 @@ func (adh *AdminHandler) RefreshWorkflowTasks(
 	return &adminservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// ApplyOperatorAction applies operator action, e.g. firing a pending timer, to a running workflow execution. The caller
+// needs admin role, and the action is recorded with the authenticated subject as identity.
+func (adh *AdminHandler) ApplyOperatorAction(
+	ctx context.Context,
+	request *adminservice.ApplyOperatorActionRequest,
+) (_ *adminservice.ApplyOperatorActionResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminApplyOperatorActionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := validateOperatorAction(request); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	identity, err := authorizeOperator(ctx, request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if identity != "" {
+		request.Identity = identity
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().ApplyOperatorAction(ctx, &historyservice.ApplyOperatorActionRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.ApplyOperatorActionResponse{}, nil
+}
+
+// AnnotateWorkflowExecution attaches operator annotation to a running or closed workflow execution. The caller needs
+// admin role, and the annotation is recorded with the authenticated subject as identity.
+func (adh *AdminHandler) AnnotateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.AnnotateWorkflowExecutionRequest,
+) (_ *adminservice.AnnotateWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminAnnotateWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := common.ValidateOperatorAnnotation(request.GetAnnotation(), request.GetTags()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	identity, err := authorizeOperator(ctx, request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if identity != "" {
+		request.Identity = identity
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().AnnotateWorkflowExecution(ctx, &historyservice.AnnotateWorkflowExecutionRequest{
+		NamespaceId: namespaceEntry.GetInfo().Id,
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.AnnotateWorkflowExecutionResponse{}, nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *AdminHandler) ResendReplicationTasks(
 	ctx context.Context,
@@ -899,6 +1098,590 @@ func (adh *AdminHandler) ResendReplicationTasks(
 	return &adminservice.ResendReplicationTasksResponse{}, nil
 }
 
+// GetIntakeOutcome returns the outcome of a request accepted to the intake queue
+func (adh *AdminHandler) GetIntakeOutcome(
+	ctx context.Context,
+	request *adminservice.GetIntakeOutcomeRequest,
+) (_ *adminservice.GetIntakeOutcomeResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminGetIntakeOutcomeScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	outcome, err := adh.GetIntakeQueue().GetOutcome(namespaceID, request.GetToken())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.GetIntakeOutcomeResponse{
+		State:   intakeOutcomeStates[outcome.State],
+		Failure: outcome.Failure,
+	}, nil
+}
+
+// StartReplayCheck starts a system workflow replaying recent workflow histories on a shadow worker build polling the
+// validation task queue
+func (adh *AdminHandler) StartReplayCheck(
+	ctx context.Context,
+	request *adminservice.StartReplayCheckRequest,
+) (_ *adminservice.StartReplayCheckResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminStartReplayCheckScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetValidationTaskQueue() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+	if request.GetQuery() == "" && len(request.GetExecutions()) == 0 {
+		return nil, adh.error(errReplayCheckExecutionsNotSet, scope)
+	}
+	if len(request.GetExecutions()) > batcher.MaxReplayCheckWorkflows || request.GetMaxWorkflows() > batcher.MaxReplayCheckWorkflows {
+		return nil, adh.error(errTooManyReplayCheckWorkflows, scope)
+	}
+	if _, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	params := batcher.ReplayCheckParams{
+		Namespace:           request.GetNamespace(),
+		ValidationTaskQueue: request.GetValidationTaskQueue(),
+		Executions:          request.GetExecutions(),
+		Query:               request.GetQuery(),
+		MaxWorkflows:        int(request.GetMaxWorkflows()),
+		Concurrency:         int(request.GetConcurrency()),
+		PickupTimeout:       timestamp.DurationValue(request.GetPickupTimeout()),
+		ReplayTimeout:       timestamp.DurationValue(request.GetReplayTimeout()),
+	}
+	options := sdkclient.StartWorkflowOptions{
+		ID:                       uuid.New(),
+		TaskQueue:                batcher.BatcherTaskQueueName,
+		WorkflowExecutionTimeout: batcher.ReplayCheckExecutionTimeout(params),
+		SearchAttributes: map[string]interface{}{
+			definition.CustomNamespace: request.GetNamespace(),
+			definition.Operator:        request.GetIdentity(),
+		},
+	}
+	run, err := adh.GetSDKClient().ExecuteWorkflow(ctx, options, batcher.ReplayCheckWFTypeName, params)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.StartReplayCheckResponse{JobId: run.GetID()}, nil
+}
+
+// DescribeReplayCheck returns the status of a replay check, and its outcome once the check is closed
+func (adh *AdminHandler) DescribeReplayCheck(
+	ctx context.Context,
+	request *adminservice.DescribeReplayCheckRequest,
+) (_ *adminservice.DescribeReplayCheckResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeReplayCheckScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetJobId() == "" {
+		return nil, adh.error(errJobIDNotSet, scope)
+	}
+
+	resp, err := adh.GetSDKClient().DescribeWorkflowExecution(ctx, request.GetJobId(), "")
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	if info.GetType().GetName() != batcher.ReplayCheckWFTypeName {
+		return nil, adh.error(errReplayCheckNotFound, scope)
+	}
+
+	response := &adminservice.DescribeReplayCheckResponse{Status: info.GetStatus()}
+	switch info.GetStatus() {
+	case enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING:
+	case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		var result batcher.ReplayCheckResult
+		if err := adh.GetSDKClient().GetWorkflow(ctx, request.GetJobId(), "").Get(ctx, &result); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		response.Checked = int32(result.Checked)
+		for _, failure := range result.Failures {
+			response.Failures = append(response.Failures, &adminservice.ReplayCheckFailure{
+				WorkflowId: failure.WorkflowID,
+				RunId:      failure.RunID,
+				Error:      failure.Error,
+			})
+		}
+	default:
+		if err := adh.GetSDKClient().GetWorkflow(ctx, request.GetJobId(), "").Get(ctx, nil); err != nil {
+			response.Failure = err.Error()
+		}
+	}
+	return response, nil
+}
+
+// ListNamespaceRegistrations lists namespace registrations which wait for approval
+func (adh *AdminHandler) ListNamespaceRegistrations(
+	ctx context.Context,
+	request *adminservice.ListNamespaceRegistrationsRequest,
+) (_ *adminservice.ListNamespaceRegistrationsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminListNamespaceRegistrationsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	registrations, nextPageToken, err := adh.namespaceHandler.ListRegistrations(
+		ctx,
+		int(request.GetPageSize()),
+		request.GetNextPageToken(),
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	response := &adminservice.ListNamespaceRegistrationsResponse{NextPageToken: nextPageToken}
+	for _, registration := range registrations {
+		response.Registrations = append(response.Registrations, &adminservice.NamespaceRegistration{
+			RegistrationId: registration.RegistrationID,
+			Requester:      registration.Requester,
+			Request:        registration.Request,
+			CreateTime:     timestamp.TimePtr(registration.CreatedTime),
+		})
+	}
+	return response, nil
+}
+
+// ApproveNamespaceRegistration registers the namespace of a pending registration
+func (adh *AdminHandler) ApproveNamespaceRegistration(
+	ctx context.Context,
+	request *adminservice.ApproveNamespaceRegistrationRequest,
+) (_ *adminservice.ApproveNamespaceRegistrationResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminApproveNamespaceRegistrationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetRegistrationId() <= 0 {
+		return nil, adh.error(errRegistrationIDNotSet, scope)
+	}
+
+	adh.GetLogger().Info("Approving namespace registration.",
+		tag.NamespaceRegistrationID(request.GetRegistrationId()),
+		tag.NamespaceRegistrationReviewer(request.GetIdentity()),
+	)
+	if err := adh.namespaceHandler.ApproveRegistration(ctx, request.GetRegistrationId()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.ApproveNamespaceRegistrationResponse{}, nil
+}
+
+// RejectNamespaceRegistration deletes a pending namespace registration
+func (adh *AdminHandler) RejectNamespaceRegistration(
+	ctx context.Context,
+	request *adminservice.RejectNamespaceRegistrationRequest,
+) (_ *adminservice.RejectNamespaceRegistrationResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminRejectNamespaceRegistrationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetRegistrationId() <= 0 {
+		return nil, adh.error(errRegistrationIDNotSet, scope)
+	}
+
+	adh.GetLogger().Info("Rejecting namespace registration.",
+		tag.NamespaceRegistrationID(request.GetRegistrationId()),
+		tag.NamespaceRegistrationReviewer(request.GetIdentity()),
+	)
+	if err := adh.namespaceHandler.RejectRegistration(ctx, request.GetRegistrationId()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.RejectNamespaceRegistrationResponse{}, nil
+}
+
+// ListHistoryBranches scans a page of history branches and returns workflows with at least the requested number of
+// live branches along with the size of every branch. A tree whose branches span pages is described in the first page.
+func (adh *AdminHandler) ListHistoryBranches(
+	_ context.Context,
+	request *adminservice.ListHistoryBranchesRequest,
+) (_ *adminservice.ListHistoryBranchesResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminListHistoryBranchesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultHistoryBranchesPageSize
+	}
+	minBranchCount := int(request.GetMinBranchCount())
+	if minBranchCount <= 0 {
+		minBranchCount = defaultMinHistoryBranchCount
+	}
+	pageToken := &tokenspb.HistoryBranchContinuation{}
+	if len(request.GetNextPageToken()) != 0 {
+		var err error
+		if pageToken, err = deserializeHistoryBranchToken(request.GetNextPageToken()); err != nil {
+			return nil, adh.error(errInvalidNextPageToken, scope)
+		}
+	}
+
+	historyManager := adh.GetHistoryManager()
+	resp, err := historyManager.GetAllHistoryTreeBranches(&persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize:      pageSize,
+		NextPageToken: pageToken.GetPersistenceToken(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	var treeIDs []string
+	trees := make(map[string][]persistence.HistoryBranchDetail)
+	for _, branch := range resp.Branches {
+		if branch.TreeID == pageToken.GetLastTreeId() {
+			continue
+		}
+		if _, ok := trees[branch.TreeID]; !ok {
+			treeIDs = append(treeIDs, branch.TreeID)
+		}
+		trees[branch.TreeID] = append(trees[branch.TreeID], branch)
+	}
+
+	response := &adminservice.ListHistoryBranchesResponse{}
+	for _, treeID := range treeIDs {
+		tree, err := adh.describeHistoryTree(treeID, trees[treeID], minBranchCount)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		if tree != nil {
+			response.Trees = append(response.Trees, tree)
+		}
+	}
+
+	if len(resp.NextPageToken) != 0 {
+		response.NextPageToken, err = serializeHistoryBranchToken(&tokenspb.HistoryBranchContinuation{
+			PersistenceToken: resp.NextPageToken,
+			LastTreeId:       resp.Branches[len(resp.Branches)-1].TreeID,
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return response, nil
+}
+
+// describeHistoryTree returns the live branches of the tree, or nil if the tree has less than minBranchCount branches
+func (adh *AdminHandler) describeHistoryTree(
+	treeID string,
+	branches []persistence.HistoryBranchDetail,
+	minBranchCount int,
+) (*adminservice.HistoryTreeDescription, error) {
+
+	namespaceID, workflowID, _, err := persistence.SplitHistoryGarbageCleanupInfo(branches[0].Info)
+	if err != nil {
+		adh.GetLogger().Warn("Unable to parse history branch info.", tag.Error(err))
+		return nil, nil
+	}
+	shardID := common.WorkflowIDToHistoryShard(namespaceID, workflowID, adh.numberOfHistoryShards)
+
+	historyManager := adh.GetHistoryManager()
+	resp, err := historyManager.GetHistoryTree(&persistence.GetHistoryTreeRequest{
+		TreeID:  treeID,
+		ShardID: convert.Int32Ptr(shardID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Branches) < minBranchCount {
+		return nil, nil
+	}
+
+	details := make(map[string]persistence.HistoryBranchDetail, len(branches))
+	for _, branch := range branches {
+		details[branch.BranchID] = branch
+	}
+	tree := &adminservice.HistoryTreeDescription{
+		NamespaceId: namespaceID,
+		WorkflowId:  workflowID,
+		TreeId:      treeID,
+		ShardId:     shardID,
+	}
+	for _, branch := range resp.Branches {
+		size, err := adh.getHistoryBranchSize(branch, shardID)
+		if err != nil {
+			return nil, err
+		}
+		// run ID and fork time are known only for branches scanned in this page
+		detail := details[branch.GetBranchId()]
+		_, _, runID, _ := persistence.SplitHistoryGarbageCleanupInfo(detail.Info)
+		tree.TotalSizeBytes += size
+		tree.Branches = append(tree.Branches, &adminservice.HistoryBranchDescription{
+			BranchId:  branch.GetBranchId(),
+			RunId:     runID,
+			ForkTime:  detail.ForkTime,
+			SizeBytes: size,
+		})
+	}
+	return tree, nil
+}
+
+func (adh *AdminHandler) getHistoryBranchSize(
+	branch *persistencespb.HistoryBranch,
+	shardID int32,
+) (int64, error) {
+
+	blob, err := serialization.HistoryBranchToBlob(branch)
+	if err != nil {
+		return 0, err
+	}
+	req := &persistence.ReadHistoryBranchRequest{
+		BranchToken: blob.Data,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.EndEventID,
+		PageSize:    historyBranchSizeReadPageSize,
+		ShardID:     convert.Int32Ptr(shardID),
+	}
+
+	var size int64
+	for {
+		resp, err := adh.GetHistoryManager().ReadRawHistoryBranch(req)
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return size, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		size += int64(resp.Size)
+		if len(resp.NextPageToken) == 0 {
+			return size, nil
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+}
+
+// GetTaskQueueAutoscalingHint returns recommended worker count of task queue. Partitions are described one by one,
+// their rates are summed up and pollers, which poll all partitions, are counted once.
+func (adh *AdminHandler) GetTaskQueueAutoscalingHint(
+	ctx context.Context,
+	request *adminservice.GetTaskQueueAutoscalingHintRequest,
+) (_ *adminservice.GetTaskQueueAutoscalingHintResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminGetTaskQueueAutoscalingHintScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, adh.error(errTaskQueueNotSet, scope)
+	}
+	taskQueueType := request.GetTaskQueueType()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		taskQueueType = enumspb.TASK_QUEUE_TYPE_WORKFLOW
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: request.GetTaskQueue(),
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+	partitionsResp, err := adh.GetMatchingClient().ListTaskQueuePartitions(ctx, &matchingservice.ListTaskQueuePartitionsRequest{
+		Namespace: request.GetNamespace(),
+		TaskQueue: taskQueue,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	partitions := partitionsResp.GetWorkflowTaskQueuePartitions()
+	if taskQueueType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		partitions = partitionsResp.GetActivityTaskQueuePartitions()
+	}
+
+	var hint *taskqueuespb.TaskQueueAutoscalingHint
+	for _, partition := range partitions {
+		resp, err := adh.GetMatchingClient().DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: namespaceID,
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				Namespace: request.GetNamespace(),
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: partition.GetKey(),
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				TaskQueueType: taskQueueType,
+			},
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		partitionHint := resp.GetAutoscalingHint()
+		if partitionHint == nil {
+			continue
+		}
+		if hint == nil {
+			hint = &taskqueuespb.TaskQueueAutoscalingHint{}
+		}
+		hint.DispatchRate += partitionHint.GetDispatchRate()
+		hint.BacklogGrowthRate += partitionHint.GetBacklogGrowthRate()
+		hint.DemandRate += partitionHint.GetDemandRate()
+		hint.BacklogCountHint += partitionHint.GetBacklogCountHint()
+		if partitionHint.GetCurrentWorkers() > hint.CurrentWorkers {
+			hint.CurrentWorkers = partitionHint.GetCurrentWorkers()
+		}
+	}
+	if hint != nil {
+		var recommendedWorkers int
+		hint.Utilization, recommendedWorkers = autoscaling.RecommendWorkers(hint.DemandRate, hint.DispatchRate, int(hint.CurrentWorkers))
+		hint.RecommendedWorkers = int32(recommendedWorkers)
+	}
+	return &adminservice.GetTaskQueueAutoscalingHintResponse{Hint: hint}, nil
+}
+
+// RecordConsistencyMarker records the persisted ack levels of all shards and the namespace replication queue ack
+// levels as a consistency marker. Persisted ack levels trail the in memory ones, restoring to them replays more tasks.
+func (adh *AdminHandler) RecordConsistencyMarker(
+	ctx context.Context,
+	request *adminservice.RecordConsistencyMarkerRequest,
+) (_ *adminservice.RecordConsistencyMarkerResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminRecordConsistencyMarkerScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	marker := &persistence.ConsistencyMarker{
+		CreatedTime: adh.GetTimeSource().Now().UTC(),
+		Description: request.GetDescription(),
+	}
+	// ShardID starts with 1
+	for shardID := int32(1); shardID <= adh.numberOfHistoryShards; shardID++ {
+		if err := ctx.Err(); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		resp, err := adh.GetShardManager().GetShard(&persistence.GetShardRequest{ShardID: shardID})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		marker.Shards = append(marker.Shards, resp.ShardInfo)
+	}
+	ackLevels, err := adh.GetNamespaceReplicationQueue().GetAckLevels()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	marker.NamespaceReplicationAckLevels = ackLevels
+
+	markerID, err := adh.GetConsistencyMarkerStore().RecordMarker(marker)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.RecordConsistencyMarkerResponse{
+		MarkerId:    markerID,
+		CreatedTime: &marker.CreatedTime,
+		ShardCount:  int32(len(marker.Shards)),
+	}, nil
+}
+
+// ListConsistencyMarkers lists recorded consistency markers ordered by marker ID
+func (adh *AdminHandler) ListConsistencyMarkers(
+	_ context.Context,
+	request *adminservice.ListConsistencyMarkersRequest,
+) (_ *adminservice.ListConsistencyMarkersResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminListConsistencyMarkersScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultConsistencyMarkersPageSize
+	}
+
+	markers, nextPageToken, err := adh.GetConsistencyMarkerStore().ListMarkers(pageSize, request.GetNextPageToken())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	response := &adminservice.ListConsistencyMarkersResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, marker := range markers {
+		createdTime := marker.CreatedTime
+		response.Markers = append(response.Markers, &adminservice.ConsistencyMarkerInfo{
+			MarkerId:    marker.MarkerID,
+			CreatedTime: &createdTime,
+			Description: marker.Description,
+			ShardCount:  int32(len(marker.Shards)),
+		})
+	}
+	return response, nil
+}
+
+// GetCertificateChains returns the certificate chains loaded by this frontend node
+func (adh *AdminHandler) GetCertificateChains(
+	_ context.Context,
+	request *adminservice.GetCertificateChainsRequest,
+) (_ *adminservice.GetCertificateChainsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminGetCertificateChainsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	reporter, ok := adh.params.RPCFactory.(encryption.CertChainReporter)
+	if !ok {
+		return &adminservice.GetCertificateChainsResponse{}, nil
+	}
+	chains, err := reporter.GetCertChains()
+	if err != nil {
+		return nil, adh.error(serviceerror.NewInternal(err.Error()), scope)
+	}
+	return &adminservice.GetCertificateChainsResponse{
+		Chains: toCertificateChains(chains),
+	}, nil
+}
+
 func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
 ) error {
@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 
@@ -42,20 +43,42 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
+	sdkmocks "go.temporal.io/sdk/mocks"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	clusterspb "go.temporal.io/server/api/cluster/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/api/rawhistoryservice/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/elasticsearch"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/service/worker/batcher"
 )
 
 type (
@@ -99,8 +122,10 @@ func (s *adminHandlerSuite) SetupTest() {
 			NumHistoryShards: 1,
 		},
 	}
-	config := &Config{}
-	s.handler = NewAdminHandler(s.mockResource, params, config)
+	config := &Config{
+		MinRetentionDays: dynamicconfig.GetIntPropertyFn(1),
+	}
+	s.handler = NewAdminHandler(s.mockResource, params, config, nil)
 	s.handler.Start()
 }
 
@@ -561,3 +586,732 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Validate() {
 	s.Equal(esErrorTest.Expected, err)
 	s.Nil(resp)
 }
+
+func (s *adminHandlerSuite) Test_ImbalanceScore() {
+	s.Equal(float64(0), imbalanceScore(nil))
+	s.Equal(float64(0), imbalanceScore([]float64{0, 0}))
+	s.Equal(float64(0), imbalanceScore([]float64{4, 4, 4}))
+	s.InDelta(0.5, imbalanceScore([]float64{6, 2}), 0.0001)
+}
+
+func (s *adminHandlerSuite) Test_GetShardOwnershipReport() {
+	hostA := membership.NewHostInfo("10.0.0.1:7234", nil)
+	hostB := membership.NewHostInfo("10.0.0.2:7234", nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().Members().Return([]*membership.HostInfo{hostA, hostB})
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(&persistence.GetClusterMetadataResponse{}, nil)
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), &historyservice.DescribeHistoryHostRequest{HostAddress: hostA.GetAddress()}).
+		Return(&historyservice.DescribeHistoryHostResponse{ShardsNumber: 3, RequestsPerSecond: 6}, nil)
+	s.mockHistoryClient.EXPECT().DescribeHistoryHost(gomock.Any(), &historyservice.DescribeHistoryHostRequest{HostAddress: hostB.GetAddress()}).
+		Return(nil, serviceerror.NewUnavailable("unavailable"))
+
+	resp, err := s.handler.GetShardOwnershipReport(context.Background(), &adminservice.GetShardOwnershipReportRequest{})
+	s.NoError(err)
+	s.Equal([]*clusterspb.HostShardReport{
+		{Address: hostA.GetAddress(), Shards: 3, RequestsPerSecond: 6},
+		{Address: hostB.GetAddress(), Error: "unavailable"},
+	}, resp.GetHosts())
+	s.Equal(float64(0), resp.GetShardImbalance())
+	s.Nil(resp.GetRebalancePlan())
+}
+
+func (s *adminHandlerSuite) Test_ShardRebalance() {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.mockResource.TimeSource = clock.NewEventTimeSource().Update(now)
+	hostA := membership.NewHostInfo("10.0.0.1:7234", nil)
+	hostB := membership.NewHostInfo("10.0.0.2:7234", nil)
+	// hash ring of membership monitor assigns the only shard to host A
+	historyRing := s.mockResource.HistoryServiceResolver
+	historyRing.EXPECT().Members().Return([]*membership.HostInfo{hostA, hostB}).AnyTimes()
+	historyRing.EXPECT().Lookup("1").Return(hostA, nil).AnyTimes()
+	target := map[string]int32{hostA.GetAddress(): 0, hostB.GetAddress(): 1}
+
+	// target must list history hosts only
+	_, err := s.handler.StartShardRebalance(context.Background(), &adminservice.StartShardRebalanceRequest{
+		Target:         map[string]int32{"10.0.0.3:7234": 1},
+		MovesPerMinute: 1,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	// plan is persisted in cluster metadata and takes effect once all hosts loaded it
+	startTime := now.Add(membership.ShardRebalanceActivationDelay)
+	plan := &persistencespb.ShardRebalancePlan{
+		StartTime:      &startTime,
+		MovesPerMinute: 1,
+		Moves:          []*persistencespb.ShardMove{{ShardId: 1, HostAddress: hostB.GetAddress()}},
+	}
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active"},
+		Version:         3,
+	}, nil)
+	s.mockResource.ClusterMetadataMgr.EXPECT().SaveClusterMetadata(&persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active", ShardRebalancePlan: plan},
+		Version:         3,
+	}).Return(true, nil)
+	startResp, err := s.handler.StartShardRebalance(context.Background(), &adminservice.StartShardRebalanceRequest{
+		Target:         target,
+		MovesPerMinute: 1,
+	})
+	s.NoError(err)
+	s.Equal(plan, startResp.GetRebalancePlan())
+
+	// plan in effect can't be replaced
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active", ShardRebalancePlan: plan},
+		Version:         4,
+	}, nil)
+	_, err = s.handler.StartShardRebalance(context.Background(), &adminservice.StartShardRebalanceRequest{
+		Target:         target,
+		MovesPerMinute: 1,
+	})
+	s.Equal(errShardRebalanceInEffect, err)
+
+	// cancellation is persisted in the plan
+	cancelTime := now.Add(membership.ShardRebalanceActivationDelay)
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active", ShardRebalancePlan: plan},
+		Version:         4,
+	}, nil)
+	s.mockResource.ClusterMetadataMgr.EXPECT().SaveClusterMetadata(&persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active", ShardRebalancePlan: &persistencespb.ShardRebalancePlan{
+			StartTime:      &startTime,
+			MovesPerMinute: 1,
+			Moves:          plan.GetMoves(),
+			CancelTime:     &cancelTime,
+		}},
+		Version: 4,
+	}).Return(false, nil)
+	_, err = s.handler.CancelShardRebalance(context.Background(), &adminservice.CancelShardRebalanceRequest{})
+	s.Equal(errClusterMetadataUpdateConflict, err)
+}
+
+func (s *adminHandlerSuite) Test_RotateTLSCertificate() {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.mockResource.TimeSource = clock.NewEventTimeSource().Update(now)
+
+	_, err := s.handler.RotateTLSCertificate(context.Background(), &adminservice.RotateTLSCertificateRequest{Group: "http"})
+	s.Equal(errUnknownTLSGroup, err)
+
+	// new generation replaces outcome of the previous one
+	previous := &persistencespb.TLSRotation{
+		Group:      encryption.TLSGroupFrontend,
+		Generation: 2,
+		Hosts:      []*persistencespb.TLSRotationHostStatus{{Service: "frontend", HostAddress: "10.0.0.1:7233", Rotated: true}},
+	}
+	rotation := &persistencespb.TLSRotation{
+		Group:      encryption.TLSGroupInternode,
+		Generation: 3,
+		StartTime:  &now,
+	}
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active", TlsRotation: previous},
+		Version:         5,
+	}, nil)
+	s.mockResource.ClusterMetadataMgr.EXPECT().SaveClusterMetadata(&persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active", TlsRotation: rotation},
+		Version:         5,
+	}).Return(true, nil)
+	resp, err := s.handler.RotateTLSCertificate(context.Background(), &adminservice.RotateTLSCertificateRequest{
+		Group: encryption.TLSGroupInternode,
+	})
+	s.NoError(err)
+	s.Equal(rotation, resp.GetRotation())
+
+	s.mockResource.ClusterMetadataMgr.EXPECT().GetClusterMetadata().Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: persistencespb.ClusterMetadata{ClusterName: "active", TlsRotation: previous},
+		Version:         6,
+	}, nil)
+	describeResp, err := s.handler.DescribeTLSRotation(context.Background(), &adminservice.DescribeTLSRotationRequest{})
+	s.NoError(err)
+	s.Equal(previous, describeResp.GetRotation())
+}
+
+func (s *adminHandlerSuite) Test_ApplyOperatorAction() {
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace}, nil, "", nil)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil).AnyTimes()
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+	adminCtx := context.WithValue(context.Background(), authorization.ContextKeyMappedClaims,
+		&authorization.Claims{Subject: "operator", Namespaces: map[string]authorization.Role{s.namespace: authorization.RoleAdmin}})
+
+	_, err := s.handler.ApplyOperatorAction(adminCtx, &adminservice.ApplyOperatorActionRequest{
+		Namespace:  s.namespace,
+		Execution:  execution,
+		ActionType: enumsspb.OPERATOR_ACTION_TYPE_FIRE_TIMER,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	request := &adminservice.ApplyOperatorActionRequest{
+		Namespace:  s.namespace,
+		Execution:  execution,
+		ActionType: enumsspb.OPERATOR_ACTION_TYPE_FIRE_TIMER,
+		TimerId:    "t1",
+		Identity:   "spoofed",
+	}
+
+	// callers without claims and without admin role are denied
+	_, err = s.handler.ApplyOperatorAction(context.Background(), request)
+	s.Equal(errOperatorActionDenied, err)
+	readerCtx := context.WithValue(context.Background(), authorization.ContextKeyMappedClaims,
+		&authorization.Claims{Subject: "reader", System: authorization.RoleReader})
+	_, err = s.handler.ApplyOperatorAction(readerCtx, request)
+	s.Equal(errOperatorActionDenied, err)
+
+	// operator action is forwarded to history with the authenticated subject as identity
+	s.mockHistoryClient.EXPECT().ApplyOperatorAction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ApplyOperatorActionRequest, _ ...grpc.CallOption) (*historyservice.ApplyOperatorActionResponse, error) {
+			s.Equal(s.namespaceID, request.GetNamespaceId())
+			s.Equal(enumsspb.OPERATOR_ACTION_TYPE_FIRE_TIMER, request.GetRequest().GetActionType())
+			s.Equal("t1", request.GetRequest().GetTimerId())
+			s.Equal("operator", request.GetRequest().GetIdentity())
+			return &historyservice.ApplyOperatorActionResponse{}, nil
+		})
+	_, err = s.handler.ApplyOperatorAction(adminCtx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_AnnotateWorkflowExecution() {
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace}, nil, "", nil)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil).AnyTimes()
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+
+	_, err := s.handler.AnnotateWorkflowExecution(context.Background(), &adminservice.AnnotateWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: execution,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	_, err = s.handler.AnnotateWorkflowExecution(context.Background(), &adminservice.AnnotateWorkflowExecutionRequest{
+		Namespace:  s.namespace,
+		Execution:  execution,
+		Annotation: "incident",
+	})
+	s.Equal(errOperatorActionDenied, err)
+
+	readerCtx := context.WithValue(context.Background(), authorization.ContextKeyMappedClaims,
+		&authorization.Claims{Subject: "reader", System: authorization.RoleReader})
+	_, err = s.handler.AnnotateWorkflowExecution(readerCtx, &adminservice.AnnotateWorkflowExecutionRequest{
+		Namespace:  s.namespace,
+		Execution:  execution,
+		Annotation: "incident",
+	})
+	s.Equal(errOperatorActionDenied, err)
+
+	// annotation is forwarded to history with the authenticated subject as identity
+	adminCtx := context.WithValue(context.Background(), authorization.ContextKeyMappedClaims,
+		&authorization.Claims{Subject: "operator", Namespaces: map[string]authorization.Role{s.namespace: authorization.RoleAdmin}})
+	s.mockHistoryClient.EXPECT().AnnotateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.AnnotateWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.AnnotateWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID, request.GetNamespaceId())
+			s.Equal("incident", request.GetRequest().GetAnnotation())
+			s.Equal([]string{"vendor-outage"}, request.GetRequest().GetTags())
+			s.Equal("operator", request.GetRequest().GetIdentity())
+			return &historyservice.AnnotateWorkflowExecutionResponse{}, nil
+		})
+	_, err = s.handler.AnnotateWorkflowExecution(adminCtx, &adminservice.AnnotateWorkflowExecutionRequest{
+		Namespace:  s.namespace,
+		Execution:  execution,
+		Annotation: "incident",
+		Tags:       []string{"vendor-outage"},
+		Identity:   "spoofed",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_GetIntakeOutcome() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.mockResource.IntakeQueue.EXPECT().GetOutcome(s.namespaceID, "token").Return(&persistence.IntakeOutcome{
+		State:   persistence.IntakeMessageStateRejected,
+		Failure: "workflow already started",
+	}, nil)
+
+	resp, err := s.handler.GetIntakeOutcome(context.Background(), &adminservice.GetIntakeOutcomeRequest{
+		Namespace: s.namespace,
+		Token:     "token",
+	})
+	s.NoError(err)
+	s.Equal(enumsspb.INTAKE_OUTCOME_STATE_REJECTED, resp.GetState())
+	s.Equal("workflow already started", resp.GetFailure())
+
+	_, err = s.handler.GetIntakeOutcome(context.Background(), &adminservice.GetIntakeOutcomeRequest{Token: "token"})
+	s.Equal(errNamespaceNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_GetReplicationEventChunk() {
+	taskInfo := &replicationspb.ReplicationTaskInfo{
+		NamespaceId: s.namespaceID,
+		WorkflowId:  "workflow-id",
+		TaskId:      123,
+	}
+	_, err := s.handler.GetReplicationEventChunk(context.Background(), &adminservice.GetReplicationEventChunkRequest{ChunkSize: 1024})
+	s.Equal(errEmptyReplicationInfo, err)
+	_, err = s.handler.GetReplicationEventChunk(context.Background(), &adminservice.GetReplicationEventChunkRequest{TaskInfo: taskInfo})
+	s.Equal(errInvalidReplicationEventChunk, err)
+
+	request := &adminservice.GetReplicationEventChunkRequest{TaskInfo: taskInfo, ChunkIndex: 2, ChunkSize: 1024}
+	s.mockHistoryClient.EXPECT().GetReplicationEventChunk(gomock.Any(), &historyservice.GetReplicationEventChunkRequest{
+		NamespaceId: s.namespaceID,
+		Request:     request,
+	}).Return(&historyservice.GetReplicationEventChunkResponse{Data: []byte("chunk")}, nil)
+	resp, err := s.handler.GetReplicationEventChunk(context.Background(), request)
+	s.NoError(err)
+	s.Equal([]byte("chunk"), resp.GetData())
+}
+
+func (s *adminHandlerSuite) Test_StartReplayCheck() {
+	_, err := s.handler.StartReplayCheck(context.Background(), &adminservice.StartReplayCheckRequest{
+		Namespace: s.namespace,
+		Query:     "TaskQueue = 'tq'",
+	})
+	s.Equal(errTaskQueueNotSet, err)
+	_, err = s.handler.StartReplayCheck(context.Background(), &adminservice.StartReplayCheckRequest{
+		Namespace:           s.namespace,
+		ValidationTaskQueue: "validation",
+	})
+	s.Equal(errReplayCheckExecutionsNotSet, err)
+	_, err = s.handler.StartReplayCheck(context.Background(), &adminservice.StartReplayCheckRequest{
+		Namespace:           s.namespace,
+		ValidationTaskQueue: "validation",
+		Query:               "TaskQueue = 'tq'",
+		MaxWorkflows:        batcher.MaxReplayCheckWorkflows + 1,
+	})
+	s.Equal(errTooManyReplayCheckWorkflows, err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Name: s.namespace, Id: s.namespaceID}, nil, "", nil), nil)
+	sdkClient := s.mockResource.SDKClient.(*sdkmocks.Client)
+	run := &sdkmocks.WorkflowRun{}
+	run.On("GetID").Return("job-id")
+	sdkClient.On("ExecuteWorkflow", mock.Anything, mock.MatchedBy(func(options sdkclient.StartWorkflowOptions) bool {
+		return options.TaskQueue == batcher.BatcherTaskQueueName && options.WorkflowExecutionTimeout > 0
+	}), batcher.ReplayCheckWFTypeName, mock.MatchedBy(func(params batcher.ReplayCheckParams) bool {
+		return params.Namespace == s.namespace && params.ValidationTaskQueue == "validation" && params.PickupTimeout == time.Minute
+	})).Return(run, nil).Once()
+
+	resp, err := s.handler.StartReplayCheck(context.Background(), &adminservice.StartReplayCheckRequest{
+		Namespace:           s.namespace,
+		ValidationTaskQueue: "validation",
+		Query:               "TaskQueue = 'tq'",
+		PickupTimeout:       timestamp.DurationPtr(time.Minute),
+		Identity:            "operator",
+	})
+	s.NoError(err)
+	s.Equal("job-id", resp.GetJobId())
+	sdkClient.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) Test_DescribeReplayCheck() {
+	sdkClient := s.mockResource.SDKClient.(*sdkmocks.Client)
+	describe := func(jobID string, workflowType string, status enumspb.WorkflowExecutionStatus) {
+		sdkClient.On("DescribeWorkflowExecution", mock.Anything, jobID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Type:   &commonpb.WorkflowType{Name: workflowType},
+				Status: status,
+			},
+		}, nil).Once()
+	}
+
+	describe("batch-job", batcher.BatchWFTypeName, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	_, err := s.handler.DescribeReplayCheck(context.Background(), &adminservice.DescribeReplayCheckRequest{JobId: "batch-job"})
+	s.Equal(errReplayCheckNotFound, err)
+
+	describe("running-job", batcher.ReplayCheckWFTypeName, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	resp, err := s.handler.DescribeReplayCheck(context.Background(), &adminservice.DescribeReplayCheckRequest{JobId: "running-job"})
+	s.NoError(err)
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, resp.GetStatus())
+
+	describe("completed-job", batcher.ReplayCheckWFTypeName, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	run := &sdkmocks.WorkflowRun{}
+	run.On("Get", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(1).(*batcher.ReplayCheckResult) = batcher.ReplayCheckResult{
+			Checked:  2,
+			Failures: []batcher.ReplayCheckFailure{{WorkflowID: "wid", RunID: "rid", Error: "nondeterministic workflow"}},
+		}
+	}).Return(nil)
+	sdkClient.On("GetWorkflow", mock.Anything, "completed-job", "").Return(run).Once()
+	resp, err = s.handler.DescribeReplayCheck(context.Background(), &adminservice.DescribeReplayCheckRequest{JobId: "completed-job"})
+	s.NoError(err)
+	s.Equal(int32(2), resp.GetChecked())
+	s.Equal([]*adminservice.ReplayCheckFailure{{WorkflowId: "wid", RunId: "rid", Error: "nondeterministic workflow"}}, resp.GetFailures())
+
+	describe("failed-job", batcher.ReplayCheckWFTypeName, enumspb.WORKFLOW_EXECUTION_STATUS_FAILED)
+	failedRun := &sdkmocks.WorkflowRun{}
+	failedRun.On("Get", mock.Anything, mock.Anything).Return(errors.New("no shadow worker picked up replay"))
+	sdkClient.On("GetWorkflow", mock.Anything, "failed-job", "").Return(failedRun).Once()
+	resp, err = s.handler.DescribeReplayCheck(context.Background(), &adminservice.DescribeReplayCheckRequest{JobId: "failed-job"})
+	s.NoError(err)
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, resp.GetStatus())
+	s.Equal("no shadow worker picked up replay", resp.GetFailure())
+}
+
+func (s *adminHandlerSuite) Test_NamespaceRegistrations() {
+	namespaceHandler := namespace.NewMockHandler(s.controller)
+	s.handler.namespaceHandler = namespaceHandler
+	ctx := context.Background()
+	createdTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	registerRequest := &workflowservice.RegisterNamespaceRequest{Namespace: "test-namespace"}
+
+	namespaceHandler.EXPECT().ListRegistrations(ctx, 10, []byte{1}).Return([]*persistence.NamespaceRegistration{
+		{RegistrationID: 5, Requester: "user", Request: registerRequest, CreatedTime: createdTime},
+	}, []byte{2}, nil)
+	listResp, err := s.handler.ListNamespaceRegistrations(ctx, &adminservice.ListNamespaceRegistrationsRequest{
+		PageSize:      10,
+		NextPageToken: []byte{1},
+	})
+	s.NoError(err)
+	s.Equal(&adminservice.ListNamespaceRegistrationsResponse{
+		Registrations: []*adminservice.NamespaceRegistration{
+			{RegistrationId: 5, Requester: "user", Request: registerRequest, CreateTime: &createdTime},
+		},
+		NextPageToken: []byte{2},
+	}, listResp)
+
+	_, err = s.handler.ApproveNamespaceRegistration(ctx, &adminservice.ApproveNamespaceRegistrationRequest{})
+	s.Equal(errRegistrationIDNotSet, err)
+	namespaceHandler.EXPECT().ApproveRegistration(ctx, int64(5)).Return(nil)
+	_, err = s.handler.ApproveNamespaceRegistration(ctx, &adminservice.ApproveNamespaceRegistrationRequest{
+		RegistrationId: 5,
+		Identity:       "admin",
+	})
+	s.NoError(err)
+
+	_, err = s.handler.RejectNamespaceRegistration(ctx, &adminservice.RejectNamespaceRegistrationRequest{})
+	s.Equal(errRegistrationIDNotSet, err)
+	namespaceHandler.EXPECT().RejectRegistration(ctx, int64(6)).Return(serviceerror.NewNotFound("not found"))
+	_, err = s.handler.RejectNamespaceRegistration(ctx, &adminservice.RejectNamespaceRegistrationRequest{
+		RegistrationId: 6,
+		Identity:       "admin",
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (s *adminHandlerSuite) Test_ListHistoryBranches() {
+	forkTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	branchyTreeID := uuid.New()
+	singleTreeID := uuid.New()
+	newBranch := func(treeID string, branchID string, runID string) persistence.HistoryBranchDetail {
+		return persistence.HistoryBranchDetail{
+			TreeID:   treeID,
+			BranchID: branchID,
+			ForkTime: &forkTime,
+			Info:     persistence.BuildHistoryGarbageCleanupInfo(s.namespaceID, "workflow-id", runID),
+		}
+	}
+
+	// the first tree was described in the previous page already
+	pageToken, err := serializeHistoryBranchToken(&tokenspb.HistoryBranchContinuation{
+		PersistenceToken: []byte{1},
+		LastTreeId:       "previous-tree",
+	})
+	s.NoError(err)
+	s.mockHistoryV2Mgr.On("GetAllHistoryTreeBranches", &persistence.GetAllHistoryTreeBranchesRequest{
+		PageSize:      3,
+		NextPageToken: []byte{1},
+	}).Return(&persistence.GetAllHistoryTreeBranchesResponse{
+		Branches: []persistence.HistoryBranchDetail{
+			newBranch("previous-tree", "previous-branch", "previous-run"),
+			newBranch(branchyTreeID, "branch-1", "run-1"),
+			newBranch(branchyTreeID, "branch-2", "run-2"),
+			newBranch(singleTreeID, "branch-3", "run-3"),
+		},
+		NextPageToken: []byte{2},
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("GetHistoryTree", mock.MatchedBy(func(request *persistence.GetHistoryTreeRequest) bool {
+		return request.TreeID == branchyTreeID
+	})).Return(&persistence.GetHistoryTreeResponse{
+		Branches: []*persistencespb.HistoryBranch{
+			{TreeId: branchyTreeID, BranchId: "branch-1"},
+			{TreeId: branchyTreeID, BranchId: "branch-2"},
+		},
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("GetHistoryTree", mock.MatchedBy(func(request *persistence.GetHistoryTreeRequest) bool {
+		return request.TreeID == singleTreeID
+	})).Return(&persistence.GetHistoryTreeResponse{
+		Branches: []*persistencespb.HistoryBranch{
+			{TreeId: singleTreeID, BranchId: "branch-3"},
+		},
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadRawHistoryBranch", mock.Anything).Return(&persistence.ReadRawHistoryBranchResponse{
+		Size: 100,
+	}, nil).Twice()
+
+	resp, err := s.handler.ListHistoryBranches(context.Background(), &adminservice.ListHistoryBranchesRequest{
+		PageSize:       3,
+		NextPageToken:  pageToken,
+		MinBranchCount: 2,
+	})
+	s.NoError(err)
+	s.Equal([]*adminservice.HistoryTreeDescription{
+		{
+			NamespaceId:    s.namespaceID,
+			WorkflowId:     "workflow-id",
+			TreeId:         branchyTreeID,
+			ShardId:        1,
+			TotalSizeBytes: 200,
+			Branches: []*adminservice.HistoryBranchDescription{
+				{BranchId: "branch-1", RunId: "run-1", ForkTime: &forkTime, SizeBytes: 100},
+				{BranchId: "branch-2", RunId: "run-2", ForkTime: &forkTime, SizeBytes: 100},
+			},
+		},
+	}, resp.GetTrees())
+
+	nextPageToken, err := deserializeHistoryBranchToken(resp.GetNextPageToken())
+	s.NoError(err)
+	s.Equal(&tokenspb.HistoryBranchContinuation{
+		PersistenceToken: []byte{2},
+		LastTreeId:       singleTreeID,
+	}, nextPageToken)
+}
+
+func (s *adminHandlerSuite) Test_SignalDLQ() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	signalDLQ := s.mockResource.SignalDLQ
+	newMessage := func(messageID int64, workflowID string) *persistence.SignalDLQMessage {
+		return &persistence.SignalDLQMessage{
+			MessageID:   messageID,
+			NamespaceID: s.namespaceID,
+			Request: &historyservice.SignalWorkflowExecutionRequest{
+				NamespaceId: s.namespaceID,
+				SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+					Namespace:         s.namespace,
+					WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+					SignalName:        "signal",
+					RequestId:         uuid.New(),
+				},
+			},
+			Reason: "exceeded workflow execution limit for signal events",
+		}
+	}
+	messages := []*persistence.SignalDLQMessage{newMessage(1, "workflow1"), newMessage(3, "workflow2")}
+	ctx := context.Background()
+
+	// namespace is required
+	_, err := s.handler.GetDLQMessages(ctx, &adminservice.GetDLQMessagesRequest{Type: enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL})
+	s.Equal(errNamespaceNotSet, err)
+
+	signalDLQ.EXPECT().GetMessages(s.namespaceID, int64(10), 2, nil).Return(messages, []byte{3}, nil)
+	getResp, err := s.handler.GetDLQMessages(ctx, &adminservice.GetDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL,
+		Namespace:             s.namespace,
+		InclusiveEndMessageId: 10,
+		MaximumPageSize:       2,
+	})
+	s.NoError(err)
+	s.Equal(enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL, getResp.GetType())
+	s.Equal([]byte{3}, getResp.GetNextPageToken())
+	s.Len(getResp.GetSignalMessages(), 2)
+	s.Equal(int64(3), getResp.GetSignalMessages()[1].GetMessageId())
+	s.Equal(messages[1].Request.GetSignalRequest(), getResp.GetSignalMessages()[1].GetRequest())
+	s.Equal(messages[1].Reason, getResp.GetSignalMessages()[1].GetReason())
+
+	// re-delivered signals are deleted, re-delivery stops at the first failure
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			s.True(request.GetRedelivery())
+			s.Equal(messages[0].Request.GetSignalRequest(), request.GetSignalRequest())
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewResourceExhausted("exceeded workflow execution limit for signal events"))
+	signalDLQ.EXPECT().GetMessages(s.namespaceID, common.EndMessageID, common.ReadDLQMessagesPageSize, nil).Return(messages, nil, nil)
+	signalDLQ.EXPECT().DeleteMessage(s.namespaceID, int64(1)).Return(nil)
+	_, err = s.handler.MergeDLQMessages(ctx, &adminservice.MergeDLQMessagesRequest{
+		Type:      enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL,
+		Namespace: s.namespace,
+	})
+	s.IsType(&serviceerror.ResourceExhausted{}, err)
+	// stored requests are not marked as re-delivered
+	s.False(messages[0].Request.GetRedelivery())
+
+	// purge deletes messages of all pages
+	signalDLQ.EXPECT().GetMessages(s.namespaceID, int64(3), signalDLQPurgePageSize, nil).Return(messages[:1], []byte{1}, nil)
+	signalDLQ.EXPECT().GetMessages(s.namespaceID, int64(3), signalDLQPurgePageSize, []byte{1}).Return(messages[1:], nil, nil)
+	signalDLQ.EXPECT().DeleteMessage(s.namespaceID, int64(1)).Return(nil)
+	signalDLQ.EXPECT().DeleteMessage(s.namespaceID, int64(3)).Return(nil)
+	_, err = s.handler.PurgeDLQMessages(ctx, &adminservice.PurgeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL,
+		Namespace:             s.namespace,
+		InclusiveEndMessageId: 3,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_GetTaskQueueAutoscalingHint() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.mockResource.MatchingClient.EXPECT().ListTaskQueuePartitions(gomock.Any(), gomock.Any()).Return(&matchingservice.ListTaskQueuePartitionsResponse{
+		ActivityTaskQueuePartitions: []*taskqueuepb.TaskQueuePartitionMetadata{{Key: "tq"}, {Key: "/_sys/tq/1"}, {Key: "/_sys/tq/2"}},
+	}, nil)
+	partitionHints := map[string]*taskqueuespb.TaskQueueAutoscalingHint{
+		"tq":         {DispatchRate: 10, BacklogGrowthRate: 5, DemandRate: 15, BacklogCountHint: 50, CurrentWorkers: 2},
+		"/_sys/tq/1": {DispatchRate: 10, BacklogGrowthRate: -1, DemandRate: 15, BacklogCountHint: 20, CurrentWorkers: 3},
+		// partition which was loaded recently has no hint yet
+		"/_sys/tq/2": nil,
+	}
+	s.mockResource.MatchingClient.EXPECT().DescribeTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.DescribeTaskQueueRequest, _ ...interface{}) (*matchingservice.DescribeTaskQueueResponse, error) {
+			s.Equal(s.namespaceID, request.GetNamespaceId())
+			s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.GetDescRequest().GetTaskQueueType())
+			return &matchingservice.DescribeTaskQueueResponse{
+				AutoscalingHint: partitionHints[request.GetDescRequest().GetTaskQueue().GetName()],
+			}, nil
+		}).Times(3)
+
+	resp, err := s.handler.GetTaskQueueAutoscalingHint(ctx, &adminservice.GetTaskQueueAutoscalingHintRequest{
+		Namespace:     s.namespace,
+		TaskQueue:     "tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	s.NoError(err)
+	s.Equal(&taskqueuespb.TaskQueueAutoscalingHint{
+		DispatchRate:       20,
+		BacklogGrowthRate:  4,
+		DemandRate:         30,
+		BacklogCountHint:   70,
+		CurrentWorkers:     3,
+		Utilization:        1.5,
+		RecommendedWorkers: 5,
+	}, resp.GetHint())
+
+	_, err = s.handler.GetTaskQueueAutoscalingHint(ctx, &adminservice.GetTaskQueueAutoscalingHintRequest{Namespace: s.namespace})
+	s.Equal(errTaskQueueNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_ConsistencyMarkers() {
+	ctx := context.Background()
+	shardInfo := &persistencespb.ShardInfo{ShardId: 1, RangeId: 3, TransferAckLevel: 100}
+	s.mockResource.ShardMgr.On("GetShard", &persistence.GetShardRequest{ShardID: 1}).Return(&persistence.GetShardResponse{ShardInfo: shardInfo}, nil).Once()
+	s.mockResource.NamespaceReplicationQueue.(*persistence.MockNamespaceReplicationQueue).EXPECT().GetAckLevels().Return(map[string]int64{"standby": 7}, nil)
+	s.mockResource.ConsistencyMarkerStore.EXPECT().RecordMarker(gomock.Any()).DoAndReturn(
+		func(marker *persistence.ConsistencyMarker) (int64, error) {
+			s.Equal("before backup", marker.Description)
+			s.Equal([]*persistencespb.ShardInfo{shardInfo}, marker.Shards)
+			s.Equal(map[string]int64{"standby": 7}, marker.NamespaceReplicationAckLevels)
+			return 5, nil
+		})
+
+	recordResp, err := s.handler.RecordConsistencyMarker(ctx, &adminservice.RecordConsistencyMarkerRequest{Description: "before backup"})
+	s.NoError(err)
+	s.Equal(int64(5), recordResp.GetMarkerId())
+	s.Equal(int32(1), recordResp.GetShardCount())
+
+	createdTime := time.Now().UTC()
+	s.mockResource.ConsistencyMarkerStore.EXPECT().ListMarkers(defaultConsistencyMarkersPageSize, []byte("token")).Return(
+		[]*persistence.ConsistencyMarker{{MarkerID: 5, CreatedTime: createdTime, Description: "before backup", Shards: []*persistencespb.ShardInfo{shardInfo}}},
+		nil, nil)
+
+	listResp, err := s.handler.ListConsistencyMarkers(ctx, &adminservice.ListConsistencyMarkersRequest{NextPageToken: []byte("token")})
+	s.NoError(err)
+	s.Equal([]*adminservice.ConsistencyMarkerInfo{{
+		MarkerId:    5,
+		CreatedTime: &createdTime,
+		Description: "before backup",
+		ShardCount:  1,
+	}}, listResp.GetMarkers())
+	s.Empty(listResp.GetNextPageToken())
+}
+
+type certChainRPCFactory struct {
+	common.RPCFactory
+	chains []encryption.CertChain
+}
+
+func (f *certChainRPCFactory) GetCertChains() ([]encryption.CertChain, error) {
+	return f.chains, nil
+}
+
+func (s *adminHandlerSuite) Test_GetCertificateChains() {
+	ctx := context.Background()
+	resp, err := s.handler.GetCertificateChains(ctx, &adminservice.GetCertificateChainsRequest{})
+	s.NoError(err)
+	s.Empty(resp.GetChains())
+
+	notBefore := time.Now().UTC()
+	notAfter := notBefore.Add(time.Hour)
+	s.handler.params.RPCFactory = &certChainRPCFactory{chains: []encryption.CertChain{{
+		Role: encryption.CertRoleFrontendServer,
+		Certificates: []encryption.CertificateInfo{{
+			Subject:      "CN=frontend",
+			Issuer:       "CN=ca",
+			SerialNumber: "1F",
+			NotBefore:    notBefore,
+			NotAfter:     notAfter,
+			DNSNames:     []string{"frontend.local"},
+		}},
+	}}}
+	resp, err = s.handler.GetCertificateChains(ctx, &adminservice.GetCertificateChainsRequest{})
+	s.NoError(err)
+	s.Equal([]*adminservice.CertificateChain{{
+		Role: encryption.CertRoleFrontendServer,
+		Certificates: []*adminservice.CertificateInfo{{
+			Subject:      "CN=frontend",
+			Issuer:       "CN=ca",
+			SerialNumber: "1F",
+			NotBefore:    &notBefore,
+			NotAfter:     &notAfter,
+			DnsNames:     []string{"frontend.local"},
+		}},
+	}}, resp.GetChains())
+}
+
+func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistory() {
+	ctx := context.Background()
+	config := &Config{
+		AllowRawWorkflowHistoryRequests: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		RedactionRules: dynamicconfig.GetMapPropertyFnWithNamespaceFilter(map[string]interface{}{
+			"input": redactionActionStrip,
+		}),
+	}
+	handler := NewRawHistoryHandler(config, s.handler)
+	request := &rawhistoryservice.GetWorkflowExecutionRawHistoryRequest{
+		Namespace: s.namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID",
+		},
+		MaximumPageSize: 10,
+	}
+
+	_, err := handler.GetWorkflowExecutionRawHistory(ctx, request)
+	s.Equal(errRawHistoryRequestsNotAllowed, err)
+
+	config.AllowRawWorkflowHistoryRequests = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	// history of readers is redacted, they can't read it raw
+	readerCtx := context.WithValue(ctx, authorization.ContextKeyMappedClaims, &authorization.Claims{
+		Namespaces: map[string]authorization.Role{s.namespace: authorization.RoleReader},
+	})
+	_, err = handler.GetWorkflowExecutionRawHistory(readerCtx, request)
+	s.Equal(errRawHistoryRedacted, err)
+
+	runID := uuid.New()
+	branchToken := []byte{1}
+	versionHistory := versionhistory.NewVersionHistory(branchToken, []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(int64(10), int64(100)),
+	})
+	mState := &historyservice.GetMutableStateResponse{
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflowID",
+			RunId:      runID,
+		},
+		NextEventId:        11,
+		CurrentBranchToken: branchToken,
+		VersionHistories:   versionhistory.NewVersionHistories(versionHistory),
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(mState, nil).AnyTimes()
+	blobs := []*commonpb.DataBlob{{Data: []byte{1}}}
+	// whole current branch of current run is read
+	s.mockHistoryV2Mgr.On("ReadRawHistoryBranch", &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  11,
+		PageSize:    10,
+		ShardID:     convert.Int32Ptr(common.WorkflowIDToHistoryShard(s.namespaceID, "workflowID", 1)),
+	}).Return(&persistence.ReadRawHistoryBranchResponse{
+		HistoryEventBlobs: blobs,
+	}, nil).Once()
+
+	response, err := handler.GetWorkflowExecutionRawHistory(ctx, request)
+	s.NoError(err)
+	s.Equal(blobs, response.GetHistoryBatches())
+	s.Equal(versionHistory, response.GetVersionHistory())
+	s.Empty(response.GetNextPageToken())
+}
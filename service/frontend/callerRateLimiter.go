@@ -0,0 +1,198 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	callerRateLimitersMaxSize = 10000
+	callerRateLimitersTTL     = 10 * time.Minute
+
+	healthCheckMethodPrefix = "/grpc.health.v1.Health/"
+)
+
+type (
+	// callerRateLimiter limits request rate per caller identity, in total and per API,
+	// so one noisy client can't starve other tenants of a shared frontend host
+	callerRateLimiter struct {
+		config        *Config
+		metricsClient metrics.Client
+		// caller and API name -> quotas.RateLimiter, total limit of caller uses empty API name
+		limiters cache.Cache
+	}
+
+	callerRateLimiterKey struct {
+		caller string
+		api    string
+	}
+)
+
+func newCallerRateLimiter(
+	config *Config,
+	metricsClient metrics.Client,
+) *callerRateLimiter {
+	return &callerRateLimiter{
+		config:        config,
+		metricsClient: metricsClient,
+		limiters: cache.New(callerRateLimitersMaxSize, &cache.Options{
+			TTL: callerRateLimitersTTL,
+		}),
+	}
+}
+
+// Interceptor rejects requests of callers which exceed their total or per API rate limit,
+// it must run after authorization interceptor which puts mapped claims to the context
+func (l *callerRateLimiter) Interceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if strings.HasPrefix(info.FullMethod, healthCheckMethodPrefix) {
+		return handler(ctx, req)
+	}
+
+	caller := callerIdentity(ctx)
+	if caller != "" && !l.allow(caller, apiName(info.FullMethod)) {
+		l.metricsClient.IncCounter(metrics.CallerRateLimiterScope, metrics.ServiceErrResourceExhaustedCounter)
+		return nil, errCallerRateLimited
+	}
+	return handler(ctx, req)
+}
+
+func (l *callerRateLimiter) allow(
+	caller string,
+	api string,
+) bool {
+	if l.apiRPS(api) > 0 && !l.rateLimiter(caller, api).Allow() {
+		return false
+	}
+	if l.callerRPS(caller) > 0 && !l.rateLimiter(caller, "").Allow() {
+		return false
+	}
+	return true
+}
+
+func (l *callerRateLimiter) rateLimiter(
+	caller string,
+	api string,
+) quotas.RateLimiter {
+	key := callerRateLimiterKey{caller: caller, api: api}
+	if limiter, ok := l.limiters.Get(key).(quotas.RateLimiter); ok {
+		return limiter
+	}
+
+	rateFn := func() float64 { return float64(l.callerRPS(caller)) }
+	if api != "" {
+		rateFn = func() float64 { return float64(l.apiRPS(api)) }
+	}
+	limiter, err := l.limiters.PutIfNotExist(key, quotas.NewDefaultIncomingDynamicRateLimiter(rateFn))
+	if err != nil {
+		// cache is not pinned, put never fails
+		return quotas.NewDefaultIncomingDynamicRateLimiter(rateFn)
+	}
+	return limiter.(quotas.RateLimiter)
+}
+
+// callerRPS returns total rate limit of the caller, zero means no limit
+func (l *callerRateLimiter) callerRPS(
+	caller string,
+) int {
+	if rps, ok := callerRPSValue(l.config.CallerRPSOverrides()[caller]); ok {
+		return rps
+	}
+	return l.config.CallerRPS()
+}
+
+// apiRPS returns rate limit of the API per caller, zero means no limit
+func (l *callerRateLimiter) apiRPS(
+	api string,
+) int {
+	rps, _ := callerRPSValue(l.config.CallerAPIRPS()[api])
+	return rps
+}
+
+// callerIdentity returns the identity requests are rate limited by: subject of mapped auth claims,
+// subject of the verified client certificate, or client IP, in this order of preference
+func callerIdentity(
+	ctx context.Context,
+) string {
+	if claims, ok := ctx.Value(authorization.ContextKeyMappedClaims).(*authorization.Claims); ok && claims != nil && claims.Subject != "" {
+		return claims.Subject
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok &&
+		len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+		if subject := tlsInfo.State.VerifiedChains[0][0].Subject.String(); subject != "" {
+			return subject
+		}
+	}
+	if p.Addr == nil {
+		return ""
+	}
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return ip
+}
+
+// apiName returns method name of full gRPC method, e.g. StartWorkflowExecution
+func apiName(
+	fullMethod string,
+) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+func callerRPSValue(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,116 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+const startWorkflowExecutionMethod = "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"
+
+func newTestCallerRateLimiter(callerRPS int, overrides map[string]interface{}, apiRPS map[string]interface{}) *callerRateLimiter {
+	return newCallerRateLimiter(&Config{
+		CallerRPS:          dynamicconfig.GetIntPropertyFn(callerRPS),
+		CallerRPSOverrides: dynamicconfig.GetMapPropertyFn(overrides),
+		CallerAPIRPS:       dynamicconfig.GetMapPropertyFn(apiRPS),
+	}, metrics.NewClient(tally.NoopScope, metrics.Frontend))
+}
+
+func callerContext(subject string) context.Context {
+	return context.WithValue(context.Background(), authorization.ContextKeyMappedClaims, &authorization.Claims{Subject: subject})
+}
+
+func invokeCallerRateLimiter(ctx context.Context, l *callerRateLimiter, method string) error {
+	_, err := l.Interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestCallerRateLimiterTotal(t *testing.T) {
+	// burst is twice the rate
+	limiter := newTestCallerRateLimiter(1, map[string]interface{}{"batch": 2}, nil)
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, invokeCallerRateLimiter(callerContext("noisy"), limiter, startWorkflowExecutionMethod))
+	}
+	assert.Equal(t, errCallerRateLimited, invokeCallerRateLimiter(callerContext("noisy"), limiter, startWorkflowExecutionMethod))
+
+	// other callers are not affected
+	assert.NoError(t, invokeCallerRateLimiter(callerContext("quiet"), limiter, startWorkflowExecutionMethod))
+
+	// override raises the limit of a caller
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, invokeCallerRateLimiter(callerContext("batch"), limiter, startWorkflowExecutionMethod))
+	}
+	assert.Equal(t, errCallerRateLimited, invokeCallerRateLimiter(callerContext("batch"), limiter, startWorkflowExecutionMethod))
+
+	// health checks are never limited
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, invokeCallerRateLimiter(callerContext("noisy"), limiter, "/grpc.health.v1.Health/Check"))
+	}
+}
+
+func TestCallerRateLimiterPerAPI(t *testing.T) {
+	limiter := newTestCallerRateLimiter(0, nil, map[string]interface{}{"StartWorkflowExecution": 1.0})
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, invokeCallerRateLimiter(callerContext("noisy"), limiter, startWorkflowExecutionMethod))
+	}
+	assert.Equal(t, errCallerRateLimited, invokeCallerRateLimiter(callerContext("noisy"), limiter, startWorkflowExecutionMethod))
+
+	// other APIs have no limit
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, invokeCallerRateLimiter(callerContext("noisy"), limiter, "/temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace"))
+	}
+}
+
+func TestCallerIdentity(t *testing.T) {
+	assert.Equal(t, "", callerIdentity(context.Background()))
+	assert.Equal(t, "alice", callerIdentity(callerContext("alice")))
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 7233}})
+	assert.Equal(t, "10.0.0.1", callerIdentity(ctx))
+
+	// claims without subject fall back to the client IP
+	ctx = context.WithValue(ctx, authorization.ContextKeyMappedClaims, &authorization.Claims{})
+	assert.Equal(t, "10.0.0.1", callerIdentity(ctx))
+}
+
+func TestAPIName(t *testing.T) {
+	assert.Equal(t, "StartWorkflowExecution", apiName(startWorkflowExecutionMethod))
+	assert.Equal(t, "Check", apiName("/grpc.health.v1.Health/Check"))
+}
@@ -0,0 +1,143 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/converter"
+
+	clusterspb "go.temporal.io/server/api/cluster/v1"
+)
+
+type (
+	// clientFeatureChecker reports server capabilities and rejects requests which use protocol features
+	// the server does not support, with errors describing what is supported instead
+	clientFeatureChecker struct {
+		config *Config
+	}
+)
+
+func newClientFeatureChecker(
+	config *Config,
+) *clientFeatureChecker {
+	return &clientFeatureChecker{
+		config: config,
+	}
+}
+
+// capabilities returns protocol features supported by the server, reported by admin DescribeCluster
+func (c *clientFeatureChecker) capabilities() *clusterspb.ServerCapabilities {
+	return &clusterspb.ServerCapabilities{
+		FailureDetailEncodings: c.failureDetailEncodings(),
+		MaxPayloadSize:         int32(c.config.BlobSizeLimitError("")),
+	}
+}
+
+func (c *clientFeatureChecker) checkRequest(req interface{}) error {
+	encodings := c.failureDetailEncodings()
+	if len(encodings) == 0 {
+		return nil
+	}
+	for _, failure := range requestFailures(req) {
+		if err := checkFailureDetailEncodings(failure, encodings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *clientFeatureChecker) failureDetailEncodings() []string {
+	var encodings []string
+	for _, encoding := range strings.Split(c.config.FailureDetailEncodings(), ",") {
+		if encoding = strings.TrimSpace(encoding); encoding != "" {
+			encodings = append(encodings, encoding)
+		}
+	}
+	return encodings
+}
+
+// requestFailures returns failures reported by the request
+func requestFailures(req interface{}) []*failurepb.Failure {
+	switch request := req.(type) {
+	case *workflowservice.RespondActivityTaskFailedRequest:
+		return []*failurepb.Failure{request.GetFailure()}
+	case *workflowservice.RespondActivityTaskFailedByIdRequest:
+		return []*failurepb.Failure{request.GetFailure()}
+	case *workflowservice.RespondWorkflowTaskFailedRequest:
+		return []*failurepb.Failure{request.GetFailure()}
+	case *workflowservice.RespondWorkflowTaskCompletedRequest:
+		var failures []*failurepb.Failure
+		for _, command := range request.GetCommands() {
+			if attributes := command.GetFailWorkflowExecutionCommandAttributes(); attributes != nil {
+				failures = append(failures, attributes.GetFailure())
+			}
+		}
+		return failures
+	default:
+		return nil
+	}
+}
+
+// checkFailureDetailEncodings checks encodings of details of the failure and its causes
+func checkFailureDetailEncodings(failure *failurepb.Failure, encodings []string) error {
+	for ; failure != nil; failure = failure.GetCause() {
+		var details *commonpb.Payloads
+		switch info := failure.GetFailureInfo().(type) {
+		case *failurepb.Failure_ApplicationFailureInfo:
+			details = info.ApplicationFailureInfo.GetDetails()
+		case *failurepb.Failure_CanceledFailureInfo:
+			details = info.CanceledFailureInfo.GetDetails()
+		case *failurepb.Failure_TimeoutFailureInfo:
+			details = info.TimeoutFailureInfo.GetLastHeartbeatDetails()
+		case *failurepb.Failure_ResetWorkflowFailureInfo:
+			details = info.ResetWorkflowFailureInfo.GetLastHeartbeatDetails()
+		}
+
+		for _, payload := range details.GetPayloads() {
+			// payloads without encoding are passed as is, SDK data converters always set it
+			encoding := string(payload.GetMetadata()[converter.MetadataEncoding])
+			if encoding != "" && !isSupportedEncoding(encoding, encodings) {
+				return serviceerror.NewInvalidArgument(fmt.Sprintf(
+					"Failure detail encoding %q is not supported, supported encodings are %s.", encoding, strings.Join(encodings, ", ")))
+			}
+		}
+	}
+	return nil
+}
+
+func isSupportedEncoding(encoding string, encodings []string) bool {
+	for _, supported := range encodings {
+		if encoding == supported {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commandpb "go.temporal.io/api/command/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	clusterspb "go.temporal.io/server/api/cluster/v1"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+func newTestClientFeatureChecker(encodings string) *clientFeatureChecker {
+	return newClientFeatureChecker(&Config{
+		FailureDetailEncodings: dynamicconfig.GetStringPropertyFn(encodings),
+		BlobSizeLimitError:     dynamicconfig.GetIntPropertyFilteredByNamespace(1024),
+	})
+}
+
+func newFailureWithDetails(encoding string, cause *failurepb.Failure) *failurepb.Failure {
+	return &failurepb.Failure{
+		Message: "failed",
+		Cause:   cause,
+		FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+			Details: &commonpb.Payloads{Payloads: []*commonpb.Payload{{
+				Metadata: map[string][]byte{"encoding": []byte(encoding)},
+				Data:     []byte("details"),
+			}}},
+		}},
+	}
+}
+
+func TestClientFeatureCheckerCapabilities(t *testing.T) {
+	checker := newTestClientFeatureChecker(" json/plain, binary/protobuf ,")
+	assert.Equal(t, &clusterspb.ServerCapabilities{
+		FailureDetailEncodings: []string{"json/plain", "binary/protobuf"},
+		MaxPayloadSize:         1024,
+	}, checker.capabilities())
+}
+
+func TestClientFeatureCheckerFailureDetailEncodings(t *testing.T) {
+	checker := newTestClientFeatureChecker("json/plain")
+
+	err := checker.checkRequest(&workflowservice.RespondActivityTaskFailedRequest{
+		Failure: newFailureWithDetails("json/plain", nil),
+	})
+	assert.NoError(t, err)
+
+	// unsupported encoding of a cause is rejected
+	err = checker.checkRequest(&workflowservice.RespondWorkflowTaskFailedRequest{
+		Failure: newFailureWithDetails("json/plain", newFailureWithDetails("binary/custom", nil)),
+	})
+	require.IsType(t, &serviceerror.InvalidArgument{}, err)
+	assert.Contains(t, err.Error(), `"binary/custom"`)
+	assert.Contains(t, err.Error(), "json/plain")
+
+	err = checker.checkRequest(&workflowservice.RespondWorkflowTaskCompletedRequest{
+		Commands: []*commandpb.Command{{
+			Attributes: &commandpb.Command_FailWorkflowExecutionCommandAttributes{
+				FailWorkflowExecutionCommandAttributes: &commandpb.FailWorkflowExecutionCommandAttributes{
+					Failure: newFailureWithDetails("binary/custom", nil),
+				},
+			},
+		}},
+	})
+	assert.IsType(t, &serviceerror.InvalidArgument{}, err)
+
+	// empty list, the default, accepts all encodings
+	checker = newTestClientFeatureChecker("")
+	err = checker.checkRequest(&workflowservice.RespondActivityTaskFailedByIdRequest{
+		Failure: newFailureWithDetails("binary/custom", nil),
+	})
+	assert.NoError(t, err)
+}
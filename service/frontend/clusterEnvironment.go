@@ -0,0 +1,179 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"github.com/blang/semver/v4"
+
+	clusterspb "go.temporal.io/server/api/cluster/v1"
+	"go.temporal.io/server/common/elasticsearch"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/service/config"
+	cassandraschema "go.temporal.io/server/schema/cassandra"
+	mysqlschema "go.temporal.io/server/schema/mysql"
+	postgresqlschema "go.temporal.io/server/schema/postgresql"
+)
+
+const (
+	storeTypeCassandra     = "cassandra"
+	storeTypeElasticsearch = "elasticsearch"
+
+	unknownServerVersion = "unknown"
+)
+
+type (
+	// StoreInfo describes a configured persistence or visibility store
+	StoreInfo struct {
+		// Name is the name of the datastore in persistence config
+		Name string
+		// Type is the store type, i.e. cassandra, SQL plugin name, custom visibility store name or elasticsearch
+		Type string
+		// Version is the schema version the server expects, or the configured elasticsearch version
+		Version string
+	}
+
+	// ClusterStores describes the stores configured for the cluster, it is generated from static config
+	ClusterStores struct {
+		PersistenceStore        StoreInfo
+		VisibilityStore         StoreInfo
+		AdvancedVisibilityStore *StoreInfo
+	}
+)
+
+// NewClusterStores describes the stores of persistence config
+func NewClusterStores(
+	persistenceConfig *config.Persistence,
+	esConfig *elasticsearch.Config,
+) ClusterStores {
+	stores := ClusterStores{
+		PersistenceStore: newStoreInfo(persistenceConfig, persistenceConfig.DefaultStore, false),
+		VisibilityStore:  newStoreInfo(persistenceConfig, persistenceConfig.VisibilityStore, true),
+	}
+	if persistenceConfig.IsAdvancedVisibilityConfigExist() {
+		stores.AdvancedVisibilityStore = &StoreInfo{
+			Name: persistenceConfig.AdvancedVisibilityStore,
+			Type: storeTypeElasticsearch,
+		}
+		if esConfig != nil {
+			stores.AdvancedVisibilityStore.Version = esConfig.Version
+		}
+	}
+	return stores
+}
+
+func newStoreInfo(
+	persistenceConfig *config.Persistence,
+	name string,
+	visibility bool,
+) StoreInfo {
+	info := StoreInfo{Name: name}
+	ds := persistenceConfig.DataStores[name]
+	switch {
+	case ds.Cassandra != nil:
+		info.Type = storeTypeCassandra
+		info.Version = schemaVersion(cassandraschema.Version, cassandraschema.VisibilityVersion, visibility)
+	case ds.SQL != nil:
+		info.Type = ds.SQL.PluginName
+		switch ds.SQL.PluginName {
+		case mysql.PluginName:
+			info.Version = schemaVersion(mysqlschema.Version, mysqlschema.VisibilityVersion, visibility)
+		case postgresql.PluginName:
+			info.Version = schemaVersion(postgresqlschema.Version, postgresqlschema.VisibilityVersion, visibility)
+		}
+	case ds.CustomVisibilityStore != nil:
+		info.Type = ds.CustomVisibilityStore.Name
+	}
+	return info
+}
+
+func (info *StoreInfo) toProto() *clusterspb.StoreInfo {
+	if info == nil {
+		return nil
+	}
+	return &clusterspb.StoreInfo{
+		Name:    info.Name,
+		Type:    info.Type,
+		Version: info.Version,
+	}
+}
+
+func schemaVersion(version string, visibilityVersion string, visibility bool) string {
+	if visibility {
+		return visibilityVersion
+	}
+	return version
+}
+
+// newClusterEnvironment reports stores and server versions of the members, members which do not
+// advertise a parsable version are counted as unknown and do not affect min and max versions
+func newClusterEnvironment(
+	stores ClusterStores,
+	historyShardCount int32,
+	memberVersions map[string]string,
+) *clusterspb.ClusterEnvironment {
+	env := &clusterspb.ClusterEnvironment{
+		PersistenceStore:        stores.PersistenceStore.toProto(),
+		VisibilityStore:         stores.VisibilityStore.toProto(),
+		AdvancedVisibilityStore: stores.AdvancedVisibilityStore.toProto(),
+		HistoryShardCount:       historyShardCount,
+	}
+
+	var minVersion, maxVersion *semver.Version
+	for _, value := range memberVersions {
+		version, err := semver.ParseTolerant(value)
+		if err != nil {
+			value = unknownServerVersion
+		} else {
+			if minVersion == nil || version.LT(*minVersion) {
+				minVersion = &version
+				env.MinServerVersion = value
+			}
+			if maxVersion == nil || version.GT(*maxVersion) {
+				maxVersion = &version
+				env.MaxServerVersion = value
+			}
+		}
+		if env.ServerVersions == nil {
+			env.ServerVersions = make(map[string]int32)
+		}
+		env.ServerVersions[value]++
+	}
+	return env
+}
+
+// getClusterEnvironment reports environment of the cluster in DescribeCluster response
+func (adh *AdminHandler) getClusterEnvironment() *clusterspb.ClusterEnvironment {
+	var memberVersions map[string]string
+	if monitor := adh.GetMembershipMonitor(); monitor != nil {
+		versions, err := monitor.GetReachableMemberVersions()
+		if err != nil {
+			adh.GetLogger().Warn("Unable to get server versions of cluster members.", tag.Error(err))
+		}
+		memberVersions = versions
+	}
+	return newClusterEnvironment(adh.config.ClusterStores, adh.numberOfHistoryShards, memberVersions)
+}
@@ -0,0 +1,82 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	clusterspb "go.temporal.io/server/api/cluster/v1"
+	"go.temporal.io/server/common/elasticsearch"
+	"go.temporal.io/server/common/service/config"
+	cassandraschema "go.temporal.io/server/schema/cassandra"
+	mysqlschema "go.temporal.io/server/schema/mysql"
+)
+
+func TestNewClusterStores(t *testing.T) {
+	persistenceConfig := &config.Persistence{
+		DefaultStore:            "default",
+		VisibilityStore:         "visibility",
+		AdvancedVisibilityStore: "es-visibility",
+		DataStores: map[string]config.DataStore{
+			"default":    {Cassandra: &config.Cassandra{}},
+			"visibility": {SQL: &config.SQL{PluginName: "mysql"}},
+		},
+	}
+
+	stores := NewClusterStores(persistenceConfig, &elasticsearch.Config{Version: "v7"})
+	assert.Equal(t, StoreInfo{Name: "default", Type: "cassandra", Version: cassandraschema.Version}, stores.PersistenceStore)
+	assert.Equal(t, StoreInfo{Name: "visibility", Type: "mysql", Version: mysqlschema.VisibilityVersion}, stores.VisibilityStore)
+	assert.Equal(t, &StoreInfo{Name: "es-visibility", Type: "elasticsearch", Version: "v7"}, stores.AdvancedVisibilityStore)
+
+	persistenceConfig.AdvancedVisibilityStore = ""
+	persistenceConfig.DataStores["visibility"] = config.DataStore{CustomVisibilityStore: &config.CustomDatastoreConfig{Name: "custom"}}
+	stores = NewClusterStores(persistenceConfig, nil)
+	assert.Equal(t, StoreInfo{Name: "visibility", Type: "custom"}, stores.VisibilityStore)
+	assert.Nil(t, stores.AdvancedVisibilityStore)
+}
+
+func TestNewClusterEnvironment(t *testing.T) {
+	env := newClusterEnvironment(ClusterStores{}, 4, map[string]string{
+		"10.0.0.1:6933": "1.7.0",
+		"10.0.0.2:6933": "1.10.1",
+		"10.0.0.3:6933": "1.7.0",
+		"10.0.0.4:6933": "",
+	})
+	assert.Equal(t, int32(4), env.HistoryShardCount)
+	assert.Equal(t, "1.7.0", env.MinServerVersion)
+	assert.Equal(t, "1.10.1", env.MaxServerVersion)
+	assert.Equal(t, map[string]int32{"1.7.0": 2, "1.10.1": 1, "unknown": 1}, env.ServerVersions)
+
+	env = newClusterEnvironment(ClusterStores{
+		PersistenceStore: StoreInfo{Name: "default", Type: "cassandra", Version: cassandraschema.Version},
+	}, 4, nil)
+	assert.Equal(t, &clusterspb.StoreInfo{Name: "default", Type: "cassandra", Version: cassandraschema.Version}, env.PersistenceStore)
+	assert.Nil(t, env.AdvancedVisibilityStore)
+	assert.Empty(t, env.MinServerVersion)
+	assert.Empty(t, env.MaxServerVersion)
+	assert.Nil(t, env.ServerVersions)
+}
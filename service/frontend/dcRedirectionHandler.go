@@ -102,6 +102,11 @@ func (handler *DCRedirectionHandlerImpl) UpdateHealthStatus(status HealthStatus)
 	handler.frontendHandler.UpdateHealthStatus(status)
 }
 
+// DrainLongPolls completes outstanding long polls with empty responses when frontend shuts down
+func (handler *DCRedirectionHandlerImpl) DrainLongPolls() {
+	handler.frontendHandler.DrainLongPolls()
+}
+
 // Check is for health check
 func (handler *DCRedirectionHandlerImpl) Check(ctx context.Context, request *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
 	return handler.frontendHandler.Check(ctx, request)
@@ -390,6 +395,11 @@ func (handler *DCRedirectionHandlerImpl) ListWorkflowExecutions(
 		handler.afterCall(scope, startTime, cluster, &retError)
 	}()
 
+	if remoteCluster, ok := handler.visibilityFederationCluster(request.GetNamespace(), request.GetQuery()); ok {
+		cluster = remoteCluster
+		return handler.federateListWorkflowExecutions(ctx, request, remoteCluster)
+	}
+
 	err = handler.redirectionPolicy.WithNamespaceRedirect(ctx, request.GetNamespace(), apiName, func(targetDC string) error {
 		cluster = targetDC
 		switch {
@@ -27,19 +27,25 @@ package frontend
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
@@ -107,7 +113,7 @@ func (s *dcRedirectionHandlerSuite) SetupTest() {
 
 	s.config = NewConfig(dynamicconfig.NewCollection(dynamicconfig.NewNopClient(), s.mockResource.GetLogger()), 0, false)
 
-	frontendHandlerGRPC := NewWorkflowHandler(s.mockResource, s.config, nil)
+	frontendHandlerGRPC := NewWorkflowHandler(s.mockResource, s.config, nil, nil)
 
 	s.mockFrontendHandler = workflowservicemock.NewMockWorkflowServiceServer(s.controller)
 	s.handler = NewDCRedirectionHandler(frontendHandlerGRPC, config.DCRedirectionPolicy{})
@@ -282,6 +288,76 @@ func (s *dcRedirectionHandlerSuite) TestListWorkflowExecutions() {
 	s.Nil(err)
 }
 
+func (s *dcRedirectionHandlerSuite) TestListWorkflowExecutions_Federated() {
+	s.config.EnableVisibilityFederation = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: s.alternativeClusterName,
+			Clusters:          []string{s.currentClusterName, s.alternativeClusterName},
+		},
+		1,
+		s.mockClusterMetadata,
+	)
+	s.mockResource.NamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil).AnyTimes()
+
+	startTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	newExecution := func(runID string, startedMinutesAgo int) *workflowpb.WorkflowExecutionInfo {
+		return &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: runID},
+			StartTime: timestamp.TimePtr(startTime.Add(-time.Duration(startedMinutesAgo) * time.Minute)),
+		}
+	}
+	req := &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: s.namespace,
+		PageSize:  2,
+		Query:     "WorkflowId = 'wid'",
+	}
+	s.mockFrontendHandler.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+			s.Equal(int32(2), request.GetPageSize())
+			if len(request.NextPageToken) == 0 {
+				return &workflowservice.ListWorkflowExecutionsResponse{
+					Executions:    []*workflowpb.WorkflowExecutionInfo{newExecution("run-1", 1), newExecution("run-2", 2)},
+					NextPageToken: []byte("local-token"),
+				}, nil
+			}
+			s.Equal([]byte("local-token"), request.NextPageToken)
+			return &workflowservice.ListWorkflowExecutionsResponse{
+				Executions: []*workflowpb.WorkflowExecutionInfo{newExecution("run-4", 4)},
+			}, nil
+		}).Times(3)
+	s.mockRemoteFrontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{newExecution("run-2", 2), newExecution("run-3", 3)},
+	}, nil).Times(2)
+
+	// executions known to both clusters are returned once, pages are capped at page size
+	resp, err := s.handler.ListWorkflowExecutions(context.Background(), req)
+	s.NoError(err)
+	s.Len(resp.Executions, 2)
+	s.Equal("run-1", resp.Executions[0].GetExecution().GetRunId())
+	s.Equal("run-2", resp.Executions[1].GetExecution().GetRunId())
+	s.NotEmpty(resp.NextPageToken)
+
+	// next page continues in results of both clusters where the previous one stopped
+	req.NextPageToken = resp.NextPageToken
+	resp, err = s.handler.ListWorkflowExecutions(context.Background(), req)
+	s.NoError(err)
+	s.Len(resp.Executions, 2)
+	s.Equal("run-3", resp.Executions[0].GetExecution().GetRunId())
+	s.Equal("run-4", resp.Executions[1].GetExecution().GetRunId())
+	s.Empty(resp.NextPageToken)
+
+	// queries with custom order are not federated
+	_, ok := s.handler.visibilityFederationCluster(s.namespace, "WorkflowId = 'wid' ORDER BY CloseTime")
+	s.False(ok)
+
+	req.NextPageToken = []byte("invalid")
+	_, err = s.handler.ListWorkflowExecutions(context.Background(), req)
+	s.Equal(errInvalidFederatedPageToken, err)
+}
+
 func (s *dcRedirectionHandlerSuite) TestScanWorkflowExecutions() {
 	apiName := "ScanWorkflowExecutions"
 
@@ -878,6 +954,9 @@ func (serverHandler *testServerHandler) Watch(*healthpb.HealthCheckRequest, heal
 func (serverHandler *testServerHandler) UpdateHealthStatus(status HealthStatus) {
 }
 
+func (serverHandler *testServerHandler) DrainLongPolls() {
+}
+
 func (serverHandler *testServerHandler) GetResource() resource.Resource {
 	return nil
 }
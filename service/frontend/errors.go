@@ -50,6 +50,12 @@ var (
 	errQueryDisallowedForNamespace                        = serviceerror.NewInvalidArgument("Namespace is not allowed to query, please contact temporal team to re-enable queries.")
 	errClusterNameNotSet                                  = serviceerror.NewInvalidArgument("Cluster name is not set.")
 	errEmptyReplicationInfo                               = serviceerror.NewInvalidArgument("Replication task info is not set.")
+	errInvalidReplicationEventChunk                       = serviceerror.NewInvalidArgument("Invalid replication event chunk index or size.")
+	errReplayCheckExecutionsNotSet                        = serviceerror.NewInvalidArgument("Neither Query nor Executions is set on request.")
+	errTooManyReplayCheckWorkflows                        = serviceerror.NewInvalidArgument("Too many workflows to replay.")
+	errReplayCheckNotFound                                = serviceerror.NewNotFound("Replay check not found.")
+	errJobIDNotSet                                        = serviceerror.NewInvalidArgument("JobId is not set on request.")
+	errRegistrationIDNotSet                               = serviceerror.NewInvalidArgument("RegistrationId is not set on request.")
 	errHistoryNotFound                                    = serviceerror.NewInvalidArgument("Requested workflow history not found, may have passed retention period.")
 	errNamespaceTooLong                                   = serviceerror.NewInvalidArgument("Namespace length exceeds limit.")
 	errWorkflowTypeTooLong                                = serviceerror.NewInvalidArgument("WorkflowType length exceeds limit.")
@@ -79,14 +85,27 @@ var (
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
 	errTokenNamespaceMismatch                             = serviceerror.NewInvalidArgument("Operation requested with a token from a different namespace.")
+	errShardRebalanceTargetNotSet                         = serviceerror.NewInvalidArgument("Target is not set on request.")
+	errInvalidShardMovesPerMinute                         = serviceerror.NewInvalidArgument("MovesPerMinute must be positive.")
+	errShardRebalanceInEffect                             = serviceerror.NewInvalidArgument("Shard rebalance is in effect, cancel it and wait until its moves are undone.")
+	errUnknownTLSGroup                                    = serviceerror.NewInvalidArgument("Group must be either internode or frontend.")
+	errIntakeNamespaceMismatch                            = serviceerror.NewInvalidArgument("Namespace of the wrapped request must match namespace of the intake request.")
 	errShuttingDown                                       = serviceerror.NewInternal("Shutting down")
 
+	errClusterMetadataUpdateConflict = serviceerror.NewUnavailable("Cluster metadata was updated concurrently, retry the request.")
+
 	errFailedUpdateDynamicConfig = serviceerror.NewInternal("Failed to update dynamic config, err: %v.")
 	errFailedToCreateESIndex     = serviceerror.NewInternal("Failed to create ES index, err: %v.")
 	errFailedToUpdateESMapping   = serviceerror.NewInternal("Failed to update ES mapping, err: %v.")
 
-	errNoPermission = serviceerror.NewPermissionDenied("No permission to do this operation.")
-	errUnauthorized = serviceerror.NewPermissionDenied("Request unauthorized.")
+	errNoPermission                 = serviceerror.NewPermissionDenied("No permission to do this operation.")
+	errUnauthorized                 = serviceerror.NewPermissionDenied("Request unauthorized.")
+	errRawHistoryRequestsNotAllowed = serviceerror.NewPermissionDenied("Raw history requests are not allowed for namespace.")
+	errRawHistoryRedacted           = serviceerror.NewPermissionDenied("Raw history is not available to callers whose history is redacted.")
+	errAsyncIntakeNotAllowed        = serviceerror.NewPermissionDenied("Async intake is not allowed for namespace.")
 
-	errServiceBusy = serviceerror.NewResourceExhausted("Too many outstanding requests to the service.")
+	errServiceBusy              = serviceerror.NewResourceExhausted("Too many outstanding requests to the service.")
+	errTooManyPollsFromIdentity = serviceerror.NewResourceExhausted("Too many outstanding polls from the worker identity.")
+	errTooManyPollsFromIP       = serviceerror.NewResourceExhausted("Too many outstanding polls from the client IP.")
+	errCallerRateLimited        = serviceerror.NewResourceExhausted("Too many requests from the caller.")
 )
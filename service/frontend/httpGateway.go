@@ -0,0 +1,319 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// httpGatewayPathPrefix is followed by WorkflowService method name, e.g. /api/v1/workflowservice/StartWorkflowExecution
+	httpGatewayPathPrefix = "/api/v1/workflowservice/"
+	// httpGatewayMetadataHeaderPrefix prefixes names of HTTP response headers which carry gRPC response headers
+	httpGatewayMetadataHeaderPrefix = "Grpc-Metadata-"
+	// httpGatewayMaxRequestSize matches default max size of messages received by gRPC server
+	httpGatewayMaxRequestSize = 4 * 1024 * 1024
+	// httpGatewayReadHeaderTimeout bounds the time clients take to send request headers
+	httpGatewayReadHeaderTimeout = 10 * time.Second
+	// httpGatewayReadTimeout bounds the time clients take to send a whole request, long polls are not affected
+	// as the response is not covered by it
+	httpGatewayReadTimeout = 30 * time.Second
+	// httpGatewayIdleTimeout bounds the time keep-alive connections are kept open between requests
+	httpGatewayIdleTimeout = 2 * time.Minute
+
+	workflowServiceName = "temporal.api.workflowservice.v1.WorkflowService"
+)
+
+type (
+	// HTTPListenerProvider is implemented by RPC factories which create listener of HTTP/JSON gateway
+	HTTPListenerProvider interface {
+		GetFrontendHTTPListener() (net.Listener, error)
+	}
+
+	// httpGateway serves WorkflowService over HTTP/JSON. Each request is POST with JSON encoded request
+	// message in body, HTTP headers are passed as gRPC metadata and TLS connection state as peer info, so
+	// the request goes through the same interceptors as gRPC requests.
+	httpGateway struct {
+		handler     workflowservice.WorkflowServiceServer
+		interceptor grpc.UnaryServerInterceptor
+		methods     map[string]reflect.Value
+		encoder     *codec.JSONPBEncoder
+		logger      log.Logger
+	}
+
+	// httpGatewayError is JSON encoded in the body of error responses
+	httpGatewayError struct {
+		Code    codes.Code `json:"code"`
+		Message string     `json:"message"`
+	}
+
+	// httpTransportStream collects headers and trailers set by handler and interceptors
+	httpTransportStream struct {
+		method string
+		header metadata.MD
+	}
+
+	// httpGatewayAddr is the address of HTTP client
+	httpGatewayAddr string
+)
+
+var _ grpc.ServerTransportStream = (*httpTransportStream)(nil)
+
+// newHTTPGatewayServer creates the HTTP server of the gateway. Timeouts bound reading of requests so slow
+// clients cannot hold connections open, writing of responses is not bounded as long polls block for minutes.
+func newHTTPGatewayServer(
+	handler workflowservice.WorkflowServiceServer,
+	interceptors []grpc.UnaryServerInterceptor,
+	logger log.Logger,
+) *http.Server {
+	return &http.Server{
+		Handler:           newHTTPGateway(handler, interceptors, logger),
+		ReadHeaderTimeout: httpGatewayReadHeaderTimeout,
+		ReadTimeout:       httpGatewayReadTimeout,
+		IdleTimeout:       httpGatewayIdleTimeout,
+	}
+}
+
+func newHTTPGateway(
+	handler workflowservice.WorkflowServiceServer,
+	interceptors []grpc.UnaryServerInterceptor,
+	logger log.Logger,
+) *httpGateway {
+	contextType := reflect.TypeOf((*context.Context)(nil)).Elem()
+	messageType := reflect.TypeOf((*proto.Message)(nil)).Elem()
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+
+	methods := make(map[string]reflect.Value)
+	handlerValue := reflect.ValueOf(handler)
+	serviceType := reflect.TypeOf((*workflowservice.WorkflowServiceServer)(nil)).Elem()
+	for i := 0; i < serviceType.NumMethod(); i++ {
+		method := serviceType.Method(i)
+		if method.Type.NumIn() != 2 || method.Type.In(0) != contextType || !method.Type.In(1).Implements(messageType) ||
+			method.Type.NumOut() != 2 || !method.Type.Out(0).Implements(messageType) || method.Type.Out(1) != errorType {
+			continue
+		}
+		methods[method.Name] = handlerValue.MethodByName(method.Name)
+	}
+
+	return &httpGateway{
+		handler:     handler,
+		interceptor: chainUnaryInterceptors(interceptors),
+		methods:     methods,
+		encoder:     codec.NewJSONPBEncoder(),
+		logger:      logger,
+	}
+}
+
+func (g *httpGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, httpGatewayPathPrefix) {
+		http.NotFound(w, r)
+		return
+	}
+	methodName := strings.TrimPrefix(r.URL.Path, httpGatewayPathPrefix)
+	method, ok := g.methods[methodName]
+	if !ok {
+		g.writeError(w, serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown method %q.", methodName)), http.StatusNotFound)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		g.writeError(w, serviceerror.NewInvalidArgument("Only POST requests are supported."), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, httpGatewayMaxRequestSize))
+	if err != nil {
+		g.writeError(w, serviceerror.NewInvalidArgument(fmt.Sprintf("Unable to read request body: %v.", err)), 0)
+		return
+	}
+	request := reflect.New(method.Type().In(1).Elem()).Interface().(proto.Message)
+	if len(body) > 0 {
+		if err := g.encoder.Decode(body, request); err != nil {
+			g.writeError(w, serviceerror.NewInvalidArgument(fmt.Sprintf("Unable to decode request: %v.", err)), 0)
+			return
+		}
+	}
+
+	stream := &httpTransportStream{
+		method: "/" + workflowServiceName + "/" + methodName,
+		header: metadata.MD{},
+	}
+	ctx := grpc.NewContextWithServerTransportStream(g.requestContext(r), stream)
+	info := &grpc.UnaryServerInfo{Server: g.handler, FullMethod: stream.method}
+	response, err := g.interceptor(ctx, request, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		results := method.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)})
+		err, _ := results[1].Interface().(error)
+		return results[0].Interface(), err
+	})
+
+	for key, values := range stream.header {
+		for _, value := range values {
+			w.Header().Add(httpGatewayMetadataHeaderPrefix+key, value)
+		}
+	}
+	if err != nil {
+		g.writeError(w, err, 0)
+		return
+	}
+
+	data, err := g.encoder.Encode(response.(proto.Message))
+	if err != nil {
+		g.writeError(w, serviceerror.NewInternal(fmt.Sprintf("Unable to encode response: %v.", err)), 0)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		g.logger.Debug("Unable to write HTTP gateway response.", tag.Error(err))
+	}
+}
+
+// requestContext passes HTTP headers as incoming gRPC metadata, and client address and TLS state as gRPC peer
+func (g *httpGateway) requestContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		md.Append(key, values...)
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+
+	p := &peer.Peer{Addr: httpGatewayAddr(r.RemoteAddr)}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{
+			State:          *r.TLS,
+			CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		}
+	}
+	return peer.NewContext(ctx, p)
+}
+
+// writeError writes the error with HTTP status code of its gRPC code, unless status code is given
+func (g *httpGateway) writeError(w http.ResponseWriter, err error, statusCode int) {
+	st := serviceerror.ToStatus(err)
+	if statusCode == 0 {
+		statusCode = httpStatusFromCode(st.Code())
+	}
+
+	data, err := json.Marshal(&httpGatewayError{Code: st.Code(), Message: st.Message()})
+	if err != nil {
+		g.logger.Error("Unable to encode HTTP gateway error.", tag.Error(err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(data); err != nil {
+		g.logger.Debug("Unable to write HTTP gateway response.", tag.Error(err))
+	}
+}
+
+// httpStatusFromCode maps gRPC code to HTTP status code the same way as grpc-gateway
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// chainUnaryInterceptors chains interceptors the same way as grpc.ChainUnaryInterceptor, first one is outermost
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chained(ctx, req)
+	}
+}
+
+func (s *httpTransportStream) Method() string {
+	return s.method
+}
+
+func (s *httpTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *httpTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *httpTransportStream) SetTrailer(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (a httpGatewayAddr) Network() string {
+	return "tcp"
+}
+
+func (a httpGatewayAddr) String() string {
+	return string(a)
+}
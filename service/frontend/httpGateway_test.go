@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/api/workflowservicemock/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/log"
+)
+
+func newTestHTTPGateway(t *testing.T, interceptors ...grpc.UnaryServerInterceptor) (*httpGateway, *workflowservicemock.MockWorkflowServiceServer) {
+	handler := workflowservicemock.NewMockWorkflowServiceServer(gomock.NewController(t))
+	return newHTTPGateway(handler, interceptors, log.NewNoop()), handler
+}
+
+func serveHTTPGateway(gateway *httpGateway, method string, path string, body string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	request.Header.Set("Authorization", "Bearer token")
+	gateway.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestHTTPGateway(t *testing.T) {
+	var calls []string
+	interceptor := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			calls = append(calls, name+" "+info.FullMethod)
+			return handler(ctx, req)
+		}
+	}
+	gateway, handler := newTestHTTPGateway(t, interceptor("first"), interceptor("second"))
+
+	handler.EXPECT().DescribeNamespace(gomock.Any(), &workflowservice.DescribeNamespaceRequest{Namespace: "test-namespace"}).
+		DoAndReturn(func(ctx context.Context, _ *workflowservice.DescribeNamespaceRequest) (*workflowservice.DescribeNamespaceResponse, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			assert.Equal(t, []string{"Bearer token"}, md.Get("authorization"))
+			assert.NoError(t, grpc.SetHeader(ctx, metadata.Pairs("long-poll-timeout", "60s")))
+			return &workflowservice.DescribeNamespaceResponse{IsGlobalNamespace: true}, nil
+		})
+	recorder := serveHTTPGateway(gateway, http.MethodPost, "/api/v1/workflowservice/DescribeNamespace", `{"namespace":"test-namespace"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"isGlobalNamespace":true}`, recorder.Body.String())
+	assert.Equal(t, "60s", recorder.Header().Get("Grpc-Metadata-long-poll-timeout"))
+	assert.Equal(t, []string{
+		"first /temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace",
+		"second /temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace",
+	}, calls)
+}
+
+func TestHTTPGatewayErrors(t *testing.T) {
+	gateway, handler := newTestHTTPGateway(t)
+
+	handler.EXPECT().DescribeNamespace(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("namespace not found"))
+	recorder := serveHTTPGateway(gateway, http.MethodPost, "/api/v1/workflowservice/DescribeNamespace", `{}`)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	var gatewayErr httpGatewayError
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &gatewayErr))
+	assert.Equal(t, httpGatewayError{Code: codes.NotFound, Message: "namespace not found"}, gatewayErr)
+
+	recorder = serveHTTPGateway(gateway, http.MethodPost, "/api/v1/workflowservice/DescribeNamespace", `{"unknownField":1}`)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = serveHTTPGateway(gateway, http.MethodGet, "/api/v1/workflowservice/DescribeNamespace", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = serveHTTPGateway(gateway, http.MethodPost, "/api/v1/workflowservice/NoSuchMethod", `{}`)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = serveHTTPGateway(gateway, http.MethodPost, "/metrics", `{}`)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestHTTPGatewayServerTimeouts(t *testing.T) {
+	handler := workflowservicemock.NewMockWorkflowServiceServer(gomock.NewController(t))
+	server := newHTTPGatewayServer(handler, nil, log.NewNoop())
+	assert.IsType(t, &httpGateway{}, server.Handler)
+	assert.Equal(t, httpGatewayReadHeaderTimeout, server.ReadHeaderTimeout)
+	assert.Equal(t, httpGatewayReadTimeout, server.ReadTimeout)
+	assert.Equal(t, httpGatewayIdleTimeout, server.IdleTimeout)
+	// long polls write their response after minutes
+	assert.Zero(t, server.WriteTimeout)
+}
@@ -0,0 +1,147 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"go.temporal.io/server/api/intakeservice/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+var _ intakeservice.IntakeServiceServer = (*IntakeHandler)(nil)
+
+type (
+	// IntakeHandler accepts start and signal requests to the intake queue, from which intake processor of worker
+	// service applies them later. Requests are validated the same way as by WorkflowService. The outcome of
+	// an accepted request is looked up with its acceptance token through AdminService GetIntakeOutcome.
+	IntakeHandler struct {
+		workflowHandler *WorkflowHandler
+	}
+)
+
+// NewIntakeHandler creates a gRPC handler for the intakeservice
+func NewIntakeHandler(
+	workflowHandler *WorkflowHandler,
+) *IntakeHandler {
+	return &IntakeHandler{
+		workflowHandler: workflowHandler,
+	}
+}
+
+// AcceptStartWorkflowExecution accepts start request to the intake queue
+func (h *IntakeHandler) AcceptStartWorkflowExecution(
+	ctx context.Context,
+	request *intakeservice.AcceptStartWorkflowExecutionRequest,
+) (_ *intakeservice.AcceptStartWorkflowExecutionResponse, retError error) {
+	wh := h.workflowHandler
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendStartWorkflowExecutionScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if err := h.validateNamespace(request.GetNamespace(), request.GetRequest().GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	namespaceID, scope, err := wh.validateStartWorkflowExecutionRequest(ctx, request.GetRequest(), scope)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := h.enqueue(&persistence.IntakeMessage{
+		NamespaceID:  namespaceID,
+		StartRequest: request.GetRequest(),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &intakeservice.AcceptStartWorkflowExecutionResponse{AcceptanceToken: token}, nil
+}
+
+// AcceptSignalWorkflowExecution accepts signal request to the intake queue
+func (h *IntakeHandler) AcceptSignalWorkflowExecution(
+	ctx context.Context,
+	request *intakeservice.AcceptSignalWorkflowExecutionRequest,
+) (_ *intakeservice.AcceptSignalWorkflowExecutionResponse, retError error) {
+	wh := h.workflowHandler
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendSignalWorkflowExecutionScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if err := h.validateNamespace(request.GetNamespace(), request.GetRequest().GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	signalRequest := request.GetRequest()
+	namespaceID, err := wh.validateSignalWorkflowExecutionRequest(ctx, signalRequest, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// request ID makes replay of the signal by intake processor idempotent
+	if signalRequest.GetRequestId() == "" {
+		signalRequest.RequestId = uuid.New()
+	}
+	token, err := h.enqueue(&persistence.IntakeMessage{
+		NamespaceID:   namespaceID,
+		SignalRequest: signalRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &intakeservice.AcceptSignalWorkflowExecutionResponse{AcceptanceToken: token}, nil
+}
+
+// validateNamespace checks that the namespace the request is authorized for is the namespace of the wrapped
+// request and that the namespace allows async intake
+func (h *IntakeHandler) validateNamespace(
+	namespace string,
+	requestNamespace string,
+) error {
+
+	if namespace == "" {
+		return errNamespaceNotSet
+	}
+	if namespace != requestNamespace {
+		return errIntakeNamespaceMismatch
+	}
+	if !h.workflowHandler.config.EnableAsyncIntake(namespace) {
+		return errAsyncIntakeNotAllowed
+	}
+	return nil
+}
+
+func (h *IntakeHandler) enqueue(
+	message *persistence.IntakeMessage,
+) (string, error) {
+
+	message.EnqueueTime = time.Now().UTC()
+	return h.workflowHandler.GetIntakeQueue().Enqueue(message)
+}
@@ -46,6 +46,8 @@ type (
 		// UpdateHealthStatus sets the health status for this rpc handler.
 		// This health status will be used within the rpc health check handler
 		UpdateHealthStatus(status HealthStatus)
+		// DrainLongPolls completes outstanding long polls with empty responses when frontend shuts down
+		DrainLongPolls()
 
 		GetResource() resource.Resource
 		GetConfig() *Config
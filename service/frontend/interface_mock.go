@@ -151,6 +151,18 @@ func (mr *MockHandlerMockRecorder) DescribeWorkflowExecution(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DescribeWorkflowExecution), arg0, arg1)
 }
 
+// DrainLongPolls mocks base method.
+func (m *MockHandler) DrainLongPolls() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DrainLongPolls")
+}
+
+// DrainLongPolls indicates an expected call of DrainLongPolls.
+func (mr *MockHandlerMockRecorder) DrainLongPolls() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainLongPolls", reflect.TypeOf((*MockHandler)(nil).DrainLongPolls))
+}
+
 // GetClusterInfo mocks base method.
 func (m *MockHandler) GetClusterInfo(arg0 context.Context, arg1 *v1.GetClusterInfoRequest) (*v1.GetClusterInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	// namespaceQuotaUsage tracks rate of requests admitted by namespace rate limiter,
+	// so utilization of namespace quota can be reported before requests start being rejected
+	namespaceQuotaUsage struct {
+		timeSource clock.TimeSource
+
+		sync.RWMutex
+		windows map[string]*rateWindow
+	}
+
+	// rateWindow approximates rate over the last second from counters of the current and the previous second
+	rateWindow struct {
+		sync.Mutex
+		start    time.Time
+		current  int64
+		previous int64
+	}
+)
+
+func newNamespaceQuotaUsage(
+	timeSource clock.TimeSource,
+) *namespaceQuotaUsage {
+	return &namespaceQuotaUsage{
+		timeSource: timeSource,
+		windows:    make(map[string]*rateWindow),
+	}
+}
+
+// record counts one admitted request for namespace
+func (u *namespaceQuotaUsage) record(
+	namespace string,
+) {
+	u.getOrInitWindow(namespace).add(u.timeSource.Now())
+}
+
+// utilization returns rate of admitted requests for namespace relative to the given rate limit
+func (u *namespaceQuotaUsage) utilization(
+	namespace string,
+	rps float64,
+) float64 {
+	if rps <= 0 {
+		return 0
+	}
+	return u.getOrInitWindow(namespace).rate(u.timeSource.Now()) / rps
+}
+
+func (u *namespaceQuotaUsage) getOrInitWindow(
+	namespace string,
+) *rateWindow {
+	u.RLock()
+	window, ok := u.windows[namespace]
+	u.RUnlock()
+	if ok {
+		return window
+	}
+
+	u.Lock()
+	defer u.Unlock()
+
+	window, ok = u.windows[namespace]
+	if ok {
+		return window
+	}
+	window = &rateWindow{}
+	u.windows[namespace] = window
+	return window
+}
+
+func (w *rateWindow) add(
+	now time.Time,
+) {
+	w.Lock()
+	defer w.Unlock()
+
+	w.roll(now)
+	w.current++
+}
+
+func (w *rateWindow) rate(
+	now time.Time,
+) float64 {
+	w.Lock()
+	defer w.Unlock()
+
+	w.roll(now)
+	elapsed := float64(now.Sub(w.start)) / float64(time.Second)
+	return float64(w.previous)*(1-elapsed) + float64(w.current)
+}
+
+func (w *rateWindow) roll(
+	now time.Time,
+) {
+	second := now.Truncate(time.Second)
+	switch {
+	case second.Equal(w.start):
+	case second.Equal(w.start.Add(time.Second)):
+		w.previous = w.current
+		w.current = 0
+		w.start = second
+	default:
+		w.previous = 0
+		w.current = 0
+		w.start = second
+	}
+}
@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	namespaceQuotaUsageSuite struct {
+		suite.Suite
+
+		timeSource *clock.EventTimeSource
+		usage      *namespaceQuotaUsage
+	}
+)
+
+func TestNamespaceQuotaUsageSuite(t *testing.T) {
+	s := new(namespaceQuotaUsageSuite)
+	suite.Run(t, s)
+}
+
+func (s *namespaceQuotaUsageSuite) SetupTest() {
+	s.timeSource = clock.NewEventTimeSource()
+	s.timeSource.Update(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.usage = newNamespaceQuotaUsage(s.timeSource)
+}
+
+func (s *namespaceQuotaUsageSuite) TestUtilization_NoRequests() {
+	s.Equal(float64(0), s.usage.utilization("test-namespace", 100))
+}
+
+func (s *namespaceQuotaUsageSuite) TestUtilization_NoLimit() {
+	s.usage.record("test-namespace")
+	s.Equal(float64(0), s.usage.utilization("test-namespace", 0))
+}
+
+func (s *namespaceQuotaUsageSuite) TestUtilization_CurrentSecond() {
+	for i := 0; i < 80; i++ {
+		s.usage.record("test-namespace")
+	}
+	s.InDelta(0.8, s.usage.utilization("test-namespace", 100), 0.0001)
+	s.Equal(float64(0), s.usage.utilization("other-namespace", 100))
+}
+
+func (s *namespaceQuotaUsageSuite) TestUtilization_SlidingWindow() {
+	for i := 0; i < 100; i++ {
+		s.usage.record("test-namespace")
+	}
+
+	// half of the previous second is still within the window
+	s.timeSource.Update(s.timeSource.Now().Add(1500 * time.Millisecond))
+	for i := 0; i < 20; i++ {
+		s.usage.record("test-namespace")
+	}
+	s.InDelta(0.7, s.usage.utilization("test-namespace", 100), 0.0001)
+
+	// no requests in the last two seconds
+	s.timeSource.Update(s.timeSource.Now().Add(2 * time.Second))
+	s.Equal(float64(0), s.usage.utilization("test-namespace", 100))
+}
@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/authorization"
+)
+
+var (
+	errOperatorActionDenied     = serviceerror.NewPermissionDenied("Operator action requires admin role.")
+	errOperatorActionTypeNotSet = serviceerror.NewInvalidArgument("ActionType is not set on request.")
+)
+
+// validateOperatorAction validates that operator action request has the targets its action type needs.
+func validateOperatorAction(
+	request *adminservice.ApplyOperatorActionRequest,
+) error {
+
+	switch request.GetActionType() {
+	case enumsspb.OPERATOR_ACTION_TYPE_FIRE_TIMER:
+		if request.GetTimerId() == "" {
+			return serviceerror.NewInvalidArgument("TimerId is not set on request.")
+		}
+	case enumsspb.OPERATOR_ACTION_TYPE_RETRY_ACTIVITY:
+		if request.GetActivityId() == "" {
+			return serviceerror.NewInvalidArgument("ActivityId is not set on request.")
+		}
+	case enumsspb.OPERATOR_ACTION_TYPE_REROUTE_ACTIVITIES:
+		if request.GetActivityType() == "" {
+			return serviceerror.NewInvalidArgument("ActivityType is not set on request.")
+		}
+		if request.GetTaskQueue() == "" || request.GetTargetTaskQueue() == "" {
+			return serviceerror.NewInvalidArgument("TaskQueue and TargetTaskQueue are not set on request.")
+		}
+		if request.GetTaskQueue() == request.GetTargetTaskQueue() {
+			return serviceerror.NewInvalidArgument("TargetTaskQueue is the same as TaskQueue.")
+		}
+	case enumsspb.OPERATOR_ACTION_TYPE_UNSPECIFIED:
+		return errOperatorActionTypeNotSet
+	default:
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown operator action type %v.", request.GetActionType()))
+	}
+	if request.GetReason() != "" {
+		// reason is kept in operator annotation of the execution
+		return common.ValidateOperatorAnnotation(request.GetReason(), nil)
+	}
+	return nil
+}
+
+// authorizeOperator checks that caller has admin role on namespace and returns the authenticated subject, if any,
+// to be recorded as identity of the operator. Callers without authorization claims are denied.
+func authorizeOperator(
+	ctx context.Context,
+	namespace string,
+) (string, error) {
+	claims, ok := ctx.Value(authorization.ContextKeyMappedClaims).(*authorization.Claims)
+	if !ok || claims == nil {
+		return "", errOperatorActionDenied
+	}
+	role := claims.System | claims.Namespaces[namespace]
+	if role&authorization.RoleAdmin == 0 {
+		return "", errOperatorActionDenied
+	}
+	return claims.Subject, nil
+}
@@ -0,0 +1,85 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sync"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+)
+
+type (
+	// pollDrainer tracks long polls forwarded to matching, so they can be completed with empty responses
+	// when frontend shuts down, instead of being cut off with errors once connections are closed
+	pollDrainer struct {
+		sync.Mutex
+		polls    map[string]*matchingservice.CancelOutstandingPollRequest
+		draining bool
+	}
+)
+
+func newPollDrainer() *pollDrainer {
+	return &pollDrainer{
+		polls: make(map[string]*matchingservice.CancelOutstandingPollRequest),
+	}
+}
+
+// register adds outstanding poll identified by request used to cancel it, it returns false if frontend
+// is draining already and the poll should be completed right away
+func (d *pollDrainer) register(
+	request *matchingservice.CancelOutstandingPollRequest,
+) bool {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.draining {
+		return false
+	}
+	d.polls[request.GetPollerId()] = request
+	return true
+}
+
+// unregister removes outstanding poll registered before
+func (d *pollDrainer) unregister(
+	pollerID string,
+) {
+	d.Lock()
+	defer d.Unlock()
+
+	delete(d.polls, pollerID)
+}
+
+// drain stops registering polls and returns polls outstanding at the moment
+func (d *pollDrainer) drain() []*matchingservice.CancelOutstandingPollRequest {
+	d.Lock()
+	defer d.Unlock()
+
+	d.draining = true
+	polls := make([]*matchingservice.CancelOutstandingPollRequest, 0, len(d.polls))
+	for _, request := range d.polls {
+		polls = append(polls, request)
+	}
+	return polls
+}
@@ -0,0 +1,62 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/api/matchingservice/v1"
+)
+
+type (
+	pollDrainerSuite struct {
+		suite.Suite
+
+		drainer *pollDrainer
+	}
+)
+
+func TestPollDrainerSuite(t *testing.T) {
+	s := new(pollDrainerSuite)
+	suite.Run(t, s)
+}
+
+func (s *pollDrainerSuite) SetupTest() {
+	s.drainer = newPollDrainer()
+}
+
+func (s *pollDrainerSuite) TestDrain() {
+	poll1 := &matchingservice.CancelOutstandingPollRequest{PollerId: "poller-1"}
+	poll2 := &matchingservice.CancelOutstandingPollRequest{PollerId: "poller-2"}
+	s.True(s.drainer.register(poll1))
+	s.True(s.drainer.register(poll2))
+	s.drainer.unregister("poller-2")
+
+	s.Equal([]*matchingservice.CancelOutstandingPollRequest{poll1}, s.drainer.drain())
+	s.False(s.drainer.register(&matchingservice.CancelOutstandingPollRequest{PollerId: "poller-3"}))
+	s.Equal([]*matchingservice.CancelOutstandingPollRequest{poll1}, s.drainer.drain())
+}
@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sync"
+)
+
+type (
+	// pollerLimiter tracks outstanding long polls per key (i.e. worker identity or client IP),
+	// so a single worker deployment can't take the whole long poll capacity of frontend host
+	pollerLimiter struct {
+		sync.Mutex
+		pollers map[string]int
+	}
+)
+
+func newPollerLimiter() *pollerLimiter {
+	return &pollerLimiter{
+		pollers: make(map[string]int),
+	}
+}
+
+// acquire registers one more outstanding poll for key, it returns false
+// if key already has limit outstanding polls; limit <= 0 means no limit
+func (l *pollerLimiter) acquire(
+	key string,
+	limit int,
+) bool {
+	l.Lock()
+	defer l.Unlock()
+
+	if limit > 0 && l.pollers[key] >= limit {
+		return false
+	}
+	l.pollers[key]++
+	return true
+}
+
+// release unregisters outstanding poll for key acquired before
+func (l *pollerLimiter) release(
+	key string,
+) {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.pollers[key] <= 1 {
+		delete(l.pollers, key)
+		return
+	}
+	l.pollers[key]--
+}
@@ -0,0 +1,71 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	pollerLimiterSuite struct {
+		suite.Suite
+
+		limiter *pollerLimiter
+	}
+)
+
+func TestPollerLimiterSuite(t *testing.T) {
+	s := new(pollerLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *pollerLimiterSuite) SetupTest() {
+	s.limiter = newPollerLimiter()
+}
+
+func (s *pollerLimiterSuite) TestAcquireRelease() {
+	s.True(s.limiter.acquire("key", 2))
+	s.True(s.limiter.acquire("key", 2))
+	s.False(s.limiter.acquire("key", 2))
+	s.True(s.limiter.acquire("other-key", 2))
+
+	s.limiter.release("key")
+	s.True(s.limiter.acquire("key", 2))
+}
+
+func (s *pollerLimiterSuite) TestNoLimit() {
+	for i := 0; i < 100; i++ {
+		s.True(s.limiter.acquire("key", 0))
+	}
+	s.Equal(100, s.limiter.pollers["key"])
+}
+
+func (s *pollerLimiterSuite) TestRelease_RemovesKey() {
+	s.True(s.limiter.acquire("key", 1))
+	s.limiter.release("key")
+	s.NotContains(s.limiter.pollers, "key")
+}
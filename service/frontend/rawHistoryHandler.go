@@ -0,0 +1,160 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/rawhistoryservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence/versionhistory"
+)
+
+var _ rawhistoryservice.RawHistoryServiceServer = (*RawHistoryHandler)(nil)
+
+type (
+	// RawHistoryHandler serves raw workflow histories to callers of namespace, e.g. SDK replayers. Histories
+	// are read the same way as by AdminService GetWorkflowExecutionRawHistoryV2.
+	RawHistoryHandler struct {
+		config       *Config
+		adminHandler *AdminHandler
+	}
+)
+
+// NewRawHistoryHandler creates a gRPC handler for the rawhistoryservice
+func NewRawHistoryHandler(
+	config *Config,
+	adminHandler *AdminHandler,
+) *RawHistoryHandler {
+	return &RawHistoryHandler{
+		config:       config,
+		adminHandler: adminHandler,
+	}
+}
+
+// GetWorkflowExecutionRawHistory returns raw history of workflow execution on the current branch,
+// or on the branch which contains the requested events
+func (h *RawHistoryHandler) GetWorkflowExecutionRawHistory(
+	ctx context.Context,
+	request *rawhistoryservice.GetWorkflowExecutionRawHistoryRequest,
+) (*rawhistoryservice.GetWorkflowExecutionRawHistoryResponse, error) {
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if !h.config.AllowRawWorkflowHistoryRequests(request.GetNamespace()) {
+		return nil, errRawHistoryRequestsNotAllowed
+	}
+	// raw history can't be redacted, callers whose responses are redacted read history through WorkflowService
+	if getRedactionRules(ctx, h.config, request.GetNamespace()) != nil {
+		return nil, errRawHistoryRedacted
+	}
+	if request.GetExecution().GetWorkflowId() == "" {
+		return nil, errWorkflowIDNotSet
+	}
+
+	adminRequest := &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+		Namespace:         request.GetNamespace(),
+		Execution:         request.GetExecution(),
+		StartEventId:      request.GetStartEventId(),
+		StartEventVersion: request.GetStartEventVersion(),
+		EndEventId:        request.GetEndEventId(),
+		EndEventVersion:   request.GetEndEventVersion(),
+		MaximumPageSize:   request.GetMaximumPageSize(),
+		NextPageToken:     request.GetNextPageToken(),
+	}
+	if request.GetStartEventId() == 0 && request.GetStartEventVersion() == 0 {
+		adminRequest.StartEventId = common.EmptyEventID
+		adminRequest.StartEventVersion = common.EmptyVersion
+	}
+	if request.GetEndEventId() == 0 && request.GetEndEventVersion() == 0 {
+		adminRequest.EndEventId = common.EmptyEventID
+		adminRequest.EndEventVersion = common.EmptyVersion
+	}
+
+	// first page of the current run or of the whole current branch needs the run ID and the end
+	// of the branch, following pages carry both in the page token
+	if len(request.GetNextPageToken()) == 0 &&
+		(request.GetExecution().GetRunId() == "" || adminRequest.GetEndEventId() == common.EmptyEventID) {
+		if err := h.setCurrentExecutionAndEndEvent(ctx, adminRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := h.adminHandler.GetWorkflowExecutionRawHistoryV2(ctx, adminRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &rawhistoryservice.GetWorkflowExecutionRawHistoryResponse{
+		NextPageToken:  response.GetNextPageToken(),
+		HistoryBatches: response.GetHistoryBatches(),
+		VersionHistory: response.GetVersionHistory(),
+	}, nil
+}
+
+func (h *RawHistoryHandler) setCurrentExecutionAndEndEvent(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+) error {
+
+	namespaceID, err := h.adminHandler.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return err
+	}
+	response, err := h.adminHandler.GetHistoryClient().GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: namespaceID,
+		Execution:   request.GetExecution(),
+	})
+	if err != nil {
+		return err
+	}
+	request.Execution = &commonpb.WorkflowExecution{
+		WorkflowId: request.GetExecution().GetWorkflowId(),
+		RunId:      response.GetExecution().GetRunId(),
+	}
+
+	if request.GetEndEventId() != common.EmptyEventID {
+		return nil
+	}
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(response.GetVersionHistories())
+	if err != nil {
+		return err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return err
+	}
+	// range is exclusive
+	request.EndEventId = lastItem.GetEventId() + 1
+	request.EndEventVersion = lastItem.GetVersion()
+	return nil
+}
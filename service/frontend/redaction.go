@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/authorization"
+)
+
+const (
+	// redactionActionStrip drops the payload data
+	redactionActionStrip = "strip"
+	// redactionActionHash replaces the payload data with its hex encoded HMAC-SHA256 keyed with the redaction
+	// hash key of server config, so that equal payloads can still be correlated but not guessed. Payloads are
+	// stripped instead if no key is configured.
+	redactionActionHash = "hash"
+
+	redactedPayloadEncoding = "binary/redacted"
+)
+
+var (
+	payloadType          = reflect.TypeOf((*commonpb.Payload)(nil))
+	payloadsType         = reflect.TypeOf((*commonpb.Payloads)(nil))
+	headerType           = reflect.TypeOf((*commonpb.Header)(nil))
+	memoType             = reflect.TypeOf((*commonpb.Memo)(nil))
+	searchAttributesType = reflect.TypeOf((*commonpb.SearchAttributes)(nil))
+)
+
+type (
+	// redactionRules are the redaction actions applied to the payloads of a response
+	redactionRules struct {
+		// actions maps lower case field name to redaction action. Field name is the name of payload field
+		// in API messages, e.g. "input", "result" or "details", a single header, memo or search attribute is
+		// addressed by its key, e.g. "header.auth-token", or all of them by "header", "memo" or "searchattributes".
+		actions map[string]string
+		hashKey []byte
+	}
+)
+
+// redactResponse strips or hashes payloads of response returned to callers without admin or worker role,
+// according to the redaction rules configured for namespace. Persisted data is left intact.
+func (wh *WorkflowHandler) redactResponse(ctx context.Context, namespace string, response interface{}) {
+	if rules := getRedactionRules(ctx, wh.config, namespace); rules != nil {
+		rules.redact(reflect.ValueOf(response), "")
+	}
+}
+
+// getRedactionRules returns redaction rules applied to the caller, nil if payloads are returned as is
+func getRedactionRules(ctx context.Context, frontendConfig *Config, namespace string) *redactionRules {
+	config := frontendConfig.RedactionRules(namespace)
+	if len(config) == 0 || !redactionApplies(ctx, namespace) {
+		return nil
+	}
+
+	actions := make(map[string]string, len(config))
+	for field, action := range config {
+		if action, ok := action.(string); ok && (action == redactionActionStrip || action == redactionActionHash) {
+			actions[strings.ToLower(field)] = action
+		}
+	}
+	if len(actions) == 0 {
+		return nil
+	}
+	return &redactionRules{
+		actions: actions,
+		hashKey: frontendConfig.RedactionHashKey,
+	}
+}
+
+// redactionApplies returns true if caller has no admin or worker role, workers need payloads to replay workflows.
+// Without authorization claims callers can't be told apart, so nothing is redacted.
+func redactionApplies(ctx context.Context, namespace string) bool {
+	claims, ok := ctx.Value(authorization.ContextKeyMappedClaims).(*authorization.Claims)
+	if !ok || claims == nil {
+		return false
+	}
+	role := claims.System | claims.Namespaces[namespace]
+	return role&(authorization.RoleAdmin|authorization.RoleWorker) == 0
+}
+
+func (r *redactionRules) redact(value reflect.Value, field string) {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return
+		}
+		switch value.Type() {
+		case payloadType:
+			r.redactPayload(value.Interface().(*commonpb.Payload), r.actions[field])
+			return
+		case payloadsType:
+			for _, payload := range value.Interface().(*commonpb.Payloads).GetPayloads() {
+				r.redactPayload(payload, r.actions[field])
+			}
+			return
+		case headerType:
+			r.redactPayloadMap("header", value.Interface().(*commonpb.Header).GetFields())
+			return
+		case memoType:
+			r.redactPayloadMap("memo", value.Interface().(*commonpb.Memo).GetFields())
+			return
+		case searchAttributesType:
+			r.redactPayloadMap("searchattributes", value.Interface().(*commonpb.SearchAttributes).GetIndexedFields())
+			return
+		}
+		r.redact(value.Elem(), field)
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).PkgPath != "" {
+				// unexported field
+				continue
+			}
+			r.redact(value.Field(i), strings.ToLower(value.Type().Field(i).Name))
+		}
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < value.Len(); i++ {
+			r.redact(value.Index(i), field)
+		}
+	case reflect.Map:
+		iter := value.MapRange()
+		for iter.Next() {
+			r.redact(iter.Value(), field)
+		}
+	}
+}
+
+func (r *redactionRules) redactPayloadMap(field string, payloads map[string]*commonpb.Payload) {
+	for key, payload := range payloads {
+		action, ok := r.actions[field+"."+strings.ToLower(key)]
+		if !ok {
+			action = r.actions[field]
+		}
+		r.redactPayload(payload, action)
+	}
+}
+
+func (r *redactionRules) redactPayload(payload *commonpb.Payload, action string) {
+	if payload == nil {
+		return
+	}
+
+	if action == redactionActionHash && len(r.hashKey) == 0 {
+		action = redactionActionStrip
+	}
+	switch action {
+	case redactionActionStrip:
+		payload.Data = nil
+	case redactionActionHash:
+		mac := hmac.New(sha256.New, r.hashKey)
+		_, _ = mac.Write(payload.Data)
+		payload.Data = []byte(hex.EncodeToString(mac.Sum(nil)))
+	default:
+		return
+	}
+	payload.Metadata = map[string][]byte{"encoding": []byte(redactedPayloadEncoding)}
+}
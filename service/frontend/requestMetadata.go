@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/payload"
+)
+
+// propagateRequestMetadata captures values of configured gRPC request headers into workflow header and memo,
+// so workflows can be tied back to the systems which started them. propagatedHeaders maps gRPC header name
+// to the key used in header and memo, empty key means header name is used as is. Keys already set by the
+// client are never overwritten.
+func propagateRequestMetadata(
+	ctx context.Context,
+	propagatedHeaders map[string]interface{},
+	header *commonpb.Header,
+	memo *commonpb.Memo,
+) (*commonpb.Header, *commonpb.Memo) {
+	if len(propagatedHeaders) == 0 {
+		return header, memo
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return header, memo
+	}
+
+	for headerName, key := range propagatedHeaders {
+		values := md.Get(headerName)
+		if len(values) == 0 {
+			continue
+		}
+		fieldName, ok := key.(string)
+		if !ok || fieldName == "" {
+			fieldName = strings.ToLower(headerName)
+		}
+		value := payload.EncodeString(strings.Join(values, ","))
+
+		if header == nil {
+			header = &commonpb.Header{}
+		}
+		if header.Fields == nil {
+			header.Fields = make(map[string]*commonpb.Payload)
+		}
+		if _, ok := header.Fields[fieldName]; !ok {
+			header.Fields[fieldName] = value
+		}
+
+		if memo == nil {
+			memo = &commonpb.Memo{}
+		}
+		if memo.Fields == nil {
+			memo.Fields = make(map[string]*commonpb.Payload)
+		}
+		if _, ok := memo.Fields[fieldName]; !ok {
+			memo.Fields[fieldName] = value
+		}
+	}
+	return header, memo
+}
@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/payload"
+)
+
+type (
+	requestMetadataSuite struct {
+		suite.Suite
+	}
+)
+
+func TestRequestMetadataSuite(t *testing.T) {
+	s := new(requestMetadataSuite)
+	suite.Run(t, s)
+}
+
+func (s *requestMetadataSuite) TestPropagate_NotConfigured() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-source", "billing"))
+	header, memo := propagateRequestMetadata(ctx, nil, nil, nil)
+	s.Nil(header)
+	s.Nil(memo)
+}
+
+func (s *requestMetadataSuite) TestPropagate_NoMetadata() {
+	header, memo := propagateRequestMetadata(context.Background(), map[string]interface{}{"x-request-source": ""}, nil, nil)
+	s.Nil(header)
+	s.Nil(memo)
+}
+
+func (s *requestMetadataSuite) TestPropagate() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-request-source", "billing",
+		"x-correlation-id", "id-1",
+		"x-correlation-id", "id-2",
+		"x-other", "ignored",
+	))
+	propagated := map[string]interface{}{
+		"x-request-source": "",
+		"x-correlation-id": "correlationId",
+		"x-missing":        "missing",
+	}
+
+	header, memo := propagateRequestMetadata(ctx, propagated, nil, nil)
+	s.Equal(map[string]*commonpb.Payload{
+		"x-request-source": payload.EncodeString("billing"),
+		"correlationId":    payload.EncodeString("id-1,id-2"),
+	}, header.Fields)
+	s.Equal(header.Fields, memo.Fields)
+}
+
+func (s *requestMetadataSuite) TestPropagate_ClientValuesNotOverwritten() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-source", "billing"))
+	header := &commonpb.Header{Fields: map[string]*commonpb.Payload{"source": payload.EncodeString("header")}}
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{"source": payload.EncodeString("memo")}}
+
+	header, memo = propagateRequestMetadata(ctx, map[string]interface{}{"x-request-source": "source"}, header, memo)
+	s.Equal(payload.EncodeString("header"), header.Fields["source"])
+	s.Equal(payload.EncodeString("memo"), memo.Fields["source"])
+}
@@ -0,0 +1,239 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/runchainservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	runInitiatorStart         = "start"
+	runInitiatorContinueAsNew = "continue-as-new"
+	runInitiatorRetry         = "retry"
+	runInitiatorCron          = "cron"
+	runInitiatorReset         = "reset"
+)
+
+var _ runchainservice.RunChainServiceServer = (*RunChainHandler)(nil)
+
+type (
+	// RunChainHandler reports chain of runs of a workflow. Runs are listed from visibility and each of them is
+	// linked to the run it follows with its started event, or with the reset base run recorded by history.
+	RunChainHandler struct {
+		workflowHandler *WorkflowHandler
+	}
+)
+
+// NewRunChainHandler creates a gRPC handler for the runchainservice
+func NewRunChainHandler(
+	workflowHandler *WorkflowHandler,
+) *RunChainHandler {
+	return &RunChainHandler{
+		workflowHandler: workflowHandler,
+	}
+}
+
+// GetRunChain returns most recent runs of the workflow ordered from the oldest to the most recent one
+func (h *RunChainHandler) GetRunChain(
+	ctx context.Context,
+	request *runchainservice.GetRunChainRequest,
+) (_ *runchainservice.GetRunChainResponse, retError error) {
+	wh := h.workflowHandler
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendDescribeWorkflowExecutionScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, wh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetWorkflowId() == "" {
+		return nil, wh.error(errWorkflowIDNotSet, scope)
+	}
+	maxLength := int(request.GetMaximumLength())
+	if maxLength <= 0 {
+		return nil, wh.error(serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid run chain length %v.", maxLength)), scope)
+	}
+	if limit := wh.config.RunChainMaxLength(request.GetNamespace()); maxLength > limit {
+		maxLength = limit
+	}
+
+	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	response, err := h.getRunChain(ctx, scope, request.GetNamespace(), namespaceID, request.GetWorkflowId(), maxLength)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return response, nil
+}
+
+func (h *RunChainHandler) getRunChain(
+	ctx context.Context,
+	scope metrics.Scope,
+	namespace string,
+	namespaceID string,
+	workflowID string,
+	maxLength int,
+) (*runchainservice.GetRunChainResponse, error) {
+	executions, truncated, err := h.listRuns(namespace, namespaceID, workflowID, maxLength)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &runchainservice.GetRunChainResponse{Truncated: truncated}
+	for _, execution := range executions {
+		link := &runchainservice.RunChainLink{
+			RunId:     execution.GetExecution().GetRunId(),
+			Status:    execution.GetStatus(),
+			StartTime: execution.GetStartTime(),
+			CloseTime: execution.GetCloseTime(),
+		}
+		if err := h.linkRun(ctx, scope, namespaceID, execution.GetExecution(), link); err != nil {
+			link.Error = err.Error()
+		}
+		response.Runs = append(response.Runs, link)
+	}
+	return response, nil
+}
+
+// listRuns lists most recent runs of the workflow from visibility ordered by start time. Reset run starts at
+// the same time as the run it resets, which closes before the reset run does, so close time breaks the tie.
+func (h *RunChainHandler) listRuns(
+	namespace string,
+	namespaceID string,
+	workflowID string,
+	maxLength int,
+) ([]*workflowpb.WorkflowExecutionInfo, bool, error) {
+	request := &persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+		ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+			NamespaceID:       namespaceID,
+			Namespace:         namespace,
+			EarliestStartTime: 0,
+			LatestStartTime:   time.Now().UTC().UnixNano(),
+			PageSize:          maxLength + 1,
+		},
+		WorkflowID: workflowID,
+	}
+	open, err := h.workflowHandler.GetVisibilityManager().ListOpenWorkflowExecutionsByWorkflowID(request)
+	if err != nil {
+		return nil, false, err
+	}
+	closed, err := h.workflowHandler.GetVisibilityManager().ListClosedWorkflowExecutionsByWorkflowID(request)
+	if err != nil {
+		return nil, false, err
+	}
+
+	executions := append(closed.Executions, open.Executions...)
+	sort.SliceStable(executions, func(i, j int) bool {
+		startI, startJ := timestampOf(executions[i].GetStartTime()), timestampOf(executions[j].GetStartTime())
+		if !startI.Equal(startJ) {
+			return startI.Before(startJ)
+		}
+		closeI, closeJ := executions[i].GetCloseTime(), executions[j].GetCloseTime()
+		if closeI == nil || closeJ == nil {
+			return closeJ == nil && closeI != nil
+		}
+		return closeI.Before(*closeJ)
+	})
+
+	truncated := len(executions) > maxLength ||
+		len(open.NextPageToken) > 0 || len(closed.NextPageToken) > 0
+	if len(executions) > maxLength {
+		executions = executions[len(executions)-maxLength:]
+	}
+	return executions, truncated, nil
+}
+
+// linkRun finds how the run was started. Reset run shares started event with the run it resets, so
+// history records its base run in mutable state.
+func (h *RunChainHandler) linkRun(
+	ctx context.Context,
+	scope metrics.Scope,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+	link *runchainservice.RunChainLink,
+) error {
+	mutableState, err := h.workflowHandler.GetHistoryClient().GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: namespaceID,
+		Execution:   execution,
+	})
+	if err != nil {
+		return err
+	}
+	if baseRunID := mutableState.GetResetBaseRunId(); baseRunID != "" {
+		link.Initiator = runInitiatorReset
+		link.PreviousRunId = baseRunID
+		return nil
+	}
+
+	history, _, err := h.workflowHandler.getHistory(scope, namespaceID, *execution, common.FirstEventID, mutableState.GetNextEventId(),
+		1, nil, nil, mutableState.GetCurrentBranchToken())
+	if err != nil {
+		return err
+	}
+	if len(history.GetEvents()) == 0 || history.Events[0].GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED {
+		return serviceerror.NewInternal("Run history does not start with workflow execution started event.")
+	}
+	attributes := history.Events[0].GetWorkflowExecutionStartedEventAttributes()
+	link.PreviousRunId = attributes.GetContinuedExecutionRunId()
+	switch attributes.GetInitiator() {
+	case enumspb.CONTINUE_AS_NEW_INITIATOR_WORKFLOW:
+		link.Initiator = runInitiatorContinueAsNew
+	case enumspb.CONTINUE_AS_NEW_INITIATOR_RETRY:
+		link.Initiator = runInitiatorRetry
+	case enumspb.CONTINUE_AS_NEW_INITIATOR_CRON_SCHEDULE:
+		link.Initiator = runInitiatorCron
+	default:
+		link.Initiator = runInitiatorStart
+	}
+	return nil
+}
+
+func timestampOf(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
@@ -0,0 +1,130 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/searchattributesservice/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/elasticsearch/validator"
+	"go.temporal.io/server/common/resource"
+)
+
+var _ searchattributesservice.SearchAttributesServiceServer = (*SearchAttributesHandler)(nil)
+
+var (
+	errSearchAttributesUpsertDisabled = serviceerror.NewPermissionDenied("Search attributes upsert is disabled for namespace.")
+	errSearchAttributesUpsertDenied   = serviceerror.NewPermissionDenied("Search attributes upsert requires writer role.")
+	errSearchAttributesUpsertEmpty    = serviceerror.NewInvalidArgument("Search attributes upsert has no search attributes.")
+)
+
+type (
+	// SearchAttributesHandler lets external systems upsert search attributes of running workflows, e.g. to tag
+	// executions for review, without involving the workflow. History keeps the attributes in mutable state only.
+	SearchAttributesHandler struct {
+		resource.Resource
+
+		config                    *Config
+		searchAttributesValidator *validator.SearchAttributesValidator
+	}
+)
+
+// NewSearchAttributesHandler creates a gRPC handler for the searchattributesservice
+func NewSearchAttributesHandler(
+	resource resource.Resource,
+	config *Config,
+) *SearchAttributesHandler {
+	return &SearchAttributesHandler{
+		Resource: resource,
+		config:   config,
+		searchAttributesValidator: validator.NewSearchAttributesValidator(
+			resource.GetLogger(),
+			config.ValidSearchAttributes,
+			config.SearchAttributesNumberOfKeysLimit,
+			config.SearchAttributesSizeOfValueLimit,
+			config.SearchAttributesTotalSizeLimit,
+		),
+	}
+}
+
+// UpsertWorkflowSearchAttributes upserts search attributes of running workflow execution. It is opt-in per
+// namespace and, when authorization claims are present, requires writer role.
+func (h *SearchAttributesHandler) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	request *searchattributesservice.UpsertWorkflowSearchAttributesRequest,
+) (*searchattributesservice.UpsertWorkflowSearchAttributesResponse, error) {
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := h.validateUpsert(ctx, request); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := h.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.GetHistoryClient().UpsertWorkflowSearchAttributes(ctx, &historyservice.UpsertWorkflowSearchAttributesRequest{
+		NamespaceId: namespaceID,
+		Request:     request,
+	}); err != nil {
+		return nil, err
+	}
+	return &searchattributesservice.UpsertWorkflowSearchAttributesResponse{}, nil
+}
+
+func (h *SearchAttributesHandler) validateUpsert(
+	ctx context.Context,
+	request *searchattributesservice.UpsertWorkflowSearchAttributesRequest,
+) error {
+
+	namespace := request.GetNamespace()
+	if namespace == "" {
+		return errNamespaceNotSet
+	}
+	if !h.config.EnableSearchAttributesUpsert(namespace) {
+		return errSearchAttributesUpsertDisabled
+	}
+	if request.GetExecution().GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+
+	if claims, ok := ctx.Value(authorization.ContextKeyMappedClaims).(*authorization.Claims); ok && claims != nil {
+		role := claims.System | claims.Namespaces[namespace]
+		if role&(authorization.RoleAdmin|authorization.RoleWriter) == 0 {
+			return errSearchAttributesUpsertDenied
+		}
+	}
+
+	if len(request.GetSearchAttributes().GetIndexedFields()) == 0 {
+		return errSearchAttributesUpsertEmpty
+	}
+	return h.searchAttributesValidator.ValidateSearchAttributes(request.GetSearchAttributes(), namespace)
+}
@@ -25,19 +25,26 @@
 package frontend
 
 import (
+	"context"
+	"net/http"
 	"os"
 	"sync/atomic"
 	"time"
 
 	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/grpc"
-	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/intakeservice/v1"
+	"go.temporal.io/server/api/rawhistoryservice/v1"
+	"go.temporal.io/server/api/runchainservice/v1"
+	"go.temporal.io/server/api/searchattributesservice/v1"
+	"go.temporal.io/server/api/workflowreportservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/healthcheck"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/messaging"
@@ -54,6 +61,7 @@ import (
 // Config represents configuration for frontend service
 type Config struct {
 	NumHistoryShards           int32
+	ClusterStores              ClusterStores
 	PersistenceMaxQPS          dynamicconfig.IntPropertyFn
 	PersistenceGlobalMaxQPS    dynamicconfig.IntPropertyFn
 	VisibilityMaxPageSize      dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -71,15 +79,46 @@ type Config struct {
 	MinRetentionDays           dynamicconfig.IntPropertyFn
 	DisallowQuery              dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ShutdownDrainDuration      dynamicconfig.DurationPropertyFn
+	// ShutdownRequestDrainDuration is the max time in-flight requests are given to complete during shutdown
+	ShutdownRequestDrainDuration dynamicconfig.DurationPropertyFn
+
+	// NamespaceQuotaWarningThreshold is the namespace rate limit utilization at which start responses carry a warning
+	NamespaceQuotaWarningThreshold dynamicconfig.FloatPropertyFnWithNamespaceFilter
+
+	// MaxPollersPerIdentity and MaxPollersPerIP limit concurrent long polls of a single worker deployment
+	MaxPollersPerIdentity dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxPollersPerIP       dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+	// PropagatedRequestHeaders maps gRPC request headers to workflow header and memo keys
+	PropagatedRequestHeaders dynamicconfig.MapPropertyFnWithNamespaceFilter
 
 	MaxBadBinaries dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// EnableArchivalURIProbe is whether archival URIs are probed end-to-end when archival is enabled for a namespace
+	EnableArchivalURIProbe dynamicconfig.BoolPropertyFn
+
+	// EnableSearchAttributesUpsert is whether external callers can upsert search attributes of running workflows
+	EnableSearchAttributesUpsert dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+	// VisibilityConsistencyMaxWait is the max time ListWorkflowExecutions waits for the visibility record
+	// referenced by the visibility consistency token of the request, zero disables the wait
+	VisibilityConsistencyMaxWait dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// EnableVisibilityFederation is whether ListWorkflowExecutions of global namespace active in other cluster
+	// also queries visibility of the active cluster and merges results of both clusters
+	EnableVisibilityFederation dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
 	// security protection settings
 	DisableListVisibilityByFilter dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// size limit system protection
-	BlobSizeLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
-	BlobSizeLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	BlobSizeLimitError     dynamicconfig.IntPropertyFnWithNamespaceFilter
+	BlobSizeLimitWarn      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	BlobSizeLimitOverrides dynamicconfig.MapPropertyFnWithNamespaceFilter
+
+	// FailureMessageSizeLimit is the size messages of reported failures are truncated to, unlimited if zero
+	FailureMessageSizeLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
+	// FailureStackTraceSizeLimit is the size stack traces of reported failures are truncated to, unlimited if zero
+	FailureStackTraceSizeLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 
@@ -99,7 +138,32 @@ type Config struct {
 	// VisibilityArchival system protection
 	VisibilityArchivalQueryMaxPageSize dynamicconfig.IntPropertyFn
 
-	SendRawWorkflowHistory dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	SendRawWorkflowHistory          dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	AllowRawWorkflowHistoryRequests dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+	// Long poll timeouts advertised to clients, zero keeps the caller deadline
+	PollWorkflowTaskQueueTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	PollActivityTaskQueueTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	GetHistoryLongPollTimeout    dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
+	// RedactionRules are the payload redaction rules applied to read APIs for callers without admin or worker role
+	RedactionRules dynamicconfig.MapPropertyFnWithNamespaceFilter
+	// RedactionHashKey is the secret key of the HMAC payloads redacted with hash action are replaced with
+	RedactionHashKey []byte
+
+	// Protocol features advertised to clients in GetClusterInfo response
+	FailureDetailEncodings dynamicconfig.StringPropertyFn
+
+	// RunChainMaxLength is the max number of runs reported in run chain of a workflow
+	RunChainMaxLength dynamicconfig.IntPropertyFnWithNamespaceFilter
+
+	// Rate limits per caller identity, in total and per API
+	CallerRPS          dynamicconfig.IntPropertyFn
+	CallerRPSOverrides dynamicconfig.MapPropertyFn
+	CallerAPIRPS       dynamicconfig.MapPropertyFn
+
+	// EnableAsyncIntake is whether start and signal requests may be accepted to the intake queue
+	EnableAsyncIntake dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// DefaultWorkflowTaskTimeout the default workflow task timeout
 	DefaultWorkflowTaskTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
@@ -127,13 +191,25 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, enableReadF
 		RPS:                                    dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
 		MaxNamespaceRPSPerInstance:             dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceRPSPerInstance, 1200),
 		GlobalNamespaceRPS:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalNamespaceRPS, 0),
+		NamespaceQuotaWarningThreshold:         dc.GetFloat64PropertyFilteredByNamespace(dynamicconfig.FrontendNamespaceQuotaWarningThreshold, 0.8),
+		MaxPollersPerIdentity:                  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxPollersPerIdentity, 0),
+		MaxPollersPerIP:                        dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxPollersPerIP, 0),
+		PropagatedRequestHeaders:               dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.FrontendPropagatedRequestHeaders, map[string]interface{}{}),
 		MaxIDLengthLimit:                       dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		MaxBadBinaries:                         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxBadBinaries, namespace.MaxBadBinaries),
+		EnableArchivalURIProbe:                 dc.GetBoolProperty(dynamicconfig.FrontendEnableArchivalURIProbe, false),
+		EnableSearchAttributesUpsert:           dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableSearchAttributesUpsert, false),
+		VisibilityConsistencyMaxWait:           dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.FrontendVisibilityConsistencyMaxWait, 5*time.Second),
+		EnableVisibilityFederation:             dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableVisibilityFederation, false),
 		DisableListVisibilityByFilter:          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisableListVisibilityByFilter, false),
 		BlobSizeLimitError:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 256*1024),
+		BlobSizeLimitOverrides:                 dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.BlobSizeLimitOverrides, map[string]interface{}{}),
+		FailureMessageSizeLimit:                dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FailureMessageSizeLimit, 0),
+		FailureStackTraceSizeLimit:             dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FailureStackTraceSizeLimit, 0),
 		ThrottledLogRPS:                        dc.GetIntProperty(dynamicconfig.FrontendThrottledLogRPS, 20),
 		ShutdownDrainDuration:                  dc.GetDurationProperty(dynamicconfig.FrontendShutdownDrainDuration, 0),
+		ShutdownRequestDrainDuration:           dc.GetDurationProperty(dynamicconfig.FrontendShutdownRequestDrainDuration, time.Second),
 		EnableNamespaceNotActiveAutoForwarding: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableNamespaceNotActiveAutoForwarding, true),
 		EnableClientVersionCheck:               dc.GetBoolProperty(dynamicconfig.EnableClientVersionCheck, true),
 		ValidSearchAttributes:                  dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
@@ -144,6 +220,17 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, enableReadF
 		VisibilityArchivalQueryMaxPageSize:     dc.GetIntProperty(dynamicconfig.VisibilityArchivalQueryMaxPageSize, 10000),
 		DisallowQuery:                          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisallowQuery, false),
 		SendRawWorkflowHistory:                 dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.SendRawWorkflowHistory, false),
+		AllowRawWorkflowHistoryRequests:        dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.AllowRawWorkflowHistoryRequests, false),
+		PollWorkflowTaskQueueTimeout:           dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.FrontendPollWorkflowTaskQueueTimeout, 0),
+		PollActivityTaskQueueTimeout:           dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.FrontendPollActivityTaskQueueTimeout, 0),
+		GetHistoryLongPollTimeout:              dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.FrontendGetHistoryLongPollTimeout, 0),
+		RedactionRules:                         dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.FrontendRedactionRules, map[string]interface{}{}),
+		FailureDetailEncodings:                 dc.GetStringProperty(dynamicconfig.FrontendFailureDetailEncodings, ""),
+		RunChainMaxLength:                      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendRunChainMaxLength, 100),
+		CallerRPS:                              dc.GetIntProperty(dynamicconfig.FrontendCallerRPS, 0),
+		CallerRPSOverrides:                     dc.GetMapProperty(dynamicconfig.FrontendCallerRPSOverrides, map[string]interface{}{}),
+		CallerAPIRPS:                           dc.GetMapProperty(dynamicconfig.FrontendCallerAPIRPS, map[string]interface{}{}),
+		EnableAsyncIntake:                      dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.FrontendEnableAsyncIntake, false),
 		DefaultWorkflowRetryPolicy:             dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.DefaultWorkflowRetryPolicy, common.GetDefaultRetryPolicyConfigOptions()),
 		DefaultWorkflowTaskTimeout:             dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.DefaultWorkflowTaskTimeout, common.DefaultWorkflowTaskTimeout),
 		EnableServerVersionCheck:               dc.GetBoolProperty(dynamicconfig.EnableServerVersionCheck, os.Getenv("TEMPORAL_VERSION_CHECK_DISABLED") == ""),
@@ -163,6 +250,8 @@ type Service struct {
 	adminHandler   *AdminHandler
 	versionChecker *VersionChecker
 	server         *grpc.Server
+	httpServer     *http.Server
+	healthChecker  *healthcheck.Checker
 }
 
 // NewService builds a new frontend service
@@ -172,6 +261,8 @@ func NewService(
 
 	isAdvancedVisExistInConfig := len(params.PersistenceConfig.AdvancedVisibilityStore) != 0
 	serviceConfig := NewConfig(dynamicconfig.NewCollection(params.DynamicConfig, params.Logger), params.PersistenceConfig.NumHistoryShards, isAdvancedVisExistInConfig)
+	serviceConfig.ClusterStores = NewClusterStores(&params.PersistenceConfig, params.ESConfig)
+	serviceConfig.RedactionHashKey = params.RedactionHashKey
 
 	params.PersistenceConfig.VisibilityConfig = &config.VisibilityConfig{
 		VisibilityListMaxQPS: serviceConfig.VisibilityListMaxQPS,
@@ -243,25 +334,60 @@ func (s *Service) Start() {
 	if err != nil {
 		logger.Fatal("creating grpc server options failed", tag.Error(err))
 	}
-	opts = append(
-		opts,
-		grpc.ChainUnaryInterceptor(
-			rpc.ServiceErrorInterceptor,
-			authorization.NewAuthorizationInterceptor(
-				s.params.ClaimMapper,
-				s.params.Authorizer,
-				s.Resource.GetMetricsClient(),
-				s.GetLogger())))
+	interceptors := []grpc.UnaryServerInterceptor{
+		rpc.ServiceErrorInterceptor,
+		authorization.NewAuthorizationInterceptor(
+			s.params.ClaimMapper,
+			s.params.Authorizer,
+			s.Resource.GetMetricsClient(),
+			s.GetLogger()),
+		newCallerRateLimiter(s.config, s.Resource.GetMetricsClient()).Interceptor,
+	}
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if provider, ok := s.params.RPCFactory.(common.ServerInterceptorProvider); ok {
+		unary, stream := provider.GetFrontendServerInterceptors()
+		interceptors = append(interceptors, unary...)
+		streamInterceptors = stream
+	}
+	opts = append(opts, grpc.ChainUnaryInterceptor(interceptors...), grpc.ChainStreamInterceptor(streamInterceptors...))
 	s.server = grpc.NewServer(opts...)
 
-	wfHandler := NewWorkflowHandler(s, s.config, replicationMessageSink)
+	wfHandler := NewWorkflowHandler(s, s.config, replicationMessageSink, s.params.NamespaceRegistrationApprover)
 	s.handler = NewDCRedirectionHandler(wfHandler, s.params.DCRedirectionPolicy)
 
+	if provider, ok := s.params.RPCFactory.(HTTPListenerProvider); ok {
+		httpListener, err := provider.GetFrontendHTTPListener()
+		if err != nil {
+			logger.Fatal("creating HTTP listener failed", tag.Error(err))
+		}
+		if httpListener != nil {
+			s.httpServer = newHTTPGatewayServer(s.handler, interceptors, logger)
+			go func() {
+				logger.Info("Starting to serve on frontend HTTP listener")
+				if err := s.httpServer.Serve(httpListener); err != nil && err != http.ErrServerClosed {
+					logger.Fatal("Failed to serve on frontend HTTP listener", tag.Error(err))
+				}
+			}()
+		}
+	}
+
 	workflowservice.RegisterWorkflowServiceServer(s.server, s.handler)
-	healthpb.RegisterHealthServer(s.server, s.handler)
+	intakeservice.RegisterIntakeServiceServer(s.server, NewIntakeHandler(wfHandler.(*WorkflowHandler)))
+	runchainservice.RegisterRunChainServiceServer(s.server, NewRunChainHandler(wfHandler.(*WorkflowHandler)))
+	s.healthChecker = healthcheck.NewChecker(
+		common.FrontendServiceName,
+		s.GetMembershipMonitor(),
+		s.GetClusterMetadataManager(),
+		logger,
+		serviceName,
+	)
+	s.healthChecker.Register(s.server)
 
-	s.adminHandler = NewAdminHandler(s, s.params, s.config)
+	s.adminHandler = NewAdminHandler(s, s.params, s.config, replicationMessageSink)
 	adminservice.RegisterAdminServiceServer(s.server, s.adminHandler)
+	rawhistoryservice.RegisterRawHistoryServiceServer(s.server, NewRawHistoryHandler(s.config, s.adminHandler))
+	searchattributesservice.RegisterSearchAttributesServiceServer(s.server, NewSearchAttributesHandler(s, s.config))
+	workflowreportservice.RegisterWorkflowReportServiceServer(s.server, NewWorkflowReportHandler(s))
 
 	reflection.Register(s.server)
 
@@ -271,6 +397,7 @@ func (s *Service) Start() {
 	s.Resource.Start()
 	s.adminHandler.Start()
 	s.versionChecker.Start()
+	s.healthChecker.Start()
 
 	listener := s.GetGRPCListener()
 	logger.Info("Starting to serve on frontend listener")
@@ -288,15 +415,16 @@ func (s *Service) Stop() {
 	// initiate graceful shutdown:
 	// 1. Fail rpc health check, this will cause client side load balancer to stop forwarding requests to this node
 	// 2. wait for failure detection time
-	// 3. stop taking new requests by returning InternalServiceError
-	// 4. Wait for a second
+	// 3. complete outstanding long polls with empty responses, so pollers reconnect to other nodes
+	// 4. send GOAWAY to clients and wait for in-flight requests up to request drain time
 	// 5. Stop everything forcefully and return
 
-	requestDrainTime := common.MinDuration(time.Second, s.config.ShutdownDrainDuration())
+	requestDrainTime := common.MaxDuration(0, s.config.ShutdownRequestDrainDuration())
 	failureDetectionTime := common.MaxDuration(0, s.config.ShutdownDrainDuration()-requestDrainTime)
 
 	s.GetLogger().Info("ShutdownHandler: Updating rpc health status to ShuttingDown")
 	s.handler.UpdateHealthStatus(HealthStatusShuttingDown)
+	s.healthChecker.Stop()
 
 	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
 	time.Sleep(failureDetectionTime)
@@ -305,10 +433,28 @@ func (s *Service) Stop() {
 	s.versionChecker.Stop()
 
 	s.GetLogger().Info("ShutdownHandler: Draining traffic")
-	time.Sleep(requestDrainTime)
+	s.handler.DrainLongPolls()
+	s.drainRequests(requestDrainTime)
 
-	// TODO: Change this to GracefulStop when integration tests are refactored.
-	s.server.Stop()
 	s.Resource.Stop()
 	s.params.Logger.Info("frontend stopped")
 }
+
+// drainRequests stops accepting requests and lets in-flight requests complete until timeout,
+// then closes remaining connections
+func (s *Service) drainRequests(timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	if s.httpServer != nil {
+		if err := s.httpServer.Shutdown(ctx); err != nil {
+			_ = s.httpServer.Close()
+		}
+	}
+
+	// graceful stop sends GOAWAY, so clients open new connections to other nodes
+	if !rpc.GracefulStop(s.server, time.Until(deadline)) {
+		s.GetLogger().Info("ShutdownHandler: Closed connections with requests in flight")
+	}
+}
@@ -0,0 +1,237 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	clusterspb "go.temporal.io/server/api/cluster/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// shardOwnershipReportHostTimeout bounds how long shard ownership report waits for each history host
+	shardOwnershipReportHostTimeout = 5 * time.Second
+)
+
+// GetShardOwnershipReport reports shard counts and load of every history host, and progress of shard rebalance
+func (adh *AdminHandler) GetShardOwnershipReport(
+	ctx context.Context,
+	request *adminservice.GetShardOwnershipReportRequest,
+) (_ *adminservice.GetShardOwnershipReportResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminGetShardOwnershipReportScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	metadata, err := adh.GetClusterMetadataManager().GetClusterMetadata()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// hosts are described concurrently, a host which doesn't respond in time is reported with error
+	members := adh.GetHistoryServiceResolver().Members()
+	hosts := make([]*clusterspb.HostShardReport, len(members))
+	var wg sync.WaitGroup
+	for i, member := range members {
+		hosts[i] = &clusterspb.HostShardReport{Address: member.GetAddress()}
+		wg.Add(1)
+		go func(host *clusterspb.HostShardReport) {
+			defer wg.Done()
+			hostCtx, cancel := context.WithTimeout(ctx, shardOwnershipReportHostTimeout)
+			defer cancel()
+			resp, err := adh.GetHistoryClient().DescribeHistoryHost(hostCtx, &historyservice.DescribeHistoryHostRequest{
+				HostAddress: host.GetAddress(),
+			})
+			if err != nil {
+				host.Error = err.Error()
+				return
+			}
+			host.Shards = resp.GetShardsNumber()
+			host.RequestsPerSecond = resp.GetRequestsPerSecond()
+		}(hosts[i])
+	}
+	wg.Wait()
+
+	var shards []float64
+	var loads []float64
+	for _, host := range hosts {
+		if host.GetError() == "" {
+			shards = append(shards, float64(host.GetShards()))
+			loads = append(loads, host.GetRequestsPerSecond())
+		}
+	}
+
+	plan := metadata.GetShardRebalancePlan()
+	return &adminservice.GetShardOwnershipReportResponse{
+		Hosts:                 hosts,
+		ShardImbalance:        imbalanceScore(shards),
+		LoadImbalance:         imbalanceScore(loads),
+		RebalancePlan:         plan,
+		AppliedRebalanceMoves: int32(membership.AppliedShardMoves(plan, adh.GetTimeSource().Now())),
+	}, nil
+}
+
+// StartShardRebalance persists plan which gradually moves history shards towards target distribution.
+// A plan can replace the previous one only after the previous one is cancelled and its moves are undone.
+func (adh *AdminHandler) StartShardRebalance(
+	ctx context.Context,
+	request *adminservice.StartShardRebalanceRequest,
+) (_ *adminservice.StartShardRebalanceResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminStartShardRebalanceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if len(request.GetTarget()) == 0 {
+		return nil, adh.error(errShardRebalanceTargetNotSet, scope)
+	}
+	if request.GetMovesPerMinute() <= 0 {
+		return nil, adh.error(errInvalidShardMovesPerMinute, scope)
+	}
+
+	// plan is computed from hash ring, not from shard owners overridden by previous plan
+	resolver, err := adh.GetMembershipMonitor().GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	members := make(map[string]struct{})
+	for _, member := range resolver.Members() {
+		members[member.GetAddress()] = struct{}{}
+	}
+	for address, shards := range request.GetTarget() {
+		if _, ok := members[address]; !ok {
+			return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf("%v is not a history host.", address)), scope)
+		}
+		if shards < 0 {
+			return nil, adh.error(serviceerror.NewInvalidArgument(fmt.Sprintf("Target of %v is negative.", address)), scope)
+		}
+	}
+
+	metadata, err := adh.GetClusterMetadataManager().GetClusterMetadata()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	now := adh.GetTimeSource().Now()
+	if current := metadata.GetShardRebalancePlan(); len(current.GetMoves()) > 0 &&
+		(current.GetCancelTime() == nil || membership.AppliedShardMoves(current, now) > 0) {
+		return nil, adh.error(errShardRebalanceInEffect, scope)
+	}
+
+	plan, err := membership.NewShardRebalancePlan(
+		resolver,
+		adh.numberOfHistoryShards,
+		request.GetTarget(),
+		request.GetMovesPerMinute(),
+		now.Add(membership.ShardRebalanceActivationDelay),
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	metadata.ShardRebalancePlan = plan
+	if err := adh.saveClusterMetadata(metadata); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.StartShardRebalanceResponse{RebalancePlan: plan}, nil
+}
+
+// CancelShardRebalance cancels shard rebalance, moves in effect are undone at the rate they were applied
+func (adh *AdminHandler) CancelShardRebalance(
+	ctx context.Context,
+	request *adminservice.CancelShardRebalanceRequest,
+) (_ *adminservice.CancelShardRebalanceResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminCancelShardRebalanceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	metadata, err := adh.GetClusterMetadataManager().GetClusterMetadata()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	plan := metadata.GetShardRebalancePlan()
+	if plan == nil || plan.GetCancelTime() != nil {
+		return &adminservice.CancelShardRebalanceResponse{}, nil
+	}
+
+	cancelTime := adh.GetTimeSource().Now().Add(membership.ShardRebalanceActivationDelay)
+	plan.CancelTime = &cancelTime
+	if err := adh.saveClusterMetadata(metadata); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.CancelShardRebalanceResponse{}, nil
+}
+
+func (adh *AdminHandler) saveClusterMetadata(metadata *persistence.GetClusterMetadataResponse) error {
+	applied, err := adh.GetClusterMetadataManager().SaveClusterMetadata(&persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: metadata.ClusterMetadata,
+		Version:         metadata.Version,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return errClusterMetadataUpdateConflict
+	}
+	return nil
+}
+
+// imbalanceScore returns ratio by which the max value exceeds the average value
+func imbalanceScore(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum, max float64
+	for _, value := range values {
+		sum += value
+		if value > max {
+			max = value
+		}
+	}
+	if sum == 0 {
+		return 0
+	}
+	return max/(sum/float64(len(values))) - 1
+}
@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	signalDLQPurgePageSize = 1000
+)
+
+// getSignalDLQMessages reads a page of signals of the namespace from signal DLQ
+func (adh *AdminHandler) getSignalDLQMessages(
+	namespace string,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*adminservice.SignalDLQMessage, []byte, error) {
+
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	messages, token, err := adh.GetSignalDLQ().GetMessages(namespaceID, lastMessageID, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]*adminservice.SignalDLQMessage, 0, len(messages))
+	for _, message := range messages {
+		result = append(result, &adminservice.SignalDLQMessage{
+			MessageId:   message.MessageID,
+			Request:     message.Request.GetSignalRequest(),
+			Reason:      message.Reason,
+			EnqueueTime: timestamp.TimePtr(message.EnqueueTime),
+		})
+	}
+	return result, token, nil
+}
+
+// purgeSignalDLQMessages deletes signals of the namespace up to lastMessageID from signal DLQ.
+func (adh *AdminHandler) purgeSignalDLQMessages(
+	namespace string,
+	lastMessageID int64,
+) error {
+
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(namespace)
+	if err != nil {
+		return err
+	}
+
+	var token []byte
+	for {
+		messages, nextToken, err := adh.GetSignalDLQ().GetMessages(namespaceID, lastMessageID, signalDLQPurgePageSize, token)
+		if err != nil {
+			return err
+		}
+		for _, message := range messages {
+			if err := adh.GetSignalDLQ().DeleteMessage(namespaceID, message.MessageID); err != nil {
+				return err
+			}
+		}
+		if len(nextToken) == 0 {
+			return nil
+		}
+		token = nextToken
+	}
+}
+
+// mergeSignalDLQMessages re-delivers a page of signals of the namespace from signal DLQ, delivered signals are deleted
+// from the DLQ. Signals keep their request ID, so that a signal which was applied before is not applied twice.
+func (adh *AdminHandler) mergeSignalDLQMessages(
+	ctx context.Context,
+	namespace string,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]byte, error) {
+
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(namespace)
+	if err != nil {
+		return nil, err
+	}
+	messages, token, err := adh.GetSignalDLQ().GetMessages(namespaceID, lastMessageID, pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, message := range messages {
+		request := *message.Request
+		request.Redelivery = true
+		if _, err := adh.GetHistoryClient().SignalWorkflowExecution(ctx, &request); err != nil {
+			adh.GetLogger().Warn("Unable to re-deliver signal from signal DLQ.",
+				tag.WorkflowNamespace(namespace),
+				tag.WorkflowID(message.Request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId()),
+				tag.TaskID(message.MessageID),
+				tag.Error(err))
+			return nil, err
+		}
+		if err := adh.GetSignalDLQ().DeleteMessage(namespaceID, message.MessageID); err != nil {
+			return nil, err
+		}
+	}
+	return token, nil
+}
@@ -0,0 +1,90 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/rpc/encryption"
+)
+
+// RotateTLSCertificate starts a new generation of TLS certificate rotation, every host reloads the certificate
+// of the group from its configured files and reports the outcome
+func (adh *AdminHandler) RotateTLSCertificate(
+	ctx context.Context,
+	request *adminservice.RotateTLSCertificateRequest,
+) (_ *adminservice.RotateTLSCertificateResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminRotateTLSCertificateScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetGroup() != encryption.TLSGroupInternode && request.GetGroup() != encryption.TLSGroupFrontend {
+		return nil, adh.error(errUnknownTLSGroup, scope)
+	}
+
+	metadata, err := adh.GetClusterMetadataManager().GetClusterMetadata()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	startTime := adh.GetTimeSource().Now()
+	rotation := &persistencespb.TLSRotation{
+		Group:      request.GetGroup(),
+		Generation: metadata.GetTlsRotation().GetGeneration() + 1,
+		StartTime:  &startTime,
+	}
+	metadata.TlsRotation = rotation
+	if err := adh.saveClusterMetadata(metadata); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.RotateTLSCertificateResponse{Rotation: rotation}, nil
+}
+
+// DescribeTLSRotation returns the latest TLS certificate rotation and the outcome reported by hosts
+func (adh *AdminHandler) DescribeTLSRotation(
+	ctx context.Context,
+	request *adminservice.DescribeTLSRotationRequest,
+) (_ *adminservice.DescribeTLSRotationResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeTLSRotationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	metadata, err := adh.GetClusterMetadataManager().GetClusterMetadata()
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.DescribeTLSRotationResponse{Rotation: metadata.GetTlsRotation()}, nil
+}
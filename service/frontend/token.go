@@ -81,6 +81,20 @@ func deserializeRawHistoryToken(bytes []byte) (*tokenspb.RawHistoryContinuation,
 	return token, err
 }
 
+func serializeHistoryBranchToken(token *tokenspb.HistoryBranchContinuation) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+
+	return token.Marshal()
+}
+
+func deserializeHistoryBranchToken(bytes []byte) (*tokenspb.HistoryBranchContinuation, error) {
+	token := &tokenspb.HistoryBranchContinuation{}
+	err := token.Unmarshal(bytes)
+	return token, err
+}
+
 func serializeHistoryToken(token *tokenspb.HistoryContinuation) ([]byte, error) {
 	if token == nil {
 		return nil, nil
@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	visibilityConsistencyPollInterval = 200 * time.Millisecond
+
+	// versions of visibility record of a run, visibility reflects a mutation once record of
+	// the mutated run has at least the version of the mutation
+	visibilityRecordVersionStarted int32 = 1
+	visibilityRecordVersionClosed  int32 = 2
+)
+
+var (
+	errInvalidVisibilityConsistencyToken = serviceerror.NewInvalidArgument("Invalid visibility consistency token.")
+)
+
+type (
+	// visibilityConsistencyToken identifies workflow run changed by mutating API and the visibility record version
+	// the change results in. It is returned in response header to caller which asks for it with request header,
+	// and can be passed back to ListWorkflowExecutions to read its own writes.
+	visibilityConsistencyToken struct {
+		NamespaceID string `json:"namespaceId"`
+		RunID       string `json:"runId"`
+		Version     int32  `json:"version"`
+	}
+)
+
+func serializeVisibilityConsistencyToken(token *visibilityConsistencyToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func deserializeVisibilityConsistencyToken(value string) (*visibilityConsistencyToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errInvalidVisibilityConsistencyToken
+	}
+	token := &visibilityConsistencyToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, errInvalidVisibilityConsistencyToken
+	}
+	if uuid.Parse(token.NamespaceID) == nil || uuid.Parse(token.RunID) == nil {
+		return nil, errInvalidVisibilityConsistencyToken
+	}
+	return token, nil
+}
+
+// visibilityConsistencyTokenRequested returns true if caller of mutating API asks for visibility consistency token.
+func visibilityConsistencyTokenRequested(ctx context.Context) bool {
+	return headers.GetValues(ctx, headers.RequestVisibilityConsistencyTokenHeaderName)[0] != ""
+}
+
+// setVisibilityConsistencyToken returns token of mutated run in response header, if caller asks for it.
+func (wh *WorkflowHandler) setVisibilityConsistencyToken(
+	ctx context.Context,
+	namespace string,
+	namespaceID string,
+	runID string,
+	version int32,
+) {
+	if !visibilityConsistencyTokenRequested(ctx) {
+		return
+	}
+	value, err := serializeVisibilityConsistencyToken(&visibilityConsistencyToken{
+		NamespaceID: namespaceID,
+		RunID:       runID,
+		Version:     version,
+	})
+	if err == nil {
+		err = grpc.SetHeader(ctx, metadata.Pairs(headers.VisibilityConsistencyTokenHeaderName, value))
+	}
+	if err != nil {
+		wh.GetThrottledLogger().Debug("Unable to set visibility consistency token header.", tag.WorkflowNamespace(namespace), tag.Error(err))
+	}
+}
+
+// setExecutionVisibilityConsistencyToken returns token of mutated execution in response header, if caller asks
+// for it. Execution without run ID is resolved to the current run after the mutation.
+func (wh *WorkflowHandler) setExecutionVisibilityConsistencyToken(
+	ctx context.Context,
+	namespace string,
+	namespaceID string,
+	execution *commonpb.WorkflowExecution,
+	version int32,
+) {
+	if !visibilityConsistencyTokenRequested(ctx) {
+		return
+	}
+	runID := execution.GetRunId()
+	if runID == "" {
+		resp, err := wh.GetHistoryClient().GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+			NamespaceId: namespaceID,
+			Execution:   &commonpb.WorkflowExecution{WorkflowId: execution.GetWorkflowId()},
+		})
+		if err != nil {
+			wh.GetThrottledLogger().Debug("Unable to resolve run of visibility consistency token.", tag.WorkflowNamespace(namespace), tag.Error(err))
+			return
+		}
+		runID = resp.GetExecution().GetRunId()
+	}
+	wh.setVisibilityConsistencyToken(ctx, namespace, namespaceID, runID, version)
+}
+
+// visibilityRecordVersion returns version of visibility record, closing run is the only change of record
+// which mutating APIs wait for besides creating it
+func visibilityRecordVersion(execution *workflowpb.WorkflowExecutionInfo) int32 {
+	if execution.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return visibilityRecordVersionStarted
+	}
+	return visibilityRecordVersionClosed
+}
+
+// waitForVisibilityConsistency waits, at most for configured time, until visibility has record of the run
+// referenced by consistency token of the request, with at least the version of the token. When the wait times out the list is served as is,
+// the token is a best effort hint and not a guarantee.
+func (wh *WorkflowHandler) waitForVisibilityConsistency(
+	ctx context.Context,
+	namespace string,
+	namespaceID string,
+) error {
+	value := headers.GetValues(ctx, headers.VisibilityConsistencyTokenHeaderName)[0]
+	if value == "" {
+		return nil
+	}
+	token, err := deserializeVisibilityConsistencyToken(value)
+	if err != nil {
+		return err
+	}
+	if token.NamespaceID != namespaceID {
+		return errInvalidVisibilityConsistencyToken
+	}
+
+	maxWait := wh.config.VisibilityConsistencyMaxWait(namespace)
+	if maxWait <= 0 {
+		return nil
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, maxWait)
+	defer cancel()
+
+	ticker := time.NewTicker(visibilityConsistencyPollInterval)
+	defer ticker.Stop()
+	request := &persistence.ListWorkflowExecutionsRequestV2{
+		NamespaceID: namespaceID,
+		Namespace:   namespace,
+		PageSize:    1,
+		Query:       fmt.Sprintf("RunId = '%s'", token.RunID),
+	}
+	for {
+		resp, err := wh.GetVisibilityManager().ListWorkflowExecutions(request)
+		if err != nil {
+			return err
+		}
+		if len(resp.Executions) > 0 && visibilityRecordVersion(resp.Executions[0]) >= token.Version {
+			return nil
+		}
+
+		select {
+		case <-waitCtx.Done():
+			wh.GetThrottledLogger().Debug("Visibility did not reflect consistency token in time.",
+				tag.WorkflowNamespace(namespace), tag.WorkflowRunID(token.RunID))
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
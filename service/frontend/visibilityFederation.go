@@ -0,0 +1,239 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+var (
+	errInvalidFederatedPageToken = serviceerror.NewInvalidArgument("Invalid federated visibility page token.")
+)
+
+type (
+	// visibilityFederationPageToken tracks merging of local and remote visibility queries of federated list.
+	visibilityFederationPageToken struct {
+		Local  visibilityFederationCursor `json:"local"`
+		Remote visibilityFederationCursor `json:"remote"`
+	}
+
+	// visibilityFederationCursor is the position of federated list in the results of a visibility query
+	visibilityFederationCursor struct {
+		// PageToken is the token of the page being merged, empty for the first page
+		PageToken []byte `json:"pageToken,omitempty"`
+		// Offset is the number of executions of the page already returned
+		Offset int `json:"offset,omitempty"`
+		// Done is whether all executions were returned
+		Done bool `json:"done,omitempty"`
+	}
+
+	// visibilityFederationSource is a visibility query merged into federated list
+	visibilityFederationSource struct {
+		cursor     *visibilityFederationCursor
+		list       func(pageToken []byte) (*workflowservice.ListWorkflowExecutionsResponse, error)
+		executions []*workflowpb.WorkflowExecutionInfo
+		nextToken  []byte
+	}
+)
+
+// visibilityFederationCluster returns cluster whose visibility is federated into ListWorkflowExecutions
+// of namespace, that is active cluster of global namespace active elsewhere. Queries with custom order
+// are not federated, as results of both clusters are merged in default visibility order.
+func (handler *DCRedirectionHandlerImpl) visibilityFederationCluster(namespace string, query string) (string, bool) {
+	if !handler.config.EnableVisibilityFederation(namespace) || strings.Contains(strings.ToLower(query), "order by") {
+		return "", false
+	}
+	namespaceEntry, err := handler.GetNamespaceCache().GetNamespace(namespace)
+	if err != nil || !namespaceEntry.IsGlobalNamespace() {
+		return "", false
+	}
+	activeCluster := namespaceEntry.GetReplicationConfig().GetActiveClusterName()
+	if activeCluster == "" || activeCluster == handler.currentClusterName {
+		return "", false
+	}
+	return activeCluster, true
+}
+
+// federateListWorkflowExecutions merges results of list query of local visibility and of visibility of remote
+// cluster, ordered by start time and run ID descending as visibility orders them by default. Executions known to
+// both clusters are returned once, as reported by remote cluster where namespace is active. The page token keeps
+// the position in results of both clusters.
+func (handler *DCRedirectionHandlerImpl) federateListWorkflowExecutions(
+	ctx context.Context,
+	request *workflowservice.ListWorkflowExecutionsRequest,
+	remoteCluster string,
+) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+	pageToken := &visibilityFederationPageToken{}
+	if len(request.NextPageToken) != 0 {
+		if err := json.Unmarshal(request.NextPageToken, pageToken); err != nil {
+			return nil, errInvalidFederatedPageToken
+		}
+	}
+	newListRequest := func(pageToken []byte) *workflowservice.ListWorkflowExecutionsRequest {
+		return &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     request.GetNamespace(),
+			PageSize:      request.GetPageSize(),
+			NextPageToken: pageToken,
+			Query:         request.GetQuery(),
+		}
+	}
+	remote := &visibilityFederationSource{
+		cursor: &pageToken.Remote,
+		list: func(pageToken []byte) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+			return handler.GetRemoteFrontendClient(remoteCluster).ListWorkflowExecutions(ctx, newListRequest(pageToken))
+		},
+	}
+	local := &visibilityFederationSource{
+		cursor: &pageToken.Local,
+		list: func(pageToken []byte) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+			return handler.frontendHandler.ListWorkflowExecutions(ctx, newListRequest(pageToken))
+		},
+	}
+
+	var remoteErr error
+	remoteDoneCh := make(chan struct{})
+	go func() {
+		defer close(remoteDoneCh)
+		remoteErr = remote.fetch()
+	}()
+	localErr := local.fetch()
+	<-remoteDoneCh
+	if localErr != nil {
+		return nil, localErr
+	}
+	if remoteErr != nil {
+		return nil, remoteErr
+	}
+
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for len(executions) < int(request.GetPageSize()) {
+		// a page of either cluster is merged only while the next execution of the other cluster is known
+		for _, source := range []*visibilityFederationSource{remote, local} {
+			if err := source.fetchIfExhausted(); err != nil {
+				return nil, err
+			}
+		}
+		next := remote
+		switch {
+		case remote.cursor.Done && local.cursor.Done:
+			next = nil
+		case remote.cursor.Done:
+			next = local
+		case !local.cursor.Done:
+			order := compareVisibilityOrder(remote.head(), local.head())
+			if order > 0 {
+				next = local
+			} else if order == 0 {
+				local.advance()
+			}
+		}
+		if next == nil {
+			break
+		}
+		executions = append(executions, next.head())
+		next.advance()
+	}
+	for _, source := range []*visibilityFederationSource{remote, local} {
+		if len(source.executions) == 0 && len(source.nextToken) == 0 {
+			source.cursor.Done = true
+		}
+	}
+
+	resp := &workflowservice.ListWorkflowExecutionsResponse{
+		Executions: executions,
+	}
+	if !local.cursor.Done || !remote.cursor.Done {
+		data, err := json.Marshal(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		resp.NextPageToken = data
+	}
+	return resp, nil
+}
+
+// fetch reads the page of the cursor and skips executions already returned
+func (s *visibilityFederationSource) fetch() error {
+	if s.cursor.Done {
+		return nil
+	}
+	resp, err := s.list(s.cursor.PageToken)
+	if err != nil {
+		return err
+	}
+	s.executions = resp.GetExecutions()
+	if s.cursor.Offset < len(s.executions) {
+		s.executions = s.executions[s.cursor.Offset:]
+	} else {
+		s.executions = nil
+	}
+	s.nextToken = resp.GetNextPageToken()
+	return s.fetchIfExhausted()
+}
+
+// fetchIfExhausted moves the cursor to the next page once all executions of the page were returned
+func (s *visibilityFederationSource) fetchIfExhausted() error {
+	if s.cursor.Done || len(s.executions) != 0 {
+		return nil
+	}
+	if len(s.nextToken) == 0 {
+		s.cursor.Done = true
+		return nil
+	}
+	s.cursor.PageToken = s.nextToken
+	s.cursor.Offset = 0
+	return s.fetch()
+}
+
+func (s *visibilityFederationSource) head() *workflowpb.WorkflowExecutionInfo {
+	return s.executions[0]
+}
+
+func (s *visibilityFederationSource) advance() {
+	s.executions = s.executions[1:]
+	s.cursor.Offset++
+}
+
+// compareVisibilityOrder returns a negative number if execution a is listed before b by visibility,
+// a positive number if after and 0 if both are the same execution
+func compareVisibilityOrder(a *workflowpb.WorkflowExecutionInfo, b *workflowpb.WorkflowExecutionInfo) int {
+	aStartTime := timestamp.TimeValue(a.GetStartTime())
+	bStartTime := timestamp.TimeValue(b.GetStartTime())
+	switch {
+	case aStartTime.After(bStartTime):
+		return -1
+	case aStartTime.Before(bStartTime):
+		return 1
+	}
+	return -strings.Compare(a.GetExecution().GetRunId(), b.GetExecution().GetRunId())
+}
@@ -27,18 +27,26 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 	filterpb "go.temporal.io/api/filter/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -48,6 +56,7 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/elasticsearch/validator"
 	"go.temporal.io/server/common/enums"
@@ -63,6 +72,7 @@ import (
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
 const (
@@ -91,6 +101,10 @@ type (
 		healthStatus                    int32
 		tokenSerializer                 common.TaskTokenSerializer
 		rateLimiter                     quotas.NamespaceRateLimiter
+		quotaUsage                      *namespaceQuotaUsage
+		pollerLimiter                   *pollerLimiter
+		pollDrainer                     *pollDrainer
+		clientFeatures                  *clientFeatureChecker
 		config                          *Config
 		versionChecker                  headers.VersionChecker
 		namespaceHandler                namespace.Handler
@@ -112,6 +126,7 @@ func NewWorkflowHandler(
 	resource resource.Resource,
 	config *Config,
 	replicationMessageSink messaging.Producer,
+	namespaceRegistrationApprover namespace.RegistrationApprover,
 ) Handler {
 
 	handler := &WorkflowHandler{
@@ -124,12 +139,15 @@ func NewWorkflowHandler(
 		namespaceHandler: namespace.NewHandler(
 			config.MinRetentionDays(),
 			config.MaxBadBinaries,
+			config.EnableArchivalURIProbe,
 			resource.GetLogger(),
 			resource.GetMetadataManager(),
 			resource.GetClusterMetadata(),
 			namespace.NewNamespaceReplicator(replicationMessageSink, resource.GetLogger()),
 			resource.GetArchivalMetadata(),
 			resource.GetArchiverProvider(),
+			namespaceRegistrationApprover,
+			resource.GetNamespaceRegistrationStore(),
 		),
 		visibilityQueryValidator: validator.NewQueryValidator(config.ValidSearchAttributes),
 		searchAttributesValidator: validator.NewSearchAttributesValidator(
@@ -140,6 +158,10 @@ func NewWorkflowHandler(
 			config.SearchAttributesTotalSizeLimit,
 		),
 		getDefaultWorkflowRetrySettings: config.DefaultWorkflowRetryPolicy,
+		quotaUsage:                      newNamespaceQuotaUsage(clock.NewRealTimeSource()),
+		pollerLimiter:                   newPollerLimiter(),
+		pollDrainer:                     newPollDrainer(),
+		clientFeatures:                  newClientFeatureChecker(config),
 	}
 
 	handler.rateLimiter = quotas.NewNamespaceMultiStageRateLimiter(
@@ -180,6 +202,27 @@ func (wh *WorkflowHandler) UpdateHealthStatus(status HealthStatus) {
 	atomic.StoreInt32(&wh.healthStatus, int32(status))
 }
 
+// DrainLongPolls completes outstanding long polls with empty responses and answers polls received
+// afterwards right away, so pollers move to other frontend hosts without errors
+func (wh *WorkflowHandler) DrainLongPolls() {
+	polls := wh.pollDrainer.drain()
+	wh.GetLogger().Info("Draining outstanding long polls.", tag.Counter(len(polls)))
+
+	var wg sync.WaitGroup
+	for _, request := range polls {
+		wg.Add(1)
+		go func(request *matchingservice.CancelOutstandingPollRequest) {
+			defer wg.Done()
+			// matching completes the canceled poll with empty response
+			if _, err := wh.GetMatchingClient().CancelOutstandingPoll(context.Background(), request); err != nil {
+				wh.GetLogger().Warn("Failed to drain outstanding poller.",
+					tag.WorkflowTaskQueueName(request.TaskQueue.GetName()), tag.Error(err))
+			}
+		}(request)
+	}
+	wg.Wait()
+}
+
 func (wh *WorkflowHandler) isStopped() bool {
 	return atomic.LoadInt32(&wh.status) == common.DaemonStatusStopped
 }
@@ -386,45 +429,69 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendStartWorkflowExecutionScope, request.GetNamespace())
 	defer sw.Stop()
 
+	namespaceID, scope, err := wh.validateStartWorkflowExecutionRequest(ctx, request, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	wh.GetLogger().Debug("Start workflow execution request namespaceID", tag.WorkflowNamespaceID(namespaceID))
+	resp, err := wh.GetHistoryClient().StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID, request, nil, time.Now().UTC()))
+
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	wh.signalNamespaceQuotaUtilization(ctx, request.GetNamespace(), scope)
+	wh.setVisibilityConsistencyToken(ctx, request.GetNamespace(), namespaceID, resp.GetRunId(), visibilityRecordVersionStarted)
+	return &workflowservice.StartWorkflowExecutionResponse{RunId: resp.GetRunId()}, nil
+}
+
+// validateStartWorkflowExecutionRequest validates the start request, it returns ID of the namespace of the request
+// and the scope tagged with the namespace
+func (wh *WorkflowHandler) validateStartWorkflowExecutionRequest(
+	ctx context.Context,
+	request *workflowservice.StartWorkflowExecutionRequest,
+	scope metrics.Scope,
+) (string, metrics.Scope, error) {
+
 	if wh.isStopped() {
-		return nil, errShuttingDown
+		return "", scope, errShuttingDown
 	}
 
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
-		return nil, wh.error(err, scope)
+		return "", scope, wh.error(err, scope)
 	}
 
 	if request == nil {
-		return nil, wh.error(errRequestNotSet, scope)
+		return "", scope, wh.error(errRequestNotSet, scope)
 	}
 
 	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+		return "", scope, wh.error(errServiceBusy, scope)
 	}
 
 	namespace := request.GetNamespace()
 	if namespace == "" {
-		return nil, wh.error(errNamespaceNotSet, scope)
+		return "", scope, wh.error(errNamespaceNotSet, scope)
 	}
 
 	if len(namespace) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errNamespaceTooLong, scope)
+		return "", scope, wh.error(errNamespaceTooLong, scope)
 	}
 
 	if request.GetWorkflowId() == "" {
-		return nil, wh.error(errWorkflowIDNotSet, scope)
+		return "", scope, wh.error(errWorkflowIDNotSet, scope)
 	}
 
 	if len(request.GetWorkflowId()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errWorkflowIDTooLong, scope)
+		return "", scope, wh.error(errWorkflowIDTooLong, scope)
 	}
 
 	if err := wh.validateRetryPolicy(request.GetNamespace(), request.RetryPolicy); err != nil {
-		return nil, wh.error(err, scope)
+		return "", scope, wh.error(err, scope)
 	}
 
 	if err := backoff.ValidateSchedule(request.GetCronSchedule()); err != nil {
-		return nil, wh.error(err, scope)
+		return "", scope, wh.error(err, scope)
 	}
 
 	wh.GetLogger().Debug(
@@ -432,31 +499,31 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		tag.WorkflowID(request.GetWorkflowId()))
 
 	if request.WorkflowType == nil || request.WorkflowType.GetName() == "" {
-		return nil, wh.error(errWorkflowTypeNotSet, scope)
+		return "", scope, wh.error(errWorkflowTypeNotSet, scope)
 	}
 
 	if len(request.WorkflowType.GetName()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errWorkflowTypeTooLong, scope)
+		return "", scope, wh.error(errWorkflowTypeTooLong, scope)
 	}
 
 	if err := wh.validateTaskQueue(request.TaskQueue, scope); err != nil {
-		return nil, err
+		return "", scope, err
 	}
 
 	if err := wh.validateStartWorkflowTimeouts(scope, request); err != nil {
-		return nil, err
+		return "", scope, err
 	}
 
 	if request.GetRequestId() == "" {
-		return nil, wh.error(errRequestIDNotSet, scope)
+		return "", scope, wh.error(errRequestIDNotSet, scope)
 	}
 
 	if len(request.GetRequestId()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errRequestIDTooLong, scope)
+		return "", scope, wh.error(errRequestIDTooLong, scope)
 	}
 
 	if err := wh.searchAttributesValidator.ValidateSearchAttributes(request.SearchAttributes, namespace); err != nil {
-		return nil, wh.error(err, scope)
+		return "", scope, wh.error(err, scope)
 	}
 
 	enums.SetDefaultWorkflowIdReusePolicy(&request.WorkflowIdReusePolicy)
@@ -464,14 +531,15 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 	wh.GetLogger().Debug("Start workflow execution request namespace", tag.WorkflowNamespace(namespace))
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(namespace)
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return "", scope, wh.error(err, scope)
 	}
 
 	// add namespace tag to scope, so further metrics will have the namespace tag
 	scope = scope.Tagged(metrics.NamespaceTag(namespace))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespace)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespace)
+	request.Header, request.Memo = propagateRequestMetadata(ctx, wh.config.PropagatedRequestHeaders(namespace), request.Header, request.Memo)
+
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespace, "StartWorkflowExecution")
 
 	actualSize := request.GetInput().Size()
 	actualSize += request.GetMemo().Size()
@@ -485,18 +553,13 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		"",
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("StartWorkflowExecution"),
+		"StartWorkflowExecution",
+		"Input and Memo",
 	); err != nil {
-		return nil, wh.error(err, scope)
+		return "", scope, wh.error(err, scope)
 	}
 
-	wh.GetLogger().Debug("Start workflow execution request namespaceID", tag.WorkflowNamespaceID(namespaceID))
-	resp, err := wh.GetHistoryClient().StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID, request, nil, time.Now().UTC()))
-
-	if err != nil {
-		return nil, wh.error(err, scope)
-	}
-	return &workflowservice.StartWorkflowExecutionResponse{RunId: resp.GetRunId()}, nil
+	return namespaceID, scope, nil
 }
 
 // GetWorkflowExecutionHistory returns the history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow
@@ -571,12 +634,27 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		expectedNextEventID int64,
 		currentBranchToken []byte,
 	) ([]byte, string, int64, int64, bool, error) {
-		response, err := wh.GetHistoryClient().PollMutableState(ctx, &historyservice.PollMutableStateRequest{
+		pollCtx, cancel := ctx, context.CancelFunc(func() {})
+		if request.GetWaitNewEvent() {
+			pollCtx, cancel = wh.longPollContext(ctx, request.GetNamespace(), wh.config.GetHistoryLongPollTimeout(request.GetNamespace()))
+		}
+		defer cancel()
+
+		response, err := wh.GetHistoryClient().PollMutableState(pollCtx, &historyservice.PollMutableStateRequest{
 			NamespaceId:         namespaceUUID,
 			Execution:           execution,
 			ExpectedNextEventId: expectedNextEventID,
 			CurrentBranchToken:  currentBranchToken,
 		})
+		if err != nil && pollCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+			// long poll timeout configured for namespace elapsed without new events, return current state without blocking
+			response, err = wh.GetHistoryClient().PollMutableState(ctx, &historyservice.PollMutableStateRequest{
+				NamespaceId:         namespaceUUID,
+				Execution:           execution,
+				ExpectedNextEventId: common.EmptyEventID,
+				CurrentBranchToken:  currentBranchToken,
+			})
+		}
 
 		if err != nil {
 			return nil, "", 0, 0, false, err
@@ -647,7 +725,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		continuationToken.IsWorkflowRunning = isWorkflowRunning
 		continuationToken.PersistenceToken = nil
 	}
-	rawHistoryQueryEnabled := wh.config.SendRawWorkflowHistory(request.GetNamespace())
+	// raw history can't be redacted
+	rawHistoryQueryEnabled := wh.config.SendRawWorkflowHistory(request.GetNamespace()) &&
+		getRedactionRules(ctx, wh.config, request.GetNamespace()) == nil
 
 	history := &historypb.History{}
 	history.Events = []*historypb.HistoryEvent{}
@@ -749,6 +829,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	wh.redactResponse(ctx, request.GetNamespace(), history)
 	return &workflowservice.GetWorkflowExecutionHistoryResponse{
 		History:       history,
 		RawHistory:    historyBlob,
@@ -815,11 +896,31 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 		return nil, wh.error(err, scope, tagsForErrorLog...)
 	}
 
+	releasePoll, err := wh.acquirePoll(ctx, namespace, request.GetIdentity())
+	if err != nil {
+		return nil, wh.error(err, scope, tagsForErrorLog...)
+	}
+	defer releasePoll()
+
+	pollCtx, cancel := wh.longPollContext(ctx, namespace, wh.config.PollWorkflowTaskQueueTimeout(namespace))
+	defer cancel()
+
 	pollerID := uuid.New()
+	if !wh.pollDrainer.register(&matchingservice.CancelOutstandingPollRequest{
+		NamespaceId:   namespaceID,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		TaskQueue:     request.TaskQueue,
+		PollerId:      pollerID,
+	}) {
+		// frontend is shutting down, poller retries on another host
+		return &workflowservice.PollWorkflowTaskQueueResponse{}, nil
+	}
+	defer wh.pollDrainer.unregister(pollerID)
+
 	var matchingResp *matchingservice.PollWorkflowTaskQueueResponse
 	op := func() error {
 		var err error
-		matchingResp, err = wh.GetMatchingClient().PollWorkflowTaskQueue(ctx, &matchingservice.PollWorkflowTaskQueueRequest{
+		matchingResp, err = wh.GetMatchingClient().PollWorkflowTaskQueue(pollCtx, &matchingservice.PollWorkflowTaskQueueRequest{
 			NamespaceId: namespaceID,
 			PollerId:    pollerID,
 			PollRequest: request,
@@ -827,7 +928,7 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 		return err
 	}
 
-	err = backoff.Retry(op, frontendServiceRetryPolicy, common.IsServiceTransientError)
+	err = backoff.Retry(op, frontendServiceRetryPolicy, isPollRetryable)
 	if err != nil {
 		errCancel := wh.cancelOutstandingPoll(ctx, err, namespaceID, enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.TaskQueue, pollerID)
 		if errCancel != nil {
@@ -852,6 +953,7 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 	if err != nil {
 		return nil, wh.error(err, scope, tagsForErrorLog...)
 	}
+	wh.suggestContinueAsNew(ctx, namespace, matchingResp.GetSuggestContinueAsNewReasons())
 	return resp, nil
 }
 
@@ -876,6 +978,9 @@ func (wh *WorkflowHandler) RespondWorkflowTaskCompleted(
 	if request == nil {
 		return nil, wh.error(errRequestNotSet, scope)
 	}
+	if err := wh.clientFeatures.checkRequest(request); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.allow("")
@@ -946,6 +1051,7 @@ func (wh *WorkflowHandler) RespondWorkflowTaskCompleted(
 			return nil, wh.error(err, scope)
 		}
 		completedResp.WorkflowTask = newWorkflowTask
+		wh.suggestContinueAsNew(ctx, namespaceName, matchingResp.GetSuggestContinueAsNewReasons())
 	}
 
 	return completedResp, nil
@@ -970,6 +1076,9 @@ func (wh *WorkflowHandler) RespondWorkflowTaskFailed(
 	if request == nil {
 		return nil, wh.error(errRequestNotSet, scope)
 	}
+	if err := wh.clientFeatures.checkRequest(request); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.allow("")
@@ -1010,10 +1119,9 @@ func (wh *WorkflowHandler) RespondWorkflowTaskFailed(
 		return nil, wh.error(errIdentityTooLong, scope)
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespaceEntry.GetInfo().Name, "RespondWorkflowTaskFailed")
 
-	if err := common.CheckEventBlobSizeLimit(
+	err = common.CheckEventBlobSizeLimit(
 		request.GetFailure().Size(),
 		sizeLimitWarn,
 		sizeLimitError,
@@ -1022,12 +1130,10 @@ func (wh *WorkflowHandler) RespondWorkflowTaskFailed(
 		taskToken.GetRunId(),
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RespondWorkflowTaskFailed"),
-	); err != nil {
-		serverFailure := failure.NewServerFailure(common.FailureReasonFailureExceedsLimit, false)
-		serverFailure.Cause = failure.Truncate(request.Failure, sizeLimitWarn)
-		request.Failure = serverFailure
-	}
+		"RespondWorkflowTaskFailed",
+		"Failure",
+	)
+	request.Failure = wh.truncateFailure(request.Failure, namespaceEntry.GetInfo().Name, err != nil, sizeLimitWarn, scope)
 
 	_, err = wh.GetHistoryClient().RespondWorkflowTaskFailed(ctx, &historyservice.RespondWorkflowTaskFailedRequest{
 		NamespaceId:   namespaceId,
@@ -1092,11 +1198,31 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		return nil, wh.error(err, scope)
 	}
 
+	releasePoll, err := wh.acquirePoll(ctx, request.GetNamespace(), request.GetIdentity())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	defer releasePoll()
+
+	pollCtx, cancel := wh.longPollContext(ctx, request.GetNamespace(), wh.config.PollActivityTaskQueueTimeout(request.GetNamespace()))
+	defer cancel()
+
 	pollerID := uuid.New()
+	if !wh.pollDrainer.register(&matchingservice.CancelOutstandingPollRequest{
+		NamespaceId:   namespaceID,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		TaskQueue:     request.TaskQueue,
+		PollerId:      pollerID,
+	}) {
+		// frontend is shutting down, poller retries on another host
+		return &workflowservice.PollActivityTaskQueueResponse{}, nil
+	}
+	defer wh.pollDrainer.unregister(pollerID)
+
 	var matchingResponse *matchingservice.PollActivityTaskQueueResponse
 	op := func() error {
 		var err error
-		matchingResponse, err = wh.GetMatchingClient().PollActivityTaskQueue(ctx, &matchingservice.PollActivityTaskQueueRequest{
+		matchingResponse, err = wh.GetMatchingClient().PollActivityTaskQueue(pollCtx, &matchingservice.PollActivityTaskQueueRequest{
 			NamespaceId: namespaceID,
 			PollerId:    pollerID,
 			PollRequest: request,
@@ -1104,7 +1230,7 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		return err
 	}
 
-	err = backoff.Retry(op, frontendServiceRetryPolicy, common.IsServiceTransientError)
+	err = backoff.Retry(op, frontendServiceRetryPolicy, isPollRetryable)
 	if err != nil {
 		errCancel := wh.cancelOutstandingPoll(ctx, err, namespaceID, enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.TaskQueue, pollerID)
 		if errCancel != nil {
@@ -1189,8 +1315,7 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(ctx context.Context, requ
 		return nil, errShuttingDown
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespaceEntry.GetInfo().Name, "RecordActivityTaskHeartbeat")
 
 	if err := common.CheckEventBlobSizeLimit(
 		request.GetDetails().Size(),
@@ -1201,7 +1326,8 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(ctx context.Context, requ
 		taskToken.GetRunId(),
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RecordActivityTaskHeartbeat"),
+		"RecordActivityTaskHeartbeat",
+		"Details",
 	); err != nil {
 		// heartbeat details exceed size limit, we would fail the activity immediately with explicit error reason
 		failRequest := &workflowservice.RespondActivityTaskFailedRequest{
@@ -1296,8 +1422,7 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatById(ctx context.Context,
 	// add namespace tag to scope, so further metrics will have the namespace tag
 	scope = scope.Tagged(metrics.NamespaceTag(namespaceEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespaceEntry.GetInfo().Name, "RecordActivityTaskHeartbeatById")
 
 	if err := common.CheckEventBlobSizeLimit(
 		request.GetDetails().Size(),
@@ -1308,7 +1433,8 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatById(ctx context.Context,
 		taskToken.GetRunId(),
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RecordActivityTaskHeartbeatById"),
+		"RecordActivityTaskHeartbeatById",
+		"Details",
 	); err != nil {
 		// heartbeat details exceed size limit, we would fail the activity immediately with explicit error reason
 		failRequest := &workflowservice.RespondActivityTaskFailedRequest{
@@ -1401,8 +1527,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		return nil, err
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespaceEntry.GetInfo().Name, "RespondActivityTaskCompleted")
 
 	if err := common.CheckEventBlobSizeLimit(
 		request.GetResult().Size(),
@@ -1413,7 +1538,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 		taskToken.GetRunId(),
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RespondActivityTaskCompleted"),
+		"RespondActivityTaskCompleted",
+		"Result",
 	); err != nil {
 		// result exceeds blob size limit, we would record it as failure
 		failRequest := &workflowservice.RespondActivityTaskFailedRequest{
@@ -1510,8 +1636,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 	// add namespace tag to scope, so further metrics will have the namespace tag
 	scope = scope.Tagged(metrics.NamespaceTag(namespaceEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespaceEntry.GetInfo().Name, "RespondActivityTaskCompletedById")
 
 	if err := common.CheckEventBlobSizeLimit(
 		request.GetResult().Size(),
@@ -1522,7 +1647,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 		runID,
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RespondActivityTaskCompletedById"),
+		"RespondActivityTaskCompletedById",
+		"Result",
 	); err != nil {
 		// result exceeds blob size limit, we would record it as failure
 		failRequest := &workflowservice.RespondActivityTaskFailedRequest{
@@ -1576,6 +1702,9 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	if request == nil {
 		return nil, wh.error(errRequestNotSet, scope)
 	}
+	if err := wh.clientFeatures.checkRequest(request); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.allow("")
@@ -1620,10 +1749,9 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 		return nil, wh.error(errIdentityTooLong, scope)
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespaceEntry.GetInfo().Name, "RespondActivityTaskFailed")
 
-	if err := common.CheckEventBlobSizeLimit(
+	err = common.CheckEventBlobSizeLimit(
 		request.GetFailure().Size(),
 		sizeLimitWarn,
 		sizeLimitError,
@@ -1632,12 +1760,10 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 		taskToken.GetRunId(),
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RespondActivityTaskFailed"),
-	); err != nil {
-		serverFailure := failure.NewServerFailure(common.FailureReasonFailureExceedsLimit, false)
-		serverFailure.Cause = failure.Truncate(request.Failure, sizeLimitWarn)
-		request.Failure = serverFailure
-	}
+		"RespondActivityTaskFailed",
+		"Failure",
+	)
+	request.Failure = wh.truncateFailure(request.Failure, namespaceEntry.GetInfo().Name, err != nil, sizeLimitWarn, scope)
 
 	_, err = wh.GetHistoryClient().RespondActivityTaskFailed(ctx, &historyservice.RespondActivityTaskFailedRequest{
 		NamespaceId:   namespaceID,
@@ -1671,6 +1797,9 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 	if request == nil {
 		return nil, wh.error(errRequestNotSet, scope)
 	}
+	if err := wh.clientFeatures.checkRequest(request); err != nil {
+		return nil, wh.error(err, scope)
+	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	wh.allow("")
@@ -1717,10 +1846,9 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 	// add namespace tag to scope, so further metrics will have the namespace tag
 	scope = scope.Tagged(metrics.NamespaceTag(namespaceEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespaceEntry.GetInfo().Name, "RespondActivityTaskFailedById")
 
-	if err := common.CheckEventBlobSizeLimit(
+	err = common.CheckEventBlobSizeLimit(
 		request.GetFailure().Size(),
 		sizeLimitWarn,
 		sizeLimitError,
@@ -1729,12 +1857,10 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 		runID,
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RespondActivityTaskFailedById"),
-	); err != nil {
-		serverFailure := failure.NewServerFailure(common.FailureReasonFailureExceedsLimit, false)
-		serverFailure.Cause = failure.Truncate(request.Failure, sizeLimitWarn)
-		request.Failure = serverFailure
-	}
+		"RespondActivityTaskFailedById",
+		"Failure",
+	)
+	request.Failure = wh.truncateFailure(request.Failure, namespaceEntry.GetInfo().Name, err != nil, sizeLimitWarn, scope)
 
 	req := &workflowservice.RespondActivityTaskFailedRequest{
 		TaskToken: token,
@@ -1810,8 +1936,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(ctx context.Context, requ
 		return nil, wh.error(errIdentityTooLong, scope)
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespaceEntry.GetInfo().Name, "RespondActivityTaskCanceled")
 
 	if err := common.CheckEventBlobSizeLimit(
 		request.GetDetails().Size(),
@@ -1822,7 +1947,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(ctx context.Context, requ
 		taskToken.GetRunId(),
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RespondActivityTaskCanceled"),
+		"RespondActivityTaskCanceled",
+		"Details",
 	); err != nil {
 		// details exceeds blob size limit, we would record it as failure
 		failRequest := &workflowservice.RespondActivityTaskFailedRequest{
@@ -1918,8 +2044,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledById(ctx context.Context,
 	// add namespace tag to scope, so further metrics will have the namespace tag
 	scope = scope.Tagged(metrics.NamespaceTag(namespaceEntry.GetInfo().Name))
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespaceEntry.GetInfo().Name, "RespondActivityTaskCanceledById")
 
 	if err := common.CheckEventBlobSizeLimit(
 		request.GetDetails().Size(),
@@ -1930,7 +2055,8 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledById(ctx context.Context,
 		runID,
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RespondActivityTaskCanceledById"),
+		"RespondActivityTaskCanceledById",
+		"Details",
 	); err != nil {
 		// details exceeds blob size limit, we would record it as failure
 		failRequest := &workflowservice.RespondActivityTaskFailedRequest{
@@ -2010,6 +2136,8 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(ctx context.Context, r
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	// run closes only once workflow handles the cancellation
+	wh.setExecutionVisibilityConsistencyToken(ctx, request.GetNamespace(), namespaceID, request.GetWorkflowExecution(), visibilityRecordVersionStarted)
 
 	return &workflowservice.RequestCancelWorkflowExecutionResponse{}, nil
 }
@@ -2022,53 +2150,76 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendSignalWorkflowExecutionScope, request.GetNamespace())
 	defer sw.Stop()
 
+	namespaceID, err := wh.validateSignalWorkflowExecutionRequest(ctx, request, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = wh.GetHistoryClient().SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId:   namespaceID,
+		SignalRequest: request,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	wh.setExecutionVisibilityConsistencyToken(ctx, request.GetNamespace(), namespaceID, request.GetWorkflowExecution(), visibilityRecordVersionStarted)
+
+	return &workflowservice.SignalWorkflowExecutionResponse{}, nil
+}
+
+// validateSignalWorkflowExecutionRequest validates the signal request, it returns ID of the namespace of the request
+func (wh *WorkflowHandler) validateSignalWorkflowExecutionRequest(
+	ctx context.Context,
+	request *workflowservice.SignalWorkflowExecutionRequest,
+	scope metrics.Scope,
+) (string, error) {
+
 	if wh.isStopped() {
-		return nil, errShuttingDown
+		return "", errShuttingDown
 	}
 
 	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
-		return nil, wh.error(err, scope)
+		return "", wh.error(err, scope)
 	}
 
 	if request == nil {
-		return nil, wh.error(errRequestNotSet, scope)
+		return "", wh.error(errRequestNotSet, scope)
 	}
 
 	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+		return "", wh.error(errServiceBusy, scope)
 	}
 
 	if request.GetNamespace() == "" {
-		return nil, wh.error(errNamespaceNotSet, scope)
+		return "", wh.error(errNamespaceNotSet, scope)
 	}
 
 	if len(request.GetNamespace()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errNamespaceTooLong, scope)
+		return "", wh.error(errNamespaceTooLong, scope)
 	}
 
 	if err := wh.validateExecution(request.WorkflowExecution, scope); err != nil {
-		return nil, err
+		return "", err
 	}
 
 	if request.GetSignalName() == "" {
-		return nil, wh.error(errSignalNameTooLong, scope)
+		return "", wh.error(errSignalNameTooLong, scope)
 	}
 
 	if len(request.GetSignalName()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errSignalNameTooLong, scope)
+		return "", wh.error(errSignalNameTooLong, scope)
 	}
 
 	if len(request.GetRequestId()) > wh.config.MaxIDLengthLimit() {
-		return nil, wh.error(errRequestIDTooLong, scope)
+		return "", wh.error(errRequestIDTooLong, scope)
 	}
 
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
 	if err != nil {
-		return nil, wh.error(err, scope)
+		return "", wh.error(err, scope)
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(request.GetNamespace())
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(request.GetNamespace())
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(request.GetNamespace(), "SignalWorkflowExecution")
 	if err := common.CheckEventBlobSizeLimit(
 		request.GetInput().Size(),
 		sizeLimitWarn,
@@ -2078,20 +2229,13 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 		request.GetWorkflowExecution().GetRunId(),
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("SignalWorkflowExecution"),
+		"SignalWorkflowExecution",
+		"Input",
 	); err != nil {
-		return nil, wh.error(err, scope)
+		return "", wh.error(err, scope)
 	}
 
-	_, err = wh.GetHistoryClient().SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
-		NamespaceId:   namespaceID,
-		SignalRequest: request,
-	})
-	if err != nil {
-		return nil, wh.error(err, scope)
-	}
-
-	return &workflowservice.SignalWorkflowExecutionResponse{}, nil
+	return namespaceID, nil
 }
 
 // SignalWithStartWorkflowExecution is used to ensure sending signal to a workflow.
@@ -2185,8 +2329,9 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(err, scope)
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespace)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespace)
+	request.Header, request.Memo = propagateRequestMetadata(ctx, wh.config.PropagatedRequestHeaders(namespace), request.Header, request.Memo)
+
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespace, "SignalWithStartWorkflowExecution")
 	if err := common.CheckEventBlobSizeLimit(
 		request.GetSignalInput().Size(),
 		sizeLimitWarn,
@@ -2196,7 +2341,8 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		"",
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("SignalWithStartWorkflowExecution"),
+		"SignalWithStartWorkflowExecution",
+		"SignalInput",
 	); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2210,7 +2356,8 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		"",
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("SignalWithStartWorkflowExecution"),
+		"SignalWithStartWorkflowExecution",
+		"Input and Memo",
 	); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2231,6 +2378,8 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(err, scope)
 	}
 
+	wh.signalNamespaceQuotaUtilization(ctx, namespace, scope)
+	wh.setVisibilityConsistencyToken(ctx, namespace, namespaceID, runId, visibilityRecordVersionStarted)
 	return &workflowservice.SignalWithStartWorkflowExecutionResponse{RunId: runId}, nil
 }
 
@@ -2278,6 +2427,7 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context, request *
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	wh.setVisibilityConsistencyToken(ctx, request.GetNamespace(), namespaceID, resp.GetRunId(), visibilityRecordVersionStarted)
 
 	return &workflowservice.ResetWorkflowExecutionResponse{RunId: resp.GetRunId()}, nil
 }
@@ -2326,6 +2476,7 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context, reque
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
+	wh.setExecutionVisibilityConsistencyToken(ctx, request.GetNamespace(), namespaceID, request.GetWorkflowExecution(), visibilityRecordVersionClosed)
 
 	return &workflowservice.TerminateWorkflowExecutionResponse{}, nil
 }
@@ -2424,6 +2575,7 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context, reque
 		return nil, wh.error(err, scope)
 	}
 
+	wh.redactResponse(ctx, request.GetNamespace(), persistenceResp.Executions)
 	return &workflowservice.ListOpenWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
 		NextPageToken: persistenceResp.NextPageToken,
@@ -2539,6 +2691,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context, req
 		return nil, wh.error(err, scope)
 	}
 
+	wh.redactResponse(ctx, request.GetNamespace(), persistenceResp.Executions)
 	return &workflowservice.ListClosedWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
 		NextPageToken: persistenceResp.NextPageToken,
@@ -2590,6 +2743,10 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		return nil, wh.error(err, scope)
 	}
 
+	if err := wh.waitForVisibilityConsistency(ctx, namespace, namespaceID); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	req := &persistence.ListWorkflowExecutionsRequestV2{
 		NamespaceID:   namespaceID,
 		Namespace:     namespace,
@@ -2602,6 +2759,7 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		return nil, wh.error(err, scope)
 	}
 
+	wh.redactResponse(ctx, request.GetNamespace(), persistenceResp.Executions)
 	return &workflowservice.ListWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
 		NextPageToken: persistenceResp.NextPageToken,
@@ -2690,6 +2848,7 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(ctx context.Context, r
 		}
 	}
 
+	wh.redactResponse(ctx, request.GetNamespace(), archiverResponse.Executions)
 	return &workflowservice.ListArchivedWorkflowExecutionsResponse{
 		Executions:    archiverResponse.Executions,
 		NextPageToken: archiverResponse.NextPageToken,
@@ -2753,6 +2912,7 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context, request *
 		return nil, wh.error(err, scope)
 	}
 
+	wh.redactResponse(ctx, request.GetNamespace(), persistenceResp.Executions)
 	resp := &workflowservice.ScanWorkflowExecutionsResponse{
 		Executions:    persistenceResp.Executions,
 		NextPageToken: persistenceResp.NextPageToken,
@@ -2888,8 +3048,7 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 		return nil, err
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.GetInfo().Name)
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.GetInfo().Name)
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(namespaceEntry.GetInfo().Name, "RespondQueryTaskCompleted")
 
 	if err := common.CheckEventBlobSizeLimit(
 		request.GetQueryResult().Size(),
@@ -2900,7 +3059,8 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 		"",
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("RespondQueryTaskCompleted"),
+		"RespondQueryTaskCompleted",
+		"QueryResult",
 	); err != nil {
 		request = &workflowservice.RespondQueryTaskCompletedRequest{
 			TaskToken:     request.TaskToken,
@@ -3017,8 +3177,7 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 		return nil, wh.error(err, scope)
 	}
 
-	sizeLimitError := wh.config.BlobSizeLimitError(request.GetNamespace())
-	sizeLimitWarn := wh.config.BlobSizeLimitWarn(request.GetNamespace())
+	sizeLimitWarn, sizeLimitError := wh.blobSizeLimits(request.GetNamespace(), "QueryWorkflow")
 
 	if err := common.CheckEventBlobSizeLimit(
 		request.GetQuery().GetQueryArgs().Size(),
@@ -3029,7 +3188,9 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 		request.GetExecution().GetRunId(),
 		scope.Tagged(metrics.CommandTypeTag(enumspb.COMMAND_TYPE_UNSPECIFIED.String())),
 		wh.GetThrottledLogger(),
-		tag.BlobSizeViolationOperation("QueryWorkflow")); err != nil {
+		"QueryWorkflow",
+		"QueryArgs",
+	); err != nil {
 		return nil, wh.error(err, scope)
 	}
 
@@ -3088,12 +3249,14 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 		return nil, wh.error(err, scope)
 	}
 
-	return &workflowservice.DescribeWorkflowExecutionResponse{
+	resp := &workflowservice.DescribeWorkflowExecutionResponse{
 		ExecutionConfig:       response.GetExecutionConfig(),
 		WorkflowExecutionInfo: response.GetWorkflowExecutionInfo(),
 		PendingActivities:     response.GetPendingActivities(),
 		PendingChildren:       response.GetPendingChildren(),
-	}, nil
+	}
+	wh.redactResponse(ctx, request.GetNamespace(), resp)
+	return resp, nil
 }
 
 // DescribeTaskQueue returns information about the target taskqueue, right now this API returns the
@@ -3413,7 +3576,7 @@ func (wh *WorkflowHandler) error(err error, scope metrics.Scope, tagsForErrorLog
 	case *serviceerror.NamespaceNotActive:
 		scope.IncCounter(metrics.ServiceErrNamespaceNotActiveCounter)
 		return err
-	case *serviceerror.ResourceExhausted:
+	case *serviceerror.ResourceExhausted, *serviceerrors.HistoryDegraded:
 		scope.IncCounter(metrics.ServiceErrResourceExhaustedCounter)
 		return err
 	case *serviceerror.NotFound:
@@ -3462,6 +3625,22 @@ func (wh *WorkflowHandler) validateExecution(w *commonpb.WorkflowExecution, scop
 	return nil
 }
 
+// suggestContinueAsNew passes continue-as-new suggestion, computed by history for the workflow task, to SDK.
+// Public workflow task responses have no field for the suggestion, so it is set as response header.
+func (wh *WorkflowHandler) suggestContinueAsNew(
+	ctx context.Context,
+	namespace string,
+	reasons []string,
+) {
+	if len(reasons) == 0 {
+		return
+	}
+	header := metadata.Pairs(headers.SuggestContinueAsNewHeaderName, strings.Join(reasons, ","))
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		wh.GetThrottledLogger().Debug("Unable to set suggest continue-as-new header.", tag.WorkflowNamespace(namespace), tag.Error(err))
+	}
+}
+
 func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 	ctx context.Context,
 	scope metrics.Scope,
@@ -3680,6 +3859,7 @@ func (wh *WorkflowHandler) getArchivedHistory(
 	for _, batch := range resp.HistoryBatches {
 		history.Events = append(history.Events, batch.Events...)
 	}
+	wh.redactResponse(ctx, request.GetNamespace(), history)
 	return &workflowservice.GetWorkflowExecutionHistoryResponse{
 		History:       history,
 		NextPageToken: resp.NextPageToken,
@@ -3700,33 +3880,144 @@ func (wh *WorkflowHandler) isListRequestPageSizeTooLarge(pageSize int32, namespa
 		pageSize > int32(wh.config.ESIndexMaxResultWindow())
 }
 
+// blobSizeLimits returns warn and error blob size limits of API, taking per API overrides of namespace into account
+func (wh *WorkflowHandler) blobSizeLimits(namespace string, operation string) (int, int) {
+	return common.GetBlobSizeLimits(
+		wh.config.BlobSizeLimitOverrides(namespace),
+		operation,
+		wh.config.BlobSizeLimitWarn(namespace),
+		wh.config.BlobSizeLimitError(namespace),
+	)
+}
+
+// truncateFailure truncates the reported failure to the failure size limits of the namespace. Failure exceeding
+// blob size limit is replaced by server failure, which has the failure truncated to the warn limit as its cause.
+func (wh *WorkflowHandler) truncateFailure(
+	f *failurepb.Failure,
+	namespace string,
+	exceedsSizeLimit bool,
+	sizeLimitWarn int,
+	scope metrics.Scope,
+) *failurepb.Failure {
+	limits := failure.TruncationLimits{
+		MaxMessageSize:    wh.config.FailureMessageSizeLimit(namespace),
+		MaxStackTraceSize: wh.config.FailureStackTraceSizeLimit(namespace),
+	}
+	if exceedsSizeLimit {
+		limits.MaxSize = sizeLimitWarn
+	}
+	truncated, ok := failure.TruncateWithLimits(f, limits)
+	if ok {
+		scope.IncCounter(metrics.FailureTruncated)
+	}
+	if !exceedsSizeLimit {
+		return truncated
+	}
+
+	serverFailure := failure.NewServerFailure(common.FailureReasonFailureExceedsLimit, false)
+	serverFailure.Cause = truncated
+	return serverFailure
+}
+
 func (wh *WorkflowHandler) allow(namespace string) bool {
-	return wh.rateLimiter.Allow(namespace)
+	if !wh.rateLimiter.Allow(namespace) {
+		return false
+	}
+	wh.quotaUsage.record(namespace)
+	return true
+}
+
+// acquirePoll checks limits of outstanding polls of namespace from the worker identity and the client IP,
+// returned function must be called when the poll completes
+func (wh *WorkflowHandler) acquirePoll(ctx context.Context, namespace string, identity string) (func(), error) {
+	var acquiredKeys []string
+	release := func() {
+		for _, key := range acquiredKeys {
+			wh.pollerLimiter.release(key)
+		}
+	}
+
+	if identity != "" {
+		key := namespace + "/identity/" + identity
+		if !wh.pollerLimiter.acquire(key, wh.config.MaxPollersPerIdentity(namespace)) {
+			return nil, errTooManyPollsFromIdentity
+		}
+		acquiredKeys = append(acquiredKeys, key)
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip := p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+		key := namespace + "/ip/" + ip
+		if !wh.pollerLimiter.acquire(key, wh.config.MaxPollersPerIP(namespace)) {
+			release()
+			return nil, errTooManyPollsFromIP
+		}
+		acquiredKeys = append(acquiredKeys, key)
+	}
+
+	return release, nil
 }
 
 func (wh *WorkflowHandler) initNamespaceRateLimiter(namespace string) quotas.RateLimiter {
+	ringSize := wh.getFrontendRingSize(namespace)
+	return quotas.NewDefaultIncomingDynamicRateLimiter(
+		func() float64 { return wh.getNamespaceRPS(namespace, ringSize) },
+	)
+}
+
+// getFrontendRingSize returns the number of frontend hosts which share global namespace rate limit, or 0 if it is not shared
+func (wh *WorkflowHandler) getFrontendRingSize(namespace string) int {
 	monitor := wh.GetMembershipMonitor()
 	if monitor == nil || wh.config.GlobalNamespaceRPS(namespace) == 0 {
-		return quotas.NewDefaultIncomingDynamicRateLimiter(
-			func() float64 { return float64(wh.config.MaxNamespaceRPSPerInstance(namespace)) },
-		)
+		return 0
 	}
 
 	ringSize, err := monitor.GetMemberCount(common.FrontendServiceName)
-	if err != nil || ringSize == 0 {
-		return quotas.NewDefaultIncomingDynamicRateLimiter(
-			func() float64 { return float64(wh.config.MaxNamespaceRPSPerInstance(namespace)) },
-		)
+	if err != nil {
+		return 0
 	}
+	return ringSize
+}
 
-	return quotas.NewDefaultIncomingDynamicRateLimiter(
-		func() float64 {
-			return float64(common.MinInt(
-				wh.config.MaxNamespaceRPSPerInstance(namespace),
-				common.MaxInt(wh.config.GlobalNamespaceRPS(namespace)/ringSize, 1),
-			))
-		},
-	)
+func (wh *WorkflowHandler) getNamespaceRPS(namespace string, ringSize int) float64 {
+	if ringSize == 0 {
+		return float64(wh.config.MaxNamespaceRPSPerInstance(namespace))
+	}
+	return float64(common.MinInt(
+		wh.config.MaxNamespaceRPSPerInstance(namespace),
+		common.MaxInt(wh.config.GlobalNamespaceRPS(namespace)/ringSize, 1),
+	))
+}
+
+// signalNamespaceQuotaUtilization emits utilization of namespace rate limit and, once it crosses the warning threshold,
+// sets response header so callers can slow down before requests start being rejected with ResourceExhausted
+func (wh *WorkflowHandler) signalNamespaceQuotaUtilization(ctx context.Context, namespace string, scope metrics.Scope) {
+	rps := wh.getNamespaceRPS(namespace, wh.getFrontendRingSize(namespace))
+	utilization := wh.quotaUsage.utilization(namespace, rps)
+	scope.UpdateGauge(metrics.NamespaceQuotaUtilization, utilization)
+
+	threshold := wh.config.NamespaceQuotaWarningThreshold(namespace)
+	if threshold <= 0 || utilization < threshold {
+		return
+	}
+
+	scope.IncCounter(metrics.NamespaceQuotaWarningCounter)
+	header := metadata.Pairs(headers.NamespaceQuotaUtilizationHeaderName, strconv.FormatFloat(utilization, 'f', 2, 64))
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		wh.GetThrottledLogger().Debug("Unable to set namespace quota utilization header.", tag.WorkflowNamespace(namespace), tag.Error(err))
+	}
+}
+
+// isPollRetryable returns true if poll can be retried on the error, polls shed by matching while history
+// is degraded are not retried, so the client backs off as hinted instead of being parked again
+func isPollRetryable(err error) bool {
+	if _, ok := err.(*serviceerrors.HistoryDegraded); ok {
+		return false
+	}
+	return common.IsServiceTransientError(err)
 }
 
 func (wh *WorkflowHandler) cancelOutstandingPoll(ctx context.Context, err error, namespaceID string, taskQueueType enumspb.TaskQueueType,
@@ -26,7 +26,11 @@ package frontend
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"net"
 	"testing"
 	"time"
 
@@ -37,33 +41,48 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 	filterpb "go.temporal.io/api/filter/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/intakeservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/api/runchainservice/v1"
+	"go.temporal.io/server/api/searchattributesservice/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/api/workflowreportservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/mocks"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/dynamicconfig"
 	dc "go.temporal.io/server/common/service/dynamicconfig"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
 const (
@@ -152,7 +171,7 @@ func (s *workflowHandlerSuite) TearDownTest() {
 }
 
 func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandler {
-	return NewWorkflowHandler(s.mockResource, config, s.mockProducer).(*WorkflowHandler)
+	return NewWorkflowHandler(s.mockResource, config, s.mockProducer, nil).(*WorkflowHandler)
 }
 
 func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
@@ -271,6 +290,76 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_RequestIdNotSet
 	s.Equal(errRequestIDNotSet, err)
 }
 
+func (s *workflowHandlerSuite) TestIntakeHandler_AcceptStartWorkflowExecution() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	handler := NewIntakeHandler(s.getWorkflowHandler(config))
+
+	startRequest := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:  s.testNamespace,
+		WorkflowId: "workflow-id",
+		WorkflowType: &commonpb.WorkflowType{
+			Name: "workflow-type",
+		},
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name: "task-queue",
+		},
+		RequestId: uuid.New(),
+	}
+	request := &intakeservice.AcceptStartWorkflowExecutionRequest{
+		Namespace: s.testNamespace,
+		Request:   startRequest,
+	}
+	_, err := handler.AcceptStartWorkflowExecution(context.Background(), request)
+	s.Equal(errAsyncIntakeNotAllowed, err)
+
+	config.EnableAsyncIntake = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	_, err = handler.AcceptStartWorkflowExecution(context.Background(), &intakeservice.AcceptStartWorkflowExecutionRequest{
+		Namespace: "other-namespace",
+		Request:   startRequest,
+	})
+	s.Equal(errIntakeNamespaceMismatch, err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockResource.IntakeQueue.EXPECT().Enqueue(gomock.Any()).DoAndReturn(func(message *persistence.IntakeMessage) (string, error) {
+		s.Equal(s.testNamespaceID, message.NamespaceID)
+		s.Equal(startRequest, message.StartRequest)
+		s.False(message.EnqueueTime.IsZero())
+		return "token", nil
+	})
+	resp, err := handler.AcceptStartWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+	s.Equal("token", resp.GetAcceptanceToken())
+}
+
+func (s *workflowHandlerSuite) TestIntakeHandler_AcceptSignalWorkflowExecution() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	config.EnableAsyncIntake = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	handler := NewIntakeHandler(s.getWorkflowHandler(config))
+
+	signalRequest := &workflowservice.SignalWorkflowExecutionRequest{
+		Namespace: s.testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflow-id",
+		},
+		SignalName: "signal",
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockResource.IntakeQueue.EXPECT().Enqueue(gomock.Any()).DoAndReturn(func(message *persistence.IntakeMessage) (string, error) {
+		s.Equal(s.testNamespaceID, message.NamespaceID)
+		s.Equal("signal", message.SignalRequest.GetSignalName())
+		s.NotEmpty(message.SignalRequest.GetRequestId())
+		return "token", nil
+	})
+	resp, err := handler.AcceptSignalWorkflowExecution(context.Background(), &intakeservice.AcceptSignalWorkflowExecutionRequest{
+		Namespace: s.testNamespace,
+		Request:   signalRequest,
+	})
+	s.NoError(err)
+	s.Equal("token", resp.GetAcceptanceToken())
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
 	config := s.newConfig()
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -535,8 +624,8 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskTime
 
 func (s *workflowHandlerSuite) TestRegisterNamespace_Failure_InvalidArchivalURI() {
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(false)
-	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI", nil))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI", nil))
 	s.mockMetadataMgr.On("GetNamespace", mock.Anything).Return(nil, serviceerror.NewNotFound(""))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(errors.New("invalid URI"))
@@ -559,8 +648,8 @@ func (s *workflowHandlerSuite) TestRegisterNamespace_Success_EnabledWithNoArchiv
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(false)
 	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
-	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", testHistoryArchivalURI))
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", testVisibilityArchivalURI))
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", testHistoryArchivalURI, nil))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", testVisibilityArchivalURI, nil))
 	s.mockMetadataMgr.On("GetNamespace", mock.Anything).Return(nil, serviceerror.NewNotFound(""))
 	s.mockMetadataMgr.On("CreateNamespace", mock.Anything).Return(&persistence.CreateNamespaceResponse{
 		ID: testNamespaceID,
@@ -581,8 +670,8 @@ func (s *workflowHandlerSuite) TestRegisterNamespace_Success_EnabledWithArchival
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(false)
 	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
-	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "invalidURI"))
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "invalidURI"))
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "invalidURI", nil))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "invalidURI", nil))
 	s.mockMetadataMgr.On("GetNamespace", mock.Anything).Return(nil, serviceerror.NewNotFound(""))
 	s.mockMetadataMgr.On("CreateNamespace", mock.Anything).Return(&persistence.CreateNamespaceResponse{
 		ID: testNamespaceID,
@@ -631,8 +720,8 @@ func (s *workflowHandlerSuite) TestRegisterNamespace_Success_NotEnabled() {
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(false)
 	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
-	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
 	s.mockMetadataMgr.On("GetNamespace", mock.Anything).Return(nil, serviceerror.NewNotFound(""))
 	s.mockMetadataMgr.On("CreateNamespace", mock.Anything).Return(&persistence.CreateNamespaceResponse{
 		ID: testNamespaceID,
@@ -700,8 +789,8 @@ func (s *workflowHandlerSuite) TestUpdateNamespace_Failure_UpdateExistingArchiva
 		&namespace.ArchivalState{State: enumspb.ARCHIVAL_STATE_ENABLED, URI: testVisibilityArchivalURI},
 	)
 	s.mockMetadataMgr.On("GetNamespace", mock.Anything).Return(getNamespaceResp, nil)
-	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
 
@@ -726,7 +815,7 @@ func (s *workflowHandlerSuite) TestUpdateNamespace_Failure_InvalidArchivalURI()
 		&namespace.ArchivalState{State: enumspb.ARCHIVAL_STATE_DISABLED, URI: ""},
 	)
 	s.mockMetadataMgr.On("GetNamespace", mock.Anything).Return(getNamespaceResp, nil)
-	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(errors.New("invalid URI"))
 	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
 
@@ -754,8 +843,8 @@ func (s *workflowHandlerSuite) TestUpdateNamespace_Success_ArchivalEnabledToArch
 	s.mockMetadataMgr.On("UpdateNamespace", mock.Anything).Return(nil)
 	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
-	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
@@ -818,8 +907,8 @@ func (s *workflowHandlerSuite) TestUpdateNamespace_Success_ArchivalEnabledToArch
 	s.mockMetadataMgr.On("UpdateNamespace", mock.Anything).Return(nil)
 	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
-	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
@@ -854,8 +943,8 @@ func (s *workflowHandlerSuite) TestUpdateNamespace_Success_ArchivalEnabledToEnab
 	s.mockMetadataMgr.On("GetNamespace", mock.Anything).Return(getNamespaceResp, nil)
 	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
-	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
@@ -891,8 +980,8 @@ func (s *workflowHandlerSuite) TestUpdateNamespace_Success_ArchivalNeverEnabledT
 	s.mockMetadataMgr.On("UpdateNamespace", mock.Anything).Return(nil)
 	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
-	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI", nil))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
@@ -1110,7 +1199,7 @@ func (s *workflowHandlerSuite) TestListArchivedVisibility_Failure_ClusterNotConf
 
 func (s *workflowHandlerSuite) TestListArchivedVisibility_Failure_NamespaceCacheEntryError() {
 	s.mockNamespaceCache.EXPECT().GetNamespace(gomock.Any()).Return(nil, errors.New("error getting namespace"))
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI", nil))
 
 	wh := s.getWorkflowHandler(s.newConfig())
 
@@ -1128,7 +1217,7 @@ func (s *workflowHandlerSuite) TestListArchivedVisibility_Failure_NamespaceNotCo
 		"",
 		nil,
 	), nil)
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI", nil))
 
 	wh := s.getWorkflowHandler(s.newConfig())
 
@@ -1147,7 +1236,7 @@ func (s *workflowHandlerSuite) TestListArchivedVisibility_Failure_InvalidURI() {
 		"",
 		nil,
 	), nil)
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI", nil))
 
 	wh := s.getWorkflowHandler(s.newConfig())
 
@@ -1166,7 +1255,7 @@ func (s *workflowHandlerSuite) TestListArchivedVisibility_Success() {
 		"",
 		nil,
 	), nil).AnyTimes()
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI", nil))
 	s.mockVisibilityArchiver.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.QueryVisibilityResponse{}, nil)
 	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
 
@@ -1215,6 +1304,73 @@ func (s *workflowHandlerSuite) TestListWorkflowExecutions() {
 	s.NotNil(err)
 }
 
+func (s *workflowHandlerSuite) TestSetExecutionVisibilityConsistencyToken_OnlyWhenRequested() {
+	wh := s.getWorkflowHandler(s.newConfig())
+	execution := &commonpb.WorkflowExecution{WorkflowId: testWorkflowID}
+
+	// current run is not resolved for callers which don't ask for the token
+	wh.setExecutionVisibilityConsistencyToken(context.Background(), s.testNamespace, s.testNamespaceID, execution, visibilityRecordVersionStarted)
+
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), &historyservice.GetMutableStateRequest{
+		NamespaceId: s.testNamespaceID,
+		Execution:   execution,
+	}).Return(&historyservice.GetMutableStateResponse{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: uuid.New()},
+	}, nil).Times(1)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.RequestVisibilityConsistencyTokenHeaderName, "true"))
+	wh.setExecutionVisibilityConsistencyToken(ctx, s.testNamespace, s.testNamespaceID, execution, visibilityRecordVersionStarted)
+}
+
+func (s *workflowHandlerSuite) TestListWorkflowExecutions_VisibilityConsistencyToken() {
+	config := s.newConfig()
+	config.VisibilityConsistencyMaxWait = dc.GetDurationPropertyFnFilteredByNamespace(time.Second)
+	wh := s.getWorkflowHandler(config)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(s.testNamespaceID, nil).AnyTimes()
+
+	runID := uuid.New()
+	token, err := serializeVisibilityConsistencyToken(&visibilityConsistencyToken{
+		NamespaceID: s.testNamespaceID,
+		RunID:       runID,
+		Version:     visibilityRecordVersionClosed,
+	})
+	s.NoError(err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.VisibilityConsistencyTokenHeaderName, token))
+	listRequest := &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace: s.testNamespace,
+		Query:     "WorkflowId = 'wid'",
+	}
+
+	isTokenQuery := func(request *persistence.ListWorkflowExecutionsRequestV2) bool {
+		return request.Query == "RunId = '"+runID+"'"
+	}
+	s.mockVisibilityMgr.On("ListWorkflowExecutions", mock.MatchedBy(isTokenQuery)).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+	// record of run which is still open is older than the token
+	s.mockVisibilityMgr.On("ListWorkflowExecutions", mock.MatchedBy(isTokenQuery)).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING}},
+	}, nil).Once()
+	s.mockVisibilityMgr.On("ListWorkflowExecutions", mock.MatchedBy(isTokenQuery)).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{{Status: enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED}},
+	}, nil).Once()
+	s.mockVisibilityMgr.On("ListWorkflowExecutions", mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsRequestV2) bool {
+		return request.Query == listRequest.Query
+	})).Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+	_, err = wh.ListWorkflowExecutions(ctx, listRequest)
+	s.NoError(err)
+	s.mockVisibilityMgr.AssertExpectations(s.T())
+
+	// token of other namespace is rejected
+	token, err = serializeVisibilityConsistencyToken(&visibilityConsistencyToken{NamespaceID: uuid.New(), RunID: runID})
+	s.NoError(err)
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.VisibilityConsistencyTokenHeaderName, token))
+	_, err = wh.ListWorkflowExecutions(ctx, listRequest)
+	s.Equal(errInvalidVisibilityConsistencyToken, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.VisibilityConsistencyTokenHeaderName, "invalid"))
+	_, err = wh.ListWorkflowExecutions(ctx, listRequest)
+	s.Equal(errInvalidVisibilityConsistencyToken, err)
+}
+
 func (s *workflowHandlerSuite) TestScantWorkflowExecutions() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
@@ -1442,6 +1598,64 @@ func (s *workflowHandlerSuite) checkResponse(err error, response interface{},
 	}
 }
 
+func (s *workflowHandlerSuite) TestAcquirePoll() {
+	config := s.newConfig()
+	config.MaxPollersPerIdentity = dc.GetIntPropertyFilteredByNamespace(2)
+	config.MaxPollersPerIP = dc.GetIntPropertyFilteredByNamespace(3)
+	wh := s.getWorkflowHandler(config)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1234}})
+
+	release1, err := wh.acquirePoll(ctx, s.testNamespace, "worker-1")
+	s.NoError(err)
+	_, err = wh.acquirePoll(ctx, s.testNamespace, "worker-1")
+	s.NoError(err)
+	_, err = wh.acquirePoll(ctx, s.testNamespace, "worker-1")
+	s.Equal(errTooManyPollsFromIdentity, err)
+
+	// identity limit is per namespace
+	release2, err := wh.acquirePoll(ctx, "other-namespace", "worker-1")
+	s.NoError(err)
+	release2()
+
+	_, err = wh.acquirePoll(ctx, s.testNamespace, "worker-2")
+	s.NoError(err)
+	_, err = wh.acquirePoll(ctx, s.testNamespace, "worker-3")
+	s.Equal(errTooManyPollsFromIP, err)
+
+	release1()
+	_, err = wh.acquirePoll(ctx, s.testNamespace, "worker-3")
+	s.NoError(err)
+	_, err = wh.acquirePoll(context.Background(), s.testNamespace, "worker-4")
+	s.NoError(err)
+}
+
+func (s *workflowHandlerSuite) TestDrainLongPolls() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+
+	outstanding := &matchingservice.CancelOutstandingPollRequest{
+		NamespaceId:   s.testNamespaceID,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		TaskQueue:     &taskqueuepb.TaskQueue{Name: "task-queue"},
+		PollerId:      "poller-1",
+	}
+	s.True(wh.pollDrainer.register(outstanding))
+	s.mockMatchingClient.EXPECT().CancelOutstandingPoll(gomock.Any(), outstanding).Return(&matchingservice.CancelOutstandingPollResponse{}, nil)
+	wh.DrainLongPolls()
+
+	// polls received after drain are completed without reaching matching
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	resp, err := wh.PollActivityTaskQueue(ctx, &workflowservice.PollActivityTaskQueueRequest{
+		Namespace: s.testNamespace,
+		TaskQueue: &taskqueuepb.TaskQueue{Name: "task-queue"},
+	})
+	s.NoError(err)
+	s.Equal(&workflowservice.PollActivityTaskQueueResponse{}, resp)
+}
+
 func (s *workflowHandlerSuite) newConfig() *Config {
 	return NewConfig(dc.NewCollection(dc.NewNopClient(), s.mockResource.GetLogger()), numHistoryShards, false)
 }
@@ -1517,7 +1731,7 @@ func (s *workflowHandlerSuite) setupTokenNamespaceTest(tokenNamespace string, en
 	s.mockHistoryClient.EXPECT().RespondActivityTaskCompleted(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
 	s.mockHistoryClient.EXPECT().RespondActivityTaskFailed(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
 	s.mockHistoryClient.EXPECT().RespondActivityTaskCanceled(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
-	s.mockHistoryClient.EXPECT().RespondWorkflowTaskCompleted(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().RespondWorkflowTaskCompleted(ctx, gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 	s.mockHistoryClient.EXPECT().RespondWorkflowTaskFailed(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
 	s.mockMatchingClient.EXPECT().RespondQueryTaskCompleted(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
 	cfg := s.newConfig()
@@ -1619,3 +1833,269 @@ func listArchivedWorkflowExecutionsTestRequest() *workflowservice.ListArchivedWo
 		Query:     "some random query string",
 	}
 }
+
+func (s *workflowHandlerSuite) TestLongPollContext() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// zero timeout keeps the caller deadline
+	pollCtx, pollCancel := wh.longPollContext(ctx, s.testNamespace, 0)
+	s.Equal(ctx, pollCtx)
+	pollCancel()
+
+	pollCtx, pollCancel = wh.longPollContext(ctx, s.testNamespace, 10*time.Second)
+	deadline, ok := pollCtx.Deadline()
+	s.True(ok)
+	s.True(time.Until(deadline) <= 10*time.Second)
+	pollCancel()
+
+	// caller deadline shorter than timeout is kept
+	pollCtx, pollCancel = wh.longPollContext(ctx, s.testNamespace, 2*time.Minute)
+	s.Equal(ctx, pollCtx)
+	pollCancel()
+}
+
+func (s *workflowHandlerSuite) TestRedactResponse() {
+	config := s.newConfig()
+	config.RedactionRules = dc.GetMapPropertyFnWithNamespaceFilter(map[string]interface{}{
+		"Input":             "strip",
+		"header.auth-token": "hash",
+		"result":            "unknown",
+	})
+	config.RedactionHashKey = []byte("secret")
+	wh := s.getWorkflowHandler(config)
+
+	newHistory := func() *historypb.History {
+		return &historypb.History{Events: []*historypb.HistoryEvent{{
+			EventId:   common.FirstEventID,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Input: payloads.EncodeString("input"),
+				Header: &commonpb.Header{Fields: map[string]*commonpb.Payload{
+					"auth-token": payload.EncodeString("token"),
+					"trace-id":   payload.EncodeString("trace"),
+				}},
+			}},
+		}}}
+	}
+	readerCtx := context.WithValue(context.Background(), authorization.ContextKeyMappedClaims, &authorization.Claims{
+		Namespaces: map[string]authorization.Role{s.testNamespace: authorization.RoleReader},
+	})
+	workerCtx := context.WithValue(context.Background(), authorization.ContextKeyMappedClaims, &authorization.Claims{
+		Namespaces: map[string]authorization.Role{s.testNamespace: authorization.RoleReader | authorization.RoleWorker},
+	})
+
+	// callers can't be told apart without claims, and workers need payloads to replay workflows
+	for _, ctx := range []context.Context{context.Background(), workerCtx} {
+		history := newHistory()
+		wh.redactResponse(ctx, s.testNamespace, history)
+		s.Equal(newHistory(), history)
+	}
+
+	history := newHistory()
+	wh.redactResponse(readerCtx, s.testNamespace, history)
+	attributes := history.Events[0].GetWorkflowExecutionStartedEventAttributes()
+	s.Nil(attributes.Input.Payloads[0].Data)
+	s.Equal([]byte(redactedPayloadEncoding), attributes.Input.Payloads[0].Metadata["encoding"])
+	mac := hmac.New(sha256.New, []byte("secret"))
+	_, _ = mac.Write(payload.EncodeString("token").Data)
+	s.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), attributes.Header.Fields["auth-token"].Data)
+	s.Equal(payload.EncodeString("trace"), attributes.Header.Fields["trace-id"])
+
+	// payloads are stripped instead of hashed without a hash key
+	config.RedactionHashKey = nil
+	history = newHistory()
+	wh.redactResponse(readerCtx, s.testNamespace, history)
+	attributes = history.Events[0].GetWorkflowExecutionStartedEventAttributes()
+	s.Nil(attributes.Header.Fields["auth-token"].Data)
+	s.Equal([]byte(redactedPayloadEncoding), attributes.Header.Fields["auth-token"].Metadata["encoding"])
+}
+
+func (s *workflowHandlerSuite) TestUpsertWorkflowSearchAttributes() {
+	config := s.newConfig()
+	handler := NewSearchAttributesHandler(s.mockResource, config)
+
+	newRequest := func(fields map[string]string) *searchattributesservice.UpsertWorkflowSearchAttributesRequest {
+		searchAttributes := &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{}}
+		for k, v := range fields {
+			searchAttributes.IndexedFields[k] = payload.EncodeString(v)
+		}
+		return &searchattributesservice.UpsertWorkflowSearchAttributesRequest{
+			Namespace:        s.testNamespace,
+			Execution:        &commonpb.WorkflowExecution{WorkflowId: testWorkflowID},
+			SearchAttributes: searchAttributes,
+		}
+	}
+	request := newRequest(map[string]string{"CustomKeywordField": "fraud_review"})
+
+	_, err := handler.UpsertWorkflowSearchAttributes(context.Background(), request)
+	s.Equal(errSearchAttributesUpsertDisabled, err)
+
+	config.EnableSearchAttributesUpsert = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	readerCtx := context.WithValue(context.Background(), authorization.ContextKeyMappedClaims, &authorization.Claims{
+		Namespaces: map[string]authorization.Role{s.testNamespace: authorization.RoleReader},
+	})
+	_, err = handler.UpsertWorkflowSearchAttributes(readerCtx, request)
+	s.Equal(errSearchAttributesUpsertDenied, err)
+
+	_, err = handler.UpsertWorkflowSearchAttributes(context.Background(), newRequest(nil))
+	s.Equal(errSearchAttributesUpsertEmpty, err)
+	_, err = handler.UpsertWorkflowSearchAttributes(context.Background(), newRequest(map[string]string{"UnknownField": "value"}))
+	s.Error(err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockHistoryClient.EXPECT().UpsertWorkflowSearchAttributes(gomock.Any(), &historyservice.UpsertWorkflowSearchAttributesRequest{
+		NamespaceId: s.testNamespaceID,
+		Request:     request,
+	}).Return(&historyservice.UpsertWorkflowSearchAttributesResponse{}, nil)
+	writerCtx := context.WithValue(context.Background(), authorization.ContextKeyMappedClaims, &authorization.Claims{
+		Namespaces: map[string]authorization.Role{s.testNamespace: authorization.RoleWriter},
+	})
+	_, err = handler.UpsertWorkflowSearchAttributes(writerCtx, request)
+	s.NoError(err)
+}
+
+func (s *workflowHandlerSuite) TestGetWorkflowReports() {
+	handler := NewWorkflowReportHandler(s.mockResource)
+
+	_, err := handler.GetWorkflowReports(context.Background(), &workflowreportservice.GetWorkflowReportsRequest{Namespace: s.testNamespace})
+	s.Equal(errWorkflowIDNotSet, err)
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: testWorkflowID}
+	reports := map[string]*commonpb.Payload{"progress": payload.EncodeString("50%")}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: s.testNamespaceID,
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: s.testNamespace,
+			Execution: execution,
+		},
+	}).Return(&historyservice.DescribeWorkflowExecutionResponse{WorkflowReports: reports}, nil)
+	resp, err := handler.GetWorkflowReports(context.Background(), &workflowreportservice.GetWorkflowReportsRequest{
+		Namespace: s.testNamespace,
+		Execution: execution,
+	})
+	s.NoError(err)
+	s.Equal(reports, resp.GetReports())
+}
+
+func (s *workflowHandlerSuite) TestTruncateFailure() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+	scope := metrics.NoopScope(metrics.Frontend)
+
+	f := &failurepb.Failure{
+		Message:    "wrapping failure message",
+		StackTrace: "wrapping failure stack trace",
+		Cause:      &failurepb.Failure{Message: "root cause", StackTrace: "root cause stack trace"},
+	}
+	s.Equal(f, wh.truncateFailure(f, s.testNamespace, false, 1000, scope))
+
+	config.FailureStackTraceSizeLimit = dc.GetIntPropertyFilteredByNamespace(10)
+	truncated := wh.truncateFailure(f, s.testNamespace, false, 1000, scope)
+	s.Equal(f.GetMessage(), truncated.GetMessage())
+	s.Equal("wrapping f", truncated.GetStackTrace())
+	s.Equal("root cause", truncated.GetCause().GetMessage())
+	s.Equal("root cause", truncated.GetCause().GetStackTrace())
+
+	truncated = wh.truncateFailure(f, s.testNamespace, true, 30, scope)
+	s.Equal(common.FailureReasonFailureExceedsLimit, truncated.GetMessage())
+	s.NotNil(truncated.GetServerFailureInfo())
+	s.Equal(f.GetMessage(), truncated.GetCause().GetMessage())
+	s.Equal("wrappi", truncated.GetCause().GetStackTrace())
+	s.Nil(truncated.GetCause().GetCause())
+}
+
+func (s *workflowHandlerSuite) TestGetRunChain() {
+	wh := s.getWorkflowHandler(s.newConfig())
+	handler := NewRunChainHandler(wh)
+
+	startTime := time.Now().UTC().Add(-time.Hour)
+	continueTime := startTime.Add(10 * time.Minute)
+	resetTime := startTime.Add(20 * time.Minute)
+	runInfo := func(runID string, status enumspb.WorkflowExecutionStatus, start time.Time, closeTime *time.Time) *workflowpb.WorkflowExecutionInfo {
+		return &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: runID},
+			Status:    status,
+			StartTime: &start,
+			CloseTime: closeTime,
+		}
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockVisibilityMgr.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			runInfo("run-3", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, continueTime, nil),
+		},
+	}, nil).Once()
+	s.mockVisibilityMgr.On("ListClosedWorkflowExecutionsByWorkflowID", mock.Anything).Return(&persistence.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			runInfo("run-2", enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED, continueTime, &resetTime),
+			runInfo("run-1", enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW, startTime, &continueTime),
+		},
+	}, nil).Once()
+
+	startedEvent := func(previousRunID string, initiator enumspb.ContinueAsNewInitiator) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventId:   common.FirstEventID,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				ContinuedExecutionRunId: previousRunID,
+				Initiator:               initiator,
+			}},
+		}
+	}
+	histories := map[string][]*historypb.HistoryEvent{
+		"run-1": {startedEvent("", enumspb.CONTINUE_AS_NEW_INITIATOR_UNSPECIFIED)},
+		"run-2": {startedEvent("run-1", enumspb.CONTINUE_AS_NEW_INITIATOR_WORKFLOW)},
+	}
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.GetMutableStateRequest, _ ...interface{}) (*historyservice.GetMutableStateResponse, error) {
+			runID := request.Execution.GetRunId()
+			response := &historyservice.GetMutableStateResponse{
+				CurrentBranchToken: []byte(runID),
+				NextEventId:        int64(len(histories[runID]) + 1),
+			}
+			if runID == "run-3" {
+				response.ResetBaseRunId = "run-2"
+			}
+			return response, nil
+		}).Times(3)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(
+		func(request *persistence.ReadHistoryBranchRequest) *persistence.ReadHistoryBranchResponse {
+			return &persistence.ReadHistoryBranchResponse{HistoryEvents: histories[string(request.BranchToken)]}
+		}, nil).Twice()
+
+	response, err := handler.GetRunChain(context.Background(), &runchainservice.GetRunChainRequest{
+		Namespace:     s.testNamespace,
+		WorkflowId:    testWorkflowID,
+		MaximumLength: 10,
+	})
+	s.NoError(err)
+	s.False(response.GetTruncated())
+	s.Len(response.GetRuns(), 3)
+	s.Equal("run-1", response.Runs[0].GetRunId())
+	s.Equal(runInitiatorStart, response.Runs[0].GetInitiator())
+	s.Empty(response.Runs[0].GetPreviousRunId())
+	s.Equal("run-2", response.Runs[1].GetRunId())
+	s.Equal(runInitiatorContinueAsNew, response.Runs[1].GetInitiator())
+	s.Equal("run-1", response.Runs[1].GetPreviousRunId())
+	s.Equal("run-3", response.Runs[2].GetRunId())
+	s.Equal(runInitiatorReset, response.Runs[2].GetInitiator())
+	s.Equal("run-2", response.Runs[2].GetPreviousRunId())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, response.Runs[2].GetStatus())
+
+	_, err = handler.GetRunChain(context.Background(), &runchainservice.GetRunChainRequest{
+		Namespace:  s.testNamespace,
+		WorkflowId: testWorkflowID,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *workflowHandlerSuite) TestIsPollRetryable() {
+	s.False(isPollRetryable(serviceerrors.NewHistoryDegraded(time.Second)))
+	s.True(isPollRetryable(serviceerror.NewResourceExhausted("Matching host RPS exceeded.")))
+	s.True(isPollRetryable(serviceerror.NewUnavailable("unavailable")))
+	s.False(isPollRetryable(serviceerror.NewInvalidArgument("invalid")))
+}
@@ -0,0 +1,89 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/workflowreportservice/v1"
+	"go.temporal.io/server/common/resource"
+)
+
+var _ workflowreportservice.WorkflowReportServiceServer = (*WorkflowReportHandler)(nil)
+
+type (
+	// WorkflowReportHandler serves reports published by workflows with the workflow report marker, which history
+	// keeps in mutable state, so that they are available without querying a worker.
+	WorkflowReportHandler struct {
+		resource.Resource
+	}
+)
+
+// NewWorkflowReportHandler creates a gRPC handler for the workflowreportservice
+func NewWorkflowReportHandler(
+	resource resource.Resource,
+) *WorkflowReportHandler {
+	return &WorkflowReportHandler{
+		Resource: resource,
+	}
+}
+
+// GetWorkflowReports returns the latest value of each report published by workflow execution
+func (h *WorkflowReportHandler) GetWorkflowReports(
+	ctx context.Context,
+	request *workflowreportservice.GetWorkflowReportsRequest,
+) (*workflowreportservice.GetWorkflowReportsResponse, error) {
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetExecution().GetWorkflowId() == "" {
+		return nil, errWorkflowIDNotSet
+	}
+
+	namespaceID, err := h.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	response, err := h.GetHistoryClient().DescribeWorkflowExecution(ctx, &historyservice.DescribeWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		Request: &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: request.GetNamespace(),
+			Execution: request.GetExecution(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &workflowreportservice.GetWorkflowReportsResponse{
+		Reports: response.GetWorkflowReports(),
+	}, nil
+}
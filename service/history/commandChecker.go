@@ -43,7 +43,6 @@ import (
 	"go.temporal.io/server/common/elasticsearch/validator"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
-	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/service/dynamicconfig"
@@ -61,8 +60,9 @@ type (
 	}
 
 	workflowSizeChecker struct {
-		blobSizeLimitWarn  int
-		blobSizeLimitError int
+		blobSizeLimitWarn      int
+		blobSizeLimitError     int
+		blobSizeLimitOverrides map[string]interface{}
 
 		historySizeLimitWarn  int
 		historySizeLimitError int
@@ -106,6 +106,7 @@ func newCommandAttrValidator(
 func newWorkflowSizeChecker(
 	blobSizeLimitWarn int,
 	blobSizeLimitError int,
+	blobSizeLimitOverrides map[string]interface{},
 	historySizeLimitWarn int,
 	historySizeLimitError int,
 	historyCountLimitWarn int,
@@ -119,6 +120,7 @@ func newWorkflowSizeChecker(
 	return &workflowSizeChecker{
 		blobSizeLimitWarn:      blobSizeLimitWarn,
 		blobSizeLimitError:     blobSizeLimitError,
+		blobSizeLimitOverrides: blobSizeLimitOverrides,
 		historySizeLimitWarn:   historySizeLimitWarn,
 		historySizeLimitError:  historySizeLimitError,
 		historyCountLimitWarn:  historyCountLimitWarn,
@@ -139,16 +141,23 @@ func (c *workflowSizeChecker) failWorkflowIfPayloadSizeExceedsLimit(
 
 	executionInfo := c.mutableState.GetExecutionInfo()
 	executionState := c.mutableState.GetExecutionState()
-	err := common.CheckEventBlobSizeLimit(
-		payloadSize,
+	blobSizeLimitWarn, blobSizeLimitError := common.GetBlobSizeLimits(
+		c.blobSizeLimitOverrides,
+		commandTypeTag.Value(),
 		c.blobSizeLimitWarn,
 		c.blobSizeLimitError,
+	)
+	err := common.CheckEventBlobSizeLimit(
+		payloadSize,
+		blobSizeLimitWarn,
+		blobSizeLimitError,
 		executionInfo.NamespaceId,
 		executionInfo.WorkflowId,
 		executionState.RunId,
 		c.metricsScope.Tagged(commandTypeTag),
 		c.logger,
-		tag.BlobSizeViolationOperation(commandTypeTag.Value()),
+		commandTypeTag.Value(),
+		"",
 	)
 	if err == nil {
 		return false, nil
@@ -326,7 +335,57 @@ func (v *commandAttrValidator) validateRecordMarkerAttributes(
 	if len(attributes.GetMarkerName()) > v.maxIDLengthLimit {
 		return serviceerror.NewInvalidArgument("MarkerName exceeds length limit.")
 	}
+	if attributes.GetMarkerName() == common.WorkflowReportMarkerName {
+		return v.validateWorkflowReports(attributes.GetDetails())
+	}
+
+	return nil
+}
+
+func (v *commandAttrValidator) validateWorkflowReports(
+	reports map[string]*commonpb.Payloads,
+) error {
 
+	if len(reports) == 0 {
+		return serviceerror.NewInvalidArgument("Details of workflow report marker are not set on command.")
+	}
+	for name, value := range reports {
+		if name == "" {
+			return serviceerror.NewInvalidArgument("Workflow report name is not set on command.")
+		}
+		if len(name) > v.maxIDLengthLimit {
+			return serviceerror.NewInvalidArgument("Workflow report name exceeds length limit.")
+		}
+		if len(value.GetPayloads()) != 1 {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("Workflow report %v must have a single payload.", name))
+		}
+	}
+	return nil
+}
+
+// validateWorkflowReportsSize checks that reports published so far along with the ones of the marker
+// don't exceed the size limit of the namespace
+func (v *commandAttrValidator) validateWorkflowReportsSize(
+	namespace string,
+	published map[string]*commonpb.Payload,
+	reports map[string]*commonpb.Payloads,
+) error {
+
+	size := 0
+	for name, value := range published {
+		if _, ok := reports[name]; ok {
+			// overwritten by the marker
+			continue
+		}
+		size += len(name) + value.Size()
+	}
+	for name, value := range reports {
+		size += len(name) + value.Size()
+	}
+
+	if limit := v.config.WorkflowReportsTotalSizeLimit(namespace); size > limit {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Workflow reports size %v exceeds limit %v.", size, limit))
+	}
 	return nil
 }
 
@@ -92,6 +92,7 @@ func (s *commandAttrValidatorSuite) SetupTest() {
 		SearchAttributesTotalSizeLimit:    dynamicconfig.GetIntPropertyFilteredByNamespace(40 * 1024),
 		DefaultActivityRetryPolicy:        dynamicconfig.GetMapPropertyFnWithNamespaceFilter(common.GetDefaultRetryPolicyConfigOptions()),
 		DefaultWorkflowRetryPolicy:        dynamicconfig.GetMapPropertyFnWithNamespaceFilter(common.GetDefaultRetryPolicyConfigOptions()),
+		WorkflowReportsTotalSizeLimit:     dynamicconfig.GetIntPropertyFilteredByNamespace(64),
 	}
 	s.validator = newCommandAttrValidator(
 		s.mockNamespaceCache,
@@ -169,6 +170,40 @@ func (s *commandAttrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes()
 	s.Nil(err)
 }
 
+func (s *commandAttrValidatorSuite) TestValidateWorkflowReportMarker() {
+	attributes := &commandpb.RecordMarkerCommandAttributes{MarkerName: common.WorkflowReportMarkerName}
+	err := s.validator.validateRecordMarkerAttributes(attributes)
+	s.EqualError(err, "Details of workflow report marker are not set on command.")
+
+	attributes.Details = map[string]*commonpb.Payloads{"": payloads.EncodeString("done")}
+	err = s.validator.validateRecordMarkerAttributes(attributes)
+	s.EqualError(err, "Workflow report name is not set on command.")
+
+	attributes.Details = map[string]*commonpb.Payloads{"progress": {Payloads: []*commonpb.Payload{payload.EncodeString("1"), payload.EncodeString("2")}}}
+	err = s.validator.validateRecordMarkerAttributes(attributes)
+	s.EqualError(err, "Workflow report progress must have a single payload.")
+
+	attributes.Details = map[string]*commonpb.Payloads{"progress": payloads.EncodeString("1")}
+	s.NoError(s.validator.validateRecordMarkerAttributes(attributes))
+
+	// other markers may have any details
+	s.NoError(s.validator.validateRecordMarkerAttributes(&commandpb.RecordMarkerCommandAttributes{MarkerName: "Version"}))
+}
+
+func (s *commandAttrValidatorSuite) TestValidateWorkflowReportsSize() {
+	namespace := "testNamespace"
+	value := payload.EncodeString("progress of the workflow")
+	reports := map[string]*commonpb.Payloads{"progress": {Payloads: []*commonpb.Payload{value}}}
+	s.NoError(s.validator.validateWorkflowReportsSize(namespace, nil, reports))
+
+	// previous value of the same report is replaced
+	published := map[string]*commonpb.Payload{"progress": value}
+	s.NoError(s.validator.validateWorkflowReportsSize(namespace, published, reports))
+
+	published["status"] = value
+	s.Error(s.validator.validateWorkflowReportsSize(namespace, published, reports))
+}
+
 func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_LocalToLocal() {
 	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
 		&persistencespb.NamespaceInfo{Name: s.testNamespaceID},
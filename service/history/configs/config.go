@@ -59,6 +59,10 @@ type Config struct {
 	HistoryCacheMaxSize     dynamicconfig.IntPropertyFn
 	HistoryCacheTTL         dynamicconfig.DurationPropertyFn
 
+	// Workflow lock contention diagnostics
+	LockContentionThreshold  dynamicconfig.DurationPropertyFn
+	LockContentionMaxTracked dynamicconfig.IntPropertyFn
+
 	// EventsCache settings
 	// Change of these configs require shard restart
 	EventsCacheInitialSize dynamicconfig.IntPropertyFn
@@ -120,6 +124,8 @@ type Config struct {
 	TransferProcessorMaxRedispatchQueueSize              dynamicconfig.IntPropertyFn
 	TransferProcessorEnablePriorityTaskProcessor         dynamicconfig.BoolPropertyFn
 	TransferProcessorVisibilityArchivalTimeLimit         dynamicconfig.DurationPropertyFn
+	TransferProcessorHistoryArchivalTimeLimit            dynamicconfig.DurationPropertyFn
+	ArchiveHistoryOnClose                                dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	// ReplicatorQueueProcessor settings
 	ReplicatorTaskBatchSize                                dynamicconfig.IntPropertyFn
@@ -139,12 +145,26 @@ type Config struct {
 	// System Limits
 	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithNamespaceFilter
+	// EnableSignalDLQ is whether signals which can't be applied are captured in the signal DLQ
+	EnableSignalDLQ dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	// MaximumHistoryBranchesPerWorkflow the max number of live history branches of a workflow, 0 means no limit
+	MaximumHistoryBranchesPerWorkflow dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
 	// ShardSyncMinInterval the minimal time interval which the shard info should be sync to remote
 	ShardSyncMinInterval            dynamicconfig.DurationPropertyFn
 	ShardSyncTimerJitterCoefficient dynamicconfig.FloatPropertyFn
+	// ShardJournalEnabled whether mutable state updates are journaled, so that new shard owners can warm their caches
+	ShardJournalEnabled dynamicconfig.BoolPropertyFn
+	// ShardJournalMaxEntries the number of most recent entries kept in the journal of a shard
+	ShardJournalMaxEntries dynamicconfig.IntPropertyFn
+	// ShardJournalWarmUpRPS the rate at which journaled executions are loaded into the cache of a new shard owner
+	ShardJournalWarmUpRPS dynamicconfig.IntPropertyFn
+	// RestoreConsistencyMarkerID the consistency marker shards are reconciled with when they are acquired after a restore
+	RestoreConsistencyMarkerID dynamicconfig.IntPropertyFn
+	// RestoreConsistencyMarkerTrimTasks whether tasks processed before the consistency marker are deleted on restore
+	RestoreConsistencyMarkerTrimTasks dynamicconfig.BoolPropertyFn
 
 	// Time to hold a poll request before returning an empty response
 	// right now only used by GetMutableState
@@ -169,11 +189,17 @@ type Config struct {
 	// Size limit related settings
 	BlobSizeLimitError     dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	BlobSizeLimitOverrides dynamicconfig.MapPropertyFnWithNamespaceFilter
 	HistorySizeLimitError  dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistorySizeLimitWarn   dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// HistorySizeSuggestContinueAsNew and HistoryCountSuggestContinueAsNew are thresholds
+	// above which workflow task responses suggest continue-as-new
+	HistorySizeSuggestContinueAsNew  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	HistoryCountSuggestContinueAsNew dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	// DefaultActivityRetryOptions specifies the out-of-box retry policy if
 	// none is configured on the Activity by the user.
 	DefaultActivityRetryPolicy dynamicconfig.MapPropertyFnWithNamespaceFilter
@@ -181,6 +207,34 @@ type Config struct {
 	// DefaultWorkflowRetryPolicy specifies the out-of-box retry policy for
 	// any unset fields on a RetryPolicy configured on a Workflow
 	DefaultWorkflowRetryPolicy dynamicconfig.MapPropertyFnWithNamespaceFilter
+	// RetryBackoffJitterCoefficient is the max portion of retry backoff which is randomly taken off
+	RetryBackoffJitterCoefficient dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	// CronMaxJitterDuration is the max random delay added to the next cron run
+	CronMaxJitterDuration dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// ActivityTaskQueueReroutes maps task queue to activity types whose tasks are pushed to another task queue
+	ActivityTaskQueueReroutes dynamicconfig.MapPropertyFnWithNamespaceFilter
+	// WorkflowReportsTotalSizeLimit is the size limit of all reports published by a workflow
+	WorkflowReportsTotalSizeLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
+	// ReplicationEventBatchSizeLimit is the size of event batches above which replication is expected to fail
+	ReplicationEventBatchSizeLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
+	// ReplicationChunkedTransferEnabled is whether oversized event batches are replicated in chunks
+	ReplicationChunkedTransferEnabled dynamicconfig.BoolPropertyFn
+	// ReplicationEventChunkSize is the size of chunks of oversized event batches
+	ReplicationEventChunkSize dynamicconfig.IntPropertyFn
+	// ReplicationChunkedEventsCacheSize is the max number of serialized oversized event batches cached per shard
+	ReplicationChunkedEventsCacheSize dynamicconfig.IntPropertyFn
+	// ReplicationChunkedEventsCacheTTL is the TTL of serialized oversized event batches cached per shard
+	ReplicationChunkedEventsCacheTTL dynamicconfig.DurationPropertyFn
+	// EnableTaskLatencyBreakdown is whether latency breakdown of tasks is recorded in execution info
+	EnableTaskLatencyBreakdown dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	// EnableStuckExecutionDetection is whether executions with long pending workflow tasks or activities are flagged in visibility
+	EnableStuckExecutionDetection dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	// StuckActivityStartThreshold is how long an activity may stay scheduled without being started
+	StuckActivityStartThreshold dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// StuckWorkflowTaskStartThreshold is how long a workflow task may stay scheduled without being started
+	StuckWorkflowTaskStartThreshold dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// StuckWorkflowTaskAttemptThreshold is the workflow task attempt at which the execution is considered stuck
+	StuckWorkflowTaskAttemptThreshold dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	// Workflow task settings
 	// StickyTTL is to expire a sticky taskqueue if no update more than this duration
@@ -243,10 +297,27 @@ type Config struct {
 	VisibilityProcessorMaxRedispatchQueueSize              dynamicconfig.IntPropertyFn
 	VisibilityProcessorEnablePriorityTaskProcessor         dynamicconfig.BoolPropertyFn
 	VisibilityProcessorVisibilityArchivalTimeLimit         dynamicconfig.DurationPropertyFn
+	VisibilityProcessorLoadSheddingFailureThreshold        dynamicconfig.IntPropertyFn
+	VisibilityProcessorLoadSheddingProbeInterval           dynamicconfig.DurationPropertyFn
+	VisibilityProcessorCatchUpRPS                          dynamicconfig.IntPropertyFn
 
 	VisibilityQueue            dynamicconfig.StringPropertyFn
 	VisibilityProcessorEnabled dynamicconfig.BoolPropertyFn
 
+	// CustomTaskQueueProcessor settings
+	CustomTaskBatchSize                                dynamicconfig.IntPropertyFn
+	CustomProcessorMaxPollRPS                          dynamicconfig.IntPropertyFn
+	CustomTaskWorkerCount                              dynamicconfig.IntPropertyFn
+	CustomTaskMaxRetryCount                            dynamicconfig.IntPropertyFn
+	CustomProcessorMaxPollInterval                     dynamicconfig.DurationPropertyFn
+	CustomProcessorMaxPollIntervalJitterCoefficient    dynamicconfig.FloatPropertyFn
+	CustomProcessorUpdateAckInterval                   dynamicconfig.DurationPropertyFn
+	CustomProcessorUpdateAckIntervalJitterCoefficient  dynamicconfig.FloatPropertyFn
+	CustomProcessorRedispatchInterval                  dynamicconfig.DurationPropertyFn
+	CustomProcessorRedispatchIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
+	CustomProcessorMaxRedispatchQueueSize              dynamicconfig.IntPropertyFn
+	CustomProcessorEnablePriorityTaskProcessor         dynamicconfig.BoolPropertyFn
+
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -285,6 +356,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
 		HistoryCacheTTL:                      dc.GetDurationProperty(dynamicconfig.HistoryCacheTTL, time.Hour),
+		LockContentionThreshold:              dc.GetDurationProperty(dynamicconfig.HistoryLockContentionThreshold, 100*time.Millisecond),
+		LockContentionMaxTracked:             dc.GetIntProperty(dynamicconfig.HistoryLockContentionMaxTracked, 1000),
 		EventsCacheInitialSize:               dc.GetIntProperty(dynamicconfig.EventsCacheInitialSize, 128),
 		EventsCacheMaxSize:                   dc.GetIntProperty(dynamicconfig.EventsCacheMaxSize, 512),
 		EventsCacheTTL:                       dc.GetDurationProperty(dynamicconfig.EventsCacheTTL, time.Hour),
@@ -338,6 +411,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		TransferProcessorMaxRedispatchQueueSize:              dc.GetIntProperty(dynamicconfig.TransferProcessorMaxRedispatchQueueSize, 10000),
 		TransferProcessorEnablePriorityTaskProcessor:         dc.GetBoolProperty(dynamicconfig.TransferProcessorEnablePriorityTaskProcessor, false),
 		TransferProcessorVisibilityArchivalTimeLimit:         dc.GetDurationProperty(dynamicconfig.TransferProcessorVisibilityArchivalTimeLimit, 200*time.Millisecond),
+		TransferProcessorHistoryArchivalTimeLimit:            dc.GetDurationProperty(dynamicconfig.TransferProcessorHistoryArchivalTimeLimit, 10*time.Second),
+		ArchiveHistoryOnClose:                                dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.ArchiveHistoryOnClose, false),
 
 		ReplicatorTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.ReplicatorTaskBatchSize, 100),
 		ReplicatorTaskWorkerCount:                              dc.GetIntProperty(dynamicconfig.ReplicatorTaskWorkerCount, 10),
@@ -357,11 +432,18 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		ReplicationTaskProcessorHostQPS:                        dc.GetFloat64Property(dynamicconfig.ReplicationTaskProcessorHostQPS, 1500),
 		ReplicationTaskProcessorShardQPS:                       dc.GetFloat64Property(dynamicconfig.ReplicationTaskProcessorShardQPS, 30),
 
-		MaximumBufferedEventsBatch:      dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
-		MaximumSignalsPerExecution:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaximumSignalsPerExecution, 0),
-		ShardUpdateMinInterval:          dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval, 5*time.Minute),
-		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
-		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient, 0.15),
+		MaximumBufferedEventsBatch:        dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
+		MaximumSignalsPerExecution:        dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaximumSignalsPerExecution, 0),
+		EnableSignalDLQ:                   dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableSignalDLQ, false),
+		MaximumHistoryBranchesPerWorkflow: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaximumHistoryBranchesPerWorkflow, 1000),
+		ShardUpdateMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval, 5*time.Minute),
+		ShardSyncMinInterval:              dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
+		ShardSyncTimerJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient, 0.15),
+		ShardJournalEnabled:               dc.GetBoolProperty(dynamicconfig.ShardJournalEnabled, false),
+		ShardJournalMaxEntries:            dc.GetIntProperty(dynamicconfig.ShardJournalMaxEntries, 1000),
+		ShardJournalWarmUpRPS:             dc.GetIntProperty(dynamicconfig.ShardJournalWarmUpRPS, 50),
+		RestoreConsistencyMarkerID:        dc.GetIntProperty(dynamicconfig.RestoreConsistencyMarkerID, 0),
+		RestoreConsistencyMarkerTrimTasks: dc.GetBoolProperty(dynamicconfig.RestoreConsistencyMarkerTrimTasks, false),
 
 		// history client: client/history/client.go set the client timeout 30s
 		// TODO: Return this value to the client: go.temporal.io/server/issues/294
@@ -377,11 +459,15 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 
 		BlobSizeLimitError:     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 512*1024),
+		BlobSizeLimitOverrides: dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.BlobSizeLimitOverrides, map[string]interface{}{}),
 		HistorySizeLimitError:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistorySizeLimitError, 50*1024*1024),
 		HistorySizeLimitWarn:   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistorySizeLimitWarn, 10*1024*1024),
 		HistoryCountLimitError: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitError, 50*1024),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountLimitWarn, 10*1024),
 
+		HistorySizeSuggestContinueAsNew:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistorySizeSuggestContinueAsNew, 10*1024*1024),
+		HistoryCountSuggestContinueAsNew: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.HistoryCountSuggestContinueAsNew, 10*1024),
+
 		ThrottledLogRPS:   dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
 		EnableStickyQuery: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableStickyQuery, true),
 
@@ -390,6 +476,22 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		StickyTTL:                    dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.StickyTTL, time.Hour*24*365),
 		WorkflowTaskHeartbeatTimeout: dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.WorkflowTaskHeartbeatTimeout, time.Minute*30),
 
+		RetryBackoffJitterCoefficient: dc.GetFloat64PropertyFilteredByNamespace(dynamicconfig.RetryBackoffJitterCoefficient, 0),
+		CronMaxJitterDuration:         dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.CronMaxJitterDuration, 0),
+		ActivityTaskQueueReroutes:     dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.ActivityTaskQueueReroutes, map[string]interface{}{}),
+		WorkflowReportsTotalSizeLimit: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.WorkflowReportsTotalSizeLimit, 64*1024),
+		// default max receive message size of gRPC servers, minus headroom for the replication task
+		ReplicationEventBatchSizeLimit:    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.ReplicationEventBatchSizeLimit, 4*1024*1024-64*1024),
+		ReplicationChunkedTransferEnabled: dc.GetBoolProperty(dynamicconfig.ReplicationChunkedTransferEnabled, false),
+		ReplicationEventChunkSize:         dc.GetIntProperty(dynamicconfig.ReplicationEventChunkSize, 1024*1024),
+		ReplicationChunkedEventsCacheSize: dc.GetIntProperty(dynamicconfig.ReplicationChunkedEventsCacheSize, 8),
+		ReplicationChunkedEventsCacheTTL:  dc.GetDurationProperty(dynamicconfig.ReplicationChunkedEventsCacheTTL, 5*time.Minute),
+		EnableTaskLatencyBreakdown:        dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableTaskLatencyBreakdown, false),
+		EnableStuckExecutionDetection:     dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableStuckExecutionDetection, false),
+		StuckActivityStartThreshold:       dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.StuckActivityStartThreshold, time.Hour),
+		StuckWorkflowTaskStartThreshold:   dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.StuckWorkflowTaskStartThreshold, 10*time.Minute),
+		StuckWorkflowTaskAttemptThreshold: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.StuckWorkflowTaskAttemptThreshold, 10),
+
 		ReplicationTaskFetcherParallelism:            dc.GetIntProperty(dynamicconfig.ReplicationTaskFetcherParallelism, 4),
 		ReplicationTaskFetcherAggregationInterval:    dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherAggregationInterval, 2*time.Second),
 		ReplicationTaskFetcherTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.ReplicationTaskFetcherTimerJitterCoefficient, 0.15),
@@ -432,10 +534,26 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		VisibilityProcessorMaxRedispatchQueueSize:              dc.GetIntProperty(dynamicconfig.VisibilityProcessorMaxRedispatchQueueSize, 10000),
 		VisibilityProcessorEnablePriorityTaskProcessor:         dc.GetBoolProperty(dynamicconfig.VisibilityProcessorEnablePriorityTaskProcessor, false),
 		VisibilityProcessorVisibilityArchivalTimeLimit:         dc.GetDurationProperty(dynamicconfig.VisibilityProcessorVisibilityArchivalTimeLimit, 200*time.Millisecond),
+		VisibilityProcessorLoadSheddingFailureThreshold:        dc.GetIntProperty(dynamicconfig.VisibilityProcessorLoadSheddingFailureThreshold, 10),
+		VisibilityProcessorLoadSheddingProbeInterval:           dc.GetDurationProperty(dynamicconfig.VisibilityProcessorLoadSheddingProbeInterval, 30*time.Second),
+		VisibilityProcessorCatchUpRPS:                          dc.GetIntProperty(dynamicconfig.VisibilityProcessorCatchUpRPS, 50),
 
 		VisibilityQueue:            dc.GetStringProperty(dynamicconfig.VisibilityQueue, common.VisibilityQueueInternalWithDualProcessor),
 		VisibilityProcessorEnabled: dc.GetBoolProperty(dynamicconfig.VisibilityProcessorEnabled, true),
 
+		CustomTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.CustomTaskBatchSize, 100),
+		CustomProcessorMaxPollRPS:                          dc.GetIntProperty(dynamicconfig.CustomProcessorMaxPollRPS, 20),
+		CustomTaskWorkerCount:                              dc.GetIntProperty(dynamicconfig.CustomTaskWorkerCount, 10),
+		CustomTaskMaxRetryCount:                            dc.GetIntProperty(dynamicconfig.CustomTaskMaxRetryCount, 100),
+		CustomProcessorMaxPollInterval:                     dc.GetDurationProperty(dynamicconfig.CustomProcessorMaxPollInterval, 1*time.Minute),
+		CustomProcessorMaxPollIntervalJitterCoefficient:    dc.GetFloat64Property(dynamicconfig.CustomProcessorMaxPollIntervalJitterCoefficient, 0.15),
+		CustomProcessorUpdateAckInterval:                   dc.GetDurationProperty(dynamicconfig.CustomProcessorUpdateAckInterval, 30*time.Second),
+		CustomProcessorUpdateAckIntervalJitterCoefficient:  dc.GetFloat64Property(dynamicconfig.CustomProcessorUpdateAckIntervalJitterCoefficient, 0.15),
+		CustomProcessorRedispatchInterval:                  dc.GetDurationProperty(dynamicconfig.CustomProcessorRedispatchInterval, 5*time.Second),
+		CustomProcessorRedispatchIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.CustomProcessorRedispatchIntervalJitterCoefficient, 0.15),
+		CustomProcessorMaxRedispatchQueueSize:              dc.GetIntProperty(dynamicconfig.CustomProcessorMaxRedispatchQueueSize, 10000),
+		CustomProcessorEnablePriorityTaskProcessor:         dc.GetBoolProperty(dynamicconfig.CustomProcessorEnablePriorityTaskProcessor, false),
+
 		ValidSearchAttributes:             dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
 		SearchAttributesNumberOfKeysLimit: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributesNumberOfKeysLimit, 100),
 		SearchAttributesSizeOfValueLimit:  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	historypb "go.temporal.io/api/history/v1"
+)
+
+type (
+	// CustomTaskCategory is a category of tasks, e.g. outbox delivery, that are persisted in their own queue
+	// atomically with the mutable state of a workflow execution and processed by their own queue processor.
+	// Tasks are generated in the active cluster only and executed at least once by the cluster which generated
+	// them, tasks failed with an error are retried. Tasks of a category which is no longer registered stay in
+	// its queue until the category is registered again.
+	CustomTaskCategory interface {
+		// Name identifies the queue of the category, it must not change once tasks have been persisted
+		Name() string
+		// GenerateTasks returns the payloads of the tasks to add for the events written by a transaction
+		GenerateTasks(execution CustomTaskExecution, events []*historypb.HistoryEvent) ([][]byte, error)
+		// Execute executes a task of the category
+		Execute(ctx context.Context, task *CustomTaskInfo) error
+	}
+
+	// CustomTaskExecution identifies the workflow execution a custom task is generated for
+	CustomTaskExecution struct {
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+	}
+
+	// CustomTaskInfo is a task read from the queue of a custom task category
+	CustomTaskInfo struct {
+		ShardID        int32
+		TaskID         int64
+		NamespaceID    string
+		WorkflowID     string
+		RunID          string
+		Category       string
+		Data           []byte
+		VisibilityTime time.Time
+	}
+
+	customTaskCategoryRegistry struct {
+		sync.RWMutex
+		categories map[string]CustomTaskCategory
+	}
+)
+
+var customTaskCategories = &customTaskCategoryRegistry{
+	categories: make(map[string]CustomTaskCategory),
+}
+
+// RegisterCustomTaskCategory registers a custom task category. It must be called before the server is started.
+func RegisterCustomTaskCategory(category CustomTaskCategory) {
+	customTaskCategories.register(category)
+}
+
+func (r *customTaskCategoryRegistry) register(category CustomTaskCategory) {
+	r.Lock()
+	defer r.Unlock()
+	name := category.Name()
+	if name == "" {
+		panic("custom task category name is empty")
+	}
+	if _, ok := r.categories[name]; ok {
+		panic("custom task category " + name + " already registered")
+	}
+	r.categories[name] = category
+}
+
+// list returns the registered categories ordered by name
+func (r *customTaskCategoryRegistry) list() []CustomTaskCategory {
+	r.RLock()
+	defer r.RUnlock()
+	categories := make([]CustomTaskCategory, 0, len(r.categories))
+	for _, category := range r.categories {
+		categories = append(categories, category)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Name() < categories[j].Name()
+	})
+	return categories
+}
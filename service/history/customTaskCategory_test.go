@@ -0,0 +1,146 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	historypb "go.temporal.io/api/history/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/shard"
+)
+
+type testCustomTaskCategory struct {
+	name  string
+	tasks []*CustomTaskInfo
+	err   error
+}
+
+func (c *testCustomTaskCategory) Name() string {
+	return c.name
+}
+
+func (c *testCustomTaskCategory) GenerateTasks(_ CustomTaskExecution, events []*historypb.HistoryEvent) ([][]byte, error) {
+	return [][]byte{[]byte(c.name)}, nil
+}
+
+func (c *testCustomTaskCategory) Execute(ctx context.Context, task *CustomTaskInfo) error {
+	if _, ok := ctx.Deadline(); !ok {
+		return errors.New("context without deadline")
+	}
+	c.tasks = append(c.tasks, task)
+	return c.err
+}
+
+func TestCustomTaskCategoryRegistry(t *testing.T) {
+	registry := &customTaskCategoryRegistry{categories: make(map[string]CustomTaskCategory)}
+	outbox := &testCustomTaskCategory{name: "outbox"}
+	audit := &testCustomTaskCategory{name: "audit"}
+	registry.register(outbox)
+	registry.register(audit)
+	assert.Panics(t, func() { registry.register(&testCustomTaskCategory{name: "outbox"}) })
+	assert.Panics(t, func() { registry.register(&testCustomTaskCategory{}) })
+
+	assert.Equal(t, []CustomTaskCategory{audit, outbox}, registry.list())
+}
+
+func TestCustomQueueTaskExecutor(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	mockShard := shard.NewMockContext(controller)
+	mockShard.EXPECT().GetShardID().Return(int32(7)).AnyTimes()
+	category := &testCustomTaskCategory{name: "outbox"}
+	executor := &customQueueTaskExecutor{shard: mockShard, category: category}
+
+	visibilityTime := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	task := &serialization.CustomTaskInfo{
+		NamespaceId:    "namespace-id",
+		WorkflowId:     "workflow-id",
+		RunId:          "run-id",
+		Category:       "outbox",
+		TaskId:         42,
+		VisibilityTime: timestamp.TimePtr(visibilityTime),
+		Data:           []byte(`{"message":"hello"}`),
+	}
+	require.NoError(t, executor.execute(task, true))
+	require.Len(t, category.tasks, 1)
+	assert.Equal(t, &CustomTaskInfo{
+		ShardID:        7,
+		TaskID:         42,
+		NamespaceID:    "namespace-id",
+		WorkflowID:     "workflow-id",
+		RunID:          "run-id",
+		Category:       "outbox",
+		Data:           []byte(`{"message":"hello"}`),
+		VisibilityTime: visibilityTime,
+	}, category.tasks[0])
+
+	category.err = errors.New("delivery failed")
+	assert.Equal(t, category.err, executor.execute(task, true))
+	assert.Equal(t, errUnexpectedQueueTask, executor.execute(&persistencespb.TransferTaskInfo{}, true))
+}
+
+func TestCustomTaskQueueProcessorUpdateAckLevel(t *testing.T) {
+	mockExecutionMgr := &mocks.ExecutionManager{}
+	defer mockExecutionMgr.AssertExpectations(t)
+
+	processor := &customTaskQueueProcessorImpl{
+		category:         &testCustomTaskCategory{name: "outbox"},
+		executionManager: mockExecutionMgr,
+		metricsClient:    metrics.NewClient(tally.NoopScope, metrics.History),
+	}
+	mockExecutionMgr.On("RangeCompleteCustomTask", &persistence.RangeCompleteCustomTaskRequest{
+		Category:             "outbox",
+		ExclusiveBeginTaskID: 0,
+		InclusiveEndTaskID:   10,
+	}).Return(errors.New("unavailable")).Once()
+	assert.Error(t, processor.updateAckLevel(10))
+	assert.Equal(t, int64(0), processor.ackLevel)
+
+	mockExecutionMgr.On("RangeCompleteCustomTask", &persistence.RangeCompleteCustomTaskRequest{
+		Category:             "outbox",
+		ExclusiveBeginTaskID: 0,
+		InclusiveEndTaskID:   10,
+	}).Return(nil).Once()
+	require.NoError(t, processor.updateAckLevel(10))
+	assert.Equal(t, int64(10), processor.ackLevel)
+
+	// ack level did not move, nothing to complete
+	require.NoError(t, processor.updateAckLevel(10))
+}
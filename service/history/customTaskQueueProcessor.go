@@ -0,0 +1,267 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/shard"
+)
+
+type (
+	customTaskQueueProcessor interface {
+		common.Daemon
+		NotifyNewTask(customTasks []persistence.Task)
+	}
+
+	customTaskQueueProcessorImpl struct {
+		*queueProcessorBase
+		queueAckMgr
+		shard            shard.Context
+		category         CustomTaskCategory
+		options          *QueueProcessorOptions
+		executionManager persistence.ExecutionManager
+		customTaskFilter taskFilter
+		taskExecutor     queueTaskExecutor
+		logger           log.Logger
+		metricsClient    metrics.Client
+
+		// tasks up to ackLevel are deleted from the queue of the category,
+		// so processing resumes from the first remaining task after a restart
+		ackLevel int64
+	}
+
+	customQueueTaskExecutor struct {
+		shard    shard.Context
+		category CustomTaskCategory
+	}
+)
+
+func newCustomTaskQueueProcessor(
+	shard shard.Context,
+	historyService *historyEngineImpl,
+	category CustomTaskCategory,
+	queueTaskProcessor queueTaskProcessor,
+	logger log.Logger,
+) *customTaskQueueProcessorImpl {
+
+	config := shard.GetConfig()
+	logger = logger.WithTags(tag.ComponentCustomTaskQueue, tag.TaskCategory(category.Name()))
+
+	options := &QueueProcessorOptions{
+		BatchSize:                           config.CustomTaskBatchSize,
+		WorkerCount:                         config.CustomTaskWorkerCount,
+		MaxPollRPS:                          config.CustomProcessorMaxPollRPS,
+		MaxPollInterval:                     config.CustomProcessorMaxPollInterval,
+		MaxPollIntervalJitterCoefficient:    config.CustomProcessorMaxPollIntervalJitterCoefficient,
+		UpdateAckInterval:                   config.CustomProcessorUpdateAckInterval,
+		UpdateAckIntervalJitterCoefficient:  config.CustomProcessorUpdateAckIntervalJitterCoefficient,
+		MaxRetryCount:                       config.CustomTaskMaxRetryCount,
+		RedispatchInterval:                  config.CustomProcessorRedispatchInterval,
+		RedispatchIntervalJitterCoefficient: config.CustomProcessorRedispatchIntervalJitterCoefficient,
+		MaxRedispatchQueueSize:              config.CustomProcessorMaxRedispatchQueueSize,
+		EnablePriorityTaskProcessor:         config.CustomProcessorEnablePriorityTaskProcessor,
+		MetricScope:                         metrics.CustomQueueProcessorScope,
+	}
+	// custom tasks are not replicated, they are executed by the cluster which generated them
+	customTaskFilter := func(taskInfo queueTaskInfo) (bool, error) {
+		return true, nil
+	}
+
+	retProcessor := &customTaskQueueProcessorImpl{
+		shard:            shard,
+		category:         category,
+		options:          options,
+		executionManager: shard.GetExecutionManager(),
+		customTaskFilter: customTaskFilter,
+		taskExecutor: &customQueueTaskExecutor{
+			shard:    shard,
+			category: category,
+		},
+		logger:        logger,
+		metricsClient: historyService.metricsClient,
+
+		queueAckMgr:        nil, // is set bellow
+		queueProcessorBase: nil, // is set bellow
+	}
+
+	queueAckMgr := newQueueAckMgr(
+		shard,
+		options,
+		retProcessor,
+		retProcessor.ackLevel,
+		logger,
+	)
+
+	redispatchQueue := collection.NewConcurrentQueue()
+
+	customQueueTaskInitializer := func(taskInfo queueTaskInfo) queueTask {
+		return newCustomQueueTask(
+			shard,
+			taskInfo,
+			historyService.metricsClient.Scope(metrics.CustomQueueProcessorScope),
+			initializeLoggerForTask(shard.GetShardID(), taskInfo, logger),
+			customTaskFilter,
+			retProcessor.taskExecutor,
+			redispatchQueue,
+			shard.GetTimeSource(),
+			options.MaxRetryCount,
+			queueAckMgr,
+		)
+	}
+
+	queueProcessorBase := newQueueProcessorBase(
+		shard.GetService().GetClusterMetadata().GetCurrentClusterName(),
+		shard,
+		options,
+		retProcessor,
+		queueTaskProcessor,
+		queueAckMgr,
+		redispatchQueue,
+		historyService.historyCache,
+		customQueueTaskInitializer,
+		logger,
+		shard.GetMetricsClient().Scope(metrics.CustomQueueProcessorScope),
+	)
+	retProcessor.queueAckMgr = queueAckMgr
+	retProcessor.queueProcessorBase = queueProcessorBase
+
+	return retProcessor
+}
+
+// NotifyNewTask - Notify the processor about the new custom task arrival.
+// This should be called each time new custom task arrives, otherwise tasks maybe delayed.
+func (t *customTaskQueueProcessorImpl) NotifyNewTask(
+	customTasks []persistence.Task,
+) {
+	if len(customTasks) != 0 {
+		t.notifyNewTask()
+	}
+}
+
+// queueProcessor interface
+func (t *customTaskQueueProcessorImpl) notifyNewTask() {
+	t.queueProcessorBase.notifyNewTask()
+}
+
+// taskExecutor interfaces
+func (t *customTaskQueueProcessorImpl) getTaskFilter() taskFilter {
+	return t.customTaskFilter
+}
+
+func (t *customTaskQueueProcessorImpl) complete(
+	taskInfo *taskInfo,
+) {
+
+	t.queueProcessorBase.complete(taskInfo.task)
+}
+
+func (t *customTaskQueueProcessorImpl) process(
+	taskInfo *taskInfo,
+) (int, error) {
+	return metrics.CustomQueueProcessorScope, t.taskExecutor.execute(taskInfo.task, taskInfo.shouldProcessTask)
+}
+
+// processor interfaces
+func (t *customTaskQueueProcessorImpl) readTasks(
+	readLevel int64,
+) ([]queueTaskInfo, bool, error) {
+
+	response, err := t.executionManager.GetCustomTasks(&persistence.GetCustomTasksRequest{
+		Category:     t.category.Name(),
+		ReadLevel:    readLevel,
+		MaxReadLevel: t.shard.GetTransferMaxReadLevel(),
+		BatchSize:    t.options.BatchSize(),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	tasks := make([]queueTaskInfo, len(response.Tasks))
+	for i := range response.Tasks {
+		tasks[i] = response.Tasks[i]
+	}
+	return tasks, len(response.NextPageToken) != 0, nil
+}
+
+func (t *customTaskQueueProcessorImpl) updateAckLevel(
+	ackLevel int64,
+) error {
+
+	if ackLevel <= t.ackLevel {
+		return nil
+	}
+
+	t.metricsClient.IncCounter(metrics.CustomQueueProcessorScope, metrics.TaskBatchCompleteCounter)
+	if err := t.executionManager.RangeCompleteCustomTask(&persistence.RangeCompleteCustomTaskRequest{
+		Category:             t.category.Name(),
+		ExclusiveBeginTaskID: t.ackLevel,
+		InclusiveEndTaskID:   ackLevel,
+	}); err != nil {
+		return err
+	}
+	t.ackLevel = ackLevel
+	return nil
+}
+
+func (t *customTaskQueueProcessorImpl) queueShutdown() error {
+	return nil
+}
+
+func (t *customQueueTaskExecutor) execute(
+	taskInfo queueTaskInfo,
+	shouldProcessTask bool,
+) error {
+
+	task, ok := taskInfo.(*serialization.CustomTaskInfo)
+	if !ok {
+		return errUnexpectedQueueTask
+	}
+
+	if !shouldProcessTask {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
+	defer cancel()
+	return t.category.Execute(ctx, &CustomTaskInfo{
+		ShardID:        t.shard.GetShardID(),
+		TaskID:         task.GetTaskId(),
+		NamespaceID:    task.GetNamespaceId(),
+		WorkflowID:     task.GetWorkflowId(),
+		RunID:          task.GetRunId(),
+		Category:       task.GetCategory(),
+		Data:           task.GetData(),
+		VisibilityTime: timestamp.TimeValue(task.GetVisibilityTime()),
+	})
+}
@@ -0,0 +1,49 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+// withExternalSearchAttributes adds the search attributes upserted by external systems, kept in execution info,
+// to the given copy of search attributes. Keys upserted by the workflow are removed from external attributes,
+// so external values are the most recent ones.
+func withExternalSearchAttributes(
+	searchAttributes map[string]*commonpb.Payload,
+	externalSearchAttributes map[string]*commonpb.Payload,
+) map[string]*commonpb.Payload {
+
+	if len(externalSearchAttributes) == 0 {
+		return searchAttributes
+	}
+	if searchAttributes == nil {
+		searchAttributes = make(map[string]*commonpb.Payload, len(externalSearchAttributes))
+	}
+	for key, value := range externalSearchAttributes {
+		searchAttributes[key] = value
+	}
+	return searchAttributes
+}
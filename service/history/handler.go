@@ -38,7 +38,6 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
-	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -72,6 +71,8 @@ type (
 		rateLimiter             quotas.RateLimiter
 		replicationTaskFetchers ReplicationTaskFetchers
 		queueTaskProcessor      queueTaskProcessor
+		lockContention          *lockContentionTracker
+		requestRate             *requestRateTracker
 	}
 )
 
@@ -111,6 +112,12 @@ func NewHandler(
 		rateLimiter: quotas.NewDefaultIncomingDynamicRateLimiter(
 			func() float64 { return float64(config.RPS()) },
 		),
+		lockContention: newLockContentionTracker(
+			config.LockContentionThreshold,
+			config.LockContentionMaxTracked,
+			resource.GetTimeSource(),
+		),
+		requestRate: newRequestRateTracker(resource.GetTimeSource()),
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -230,30 +237,10 @@ func (h *Handler) CreateEngine(
 		h.replicationTaskFetchers,
 		h.GetMatchingRawClient(),
 		h.queueTaskProcessor,
+		h.lockContention,
 	)
 }
 
-// https://github.com/grpc/grpc/blob/master/doc/health-checking.md
-func (h *Handler) Check(_ context.Context, request *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
-	h.GetLogger().Debug("History service health check endpoint (gRPC) reached.")
-
-	h.startWG.Wait()
-
-	if request.Service != serviceName {
-		return &healthpb.HealthCheckResponse{
-			Status: healthpb.HealthCheckResponse_SERVICE_UNKNOWN,
-		}, nil
-	}
-
-	hs := &healthpb.HealthCheckResponse{
-		Status: healthpb.HealthCheckResponse_SERVING,
-	}
-	return hs, nil
-}
-func (h *Handler) Watch(*healthpb.HealthCheckRequest, healthpb.Health_WatchServer) error {
-	return serviceerror.NewUnimplemented("Watch is not implemented.")
-}
-
 // RecordActivityTaskHeartbeat - Record Activity Task Heart beat.
 func (h *Handler) RecordActivityTaskHeartbeat(ctx context.Context, request *historyservice.RecordActivityTaskHeartbeatRequest) (_ *historyservice.RecordActivityTaskHeartbeatResponse, retError error) {
 
@@ -670,7 +657,7 @@ func (h *Handler) StartWorkflowExecution(ctx context.Context, request *historyse
 }
 
 // DescribeHistoryHost returns information about the internal states of a history host
-func (h *Handler) DescribeHistoryHost(_ context.Context, _ *historyservice.DescribeHistoryHostRequest) (_ *historyservice.DescribeHistoryHostResponse, retError error) {
+func (h *Handler) DescribeHistoryHost(ctx context.Context, _ *historyservice.DescribeHistoryHostRequest) (_ *historyservice.DescribeHistoryHostResponse, retError error) {
 	defer log.CapturePanic(h.GetLogger(), &retError)
 	h.startWG.Wait()
 
@@ -694,6 +681,8 @@ func (h *Handler) DescribeHistoryHost(_ context.Context, _ *historyservice.Descr
 		},
 		ShardControllerStatus: status,
 		Address:               h.GetHostInfo().GetAddress(),
+		RequestsPerSecond:     h.requestRate.rate(),
+		ContendedWorkflows:    h.lockContention.topProto(lockContentionTopCount),
 	}
 	return resp, nil
 }
@@ -1558,6 +1547,34 @@ func (h *Handler) GetDLQReplicationMessages(ctx context.Context, request *histor
 	}, nil
 }
 
+// GetReplicationEventChunk returns a chunk of the events of a history replication task too large to be sent in one message
+func (h *Handler) GetReplicationEventChunk(ctx context.Context, request *historyservice.GetReplicationEventChunkRequest) (_ *historyservice.GetReplicationEventChunkResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryGetReplicationEventChunkScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	workflowID := request.GetRequest().GetTaskInfo().GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, namespaceID, workflowID)
+	}
+
+	data, err := engine.GetReplicationEventChunk(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, namespaceID, workflowID)
+	}
+	return &historyservice.GetReplicationEventChunkResponse{Data: data}, nil
+}
+
 // ReapplyEvents applies stale events to the current workflow and the current run
 func (h *Handler) ReapplyEvents(ctx context.Context, request *historyservice.ReapplyEventsRequest) (_ *historyservice.ReapplyEventsResponse, retError error) {
 	defer log.CapturePanic(h.GetLogger(), &retError)
@@ -1726,6 +1743,90 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// ApplyOperatorAction applies operator action to a running workflow execution
+func (h *Handler) ApplyOperatorAction(ctx context.Context, request *historyservice.ApplyOperatorActionRequest) (_ *historyservice.ApplyOperatorActionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryApplyOperatorActionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, namespaceID, workflowID)
+	}
+
+	if err := engine.ApplyOperatorAction(ctx, request); err != nil {
+		return nil, h.error(err, scope, namespaceID, workflowID)
+	}
+	return &historyservice.ApplyOperatorActionResponse{}, nil
+}
+
+// AnnotateWorkflowExecution stores operator annotation on a workflow execution
+func (h *Handler) AnnotateWorkflowExecution(ctx context.Context, request *historyservice.AnnotateWorkflowExecutionRequest) (_ *historyservice.AnnotateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryAnnotateWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, namespaceID, workflowID)
+	}
+
+	if err := engine.AnnotateWorkflowExecution(ctx, request); err != nil {
+		return nil, h.error(err, scope, namespaceID, workflowID)
+	}
+	return &historyservice.AnnotateWorkflowExecutionResponse{}, nil
+}
+
+// UpsertWorkflowSearchAttributes upserts search attributes of a running workflow execution on behalf of an external system
+func (h *Handler) UpsertWorkflowSearchAttributes(ctx context.Context, request *historyservice.UpsertWorkflowSearchAttributesRequest) (_ *historyservice.UpsertWorkflowSearchAttributesResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpsertWorkflowSearchAttributesScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err := h.controller.GetEngine(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.error(err, scope, namespaceID, workflowID)
+	}
+
+	if err := engine.UpsertWorkflowSearchAttributes(ctx, request); err != nil {
+		return nil, h.error(err, scope, namespaceID, workflowID)
+	}
+	return &historyservice.UpsertWorkflowSearchAttributesResponse{}, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -27,6 +27,7 @@ package history
 import (
 	"context"
 	"sync/atomic"
+	"time"
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
@@ -55,6 +56,7 @@ type (
 		logger           log.Logger
 		metricsClient    metrics.Client
 		config           *configs.Config
+		lockContention   *lockContentionTracker
 	}
 )
 
@@ -133,7 +135,7 @@ func (c *historyCache) getAndCreateWorkflowExecution(
 	releaseFunc := noopReleaseFn
 	// If cache hit, we need to lock the cache to prevent race condition
 	if cacheHit {
-		if err := contextFromCache.lock(ctx); err != nil {
+		if err := c.lockWorkflowExecution(ctx, metrics.HistoryCacheGetAndCreateScope, contextFromCache); err != nil {
 			// ctx is done before lock can be acquired
 			c.Release(key)
 			c.metricsClient.IncCounter(metrics.HistoryCacheGetAndCreateScope, metrics.CacheFailures)
@@ -214,7 +216,7 @@ func (c *historyCache) getOrCreateWorkflowExecutionInternal(
 	//  Consider revisiting this if it causes too much GC activity
 	releaseFunc := c.makeReleaseFunc(key, workflowCtx, forceClearContext)
 
-	if err := workflowCtx.lock(ctx); err != nil {
+	if err := c.lockWorkflowExecution(ctx, scope, workflowCtx); err != nil {
 		// ctx is done before lock can be acquired
 		c.Release(key)
 		c.metricsClient.IncCounter(scope, metrics.CacheFailures)
@@ -224,6 +226,24 @@ func (c *historyCache) getOrCreateWorkflowExecutionInternal(
 	return workflowCtx, releaseFunc, nil
 }
 
+func (c *historyCache) lockWorkflowExecution(
+	ctx context.Context,
+	scope int,
+	workflowCtx workflowExecutionContext,
+) error {
+
+	startTime := time.Now()
+	err := workflowCtx.lock(ctx)
+	wait := time.Since(startTime)
+	c.metricsClient.RecordTimer(scope, metrics.AcquireLockLatency, wait)
+
+	if c.lockContention.isContended(wait) {
+		c.metricsClient.IncCounter(scope, metrics.AcquireLockContendedCounter)
+		c.lockContention.record(workflowCtx.getNamespaceID(), workflowCtx.getExecution().GetWorkflowId(), wait)
+	}
+	return err
+}
+
 func (c *historyCache) validateWorkflowExecutionInfo(
 	namespaceID string,
 	execution *commonpb.WorkflowExecution,
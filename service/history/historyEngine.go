@@ -29,6 +29,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -64,6 +65,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/configs"
@@ -80,6 +82,7 @@ const (
 	timerCancellationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
 	defaultQueryFirstWorkflowTaskWaitTime     = time.Second
 	queryFirstWorkflowTaskCheckInterval       = 200 * time.Millisecond
+	taskQueuePartitionPrefix                  = "/_sys/"
 )
 
 type (
@@ -96,6 +99,7 @@ type (
 		txProcessor               transferQueueProcessor
 		timerProcessor            timerQueueProcessor
 		visibilityProcessor       visibilityQueueProcessor
+		customProcessors          map[string]customTaskQueueProcessor
 		nDCReplicator             nDCHistoryReplicator
 		nDCActivityReplicator     nDCActivityReplicator
 		replicatorProcessor       *replicatorQueueProcessorImpl
@@ -123,6 +127,8 @@ var (
 	ErrTaskDiscarded = errors.New("passive task pending for too long")
 	// ErrTaskRetry is the error indicating that the timer / transfer task should be retried.
 	ErrTaskRetry = errors.New("passive task should retry due to condition in mutable state is not met")
+	// ErrTaskShed is the error indicating that the task is held back because the store it writes to is unavailable.
+	ErrTaskShed = errors.New("task is shed while its target store is unavailable")
 	// ErrDuplicate is exported temporarily for integration test
 	ErrDuplicate = errors.New("duplicate task, completing it")
 	// ErrConflict is exported temporarily for integration test
@@ -145,6 +151,10 @@ var (
 	ErrCancellationAlreadyRequested = serviceerror.NewCancellationAlreadyRequested("cancellation already requested for this workflow execution")
 	// ErrSignalsLimitExceeded is the error indicating limit reached for maximum number of signal events
 	ErrSignalsLimitExceeded = serviceerror.NewResourceExhausted("exceeded workflow execution limit for signal events")
+	// ErrUserTimerNotFound is error indicating user timer is not pending
+	ErrUserTimerNotFound = serviceerror.NewNotFound("invalid timerID or timer already fired or canceled")
+	// ErrHistoryBranchesLimitExceeded is the error indicating limit reached for maximum number of history branches of a workflow
+	ErrHistoryBranchesLimitExceeded = serviceerror.NewResourceExhausted("exceeded workflow limit for history branches")
 	// ErrEventsAterWorkflowFinish is the error indicating server error trying to write events after workflow finish event
 	ErrEventsAterWorkflowFinish = serviceerror.NewInternal("error validating last event being workflow finish event")
 	// ErrQueryEnteredInvalidState is error indicating query entered invalid state
@@ -180,6 +190,7 @@ func NewEngineWithShardContext(
 	replicationTaskFetchers ReplicationTaskFetchers,
 	rawMatchingClient matching.Client,
 	queueTaskProcessor queueTaskProcessor,
+	lockContention *lockContentionTracker,
 ) *historyEngineImpl {
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
 
@@ -187,6 +198,7 @@ func NewEngineWithShardContext(
 	executionManager := shard.GetExecutionManager()
 	historyV2Manager := shard.GetHistoryManager()
 	historyCache := newHistoryCache(shard)
+	historyCache.lockContention = lockContention
 	historyEngImpl := &historyEngineImpl{
 		status:             common.DaemonStatusInitialized,
 		currentClusterName: currentClusterName,
@@ -223,6 +235,10 @@ func NewEngineWithShardContext(
 		config.VisibilityProcessorEnabled() {
 		historyEngImpl.visibilityProcessor = newVisibilityQueueProcessor(shard, historyEngImpl, visibilityMgr, matching, historyClient, queueTaskProcessor, logger)
 	}
+	historyEngImpl.customProcessors = make(map[string]customTaskQueueProcessor)
+	for _, category := range customTaskCategories.list() {
+		historyEngImpl.customProcessors[category.Name()] = newCustomTaskQueueProcessor(shard, historyEngImpl, category, queueTaskProcessor, logger)
+	}
 	historyEngImpl.eventsReapplier = newNDCEventsReapplier(shard.GetMetricsClient(), logger)
 
 	if shard.GetClusterMetadata().IsGlobalNamespaceEnabled() {
@@ -330,6 +346,9 @@ func (e *historyEngineImpl) Start() {
 	if e.visibilityProcessor != nil {
 		e.visibilityProcessor.Start()
 	}
+	for _, customProcessor := range e.customProcessors {
+		customProcessor.Start()
+	}
 
 	// failover callback will try to create a failover queue processor to scan all inflight tasks
 	// if domain needs to be failovered. However, in the multicursor queue logic, the scan range
@@ -341,6 +360,44 @@ func (e *historyEngineImpl) Start() {
 	for _, replicationTaskProcessor := range e.replicationTaskProcessors {
 		replicationTaskProcessor.Start()
 	}
+
+	if executions := e.shard.GetJournaledExecutions(); len(executions) != 0 {
+		go e.warmUpCache(executions)
+	}
+}
+
+// warmUpCache loads the mutable states of the executions recently updated by previous owners of the shard,
+// so that in-flight tasks and requests do not have to rebuild them from persistence on first access. Executions
+// are loaded at a limited rate, so that warming up does not compete with tasks and requests for persistence.
+func (e *historyEngineImpl) warmUpCache(executions []persistence.ShardJournalExecution) {
+	if maxSize := e.config.HistoryCacheMaxSize(); len(executions) > maxSize {
+		executions = executions[:maxSize]
+	}
+
+	rateLimiter := quotas.NewDefaultOutgoingDynamicRateLimiter(
+		func() float64 { return float64(e.config.ShardJournalWarmUpRPS()) },
+	)
+	for _, execution := range executions {
+		if err := rateLimiter.Wait(context.Background()); err != nil {
+			return
+		}
+		if atomic.LoadInt32(&e.status) != common.DaemonStatusStarted {
+			return
+		}
+		context, release, err := e.historyCache.getOrCreateWorkflowExecutionForBackground(
+			execution.NamespaceID,
+			commonpb.WorkflowExecution{
+				WorkflowId: execution.WorkflowID,
+				RunId:      execution.RunID,
+			},
+		)
+		if err != nil {
+			continue
+		}
+		_, err = context.loadWorkflowExecution()
+		release(err)
+	}
+	e.logger.Info("Warmed up history cache with journaled executions.", tag.Counter(len(executions)))
 }
 
 // Stop the service.
@@ -361,6 +418,9 @@ func (e *historyEngineImpl) Stop() {
 	if e.visibilityProcessor != nil {
 		e.visibilityProcessor.Stop()
 	}
+	for _, customProcessor := range e.customProcessors {
+		customProcessor.Stop()
+	}
 
 	for _, replicationTaskProcessor := range e.replicationTaskProcessors {
 		replicationTaskProcessor.Stop()
@@ -527,7 +587,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: workflowID,
-		RunId:      uuid.New(),
+		RunId:      e.shard.GetService().GetUUIDGenerator().New(),
 	}
 	clusterMetadata := e.shard.GetService().GetClusterMetadata()
 	mutableState, err := e.createMutableState(clusterMetadata, namespaceEntry, execution.GetRunId())
@@ -1038,6 +1098,7 @@ func (e *historyEngineImpl) getMutableState(
 		WorkflowState:                         workflowState,
 		WorkflowStatus:                        workflowStatus,
 		IsStickyTaskQueueEnabled:              mutableState.IsStickyTaskQueueEnabled(),
+		ResetBaseRunId:                        executionInfo.ResetBaseRunId,
 	}
 	versionHistories := mutableState.GetExecutionInfo().GetVersionHistories()
 	if versionHistories != nil {
@@ -1082,6 +1143,9 @@ func (e *historyEngineImpl) DescribeMutableState(
 	}
 
 	response.DatabaseMutableState = msb.ToProto()
+	if request.GetIncludePendingDetails() {
+		response.PendingExecutionDetails = newPendingExecutionDetails(msb, pendingDetailsTimerCount(request.GetPendingTimerCount()))
+	}
 	return response, nil
 }
 
@@ -1140,6 +1204,10 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 	}
 	executionInfo := mutableState.GetExecutionInfo()
 	executionState := mutableState.GetExecutionState()
+	searchAttributes, err := withOperatorAnnotations(withExternalSearchAttributes(copySearchAttributes(executionInfo.SearchAttributes), executionInfo.ExternalSearchAttributes), executionInfo.OperatorAnnotations)
+	if err != nil {
+		return nil, err
+	}
 	result := &historyservice.DescribeWorkflowExecutionResponse{
 		ExecutionConfig: &workflowpb.WorkflowExecutionConfig{
 			TaskQueue: &taskqueuepb.TaskQueue{
@@ -1159,10 +1227,11 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			StartTime:        executionInfo.StartTime,
 			HistoryLength:    mutableState.GetNextEventID() - common.FirstEventID,
 			AutoResetPoints:  executionInfo.AutoResetPoints,
-			Memo:             &commonpb.Memo{Fields: executionInfo.Memo},
-			SearchAttributes: &commonpb.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
+			Memo:             &commonpb.Memo{Fields: withReplicationOversizedFlag(executionInfo.Memo, executionInfo.ReplicationOversizedBatch)},
+			SearchAttributes: &commonpb.SearchAttributes{IndexedFields: searchAttributes},
 			Status:           executionState.Status,
 		},
+		WorkflowReports: executionInfo.WorkflowReports,
 	}
 
 	// TODO: we need to consider adding execution time to mutable state
@@ -1275,6 +1344,7 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 	}
 
 	response := &historyservice.RecordActivityTaskStartedResponse{}
+	var startToCloseTimeout *time.Duration
 	err = e.updateWorkflowExecution(ctx, namespaceID, execution, false,
 		func(context workflowExecutionContext, mutableState mutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
@@ -1301,6 +1371,13 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return ErrActivityTaskNotFound
 			}
 
+			// Activity task pushed to the task queue activity was on before it is rerouted is stale,
+			// it is OK to drop the task as the activity is dispatched to its current task queue.
+			if ai.StartedId == common.EmptyEventID && rootTaskQueueName(request.PollRequest.GetTaskQueue().GetName()) != ai.TaskQueue {
+				e.logger.Debug("Activity task from stale task queue.", tag.TaskID(request.GetTaskId()), tag.WorkflowScheduleID(scheduleID), tag.WorkflowTaskQueueName(ai.TaskQueue))
+				return ErrActivityTaskNotFound
+			}
+
 			scheduledEvent, err := mutableState.GetActivityScheduledEvent(scheduleID)
 			if err != nil {
 				return err
@@ -1313,6 +1390,7 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				if ai.RequestId == requestID {
 					response.StartedTime = ai.StartedTime
 					response.Attempt = ai.Attempt
+					startToCloseTimeout = ai.StartToCloseTimeout
 					return nil
 				}
 
@@ -1327,10 +1405,16 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			); err != nil {
 				return err
 			}
+			if e.config.EnableTaskLatencyBreakdown(namespace) {
+				recordTaskLatencyBreakdown(
+					mutableState.GetExecutionInfo(), request.LatencyBreakdown, scheduleID, ai.ScheduledTime, e.shard.GetTimeSource().Now(),
+				)
+			}
 
 			response.StartedTime = ai.StartedTime
 			response.Attempt = ai.Attempt
 			response.HeartbeatDetails = ai.LastHeartbeatDetails
+			startToCloseTimeout = ai.StartToCloseTimeout
 
 			response.WorkflowType = mutableState.GetWorkflowType()
 			response.WorkflowNamespace = namespace
@@ -1342,6 +1426,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 		return nil, err
 	}
 
+	lockTimeSkipping(
+		e.shard.GetTimeSource(),
+		activityTaskTimeSkippingKey(namespaceID, execution, request.GetScheduleId(), response.Attempt),
+		startToCloseTimeout,
+	)
 	return response, err
 }
 
@@ -1403,6 +1492,7 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 
 	var activityStartedTime time.Time
 	var taskQueue string
+	var timeSkippingKey string
 	err = e.updateWorkflowExecution(ctx, namespaceID, workflowExecution, true,
 		func(context workflowExecutionContext, mutableState mutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
@@ -1436,8 +1526,12 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 			}
 			activityStartedTime = *ai.StartedTime
 			taskQueue = ai.TaskQueue
+			timeSkippingKey = activityTaskTimeSkippingKey(namespaceID, workflowExecution, scheduleID, ai.Attempt)
 			return nil
 		})
+	if err == nil {
+		unlockTimeSkipping(e.shard.GetTimeSource(), timeSkippingKey)
+	}
 	if err == nil && !activityStartedTime.IsZero() {
 		scope := e.metricsClient.Scope(metrics.HistoryRespondActivityTaskCompletedScope).
 			Tagged(
@@ -1477,6 +1571,7 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 
 	var activityStartedTime time.Time
 	var taskQueue string
+	var timeSkippingKey string
 	err = e.updateWorkflowExecutionWithAction(ctx, namespaceID, workflowExecution,
 		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
 			if !mutableState.IsWorkflowExecutionRunning() {
@@ -1504,6 +1599,8 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 				return nil, ErrActivityTaskNotFound
 			}
 
+			// retry increments attempt of activity info
+			timeSkippingKey = activityTaskTimeSkippingKey(namespaceID, workflowExecution, scheduleID, ai.Attempt)
 			postActions := &updateWorkflowAction{}
 			failure := request.GetFailure()
 			retryState, err := mutableState.RetryActivity(ai, failure)
@@ -1523,6 +1620,9 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 			taskQueue = ai.TaskQueue
 			return postActions, nil
 		})
+	if err == nil {
+		unlockTimeSkipping(e.shard.GetTimeSource(), timeSkippingKey)
+	}
 	if err == nil && !activityStartedTime.IsZero() {
 		scope := e.metricsClient.Scope(metrics.HistoryRespondActivityTaskFailedScope).
 			Tagged(
@@ -1562,6 +1662,7 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 
 	var activityStartedTime time.Time
 	var taskQueue string
+	var timeSkippingKey string
 	err = e.updateWorkflowExecution(ctx, namespaceID, workflowExecution, true,
 		func(context workflowExecutionContext, mutableState mutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
@@ -1601,8 +1702,12 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 
 			activityStartedTime = *ai.StartedTime
 			taskQueue = ai.TaskQueue
+			timeSkippingKey = activityTaskTimeSkippingKey(namespaceID, workflowExecution, scheduleID, ai.Attempt)
 			return nil
 		})
+	if err == nil {
+		unlockTimeSkipping(e.shard.GetTimeSource(), timeSkippingKey)
+	}
 	if err == nil && !activityStartedTime.IsZero() {
 		scope := e.metricsClient.Scope(metrics.HistoryClientRespondActivityTaskCanceledScope).
 			Tagged(
@@ -1772,7 +1877,7 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 		RunId:      request.WorkflowExecution.RunId,
 	}
 
-	return e.updateWorkflow(
+	err = e.updateWorkflow(
 		ctx,
 		namespaceID,
 		execution,
@@ -1826,6 +1931,10 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 
 			return postActions, nil
 		})
+	if err != nil && e.deadLetterSignal(namespaceEntry, signalRequest, err) {
+		return nil
+	}
+	return err
 }
 
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
@@ -1935,7 +2044,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 	execution = commonpb.WorkflowExecution{
 		WorkflowId: workflowID,
-		RunId:      uuid.New(),
+		RunId:      e.shard.GetService().GetUUIDGenerator().New(),
 	}
 
 	clusterMetadata := e.shard.GetService().GetClusterMetadata()
@@ -2287,7 +2396,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		}, nil
 	}
 
-	resetRunID := uuid.New()
+	resetRunID := e.shard.GetService().GetUUIDGenerator().New()
 	baseRebuildLastEventID := request.GetWorkflowTaskFinishEventId() - 1
 	baseVersionHistories := baseMutableState.GetExecutionInfo().GetVersionHistories()
 	baseCurrentVersionHistory, err := versionhistory.GetCurrentVersionHistory(baseVersionHistories)
@@ -2515,6 +2624,23 @@ func (e *historyEngineImpl) NotifyNewVisibilityTasks(
 	}
 }
 
+func (e *historyEngineImpl) NotifyNewCustomTasks(
+	tasks []persistence.Task,
+) {
+
+	tasksByCategory := make(map[string][]persistence.Task)
+	for _, task := range tasks {
+		if customTask, ok := task.(*persistence.CustomTask); ok {
+			tasksByCategory[customTask.Category] = append(tasksByCategory[customTask.Category], task)
+		}
+	}
+	for category, categoryTasks := range tasksByCategory {
+		if customProcessor, ok := e.customProcessors[category]; ok {
+			customProcessor.NotifyNewTask(categoryTasks)
+		}
+	}
+}
+
 func (e *historyEngineImpl) NotifyNewTimerTasks(
 	tasks []persistence.Task,
 ) {
@@ -2689,6 +2815,7 @@ func setTaskInfo(
 	transferTasks []persistence.Task,
 	timerTasks []persistence.Task,
 	visibilityTasks []persistence.Task,
+	customTasks []persistence.Task,
 ) {
 	// set both the task version, as well as the timestamp on the transfer tasks
 	for _, task := range transferTasks {
@@ -2702,6 +2829,10 @@ func setTaskInfo(
 		task.SetVersion(version)
 		task.SetVisibilityTimestamp(timestamp)
 	}
+	for _, task := range customTasks {
+		task.SetVersion(version)
+		task.SetVisibilityTimestamp(timestamp)
+	}
 }
 
 // for startWorkflowExecution & signalWithStart to handle workflow reuse policy
@@ -2779,6 +2910,19 @@ func getWorkflowAlreadyStartedError(errMsg string, createRequestID string, workf
 	)
 }
 
+// rootTaskQueueName returns the name of the task queue which taskQueue is a partition of, partitions other than
+// partition 0 are named /_sys/<task queue>/<partition> by matching
+func rootTaskQueueName(taskQueue string) string {
+	if !strings.HasPrefix(taskQueue, taskQueuePartitionPrefix) {
+		return taskQueue
+	}
+	name := strings.TrimPrefix(taskQueue, taskQueuePartitionPrefix)
+	if i := strings.LastIndex(name, "/"); i > 0 {
+		return name[:i]
+	}
+	return taskQueue
+}
+
 func (e *historyEngineImpl) GetReplicationMessages(
 	ctx context.Context,
 	pollingCluster string,
@@ -2829,6 +2973,19 @@ func (e *historyEngineImpl) GetDLQReplicationMessages(
 	return tasks, nil
 }
 
+func (e *historyEngineImpl) GetReplicationEventChunk(
+	ctx context.Context,
+	request *historyservice.GetReplicationEventChunkRequest,
+) ([]byte, error) {
+
+	return e.replicatorProcessor.getTaskChunk(
+		ctx,
+		request.GetRequest().GetTaskInfo(),
+		int(request.GetRequest().GetChunkIndex()),
+		int(request.GetRequest().GetChunkSize()),
+	)
+}
+
 func (e *historyEngineImpl) ReapplyEvents(
 	ctx context.Context,
 	namespaceUUID string,
@@ -2884,7 +3041,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 				// need to reset target workflow (which is also the current workflow)
 				// to accept events to be reapplied
 				baseRunID := mutableState.GetExecutionState().GetRunId()
-				resetRunID := uuid.New()
+				resetRunID := e.shard.GetService().GetUUIDGenerator().New()
 				baseRebuildLastEventID := mutableState.GetPreviousStartedEventID()
 
 				// TODO when https://github.com/uber/cadence/issues/2420 is finished, remove this block,
@@ -3081,6 +3238,206 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// ApplyOperatorAction applies the operator action to the running execution. Action is kept as operator annotation
+// of the execution instead of history event, so it is not delivered to the workflow.
+func (e *historyEngineImpl) ApplyOperatorAction(
+	ctx context.Context,
+	request *historyservice.ApplyOperatorActionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(request.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+	action := request.GetRequest()
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: action.GetExecution().GetWorkflowId(),
+		RunId:      action.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			postActions := &updateWorkflowAction{}
+			var target string
+			switch action.GetActionType() {
+			case enumsspb.OPERATOR_ACTION_TYPE_FIRE_TIMER:
+				if _, ok := mutableState.GetUserTimerInfo(action.GetTimerId()); !ok {
+					return nil, ErrUserTimerNotFound
+				}
+				if _, err := mutableState.AddTimerFiredEvent(action.GetTimerId()); err != nil {
+					return nil, serviceerror.NewInternal("Unable to fire timer.")
+				}
+				postActions.createWorkflowTask = true
+				target = fmt.Sprintf("timer %v", action.GetTimerId())
+
+			case enumsspb.OPERATOR_ACTION_TYPE_RETRY_ACTIVITY:
+				activityInfo, ok := mutableState.GetActivityByActivityID(action.GetActivityId())
+				if !ok {
+					return nil, ErrActivityTaskNotFound
+				}
+				if err := mutableState.RetryActivityImmediately(activityInfo); err != nil {
+					return nil, err
+				}
+				target = fmt.Sprintf("activity %v", action.GetActivityId())
+
+			case enumsspb.OPERATOR_ACTION_TYPE_REROUTE_ACTIVITIES:
+				rerouted := 0
+				for _, activityInfo := range mutableState.GetPendingActivityInfos() {
+					if activityInfo.TaskQueue != action.GetTaskQueue() || activityInfo.StartedId != common.EmptyEventID {
+						continue
+					}
+					activityType := activityInfo.ActivityType
+					if activityType == "" {
+						// activity info persisted before activity type is kept in it
+						scheduledEvent, err := mutableState.GetActivityScheduledEvent(activityInfo.ScheduleId)
+						if err != nil {
+							return nil, err
+						}
+						activityType = scheduledEvent.GetActivityTaskScheduledEventAttributes().GetActivityType().GetName()
+					}
+					if activityType != action.GetActivityType() {
+						continue
+					}
+					if err := mutableState.RerouteActivity(activityInfo, action.GetTargetTaskQueue()); err != nil {
+						return nil, err
+					}
+					rerouted++
+				}
+				if rerouted == 0 {
+					return nil, serviceerror.NewNotFound(fmt.Sprintf(
+						"No pending activity of type %v on task queue %v.", action.GetActivityType(), action.GetTaskQueue()))
+				}
+				target = fmt.Sprintf("%d activities of type %v from task queue %v to %v",
+					rerouted, action.GetActivityType(), action.GetTaskQueue(), action.GetTargetTaskQueue())
+
+			default:
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown operator action type %v.", action.GetActionType()))
+			}
+
+			annotation := fmt.Sprintf("%v %v", action.GetActionType(), target)
+			if action.GetReason() != "" {
+				annotation = fmt.Sprintf("%v: %v", annotation, action.GetReason())
+			}
+			now := e.shard.GetTimeSource().Now()
+			if err := mutableState.AddOperatorAnnotation(now, &persistencespb.OperatorAnnotation{
+				Time:       &now,
+				Annotation: annotation,
+				Tags:       []string{operatorActionAnnotationTag},
+				Identity:   action.GetIdentity(),
+			}); err != nil {
+				return nil, err
+			}
+			return postActions, nil
+		})
+}
+
+// AnnotateWorkflowExecution stores operator annotation on the running or closed execution. Annotation is kept
+// in mutable state only, no event is written to history and no workflow task is scheduled.
+func (e *historyEngineImpl) AnnotateWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.AnnotateWorkflowExecutionRequest,
+) (retError error) {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(request.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+	annotateRequest := request.GetRequest()
+	if err := common.ValidateOperatorAnnotation(annotateRequest.GetAnnotation(), annotateRequest.GetTags()); err != nil {
+		return err
+	}
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: annotateRequest.GetExecution().GetWorkflowId(),
+		RunId:      annotateRequest.GetExecution().GetRunId(),
+	}
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, namespaceID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := context.loadWorkflowExecution()
+	if err != nil {
+		return err
+	}
+
+	// closed execution may no longer be the current run of the workflow
+	updateMode := persistence.UpdateWorkflowModeUpdateCurrent
+	if !mutableState.IsWorkflowExecutionRunning() {
+		resp, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+			NamespaceID: namespaceID,
+			WorkflowID:  execution.GetWorkflowId(),
+		})
+		if err != nil {
+			return err
+		}
+		if resp.RunID != context.getExecution().GetRunId() {
+			updateMode = persistence.UpdateWorkflowModeBypassCurrent
+		}
+	}
+
+	now := e.shard.GetTimeSource().Now()
+	if err := mutableState.AddOperatorAnnotation(now, &persistencespb.OperatorAnnotation{
+		Time:       &now,
+		Annotation: annotateRequest.GetAnnotation(),
+		Tags:       annotateRequest.GetTags(),
+		Identity:   annotateRequest.GetIdentity(),
+	}); err != nil {
+		return err
+	}
+
+	return context.updateWorkflowExecutionWithNew(
+		now,
+		updateMode,
+		nil, // no new workflow
+		nil, // no new workflow
+		transactionPolicyActive,
+		nil,
+	)
+}
+
+// UpsertWorkflowSearchAttributes upserts search attributes of the running execution on behalf of an external system.
+// Attributes are kept in mutable state only, no event is written to history and no workflow task is scheduled.
+func (e *historyEngineImpl) UpsertWorkflowSearchAttributes(
+	ctx context.Context,
+	request *historyservice.UpsertWorkflowSearchAttributesRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(request.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	upsertRequest := request.GetRequest()
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: upsertRequest.GetExecution().GetWorkflowId(),
+		RunId:      upsertRequest.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflowExecution(ctx, namespaceID, execution, false,
+		func(context workflowExecutionContext, mutableState mutableState) error {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return ErrWorkflowCompleted
+			}
+
+			return mutableState.UpsertExternalSearchAttributes(
+				e.shard.GetTimeSource().Now(),
+				upsertRequest.GetSearchAttributes().GetIndexedFields(),
+			)
+		})
+}
+
 func (e *historyEngineImpl) loadWorkflowOnce(
 	ctx context.Context,
 	namespaceID string,
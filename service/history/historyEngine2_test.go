@@ -759,6 +759,46 @@ func (s *engine2Suite) TestRecordActivityTaskStartedSuccess() {
 	s.Equal(scheduledEvent, response.ScheduledEvent)
 }
 
+func (s *engine2Suite) TestRecordActivityTaskStarted_StaleTaskQueue() {
+	namespaceID := testNamespaceID
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskQueue"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, int64(2), int64(3), identity)
+	scheduledEvent, ai := addActivityTaskScheduledEvent(msBuilder, workflowTaskCompletedEvent.EventId, "activity1_id", "activity_type1", tl, payloads.EncodeString("input1"), 100*time.Second, 10*time.Second, 1*time.Second, 5*time.Second)
+	ai.TaskQueue = "reroutedTaskQueue"
+
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: ms1}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+
+	// task pushed to the task queue activity was on before it is rerouted is dropped
+	response, err := s.historyEngine.RecordActivityTaskStarted(context.Background(), &historyservice.RecordActivityTaskStartedRequest{
+		NamespaceId:       namespaceID,
+		WorkflowExecution: &workflowExecution,
+		ScheduleId:        scheduledEvent.GetEventId(),
+		TaskId:            100,
+		RequestId:         "reqId",
+		PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: "/_sys/" + tl + "/1",
+			},
+			Identity: identity,
+		},
+	})
+	s.Nil(response)
+	s.Equal(ErrActivityTaskNotFound, err)
+
+	s.Equal("reroutedTaskQueue", rootTaskQueueName("/_sys/reroutedTaskQueue/2"))
+	s.Equal("reroutedTaskQueue", rootTaskQueueName("reroutedTaskQueue"))
+}
+
 func (s *engine2Suite) TestRequestCancelWorkflowExecution_Running() {
 	namespaceID := testNamespaceID
 	workflowExecution := commonpb.WorkflowExecution{
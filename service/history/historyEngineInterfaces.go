@@ -131,4 +131,5 @@ const (
 	timerQueueType
 	replicationQueueType
 	visibilityQueueType
+	customQueueType
 )
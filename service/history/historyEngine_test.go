@@ -50,6 +50,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -57,6 +58,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/api/searchattributesservice/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/common"
@@ -68,6 +70,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
@@ -4722,6 +4725,359 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestApplyOperatorAction_FireTimer() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+	timerID := "t1"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	workflowTaskStartedEvent := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, workflowTaskStartedEvent.GetEventId(), identity)
+	addTimerStartedEvent(msBuilder, workflowTaskCompletedEvent.GetEventId(), timerID, time.Hour)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var appendedEvents []*historypb.HistoryEvent
+	var mutation *persistence.WorkflowMutation
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Run(func(arguments mock.Arguments) {
+		appendedEvents = arguments.Get(0).(*persistence.AppendHistoryNodesRequest).Events
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		mutation = &arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest).UpdateWorkflowMutation
+	}).Once()
+
+	request := &historyservice.ApplyOperatorActionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.ApplyOperatorActionRequest{
+			Namespace:  testNamespace,
+			Execution:  &we,
+			ActionType: enumsspb.OPERATOR_ACTION_TYPE_FIRE_TIMER,
+			TimerId:    timerID,
+			Reason:     "incident",
+			Identity:   "operator",
+		},
+	}
+	err := s.mockHistoryEngine.ApplyOperatorAction(context.Background(), request)
+	s.NoError(err)
+
+	// the action is recorded as an operator annotation, not as a history event
+	s.Len(appendedEvents, 2)
+	s.Equal(enumspb.EVENT_TYPE_TIMER_FIRED, appendedEvents[0].GetEventType())
+	s.Equal(timerID, appendedEvents[0].GetTimerFiredEventAttributes().GetTimerId())
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, appendedEvents[1].GetEventType())
+	annotations := mutation.ExecutionInfo.GetOperatorAnnotations()
+	s.Len(annotations, 1)
+	s.Equal([]string{operatorActionAnnotationTag}, annotations[0].GetTags())
+	s.Equal("operator", annotations[0].GetIdentity())
+
+	executionBuilder := s.getBuilder(testNamespaceID, we)
+	_, ok := executionBuilder.GetUserTimerInfo(timerID)
+	s.False(ok)
+
+	err = s.mockHistoryEngine.ApplyOperatorAction(context.Background(), request)
+	s.Equal(ErrUserTimerNotFound, err)
+}
+
+func (s *engineSuite) TestApplyOperatorAction_RetryActivity() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+	activityID := "activity1"
+	retryPolicy := &commonpb.RetryPolicy{
+		InitialInterval:    timestamp.DurationPtr(time.Hour),
+		BackoffCoefficient: 1,
+		MaximumAttempts:    10,
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	workflowTaskStartedEvent := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, workflowTaskStartedEvent.GetEventId(), identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEventWithRetry(msBuilder, workflowTaskCompletedEvent.GetEventId(), activityID,
+		"activity_type", taskqueue, nil, 10*time.Hour, 10*time.Hour, time.Hour, time.Hour, retryPolicy)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), identity)
+	ai, _ := msBuilder.GetActivityInfo(activityScheduledEvent.GetEventId())
+	retryState, err := msBuilder.RetryActivity(ai, failure.NewServerFailure("failed", false))
+	s.NoError(err)
+	s.Equal(enumspb.RETRY_STATE_IN_PROGRESS, retryState)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var mutation *persistence.WorkflowMutation
+	// mutable state is reloaded after the failed action
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Twice()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		mutation = &arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest).UpdateWorkflowMutation
+	}).Once()
+
+	request := &historyservice.ApplyOperatorActionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.ApplyOperatorActionRequest{
+			Namespace:  testNamespace,
+			Execution:  &we,
+			ActionType: enumsspb.OPERATOR_ACTION_TYPE_RETRY_ACTIVITY,
+			ActivityId: "unknown",
+			Identity:   "operator",
+		},
+	}
+	err = s.mockHistoryEngine.ApplyOperatorAction(context.Background(), request)
+	s.Equal(ErrActivityTaskNotFound, err)
+
+	request.Request.ActivityId = activityID
+	err = s.mockHistoryEngine.ApplyOperatorAction(context.Background(), request)
+	s.NoError(err)
+
+	// no history event is written, the action is recorded as an operator annotation
+	s.mockHistoryV2Mgr.AssertNotCalled(s.T(), "AppendHistoryNodes", mock.Anything)
+	annotations := mutation.ExecutionInfo.GetOperatorAnnotations()
+	s.Len(annotations, 1)
+	s.Equal([]string{operatorActionAnnotationTag}, annotations[0].GetTags())
+	s.Equal("operator", annotations[0].GetIdentity())
+	s.Len(mutation.TimerTasks, 1)
+	retryTask, ok := mutation.TimerTasks[0].(*persistence.ActivityRetryTimerTask)
+	s.True(ok)
+	s.Equal(activityScheduledEvent.GetEventId(), retryTask.EventID)
+	s.Equal(int32(2), retryTask.Attempt)
+	s.False(retryTask.VisibilityTimestamp.After(time.Now()))
+
+	// activity is no longer waiting for retry backoff
+	err = s.mockHistoryEngine.ApplyOperatorAction(context.Background(), request)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *engineSuite) TestApplyOperatorAction_RerouteActivities() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	targetTaskQueue := "testTargetTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	workflowTaskStartedEvent := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, workflowTaskStartedEvent.GetEventId(), identity)
+	reroutedEvent, _ := addActivityTaskScheduledEvent(msBuilder, workflowTaskCompletedEvent.GetEventId(), "activity1",
+		"activity_type", taskqueue, nil, time.Hour, time.Hour, time.Hour, time.Hour)
+	addActivityTaskScheduledEvent(msBuilder, workflowTaskCompletedEvent.GetEventId(), "activity2",
+		"other_activity_type", taskqueue, nil, time.Hour, time.Hour, time.Hour, time.Hour)
+	startedEvent, _ := addActivityTaskScheduledEvent(msBuilder, workflowTaskCompletedEvent.GetEventId(), "activity3",
+		"activity_type", taskqueue, nil, time.Hour, time.Hour, time.Hour, time.Hour)
+	addActivityTaskStartedEvent(msBuilder, startedEvent.GetEventId(), identity)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var mutation *persistence.WorkflowMutation
+	// mutable state is reloaded after the failed action
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Twice()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		mutation = &arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest).UpdateWorkflowMutation
+	}).Once()
+
+	request := &historyservice.ApplyOperatorActionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.ApplyOperatorActionRequest{
+			Namespace:       testNamespace,
+			Execution:       &we,
+			ActionType:      enumsspb.OPERATOR_ACTION_TYPE_REROUTE_ACTIVITIES,
+			ActivityType:    "unknown_activity_type",
+			TaskQueue:       taskqueue,
+			TargetTaskQueue: targetTaskQueue,
+			Identity:        "operator",
+		},
+	}
+	err := s.mockHistoryEngine.ApplyOperatorAction(context.Background(), request)
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	request.Request.ActivityType = "activity_type"
+	err = s.mockHistoryEngine.ApplyOperatorAction(context.Background(), request)
+	s.NoError(err)
+
+	// no history event is written, activity of the type which has not started is rerouted
+	s.mockHistoryV2Mgr.AssertNotCalled(s.T(), "AppendHistoryNodes", mock.Anything)
+	annotations := mutation.ExecutionInfo.GetOperatorAnnotations()
+	s.Len(annotations, 1)
+	s.Equal([]string{operatorActionAnnotationTag}, annotations[0].GetTags())
+	s.Len(mutation.UpsertActivityInfos, 1)
+	s.Equal(reroutedEvent.GetEventId(), mutation.UpsertActivityInfos[0].ScheduleId)
+	s.Equal(targetTaskQueue, mutation.UpsertActivityInfos[0].TaskQueue)
+	s.Len(mutation.TimerTasks, 1)
+	retryTask, ok := mutation.TimerTasks[0].(*persistence.ActivityRetryTimerTask)
+	s.True(ok)
+	s.Equal(reroutedEvent.GetEventId(), retryTask.EventID)
+	s.False(retryTask.VisibilityTimestamp.After(time.Now()))
+}
+
+func (s *engineSuite) TestAnnotateWorkflowExecution_Running() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	workflowTaskStartedEvent := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, workflowTaskStartedEvent.GetEventId(), identity)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var request *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		request = arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	err := s.mockHistoryEngine.AnnotateWorkflowExecution(context.Background(), &historyservice.AnnotateWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.AnnotateWorkflowExecutionRequest{
+			Namespace:  testNamespace,
+			Execution:  &we,
+			Annotation: "paused pending vendor fix",
+			Tags:       []string{"vendor-outage"},
+			Identity:   "operator",
+		},
+	})
+	s.NoError(err)
+
+	// annotation is kept in execution info only, no event is written and user search attributes are untouched
+	mutation := request.UpdateWorkflowMutation
+	s.Equal(persistence.UpdateWorkflowModeUpdateCurrent, request.Mode)
+	s.Empty(mutation.ExecutionInfo.SearchAttributes)
+	s.Len(mutation.ExecutionInfo.OperatorAnnotations, 1)
+	s.Equal("paused pending vendor fix", mutation.ExecutionInfo.OperatorAnnotations[0].GetAnnotation())
+	s.Equal([]string{"vendor-outage"}, mutation.ExecutionInfo.OperatorAnnotations[0].GetTags())
+	s.Equal("operator", mutation.ExecutionInfo.OperatorAnnotations[0].GetIdentity())
+	s.mockHistoryV2Mgr.AssertNotCalled(s.T(), "AppendHistoryNodes", mock.Anything)
+	s.Len(mutation.VisibilityTasks, 1)
+	s.IsType(&persistence.UpsertExecutionVisibilityTask{}, mutation.VisibilityTasks[0])
+}
+
+func (s *engineSuite) TestAnnotateWorkflowExecution_ClosedNotCurrent() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	workflowTaskStartedEvent := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	workflowTaskCompletedEvent := addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, workflowTaskStartedEvent.GetEventId(), identity)
+	addCompleteWorkflowEvent(msBuilder, workflowTaskCompletedEvent.GetEventId(), nil)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var request *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{RunID: uuid.New()}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		request = arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	err := s.mockHistoryEngine.AnnotateWorkflowExecution(context.Background(), &historyservice.AnnotateWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.AnnotateWorkflowExecutionRequest{
+			Namespace:  testNamespace,
+			Execution:  &we,
+			Annotation: "refunded manually",
+		},
+	})
+	s.NoError(err)
+
+	// close visibility record of the run is rewritten without touching the current run
+	mutation := request.UpdateWorkflowMutation
+	s.Equal(persistence.UpdateWorkflowModeBypassCurrent, request.Mode)
+	s.Len(mutation.ExecutionInfo.OperatorAnnotations, 1)
+	s.Empty(mutation.TransferTasks)
+	s.Len(mutation.VisibilityTasks, 1)
+	s.IsType(&persistence.CloseExecutionVisibilityTask{}, mutation.VisibilityTasks[0])
+}
+
+func (s *engineSuite) TestAnnotateWorkflowExecution_InvalidAnnotation() {
+	err := s.mockHistoryEngine.AnnotateWorkflowExecution(context.Background(), &historyservice.AnnotateWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.AnnotateWorkflowExecutionRequest{
+			Namespace: testNamespace,
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "wId", RunId: testRunID},
+		},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *engineSuite) TestUpsertWorkflowSearchAttributes() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	workflowTaskStartedEvent := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, workflowTaskStartedEvent.GetEventId(), identity)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var request *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		request = arguments.Get(0).(*persistence.UpdateWorkflowExecutionRequest)
+	}).Once()
+
+	searchAttributes := map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("fraud_review")}
+	err := s.mockHistoryEngine.UpsertWorkflowSearchAttributes(context.Background(), &historyservice.UpsertWorkflowSearchAttributesRequest{
+		NamespaceId: testNamespaceID,
+		Request: &searchattributesservice.UpsertWorkflowSearchAttributesRequest{
+			Namespace:        testNamespace,
+			Execution:        &we,
+			SearchAttributes: &commonpb.SearchAttributes{IndexedFields: searchAttributes},
+		},
+	})
+	s.NoError(err)
+
+	// attributes are kept in execution info only, no event is written and no workflow task is scheduled
+	mutation := request.UpdateWorkflowMutation
+	s.Empty(mutation.ExecutionInfo.SearchAttributes)
+	s.Equal(searchAttributes, mutation.ExecutionInfo.ExternalSearchAttributes)
+	s.Equal(int64(0), mutation.ExecutionInfo.SignalCount)
+	s.Equal(common.EmptyEventID, mutation.ExecutionInfo.WorkflowTaskScheduleId)
+	s.mockHistoryV2Mgr.AssertNotCalled(s.T(), "AppendHistoryNodes", mock.Anything)
+	s.Len(mutation.VisibilityTasks, 1)
+	s.IsType(&persistence.UpsertExecutionVisibilityTask{}, mutation.VisibilityTasks[0])
+}
+
 // Test signal workflow task by adding request ID
 func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest() {
 	signalRequest := &historyservice.SignalWorkflowExecutionRequest{}
@@ -4804,6 +5160,60 @@ func (s *engineSuite) TestSignalWorkflowExecution_Failed() {
 	s.EqualError(err, "workflow execution already completed")
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_DeadLettered() {
+	we := &commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+	signalRequest := &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         testNamespaceID,
+			WorkflowExecution: we,
+			Identity:          identity,
+			SignalName:        "my signal name",
+			Input:             payloads.EncodeString("test input"),
+		},
+	}
+	s.mockHistoryEngine.config.MaximumSignalsPerExecution = dynamicconfig.GetIntPropertyFilteredByNamespace(1)
+	s.mockHistoryEngine.config.EnableSignalDLQ = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, *we, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	addWorkflowTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.SignalCount = 1
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	// failed signals don't keep the execution cached
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Times(3)
+
+	s.mockShard.Resource.SignalDLQ.EXPECT().Publish(gomock.Any()).DoAndReturn(func(message *persistence.SignalDLQMessage) error {
+		s.Equal(testNamespaceID, message.NamespaceID)
+		s.Equal(signalRequest, message.Request)
+		s.Equal(ErrSignalsLimitExceeded.Error(), message.Reason)
+		return nil
+	})
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.NoError(err)
+
+	// re-delivered signal stays in DLQ
+	signalRequest.Redelivery = true
+	err = s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Equal(ErrSignalsLimitExceeded, err)
+	signalRequest.Redelivery = false
+
+	// signal of another workflow is failed back to it
+	signalRequest.ExternalWorkflowExecution = &commonpb.WorkflowExecution{
+		WorkflowId: "parent wId",
+		RunId:      uuid.New(),
+	}
+	err = s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Equal(ErrSignalsLimitExceeded, err)
+}
+
 func (s *engineSuite) TestRemoveSignalMutableState() {
 	removeRequest := &historyservice.RemoveSignalMutableStateRequest{}
 	err := s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
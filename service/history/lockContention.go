@@ -0,0 +1,182 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	// lockContentionTracker keeps per host statistics of workflows whose lock
+	// wait time exceeded the contention threshold, so lock convoys (e.g. signal storms
+	// against a single workflow) can be identified with DescribeHistoryHost.
+	lockContentionTracker struct {
+		sync.Mutex
+		threshold  dynamicconfig.DurationPropertyFn
+		maxTracked dynamicconfig.IntPropertyFn
+		timeSource clock.TimeSource
+		workflows  map[contendedWorkflowKey]*contendedWorkflow
+	}
+
+	contendedWorkflowKey struct {
+		namespaceID string
+		workflowID  string
+	}
+
+	contendedWorkflow struct {
+		NamespaceID string
+		WorkflowID  string
+		WaitCount   int64
+		TotalWait   time.Duration
+		MaxWait     time.Duration
+		LastWait    time.Time
+	}
+)
+
+const (
+	// lockContentionWindow is how long workflow stays tracked after its last contended lock wait
+	lockContentionWindow = 10 * time.Minute
+	// lockContentionTopCount is number of most contended workflows reported by DescribeHistoryHost
+	lockContentionTopCount = 10
+)
+
+func newLockContentionTracker(
+	threshold dynamicconfig.DurationPropertyFn,
+	maxTracked dynamicconfig.IntPropertyFn,
+	timeSource clock.TimeSource,
+) *lockContentionTracker {
+	return &lockContentionTracker{
+		threshold:  threshold,
+		maxTracked: maxTracked,
+		timeSource: timeSource,
+		workflows:  make(map[contendedWorkflowKey]*contendedWorkflow),
+	}
+}
+
+// isContended returns true if lock wait exceeds contention threshold.
+func (t *lockContentionTracker) isContended(wait time.Duration) bool {
+	return t != nil && wait >= t.threshold()
+}
+
+// record accounts contended lock wait of the workflow.
+func (t *lockContentionTracker) record(
+	namespaceID string,
+	workflowID string,
+	wait time.Duration,
+) {
+
+	now := t.timeSource.Now()
+	key := contendedWorkflowKey{namespaceID: namespaceID, workflowID: workflowID}
+
+	t.Lock()
+	defer t.Unlock()
+
+	workflow, ok := t.workflows[key]
+	if !ok {
+		t.pruneLocked(now)
+		if len(t.workflows) >= t.maxTracked() && !t.evictLocked(wait) {
+			return
+		}
+		workflow = &contendedWorkflow{NamespaceID: namespaceID, WorkflowID: workflowID}
+		t.workflows[key] = workflow
+	}
+
+	workflow.WaitCount++
+	workflow.TotalWait += wait
+	if wait > workflow.MaxWait {
+		workflow.MaxWait = wait
+	}
+	workflow.LastWait = now
+}
+
+// top returns up to n workflows with the largest total lock wait time within contention window.
+func (t *lockContentionTracker) top(n int) []contendedWorkflow {
+	if t == nil {
+		return nil
+	}
+
+	t.Lock()
+	t.pruneLocked(t.timeSource.Now())
+	result := make([]contendedWorkflow, 0, len(t.workflows))
+	for _, workflow := range t.workflows {
+		result = append(result, *workflow)
+	}
+	t.Unlock()
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].TotalWait > result[j].TotalWait
+	})
+	if len(result) > n {
+		result = result[:n]
+	}
+	return result
+}
+
+// topProto returns up to n most contended workflows as reported by DescribeHistoryHost.
+func (t *lockContentionTracker) topProto(n int) []*historyspb.ContendedWorkflow {
+	var result []*historyspb.ContendedWorkflow
+	for _, workflow := range t.top(n) {
+		result = append(result, &historyspb.ContendedWorkflow{
+			NamespaceId:  workflow.NamespaceID,
+			WorkflowId:   workflow.WorkflowID,
+			WaitCount:    workflow.WaitCount,
+			TotalWait:    timestamp.DurationPtr(workflow.TotalWait),
+			MaxWait:      timestamp.DurationPtr(workflow.MaxWait),
+			LastWaitTime: timestamp.TimePtr(workflow.LastWait),
+		})
+	}
+	return result
+}
+
+func (t *lockContentionTracker) pruneLocked(now time.Time) {
+	for key, workflow := range t.workflows {
+		if now.Sub(workflow.LastWait) > lockContentionWindow {
+			delete(t.workflows, key)
+		}
+	}
+}
+
+// evictLocked removes the least contended workflow if its total wait is smaller than wait.
+func (t *lockContentionTracker) evictLocked(wait time.Duration) bool {
+	var minKey contendedWorkflowKey
+	var minWorkflow *contendedWorkflow
+	for key, workflow := range t.workflows {
+		if minWorkflow == nil || workflow.TotalWait < minWorkflow.TotalWait {
+			minKey, minWorkflow = key, workflow
+		}
+	}
+	if minWorkflow == nil || minWorkflow.TotalWait >= wait {
+		return false
+	}
+	delete(t.workflows, minKey)
+	return true
+}
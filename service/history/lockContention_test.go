@@ -0,0 +1,129 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	lockContentionTrackerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		timeSource *clock.EventTimeSource
+		tracker    *lockContentionTracker
+	}
+)
+
+func TestLockContentionTrackerSuite(t *testing.T) {
+	s := new(lockContentionTrackerSuite)
+	suite.Run(t, s)
+}
+
+func (s *lockContentionTrackerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.tracker = newLockContentionTracker(
+		dynamicconfig.GetDurationPropertyFn(100*time.Millisecond),
+		dynamicconfig.GetIntPropertyFn(2),
+		s.timeSource,
+	)
+}
+
+func (s *lockContentionTrackerSuite) TestIsContended() {
+	s.False(s.tracker.isContended(10 * time.Millisecond))
+	s.True(s.tracker.isContended(100 * time.Millisecond))
+
+	var nilTracker *lockContentionTracker
+	s.False(nilTracker.isContended(time.Hour))
+	s.Empty(nilTracker.top(lockContentionTopCount))
+}
+
+func (s *lockContentionTrackerSuite) TestTop() {
+	s.tracker.record("namespace", "wf-1", 200*time.Millisecond)
+	s.tracker.record("namespace", "wf-2", 300*time.Millisecond)
+	s.tracker.record("namespace", "wf-1", 400*time.Millisecond)
+
+	top := s.tracker.top(lockContentionTopCount)
+	s.Len(top, 2)
+	s.Equal("wf-1", top[0].WorkflowID)
+	s.Equal(int64(2), top[0].WaitCount)
+	s.Equal(600*time.Millisecond, top[0].TotalWait)
+	s.Equal(400*time.Millisecond, top[0].MaxWait)
+	s.Equal("wf-2", top[1].WorkflowID)
+
+	s.Len(s.tracker.top(1), 1)
+}
+
+func (s *lockContentionTrackerSuite) TestTopProto() {
+	s.tracker.record("namespace", "wf-1", 200*time.Millisecond)
+
+	top := s.tracker.topProto(lockContentionTopCount)
+	s.Len(top, 1)
+	s.Equal("namespace", top[0].GetNamespaceId())
+	s.Equal("wf-1", top[0].GetWorkflowId())
+	s.Equal(int64(1), top[0].GetWaitCount())
+	s.Equal(200*time.Millisecond, *top[0].GetTotalWait())
+	s.Equal(200*time.Millisecond, *top[0].GetMaxWait())
+	s.Equal(s.timeSource.Now(), *top[0].GetLastWaitTime())
+}
+
+func (s *lockContentionTrackerSuite) TestRecord_EvictLeastContended() {
+	s.tracker.record("namespace", "wf-1", 200*time.Millisecond)
+	s.tracker.record("namespace", "wf-2", 300*time.Millisecond)
+
+	// not contended enough to replace any of tracked workflows
+	s.tracker.record("namespace", "wf-3", 100*time.Millisecond)
+	top := s.tracker.top(lockContentionTopCount)
+	s.Len(top, 2)
+	s.Equal("wf-2", top[0].WorkflowID)
+	s.Equal("wf-1", top[1].WorkflowID)
+
+	s.tracker.record("namespace", "wf-3", time.Second)
+	top = s.tracker.top(lockContentionTopCount)
+	s.Len(top, 2)
+	s.Equal("wf-3", top[0].WorkflowID)
+	s.Equal("wf-2", top[1].WorkflowID)
+}
+
+func (s *lockContentionTrackerSuite) TestTop_ExpiredWorkflows() {
+	s.tracker.record("namespace", "wf-1", 200*time.Millisecond)
+	s.timeSource.Update(s.timeSource.Now().Add(lockContentionWindow / 2))
+	s.tracker.record("namespace", "wf-2", 100*time.Millisecond)
+
+	s.timeSource.Update(s.timeSource.Now().Add(lockContentionWindow/2 + time.Second))
+	top := s.tracker.top(lockContentionTopCount)
+	s.Len(top, 1)
+	s.Equal("wf-2", top[0].WorkflowID)
+}
@@ -155,6 +155,7 @@ type (
 		insertReplicationTasks []persistence.Task
 		insertTimerTasks       []persistence.Task
 		insertVisibilityTasks  []persistence.Task
+		insertCustomTasks      []persistence.Task
 
 		// do not rely on this, this is only updated on
 		// Load() and closeTransactionXXX methods. So when
@@ -1029,7 +1030,7 @@ func (e *mutableStateBuilder) GetRetryBackoffDuration(
 		return backoff.NoBackoff, enumspb.RETRY_STATE_RETRY_POLICY_NOT_SET
 	}
 
-	return getBackoffInterval(
+	backoffInterval, retryState := getBackoffInterval(
 		e.timeSource.Now(),
 		timestamp.TimeValue(info.WorkflowExecutionExpirationTime),
 		info.Attempt,
@@ -1040,6 +1041,7 @@ func (e *mutableStateBuilder) GetRetryBackoffDuration(
 		failure,
 		info.RetryNonRetryableErrorTypes,
 	)
+	return jitterRetryBackoff(backoffInterval, e.config.RetryBackoffJitterCoefficient(e.GetNamespaceEntry().GetInfo().Name)), retryState
 }
 
 func (e *mutableStateBuilder) GetCronBackoffDuration() (time.Duration, error) {
@@ -1057,7 +1059,8 @@ func (e *mutableStateBuilder) GetCronBackoffDuration() (time.Duration, error) {
 	}
 	firstWorkflowTaskBackoff := timestamp.DurationValue(workflowStartEvent.GetWorkflowExecutionStartedEventAttributes().GetFirstWorkflowTaskBackoff())
 	executionTime = executionTime.Add(firstWorkflowTaskBackoff)
-	return backoff.GetBackoffForNextSchedule(info.CronSchedule, executionTime, e.timeSource.Now()), nil
+	backoffInterval := backoff.GetBackoffForNextSchedule(info.CronSchedule, executionTime, e.timeSource.Now())
+	return jitterCronBackoff(backoffInterval, e.config.CronMaxJitterDuration(e.GetNamespaceEntry().GetInfo().Name)), nil
 }
 
 // GetSignalInfo get details about a signal request that is currently in progress.
@@ -2167,9 +2170,11 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		LastHeartbeatUpdateTime: timestamp.TimePtr(time.Time{}),
 		TimerTaskStatus:         timerTaskStatusNone,
 		TaskQueue:               attributes.TaskQueue.GetName(),
+		ActivityType:            attributes.ActivityType.GetName(),
 		HasRetryPolicy:          attributes.RetryPolicy != nil,
 		Attempt:                 1,
 	}
+	e.rerouteActivityTaskQueue(ai)
 	if ai.HasRetryPolicy {
 		ai.RetryInitialInterval = attributes.RetryPolicy.GetInitialInterval()
 		ai.RetryBackoffCoefficient = attributes.RetryPolicy.GetBackoffCoefficient()
@@ -2935,6 +2940,10 @@ func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(
 	currentSearchAttr := e.GetExecutionInfo().SearchAttributes
 
 	e.executionInfo.SearchAttributes = mergeMapOfPayload(currentSearchAttr, upsertSearchAttr)
+	// values upserted by the workflow replace values upserted by external systems
+	for key := range upsertSearchAttr {
+		delete(e.executionInfo.ExternalSearchAttributes, key)
+	}
 }
 
 func mergeMapOfPayload(
@@ -3181,7 +3190,31 @@ func (e *mutableStateBuilder) AddRecordMarkerEvent(
 		return nil, err
 	}
 
-	return e.hBuilder.AddMarkerRecordedEvent(workflowTaskCompletedEventID, attributes), nil
+	event := e.hBuilder.AddMarkerRecordedEvent(workflowTaskCompletedEventID, attributes)
+	if attributes.GetMarkerName() != common.WorkflowReportMarkerName {
+		return event, nil
+	}
+
+	e.ReplicateWorkflowReportRecordedEvent(event)
+	return event, nil
+}
+
+// ReplicateWorkflowReportRecordedEvent stores the reports published by the workflow report marker event,
+// replacing previous values of the same reports
+func (e *mutableStateBuilder) ReplicateWorkflowReportRecordedEvent(
+	event *historypb.HistoryEvent,
+) {
+
+	reports := event.GetMarkerRecordedEventAttributes().GetDetails()
+	values := make(map[string]*commonpb.Payload, len(reports))
+	for name, value := range reports {
+		if len(value.GetPayloads()) == 0 {
+			continue
+		}
+		values[name] = value.GetPayloads()[0]
+	}
+
+	e.executionInfo.WorkflowReports = mergeMapOfPayload(e.executionInfo.WorkflowReports, values)
 }
 
 func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
@@ -3266,7 +3299,7 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 	}
 
 	var err error
-	newRunID := uuid.New()
+	newRunID := e.shard.GetService().GetUUIDGenerator().New()
 	newExecution := commonpb.WorkflowExecution{
 		WorkflowId: e.executionInfo.WorkflowId,
 		RunId:      newRunID,
@@ -3766,6 +3799,7 @@ func (e *mutableStateBuilder) RetryActivity(
 	if retryState != enumspb.RETRY_STATE_IN_PROGRESS {
 		return retryState, nil
 	}
+	backoffInterval = jitterRetryBackoff(backoffInterval, e.config.RetryBackoffJitterCoefficient(e.GetNamespaceEntry().GetInfo().Name))
 
 	// a retry is needed, update activity info for next retry
 	ai.Version = e.GetCurrentVersion()
@@ -3777,6 +3811,7 @@ func (e *mutableStateBuilder) RetryActivity(
 	ai.TimerTaskStatus = timerTaskStatusNone
 	ai.RetryLastWorkerIdentity = ai.StartedIdentity
 	ai.RetryLastFailure = failure
+	e.rerouteActivityTaskQueue(ai)
 
 	if err := e.taskGenerator.generateActivityRetryTasks(
 		ai.ScheduleId,
@@ -3789,6 +3824,104 @@ func (e *mutableStateBuilder) RetryActivity(
 	return enumspb.RETRY_STATE_IN_PROGRESS, nil
 }
 
+// RetryActivityImmediately dispatches the attempt of activity waiting for retry backoff without waiting for the rest
+// of the backoff. Retry timer task previously generated for the attempt is dropped as stale when it fires, since it is
+// scheduled after the attempt now.
+func (e *mutableStateBuilder) RetryActivityImmediately(
+	ai *persistencespb.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskRetry
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	if ai.StartedId != common.EmptyEventID || !e.timeSource.Now().Before(timestamp.TimeValue(ai.ScheduledTime)) {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Activity %v is not waiting for retry backoff.", ai.ActivityId))
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.ScheduledTime = timestamp.TimePtr(e.timeSource.Now())
+
+	if err := e.taskGenerator.generateActivityRetryTasks(
+		ai.ScheduleId,
+	); err != nil {
+		return err
+	}
+
+	e.updateActivityInfos[ai] = struct{}{}
+	e.syncActivityTasks[ai.ScheduleId] = struct{}{}
+	return nil
+}
+
+// RerouteActivity moves activity which has not started yet to taskQueue. Activity task is pushed to taskQueue
+// by activity retry timer, right away unless the activity is waiting for retry backoff. Activity task pushed to
+// the previous task queue before is dropped when it is polled, since the activity can only be started from taskQueue.
+func (e *mutableStateBuilder) RerouteActivity(
+	ai *persistencespb.ActivityInfo,
+	taskQueue string,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskReroute
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	if ai.StartedId != common.EmptyEventID {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Activity %v is already started.", ai.ActivityId))
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	ai.TaskQueue = taskQueue
+
+	if err := e.taskGenerator.generateActivityRetryTasks(
+		ai.ScheduleId,
+	); err != nil {
+		return err
+	}
+
+	e.updateActivityInfos[ai] = struct{}{}
+	e.syncActivityTasks[ai.ScheduleId] = struct{}{}
+	return nil
+}
+
+// rerouteActivityTaskQueue moves activity to the task queue its activity type is rerouted to by
+// ActivityTaskQueueReroutes dynamic config, activity stays on its task queue if it is not rerouted.
+func (e *mutableStateBuilder) rerouteActivityTaskQueue(
+	ai *persistencespb.ActivityInfo,
+) {
+
+	reroutes := e.config.ActivityTaskQueueReroutes(e.GetNamespaceEntry().GetInfo().Name)
+	ai.TaskQueue = common.GetActivityTaskQueueReroute(reroutes, ai.TaskQueue, ai.ActivityType)
+}
+
+// UpsertExternalSearchAttributes upserts search attributes of the running execution on behalf of an external system
+// and regenerates its visibility record. The attributes are not recorded in history and are not replicated.
+func (e *mutableStateBuilder) UpsertExternalSearchAttributes(
+	now time.Time,
+	searchAttributes map[string]*commonpb.Payload,
+) error {
+
+	opTag := tag.WorkflowActionUpsertWorkflowSearchAttributes
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	e.executionInfo.ExternalSearchAttributes = mergeMapOfPayload(e.executionInfo.ExternalSearchAttributes, searchAttributes)
+	return e.taskGenerator.generateWorkflowSearchAttrTasks(now)
+}
+
+// AddOperatorAnnotation appends operator annotation to the running or closed execution and regenerates its
+// visibility record. Annotations are not recorded in history and are not replicated.
+func (e *mutableStateBuilder) AddOperatorAnnotation(
+	now time.Time,
+	annotation *persistencespb.OperatorAnnotation,
+) error {
+
+	e.executionInfo.OperatorAnnotations = common.AppendOperatorAnnotation(e.executionInfo.OperatorAnnotations, annotation)
+	return e.taskGenerator.generateOperatorAnnotationTasks(now)
+}
+
 // TODO mutable state should generate corresponding transfer / timer tasks according to
 //  updates accumulated, while currently all transfer / timer tasks are managed manually
 
@@ -3885,6 +4018,13 @@ func (e *mutableStateBuilder) CloseTransactionAsMutation(
 		return nil, nil, err
 	}
 
+	if err := e.closeTransactionHandleCustomTasks(
+		transactionPolicy,
+		workflowEventsSeq,
+	); err != nil {
+		return nil, nil, err
+	}
+
 	if len(workflowEventsSeq) > 0 {
 		lastEvents := workflowEventsSeq[len(workflowEventsSeq)-1].Events
 		firstEvent := lastEvents[0]
@@ -3898,7 +4038,7 @@ func (e *mutableStateBuilder) CloseTransactionAsMutation(
 		}
 	}
 
-	setTaskInfo(e.GetCurrentVersion(), now, e.insertTransferTasks, e.insertTimerTasks, e.insertVisibilityTasks)
+	setTaskInfo(e.GetCurrentVersion(), now, e.insertTransferTasks, e.insertTimerTasks, e.insertVisibilityTasks, e.insertCustomTasks)
 
 	// update last update time
 	e.executionInfo.LastUpdateTime = &now
@@ -3934,6 +4074,7 @@ func (e *mutableStateBuilder) CloseTransactionAsMutation(
 		ReplicationTasks: e.insertReplicationTasks,
 		TimerTasks:       e.insertTimerTasks,
 		VisibilityTasks:  e.insertVisibilityTasks,
+		CustomTasks:      e.insertCustomTasks,
 
 		Condition: e.nextEventIDInDB,
 		Checksum:  checksum,
@@ -3963,6 +4104,13 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 		return nil, nil, err
 	}
 
+	if err := e.closeTransactionHandleCustomTasks(
+		transactionPolicy,
+		workflowEventsSeq,
+	); err != nil {
+		return nil, nil, err
+	}
+
 	if len(workflowEventsSeq) > 1 {
 		return nil, nil, serviceerror.NewInternal("cannot generate workflow snapshot with transient events")
 	}
@@ -3984,7 +4132,7 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 		}
 	}
 
-	setTaskInfo(e.GetCurrentVersion(), now, e.insertTransferTasks, e.insertTimerTasks, e.insertVisibilityTasks)
+	setTaskInfo(e.GetCurrentVersion(), now, e.insertTransferTasks, e.insertTimerTasks, e.insertVisibilityTasks, e.insertCustomTasks)
 
 	// update last update time
 	e.executionInfo.LastUpdateTime = &now
@@ -4012,6 +4160,7 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 		ReplicationTasks: e.insertReplicationTasks,
 		TimerTasks:       e.insertTimerTasks,
 		VisibilityTasks:  e.insertVisibilityTasks,
+		CustomTasks:      e.insertCustomTasks,
 
 		Condition: e.nextEventIDInDB,
 		Checksum:  checksum,
@@ -4070,6 +4219,13 @@ func (e *mutableStateBuilder) prepareCloseTransaction(
 		}
 	}
 
+	if err := e.closeTransactionHandleStuckExecution(
+		now,
+		transactionPolicy,
+	); err != nil {
+		return err
+	}
+
 	// TODO merge active & passive task generation
 	// NOTE: this function must be the last call
 	//  since we only generate at most one activity & user timer,
@@ -4121,10 +4277,48 @@ func (e *mutableStateBuilder) cleanupTransaction(
 	e.insertReplicationTasks = nil
 	e.insertTimerTasks = nil
 	e.insertVisibilityTasks = nil
+	e.insertCustomTasks = nil
 
 	return nil
 }
 
+func (e *mutableStateBuilder) closeTransactionHandleCustomTasks(
+	transactionPolicy transactionPolicy,
+	workflowEventsSeq []*persistence.WorkflowEvents,
+) error {
+
+	if transactionPolicy == transactionPolicyPassive {
+		return nil
+	}
+
+	var events []*historypb.HistoryEvent
+	for _, workflowEvents := range workflowEventsSeq {
+		events = append(events, workflowEvents.Events...)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	execution := CustomTaskExecution{
+		NamespaceID: e.executionInfo.NamespaceId,
+		WorkflowID:  e.executionInfo.WorkflowId,
+		RunID:       e.executionState.RunId,
+	}
+	for _, category := range customTaskCategories.list() {
+		payloads, err := category.GenerateTasks(execution, events)
+		if err != nil {
+			return err
+		}
+		for _, payload := range payloads {
+			e.insertCustomTasks = append(e.insertCustomTasks, &persistence.CustomTask{
+				Category: category.Name(),
+				Data:     payload,
+			})
+		}
+	}
+	return nil
+}
+
 func (e *mutableStateBuilder) prepareEventsAndReplicationTasks(
 	transactionPolicy transactionPolicy,
 ) ([]*persistence.WorkflowEvents, error) {
@@ -4505,6 +4699,33 @@ func (e *mutableStateBuilder) closeTransactionHandleWorkflowReset(
 	return nil
 }
 
+func (e *mutableStateBuilder) closeTransactionHandleStuckExecution(
+	now time.Time,
+	transactionPolicy transactionPolicy,
+) error {
+
+	if transactionPolicy == transactionPolicyPassive ||
+		!e.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	// executions waiting for a workflow task or activity to be started are flagged by stuck execution check timers,
+	// here the flag is cleared once they make progress and retrying workflow tasks are flagged right away
+	if e.executionInfo.StuckReason == "" &&
+		int(e.executionInfo.WorkflowTaskAttempt) < e.config.StuckWorkflowTaskAttemptThreshold(e.GetNamespaceEntry().GetInfo().Name) {
+		return nil
+	}
+
+	return refreshStuckReason(
+		e,
+		e.taskGenerator,
+		e.config,
+		e.metricsClient,
+		metrics.WorkflowContextScope,
+		e.unixNanoToTime(now.UnixNano()),
+	)
+}
+
 func (e *mutableStateBuilder) closeTransactionHandleActivityUserTimerTasks(
 	now time.Time,
 	transactionPolicy transactionPolicy,
@@ -358,6 +358,72 @@ func (s *mutableStateSuite) TestReorderEvents() {
 	s.Equal(int64(5), s.msBuilder.hBuilder.history[1].GetActivityTaskCompletedEventAttributes().GetScheduledEventId())
 }
 
+func (s *mutableStateSuite) TestUpsertExternalSearchAttributes() {
+	s.msBuilder.GetExecutionInfo().SearchAttributes = map[string]*commonpb.Payload{
+		"CustomKeywordField": payload.EncodeString("old"),
+	}
+
+	s.NoError(s.msBuilder.UpsertExternalSearchAttributes(time.Now().UTC(), map[string]*commonpb.Payload{
+		"CustomKeywordField": payload.EncodeString("fraud_review"),
+		"CustomIntField":     payload.EncodeString("1"),
+	}))
+	s.Equal(map[string]*commonpb.Payload{
+		"CustomKeywordField": payload.EncodeString("fraud_review"),
+		"CustomIntField":     payload.EncodeString("1"),
+	}, s.msBuilder.GetExecutionInfo().ExternalSearchAttributes)
+	s.Equal(map[string]*commonpb.Payload{
+		"CustomKeywordField": payload.EncodeString("old"),
+	}, s.msBuilder.GetExecutionInfo().SearchAttributes)
+	s.Equal(int64(0), s.msBuilder.GetExecutionInfo().SignalCount)
+	s.Len(s.msBuilder.insertVisibilityTasks, 1)
+
+	// value upserted by the workflow replaces the external one
+	s.msBuilder.ReplicateUpsertWorkflowSearchAttributesEvent(&historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES,
+		Attributes: &historypb.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{UpsertWorkflowSearchAttributesEventAttributes: &historypb.UpsertWorkflowSearchAttributesEventAttributes{
+			SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+				"CustomKeywordField": payload.EncodeString("reviewed"),
+			}},
+		}},
+	})
+	s.Equal(map[string]*commonpb.Payload{
+		"CustomIntField": payload.EncodeString("1"),
+	}, s.msBuilder.GetExecutionInfo().ExternalSearchAttributes)
+	s.Equal(map[string]*commonpb.Payload{
+		"CustomKeywordField": payload.EncodeString("reviewed"),
+		"CustomIntField":     payload.EncodeString("1"),
+	}, withExternalSearchAttributes(copySearchAttributes(s.msBuilder.GetExecutionInfo().SearchAttributes), s.msBuilder.GetExecutionInfo().ExternalSearchAttributes))
+}
+
+func (s *mutableStateSuite) TestWorkflowReportRecorded() {
+	s.msBuilder.GetExecutionInfo().Memo = map[string]*commonpb.Payload{
+		"owner": payload.EncodeString("payments"),
+	}
+	s.msBuilder.GetExecutionInfo().WorkflowReports = map[string]*commonpb.Payload{
+		"progress": payload.EncodeString("10%"),
+	}
+
+	event := &historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_MARKER_RECORDED,
+		Attributes: &historypb.HistoryEvent_MarkerRecordedEventAttributes{MarkerRecordedEventAttributes: &historypb.MarkerRecordedEventAttributes{
+			MarkerName: common.WorkflowReportMarkerName,
+			Details: map[string]*commonpb.Payloads{
+				"progress": payloads.EncodeString("50%"),
+				"status":   payloads.EncodeString("charging"),
+			},
+		}},
+	}
+	s.msBuilder.ReplicateWorkflowReportRecordedEvent(event)
+	s.Equal(map[string]*commonpb.Payload{
+		"progress": payload.EncodeString("50%"),
+		"status":   payload.EncodeString("charging"),
+	}, s.msBuilder.GetExecutionInfo().WorkflowReports)
+	// reports don't leak into the memo
+	s.Equal(map[string]*commonpb.Payload{
+		"owner": payload.EncodeString("payments"),
+	}, s.msBuilder.GetExecutionInfo().Memo)
+}
+
 func (s *mutableStateSuite) TestChecksum() {
 	testCases := []struct {
 		name                 string
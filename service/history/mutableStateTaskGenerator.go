@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/configs"
 )
 
 type (
@@ -93,6 +94,9 @@ type (
 		generateWorkflowResetTasks(
 			now time.Time,
 		) error
+		generateOperatorAnnotationTasks(
+			now time.Time,
+		) error
 
 		// these 2 APIs should only be called when mutable state transaction is being closed
 		generateActivityTimerTasks(
@@ -111,6 +115,8 @@ type (
 
 		// TODO (alex): remove when kafka deprecation is done.
 		visibilityQueue string
+		// config is nil if mutable state is not a mutableStateBuilder, stuck execution checks are not generated then
+		config *configs.Config
 	}
 )
 
@@ -135,6 +141,7 @@ func newMutableStateTaskGenerator(
 	// TODO (alex): remove when kafka deprecation is done.
 	if ms, ok := mutableState.(*mutableStateBuilder); ok {
 		mstg.visibilityQueue = ms.config.VisibilityQueue()
+		mstg.config = ms.config
 	}
 
 	return mstg
@@ -295,6 +302,11 @@ func (r *mutableStateTaskGeneratorImpl) generateScheduleWorkflowTaskTasks(
 		})
 	}
 
+	r.generateStuckExecutionCheckTask(
+		workflowTask.ScheduledTime,
+		workflowTask.Version,
+		func(namespace string) time.Duration { return r.config.StuckWorkflowTaskStartThreshold(namespace) },
+	)
 	return nil
 }
 
@@ -362,6 +374,11 @@ func (r *mutableStateTaskGeneratorImpl) generateActivityTransferTasks(
 		Version:             activityInfo.Version,
 	})
 
+	r.generateStuckExecutionCheckTask(
+		activityInfo.ScheduledTime,
+		activityInfo.Version,
+		func(namespace string) time.Duration { return r.config.StuckActivityStartThreshold(namespace) },
+	)
 	return nil
 }
 
@@ -381,6 +398,12 @@ func (r *mutableStateTaskGeneratorImpl) generateActivityRetryTasks(
 		EventID:             ai.ScheduleId,
 		Attempt:             ai.Attempt,
 	})
+
+	r.generateStuckExecutionCheckTask(
+		ai.ScheduledTime,
+		ai.Version,
+		func(namespace string) time.Duration { return r.config.StuckActivityStartThreshold(namespace) },
+	)
 	return nil
 }
 
@@ -512,6 +535,33 @@ func (r *mutableStateTaskGeneratorImpl) generateWorkflowSearchAttrTasks(
 	return nil
 }
 
+// generateOperatorAnnotationTasks regenerates visibility record of the execution after operator annotated it.
+// Close record of closed execution is only rewritten by visibility queue, with kafka visibility queue the
+// annotations of closed execution show up in describe results only.
+func (r *mutableStateTaskGeneratorImpl) generateOperatorAnnotationTasks(
+	now time.Time,
+) error {
+
+	if r.mutableState.IsWorkflowExecutionRunning() {
+		return r.generateWorkflowSearchAttrTasks(now)
+	}
+	if r.visibilityQueue == common.VisibilityQueueKafka {
+		return nil
+	}
+
+	// close visibility task is verified against last write version of the execution
+	lastWriteVersion, err := r.mutableState.GetLastWriteVersion()
+	if err != nil {
+		return err
+	}
+	r.mutableState.AddVisibilityTasks(&persistence.CloseExecutionVisibilityTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: now,
+		Version:             lastWriteVersion,
+	})
+	return nil
+}
+
 func (r *mutableStateTaskGeneratorImpl) generateWorkflowResetTasks(
 	now time.Time,
 ) error {
@@ -543,6 +593,27 @@ func (r *mutableStateTaskGeneratorImpl) generateUserTimerTasks(
 	return err
 }
 
+func (r *mutableStateTaskGeneratorImpl) generateStuckExecutionCheckTask(
+	scheduledTime *time.Time,
+	version int64,
+	threshold func(namespace string) time.Duration,
+) {
+
+	if r.config == nil || scheduledTime == nil {
+		return
+	}
+	namespace := r.mutableState.GetNamespaceEntry().GetInfo().Name
+	if !r.config.EnableStuckExecutionDetection(namespace) {
+		return
+	}
+
+	r.mutableState.AddTimerTasks(&persistence.StuckExecutionCheckTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: scheduledTime.Add(threshold(namespace)),
+		Version:             version,
+	})
+}
+
 func (r *mutableStateTaskGeneratorImpl) getTimerSequence(now time.Time) timerSequence {
 	timeSource := clock.NewEventTimeSource()
 	timeSource.Update(now)
@@ -129,6 +129,20 @@ func (mr *MockmutableStateTaskGeneratorMockRecorder) generateDelayedWorkflowTask
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "generateDelayedWorkflowTasks", reflect.TypeOf((*MockmutableStateTaskGenerator)(nil).generateDelayedWorkflowTasks), now, startEvent)
 }
 
+// generateOperatorAnnotationTasks mocks base method.
+func (m *MockmutableStateTaskGenerator) generateOperatorAnnotationTasks(now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "generateOperatorAnnotationTasks", now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// generateOperatorAnnotationTasks indicates an expected call of generateOperatorAnnotationTasks.
+func (mr *MockmutableStateTaskGeneratorMockRecorder) generateOperatorAnnotationTasks(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "generateOperatorAnnotationTasks", reflect.TypeOf((*MockmutableStateTaskGenerator)(nil).generateOperatorAnnotationTasks), now)
+}
+
 // generateRecordWorkflowStartedTasks mocks base method.
 func (m *MockmutableStateTaskGenerator) generateRecordWorkflowStartedTasks(now time.Time, startEvent *history.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -358,6 +358,20 @@ func (mr *MockmutableStateMockRecorder) AddFirstWorkflowTaskScheduled(arg0 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFirstWorkflowTaskScheduled", reflect.TypeOf((*MockmutableState)(nil).AddFirstWorkflowTaskScheduled), arg0)
 }
 
+// AddOperatorAnnotation mocks base method.
+func (m *MockmutableState) AddOperatorAnnotation(now time.Time, annotation *persistence.OperatorAnnotation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOperatorAnnotation", now, annotation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOperatorAnnotation indicates an expected call of AddOperatorAnnotation.
+func (mr *MockmutableStateMockRecorder) AddOperatorAnnotation(now, annotation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOperatorAnnotation", reflect.TypeOf((*MockmutableState)(nil).AddOperatorAnnotation), now, annotation)
+}
+
 // AddRecordMarkerEvent mocks base method.
 func (m *MockmutableState) AddRecordMarkerEvent(arg0 int64, arg1 *command.RecordMarkerCommandAttributes) (*history.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -2024,6 +2038,18 @@ func (mr *MockmutableStateMockRecorder) ReplicateUpsertWorkflowSearchAttributesE
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateUpsertWorkflowSearchAttributesEvent", reflect.TypeOf((*MockmutableState)(nil).ReplicateUpsertWorkflowSearchAttributesEvent), arg0)
 }
 
+// ReplicateWorkflowReportRecordedEvent mocks base method.
+func (m *MockmutableState) ReplicateWorkflowReportRecordedEvent(arg0 *history.HistoryEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReplicateWorkflowReportRecordedEvent", arg0)
+}
+
+// ReplicateWorkflowReportRecordedEvent indicates an expected call of ReplicateWorkflowReportRecordedEvent.
+func (mr *MockmutableStateMockRecorder) ReplicateWorkflowReportRecordedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowReportRecordedEvent", reflect.TypeOf((*MockmutableState)(nil).ReplicateWorkflowReportRecordedEvent), arg0)
+}
+
 // ReplicateWorkflowExecutionCancelRequestedEvent mocks base method.
 func (m *MockmutableState) ReplicateWorkflowExecutionCancelRequestedEvent(arg0 *history.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -2237,6 +2263,34 @@ func (mr *MockmutableStateMockRecorder) RetryActivity(ai, failure interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivity", reflect.TypeOf((*MockmutableState)(nil).RetryActivity), ai, failure)
 }
 
+// RerouteActivity mocks base method.
+func (m *MockmutableState) RerouteActivity(ai *persistence.ActivityInfo, taskQueue string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RerouteActivity", ai, taskQueue)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RerouteActivity indicates an expected call of RerouteActivity.
+func (mr *MockmutableStateMockRecorder) RerouteActivity(ai, taskQueue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RerouteActivity", reflect.TypeOf((*MockmutableState)(nil).RerouteActivity), ai, taskQueue)
+}
+
+// RetryActivityImmediately mocks base method.
+func (m *MockmutableState) RetryActivityImmediately(ai *persistence.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryActivityImmediately", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryActivityImmediately indicates an expected call of RetryActivityImmediately.
+func (mr *MockmutableStateMockRecorder) RetryActivityImmediately(ai interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryActivityImmediately", reflect.TypeOf((*MockmutableState)(nil).RetryActivityImmediately), ai)
+}
+
 // SetCurrentBranchToken mocks base method.
 func (m *MockmutableState) SetCurrentBranchToken(branchToken []byte) error {
 	m.ctrl.T.Helper()
@@ -2449,3 +2503,17 @@ func (mr *MockmutableStateMockRecorder) UpdateWorkflowTask(arg0 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowTask", reflect.TypeOf((*MockmutableState)(nil).UpdateWorkflowTask), arg0)
 }
+
+// UpsertExternalSearchAttributes mocks base method.
+func (m *MockmutableState) UpsertExternalSearchAttributes(now time.Time, searchAttributes map[string]*common.Payload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertExternalSearchAttributes", now, searchAttributes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertExternalSearchAttributes indicates an expected call of UpsertExternalSearchAttributes.
+func (mr *MockmutableStateMockRecorder) UpsertExternalSearchAttributes(now, searchAttributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertExternalSearchAttributes", reflect.TypeOf((*MockmutableState)(nil).UpsertExternalSearchAttributes), now, searchAttributes)
+}
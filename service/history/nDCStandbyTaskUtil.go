@@ -119,6 +119,7 @@ type (
 
 	pushActivityTaskToMatchingInfo struct {
 		activityTaskScheduleToStartTimeout time.Duration
+		taskQueue                          string
 	}
 
 	pushWorkflowTaskToMatchingInfo struct {
@@ -139,10 +140,12 @@ func newHistoryResendInfo(
 
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout time.Duration,
+	taskQueue string,
 ) *pushActivityTaskToMatchingInfo {
 
 	return &pushActivityTaskToMatchingInfo{
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
+		taskQueue:                          taskQueue,
 	}
 }
 
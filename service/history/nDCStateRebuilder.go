@@ -137,6 +137,7 @@ func (r *nDCStateRebuilderImpl) rebuild(
 	if err := r.applyEvents(targetWorkflowIdentifier, stateBuilder, firstEventBatch, requestID); err != nil {
 		return nil, 0, err
 	}
+	r.checkReplicationSize(namespaceEntry, rebuiltMutableState, firstEventBatch)
 
 	for iter.HasNext() {
 		batch, err := iter.Next()
@@ -147,6 +148,7 @@ func (r *nDCStateRebuilderImpl) rebuild(
 		if err := r.applyEvents(targetWorkflowIdentifier, stateBuilder, events, requestID); err != nil {
 			return nil, 0, err
 		}
+		r.checkReplicationSize(namespaceEntry, rebuiltMutableState, events)
 	}
 
 	if err := rebuiltMutableState.SetCurrentBranchToken(targetBranchToken); err != nil {
@@ -227,6 +229,21 @@ func (r *nDCStateRebuilderImpl) applyEvents(
 	return nil
 }
 
+// checkReplicationSize restores the replication oversized flag of the execution, which is not part of history
+func (r *nDCStateRebuilderImpl) checkReplicationSize(
+	namespaceEntry *cache.NamespaceCacheEntry,
+	mutableState mutableState,
+	events []*historypb.HistoryEvent,
+) {
+
+	if namespaceEntry.GetReplicationPolicy() != cache.ReplicationPolicyMultiCluster {
+		return
+	}
+	sizeLimit := r.shard.GetConfig().ReplicationEventBatchSizeLimit(namespaceEntry.GetInfo().Name)
+	eventsSize := int64((&historypb.History{Events: events}).Size())
+	recordReplicationOversizedBatch(mutableState.GetExecutionInfo(), events, eventsSize, sizeLimit)
+}
+
 func (r *nDCStateRebuilderImpl) getPaginationFn(
 	firstEventID int64,
 	nextEventID int64,
@@ -51,6 +51,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/shard"
 )
@@ -283,6 +284,8 @@ func (s *nDCStateRebuilderSuite) TestRebuild() {
 
 	historySize1 := 12345
 	historySize2 := 67890
+	// only the batch with started event exceeds the replication size limit
+	s.mockShard.GetConfig().ReplicationEventBatchSizeLimit = dynamicconfig.GetIntPropertyFilteredByNamespace(history2[0].Size())
 	shardId := s.mockShard.GetShardID()
 	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", &persistence.ReadHistoryBranchRequest{
 		BranchToken:   branchToken,
@@ -349,4 +352,9 @@ func (s *nDCStateRebuilderSuite) TestRebuild() {
 		),
 	), rebuildMutableState.GetExecutionInfo().GetVersionHistories())
 	s.Equal(timestamp.TimeValue(rebuildMutableState.GetExecutionInfo().StartTime), s.now)
+	s.Equal(&persistencespb.OversizedEventBatch{
+		FirstEventId:   1,
+		SizeBytes:      int64(history1[0].Size()),
+		SizeLimitBytes: int64(history2[0].Size()),
+	}, rebuildExecutionInfo.GetReplicationOversizedBatch())
 }
@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/shard"
 )
 
@@ -189,6 +190,10 @@ func initializeLoggerForTask(
 		*persistencespb.VisibilityTaskInfo,
 		*persistence.ReplicationTaskInfoWrapper:
 		// noop
+	case *serialization.CustomTaskInfo:
+		taskLogger = taskLogger.WithTags(
+			tag.TaskCategory(task.GetCategory()),
+		)
 	default:
 		taskLogger.Error(fmt.Sprintf("Unknown queue task type: %v", task))
 	}
@@ -316,7 +316,7 @@ func (r *nDCTransactionMgrImpl) backfillWorkflowEventsReapply(
 		namespaceID := baseMutableState.GetExecutionInfo().NamespaceId
 		workflowID := baseMutableState.GetExecutionInfo().WorkflowId
 		baseRunID := baseMutableState.GetExecutionState().GetRunId()
-		resetRunID := uuid.New()
+		resetRunID := r.shard.GetService().GetUUIDGenerator().New()
 		baseRebuildLastEventID := baseMutableState.GetPreviousStartedEventID()
 
 		// TODO when https://github.com/uber/cadence/issues/2420 is finished, remove this block,
@@ -0,0 +1,59 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+)
+
+// operatorActionAnnotationTag is the tag of operator annotations which record applied operator actions
+const operatorActionAnnotationTag = "operator-action"
+
+// withOperatorAnnotations adds the operator annotations kept in execution info to the given copy of search
+// attributes as TemporalOperatorAnnotations and TemporalOperatorAnnotationTags. Both attributes are reserved
+// for the server, so values written by workflows are not mistaken for annotations.
+func withOperatorAnnotations(
+	searchAttributes map[string]*commonpb.Payload,
+	annotations []*persistencespb.OperatorAnnotation,
+) (map[string]*commonpb.Payload, error) {
+
+	if len(annotations) == 0 {
+		return searchAttributes, nil
+	}
+	annotationAttributes, err := common.EncodeOperatorAnnotations(annotations)
+	if err != nil {
+		return nil, err
+	}
+	if searchAttributes == nil {
+		searchAttributes = make(map[string]*commonpb.Payload)
+	}
+	for key, value := range annotationAttributes {
+		searchAttributes[key] = value
+	}
+	return searchAttributes, nil
+}
@@ -0,0 +1,58 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/payload"
+)
+
+func TestWithOperatorAnnotations(t *testing.T) {
+	searchAttributes, err := withOperatorAnnotations(nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, searchAttributes)
+
+	annotatedTime := time.Date(2020, 8, 23, 12, 0, 0, 0, time.UTC)
+	searchAttributes, err = withOperatorAnnotations(map[string]*commonpb.Payload{
+		definition.CustomKeywordField: payload.EncodeString("keyword"),
+	}, []*persistencespb.OperatorAnnotation{{
+		Time:       &annotatedTime,
+		Annotation: "paused pending vendor fix",
+		Tags:       []string{"vendor-outage"},
+	}})
+	assert.NoError(t, err)
+	assert.Contains(t, searchAttributes, definition.CustomKeywordField)
+	assert.Contains(t, searchAttributes, definition.TemporalOperatorAnnotations)
+	var tags []string
+	assert.NoError(t, payload.Decode(searchAttributes[definition.TemporalOperatorAnnotationTags], &tags))
+	assert.Equal(t, []string{"vendor-outage"}, tags)
+}
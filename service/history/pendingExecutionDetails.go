@@ -0,0 +1,142 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sort"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// defaultPendingDetailsTimerCount is number of timers reported when caller doesn't ask for specific number
+	defaultPendingDetailsTimerCount = 10
+	// maxPendingDetailsTimerCount caps number of timers reported to keep response small
+	maxPendingDetailsTimerCount = 100
+)
+
+const (
+	pendingStateScheduled = "Scheduled"
+	pendingStateStarted   = "Started"
+	pendingStateInitiated = "Initiated"
+)
+
+// pendingDetailsTimerCount returns number of next timers to report with pending execution details.
+func pendingDetailsTimerCount(requested int32) int {
+	if requested <= 0 {
+		return defaultPendingDetailsTimerCount
+	}
+	if requested > maxPendingDetailsTimerCount {
+		return maxPendingDetailsTimerCount
+	}
+	return int(requested)
+}
+
+// newPendingExecutionDetails explains what a running execution is waiting for.
+func newPendingExecutionDetails(mutableState mutableState, timerCount int) *historyspb.PendingExecutionDetails {
+	details := &historyspb.PendingExecutionDetails{}
+
+	if workflowTask, ok := mutableState.GetPendingWorkflowTask(); ok {
+		details.WorkflowTask = &historyspb.PendingWorkflowTaskDetails{
+			State:                 pendingStateScheduled,
+			ScheduleId:            workflowTask.ScheduleID,
+			Attempt:               workflowTask.Attempt,
+			Timeout:               workflowTask.WorkflowTaskTimeout,
+			ScheduledTime:         workflowTask.ScheduledTime,
+			OriginalScheduledTime: workflowTask.OriginalScheduledTime,
+		}
+		if workflowTask.StartedID != common.EmptyEventID {
+			details.WorkflowTask.State = pendingStateStarted
+			details.WorkflowTask.StartedId = workflowTask.StartedID
+			details.WorkflowTask.StartedTime = workflowTask.StartedTime
+		}
+	}
+
+	timerInfos := mutableState.GetPendingTimerInfos()
+	details.TotalTimers = int32(len(timerInfos))
+	for _, ti := range timerInfos {
+		details.NextTimers = append(details.NextTimers, &historyspb.PendingTimerDetails{
+			TimerId:   ti.GetTimerId(),
+			StartedId: ti.GetStartedId(),
+			FireTime:  ti.GetExpiryTime(),
+		})
+	}
+	sort.Slice(details.NextTimers, func(i, j int) bool {
+		left := timestamp.TimeValue(details.NextTimers[i].FireTime)
+		right := timestamp.TimeValue(details.NextTimers[j].FireTime)
+		if left.Equal(right) {
+			return details.NextTimers[i].StartedId < details.NextTimers[j].StartedId
+		}
+		return left.Before(right)
+	})
+	if len(details.NextTimers) > timerCount {
+		details.NextTimers = details.NextTimers[:timerCount]
+	}
+
+	for _, ci := range mutableState.GetPendingChildExecutionInfos() {
+		child := &historyspb.PendingChildDetails{
+			InitiatedId:       ci.GetInitiatedId(),
+			State:             pendingStateInitiated,
+			Namespace:         ci.GetNamespace(),
+			WorkflowTypeName:  ci.GetWorkflowTypeName(),
+			ParentClosePolicy: ci.GetParentClosePolicy(),
+		}
+		if ci.GetStartedId() != common.EmptyEventID {
+			child.State = pendingStateStarted
+			child.StartedId = ci.GetStartedId()
+			child.WorkflowId = ci.GetStartedWorkflowId()
+			child.RunId = ci.GetStartedRunId()
+		}
+		details.Children = append(details.Children, child)
+	}
+	sort.Slice(details.Children, func(i, j int) bool {
+		return details.Children[i].InitiatedId < details.Children[j].InitiatedId
+	})
+
+	for _, rci := range mutableState.GetPendingRequestCancelExternalInfos() {
+		details.CancelRequests = append(details.CancelRequests, &historyspb.PendingCancelRequestDetails{
+			InitiatedId:     rci.GetInitiatedId(),
+			CancelRequestId: rci.GetCancelRequestId(),
+		})
+	}
+	sort.Slice(details.CancelRequests, func(i, j int) bool {
+		return details.CancelRequests[i].InitiatedId < details.CancelRequests[j].InitiatedId
+	})
+
+	for _, si := range mutableState.GetPendingSignalExternalInfos() {
+		details.Signals = append(details.Signals, &historyspb.PendingSignalExternalDetails{
+			InitiatedId: si.GetInitiatedId(),
+			SignalName:  si.GetName(),
+			RequestId:   si.GetRequestId(),
+		})
+	}
+	sort.Slice(details.Signals, func(i, j int) bool {
+		return details.Signals[i].InitiatedId < details.Signals[j].InitiatedId
+	})
+
+	return details
+}
@@ -0,0 +1,166 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	pendingExecutionDetailsSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		mockMutableState *MockmutableState
+	}
+)
+
+func TestPendingExecutionDetailsSuite(t *testing.T) {
+	s := new(pendingExecutionDetailsSuite)
+	suite.Run(t, s)
+}
+
+func (s *pendingExecutionDetailsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockMutableState = NewMockmutableState(s.controller)
+}
+
+func (s *pendingExecutionDetailsSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *pendingExecutionDetailsSuite) TestNewPendingExecutionDetails() {
+	now := time.Date(2020, 8, 23, 12, 0, 0, 0, time.UTC)
+	scheduledTime := now.Add(-time.Minute)
+	startedTime := now.Add(-time.Second)
+
+	s.mockMutableState.EXPECT().GetPendingWorkflowTask().Return(&workflowTaskInfo{
+		ScheduleID:          10,
+		StartedID:           11,
+		Attempt:             3,
+		WorkflowTaskTimeout: timestamp.DurationPtr(10 * time.Second),
+		ScheduledTime:       &scheduledTime,
+		StartedTime:         &startedTime,
+	}, true)
+	s.mockMutableState.EXPECT().GetPendingTimerInfos().Return(map[string]*persistencespb.TimerInfo{
+		"late":   {TimerId: "late", StartedId: 5, ExpiryTime: timestamp.TimePtr(now.Add(time.Hour))},
+		"soon":   {TimerId: "soon", StartedId: 6, ExpiryTime: timestamp.TimePtr(now.Add(time.Minute))},
+		"middle": {TimerId: "middle", StartedId: 7, ExpiryTime: timestamp.TimePtr(now.Add(10 * time.Minute))},
+	})
+	s.mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(map[int64]*persistencespb.ChildExecutionInfo{
+		9: {
+			InitiatedId:       9,
+			StartedId:         12,
+			StartedWorkflowId: "child-wid",
+			StartedRunId:      "child-rid",
+			Namespace:         "child-namespace",
+			WorkflowTypeName:  "child-type",
+			ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_ABANDON,
+		},
+		8: {
+			InitiatedId:      8,
+			StartedId:        common.EmptyEventID,
+			Namespace:        "child-namespace",
+			WorkflowTypeName: "child-type",
+		},
+	})
+	s.mockMutableState.EXPECT().GetPendingRequestCancelExternalInfos().Return(map[int64]*persistencespb.RequestCancelInfo{
+		13: {InitiatedId: 13, CancelRequestId: "cancel-request-id"},
+	})
+	s.mockMutableState.EXPECT().GetPendingSignalExternalInfos().Return(map[int64]*persistencespb.SignalInfo{
+		14: {InitiatedId: 14, Name: "signal-name", RequestId: "signal-request-id"},
+	})
+
+	details := newPendingExecutionDetails(s.mockMutableState, 2)
+
+	s.Equal(&historyspb.PendingWorkflowTaskDetails{
+		State:         pendingStateStarted,
+		ScheduleId:    10,
+		StartedId:     11,
+		Attempt:       3,
+		Timeout:       timestamp.DurationPtr(10 * time.Second),
+		ScheduledTime: &scheduledTime,
+		StartedTime:   &startedTime,
+	}, details.WorkflowTask)
+	s.Equal(int32(3), details.TotalTimers)
+	s.Len(details.NextTimers, 2)
+	s.Equal("soon", details.NextTimers[0].TimerId)
+	s.Equal("middle", details.NextTimers[1].TimerId)
+	s.Equal([]*historyspb.PendingChildDetails{
+		{
+			InitiatedId:      8,
+			State:            pendingStateInitiated,
+			Namespace:        "child-namespace",
+			WorkflowTypeName: "child-type",
+		},
+		{
+			InitiatedId:       9,
+			StartedId:         12,
+			State:             pendingStateStarted,
+			Namespace:         "child-namespace",
+			WorkflowTypeName:  "child-type",
+			WorkflowId:        "child-wid",
+			RunId:             "child-rid",
+			ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_ABANDON,
+		},
+	}, details.Children)
+	s.Equal([]*historyspb.PendingCancelRequestDetails{{InitiatedId: 13, CancelRequestId: "cancel-request-id"}}, details.CancelRequests)
+	s.Equal([]*historyspb.PendingSignalExternalDetails{{InitiatedId: 14, SignalName: "signal-name", RequestId: "signal-request-id"}}, details.Signals)
+}
+
+func (s *pendingExecutionDetailsSuite) TestNewPendingExecutionDetails_Idle() {
+	s.mockMutableState.EXPECT().GetPendingWorkflowTask().Return(nil, false)
+	s.mockMutableState.EXPECT().GetPendingTimerInfos().Return(nil)
+	s.mockMutableState.EXPECT().GetPendingChildExecutionInfos().Return(nil)
+	s.mockMutableState.EXPECT().GetPendingRequestCancelExternalInfos().Return(nil)
+	s.mockMutableState.EXPECT().GetPendingSignalExternalInfos().Return(nil)
+
+	s.Equal(&historyspb.PendingExecutionDetails{}, newPendingExecutionDetails(s.mockMutableState, defaultPendingDetailsTimerCount))
+}
+
+func (s *pendingExecutionDetailsSuite) TestPendingDetailsTimerCount() {
+	testCases := map[int32]int{
+		5:    5,
+		0:    defaultPendingDetailsTimerCount,
+		-1:   defaultPendingDetailsTimerCount,
+		1000: maxPendingDetailsTimerCount,
+	}
+	for requested, expected := range testCases {
+		s.Equal(expected, pendingDetailsTimerCount(requested), requested)
+	}
+}
@@ -89,6 +89,13 @@ type (
 		ackMgr          queueAckMgr
 		redispatchQueue collection.Queue
 	}
+
+	customQueueTask struct {
+		*queueTaskBase
+
+		ackMgr          queueAckMgr
+		redispatchQueue collection.Queue
+	}
 )
 
 func newTimerQueueTask(
@@ -175,6 +182,34 @@ func newVisibilityQueueTask(
 	}
 }
 
+func newCustomQueueTask(
+	shard shard.Context,
+	taskInfo queueTaskInfo,
+	scope metrics.Scope,
+	logger log.Logger,
+	taskFilter taskFilter,
+	taskExecutor queueTaskExecutor,
+	redispatchQueue collection.Queue,
+	timeSource clock.TimeSource,
+	maxRetryCount dynamicconfig.IntPropertyFn,
+	ackMgr queueAckMgr,
+) queueTask {
+	return &customQueueTask{
+		queueTaskBase: newQueueTaskBase(
+			shard,
+			taskInfo,
+			scope,
+			logger,
+			taskFilter,
+			taskExecutor,
+			timeSource,
+			maxRetryCount,
+		),
+		ackMgr:          ackMgr,
+		redispatchQueue: redispatchQueue,
+	}
+}
+
 func newQueueTaskBase(
 	shard shard.Context,
 	queueTaskInfo queueTaskInfo,
@@ -258,6 +293,24 @@ func (t *visibilityQueueTask) GetQueueType() queueType {
 	return visibilityQueueType
 }
 
+func (t *customQueueTask) Ack() {
+	t.queueTaskBase.Ack()
+
+	t.ackMgr.completeQueueTask(t.GetTaskId())
+}
+
+func (t *customQueueTask) Nack() {
+	t.queueTaskBase.Nack()
+
+	// don't move redispatchQueue to queueTaskBase as we need to
+	// redispatch customQueueTask, not queueTaskBase
+	t.redispatchQueue.Add(t)
+}
+
+func (t *customQueueTask) GetQueueType() queueType {
+	return customQueueType
+}
+
 func (t *queueTaskBase) Execute() error {
 	// TODO: after mergering active and standby queue,
 	// the task should be smart enough to tell if it should be
@@ -286,7 +339,7 @@ func (t *queueTaskBase) HandleErr(
 	err error,
 ) (retErr error) {
 	defer func() {
-		if retErr != nil {
+		if retErr != nil && retErr != ErrTaskShed {
 			t.attempt++
 			if t.attempt > t.maxRetryCount() {
 				t.logger.Error("Critical error processing task, retrying.",
@@ -309,6 +362,12 @@ func (t *queueTaskBase) HandleErr(
 		return err
 	}
 
+	// shed tasks wait for the store to recover, they do not count as attempts
+	if err == ErrTaskShed {
+		t.scope.IncCounter(metrics.TaskShedCounter)
+		return err
+	}
+
 	if err == ErrTaskDiscarded {
 		t.scope.IncCounter(metrics.TaskDiscarded)
 		err = nil
@@ -341,7 +400,7 @@ func (t *queueTaskBase) HandleErr(
 func (t *queueTaskBase) RetryErr(
 	err error,
 ) bool {
-	if common.IsContextDeadlineExceededErr(err) {
+	if common.IsContextDeadlineExceededErr(err) || err == ErrTaskShed {
 		return false
 	}
 	return true
@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"hash/crc32"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+)
+
+// History replication tasks with event batches too large for a single message are polled by remote clusters with
+// a manifest in place of the events. Remote clusters pull the events chunk by chunk with GetReplicationEventChunk,
+// verify the checksum of each chunk and reassemble the task before applying it.
+
+// newReplicationChunkManifestTask returns the history replication task with its events replaced by the manifest
+// of their chunks, the events of the new run are kept as they are
+func newReplicationChunkManifestTask(
+	task *replicationspb.ReplicationTask,
+	taskInfo *persistencespb.ReplicationTaskInfo,
+	chunkSize int,
+) (*replicationspb.ReplicationTask, error) {
+	attributes := task.GetHistoryTaskV2Attributes()
+	if attributes == nil || chunkSize <= 0 {
+		return nil, fmt.Errorf("cannot chunk replication task %v", task.GetSourceTaskId())
+	}
+
+	events := attributes.GetEvents().GetData()
+	manifest := &replicationspb.ReplicationEventChunkManifest{
+		FirstEventId: taskInfo.GetFirstEventId(),
+		NextEventId:  taskInfo.GetNextEventId(),
+		Version:      taskInfo.GetVersion(),
+		EncodingType: attributes.GetEvents().GetEncodingType(),
+		EventsSize:   int64(len(events)),
+		ChunkSize:    int32(chunkSize),
+	}
+	for start := 0; start < len(events); start += chunkSize {
+		manifest.Checksums = append(manifest.Checksums, crc32.ChecksumIEEE(replicationChunk(events, start, chunkSize)))
+	}
+
+	manifestAttributes := *attributes
+	manifestAttributes.Events = nil
+	manifestAttributes.EventsChunkManifest = manifest
+	return &replicationspb.ReplicationTask{
+		TaskType:     task.GetTaskType(),
+		SourceTaskId: task.GetSourceTaskId(),
+		Attributes: &replicationspb.ReplicationTask_HistoryTaskV2Attributes{
+			HistoryTaskV2Attributes: &manifestAttributes,
+		},
+	}, nil
+}
+
+// getReplicationChunkManifest returns the manifest of the chunked history replication task,
+// and nil if the task carries its events
+func getReplicationChunkManifest(
+	task *replicationspb.ReplicationTask,
+) (*replicationspb.ReplicationEventChunkManifest, error) {
+	manifest := task.GetHistoryTaskV2Attributes().GetEventsChunkManifest()
+	if manifest == nil {
+		return nil, nil
+	}
+	if manifest.GetChunkSize() <= 0 || manifest.GetEventsSize() > int64(len(manifest.GetChecksums()))*int64(manifest.GetChunkSize()) {
+		return nil, serviceerror.NewInvalidArgument("invalid replication chunk manifest: chunks don't cover the events")
+	}
+	return manifest, nil
+}
+
+// replicationEventChunk returns the chunk of the serialized events of a history replication task
+func replicationEventChunk(
+	events []byte,
+	index int,
+	chunkSize int,
+) ([]byte, error) {
+	start := index * chunkSize
+	if index < 0 || chunkSize <= 0 || start >= len(events) {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"replication chunk %v of size %v is out of range of events of size %v", index, chunkSize, len(events)))
+	}
+	return replicationChunk(events, start, chunkSize), nil
+}
+
+// isLastReplicationEventChunk returns whether the chunk is the last one of the serialized events
+func isLastReplicationEventChunk(
+	events []byte,
+	index int,
+	chunkSize int,
+) bool {
+	return (index+1)*chunkSize >= len(events)
+}
+
+// assembleReplicationChunks returns the chunked history replication task with its events fetched chunk by chunk,
+// fetching fails on the first chunk not matching its checksum
+func assembleReplicationChunks(
+	task *replicationspb.ReplicationTask,
+	manifest *replicationspb.ReplicationEventChunkManifest,
+	fetchChunk func(index int) ([]byte, error),
+) (*replicationspb.ReplicationTask, error) {
+	events := make([]byte, 0, manifest.GetEventsSize())
+	for index, checksum := range manifest.GetChecksums() {
+		chunk, err := fetchChunk(index)
+		if err != nil {
+			return nil, err
+		}
+		if crc32.ChecksumIEEE(chunk) != checksum {
+			return nil, &replicationChunkChecksumError{index: index}
+		}
+		events = append(events, chunk...)
+	}
+	if int64(len(events)) != manifest.GetEventsSize() {
+		return nil, fmt.Errorf("assembled %v bytes of events of replication task %v, expected %v",
+			len(events), task.GetSourceTaskId(), manifest.GetEventsSize())
+	}
+
+	assembledAttributes := *task.GetHistoryTaskV2Attributes()
+	assembledAttributes.Events = &commonpb.DataBlob{
+		EncodingType: manifest.GetEncodingType(),
+		Data:         events,
+	}
+	assembledAttributes.EventsChunkManifest = nil
+	return &replicationspb.ReplicationTask{
+		TaskType:     task.GetTaskType(),
+		SourceTaskId: task.GetSourceTaskId(),
+		Attributes: &replicationspb.ReplicationTask_HistoryTaskV2Attributes{
+			HistoryTaskV2Attributes: &assembledAttributes,
+		},
+	}, nil
+}
+
+func replicationChunk(events []byte, start int, chunkSize int) []byte {
+	end := start + chunkSize
+	if end > len(events) {
+		end = len(events)
+	}
+	return events[start:end]
+}
+
+type replicationChunkChecksumError struct {
+	index int
+}
+
+func (e *replicationChunkChecksumError) Error() string {
+	return fmt.Sprintf("checksum mismatch of replication chunk %v", e.index)
+}
@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/cache"
+)
+
+func newTestHistoryReplicationTask(events []byte) *replicationspb.ReplicationTask {
+	return &replicationspb.ReplicationTask{
+		TaskType:     enumsspb.REPLICATION_TASK_TYPE_HISTORY_V2_TASK,
+		SourceTaskId: 123,
+		Attributes: &replicationspb.ReplicationTask_HistoryTaskV2Attributes{
+			HistoryTaskV2Attributes: &replicationspb.HistoryTaskV2Attributes{
+				TaskId:       5,
+				NamespaceId:  "namespace-id",
+				WorkflowId:   "workflow-id",
+				RunId:        "run-id",
+				Events:       &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: events},
+				NewRunEvents: &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("new run")},
+			},
+		},
+	}
+}
+
+func TestReplicationChunks(t *testing.T) {
+	events := make([]byte, 2500)
+	for i := range events {
+		events[i] = byte(i)
+	}
+	task := newTestHistoryReplicationTask(events)
+	taskInfo := &persistencespb.ReplicationTaskInfo{FirstEventId: 5, NextEventId: 8, Version: 2}
+
+	manifestTask, err := newReplicationChunkManifestTask(task, taskInfo, 1000)
+	require.NoError(t, err)
+	require.Equal(t, task.GetSourceTaskId(), manifestTask.GetSourceTaskId())
+	require.Equal(t, task.GetHistoryTaskV2Attributes().GetNewRunEvents(), manifestTask.GetHistoryTaskV2Attributes().GetNewRunEvents())
+	require.Less(t, manifestTask.Size(), 1000)
+	// the original task is left intact
+	require.Equal(t, events, task.GetHistoryTaskV2Attributes().GetEvents().GetData())
+
+	require.Nil(t, manifestTask.GetHistoryTaskV2Attributes().GetEvents())
+
+	manifest, err := getReplicationChunkManifest(manifestTask)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), manifest.GetFirstEventId())
+	require.Equal(t, int64(8), manifest.GetNextEventId())
+	require.Equal(t, int64(2), manifest.GetVersion())
+	require.Equal(t, int64(len(events)), manifest.GetEventsSize())
+	require.Len(t, manifest.GetChecksums(), 3)
+
+	manifest, err = getReplicationChunkManifest(task)
+	require.NoError(t, err)
+	require.Nil(t, manifest)
+	manifest, _ = getReplicationChunkManifest(manifestTask)
+
+	var fetched []int
+	fetchChunk := func(index int) ([]byte, error) {
+		fetched = append(fetched, index)
+		return replicationEventChunk(events, index, int(manifest.GetChunkSize()))
+	}
+	assembledTask, err := assembleReplicationChunks(manifestTask, manifest, fetchChunk)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 2}, fetched)
+	require.Equal(t, task, assembledTask)
+
+	_, err = replicationEventChunk(events, 3, 1000)
+	require.Error(t, err)
+}
+
+func TestReplicationChunks_ChecksumMismatch(t *testing.T) {
+	task := newTestHistoryReplicationTask(make([]byte, 2500))
+	manifestTask, err := newReplicationChunkManifestTask(task, &persistencespb.ReplicationTaskInfo{}, 1000)
+	require.NoError(t, err)
+	manifest, err := getReplicationChunkManifest(manifestTask)
+	require.NoError(t, err)
+
+	corrupted := make([]byte, 2500)
+	corrupted[1500] = 1
+	_, err = assembleReplicationChunks(manifestTask, manifest, func(index int) ([]byte, error) {
+		return replicationEventChunk(corrupted, index, 1000)
+	})
+	require.Equal(t, &replicationChunkChecksumError{index: 1}, err)
+}
+
+func TestReplicatorQueueProcessor_GetTaskChunk_Cached(t *testing.T) {
+	events := make([]byte, 2500)
+	events[2000] = 1
+	processor := &replicatorQueueProcessorImpl{
+		chunkedEvents: cache.New(2, &cache.Options{TTL: time.Minute}),
+	}
+	processor.chunkedEvents.Put(int64(123), events)
+	taskInfo := &replicationspb.ReplicationTaskInfo{TaskId: 123}
+
+	// chunks are served from the cache without reading the event batch again
+	chunk, err := processor.getTaskChunk(context.Background(), taskInfo, 0, 1000)
+	require.NoError(t, err)
+	require.Equal(t, events[:1000], chunk)
+	chunk, err = processor.getTaskChunk(context.Background(), taskInfo, 2, 1000)
+	require.NoError(t, err)
+	require.Equal(t, events[2000:], chunk)
+
+	// the events are evicted once the last chunk is fetched
+	require.Nil(t, processor.chunkedEvents.Get(int64(123)))
+}
@@ -0,0 +1,89 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/payload"
+)
+
+type (
+	// replicationOversizedFlag is the memo value of executions with an event batch too large to be replicated
+	replicationOversizedFlag struct {
+		FirstEventID int64 `json:"firstEventId"`
+		BatchSize    int64 `json:"batchSize"`
+		SizeLimit    int64 `json:"sizeLimit"`
+	}
+)
+
+// recordReplicationOversizedBatch keeps the first event batch exceeding the replication size limit in execution info,
+// returns true if the execution was flagged by this batch. The flag is not written to history, it is neither
+// replicated nor part of the memo of the execution.
+func recordReplicationOversizedBatch(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	events []*historypb.HistoryEvent,
+	eventsSize int64,
+	sizeLimit int,
+) bool {
+
+	if eventsSize <= int64(sizeLimit) || len(events) == 0 || executionInfo.ReplicationOversizedBatch != nil {
+		return false
+	}
+	executionInfo.ReplicationOversizedBatch = &persistencespb.OversizedEventBatch{
+		FirstEventId:   events[0].GetEventId(),
+		SizeBytes:      eventsSize,
+		SizeLimitBytes: int64(sizeLimit),
+	}
+	return true
+}
+
+// withReplicationOversizedFlag adds the replication oversized flag kept in execution info to a copy of the given memo
+func withReplicationOversizedFlag(
+	memo map[string]*commonpb.Payload,
+	batch *persistencespb.OversizedEventBatch,
+) map[string]*commonpb.Payload {
+
+	if batch == nil {
+		return memo
+	}
+	flag, err := payload.Encode(&replicationOversizedFlag{
+		FirstEventID: batch.GetFirstEventId(),
+		BatchSize:    batch.GetSizeBytes(),
+		SizeLimit:    batch.GetSizeLimitBytes(),
+	})
+	if err != nil {
+		return memo
+	}
+	memoCopy := make(map[string]*commonpb.Payload, len(memo)+1)
+	for key, value := range memo {
+		memoCopy[key] = value
+	}
+	memoCopy[common.ReplicationOversizedMemoKey] = flag
+	return memoCopy
+}
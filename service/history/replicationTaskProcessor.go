@@ -34,6 +34,7 @@ import (
 
 	"go.temporal.io/api/serviceerror"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -313,6 +314,22 @@ func (p *ReplicationTaskProcessorImpl) handleReplicationTask(
 
 	_ = p.rateLimiter.Wait(context.Background())
 
+	manifest, err := getReplicationChunkManifest(replicationTask)
+	if err != nil {
+		return err
+	}
+	if manifest != nil {
+		manifestTask := replicationTask
+		assemble := func() error {
+			var err error
+			replicationTask, err = p.assembleReplicationTask(manifestTask, manifest)
+			return err
+		}
+		if err := backoff.Retry(assemble, p.taskRetryPolicy, p.isRetryableError); err != nil {
+			return err
+		}
+	}
+
 	operation := func() error {
 		scope, err := p.replicationTaskExecutor.execute(replicationTask, false)
 		p.emitTaskMetrics(scope, err)
@@ -321,6 +338,52 @@ func (p *ReplicationTaskProcessorImpl) handleReplicationTask(
 	return backoff.Retry(operation, p.taskRetryPolicy, p.isRetryableError)
 }
 
+// assembleReplicationTask pulls events of the chunked history replication task from the source cluster chunk by chunk
+func (p *ReplicationTaskProcessorImpl) assembleReplicationTask(
+	replicationTask *replicationspb.ReplicationTask,
+	manifest *replicationspb.ReplicationEventChunkManifest,
+) (*replicationspb.ReplicationTask, error) {
+
+	attributes := replicationTask.GetHistoryTaskV2Attributes()
+	taskInfo := &replicationspb.ReplicationTaskInfo{
+		NamespaceId:  attributes.GetNamespaceId(),
+		WorkflowId:   attributes.GetWorkflowId(),
+		RunId:        attributes.GetRunId(),
+		TaskType:     enumsspb.TASK_TYPE_REPLICATION_HISTORY,
+		TaskId:       replicationTask.GetSourceTaskId(),
+		Version:      manifest.GetVersion(),
+		FirstEventId: manifest.GetFirstEventId(),
+		NextEventId:  manifest.GetNextEventId(),
+	}
+	remoteAdminClient := p.shard.GetService().GetClientBean().GetRemoteAdminClient(p.sourceCluster)
+	fetchChunk := func(index int) ([]byte, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+		defer cancel()
+
+		resp, err := remoteAdminClient.GetReplicationEventChunk(ctx, &adminservice.GetReplicationEventChunkRequest{
+			TaskInfo:   taskInfo,
+			ChunkIndex: int32(index),
+			ChunkSize:  manifest.GetChunkSize(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		p.metricsClient.IncCounter(metrics.ReplicationTaskFetcherScope, metrics.ReplicationChunksFetched)
+		return resp.GetData(), nil
+	}
+
+	task, err := assembleReplicationChunks(replicationTask, manifest, fetchChunk)
+	if err != nil {
+		if _, ok := err.(*replicationChunkChecksumError); ok {
+			p.metricsClient.IncCounter(metrics.ReplicationTaskFetcherScope, metrics.ReplicationChunkChecksumFailures)
+		}
+		p.logger.Warn("Failed to assemble chunked replication task.", tag.TaskID(replicationTask.GetSourceTaskId()), tag.Error(err))
+		return nil, err
+	}
+	p.metricsClient.IncCounter(metrics.ReplicationTaskFetcherScope, metrics.ReplicationChunkedTasksAssembled)
+	return task, nil
+}
+
 func (p *ReplicationTaskProcessorImpl) handleReplicationDLQTask(
 	request *persistence.PutReplicationTaskToDLQRequest,
 ) error {
@@ -375,6 +438,26 @@ func (p *ReplicationTaskProcessorImpl) convertTaskToDLQTask(
 	case enumsspb.REPLICATION_TASK_TYPE_HISTORY_V2_TASK:
 		taskAttributes := replicationTask.GetHistoryTaskV2Attributes()
 
+		manifest, err := getReplicationChunkManifest(replicationTask)
+		if err != nil {
+			return nil, err
+		}
+		if manifest != nil {
+			return &persistence.PutReplicationTaskToDLQRequest{
+				SourceClusterName: p.sourceCluster,
+				TaskInfo: &persistencespb.ReplicationTaskInfo{
+					NamespaceId:  taskAttributes.GetNamespaceId(),
+					WorkflowId:   taskAttributes.GetWorkflowId(),
+					RunId:        taskAttributes.GetRunId(),
+					TaskId:       replicationTask.GetSourceTaskId(),
+					TaskType:     enumsspb.TASK_TYPE_REPLICATION_HISTORY,
+					FirstEventId: manifest.GetFirstEventId(),
+					NextEventId:  manifest.GetNextEventId(),
+					Version:      manifest.GetVersion(),
+				},
+			}, nil
+		}
+
 		eventsDataBlob := persistence.NewDataBlobFromProto(taskAttributes.GetEvents())
 		events, err := p.historySerializer.DeserializeEvents(eventsDataBlob)
 		if err != nil {
@@ -27,6 +27,7 @@ package history
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -38,6 +39,7 @@ import (
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -61,6 +63,8 @@ type (
 		retryPolicy        backoff.RetryPolicy
 		// This is the batch size used by pull based RPC replicator.
 		fetchTasksBatchSize int
+		// serialized events of chunked replication tasks by task ID, kept while remote clusters fetch their chunks
+		chunkedEvents cache.Cache
 	}
 )
 
@@ -94,6 +98,9 @@ func newReplicatorQueueProcessor(
 		logger:              logger,
 		retryPolicy:         retryPolicy,
 		fetchTasksBatchSize: config.ReplicatorProcessorFetchTasksBatchSize(),
+		chunkedEvents: cache.New(config.ReplicationChunkedEventsCacheSize(), &cache.Options{
+			TTL: config.ReplicationChunkedEventsCacheTTL(),
+		}),
 	}
 }
 
@@ -137,6 +144,7 @@ func (p *replicatorQueueProcessorImpl) getTasks(
 		}
 		readLevel = taskInfo.GetTaskId()
 		if replicationTask != nil {
+			replicationTask = p.checkReplicationTaskSize(pollingCluster, taskInfo, replicationTask)
 			replicationTasks = append(replicationTasks, replicationTask)
 		}
 	}
@@ -169,6 +177,92 @@ func (p *replicatorQueueProcessorImpl) getTasks(
 	}, nil
 }
 
+// checkReplicationTaskSize returns the history replication task to be sent to the polling cluster. Tasks too
+// large to be received by the polling cluster are chunked if chunked transfer is enabled, otherwise they are
+// reported and keep failing to replicate until the limits of the cluster are raised.
+func (p *replicatorQueueProcessorImpl) checkReplicationTaskSize(
+	pollingCluster string,
+	taskInfo queueTaskInfo,
+	replicationTask *replicationspb.ReplicationTask,
+) *replicationspb.ReplicationTask {
+	attributes := replicationTask.GetHistoryTaskV2Attributes()
+	if attributes == nil {
+		return replicationTask
+	}
+	namespace, err := p.shard.GetNamespaceCache().GetNamespaceName(attributes.GetNamespaceId())
+	if err != nil {
+		return replicationTask
+	}
+	config := p.shard.GetConfig()
+	sizeLimit := config.ReplicationEventBatchSizeLimit(namespace)
+	taskSize := replicationTask.Size()
+	if taskSize <= sizeLimit {
+		return replicationTask
+	}
+
+	scope := p.metricsClient.Scope(
+		metrics.ReplicatorQueueProcessorScope,
+		metrics.TargetClusterTag(pollingCluster),
+		metrics.NamespaceTag(namespace),
+	)
+	if config.ReplicationChunkedTransferEnabled() {
+		if t, ok := taskInfo.(*persistence.ReplicationTaskInfoWrapper); ok {
+			manifestTask, err := newReplicationChunkManifestTask(replicationTask, t.ReplicationTaskInfo, config.ReplicationEventChunkSize())
+			if err == nil {
+				p.chunkedEvents.Put(replicationTask.GetSourceTaskId(), attributes.GetEvents().GetData())
+				scope.IncCounter(metrics.ReplicationTasksChunked)
+				return manifestTask
+			}
+			p.logger.Error("Failed to chunk oversized replication task.", tag.TaskID(replicationTask.GetSourceTaskId()), tag.Error(err))
+		}
+	}
+
+	scope.IncCounter(metrics.ReplicationTasksOversized)
+	p.shard.GetThrottledLogger().Error("Replication task exceeds replication size limit and is expected to be rejected by the remote cluster. "+
+		"Enable chunked replication transfer or raise the max receive message size of the remote cluster, the execution is flagged in its memo.",
+		tag.ClusterName(pollingCluster),
+		tag.WorkflowNamespace(namespace),
+		tag.WorkflowID(attributes.GetWorkflowId()),
+		tag.WorkflowRunID(attributes.GetRunId()),
+		tag.WorkflowFirstEventID(attributes.GetTaskId()),
+		tag.WorkflowHistorySizeBytes(taskSize))
+	return replicationTask
+}
+
+// getTaskChunk returns the requested chunk of events of the history replication task. The serialized events are
+// cached while the chunks are fetched, so that the event batch is read from persistence once per transfer.
+func (p *replicatorQueueProcessorImpl) getTaskChunk(
+	ctx context.Context,
+	taskInfo *replicationspb.ReplicationTaskInfo,
+	index int,
+	chunkSize int,
+) ([]byte, error) {
+	events, ok := p.chunkedEvents.Get(taskInfo.GetTaskId()).([]byte)
+	if !ok {
+		task, err := p.getTask(ctx, taskInfo)
+		if err != nil {
+			return nil, err
+		}
+		if task == nil {
+			return nil, serviceerror.NewNotFound(fmt.Sprintf("replication task %v is not found", taskInfo.GetTaskId()))
+		}
+		if task.GetHistoryTaskV2Attributes() == nil {
+			return nil, serviceerror.NewInvalidArgument("only history replication tasks can be chunked")
+		}
+		events = task.GetHistoryTaskV2Attributes().GetEvents().GetData()
+		p.chunkedEvents.Put(taskInfo.GetTaskId(), events)
+	}
+
+	chunk, err := replicationEventChunk(events, index, chunkSize)
+	if err != nil {
+		return nil, err
+	}
+	if isLastReplicationEventChunk(events, index, chunkSize) {
+		p.chunkedEvents.Delete(taskInfo.GetTaskId())
+	}
+	return chunk, nil
+}
+
 func (p *replicatorQueueProcessorImpl) getTask(
 	ctx context.Context,
 	taskInfo *replicationspb.ReplicationTaskInfo,
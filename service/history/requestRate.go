@@ -0,0 +1,97 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	// requestRateTracker counts requests served by history host over a sliding window,
+	// the rate is reported by DescribeHistoryHost as load of the host.
+	requestRateTracker struct {
+		sync.Mutex
+		timeSource clock.TimeSource
+		// counts and seconds are per second buckets of the window, indexed by unix second modulo window size
+		counts  [requestRateWindowSeconds]int64
+		seconds [requestRateWindowSeconds]int64
+	}
+)
+
+const (
+	requestRateWindowSeconds = 60
+)
+
+func newRequestRateTracker(
+	timeSource clock.TimeSource,
+) *requestRateTracker {
+	return &requestRateTracker{
+		timeSource: timeSource,
+	}
+}
+
+func (t *requestRateTracker) record() {
+	now := t.timeSource.Now().Unix()
+	index := now % requestRateWindowSeconds
+
+	t.Lock()
+	defer t.Unlock()
+	if t.seconds[index] != now {
+		t.seconds[index] = now
+		t.counts[index] = 0
+	}
+	t.counts[index]++
+}
+
+// rate returns average requests per second over the window
+func (t *requestRateTracker) rate() float64 {
+	now := t.timeSource.Now().Unix()
+
+	t.Lock()
+	defer t.Unlock()
+	var total int64
+	for i := range t.counts {
+		if now-t.seconds[i] < requestRateWindowSeconds {
+			total += t.counts[i]
+		}
+	}
+	return float64(total) / float64(requestRateWindowSeconds)
+}
+
+// interceptor records every unary request served by history host
+func (t *requestRateTracker) interceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	t.record()
+	return handler(ctx, req)
+}
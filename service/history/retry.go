@@ -26,6 +26,7 @@ package history
 
 import (
 	"math"
+	"math/rand"
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
@@ -92,6 +93,34 @@ func getBackoffInterval(
 	return backoffInterval, enumspb.RETRY_STATE_IN_PROGRESS
 }
 
+// jitterRetryBackoff randomly takes off up to coefficient portion of the backoff,
+// so jittered backoff never exceeds the one computed from retry policy
+func jitterRetryBackoff(
+	backoffInterval time.Duration,
+	coefficient float64,
+) time.Duration {
+
+	if backoffInterval <= 0 || coefficient <= 0 {
+		return backoffInterval
+	}
+	if coefficient > 1 {
+		coefficient = 1
+	}
+	return backoffInterval - time.Duration(rand.Float64()*coefficient*float64(backoffInterval))
+}
+
+// jitterCronBackoff randomly delays the next cron run by up to maxJitter
+func jitterCronBackoff(
+	backoffInterval time.Duration,
+	maxJitter time.Duration,
+) time.Duration {
+
+	if backoffInterval < 0 || maxJitter <= 0 {
+		return backoffInterval
+	}
+	return backoffInterval + time.Duration(rand.Int63n(int64(maxJitter)))
+}
+
 func isRetryable(failure *failurepb.Failure, nonRetryableTypes []string) bool {
 	if failure == nil {
 		return true
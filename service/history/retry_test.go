@@ -373,3 +373,37 @@ func Test_NextRetry(t *testing.T) {
 	a.Equal(enumspb.RETRY_STATE_IN_PROGRESS, retryState)
 	ai.Attempt++
 }
+
+func Test_JitterRetryBackoff(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(10*time.Second, jitterRetryBackoff(10*time.Second, 0))
+	a.Equal(backoff.NoBackoff, jitterRetryBackoff(backoff.NoBackoff, 0.5))
+
+	for i := 0; i < 100; i++ {
+		jittered := jitterRetryBackoff(10*time.Second, 0.2)
+		a.True(jittered > 8*time.Second)
+		a.True(jittered <= 10*time.Second)
+
+		jittered = jitterRetryBackoff(10*time.Second, 2)
+		a.True(jittered > 0)
+		a.True(jittered <= 10*time.Second)
+	}
+}
+
+func Test_JitterCronBackoff(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(time.Minute, jitterCronBackoff(time.Minute, 0))
+	a.Equal(backoff.NoBackoff, jitterCronBackoff(backoff.NoBackoff, time.Second))
+
+	for i := 0; i < 100; i++ {
+		jittered := jitterCronBackoff(time.Minute, 10*time.Second)
+		a.True(jittered >= time.Minute)
+		a.True(jittered < time.Minute+10*time.Second)
+
+		jittered = jitterCronBackoff(0, 10*time.Second)
+		a.True(jittered >= 0)
+		a.True(jittered < 10*time.Second)
+	}
+}
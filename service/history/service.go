@@ -29,10 +29,10 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
-	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/healthcheck"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/messaging"
@@ -55,7 +55,8 @@ type Service struct {
 	params  *resource.BootstrapParams
 	config  *configs.Config
 
-	server *grpc.Server
+	server        *grpc.Server
+	healthChecker *healthcheck.Checker
 }
 
 // NewService builds a new history service
@@ -160,12 +161,28 @@ func (s *Service) Start() {
 	if err != nil {
 		logger.Fatal("creating grpc server options failed", tag.Error(err))
 	}
+	interceptors := []grpc.UnaryServerInterceptor{rpc.ServiceErrorInterceptor, s.handler.requestRate.interceptor}
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if provider, ok := s.params.RPCFactory.(common.ServerInterceptorProvider); ok {
+		unary, stream := provider.GetInternodeServerInterceptors()
+		interceptors = append(interceptors, unary...)
+		streamInterceptors = stream
+	}
 	opts = append(
 		opts,
-		grpc.ChainUnaryInterceptor(rpc.ServiceErrorInterceptor))
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...))
 	s.server = grpc.NewServer(opts...)
 	historyservice.RegisterHistoryServiceServer(s.server, s.handler)
-	healthpb.RegisterHealthServer(s.server, s.handler)
+	s.healthChecker = healthcheck.NewChecker(
+		common.HistoryServiceName,
+		s.GetMembershipMonitor(),
+		s.GetClusterMetadataManager(),
+		logger,
+		serviceName,
+	)
+	s.healthChecker.Register(s.server)
+	s.healthChecker.Start()
 
 	listener := s.GetGRPCListener()
 	logger.Info("Starting to serve on history listener")
@@ -197,6 +214,9 @@ func (s *Service) Stop() {
 
 	remainingTime := s.config.ShutdownDrainDuration()
 
+	s.GetLogger().Info("ShutdownHandler: Updating rpc health status to NotServing")
+	s.healthChecker.Stop()
+
 	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
 	s.GetMembershipMonitor().EvictSelf()
 
@@ -211,8 +231,11 @@ func (s *Service) Stop() {
 	s.GetLogger().Info("ShutdownHandler: No longer taking rpc requests")
 	remainingTime = s.sleep(gracePeriod, remainingTime)
 
-	// TODO: Change this to GracefulStop when integration tests are refactored.
-	s.server.Stop()
+	if drainer, ok := s.params.RPCFactory.(common.GRPCServerDrainer); ok {
+		drainer.DrainGRPCServer(s.server)
+	} else {
+		s.server.Stop()
+	}
 
 	s.handler.Stop()
 	s.Resource.Stop()
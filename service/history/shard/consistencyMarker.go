@@ -0,0 +1,280 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// consistencyMarkerCache keeps the consistency marker shards are restored to, the marker carries all shards
+	// so that it is read once per host instead of once per shard
+	consistencyMarkerCache struct {
+		store                     persistence.ConsistencyMarkerStore
+		namespaceReplicationQueue persistence.NamespaceReplicationQueue
+		logger                    log.Logger
+
+		sync.Mutex
+		marker *persistence.ConsistencyMarker
+	}
+
+	// shardRestorePlan describes how a shard is reconciled with its marked state
+	shardRestorePlan struct {
+		// ShardInfo is the shard to write, its ack levels are rewound to the marker so that tasks
+		// created after the marker are replayed, and its range is moved past both the current
+		// and the marked range so that new task IDs never go backwards.
+		ShardInfo       *persistencespb.ShardInfo
+		PreviousRangeID int64
+		Rewound         bool
+
+		// Tasks at or below the marked ack levels were processed before the marker and can be trimmed
+		TrimTransferTaskID    int64
+		TrimVisibilityTaskID  int64
+		TrimReplicationTaskID int64
+		TrimTimerTime         time.Time
+	}
+)
+
+func newConsistencyMarkerCache(
+	store persistence.ConsistencyMarkerStore,
+	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
+	logger log.Logger,
+) *consistencyMarkerCache {
+	return &consistencyMarkerCache{
+		store:                     store,
+		namespaceReplicationQueue: namespaceReplicationQueue,
+		logger:                    logger,
+	}
+}
+
+// getShard returns the marked state of shard
+func (c *consistencyMarkerCache) getShard(markerID int64, shardID int32) (*persistencespb.ShardInfo, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.marker == nil || c.marker.MarkerID != markerID {
+		marker, err := c.store.GetMarker(markerID)
+		if err != nil {
+			return nil, err
+		}
+		c.marker = marker
+		c.checkNamespaceReplicationAckLevels()
+	}
+	for _, shardInfo := range c.marker.Shards {
+		if shardInfo.GetShardId() == shardID {
+			return shardInfo, nil
+		}
+	}
+	return nil, serviceerror.NewNotFound(fmt.Sprintf("Shard %d is not in consistency marker %d.", shardID, markerID))
+}
+
+// checkNamespaceReplicationAckLevels warns of clusters ahead of the marker, queue ack levels only move forward,
+// so those clusters have to re-sync namespaces
+func (c *consistencyMarkerCache) checkNamespaceReplicationAckLevels() {
+	ackLevels, err := c.namespaceReplicationQueue.GetAckLevels()
+	if err != nil {
+		c.logger.Warn("Failed to get namespace replication queue ack levels.", tag.Error(err))
+		return
+	}
+	for clusterName, ackLevel := range ackLevels {
+		if markedAckLevel, ok := c.marker.NamespaceReplicationAckLevels[clusterName]; ok && ackLevel > markedAckLevel {
+			c.logger.Warn("Namespace replication ack level of cluster is ahead of consistency marker.",
+				tag.ClusterName(clusterName), tag.AckLevel(ackLevel), tag.Value(markedAckLevel))
+		}
+	}
+}
+
+// restoreToConsistencyMarker reconciles shard with the consistency marker set by RestoreConsistencyMarkerID
+// dynamic config when the shard is acquired after a restore. Each marker is applied once, the marker shard
+// is restored to is kept in shard info.
+func restoreToConsistencyMarker(
+	shardItem *historyShardsItem,
+	shardInfo *persistencespb.ShardInfo,
+) (*persistencespb.ShardInfo, error) {
+
+	markerID := int64(shardItem.config.RestoreConsistencyMarkerID())
+	if markerID <= 0 || shardInfo.GetRestoredConsistencyMarkerId() == markerID {
+		return shardInfo, nil
+	}
+
+	marked, err := shardItem.consistencyMarkers.getShard(markerID, shardItem.shardID)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := newShardRestorePlan(shardInfo, marked)
+	if err != nil {
+		return nil, err
+	}
+	plan.ShardInfo.RestoredConsistencyMarkerId = markerID
+
+	if shardItem.config.RestoreConsistencyMarkerTrimTasks() {
+		executionMgr, err := shardItem.GetExecutionManager(shardItem.shardID)
+		if err != nil {
+			return nil, err
+		}
+		if err := trimShardTasks(executionMgr, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := shardItem.GetShardManager().UpdateShard(&persistence.UpdateShardRequest{
+		ShardInfo:       plan.ShardInfo,
+		PreviousRangeID: plan.PreviousRangeID,
+	}); err != nil {
+		return nil, err
+	}
+	shardItem.logger.Info("Restored shard to consistency marker.",
+		tag.Value(markerID),
+		tag.ShardRangeID(plan.ShardInfo.GetRangeId()),
+		tag.PreviousShardRangeID(plan.PreviousRangeID),
+		tag.Bool(plan.Rewound),
+	)
+	return plan.ShardInfo, nil
+}
+
+func newShardRestorePlan(current *persistencespb.ShardInfo, marked *persistencespb.ShardInfo) (*shardRestorePlan, error) {
+	// copy through the wire format, proto.Clone does not support stdtime fields
+	data, err := current.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	shardInfo := &persistencespb.ShardInfo{}
+	if err := shardInfo.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	plan := &shardRestorePlan{
+		ShardInfo:             shardInfo,
+		PreviousRangeID:       current.GetRangeId(),
+		TrimTransferTaskID:    marked.GetTransferAckLevel(),
+		TrimVisibilityTaskID:  marked.GetVisibilityAckLevel(),
+		TrimReplicationTaskID: marked.GetReplicationAckLevel(),
+		TrimTimerTime:         timestamp.TimeValue(marked.GetTimerAckLevelTime()),
+	}
+
+	rangeID := current.GetRangeId()
+	if marked.GetRangeId() > rangeID {
+		rangeID = marked.GetRangeId()
+	}
+	shardInfo.RangeId = rangeID + 1
+
+	rewindTaskID := func(level *int64, markedLevel int64) {
+		if *level > markedLevel {
+			*level = markedLevel
+			plan.Rewound = true
+		}
+	}
+	rewindTaskID(&shardInfo.TransferAckLevel, marked.GetTransferAckLevel())
+	rewindTaskID(&shardInfo.VisibilityAckLevel, marked.GetVisibilityAckLevel())
+	rewindTaskID(&shardInfo.ReplicationAckLevel, marked.GetReplicationAckLevel())
+	for clusterName, markedLevel := range marked.GetClusterTransferAckLevel() {
+		if level, ok := shardInfo.ClusterTransferAckLevel[clusterName]; ok {
+			rewindTaskID(&level, markedLevel)
+			shardInfo.ClusterTransferAckLevel[clusterName] = level
+		}
+	}
+	for clusterName, markedLevel := range marked.GetClusterReplicationLevel() {
+		if level, ok := shardInfo.ClusterReplicationLevel[clusterName]; ok {
+			rewindTaskID(&level, markedLevel)
+			shardInfo.ClusterReplicationLevel[clusterName] = level
+		}
+	}
+
+	rewindTime := func(level **time.Time, markedLevel *time.Time) {
+		if markedLevel != nil && timestamp.TimeValue(*level).After(*markedLevel) {
+			*level = timestamp.TimePtr(*markedLevel)
+			plan.Rewound = true
+		}
+	}
+	rewindTime(&shardInfo.TimerAckLevelTime, marked.GetTimerAckLevelTime())
+	for clusterName, markedLevel := range marked.GetClusterTimerAckLevel() {
+		if level, ok := shardInfo.ClusterTimerAckLevel[clusterName]; ok {
+			rewindTime(&level, markedLevel)
+			shardInfo.ClusterTimerAckLevel[clusterName] = level
+		}
+	}
+
+	// cluster ack levels may trail the shard ack levels, never trim tasks they still need
+	for _, level := range marked.GetClusterTransferAckLevel() {
+		if level < plan.TrimTransferTaskID {
+			plan.TrimTransferTaskID = level
+		}
+	}
+	for _, level := range marked.GetClusterReplicationLevel() {
+		if level < plan.TrimReplicationTaskID {
+			plan.TrimReplicationTaskID = level
+		}
+	}
+	for _, level := range marked.GetClusterTimerAckLevel() {
+		if level != nil && level.Before(plan.TrimTimerTime) {
+			plan.TrimTimerTime = *level
+		}
+	}
+	return plan, nil
+}
+
+func trimShardTasks(executionMgr persistence.ExecutionManager, plan *shardRestorePlan) error {
+	if plan.TrimTransferTaskID > 0 {
+		if err := executionMgr.RangeCompleteTransferTask(&persistence.RangeCompleteTransferTaskRequest{
+			ExclusiveBeginTaskID: 0,
+			InclusiveEndTaskID:   plan.TrimTransferTaskID,
+		}); err != nil {
+			return err
+		}
+	}
+	if plan.TrimVisibilityTaskID > 0 {
+		if err := executionMgr.RangeCompleteVisibilityTask(&persistence.RangeCompleteVisibilityTaskRequest{
+			ExclusiveBeginTaskID: 0,
+			InclusiveEndTaskID:   plan.TrimVisibilityTaskID,
+		}); err != nil {
+			return err
+		}
+	}
+	if plan.TrimReplicationTaskID > 0 {
+		if err := executionMgr.RangeCompleteReplicationTask(&persistence.RangeCompleteReplicationTaskRequest{
+			InclusiveEndTaskID: plan.TrimReplicationTaskID,
+		}); err != nil {
+			return err
+		}
+	}
+	if !plan.TrimTimerTime.IsZero() {
+		if err := executionMgr.RangeCompleteTimerTask(&persistence.RangeCompleteTimerTaskRequest{
+			InclusiveBeginTimestamp: time.Unix(0, 0).UTC(),
+			ExclusiveEndTimestamp:   plan.TrimTimerTime,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
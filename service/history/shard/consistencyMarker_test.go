@@ -0,0 +1,185 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/service/history/configs"
+)
+
+func TestRestoreToConsistencyMarker(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockResource := resource.NewTest(controller, metrics.History)
+	defer mockResource.Finish(t)
+
+	marker := &persistence.ConsistencyMarker{
+		MarkerID: 7,
+		Shards: []*persistencespb.ShardInfo{
+			{ShardId: 1, RangeId: 10, TransferAckLevel: 100, VisibilityAckLevel: 90},
+			{ShardId: 2, RangeId: 10, TransferAckLevel: 200},
+		},
+		NamespaceReplicationAckLevels: map[string]int64{"standby": 5},
+	}
+	// the marker is read once for all shards of the host
+	mockResource.ConsistencyMarkerStore.EXPECT().GetMarker(int64(7)).Return(marker, nil).Times(1)
+	mockResource.NamespaceReplicationQueue.(*persistence.MockNamespaceReplicationQueue).EXPECT().
+		GetAckLevels().Return(map[string]int64{"standby": 9}, nil).Times(1)
+	mockResource.ExecutionMgr.On("RangeCompleteTransferTask", &persistence.RangeCompleteTransferTaskRequest{
+		InclusiveEndTaskID: 100,
+	}).Return(nil).Once()
+	mockResource.ExecutionMgr.On("RangeCompleteVisibilityTask", &persistence.RangeCompleteVisibilityTaskRequest{
+		InclusiveEndTaskID: 90,
+	}).Return(nil).Once()
+	mockResource.ExecutionMgr.On("RangeCompleteTransferTask", &persistence.RangeCompleteTransferTaskRequest{
+		InclusiveEndTaskID: 200,
+	}).Return(nil).Once()
+	var updated []*persistence.UpdateShardRequest
+	mockResource.ShardMgr.On("UpdateShard", mock.Anything).Return(nil).Run(func(arguments mock.Arguments) {
+		updated = append(updated, arguments.Get(0).(*persistence.UpdateShardRequest))
+	}).Twice()
+
+	config := configs.NewDynamicConfigForTest()
+	config.RestoreConsistencyMarkerID = dynamicconfig.GetIntPropertyFn(7)
+	config.RestoreConsistencyMarkerTrimTasks = dynamicconfig.GetBoolPropertyFn(true)
+	consistencyMarkers := newConsistencyMarkerCache(
+		mockResource.GetConsistencyMarkerStore(), mockResource.GetNamespaceReplicationQueue(), mockResource.GetLogger())
+	newShardItem := func(shardID int32) *historyShardsItem {
+		return &historyShardsItem{
+			Resource:           mockResource,
+			shardID:            shardID,
+			config:             config,
+			consistencyMarkers: consistencyMarkers,
+			logger:             mockResource.GetLogger(),
+		}
+	}
+
+	shardInfo, err := restoreToConsistencyMarker(newShardItem(1), &persistencespb.ShardInfo{
+		ShardId: 1, RangeId: 12, TransferAckLevel: 150, VisibilityAckLevel: 150,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), shardInfo.RestoredConsistencyMarkerId)
+	assert.Equal(t, int64(13), shardInfo.RangeId)
+	assert.Equal(t, int64(100), shardInfo.TransferAckLevel)
+	assert.Equal(t, int64(90), shardInfo.VisibilityAckLevel)
+	require.Len(t, updated, 1)
+	assert.Equal(t, int64(12), updated[0].PreviousRangeID)
+	assert.Equal(t, shardInfo, updated[0].ShardInfo)
+
+	// shard already restored to the marker is left as is
+	restoredShardInfo, err := restoreToConsistencyMarker(newShardItem(1), shardInfo)
+	require.NoError(t, err)
+	assert.Equal(t, shardInfo, restoredShardInfo)
+
+	shardInfo, err = restoreToConsistencyMarker(newShardItem(2), &persistencespb.ShardInfo{ShardId: 2, RangeId: 3})
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), shardInfo.RestoredConsistencyMarkerId)
+	assert.Equal(t, int64(11), shardInfo.RangeId)
+	require.Len(t, updated, 2)
+
+	_, err = restoreToConsistencyMarker(newShardItem(3), &persistencespb.ShardInfo{ShardId: 3, RangeId: 3})
+	assert.Error(t, err)
+
+	// restore mode is disabled
+	config.RestoreConsistencyMarkerID = dynamicconfig.GetIntPropertyFn(0)
+	shardInfo = &persistencespb.ShardInfo{ShardId: 3, RangeId: 3}
+	restoredShardInfo, err = restoreToConsistencyMarker(newShardItem(3), shardInfo)
+	require.NoError(t, err)
+	assert.Equal(t, shardInfo, restoredShardInfo)
+	mockResource.ExecutionMgr.AssertExpectations(t)
+	mockResource.ShardMgr.AssertExpectations(t)
+}
+
+func TestNewShardRestorePlan(t *testing.T) {
+	now := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	marked := &persistencespb.ShardInfo{
+		ShardId:                 1,
+		RangeId:                 10,
+		TransferAckLevel:        100,
+		VisibilityAckLevel:      90,
+		ReplicationAckLevel:     80,
+		TimerAckLevelTime:       timestamp.TimePtr(now),
+		ClusterTransferAckLevel: map[string]int64{"active": 100, "standby": 60},
+		ClusterTimerAckLevel:    map[string]*time.Time{"active": timestamp.TimePtr(now), "standby": timestamp.TimePtr(now.Add(-time.Hour))},
+	}
+
+	// shard table restored from a later backup than the task tables
+	ahead := &persistencespb.ShardInfo{
+		ShardId:                 1,
+		RangeId:                 12,
+		TransferAckLevel:        150,
+		VisibilityAckLevel:      85,
+		ReplicationAckLevel:     80,
+		TimerAckLevelTime:       timestamp.TimePtr(now.Add(time.Minute)),
+		ClusterTransferAckLevel: map[string]int64{"active": 150, "standby": 60},
+		ClusterTimerAckLevel:    map[string]*time.Time{"active": timestamp.TimePtr(now.Add(time.Minute)), "standby": timestamp.TimePtr(now.Add(-time.Hour))},
+	}
+	plan, err := newShardRestorePlan(ahead, marked)
+	require.NoError(t, err)
+	assert.True(t, plan.Rewound)
+	assert.Equal(t, int64(12), plan.PreviousRangeID)
+	assert.Equal(t, int64(13), plan.ShardInfo.RangeId)
+	assert.Equal(t, int64(100), plan.ShardInfo.TransferAckLevel)
+	assert.Equal(t, int64(85), plan.ShardInfo.VisibilityAckLevel)
+	assert.Equal(t, now, *plan.ShardInfo.TimerAckLevelTime)
+	assert.Equal(t, map[string]int64{"active": 100, "standby": 60}, plan.ShardInfo.ClusterTransferAckLevel)
+	assert.Equal(t, now, *plan.ShardInfo.ClusterTimerAckLevel["active"])
+	// the current shard info is left untouched
+	assert.Equal(t, int64(150), ahead.TransferAckLevel)
+
+	// standby cluster still needs tasks after its own ack levels
+	assert.Equal(t, int64(60), plan.TrimTransferTaskID)
+	assert.Equal(t, int64(90), plan.TrimVisibilityTaskID)
+	assert.Equal(t, int64(80), plan.TrimReplicationTaskID)
+	assert.Equal(t, now.Add(-time.Hour), plan.TrimTimerTime)
+
+	// shard table restored from an earlier backup, its range has to move past the marked one
+	behind := &persistencespb.ShardInfo{
+		ShardId:            1,
+		RangeId:            8,
+		TransferAckLevel:   50,
+		VisibilityAckLevel: 50,
+		TimerAckLevelTime:  timestamp.TimePtr(now.Add(-time.Minute)),
+	}
+	plan, err = newShardRestorePlan(behind, marked)
+	require.NoError(t, err)
+	assert.False(t, plan.Rewound)
+	assert.Equal(t, int64(8), plan.PreviousRangeID)
+	assert.Equal(t, int64(11), plan.ShardInfo.RangeId)
+	assert.Equal(t, int64(50), plan.ShardInfo.TransferAckLevel)
+}
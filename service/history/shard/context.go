@@ -58,6 +58,7 @@ type (
 		GetMetricsClient() metrics.Client
 		GetTimeSource() clock.TimeSource
 		PreviousShardOwnerWasDifferent() bool
+		GetJournaledExecutions() []persistence.ShardJournalExecution
 
 		GetEngine() Engine
 		SetEngine(Engine)
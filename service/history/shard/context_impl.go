@@ -37,7 +37,6 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cache"
-	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -82,6 +81,10 @@ type (
 
 		// true if previous owner was different from the acquirer's identity.
 		previousShardOwnerWasDifferent bool
+
+		// nil unless the shard journal was enabled when the shard was acquired
+		journal             *journal
+		journaledExecutions []persistence.ShardJournalExecution
 	}
 )
 
@@ -428,12 +431,15 @@ func (s *ContextImpl) CreateWorkflowExecution(
 		request.NewWorkflowSnapshot.ReplicationTasks,
 		request.NewWorkflowSnapshot.TimerTasks,
 		request.NewWorkflowSnapshot.VisibilityTasks,
+		request.NewWorkflowSnapshot.CustomTasks,
 		&transferMaxReadLevel,
 	); err != nil {
 		return nil, err
 	}
 	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
 
+	s.appendJournal(journalSnapshotExecution(&request.NewWorkflowSnapshot))
+
 Create_Loop:
 	for attempt := 1; attempt <= conditionalRetryCount; attempt++ {
 		currentRangeID := s.getRangeID()
@@ -506,6 +512,7 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 		request.UpdateWorkflowMutation.ReplicationTasks,
 		request.UpdateWorkflowMutation.TimerTasks,
 		request.UpdateWorkflowMutation.VisibilityTasks,
+		request.UpdateWorkflowMutation.CustomTasks,
 		&transferMaxReadLevel,
 	); err != nil {
 		return nil, err
@@ -518,6 +525,7 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 			request.NewWorkflowSnapshot.ReplicationTasks,
 			request.NewWorkflowSnapshot.TimerTasks,
 			request.NewWorkflowSnapshot.VisibilityTasks,
+			request.NewWorkflowSnapshot.CustomTasks,
 			&transferMaxReadLevel,
 		); err != nil {
 			return nil, err
@@ -525,6 +533,16 @@ func (s *ContextImpl) UpdateWorkflowExecution(
 	}
 	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
 
+	if s.isJournalEnabled() {
+		executions := []persistence.ShardJournalExecution{
+			journalMutationExecution(&request.UpdateWorkflowMutation),
+		}
+		if request.NewWorkflowSnapshot != nil {
+			executions = append(executions, journalSnapshotExecution(request.NewWorkflowSnapshot))
+		}
+		s.appendJournal(executions...)
+	}
+
 Update_Loop:
 	for attempt := 1; attempt <= conditionalRetryCount; attempt++ {
 		currentRangeID := s.getRangeID()
@@ -596,6 +614,7 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 			request.CurrentWorkflowMutation.ReplicationTasks,
 			request.CurrentWorkflowMutation.TimerTasks,
 			request.CurrentWorkflowMutation.VisibilityTasks,
+			request.CurrentWorkflowMutation.CustomTasks,
 			&transferMaxReadLevel,
 		); err != nil {
 			return err
@@ -608,6 +627,7 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 		request.ResetWorkflowSnapshot.ReplicationTasks,
 		request.ResetWorkflowSnapshot.TimerTasks,
 		request.ResetWorkflowSnapshot.VisibilityTasks,
+		request.ResetWorkflowSnapshot.CustomTasks,
 		&transferMaxReadLevel,
 	); err != nil {
 		return err
@@ -620,6 +640,7 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 			request.NewWorkflowSnapshot.ReplicationTasks,
 			request.NewWorkflowSnapshot.TimerTasks,
 			request.NewWorkflowSnapshot.VisibilityTasks,
+			request.NewWorkflowSnapshot.CustomTasks,
 			&transferMaxReadLevel,
 		); err != nil {
 			return err
@@ -627,6 +648,19 @@ func (s *ContextImpl) ConflictResolveWorkflowExecution(
 	}
 	defer s.updateMaxReadLevelLocked(transferMaxReadLevel)
 
+	if s.isJournalEnabled() {
+		executions := []persistence.ShardJournalExecution{
+			journalSnapshotExecution(&request.ResetWorkflowSnapshot),
+		}
+		if request.CurrentWorkflowMutation != nil {
+			executions = append(executions, journalMutationExecution(request.CurrentWorkflowMutation))
+		}
+		if request.NewWorkflowSnapshot != nil {
+			executions = append(executions, journalSnapshotExecution(request.NewWorkflowSnapshot))
+		}
+		s.appendJournal(executions...)
+	}
+
 Reset_Loop:
 	for attempt := 1; attempt <= conditionalRetryCount; attempt++ {
 		currentRangeID := s.getRangeID()
@@ -696,6 +730,7 @@ func (s *ContextImpl) AddTasks(
 		request.ReplicationTasks,
 		request.TimerTasks,
 		request.VisibilityTasks,
+		nil,
 		&transferMaxReadLevel,
 	); err != nil {
 		return err
@@ -776,6 +811,12 @@ func (s *ContextImpl) PreviousShardOwnerWasDifferent() bool {
 	return s.previousShardOwnerWasDifferent
 }
 
+// GetJournaledExecutions returns the executions recently updated by previous owners of the shard,
+// the ones with pending tasks first
+func (s *ContextImpl) GetJournaledExecutions() []persistence.ShardJournalExecution {
+	return s.journaledExecutions
+}
+
 func (s *ContextImpl) GetEventsCache() events.Cache {
 	return s.EventsCache
 }
@@ -812,6 +853,18 @@ func (s *ContextImpl) closeShard() {
 	atomic.StoreInt64(&s.rangeID, s.shardInfo.RangeId)
 }
 
+func (s *ContextImpl) isJournalEnabled() bool {
+	return s.journal != nil && s.config.ShardJournalEnabled()
+}
+
+// appendJournal journals the executions of an update, it is called with the shard lock held before the update
+// is applied
+func (s *ContextImpl) appendJournal(executions ...persistence.ShardJournalExecution) {
+	if s.isJournalEnabled() {
+		s.journal.write(s.getRangeID(), executions...)
+	}
+}
+
 func (s *ContextImpl) generateTransferTaskIDLocked() (int64, error) {
 	if err := s.updateRangeIfNeededLocked(); err != nil {
 		return -1, err
@@ -886,7 +939,7 @@ func (s *ContextImpl) updateShardInfoLocked() error {
 	}
 
 	var err error
-	now := clock.NewRealTimeSource().Now()
+	now := s.GetTimeSource().Now()
 	if s.lastUpdated.Add(s.config.ShardUpdateMinInterval()).After(now) {
 		return nil
 	}
@@ -978,6 +1031,7 @@ func (s *ContextImpl) allocateTaskIDsLocked(
 	replicationTasks []persistence.Task,
 	timerTasks []persistence.Task,
 	visibilityTasks []persistence.Task,
+	customTasks []persistence.Task,
 	transferMaxReadLevel *int64,
 ) error {
 
@@ -996,6 +1050,11 @@ func (s *ContextImpl) allocateTaskIDsLocked(
 		transferMaxReadLevel); err != nil {
 		return err
 	}
+	if err := s.allocateTransferIDsLocked(
+		customTasks,
+		transferMaxReadLevel); err != nil {
+		return err
+	}
 	return s.allocateTimerIDsLocked(
 		namespaceEntry,
 		workflowID,
@@ -1138,6 +1197,12 @@ func acquireShard(
 		return nil, err
 	}
 
+	shardInfo.ShardInfo, err = restoreToConsistencyMarker(shardItem, shardInfo.ShardInfo)
+	if err != nil {
+		shardItem.logger.Error("Fail to restore shard to consistency marker.", tag.ShardID(shardItem.shardID), tag.Error(err))
+		return nil, err
+	}
+
 	updatedShardInfo := copyShardInfo(shardInfo)
 	ownershipChanged := shardInfo.Owner != shardItem.GetHostInfo().Identity()
 	updatedShardInfo.Owner = shardItem.GetHostInfo().Identity()
@@ -1200,11 +1265,34 @@ func acquireShard(
 		return nil, err1
 	}
 
+	if shardContext.config.ShardJournalEnabled() {
+		shardContext.initJournal()
+	}
+
 	shardItem.logger.Info("Acquired shard")
 
 	return shardContext, nil
 }
 
+// initJournal recovers the executions journaled by previous owners of the shard. The shard is
+// acquired without journal if it fails to be read, warming up caches is an optimization only.
+func (s *ContextImpl) initJournal() {
+	store, err := s.GetPersistenceBean().GetShardJournal(s.shardID)
+	if err != nil {
+		s.logger.Warn("Failed to create shard journal.", tag.Error(err))
+		return
+	}
+
+	journal := newJournal(store, s.config.ShardJournalMaxEntries, s.isClosed, s.throttledLogger)
+	executions, err := journal.recover()
+	if err != nil {
+		s.logger.Warn("Failed to recover shard journal.", tag.Error(err))
+		return
+	}
+	s.journal = journal
+	s.journaledExecutions = executions
+}
+
 func copyShardInfo(shardInfo *persistence.ShardInfoWithFailover) *persistence.ShardInfoWithFailover {
 	transferFailoverLevels := map[string]persistence.TransferFailoverLevel{}
 	for k, v := range shardInfo.TransferFailoverLevels {
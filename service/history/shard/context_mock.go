@@ -324,6 +324,20 @@ func (mr *MockContextMockRecorder) GetHistoryManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryManager", reflect.TypeOf((*MockContext)(nil).GetHistoryManager))
 }
 
+// GetJournaledExecutions mocks base method.
+func (m *MockContext) GetJournaledExecutions() []persistence.ShardJournalExecution {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournaledExecutions")
+	ret0, _ := ret[0].([]persistence.ShardJournalExecution)
+	return ret0
+}
+
+// GetJournaledExecutions indicates an expected call of GetJournaledExecutions.
+func (mr *MockContextMockRecorder) GetJournaledExecutions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournaledExecutions", reflect.TypeOf((*MockContext)(nil).GetJournaledExecutions))
+}
+
 // GetLastUpdatedTime mocks base method.
 func (m *MockContext) GetLastUpdatedTime() time.Time {
 	m.ctrl.T.Helper()
@@ -67,6 +67,7 @@ type (
 		throttledLogger    log.Logger
 		config             *configs.Config
 		metricsScope       metrics.Scope
+		consistencyMarkers *consistencyMarkerCache
 
 		sync.RWMutex
 		historyShards map[int32]*historyShardsItem
@@ -82,6 +83,8 @@ type (
 		logger          log.Logger
 		throttledLogger log.Logger
 		engineFactory   EngineFactory
+		// consistencyMarkers is shared by the shards of the host
+		consistencyMarkers *consistencyMarkerCache
 
 		sync.RWMutex
 		status historyShardsItemStatus
@@ -112,6 +115,11 @@ func NewController(
 		throttledLogger:    resource.GetThrottledLogger().WithTags(tag.ComponentShardController, tag.Address(hostIdentity)),
 		config:             config,
 		metricsScope:       resource.GetMetricsClient().Scope(metrics.HistoryShardControllerScope),
+		consistencyMarkers: newConsistencyMarkerCache(
+			resource.GetConsistencyMarkerStore(),
+			resource.GetNamespaceReplicationQueue(),
+			resource.GetLogger().WithTags(tag.ComponentShardController, tag.Address(hostIdentity)),
+		),
 	}
 }
 
@@ -120,17 +128,19 @@ func newHistoryShardsItem(
 	shardID int32,
 	factory EngineFactory,
 	config *configs.Config,
+	consistencyMarkers *consistencyMarkerCache,
 ) (*historyShardsItem, error) {
 
 	hostIdentity := resource.GetHostInfo().Identity()
 	shardItem := &historyShardsItem{
-		Resource:        resource,
-		shardID:         shardID,
-		status:          historyShardsItemStatusInitialized,
-		engineFactory:   factory,
-		config:          config,
-		logger:          resource.GetLogger().WithTags(tag.ShardID(shardID), tag.Address(hostIdentity)),
-		throttledLogger: resource.GetThrottledLogger().WithTags(tag.ShardID(shardID), tag.Address(hostIdentity)),
+		Resource:           resource,
+		shardID:            shardID,
+		status:             historyShardsItemStatusInitialized,
+		engineFactory:      factory,
+		config:             config,
+		consistencyMarkers: consistencyMarkers,
+		logger:             resource.GetLogger().WithTags(tag.ShardID(shardID), tag.Address(hostIdentity)),
+		throttledLogger:    resource.GetThrottledLogger().WithTags(tag.ShardID(shardID), tag.Address(hostIdentity)),
 	}
 	shardItem.logger = shardItem.logger.WithTags(tag.ShardItem(shardItem))
 	shardItem.throttledLogger = shardItem.throttledLogger.WithTags(tag.ShardItem(shardItem))
@@ -260,6 +270,7 @@ func (c *ControllerImpl) getOrCreateHistoryShardItem(shardID int32) (*historySha
 			shardID,
 			c.engineFactory,
 			c.config,
+			c.consistencyMarkers,
 		)
 		if err != nil {
 			return nil, err
@@ -64,6 +64,34 @@ func (m *MockEngine) EXPECT() *MockEngineMockRecorder {
 	return m.recorder
 }
 
+// AnnotateWorkflowExecution mocks base method.
+func (m *MockEngine) AnnotateWorkflowExecution(ctx context.Context, request *historyservice.AnnotateWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnotateWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AnnotateWorkflowExecution indicates an expected call of AnnotateWorkflowExecution.
+func (mr *MockEngineMockRecorder) AnnotateWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnotateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).AnnotateWorkflowExecution), ctx, request)
+}
+
+// ApplyOperatorAction mocks base method.
+func (m *MockEngine) ApplyOperatorAction(ctx context.Context, request *historyservice.ApplyOperatorActionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyOperatorAction", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyOperatorAction indicates an expected call of ApplyOperatorAction.
+func (mr *MockEngineMockRecorder) ApplyOperatorAction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyOperatorAction", reflect.TypeOf((*MockEngine)(nil).ApplyOperatorAction), ctx, request)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockEngine) DescribeMutableState(ctx context.Context, request *historyservice.DescribeMutableStateRequest) (*historyservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +137,21 @@ func (mr *MockEngineMockRecorder) GetDLQMessages(ctx, messagesRequest interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQMessages", reflect.TypeOf((*MockEngine)(nil).GetDLQMessages), ctx, messagesRequest)
 }
 
+// GetReplicationEventChunk mocks base method.
+func (m *MockEngine) GetReplicationEventChunk(ctx context.Context, request *historyservice.GetReplicationEventChunkRequest) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationEventChunk", ctx, request)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationEventChunk indicates an expected call of GetReplicationEventChunk.
+func (mr *MockEngineMockRecorder) GetReplicationEventChunk(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationEventChunk", reflect.TypeOf((*MockEngine)(nil).GetReplicationEventChunk), ctx, request)
+}
+
 // GetDLQReplicationMessages mocks base method.
 func (m *MockEngine) GetDLQReplicationMessages(ctx context.Context, taskInfos []*repication.ReplicationTaskInfo) ([]*repication.ReplicationTask, error) {
 	m.ctrl.T.Helper()
@@ -205,6 +248,18 @@ func (mr *MockEngineMockRecorder) NotifyNewTransferTasks(tasks interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTransferTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewTransferTasks), tasks)
 }
 
+// NotifyNewCustomTasks mocks base method.
+func (m *MockEngine) NotifyNewCustomTasks(tasks []persistence.Task) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyNewCustomTasks", tasks)
+}
+
+// NotifyNewCustomTasks indicates an expected call of NotifyNewCustomTasks.
+func (mr *MockEngineMockRecorder) NotifyNewCustomTasks(tasks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewCustomTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewCustomTasks), tasks)
+}
+
 // NotifyNewVisibilityTasks mocks base method.
 func (m *MockEngine) NotifyNewVisibilityTasks(tasks []persistence.Task) {
 	m.ctrl.T.Helper()
@@ -614,3 +669,17 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
+
+// UpsertWorkflowSearchAttributes mocks base method.
+func (m *MockEngine) UpsertWorkflowSearchAttributes(ctx context.Context, request *historyservice.UpsertWorkflowSearchAttributesRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkflowSearchAttributes", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertWorkflowSearchAttributes indicates an expected call of UpsertWorkflowSearchAttributes.
+func (mr *MockEngineMockRecorder) UpsertWorkflowSearchAttributes(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkflowSearchAttributes", reflect.TypeOf((*MockEngine)(nil).UpsertWorkflowSearchAttributes), ctx, request)
+}
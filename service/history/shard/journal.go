@@ -0,0 +1,200 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+const (
+	journalReadPageSize = 100
+)
+
+type (
+	// journal journals the executions of mutable state updates to the write-ahead journal of the shard,
+	// and recovers the executions journaled by previous owners when the shard is acquired. The executions
+	// of an update are written before the update is applied, journaling stops once the shard is lost.
+	// Journaling is best effort, updates are applied even if their entry fails to be written.
+	journal struct {
+		store      persistence.ShardJournal
+		maxEntries dynamicconfig.IntPropertyFn
+		isClosed   func() bool
+		logger     log.Logger
+
+		sync.Mutex
+		stopped      bool
+		rangeID      int64
+		firstEntryID int64
+		lastEntryID  int64
+	}
+)
+
+func newJournal(
+	store persistence.ShardJournal,
+	maxEntries dynamicconfig.IntPropertyFn,
+	isClosed func() bool,
+	logger log.Logger,
+) *journal {
+	return &journal{
+		store:      store,
+		maxEntries: maxEntries,
+		isClosed:   isClosed,
+		logger:     logger,
+	}
+}
+
+// recover reads the entries journaled by previous owners of the shard and trims the journal to the configured
+// number of entries. Executions are returned once each, the ones with pending tasks first, the most recently
+// updated first otherwise. Must be called before the executions of any update are journaled.
+func (j *journal) recover() ([]persistence.ShardJournalExecution, error) {
+	var entries []*persistence.ShardJournalEntry
+	j.lastEntryID = persistence.EmptyQueueMessageID
+	for {
+		page, err := j.store.ReadEntries(j.lastEntryID, journalReadPageSize)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, page...)
+		if len(page) > 0 {
+			j.lastEntryID = page[len(page)-1].EntryID
+		}
+		if len(page) < journalReadPageSize {
+			break
+		}
+	}
+	j.firstEntryID = j.lastEntryID + 1
+	if len(entries) != 0 {
+		j.firstEntryID = entries[0].EntryID
+	}
+
+	if maxEntries := j.getMaxEntries(); len(entries) > maxEntries {
+		entries = entries[len(entries)-maxEntries:]
+		j.trim(j.lastEntryID - int64(maxEntries) + 1)
+	}
+
+	var executions []*persistence.ShardJournalExecution
+	seen := make(map[persistence.ShardJournalExecution]*persistence.ShardJournalExecution)
+	for i := len(entries) - 1; i >= 0; i-- {
+		for _, execution := range entries[i].Executions {
+			key := execution
+			key.PendingTasks = false
+			if recovered, ok := seen[key]; ok {
+				recovered.PendingTasks = recovered.PendingTasks || execution.PendingTasks
+				continue
+			}
+			recovered := execution
+			seen[key] = &recovered
+			executions = append(executions, &recovered)
+		}
+	}
+
+	result := make([]persistence.ShardJournalExecution, 0, len(executions))
+	for _, execution := range executions {
+		if execution.PendingTasks {
+			result = append(result, *execution)
+		}
+	}
+	for _, execution := range executions {
+		if !execution.PendingTasks {
+			result = append(result, *execution)
+		}
+	}
+	return result, nil
+}
+
+// write appends an entry with the executions of an update, it must be called before the update is applied.
+// Journaling stops once the shard is closed or the range ID goes backwards, as another host owns the shard
+// then. The journal is trimmed once it holds twice the configured number of entries.
+func (j *journal) write(rangeID int64, executions ...persistence.ShardJournalExecution) {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.stopped {
+		return
+	}
+	if j.isClosed() || rangeID <= 0 || rangeID < j.rangeID {
+		j.stopped = true
+		j.logger.Info("Shard ownership lost, stopped journaling.", tag.ShardRangeID(rangeID))
+		return
+	}
+	j.rangeID = rangeID
+
+	entryID, err := j.store.Append(&persistence.ShardJournalEntry{
+		RangeID:     rangeID,
+		CreatedTime: time.Now().UTC(),
+		Executions:  executions,
+	})
+	if err != nil {
+		j.logger.Warn("Failed to append shard journal entry.", tag.Error(err))
+		return
+	}
+
+	j.lastEntryID = entryID
+	maxEntries := int64(j.getMaxEntries())
+	if j.lastEntryID-j.firstEntryID+1 > 2*maxEntries {
+		j.trim(j.lastEntryID - maxEntries + 1)
+	}
+}
+
+// getMaxEntries returns the number of entries kept, at least one entry is kept, so that
+// the queue keeps assigning increasing IDs
+func (j *journal) getMaxEntries() int {
+	if maxEntries := j.maxEntries(); maxEntries > 1 {
+		return maxEntries
+	}
+	return 1
+}
+
+func (j *journal) trim(entryID int64) {
+	if err := j.store.TrimEntries(entryID); err != nil {
+		j.logger.Warn("Failed to trim shard journal.", tag.Error(err))
+		return
+	}
+	j.firstEntryID = entryID
+}
+
+func journalSnapshotExecution(snapshot *persistence.WorkflowSnapshot) persistence.ShardJournalExecution {
+	return persistence.ShardJournalExecution{
+		NamespaceID:  snapshot.ExecutionInfo.NamespaceId,
+		WorkflowID:   snapshot.ExecutionInfo.WorkflowId,
+		RunID:        snapshot.ExecutionState.RunId,
+		PendingTasks: len(snapshot.TransferTasks) != 0 || len(snapshot.TimerTasks) != 0,
+	}
+}
+
+func journalMutationExecution(mutation *persistence.WorkflowMutation) persistence.ShardJournalExecution {
+	return persistence.ShardJournalExecution{
+		NamespaceID:  mutation.ExecutionInfo.NamespaceId,
+		WorkflowID:   mutation.ExecutionInfo.WorkflowId,
+		RunID:        mutation.ExecutionState.RunId,
+		PendingTasks: len(mutation.TransferTasks) != 0 || len(mutation.TimerTasks) != 0,
+	}
+}
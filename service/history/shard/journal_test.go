@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	inMemoryShardJournal struct {
+		persistence.ShardJournal
+		sync.Mutex
+		lastEntryID int64
+		appendErr   error
+		entries     []*persistence.ShardJournalEntry
+	}
+)
+
+func notClosed() bool {
+	return false
+}
+
+func journalTestExecution(workflowID string) persistence.ShardJournalExecution {
+	return persistence.ShardJournalExecution{NamespaceID: "namespace", WorkflowID: workflowID, RunID: "run"}
+}
+
+func TestJournal(t *testing.T) {
+	store := &inMemoryShardJournal{lastEntryID: persistence.EmptyQueueMessageID}
+	previousOwner := newJournal(store, dynamicconfig.GetIntPropertyFn(2), notClosed, log.NewNoop())
+	executions, err := previousOwner.recover()
+	require.NoError(t, err)
+	require.Empty(t, executions)
+
+	execution := journalTestExecution
+	previousOwner.write(1, execution("workflow1"))
+	previousOwner.write(1, execution("workflow2"), execution("workflow3"))
+	previousOwner.write(1, execution("workflow1"))
+	previousOwner.write(1, execution("workflow4"))
+	require.Len(t, store.entries, 4)
+	// trimmed to the configured number of entries once twice as many were appended
+	previousOwner.write(1, execution("workflow2"))
+	require.Len(t, store.entries, 2)
+
+	newOwner := newJournal(store, dynamicconfig.GetIntPropertyFn(2), notClosed, log.NewNoop())
+	executions, err = newOwner.recover()
+	require.NoError(t, err)
+	require.Equal(t, []persistence.ShardJournalExecution{execution("workflow2"), execution("workflow4")}, executions)
+
+	previousOwner.write(1, execution("workflow5"))
+	previousOwner.write(1, execution("workflow6"))
+	newOwner = newJournal(store, dynamicconfig.GetIntPropertyFn(1), notClosed, log.NewNoop())
+	executions, err = newOwner.recover()
+	require.NoError(t, err)
+	require.Equal(t, []persistence.ShardJournalExecution{execution("workflow6")}, executions)
+	require.Len(t, store.entries, 1)
+}
+
+func TestJournal_PendingTasksFirst(t *testing.T) {
+	store := &inMemoryShardJournal{lastEntryID: persistence.EmptyQueueMessageID}
+	previousOwner := newJournal(store, dynamicconfig.GetIntPropertyFn(10), notClosed, log.NewNoop())
+	_, err := previousOwner.recover()
+	require.NoError(t, err)
+
+	withTasks := func(workflowID string) persistence.ShardJournalExecution {
+		execution := journalTestExecution(workflowID)
+		execution.PendingTasks = true
+		return execution
+	}
+	previousOwner.write(1, withTasks("workflow1"), journalTestExecution("workflow2"))
+	previousOwner.write(1, journalTestExecution("workflow3"))
+	previousOwner.write(1, journalTestExecution("workflow1"), withTasks("workflow4"))
+
+	newOwner := newJournal(store, dynamicconfig.GetIntPropertyFn(10), notClosed, log.NewNoop())
+	executions, err := newOwner.recover()
+	require.NoError(t, err)
+	require.Equal(t, []persistence.ShardJournalExecution{
+		withTasks("workflow1"),
+		withTasks("workflow4"),
+		journalTestExecution("workflow3"),
+		journalTestExecution("workflow2"),
+	}, executions)
+}
+
+func TestJournal_EntryIDsAssignedByStore(t *testing.T) {
+	store := &inMemoryShardJournal{lastEntryID: persistence.EmptyQueueMessageID}
+	j := newJournal(store, dynamicconfig.GetIntPropertyFn(100), notClosed, log.NewNoop())
+	_, err := j.recover()
+	require.NoError(t, err)
+
+	j.write(1, journalTestExecution("workflow1"))
+	// entry is written although append times out
+	store.appendErr = errors.New("timeout")
+	j.write(1, journalTestExecution("workflow2"))
+	store.appendErr = nil
+	j.write(1, journalTestExecution("workflow3"))
+	require.Len(t, store.entries, 3)
+	require.Equal(t, store.lastEntryID, j.lastEntryID)
+}
+
+func TestJournal_StopsOnOwnershipLost(t *testing.T) {
+	store := &inMemoryShardJournal{lastEntryID: persistence.EmptyQueueMessageID}
+	closed := false
+	j := newJournal(store, dynamicconfig.GetIntPropertyFn(100), func() bool { return closed }, log.NewNoop())
+	_, err := j.recover()
+	require.NoError(t, err)
+
+	j.write(2, journalTestExecution("workflow1"))
+	// range ID of the previous owner
+	j.write(1, journalTestExecution("workflow2"))
+	j.write(3, journalTestExecution("workflow3"))
+	require.Len(t, store.entries, 1)
+	require.True(t, j.stopped)
+
+	j = newJournal(store, dynamicconfig.GetIntPropertyFn(100), func() bool { return closed }, log.NewNoop())
+	_, err = j.recover()
+	require.NoError(t, err)
+	j.write(4, journalTestExecution("workflow4"))
+	closed = true
+	j.write(4, journalTestExecution("workflow5"))
+	require.Len(t, store.entries, 2)
+	require.Equal(t, "workflow4", store.entries[1].Executions[0].WorkflowID)
+}
+
+func (j *inMemoryShardJournal) Append(entry *persistence.ShardJournalEntry) (int64, error) {
+	j.Lock()
+	defer j.Unlock()
+	j.lastEntryID++
+	stored := *entry
+	stored.EntryID = j.lastEntryID
+	j.entries = append(j.entries, &stored)
+	if j.appendErr != nil {
+		return persistence.EmptyQueueMessageID, j.appendErr
+	}
+	return j.lastEntryID, nil
+}
+
+func (j *inMemoryShardJournal) ReadEntries(lastEntryID int64, maxCount int) ([]*persistence.ShardJournalEntry, error) {
+	var entries []*persistence.ShardJournalEntry
+	for _, entry := range j.entries {
+		if entry.EntryID > lastEntryID && len(entries) < maxCount {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (j *inMemoryShardJournal) TrimEntries(entryID int64) error {
+	var entries []*persistence.ShardJournalEntry
+	for _, entry := range j.entries {
+		if entry.EntryID >= entryID {
+			entries = append(entries, entry)
+		}
+	}
+	j.entries = entries
+	return nil
+}
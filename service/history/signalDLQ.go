@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+// deadLetterSignal captures a user signal which couldn't be applied to its execution in the signal DLQ,
+// so that the caller doesn't retry it endlessly and operators are able to re-deliver it later.
+// It returns whether the signal was captured, the caller gets signalErr otherwise.
+func (e *historyEngineImpl) deadLetterSignal(
+	namespaceEntry *cache.NamespaceCacheEntry,
+	signalRequest *historyservice.SignalWorkflowExecutionRequest,
+	signalErr error,
+) bool {
+
+	namespace := namespaceEntry.GetInfo().Name
+	if !e.config.EnableSignalDLQ(namespace) || !isSignalDeadLetterError(signalErr) {
+		return false
+	}
+	// signals of other workflows are failed back to them
+	if signalRequest.GetExternalWorkflowExecution() != nil || signalRequest.GetChildWorkflowOnly() {
+		return false
+	}
+	// re-delivered signals stay in DLQ until they succeed
+	if signalRequest.GetRedelivery() {
+		return false
+	}
+
+	execution := signalRequest.GetSignalRequest().GetWorkflowExecution()
+	if err := e.shard.GetService().GetSignalDLQ().Publish(&persistence.SignalDLQMessage{
+		NamespaceID: namespaceEntry.GetInfo().Id,
+		Request:     signalRequest,
+		Reason:      signalErr.Error(),
+		EnqueueTime: e.shard.GetTimeSource().Now(),
+	}); err != nil {
+		e.logger.Error("Unable to capture signal in signal DLQ.",
+			tag.WorkflowNamespace(namespace),
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetRunId()),
+			tag.Error(err))
+		return false
+	}
+
+	e.metricsClient.Scope(metrics.HistorySignalWorkflowExecutionScope, metrics.NamespaceTag(namespace)).
+		IncCounter(metrics.SignalDeadLetteredCounter)
+	e.logger.Warn("Captured undeliverable signal in signal DLQ.",
+		tag.WorkflowNamespace(namespace),
+		tag.WorkflowID(execution.GetWorkflowId()),
+		tag.WorkflowRunID(execution.GetRunId()),
+		tag.WorkflowSignalName(signalRequest.GetSignalRequest().GetSignalName()),
+		tag.Error(signalErr))
+	return true
+}
+
+// isSignalDeadLetterError returns whether a signal failing with err is captured in the signal DLQ. These are errors
+// which retries of the caller don't resolve, unlike e.g. busy workflow, or which are final but for a limit.
+func isSignalDeadLetterError(err error) bool {
+	return err == ErrSignalsLimitExceeded || err == ErrMaxAttemptsExceeded
+}
@@ -35,6 +35,7 @@ import (
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
@@ -266,6 +267,7 @@ func (b *stateBuilderImpl) applyEvents(
 			if err := b.mutableState.ReplicateWorkflowTaskFailedEvent(); err != nil {
 				return nil, err
 			}
+			recordResetBaseRunID(b.mutableState, event)
 
 			// this is for transient workflowTask
 			workflowTask, err := b.mutableState.ReplicateTransientWorkflowTaskScheduled()
@@ -495,7 +497,10 @@ func (b *stateBuilderImpl) applyEvents(
 			}
 
 		case enumspb.EVENT_TYPE_MARKER_RECORDED:
-			// No mutable state action is needed
+			// No mutable state action is needed, except for workflow reports which are kept in mutable state
+			if event.GetMarkerRecordedEventAttributes().GetMarkerName() == common.WorkflowReportMarkerName {
+				b.mutableState.ReplicateWorkflowReportRecordedEvent(event)
+			}
 
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
 			if err := b.mutableState.ReplicateWorkflowExecutionSignaled(
@@ -0,0 +1,123 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/service/history/configs"
+)
+
+// getStuckReason returns the TemporalStuckReason of the execution at the given time,
+// an empty string means that none of its pending workflow task and activities is stuck
+func getStuckReason(
+	mutableState mutableState,
+	config *configs.Config,
+	now time.Time,
+) string {
+
+	namespace := mutableState.GetNamespaceEntry().GetInfo().Name
+	if !config.EnableStuckExecutionDetection(namespace) {
+		return ""
+	}
+
+	executionInfo := mutableState.GetExecutionInfo()
+	if executionInfo.WorkflowTaskScheduleId != common.EmptyEventID {
+		if int(executionInfo.WorkflowTaskAttempt) >= config.StuckWorkflowTaskAttemptThreshold(namespace) {
+			return definition.StuckReasonWorkflowTaskRetrying
+		}
+		if executionInfo.WorkflowTaskStartedId == common.EmptyEventID &&
+			exceedsStuckThreshold(executionInfo.WorkflowTaskScheduledTime, config.StuckWorkflowTaskStartThreshold(namespace), now) {
+			return definition.StuckReasonWorkflowTaskNotStarted
+		}
+	}
+
+	threshold := config.StuckActivityStartThreshold(namespace)
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		if ai.StartedId == common.EmptyEventID && exceedsStuckThreshold(ai.ScheduledTime, threshold, now) {
+			return definition.StuckReasonActivityNotStarted
+		}
+	}
+	return ""
+}
+
+// refreshStuckReason records the current TemporalStuckReason of the execution in its execution info
+// and generates a visibility task if the reason changed, no history event is written
+func refreshStuckReason(
+	mutableState mutableState,
+	taskGenerator mutableStateTaskGenerator,
+	config *configs.Config,
+	metricsClient metrics.Client,
+	scope int,
+	now time.Time,
+) error {
+
+	executionInfo := mutableState.GetExecutionInfo()
+	reason := getStuckReason(mutableState, config, now)
+	if reason == executionInfo.StuckReason {
+		return nil
+	}
+
+	if reason != "" {
+		metricsClient.IncCounter(scope, metrics.StuckExecutionsDetectedCount)
+	} else {
+		metricsClient.IncCounter(scope, metrics.StuckExecutionsRecoveredCount)
+	}
+	executionInfo.StuckReason = reason
+	return taskGenerator.generateWorkflowSearchAttrTasks(now)
+}
+
+// withStuckReason adds the TemporalStuckReason kept in execution info to the given copy of search attributes
+func withStuckReason(
+	searchAttributes map[string]*commonpb.Payload,
+	stuckReason string,
+) map[string]*commonpb.Payload {
+
+	if stuckReason == "" {
+		return searchAttributes
+	}
+	if searchAttributes == nil {
+		searchAttributes = make(map[string]*commonpb.Payload)
+	}
+	searchAttributes[definition.TemporalStuckReason] = payload.EncodeString(stuckReason)
+	return searchAttributes
+}
+
+func exceedsStuckThreshold(
+	scheduledTime *time.Time,
+	threshold time.Duration,
+	now time.Time,
+) bool {
+
+	t := timestamp.TimeValue(scheduledTime)
+	return !t.IsZero() && now.Sub(t) >= threshold
+}
@@ -0,0 +1,90 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/service/history/configs"
+)
+
+func TestGetStuckReason(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	now := time.Now().UTC()
+	config := configs.NewDynamicConfigForTest()
+	config.EnableStuckExecutionDetection = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true)
+	config.StuckWorkflowTaskStartThreshold = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Minute)
+	config.StuckActivityStartThreshold = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Hour)
+	config.StuckWorkflowTaskAttemptThreshold = dynamicconfig.GetIntPropertyFilteredByNamespace(3)
+
+	executionInfo := &persistencespb.WorkflowExecutionInfo{
+		WorkflowTaskScheduleId:    5,
+		WorkflowTaskStartedId:     common.EmptyEventID,
+		WorkflowTaskAttempt:       1,
+		WorkflowTaskScheduledTime: timestamp.TimePtr(now.Add(-30 * time.Second)),
+	}
+	activityInfos := map[int64]*persistencespb.ActivityInfo{
+		7: {ScheduleId: 7, StartedId: common.EmptyEventID, ScheduledTime: timestamp.TimePtr(now.Add(-30 * time.Minute))},
+	}
+	mutableState := NewMockmutableState(controller)
+	mutableState.EXPECT().GetNamespaceEntry().Return(testGlobalNamespaceEntry).AnyTimes()
+	mutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+	mutableState.EXPECT().GetPendingActivityInfos().Return(activityInfos).AnyTimes()
+
+	assert.Equal(t, "", getStuckReason(mutableState, config, now))
+
+	activityInfos[7].ScheduledTime = timestamp.TimePtr(now.Add(-2 * time.Hour))
+	assert.Equal(t, definition.StuckReasonActivityNotStarted, getStuckReason(mutableState, config, now))
+
+	executionInfo.WorkflowTaskScheduledTime = timestamp.TimePtr(now.Add(-2 * time.Minute))
+	assert.Equal(t, definition.StuckReasonWorkflowTaskNotStarted, getStuckReason(mutableState, config, now))
+
+	executionInfo.WorkflowTaskAttempt = 3
+	assert.Equal(t, definition.StuckReasonWorkflowTaskRetrying, getStuckReason(mutableState, config, now))
+
+	config.EnableStuckExecutionDetection = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	assert.Equal(t, "", getStuckReason(mutableState, config, now))
+}
+
+func TestWithStuckReason(t *testing.T) {
+	assert.Nil(t, withStuckReason(nil, ""))
+
+	searchAttributes := withStuckReason(nil, definition.StuckReasonActivityNotStarted)
+	var reason string
+	assert.NoError(t, payload.Decode(searchAttributes[definition.TemporalStuckReason], &reason))
+	assert.Equal(t, definition.StuckReasonActivityNotStarted, reason)
+}
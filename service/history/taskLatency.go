@@ -0,0 +1,68 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// maxTaskLatencyBreakdowns is the max number of most recent task latency breakdowns kept on the execution
+	maxTaskLatencyBreakdowns = 100
+)
+
+// recordTaskLatencyBreakdown completes latency breakdown of an activity or workflow task reported by matching with
+// schedule-to-start latency and records it in execution info, keeping the most recent ones.
+func recordTaskLatencyBreakdown(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	breakdown *persistencespb.TaskLatencyBreakdown,
+	scheduleID int64,
+	scheduledTime *time.Time,
+	now time.Time,
+) {
+	if breakdown == nil {
+		return
+	}
+	scheduleToStartLatency := now.Sub(timestamp.TimeValue(scheduledTime))
+	// clocks of history and matching hosts are not in sync, so dispatch latency is only an estimate
+	dispatchLatency := common.MaxDuration(0, scheduleToStartLatency-timestamp.DurationValue(breakdown.QueueLatency))
+	executionInfo.TaskLatencyBreakdowns = append(executionInfo.TaskLatencyBreakdowns, &persistencespb.TaskLatencyBreakdown{
+		ScheduledEventId:       scheduleID,
+		StartedTime:            &now,
+		ScheduleToStartLatency: &scheduleToStartLatency,
+		DispatchLatency:        &dispatchLatency,
+		QueueLatency:           breakdown.QueueLatency,
+		SyncMatch:              breakdown.SyncMatch,
+		ForwardedFrom:          breakdown.ForwardedFrom,
+		PollForwardedFrom:      breakdown.PollForwardedFrom,
+	})
+	if len(executionInfo.TaskLatencyBreakdowns) > maxTaskLatencyBreakdowns {
+		executionInfo.TaskLatencyBreakdowns = executionInfo.TaskLatencyBreakdowns[len(executionInfo.TaskLatencyBreakdowns)-maxTaskLatencyBreakdowns:]
+	}
+}
@@ -0,0 +1,71 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+func TestRecordTaskLatencyBreakdown(t *testing.T) {
+	now := time.Now().UTC()
+	scheduledTime := now.Add(-5 * time.Second)
+	executionInfo := &persistencespb.WorkflowExecutionInfo{}
+
+	recordTaskLatencyBreakdown(executionInfo, nil, 5, &scheduledTime, now)
+	assert.Empty(t, executionInfo.TaskLatencyBreakdowns)
+
+	recordTaskLatencyBreakdown(executionInfo, &persistencespb.TaskLatencyBreakdown{
+		QueueLatency:      timestamp.DurationPtr(2 * time.Second),
+		SyncMatch:         true,
+		PollForwardedFrom: "/_sys/queue/1",
+	}, 5, &scheduledTime, now)
+	assert.Equal(t, []*persistencespb.TaskLatencyBreakdown{{
+		ScheduledEventId:       5,
+		StartedTime:            &now,
+		ScheduleToStartLatency: timestamp.DurationPtr(5 * time.Second),
+		DispatchLatency:        timestamp.DurationPtr(3 * time.Second),
+		QueueLatency:           timestamp.DurationPtr(2 * time.Second),
+		SyncMatch:              true,
+		PollForwardedFrom:      "/_sys/queue/1",
+	}}, executionInfo.TaskLatencyBreakdowns)
+
+	// queue latency measured by matching exceeds schedule-to-start latency when clocks are skewed
+	recordTaskLatencyBreakdown(executionInfo, &persistencespb.TaskLatencyBreakdown{
+		QueueLatency: timestamp.DurationPtr(7 * time.Second),
+	}, 6, &scheduledTime, now)
+	assert.Equal(t, time.Duration(0), timestamp.DurationValue(executionInfo.TaskLatencyBreakdowns[1].DispatchLatency))
+
+	for i := 0; i < maxTaskLatencyBreakdowns; i++ {
+		recordTaskLatencyBreakdown(executionInfo, &persistencespb.TaskLatencyBreakdown{}, int64(7+i), &scheduledTime, now)
+	}
+	assert.Len(t, executionInfo.TaskLatencyBreakdowns, maxTaskLatencyBreakdowns)
+	assert.Equal(t, int64(7), executionInfo.TaskLatencyBreakdowns[0].ScheduledEventId)
+}
@@ -210,7 +210,7 @@ FilterLoop:
 	op := func() error {
 		scope, err = t.processTaskOnce(notificationChan, task)
 		err := t.handleTaskError(scope, task, notificationChan, err)
-		if err != nil {
+		if err != nil && err != ErrTaskShed {
 			task.attempt++
 			if task.attempt > t.config.TimerTaskMaxRetryCount() {
 				scope.RecordTimer(metrics.TaskAttemptTimer, time.Duration(task.attempt))
@@ -299,6 +299,16 @@ func (t *taskProcessor) handleTaskError(
 		return err
 	}
 
+	// shed tasks wait for the store to recover, they do not count as attempts
+	if err == ErrTaskShed {
+		scope.IncCounter(metrics.TaskShedCounter)
+		select {
+		case <-notificationChan:
+		case <-t.shutdownCh:
+		}
+		return err
+	}
+
 	if err == ErrTaskDiscarded {
 		scope.IncCounter(metrics.TaskDiscarded)
 		err = nil
@@ -0,0 +1,75 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// lockTimeSkipping prevents time skipping test server from skipping time while a worker
+// processes the task, lease bounds the lock in case the worker never responds
+func lockTimeSkipping(
+	timeSource clock.TimeSource,
+	key string,
+	lease *time.Duration,
+) {
+
+	if skipper, ok := timeSource.(clock.TimeSkipper); ok {
+		skipper.LockSkipping(key, timestamp.DurationValue(lease))
+	}
+}
+
+func unlockTimeSkipping(
+	timeSource clock.TimeSource,
+	key string,
+) {
+
+	if skipper, ok := timeSource.(clock.TimeSkipper); ok {
+		skipper.UnlockSkipping(key)
+	}
+}
+
+// workflow has at most one workflow task outstanding
+func workflowTaskTimeSkippingKey(
+	namespaceID string,
+	execution commonpb.WorkflowExecution,
+) string {
+	return fmt.Sprintf("%v/%v/%v/workflow-task", namespaceID, execution.GetWorkflowId(), execution.GetRunId())
+}
+
+func activityTaskTimeSkippingKey(
+	namespaceID string,
+	execution commonpb.WorkflowExecution,
+	scheduleID int64,
+	attempt int32,
+) string {
+	return fmt.Sprintf("%v/%v/%v/activity-task/%v/%v", namespaceID, execution.GetWorkflowId(), execution.GetRunId(), scheduleID, attempt)
+}
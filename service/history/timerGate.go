@@ -106,11 +106,20 @@ func NewLocalTimerGate(timeSource clock.TimeSource) LocalTimerGate {
 		<-timer.timer.C
 	}
 
+	// time source which can jump forward, e.g. in time skipping test server, fires the gate on every jump,
+	// so that timers which became due are loaded without waiting for the wall clock
+	notifier, _ := timeSource.(clock.ChangeNotifier)
+
 	go func() {
 		defer close(timer.fireChan)
 		defer timer.timer.Stop()
 	loop:
 		for {
+			var timeChangedCh <-chan struct{}
+			if notifier != nil {
+				timeChangedCh = notifier.Changed()
+			}
+
 			select {
 			case <-timer.timer.C:
 				select {
@@ -119,6 +128,12 @@ func NewLocalTimerGate(timeSource clock.TimeSource) LocalTimerGate {
 				default:
 				}
 
+			case <-timeChangedCh:
+				select {
+				case timer.fireChan <- struct{}{}:
+				default:
+				}
+
 			case <-timer.closeChan:
 				// closed; cleanup and quit
 				break loop
@@ -155,6 +170,9 @@ func (timerGate *LocalTimerGateImpl) Update(nextTime time.Time) bool {
 	// or this means the timer, before stopped, is already fired / never active
 	timerGate.nextWakeupTime = nextTime
 	timerGate.timer.Reset(nextTime.Sub(now))
+	if skipper, ok := timerGate.timeSource.(clock.TimeSkipper); ok {
+		skipper.SetDeadline(timerGate, nextTime)
+	}
 	// Notifies caller that next notification is reset to fire at passed in 'next' visibility time
 	return true
 }
@@ -162,6 +180,9 @@ func (timerGate *LocalTimerGateImpl) Update(nextTime time.Time) bool {
 // Close shutdown the timer
 func (timerGate *LocalTimerGateImpl) Close() {
 	close(timerGate.closeChan)
+	if skipper, ok := timerGate.timeSource.(clock.TimeSkipper); ok {
+		skipper.SetDeadline(timerGate, time.Time{})
+	}
 }
 
 // NewRemoteTimerGate create a new timer gate instance
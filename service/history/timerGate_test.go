@@ -258,6 +258,56 @@ func (s *localTimerGateSuite) TestTimerWillFire() {
 	s.False(s.localTimerGate.FireAfter(timeAfterNewTimer))
 }
 
+func (s *localTimerGateSuite) TestTimerFire_TimeSkipped() {
+	timeSource := clock.NewSkewedTimeSource()
+	localTimerGate := NewLocalTimerGate(timeSource)
+	defer localTimerGate.Close()
+
+	now := timeSource.Now()
+	newTimer := now.Add(time.Hour)
+	localTimerGate.Update(newTimer)
+
+	select {
+	case <-localTimerGate.FireChan():
+		s.Fail("timer should not fire before time is skipped")
+	case <-time.NewTimer(100 * time.Millisecond).C:
+	}
+
+	timeSource.Advance(time.Hour)
+	select {
+	case <-localTimerGate.FireChan():
+	case <-time.NewTimer(time.Second).C:
+		s.Fail("timer should fire once time is skipped")
+	}
+	s.False(localTimerGate.FireAfter(timeSource.Now()))
+}
+
+func (s *localTimerGateSuite) TestTimerFire_TimeSkippedWhenIdle() {
+	timeSource := clock.NewAutoSkippingTimeSource(50 * time.Millisecond)
+	timeSource.Start()
+	defer timeSource.Stop()
+	localTimerGate := NewLocalTimerGate(timeSource)
+	defer localTimerGate.Close()
+
+	timeSource.LockSkipping("task", time.Minute)
+	newTimer := timeSource.Now().Add(time.Hour)
+	localTimerGate.Update(newTimer)
+
+	select {
+	case <-localTimerGate.FireChan():
+		s.Fail("timer should not fire while skipping is locked")
+	case <-time.NewTimer(200 * time.Millisecond).C:
+	}
+
+	timeSource.UnlockSkipping("task")
+	select {
+	case <-localTimerGate.FireChan():
+	case <-time.NewTimer(time.Second).C:
+		s.Fail("timer should fire once idle")
+	}
+	s.False(timeSource.Now().Before(newTimer))
+}
+
 func (s *remoteTimerGateSuite) TestTimerFire() {
 	now := s.currentTime
 	newTimer := now.Add(1 * time.Second)
@@ -104,6 +104,8 @@ func (t *timerQueueActiveTaskExecutor) execute(
 		return t.executeWorkflowBackoffTimerTask(timerTask)
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT:
 		return t.executeDeleteHistoryEventTask(timerTask)
+	case enumsspb.TASK_TYPE_STUCK_EXECUTION_CHECK:
+		return t.executeStuckExecutionCheckTask(timerTask)
 	default:
 		return errUnknownTimerTask
 	}
@@ -393,7 +395,9 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	// generate activity task
 	scheduledID := task.GetEventId()
 	activityInfo, ok := mutableState.GetActivityInfo(scheduledID)
-	if !ok || task.ScheduleAttempt < activityInfo.Attempt || activityInfo.StartedId != common.EmptyEventID {
+	// retry timer of an attempt dispatched ahead of its backoff by operator is scheduled after the attempt
+	if !ok || task.ScheduleAttempt < activityInfo.Attempt || activityInfo.StartedId != common.EmptyEventID ||
+		timestamp.TimeValue(task.GetVisibilityTime()).After(timestamp.TimeValue(activityInfo.ScheduledTime)) {
 		if ok {
 			t.logger.Info("Duplicate activity retry timer task",
 				tag.WorkflowID(mutableState.GetExecutionInfo().WorkflowId),
@@ -572,6 +576,45 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowTimeoutTask(
 	)
 }
 
+func (t *timerQueueActiveTaskExecutor) executeStuckExecutionCheckTask(
+	task *persistencespb.TimerTaskInfo,
+) (retError error) {
+
+	weContext, release, err := t.cache.getOrCreateWorkflowExecutionForBackground(
+		t.getNamespaceIDAndWorkflowExecution(task),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTimerTask(weContext, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	now := t.shard.GetTimeSource().Now()
+	if getStuckReason(mutableState, t.config, now) == mutableState.GetExecutionInfo().StuckReason {
+		return nil
+	}
+
+	// the stuck reason is only recorded in execution info and visibility, no history event is written
+	if err := refreshStuckReason(
+		mutableState,
+		newMutableStateTaskGenerator(t.shard.GetNamespaceCache(), t.logger, mutableState),
+		t.config,
+		t.metricsClient,
+		metrics.TimerActiveTaskStuckExecutionCheckScope,
+		now,
+	); err != nil {
+		return err
+	}
+	return t.updateWorkflowExecution(weContext, mutableState, false)
+}
+
 func (t *timerQueueActiveTaskExecutor) getTimerSequence(
 	mutableState mutableState,
 ) timerSequence {
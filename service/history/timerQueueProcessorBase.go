@@ -474,6 +474,11 @@ func getTimerTaskMetricScope(
 			return metrics.TimerActiveTaskWorkflowBackoffTimerScope
 		}
 		return metrics.TimerStandbyTaskWorkflowBackoffTimerScope
+	case enumsspb.TASK_TYPE_STUCK_EXECUTION_CHECK:
+		if isActive {
+			return metrics.TimerActiveTaskStuckExecutionCheckScope
+		}
+		return metrics.TimerStandbyTaskStuckExecutionCheckScope
 	default:
 		if isActive {
 			return metrics.TimerActiveQueueProcessorScope
@@ -112,6 +112,9 @@ func (t *timerQueueStandbyTaskExecutor) execute(
 		return t.executeWorkflowBackoffTimerTask(timerTask)
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT:
 		return t.executeDeleteHistoryEventTask(timerTask)
+	case enumsspb.TASK_TYPE_STUCK_EXECUTION_CHECK:
+		// stuck executions are only flagged by the active cluster
+		return nil
 	default:
 		return errUnknownTimerTask
 	}
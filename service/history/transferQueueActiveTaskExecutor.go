@@ -158,11 +158,12 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 		return err
 	}
 
+	taskQueue := ai.TaskQueue
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(task, &timeout)
+	return t.pushActivity(task, taskQueue, &timeout)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
@@ -281,10 +282,19 @@ func (t *transferQueueActiveTaskExecutor) processCloseExecution(
 	}
 	workflowStartTime := timestamp.TimeValue(startEvent.GetEventTime())
 	workflowExecutionTime := getWorkflowExecutionTime(mutableState, startEvent)
-	visibilityMemo := getWorkflowMemo(executionInfo.Memo)
-	searchAttr := executionInfo.SearchAttributes
-	namespace := mutableState.GetNamespaceEntry().GetInfo().Name
+	visibilityMemo := getWorkflowMemo(withReplicationOversizedFlag(executionInfo.Memo, executionInfo.ReplicationOversizedBatch))
+	searchAttr, err := withOperatorAnnotations(withExternalSearchAttributes(copySearchAttributes(executionInfo.SearchAttributes), executionInfo.ExternalSearchAttributes), executionInfo.OperatorAnnotations)
+	if err != nil {
+		return err
+	}
+	namespaceEntry := mutableState.GetNamespaceEntry()
+	namespace := namespaceEntry.GetInfo().Name
 	children := mutableState.GetPendingChildExecutionInfos()
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return err
+	}
+	nextEventID := mutableState.GetNextEventID()
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
@@ -336,7 +346,21 @@ func (t *transferQueueActiveTaskExecutor) processCloseExecution(
 		return err
 	}
 
-	return t.processParentClosePolicy(task.GetNamespaceId(), namespace, children)
+	if err := t.processParentClosePolicy(task.GetNamespaceId(), namespace, children); err != nil {
+		return err
+	}
+
+	// archive history last, so that a slow archiver doesn't delay notifying the parent
+	t.archiveHistoryOnClose(
+		task.GetNamespaceId(),
+		task.GetWorkflowId(),
+		task.GetRunId(),
+		namespaceEntry,
+		branchToken,
+		nextEventID,
+		lastWriteVersion,
+	)
+	return nil
 }
 
 func (t *transferQueueActiveTaskExecutor) processCancelExecution(
@@ -695,8 +719,14 @@ func (t *transferQueueActiveTaskExecutor) processRecordWorkflowStartedOrUpsertHe
 	}
 	startTimestamp := timestamp.TimeValue(startEvent.GetEventTime())
 	executionTimestamp := getWorkflowExecutionTime(mutableState, startEvent)
-	visibilityMemo := getWorkflowMemo(executionInfo.Memo)
-	searchAttr := copySearchAttributes(executionInfo.SearchAttributes)
+	visibilityMemo := getWorkflowMemo(withReplicationOversizedFlag(executionInfo.Memo, executionInfo.ReplicationOversizedBatch))
+	searchAttr, err := withOperatorAnnotations(
+		withStuckReason(withExternalSearchAttributes(copySearchAttributes(executionInfo.SearchAttributes), executionInfo.ExternalSearchAttributes), executionInfo.StuckReason),
+		executionInfo.OperatorAnnotations,
+	)
+	if err != nil {
+		return err
+	}
 
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
@@ -1276,7 +1306,7 @@ func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 	workflowID := task.GetWorkflowId()
 	baseRunID := baseMutableState.GetExecutionState().GetRunId()
 
-	resetRunID := uuid.New()
+	resetRunID := t.shard.GetService().GetUUIDGenerator().New()
 	baseRebuildLastEventID := resetPoint.GetFirstWorkflowTaskCompletedId() - 1
 	baseVersionHistories := baseMutableState.GetExecutionInfo().GetVersionHistories()
 	baseCurrentVersionHistory, err := versionhistory.GetCurrentVersionHistory(baseVersionHistories)
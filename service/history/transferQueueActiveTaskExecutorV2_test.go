@@ -25,6 +25,7 @@
 package history
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -297,6 +298,72 @@ func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessActivityTask_Success
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessActivityTask_Rerouted() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := newMutableStateBuilderWithVersionHistoriesForTest(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType: &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: taskQueueName,
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, di.ScheduleID, taskQueueName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(mutableState, di.ScheduleID, di.StartedID, "some random identity")
+
+	taskID := int64(59)
+	activityID := "activity-1"
+	activityType := "some random activity type"
+	s.mockShard.GetConfig().ActivityTaskQueueReroutes = dc.GetMapPropertyFnWithNamespaceFilter(map[string]interface{}{
+		taskQueueName: map[string]interface{}{activityType: "some other task queue"},
+	})
+	event, ai := addActivityTaskScheduledEvent(mutableState, event.GetEventId(), activityID, activityType, taskQueueName, &commonpb.Payloads{}, 1*time.Second, 1*time.Second, 1*time.Second, 1*time.Second)
+	s.Equal("some other task queue", ai.TaskQueue)
+	s.Equal(activityType, ai.ActivityType)
+
+	transferTask := &persistencespb.TransferTaskInfo{
+		Version:           s.version,
+		NamespaceId:       s.namespaceID,
+		TargetNamespaceId: testTargetNamespaceID,
+		WorkflowId:        execution.GetWorkflowId(),
+		RunId:             execution.GetRunId(),
+		TaskId:            taskID,
+		TaskQueue:         taskQueueName,
+		TaskType:          enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+		ScheduleId:        event.GetEventId(),
+	}
+
+	reroutedTask := *transferTask
+	reroutedTask.TaskQueue = "some other task queue"
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), s.createAddActivityTaskRequest(&reroutedTask, ai)).Return(&matchingservice.AddActivityTaskResponse{}, nil).Times(1)
+
+	err = s.transferQueueActiveTaskExecutor.execute(transferTask, true)
+	s.Nil(err)
+}
+
 func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessActivityTask_Duplication() {
 
 	execution := commonpb.WorkflowExecution{
@@ -755,7 +822,7 @@ func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessCloseExecution_NoPar
 
 	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
-	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI", nil))
 	s.mockArchivalClient.On("Archive", mock.Anything, mock.Anything).Return(nil, nil).Once()
 
 	err = s.transferQueueActiveTaskExecutor.execute(transferTask, true)
@@ -1074,6 +1141,45 @@ func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessCloseExecution_NoPar
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveTaskExecutorSuiteV2) TestArchiveHistoryOnClose_Disabled() {
+	namespaceEntry := s.newHistoryArchivalNamespaceEntry()
+
+	s.transferQueueActiveTaskExecutor.archiveHistoryOnClose(
+		s.namespaceID, "some random workflow ID", uuid.New(), namespaceEntry, []byte{1, 2, 3}, 101, s.version,
+	)
+}
+
+func (s *transferQueueActiveTaskExecutorSuiteV2) TestArchiveHistoryOnClose_Success() {
+	s.transferQueueActiveTaskExecutor.config.ArchiveHistoryOnClose = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	namespaceEntry := s.newHistoryArchivalNamespaceEntry()
+	runID := uuid.New()
+
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI", nil))
+	s.mockArchivalClient.On("Archive", mock.Anything, mock.MatchedBy(func(req *warchiver.ClientRequest) bool {
+		return req.CallerService == common.HistoryServiceName && req.AttemptArchiveInline && req.InlineOnly &&
+			len(req.ArchiveRequest.Targets) == 1 && req.ArchiveRequest.Targets[0] == warchiver.ArchiveTargetHistory &&
+			req.ArchiveRequest.RunID == runID && req.ArchiveRequest.NextEventID == 101 &&
+			req.ArchiveRequest.CloseFailoverVersion == s.version && req.ArchiveRequest.HistoryURI == "test:///history/archival"
+	})).Return(&warchiver.ClientResponse{HistoryArchivedInline: true}, nil).Once()
+
+	s.transferQueueActiveTaskExecutor.archiveHistoryOnClose(
+		s.namespaceID, "some random workflow ID", runID, namespaceEntry, []byte{1, 2, 3}, 101, s.version,
+	)
+}
+
+func (s *transferQueueActiveTaskExecutorSuiteV2) TestArchiveHistoryOnClose_Failed() {
+	s.transferQueueActiveTaskExecutor.config.ArchiveHistoryOnClose = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	namespaceEntry := s.newHistoryArchivalNamespaceEntry()
+
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI", nil))
+	s.mockArchivalClient.On("Archive", mock.Anything, mock.Anything).Return(nil, errors.New("some random error")).Once()
+
+	// failure is left to archival on retention
+	s.transferQueueActiveTaskExecutor.archiveHistoryOnClose(
+		s.namespaceID, "some random workflow ID", uuid.New(), namespaceEntry, []byte{1, 2, 3}, 101, s.version,
+	)
+}
+
 func (s *transferQueueActiveTaskExecutorSuiteV2) TestProcessCancelExecution_Success() {
 
 	execution := commonpb.WorkflowExecution{
@@ -1917,6 +2023,19 @@ func (s *transferQueueActiveTaskExecutorSuiteV2) TestCopySearchAttributes() {
 	s.Equal(byte('1'), val.GetData()[0])
 }
 
+func (s *transferQueueActiveTaskExecutorSuiteV2) newHistoryArchivalNamespaceEntry() *cache.NamespaceCacheEntry {
+	return cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID, Name: s.namespace},
+		&persistencespb.NamespaceConfig{
+			Retention:            timestamp.DurationFromDays(1),
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:   "test:///history/archival",
+		},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+}
+
 func (s *transferQueueActiveTaskExecutorSuiteV2) createAddActivityTaskRequest(
 	task *persistencespb.TransferTaskInfo,
 	ai *persistencespb.ActivityInfo,
@@ -137,7 +137,7 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedId == common.EmptyEventID {
-			return newPushActivityToMatchingInfo(*activityInfo.ScheduleToStartTimeout), nil
+			return newPushActivityToMatchingInfo(*activityInfo.ScheduleToStartTimeout, activityInfo.TaskQueue), nil
 		}
 
 		return nil, nil
@@ -251,7 +251,7 @@ func (t *transferQueueStandbyTaskExecutor) processCloseExecution(
 		}
 		workflowStartTime := timestamp.TimeValue(startEvent.GetEventTime())
 		workflowExecutionTimestamp := getWorkflowExecutionTime(mutableState, startEvent)
-		visibilityMemo := getWorkflowMemo(executionInfo.Memo)
+		visibilityMemo := getWorkflowMemo(withReplicationOversizedFlag(executionInfo.Memo, executionInfo.ReplicationOversizedBatch))
 		searchAttr := executionInfo.SearchAttributes
 
 		lastWriteVersion, err := mutableState.GetLastWriteVersion()
@@ -458,7 +458,7 @@ func (t *transferQueueStandbyTaskExecutor) processRecordWorkflowStartedOrUpsertH
 	}
 	startTime := timestamp.TimeValue(startEvent.GetEventTime())
 	executionTimestamp := getWorkflowExecutionTime(mutableState, startEvent)
-	visibilityMemo := getWorkflowMemo(executionInfo.Memo)
+	visibilityMemo := getWorkflowMemo(withReplicationOversizedFlag(executionInfo.Memo, executionInfo.ReplicationOversizedBatch))
 	searchAttr := copySearchAttributes(executionInfo.SearchAttributes)
 
 	if isRecordStart {
@@ -548,6 +548,7 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 	timeout := pushActivityInfo.activityTaskScheduleToStartTimeout
 	return t.transferQueueTaskExecutorBase.pushActivity(
 		task.(*persistencespb.TransferTaskInfo),
+		pushActivityInfo.taskQueue,
 		&timeout,
 	)
 }
@@ -38,6 +38,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/client/matching"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -96,6 +97,7 @@ func (t *transferQueueTaskExecutorBase) getNamespaceIDAndWorkflowExecution(
 
 func (t *transferQueueTaskExecutorBase) pushActivity(
 	task *persistencespb.TransferTaskInfo,
+	taskQueue string,
 	activityScheduleToStartTimeout *time.Duration,
 ) error {
 
@@ -114,7 +116,7 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 			RunId:      task.GetRunId(),
 		},
 		TaskQueue: &taskqueuepb.TaskQueue{
-			Name: task.TaskQueue,
+			Name: taskQueue,
 			Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
 		},
 		ScheduleId:             task.GetScheduleId(),
@@ -345,6 +347,59 @@ func (t *transferQueueTaskExecutorBase) recordWorkflowClosed(
 	return nil
 }
 
+// archiveHistoryOnClose archives history of the closed workflow inline if it is enabled for the namespace,
+// so that the archived copy exists without waiting for the retention timer. If archival fails or times out,
+// history is archived when retention expires, as it is for namespaces which don't archive on close.
+// The archival workflow is not used as fallback since it deletes history once it is archived.
+func (t *transferQueueTaskExecutorBase) archiveHistoryOnClose(
+	namespaceID string,
+	workflowID string,
+	runID string,
+	namespaceEntry *cache.NamespaceCacheEntry,
+	branchToken []byte,
+	nextEventID int64,
+	closeFailoverVersion int64,
+) {
+
+	namespace := namespaceEntry.GetInfo().Name
+	if !t.config.ArchiveHistoryOnClose(namespace) {
+		return
+	}
+	clusterConfiguredForHistoryArchival := t.shard.GetService().GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival()
+	namespaceConfiguredForHistoryArchival := namespaceEntry.GetConfig().HistoryArchivalState == enumspb.ARCHIVAL_STATE_ENABLED
+	if !clusterConfiguredForHistoryArchival || !namespaceConfiguredForHistoryArchival {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), t.config.TransferProcessorHistoryArchivalTimeLimit())
+	defer cancel()
+	_, err := t.historyService.archivalClient.Archive(ctx, &archiver.ClientRequest{
+		ArchiveRequest: &archiver.ArchiveRequest{
+			NamespaceID:          namespaceID,
+			WorkflowID:           workflowID,
+			RunID:                runID,
+			Namespace:            namespace,
+			ShardID:              t.shard.GetShardID(),
+			Targets:              []archiver.ArchivalTarget{archiver.ArchiveTargetHistory},
+			HistoryURI:           namespaceEntry.GetConfig().HistoryArchivalUri,
+			NextEventID:          nextEventID,
+			BranchToken:          branchToken,
+			CloseFailoverVersion: closeFailoverVersion,
+		},
+		CallerService:        common.HistoryServiceName,
+		AttemptArchiveInline: true,
+		InlineOnly:           true,
+	})
+	if err != nil {
+		t.logger.Warn("Failed to archive history on workflow close, history is archived when retention expires.",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.WorkflowID(workflowID),
+			tag.WorkflowRunID(runID),
+			tag.Error(err),
+		)
+	}
+}
+
 func isWorkflowNotExistError(err error) bool {
 	_, ok := err.(*serviceerror.NotFound)
 	return ok
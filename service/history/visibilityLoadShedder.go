@@ -0,0 +1,221 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	espersistence "go.temporal.io/server/common/persistence/elasticsearch"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+const (
+	visibilityStoreAvailable int = iota
+	// visibilityStoreUnavailable means writes are shed, tasks stay in the visibility task queue
+	visibilityStoreUnavailable
+	// visibilityStoreCatchingUp means the store has recovered and the backlog is being replayed
+	visibilityStoreCatchingUp
+)
+
+type (
+	// visibilityLoadShedder tracks the health of the visibility store as seen by one shard.
+	// After a number of consecutive unavailable errors it stops visibility writes, so that the
+	// visibility queue processor leaves tasks in the (durable) visibility task queue instead of
+	// retrying them against a store which is down. One write per probe interval is let through
+	// to detect recovery, after which the backlog is replayed at a controlled rate.
+	visibilityLoadShedder struct {
+		failureThreshold dynamicconfig.IntPropertyFn
+		probeInterval    dynamicconfig.DurationPropertyFn
+		timeSource       clock.TimeSource
+		metricsScope     metrics.Scope
+		logger           log.Logger
+		onRecovered      func()
+
+		sync.Mutex
+		state               int
+		consecutiveFailures int
+		sheddingSince       time.Time
+		lastProbeTime       time.Time
+	}
+)
+
+func newVisibilityLoadShedder(
+	failureThreshold dynamicconfig.IntPropertyFn,
+	probeInterval dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+	metricsScope metrics.Scope,
+	logger log.Logger,
+	onRecovered func(),
+) *visibilityLoadShedder {
+	return &visibilityLoadShedder{
+		failureThreshold: failureThreshold,
+		probeInterval:    probeInterval,
+		timeSource:       timeSource,
+		metricsScope:     metricsScope,
+		logger:           logger,
+		onRecovered:      onRecovered,
+		state:            visibilityStoreAvailable,
+	}
+}
+
+// allow returns whether a visibility write should be sent to the store,
+// while shedding only one write per probe interval is allowed
+func (s *visibilityLoadShedder) allow() bool {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.state != visibilityStoreUnavailable {
+		return true
+	}
+	now := s.timeSource.Now()
+	if now.Sub(s.lastProbeTime) < s.probeInterval() {
+		return false
+	}
+	s.lastProbeTime = now
+	return true
+}
+
+// recordResult records the result of a visibility write,
+// onRecovered is invoked if the write indicates that the store has recovered
+func (s *visibilityLoadShedder) recordResult(
+	err error,
+) {
+	if s.updateState(err) && s.onRecovered != nil {
+		s.onRecovered()
+	}
+}
+
+func (s *visibilityLoadShedder) updateState(
+	err error,
+) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	if !isVisibilityStoreUnavailableError(err) {
+		s.consecutiveFailures = 0
+		if s.state != visibilityStoreUnavailable {
+			return false
+		}
+		s.logger.Info("Visibility store recovered, replaying visibility task backlog.",
+			tag.Value(s.timeSource.Now().Sub(s.sheddingSince).String()))
+		s.transitLocked(visibilityStoreCatchingUp)
+		return true
+	}
+
+	s.consecutiveFailures++
+	threshold := s.failureThreshold()
+	if s.state == visibilityStoreUnavailable || threshold <= 0 || s.consecutiveFailures < threshold {
+		return false
+	}
+	s.logger.Warn("Visibility store unavailable, shedding visibility writes.", tag.Error(err))
+	now := s.timeSource.Now()
+	s.sheddingSince = now
+	s.lastProbeTime = now
+	s.transitLocked(visibilityStoreUnavailable)
+	return false
+}
+
+// markCaughtUp is called once the visibility task backlog has been fully read after a recovery
+func (s *visibilityLoadShedder) markCaughtUp() {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.state != visibilityStoreCatchingUp {
+		return
+	}
+	s.logger.Info("Visibility task backlog replayed.")
+	s.transitLocked(visibilityStoreAvailable)
+}
+
+func (s *visibilityLoadShedder) isShedding() bool {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.state == visibilityStoreUnavailable
+}
+
+func (s *visibilityLoadShedder) isCatchingUp() bool {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.state == visibilityStoreCatchingUp
+}
+
+// sheddingDuration returns for how long visibility writes have been shed, 0 if not shedding
+func (s *visibilityLoadShedder) sheddingDuration() time.Duration {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.state != visibilityStoreUnavailable {
+		return 0
+	}
+	return s.timeSource.Now().Sub(s.sheddingSince)
+}
+
+// probeDue returns whether the next allow call will let a probe write through
+func (s *visibilityLoadShedder) probeDue() bool {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.state == visibilityStoreUnavailable &&
+		s.timeSource.Now().Sub(s.lastProbeTime) >= s.probeInterval()
+}
+
+func (s *visibilityLoadShedder) transitLocked(
+	state int,
+) {
+	s.state = state
+	s.metricsScope.IncCounter(metrics.VisibilityLoadSheddingTransitionCounter)
+	if state == visibilityStoreUnavailable {
+		s.metricsScope.UpdateGauge(metrics.VisibilityLoadSheddingGauge, 1)
+	} else {
+		s.metricsScope.UpdateGauge(metrics.VisibilityLoadSheddingGauge, 0)
+	}
+}
+
+// isVisibilityStoreUnavailableError returns whether the error means that the store could not take the write,
+// errors specific to a single task, e.g. Internal errors of malformed records, don't count
+func isVisibilityStoreUnavailableError(
+	err error,
+) bool {
+	switch err.(type) {
+	case *serviceerror.Unavailable,
+		*serviceerror.ResourceExhausted,
+		*persistence.TimeoutError,
+		// Elasticsearch bulk processor failed or did not acknowledge the write in time
+		*espersistence.VisibilityTaskNAckError,
+		*espersistence.VisibilityTaskAckTimeoutError:
+		return true
+	}
+	return false
+}
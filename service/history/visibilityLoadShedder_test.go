@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	espersistence "go.temporal.io/server/common/persistence/elasticsearch"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	visibilityLoadShedderSuite struct {
+		suite.Suite
+
+		timeSource  *clock.EventTimeSource
+		recoveries  int
+		loadShedder *visibilityLoadShedder
+	}
+)
+
+func TestVisibilityLoadShedderSuite(t *testing.T) {
+	suite.Run(t, new(visibilityLoadShedderSuite))
+}
+
+func (s *visibilityLoadShedderSuite) SetupTest() {
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.recoveries = 0
+	s.loadShedder = newVisibilityLoadShedder(
+		dynamicconfig.GetIntPropertyFn(3),
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		s.timeSource,
+		metrics.NewClient(tally.NoopScope, metrics.History).Scope(metrics.VisibilityQueueProcessorScope),
+		log.NewNoop(),
+		func() { s.recoveries++ },
+	)
+}
+
+func (s *visibilityLoadShedderSuite) TestSheddingAfterConsecutiveFailures() {
+	unavailable := serviceerror.NewUnavailable("visibility store down")
+
+	s.loadShedder.recordResult(unavailable)
+	s.loadShedder.recordResult(unavailable)
+	s.loadShedder.recordResult(nil)
+	s.loadShedder.recordResult(unavailable)
+	s.loadShedder.recordResult(unavailable)
+	s.False(s.loadShedder.isShedding())
+	s.True(s.loadShedder.allow())
+
+	s.loadShedder.recordResult(unavailable)
+	s.True(s.loadShedder.isShedding())
+	s.False(s.loadShedder.allow())
+	s.False(s.loadShedder.probeDue())
+
+	s.timeSource.Update(s.timeSource.Now().Add(10 * time.Second))
+	s.Equal(10*time.Second, s.loadShedder.sheddingDuration())
+}
+
+func (s *visibilityLoadShedderSuite) TestElasticsearchErrorsShed() {
+	s.loadShedder.recordResult(&espersistence.VisibilityTaskNAckError{VisibilityTaskKey: "1"})
+	s.loadShedder.recordResult(&espersistence.VisibilityTaskAckTimeoutError{VisibilityTaskKey: "2", Timeout: time.Minute})
+	s.False(s.loadShedder.isShedding())
+	s.loadShedder.recordResult(&espersistence.VisibilityTaskNAckError{VisibilityTaskKey: "3"})
+	s.True(s.loadShedder.isShedding())
+}
+
+func (s *visibilityLoadShedderSuite) TestNonStoreErrorsDoNotShed() {
+	for i := 0; i < 10; i++ {
+		s.loadShedder.recordResult(serviceerror.NewNotFound("workflow not found"))
+		s.loadShedder.recordResult(serviceerror.NewInternal("malformed search attribute"))
+	}
+	s.False(s.loadShedder.isShedding())
+}
+
+func (s *visibilityLoadShedderSuite) TestDisabled() {
+	s.loadShedder.failureThreshold = dynamicconfig.GetIntPropertyFn(0)
+	for i := 0; i < 10; i++ {
+		s.loadShedder.recordResult(serviceerror.NewUnavailable("visibility store down"))
+	}
+	s.False(s.loadShedder.isShedding())
+}
+
+func (s *visibilityLoadShedderSuite) TestProbeAndRecovery() {
+	s.shed()
+
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	s.True(s.loadShedder.probeDue())
+	s.True(s.loadShedder.allow())
+	s.False(s.loadShedder.allow())
+
+	// failed probe keeps shedding
+	s.loadShedder.recordResult(serviceerror.NewUnavailable("visibility store down"))
+	s.True(s.loadShedder.isShedding())
+	s.Equal(0, s.recoveries)
+
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	s.True(s.loadShedder.allow())
+	s.loadShedder.recordResult(nil)
+	s.False(s.loadShedder.isShedding())
+	s.True(s.loadShedder.isCatchingUp())
+	s.Equal(1, s.recoveries)
+	s.True(s.loadShedder.allow())
+	s.Zero(s.loadShedder.sheddingDuration())
+
+	s.loadShedder.markCaughtUp()
+	s.False(s.loadShedder.isCatchingUp())
+}
+
+func (s *visibilityLoadShedderSuite) TestFailureWhileCatchingUp() {
+	s.shed()
+	s.timeSource.Update(s.timeSource.Now().Add(time.Minute))
+	s.loadShedder.recordResult(nil)
+	s.True(s.loadShedder.isCatchingUp())
+
+	s.shed()
+	s.False(s.loadShedder.isCatchingUp())
+	s.True(s.loadShedder.isShedding())
+}
+
+func (s *visibilityLoadShedderSuite) shed() {
+	for i := 0; i < 3; i++ {
+		s.loadShedder.recordResult(&espersistence.VisibilityTaskAckTimeoutError{VisibilityTaskKey: "1", Timeout: time.Minute})
+	}
+	s.True(s.loadShedder.isShedding())
+}
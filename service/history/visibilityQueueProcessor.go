@@ -25,6 +25,7 @@
 package history
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 	"time"
@@ -38,6 +39,8 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/shard"
 )
@@ -65,6 +68,8 @@ type (
 		logger                   log.Logger
 		metricsClient            metrics.Client
 		taskExecutor             queueTaskExecutor
+		loadShedder              *visibilityLoadShedder
+		catchUpRateLimiter       quotas.RateLimiter
 
 		// from transferQueueProcessorImpl
 		config             *configs.Config
@@ -123,7 +128,21 @@ func newVisibilityQueueProcessor(
 		return nil
 	}
 
-	retProcessor := &visibilityQueueProcessorImpl{
+	var retProcessor *visibilityQueueProcessorImpl
+	loadShedder := newVisibilityLoadShedder(
+		config.VisibilityProcessorLoadSheddingFailureThreshold,
+		config.VisibilityProcessorLoadSheddingProbeInterval,
+		shard.GetTimeSource(),
+		shard.GetMetricsClient().Scope(metrics.VisibilityQueueProcessorScope),
+		logger,
+		func() {
+			// wake up tasks waiting for the visibility store to recover
+			retProcessor.queueProcessorBase.retryTasks()
+			retProcessor.notifyNewTask()
+		},
+	)
+
+	retProcessor = &visibilityQueueProcessorImpl{
 		shard:                    shard,
 		options:                  options,
 		maxReadAckLevel:          maxReadAckLevel,
@@ -138,6 +157,11 @@ func newVisibilityQueueProcessor(
 			logger,
 			historyService.metricsClient,
 			config,
+			loadShedder,
+		),
+		loadShedder: loadShedder,
+		catchUpRateLimiter: quotas.NewDefaultOutgoingDynamicRateLimiter(
+			func() float64 { return float64(config.VisibilityProcessorCatchUpRPS()) },
 		),
 
 		config:             config,
@@ -317,10 +341,32 @@ func (t *visibilityQueueProcessorImpl) readTasks(
 	readLevel int64,
 ) ([]queueTaskInfo, bool, error) {
 
+	if t.loadShedder.isShedding() {
+		// visibility store is unavailable, tasks are left in the visibility task queue
+		// and replayed once a probe write finds the store recovered
+		t.queueProcessorBase.metricsScope.RecordTimer(metrics.VisibilityQueueLag, t.loadShedder.sheddingDuration())
+		if t.loadShedder.probeDue() {
+			t.queueProcessorBase.retryTasks()
+		}
+		return nil, false, nil
+	}
+
+	batchSize := t.options.BatchSize()
+	catchingUp := t.loadShedder.isCatchingUp()
+	if catchingUp && t.config.VisibilityProcessorCatchUpRPS() > 0 {
+		batchSize = common.MinInt(batchSize, t.catchUpRateLimiter.Burst())
+		ctx, cancel := context.WithTimeout(context.Background(), loadQueueTaskThrottleRetryDelay)
+		err := t.catchUpRateLimiter.WaitN(ctx, batchSize)
+		cancel()
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
 	response, err := t.executionManager.GetVisibilityTasks(&persistence.GetVisibilityTasksRequest{
 		ReadLevel:    readLevel,
 		MaxReadLevel: t.maxReadAckLevel(),
-		BatchSize:    t.options.BatchSize(),
+		BatchSize:    batchSize,
 	})
 
 	if err != nil {
@@ -331,8 +377,19 @@ func (t *visibilityQueueProcessorImpl) readTasks(
 	for i := range response.Tasks {
 		tasks[i] = response.Tasks[i]
 	}
+	if len(response.Tasks) != 0 {
+		lastTask := response.Tasks[len(response.Tasks)-1]
+		t.queueProcessorBase.metricsScope.RecordTimer(
+			metrics.VisibilityQueueLag,
+			t.shard.GetTimeSource().Now().Sub(timestamp.TimeValue(lastTask.GetVisibilityTime())),
+		)
+	}
 
-	return tasks, len(response.NextPageToken) != 0, nil
+	more := len(response.NextPageToken) != 0
+	if catchingUp && !more {
+		t.loadShedder.markCaughtUp()
+	}
+	return tasks, more, nil
 }
 
 func (t *visibilityQueueProcessorImpl) updateAckLevel(
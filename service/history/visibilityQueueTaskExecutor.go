@@ -61,6 +61,7 @@ type (
 		config                  *configs.Config
 		historyClient           history.Client
 		parentClosePolicyClient parentclosepolicy.Client
+		loadShedder             *visibilityLoadShedder
 	}
 )
 
@@ -74,6 +75,7 @@ func newVisibilityQueueTaskExecutor(
 	logger log.Logger,
 	metricsClient metrics.Client,
 	config *configs.Config,
+	loadShedder *visibilityLoadShedder,
 ) *visibilityQueueTaskExecutor {
 	return &visibilityQueueTaskExecutor{
 		shard:          shard,
@@ -91,6 +93,7 @@ func newVisibilityQueueTaskExecutor(
 			historyService.publicClient,
 			config.NumParentClosePolicySystemWorkflows(),
 		),
+		loadShedder: loadShedder,
 	}
 }
 
@@ -171,9 +174,15 @@ func (t *visibilityQueueTaskExecutor) processStartOrUpsertExecution(
 	}
 	startTimestamp := timestamp.TimeValue(startEvent.GetEventTime())
 	executionTimestamp := getWorkflowExecutionTime(mutableState, startEvent)
-	visibilityMemo := getWorkflowMemo(executionInfo.Memo)
+	visibilityMemo := getWorkflowMemo(withReplicationOversizedFlag(executionInfo.Memo, executionInfo.ReplicationOversizedBatch))
 	// TODO (alex): remove copy?
-	searchAttr := copySearchAttributes(executionInfo.SearchAttributes)
+	searchAttr, err := withOperatorAnnotations(
+		withStuckReason(withExternalSearchAttributes(copySearchAttributes(executionInfo.SearchAttributes), executionInfo.ExternalSearchAttributes), executionInfo.StuckReason),
+		executionInfo.OperatorAnnotations,
+	)
+	if err != nil {
+		return err
+	}
 	executionStatus := executionState.GetStatus()
 	taskQueue := executionInfo.TaskQueue
 
@@ -262,7 +271,9 @@ func (t *visibilityQueueTaskExecutor) recordStartExecution(
 		},
 		RunTimeout: int64(timestamp.DurationValue(runTimeout).Round(time.Second).Seconds()),
 	}
-	return t.visibilityMgr.RecordWorkflowExecutionStartedV2(request)
+	return t.writeVisibility(func() error {
+		return t.visibilityMgr.RecordWorkflowExecutionStartedV2(request)
+	})
 }
 
 func (t *visibilityQueueTaskExecutor) upsertExecution(
@@ -311,7 +322,9 @@ func (t *visibilityQueueTaskExecutor) upsertExecution(
 		WorkflowTimeout: int64(timestamp.DurationValue(workflowTimeout).Round(time.Second).Seconds()),
 	}
 
-	return t.visibilityMgr.UpsertWorkflowExecutionV2(request)
+	return t.writeVisibility(func() error {
+		return t.visibilityMgr.UpsertWorkflowExecutionV2(request)
+	})
 }
 
 func (t *visibilityQueueTaskExecutor) processCloseExecution(
@@ -366,8 +379,11 @@ func (t *visibilityQueueTaskExecutor) processCloseExecution(
 	}
 	workflowStartTime := timestamp.TimeValue(startEvent.GetEventTime())
 	workflowExecutionTime := getWorkflowExecutionTime(mutableState, startEvent)
-	visibilityMemo := getWorkflowMemo(executionInfo.Memo)
-	searchAttr := executionInfo.SearchAttributes
+	visibilityMemo := getWorkflowMemo(withReplicationOversizedFlag(executionInfo.Memo, executionInfo.ReplicationOversizedBatch))
+	searchAttr, err := withOperatorAnnotations(withExternalSearchAttributes(copySearchAttributes(executionInfo.SearchAttributes), executionInfo.ExternalSearchAttributes), executionInfo.OperatorAnnotations)
+	if err != nil {
+		return err
+	}
 	taskQueue := executionInfo.TaskQueue
 
 	// release the context lock since we no longer need mutable state builder and
@@ -428,7 +444,7 @@ func (t *visibilityQueueTaskExecutor) recordCloseExecution(
 	}
 
 	if recordWorkflowClose {
-		return t.visibilityMgr.RecordWorkflowExecutionClosedV2(&persistence.RecordWorkflowExecutionClosedRequest{
+		request := &persistence.RecordWorkflowExecutionClosedRequest{
 			VisibilityRequestBase: &persistence.VisibilityRequestBase{
 				NamespaceID: namespaceID,
 				Namespace:   namespace,
@@ -449,6 +465,9 @@ func (t *visibilityQueueTaskExecutor) recordCloseExecution(
 			CloseTimestamp:   endTime.UnixNano(),
 			HistoryLength:    historyLength,
 			RetentionSeconds: retentionSeconds,
+		}
+		return t.writeVisibility(func() error {
+			return t.visibilityMgr.RecordWorkflowExecutionClosedV2(request)
 		})
 	}
 
@@ -468,7 +487,22 @@ func (t *visibilityQueueTaskExecutor) processDeleteExecution(
 		// TODO: expose GetVisibilityManager method on shardContext interface
 		return t.shard.GetService().GetVisibilityManager().DeleteWorkflowExecutionV2(request) // delete from db
 	}
-	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	return t.writeVisibility(func() error {
+		return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	})
+}
+
+// writeVisibility sends a write to the visibility store, unless writes are shed because the store is unavailable
+func (t *visibilityQueueTaskExecutor) writeVisibility(
+	op func() error,
+) error {
+
+	if !t.loadShedder.allow() {
+		return ErrTaskShed
+	}
+	err := op()
+	t.loadShedder.recordResult(err)
+	return err
 }
 
 // Argument startEvent is to save additional call of msBuilder.GetStartEvent
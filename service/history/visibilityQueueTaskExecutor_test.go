@@ -168,6 +168,14 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 		s.logger,
 		s.mockShard.GetMetricsClient(),
 		config,
+		newVisibilityLoadShedder(
+			config.VisibilityProcessorLoadSheddingFailureThreshold,
+			config.VisibilityProcessorLoadSheddingProbeInterval,
+			clock.NewRealTimeSource(),
+			s.mockShard.GetMetricsClient().Scope(metrics.VisibilityQueueProcessorScope),
+			s.logger,
+			nil,
+		),
 	)
 }
 
@@ -422,6 +422,7 @@ func (c *workflowExecutionContextImpl) createWorkflowExecution(
 		newWorkflow.ReplicationTasks,
 		newWorkflow.TimerTasks,
 		newWorkflow.VisibilityTasks,
+		newWorkflow.CustomTasks,
 	)
 	return nil
 }
@@ -571,6 +572,7 @@ func (c *workflowExecutionContextImpl) conflictResolveWorkflowExecution(
 		resetWorkflow.ReplicationTasks,
 		resetWorkflow.TimerTasks,
 		resetWorkflow.VisibilityTasks,
+		resetWorkflow.CustomTasks,
 	)
 	if newWorkflow != nil {
 		c.notifyTasks(
@@ -578,6 +580,7 @@ func (c *workflowExecutionContextImpl) conflictResolveWorkflowExecution(
 			newWorkflow.ReplicationTasks,
 			newWorkflow.TimerTasks,
 			newWorkflow.VisibilityTasks,
+			newWorkflow.CustomTasks,
 		)
 	}
 	if currentWorkflow != nil {
@@ -586,6 +589,7 @@ func (c *workflowExecutionContextImpl) conflictResolveWorkflowExecution(
 			currentWorkflow.ReplicationTasks,
 			currentWorkflow.TimerTasks,
 			currentWorkflow.VisibilityTasks,
+			currentWorkflow.CustomTasks,
 		)
 	}
 
@@ -699,6 +703,9 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 			return err
 		}
 		currentWorkflowSize += eventsSize
+		if len(currentWorkflow.ReplicationTasks) != 0 {
+			c.checkReplicationSize(now, currentWorkflow, workflowEvents, eventsSize)
+		}
 	}
 	c.setHistorySize(currentWorkflowSize)
 	currentWorkflow.ExecutionInfo.ExecutionStats = &persistencespb.ExecutionStats{
@@ -788,6 +795,7 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 		currentWorkflow.ReplicationTasks,
 		currentWorkflow.TimerTasks,
 		currentWorkflow.VisibilityTasks,
+		currentWorkflow.CustomTasks,
 	)
 
 	// notify new workflow tasks
@@ -797,6 +805,7 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 			newWorkflow.ReplicationTasks,
 			newWorkflow.TimerTasks,
 			newWorkflow.VisibilityTasks,
+			newWorkflow.CustomTasks,
 		)
 	}
 
@@ -829,10 +838,12 @@ func (c *workflowExecutionContextImpl) notifyTasks(
 	replicationTasks []persistence.Task,
 	timerTasks []persistence.Task,
 	visibilityTasks []persistence.Task,
+	customTasks []persistence.Task,
 ) {
 	c.engine.NotifyNewTransferTasks(transferTasks)
 	c.engine.NotifyNewTimerTasks(timerTasks)
 	c.engine.NotifyNewVisibilityTasks(visibilityTasks)
+	c.engine.NotifyNewCustomTasks(customTasks)
 }
 
 func (c *workflowExecutionContextImpl) mergeContinueAsNewReplicationTasks(
@@ -1246,6 +1257,49 @@ func (c *workflowExecutionContextImpl) enforceSizeCheck() (bool, error) {
 	return false, nil
 }
 
+// checkReplicationSize flags the execution and emits a namespace tagged metric once an event batch exceeds the size
+// remote clusters are able to receive, so that operators learn about it before replication of the execution keeps
+// failing. The flag is kept in execution info only, the update the events are appended with persists it together
+// with a visibility task exposing it.
+func (c *workflowExecutionContextImpl) checkReplicationSize(
+	now time.Time,
+	workflowMutation *persistence.WorkflowMutation,
+	workflowEvents *persistence.WorkflowEvents,
+	eventsSize int64,
+) {
+	namespace := c.getNamespace()
+	sizeLimit := c.config.ReplicationEventBatchSizeLimit(namespace)
+	if eventsSize <= int64(sizeLimit) {
+		return
+	}
+
+	c.metricsClient.Scope(metrics.ExecutionSizeStatsScope, metrics.NamespaceTag(namespace)).IncCounter(metrics.ReplicationEventBatchOversized)
+	c.logger.Warn("Event batch exceeds replication size limit, replication to remote clusters is expected to fail.",
+		tag.WorkflowNamespaceID(c.namespaceID),
+		tag.WorkflowID(c.workflowExecution.GetWorkflowId()),
+		tag.WorkflowRunID(c.workflowExecution.GetRunId()),
+		tag.WorkflowFirstEventID(workflowEvents.Events[0].GetEventId()),
+		tag.WorkflowHistorySizeBytes(int(eventsSize)))
+
+	if !recordReplicationOversizedBatch(workflowMutation.ExecutionInfo, workflowEvents.Events, eventsSize, sizeLimit) {
+		return
+	}
+	// transaction of the mutation is already closed, the visibility task is added to the mutation directly
+	if c.config.VisibilityQueue() == common.VisibilityQueueKafka {
+		workflowMutation.TransferTasks = append(workflowMutation.TransferTasks, &persistence.UpsertWorkflowSearchAttributesTask{
+			// TaskID is set by shard
+			VisibilityTimestamp: now,
+			Version:             c.mutableState.GetCurrentVersion(), // task processing does not check this version
+		})
+		return
+	}
+	workflowMutation.VisibilityTasks = append(workflowMutation.VisibilityTasks, &persistence.UpsertExecutionVisibilityTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: now,
+		Version:             c.mutableState.GetCurrentVersion(), // task processing does not check this version
+	})
+}
+
 func (c *workflowExecutionContextImpl) persistNewWorkflowEvents(
 	newWorkflowEvents *persistence.WorkflowEvents,
 ) (int64, error) {
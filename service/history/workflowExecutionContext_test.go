@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/shard"
+)
+
+func TestCheckReplicationSize(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockNamespaceCache := cache.NewMockNamespaceCache(controller)
+	mockNamespaceCache.EXPECT().GetNamespaceByID(testNamespaceID).Return(testLocalNamespaceEntry, nil).AnyTimes()
+	mockShard := shard.NewMockContext(controller)
+	mockShard.EXPECT().GetNamespaceCache().Return(mockNamespaceCache).AnyTimes()
+	mockMutableState := NewMockmutableState(controller)
+	mockMutableState.EXPECT().GetCurrentVersion().Return(int64(12)).AnyTimes()
+
+	config := configs.NewDynamicConfigForTest()
+	config.ReplicationEventBatchSizeLimit = dynamicconfig.GetIntPropertyFilteredByNamespace(100)
+	config.VisibilityQueue = dynamicconfig.GetStringPropertyFn(common.VisibilityQueueInternal)
+	context := &workflowExecutionContextImpl{
+		namespaceID:   testNamespaceID,
+		shard:         mockShard,
+		logger:        log.NewNoop(),
+		metricsClient: metrics.NewClient(tally.NoopScope, metrics.History),
+		config:        config,
+		mutableState:  mockMutableState,
+	}
+	now := time.Now().UTC()
+	workflowMutation := &persistence.WorkflowMutation{ExecutionInfo: &persistencespb.WorkflowExecutionInfo{}}
+	workflowEvents := &persistence.WorkflowEvents{Events: []*historypb.HistoryEvent{{EventId: 5}}}
+
+	context.checkReplicationSize(now, workflowMutation, workflowEvents, 100)
+	require.Nil(t, workflowMutation.ExecutionInfo.ReplicationOversizedBatch)
+	require.Empty(t, workflowMutation.VisibilityTasks)
+
+	context.checkReplicationSize(now, workflowMutation, workflowEvents, 101)
+	require.Equal(t, &persistencespb.OversizedEventBatch{
+		FirstEventId:   5,
+		SizeBytes:      101,
+		SizeLimitBytes: 100,
+	}, workflowMutation.ExecutionInfo.ReplicationOversizedBatch)
+	require.Empty(t, workflowMutation.ExecutionInfo.Memo)
+	require.Equal(t, []persistence.Task{&persistence.UpsertExecutionVisibilityTask{
+		VisibilityTimestamp: now,
+		Version:             12,
+	}}, workflowMutation.VisibilityTasks)
+
+	// the first oversized batch is kept
+	workflowEvents.Events[0].EventId = 9
+	context.checkReplicationSize(now, workflowMutation, workflowEvents, 200)
+	require.Equal(t, int64(5), workflowMutation.ExecutionInfo.ReplicationOversizedBatch.GetFirstEventId())
+	require.Len(t, workflowMutation.VisibilityTasks, 1)
+}
+
+func TestWithReplicationOversizedFlag(t *testing.T) {
+	memo := map[string]*commonpb.Payload{"key": payload.EncodeString("value")}
+	require.Equal(t, memo, withReplicationOversizedFlag(memo, nil))
+
+	flagged := withReplicationOversizedFlag(memo, &persistencespb.OversizedEventBatch{
+		FirstEventId:   5,
+		SizeBytes:      101,
+		SizeLimitBytes: 100,
+	})
+	require.Len(t, memo, 1)
+	require.Len(t, flagged, 2)
+	var flag replicationOversizedFlag
+	require.NoError(t, payload.Decode(flagged[common.ReplicationOversizedMemoKey], &flag))
+	require.Equal(t, replicationOversizedFlag{FirstEventID: 5, BatchSize: 101, SizeLimit: 100}, flag)
+}
@@ -29,6 +29,7 @@ package history
 import (
 	"context"
 	"fmt"
+	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -43,6 +44,8 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/history/shard"
@@ -224,7 +227,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 	}
 
 	resetFailure := failure.NewResetWorkflowFailure(resetReason, nil)
-	_, err = resetMutableState.AddWorkflowTaskFailedEvent(
+	resetEvent, err := resetMutableState.AddWorkflowTaskFailedEvent(
 		workflowTask.ScheduleID,
 		workflowTask.StartedID, enumspb.WORKFLOW_TASK_FAILED_CAUSE_RESET_WORKFLOW,
 		resetFailure,
@@ -237,6 +240,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 	if err != nil {
 		return nil, err
 	}
+	recordResetBaseRunID(resetMutableState, resetEvent)
 
 	if err := r.failInflightActivity(resetMutableState, resetReason); err != nil {
 		return nil, err
@@ -412,6 +416,10 @@ func (r *workflowResetterImpl) forkAndGenerateBranchToken(
 	forkNodeID int64,
 	resetRunID string,
 ) ([]byte, error) {
+	if err := r.validateHistoryBranchCount(namespaceID, workflowID, forkBranchToken); err != nil {
+		return nil, err
+	}
+
 	// fork a new history branch
 	shardID := r.shard.GetShardID()
 	resp, err := r.historyV2Mgr.ForkHistoryBranch(&persistence.ForkHistoryBranchRequest{
@@ -427,6 +435,45 @@ func (r *workflowResetterImpl) forkAndGenerateBranchToken(
 	return resp.NewBranchToken, nil
 }
 
+// validateHistoryBranchCount records number of live branches of the workflow history tree
+// and rejects the reset if forking another branch would exceed the limit
+func (r *workflowResetterImpl) validateHistoryBranchCount(
+	namespaceID string,
+	workflowID string,
+	forkBranchToken []byte,
+) error {
+
+	namespaceEntry, err := r.namespaceCache.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return err
+	}
+	namespace := namespaceEntry.GetInfo().Name
+
+	resp, err := r.historyV2Mgr.GetHistoryTree(&persistence.GetHistoryTreeRequest{
+		BranchToken: forkBranchToken,
+		ShardID:     convert.Int32Ptr(r.shard.GetShardID()),
+	})
+	if err != nil {
+		return err
+	}
+	branchCount := len(resp.Branches)
+
+	scope := r.shard.GetMetricsClient().Scope(metrics.HistoryResetWorkflowExecutionScope, metrics.NamespaceTag(namespace))
+	scope.RecordTimer(metrics.HistoryBranchCount, time.Duration(branchCount))
+
+	limit := r.shard.GetConfig().MaximumHistoryBranchesPerWorkflow(namespace)
+	if limit > 0 && branchCount >= limit {
+		scope.IncCounter(metrics.HistoryBranchLimitExceededCounter)
+		r.logger.Warn("Workflow reset rejected, history branches limit exceeded.",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.WorkflowID(workflowID),
+			tag.Counter(branchCount),
+		)
+		return ErrHistoryBranchesLimitExceeded
+	}
+	return nil
+}
+
 func (r *workflowResetterImpl) terminateWorkflow(
 	mutableState mutableState,
 	terminateReason string,
@@ -606,3 +653,19 @@ func (r *workflowResetterImpl) getPaginationFn(
 		return paginateItems, resp.NextPageToken, nil
 	}
 }
+
+// recordResetBaseRunID records the run which the run was reset from, if the workflow task failed event was written
+// by the reset which created the run. History of reset run includes reset events of earlier runs too.
+func recordResetBaseRunID(
+	mutableState mutableState,
+	event *historypb.HistoryEvent,
+) {
+
+	attributes := event.GetWorkflowTaskFailedEventAttributes()
+	if attributes.GetCause() != enumspb.WORKFLOW_TASK_FAILED_CAUSE_RESET_WORKFLOW {
+		return
+	}
+	if attributes.GetNewRunId() == mutableState.GetExecutionState().GetRunId() {
+		mutableState.GetExecutionInfo().ResetBaseRunId = attributes.GetBaseRunId()
+	}
+}
@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/common/mocks"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/service/history/shard"
 )
 
@@ -99,6 +100,7 @@ func (s *workflowResetterSuite) SetupTest() {
 		NewDynamicConfigForTest(),
 	)
 	s.mockHistoryV2Mgr = s.mockShard.Resource.HistoryMgr
+	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceByID(testNamespaceID).Return(testLocalNamespaceEntry, nil).AnyTimes()
 
 	s.workflowResetter = newWorkflowResetter(
 		s.mockShard,
@@ -224,6 +226,10 @@ func (s *workflowResetterSuite) TestReplayResetWorkflow() {
 	resetMutableState := NewMockmutableState(s.controller)
 
 	shardId := s.mockShard.GetShardID()
+	s.mockHistoryV2Mgr.On("GetHistoryTree", &persistence.GetHistoryTreeRequest{
+		BranchToken: baseBranchToken,
+		ShardID:     &shardId,
+	}).Return(&persistence.GetHistoryTreeResponse{Branches: make([]*persistencespb.HistoryBranch, 1)}, nil).Times(1)
 	s.mockHistoryV2Mgr.On("ForkHistoryBranch", &persistence.ForkHistoryBranchRequest{
 		ForkBranchToken: baseBranchToken,
 		ForkNodeID:      baseNodeID,
@@ -308,6 +314,10 @@ func (s *workflowResetterSuite) TestGenerateBranchToken() {
 	resetBranchToken := []byte("some random reset branch token")
 
 	shardId := s.mockShard.GetShardID()
+	s.mockHistoryV2Mgr.On("GetHistoryTree", &persistence.GetHistoryTreeRequest{
+		BranchToken: baseBranchToken,
+		ShardID:     &shardId,
+	}).Return(&persistence.GetHistoryTreeResponse{Branches: make([]*persistencespb.HistoryBranch, 1)}, nil).Times(1)
 	s.mockHistoryV2Mgr.On("ForkHistoryBranch", &persistence.ForkHistoryBranchRequest{
 		ForkBranchToken: baseBranchToken,
 		ForkNodeID:      baseNodeID,
@@ -322,6 +332,23 @@ func (s *workflowResetterSuite) TestGenerateBranchToken() {
 	s.Equal(resetBranchToken, newBranchToken)
 }
 
+func (s *workflowResetterSuite) TestGenerateBranchToken_BranchesLimitExceeded() {
+	s.mockShard.GetConfig().MaximumHistoryBranchesPerWorkflow = dynamicconfig.GetIntPropertyFilteredByNamespace(2)
+	baseBranchToken := []byte("some random base branch token")
+	baseNodeID := int64(1234)
+
+	shardId := s.mockShard.GetShardID()
+	s.mockHistoryV2Mgr.On("GetHistoryTree", &persistence.GetHistoryTreeRequest{
+		BranchToken: baseBranchToken,
+		ShardID:     &shardId,
+	}).Return(&persistence.GetHistoryTreeResponse{Branches: make([]*persistencespb.HistoryBranch, 2)}, nil).Times(1)
+
+	_, err := s.workflowResetter.forkAndGenerateBranchToken(
+		s.namespaceID, s.workflowID, baseBranchToken, baseNodeID, s.resetRunID,
+	)
+	s.Equal(ErrHistoryBranchesLimitExceeded, err)
+}
+
 func (s *workflowResetterSuite) TestTerminateWorkflow() {
 	workflowTask := &workflowTaskInfo{
 		Version:    123,
@@ -573,6 +600,39 @@ func (s *workflowResetterSuite) TestReapplyEvents() {
 	s.NoError(err)
 }
 
+func (s *workflowResetterSuite) TestRecordResetBaseRunID() {
+	resetEvent := func(newRunID string) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED,
+			Attributes: &historypb.HistoryEvent_WorkflowTaskFailedEventAttributes{WorkflowTaskFailedEventAttributes: &historypb.WorkflowTaskFailedEventAttributes{
+				Cause:     enumspb.WORKFLOW_TASK_FAILED_CAUSE_RESET_WORKFLOW,
+				BaseRunId: s.baseRunID,
+				NewRunId:  newRunID,
+			}},
+		}
+	}
+
+	executionInfo := &persistencespb.WorkflowExecutionInfo{}
+	mutableState := NewMockmutableState(s.controller)
+	mutableState.EXPECT().GetExecutionState().Return(&persistencespb.WorkflowExecutionState{RunId: s.resetRunID}).AnyTimes()
+	mutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+
+	// reset of an earlier run copied to history of the reset run
+	recordResetBaseRunID(mutableState, resetEvent(uuid.New()))
+	s.Empty(executionInfo.ResetBaseRunId)
+
+	recordResetBaseRunID(mutableState, &historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED,
+		Attributes: &historypb.HistoryEvent_WorkflowTaskFailedEventAttributes{WorkflowTaskFailedEventAttributes: &historypb.WorkflowTaskFailedEventAttributes{
+			Cause: enumspb.WORKFLOW_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE,
+		}},
+	})
+	s.Empty(executionInfo.ResetBaseRunId)
+
+	recordResetBaseRunID(mutableState, resetEvent(s.resetRunID))
+	s.Equal(s.baseRunID, executionInfo.ResetBaseRunId)
+}
+
 func (s *workflowResetterSuite) TestPagination() {
 	firstEventID := common.FirstEventID
 	nextEventID := int64(101)
@@ -615,6 +615,27 @@ func (handler *workflowTaskHandlerImpl) handleCommandRecordMarker(
 		return err
 	}
 
+	if attr.GetMarkerName() == common.WorkflowReportMarkerName {
+		executionInfo := handler.mutableState.GetExecutionInfo()
+		namespaceEntry, err := handler.namespaceCache.GetNamespaceByID(executionInfo.NamespaceId)
+		if err != nil {
+			return serviceerror.NewInternal(fmt.Sprintf("Unable to get namespace for namespaceID: %v.", executionInfo.NamespaceId))
+		}
+		// reports are kept in mutable state, their total size is limited
+		if err := handler.validateCommandAttr(
+			func() error {
+				return handler.attrValidator.validateWorkflowReportsSize(
+					namespaceEntry.GetInfo().Name,
+					executionInfo.WorkflowReports,
+					attr.GetDetails(),
+				)
+			},
+			enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_RECORD_MARKER_ATTRIBUTES,
+		); err != nil || handler.stopProcessing {
+			return err
+		}
+	}
+
 	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
 		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_RECORD_MARKER.String()),
 		common.GetPayloadsMapSize(attr.GetDetails()),
@@ -27,6 +27,7 @@ package history
 import (
 	"context"
 	"fmt"
+	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -83,6 +84,12 @@ type (
 	}
 )
 
+const (
+	// reasons of continue-as-new suggestion returned in workflow task response header
+	continueAsNewReasonHistorySize  = "history-size"
+	continueAsNewReasonHistoryCount = "history-count"
+)
+
 func newWorkflowTaskHandlerCallback(historyEngine *historyEngineImpl) *workflowTaskHandlerCallbacksImpl {
 	return &workflowTaskHandlerCallbacksImpl{
 		currentClusterName: historyEngine.currentClusterName,
@@ -158,6 +165,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 		return nil, err
 	}
 	namespaceID := namespaceEntry.GetInfo().Id
+	namespace := namespaceEntry.GetInfo().Name
 
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: req.WorkflowExecution.WorkflowId,
@@ -168,6 +176,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 	requestID := req.GetRequestId()
 
 	var resp *historyservice.RecordWorkflowTaskStartedResponse
+	var workflowTaskTimeout *time.Duration
 	err = handler.historyEngine.updateWorkflowExecutionWithAction(ctx, namespaceID, execution,
 		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
 			if !mutableState.IsWorkflowExecutionRunning() {
@@ -202,6 +211,8 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 					if err != nil {
 						return nil, err
 					}
+					resp.SuggestContinueAsNewReasons = handler.continueAsNewReasons(namespace, context, mutableState)
+					workflowTaskTimeout = workflowTask.WorkflowTaskTimeout
 					updateAction.noop = true
 					return updateAction, nil
 				}
@@ -211,22 +222,31 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 				return nil, serviceerrors.NewTaskAlreadyStarted("Workflow")
 			}
 
+			scheduledTime := workflowTask.ScheduledTime
 			_, workflowTask, err = mutableState.AddWorkflowTaskStartedEvent(scheduleID, requestID, req.PollRequest)
 			if err != nil {
 				// Unable to add WorkflowTaskStarted event to history
 				return nil, serviceerror.NewInternal("Unable to add WorkflowTaskStarted event to history.")
 			}
+			if handler.config.EnableTaskLatencyBreakdown(namespace) {
+				recordTaskLatencyBreakdown(
+					mutableState.GetExecutionInfo(), req.LatencyBreakdown, scheduleID, scheduledTime, handler.timeSource.Now(),
+				)
+			}
 
 			resp, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, mutableState, workflowTask, req.PollRequest.GetIdentity())
 			if err != nil {
 				return nil, err
 			}
+			resp.SuggestContinueAsNewReasons = handler.continueAsNewReasons(namespace, context, mutableState)
+			workflowTaskTimeout = workflowTask.WorkflowTaskTimeout
 			return updateAction, nil
 		})
 
 	if err != nil {
 		return nil, err
 	}
+	lockTimeSkipping(handler.timeSource, workflowTaskTimeSkippingKey(namespaceID, execution), workflowTaskTimeout)
 	return resp, nil
 }
 
@@ -252,7 +272,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskFailed(
 		RunId:      token.GetRunId(),
 	}
 
-	return handler.historyEngine.updateWorkflowExecution(ctx, namespaceID, workflowExecution, true,
+	err = handler.historyEngine.updateWorkflowExecution(ctx, namespaceID, workflowExecution, true,
 		func(context workflowExecutionContext, mutableState mutableState) error {
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return ErrWorkflowCompleted
@@ -268,6 +288,12 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskFailed(
 				request.GetIdentity(), request.GetBinaryChecksum(), "", "", 0)
 			return err
 		})
+	if err != nil {
+		return err
+	}
+
+	unlockTimeSkipping(handler.timeSource, workflowTaskTimeSkippingKey(namespaceID, workflowExecution))
+	return nil
 }
 
 func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
@@ -298,6 +324,17 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskCompleted(
 	}
 	defer func() { release(retError) }()
 
+	// worker is done with the workflow task, unless it gets the next one started right away
+	var newWorkflowTaskTimeout *time.Duration
+	defer func() {
+		timeSkippingKey := workflowTaskTimeSkippingKey(namespaceID, workflowExecution)
+		if retError == nil && resp.GetStartedResponse() != nil {
+			lockTimeSkipping(handler.timeSource, timeSkippingKey, newWorkflowTaskTimeout)
+		} else {
+			unlockTimeSkipping(handler.timeSource, timeSkippingKey)
+		}
+	}()
+
 Update_History_Loop:
 	for attempt := 1; attempt <= conditionalRetryCount; attempt++ {
 		msBuilder, err := weContext.loadWorkflowExecution()
@@ -393,6 +430,7 @@ Update_History_Loop:
 			workflowSizeChecker := newWorkflowSizeChecker(
 				handler.config.BlobSizeLimitWarn(namespace),
 				handler.config.BlobSizeLimitError(namespace),
+				handler.config.BlobSizeLimitOverrides(namespace),
 				handler.config.HistorySizeLimitWarn(namespace),
 				handler.config.HistorySizeLimitError(namespace),
 				handler.config.HistoryCountLimitWarn(namespace),
@@ -559,12 +597,15 @@ Update_History_Loop:
 		resp = &historyservice.RespondWorkflowTaskCompletedResponse{}
 		if request.GetReturnNewWorkflowTask() && createNewWorkflowTask {
 			workflowTask, _ := msBuilder.GetWorkflowTaskInfo(newWorkflowTaskScheduledID)
+			newWorkflowTaskTimeout = workflowTask.WorkflowTaskTimeout
 			resp.StartedResponse, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, msBuilder, workflowTask, request.GetIdentity())
 			if err != nil {
 				return nil, err
 			}
 			// sticky is always enabled when worker request for new workflow task from RespondWorkflowTaskCompleted
 			resp.StartedResponse.StickyExecutionEnabled = true
+			namespace := namespaceEntry.GetInfo().Name
+			resp.StartedResponse.SuggestContinueAsNewReasons = handler.continueAsNewReasons(namespace, weContext, msBuilder)
 		}
 
 		return resp, nil
@@ -629,6 +670,27 @@ func (handler *workflowTaskHandlerCallbacksImpl) createRecordWorkflowTaskStarted
 	return response, nil
 }
 
+// continueAsNewReasons returns reasons to continue-as-new, if workflow history size or event count
+// exceeds thresholds configured for the namespace.
+func (handler *workflowTaskHandlerCallbacksImpl) continueAsNewReasons(
+	namespace string,
+	weContext workflowExecutionContext,
+	msBuilder mutableState,
+) []string {
+
+	historySize := weContext.getHistorySize()
+	historyCount := msBuilder.GetNextEventID() - 1
+
+	var reasons []string
+	if sizeLimit := handler.config.HistorySizeSuggestContinueAsNew(namespace); sizeLimit > 0 && historySize >= int64(sizeLimit) {
+		reasons = append(reasons, continueAsNewReasonHistorySize)
+	}
+	if countLimit := handler.config.HistoryCountSuggestContinueAsNew(namespace); countLimit > 0 && historyCount >= int64(countLimit) {
+		reasons = append(reasons, continueAsNewReasonHistoryCount)
+	}
+	return reasons
+}
+
 func (handler *workflowTaskHandlerCallbacksImpl) handleBufferedQueries(msBuilder mutableState, queryResults map[string]*querypb.WorkflowQueryResult, createNewWorkflowTask bool, namespaceEntry *cache.NamespaceCacheEntry, workflowTaskHeartbeating bool) {
 	queryRegistry := msBuilder.GetQueryRegistry()
 	if !queryRegistry.hasBufferedQuery() {
@@ -651,8 +713,12 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleBufferedQueries(msBuilder
 		return
 	}
 
-	sizeLimitError := handler.config.BlobSizeLimitError(namespace)
-	sizeLimitWarn := handler.config.BlobSizeLimitWarn(namespace)
+	sizeLimitWarn, sizeLimitError := common.GetBlobSizeLimits(
+		handler.config.BlobSizeLimitOverrides(namespace),
+		"ConsistentQuery",
+		handler.config.BlobSizeLimitWarn(namespace),
+		handler.config.BlobSizeLimitError(namespace),
+	)
 
 	// Complete or fail all queries we have results for
 	for id, result := range queryResults {
@@ -665,7 +731,8 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleBufferedQueries(msBuilder
 			runID,
 			scope,
 			handler.throttledLogger,
-			tag.BlobSizeViolationOperation("ConsistentQuery"),
+			"ConsistentQuery",
+			"Answer",
 		); err != nil {
 			handler.logger.Info("failing query because query result size is too large",
 				tag.WorkflowNamespace(namespace),
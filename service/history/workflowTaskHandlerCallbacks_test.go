@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
 type (
@@ -139,3 +140,41 @@ func (s *WorkflowTaskHandlerCallbackSuite) assertQueryCounts(queryRegistry query
 	s.Len(queryRegistry.getUnblockedIDs(), unblocked)
 	s.Len(queryRegistry.getFailedIDs(), failed)
 }
+
+func TestContinueAsNewReasons(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	config := NewDynamicConfigForTest()
+	config.HistorySizeSuggestContinueAsNew = dynamicconfig.GetIntPropertyFilteredByNamespace(1024)
+	config.HistoryCountSuggestContinueAsNew = dynamicconfig.GetIntPropertyFilteredByNamespace(100)
+	handler := &workflowTaskHandlerCallbacksImpl{config: config}
+
+	testCases := []struct {
+		historySize     int64
+		nextEventID     int64
+		expectedReasons []string
+	}{
+		{historySize: 512, nextEventID: 51},
+		{historySize: 2048, nextEventID: 51, expectedReasons: []string{continueAsNewReasonHistorySize}},
+		{historySize: 512, nextEventID: 101, expectedReasons: []string{continueAsNewReasonHistoryCount}},
+		{historySize: 2048, nextEventID: 201, expectedReasons: []string{continueAsNewReasonHistorySize, continueAsNewReasonHistoryCount}},
+	}
+	for _, tc := range testCases {
+		weContext := NewMockworkflowExecutionContext(controller)
+		weContext.EXPECT().getHistorySize().Return(tc.historySize)
+		msBuilder := NewMockmutableState(controller)
+		msBuilder.EXPECT().GetNextEventID().Return(tc.nextEventID)
+
+		require.Equal(t, tc.expectedReasons, handler.continueAsNewReasons(testNamespace, weContext, msBuilder))
+	}
+
+	// zero thresholds disable the suggestion
+	config.HistorySizeSuggestContinueAsNew = dynamicconfig.GetIntPropertyFilteredByNamespace(0)
+	config.HistoryCountSuggestContinueAsNew = dynamicconfig.GetIntPropertyFilteredByNamespace(0)
+	weContext := NewMockworkflowExecutionContext(controller)
+	weContext.EXPECT().getHistorySize().Return(int64(2048))
+	msBuilder := NewMockmutableState(controller)
+	msBuilder.EXPECT().GetNextEventID().Return(int64(201))
+	require.Empty(t, handler.continueAsNewReasons(testNamespace, weContext, msBuilder))
+}
@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/autoscaling"
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	// autoscalingHint derives recommended worker count of a task queue from dispatch rate and
+	// backlog growth between two consecutive updates, it is exported as per task queue gauges
+	// so that external autoscalers (KEDA, HPA) can scale worker fleets
+	autoscalingHint struct {
+		timeSource clock.TimeSource
+		dispatched int64
+
+		sync.Mutex
+		lastUpdate  time.Time
+		lastBacklog int64
+	}
+
+	autoscalingRecommendation struct {
+		// dispatchRate is the number of tasks dispatched to pollers per second
+		dispatchRate float64
+		// backlogGrowthRate is the change of backlog per second, negative when backlog is draining
+		backlogGrowthRate float64
+		// demandRate is the number of tasks per second workers need to handle to keep up and drain backlog
+		demandRate float64
+		// utilization is the ratio of demand to what current workers are able to handle,
+		// values above 1 mean task queue is under provisioned
+		utilization float64
+		// recommendedWorkers is the number of workers needed to keep up with demand
+		recommendedWorkers int
+	}
+)
+
+func newAutoscalingHint(
+	timeSource clock.TimeSource,
+) *autoscalingHint {
+	return &autoscalingHint{
+		timeSource: timeSource,
+	}
+}
+
+// recordDispatch counts one task dispatched to a poller
+func (h *autoscalingHint) recordDispatch() {
+	atomic.AddInt64(&h.dispatched, 1)
+}
+
+// update computes recommendation from tasks dispatched and backlog change since last update,
+// demand is the dispatch rate plus backlog growth plus the rate needed to drain backlog within drainTarget.
+// Returns false on first update, when there is no previous sample to compare against.
+func (h *autoscalingHint) update(
+	backlog int64,
+	workers int,
+	drainTarget time.Duration,
+) (autoscalingRecommendation, bool) {
+	h.Lock()
+	defer h.Unlock()
+
+	now := h.timeSource.Now()
+	dispatched := atomic.SwapInt64(&h.dispatched, 0)
+	lastUpdate := h.lastUpdate
+	lastBacklog := h.lastBacklog
+	h.lastUpdate = now
+	h.lastBacklog = backlog
+
+	elapsed := now.Sub(lastUpdate).Seconds()
+	if lastUpdate.IsZero() || elapsed <= 0 {
+		return autoscalingRecommendation{}, false
+	}
+
+	result := autoscalingRecommendation{
+		dispatchRate:      float64(dispatched) / elapsed,
+		backlogGrowthRate: float64(backlog-lastBacklog) / elapsed,
+	}
+	result.demandRate = result.dispatchRate + math.Max(result.backlogGrowthRate, 0)
+	if drainTarget > 0 && backlog > 0 {
+		result.demandRate += float64(backlog) / drainTarget.Seconds()
+	}
+	result.utilization, result.recommendedWorkers = autoscaling.RecommendWorkers(result.demandRate, result.dispatchRate, workers)
+	return result, true
+}
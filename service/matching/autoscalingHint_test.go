@@ -0,0 +1,128 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	autoscalingHintSuite struct {
+		suite.Suite
+
+		timeSource *clock.EventTimeSource
+		hint       *autoscalingHint
+	}
+)
+
+func TestAutoscalingHintSuite(t *testing.T) {
+	s := new(autoscalingHintSuite)
+	suite.Run(t, s)
+}
+
+func (s *autoscalingHintSuite) SetupTest() {
+	s.timeSource = clock.NewEventTimeSource()
+	s.timeSource.Update(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.hint = newAutoscalingHint(s.timeSource)
+}
+
+func (s *autoscalingHintSuite) advance(d time.Duration) {
+	s.timeSource.Update(s.timeSource.Now().Add(d))
+}
+
+func (s *autoscalingHintSuite) dispatch(count int) {
+	for i := 0; i < count; i++ {
+		s.hint.recordDispatch()
+	}
+}
+
+func (s *autoscalingHintSuite) TestUpdate_FirstSample() {
+	s.dispatch(10)
+	_, ok := s.hint.update(0, 2, time.Minute)
+	s.False(ok)
+}
+
+func (s *autoscalingHintSuite) TestUpdate_Idle() {
+	s.hint.update(0, 3, time.Minute)
+	s.advance(10 * time.Second)
+
+	result, ok := s.hint.update(0, 3, time.Minute)
+	s.True(ok)
+	s.Equal(autoscalingRecommendation{recommendedWorkers: 3}, result)
+}
+
+func (s *autoscalingHintSuite) TestUpdate_KeepingUp() {
+	s.hint.update(0, 2, time.Minute)
+	s.advance(10 * time.Second)
+	s.dispatch(100)
+
+	result, ok := s.hint.update(0, 2, time.Minute)
+	s.True(ok)
+	s.Equal(10.0, result.dispatchRate)
+	s.Equal(0.0, result.backlogGrowthRate)
+	s.Equal(1.0, result.utilization)
+	s.Equal(2, result.recommendedWorkers)
+}
+
+func (s *autoscalingHintSuite) TestUpdate_BacklogGrowing() {
+	s.hint.update(0, 2, 0)
+	s.advance(10 * time.Second)
+	s.dispatch(100)
+
+	// backlog grows by 10 tasks per second while workers dispatch 10 tasks per second
+	result, ok := s.hint.update(100, 2, 0)
+	s.True(ok)
+	s.Equal(10.0, result.backlogGrowthRate)
+	s.Equal(2.0, result.utilization)
+	s.Equal(4, result.recommendedWorkers)
+}
+
+func (s *autoscalingHintSuite) TestUpdate_BacklogDraining() {
+	s.hint.update(600, 2, time.Minute)
+	s.advance(10 * time.Second)
+	s.dispatch(100)
+
+	// backlog shrinks, but remaining 500 tasks still need to be drained within a minute
+	result, ok := s.hint.update(500, 2, time.Minute)
+	s.True(ok)
+	s.Equal(-10.0, result.backlogGrowthRate)
+	s.InDelta((10+500.0/60)/10, result.utilization, 1e-9)
+	s.Equal(4, result.recommendedWorkers)
+}
+
+func (s *autoscalingHintSuite) TestUpdate_NoDispatch() {
+	s.hint.update(0, 0, time.Minute)
+	s.advance(10 * time.Second)
+
+	result, ok := s.hint.update(10, 0, time.Minute)
+	s.True(ok)
+	s.Equal(0.0, result.dispatchRate)
+	s.Equal(1, result.recommendedWorkers)
+}
@@ -58,12 +58,21 @@ type (
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		MaxTaskDeleteBatchSize     dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		TaskPointDelete            dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
 
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		MaxTaskBatchSize                dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 
 		ThrottledLogRPS dynamicconfig.IntPropertyFn
+
+		// poll shedding while history is degraded
+		HistoryDegradedLatencyThreshold dynamicconfig.DurationPropertyFn
+		DegradedLongPollExpiration      dynamicconfig.DurationPropertyFn
+
+		// worker autoscaling hints
+		AutoscalingHintInterval       dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		AutoscalingBacklogDrainTarget dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 	}
 
 	forwarderConfig struct {
@@ -85,11 +94,15 @@ type (
 		MaxTaskqueueIdleTime       func() time.Duration
 		MinTaskThrottlingBurstSize func() int
 		MaxTaskDeleteBatchSize     func() int
+		TaskPointDelete            func() bool
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold func() int
 		MaxTaskBatchSize                func() int
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
+		// worker autoscaling hints
+		AutoscalingHintInterval       func() time.Duration
+		AutoscalingBacklogDrainTarget func() time.Duration
 	}
 )
 
@@ -108,6 +121,7 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
 		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
 		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
+		TaskPointDelete:                 dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingTaskPointDelete, false),
 		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
 		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
 		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
@@ -118,6 +132,10 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
 		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
 		ShutdownDrainDuration:           dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0),
+		HistoryDegradedLatencyThreshold: dc.GetDurationProperty(dynamicconfig.MatchingHistoryDegradedLatencyThreshold, 0),
+		DegradedLongPollExpiration:      dc.GetDurationProperty(dynamicconfig.MatchingDegradedLongPollExpiration, 5*time.Second),
+		AutoscalingHintInterval:         dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingAutoscalingHintInterval, 10*time.Second),
+		AutoscalingBacklogDrainTarget:   dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingAutoscalingBacklogDrainTarget, time.Minute),
 	}
 }
 
@@ -156,6 +174,9 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespaceCache cache.Na
 		MaxTaskDeleteBatchSize: func() int {
 			return config.MaxTaskDeleteBatchSize(namespace, taskQueueName, taskType)
 		},
+		TaskPointDelete: func() bool {
+			return config.TaskPointDelete(namespace, taskQueueName, taskType)
+		},
 		OutstandingTaskAppendsThreshold: func() int {
 			return config.OutstandingTaskAppendsThreshold(namespace, taskQueueName, taskType)
 		},
@@ -168,6 +189,12 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespaceCache cache.Na
 		NumReadPartitions: func() int {
 			return common.MaxInt(1, config.NumTaskqueueReadPartitions(namespace, taskQueueName, taskType))
 		},
+		AutoscalingHintInterval: func() time.Duration {
+			return config.AutoscalingHintInterval(namespace, taskQueueName, taskType)
+		},
+		AutoscalingBacklogDrainTarget: func() time.Duration {
+			return config.AutoscalingBacklogDrainTarget(namespace, taskQueueName, taskType)
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(namespace, taskQueueName, taskType)
@@ -32,6 +32,7 @@ import (
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/metrics"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -117,7 +118,7 @@ func (reqCtx *handlerContext) handleErr(err error) error {
 	case *serviceerror.QueryFailed:
 		scope.IncCounter(metrics.ServiceErrQueryFailedPerTaskQueueCounter)
 		return err
-	case *serviceerror.ResourceExhausted:
+	case *serviceerror.ResourceExhausted, *serviceerrors.HistoryDegraded:
 		scope.IncCounter(metrics.ServiceErrResourceExhaustedPerTaskQueueCounter)
 		return err
 	case *serviceerror.NamespaceNotActive:
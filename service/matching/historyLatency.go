@@ -0,0 +1,94 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	// historyLatencyTracker keeps moving average of latency of history calls made to start
+	// dispatched tasks, which is used to detect that history is degraded and long polls
+	// should not be parked for the whole long poll interval
+	historyLatencyTracker struct {
+		timeSource clock.TimeSource
+
+		sync.Mutex
+		average    time.Duration
+		lastSample time.Time
+	}
+)
+
+const (
+	// historyLatencyDecay is the weight of the latest sample in moving average
+	historyLatencyDecay = 0.2
+	// historyLatencySampleTTL is the time after which moving average is considered stale,
+	// so history is not considered degraded forever when no tasks are dispatched
+	historyLatencySampleTTL = 30 * time.Second
+)
+
+func newHistoryLatencyTracker(
+	timeSource clock.TimeSource,
+) *historyLatencyTracker {
+	return &historyLatencyTracker{
+		timeSource: timeSource,
+	}
+}
+
+// record adds latency of one history call to moving average
+func (t *historyLatencyTracker) record(
+	latency time.Duration,
+) {
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.timeSource.Now()
+	if t.lastSample.IsZero() || now.Sub(t.lastSample) > historyLatencySampleTTL {
+		t.average = latency
+	} else {
+		t.average = time.Duration(historyLatencyDecay*float64(latency) + (1-historyLatencyDecay)*float64(t.average))
+	}
+	t.lastSample = now
+}
+
+// isDegraded returns true if moving average of history latency exceeds threshold, threshold <= 0 disables detection
+func (t *historyLatencyTracker) isDegraded(
+	threshold time.Duration,
+) bool {
+	if threshold <= 0 {
+		return false
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.lastSample.IsZero() || t.timeSource.Now().Sub(t.lastSample) > historyLatencySampleTTL {
+		return false
+	}
+	return t.average > threshold
+}
@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	historyLatencyTrackerSuite struct {
+		suite.Suite
+
+		timeSource *clock.EventTimeSource
+		tracker    *historyLatencyTracker
+	}
+)
+
+func TestHistoryLatencyTrackerSuite(t *testing.T) {
+	s := new(historyLatencyTrackerSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyLatencyTrackerSuite) SetupTest() {
+	s.timeSource = clock.NewEventTimeSource()
+	s.timeSource.Update(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.tracker = newHistoryLatencyTracker(s.timeSource)
+}
+
+func (s *historyLatencyTrackerSuite) TestIsDegraded_NoSamples() {
+	s.False(s.tracker.isDegraded(time.Millisecond))
+}
+
+func (s *historyLatencyTrackerSuite) TestIsDegraded_Disabled() {
+	s.tracker.record(time.Second)
+	s.False(s.tracker.isDegraded(0))
+}
+
+func (s *historyLatencyTrackerSuite) TestIsDegraded_MovingAverage() {
+	s.tracker.record(time.Second)
+	s.True(s.tracker.isDegraded(500 * time.Millisecond))
+
+	// single fast call doesn't recover average
+	s.tracker.record(0)
+	s.True(s.tracker.isDegraded(500 * time.Millisecond))
+
+	for i := 0; i < 10; i++ {
+		s.tracker.record(0)
+	}
+	s.False(s.tracker.isDegraded(500 * time.Millisecond))
+}
+
+func (s *historyLatencyTrackerSuite) TestIsDegraded_StaleSamples() {
+	s.tracker.record(time.Second)
+	s.timeSource.Update(s.timeSource.Now().Add(historyLatencySampleTTL + time.Second))
+	s.False(s.tracker.isDegraded(500 * time.Millisecond))
+
+	// stale average is replaced by the next sample
+	s.tracker.record(100 * time.Millisecond)
+	s.False(s.tracker.isDegraded(500 * time.Millisecond))
+}
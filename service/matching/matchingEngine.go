@@ -48,6 +48,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -86,6 +87,7 @@ type (
 		lockableQueryTaskMap lockableQueryTaskMap
 		namespaceCache       cache.NamespaceCache
 		keyResolver          membership.ServiceResolver
+		historyLatency       *historyLatencyTracker
 	}
 )
 
@@ -130,6 +132,7 @@ func NewEngine(taskManager persistence.TaskManager,
 		lockableQueryTaskMap: lockableQueryTaskMap{queryTaskMap: make(map[string]chan *queryResult)},
 		namespaceCache:       namespaceCache,
 		keyResolver:          resolver,
+		historyLatency:       newHistoryLatencyTracker(clock.NewRealTimeSource()),
 	}
 }
 
@@ -394,7 +397,7 @@ pollLoop:
 			return e.createPollWorkflowTaskQueueResponse(task, resp, hCtx.scope), nil
 		}
 
-		resp, err := e.recordWorkflowTaskStarted(hCtx.Context, request, task)
+		resp, err := e.recordWorkflowTaskStarted(hCtx.Context, request, task, req.GetForwardedSource())
 		if err != nil {
 			switch err.(type) {
 			case *serviceerror.NotFound, *serviceerrors.TaskAlreadyStarted:
@@ -461,7 +464,7 @@ pollLoop:
 			return task.pollActivityTaskQueueResponse(), nil
 		}
 
-		resp, err := e.recordActivityTaskStarted(hCtx.Context, request, task)
+		resp, err := e.recordActivityTaskStarted(hCtx.Context, request, task, req.GetForwardedSource())
 		if err != nil {
 			switch err.(type) {
 			case *serviceerror.NotFound, *serviceerrors.TaskAlreadyStarted:
@@ -842,6 +845,7 @@ func (e *matchingEngineImpl) recordWorkflowTaskStarted(
 	ctx context.Context,
 	pollReq *workflowservice.PollWorkflowTaskQueueRequest,
 	task *internalTask,
+	pollForwardedFrom string,
 ) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
 	request := &historyservice.RecordWorkflowTaskStartedRequest{
 		NamespaceId:       task.event.Data.GetNamespaceId(),
@@ -850,11 +854,14 @@ func (e *matchingEngineImpl) recordWorkflowTaskStarted(
 		TaskId:            task.event.GetTaskId(),
 		RequestId:         uuid.New(),
 		PollRequest:       pollReq,
+		LatencyBreakdown:  task.latencyBreakdown(pollForwardedFrom),
 	}
 	var resp *historyservice.RecordWorkflowTaskStartedResponse
 	op := func() error {
 		var err error
+		startTime := time.Now().UTC()
 		resp, err = e.historyService.RecordWorkflowTaskStarted(ctx, request)
+		e.historyLatency.record(time.Now().UTC().Sub(startTime))
 		return err
 	}
 	err := backoff.Retry(op, historyServiceOperationRetryPolicy, func(err error) bool {
@@ -871,6 +878,7 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 	ctx context.Context,
 	pollReq *workflowservice.PollActivityTaskQueueRequest,
 	task *internalTask,
+	pollForwardedFrom string,
 ) (*historyservice.RecordActivityTaskStartedResponse, error) {
 	request := &historyservice.RecordActivityTaskStartedRequest{
 		NamespaceId:       task.event.Data.GetNamespaceId(),
@@ -879,11 +887,14 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 		TaskId:            task.event.GetTaskId(),
 		RequestId:         uuid.New(),
 		PollRequest:       pollReq,
+		LatencyBreakdown:  task.latencyBreakdown(pollForwardedFrom),
 	}
 	var resp *historyservice.RecordActivityTaskStartedResponse
 	op := func() error {
 		var err error
+		startTime := time.Now().UTC()
 		resp, err = e.historyService.RecordActivityTaskStarted(ctx, request)
+		e.historyLatency.record(time.Now().UTC().Sub(startTime))
 		return err
 	}
 	err := backoff.Retry(op, historyServiceOperationRetryPolicy, func(err error) bool {
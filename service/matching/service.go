@@ -29,10 +29,10 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
-	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/healthcheck"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence"
@@ -51,7 +51,8 @@ type Service struct {
 	config  *Config
 	params  *resource.BootstrapParams
 
-	server *grpc.Server
+	server        *grpc.Server
+	healthChecker *healthcheck.Checker
 }
 
 // NewService builds a new matching service
@@ -104,12 +105,28 @@ func (s *Service) Start() {
 	if err != nil {
 		logger.Fatal("creating grpc server options failed", tag.Error(err))
 	}
+	interceptors := []grpc.UnaryServerInterceptor{rpc.ServiceErrorInterceptor}
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if provider, ok := s.params.RPCFactory.(common.ServerInterceptorProvider); ok {
+		unary, stream := provider.GetInternodeServerInterceptors()
+		interceptors = append(interceptors, unary...)
+		streamInterceptors = stream
+	}
 	opts = append(
 		opts,
-		grpc.ChainUnaryInterceptor(rpc.ServiceErrorInterceptor))
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...))
 	s.server = grpc.NewServer(opts...)
 	matchingservice.RegisterMatchingServiceServer(s.server, s.handler)
-	healthpb.RegisterHealthServer(s.server, s.handler)
+	s.healthChecker = healthcheck.NewChecker(
+		common.MatchingServiceName,
+		s.GetMembershipMonitor(),
+		s.GetClusterMetadataManager(),
+		logger,
+		serviceName,
+	)
+	s.healthChecker.Register(s.server)
+	s.healthChecker.Start()
 
 	listener := s.GetGRPCListener()
 	logger.Info("Starting to serve on matching listener")
@@ -124,14 +141,19 @@ func (s *Service) Stop() {
 		return
 	}
 
-	// remove self from membership ring and wait for traffic to drain
+	// fail rpc health check, remove self from membership ring and wait for traffic to drain
+	s.GetLogger().Info("ShutdownHandler: Updating rpc health status to NotServing")
+	s.healthChecker.Stop()
 	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
 	s.GetMembershipMonitor().EvictSelf()
 	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
 	time.Sleep(s.config.ShutdownDrainDuration())
 
-	// TODO: Change this to GracefulStop when integration tests are refactored.
-	s.server.Stop()
+	if drainer, ok := s.params.RPCFactory.(common.GRPCServerDrainer); ok {
+		drainer.DrainGRPCServer(s.server)
+	} else {
+		s.server.Stop()
+	}
 
 	s.handler.Stop()
 	s.Resource.Stop()
@@ -25,11 +25,14 @@
 package matching
 
 import (
+	"time"
+
 	commonpb "go.temporal.io/api/common/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
 )
 
 type (
@@ -133,6 +136,18 @@ func (task *internalTask) workflowExecution() *commonpb.WorkflowExecution {
 	return &commonpb.WorkflowExecution{}
 }
 
+// latencyBreakdown returns the part of latency breakdown of an activity or workflow task measured by this partition,
+// pollForwardedFrom is the child partition the poll which matched the task was forwarded from
+func (task *internalTask) latencyBreakdown(pollForwardedFrom string) *persistencespb.TaskLatencyBreakdown {
+	queueLatency := time.Now().UTC().Sub(timestamp.TimeValue(task.event.Data.GetCreateTime()))
+	return &persistencespb.TaskLatencyBreakdown{
+		QueueLatency:      &queueLatency,
+		SyncMatch:         task.responseC != nil,
+		ForwardedFrom:     task.forwardedFrom,
+		PollForwardedFrom: pollForwardedFrom,
+	}
+}
+
 // pollWorkflowTaskQueueResponse returns the poll response for a workflow task that is
 // already marked as started. This method should only be called when isStarted() is true
 func (task *internalTask) pollWorkflowTaskQueueResponse() *matchingservice.PollWorkflowTaskQueueResponse {
@@ -25,8 +25,11 @@
 package matching
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
+
+	"go.temporal.io/server/common/metrics"
 )
 
 type taskGC struct {
@@ -35,6 +38,14 @@ type taskGC struct {
 	ackLevel       int64
 	lastDeleteTime time.Time
 	config         *taskQueueConfig
+	scope          func() metrics.Scope
+	// rangeDeletes is the number of range deletes since the task queue was loaded
+	rangeDeletes int64
+
+	sync.Mutex
+	pointDelete bool
+	// rangeDeleteLevel caps range deletes in point delete mode, completed tasks above it were point deleted
+	rangeDeleteLevel int64
 }
 
 var maxTimeBetweenTaskDeletes = time.Second
@@ -50,20 +61,68 @@ var maxTimeBetweenTaskDeletes = time.Second
 //
 // Finally, the Run() method is safe to be called from multiple threads. The underlying
 // implementation will make sure only one caller executes Run() and others simply bail out
-func newTaskGC(db *taskQueueDB, config *taskQueueConfig) *taskGC {
-	return &taskGC{db: db, config: config}
+//
+// In point delete mode, every task is deleted by DeleteTask once it's completed and Run() only
+// range deletes the tasks which may have been completed before switching to point deletes, or
+// whose point delete failed
+func newTaskGC(db *taskQueueDB, config *taskQueueConfig, scope func() metrics.Scope) *taskGC {
+	return &taskGC{db: db, config: config, scope: scope}
 }
 
 // Run deletes a batch of completed tasks, if its possible to do so
 // Only attempts deletion if size or time thresholds are met
 func (tgc *taskGC) Run(ackLevel int64) {
-	tgc.tryDeleteNextBatch(ackLevel, false)
+	tgc.tryDeleteNextBatch(tgc.capRangeDelete(ackLevel), false)
 }
 
 // RunNow deletes a batch of completed tasks if its possible to do so
 // This method attempts deletions without waiting for size/time threshold to be met
 func (tgc *taskGC) RunNow(ackLevel int64) {
-	tgc.tryDeleteNextBatch(ackLevel, true)
+	tgc.tryDeleteNextBatch(tgc.capRangeDelete(ackLevel), true)
+}
+
+// DeleteTask deletes a completed or expired task right away in point delete mode, it's no-op otherwise.
+// Tasks up to readLevel are left to range deletes when switching to point deletes, as some of them may
+// have been completed in range delete mode.
+func (tgc *taskGC) DeleteTask(taskID int64, readLevel int64) {
+	if !tgc.switchDeleteMode(readLevel) {
+		return
+	}
+	if err := tgc.db.CompleteTask(taskID); err != nil {
+		tgc.Lock()
+		if tgc.rangeDeleteLevel < taskID {
+			tgc.rangeDeleteLevel = taskID
+		}
+		tgc.Unlock()
+		return
+	}
+	tgc.scope().IncCounter(metrics.TaskPointDeletesPerTaskQueueCounter)
+}
+
+// rangeDeleteStats returns the number of range deletes since the task queue was loaded
+// and the ack level they reached
+func (tgc *taskGC) rangeDeleteStats() (int64, int64) {
+	return atomic.LoadInt64(&tgc.rangeDeletes), atomic.LoadInt64(&tgc.ackLevel)
+}
+
+func (tgc *taskGC) switchDeleteMode(readLevel int64) bool {
+	pointDelete := tgc.config.TaskPointDelete()
+	tgc.Lock()
+	defer tgc.Unlock()
+	if pointDelete && !tgc.pointDelete {
+		tgc.rangeDeleteLevel = readLevel
+	}
+	tgc.pointDelete = pointDelete
+	return pointDelete
+}
+
+func (tgc *taskGC) capRangeDelete(ackLevel int64) int64 {
+	tgc.Lock()
+	defer tgc.Unlock()
+	if tgc.pointDelete && tgc.rangeDeleteLevel < ackLevel {
+		return tgc.rangeDeleteLevel
+	}
+	return ackLevel
 }
 
 func (tgc *taskGC) tryDeleteNextBatch(ackLevel int64, ignoreTimeCond bool) {
@@ -77,11 +136,13 @@ func (tgc *taskGC) tryDeleteNextBatch(ackLevel int64, ignoreTimeCond bool) {
 	}
 	tgc.lastDeleteTime = time.Now().UTC()
 	n, err := tgc.db.CompleteTasksLessThan(ackLevel, batchSize)
-	switch {
-	case err != nil:
+	if err != nil {
 		return
-	case n < batchSize:
-		tgc.ackLevel = ackLevel
+	}
+	atomic.AddInt64(&tgc.rangeDeletes, 1)
+	tgc.scope().IncCounter(metrics.TaskRangeDeletesPerTaskQueueCounter)
+	if n < batchSize {
+		atomic.StoreInt64(&tgc.ackLevel, ackLevel)
 	}
 }
 
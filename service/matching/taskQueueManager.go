@@ -41,13 +41,16 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
 const (
@@ -117,6 +120,12 @@ type (
 		// prevent tasks being dispatched to zombie pollers.
 		outstandingPollsLock sync.Mutex
 		outstandingPollsMap  map[string]context.CancelFunc
+		// parkedPollers is the number of polls waiting for a task on this taskqueue
+		parkedPollers int32
+		// autoscalingHint tracks dispatch rate and backlog growth to recommend worker count
+		autoscalingHint *autoscalingHint
+		// lastAutoscalingHint is the last recommendation, returned by DescribeTaskQueue
+		lastAutoscalingHint atomic.Value
 
 		shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
@@ -158,12 +167,13 @@ func newTaskQueueManager(
 			tag.WorkflowTaskQueueType(taskQueue.taskType)),
 		db:                  db,
 		taskAckManager:      newAckManager(e.logger),
-		taskGC:              newTaskGC(db, taskQueueConfig),
 		config:              taskQueueConfig,
 		pollerHistory:       newPollerHistory(),
 		outstandingPollsMap: make(map[string]context.CancelFunc),
+		autoscalingHint:     newAutoscalingHint(clock.NewRealTimeSource()),
 	}
 
+	tlMgr.taskGC = newTaskGC(db, taskQueueConfig, tlMgr.metricScope)
 	tlMgr.namespaceValue.Store("")
 	if tlMgr.metricScope() == nil { // namespace name lookup failed
 		// metric scope to use when namespace lookup fails
@@ -299,7 +309,14 @@ func (c *taskQueueManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	// reached, instead of emptyTask, context timeout error is returned to the frontend by the rpc stack,
 	// which counts against our SLO. By shortening the timeout by a very small amount, the emptyTask can be
 	// returned to the handler before a context timeout error is generated.
-	childCtx, cancel := c.newChildContext(ctx, c.config.LongPollExpirationInterval(), returnEmptyTaskTimeBudget)
+	// While history is degraded, tasks handed out to pollers are likely to time out while being started,
+	// so instead of parking pollers for the whole long poll interval they are rejected sooner with a retry hint.
+	longPollExpiration := c.config.LongPollExpirationInterval()
+	historyDegraded := c.engine.historyLatency.isDegraded(c.engine.config.HistoryDegradedLatencyThreshold())
+	if historyDegraded {
+		longPollExpiration = common.MinDuration(longPollExpiration, c.engine.config.DegradedLongPollExpiration())
+	}
+	childCtx, cancel := c.newChildContext(ctx, longPollExpiration, returnEmptyTaskTimeBudget)
 	defer cancel()
 
 	pollerID, ok := ctx.Value(pollerIDKey).(string)
@@ -333,11 +350,58 @@ func (c *taskQueueManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	// value. Last poller wins if different pollers provide different values
 	c.matcher.UpdateRatelimit(maxDispatchPerSecond)
 
+	c.updateParkedPollers(1)
+	defer c.updateParkedPollers(-1)
+
+	var task *internalTask
 	if namespaceEntry.GetNamespaceNotActiveErr() != nil {
-		return c.matcher.PollForQuery(childCtx)
+		task, err = c.matcher.PollForQuery(childCtx)
+	} else {
+		task, err = c.matcher.Poll(childCtx)
+	}
+	if err == ErrNoTasks && historyDegraded && ctx.Err() == nil {
+		c.metricScope().IncCounter(metrics.PollShedPerTaskQueueCounter)
+		return nil, serviceerrors.NewHistoryDegraded(longPollExpiration)
+	}
+	if err == nil && !task.isQuery() {
+		c.autoscalingHint.recordDispatch()
+	}
+	return task, err
+}
+
+func (c *taskQueueManagerImpl) updateParkedPollers(delta int32) {
+	parkedPollers := atomic.AddInt32(&c.parkedPollers, delta)
+	c.metricScope().UpdateGauge(metrics.ParkedPollersPerTaskQueueGauge, float64(parkedPollers))
+}
+
+// updateAutoscalingHint recomputes recommended worker count of this taskqueue and exports it as gauges,
+// pollers which polled in last few minutes are considered as current workers
+func (c *taskQueueManagerImpl) updateAutoscalingHint() {
+	backlog := c.taskAckManager.getBacklogCountHint()
+	workers := c.pollerHistory.history.Size()
+	recommendation, ok := c.autoscalingHint.update(
+		backlog,
+		workers,
+		c.config.AutoscalingBacklogDrainTarget(),
+	)
+	if !ok {
+		return
 	}
+	c.lastAutoscalingHint.Store(&taskqueuespb.TaskQueueAutoscalingHint{
+		DispatchRate:       recommendation.dispatchRate,
+		BacklogGrowthRate:  recommendation.backlogGrowthRate,
+		DemandRate:         recommendation.demandRate,
+		BacklogCountHint:   backlog,
+		CurrentWorkers:     int32(workers),
+		Utilization:        recommendation.utilization,
+		RecommendedWorkers: int32(recommendation.recommendedWorkers),
+	})
 
-	return c.matcher.Poll(childCtx)
+	scope := c.metricScope()
+	scope.UpdateGauge(metrics.RecommendedWorkersPerTaskQueueGauge, float64(recommendation.recommendedWorkers))
+	scope.UpdateGauge(metrics.WorkerUtilizationPerTaskQueueGauge, recommendation.utilization)
+	scope.UpdateGauge(metrics.DispatchRatePerTaskQueueGauge, recommendation.dispatchRate)
+	scope.UpdateGauge(metrics.BacklogGrowthRatePerTaskQueueGauge, recommendation.backlogGrowthRate)
 }
 
 // GetAllPollerInfo returns all pollers that polled from this taskqueue in last few minutes
@@ -360,6 +424,9 @@ func (c *taskQueueManagerImpl) CancelPoller(pollerID string) {
 // (readLevel, ackLevel, backlogCountHint and taskIDBlock).
 func (c *taskQueueManagerImpl) DescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse {
 	response := &matchingservice.DescribeTaskQueueResponse{Pollers: c.GetAllPollerInfo()}
+	if hint, ok := c.lastAutoscalingHint.Load().(*taskqueuespb.TaskQueueAutoscalingHint); ok {
+		response.AutoscalingHint = hint
+	}
 	if !includeTaskQueueStatus {
 		return response
 	}
@@ -430,6 +497,7 @@ func (c *taskQueueManagerImpl) completeTask(task *persistencespb.AllocatedTaskIn
 	}
 
 	ackLevel := c.taskAckManager.completeTask(task.GetTaskId())
+	c.taskGC.DeleteTask(task.GetTaskId(), c.taskAckManager.getReadLevel())
 	c.taskGC.Run(ackLevel)
 }
 
@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
 func TestDeliverBufferTasks(t *testing.T) {
@@ -146,6 +147,49 @@ func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	require.Equal(t, int64(14), tlm.taskAckManager.getReadLevel())
 }
 
+func TestTaskGCPointDelete(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskQueueManager(controller)
+	_, err := tlm.db.RenewLease()
+	require.NoError(t, err)
+	var tasks []*persistencespb.AllocatedTaskInfo
+	for i := int64(1); i <= 4; i++ {
+		tasks = append(tasks, &persistencespb.AllocatedTaskInfo{Data: &persistencespb.TaskInfo{}, TaskId: i})
+	}
+	_, err = tlm.db.CreateTasks(tasks)
+	require.NoError(t, err)
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	require.Equal(t, 4, tm.getTaskCount(tlm.taskQueueID))
+
+	pointDelete := false
+	tlm.config.TaskPointDelete = func() bool { return pointDelete }
+
+	// range delete mode leaves completed tasks to Run
+	tlm.taskGC.DeleteTask(1, 1)
+	require.Equal(t, 4, tm.getTaskCount(tlm.taskQueueID))
+
+	// task 1 was completed before switching, so it's left to range deletes
+	pointDelete = true
+	tlm.taskGC.DeleteTask(2, 2)
+	tlm.taskGC.DeleteTask(3, 3)
+	require.Equal(t, 2, tm.getTaskCount(tlm.taskQueueID))
+
+	// range deletes stop at the read level seen when switching to point deletes
+	tlm.taskGC.RunNow(3)
+	require.Equal(t, 1, tm.getTaskCount(tlm.taskQueueID))
+	rangeDeletes, gcLevel := tlm.taskGC.rangeDeleteStats()
+	require.Equal(t, int64(1), rangeDeletes)
+	require.Equal(t, int64(2), gcLevel)
+
+	pointDelete = false
+	tlm.taskGC.DeleteTask(4, 4)
+	require.Equal(t, 1, tm.getTaskCount(tlm.taskQueueID))
+	tlm.taskGC.RunNow(4)
+	require.Equal(t, 0, tm.getTaskCount(tlm.taskQueueID))
+}
+
 func createTestTaskQueueManager(controller *gomock.Controller) *taskQueueManagerImpl {
 	return createTestTaskQueueManagerWithConfig(controller, defaultTestConfig())
 }
@@ -283,3 +327,31 @@ func TestCheckIdleTaskQueue(t *testing.T) {
 	tlm.Stop()
 	require.Equal(t, int32(1), tlm.stopped)
 }
+
+func TestGetTask_HistoryDegraded(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := NewConfig(dynamicconfig.NewNopCollection())
+	cfg.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(time.Minute)
+	cfg.HistoryDegradedLatencyThreshold = dynamicconfig.GetDurationPropertyFn(100 * time.Millisecond)
+	cfg.DegradedLongPollExpiration = dynamicconfig.GetDurationPropertyFn(10 * time.Millisecond)
+
+	tlm := createTestTaskQueueManagerWithConfig(controller, cfg)
+	tlm.engine.historyLatency.record(time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := tlm.GetTask(ctx, nil)
+	require.IsType(t, &serviceerrors.HistoryDegraded{}, err)
+	require.Equal(t, 10*time.Millisecond, err.(*serviceerrors.HistoryDegraded).RetryAfter)
+	require.Equal(t, int32(0), atomic.LoadInt32(&tlm.parkedPollers))
+
+	// history recovered, poll is parked for the whole long poll interval
+	tlm.engine.historyLatency.record(0)
+	tlm.engine.config.HistoryDegradedLatencyThreshold = dynamicconfig.GetDurationPropertyFn(time.Second)
+	ctx, cancel = context.WithTimeout(context.Background(), 1100*time.Millisecond)
+	defer cancel()
+	_, err = tlm.GetTask(ctx, nil)
+	require.Equal(t, ErrNoTasks, err)
+}
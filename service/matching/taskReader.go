@@ -35,9 +35,15 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
 )
 
+const (
+	// tombstoneLogInterval limits how often tombstone heavy reads are logged for a task queue
+	tombstoneLogInterval = time.Minute
+)
+
 type (
 	taskReader struct {
 		taskBuffer chan *persistencespb.AllocatedTaskInfo // tasks loaded from persistence
@@ -53,6 +59,8 @@ type (
 		// separate shutdownC needed for dispatchTasks go routine to allow
 		// getTasksPump to be stopped without stopping dispatchTasks in unit tests
 		dispatcherShutdownC chan struct{}
+		// only accessed by getTasksPump
+		lastTombstoneLog time.Time
 	}
 )
 
@@ -124,6 +132,7 @@ func (tr *taskReader) getTasksPump() {
 
 	updateAckTimer := time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
 	checkIdleTaskQueueTimer := time.NewTimer(tr.tlMgr.config.IdleTaskqueueCheckInterval())
+	autoscalingHintTimer := time.NewTimer(tr.tlMgr.config.AutoscalingHintInterval())
 	lastTimeWriteTask := time.Time{}
 getTasksPumpLoop:
 	for {
@@ -180,11 +189,17 @@ getTasksPumpLoop:
 				}
 				checkIdleTaskQueueTimer = time.NewTimer(tr.tlMgr.config.IdleTaskqueueCheckInterval())
 			}
+		case <-autoscalingHintTimer.C:
+			{
+				tr.tlMgr.updateAutoscalingHint()
+				autoscalingHintTimer = time.NewTimer(tr.tlMgr.config.AutoscalingHintInterval())
+			}
 		}
 	}
 
 	updateAckTimer.Stop()
 	checkIdleTaskQueueTimer.Stop()
+	autoscalingHintTimer.Stop()
 }
 
 func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64) ([]*persistencespb.AllocatedTaskInfo, error) {
@@ -194,7 +209,35 @@ func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64)
 	if err != nil {
 		return nil, err
 	}
-	return response.(*persistence.GetTasksResponse).Tasks, err
+	resp := response.(*persistence.GetTasksResponse)
+	tr.reportTombstones(resp.TombstoneCount, readLevel, maxReadLevel)
+	return resp.Tasks, err
+}
+
+// reportTombstones surfaces the tombstones the persistence layer scanned to read a batch of tasks,
+// which are left behind by range deletes of completed tasks
+func (tr *taskReader) reportTombstones(tombstones int, readLevel int64, maxReadLevel int64) {
+	if tombstones == 0 {
+		return
+	}
+	tr.scope().AddCounter(metrics.TaskTombstonesPerTaskQueueCounter, int64(tombstones))
+	now := time.Now()
+	if now.Sub(tr.lastTombstoneLog) < tombstoneLogInterval {
+		return
+	}
+	tr.lastTombstoneLog = now
+	rangeDeletes, gcLevel := tr.tlMgr.taskGC.rangeDeleteStats()
+	pointDelete := tr.tlMgr.config.TaskPointDelete()
+	tr.logger().Warn("Task queue read scanned tombstones",
+		tag.WorkflowNamespaceID(tr.tlMgr.taskQueueID.namespaceID),
+		tag.Counter(tombstones),
+		tag.ReadLevel(readLevel),
+		tag.MaxLevel(maxReadLevel),
+		tag.AckLevel(gcLevel),
+		tag.Number(rangeDeletes),
+		tag.Bool(pointDelete),
+		tag.DetailInfo("enable "+dynamicconfig.MatchingTaskPointDelete.String()+" for this task queue if tombstones keep growing"),
+	)
 }
 
 // Returns a batch of tasks from persistence starting form current read level.
@@ -241,6 +284,7 @@ func (tr *taskReader) addTasksToBuffer(tasks []*persistencespb.AllocatedTaskInfo
 			// Also increment readLevel for expired tasks otherwise it could result in
 			// looping over the same tasks if all tasks read in the batch are expired
 			tr.tlMgr.taskAckManager.setReadLevel(t.GetTaskId())
+			tr.tlMgr.taskGC.DeleteTask(t.GetTaskId(), tr.tlMgr.taskAckManager.getReadLevel())
 			continue
 		}
 		if !tr.addSingleTaskToBuffer(t, lastWriteTime, idleTimer) {
@@ -52,6 +52,9 @@ type (
 		ArchiveRequest       *ArchiveRequest
 		CallerService        string
 		AttemptArchiveInline bool
+		// InlineOnly returns the error of inline archival instead of falling back to the archival workflow,
+		// it is ignored when AttemptArchiveInline is not set
+		InlineOnly bool
 	}
 
 	// ClientResponse is the archive response returned from the archiver client
@@ -173,13 +176,18 @@ func (c *client) Archive(ctx context.Context, request *ClientRequest) (*ClientRe
 		}
 
 		targets := []ArchivalTarget{}
+		var inlineErr error
 		for i, target := range request.ArchiveRequest.Targets {
-			if <-results[i] != nil {
+			if err := <-results[i]; err != nil {
 				targets = append(targets, target)
+				inlineErr = err
 			} else if target == ArchiveTargetHistory {
 				resp.HistoryArchivedInline = true
 			}
 		}
+		if request.InlineOnly && inlineErr != nil {
+			return nil, inlineErr
+		}
 		request.ArchiveRequest.Targets = targets
 	}
 	if len(request.ArchiveRequest.Targets) != 0 {
@@ -215,6 +215,26 @@ func (s *clientSuite) TestArchiveHistoryInlineFail_SendSignalFail() {
 	s.Nil(resp)
 }
 
+func (s *clientSuite) TestArchiveHistoryInlineOnlyFail() {
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
+	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some random error")).Once()
+	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryRequestCount).Once()
+	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryInlineArchiveAttemptCount).Once()
+	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryInlineArchiveFailureCount).Once()
+
+	resp, err := s.client.Archive(context.Background(), &ClientRequest{
+		ArchiveRequest: &ArchiveRequest{
+			HistoryURI: "test:///history/archival",
+			Targets:    []ArchivalTarget{ArchiveTargetHistory},
+		},
+		AttemptArchiveInline: true,
+		InlineOnly:           true,
+	})
+	s.Error(err)
+	s.Nil(resp)
+	s.temporalClient.AssertNotCalled(s.T(), "SignalWithStartWorkflow")
+}
+
 func (s *clientSuite) TestArchiveInline_HistoryFail_VisibilitySuccess() {
 	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
 	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
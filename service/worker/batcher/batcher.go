@@ -68,6 +68,7 @@ type (
 		clientBean    client.Bean
 		metricsClient metrics.Client
 		logger        log.Logger
+		worker        worker.Worker
 	}
 )
 
@@ -93,6 +94,20 @@ func (s *Batcher) Start() error {
 	batchWorker := worker.New(s.svcClient, BatcherTaskQueueName, workerOpts)
 	batchWorker.RegisterWorkflowWithOptions(BatchWorkflow, workflow.RegisterOptions{Name: BatchWFTypeName})
 	batchWorker.RegisterActivityWithOptions(BatchActivity, activity.RegisterOptions{Name: batchActivityName})
+	batchWorker.RegisterWorkflowWithOptions(ReplayCheckWorkflow, workflow.RegisterOptions{Name: ReplayCheckWFTypeName})
+	batchWorker.RegisterActivityWithOptions(ReplayCheckListActivity, activity.RegisterOptions{Name: replayCheckListActivityName})
 
-	return batchWorker.Start()
+	if err := batchWorker.Start(); err != nil {
+		return err
+	}
+	s.worker = batchWorker
+	return nil
+}
+
+// Stop stops the worker for batch operation workflows
+func (s *Batcher) Stop() {
+	if s.worker != nil {
+		s.worker.Stop()
+		s.worker = nil
+	}
 }
@@ -0,0 +1,251 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// ReplayCheckWFTypeName is the workflow type of replay safety check
+	ReplayCheckWFTypeName       = "temporal-sys-replay-check-workflow"
+	replayCheckListActivityName = "temporal-sys-replay-check-list-activity"
+	// ReplayCheckActivityName is the activity which shadow worker build registers on validation task queue,
+	// it replays history of ReplayCheckRequest execution with the new build and fails on non-determinism
+	ReplayCheckActivityName = "temporal-sys-replay-check-activity"
+
+	// DefaultReplayCheckMaxWorkflows is the default number of workflows sampled by query
+	DefaultReplayCheckMaxWorkflows = 100
+	// MaxReplayCheckWorkflows is the max number of workflows replayed by one check
+	MaxReplayCheckWorkflows = 1000
+	// DefaultReplayCheckConcurrency is the default number of histories replayed in parallel
+	DefaultReplayCheckConcurrency = 10
+	// DefaultReplayCheckPickupTimeout is the default time shadow worker has to pick up replay of one history
+	DefaultReplayCheckPickupTimeout = 5 * time.Minute
+	// DefaultReplayCheckTimeout is the default time shadow worker has to replay one history once picked up
+	DefaultReplayCheckTimeout = time.Minute
+)
+
+type (
+	// ReplayCheckParams is the parameters for replay safety check workflow
+	ReplayCheckParams struct {
+		// Namespace of the workflows to replay
+		Namespace string
+		// ValidationTaskQueue is the task queue polled by shadow worker build
+		ValidationTaskQueue string
+		// Executions to replay, if empty executions are sampled by Query
+		Executions []*commonpb.WorkflowExecution
+		// Query to sample executions, e.g. recently closed workflows of a task queue
+		Query string
+
+		// Below are all optional
+		// MaxWorkflows is the max number of executions sampled by Query. Default to DefaultReplayCheckMaxWorkflows
+		MaxWorkflows int
+		// Concurrency is the number of histories replayed in parallel. Default to DefaultReplayCheckConcurrency
+		Concurrency int
+		// PickupTimeout is the time shadow worker has to pick up replay of one history. Default to DefaultReplayCheckPickupTimeout
+		PickupTimeout time.Duration
+		// ReplayTimeout is the time shadow worker has to replay one history once picked up. Default to DefaultReplayCheckTimeout
+		ReplayTimeout time.Duration
+	}
+
+	// ReplayCheckRequest is the input of ReplayCheckActivityName activity executed by shadow worker
+	ReplayCheckRequest struct {
+		Namespace string
+		Execution *commonpb.WorkflowExecution
+	}
+
+	// ReplayCheckResult is the result of replay safety check workflow
+	ReplayCheckResult struct {
+		// Checked is the number of replayed executions
+		Checked int
+		// Failures are executions which failed to replay, e.g. because of non-determinism
+		Failures []ReplayCheckFailure
+	}
+
+	// ReplayCheckFailure describes execution which failed to replay
+	ReplayCheckFailure struct {
+		WorkflowID string
+		RunID      string
+		Error      string
+	}
+)
+
+var (
+	replayCheckListActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    5 * time.Minute,
+		RetryPolicy:            &batchActivityRetryPolicy,
+	}
+)
+
+// ReplayCheckWorkflow replays histories of sampled executions on shadow worker build polling validation task queue,
+// so that non-determinism of the build is reported before it receives real traffic
+func ReplayCheckWorkflow(ctx workflow.Context, params ReplayCheckParams) (ReplayCheckResult, error) {
+	params = setDefaultReplayCheckParams(params)
+	if err := validateReplayCheckParams(params); err != nil {
+		return ReplayCheckResult{}, err
+	}
+
+	executions := params.Executions
+	if len(executions) == 0 {
+		listCtx := workflow.WithActivityOptions(ctx, replayCheckListActivityOptions)
+		if err := workflow.ExecuteActivity(listCtx, replayCheckListActivityName, params).Get(ctx, &executions); err != nil {
+			return ReplayCheckResult{}, err
+		}
+	}
+
+	replayCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:              params.ValidationTaskQueue,
+		ScheduleToStartTimeout: params.PickupTimeout,
+		StartToCloseTimeout:    params.ReplayTimeout,
+		ScheduleToCloseTimeout: params.PickupTimeout + params.ReplayTimeout,
+		// replay is deterministic, retrying it doesn't change the result
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    1,
+		},
+	})
+
+	result := ReplayCheckResult{}
+	for start := 0; start < len(executions); start += params.Concurrency {
+		end := start + params.Concurrency
+		if end > len(executions) {
+			end = len(executions)
+		}
+
+		futures := make([]workflow.Future, 0, end-start)
+		for _, execution := range executions[start:end] {
+			futures = append(futures, workflow.ExecuteActivity(replayCtx, ReplayCheckActivityName, ReplayCheckRequest{
+				Namespace: params.Namespace,
+				Execution: execution,
+			}))
+		}
+		for i, future := range futures {
+			err := future.Get(ctx, nil)
+			if isScheduleToStartTimeout(err) {
+				// none of the remaining histories would be picked up either
+				return ReplayCheckResult{}, fmt.Errorf(
+					"no shadow worker picked up replay from validation task queue %v within %v",
+					params.ValidationTaskQueue, params.PickupTimeout)
+			}
+			result.Checked++
+			if err != nil {
+				execution := executions[start+i]
+				result.Failures = append(result.Failures, ReplayCheckFailure{
+					WorkflowID: execution.GetWorkflowId(),
+					RunID:      execution.GetRunId(),
+					Error:      err.Error(),
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+// ReplayCheckListActivity samples executions to replay by query
+func ReplayCheckListActivity(ctx context.Context, params ReplayCheckParams) ([]*commonpb.WorkflowExecution, error) {
+	batcher := ctx.Value(batcherContextKey).(*Batcher)
+	client := batcher.clientBean.GetFrontendClient()
+
+	var executions []*commonpb.WorkflowExecution
+	var pageToken []byte
+	for len(executions) < params.MaxWorkflows {
+		resp, err := client.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     params.Namespace,
+			PageSize:      int32(params.MaxWorkflows - len(executions)),
+			NextPageToken: pageToken,
+			Query:         params.Query,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range resp.Executions {
+			executions = append(executions, info.GetExecution())
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	if len(executions) > params.MaxWorkflows {
+		executions = executions[:params.MaxWorkflows]
+	}
+	return executions, nil
+}
+
+// ReplayCheckExecutionTimeout returns the execution timeout of replay safety check workflow with the parameters,
+// it bounds the check if shadow worker stops polling validation task queue in the middle of it
+func ReplayCheckExecutionTimeout(params ReplayCheckParams) time.Duration {
+	params = setDefaultReplayCheckParams(params)
+	executions := len(params.Executions)
+	if executions == 0 {
+		executions = params.MaxWorkflows
+	}
+	batches := (executions + params.Concurrency - 1) / params.Concurrency
+	return replayCheckListActivityOptions.ScheduleToStartTimeout + replayCheckListActivityOptions.StartToCloseTimeout +
+		time.Duration(batches)*(params.PickupTimeout+params.ReplayTimeout)
+}
+
+func isScheduleToStartTimeout(err error) bool {
+	var timeoutErr *temporal.TimeoutError
+	return errors.As(err, &timeoutErr) && timeoutErr.TimeoutType() == enumspb.TIMEOUT_TYPE_SCHEDULE_TO_START
+}
+
+func validateReplayCheckParams(params ReplayCheckParams) error {
+	if params.Namespace == "" ||
+		params.ValidationTaskQueue == "" ||
+		(params.Query == "" && len(params.Executions) == 0) {
+		return fmt.Errorf("must provide required parameters: Namespace/ValidationTaskQueue/Query or Executions")
+	}
+	return nil
+}
+
+func setDefaultReplayCheckParams(params ReplayCheckParams) ReplayCheckParams {
+	if params.MaxWorkflows <= 0 {
+		params.MaxWorkflows = DefaultReplayCheckMaxWorkflows
+	}
+	if params.Concurrency <= 0 {
+		params.Concurrency = DefaultReplayCheckConcurrency
+	}
+	if params.PickupTimeout <= 0 {
+		params.PickupTimeout = DefaultReplayCheckPickupTimeout
+	}
+	if params.ReplayTimeout <= 0 {
+		params.ReplayTimeout = DefaultReplayCheckTimeout
+	}
+	return params
+}
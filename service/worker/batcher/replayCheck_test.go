@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type replayCheckWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestReplayCheckWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(replayCheckWorkflowTestSuite))
+}
+
+func (s *replayCheckWorkflowTestSuite) newEnvironment(replay func(ctx context.Context, request ReplayCheckRequest) error) *testsuite.TestWorkflowEnvironment {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(ReplayCheckWorkflow, workflow.RegisterOptions{Name: ReplayCheckWFTypeName})
+	env.RegisterActivityWithOptions(ReplayCheckListActivity, activity.RegisterOptions{Name: replayCheckListActivityName})
+	env.RegisterActivityWithOptions(replay, activity.RegisterOptions{Name: ReplayCheckActivityName})
+	return env
+}
+
+func (s *replayCheckWorkflowTestSuite) TestFailuresAreReported() {
+	env := s.newEnvironment(func(ctx context.Context, request ReplayCheckRequest) error {
+		if request.Execution.GetWorkflowId() == "wid2" {
+			return errors.New("nondeterministic workflow")
+		}
+		return nil
+	})
+	env.OnActivity(replayCheckListActivityName, mock.Anything, mock.Anything).Return([]*commonpb.WorkflowExecution{
+		{WorkflowId: "wid1", RunId: "rid1"},
+		{WorkflowId: "wid2", RunId: "rid2"},
+		{WorkflowId: "wid3", RunId: "rid3"},
+	}, nil)
+
+	env.ExecuteWorkflow(ReplayCheckWFTypeName, ReplayCheckParams{
+		Namespace:           "test-namespace",
+		ValidationTaskQueue: "validation",
+		Query:               "TaskQueue = 'tq'",
+		Concurrency:         2,
+	})
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+
+	var result ReplayCheckResult
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(3, result.Checked)
+	s.Len(result.Failures, 1)
+	s.Equal("wid2", result.Failures[0].WorkflowID)
+	s.Equal("rid2", result.Failures[0].RunID)
+	s.Contains(result.Failures[0].Error, "nondeterministic workflow")
+}
+
+func (s *replayCheckWorkflowTestSuite) TestNoShadowWorker() {
+	env := s.newEnvironment(func(ctx context.Context, request ReplayCheckRequest) error {
+		return temporal.NewTimeoutError(enumspb.TIMEOUT_TYPE_SCHEDULE_TO_START, nil)
+	})
+
+	env.ExecuteWorkflow(ReplayCheckWFTypeName, ReplayCheckParams{
+		Namespace:           "test-namespace",
+		ValidationTaskQueue: "validation",
+		Executions:          []*commonpb.WorkflowExecution{{WorkflowId: "wid1", RunId: "rid1"}},
+	})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+	s.Contains(env.GetWorkflowError().Error(), "no shadow worker picked up replay")
+}
+
+func (s *replayCheckWorkflowTestSuite) TestExecutionTimeout() {
+	params := ReplayCheckParams{
+		Executions:    make([]*commonpb.WorkflowExecution, 25),
+		Concurrency:   10,
+		PickupTimeout: time.Minute,
+		ReplayTimeout: time.Minute,
+	}
+	s.Equal(10*time.Minute+3*2*time.Minute, ReplayCheckExecutionTimeout(params))
+
+	// executions are sampled by query
+	s.Equal(10*time.Minute+10*(DefaultReplayCheckPickupTimeout+DefaultReplayCheckTimeout),
+		ReplayCheckExecutionTimeout(ReplayCheckParams{}))
+}
@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+const (
+	// canaryStartUpDelay is to let services warm up
+	canaryStartUpDelay = time.Second * 4
+	// canaryEnabledCheckInterval is the interval at which a running canary checks whether it was disabled
+	canaryEnabledCheckInterval = time.Minute
+	// canaryTerminateReason is the reason canary workflow is terminated with when canary is disabled
+	canaryTerminateReason = "canary is disabled"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the canary sub-system
+	BootstrapParams struct {
+		// ServiceClient is an instance of temporal service client
+		ServiceClient sdkclient.Client
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// Enabled is checked by the running canary, which terminates its workflow once it is disabled
+		Enabled dynamicconfig.BoolPropertyFn
+	}
+
+	// Canary is the background sub-system which continuously exercises timers, activities, signals, queries,
+	// child workflows and cron with system workflows and emits success and latency metrics of each feature.
+	// It is also the context object that gets passed around within the canary activities.
+	Canary struct {
+		sync.Mutex
+		svcClient     sdkclient.Client
+		metricsClient metrics.Client
+		logger        log.Logger
+		enabled       dynamicconfig.BoolPropertyFn
+		worker        worker.Worker
+		stopC         chan struct{}
+	}
+)
+
+// New returns a new instance of canary daemon
+func New(params *BootstrapParams) *Canary {
+	return &Canary{
+		svcClient:     params.ServiceClient,
+		metricsClient: params.MetricsClient,
+		logger:        params.Logger.WithTags(tag.ComponentCanary),
+		enabled:       params.Enabled,
+	}
+}
+
+// Start starts worker of canary workflows and the cron workflow which runs canary probes
+func (c *Canary) Start() error {
+	workerOpts := worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), canaryContextKey, c),
+	}
+	canaryWorker := worker.New(c.svcClient, CanaryTaskQueueName, workerOpts)
+	canaryWorker.RegisterWorkflowWithOptions(CanaryWorkflow, workflow.RegisterOptions{Name: CanaryWFTypeName})
+	canaryWorker.RegisterWorkflowWithOptions(CanaryChildWorkflow, workflow.RegisterOptions{Name: canaryChildWFTypeName})
+	canaryWorker.RegisterActivityWithOptions(EchoActivity, activity.RegisterOptions{Name: canaryEchoActivityName})
+	canaryWorker.RegisterActivityWithOptions(QueryActivity, activity.RegisterOptions{Name: canaryQueryActivityName})
+	canaryWorker.RegisterActivityWithOptions(ReportActivity, activity.RegisterOptions{Name: canaryReportActivityName})
+
+	if err := canaryWorker.Start(); err != nil {
+		return err
+	}
+	c.Lock()
+	c.worker = canaryWorker
+	c.stopC = make(chan struct{})
+	c.Unlock()
+
+	go c.startWorkflowWithRetry()
+	go c.disableLoop(c.stopC)
+	return nil
+}
+
+// Stop stops the worker of canary workflows, the cron workflow keeps running and is picked up
+// by the worker host which takes over
+func (c *Canary) Stop() {
+	c.Lock()
+	defer c.Unlock()
+	if c.worker != nil {
+		close(c.stopC)
+		c.worker.Stop()
+		c.worker = nil
+	}
+}
+
+// disableLoop terminates canary workflow and stops canary worker once canary is disabled,
+// the cron workflow would otherwise keep being scheduled with no worker polling its task queue
+func (c *Canary) disableLoop(stopC <-chan struct{}) {
+	ticker := time.NewTicker(canaryEnabledCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopC:
+			return
+		case <-ticker.C:
+			if c.enabled() {
+				continue
+			}
+			if TerminateWorkflowWithRetry(c.svcClient, c.logger, stopC) {
+				c.Stop()
+			}
+			return
+		}
+	}
+}
+
+// TerminateWorkflowWithRetry terminates canary workflow if it is running. It retries until the workflow
+// is terminated or stopC is closed and returns whether the workflow is terminated.
+func TerminateWorkflowWithRetry(svcClient sdkclient.Client, logger log.Logger, stopC <-chan struct{}) bool {
+	policy := backoff.NewExponentialRetryPolicy(time.Second)
+	policy.SetMaximumInterval(time.Minute)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	err := backoff.Retry(func() error {
+		return terminateWorkflow(svcClient, logger)
+	}, policy, func(err error) bool {
+		select {
+		case <-stopC:
+			return false
+		default:
+			return true
+		}
+	})
+	return err == nil
+}
+
+func terminateWorkflow(svcClient sdkclient.Client, logger log.Logger) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	err := svcClient.TerminateWorkflow(ctx, canaryWFID, "", canaryTerminateReason)
+	cancel()
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return nil
+		}
+		logger.Error("error terminating "+CanaryWFTypeName+" workflow", tag.Error(err))
+		return err
+	}
+	logger.Info(CanaryWFTypeName + " workflow terminated")
+	return nil
+}
+
+func (c *Canary) startWorkflowWithRetry() {
+	// let history / matching service warm up
+	time.Sleep(canaryStartUpDelay)
+
+	policy := backoff.NewExponentialRetryPolicy(time.Second)
+	policy.SetMaximumInterval(time.Minute)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	err := backoff.Retry(c.startWorkflow, policy, func(err error) bool {
+		return true
+	})
+	if err != nil {
+		c.logger.Error("unable to start canary", tag.WorkflowType(CanaryWFTypeName), tag.Error(err))
+	}
+}
+
+func (c *Canary) startWorkflow() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	_, err := c.svcClient.ExecuteWorkflow(ctx, canaryWFStartOptions, CanaryWFTypeName)
+	cancel()
+	if err != nil {
+		if _, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); ok {
+			return nil
+		}
+		c.logger.Error("error starting "+CanaryWFTypeName+" workflow", tag.Error(err))
+		return err
+	}
+	c.logger.Info(CanaryWFTypeName + " workflow successfully started")
+	return nil
+}
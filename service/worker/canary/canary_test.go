@@ -0,0 +1,64 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/mocks"
+
+	"go.temporal.io/server/common/log"
+)
+
+func TestTerminateWorkflowWithRetry(t *testing.T) {
+	svcClient := &mocks.Client{}
+	defer svcClient.AssertExpectations(t)
+
+	svcClient.On("TerminateWorkflow", mock.Anything, canaryWFID, "", canaryTerminateReason, mock.Anything).
+		Return(errors.New("unavailable")).Once()
+	svcClient.On("TerminateWorkflow", mock.Anything, canaryWFID, "", canaryTerminateReason, mock.Anything).
+		Return(nil).Once()
+	assert.True(t, TerminateWorkflowWithRetry(svcClient, log.NewNoop(), make(chan struct{})))
+
+	// canary workflow is not running
+	svcClient.On("TerminateWorkflow", mock.Anything, canaryWFID, "", canaryTerminateReason, mock.Anything).
+		Return(serviceerror.NewNotFound("workflow not found")).Once()
+	assert.True(t, TerminateWorkflowWithRetry(svcClient, log.NewNoop(), make(chan struct{})))
+}
+
+func TestTerminateWorkflowWithRetryStopped(t *testing.T) {
+	svcClient := &mocks.Client{}
+	defer svcClient.AssertExpectations(t)
+
+	svcClient.On("TerminateWorkflow", mock.Anything, canaryWFID, "", canaryTerminateReason, mock.Anything).
+		Return(errors.New("unavailable")).Once()
+	stopC := make(chan struct{})
+	close(stopC)
+	assert.False(t, TerminateWorkflowWithRetry(svcClient, log.NewNoop(), stopC))
+}
@@ -0,0 +1,299 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	contextKey int
+
+	// ProbeResult is the outcome of exercising one feature in a canary run
+	ProbeResult struct {
+		Feature string
+		Success bool
+		// Latency is the time the feature took beyond what was requested, e.g. timer firing delay
+		Latency time.Duration
+		Error   string
+	}
+)
+
+const (
+	canaryContextKey = contextKey(0)
+
+	// CanaryTaskQueueName is the task queue of canary workflows and activities
+	CanaryTaskQueueName = "temporal-sys-canary-taskqueue"
+	// CanaryWFTypeName is the workflow type of the cron workflow which runs canary probes
+	CanaryWFTypeName         = "temporal-sys-canary-workflow"
+	canaryWFID               = "temporal-sys-canary"
+	canaryChildWFTypeName    = "temporal-sys-canary-child-workflow"
+	canaryEchoActivityName   = "temporal-sys-canary-echo-activity"
+	canaryQueryActivityName  = "temporal-sys-canary-query-activity"
+	canaryReportActivityName = "temporal-sys-canary-report-activity"
+	canarySignalName         = "temporal-sys-canary-signal"
+	canaryQueryType          = "temporal-sys-canary-query"
+
+	// canaryCronSchedule runs canary every minute, cron latency is measured from the start of the minute
+	canaryCronSchedule  = "* * * * *"
+	canaryRunTimeout    = 5 * time.Minute
+	canaryProbeTimeout  = 30 * time.Second
+	canaryTimerDuration = 5 * time.Second
+	canaryPayload       = "canary"
+	// canaryChildWaitingState is the state the child workflow reports to queries until it is signaled
+	canaryChildWaitingState = "waiting-for-signal"
+
+	featureTimer         = "timer"
+	featureActivity      = "activity"
+	featureChildWorkflow = "child-workflow"
+	featureSignal        = "signal"
+	featureQuery         = "query"
+	featureCron          = "cron"
+)
+
+var (
+	canaryWFStartOptions = client.StartWorkflowOptions{
+		ID:                    canaryWFID,
+		TaskQueue:             CanaryTaskQueueName,
+		WorkflowRunTimeout:    canaryRunTimeout,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          canaryCronSchedule,
+	}
+	// probes are not retried, canary is to report failures rather than hide them
+	probeRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumAttempts:    1,
+	}
+	probeActivityOptions = workflow.ActivityOptions{
+		ScheduleToCloseTimeout: canaryProbeTimeout,
+		RetryPolicy:            &probeRetryPolicy,
+	}
+	reportActivityOptions = workflow.ActivityOptions{
+		ScheduleToCloseTimeout: canaryProbeTimeout,
+	}
+	probeChildWorkflowOptions = workflow.ChildWorkflowOptions{
+		WorkflowRunTimeout: canaryProbeTimeout,
+		RetryPolicy:        &probeRetryPolicy,
+	}
+
+	featureScopes = map[string]int{
+		featureTimer:         metrics.CanaryTimerScope,
+		featureActivity:      metrics.CanaryActivityScope,
+		featureChildWorkflow: metrics.CanaryChildWorkflowScope,
+		featureSignal:        metrics.CanarySignalScope,
+		featureQuery:         metrics.CanaryQueryScope,
+		featureCron:          metrics.CanaryCronScope,
+	}
+
+	errUnexpectedEcho       = errors.New("unexpected echo of canary payload")
+	errUnexpectedQueryState = errors.New("unexpected state of canary child workflow")
+)
+
+// CanaryWorkflow is the cron workflow which exercises timers, activities, signals, queries, child workflows and cron,
+// and reports outcome of the probes with an activity, so metrics are not emitted again when the workflow is replayed
+func CanaryWorkflow(
+	ctx workflow.Context,
+) error {
+
+	results := []ProbeResult{probeCron(ctx), probeTimer(ctx), probeActivity(ctx)}
+	results = append(results, probeChildWorkflow(ctx)...)
+
+	return workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, reportActivityOptions),
+		canaryReportActivityName,
+		results,
+	).Get(ctx, nil)
+}
+
+// CanaryChildWorkflow is the child workflow of canary, it answers queries until it is signaled
+// and returns the signal payload
+func CanaryChildWorkflow(
+	ctx workflow.Context,
+) (string, error) {
+
+	state := canaryChildWaitingState
+	if err := workflow.SetQueryHandler(ctx, canaryQueryType, func() (string, error) {
+		return state, nil
+	}); err != nil {
+		return "", err
+	}
+
+	var payload string
+	workflow.GetSignalChannel(ctx, canarySignalName).Receive(ctx, &payload)
+	state = "signaled"
+	return payload, nil
+}
+
+// EchoActivity is the activity of canary activity probe
+func EchoActivity(
+	_ context.Context,
+	payload string,
+) (string, error) {
+	return payload, nil
+}
+
+// QueryActivity queries the canary child workflow and returns latency of the query
+func QueryActivity(
+	activityCtx context.Context,
+	workflowID string,
+	runID string,
+) (time.Duration, error) {
+
+	c := activityCtx.Value(canaryContextKey).(*Canary)
+	startTime := time.Now().UTC()
+	value, err := c.svcClient.QueryWorkflow(activityCtx, workflowID, runID, canaryQueryType)
+	if err != nil {
+		return 0, err
+	}
+	latency := time.Now().UTC().Sub(startTime)
+
+	var state string
+	if err := value.Get(&state); err != nil {
+		return 0, err
+	}
+	if state != canaryChildWaitingState {
+		return 0, fmt.Errorf("%w: %v", errUnexpectedQueryState, state)
+	}
+	return latency, nil
+}
+
+// ReportActivity emits success and latency metrics of canary probes
+func ReportActivity(
+	activityCtx context.Context,
+	results []ProbeResult,
+) error {
+
+	c := activityCtx.Value(canaryContextKey).(*Canary)
+	for _, result := range results {
+		scope := c.metricsClient.Scope(featureScopes[result.Feature])
+		if !result.Success {
+			scope.IncCounter(metrics.CanaryFailureCount)
+			c.logger.Warn("Canary probe failed.", tag.Name(result.Feature), tag.Error(errors.New(result.Error)))
+			continue
+		}
+		scope.IncCounter(metrics.CanarySuccessCount)
+		scope.RecordTimer(metrics.CanaryLatency, result.Latency)
+	}
+	return nil
+}
+
+func probeCron(
+	ctx workflow.Context,
+) ProbeResult {
+
+	// the run is scheduled at the start of the minute, see canaryCronSchedule
+	now := workflow.Now(ctx)
+	return newProbeResult(featureCron, now.Sub(now.Truncate(time.Minute)), nil)
+}
+
+func probeTimer(
+	ctx workflow.Context,
+) ProbeResult {
+
+	startTime := workflow.Now(ctx)
+	err := workflow.NewTimer(ctx, canaryTimerDuration).Get(ctx, nil)
+	return newProbeResult(featureTimer, workflow.Now(ctx).Sub(startTime)-canaryTimerDuration, err)
+}
+
+func probeActivity(
+	ctx workflow.Context,
+) ProbeResult {
+
+	startTime := workflow.Now(ctx)
+	var echo string
+	err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, probeActivityOptions),
+		canaryEchoActivityName,
+		canaryPayload,
+	).Get(ctx, &echo)
+	if err == nil && echo != canaryPayload {
+		err = errUnexpectedEcho
+	}
+	return newProbeResult(featureActivity, workflow.Now(ctx).Sub(startTime), err)
+}
+
+// probeChildWorkflow starts child workflow, queries it, signals it and waits for it to complete,
+// it returns results of child workflow, query and signal probes
+func probeChildWorkflow(
+	ctx workflow.Context,
+) []ProbeResult {
+
+	startTime := workflow.Now(ctx)
+	child := workflow.ExecuteChildWorkflow(workflow.WithChildOptions(ctx, probeChildWorkflowOptions), canaryChildWFTypeName)
+	var execution workflow.Execution
+	if err := child.GetChildWorkflowExecution().Get(ctx, &execution); err != nil {
+		return []ProbeResult{
+			newProbeResult(featureChildWorkflow, 0, err),
+			newProbeResult(featureQuery, 0, err),
+			newProbeResult(featureSignal, 0, err),
+		}
+	}
+
+	var queryLatency time.Duration
+	err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, probeActivityOptions),
+		canaryQueryActivityName,
+		execution.ID,
+		execution.RunID,
+	).Get(ctx, &queryLatency)
+	queryResult := newProbeResult(featureQuery, queryLatency, err)
+
+	signalTime := workflow.Now(ctx)
+	err = child.SignalChildWorkflow(ctx, canarySignalName, canaryPayload).Get(ctx, nil)
+	signalResult := newProbeResult(featureSignal, workflow.Now(ctx).Sub(signalTime), err)
+
+	var echo string
+	err = child.Get(ctx, &echo)
+	if err == nil && echo != canaryPayload {
+		err = errUnexpectedEcho
+	}
+	childResult := newProbeResult(featureChildWorkflow, workflow.Now(ctx).Sub(startTime), err)
+
+	return []ProbeResult{childResult, queryResult, signalResult}
+}
+
+func newProbeResult(
+	feature string,
+	latency time.Duration,
+	err error,
+) ProbeResult {
+
+	if err != nil {
+		return ProbeResult{Feature: feature, Error: err.Error()}
+	}
+	return ProbeResult{Feature: feature, Success: true, Latency: latency}
+}
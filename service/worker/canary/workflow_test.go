@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canary
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type canaryWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestCanaryWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(canaryWorkflowTestSuite))
+}
+
+func (s *canaryWorkflowTestSuite) newEnvironment() *testsuite.TestWorkflowEnvironment {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(CanaryWorkflow, workflow.RegisterOptions{Name: CanaryWFTypeName})
+	env.RegisterWorkflowWithOptions(CanaryChildWorkflow, workflow.RegisterOptions{Name: canaryChildWFTypeName})
+	env.RegisterActivityWithOptions(EchoActivity, activity.RegisterOptions{Name: canaryEchoActivityName})
+	env.RegisterActivityWithOptions(QueryActivity, activity.RegisterOptions{Name: canaryQueryActivityName})
+	env.RegisterActivityWithOptions(ReportActivity, activity.RegisterOptions{Name: canaryReportActivityName})
+	return env
+}
+
+func (s *canaryWorkflowTestSuite) reportedResults(env *testsuite.TestWorkflowEnvironment) map[string]ProbeResult {
+	results := make(map[string]ProbeResult)
+	env.OnActivity(canaryReportActivityName, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, reported []ProbeResult) error {
+			for _, result := range reported {
+				results[result.Feature] = result
+			}
+			return nil
+		})
+	return results
+}
+
+func (s *canaryWorkflowTestSuite) TestAllProbesSucceed() {
+	env := s.newEnvironment()
+	env.OnActivity(canaryQueryActivityName, mock.Anything, mock.Anything, mock.Anything).Return(10*time.Millisecond, nil)
+	results := s.reportedResults(env)
+
+	env.ExecuteWorkflow(CanaryWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+
+	s.Len(results, len(featureScopes))
+	for feature := range featureScopes {
+		s.True(results[feature].Success, feature)
+	}
+	s.Equal(10*time.Millisecond, results[featureQuery].Latency)
+}
+
+func (s *canaryWorkflowTestSuite) TestFailedProbeIsReported() {
+	env := s.newEnvironment()
+	env.OnActivity(canaryEchoActivityName, mock.Anything, mock.Anything).Return("", errors.New("activity failed"))
+	env.OnActivity(canaryQueryActivityName, mock.Anything, mock.Anything, mock.Anything).Return(time.Duration(0), errors.New("query failed"))
+	results := s.reportedResults(env)
+
+	env.ExecuteWorkflow(CanaryWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+
+	s.False(results[featureActivity].Success)
+	s.Contains(results[featureActivity].Error, "activity failed")
+	s.False(results[featureQuery].Success)
+	s.True(results[featureTimer].Success)
+	s.True(results[featureSignal].Success)
+	s.True(results[featureChildWorkflow].Success)
+}
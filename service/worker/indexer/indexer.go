@@ -46,6 +46,8 @@ type (
 		metricsClient       metrics.Client
 		visibilityProcessor *indexProcessor
 		visibilityIndexName string
+		rolloverConfig      es.RolloverConfig
+		rolloverManager     *indexRolloverManager
 	}
 
 	// Config contains all configs for indexer
@@ -75,21 +77,39 @@ func NewIndexer(config *Config, client messaging.Client, esClient es.Client, esC
 		logger:              logger,
 		metricsClient:       metricsClient,
 		visibilityIndexName: esConfig.Indices[common.VisibilityAppName],
+		rolloverConfig:      esConfig.Rollover,
 	}
 }
 
 // Start indexer
-func (x Indexer) Start() error {
+func (x *Indexer) Start() error {
+	var indexResolver *docIndexResolver
+	if x.rolloverConfig.Enabled {
+		if err := x.rolloverConfig.Validate(); err != nil {
+			return err
+		}
+		// visibility index name is used as write alias, so it must exist before first document is indexed
+		x.rolloverManager = newIndexRolloverManager(x.esClient, x.visibilityIndexName, &x.rolloverConfig,
+			x.logger, x.metricsClient)
+		if err := x.rolloverManager.Start(); err != nil {
+			return err
+		}
+		indexResolver = newDocIndexResolver(x.esClient, x.visibilityIndexName)
+	}
+
 	visibilityApp := common.VisibilityAppName
 	visConsumerName := getConsumerName(x.visibilityIndexName)
 	x.visibilityProcessor = newIndexProcessor(visibilityApp, visConsumerName, x.kafkaClient, x.esClient,
-		visibilityProcessorName, x.visibilityIndexName, x.config, x.logger, x.metricsClient)
+		visibilityProcessorName, x.visibilityIndexName, indexResolver, x.config, x.logger, x.metricsClient)
 	return x.visibilityProcessor.Start()
 }
 
 // Stop indexer
-func (x Indexer) Stop() {
+func (x *Indexer) Stop() {
 	x.visibilityProcessor.Stop()
+	if x.rolloverManager != nil {
+		x.rolloverManager.Stop()
+	}
 }
 
 func getConsumerName(topic string) string {
@@ -54,6 +54,7 @@ type indexProcessor struct {
 	esProcessor     ESProcessor
 	esProcessorName string
 	esIndexName     string
+	indexResolver   *docIndexResolver
 	config          *Config
 	logger          log.Logger
 	metricsClient   metrics.Client
@@ -76,7 +77,8 @@ var (
 )
 
 func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Client, esClient es.Client,
-	esProcessorName, esIndexName string, config *Config, logger log.Logger, metricsClient metrics.Client) *indexProcessor {
+	esProcessorName, esIndexName string, indexResolver *docIndexResolver, config *Config, logger log.Logger,
+	metricsClient metrics.Client) *indexProcessor {
 	return &indexProcessor{
 		appName:         appName,
 		consumerName:    consumerName,
@@ -84,6 +86,7 @@ func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Clien
 		esClient:        esClient,
 		esProcessorName: esProcessorName,
 		esIndexName:     esIndexName,
+		indexResolver:   indexResolver,
 		config:          config,
 		logger:          logger.WithTags(tag.ComponentIndexerProcessor),
 		metricsClient:   metricsClient,
@@ -198,6 +201,15 @@ func (p *indexProcessor) deserialize(payload []byte) (*indexerspb.Message, error
 func (p *indexProcessor) addMessageToES(indexMsg *indexerspb.Message, kafkaMsg messaging.Message, logger log.Logger) error {
 	docID := indexMsg.GetWorkflowId() + esDocIDDelimiter + indexMsg.GetRunId()
 
+	index := p.esIndexName
+	if p.indexResolver != nil {
+		var err error
+		if index, err = p.indexResolver.resolve(docID); err != nil {
+			logger.Error("Failed to resolve index of visibility record.", tag.ESKey(docID), tag.Error(err))
+			return err
+		}
+	}
+
 	var keyToKafkaMsg string
 	var req *es.BulkableRequest
 	switch indexMsg.GetMessageType() {
@@ -205,7 +217,7 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexerspb.Message, kafkaMsg m
 		keyToKafkaMsg = fmt.Sprintf("%v-%v", kafkaMsg.Partition(), kafkaMsg.Offset())
 		doc := p.generateESDoc(indexMsg, keyToKafkaMsg)
 		req = &es.BulkableRequest{
-			Index:       p.esIndexName,
+			Index:       index,
 			ID:          docID,
 			Version:     indexMsg.GetVersion(),
 			RequestType: es.BulkableRequestTypeIndex,
@@ -214,11 +226,14 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexerspb.Message, kafkaMsg m
 	case enumsspb.MESSAGE_TYPE_DELETE:
 		keyToKafkaMsg = docID
 		req = &es.BulkableRequest{
-			Index:       p.esIndexName,
+			Index:       index,
 			ID:          docID,
 			Version:     indexMsg.GetVersion(),
 			RequestType: es.BulkableRequestTypeDelete,
 		}
+		if p.indexResolver != nil {
+			p.indexResolver.forget(docID)
+		}
 	default:
 		logger.Error("Unknown message type")
 		p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
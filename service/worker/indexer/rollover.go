@@ -0,0 +1,267 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
+	es "go.temporal.io/server/common/elasticsearch"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type indexRolloverManager struct {
+	esClient      es.Client
+	alias         string
+	config        *es.RolloverConfig
+	logger        log.Logger
+	metricsClient metrics.Client
+	isStarted     int32
+	isStopped     int32
+	shutdownWG    sync.WaitGroup
+	shutdownCh    chan struct{}
+}
+
+const (
+	rolloverRequestTimeout = 30 * time.Second
+	indexMigrationTimeout  = time.Hour
+	docIndexCacheMaxSize   = 100000
+
+	blockWritesSettings = `{"index.blocks.write": true}`
+)
+
+func newIndexRolloverManager(esClient es.Client, alias string, config *es.RolloverConfig,
+	logger log.Logger, metricsClient metrics.Client) *indexRolloverManager {
+	return &indexRolloverManager{
+		esClient:      esClient,
+		alias:         alias,
+		config:        config,
+		logger:        logger.WithTags(tag.ComponentIndexerRollover),
+		metricsClient: metricsClient,
+		shutdownCh:    make(chan struct{}),
+	}
+}
+
+func (m *indexRolloverManager) Start() error {
+	if !atomic.CompareAndSwapInt32(&m.isStarted, 0, 1) {
+		return nil
+	}
+
+	m.logger.Info("", tag.LifeCycleStarting)
+	if err := m.bootstrap(); err != nil {
+		m.logger.Info("", tag.LifeCycleStartFailed, tag.Error(err))
+		return err
+	}
+
+	// with ILM policy ElasticSearch evaluates rollover conditions itself
+	if m.config.ILMPolicy == "" {
+		m.shutdownWG.Add(1)
+		go m.rolloverPump()
+	}
+
+	m.logger.Info("", tag.LifeCycleStarted)
+	return nil
+}
+
+func (m *indexRolloverManager) Stop() {
+	if !atomic.CompareAndSwapInt32(&m.isStopped, 0, 1) {
+		return
+	}
+
+	m.logger.Info("", tag.LifeCycleStopping)
+	defer m.logger.Info("", tag.LifeCycleStopped)
+
+	if atomic.LoadInt32(&m.isStarted) == 1 {
+		close(m.shutdownCh)
+	}
+
+	if success := common.AwaitWaitGroup(&m.shutdownWG, time.Minute); !success {
+		m.logger.Info("", tag.LifeCycleStopTimedout)
+	}
+}
+
+// bootstrap creates the first index behind the write alias if alias doesn't point to any index yet.
+func (m *indexRolloverManager) bootstrap() error {
+	ctx, cancel := context.WithTimeout(context.Background(), rolloverRequestTimeout)
+	defer cancel()
+
+	indices, err := m.esClient.GetAliasIndices(ctx, m.alias)
+	if err != nil {
+		return fmt.Errorf("unable to get indices for alias %s: %w", m.alias, err)
+	}
+
+	if m.config.ILMPolicy != "" {
+		if _, err := m.esClient.PutLifecyclePolicy(ctx, m.config.ILMPolicy, m.config); err != nil {
+			return fmt.Errorf("unable to put ILM policy %s: %w", m.config.ILMPolicy, err)
+		}
+	}
+
+	if len(indices) > 0 {
+		return nil
+	}
+
+	exists, err := m.esClient.IndexExists(ctx, m.alias)
+	if err != nil {
+		return fmt.Errorf("unable to check if index %s exists: %w", m.alias, err)
+	}
+	if exists {
+		return m.migrate()
+	}
+
+	index := es.GetInitialRolloverIndexName(m.alias)
+	if _, err := m.esClient.CreateIndexWithAlias(ctx, index, m.alias, m.config); err != nil {
+		return fmt.Errorf("unable to create index %s with alias %s: %w", index, m.alias, err)
+	}
+	m.logger.Info("Created initial index for write alias.", tag.ESAlias(m.alias), tag.ESIndex(index))
+	return nil
+}
+
+// migrate replaces the concrete index which has the alias name with the first rollover index.
+// Writes to the concrete index are blocked before its documents are copied, so nothing written by other indexers
+// during migration is lost: their requests fail and are retried from kafka once the alias is in place.
+// Every step is safe to repeat, so a failed migration is resumed on next start.
+func (m *indexRolloverManager) migrate() error {
+	ctx, cancel := context.WithTimeout(context.Background(), indexMigrationTimeout)
+	defer cancel()
+
+	index := es.GetInitialRolloverIndexName(m.alias)
+	m.logger.Info("Migrating concrete index to write alias.", tag.ESAlias(m.alias), tag.ESIndex(index))
+
+	if _, err := m.esClient.IndexPutSettings(ctx, m.alias, blockWritesSettings); err != nil {
+		return fmt.Errorf("unable to block writes to index %s: %w", m.alias, err)
+	}
+
+	exists, err := m.esClient.IndexExists(ctx, index)
+	if err != nil {
+		return fmt.Errorf("unable to check if index %s exists: %w", index, err)
+	}
+	if !exists {
+		if _, err := m.esClient.CreateRolloverIndex(ctx, index, m.alias, m.config); err != nil {
+			return fmt.Errorf("unable to create index %s: %w", index, err)
+		}
+	}
+
+	copied, err := m.esClient.Reindex(ctx, m.alias, index)
+	if err != nil {
+		return fmt.Errorf("unable to copy documents from index %s to %s: %w", m.alias, index, err)
+	}
+
+	if _, err := m.esClient.ReplaceIndexWithAlias(ctx, m.alias, index); err != nil {
+		return fmt.Errorf("unable to replace index %s with alias: %w", m.alias, err)
+	}
+	m.logger.Info("Migrated concrete index to write alias.", tag.ESAlias(m.alias), tag.ESIndex(index), tag.Number(copied))
+	return nil
+}
+
+func (m *indexRolloverManager) rolloverPump() {
+	defer m.shutdownWG.Done()
+
+	ticker := time.NewTicker(m.config.GetCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.shutdownCh:
+			return
+		case <-ticker.C:
+			m.rollover()
+		}
+	}
+}
+
+func (m *indexRolloverManager) rollover() {
+	ctx, cancel := context.WithTimeout(context.Background(), rolloverRequestTimeout)
+	defer cancel()
+
+	result, err := m.esClient.RolloverIndex(ctx, m.alias, m.config.Conditions())
+	if err != nil {
+		m.metricsClient.IncCounter(metrics.IndexRolloverScope, metrics.IndexRolloverFailures)
+		m.logger.Error("Unable to rollover index.", tag.ESAlias(m.alias), tag.Error(err))
+		return
+	}
+
+	if result.RolledOver {
+		m.metricsClient.IncCounter(metrics.IndexRolloverScope, metrics.IndexRolloverCount)
+		m.logger.Info("Rolled over index.", tag.ESAlias(m.alias), tag.ESIndex(result.NewIndex), tag.Value(result.OldIndex))
+	}
+}
+
+// docIndexResolver finds the concrete index which holds the visibility record of an execution.
+// Once the write alias is rolled over, an update or delete sent through the alias would land in the new write index
+// and leave the old record behind, so requests for existing records are sent to the index which holds them.
+type docIndexResolver struct {
+	esClient es.Client
+	alias    string
+	cache    cache.Cache // docID -> concrete index
+}
+
+func newDocIndexResolver(esClient es.Client, alias string) *docIndexResolver {
+	return &docIndexResolver{
+		esClient: esClient,
+		alias:    alias,
+		cache:    cache.New(docIndexCacheMaxSize, nil),
+	}
+}
+
+// resolve returns the index which holds docID, or the write alias if the record doesn't exist yet.
+// Records which are not searchable yet are not cached, so the lookup is repeated until they are.
+func (r *docIndexResolver) resolve(docID string) (string, error) {
+	if index, ok := r.cache.Get(docID).(string); ok {
+		return index, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), rolloverRequestTimeout)
+	defer cancel()
+
+	result, err := r.esClient.Search(ctx, &es.SearchParameters{
+		Index:    r.alias,
+		Query:    elastic.NewIdsQuery().Ids(docID),
+		PageSize: 1,
+	})
+	if err != nil {
+		return "", err
+	}
+	if result.Hits == nil || len(result.Hits.Hits) == 0 {
+		return r.alias, nil
+	}
+
+	index := result.Hits.Hits[0].Index
+	r.cache.Put(docID, index)
+	return index, nil
+}
+
+// forget drops docID from the cache once its record is deleted.
+func (r *docIndexResolver) forget(docID string) {
+	r.cache.Delete(docID)
+}
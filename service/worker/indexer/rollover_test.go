@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package indexer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/suite"
+
+	es "go.temporal.io/server/common/elasticsearch"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	mmocks "go.temporal.io/server/common/metrics/mocks"
+)
+
+type rolloverManagerSuite struct {
+	suite.Suite
+	controller       *gomock.Controller
+	mockESClient     *es.MockClient
+	mockMetricClient *mmocks.Client
+	config           *es.RolloverConfig
+}
+
+const testAlias = "test-alias"
+
+func TestRolloverManagerSuite(t *testing.T) {
+	s := new(rolloverManagerSuite)
+	suite.Run(t, s)
+}
+
+func (s *rolloverManagerSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockESClient = es.NewMockClient(s.controller)
+	s.mockMetricClient = &mmocks.Client{}
+	s.config = &es.RolloverConfig{
+		Enabled: true,
+		MaxAge:  24 * time.Hour,
+		MaxDocs: 1000,
+	}
+}
+
+func (s *rolloverManagerSuite) TearDownTest() {
+	s.mockMetricClient.AssertExpectations(s.T())
+	s.controller.Finish()
+}
+
+func (s *rolloverManagerSuite) newManager() *indexRolloverManager {
+	return newIndexRolloverManager(s.mockESClient, testAlias, s.config, loggerimpl.NewNopLogger(), s.mockMetricClient)
+}
+
+func (s *rolloverManagerSuite) TestBootstrap_CreatesInitialIndex() {
+	s.mockESClient.EXPECT().GetAliasIndices(gomock.Any(), testAlias).Return(nil, nil)
+	s.mockESClient.EXPECT().IndexExists(gomock.Any(), testAlias).Return(false, nil)
+	s.mockESClient.EXPECT().CreateIndexWithAlias(gomock.Any(), testAlias+"-000001", testAlias, s.config).Return(true, nil)
+
+	s.NoError(s.newManager().bootstrap())
+}
+
+func (s *rolloverManagerSuite) TestBootstrap_AliasExists() {
+	s.mockESClient.EXPECT().GetAliasIndices(gomock.Any(), testAlias).Return([]string{testAlias + "-000003"}, nil)
+
+	s.NoError(s.newManager().bootstrap())
+}
+
+func (s *rolloverManagerSuite) TestBootstrap_ILMPolicy() {
+	s.config.ILMPolicy = "test-policy"
+	s.mockESClient.EXPECT().GetAliasIndices(gomock.Any(), testAlias).Return(nil, nil)
+	s.mockESClient.EXPECT().PutLifecyclePolicy(gomock.Any(), "test-policy", s.config).Return(true, nil)
+	s.mockESClient.EXPECT().IndexExists(gomock.Any(), testAlias).Return(false, nil)
+	s.mockESClient.EXPECT().CreateIndexWithAlias(gomock.Any(), testAlias+"-000001", testAlias, s.config).Return(true, nil)
+
+	s.NoError(s.newManager().bootstrap())
+}
+
+func (s *rolloverManagerSuite) TestBootstrap_Error() {
+	s.mockESClient.EXPECT().GetAliasIndices(gomock.Any(), testAlias).Return(nil, errors.New("some error"))
+
+	s.Error(s.newManager().bootstrap())
+}
+
+func (s *rolloverManagerSuite) TestBootstrap_MigratesConcreteIndex() {
+	s.mockESClient.EXPECT().GetAliasIndices(gomock.Any(), testAlias).Return(nil, nil)
+	s.mockESClient.EXPECT().IndexExists(gomock.Any(), testAlias).Return(true, nil)
+	gomock.InOrder(
+		s.mockESClient.EXPECT().IndexPutSettings(gomock.Any(), testAlias, blockWritesSettings).Return(true, nil),
+		s.mockESClient.EXPECT().IndexExists(gomock.Any(), testAlias+"-000001").Return(false, nil),
+		s.mockESClient.EXPECT().CreateRolloverIndex(gomock.Any(), testAlias+"-000001", testAlias, s.config).Return(true, nil),
+		s.mockESClient.EXPECT().Reindex(gomock.Any(), testAlias, testAlias+"-000001").Return(int64(10), nil),
+		s.mockESClient.EXPECT().ReplaceIndexWithAlias(gomock.Any(), testAlias, testAlias+"-000001").Return(true, nil),
+	)
+
+	s.NoError(s.newManager().bootstrap())
+}
+
+func (s *rolloverManagerSuite) TestBootstrap_ResumesMigration() {
+	s.mockESClient.EXPECT().GetAliasIndices(gomock.Any(), testAlias).Return(nil, nil)
+	s.mockESClient.EXPECT().IndexExists(gomock.Any(), testAlias).Return(true, nil)
+	s.mockESClient.EXPECT().IndexPutSettings(gomock.Any(), testAlias, blockWritesSettings).Return(true, nil)
+	s.mockESClient.EXPECT().IndexExists(gomock.Any(), testAlias+"-000001").Return(true, nil)
+	s.mockESClient.EXPECT().Reindex(gomock.Any(), testAlias, testAlias+"-000001").Return(int64(0), nil)
+	s.mockESClient.EXPECT().ReplaceIndexWithAlias(gomock.Any(), testAlias, testAlias+"-000001").Return(true, nil)
+
+	s.NoError(s.newManager().bootstrap())
+}
+
+func (s *rolloverManagerSuite) TestBootstrap_MigrationError() {
+	s.mockESClient.EXPECT().GetAliasIndices(gomock.Any(), testAlias).Return(nil, nil)
+	s.mockESClient.EXPECT().IndexExists(gomock.Any(), testAlias).Return(true, nil)
+	s.mockESClient.EXPECT().IndexPutSettings(gomock.Any(), testAlias, blockWritesSettings).Return(true, nil)
+	s.mockESClient.EXPECT().IndexExists(gomock.Any(), testAlias+"-000001").Return(false, nil)
+	s.mockESClient.EXPECT().CreateRolloverIndex(gomock.Any(), testAlias+"-000001", testAlias, s.config).Return(true, nil)
+	s.mockESClient.EXPECT().Reindex(gomock.Any(), testAlias, testAlias+"-000001").Return(int64(0), errors.New("some error"))
+
+	s.Error(s.newManager().bootstrap())
+}
+
+func (s *rolloverManagerSuite) TestRollover() {
+	s.mockESClient.EXPECT().RolloverIndex(gomock.Any(), testAlias, s.config.Conditions()).
+		Return(&es.RolloverResult{OldIndex: testAlias + "-000001", NewIndex: testAlias + "-000002", RolledOver: true}, nil)
+	s.mockMetricClient.On("IncCounter", metrics.IndexRolloverScope, metrics.IndexRolloverCount).Once()
+
+	s.newManager().rollover()
+}
+
+func (s *rolloverManagerSuite) TestRollover_NotRolledOver() {
+	s.mockESClient.EXPECT().RolloverIndex(gomock.Any(), testAlias, s.config.Conditions()).
+		Return(&es.RolloverResult{OldIndex: testAlias + "-000001", RolledOver: false}, nil)
+
+	s.newManager().rollover()
+}
+
+func (s *rolloverManagerSuite) TestRollover_Error() {
+	s.mockESClient.EXPECT().RolloverIndex(gomock.Any(), testAlias, s.config.Conditions()).
+		Return(nil, errors.New("some error"))
+	s.mockMetricClient.On("IncCounter", metrics.IndexRolloverScope, metrics.IndexRolloverFailures).Once()
+
+	s.newManager().rollover()
+}
+
+func (s *rolloverManagerSuite) TestDocIndexResolver_ExistingRecord() {
+	resolver := newDocIndexResolver(s.mockESClient, testAlias)
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{Hits: []*elastic.SearchHit{{Index: testAlias + "-000001", Id: "wid~rid"}}},
+	}, nil).Times(1)
+
+	for i := 0; i < 2; i++ {
+		index, err := resolver.resolve("wid~rid")
+		s.NoError(err)
+		s.Equal(testAlias+"-000001", index)
+	}
+}
+
+func (s *rolloverManagerSuite) TestDocIndexResolver_NewRecord() {
+	resolver := newDocIndexResolver(s.mockESClient, testAlias)
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{},
+	}, nil).Times(2)
+
+	// record which is not searchable yet is looked up again
+	for i := 0; i < 2; i++ {
+		index, err := resolver.resolve("wid~rid")
+		s.NoError(err)
+		s.Equal(testAlias, index)
+	}
+}
+
+func (s *rolloverManagerSuite) TestDocIndexResolver_Forget() {
+	resolver := newDocIndexResolver(s.mockESClient, testAlias)
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&elastic.SearchResult{
+		Hits: &elastic.SearchHits{Hits: []*elastic.SearchHit{{Index: testAlias + "-000001", Id: "wid~rid"}}},
+	}, nil).Times(2)
+
+	_, err := resolver.resolve("wid~rid")
+	s.NoError(err)
+	resolver.forget("wid~rid")
+	_, err = resolver.resolve("wid~rid")
+	s.NoError(err)
+}
+
+func (s *rolloverManagerSuite) TestDocIndexResolver_Error() {
+	resolver := newDocIndexResolver(s.mockESClient, testAlias)
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+
+	_, err := resolver.resolve("wid~rid")
+	s.Error(err)
+}
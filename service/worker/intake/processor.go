@@ -0,0 +1,303 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package intake
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/client/history"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+const (
+	readBatchSize       = 100
+	pollInterval        = time.Second
+	applyRequestTimeout = 10 * time.Second
+	// maxApplyAttempts bounds attempts to apply a message which fails with an error specific to the message,
+	// the message is rejected to the dead-letter queue after that
+	maxApplyAttempts = 5
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap the intake processor
+	BootstrapParams struct {
+		IntakeQueue   persistence.IntakeQueue
+		HistoryClient history.Client
+		// RPS is the max rate at which requests are applied
+		RPS           dynamicconfig.IntPropertyFn
+		MetricsClient metrics.Client
+		Logger        log.Logger
+	}
+
+	// Processor applies StartWorkflowExecution and SignalWorkflowExecution requests accepted by frontend
+	// to the intake queue, at a rate history is able to sustain. Partitions of the queue are processed
+	// concurrently, messages of a partition in order. Requests are applied with their request IDs, so
+	// requests replayed after a crash before ack are deduplicated by history. Requests which can't be
+	// applied are rejected to the dead-letter queue of the partition.
+	Processor struct {
+		intakeQueue   persistence.IntakeQueue
+		historyClient history.Client
+		rateLimiter   quotas.RateLimiter
+		retryPolicy   backoff.RetryPolicy
+		metricsScope  metrics.Scope
+		logger        log.Logger
+
+		sync.Mutex
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+	}
+)
+
+// New returns a new instance of intake processor
+func New(params *BootstrapParams) *Processor {
+	retryPolicy := backoff.NewExponentialRetryPolicy(100 * time.Millisecond)
+	retryPolicy.SetMaximumInterval(10 * time.Second)
+	retryPolicy.SetExpirationInterval(backoff.NoInterval)
+
+	return &Processor{
+		intakeQueue:   params.IntakeQueue,
+		historyClient: params.HistoryClient,
+		rateLimiter: quotas.NewDefaultOutgoingDynamicRateLimiter(
+			func() float64 { return float64(params.RPS()) },
+		),
+		retryPolicy:  retryPolicy,
+		metricsScope: params.MetricsClient.Scope(metrics.IntakeProcessorScope),
+		logger:       params.Logger.WithTags(tag.ComponentIntakeProcessor),
+	}
+}
+
+// Start starts processing of the intake queue
+func (p *Processor) Start() error {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.shutdownCh != nil {
+		return nil
+	}
+	p.shutdownCh = make(chan struct{})
+	for partition := int32(0); partition < persistence.IntakeQueuePartitionCount; partition++ {
+		p.shutdownWG.Add(1)
+		go p.processLoop(partition, p.shutdownCh)
+	}
+	p.logger.Info("Intake processor started.")
+	return nil
+}
+
+// Stop stops processing of the intake queue, requests which are not acked yet are applied again
+// by the worker host which takes over
+func (p *Processor) Stop() {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.shutdownCh == nil {
+		return
+	}
+	close(p.shutdownCh)
+	p.shutdownCh = nil
+	p.shutdownWG.Wait()
+	p.logger.Info("Intake processor stopped.")
+}
+
+func (p *Processor) processLoop(
+	partition int32,
+	shutdownCh <-chan struct{},
+) {
+	defer p.shutdownWG.Done()
+
+	logger := p.logger.WithTags(tag.IntakePartition(partition))
+	ackLevel := persistence.EmptyQueueMessageID
+	loaded := false
+	for {
+		if !loaded {
+			var err error
+			if ackLevel, err = p.intakeQueue.GetAckLevel(partition); err != nil {
+				logger.Warn("Failed to load intake queue ack level.", tag.Error(err))
+			} else {
+				loaded = true
+			}
+		}
+
+		processed := 0
+		if loaded {
+			var err error
+			if processed, ackLevel, err = p.processBatch(partition, ackLevel, shutdownCh); err != nil {
+				logger.Warn("Failed to process intake queue.", tag.Error(err))
+			}
+		}
+
+		if processed == readBatchSize {
+			continue
+		}
+		select {
+		case <-shutdownCh:
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// processBatch applies next batch of messages of the partition after ackLevel and returns number of
+// messages processed and the new ack level
+func (p *Processor) processBatch(
+	partition int32,
+	ackLevel int64,
+	shutdownCh <-chan struct{},
+) (int, int64, error) {
+
+	messages, err := p.intakeQueue.ReadMessages(partition, ackLevel, readBatchSize)
+	if err != nil {
+		return 0, ackLevel, err
+	}
+
+	processed := 0
+	for _, message := range messages {
+		if !p.apply(message, shutdownCh) {
+			break
+		}
+		processed++
+	}
+	if processed == 0 {
+		return 0, ackLevel, nil
+	}
+
+	lastMessageID := messages[processed-1].MessageID
+	if err := p.intakeQueue.Ack(partition, lastMessageID); err != nil {
+		// messages are applied again after restart, history deduplicates them by request ID
+		p.logger.Warn("Failed to ack intake queue messages.", tag.IntakePartition(partition), tag.Error(err))
+	}
+	return processed, lastMessageID, nil
+}
+
+// apply applies the message or rejects it to the dead-letter queue. Errors of an unavailable history are
+// retried until shutdown, other errors are retried up to maxApplyAttempts. It returns false if processor
+// is shutting down or the message could not be rejected, the message is processed again then.
+func (p *Processor) apply(
+	message *persistence.IntakeMessage,
+	shutdownCh <-chan struct{},
+) bool {
+
+	op := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), applyRequestTimeout)
+		defer cancel()
+		if err := p.rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		return p.applyRequest(ctx, message)
+	}
+	attempts := 0
+	isRetryable := func(err error) bool {
+		select {
+		case <-shutdownCh:
+			return false
+		default:
+		}
+		if isHistoryUnavailableError(err) {
+			return true
+		}
+		attempts++
+		return attempts < maxApplyAttempts && common.IsServiceTransientError(err)
+	}
+
+	err := backoff.Retry(op, p.retryPolicy, isRetryable)
+	if err == nil {
+		p.metricsScope.IncCounter(metrics.IntakeProcessedCount)
+		p.metricsScope.RecordTimer(metrics.IntakeLag, time.Since(message.EnqueueTime))
+		return true
+	}
+
+	select {
+	case <-shutdownCh:
+		return false
+	default:
+	}
+	if rejectErr := p.intakeQueue.Reject(message, err); rejectErr != nil {
+		p.logger.Warn("Failed to reject intake request.",
+			tag.WorkflowNamespaceID(message.NamespaceID),
+			tag.WorkflowID(messageWorkflowID(message)),
+			tag.Error(rejectErr))
+		return false
+	}
+	p.metricsScope.IncCounter(metrics.IntakeRejectedCount)
+	p.metricsScope.RecordTimer(metrics.IntakeLag, time.Since(message.EnqueueTime))
+	p.logger.Warn("Rejected intake request which can't be applied.",
+		tag.WorkflowNamespaceID(message.NamespaceID),
+		tag.WorkflowID(messageWorkflowID(message)),
+		tag.Error(err))
+	return true
+}
+
+func (p *Processor) applyRequest(
+	ctx context.Context,
+	message *persistence.IntakeMessage,
+) error {
+	if message.StartRequest != nil {
+		_, err := p.historyClient.StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(
+			message.NamespaceID,
+			message.StartRequest,
+			nil,
+			time.Now().UTC(),
+		))
+		return err
+	}
+
+	_, err := p.historyClient.SignalWorkflowExecution(ctx, &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId:   message.NamespaceID,
+		SignalRequest: message.SignalRequest,
+	})
+	return err
+}
+
+// isHistoryUnavailableError returns whether the error is caused by history being unavailable rather than
+// by the message, such errors don't count towards attempts of the message
+func isHistoryUnavailableError(
+	err error,
+) bool {
+	switch err.(type) {
+	case *serviceerror.Unavailable, *serviceerror.ResourceExhausted:
+		return true
+	}
+	return common.IsContextDeadlineExceededErr(err)
+}
+
+func messageWorkflowID(
+	message *persistence.IntakeMessage,
+) string {
+	if message.StartRequest != nil {
+		return message.StartRequest.GetWorkflowId()
+	}
+	return message.SignalRequest.GetWorkflowExecution().GetWorkflowId()
+}
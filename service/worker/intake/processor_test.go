@@ -0,0 +1,315 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package intake
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	processorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller    *gomock.Controller
+		intakeQueue   *persistence.MockIntakeQueue
+		historyClient *historyservicemock.MockHistoryServiceClient
+		processor     *Processor
+		shutdownCh    chan struct{}
+	}
+)
+
+const (
+	testNamespaceID = "deadbeef-0123-4567-890a-bcdef0123456"
+	testPartition   = int32(3)
+)
+
+func TestProcessorSuite(t *testing.T) {
+	suite.Run(t, new(processorSuite))
+}
+
+func (s *processorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.intakeQueue = persistence.NewMockIntakeQueue(s.controller)
+	s.historyClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.processor = New(&BootstrapParams{
+		IntakeQueue:   s.intakeQueue,
+		HistoryClient: s.historyClient,
+		RPS:           dynamicconfig.GetIntPropertyFn(1000),
+		MetricsClient: metrics.NewClient(tally.NoopScope, metrics.Worker),
+		Logger:        loggerimpl.NewNopLogger(),
+	})
+	retryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	retryPolicy.SetMaximumInterval(10 * time.Millisecond)
+	retryPolicy.SetExpirationInterval(backoff.NoInterval)
+	s.processor.retryPolicy = retryPolicy
+	s.shutdownCh = make(chan struct{})
+}
+
+func (s *processorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *processorSuite) TestProcessBatch_Empty() {
+	s.intakeQueue.EXPECT().ReadMessages(testPartition, int64(5), readBatchSize).Return(nil, nil)
+
+	processed, ackLevel, err := s.processor.processBatch(testPartition, 5, s.shutdownCh)
+	s.NoError(err)
+	s.Equal(0, processed)
+	s.Equal(int64(5), ackLevel)
+}
+
+func (s *processorSuite) TestProcessBatch_ReadError() {
+	s.intakeQueue.EXPECT().ReadMessages(testPartition, int64(5), readBatchSize).Return(nil, errors.New("some random error"))
+
+	processed, ackLevel, err := s.processor.processBatch(testPartition, 5, s.shutdownCh)
+	s.Error(err)
+	s.Equal(0, processed)
+	s.Equal(int64(5), ackLevel)
+}
+
+func (s *processorSuite) TestProcessBatch_AppliesAndAcks() {
+	messages := []*persistence.IntakeMessage{
+		s.newStartMessage(6, "wid-1"),
+		s.newSignalMessage(7, "wid-2"),
+		s.newStartMessage(8, "wid-3"),
+	}
+	s.intakeQueue.EXPECT().ReadMessages(testPartition, int64(5), readBatchSize).Return(messages, nil)
+	gomock.InOrder(
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, request *historyservice.StartWorkflowExecutionRequest, _ ...interface{}) (*historyservice.StartWorkflowExecutionResponse, error) {
+				s.Equal(testNamespaceID, request.GetNamespaceId())
+				s.Equal("wid-1", request.GetStartRequest().GetWorkflowId())
+				return &historyservice.StartWorkflowExecutionResponse{}, nil
+			}),
+		s.historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, request *historyservice.SignalWorkflowExecutionRequest, _ ...interface{}) (*historyservice.SignalWorkflowExecutionResponse, error) {
+				s.Equal(testNamespaceID, request.GetNamespaceId())
+				s.Equal("wid-2", request.GetSignalRequest().GetWorkflowExecution().GetWorkflowId())
+				return &historyservice.SignalWorkflowExecutionResponse{}, nil
+			}),
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+			&historyservice.StartWorkflowExecutionResponse{}, nil),
+	)
+	s.intakeQueue.EXPECT().Ack(testPartition, int64(8)).Return(nil)
+
+	processed, ackLevel, err := s.processor.processBatch(testPartition, 5, s.shutdownCh)
+	s.NoError(err)
+	s.Equal(3, processed)
+	s.Equal(int64(8), ackLevel)
+}
+
+func (s *processorSuite) TestProcessBatch_RetriesTransientError() {
+	s.intakeQueue.EXPECT().ReadMessages(testPartition, int64(5), readBatchSize).Return([]*persistence.IntakeMessage{
+		s.newStartMessage(6, "wid-1"),
+	}, nil)
+	gomock.InOrder(
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+			nil, serviceerror.NewUnavailable("unavailable")),
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+			&historyservice.StartWorkflowExecutionResponse{}, nil),
+	)
+	s.intakeQueue.EXPECT().Ack(testPartition, int64(6)).Return(nil)
+
+	processed, ackLevel, err := s.processor.processBatch(testPartition, 5, s.shutdownCh)
+	s.NoError(err)
+	s.Equal(1, processed)
+	s.Equal(int64(6), ackLevel)
+}
+
+func (s *processorSuite) TestProcessBatch_RejectsNonRetryableError() {
+	messages := []*persistence.IntakeMessage{
+		s.newSignalMessage(6, "wid-1"),
+		s.newStartMessage(7, "wid-2"),
+		s.newSignalMessage(8, "wid-3"),
+	}
+	s.intakeQueue.EXPECT().ReadMessages(testPartition, int64(5), readBatchSize).Return(messages, nil)
+	notFound := serviceerror.NewNotFound("workflow not found")
+	alreadyStarted := serviceerror.NewWorkflowExecutionAlreadyStarted("started", "request-id", "run-id")
+	gomock.InOrder(
+		s.historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, notFound),
+		s.intakeQueue.EXPECT().Reject(messages[0], notFound).Return(nil),
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, alreadyStarted),
+		s.intakeQueue.EXPECT().Reject(messages[1], alreadyStarted).Return(nil),
+		s.historyClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+			&historyservice.SignalWorkflowExecutionResponse{}, nil),
+	)
+	s.intakeQueue.EXPECT().Ack(testPartition, int64(8)).Return(nil)
+
+	processed, ackLevel, err := s.processor.processBatch(testPartition, 5, s.shutdownCh)
+	s.NoError(err)
+	s.Equal(3, processed)
+	s.Equal(int64(8), ackLevel)
+}
+
+func (s *processorSuite) TestProcessBatch_RejectsAfterMaxAttempts() {
+	messages := []*persistence.IntakeMessage{
+		s.newStartMessage(6, "wid-1"),
+		s.newStartMessage(7, "wid-2"),
+	}
+	s.intakeQueue.EXPECT().ReadMessages(testPartition, int64(5), readBatchSize).Return(messages, nil)
+	internal := serviceerror.NewInternal("corrupted execution")
+	gomock.InOrder(
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, internal).Times(maxApplyAttempts),
+		s.intakeQueue.EXPECT().Reject(messages[0], internal).Return(nil),
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+			&historyservice.StartWorkflowExecutionResponse{}, nil),
+	)
+	s.intakeQueue.EXPECT().Ack(testPartition, int64(7)).Return(nil)
+
+	processed, ackLevel, err := s.processor.processBatch(testPartition, 5, s.shutdownCh)
+	s.NoError(err)
+	s.Equal(2, processed)
+	s.Equal(int64(7), ackLevel)
+}
+
+func (s *processorSuite) TestProcessBatch_UnavailableDoesNotCountAsAttempt() {
+	s.intakeQueue.EXPECT().ReadMessages(testPartition, int64(5), readBatchSize).Return([]*persistence.IntakeMessage{
+		s.newStartMessage(6, "wid-1"),
+	}, nil)
+	gomock.InOrder(
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+			nil, serviceerror.NewInternal("internal")).Times(maxApplyAttempts-1),
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+			nil, serviceerror.NewUnavailable("unavailable")).Times(2*maxApplyAttempts),
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+			&historyservice.StartWorkflowExecutionResponse{}, nil),
+	)
+	s.intakeQueue.EXPECT().Ack(testPartition, int64(6)).Return(nil)
+
+	processed, ackLevel, err := s.processor.processBatch(testPartition, 5, s.shutdownCh)
+	s.NoError(err)
+	s.Equal(1, processed)
+	s.Equal(int64(6), ackLevel)
+}
+
+func (s *processorSuite) TestProcessBatch_RejectFailureKeepsMessageUnacked() {
+	messages := []*persistence.IntakeMessage{
+		s.newStartMessage(6, "wid-1"),
+		s.newStartMessage(7, "wid-2"),
+	}
+	s.intakeQueue.EXPECT().ReadMessages(testPartition, int64(5), readBatchSize).Return(messages, nil)
+	notFound := serviceerror.NewNotFound("namespace not found")
+	gomock.InOrder(
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+			&historyservice.StartWorkflowExecutionResponse{}, nil),
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, notFound),
+		s.intakeQueue.EXPECT().Reject(messages[1], notFound).Return(errors.New("some random error")),
+	)
+	s.intakeQueue.EXPECT().Ack(testPartition, int64(6)).Return(nil)
+
+	processed, ackLevel, err := s.processor.processBatch(testPartition, 5, s.shutdownCh)
+	s.NoError(err)
+	s.Equal(1, processed)
+	s.Equal(int64(6), ackLevel)
+}
+
+func (s *processorSuite) TestProcessBatch_ShutdownKeepsMessageUnacked() {
+	s.intakeQueue.EXPECT().ReadMessages(testPartition, int64(5), readBatchSize).Return([]*persistence.IntakeMessage{
+		s.newStartMessage(6, "wid-1"),
+		s.newStartMessage(7, "wid-2"),
+	}, nil)
+	gomock.InOrder(
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+			&historyservice.StartWorkflowExecutionResponse{}, nil),
+		s.historyClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, _ *historyservice.StartWorkflowExecutionRequest, _ ...interface{}) (*historyservice.StartWorkflowExecutionResponse, error) {
+				close(s.shutdownCh)
+				return nil, serviceerror.NewUnavailable("unavailable")
+			}),
+	)
+	s.intakeQueue.EXPECT().Ack(testPartition, int64(6)).Return(nil)
+
+	processed, ackLevel, err := s.processor.processBatch(testPartition, 5, s.shutdownCh)
+	s.NoError(err)
+	s.Equal(1, processed)
+	s.Equal(int64(6), ackLevel)
+}
+
+func (s *processorSuite) TestStartStop() {
+	s.processor.Stop()
+
+	for partition := int32(0); partition < persistence.IntakeQueuePartitionCount; partition++ {
+		s.intakeQueue.EXPECT().GetAckLevel(partition).Return(persistence.EmptyQueueMessageID, nil).MinTimes(1)
+		s.intakeQueue.EXPECT().ReadMessages(partition, persistence.EmptyQueueMessageID, readBatchSize).Return(nil, nil).MinTimes(1)
+	}
+
+	s.NoError(s.processor.Start())
+	s.NoError(s.processor.Start())
+	s.processor.Stop()
+	s.processor.Stop()
+	s.NoError(s.processor.Start())
+	s.processor.Stop()
+}
+
+func (s *processorSuite) newStartMessage(
+	messageID int64,
+	workflowID string,
+) *persistence.IntakeMessage {
+	return &persistence.IntakeMessage{
+		MessageID:   messageID,
+		NamespaceID: testNamespaceID,
+		StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+			WorkflowId: workflowID,
+			RequestId:  workflowID + "-request",
+		},
+		EnqueueTime: time.Now().UTC(),
+	}
+}
+
+func (s *processorSuite) newSignalMessage(
+	messageID int64,
+	workflowID string,
+) *persistence.IntakeMessage {
+	return &persistence.IntakeMessage{
+		MessageID:   messageID,
+		NamespaceID: testNamespaceID,
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+			SignalName:        "signal",
+			RequestId:         workflowID + "-request",
+		},
+		EnqueueTime: time.Now().UTC(),
+	}
+}
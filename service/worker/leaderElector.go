@@ -0,0 +1,223 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	// singletonJob is a background job which must run on at most one worker host of the cluster
+	singletonJob interface {
+		Start() error
+		Stop()
+	}
+
+	// leaderElector runs singleton job only on the worker host which holds the lease of the job in
+	// persistence. The lease is renewed every refresh interval and expires after the lease TTL, so when
+	// the leader host is stopped or can't reach persistence, the job is started on another host once the
+	// lease expires. Every tenure has a fencing token, the leader stops the job if a renewal returns a
+	// different token, i.e. the lease expired and was acquired again in between.
+	leaderElector struct {
+		status          int32
+		name            string
+		leaseID         int32
+		job             singletonJob
+		leaseStore      persistence.LeaderLeaseStore
+		hostInfo        *membership.HostInfo
+		refreshInterval dynamicconfig.DurationPropertyFn
+		leaseTTL        dynamicconfig.DurationPropertyFn
+		metricsScope    metrics.Scope
+		logger          log.Logger
+
+		isLeader    bool
+		leaseToken  int64
+		leaseExpiry time.Time
+		shutdownCh  chan struct{}
+		shutdownWG  sync.WaitGroup
+	}
+)
+
+func newLeaderElector(
+	name string,
+	leaseID int32,
+	job singletonJob,
+	leaseStore persistence.LeaderLeaseStore,
+	hostInfo *membership.HostInfo,
+	refreshInterval dynamicconfig.DurationPropertyFn,
+	leaseTTL dynamicconfig.DurationPropertyFn,
+	metricsScope metrics.Scope,
+	logger log.Logger,
+) *leaderElector {
+	return &leaderElector{
+		status:          common.DaemonStatusInitialized,
+		name:            name,
+		leaseID:         leaseID,
+		job:             job,
+		leaseStore:      leaseStore,
+		hostInfo:        hostInfo,
+		refreshInterval: refreshInterval,
+		leaseTTL:        leaseTTL,
+		metricsScope:    metricsScope,
+		logger:          logger.WithTags(tag.ComponentLeaderElector, tag.Name(name)),
+		shutdownCh:      make(chan struct{}),
+	}
+}
+
+func (e *leaderElector) Start() {
+	if !atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	e.shutdownWG.Add(1)
+	go e.electionPump()
+
+	e.logger.Info("", tag.LifeCycleStarted)
+}
+
+func (e *leaderElector) Stop() {
+	if !atomic.CompareAndSwapInt32(&e.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(e.shutdownCh)
+
+	if success := common.AwaitWaitGroup(&e.shutdownWG, time.Minute); !success {
+		e.logger.Warn("", tag.LifeCycleStopTimedout)
+	}
+
+	e.logger.Info("", tag.LifeCycleStopped)
+}
+
+func (e *leaderElector) electionPump() {
+	defer e.shutdownWG.Done()
+
+	e.elect()
+
+	refreshTimer := time.NewTimer(e.refreshInterval())
+	defer refreshTimer.Stop()
+
+	for {
+		select {
+		case <-e.shutdownCh:
+			if e.isLeader {
+				e.resign()
+				e.release()
+			}
+			return
+		case <-refreshTimer.C:
+			e.elect()
+			refreshTimer.Reset(e.refreshInterval())
+		}
+	}
+}
+
+// elect acquires or renews the lease, starts the job if this host became the leader and stops it if this host
+// lost the lease
+func (e *leaderElector) elect() {
+	now := time.Now().UTC()
+	lease, err := e.leaseStore.AcquireLease(e.leaseID, e.hostInfo.Identity(), e.ttl())
+	if err != nil {
+		e.metricsScope.IncCounter(metrics.LeaderElectionFailures)
+		e.logger.Error("Failed to acquire lease", tag.Error(err))
+		// the job must be stopped before the lease expires, as another host may acquire it afterwards
+		if e.isLeader && !now.Add(e.refreshInterval()).Before(e.leaseExpiry) {
+			e.resign()
+			e.logger.Info("Lost leadership, lease is about to expire")
+		}
+		return
+	}
+
+	isLeader := lease.Owner == e.hostInfo.Identity() && now.Before(lease.ExpiryTime)
+	if e.isLeader && (!isLeader || lease.Token != e.leaseToken) {
+		e.resign()
+		e.logger.Info("Lost leadership", tag.HostID(lease.Owner))
+	}
+	if !isLeader {
+		e.updateLeaderGauge()
+		return
+	}
+
+	e.leaseToken = lease.Token
+	e.leaseExpiry = lease.ExpiryTime
+	if !e.isLeader {
+		if err := e.job.Start(); err != nil {
+			e.metricsScope.IncCounter(metrics.LeaderElectionFailures)
+			e.logger.Error("Failed to start job after acquiring leadership", tag.Error(err))
+			e.job.Stop()
+			e.release()
+			return
+		}
+		e.isLeader = true
+		e.metricsScope.IncCounter(metrics.LeadershipAcquiredCount)
+		e.logger.Info("Acquired leadership", tag.HostID(e.hostInfo.Identity()), tag.Number(e.leaseToken))
+	}
+	e.updateLeaderGauge()
+}
+
+func (e *leaderElector) resign() {
+	if !e.isLeader {
+		return
+	}
+	e.job.Stop()
+	e.isLeader = false
+	e.metricsScope.IncCounter(metrics.LeadershipLostCount)
+	e.updateLeaderGauge()
+}
+
+// release gives up the lease, so that another host does not have to wait for it to expire
+func (e *leaderElector) release() {
+	if err := e.leaseStore.ReleaseLease(e.leaseID, e.hostInfo.Identity(), e.leaseToken); err != nil {
+		e.logger.Warn("Failed to release lease", tag.Error(err))
+	}
+}
+
+// ttl returns the lease TTL, which is at least twice the refresh interval so the lease does not expire
+// between renewals
+func (e *leaderElector) ttl() time.Duration {
+	ttl := e.leaseTTL()
+	if minTTL := 2 * e.refreshInterval(); ttl < minTTL {
+		ttl = minTTL
+	}
+	return ttl
+}
+
+func (e *leaderElector) updateLeaderGauge() {
+	value := float64(0)
+	if e.isLeader {
+		value = 1
+	}
+	e.metricsScope.UpdateGauge(metrics.IsLeaderGauge, value)
+}
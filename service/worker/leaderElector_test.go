@@ -0,0 +1,206 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package worker
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	leaderElectorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller     *gomock.Controller
+		mockLeaseStore *persistence.MockLeaderLeaseStore
+
+		self    *membership.HostInfo
+		other   *membership.HostInfo
+		job     *testSingletonJob
+		elector *leaderElector
+	}
+
+	testSingletonJob struct {
+		startErr error
+		starts   int32
+		stops    int32
+	}
+)
+
+const (
+	testLeaseID int32 = 7
+)
+
+func TestLeaderElectorSuite(t *testing.T) {
+	s := new(leaderElectorSuite)
+	suite.Run(t, s)
+}
+
+func (s *leaderElectorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockLeaseStore = persistence.NewMockLeaderLeaseStore(s.controller)
+
+	s.self = membership.NewHostInfo("127.0.0.1:7239", nil)
+	s.other = membership.NewHostInfo("127.0.0.2:7239", nil)
+	s.job = &testSingletonJob{}
+	s.elector = newLeaderElector(
+		"test-job",
+		testLeaseID,
+		s.job,
+		s.mockLeaseStore,
+		s.self,
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		dynamicconfig.GetDurationPropertyFn(3*time.Minute),
+		metrics.NoopScope(metrics.Worker),
+		loggerimpl.NewNopLogger(),
+	)
+}
+
+func (s *leaderElectorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *leaderElectorSuite) expectAcquire(owner *membership.HostInfo, token int64, ttl time.Duration) {
+	s.mockLeaseStore.EXPECT().AcquireLease(testLeaseID, s.self.Identity(), 3*time.Minute).Return(&persistence.LeaderLease{
+		Owner:      owner.Identity(),
+		Token:      token,
+		ExpiryTime: time.Now().UTC().Add(ttl),
+	}, nil)
+}
+
+func (s *leaderElectorSuite) TestElect_AcquireAndLoseLeadership() {
+	s.expectAcquire(s.other, 1, 3*time.Minute)
+	s.elector.elect()
+	s.False(s.elector.isLeader)
+	s.Equal(int32(0), s.job.startCount())
+
+	s.expectAcquire(s.self, 2, 3*time.Minute)
+	s.expectAcquire(s.self, 2, 3*time.Minute)
+	s.elector.elect()
+	s.elector.elect()
+	s.True(s.elector.isLeader)
+	s.Equal(int64(2), s.elector.leaseToken)
+	s.Equal(int32(1), s.job.startCount())
+
+	s.expectAcquire(s.other, 3, 3*time.Minute)
+	s.elector.elect()
+	s.False(s.elector.isLeader)
+	s.Equal(int32(1), s.job.stopCount())
+}
+
+func (s *leaderElectorSuite) TestElect_TokenChanged() {
+	s.expectAcquire(s.self, 2, 3*time.Minute)
+	s.elector.elect()
+	s.True(s.elector.isLeader)
+
+	// lease expired and was acquired again in between, job of the previous tenure is stopped
+	s.expectAcquire(s.self, 5, 3*time.Minute)
+	s.elector.elect()
+	s.True(s.elector.isLeader)
+	s.Equal(int64(5), s.elector.leaseToken)
+	s.Equal(int32(1), s.job.stopCount())
+	s.Equal(int32(2), s.job.startCount())
+}
+
+func (s *leaderElectorSuite) TestElect_AcquireError() {
+	s.expectAcquire(s.self, 2, 3*time.Minute)
+	s.elector.elect()
+	s.True(s.elector.isLeader)
+
+	// leadership is kept while lease does not expire before next renewal
+	s.mockLeaseStore.EXPECT().AcquireLease(testLeaseID, s.self.Identity(), 3*time.Minute).Return(nil, errors.New("some error"))
+	s.elector.elect()
+	s.True(s.elector.isLeader)
+	s.Equal(int32(0), s.job.stopCount())
+
+	s.elector.leaseExpiry = time.Now().UTC().Add(30 * time.Second)
+	s.mockLeaseStore.EXPECT().AcquireLease(testLeaseID, s.self.Identity(), 3*time.Minute).Return(nil, errors.New("some error"))
+	s.elector.elect()
+	s.False(s.elector.isLeader)
+	s.Equal(int32(1), s.job.stopCount())
+}
+
+func (s *leaderElectorSuite) TestElect_JobStartError() {
+	s.job.startErr = errors.New("some error")
+	s.expectAcquire(s.self, 2, 3*time.Minute)
+	s.mockLeaseStore.EXPECT().ReleaseLease(testLeaseID, s.self.Identity(), int64(2)).Return(nil)
+	s.elector.elect()
+	s.False(s.elector.isLeader)
+	s.Equal(int32(1), s.job.stopCount())
+
+	s.job.startErr = nil
+	s.expectAcquire(s.self, 3, 3*time.Minute)
+	s.elector.elect()
+	s.True(s.elector.isLeader)
+	s.Equal(int32(2), s.job.startCount())
+}
+
+func (s *leaderElectorSuite) TestTTL() {
+	s.Equal(3*time.Minute, s.elector.ttl())
+	s.elector.leaseTTL = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	s.Equal(2*time.Minute, s.elector.ttl())
+}
+
+func (s *leaderElectorSuite) TestStartStop() {
+	s.expectAcquire(s.self, 2, 3*time.Minute)
+	s.mockLeaseStore.EXPECT().ReleaseLease(testLeaseID, s.self.Identity(), int64(2)).Return(nil)
+
+	s.elector.Start()
+	s.Eventually(func() bool { return s.job.startCount() > 0 }, time.Second, 10*time.Millisecond)
+	s.elector.Stop()
+	s.Equal(int32(1), s.job.stopCount())
+}
+
+func (j *testSingletonJob) Start() error {
+	atomic.AddInt32(&j.starts, 1)
+	return j.startErr
+}
+
+func (j *testSingletonJob) Stop() {
+	atomic.AddInt32(&j.stops, 1)
+}
+
+func (j *testSingletonJob) startCount() int32 {
+	return atomic.LoadInt32(&j.starts)
+}
+
+func (j *testSingletonJob) stopCount() int32 {
+	return atomic.LoadInt32(&j.stops)
+}
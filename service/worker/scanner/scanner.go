@@ -26,6 +26,7 @@ package scanner
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
@@ -92,6 +93,13 @@ type (
 	// and emit stats for analytics
 	Scanner struct {
 		context scannerContext
+		workers []worker.Worker
+
+		// startCtx is canceled on Stop, so scanner workflows are not started after the scanner
+		// is stopped, e.g. when this host lost leadership
+		startCtx    context.Context
+		startCancel context.CancelFunc
+		startWG     sync.WaitGroup
 	}
 )
 
@@ -121,18 +129,19 @@ func (s *Scanner) Start() error {
 		MaxConcurrentWorkflowTaskExecutionSize: maxConcurrentWorkflowTaskExecutionSize,
 		BackgroundActivityContext:              context.WithValue(context.Background(), scannerContextKey, s.context),
 	}
+	s.startCtx, s.startCancel = context.WithCancel(context.Background())
 
 	var workerTaskQueueNames []string
 	if s.context.cfg.ExecutionsScannerEnabled() {
 		workerTaskQueueNames = append(workerTaskQueueNames, executionsScannerTaskQueueName)
-		go s.startWorkflowWithRetry(executionsScannerWFStartOptions, executionsScannerWFTypeName, defaultExecutionsScannerParams)
+		s.startWorkflowAsync(executionsScannerWFStartOptions, executionsScannerWFTypeName, defaultExecutionsScannerParams)
 	}
 
 	if s.context.cfg.Persistence.DefaultStoreType() == config.StoreTypeSQL && s.context.cfg.TaskQueueScannerEnabled() {
-		go s.startWorkflowWithRetry(tlScannerWFStartOptions, tqScannerWFTypeName)
+		s.startWorkflowAsync(tlScannerWFStartOptions, tqScannerWFTypeName)
 		workerTaskQueueNames = append(workerTaskQueueNames, tqScannerTaskQueueName)
 	} else if s.context.cfg.Persistence.DefaultStoreType() == config.StoreTypeNoSQL && s.context.cfg.HistoryScannerEnabled() {
-		go s.startWorkflowWithRetry(historyScannerWFStartOptions, historyScannerWFTypeName)
+		s.startWorkflowAsync(historyScannerWFStartOptions, historyScannerWFTypeName)
 		workerTaskQueueNames = append(workerTaskQueueNames, historyScannerTaskQueueName)
 	}
 
@@ -147,43 +156,80 @@ func (s *Scanner) Start() error {
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
 
 		if err := work.Start(); err != nil {
+			s.Stop()
 			return err
 		}
+		s.workers = append(s.workers, work)
 	}
 
 	return nil
 }
 
+// Stop stops the scanner workers and cancels starts of scanner workflows
+func (s *Scanner) Stop() {
+	if s.startCancel != nil {
+		s.startCancel()
+	}
+	s.startWG.Wait()
+	for _, work := range s.workers {
+		work.Stop()
+	}
+	s.workers = nil
+}
+
+func (s *Scanner) startWorkflowAsync(
+	options sdkclient.StartWorkflowOptions,
+	workflowType string,
+	workflowArgs ...interface{},
+) {
+	s.startWG.Add(1)
+	go func() {
+		defer s.startWG.Done()
+		s.startWorkflowWithRetry(s.startCtx, options, workflowType, workflowArgs...)
+	}()
+}
+
+// startWorkflowWithRetry starts the workflow, retrying until it succeeds or ctx is canceled
 func (s *Scanner) startWorkflowWithRetry(
+	ctx context.Context,
 	options sdkclient.StartWorkflowOptions,
 	workflowType string,
 	workflowArgs ...interface{},
 ) {
 
 	// let history / matching service warm up
-	time.Sleep(scannerStartUpDelay)
+	delay := scannerStartUpDelay
 
 	policy := backoff.NewExponentialRetryPolicy(time.Second)
 	policy.SetMaximumInterval(time.Minute)
 	policy.SetExpirationInterval(backoff.NoInterval)
-	err := backoff.Retry(func() error {
-		return s.startWorkflow(s.context.GetSDKClient(), options, workflowType, workflowArgs)
-	}, policy, func(err error) bool {
-		return true
-	})
-	if err != nil {
-		s.context.GetLogger().Fatal("unable to start scanner", tag.WorkflowType(workflowType), tag.Error(err))
+	retrier := backoff.NewRetrier(policy, backoff.SystemClock)
+	for {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.context.GetLogger().Info("Scanner workflow start canceled", tag.WorkflowType(workflowType))
+			return
+		case <-timer.C:
+		}
+
+		if err := s.startWorkflow(ctx, s.context.GetSDKClient(), options, workflowType, workflowArgs); err == nil {
+			return
+		}
+		delay = retrier.NextBackOff()
 	}
 }
 
 func (s *Scanner) startWorkflow(
+	parentCtx context.Context,
 	client sdkclient.Client,
 	options sdkclient.StartWorkflowOptions,
 	workflowType string,
 	workflowArgs ...interface{},
 ) error {
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	ctx, cancel := context.WithTimeout(parentCtx, 5*time.Minute)
 	_, err := client.ExecuteWorkflow(ctx, options, workflowType, workflowArgs)
 	cancel()
 	if err != nil {
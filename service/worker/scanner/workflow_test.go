@@ -92,3 +92,25 @@ func (s *scannerWorkflowTestSuite) TestScavengerActivity() {
 	_, err := env.ExecuteActivity(taskQueueScavengerActivityName)
 	s.NoError(err)
 }
+
+func (s *scannerWorkflowTestSuite) TestStopCancelsWorkflowStarts() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	mockResource := resource.NewTest(controller, metrics.Worker)
+	defer mockResource.Finish(s.T())
+
+	scanner := New(mockResource, &BootstrapParams{})
+	scanner.startCtx, scanner.startCancel = context.WithCancel(context.Background())
+	scanner.startWorkflowAsync(historyScannerWFStartOptions, historyScannerWFTypeName)
+
+	stopped := make(chan struct{})
+	go func() {
+		scanner.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(scannerStartUpDelay / 2):
+		s.Fail("scanner workflow start was not canceled on stop")
+	}
+}
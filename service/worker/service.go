@@ -29,11 +29,14 @@ import (
 	"time"
 
 	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/healthcheck"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
@@ -41,12 +44,29 @@ import (
 	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/service/worker/archiver"
 	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/canary"
 	"go.temporal.io/server/service/worker/indexer"
+	"go.temporal.io/server/service/worker/intake"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
 	"go.temporal.io/server/service/worker/scanner"
 )
 
+const (
+	scannerLeaderElectionKey = "temporal-sys-scanner-leader"
+	batcherLeaderElectionKey = "temporal-sys-batcher-leader"
+	canaryLeaderElectionKey  = "temporal-sys-canary-leader"
+	intakeLeaderElectionKey  = "temporal-sys-intake-processor-leader"
+)
+
+// Lease IDs of singleton jobs, they identify leases in persistence and must not be changed
+const (
+	scannerLeaderLeaseID int32 = iota + 1
+	batcherLeaderLeaseID
+	canaryLeaderLeaseID
+	intakeLeaderLeaseID
+)
+
 type (
 	// Service represents the temporal-worker service. This service hosts all background processing needed for temporal cluster:
 	// 1. Replicator: Handles applying replication tasks generated by remote clusters.
@@ -59,6 +79,11 @@ type (
 		stopC  chan struct{}
 		params *resource.BootstrapParams
 		config *Config
+
+		leaderElectors []*leaderElector
+
+		server        *grpc.Server
+		healthChecker *healthcheck.Checker
 	}
 
 	// Config contains all the service config for worker
@@ -71,9 +96,14 @@ type (
 		ThrottledLogRPS               dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS       dynamicconfig.IntPropertyFn
 		EnableBatcher                 dynamicconfig.BoolPropertyFn
+		EnableCanary                  dynamicconfig.BoolPropertyFn
+		EnableIntakeProcessor         dynamicconfig.BoolPropertyFn
+		IntakeProcessorRPS            dynamicconfig.IntPropertyFn
 		VisibilityQueue               dynamicconfig.StringPropertyFn
 		VisibilityProcessorEnabled    dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker dynamicconfig.BoolPropertyFn
+		LeaderElectionRefreshInterval dynamicconfig.DurationPropertyFn
+		LeaderLeaseTTL                dynamicconfig.DurationPropertyFn
 	}
 )
 
@@ -143,11 +173,16 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 			ClusterMetadata: params.ClusterMetadata,
 		},
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
+		EnableCanary:                  dc.GetBoolProperty(dynamicconfig.EnableCanary, false),
+		EnableIntakeProcessor:         dc.GetBoolProperty(dynamicconfig.EnableIntakeProcessor, true),
+		IntakeProcessorRPS:            dc.GetIntProperty(dynamicconfig.IntakeProcessorRPS, 100),
 		VisibilityQueue:               dc.GetStringProperty(dynamicconfig.VisibilityQueue, common.VisibilityQueueInternalWithDualProcessor),
 		VisibilityProcessorEnabled:    dc.GetBoolProperty(dynamicconfig.VisibilityProcessorEnabled, true),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 		PersistenceGlobalMaxQPS:       dc.GetIntProperty(dynamicconfig.WorkerPersistenceGlobalMaxQPS, 0),
+		LeaderElectionRefreshInterval: dc.GetDurationProperty(dynamicconfig.WorkerLeaderElectionRefreshInterval, time.Minute),
+		LeaderLeaseTTL:                dc.GetDurationProperty(dynamicconfig.WorkerLeaderLeaseTTL, 3*time.Minute),
 	}
 	advancedVisWritingMode := dc.GetStringProperty(
 		dynamicconfig.AdvancedVisibilityWritingMode,
@@ -178,6 +213,7 @@ func (s *Service) Start() {
 	logger.Info("worker starting", tag.ComponentWorker)
 
 	s.Resource.Start()
+	s.startHealthServer()
 
 	s.ensureSystemNamespaceExists()
 	s.startScanner()
@@ -197,6 +233,15 @@ func (s *Service) Start() {
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
+	if s.config.EnableCanary() {
+		s.startCanary()
+	} else {
+		// canary workflow of a previous run keeps being scheduled until it is terminated
+		go canary.TerminateWorkflowWithRetry(s.params.PublicClient, s.GetLogger().WithTags(tag.ComponentCanary), s.stopC)
+	}
+	if s.config.EnableIntakeProcessor() {
+		s.startIntakeProcessor()
+	}
 
 	logger.Info("worker started", tag.ComponentWorker)
 	<-s.stopC
@@ -208,13 +253,48 @@ func (s *Service) Stop() {
 		return
 	}
 
+	s.healthChecker.Stop()
 	close(s.stopC)
 
+	for _, elector := range s.leaderElectors {
+		elector.Stop()
+	}
+
+	if drainer, ok := s.params.RPCFactory.(common.GRPCServerDrainer); ok {
+		drainer.DrainGRPCServer(s.server)
+	} else {
+		s.server.Stop()
+	}
 	s.Resource.Stop()
 
 	s.params.Logger.Info("worker stopped", tag.ComponentWorker)
 }
 
+// startHealthServer serves grpc health checks on the worker listener, worker has no other rpc handlers
+func (s *Service) startHealthServer() {
+	opts, err := s.params.RPCFactory.GetInternodeGRPCServerOptions()
+	if err != nil {
+		s.GetLogger().Fatal("creating grpc server options failed", tag.Error(err))
+	}
+	s.server = grpc.NewServer(opts...)
+	s.healthChecker = healthcheck.NewChecker(
+		common.WorkerServiceName,
+		s.GetMembershipMonitor(),
+		s.GetClusterMetadataManager(),
+		s.GetLogger(),
+	)
+	s.healthChecker.Register(s.server)
+	s.healthChecker.Start()
+
+	listener := s.GetGRPCListener()
+	go func() {
+		s.GetLogger().Info("Starting to serve on worker listener")
+		if err := s.server.Serve(listener); err != nil {
+			s.GetLogger().Fatal("Failed to serve on worker listener", tag.Error(err))
+		}
+	}()
+}
+
 func (s *Service) startParentClosePolicyProcessor() {
 	params := &parentclosepolicy.BootstrapParams{
 		ServiceClient: s.params.PublicClient,
@@ -236,18 +316,58 @@ func (s *Service) startBatcher() {
 		Logger:        s.GetLogger(),
 		ClientBean:    s.GetClientBean(),
 	}
-	if err := batcher.New(params).Start(); err != nil {
-		s.GetLogger().Fatal("error starting batcher", tag.Error(err))
+	s.startLeaderElector(batcherLeaderElectionKey, batcherLeaderLeaseID, batcher.New(params), metrics.BatcherLeaderElectionScope)
+}
+
+func (s *Service) startCanary() {
+	params := &canary.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		Enabled:       s.config.EnableCanary,
+	}
+	s.startLeaderElector(canaryLeaderElectionKey, canaryLeaderLeaseID, canary.New(params), metrics.CanaryLeaderElectionScope)
+}
+
+func (s *Service) startIntakeProcessor() {
+	params := &intake.BootstrapParams{
+		IntakeQueue:   s.GetIntakeQueue(),
+		HistoryClient: s.GetHistoryClient(),
+		RPS:           s.config.IntakeProcessorRPS,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
 	}
+	s.startLeaderElector(intakeLeaderElectionKey, intakeLeaderLeaseID, intake.New(params), metrics.IntakeProcessorLeaderElectionScope)
 }
 
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config: *s.config.ScannerCfg,
 	}
-	if err := scanner.New(s.Resource, params).Start(); err != nil {
-		s.GetLogger().Fatal("error starting scanner", tag.Error(err))
-	}
+	s.startLeaderElector(scannerLeaderElectionKey, scannerLeaderLeaseID, scanner.New(s.Resource, params), metrics.ScannerLeaderElectionScope)
+}
+
+// startLeaderElector runs singleton job on the worker host which holds its lease,
+// so the job does not run on several hosts at once during deploys
+func (s *Service) startLeaderElector(
+	name string,
+	leaseID int32,
+	job singletonJob,
+	metricsScope int,
+) {
+	elector := newLeaderElector(
+		name,
+		leaseID,
+		job,
+		s.GetLeaderLeaseStore(),
+		s.GetHostInfo(),
+		s.config.LeaderElectionRefreshInterval,
+		s.config.LeaderLeaseTTL,
+		s.GetMetricsClient().Scope(metricsScope),
+		s.GetLogger(),
+	)
+	elector.Start()
+	s.leaderElectors = append(s.leaderElectors, elector)
 }
 
 func (s *Service) startReplicator() {
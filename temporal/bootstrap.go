@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package temporal
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+const (
+	defaultBootstrapNamespaceRetention = 24 * time.Hour
+)
+
+// bootstrapNamespaces registers namespaces from bootstrap config which don't exist yet.
+// Existing namespaces are left untouched, so changes made through API are not overwritten on restart.
+func (s *Server) bootstrapNamespaces(metadataManager persistence.MetadataManager, logger log.Logger) error {
+	currentClusterName := s.so.config.ClusterMetadata.CurrentClusterName
+	for _, ns := range s.so.config.Bootstrap.Namespaces {
+		retention := ns.Retention
+		if retention == 0 {
+			retention = defaultBootstrapNamespaceRetention
+		}
+
+		_, err := metadataManager.CreateNamespace(&persistence.CreateNamespaceRequest{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{
+					Id:          uuid.New(),
+					Name:        ns.Name,
+					State:       enumspb.NAMESPACE_STATE_REGISTERED,
+					Description: ns.Description,
+					Owner:       ns.OwnerEmail,
+					Data:        ns.Data,
+				},
+				Config: &persistencespb.NamespaceConfig{
+					Retention:               timestamp.DurationPtr(retention),
+					HistoryArchivalState:    enumspb.ARCHIVAL_STATE_DISABLED,
+					VisibilityArchivalState: enumspb.ARCHIVAL_STATE_DISABLED,
+				},
+				ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+					ActiveClusterName: currentClusterName,
+					Clusters:          persistence.GetOrUseDefaultClusters(currentClusterName, nil),
+				},
+				FailoverVersion:             common.EmptyVersion,
+				FailoverNotificationVersion: -1,
+			},
+			IsGlobalNamespace: false,
+		})
+		switch err.(type) {
+		case nil:
+			logger.Info("Registered bootstrap namespace.", tag.WorkflowNamespace(ns.Name))
+		case *serviceerror.NamespaceAlreadyExists:
+		default:
+			return fmt.Errorf("unable to register bootstrap namespace %q: %w", ns.Name, err)
+		}
+	}
+	return nil
+}
+
+// bootstrapDynamicConfigOnce makes sure bootstrap dynamic config is reconciled once per process
+// even when it hosts several servers which share the dynamic config file.
+var bootstrapDynamicConfigOnce sync.Once
+
+// bootstrapDynamicConfig reconciles dynamic config values and search attributes from bootstrap config
+// with dynamic config once per process.
+func (s *Server) bootstrapDynamicConfig(client dynamicconfig.Client) error {
+	var err error
+	bootstrapDynamicConfigOnce.Do(func() {
+		err = bootstrapDynamicConfig(client, s.so.config.Bootstrap, s.logger)
+	})
+	return err
+}
+
+// bootstrapDynamicConfig writes dynamic config values and search attributes from bootstrap config
+// to dynamic config. Only values which differ from the current ones are written, values with constraints
+// are kept. Nothing is written when the dynamic config client is read-only.
+func bootstrapDynamicConfig(client dynamicconfig.Client, bootstrap config.Bootstrap, logger log.Logger) error {
+	for name, value := range bootstrap.DynamicConfig {
+		key, _ := dynamicconfig.GetKeyByName(name)
+		if currentValue, err := client.GetValue(key, nil); err == nil && reflect.DeepEqual(currentValue, value) {
+			continue
+		}
+		if err := client.UpdateValue(key, value); err != nil {
+			if err == dynamicconfig.ErrUpdateNotSupported {
+				logger.Warn("Dynamic config is read-only, bootstrap dynamic config is not applied.")
+				return nil
+			}
+			return fmt.Errorf("unable to update dynamic config %q: %w", name, err)
+		}
+		logger.Info("Updated dynamic config from bootstrap config.", tag.Key(name))
+	}
+
+	if len(bootstrap.SearchAttributes) == 0 {
+		return nil
+	}
+
+	currentValidAttr, _ := client.GetMapValue(dynamicconfig.ValidSearchAttributes, nil, definition.GetDefaultIndexedKeys())
+	validAttr := make(map[string]interface{}, len(currentValidAttr)+len(bootstrap.SearchAttributes))
+	for k, v := range currentValidAttr {
+		validAttr[k] = v
+	}
+
+	added := false
+	for name, typeName := range bootstrap.SearchAttributes {
+		if _, ok := validAttr[name]; ok {
+			continue
+		}
+		if definition.IsSystemIndexedKey(name) {
+			return fmt.Errorf("unable to add bootstrap search attribute %q: key is reserved by system", name)
+		}
+		valueType, err := config.GetIndexedValueType(typeName)
+		if err != nil {
+			return err
+		}
+		validAttr[name] = int(valueType)
+		added = true
+	}
+	if !added {
+		return nil
+	}
+
+	if err := client.UpdateValue(dynamicconfig.ValidSearchAttributes, validAttr); err != nil {
+		if err == dynamicconfig.ErrUpdateNotSupported {
+			logger.Warn("Dynamic config is read-only, bootstrap search attributes are not added.")
+			return nil
+		}
+		return fmt.Errorf("unable to update valid search attributes: %w", err)
+	}
+	logger.Info("Added search attributes from bootstrap config.", tag.Key(dynamicconfig.ValidSearchAttributes.String()))
+	return nil
+}
@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package temporal
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	bootstrapSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+		doneCh     chan struct{}
+		filepath   string
+		client     dynamicconfig.Client
+	}
+)
+
+func TestBootstrapSuite(t *testing.T) {
+	s := new(bootstrapSuite)
+	suite.Run(t, s)
+}
+
+func (s *bootstrapSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	file, err := ioutil.TempFile("", "bootstrap")
+	s.NoError(err)
+	_, err = file.WriteString(`
+history.enableTaskLatencyBreakdown:
+- value: false
+  constraints: {}
+- value: false
+  constraints:
+    namespace: samples-namespace
+frontend.validSearchAttributes:
+- value:
+    CustomKeywordField: 1
+  constraints: {}
+`)
+	s.NoError(err)
+	s.NoError(file.Close())
+	s.filepath = file.Name()
+
+	s.doneCh = make(chan struct{})
+	s.client, err = dynamicconfig.NewFileBasedClient(&dynamicconfig.FileBasedClientConfig{
+		Filepath:     s.filepath,
+		PollInterval: time.Second * 5,
+	}, log.NewNoop(), s.doneCh)
+	s.NoError(err)
+}
+
+func (s *bootstrapSuite) TearDownTest() {
+	close(s.doneCh)
+	os.Remove(s.filepath)
+	s.controller.Finish()
+}
+
+func (s *bootstrapSuite) TestBootstrapDynamicConfig() {
+	bootstrap := config.Bootstrap{
+		DynamicConfig: map[string]interface{}{
+			"history.enableTaskLatencyBreakdown": true,
+		},
+		SearchAttributes: map[string]string{
+			"CustomKeywordField": "Int",
+			"TenantId":           "Keyword",
+		},
+	}
+	s.NoError(bootstrapDynamicConfig(s.client, bootstrap, log.NewNoop()))
+
+	enabled, err := s.client.GetBoolValue(dynamicconfig.EnableTaskLatencyBreakdown, nil, false)
+	s.NoError(err)
+	s.True(enabled)
+	// values with constraints are kept
+	enabled, err = s.client.GetBoolValue(dynamicconfig.EnableTaskLatencyBreakdown, map[dynamicconfig.Filter]interface{}{
+		dynamicconfig.Namespace: "samples-namespace",
+	}, true)
+	s.NoError(err)
+	s.False(enabled)
+
+	// existing search attributes are left untouched
+	validAttr, err := s.client.GetMapValue(dynamicconfig.ValidSearchAttributes, nil, nil)
+	s.NoError(err)
+	s.Equal(1, validAttr["CustomKeywordField"])
+	s.Equal(2, validAttr["TenantId"])
+}
+
+func (s *bootstrapSuite) TestBootstrapDynamicConfig_Unchanged() {
+	bootstrap := config.Bootstrap{
+		DynamicConfig: map[string]interface{}{
+			"history.enableTaskLatencyBreakdown": true,
+		},
+		SearchAttributes: map[string]string{
+			"CustomKeywordField": "Keyword",
+		},
+	}
+	client := dynamicconfig.NewMockClient(s.controller)
+	client.EXPECT().GetValue(dynamicconfig.EnableTaskLatencyBreakdown, nil).Return(true, nil)
+	client.EXPECT().GetMapValue(dynamicconfig.ValidSearchAttributes, nil, gomock.Any()).Return(map[string]interface{}{
+		"CustomKeywordField": 1,
+	}, nil)
+	s.NoError(bootstrapDynamicConfig(client, bootstrap, log.NewNoop()))
+}
+
+func (s *bootstrapSuite) TestBootstrapDynamicConfig_ReadOnly() {
+	bootstrap := config.Bootstrap{
+		DynamicConfig: map[string]interface{}{
+			"history.enableTaskLatencyBreakdown": true,
+		},
+		SearchAttributes: map[string]string{
+			"TenantId": "Keyword",
+		},
+	}
+	s.NoError(bootstrapDynamicConfig(dynamicconfig.NewNopClient(), bootstrap, log.NewNoop()))
+}
+
+func (s *bootstrapSuite) TestBootstrapDynamicConfig_OncePerProcess() {
+	server := &Server{
+		so: &serverOptions{config: &config.Config{Bootstrap: config.Bootstrap{
+			DynamicConfig: map[string]interface{}{
+				"history.enableTaskLatencyBreakdown": true,
+			},
+		}}},
+		logger: log.NewNoop(),
+	}
+	s.NoError(server.bootstrapDynamicConfig(s.client))
+	s.NoError(s.client.UpdateValue(dynamicconfig.EnableTaskLatencyBreakdown, false))
+
+	s.NoError(server.bootstrapDynamicConfig(s.client))
+	enabled, err := s.client.GetBoolValue(dynamicconfig.EnableTaskLatencyBreakdown, nil, true)
+	s.NoError(err)
+	s.False(enabled)
+}
@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package temporal
+
+import (
+	"time"
+
+	"github.com/uber-go/tally"
+
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/rpc/encryption"
+)
+
+const (
+	defaultCertExpirationWarningWindow = 30 * 24 * time.Hour
+	defaultCertExpirationCheckInterval = time.Hour
+
+	certExpirationDaysGauge = "certificate_expiration_days"
+	certRoleTagName         = "cert_role"
+	// certServiceTagName tags gauges of services which override global TLS settings
+	certServiceTagName = "cert_service"
+)
+
+// checkCertExpiration periodically reports the days until the loaded certificates expire as gauges
+// per certificate role and logs warnings for those expiring soon, so that failing rotations are noticed
+// before connections start to fail. The first check runs at startup.
+func (s *Server) checkCertExpiration(checker encryption.CertExpirationChecker, metricsScope tally.Scope) {
+	settings := s.so.config.Global.TLS.ExpirationChecks
+	warningWindow := settings.WarningWindow
+	if warningWindow <= 0 {
+		warningWindow = defaultCertExpirationWarningWindow
+	}
+	checkInterval := settings.CheckInterval
+	if checkInterval <= 0 {
+		checkInterval = defaultCertExpirationCheckInterval
+	}
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		s.reportCertExpiration(checker, metricsScope, warningWindow)
+
+		select {
+		case <-ticker.C:
+		case <-s.stoppedCh:
+			return
+		}
+	}
+}
+
+func (s *Server) reportCertExpiration(
+	checker encryption.CertExpirationChecker,
+	metricsScope tally.Scope,
+	warningWindow time.Duration,
+) {
+	expirations, err := checker.GetCertExpirations()
+	if err != nil {
+		s.logger.Error("Unable to check TLS certificate expiration.", tag.Error(err))
+		return
+	}
+
+	now := time.Now().UTC()
+	if metricsScope != nil {
+		// roles with several certificates, i.e. CAs, report the one expiring first
+		minDays := make(map[string]float64)
+		for _, expiration := range expirations {
+			days := expiration.DaysUntil(now)
+			if current, ok := minDays[expiration.Role]; !ok || days < current {
+				minDays[expiration.Role] = days
+			}
+		}
+		for role, days := range minDays {
+			metricsScope.Tagged(map[string]string{certRoleTagName: role}).Gauge(certExpirationDaysGauge).Update(days)
+		}
+	}
+
+	for _, expiration := range encryption.ExpiringCerts(expirations, now, warningWindow) {
+		msg := "TLS certificate expires soon."
+		if !expiration.NotAfter.After(now) {
+			msg = "TLS certificate has expired."
+		}
+		s.logger.Warn(msg,
+			tag.Value(expiration.Role),
+			tag.Name(expiration.Subject),
+			tag.Timestamp(expiration.NotAfter))
+	}
+}
@@ -25,8 +25,11 @@
 package temporal
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -45,8 +48,10 @@ import (
 	l "go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/loggerimpl"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/messaging"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/primitives"
@@ -125,11 +130,24 @@ func (s *Server) Start() error {
 		}
 	}
 
+	httpTLSConfig, err := getHTTPServerTLSConfig(tlsFactory)
+	if err != nil {
+		return fmt.Errorf("unable to load HTTP TLS configuration: %w", err)
+	}
+	pprofInitializer := s.so.config.Global.PProf.NewInitializer(s.logger)
+	pprofInitializer.TLSConfig = httpTLSConfig
+	if err := pprofInitializer.Start(); err != nil {
+		return fmt.Errorf("unable to start PProf: %w", err)
+	}
+
 	dynamicConfig, err := dynamicconfig.NewFileBasedClient(&s.so.config.DynamicConfigClient, s.logger, s.stoppedCh)
 	if err != nil {
 		s.logger.Info("Error creating file based dynamic config client, use no-op config client instead.", tag.Error(err))
 		dynamicConfig = dynamicconfig.NewNopClient()
 	}
+	if err = s.bootstrapDynamicConfig(dynamicConfig); err != nil {
+		return fmt.Errorf("unable to reconcile bootstrap dynamic config: %w", err)
+	}
 	dc := dynamicconfig.NewCollection(dynamicConfig, s.logger)
 
 	// This call performs a config check against the configured persistence store for immutable cluster metadata.
@@ -151,14 +169,26 @@ func (s *Server) Start() error {
 
 	var globalMetricsScope tally.Scope
 	if s.so.config.Global.Metrics != nil || s.so.metricsReporter != nil {
-		globalMetricsScope = s.so.config.Global.Metrics.NewScope(s.logger, s.so.metricsReporter)
+		globalMetricsScope = s.so.config.Global.Metrics.NewScopeWithTLS(s.logger, s.so.metricsReporter, httpTLSConfig)
 	}
 
+	// without global metrics config, certificate expiration is reported to the scope of the first service
+	certMetricsScope := globalMetricsScope
 	for _, svcName := range s.so.serviceNames {
-		params, err := s.getServiceParams(svcName, dynamicConfig, tlsFactory, clusterMetadata, dc, zapLogger, globalMetricsScope)
+		svcTLSFactory, err := s.getServiceTLSFactory(svcName, tlsFactory)
 		if err != nil {
 			return err
 		}
+		params, err := s.getServiceParams(svcName, dynamicConfig, svcTLSFactory, clusterMetadata, dc, zapLogger, globalMetricsScope)
+		if err != nil {
+			return err
+		}
+		if certMetricsScope == nil {
+			certMetricsScope = params.MetricsScope
+		}
+		if checker, ok := svcTLSFactory.(encryption.CertExpirationChecker); ok && svcTLSFactory != tlsFactory {
+			go s.checkCertExpiration(checker, params.MetricsScope.Tagged(map[string]string{certServiceTagName: svcName}))
+		}
 
 		var svc common.Daemon
 		switch svcName {
@@ -188,6 +218,10 @@ func (s *Server) Start() error {
 
 	}
 
+	if checker, ok := tlsFactory.(encryption.CertExpirationChecker); ok {
+		go s.checkCertExpiration(checker, certMetricsScope)
+	}
+
 	if s.so.blockingStart {
 		// If s.so.interruptCh is nil this will wait forever.
 		interruptSignal := <-s.so.interruptCh
@@ -236,7 +270,13 @@ func (s *Server) getServiceParams(
 	params.DynamicConfig = dynamicConfig
 
 	svcCfg := s.so.config.Services[svcName]
-	rpcFactory := rpc.NewFactory(&svcCfg.RPC, svcName, s.logger, tlsFactory)
+	membershipCfg := s.so.config.Global.Membership
+	if svcCfg.RPC.BroadcastAddress != "" {
+		membershipCfg.BroadcastAddress = svcCfg.RPC.BroadcastAddress
+	}
+	rpcFactory := rpc.NewFactory(&svcCfg.RPC, &membershipCfg, svcName, s.logger, tlsFactory)
+	rpcFactory.RegisterFrontendInterceptors(s.so.frontendUnaryInterceptors, s.so.frontendStreamInterceptors)
+	rpcFactory.RegisterInternodeInterceptors(s.so.internodeUnaryInterceptors, s.so.internodeStreamInterceptors)
 	params.RPCFactory = rpcFactory
 
 	// Ringpop uses a different port to register handlers, this map is needed to resolve
@@ -249,7 +289,7 @@ func (s *Server) getServiceParams(
 	params.MembershipFactoryInitializer =
 		func(persistenceBean persistenceClient.Bean, logger l.Logger) (resource.MembershipMonitorFactory, error) {
 			return ringpop.NewRingpopFactory(
-				&s.so.config.Global.Membership,
+				&membershipCfg,
 				rpcFactory.GetRingpopChannel(),
 				svcName,
 				servicePortMap,
@@ -260,9 +300,26 @@ func (s *Server) getServiceParams(
 
 	params.DCRedirectionPolicy = s.so.config.DCRedirectionPolicy
 	if metricsScope == nil {
-		metricsScope = svcCfg.Metrics.NewScope(s.logger, s.so.metricsReporter)
+		httpTLSConfig, err := getHTTPServerTLSConfig(tlsFactory)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load HTTP TLS configuration: %w", err)
+		}
+		metricsScope = svcCfg.Metrics.NewScopeWithTLS(s.logger, s.so.metricsReporter, httpTLSConfig)
 	}
 	params.MetricsScope = metricsScope
+
+	if svcCfg.RPC.ConsolidatePorts {
+		metricsConfig := s.so.config.Global.Metrics
+		if metricsConfig == nil {
+			metricsConfig = &svcCfg.Metrics
+		}
+		if path := metricsConfig.PrometheusHandlerPath(); path != "" {
+			// serve only metrics from default HTTP handler, which serves pprof too
+			metricsHandler := http.NewServeMux()
+			metricsHandler.Handle(path, http.DefaultServeMux)
+			rpcFactory.ServeConsolidatedHTTP(metricsHandler)
+		}
+	}
 	metricsClient := metrics.NewClient(metricsScope, metrics.GetMetricsServiceIdx(svcName, s.logger))
 	params.MetricsClient = metricsClient
 	params.ClusterMetadata = clusterMetadata
@@ -272,8 +329,24 @@ func (s *Server) getServiceParams(
 		return nil, fmt.Errorf("unable to load frontend TLS configuration: %w", err)
 	}
 
+	publicClientHostPort := s.so.config.PublicClient.HostPort
+	if !strings.Contains(publicClientHostPort, "://") {
+		// spread connections over all frontend hosts instead of pinning to the one resolved at dial time,
+		// SDK applies the balancing policy passed by the resolver over its own default
+		var serviceConfig string
+		if s.so.config.PublicClient.BalancingPolicy != "" {
+			serviceConfig = rpc.ServiceConfig(s.so.config.PublicClient.BalancingPolicy)
+		}
+		publicClientHostPort = membership.GRPCResolverTarget(
+			svcName,
+			publicClientHostPort,
+			s.so.config.PublicClient.ResolveFromMembership,
+			s.so.config.PublicClient.RefreshInterval,
+			serviceConfig,
+		)
+	}
 	params.PublicClient, err = sdkclient.NewClient(sdkclient.Options{
-		HostPort:     s.so.config.PublicClient.HostPort,
+		HostPort:     publicClientHostPort,
 		Namespace:    common.SystemLocalNamespace,
 		MetricsScope: metricsScope,
 		Logger:       l.NewZapAdapter(zapLogger),
@@ -326,10 +399,13 @@ func (s *Server) getServiceParams(
 		s.so.config.Archival.Visibility.State,
 		s.so.config.Archival.Visibility.EnableRead,
 		&s.so.config.NamespaceDefaults.Archival,
+		s.so.config.Archival.History.AllowedDestinations,
+		s.so.config.Archival.Visibility.AllowedDestinations,
 	)
 
 	params.ArchiverProvider = provider.NewArchiverProvider(s.so.config.Archival.History.Provider, s.so.config.Archival.Visibility.Provider)
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
+	params.PersistenceConfig.HistoryChecksumVerification = dc.GetStringProperty(dynamicconfig.HistoryChecksumVerification, persistence.HistoryChecksumVerificationMetric)
 
 	if s.so.authorizer != nil {
 		params.Authorizer = s.so.authorizer
@@ -341,8 +417,22 @@ func (s *Server) getServiceParams(
 	} else {
 		params.ClaimMapper = authorization.NewNoopClaimMapper(s.so.config)
 	}
+	if s.so.namespaceRegistrationApprover != nil {
+		params.NamespaceRegistrationApprover = s.so.namespaceRegistrationApprover
+	} else if registrationCfg := s.so.config.Global.Authorization.NamespaceRegistration; registrationCfg.ApprovalWebhookURL != "" {
+		params.NamespaceRegistrationApprover = namespace.NewWebhookRegistrationApprover(
+			registrationCfg.ApprovalWebhookURL,
+			registrationCfg.ApprovalWebhookTimeout,
+		)
+	} else if registrationCfg.RequireApproval {
+		params.NamespaceRegistrationApprover = namespace.NewManualRegistrationApprover()
+	}
+
+	params.RedactionHashKey = []byte(s.so.config.Global.Authorization.Redaction.HashKey)
 
 	params.PersistenceServiceResolver = s.so.persistenceServiceResolver
+	params.TimeSource = s.so.timeSource
+	params.UUIDGenerator = s.so.uuidGenerator
 	if params.PersistenceServiceResolver == nil {
 		params.PersistenceServiceResolver = resolver.NewNoopResolver()
 	}
@@ -350,21 +440,49 @@ func (s *Server) getServiceParams(
 	return &params, nil
 }
 
+// getHTTPServerTLSConfig returns the TLS config of the pprof and metrics endpoints, nil if they are served over plain HTTP
+// getServiceTLSFactory returns TLS provider of the service, which is the shared provider unless the service
+// overrides internode TLS settings
+func (s *Server) getServiceTLSFactory(
+	svcName string,
+	tlsFactory encryption.TLSConfigProvider,
+) (encryption.TLSConfigProvider, error) {
+	internodeTLS := s.so.config.Services[svcName].InternodeTLS
+	if internodeTLS == nil {
+		return tlsFactory, nil
+	}
+	if s.so.tlsConfigProvider != nil {
+		return nil, fmt.Errorf("service %q overrides internode TLS, which is not supported with custom TLS provider", svcName)
+	}
+
+	tlsConfig := s.so.config.Global.TLS
+	tlsConfig.Internode = *internodeTLS
+	svcTLSFactory, err := encryption.NewTLSConfigProviderFromConfig(tlsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("service %q TLS provider initialization error: %w", svcName, err)
+	}
+	return svcTLSFactory, nil
+}
+
+func getHTTPServerTLSConfig(tlsFactory encryption.TLSConfigProvider) (*tls.Config, error) {
+	if provider, ok := tlsFactory.(encryption.HTTPTLSConfigProvider); ok {
+		return provider.GetHTTPServerConfig()
+	}
+	return nil, nil
+}
+
 // Validates configuration of dependencies
 func (s *Server) validate() error {
 	// cassandra schema version validation
 	if err := cassandra.VerifyCompatibleVersion(s.so.config.Persistence); err != nil {
 		return fmt.Errorf("cassandra schema version compatibility check failed: %w", err)
 	}
+	cassandra.VerifyTableOptions(s.so.config.Persistence, s.logger)
 	// sql schema version validation
 	if err := sql.VerifyCompatibleVersion(s.so.config.Persistence); err != nil {
 		return fmt.Errorf("sql schema version compatibility check failed: %w", err)
 	}
 
-	if err := s.so.config.Global.PProf.NewInitializer(s.logger).Start(); err != nil {
-		return fmt.Errorf("unable to start PProf: %w", err)
-	}
-
 	err := ringpop.ValidateRingpopConfig(&s.so.config.Global.Membership)
 	if err != nil {
 		return fmt.Errorf("ringpop config validation error: %w", err)
@@ -441,7 +559,7 @@ func (s *Server) immutableClusterMetadataInitialization(dc *dynamicconfig.Collec
 	if err = metadataManager.InitializeSystemNamespaces(s.so.config.ClusterMetadata.CurrentClusterName); err != nil {
 		return fmt.Errorf("unable to register system namespace: %w", err)
 	}
-	return nil
+	return s.bootstrapNamespaces(metadataManager, logger)
 }
 
 func (s *Server) logImmutableMismatch(logger l.Logger, key string, ignored interface{}, value interface{}) {
@@ -26,8 +26,12 @@ package temporal
 
 import (
 	"github.com/uber-go/tally"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/service/config"
@@ -86,6 +90,14 @@ func WithClaimMapper(claimMapper func(cfg *config.Config) authorization.ClaimMap
 	})
 }
 
+// Gates namespace registrations requested by callers without system admin role.
+// Overrides the approval webhook from config.
+func WithNamespaceRegistrationApprover(approver namespace.RegistrationApprover) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.namespaceRegistrationApprover = approver
+	})
+}
+
 // Set custom tally metric reporter
 func WithCustomMetricsReporter(reporter tally.BaseStatsReporter) ServerOption {
 	return newApplyFuncContainer(func(s *serverOptions) {
@@ -93,9 +105,43 @@ func WithCustomMetricsReporter(reporter tally.BaseStatsReporter) ServerOption {
 	})
 }
 
+// Overrides wall clock of all services, e.g. with clock.SkewedTimeSource to run a time skipping
+// test server where tests advance time to fire timers immediately, or with a started
+// clock.AutoSkippingTimeSource which skips to the next timer once no worker is processing a task
+func WithTimeSource(timeSource clock.TimeSource) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.timeSource = timeSource
+	})
+}
+
+// Overrides generator of run IDs, e.g. with primitives.NewSeededUUIDGenerator so that tests
+// can compare histories with golden files. Seeded generators must not be used in production.
+func WithUUIDGenerator(generator primitives.UUIDGenerator) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.uuidGenerator = generator
+	})
+}
+
 // Set custom persistence service resolver which will convert service name or address value from config to another a....
 func WithPersistenceServiceResolver(r resolver.ServiceResolver) ServerOption {
 	return newApplyFuncContainer(func(s *serverOptions) {
 		s.persistenceServiceResolver = r
 	})
 }
+
+// Adds gRPC interceptors to frontend servers, e.g. custom auth, quota or tracing middleware.
+// They are chained after the built-in interceptors, so they see only authorized requests.
+func WithFrontendInterceptors(unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.frontendUnaryInterceptors = append(s.frontendUnaryInterceptors, unary...)
+		s.frontendStreamInterceptors = append(s.frontendStreamInterceptors, stream...)
+	})
+}
+
+// Adds gRPC interceptors to history and matching servers, they are chained after the built-in interceptors
+func WithInternodeInterceptors(unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.internodeUnaryInterceptors = append(s.internodeUnaryInterceptors, unary...)
+		s.internodeStreamInterceptors = append(s.internodeStreamInterceptors, stream...)
+	})
+}
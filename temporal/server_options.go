@@ -28,8 +28,12 @@ import (
 	"fmt"
 
 	"github.com/uber-go/tally"
+	"google.golang.org/grpc"
 
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/service/config"
@@ -37,15 +41,22 @@ import (
 
 type (
 	serverOptions struct {
-		config                     *config.Config
-		authorizer                 authorization.Authorizer
-		tlsConfigProvider          encryption.TLSConfigProvider
-		claimMapper                authorization.ClaimMapper
-		metricsReporter            tally.BaseStatsReporter
-		persistenceServiceResolver resolver.ServiceResolver
-		configDir                  string
-		env                        string
-		zone                       string
+		config                        *config.Config
+		authorizer                    authorization.Authorizer
+		tlsConfigProvider             encryption.TLSConfigProvider
+		claimMapper                   authorization.ClaimMapper
+		namespaceRegistrationApprover namespace.RegistrationApprover
+		metricsReporter               tally.BaseStatsReporter
+		persistenceServiceResolver    resolver.ServiceResolver
+		frontendUnaryInterceptors     []grpc.UnaryServerInterceptor
+		frontendStreamInterceptors    []grpc.StreamServerInterceptor
+		internodeUnaryInterceptors    []grpc.UnaryServerInterceptor
+		internodeStreamInterceptors   []grpc.StreamServerInterceptor
+		timeSource                    clock.TimeSource
+		uuidGenerator                 primitives.UUIDGenerator
+		configDir                     string
+		env                           string
+		zone                          string
 
 		serviceNames []string
 
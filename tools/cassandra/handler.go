@@ -101,16 +101,7 @@ func checkCompatibleVersion(
 	expectedVersion string,
 ) error {
 
-	client, err := newCQLClient(&CQLClientConfig{
-		Hosts:      cfg.Hosts,
-		Port:       cfg.Port,
-		User:       cfg.User,
-		Password:   cfg.Password,
-		Keyspace:   cfg.Keyspace,
-		Timeout:    defaultTimeout,
-		Datacenter: cfg.Datacenter,
-		TLS:        cfg.TLS,
-	})
+	client, err := newCQLClient(newCQLClientConfigFromStore(cfg))
 	if err != nil {
 		return fmt.Errorf("unable to create CQL Client: %v", err.Error())
 	}
@@ -239,6 +230,19 @@ func doDropKeyspace(cfg CQLClientConfig, name string) error {
 	return nil
 }
 
+func newCQLClientConfigFromStore(cfg config.Cassandra) *CQLClientConfig {
+	return &CQLClientConfig{
+		Hosts:      cfg.Hosts,
+		Port:       cfg.Port,
+		User:       cfg.User,
+		Password:   cfg.Password,
+		Keyspace:   cfg.Keyspace,
+		Timeout:    defaultTimeout,
+		Datacenter: cfg.Datacenter,
+		TLS:        cfg.TLS,
+	}
+}
+
 func newCQLClientConfig(cli *cli.Context) (*CQLClientConfig, error) {
 	config := new(CQLClientConfig)
 	config.Hosts = cli.GlobalString(schema.CLIOptEndpoint)
@@ -256,6 +256,20 @@ func buildCLIOptions() *cli.App {
 				}
 			},
 		},
+		{
+			Name:    "update-table-options",
+			Aliases: []string{"uto"},
+			Usage:   "update the compaction, gc_grace_seconds and default_time_to_live options of the history, visibility and task tables",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagTableOptionsFile,
+					Usage: "path to the yaml file containing the history, visibility and tasks table options",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, updateTableOptions)
+			},
+		},
 		{
 			Name:    "validate-health",
 			Aliases: []string{"vh"},
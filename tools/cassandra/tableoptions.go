@@ -0,0 +1,289 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+
+	l "go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/schema/cassandra"
+	"go.temporal.io/server/tools/common/schema"
+)
+
+const (
+	readTableOptionsCQL = `SELECT table_name, compaction, gc_grace_seconds, default_time_to_live from system_schema.tables where keyspace_name=?`
+
+	compactionClassKey           = "class"
+	timeWindowCompactionStrategy = "TimeWindowCompactionStrategy"
+	sizeTieredCompactionStrategy = "SizeTieredCompactionStrategy"
+	defaultGCGraceSeconds        = 864000
+)
+
+type (
+	// tableGroup is a group of tables sharing the same table options
+	tableGroup struct {
+		name    string
+		tables  []string
+		options *config.CassandraTableGroupOptions
+		// deletes is true if the rows of the tables are deleted instead of expiring
+		deletes bool
+	}
+
+	// tableOptions are the options of a deployed table
+	tableOptions struct {
+		compaction        map[string]string
+		gcGraceSeconds    int
+		defaultTimeToLive int
+	}
+)
+
+// VerifyTableOptions logs a warning for every deployed table option which differs from the configured table options
+// or is likely to cause tombstone related latencies. It never fails as the tables still work with any options.
+func VerifyTableOptions(
+	cfg config.Persistence,
+	logger l.Logger,
+) {
+	stores := []string{cfg.DefaultStore}
+	if cfg.VisibilityStore != cfg.DefaultStore {
+		stores = append(stores, cfg.VisibilityStore)
+	}
+	for _, store := range stores {
+		ds, ok := cfg.DataStores[store]
+		if !ok || ds.Cassandra == nil {
+			continue
+		}
+		storeLogger := logger.WithTags(tag.StoreType(store))
+		if err := verifyTableOptions(*ds.Cassandra, storeLogger); err != nil {
+			storeLogger.Warn("Unable to verify cassandra table options.", tag.Error(err))
+		}
+	}
+}
+
+func verifyTableOptions(
+	cfg config.Cassandra,
+	logger l.Logger,
+) error {
+	client, err := newCQLClient(newCQLClientConfigFromStore(cfg))
+	if err != nil {
+		return fmt.Errorf("unable to create CQL Client: %v", err.Error())
+	}
+	defer client.Close()
+
+	deployed, err := client.readTableOptions()
+	if err != nil {
+		return err
+	}
+	for _, group := range tableGroups(cfg.TableOptions) {
+		for _, table := range group.tables {
+			options, ok := deployed[table]
+			if !ok {
+				continue
+			}
+			for _, warning := range tableOptionsWarnings(group, options) {
+				logger.Warn("Cassandra table options may cause tombstone related latencies.", tag.Name(table), tag.DetailInfo(warning))
+			}
+		}
+	}
+	return nil
+}
+
+// updateTableOptions alters the tables of the keyspace with the options of their group
+func updateTableOptions(cli *cli.Context) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	options, err := readTableOptionsFile(cli.String(schema.CLIOptTableOptionsFile))
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := newCQLClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+
+	tables, err := client.ListTables()
+	if err != nil {
+		return handleErr(err)
+	}
+	existing := make(map[string]struct{}, len(tables))
+	for _, table := range tables {
+		existing[table] = struct{}{}
+	}
+	for _, group := range tableGroups(options) {
+		for _, table := range group.tables {
+			if _, ok := existing[table]; !ok {
+				continue
+			}
+			stmt := buildAlterTableOptionsCQL(table, group.options)
+			if stmt == "" {
+				continue
+			}
+			log.Printf("updating %v table options: %v\n", table, stmt)
+			if err := client.Exec(stmt); err != nil {
+				return handleErr(fmt.Errorf("error updating %v table options: %w", table, err))
+			}
+		}
+	}
+	return nil
+}
+
+func readTableOptionsFile(path string) (*config.CassandraTableOptions, error) {
+	if path == "" {
+		return nil, fmt.Errorf("missing %v argument", flag(schema.CLIOptTableOptionsFile))
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var options config.CassandraTableOptions
+	if err := yaml.UnmarshalStrict(data, &options); err != nil {
+		return nil, err
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	return &options, nil
+}
+
+func tableGroups(options *config.CassandraTableOptions) []tableGroup {
+	if options == nil {
+		options = &config.CassandraTableOptions{}
+	}
+	return []tableGroup{
+		{name: "history", tables: cassandra.HistoryTables, options: options.History, deletes: true},
+		{name: "visibility", tables: cassandra.VisibilityTables, options: options.Visibility},
+		{name: "tasks", tables: cassandra.TaskTables, options: options.Tasks, deletes: true},
+	}
+}
+
+// buildAlterTableOptionsCQL returns the statement setting the options of a table, or an empty string if none is set
+func buildAlterTableOptionsCQL(table string, options *config.CassandraTableGroupOptions) string {
+	if options == nil {
+		return ""
+	}
+	var settings []string
+	if len(options.Compaction) > 0 {
+		pairs := make([]string, 0, len(options.Compaction))
+		for _, k := range sortedKeys(options.Compaction) {
+			pairs = append(pairs, fmt.Sprintf("'%v': '%v'", k, strings.ReplaceAll(options.Compaction[k], "'", "''")))
+		}
+		settings = append(settings, "compaction = {"+strings.Join(pairs, ", ")+"}")
+	}
+	if options.GCGraceSeconds != nil {
+		settings = append(settings, fmt.Sprintf("gc_grace_seconds = %v", *options.GCGraceSeconds))
+	}
+	if options.DefaultTimeToLive != nil {
+		settings = append(settings, fmt.Sprintf("default_time_to_live = %v", *options.DefaultTimeToLive))
+	}
+	if len(settings) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("ALTER TABLE %v WITH %v;", table, strings.Join(settings, " AND "))
+}
+
+// tableOptionsWarnings returns the deployed options differing from the expected options of the group of the table,
+// followed by the deployed options likely to cause tombstone related latencies
+func tableOptionsWarnings(group tableGroup, deployed tableOptions) []string {
+	var warnings []string
+	if expected := group.options; expected != nil {
+		for _, k := range sortedKeys(expected.Compaction) {
+			actual, want := deployed.compaction[k], expected.Compaction[k]
+			if k == compactionClassKey {
+				actual, want = compactionClass(actual), compactionClass(want)
+			}
+			if !strings.EqualFold(actual, want) {
+				warnings = append(warnings, fmt.Sprintf("compaction %v is %q, %v tables expect %q", k, actual, group.name, want))
+			}
+		}
+		if expected.GCGraceSeconds != nil && *expected.GCGraceSeconds != deployed.gcGraceSeconds {
+			warnings = append(warnings, fmt.Sprintf("gc_grace_seconds is %v, %v tables expect %v", deployed.gcGraceSeconds, group.name, *expected.GCGraceSeconds))
+		}
+		if expected.DefaultTimeToLive != nil && *expected.DefaultTimeToLive != deployed.defaultTimeToLive {
+			warnings = append(warnings, fmt.Sprintf("default_time_to_live is %v, %v tables expect %v", deployed.defaultTimeToLive, group.name, *expected.DefaultTimeToLive))
+		}
+	}
+
+	if !group.deletes {
+		return warnings
+	}
+	switch compactionClass(deployed.compaction[compactionClassKey]) {
+	case timeWindowCompactionStrategy:
+		warnings = append(warnings, fmt.Sprintf("%v rows are deleted rather than expired, with %v their tombstones are only purged "+
+			"once compacted with the window of the data they shadow", group.name, timeWindowCompactionStrategy))
+	case sizeTieredCompactionStrategy:
+		warnings = append(warnings, fmt.Sprintf("%v rows are frequently deleted, with %v tombstones are rarely compacted "+
+			"with the data they shadow, LeveledCompactionStrategy is recommended", group.name, sizeTieredCompactionStrategy))
+	}
+	if deployed.defaultTimeToLive > 0 {
+		warnings = append(warnings, fmt.Sprintf("%v rows expire after default_time_to_live %v regardless of the namespace retention",
+			group.name, deployed.defaultTimeToLive))
+	}
+	if deployed.gcGraceSeconds > defaultGCGraceSeconds {
+		warnings = append(warnings, fmt.Sprintf("gc_grace_seconds %v keeps the tombstones of deleted %v rows longer than the default %v",
+			deployed.gcGraceSeconds, group.name, defaultGCGraceSeconds))
+	}
+	return warnings
+}
+
+// readTableOptions reads the options of the tables in the keyspace
+func (client *cqlClient) readTableOptions() (map[string]tableOptions, error) {
+	iter := client.session.Query(readTableOptionsCQL, client.clusterConfig.Keyspace).Iter()
+	result := make(map[string]tableOptions)
+	var name string
+	var options tableOptions
+	for iter.Scan(&name, &options.compaction, &options.gcGraceSeconds, &options.defaultTimeToLive) {
+		result[name] = options
+		options = tableOptions{}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// compactionClass returns the compaction class without its package, cassandra accepts both
+func compactionClass(class string) string {
+	return class[strings.LastIndex(class, ".")+1:]
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+type TableOptionsTestSuite struct {
+	suite.Suite
+}
+
+func TestTableOptionsTestSuite(t *testing.T) {
+	suite.Run(t, new(TableOptionsTestSuite))
+}
+
+func (s *TableOptionsTestSuite) TestBuildAlterTableOptionsCQL() {
+	gcGrace := 3600
+	ttl := 0
+	s.Equal("", buildAlterTableOptionsCQL("tasks", nil))
+	s.Equal("", buildAlterTableOptionsCQL("tasks", &config.CassandraTableGroupOptions{}))
+	s.Equal(
+		"ALTER TABLE closed_executions WITH compaction = {'class': 'TimeWindowCompactionStrategy', "+
+			"'compaction_window_size': '1', 'compaction_window_unit': 'DAYS'} AND gc_grace_seconds = 3600 AND default_time_to_live = 0;",
+		buildAlterTableOptionsCQL("closed_executions", &config.CassandraTableGroupOptions{
+			Compaction: map[string]string{
+				"compaction_window_unit": "DAYS",
+				"class":                  "TimeWindowCompactionStrategy",
+				"compaction_window_size": "1",
+			},
+			GCGraceSeconds:    &gcGrace,
+			DefaultTimeToLive: &ttl,
+		}),
+	)
+	s.Equal(
+		"ALTER TABLE tasks WITH compaction = {'class': 'it''s'};",
+		buildAlterTableOptionsCQL("tasks", &config.CassandraTableGroupOptions{Compaction: map[string]string{"class": "it's"}}),
+	)
+}
+
+func (s *TableOptionsTestSuite) TestTableOptionsWarnings() {
+	leveled := tableOptions{
+		compaction:     map[string]string{"class": "org.apache.cassandra.db.compaction.LeveledCompactionStrategy"},
+		gcGraceSeconds: defaultGCGraceSeconds,
+	}
+	groups := tableGroups(nil)
+	history, visibility := groups[0], groups[1]
+	s.Empty(tableOptionsWarnings(history, leveled))
+
+	gcGrace := 3600
+	history.options = &config.CassandraTableGroupOptions{
+		Compaction:     map[string]string{"class": "LeveledCompactionStrategy"},
+		GCGraceSeconds: &gcGrace,
+	}
+	s.Equal([]string{"gc_grace_seconds is 864000, history tables expect 3600"}, tableOptionsWarnings(history, leveled))
+
+	timeWindow := tableOptions{
+		compaction:        map[string]string{"class": "org.apache.cassandra.db.compaction.TimeWindowCompactionStrategy"},
+		gcGraceSeconds:    gcGrace,
+		defaultTimeToLive: 86400,
+	}
+	s.Empty(tableOptionsWarnings(visibility, timeWindow))
+	s.Len(tableOptionsWarnings(history, timeWindow), 3)
+
+	sizeTiered := tableOptions{
+		compaction:     map[string]string{"class": "SizeTieredCompactionStrategy"},
+		gcGraceSeconds: 2 * defaultGCGraceSeconds,
+	}
+	s.Len(tableOptionsWarnings(groups[2], sizeTiered), 2)
+}
+
+func (s *TableOptionsTestSuite) TestReadTableOptionsFile() {
+	file, err := ioutil.TempFile("", "table_options_*.yaml")
+	s.NoError(err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(`
+visibility:
+  compaction:
+    class: TimeWindowCompactionStrategy
+    compaction_window_unit: DAYS
+  defaultTimeToLive: 604800
+tasks:
+  gcGraceSeconds: 3600
+`)
+	s.NoError(err)
+	s.NoError(file.Close())
+
+	options, err := readTableOptionsFile(file.Name())
+	s.NoError(err)
+	s.Nil(options.History)
+	s.Equal("DAYS", options.Visibility.Compaction["compaction_window_unit"])
+	s.Equal(604800, *options.Visibility.DefaultTimeToLive)
+	s.Equal(3600, *options.Tasks.GCGraceSeconds)
+
+	_, err = readTableOptionsFile("")
+	s.Error(err)
+}
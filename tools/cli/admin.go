@@ -55,6 +55,26 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminShowWorkflow(c)
 			},
 		},
+		{
+			Name:    "list_branches",
+			Aliases: []string{"lb"},
+			Usage:   "List workflows with many live history branches along with per-branch sizes",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagMinBranchCount,
+					Value: 2,
+					Usage: "List only workflows with at least this number of live history branches",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 1000,
+					Usage: "Number of history branches scanned per request",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListHistoryBranches(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"desc"},
@@ -91,6 +111,116 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminRefreshWorkflowTasks(c)
 			},
 		},
+		{
+			Name:  "fire_timer",
+			Usage: "Fires a pending user timer of a workflow immediately, recording the operator action as an annotation",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagTimerID,
+					Usage: "TimerId of the pending timer",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason recorded with the operator action",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFireTimer(c)
+			},
+		},
+		{
+			Name:  "retry_activity",
+			Usage: "Retries an activity waiting for retry backoff immediately, recording the operator action as an annotation",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "ActivityId of the activity waiting for retry",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason recorded with the operator action",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRetryActivity(c)
+			},
+		},
+		{
+			Name: "reroute_activities",
+			Usage: "Moves pending activities of an activity type from a task queue to another task queue, recording the " +
+				"operator action as an annotation. Future activity tasks are rerouted by history.activityTaskQueueReroutes dynamic config",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityType,
+					Usage: "Activity type of the pending activities",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "Task queue the activities are scheduled on",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetTaskQueue,
+					Usage: "Task queue the activities are rerouted to",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason recorded with the operator action",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRerouteActivities(c)
+			},
+		},
+		{
+			Name: "annotate",
+			Usage: "Attaches an operator annotation, e.g. incident context, to a running or closed workflow. Annotations are not " +
+				"recorded in history, the recent ones are shown in TemporalOperatorAnnotations and TemporalOperatorAnnotationTags search attributes",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagAnnotation,
+					Usage: "Annotation text",
+				},
+				cli.StringFlag{
+					Name:  FlagAnnotationTags,
+					Usage: "Optional comma separated tags of the annotation",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminAnnotateWorkflow(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -344,6 +474,39 @@ func newAdminHistoryHostCommands() []cli.Command {
 				AdminGetShardID(c)
 			},
 		},
+		{
+			Name:    "shard_report",
+			Aliases: []string{"sr"},
+			Usage:   "Report shard counts and load of every history host",
+			Action: func(c *cli.Context) {
+				AdminShardOwnershipReport(c)
+			},
+		},
+		{
+			Name:  "rebalance",
+			Usage: "Start gradual rebalance of shards towards target distribution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagShardTarget,
+					Usage: "Target number of shards per history host, e.g. 10.0.0.1:7234=256,10.0.0.2:7234=256",
+				},
+				cli.IntFlag{
+					Name:  FlagMovesPerMinute,
+					Value: 10,
+					Usage: "Max number of shards moved every minute",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRebalanceShards(c)
+			},
+		},
+		{
+			Name:  "cancel_rebalance",
+			Usage: "Cancel shard rebalance, moved shards are moved back at the same rate",
+			Action: func(c *cli.Context) {
+				AdminCancelShardRebalance(c)
+			},
+		},
 	}
 }
 
@@ -767,6 +930,40 @@ func newAdminClusterCommands() []cli.Command {
 				AdminDescribeCluster(c)
 			},
 		},
+		{
+			Name:    "certificates",
+			Aliases: []string{"certs"},
+			Usage:   "Show certificate chains loaded by the frontend node serving the request",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeCertificates(c)
+			},
+		},
+		{
+			Name:  "rotate_certificate",
+			Usage: "Reload the certificate of a TLS group from its configured files on every host",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTLSGroup,
+					Usage: "TLS group to rotate [internode, frontend]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRotateTLSCertificate(c)
+			},
+		},
+		{
+			Name:  "describe_certificate_rotation",
+			Usage: "Describe the latest certificate rotation and its outcome on every host",
+			Action: func(c *cli.Context) {
+				AdminDescribeTLSRotation(c)
+			},
+		},
 		{
 			Name:    "metadata",
 			Aliases: []string{"m"},
@@ -775,6 +972,62 @@ func newAdminClusterCommands() []cli.Command {
 				AdminClusterMetadata(c)
 			},
 		},
+		{
+			Name:  "backup_metadata",
+			Usage: "Export cluster metadata, namespaces and search attributes to a snapshot file",
+			Flags: append(getDBFlags(),
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Snapshot file to write",
+				},
+				cli.BoolFlag{
+					Name:  FlagSkipSearchAttributes,
+					Usage: "Skip search attributes, which are read from the frontend",
+				}),
+			Action: func(c *cli.Context) {
+				AdminBackupMetadata(c)
+			},
+		},
+		{
+			Name:  "restore_metadata",
+			Usage: "Restore cluster metadata, namespaces and search attributes of a snapshot file which are missing from the cluster",
+			Flags: append(getDBFlags(),
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Snapshot file written by backup_metadata",
+				},
+				cli.BoolFlag{
+					Name:  FlagSkipSearchAttributes,
+					Usage: "Skip search attributes, which are added through the frontend",
+				},
+				cli.StringFlag{
+					Name:  FlagSecurityTokenWithAlias,
+					Usage: "Optional token for security check when adding search attributes",
+				}),
+			Action: func(c *cli.Context) {
+				AdminRestoreMetadata(c)
+			},
+		},
+		{
+			Name:  "record_consistency_marker",
+			Usage: "Record the ack levels of all shards and the queue states as a consistency marker for backups",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDescriptionWithAlias,
+					Usage: "Description of the marker",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRecordConsistencyMarker(c)
+			},
+		},
+		{
+			Name:  "list_consistency_markers",
+			Usage: "List recorded consistency markers",
+			Action: func(c *cli.Context) {
+				AdminListConsistencyMarkers(c)
+			},
+		},
 	}
 }
 
@@ -787,7 +1040,7 @@ func newAdminDLQCommands() []cli.Command {
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagDLQTypeWithAlias,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, signal), signal DLQ is of the global namespace",
 				},
 				cli.StringFlag{
 					Name:  FlagCluster,
@@ -821,7 +1074,7 @@ func newAdminDLQCommands() []cli.Command {
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagDLQTypeWithAlias,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, signal), signal DLQ is of the global namespace",
 				},
 				cli.StringFlag{
 					Name:  FlagCluster,
@@ -847,7 +1100,7 @@ func newAdminDLQCommands() []cli.Command {
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagDLQTypeWithAlias,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, signal), signal DLQ is of the global namespace",
 				},
 				cli.StringFlag{
 					Name:  FlagCluster,
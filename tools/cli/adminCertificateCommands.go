@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// AdminDescribeCertificates prints certificate chains loaded by the frontend node serving the request
+func AdminDescribeCertificates(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.GetCertificateChains(ctx, &adminservice.GetCertificateChainsRequest{})
+	if err != nil {
+		ErrorAndExit("Operation GetCertificateChains failed.", err)
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(resp.GetChains())
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Role", "Subject", "Issuer", "Serial Number", "Not After", "Subject Alternative Names"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, chain := range resp.GetChains() {
+		for i, cert := range chain.GetCertificates() {
+			role := chain.GetRole()
+			if i > 0 {
+				// intermediates presented along with the leaf
+				role = ""
+			}
+			table.Append([]string{
+				role,
+				cert.GetSubject(),
+				cert.GetIssuer(),
+				cert.GetSerialNumber(),
+				timestamp.TimeValue(cert.GetNotAfter()).Format(time.RFC3339),
+				strings.Join(subjectAlternativeNames(cert), ", "),
+			})
+		}
+	}
+	table.Render()
+}
+
+func subjectAlternativeNames(cert *adminservice.CertificateInfo) []string {
+	var names []string
+	names = append(names, cert.GetDnsNames()...)
+	names = append(names, cert.GetIpAddresses()...)
+	names = append(names, cert.GetUris()...)
+	return names
+}
@@ -84,6 +84,33 @@ func AdminDescribeCluster(c *cli.Context) {
 	prettyPrintJSONObject(response)
 }
 
+// AdminRotateTLSCertificate starts rotation of the certificate of a TLS group on every host
+func AdminRotateTLSCertificate(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	group := getRequiredOption(c, FlagTLSGroup)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.RotateTLSCertificate(ctx, &adminservice.RotateTLSCertificateRequest{Group: group})
+	if err != nil {
+		ErrorAndExit("Operation RotateTLSCertificate failed.", err)
+	}
+	fmt.Printf("Started certificate rotation %v, hosts report the outcome within a minute.\n", response.GetRotation().GetGeneration())
+}
+
+// AdminDescribeTLSRotation prints the latest certificate rotation and its outcome on every host
+func AdminDescribeTLSRotation(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeTLSRotation(ctx, &adminservice.DescribeTLSRotationRequest{})
+	if err != nil {
+		ErrorAndExit("Operation DescribeTLSRotation failed.", err)
+	}
+	prettyPrintJSONObject(response)
+}
+
 // AdminDescribeCluster is used to dump information about the cluster
 func AdminClusterMetadata(c *cli.Context) {
 	frontendClient := cFactory.FrontendClient(c)
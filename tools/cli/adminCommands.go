@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -726,3 +727,82 @@ func AdminRefreshWorkflowTasks(c *cli.Context) {
 		fmt.Println("Refresh workflow task succeeded.")
 	}
 }
+
+// AdminFireTimer fires pending user timer of workflow immediately
+func AdminFireTimer(c *cli.Context) {
+	adminApplyOperatorAction(c, &adminservice.ApplyOperatorActionRequest{
+		ActionType: enumsspb.OPERATOR_ACTION_TYPE_FIRE_TIMER,
+		TimerId:    getRequiredOption(c, FlagTimerID),
+	})
+}
+
+// AdminRetryActivity retries activity waiting for retry backoff immediately
+func AdminRetryActivity(c *cli.Context) {
+	adminApplyOperatorAction(c, &adminservice.ApplyOperatorActionRequest{
+		ActionType: enumsspb.OPERATOR_ACTION_TYPE_RETRY_ACTIVITY,
+		ActivityId: getRequiredOption(c, FlagActivityID),
+	})
+}
+
+// AdminRerouteActivities moves pending activities of activity type to another task queue
+func AdminRerouteActivities(c *cli.Context) {
+	adminApplyOperatorAction(c, &adminservice.ApplyOperatorActionRequest{
+		ActionType:      enumsspb.OPERATOR_ACTION_TYPE_REROUTE_ACTIVITIES,
+		ActivityType:    getRequiredOption(c, FlagActivityType),
+		TaskQueue:       getRequiredOption(c, FlagTaskQueue),
+		TargetTaskQueue: getRequiredOption(c, FlagTargetTaskQueue),
+	})
+}
+
+// AdminAnnotateWorkflow attaches operator annotation to a running workflow
+func AdminAnnotateWorkflow(c *cli.Context) {
+	var tags []string
+	for _, t := range strings.Split(c.String(FlagAnnotationTags), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	annotation := getRequiredOption(c, FlagAnnotation)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.AnnotateWorkflowExecution(ctx, &adminservice.AnnotateWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Annotation: annotation,
+		Tags:       tags,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Annotate workflow failed.", err)
+	}
+	fmt.Println("Workflow annotated.")
+}
+
+func adminApplyOperatorAction(c *cli.Context, request *adminservice.ApplyOperatorActionRequest) {
+	adminClient := cFactory.AdminClient(c)
+
+	request.Namespace = getRequiredGlobalOption(c, FlagNamespace)
+	request.Execution = &commonpb.WorkflowExecution{
+		WorkflowId: getRequiredOption(c, FlagWorkflowID),
+		RunId:      c.String(FlagRunID),
+	}
+	request.Reason = c.String(FlagReason)
+	request.Identity = getCliIdentity()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.ApplyOperatorAction(ctx, request)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Operator action %v failed.", request.GetActionType()), err)
+	}
+	fmt.Printf("Operator action %v succeeded.\n", request.GetActionType())
+}
@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// AdminRecordConsistencyMarker records the ack levels of all shards and the queue states as a consistency marker
+func AdminRecordConsistencyMarker(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContextForLongPoll(c)
+	defer cancel()
+	resp, err := adminClient.RecordConsistencyMarker(ctx, &adminservice.RecordConsistencyMarkerRequest{
+		Description: c.String(FlagDescription),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to record consistency marker", err)
+	}
+	fmt.Printf("Recorded consistency marker %d of %d shards at %v\n",
+		resp.GetMarkerId(), resp.GetShardCount(), timestamp.TimeValue(resp.GetCreatedTime()))
+}
+
+// AdminListConsistencyMarkers lists the recorded consistency markers
+func AdminListConsistencyMarkers(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Marker ID", "Created Time", "Shards", "Description"})
+	table.SetHeaderLine(false)
+	request := &adminservice.ListConsistencyMarkersRequest{}
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ListConsistencyMarkers(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list consistency markers", err)
+		}
+		for _, marker := range resp.GetMarkers() {
+			table.Append([]string{
+				strconv.FormatInt(marker.GetMarkerId(), 10),
+				timestamp.TimeValue(marker.GetCreatedTime()).Format(time.RFC3339),
+				strconv.Itoa(int(marker.GetShardCount())),
+				marker.GetDescription(),
+			})
+		}
+		if len(resp.GetNextPageToken()) == 0 {
+			break
+		}
+		request.NextPageToken = resp.GetNextPageToken()
+	}
+	table.Render()
+}
@@ -42,6 +42,7 @@ import (
 
 const (
 	defaultPageSize = 1000
+	signalDLQType   = "signal"
 )
 
 // AdminGetDLQMessages gets DLQ metadata
@@ -51,6 +52,10 @@ func AdminGetDLQMessages(c *cli.Context) {
 
 	adminClient := cFactory.AdminClient(c)
 	dlqType := getRequiredOption(c, FlagDLQType)
+	if dlqType == signalDLQType {
+		adminGetSignalDLQMessages(c)
+		return
+	}
 	sourceCluster := getRequiredOption(c, FlagCluster)
 	shardID := getRequiredIntOption(c, FlagShardID)
 	outputFile := getOutputFile(c.String(FlagOutputFilename))
@@ -114,6 +119,10 @@ func AdminPurgeDLQMessages(c *cli.Context) {
 	defer cancel()
 
 	dlqType := getRequiredOption(c, FlagDLQType)
+	if dlqType == signalDLQType {
+		adminPurgeSignalDLQMessages(c)
+		return
+	}
 	sourceCluster := getRequiredOption(c, FlagCluster)
 	shardID := getRequiredIntOption(c, FlagShardID)
 
@@ -142,6 +151,10 @@ func AdminMergeDLQMessages(c *cli.Context) {
 	defer cancel()
 
 	dlqType := getRequiredOption(c, FlagDLQType)
+	if dlqType == signalDLQType {
+		adminMergeSignalDLQMessages(c)
+		return
+	}
 	sourceCluster := getRequiredOption(c, FlagCluster)
 	shardID := getRequiredIntOption(c, FlagShardID)
 
@@ -181,6 +194,8 @@ func toQueueType(dlqType string) enumsspb.DeadLetterQueueType {
 		return enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE
 	case "history":
 		return enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION
+	case signalDLQType:
+		return enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL
 	default:
 		ErrorAndExit("The queue type is not supported.", fmt.Errorf("the queue type is not supported. Type: %v", dlqType))
 	}
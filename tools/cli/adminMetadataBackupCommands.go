@@ -0,0 +1,332 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/urfave/cli"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// metadataSnapshotVersion is the version of the metadata snapshot format written by backup_metadata
+	metadataSnapshotVersion = 1
+
+	metadataBackupPageSize = 100
+)
+
+type (
+	// metadataSnapshot is a versioned snapshot of the control plane data of a cluster
+	metadataSnapshot struct {
+		Version         int                 `json:"version"`
+		CreatedTime     time.Time           `json:"createdTime"`
+		ClusterMetadata json.RawMessage     `json:"clusterMetadata,omitempty"`
+		Namespaces      []namespaceSnapshot `json:"namespaces"`
+		// SearchAttributes maps search attribute keys to their indexed value type names
+		SearchAttributes map[string]string `json:"searchAttributes,omitempty"`
+	}
+
+	namespaceSnapshot struct {
+		Detail            json.RawMessage `json:"detail"`
+		IsGlobalNamespace bool            `json:"isGlobalNamespace"`
+	}
+
+	// metadataRestoreReport summarizes what restoreMetadataSnapshot restored
+	metadataRestoreReport struct {
+		ClusterMetadataRestored bool
+		NamespacesRestored      []string
+		NamespacesSkipped       []string
+		NamespacesFailed        map[string]string
+	}
+)
+
+// AdminBackupMetadata exports cluster metadata, namespaces and search attributes to a snapshot file
+func AdminBackupMetadata(c *cli.Context) {
+	outputFile := getRequiredOption(c, FlagOutputFilename)
+
+	pFactory := CreatePersistenceFactory(c)
+	clusterMetadataManager, err := pFactory.NewClusterMetadataManager()
+	if err != nil {
+		ErrorAndExit("Failed to initialize cluster metadata manager", err)
+	}
+	defer clusterMetadataManager.Close()
+	metadataManager, err := pFactory.NewMetadataManager()
+	if err != nil {
+		ErrorAndExit("Failed to initialize metadata manager", err)
+	}
+	defer metadataManager.Close()
+
+	var searchAttributes map[string]enumspb.IndexedValueType
+	if !c.Bool(FlagSkipSearchAttributes) {
+		ctx, cancel := newContext(c)
+		defer cancel()
+		resp, err := cFactory.FrontendClient(c).GetSearchAttributes(ctx, &workflowservice.GetSearchAttributesRequest{})
+		if err != nil {
+			ErrorAndExit("Failed to get search attributes", err)
+		}
+		searchAttributes = resp.GetKeys()
+	}
+
+	snapshot, err := newMetadataSnapshot(clusterMetadataManager, metadataManager, searchAttributes)
+	if err != nil {
+		ErrorAndExit("Failed to create metadata snapshot", err)
+	}
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		ErrorAndExit("Failed to encode metadata snapshot", err)
+	}
+	if err := ioutil.WriteFile(outputFile, data, 0600); err != nil {
+		ErrorAndExit("Failed to write metadata snapshot", err)
+	}
+	fmt.Printf("Backed up cluster metadata, %d namespaces and %d search attributes to %s\n",
+		len(snapshot.Namespaces), len(snapshot.SearchAttributes), outputFile)
+}
+
+// AdminRestoreMetadata restores the cluster metadata, namespaces and search attributes of a snapshot file
+// which are missing from the cluster. Existing records are left untouched.
+func AdminRestoreMetadata(c *cli.Context) {
+	inputFile := getRequiredOption(c, FlagInputFile)
+	data, err := ioutil.ReadFile(inputFile)
+	if err != nil {
+		ErrorAndExit("Failed to read metadata snapshot", err)
+	}
+	var snapshot metadataSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		ErrorAndExit("Failed to decode metadata snapshot", err)
+	}
+	if snapshot.Version != metadataSnapshotVersion {
+		ErrorAndExit(fmt.Sprintf("Unsupported metadata snapshot version %d, expected %d", snapshot.Version, metadataSnapshotVersion), nil)
+	}
+
+	prompt(fmt.Sprintf("Restoring cluster metadata, %d namespaces and %d search attributes from snapshot created at %v, continue? Y/N",
+		len(snapshot.Namespaces), len(snapshot.SearchAttributes), snapshot.CreatedTime), c.GlobalBool(FlagAutoConfirm))
+
+	pFactory := CreatePersistenceFactory(c)
+	clusterMetadataManager, err := pFactory.NewClusterMetadataManager()
+	if err != nil {
+		ErrorAndExit("Failed to initialize cluster metadata manager", err)
+	}
+	defer clusterMetadataManager.Close()
+	metadataManager, err := pFactory.NewMetadataManager()
+	if err != nil {
+		ErrorAndExit("Failed to initialize metadata manager", err)
+	}
+	defer metadataManager.Close()
+
+	report, err := restoreMetadataSnapshot(&snapshot, clusterMetadataManager, metadataManager)
+	if err != nil {
+		ErrorAndExit("Failed to restore metadata snapshot", err)
+	}
+	fmt.Printf("Cluster metadata restored: %v\n", report.ClusterMetadataRestored)
+	fmt.Printf("Namespaces restored: %v\n", report.NamespacesRestored)
+	fmt.Printf("Namespaces already present: %v\n", report.NamespacesSkipped)
+	for name, reason := range report.NamespacesFailed {
+		fmt.Printf("Namespace %s failed to restore: %s\n", name, reason)
+	}
+
+	if !c.Bool(FlagSkipSearchAttributes) && len(snapshot.SearchAttributes) > 0 {
+		restoreSearchAttributes(c, snapshot.SearchAttributes)
+	}
+	if len(report.NamespacesFailed) > 0 {
+		ErrorAndExit(fmt.Sprintf("Failed to restore %d namespaces", len(report.NamespacesFailed)), nil)
+	}
+}
+
+func newMetadataSnapshot(
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	metadataManager persistence.MetadataManager,
+	searchAttributes map[string]enumspb.IndexedValueType,
+) (*metadataSnapshot, error) {
+	encoder := codec.NewJSONPBEncoder()
+	snapshot := &metadataSnapshot{
+		Version:     metadataSnapshotVersion,
+		CreatedTime: time.Now().UTC(),
+		Namespaces:  []namespaceSnapshot{},
+	}
+
+	clusterMetadata, err := clusterMetadataManager.GetClusterMetadata()
+	switch err.(type) {
+	case nil:
+		if snapshot.ClusterMetadata, err = encoder.Encode(&clusterMetadata.ClusterMetadata); err != nil {
+			return nil, err
+		}
+	case *serviceerror.NotFound:
+	default:
+		return nil, err
+	}
+
+	request := &persistence.ListNamespacesRequest{PageSize: metadataBackupPageSize}
+	for {
+		resp, err := metadataManager.ListNamespaces(request)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range resp.Namespaces {
+			detail, err := encoder.Encode(ns.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			snapshot.Namespaces = append(snapshot.Namespaces, namespaceSnapshot{
+				Detail:            detail,
+				IsGlobalNamespace: ns.IsGlobalNamespace,
+			})
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	if len(searchAttributes) > 0 {
+		snapshot.SearchAttributes = make(map[string]string, len(searchAttributes))
+		for key, valueType := range searchAttributes {
+			snapshot.SearchAttributes[key] = valueType.String()
+		}
+	}
+	return snapshot, nil
+}
+
+// restoreMetadataSnapshot creates the cluster metadata and namespaces of the snapshot which don't exist.
+// Namespaces which fail to restore are reported rather than stopping the restore.
+func restoreMetadataSnapshot(
+	snapshot *metadataSnapshot,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	metadataManager persistence.MetadataManager,
+) (*metadataRestoreReport, error) {
+	encoder := codec.NewJSONPBEncoder()
+	report := &metadataRestoreReport{NamespacesFailed: make(map[string]string)}
+
+	if len(snapshot.ClusterMetadata) > 0 {
+		var clusterMetadata persistencespb.ClusterMetadata
+		if err := encoder.Decode(snapshot.ClusterMetadata, &clusterMetadata); err != nil {
+			return nil, err
+		}
+		current, err := clusterMetadataManager.GetClusterMetadata()
+		switch err.(type) {
+		case nil:
+			if current.ClusterName != clusterMetadata.ClusterName ||
+				current.ClusterId != clusterMetadata.ClusterId ||
+				current.HistoryShardCount != clusterMetadata.HistoryShardCount {
+				return nil, fmt.Errorf("cluster metadata %v/%v with %v shards conflicts with snapshot %v/%v with %v shards",
+					current.ClusterName, current.ClusterId, current.HistoryShardCount,
+					clusterMetadata.ClusterName, clusterMetadata.ClusterId, clusterMetadata.HistoryShardCount)
+			}
+		case *serviceerror.NotFound:
+			applied, err := clusterMetadataManager.SaveClusterMetadata(&persistence.SaveClusterMetadataRequest{
+				ClusterMetadata: clusterMetadata,
+			})
+			if err != nil {
+				return nil, err
+			}
+			report.ClusterMetadataRestored = applied
+		default:
+			return nil, err
+		}
+	}
+
+	for _, ns := range snapshot.Namespaces {
+		var detail persistencespb.NamespaceDetail
+		if err := encoder.Decode(ns.Detail, &detail); err != nil {
+			return nil, err
+		}
+		name := detail.GetInfo().GetName()
+
+		_, err := metadataManager.GetNamespace(&persistence.GetNamespaceRequest{ID: detail.GetInfo().GetId()})
+		switch err.(type) {
+		case nil:
+			report.NamespacesSkipped = append(report.NamespacesSkipped, name)
+			continue
+		case *serviceerror.NotFound:
+		default:
+			report.NamespacesFailed[name] = err.Error()
+			continue
+		}
+
+		if _, err := metadataManager.CreateNamespace(&persistence.CreateNamespaceRequest{
+			Namespace:         &detail,
+			IsGlobalNamespace: ns.IsGlobalNamespace,
+		}); err != nil {
+			report.NamespacesFailed[name] = err.Error()
+			continue
+		}
+		report.NamespacesRestored = append(report.NamespacesRestored, name)
+	}
+	return report, nil
+}
+
+func restoreSearchAttributes(c *cli.Context, snapshotSearchAttributes map[string]string) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := cFactory.FrontendClient(c).GetSearchAttributes(ctx, &workflowservice.GetSearchAttributesRequest{})
+	if err != nil {
+		ErrorAndExit("Failed to get search attributes", err)
+	}
+
+	missing, err := missingSearchAttributes(snapshotSearchAttributes, resp.GetKeys())
+	if err != nil {
+		ErrorAndExit("Failed to restore search attributes", err)
+	}
+	if len(missing) == 0 {
+		fmt.Println("Search attributes already present")
+		return
+	}
+
+	if _, err := cFactory.AdminClient(c).AddSearchAttribute(ctx, &adminservice.AddSearchAttributeRequest{
+		SearchAttribute: missing,
+		SecurityToken:   c.String(FlagSecurityToken),
+	}); err != nil {
+		ErrorAndExit("Failed to restore search attributes", err)
+	}
+	fmt.Printf("Search attributes restored: %d\n", len(missing))
+}
+
+// missingSearchAttributes returns the search attributes of the snapshot which don't exist in the cluster
+func missingSearchAttributes(
+	snapshotSearchAttributes map[string]string,
+	existing map[string]enumspb.IndexedValueType,
+) (map[string]enumspb.IndexedValueType, error) {
+	missing := make(map[string]enumspb.IndexedValueType)
+	for key, valueTypeName := range snapshotSearchAttributes {
+		valueType, ok := enumspb.IndexedValueType_value[valueTypeName]
+		if !ok {
+			return nil, fmt.Errorf("unknown value type %q of search attribute %q", valueTypeName, key)
+		}
+		if _, ok := existing[key]; !ok {
+			missing[key] = enumspb.IndexedValueType(valueType)
+		}
+	}
+	return missing, nil
+}
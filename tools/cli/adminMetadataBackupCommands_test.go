@@ -0,0 +1,170 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	testMetadataManager struct {
+		persistence.MetadataManager
+		namespaces []*persistence.GetNamespaceResponse
+	}
+
+	testClusterMetadataManager struct {
+		persistence.ClusterMetadataManager
+		clusterMetadata *persistencespb.ClusterMetadata
+	}
+)
+
+func (m *testMetadataManager) ListNamespaces(request *persistence.ListNamespacesRequest) (*persistence.ListNamespacesResponse, error) {
+	start := 0
+	if len(request.NextPageToken) > 0 {
+		start = int(request.NextPageToken[0])
+	}
+	end := start + request.PageSize
+	if end >= len(m.namespaces) {
+		return &persistence.ListNamespacesResponse{Namespaces: m.namespaces[start:]}, nil
+	}
+	return &persistence.ListNamespacesResponse{Namespaces: m.namespaces[start:end], NextPageToken: []byte{byte(end)}}, nil
+}
+
+func (m *testMetadataManager) GetNamespace(request *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+	for _, ns := range m.namespaces {
+		if ns.Namespace.Info.Id == request.ID {
+			return ns, nil
+		}
+	}
+	return nil, serviceerror.NewNotFound("namespace not found")
+}
+
+func (m *testMetadataManager) CreateNamespace(request *persistence.CreateNamespaceRequest) (*persistence.CreateNamespaceResponse, error) {
+	m.namespaces = append(m.namespaces, &persistence.GetNamespaceResponse{
+		Namespace:         request.Namespace,
+		IsGlobalNamespace: request.IsGlobalNamespace,
+	})
+	return &persistence.CreateNamespaceResponse{ID: request.Namespace.Info.Id}, nil
+}
+
+func (m *testClusterMetadataManager) GetClusterMetadata() (*persistence.GetClusterMetadataResponse, error) {
+	if m.clusterMetadata == nil {
+		return nil, serviceerror.NewNotFound("cluster metadata not found")
+	}
+	return &persistence.GetClusterMetadataResponse{ClusterMetadata: *m.clusterMetadata}, nil
+}
+
+func (m *testClusterMetadataManager) SaveClusterMetadata(request *persistence.SaveClusterMetadataRequest) (bool, error) {
+	clusterMetadata := request.ClusterMetadata
+	m.clusterMetadata = &clusterMetadata
+	return true, nil
+}
+
+func TestMetadataSnapshot_BackupAndRestore(t *testing.T) {
+	clusterMetadata := &testClusterMetadataManager{clusterMetadata: &persistencespb.ClusterMetadata{
+		ClusterName:       "active",
+		ClusterId:         "cluster-id",
+		HistoryShardCount: 4,
+	}}
+	metadata := &testMetadataManager{}
+	for i, name := range []string{"ns-1", "ns-2", "ns-3"} {
+		metadata.namespaces = append(metadata.namespaces, &persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info:   &persistencespb.NamespaceInfo{Id: name + "-id", Name: name},
+				Config: &persistencespb.NamespaceConfig{},
+			},
+			IsGlobalNamespace: i == 0,
+		})
+	}
+
+	snapshot, err := newMetadataSnapshot(clusterMetadata, metadata, map[string]enumspb.IndexedValueType{
+		"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, metadataSnapshotVersion, snapshot.Version)
+	assert.Len(t, snapshot.Namespaces, 3)
+	assert.Equal(t, map[string]string{"CustomKeywordField": "Keyword"}, snapshot.SearchAttributes)
+
+	// lose cluster metadata and two namespaces
+	restoredClusterMetadata := &testClusterMetadataManager{}
+	restoredMetadata := &testMetadataManager{namespaces: metadata.namespaces[1:2]}
+	report, err := restoreMetadataSnapshot(snapshot, restoredClusterMetadata, restoredMetadata)
+	require.NoError(t, err)
+	assert.True(t, report.ClusterMetadataRestored)
+	assert.Equal(t, clusterMetadata.clusterMetadata, restoredClusterMetadata.clusterMetadata)
+	assert.Equal(t, []string{"ns-1", "ns-3"}, report.NamespacesRestored)
+	assert.Equal(t, []string{"ns-2"}, report.NamespacesSkipped)
+	assert.Empty(t, report.NamespacesFailed)
+	require.Len(t, restoredMetadata.namespaces, 3)
+	assert.True(t, restoredMetadata.namespaces[1].IsGlobalNamespace)
+	assert.Equal(t, "ns-1-id", restoredMetadata.namespaces[1].Namespace.Info.Id)
+
+	// restoring again is a no-op
+	report, err = restoreMetadataSnapshot(snapshot, restoredClusterMetadata, restoredMetadata)
+	require.NoError(t, err)
+	assert.False(t, report.ClusterMetadataRestored)
+	assert.Empty(t, report.NamespacesRestored)
+	assert.Len(t, report.NamespacesSkipped, 3)
+}
+
+func TestMetadataSnapshot_ClusterMetadataConflict(t *testing.T) {
+	snapshot, err := newMetadataSnapshot(&testClusterMetadataManager{clusterMetadata: &persistencespb.ClusterMetadata{
+		ClusterName:       "active",
+		ClusterId:         "cluster-id",
+		HistoryShardCount: 4,
+	}}, &testMetadataManager{}, nil)
+	require.NoError(t, err)
+
+	_, err = restoreMetadataSnapshot(snapshot, &testClusterMetadataManager{clusterMetadata: &persistencespb.ClusterMetadata{
+		ClusterName:       "active",
+		ClusterId:         "new-cluster-id",
+		HistoryShardCount: 4,
+	}}, &testMetadataManager{})
+	assert.Error(t, err)
+}
+
+func TestMissingSearchAttributes(t *testing.T) {
+	missing, err := missingSearchAttributes(map[string]string{
+		"WorkflowId":         "Keyword",
+		"CustomIntField":     "Int",
+		"CustomKeywordField": "Keyword",
+	}, map[string]enumspb.IndexedValueType{
+		"WorkflowId":     enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		"CustomIntField": enumspb.INDEXED_VALUE_TYPE_INT,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]enumspb.IndexedValueType{"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD}, missing)
+
+	_, err = missingSearchAttributes(map[string]string{"CustomField": "Unknown"}, nil)
+	assert.Error(t, err)
+}
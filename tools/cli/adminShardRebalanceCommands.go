@@ -0,0 +1,128 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminShardOwnershipReport prints shard counts and load of every history host, and progress of shard rebalance
+func AdminShardOwnershipReport(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	report, err := adminClient.GetShardOwnershipReport(ctx, &adminservice.GetShardOwnershipReportRequest{})
+	if err != nil {
+		ErrorAndExit("Operation GetShardOwnershipReport failed.", err)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"History Host", "Shards", "Requests Per Second", "Error"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, host := range report.GetHosts() {
+		table.Append([]string{
+			host.GetAddress(),
+			strconv.Itoa(int(host.GetShards())),
+			strconv.FormatFloat(host.GetRequestsPerSecond(), 'f', 2, 64),
+			host.GetError(),
+		})
+	}
+	table.Render()
+
+	fmt.Printf("Shard imbalance: %.2f\n", report.GetShardImbalance())
+	fmt.Printf("Load imbalance: %.2f\n", report.GetLoadImbalance())
+	if plan := report.GetRebalancePlan(); len(plan.GetMoves()) > 0 {
+		fmt.Printf("Rebalance moves in effect: %v of %v\n", report.GetAppliedRebalanceMoves(), len(plan.GetMoves()))
+		if plan.GetCancelTime() != nil {
+			fmt.Printf("Rebalance cancelled at: %v\n", plan.GetCancelTime().Format(time.RFC3339))
+		}
+	}
+}
+
+// AdminRebalanceShards starts gradual rebalance of shards towards target distribution
+func AdminRebalanceShards(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	target := parseShardTarget(getRequiredOption(c, FlagShardTarget))
+	movesPerMinute := c.Int(FlagMovesPerMinute)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.StartShardRebalance(ctx, &adminservice.StartShardRebalanceRequest{
+		Target:         target,
+		MovesPerMinute: int32(movesPerMinute),
+	})
+	if err != nil {
+		ErrorAndExit("Operation StartShardRebalance failed.", err)
+	}
+
+	plan := resp.GetRebalancePlan()
+	moves := len(plan.GetMoves())
+	fmt.Printf("Shards to move: %v\n", moves)
+	if moves > 0 {
+		fmt.Printf("Start time: %v\n", plan.GetStartTime().Format(time.RFC3339))
+		fmt.Printf("Estimated duration: %v minutes\n", (moves+movesPerMinute-1)/movesPerMinute)
+	}
+}
+
+// AdminCancelShardRebalance cancels shard rebalance, shards are moved back at the rate they were moved
+func AdminCancelShardRebalance(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := adminClient.CancelShardRebalance(ctx, &adminservice.CancelShardRebalanceRequest{}); err != nil {
+		ErrorAndExit("Operation CancelShardRebalance failed.", err)
+	}
+	fmt.Println("Shard rebalance is cancelled.")
+}
+
+func parseShardTarget(value string) map[string]int32 {
+	target := make(map[string]int32)
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), "=")
+		if len(parts) != 2 {
+			ErrorAndExit(fmt.Sprintf("Invalid target %q, expected address=shards.", entry), nil)
+		}
+		shards, err := strconv.Atoi(parts[1])
+		if err != nil || shards < 0 {
+			ErrorAndExit(fmt.Sprintf("Invalid number of shards in target %q.", entry), err)
+		}
+		target[parts[0]] = int32(shards)
+	}
+	return target
+}
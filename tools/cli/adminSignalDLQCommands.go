@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/codec"
+)
+
+// adminGetSignalDLQMessages prints signals captured in signal DLQ of the namespace
+func adminGetSignalDLQMessages(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	outputFile := getOutputFile(c.String(FlagOutputFilename))
+	defer outputFile.Close()
+
+	remainingMessageCount := common.EndMessageID
+	if c.IsSet(FlagMaxMessageCount) {
+		remainingMessageCount = c.Int64(FlagMaxMessageCount)
+	}
+	var lastMessageID int64
+	if c.IsSet(FlagLastMessageID) {
+		lastMessageID = c.Int64(FlagLastMessageID)
+	}
+
+	encoder := codec.NewJSONPBEncoder()
+	var token []byte
+	for remainingMessageCount > 0 {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.GetDLQMessages(ctx, &adminservice.GetDLQMessagesRequest{
+			Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL,
+			Namespace:             namespace,
+			InclusiveEndMessageId: lastMessageID,
+			MaximumPageSize:       defaultPageSize,
+			NextPageToken:         token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to read signal DLQ messages.", err)
+		}
+
+		for _, message := range resp.GetSignalMessages() {
+			if remainingMessageCount <= 0 {
+				break
+			}
+			remainingMessageCount--
+			encodedMessage, err := encoder.Encode(message)
+			if err != nil {
+				ErrorAndExit("Unable to encode signal DLQ message.", err)
+			}
+			if _, err := outputFile.WriteString(fmt.Sprintf("%v\n", string(encodedMessage))); err != nil {
+				ErrorAndExit("Failed to print signal DLQ messages.", err)
+			}
+		}
+
+		token = resp.GetNextPageToken()
+		if len(token) == 0 {
+			break
+		}
+	}
+}
+
+// adminPurgeSignalDLQMessages deletes signals of the namespace from signal DLQ
+func adminPurgeSignalDLQMessages(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	var lastMessageID int64
+	if c.IsSet(FlagLastMessageID) {
+		lastMessageID = c.Int64(FlagLastMessageID)
+	} else {
+		confirmOrExit("Are you sure to purge all signal DLQ messages of the namespace without a upper boundary?")
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	adminClient := cFactory.AdminClient(c)
+	if _, err := adminClient.PurgeDLQMessages(ctx, &adminservice.PurgeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL,
+		Namespace:             namespace,
+		InclusiveEndMessageId: lastMessageID,
+	}); err != nil {
+		ErrorAndExit("Failed to purge signal DLQ.", err)
+	}
+	fmt.Println("Successfully purged signal DLQ messages.")
+}
+
+// adminMergeSignalDLQMessages re-delivers signals of the namespace from signal DLQ
+func adminMergeSignalDLQMessages(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	var lastMessageID int64
+	if c.IsSet(FlagLastMessageID) {
+		lastMessageID = c.Int64(FlagLastMessageID)
+	} else {
+		confirmOrExit("Are you sure to re-deliver all signal DLQ messages of the namespace without a upper boundary?")
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	request := &adminservice.MergeDLQMessagesRequest{
+		Type:                  enumsspb.DEAD_LETTER_QUEUE_TYPE_SIGNAL,
+		Namespace:             namespace,
+		InclusiveEndMessageId: lastMessageID,
+		MaximumPageSize:       defaultPageSize,
+	}
+	for {
+		ctx, cancel := newContext(c)
+		response, err := adminClient.MergeDLQMessages(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to re-deliver signal DLQ messages.", err)
+		}
+		if len(response.GetNextPageToken()) == 0 {
+			break
+		}
+		request.NextPageToken = response.GetNextPageToken()
+	}
+	fmt.Println("Successfully re-delivered all signal DLQ messages.")
+}
@@ -119,5 +119,46 @@ func newBatchCommands() []cli.Command {
 				StartBatchJob(c)
 			},
 		},
+		{
+			Name:  "replay-check",
+			Usage: "Replay sampled workflow histories on a shadow worker build to detect non-determinism",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagListQueryWithAlias,
+					Usage: "Query to sample workflows to replay, e.g. recently closed workflows of a task queue",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskQueueWithAlias,
+					Usage: "Validation task queue polled by the shadow worker build",
+				},
+				//below are optional
+				cli.IntFlag{
+					Name:  FlagMaxWorkflowCount,
+					Value: batcher.DefaultReplayCheckMaxWorkflows,
+					Usage: "Max number of workflows to replay",
+				},
+				cli.IntFlag{
+					Name:  FlagConcurrency,
+					Value: batcher.DefaultReplayCheckConcurrency,
+					Usage: "Number of histories replayed in parallel",
+				},
+			},
+			Action: func(c *cli.Context) {
+				StartReplayCheck(c)
+			},
+		},
+		{
+			Name:  "describe-replay-check",
+			Usage: "Describe the status and the non-determinism found by a replay check",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "Replay check job ID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DescribeReplayCheck(c)
+			},
+		},
 	}
 }
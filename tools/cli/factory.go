@@ -40,6 +40,7 @@ import (
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/searchattributesservice/v1"
 	"go.temporal.io/server/common/auth"
 	"go.temporal.io/server/common/log"
 )
@@ -48,6 +49,7 @@ import (
 type ClientFactory interface {
 	FrontendClient(c *cli.Context) workflowservice.WorkflowServiceClient
 	AdminClient(c *cli.Context) adminservice.AdminServiceClient
+	SearchAttributesClient(c *cli.Context) searchattributesservice.SearchAttributesServiceClient
 	SDKClient(c *cli.Context, namespace string) sdkclient.Client
 	HealthClient(c *cli.Context) healthpb.HealthClient
 }
@@ -82,6 +84,13 @@ func (b *clientFactory) AdminClient(c *cli.Context) adminservice.AdminServiceCli
 	return adminservice.NewAdminServiceClient(connection)
 }
 
+// SearchAttributesClient builds a client of the frontend service upserting search attributes of workflows.
+func (b *clientFactory) SearchAttributesClient(c *cli.Context) searchattributesservice.SearchAttributesServiceClient {
+	connection, _ := b.createGRPCConnection(c)
+
+	return searchattributesservice.NewSearchAttributesServiceClient(connection)
+}
+
 // SDKClient builds an SDK client.
 func (b *clientFactory) SDKClient(c *cli.Context, namespace string) sdkclient.Client {
 	hostPort := c.GlobalString(FlagAddress)
@@ -49,6 +49,7 @@ const (
 	FlagTreeID                           = "tree_id"
 	FlagBranchID                         = "branch_id"
 	FlagNumberOfShards                   = "number_of_shards"
+	FlagMinBranchCount                   = "min_branch_count"
 	FlagRunIDWithAlias                   = FlagRunID + ", rid, r"
 	FlagTargetCluster                    = "target_cluster"
 	FlagMinEventID                       = "min_event_id"
@@ -162,12 +163,18 @@ const (
 	FlagEventIDWithAlias                 = FlagEventID + ", eid"
 	FlagActivityID                       = "activity_id"
 	FlagActivityIDWithAlias              = FlagActivityID + ", aid"
+	FlagTimerID                          = "timer_id"
+	FlagActivityType                     = "activity_type"
+	FlagTargetTaskQueue                  = "target_taskqueue"
+	FlagAnnotation                       = "annotation"
+	FlagAnnotationTags                   = "tags"
 	FlagMaxFieldLength                   = "max_field_length"
 	FlagMaxFieldLengthWithAlias          = FlagMaxFieldLength + ", maxl"
 	FlagSecurityToken                    = "security_token"
 	FlagSecurityTokenWithAlias           = FlagSecurityToken + ", st"
 	FlagSkipErrorMode                    = "skip_errors"
 	FlagSkipErrorModeWithAlias           = FlagSkipErrorMode + ", serr"
+	FlagSkipSearchAttributes             = "skip_search_attributes"
 	FlagHeadersMode                      = "headers"
 	FlagHeadersModeWithAlias             = FlagHeadersMode + ", he"
 	FlagMessageType                      = "message_type"
@@ -186,6 +193,8 @@ const (
 	FlagRemoveBadBinary                  = "remove_bad_binary"
 	FlagResetType                        = "reset_type"
 	FlagResetPointsOnly                  = "reset_points_only"
+	FlagPendingDetails                   = "pending_details"
+	FlagPendingTimers                    = "pending_timers"
 	FlagResetBadBinaryChecksum           = "reset_bad_binary_checksum"
 	FlagListQuery                        = "query"
 	FlagListQueryWithAlias               = FlagListQuery + ", q"
@@ -224,12 +233,16 @@ const (
 	FlagLastMessageID                    = "last_message_id"
 	FlagLastMessageIDWithAlias           = FlagLastMessageID + ", lm"
 	FlagConcurrency                      = "concurrency"
+	FlagMaxWorkflowCount                 = "max_workflow_count"
 	FlagReportRate                       = "report_rate"
 	FlagLowerShardBound                  = "lower_shard_bound"
 	FlagUpperShardBound                  = "upper_shard_bound"
 	FlagInputDirectory                   = "input_directory"
 	FlagAutoConfirm                      = "auto_confirm"
 	FlagVersion                          = "version"
+	FlagShardTarget                      = "target"
+	FlagMovesPerMinute                   = "moves_per_minute"
+	FlagTLSGroup                         = "tls_group"
 
 	FlagProtoType  = "type"
 	FlagHexData    = "hex_data"
@@ -570,6 +583,15 @@ func getFlagsForDescribeID() []cli.Flag {
 			Name:  FlagResetPointsOnly,
 			Usage: "Only show auto-reset points",
 		},
+		cli.BoolFlag{
+			Name:  FlagPendingDetails,
+			Usage: "Also show pending workflow task, next timers, pending children, cancel requests and signals",
+		},
+		cli.IntFlag{
+			Name:  FlagPendingTimers,
+			Value: 10,
+			Usage: "Number of next timers to show with pending details",
+		},
 	}
 }
 
@@ -267,12 +267,15 @@ func initializeNamespaceHandler(
 	return namespace.NewHandler(
 		namespace.MinRetentionDays,
 		dynamicconfig.GetIntPropertyFilteredByNamespace(namespace.MaxBadBinaries),
+		dynamicconfig.GetBoolPropertyFn(true),
 		logger,
 		metadataMgr,
 		clusterMetadata,
 		initializeNamespaceReplicator(logger),
 		archivalMetadata,
 		archiverProvider,
+		nil,
+		nil,
 	)
 }
 
@@ -339,6 +342,8 @@ func initializeArchivalMetadata(
 		serviceConfig.Archival.Visibility.State,
 		serviceConfig.Archival.Visibility.EnableRead,
 		&serviceConfig.NamespaceDefaults.Archival,
+		serviceConfig.Archival.History.AllowedDestinations,
+		serviceConfig.Archival.Visibility.AllowedDestinations,
 	)
 }
 
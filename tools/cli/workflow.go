@@ -104,6 +104,33 @@ func newWorkflowCommands() []cli.Command {
 				SignalWorkflow(c)
 			},
 		},
+		{
+			Name:  "upsert-search-attr",
+			Usage: "upsert search attributes of a running workflow execution without workflow task",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name: FlagSearchAttributesKey,
+					Usage: "Search attributes keys to upsert. If there are multiple keys, concatenate them and separate by |. " +
+						"Use 'cluster get-search-attr' cmd to list legal keys.",
+				},
+				cli.StringFlag{
+					Name: FlagSearchAttributesVal,
+					Usage: "Search attributes values to upsert. If there are multiple keys, concatenate them and separate by |. " +
+						"If value is array, use json array like [\"a\",\"b\"], [1,2].",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpsertSearchAttributes(c)
+			},
+		},
 		{
 			Name:    "terminate",
 			Aliases: []string{"term"},
@@ -153,6 +180,27 @@ func newWorkflowCommands() []cli.Command {
 				ListArchivedWorkflow(c)
 			},
 		},
+		{
+			Name:  "liststuck",
+			Usage: "list open workflow executions flagged as stuck, requires history.enableStuckExecutionDetection and advanced visibility",
+			Flags: append([]cli.Flag{
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Only list executions stuck for this reason [ActivityNotStarted, WorkflowTaskNotStarted, WorkflowTaskRetrying]",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintRawTimeWithAlias,
+					Usage: "Print raw timestamp",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintDateTimeWithAlias,
+					Usage: "Print full date time in '2006-01-02T15:04:05Z07:00' format",
+				},
+			}, flagsForPagination...),
+			Action: func(c *cli.Context) {
+				ListStuckWorkflow(c)
+			},
+		},
 		{
 			Name:    "scan",
 			Aliases: []string{"sc", "scanall"},
@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/payload"
@@ -227,6 +228,47 @@ func StartBatchJob(c *cli.Context) {
 	prettyPrintJSONObject(output)
 }
 
+// StartReplayCheck starts a job replaying sampled workflow histories on a shadow worker build
+func StartReplayCheck(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	query := getRequiredOption(c, FlagListQuery)
+	taskQueue := getRequiredOption(c, FlagTaskQueue)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.StartReplayCheck(ctx, &adminservice.StartReplayCheckRequest{
+		Namespace:           namespace,
+		ValidationTaskQueue: taskQueue,
+		Query:               query,
+		MaxWorkflows:        int32(c.Int(FlagMaxWorkflowCount)),
+		Concurrency:         int32(c.Int(FlagConcurrency)),
+		Identity:            getCurrentUserFromEnv(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to start replay check", err)
+	}
+	output := map[string]interface{}{
+		"msg":   "replay check is started",
+		"jobId": resp.GetJobId(),
+	}
+	prettyPrintJSONObject(output)
+}
+
+// DescribeReplayCheck describes the status and the outcome of a replay check
+func DescribeReplayCheck(c *cli.Context) {
+	jobID := getRequiredOption(c, FlagJobID)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeReplayCheck(ctx, &adminservice.DescribeReplayCheckRequest{JobId: jobID})
+	if err != nil {
+		ErrorAndExit("Failed to describe replay check", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
 func validateBatchType(bt string) bool {
 	for _, b := range batcher.AllBatchTypes {
 		if b == bt {
@@ -58,10 +58,13 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	clispb "go.temporal.io/server/api/cli/v1"
+	"go.temporal.io/server/api/searchattributesservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -505,6 +508,34 @@ func SignalWorkflow(c *cli.Context) {
 	}
 }
 
+// UpsertSearchAttributes upserts search attributes of a running workflow execution without workflow task
+func UpsertSearchAttributes(c *cli.Context) {
+	serviceClient := cFactory.SearchAttributesClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	getRequiredOption(c, FlagSearchAttributesKey)
+	getRequiredOption(c, FlagSearchAttributesVal)
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	_, err := serviceClient.UpsertWorkflowSearchAttributes(tcCtx, &searchattributesservice.UpsertWorkflowSearchAttributesRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: processSearchAttr(c)},
+	})
+
+	if err != nil {
+		ErrorAndExit("Upsert search attributes failed.", err)
+	} else {
+		fmt.Println("Upsert search attributes succeeded.")
+	}
+}
+
 // QueryWorkflow query workflow execution
 func QueryWorkflow(c *cli.Context) {
 	getRequiredGlobalOption(c, FlagNamespace) // for pre-check and alert if not provided
@@ -680,6 +711,60 @@ func ScanAllWorkflow(c *cli.Context) {
 	table.Render()
 }
 
+// ListStuckWorkflow list open workflow executions flagged as stuck by history
+func ListStuckWorkflow(c *cli.Context) {
+	wfClient := getWorkflowClient(c)
+	more := c.Bool(FlagMore)
+	printRawTime := c.Bool(FlagPrintRawTime)
+	printDateTime := c.Bool(FlagPrintDateTime)
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForList
+	}
+
+	reasons := definition.StuckReasons
+	if c.IsSet(FlagReason) {
+		reason := c.String(FlagReason)
+		switch reason {
+		case definition.StuckReasonActivityNotStarted, definition.StuckReasonWorkflowTaskNotStarted, definition.StuckReasonWorkflowTaskRetrying:
+			reasons = []string{reason}
+		default:
+			ErrorAndExit(optionErr, fmt.Errorf("reason must be one of %v", definition.StuckReasons))
+		}
+	}
+	query := fmt.Sprintf("%s in ('%s') and CloseTime = missing", definition.TemporalStuckReason, strings.Join(reasons, "','"))
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Workflow Type", "Workflow Id", "Run Id", "Task Queue", "Start Time", "Stuck Reason"})
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	table.SetHeaderLine(false)
+
+	var nextPageToken []byte
+	for {
+		var executions []*workflowpb.WorkflowExecutionInfo
+		executions, nextPageToken = listWorkflowExecutions(wfClient, pageSize, nextPageToken, query, c)
+		for _, e := range executions {
+			var startTime string
+			if printRawTime {
+				startTime = fmt.Sprintf("%v", timestamp.TimeValue(e.GetStartTime()))
+			} else {
+				startTime = formatTime(timestamp.TimeValue(e.GetStartTime()), !printDateTime)
+			}
+			var reason string
+			_ = payload.Decode(e.GetSearchAttributes().GetIndexedFields()[definition.TemporalStuckReason], &reason)
+			table.Append([]string{trimWorkflowType(e.Type.GetName()), e.Execution.GetWorkflowId(), e.Execution.GetRunId(), e.GetTaskQueue(), startTime, reason})
+		}
+		table.Render()
+		table.ClearRows()
+
+		if !more || len(nextPageToken) == 0 || !showNextPage() {
+			break
+		}
+	}
+}
+
 func isQueryOpen(query string) bool {
 	var openWFPattern = regexp.MustCompile(`CloseTime[ ]*=[ ]*missing`)
 	return openWFPattern.MatchString(query)
@@ -837,6 +922,7 @@ func describeWorkflowHelper(c *cli.Context, wid, rid string) {
 	printRaw := c.Bool(FlagPrintRaw) // printRaw is false by default,
 	// and will show datetime and decoded search attributes instead of raw timestamp and byte arrays
 	printResetPointsOnly := c.Bool(FlagResetPointsOnly)
+	printPendingDetails := c.Bool(FlagPendingDetails)
 
 	ctx, cancel := newContext(c)
 	defer cancel()
@@ -862,6 +948,31 @@ func describeWorkflowHelper(c *cli.Context, wid, rid string) {
 	} else {
 		prettyPrintJSONObject(convertDescribeWorkflowExecutionResponse(resp, frontendClient, c))
 	}
+
+	if printPendingDetails {
+		printPendingExecutionDetails(c, namespace, resp.GetWorkflowExecutionInfo().GetExecution())
+	}
+}
+
+// printPendingExecutionDetails prints what the execution is waiting for, as reported by admin DescribeMutableState
+func printPendingExecutionDetails(c *cli.Context, namespace string, execution *commonpb.WorkflowExecution) {
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace:             namespace,
+		Execution:             execution,
+		IncludePendingDetails: true,
+		PendingTimerCount:     int32(c.Int(FlagPendingTimers)),
+	})
+	if err != nil {
+		ErrorAndExit("Describe pending execution details failed", err)
+	}
+
+	fmt.Println("Pending details:")
+	prettyPrintJSONObject(resp.GetPendingExecutionDetails())
 }
 
 func printAutoResetPoints(resp *workflowservice.DescribeWorkflowExecutionResponse) {
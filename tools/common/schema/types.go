@@ -112,6 +112,8 @@ const (
 	CLIOptQuiet = "quiet"
 	// CLIOptForce is the cli option for force mode
 	CLIOptForce = "force"
+	// CLIOptTableOptionsFile is the cli option for the table options file
+	CLIOptTableOptionsFile = "table-options-file"
 
 	// CLIFlagEndpoint is the cli flag for endpoint
 	CLIFlagEndpoint = CLIOptEndpoint + ", ep"
@@ -153,6 +155,8 @@ const (
 	CLIFlagQuiet = CLIOptQuiet + ", q"
 	// CLIFlagForce is the cli flag for force mode
 	CLIFlagForce = CLIOptForce + ", f"
+	// CLIFlagTableOptionsFile is the cli flag for the table options file
+	CLIFlagTableOptionsFile = CLIOptTableOptionsFile + ", to"
 
 	// CLIFlagEnableTLS enables cassandra client TLS
 	CLIFlagEnableTLS = "tls"